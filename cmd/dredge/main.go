@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"time"
+	// Quiet hours resolve IANA time zones; the runtime image ships without a zoneinfo database.
+	_ "time/tzdata"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/rofleksey/dredge/internal/app"
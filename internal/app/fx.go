@@ -18,6 +18,7 @@ import (
 	"github.com/rofleksey/dredge/internal/observability"
 	"github.com/rofleksey/dredge/internal/repository"
 	"github.com/rofleksey/dredge/internal/repository/postgres"
+	"github.com/rofleksey/dredge/internal/service/notify"
 	twitchoauth "github.com/rofleksey/dredge/internal/service/twitch"
 	"github.com/rofleksey/dredge/internal/usecase/ai"
 	"github.com/rofleksey/dredge/internal/usecase/auth"
@@ -67,7 +68,16 @@ func fxOptions() fx.Option {
 			func(cfg config.Config, obs *observability.Stack) (*auth.Usecase, error) {
 				return auth.New(cfg, cfg.JWT.Secret, cfg.JWT.TTL, obs)
 			},
-			settings.New,
+			newNotifyProviders,
+			func(r repository.Store, obs *observability.Stack, providers *notify.Registry, notifier *notify.Dispatcher, tw *twitchuc.Usecase) *settings.Usecase {
+				svc := settings.New(r, obs)
+				svc.SetNotificationProviders(providers)
+				svc.SetNotificationTester(notifier)
+				svc.SetSuspicionReevaluator(tw)
+				svc.SetLoginPatternInvalidator(tw)
+
+				return svc
+			},
 			func(origin config.AllowedWebOrigin) (*ws.Hub, error) {
 				return ws.NewHub(string(origin)), nil
 			},
@@ -83,9 +93,15 @@ func fxOptions() fx.Option {
 					cfg.JWT.Secret,
 				)
 			},
+			newNotifyDispatcher,
+			newTelegramBot,
 			newRulesServices,
 			func(r repository.Store, tw *twitchuc.Usecase, rulesSvc *rules.Usecase, sett *settings.Usecase, hub *ws.Hub, obs *observability.Stack) *ai.Usecase {
-				return ai.New(r, tw, rulesSvc, sett, hub, obs)
+				svc := ai.New(r, tw, rulesSvc, sett, hub, obs)
+				tw.SetStreamRecapSummarizer(svc)
+				tw.SetChatClassifier(svc)
+
+				return svc
 			},
 			func(r repository.Store, pool *pgxpool.Pool, tw *twitchuc.Usecase, lim *httpmw.LoginLimiter) *stats.Collector {
 				return stats.NewCollector(r, tw, lim, pool)
@@ -127,6 +143,7 @@ func fxOptions() fx.Option {
 		// code (e.g. rules Bootstrap listing rules) that depends on the current schema.
 		fx.Invoke(registerLifecycle),
 		fx.Invoke(registerRulesLifecycle),
+		fx.Invoke(registerTelegramBotLifecycle),
 	)
 }
 
@@ -28,6 +28,18 @@ type twitchRuntime struct {
 	stopIrcJoinedSnap  context.CancelFunc
 	discoveryCtx       context.Context
 	stopDiscovery      context.CancelFunc
+	altDetectionCtx    context.Context
+	stopAltDetection   context.CancelFunc
+	botDetectionCtx    context.Context
+	stopBotDetection   context.CancelFunc
+	blocklistSyncCtx   context.Context
+	stopBlocklistSync  context.CancelFunc
+	analyticsCtx       context.Context
+	stopAnalytics      context.CancelFunc
+	streamTermsCtx     context.Context
+	stopStreamTerms    context.CancelFunc
+	chatClassifierCtx  context.Context
+	stopChatClassifier context.CancelFunc
 	enrichWorkerCtx    context.Context
 	stopEnrichWorker   context.CancelFunc
 	persistCtx         context.Context
@@ -81,6 +93,12 @@ func onAppStart(
 	rt.streamRecorderCtx, rt.stopStreamRecorder = context.WithCancel(context.Background())
 	rt.ircJoinedSnapCtx, rt.stopIrcJoinedSnap = context.WithCancel(context.Background())
 	rt.discoveryCtx, rt.stopDiscovery = context.WithCancel(context.Background())
+	rt.altDetectionCtx, rt.stopAltDetection = context.WithCancel(context.Background())
+	rt.botDetectionCtx, rt.stopBotDetection = context.WithCancel(context.Background())
+	rt.blocklistSyncCtx, rt.stopBlocklistSync = context.WithCancel(context.Background())
+	rt.analyticsCtx, rt.stopAnalytics = context.WithCancel(context.Background())
+	rt.streamTermsCtx, rt.stopStreamTerms = context.WithCancel(context.Background())
+	rt.chatClassifierCtx, rt.stopChatClassifier = context.WithCancel(context.Background())
 	rt.enrichWorkerCtx, rt.stopEnrichWorker = context.WithCancel(context.Background())
 	rt.persistCtx, rt.stopPersist = context.WithCancel(context.Background())
 
@@ -99,6 +117,12 @@ func onAppStart(
 	go twitchSvc.StartStreamSessionRecorder(rt.streamRecorderCtx)
 	go twitchSvc.StartIrcJoinedSnapshotLoop(rt.ircJoinedSnapCtx)
 	go twitchSvc.StartChannelDiscoveryLoop(rt.discoveryCtx)
+	go twitchSvc.StartAltDetectionLoop(rt.altDetectionCtx)
+	go twitchSvc.StartBotDetectionLoop(rt.botDetectionCtx)
+	go twitchSvc.StartBlocklistSyncLoop(rt.blocklistSyncCtx)
+	go twitchSvc.StartChannelAnalyticsRollupLoop(rt.analyticsCtx)
+	go twitchSvc.StartStreamTermsLoop(rt.streamTermsCtx)
+	go twitchSvc.StartChatClassificationLoop(rt.chatClassifierCtx)
 
 	if addr := cfg.Server.MetricsAddress; addr != "" {
 		metricsMux := http.NewServeMux()
@@ -155,6 +179,12 @@ func onAppStop(
 	rt.stopStreamRecorder()
 	rt.stopIrcJoinedSnap()
 	rt.stopDiscovery()
+	rt.stopAltDetection()
+	rt.stopBotDetection()
+	rt.stopBlocklistSync()
+	rt.stopAnalytics()
+	rt.stopStreamTerms()
+	rt.stopChatClassifier()
 	rt.stopEnrichWorker()
 
 	twitchSvc.StopMonitor()
@@ -5,22 +5,47 @@ import (
 
 	"go.uber.org/fx"
 
+	"github.com/rofleksey/dredge/internal/config"
 	"github.com/rofleksey/dredge/internal/observability"
 	"github.com/rofleksey/dredge/internal/repository"
+	"github.com/rofleksey/dredge/internal/service/notify"
 	"github.com/rofleksey/dredge/internal/usecase/rules"
 	twitchuc "github.com/rofleksey/dredge/internal/usecase/twitch"
 )
 
+func newNotifyProviders(cfg config.Config) *notify.Registry {
+	return notify.NewRegistry(notify.ProvidersConfig{
+		TelegramAPIBaseURL:  cfg.Notifications.TelegramAPIBaseURL,
+		NtfyBaseURL:         cfg.Notifications.NtfyBaseURL,
+		MatrixHomeserverURL: cfg.Notifications.MatrixHomeserverURL,
+	})
+}
+
+func newNotifyDispatcher(repo repository.Store, obs *observability.Stack, tw *twitchuc.Usecase, providers *notify.Registry) *notify.Dispatcher {
+	d := notify.NewDispatcher(notify.Config{
+		Repo:           repo,
+		Obs:            obs,
+		HTTPClient:     tw.Client.HTTPClient,
+		Providers:      providers,
+		PersistContext: func() context.Context { return tw.PersistContext() },
+	})
+	tw.SetStreamRecapNotifier(d)
+
+	return d
+}
+
 func newRulesServices(
 	repo repository.Store,
 	obs *observability.Stack,
 	tw *twitchuc.Usecase,
+	notifier *notify.Dispatcher,
 ) (*rules.Engine, *rules.Usecase, error) {
 	eng := rules.NewEngine(rules.Config{
 		Repo:           repo,
 		Helix:          tw.Client,
-		Notify:         tw.LiveRuntime(),
+		Notify:         notifier,
 		Send:           tw,
+		Suspicion:      tw,
 		PersistContext: func() context.Context { return tw.PersistContext() },
 		Obs:            obs,
 	})
@@ -30,9 +55,13 @@ func newRulesServices(
 	return eng, svc, nil
 }
 
-func registerRulesLifecycle(lc fx.Lifecycle, eng *rules.Engine, svc *rules.Usecase, tw *twitchuc.Usecase) {
+// registerRulesLifecycle also owns the notification outbox worker: its OnStop runs before the
+// pool is closed in registerLifecycle, so in-flight deliveries can record their attempt.
+func registerRulesLifecycle(lc fx.Lifecycle, eng *rules.Engine, svc *rules.Usecase, tw *twitchuc.Usecase, notifier *notify.Dispatcher) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
+			notifier.Start(context.Background())
+
 			tw.LiveRuntime().SetRuleEngine(eng)
 
 			eng.Start(context.Background())
@@ -47,6 +76,7 @@ func registerRulesLifecycle(lc fx.Lifecycle, eng *rules.Engine, svc *rules.Useca
 			tw.LiveRuntime().SetRuleEngine(nil)
 
 			eng.Stop()
+			notifier.Stop()
 
 			return nil
 		},
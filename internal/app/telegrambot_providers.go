@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	"github.com/rofleksey/dredge/internal/config"
+	"github.com/rofleksey/dredge/internal/observability"
+	"github.com/rofleksey/dredge/internal/repository"
+	"github.com/rofleksey/dredge/internal/usecase/settings"
+	"github.com/rofleksey/dredge/internal/usecase/telegrambot"
+)
+
+func newTelegramBot(cfg config.Config, repo repository.Store, sett *settings.Usecase, obs *observability.Stack) *telegrambot.Bot {
+	return telegrambot.New(telegrambot.Config{
+		Repo:       repo,
+		Settings:   sett,
+		Obs:        obs,
+		APIBaseURL: cfg.Notifications.TelegramAPIBaseURL,
+		WebBaseURL: cfg.Server.BaseURL,
+	})
+}
+
+// registerTelegramBotLifecycle runs after registerLifecycle so the schema is migrated before the first
+// entry refresh, and stops pollers before the pool is closed.
+func registerTelegramBotLifecycle(lc fx.Lifecycle, bot *telegrambot.Bot) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			bot.Start(context.Background())
+
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			bot.Stop()
+
+			return nil
+		},
+	})
+}
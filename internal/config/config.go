@@ -49,6 +49,15 @@ type Config struct {
 		// UserOAuthTokenCacheTTL is how long a linked-account OAuth access token is reused before refresh (Helix + IRC). Default 30m.
 		UserOAuthTokenCacheTTL time.Duration `yaml:"user_oauth_token_cache_ttl"`
 	} `yaml:"twitch" validate:"required"`
+	// Notifications overrides provider API endpoints (self-hosted or test servers); all keys are optional.
+	Notifications struct {
+		// TelegramAPIBaseURL replaces https://api.telegram.org (e.g. a local Bot API server).
+		TelegramAPIBaseURL string `yaml:"telegram_api_base_url" validate:"omitempty,url"`
+		// NtfyBaseURL is the ntfy server for entries without settings.server_url. Default https://ntfy.sh.
+		NtfyBaseURL string `yaml:"ntfy_base_url" validate:"omitempty,url"`
+		// MatrixHomeserverURL is the homeserver for entries without settings.homeserver_url.
+		MatrixHomeserverURL string `yaml:"matrix_homeserver_url" validate:"omitempty,url"`
+	} `yaml:"notifications"`
 	Observability struct {
 		ServiceName   string `yaml:"service_name" validate:"required"`
 		LogLevel      string `yaml:"log_level" validate:"omitempty,oneof=debug info warn error"`
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultActivityHeatmapRange is the trailing range served when a heatmap query gives no start time.
+	DefaultActivityHeatmapRange = 28 * 24 * time.Hour
+	// MaxActivityHeatmapRange caps one heatmap query; presence is computed from raw activity events.
+	MaxActivityHeatmapRange = 92 * 24 * time.Hour
+)
+
+// HourOfWeekMatrix holds one value per local weekday (Monday first) and hour of day.
+type HourOfWeekMatrix [7][24]int64
+
+// HourOfWeekCell is the matrix cell of t in loc.
+func HourOfWeekCell(t time.Time, loc *time.Location) (day, hour int) {
+	lt := t.In(loc)
+
+	return (int(lt.Weekday()) + 6) % 7, lt.Hour()
+}
+
+// AddSpan adds the seconds of [start, end) to the cells they fall in, split at local hour boundaries.
+func (m *HourOfWeekMatrix) AddSpan(start, end time.Time, loc *time.Location) {
+	for cur := start; cur.Before(end); {
+		lt := cur.In(loc)
+		intoHour := time.Duration(lt.Minute())*time.Minute + time.Duration(lt.Second())*time.Second + time.Duration(lt.Nanosecond())
+
+		next := cur.Add(time.Hour - intoHour)
+		if next.After(end) {
+			next = end
+		}
+
+		day, hour := HourOfWeekCell(cur, loc)
+		m[day][hour] += int64(next.Sub(cur).Seconds())
+		cur = next
+	}
+}
+
+// ActivityHeatmapQuery selects the range and timezone of a user or channel activity heatmap.
+type ActivityHeatmapQuery struct {
+	From     time.Time
+	To       time.Time
+	Timezone string
+}
+
+// Normalize validates the query against now and fills defaults: To now, From To minus 28 days and timezone UTC.
+// It returns the loaded location.
+func (q ActivityHeatmapQuery) Normalize(now time.Time) (ActivityHeatmapQuery, *time.Location, error) {
+	q.Timezone = strings.TrimSpace(q.Timezone)
+	if q.Timezone == "" {
+		q.Timezone = "UTC"
+	}
+
+	if strings.EqualFold(q.Timezone, "local") {
+		return q, nil, fmt.Errorf("%w: timezone must be an IANA name", ErrInvalidActivityHeatmapQuery)
+	}
+
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return q, nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidActivityHeatmapQuery, q.Timezone)
+	}
+
+	if q.To.IsZero() || q.To.After(now) {
+		q.To = now
+	}
+
+	if q.From.IsZero() {
+		q.From = q.To.Add(-DefaultActivityHeatmapRange)
+	}
+
+	q.From, q.To = q.From.UTC(), q.To.UTC()
+
+	if !q.From.Before(q.To) {
+		return q, nil, fmt.Errorf("%w: from must be before to", ErrInvalidActivityHeatmapQuery)
+	}
+
+	if q.To.Sub(q.From) > MaxActivityHeatmapRange {
+		return q, nil, fmt.Errorf("%w: at most %s per query", ErrInvalidActivityHeatmapQuery, MaxActivityHeatmapRange)
+	}
+
+	return q, loc, nil
+}
+
+// ActivityHeatmap is when a user or channel is active by local hour of week over [From, To): IRC presence seconds
+// and chat messages per cell.
+type ActivityHeatmap struct {
+	Timezone        string
+	From            time.Time
+	To              time.Time
+	PresenceSeconds HourOfWeekMatrix
+	Messages        HourOfWeekMatrix
+}
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHourOfWeekMatrix_AddSpan(t *testing.T) {
+	t.Parallel()
+
+	// 2026-03-08 is a Sunday.
+	start := time.Date(2026, 3, 8, 23, 30, 0, 0, time.UTC)
+
+	var m HourOfWeekMatrix
+	m.AddSpan(start, start.Add(90*time.Minute), time.UTC)
+
+	assert.Equal(t, int64(1800), m[6][23])
+	assert.Equal(t, int64(3600), m[0][0])
+
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+
+	var k HourOfWeekMatrix
+	k.AddSpan(start, start.Add(time.Hour), kolkata)
+
+	// 23:30 UTC is 05:00 Monday in Kolkata; the hour fills the 05 cell exactly.
+	assert.Equal(t, int64(3600), k[0][5])
+	assert.Zero(t, k[0][4]+k[0][6])
+}
+
+func TestActivityHeatmapQuery_Normalize(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+
+	q, loc, err := ActivityHeatmapQuery{}.Normalize(now)
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", q.Timezone)
+	assert.Equal(t, time.UTC, loc)
+	assert.Equal(t, now, q.To)
+	assert.Equal(t, now.Add(-DefaultActivityHeatmapRange), q.From)
+
+	q, loc, err = ActivityHeatmapQuery{Timezone: "Europe/Berlin", To: now.Add(time.Hour)}.Normalize(now)
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", loc.String())
+	assert.Equal(t, now, q.To, "to is capped at now")
+
+	for _, bad := range []ActivityHeatmapQuery{
+		{Timezone: "Mars/Olympus"},
+		{Timezone: "Local"},
+		{From: now, To: now.Add(-time.Hour)},
+		{From: now.Add(-MaxActivityHeatmapRange - time.Hour)},
+	} {
+		_, _, err := bad.Normalize(now)
+		require.ErrorIs(t, err, ErrInvalidActivityHeatmapQuery)
+	}
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// AudienceOverlapPeriod is a trailing window over which channel audiences are compared.
+type AudienceOverlapPeriod string
+
+const (
+	AudienceOverlapPeriodDay     AudienceOverlapPeriod = "24h"
+	AudienceOverlapPeriodWeek    AudienceOverlapPeriod = "7d"
+	AudienceOverlapPeriodMonth   AudienceOverlapPeriod = "30d"
+	AudienceOverlapPeriodQuarter AudienceOverlapPeriod = "90d"
+)
+
+// Duration is the window length, or an error for unknown periods.
+func (p AudienceOverlapPeriod) Duration() (time.Duration, error) {
+	switch p {
+	case AudienceOverlapPeriodDay:
+		return 24 * time.Hour, nil
+	case AudienceOverlapPeriodWeek:
+		return 7 * 24 * time.Hour, nil
+	case AudienceOverlapPeriodMonth:
+		return 30 * 24 * time.Hour, nil
+	case AudienceOverlapPeriodQuarter:
+		return 90 * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("%w: unknown period %q", ErrInvalidAudienceOverlapPeriod, p)
+	}
+}
+
+// AudienceMember is one user in a monitored channel's audience for a period: they chatted (Messages > 0), were
+// present in IRC, or both.
+type AudienceMember struct {
+	ChannelTwitchUserID int64
+	TwitchUserID        int64
+	Login               string
+	Messages            int64
+	Present             bool
+}
+
+// AudienceSharedUser is a user in the audience of both channels of a pair.
+type AudienceSharedUser struct {
+	TwitchUserID int64  `json:"twitch_user_id"`
+	Login        string `json:"login"`
+	MessagesA    int64  `json:"messages_a"`
+	MessagesB    int64  `json:"messages_b"`
+}
+
+// AudienceOverlapPair compares the audiences of two monitored channels (ChannelA < ChannelB). Jaccard is
+// shared / union; Overlap is shared / the smaller audience.
+type AudienceOverlapPair struct {
+	ChannelA    int64                `json:"channel_a"`
+	ChannelB    int64                `json:"channel_b"`
+	SharedUsers int                  `json:"shared_users"`
+	Jaccard     float64              `json:"jaccard"`
+	Overlap     float64              `json:"overlap"`
+	TopShared   []AudienceSharedUser `json:"top_shared"`
+}
+
+// AudienceCommunityChannel is another channel a monitored channel's audience also frequents: ActiveUsers were in
+// its audience (monitored channels only) and Followers follow it.
+type AudienceCommunityChannel struct {
+	ChannelTwitchUserID int64  `json:"channel_twitch_user_id"`
+	Login               string `json:"login"`
+	Monitored           bool   `json:"monitored"`
+	ActiveUsers         int    `json:"active_users"`
+	Followers           int    `json:"followers"`
+}
+
+// AudienceChannel is one monitored channel's audience size and the channels its community also frequents.
+type AudienceChannel struct {
+	ChannelTwitchUserID int64                      `json:"channel_twitch_user_id"`
+	Login               string                     `json:"login"`
+	Chatters            int                        `json:"chatters"`
+	PresentUsers        int                        `json:"present_users"`
+	Audience            int                        `json:"audience"`
+	Community           []AudienceCommunityChannel `json:"community"`
+}
+
+// AudienceOverlap is the cached audience comparison of all monitored channels over a period. Pairs without
+// shared users are omitted.
+type AudienceOverlap struct {
+	Period     AudienceOverlapPeriod `json:"period"`
+	From       time.Time             `json:"from"`
+	To         time.Time             `json:"to"`
+	ComputedAt time.Time             `json:"computed_at"`
+	Channels   []AudienceChannel     `json:"channels"`
+	Pairs      []AudienceOverlapPair `json:"pairs"`
+}
+
+// Matrix lays a pair metric out as a square matrix in Channels order, with 1 on the diagonal for channels that
+// have an audience and 0 for pairs without shared users.
+func (o AudienceOverlap) Matrix(metric func(AudienceOverlapPair) float64) [][]float64 {
+	index := make(map[int64]int, len(o.Channels))
+	out := make([][]float64, len(o.Channels))
+
+	for i, c := range o.Channels {
+		index[c.ChannelTwitchUserID] = i
+		out[i] = make([]float64, len(o.Channels))
+
+		if c.Audience > 0 {
+			out[i][i] = 1
+		}
+	}
+
+	for _, p := range o.Pairs {
+		a, okA := index[p.ChannelA]
+		b, okB := index[p.ChannelB]
+
+		if okA && okB {
+			v := metric(p)
+			out[a][b], out[b][a] = v, v
+		}
+	}
+
+	return out
+}
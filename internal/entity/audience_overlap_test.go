@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudienceOverlapPeriod_Duration(t *testing.T) {
+	d, err := AudienceOverlapPeriodWeek.Duration()
+	require.NoError(t, err)
+	assert.Equal(t, 7*24*time.Hour, d)
+
+	_, err = AudienceOverlapPeriod("1y").Duration()
+	require.ErrorIs(t, err, ErrInvalidAudienceOverlapPeriod)
+}
+
+func TestAudienceOverlap_Matrix(t *testing.T) {
+	o := AudienceOverlap{
+		Channels: []AudienceChannel{{ChannelTwitchUserID: 3, Audience: 4}, {ChannelTwitchUserID: 1, Audience: 2}, {ChannelTwitchUserID: 2}},
+		Pairs:    []AudienceOverlapPair{{ChannelA: 1, ChannelB: 3, Jaccard: 0.25}},
+	}
+
+	got := o.Matrix(func(p AudienceOverlapPair) float64 { return p.Jaccard })
+	assert.Equal(t, [][]float64{{1, 0.25, 0}, {0.25, 1, 0}, {0, 0, 0}}, got)
+}
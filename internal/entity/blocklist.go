@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Blocklist content formats (Blocklist.Format).
+const (
+	BlocklistFormatText = "text"
+	BlocklistFormatCSV  = "csv"
+	BlocklistFormatJSON = "json"
+)
+
+// MaxBlocklistEntries caps how many entries one blocklist import may hold.
+const MaxBlocklistEntries = 500_000
+
+// Blocklist is a named external list of known hate-raid or bot accounts. Lists with a SourceURL are pulled every
+// SyncIntervalMinutes; others are filled by uploads. The remaining fields record where the current entries came from.
+type Blocklist struct {
+	ID                  int64
+	Name                string
+	Format              string
+	SourceURL           *string
+	SyncIntervalMinutes int
+	Enabled             bool
+	// SourceFilename is the name of the last uploaded file (nil when the entries were pulled from SourceURL).
+	SourceFilename *string
+	ContentSHA256  *string
+	EntryCount     int
+	LastImportedAt *time.Time
+	LastSyncedAt   *time.Time
+	LastSyncError  *string
+	CreatedAt      time.Time
+}
+
+// BlocklistEntry is one listed account; at least one of Login (lowercase) and TwitchUserID (> 0) is set.
+type BlocklistEntry struct {
+	Login        string
+	TwitchUserID int64
+}
+
+// BlocklistHit is a blocklist that lists a user, with how it matched.
+type BlocklistHit struct {
+	BlocklistID int64
+	Name        string
+	MatchedBy   string // "id" or "login"
+}
+
+// BlocklistDigest is the hex SHA-256 of imported content, stored as provenance and used to skip unchanged pulls.
+func BlocklistDigest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,171 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ChannelAnalyticsBucket is the width of one channel analytics series point. Buckets are aligned in UTC.
+type ChannelAnalyticsBucket string
+
+const (
+	ChannelAnalyticsBucketMinute ChannelAnalyticsBucket = "minute"
+	ChannelAnalyticsBucketHour   ChannelAnalyticsBucket = "hour"
+	ChannelAnalyticsBucketDay    ChannelAnalyticsBucket = "day"
+)
+
+// MaxRange is the longest time range one query may span at this bucket width.
+func (b ChannelAnalyticsBucket) MaxRange() time.Duration {
+	switch b {
+	case ChannelAnalyticsBucketMinute:
+		return 24 * time.Hour
+	case ChannelAnalyticsBucketHour:
+		return 31 * 24 * time.Hour
+	case ChannelAnalyticsBucketDay:
+		return 366 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// DefaultRange is the range served when a query gives no start time.
+func (b ChannelAnalyticsBucket) DefaultRange() time.Duration {
+	switch b {
+	case ChannelAnalyticsBucketMinute:
+		return 3 * time.Hour
+	case ChannelAnalyticsBucketHour:
+		return 7 * 24 * time.Hour
+	case ChannelAnalyticsBucketDay:
+		return 90 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Truncate returns the start of the bucket holding t.
+func (b ChannelAnalyticsBucket) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+
+	switch b {
+	case ChannelAnalyticsBucketMinute:
+		return t.Truncate(time.Minute)
+	case ChannelAnalyticsBucketDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return t.Truncate(time.Hour)
+	}
+}
+
+// Next returns the start of the bucket following the one starting at start.
+func (b ChannelAnalyticsBucket) Next(start time.Time) time.Time {
+	switch b {
+	case ChannelAnalyticsBucketMinute:
+		return start.Add(time.Minute)
+	case ChannelAnalyticsBucketDay:
+		return start.AddDate(0, 0, 1)
+	default:
+		return start.Add(time.Hour)
+	}
+}
+
+// ChannelAnalyticsQuery selects a channel's analytics series; zero From/To are filled with defaults.
+type ChannelAnalyticsQuery struct {
+	Channel string
+	Bucket  ChannelAnalyticsBucket
+	From    time.Time
+	To      time.Time
+}
+
+// Normalize validates the query against now and fills defaults: bucket hour, To now and From To minus the
+// bucket's default range. From is aligned down to its bucket and Channel lowercased.
+func (q ChannelAnalyticsQuery) Normalize(now time.Time) (ChannelAnalyticsQuery, error) {
+	q.Channel = strings.ToLower(strings.TrimSpace(q.Channel))
+
+	if q.Bucket == "" {
+		q.Bucket = ChannelAnalyticsBucketHour
+	}
+
+	if q.Bucket.MaxRange() == 0 {
+		return q, fmt.Errorf("%w: unknown bucket %q", ErrInvalidChannelAnalyticsQuery, q.Bucket)
+	}
+
+	if q.To.IsZero() || q.To.After(now) {
+		q.To = now
+	}
+
+	if q.From.IsZero() {
+		q.From = q.To.Add(-q.Bucket.DefaultRange())
+	}
+
+	q.From = q.Bucket.Truncate(q.From)
+	q.To = q.To.UTC()
+
+	if !q.From.Before(q.To) {
+		return q, fmt.Errorf("%w: from must be before to", ErrInvalidChannelAnalyticsQuery)
+	}
+
+	if q.To.Sub(q.From) > q.Bucket.MaxRange() {
+		return q, fmt.Errorf("%w: %s buckets allow at most %s per query", ErrInvalidChannelAnalyticsQuery, q.Bucket, q.Bucket.MaxRange())
+	}
+
+	return q, nil
+}
+
+// ChannelActivityBucket is one bucket of a channel's activity. Unique chatters are distinct message senders,
+// new chatters those whose first message in the channel falls in the bucket, and present chatters those with IRC
+// presence (chat_online/chat_offline) overlapping it for PresenceSeconds in total.
+type ChannelActivityBucket struct {
+	ChannelTwitchUserID int64
+	Bucket              ChannelAnalyticsBucket
+	Start               time.Time
+	MessageCount        int64
+	UniqueChatters      int
+	NewChatters         int
+	PresentChatters     int
+	PresenceSeconds     int64
+}
+
+// AveragePresenceSeconds is the mean presence of the bucket's present chatters (0 without presence).
+func (b ChannelActivityBucket) AveragePresenceSeconds() float64 {
+	if b.PresentChatters == 0 {
+		return 0
+	}
+
+	return float64(b.PresenceSeconds) / float64(b.PresentChatters)
+}
+
+// StreamRetention counts a stream's chatters (message senders) and how many of them chatted again in the
+// channel's next stream.
+type StreamRetention struct {
+	StreamID          int64
+	StartedAt         time.Time
+	Title             *string
+	NextStreamID      *int64
+	Chatters          int
+	ReturningChatters int
+}
+
+// Rate is ReturningChatters / Chatters, or nil while there is no next stream or the stream had no chatters.
+func (r StreamRetention) Rate() *float64 {
+	if r.NextStreamID == nil || r.Chatters == 0 {
+		return nil
+	}
+
+	v := float64(r.ReturningChatters) / float64(r.Chatters)
+
+	return &v
+}
+
+// ChannelAnalytics is a dense activity series for one channel plus retention of the streams started in the range.
+// RolledUpUntil is how far the hour/day rollups reach; later buckets of those series read as empty.
+type ChannelAnalytics struct {
+	ChannelTwitchUserID int64
+	ChannelLogin        string
+	Bucket              ChannelAnalyticsBucket
+	From                time.Time
+	To                  time.Time
+	Series              []ChannelActivityBucket
+	Retention           []StreamRetention
+	RolledUpUntil       *time.Time
+}
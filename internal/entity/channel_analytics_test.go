@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelAnalyticsBucket_truncateNext(t *testing.T) {
+	at := time.Date(2026, 3, 9, 14, 37, 21, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 3, 9, 14, 37, 0, 0, time.UTC), ChannelAnalyticsBucketMinute.Truncate(at))
+	assert.Equal(t, time.Date(2026, 3, 9, 14, 0, 0, 0, time.UTC), ChannelAnalyticsBucketHour.Truncate(at))
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), ChannelAnalyticsBucketDay.Truncate(at))
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+		ChannelAnalyticsBucketDay.Next(ChannelAnalyticsBucketDay.Truncate(at)))
+
+	plus3 := time.FixedZone("plus3", 3*3600)
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+		ChannelAnalyticsBucketDay.Truncate(time.Date(2026, 3, 10, 1, 0, 0, 0, plus3)), "days are UTC")
+}
+
+func TestChannelAnalyticsQuery_Normalize(t *testing.T) {
+	now := time.Date(2026, 3, 9, 14, 37, 0, 0, time.UTC)
+
+	q, err := ChannelAnalyticsQuery{Channel: " Streamer "}.Normalize(now)
+	require.NoError(t, err)
+	assert.Equal(t, "streamer", q.Channel)
+	assert.Equal(t, ChannelAnalyticsBucketHour, q.Bucket)
+	assert.Equal(t, now, q.To)
+	assert.Equal(t, time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC), q.From)
+
+	q, err = ChannelAnalyticsQuery{Bucket: ChannelAnalyticsBucketMinute, To: now.Add(time.Hour)}.Normalize(now)
+	require.NoError(t, err)
+	assert.Equal(t, now, q.To, "future end is clamped")
+
+	_, err = ChannelAnalyticsQuery{Bucket: "week"}.Normalize(now)
+	require.ErrorIs(t, err, ErrInvalidChannelAnalyticsQuery)
+
+	_, err = ChannelAnalyticsQuery{From: now, To: now.Add(-time.Hour)}.Normalize(now)
+	require.ErrorIs(t, err, ErrInvalidChannelAnalyticsQuery)
+
+	_, err = ChannelAnalyticsQuery{Bucket: ChannelAnalyticsBucketMinute, From: now.Add(-48 * time.Hour)}.Normalize(now)
+	require.ErrorIs(t, err, ErrInvalidChannelAnalyticsQuery)
+}
+
+func TestStreamRetention_Rate(t *testing.T) {
+	next := int64(2)
+
+	assert.Nil(t, StreamRetention{Chatters: 10, ReturningChatters: 4}.Rate(), "no next stream yet")
+	assert.Nil(t, StreamRetention{NextStreamID: &next}.Rate(), "no chatters")
+
+	rate := StreamRetention{NextStreamID: &next, Chatters: 10, ReturningChatters: 4}.Rate()
+	require.NotNil(t, rate)
+	assert.InDelta(t, 0.4, *rate, 1e-9)
+}
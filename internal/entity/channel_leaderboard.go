@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxChannelLeaderboardRange is the longest range one leaderboard query may span.
+	MaxChannelLeaderboardRange = 366 * 24 * time.Hour
+	// DefaultChannelLeaderboardRange is the range served when a query gives no start time.
+	DefaultChannelLeaderboardRange = 30 * 24 * time.Hour
+	// DefaultChannelLeaderboardLimit is the page size when a query gives none.
+	DefaultChannelLeaderboardLimit = 50
+	// MaxChannelLeaderboardLimit caps one leaderboard page.
+	MaxChannelLeaderboardLimit = 500
+)
+
+// ChatterDayPresence is one chatter's IRC presence in a channel on one UTC day.
+type ChatterDayPresence struct {
+	ChannelTwitchUserID int64
+	ChatterTwitchUserID int64
+	Day                 time.Time
+	PresenceSeconds     int64
+}
+
+// ChannelLeaderboardQuery ranks chatters of one monitored channel (or of all of them when Channel is empty) over
+// whole UTC days. ExcludeBots nil follows the bot detection exclude_from_stats setting; ExcludeLinked drops the
+// monitor's own OAuth-linked accounts.
+type ChannelLeaderboardQuery struct {
+	Channel       string
+	From          time.Time
+	To            time.Time
+	Sort          StreamLeaderboardSort
+	Query         string
+	ExcludeBots   *bool
+	ExcludeMarked bool
+	ExcludeLinked bool
+	Limit         int
+	Offset        int
+}
+
+// Normalize validates the query against now and fills defaults: To now, From To minus 30 days, sort presence_desc
+// and limit 50. The range is widened to whole UTC days since it is served from daily rollups.
+func (q ChannelLeaderboardQuery) Normalize(now time.Time) (ChannelLeaderboardQuery, error) {
+	q.Channel = strings.ToLower(strings.TrimSpace(q.Channel))
+	q.Query = strings.TrimSpace(q.Query)
+
+	if q.Sort == "" {
+		q.Sort = StreamLeaderboardSortPresenceDesc
+	}
+
+	if !slices.Contains([]StreamLeaderboardSort{
+		StreamLeaderboardSortPresenceDesc, StreamLeaderboardSortPresenceAsc,
+		StreamLeaderboardSortMessagesDesc, StreamLeaderboardSortMessagesAsc,
+		StreamLeaderboardSortLoginAZ, StreamLeaderboardSortLoginZA,
+		StreamLeaderboardSortAccountNew, StreamLeaderboardSortAccountOld,
+	}, q.Sort) {
+		return q, fmt.Errorf("%w: unknown sort %q", ErrInvalidChannelLeaderboardQuery, q.Sort)
+	}
+
+	if q.To.IsZero() || q.To.After(now) {
+		q.To = now
+	}
+
+	if q.From.IsZero() {
+		q.From = q.To.Add(-DefaultChannelLeaderboardRange)
+	}
+
+	if !q.From.Before(q.To) {
+		return q, fmt.Errorf("%w: from must be before to", ErrInvalidChannelLeaderboardQuery)
+	}
+
+	if q.To.Sub(q.From) > MaxChannelLeaderboardRange {
+		return q, fmt.Errorf("%w: at most %s per query", ErrInvalidChannelLeaderboardQuery, MaxChannelLeaderboardRange)
+	}
+
+	q.From = ChannelAnalyticsBucketDay.Truncate(q.From)
+
+	if end := ChannelAnalyticsBucketDay.Truncate(q.To); end.Before(q.To) {
+		q.To = ChannelAnalyticsBucketDay.Next(end)
+	} else {
+		q.To = end
+	}
+
+	if q.Limit <= 0 {
+		q.Limit = DefaultChannelLeaderboardLimit
+	}
+
+	if q.Limit > MaxChannelLeaderboardLimit {
+		return q, fmt.Errorf("%w: limit is at most %d", ErrInvalidChannelLeaderboardQuery, MaxChannelLeaderboardLimit)
+	}
+
+	if q.Offset < 0 {
+		return q, fmt.Errorf("%w: offset must not be negative", ErrInvalidChannelLeaderboardQuery)
+	}
+
+	return q, nil
+}
+
+// ChannelLeaderboardRow is one chatter's totals over a leaderboard range. Channels counts the monitored channels
+// the chatter was active in.
+type ChannelLeaderboardRow struct {
+	Login            string
+	UserTwitchID     int64
+	PresenceSeconds  int64
+	MessageCount     int64
+	Channels         int
+	AccountCreatedAt *time.Time
+}
+
+// ChannelLeaderboard is one page of a channel (or cross-channel) leaderboard. Total counts all matching chatters;
+// RolledUpUntil is how far the daily rollups reach.
+type ChannelLeaderboard struct {
+	Channel       string
+	From          time.Time
+	To            time.Time
+	Total         int64
+	Rows          []ChannelLeaderboardRow
+	RolledUpUntil *time.Time
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelLeaderboardQuery_Normalize(t *testing.T) {
+	now := time.Date(2026, 3, 9, 15, 30, 0, 0, time.UTC)
+
+	q, err := ChannelLeaderboardQuery{Channel: " Chan "}.Normalize(now)
+	require.NoError(t, err)
+	assert.Equal(t, "chan", q.Channel)
+	assert.Equal(t, StreamLeaderboardSortPresenceDesc, q.Sort)
+	assert.Equal(t, time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC), q.From)
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), q.To, "the current day is included")
+	assert.Equal(t, DefaultChannelLeaderboardLimit, q.Limit)
+
+	q, err = ChannelLeaderboardQuery{
+		From: time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+		Sort: StreamLeaderboardSortAccountOld,
+	}.Normalize(now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC), q.From)
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), q.To)
+}
+
+func TestChannelLeaderboardQuery_Normalize_invalid(t *testing.T) {
+	now := time.Date(2026, 3, 9, 15, 30, 0, 0, time.UTC)
+
+	for _, q := range []ChannelLeaderboardQuery{
+		{Sort: "loudest"},
+		{From: now.Add(time.Hour)},
+		{From: now.AddDate(-2, 0, 0)},
+		{Limit: MaxChannelLeaderboardLimit + 1},
+		{Offset: -1},
+	} {
+		_, err := q.Normalize(now)
+		assert.ErrorIs(t, err, ErrInvalidChannelLeaderboardQuery)
+	}
+}
@@ -0,0 +1,82 @@
+package entity
+
+// Chat classification labels: toxicity and harassment are 0..1 scores, sentiment runs from -1 (negative) to 1.
+const (
+	ChatLabelToxicity   = "toxicity"
+	ChatLabelHarassment = "harassment"
+	ChatLabelSentiment  = "sentiment"
+)
+
+// ChatToxicThreshold is the toxicity score from which a classified message counts as toxic in aggregates.
+const ChatToxicThreshold = 0.5
+
+// ChatClassifierSettings controls the background AI chat classifier. Only messages from Channels (monitored channel
+// logins) are considered; SampleRate of them (0..1) are sent in batches of BatchSize until DailyTokenBudget tokens
+// are spent for the UTC day.
+type ChatClassifierSettings struct {
+	Enabled          bool
+	Channels         []string
+	SampleRate       float64
+	BatchSize        int
+	DailyTokenBudget int64
+}
+
+// ClassifiableChatMessage is a stored chat message picked for classification.
+type ClassifiableChatMessage struct {
+	ID       int64
+	Channel  string
+	Username string
+	Text     string
+}
+
+// ChatMessageLabels are the classifier scores of one chat message.
+type ChatMessageLabels struct {
+	MessageID  int64
+	Toxicity   float64
+	Harassment float64
+	Sentiment  float64
+}
+
+// Label returns the score of a ChatLabel* name; ok is false for unknown labels.
+func (l ChatMessageLabels) Label(name string) (float64, bool) {
+	switch name {
+	case ChatLabelToxicity:
+		return l.Toxicity, true
+	case ChatLabelHarassment:
+		return l.Harassment, true
+	case ChatLabelSentiment:
+		return l.Sentiment, true
+	default:
+		return 0, false
+	}
+}
+
+// ChatToxicity aggregates the labels of a stream's or a user's classified messages.
+type ChatToxicity struct {
+	ClassifiedMessages int64
+	// ToxicMessages counts classified messages with toxicity of at least ChatToxicThreshold.
+	ToxicMessages int64
+	AvgToxicity   float64
+	AvgHarassment float64
+	AvgSentiment  float64
+}
+
+// ChatClassifierSampled reports whether a message falls into a sample of rate (0..1). The choice is a hash of the
+// message id, so it is stable across passes and spread evenly over consecutive ids.
+func ChatClassifierSampled(messageID int64, rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+
+	if rate >= 1 {
+		return true
+	}
+
+	// splitmix64 finalizer
+	x := uint64(messageID) + 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return float64(x>>11)/(1<<53) < rate
+}
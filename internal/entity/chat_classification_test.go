@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatClassifierSampled(t *testing.T) {
+	assert.False(t, ChatClassifierSampled(1, 0))
+	assert.True(t, ChatClassifierSampled(1, 1))
+
+	var sampled int
+
+	for id := int64(1); id <= 10_000; id++ {
+		if ChatClassifierSampled(id, 0.2) {
+			sampled++
+		}
+
+		assert.Equal(t, ChatClassifierSampled(id, 0.2), ChatClassifierSampled(id, 0.2))
+	}
+
+	assert.InDelta(t, 2000, sampled, 200)
+}
+
+func TestChatMessageLabels_Label(t *testing.T) {
+	l := ChatMessageLabels{Toxicity: 0.8, Harassment: 0.1, Sentiment: -0.5}
+
+	v, ok := l.Label(ChatLabelSentiment)
+	assert.True(t, ok)
+	assert.InDelta(t, -0.5, v, 1e-9)
+
+	_, ok = l.Label("spam")
+	assert.False(t, ok)
+}
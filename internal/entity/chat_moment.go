@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// Chat moment kinds. A moment is an emote flood or a phrase cluster when one emote or one phrase makes up a large
+// share of its messages, otherwise a plain velocity spike.
+const (
+	ChatMomentKindVelocity   = "velocity"
+	ChatMomentKindEmoteFlood = "emote_flood"
+	ChatMomentKindPhrase     = "phrase"
+)
+
+// ChatMomentMessage is a representative message of a chat moment.
+type ChatMomentMessage struct {
+	Login     string    `json:"login"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ChatMoment is a burst of chat during a live stream. Label is the emote code or phrase of floods and clusters.
+// Intensity is the peak 30-second message rate over the channel's rolling baseline (0 while the baseline was still
+// warming up). OffsetSeconds is StartedAt relative to the stream start, i.e. the VOD timestamp.
+type ChatMoment struct {
+	ID             int64
+	StreamID       int64
+	Kind           string
+	Label          string
+	StartedAt      time.Time
+	EndedAt        time.Time
+	OffsetSeconds  int64
+	Messages       int64
+	Chatters       int64
+	Intensity      float64
+	SampleMessages []ChatMomentMessage
+}
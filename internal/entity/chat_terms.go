@@ -0,0 +1,64 @@
+package entity
+
+import "time"
+
+// ChatTerm is one distinct term of a message: a lowercased word n-gram (words joined by a space) or an emote code
+// kept as written.
+type ChatTerm struct {
+	Text  string
+	Emote bool
+}
+
+// TermMessage is a chat message as loaded for term extraction.
+type TermMessage struct {
+	ID                  int64
+	ChatterTwitchUserID *int64
+	CreatedAt           time.Time
+	Body                string
+	Emotes              []ChatEmote
+}
+
+// TermCount is how many messages (and distinct chatters) used a term.
+type TermCount struct {
+	Term     string
+	Emote    bool
+	Messages int
+	Chatters int
+}
+
+// TrendingTerm is a term of a stream (or a window of it) scored by TF-IDF: its share of the window's messages
+// times its IDF over the channel's baseline streams, BaselineStreams of which also used it.
+type TrendingTerm struct {
+	Term            string
+	Emote           bool
+	Words           int
+	Messages        int
+	Chatters        int
+	BaselineStreams int
+	Score           float64
+}
+
+// TrendingTerms is the top terms of a stream window against the channel baseline of BaselineStreams streams.
+type TrendingTerms struct {
+	StreamID        int64
+	From            time.Time
+	To              time.Time
+	Messages        int
+	BaselineStreams int
+	Terms           []TrendingTerm
+}
+
+// TermSeriesPoint counts the messages using a term in one bucket.
+type TermSeriesPoint struct {
+	Start    time.Time
+	Messages int
+}
+
+// TermSeries is how often a term was used over a stream, in buckets of BucketSeconds from the stream start.
+type TermSeries struct {
+	StreamID      int64
+	Term          string
+	BucketSeconds int
+	Messages      int
+	Points        []TermSeriesPoint
+}
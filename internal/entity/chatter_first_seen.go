@@ -0,0 +1,15 @@
+package entity
+
+// ChatterFirstSeen reports whether a chat message is the first Dredge stored from the chatter in its channel
+// (InChannel) and in any monitored channel (Global).
+type ChatterFirstSeen struct {
+	InChannel bool
+	Global    bool
+}
+
+// StreamChatterCounts splits a stream's chatters into those whose first message in the channel was during the
+// stream (new) and those who chatted there before it started (returning).
+type StreamChatterCounts struct {
+	NewChatters       int64
+	ReturningChatters int64
+}
@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Emote providers (ChatEmote.Provider, EmoteSet.Provider). Twitch emotes come from IRC tags; the others are
+// detected by code from locally configured emote sets.
+const (
+	EmoteProviderTwitch = "twitch"
+	EmoteProviderBTTV   = "bttv"
+	EmoteProviderFFZ    = "ffz"
+	EmoteProvider7TV    = "7tv"
+	EmoteProviderCustom = "custom"
+)
+
+// MaxEmoteSetEmotes caps how many emotes one configured set may hold.
+const MaxEmoteSetEmotes = 10_000
+
+// ChatEmote is one emote occurrence in a chat message. Start and End are inclusive rune offsets into the
+// message body (the convention of the Twitch emotes tag).
+type ChatEmote struct {
+	Provider string `json:"provider"`
+	EmoteID  string `json:"emote_id"`
+	Code     string `json:"code"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+}
+
+// EmoteSet is a locally configured set of third-party emote codes. An empty Channel applies the set to every
+// monitored channel; otherwise only to that channel's chat.
+type EmoteSet struct {
+	ID         int64
+	Name       string
+	Provider   string
+	Channel    string
+	EmoteCount int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// EmoteSetEmote is one code of an emote set; EmoteID defaults to the code when the provider id is unknown.
+type EmoteSetEmote struct {
+	Code    string
+	EmoteID string
+}
+
+// ScopedEmote is an emote set entry with its set's provider and channel scope, as loaded for detection.
+type ScopedEmote struct {
+	Provider string
+	Channel  string
+	Code     string
+	EmoteID  string
+}
+
+// NormalizeEmoteSet validates a set definition and its emotes: the name is trimmed, the channel lowercased
+// without '#', codes trimmed and de-duplicated (first wins) and missing ids filled with the code.
+func NormalizeEmoteSet(s EmoteSet, emotes []EmoteSetEmote) (EmoteSet, []EmoteSetEmote, error) {
+	s.Name = strings.TrimSpace(s.Name)
+	s.Provider = strings.ToLower(strings.TrimSpace(s.Provider))
+	s.Channel = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s.Channel)), "#")
+
+	if s.Name == "" || utf8.RuneCountInString(s.Name) > 64 {
+		return s, nil, fmt.Errorf("%w: name must be 1-64 characters", ErrInvalidEmoteSet)
+	}
+
+	switch s.Provider {
+	case EmoteProviderBTTV, EmoteProviderFFZ, EmoteProvider7TV, EmoteProviderCustom:
+	default:
+		return s, nil, fmt.Errorf("%w: unknown provider %q", ErrInvalidEmoteSet, s.Provider)
+	}
+
+	if len(emotes) > MaxEmoteSetEmotes {
+		return s, nil, fmt.Errorf("%w: at most %d emotes per set", ErrInvalidEmoteSet, MaxEmoteSetEmotes)
+	}
+
+	out := make([]EmoteSetEmote, 0, len(emotes))
+	seen := make(map[string]struct{}, len(emotes))
+
+	for _, e := range emotes {
+		e.Code = strings.TrimSpace(e.Code)
+		e.EmoteID = strings.TrimSpace(e.EmoteID)
+
+		if e.Code == "" || strings.IndexFunc(e.Code, unicode.IsSpace) >= 0 {
+			return s, nil, fmt.Errorf("%w: emote code %q must be non-empty without spaces", ErrInvalidEmoteSet, e.Code)
+		}
+
+		if _, dup := seen[e.Code]; dup {
+			continue
+		}
+
+		seen[e.Code] = struct{}{}
+
+		if e.EmoteID == "" {
+			e.EmoteID = e.Code
+		}
+
+		out = append(out, e)
+	}
+
+	return s, out, nil
+}
+
+// EmoteUsageFilter scopes an emote usage query; nil fields are not filtered on. Limit caps the emotes returned.
+type EmoteUsageFilter struct {
+	StreamID            *int64
+	ChannelTwitchUserID *int64
+	ChatterTwitchUserID *int64
+	From                *time.Time
+	To                  *time.Time
+	Limit               int
+}
+
+// EmoteUsage aggregates one emote's occurrences: Uses counts every occurrence, Messages the messages holding it
+// and Chatters their distinct senders.
+type EmoteUsage struct {
+	Provider string
+	EmoteID  string
+	Code     string
+	Uses     int64
+	Messages int64
+	Chatters int64
+}
+
+// EmoteBucketCount is how often an emote was used in one timeline bucket (bucket index from the origin).
+type EmoteBucketCount struct {
+	Bucket   int
+	Provider string
+	EmoteID  string
+	Uses     int64
+}
+
+// EmoteTimelineBucket holds per-emote uses in one bucket, aligned with StreamEmotes.Top.
+type EmoteTimelineBucket struct {
+	Start time.Time
+	Uses  []int64
+}
+
+// StreamEmotes is a stream's most used emotes and a dense timeline of their use over the stream.
+type StreamEmotes struct {
+	StreamID      int64
+	Top           []EmoteUsage
+	BucketSeconds int
+	Timeline      []EmoteTimelineBucket
+}
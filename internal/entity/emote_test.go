@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeEmoteSet(t *testing.T) {
+	s, emotes, err := NormalizeEmoteSet(
+		EmoteSet{Name: " Global BTTV ", Provider: "BTTV", Channel: " #Streamer "},
+		[]EmoteSetEmote{{Code: "catJAM", EmoteID: "5f1b0186cf6d2144653d2970"}, {Code: " OMEGALUL "}, {Code: "catJAM", EmoteID: "dup"}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Global BTTV", s.Name)
+	assert.Equal(t, EmoteProviderBTTV, s.Provider)
+	assert.Equal(t, "streamer", s.Channel)
+	assert.Equal(t, []EmoteSetEmote{
+		{Code: "catJAM", EmoteID: "5f1b0186cf6d2144653d2970"},
+		{Code: "OMEGALUL", EmoteID: "OMEGALUL"},
+	}, emotes)
+}
+
+func TestNormalizeEmoteSet_invalid(t *testing.T) {
+	_, _, err := NormalizeEmoteSet(EmoteSet{Name: "", Provider: EmoteProvider7TV}, nil)
+	require.ErrorIs(t, err, ErrInvalidEmoteSet)
+
+	_, _, err = NormalizeEmoteSet(EmoteSet{Name: "set", Provider: EmoteProviderTwitch}, nil)
+	require.ErrorIs(t, err, ErrInvalidEmoteSet)
+
+	_, _, err = NormalizeEmoteSet(EmoteSet{Name: "set", Provider: EmoteProviderFFZ}, []EmoteSetEmote{{Code: "two words"}})
+	require.ErrorIs(t, err, ErrInvalidEmoteSet)
+}
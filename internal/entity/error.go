@@ -10,14 +10,49 @@ var (
 	ErrRuleNotFound           = errors.New("rule not found")
 	ErrInvalidRule            = errors.New("invalid rule")
 	ErrNotificationNotFound   = errors.New("notification not found")
+	ErrInvalidNotification    = errors.New("invalid notification entry")
 	ErrTwitchAccountNotFound  = errors.New("twitch account not found")
 	ErrTwitchUserNotFound     = errors.New("twitch user not found")
 	ErrNoTwitchUserForChannel = errors.New("unknown twitch user for channel")
 	ErrStreamNotFound         = errors.New("stream not found")
+	// ErrNotificationDeliveryNotFound is returned for unknown notification outbox (delivery log) ids.
+	ErrNotificationDeliveryNotFound = errors.New("notification delivery not found")
+	ErrNotificationSnoozeNotFound   = errors.New("notification snooze not found")
 	// ErrNoLinkedTwitchAccount is returned when OAuth is required but no Twitch account is linked.
 	ErrNoLinkedTwitchAccount = errors.New("no linked twitch account")
 	// ErrInvalidTwitchUserMonitorSettings is returned when notify_off_stream_messages is enabled while irc_only_when_live is true.
 	ErrInvalidTwitchUserMonitorSettings = errors.New("notify_off_stream_messages is only allowed when irc_only_when_live is false")
 	ErrDiscoveryCandidateNotFound      = errors.New("discovery candidate not found")
 	ErrInvalidChannelDiscoverySettings = errors.New("invalid channel discovery settings")
+	// ErrInvalidSuspicionSettings wraps a description of the rejected threshold, weight or name pattern.
+	ErrInvalidSuspicionSettings = errors.New("invalid suspicion settings")
+	// ErrInvalidUserLink is returned for self-links and unknown link statuses.
+	ErrInvalidUserLink  = errors.New("invalid user link")
+	ErrUserLinkNotFound = errors.New("user link not found")
+	// ErrInvalidBotDetectionSettings wraps a description of the rejected threshold.
+	ErrInvalidBotDetectionSettings = errors.New("invalid bot detection settings")
+	// ErrInvalidLoginPattern wraps the reason a login pattern was rejected (empty, unknown kind, bad regex).
+	ErrInvalidLoginPattern  = errors.New("invalid login pattern")
+	ErrLoginPatternNotFound = errors.New("login pattern not found")
+	// ErrInvalidBlocklist wraps the reason a blocklist definition or its content was rejected.
+	ErrInvalidBlocklist  = errors.New("invalid blocklist")
+	ErrBlocklistNotFound = errors.New("blocklist not found")
+	// ErrInvalidChannelAnalyticsQuery wraps the reason an analytics bucket or time range was rejected.
+	ErrInvalidChannelAnalyticsQuery = errors.New("invalid channel analytics query")
+	ErrInvalidAudienceOverlapPeriod = errors.New("invalid audience overlap period")
+	// ErrInvalidEmoteSet wraps the reason an emote set or one of its codes was rejected.
+	ErrInvalidEmoteSet  = errors.New("invalid emote set")
+	ErrEmoteSetNotFound = errors.New("emote set not found")
+	// ErrInvalidEmoteUsageQuery wraps the reason an emote usage time range was rejected.
+	ErrInvalidEmoteUsageQuery = errors.New("invalid emote usage query")
+	// ErrInvalidTermQuery wraps the reason a searched term or a trending-terms window was rejected.
+	ErrInvalidTermQuery = errors.New("invalid term query")
+	// ErrInvalidChannelLeaderboardQuery wraps the reason a leaderboard range, sort or page was rejected.
+	ErrInvalidChannelLeaderboardQuery = errors.New("invalid channel leaderboard query")
+	// ErrInvalidActivityHeatmapQuery wraps the reason an activity heatmap time range or timezone was rejected.
+	ErrInvalidActivityHeatmapQuery = errors.New("invalid activity heatmap query")
+	// ErrInvalidChatClassifierSettings wraps a description of the rejected rate, budget or channel.
+	ErrInvalidChatClassifierSettings = errors.New("invalid chat classifier settings")
+	// ErrAINotConfigured is returned by AI-backed background jobs while the AI base URL or token is empty.
+	ErrAINotConfigured = errors.New("ai is not configured")
 )
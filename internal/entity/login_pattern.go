@@ -0,0 +1,176 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Login pattern kinds (LoginPattern.Kind).
+const (
+	LoginPatternRegex = "regex"
+	LoginPatternGlob  = "glob"
+)
+
+// LoginPattern is one entry of the login pattern blacklist. Glob patterns (* and ?) must match the whole login
+// skeleton, except that digits written in a glob are matched literally (see GlobSkeleton); regexes are
+// case-insensitive and match the raw login or its skeleton (see LoginSkeleton).
+type LoginPattern struct {
+	ID          int64
+	Kind        string
+	Pattern     string
+	Description string
+	CreatedAt   time.Time
+}
+
+// confusables folds look-alike characters onto the ASCII letter they imitate. Letters are never folded onto
+// other letters, so a regex written against a plain login keeps working on its skeleton.
+var confusables = map[rune]string{
+	'0': "o", '1': "i", '3': "e", '4': "a", '5': "s", '7': "t", '8': "b", '9': "g",
+	'@': "a", '$': "s", '!': "i", '|': "i",
+	// Cyrillic and Greek homoglyphs.
+	'а': "a", 'е': "e", 'о': "o", 'р': "p", 'с': "c", 'у': "y", 'х': "x", 'і': "i", 'ј': "j", 'ѕ': "s",
+	'ԁ': "d", 'ո': "n", 'α': "a", 'ο': "o", 'ρ': "p", 'ν': "v", 'ι': "i", 'κ': "k", 'τ': "t",
+}
+
+// multiConfusables are letter pairs that read as a single letter.
+var multiConfusables = strings.NewReplacer("rn", "m", "vv", "w")
+
+// LoginSkeleton normalizes a login for pattern matching: lowercase, look-alike characters folded to ASCII
+// letters ("sh0ut" -> "shout"), "rn"/"vv" read as "m"/"w", and underscores dropped.
+func LoginSkeleton(login string) string {
+	return loginSkeleton(login, false)
+}
+
+// GlobSkeleton is LoginSkeleton with digits kept, so "user1*" targets numeric suffixes instead of "useri...".
+// Glob patterns are normalized this way and logins are compared in both forms.
+func GlobSkeleton(login string) string {
+	return loginSkeleton(login, true)
+}
+
+func loginSkeleton(login string, keepDigits bool) string {
+	var b strings.Builder
+
+	for _, r := range strings.ToLower(login) {
+		// Fullwidth ASCII variants (e.g. from pasted display names).
+		if r >= '！' && r <= '～' {
+			r -= '！' - '!'
+		}
+
+		if r == '_' {
+			continue
+		}
+
+		if keepDigits && r >= '0' && r <= '9' {
+			b.WriteRune(r)
+			continue
+		}
+
+		if c, ok := confusables[r]; ok {
+			b.WriteString(c)
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	return multiConfusables.Replace(b.String())
+}
+
+// LoginMatcher reports whether a login matches one compiled LoginPattern.
+type LoginMatcher struct {
+	re   *regexp.Regexp
+	glob bool
+}
+
+// CompileLoginPattern validates and compiles a pattern of the given kind.
+func CompileLoginPattern(kind, pattern string) (LoginMatcher, error) {
+	if strings.TrimSpace(pattern) == "" {
+		return LoginMatcher{}, fmt.Errorf("%w: pattern is empty", ErrInvalidLoginPattern)
+	}
+
+	switch kind {
+	case LoginPatternRegex:
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return LoginMatcher{}, fmt.Errorf("%w: %w", ErrInvalidLoginPattern, err)
+		}
+
+		return LoginMatcher{re: re}, nil
+	case LoginPatternGlob:
+		var b strings.Builder
+
+		b.WriteString("^")
+
+		for _, r := range GlobSkeleton(pattern) {
+			switch r {
+			case '*':
+				b.WriteString(".*")
+			case '?':
+				b.WriteString(".")
+			default:
+				b.WriteString(regexp.QuoteMeta(string(r)))
+			}
+		}
+
+		b.WriteString("$")
+
+		return LoginMatcher{re: regexp.MustCompile(b.String()), glob: true}, nil
+	default:
+		return LoginMatcher{}, fmt.Errorf("%w: unknown kind %q", ErrInvalidLoginPattern, kind)
+	}
+}
+
+// Match reports whether login matches; globs look at the skeleton with and without digits folded.
+func (m LoginMatcher) Match(login string) bool {
+	if m.re == nil || login == "" {
+		return false
+	}
+
+	skeleton := LoginSkeleton(login)
+
+	if m.glob {
+		return m.re.MatchString(skeleton) || m.re.MatchString(GlobSkeleton(login))
+	}
+
+	return m.re.MatchString(login) || m.re.MatchString(skeleton)
+}
+
+// CompiledLoginPattern is a stored pattern with its matcher, compiled once when the list is loaded.
+type CompiledLoginPattern struct {
+	LoginPattern
+	Matcher LoginMatcher
+}
+
+// CompileLoginPatterns compiles a stored list. Patterns that fail to compile are left out and reported together
+// in err; the rest are still returned.
+func CompileLoginPatterns(patterns []LoginPattern) ([]CompiledLoginPattern, error) {
+	out := make([]CompiledLoginPattern, 0, len(patterns))
+
+	var errs []error
+
+	for _, p := range patterns {
+		m, err := CompileLoginPattern(p.Kind, p.Pattern)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("login pattern %d: %w", p.ID, err))
+			continue
+		}
+
+		out = append(out, CompiledLoginPattern{LoginPattern: p, Matcher: m})
+	}
+
+	return out, errors.Join(errs...)
+}
+
+// MatchLoginPatterns returns the first pattern login matches.
+func MatchLoginPatterns(patterns []CompiledLoginPattern, login string) (LoginPattern, bool) {
+	for _, p := range patterns {
+		if p.Matcher.Match(login) {
+			return p.LoginPattern, true
+		}
+	}
+
+	return LoginPattern{}, false
+}
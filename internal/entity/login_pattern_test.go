@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginSkeleton(t *testing.T) {
+	assert.Equal(t, "shroud", LoginSkeleton("Shr0ud"))
+	assert.Equal(t, "pokimane", LoginSkeleton("p0kiman3__"))
+	assert.Equal(t, "ninja", LoginSkeleton("n1nja"))
+	assert.Equal(t, "mod", LoginSkeleton("rnod"))
+	assert.Equal(t, "waifu", LoginSkeleton("vvaifu"))
+	assert.Equal(t, "xqc", LoginSkeleton("хqс"), "cyrillic homoglyphs")
+	assert.Equal(t, "abc", LoginSkeleton("ＡＢＣ"), "fullwidth")
+	assert.Equal(t, "user1", GlobSkeleton("usеr_1"), "digits kept")
+}
+
+func TestCompileLoginPattern(t *testing.T) {
+	glob, err := CompileLoginPattern(LoginPatternGlob, "*Shroud*")
+	require.NoError(t, err)
+	assert.True(t, glob.Match("real_shr0ud_tv"))
+	assert.True(t, glob.Match("shroud"))
+	assert.False(t, glob.Match("shrewd"))
+
+	suffix, err := CompileLoginPattern(LoginPatternGlob, "user1*")
+	require.NoError(t, err)
+	assert.True(t, suffix.Match("user123"))
+	assert.True(t, suffix.Match("usеr1_x"), "letters still fold, digits stay literal")
+	assert.False(t, suffix.Match("useri5"), "1 in a glob does not match i")
+	assert.False(t, suffix.Match("userl"))
+
+	single, err := CompileLoginPattern(LoginPatternGlob, "bot?")
+	require.NoError(t, err)
+	assert.True(t, single.Match("bot7"), "7 folds to t, still one character")
+	assert.False(t, single.Match("bot77"))
+
+	re, err := CompileLoginPattern(LoginPatternRegex, `^[a-z]+[0-9]{4,}$`)
+	require.NoError(t, err)
+	assert.True(t, re.Match("Spammer12345"), "raw login")
+
+	skel, err := CompileLoginPattern(LoginPatternRegex, `hate`)
+	require.NoError(t, err)
+	assert.True(t, skel.Match("i_h4te_you"), "skeleton")
+
+	_, err = CompileLoginPattern(LoginPatternRegex, `(`)
+	require.ErrorIs(t, err, ErrInvalidLoginPattern)
+
+	_, err = CompileLoginPattern("wildcard", `x`)
+	require.ErrorIs(t, err, ErrInvalidLoginPattern)
+
+	_, err = CompileLoginPattern(LoginPatternGlob, "  ")
+	require.ErrorIs(t, err, ErrInvalidLoginPattern)
+}
+
+func TestMatchLoginPatterns(t *testing.T) {
+	patterns := []LoginPattern{
+		{ID: 1, Kind: LoginPatternRegex, Pattern: "("},
+		{ID: 2, Kind: LoginPatternGlob, Pattern: "*xqc*"},
+		{ID: 3, Kind: LoginPatternGlob, Pattern: "*"},
+	}
+
+	compiled, err := CompileLoginPatterns(patterns)
+	require.ErrorIs(t, err, ErrInvalidLoginPattern)
+	require.Len(t, compiled, 2)
+
+	p, ok := MatchLoginPatterns(compiled, "xqc_fan")
+	require.True(t, ok)
+	assert.Equal(t, int64(2), p.ID)
+
+	_, ok = MatchLoginPatterns(compiled[:1], "viewer")
+	assert.False(t, ok)
+}
@@ -14,6 +14,9 @@ type ChatMessageListFilter struct {
 	Limit           int
 	CursorCreatedAt *time.Time
 	CursorID        *int64
+
+	// FirstMessageOnly keeps messages Twitch flagged as the chatter's first in that channel.
+	FirstMessageOnly bool
 }
 
 // TwitchUserBrowseFilter drives directory search (keyset on id DESC).
@@ -57,7 +60,12 @@ const (
 	SusTypeAutoAge       = "auto_age"
 	SusTypeAutoBlacklist = "auto_blacklist"
 	SusTypeAutoLowFollow = "auto_low_follows"
+	SusTypeAutoScore     = "auto_score"
 	SusTypeManual        = "manual"
+	// SusTypeAutoLoginPattern marks a user whose login matches the login pattern blacklist.
+	SusTypeAutoLoginPattern = "auto_login_pattern"
+	// SusTypeAutoBlocklist marks a user listed on an imported blocklist.
+	SusTypeAutoBlocklist = "auto_blocklist"
 )
 
 // TwitchUserPatch is a partial update for twitch_users (nil fields are left unchanged).
@@ -71,6 +79,11 @@ type TwitchUserPatch struct {
 	IrcOnlyWhenLive         *bool
 	NotifyOffStreamMessages *bool
 	NotifyStreamStart       *bool
+
+	// SusSource and SusEvidence are not columns: they describe a suspicion change for the audit log
+	// (SuspicionEvent). An empty SusSource is recorded as SuspicionSourceManual.
+	SusSource   string
+	SusEvidence map[string]any
 }
 
 // TwitchUser is a Twitch identity (e.g. a channel); monitored selects IRC join and keyword handling.
@@ -135,7 +148,17 @@ type FollowedChannelRow struct {
 	FollowedAt           *time.Time
 }
 
+// FollowsSyncState is when a user's outgoing follows were last synced from GQL and the total Twitch
+// reported. Both are nil for users whose follows were never synced.
+type FollowsSyncState struct {
+	TwitchUserID    int64
+	FollowsTotal    *int
+	FollowsSyncedAt *time.Time
+}
+
 // SuspicionSettings is the singleton row (id=1) driving automatic suspicion rules.
+// Every enabled signal adds up to its weight to a user's score; the user is auto-marked
+// suspicious when the score reaches ScoreThreshold.
 type SuspicionSettings struct {
 	AutoCheckAccountAge bool
 	AccountAgeSusDays   int
@@ -143,6 +166,218 @@ type SuspicionSettings struct {
 	AutoCheckLowFollows bool
 	LowFollowsThreshold int
 	MaxGQLFollowPages   int
+	ScoreThreshold      int
+	Weights             SuspicionWeights
+	// NamePatterns are case-insensitive regexes matched against the login.
+	NamePatterns []string
+	// MultiChannelThreshold is how many channels a user must be chatting in at once to count.
+	MultiChannelThreshold int
+}
+
+// SuspicionWeights is the maximum score each signal contributes; zero disables the signal.
+type SuspicionWeights struct {
+	AccountAge       int
+	BlacklistFollows int
+	LowFollows       int
+	NamePattern      int
+	FirstMessage     int
+	MultiChannel     int
+	Moderation       int
+}
+
+// Suspicion signal keys (SuspicionSignal.Key).
+const (
+	SuspicionSignalAccountAge       = "account_age"
+	SuspicionSignalBlacklistFollows = "blacklist_follows"
+	SuspicionSignalLowFollows       = "low_follows"
+	SuspicionSignalNamePattern      = "name_pattern"
+	SuspicionSignalFirstMessage     = "first_message"
+	SuspicionSignalMultiChannel     = "multi_channel"
+	SuspicionSignalModeration       = "moderation"
+)
+
+// SuspicionSignal is one signal that contributed to a suspicion score.
+type SuspicionSignal struct {
+	Key    string `json:"key"`
+	Score  int    `json:"score"`
+	Weight int    `json:"weight"`
+	Detail string `json:"detail"`
+}
+
+// SuspicionScore is the latest automatic evaluation of a user; Signals lists only signals that fired.
+type SuspicionScore struct {
+	TwitchUserID int64
+	Score        int
+	Threshold    int
+	Signals      []SuspicionSignal
+	ComputedAt   time.Time
+}
+
+// Suspicion event sources (SuspicionEvent.Source).
+const (
+	SuspicionSourceAuto     = "auto"
+	SuspicionSourceManual   = "manual"
+	SuspicionSourceTelegram = "telegram"
+	SuspicionSourceAI       = "ai"
+	// SuspicionSourceRule is a rule's mark_sus action.
+	SuspicionSourceRule = "rule"
+)
+
+// SuspicionEvent is one change of a user's is_sus / sus_type / sus_description with the evidence behind it.
+type SuspicionEvent struct {
+	ID                int64
+	TwitchUserID      int64
+	Username          string
+	Source            string
+	OldIsSus          bool
+	NewIsSus          bool
+	OldSusType        *string
+	NewSusType        *string
+	OldSusDescription *string
+	NewSusDescription *string
+	Evidence          map[string]any
+	CreatedAt         time.Time
+}
+
+// SuspicionEventListFilter selects suspicion events (newest first); zero values do not filter.
+type SuspicionEventListFilter struct {
+	TwitchUserID    *int64
+	Source          string
+	NewIsSus        *bool
+	Limit           int
+	CursorCreatedAt *time.Time
+	CursorID        *int64
+}
+
+// Reasons a bulk suspicion re-evaluation was started.
+const (
+	SuspicionReevalReasonSettings  = "suspicion_settings"
+	SuspicionReevalReasonBlacklist = "blacklist"
+	SuspicionReevalReasonManual    = "manual"
+	// SuspicionReevalReasonLoginPatterns follows a change to the login pattern blacklist.
+	SuspicionReevalReasonLoginPatterns = "login_patterns"
+	// SuspicionReevalReasonBlocklists follows an import or removal of an external blocklist.
+	SuspicionReevalReasonBlocklists = "blocklists"
+)
+
+// SuspicionReevaluation is the progress of the bulk job that re-scores every known chatter from cached
+// enrichment data. Queued means another run was requested while this one was in progress.
+type SuspicionReevaluation struct {
+	Running       bool
+	Queued        bool
+	Reason        string
+	RefetchBudget int
+	Total         int64
+	Processed     int
+	Evaluated     int
+	Refetched     int
+	Skipped       int
+	Failed        int
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+}
+
+// Alt-detection signal keys (AltSignal.Key).
+const (
+	AltSignalFollowOverlap   = "follow_overlap"
+	AltSignalLoginSimilarity = "login_similarity"
+	AltSignalAccountCreated  = "account_created"
+	AltSignalSharedPresence  = "shared_presence"
+	AltSignalStylometry      = "stylometry"
+	AltSignalTiming          = "timing"
+)
+
+// AltSignal is one similarity signal between two users; Score is at most Weight.
+type AltSignal struct {
+	Key    string `json:"key"`
+	Score  int    `json:"score"`
+	Weight int    `json:"weight"`
+	Detail string `json:"detail"`
+}
+
+// AltCandidate is a scored "possible alt" of TwitchUserID. Score is 0-100; Signals lists only signals that fired.
+type AltCandidate struct {
+	TwitchUserID      int64
+	CandidateUserID   int64
+	CandidateUsername string
+	Score             int
+	Signals           []AltSignal
+	ComputedAt        time.Time
+}
+
+// User link statuses (UserLink.Status).
+const (
+	UserLinkConfirmed = "confirmed"
+	UserLinkRejected  = "rejected"
+)
+
+// UserLink is a reviewed alt relation between two users, seen from TwitchUserID. Rejected links hide the pair
+// from possible alts.
+type UserLink struct {
+	TwitchUserID    int64
+	LinkedUserID    int64
+	LinkedUsername  string
+	Status          string
+	Score           *int
+	Note            string
+	DecidedByUserID *int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// BotDetectionSettings is the singleton row (id=1) for lurker / view-bot detection from IRC presence.
+// A user present in at least MinConcurrentChannels channels at once who sends at most MaxMessagesPerHour per
+// channel-hour of presence is a likely bot. ViewersPerChatter is the Helix viewers expected per human chatter
+// before a channel's viewer count counts as inflated. ExcludeFromStats hides likely bots from stream leaderboards,
+// channel chatter lists and chatter counts.
+type BotDetectionSettings struct {
+	Enabled               bool
+	MinConcurrentChannels int
+	MaxMessagesPerHour    float64
+	ViewersPerChatter     float64
+	ExcludeFromStats      bool
+}
+
+// BotPresence is one user's current IRC presence across monitored channels, as input to bot detection.
+type BotPresence struct {
+	TwitchUserID       int64
+	ConcurrentChannels int
+	// PresentSeconds sums presence over every channel the user is currently in.
+	PresentSeconds int64
+	// MessageCount counts messages since the user's earliest current presence.
+	MessageCount int64
+}
+
+// LikelyBot is a user flagged by the latest bot detection runs.
+type LikelyBot struct {
+	TwitchUserID       int64
+	Username           string
+	ConcurrentChannels int
+	PresentSeconds     int64
+	MessageCount       int64
+	FirstDetectedAt    time.Time
+	LastDetectedAt     time.Time
+}
+
+// ChannelBotCounts is a live monitored channel's Helix viewer count next to its IRC chatter presence.
+type ChannelBotCounts struct {
+	ChannelTwitchUserID int64
+	ViewerCount         int64
+	ChatterCount        int64
+	BotChatterCount     int64
+}
+
+// ChannelBotEstimate is the estimated share of a live channel's audience that is bots. ViewerInflated is set when
+// Helix viewers exceed what the human chatters present explain.
+type ChannelBotEstimate struct {
+	ChannelTwitchUserID int64
+	ChannelLogin        string
+	ViewerCount         int64
+	ChatterCount        int64
+	BotChatterCount     int64
+	BotShare            float64
+	ViewerInflated      bool
+	ComputedAt          time.Time
 }
 
 // IrcMonitorSettings is the singleton row (id=1) for the chat monitor IRC identity.
@@ -201,6 +436,11 @@ const (
 	UserActivityChatOnline  = "chat_online"
 	UserActivityChatOffline = "chat_offline"
 	UserActivityMessage     = "message"
+	// UserActivityBan and UserActivityTimeout record moderator CLEARCHAT actions against the chatter.
+	UserActivityBan     = "ban"
+	UserActivityTimeout = "timeout"
+	// UserActivityFirstSeenInChannel records the chatter's first message Dredge stored in the channel.
+	UserActivityFirstSeenInChannel = "first_seen_in_channel"
 )
 
 // UserActivityEvent is a row for the activity feed / timeline.
@@ -251,6 +491,81 @@ type NotificationEntry struct {
 	Settings  map[string]any
 	Enabled   bool
 	CreatedAt time.Time
+	NotificationRouting
+	NotificationPolicy
+}
+
+// NotificationRouting labels an entry and carries its default event/channel filters.
+// Empty EventTypes or Channels accept every event type or channel.
+type NotificationRouting struct {
+	Tags       []string
+	EventTypes []string
+	Channels   []string
+}
+
+// NotificationPolicy limits when and how often an entry is notified. Suppressed events are still
+// recorded in the delivery log.
+type NotificationPolicy struct {
+	// QuietHours suppresses deliveries during a daily local-time window; nil disables it.
+	QuietHours *NotificationQuietHours
+	// FloodThreshold is how many events per channel the entry receives within FloodWindow before the
+	// rest are collapsed into one summary; 0 disables flood control.
+	FloodThreshold int
+	FloodWindow    time.Duration
+}
+
+// NotificationQuietHours is a daily window in Timezone (IANA name); Start and End are "HH:MM" and the
+// window wraps past midnight when End is not after Start.
+type NotificationQuietHours struct {
+	Start    string
+	End      string
+	Timezone string
+}
+
+// Active reports whether t falls inside the window; an unparsable window is never active.
+func (q NotificationQuietHours) Active(t time.Time) bool {
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return false
+	}
+
+	start, err1 := time.Parse("15:04", q.Start)
+	end, err2 := time.Parse("15:04", q.End)
+
+	if err1 != nil || err2 != nil {
+		return false
+	}
+
+	local := t.In(loc)
+	now := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	if from < to {
+		return now >= from && now < to
+	}
+
+	return now >= from || now < to
+}
+
+// Notification event types accepted in NotificationRouting.EventTypes (same values as rules event_type).
+const (
+	NotifyEventChatMessage = "chat_message"
+	NotifyEventStreamStart = "stream_start"
+	NotifyEventStreamEnd   = "stream_end"
+	NotifyEventInterval    = "interval"
+)
+
+// NotificationRoute selects target entries for one notify action (rules action_settings).
+// A zero route delivers to every enabled entry whose default filters accept the event.
+type NotificationRoute struct {
+	EntryIDs []int64
+	Tags     []string
+}
+
+// IsZero reports whether the route names no explicit targets.
+func (r NotificationRoute) IsZero() bool {
+	return len(r.EntryIDs) == 0 && len(r.Tags) == 0
 }
 
 // NotificationListFilter controls notifications list pagination (newest first).
@@ -260,6 +575,120 @@ type NotificationListFilter struct {
 	CursorID        *int64
 }
 
+// NotificationSnooze mutes notifications until Until. A nil NotificationEntryID applies to every entry;
+// an empty Channel applies to every channel.
+type NotificationSnooze struct {
+	ID                  int64
+	NotificationEntryID *int64
+	Channel             string
+	Until               time.Time
+	CreatedAt           time.Time
+}
+
+// Matches reports whether the snooze covers an event for entryID in channel.
+func (s NotificationSnooze) Matches(entryID int64, channel string) bool {
+	if s.NotificationEntryID != nil && *s.NotificationEntryID != entryID {
+		return false
+	}
+
+	return s.Channel == "" || s.Channel == channel
+}
+
+// ChatterChannelPresence is one monitored channel a chatter is currently present in.
+type ChatterChannelPresence struct {
+	ChannelLogin string
+	PresentSince time.Time
+}
+
+// NotificationEvent is one outbound alert before provider-specific rendering.
+// Type is the payload type (keyword_match, rule_text, stream_start, stream_end); Text is the
+// expanded rule template and, when empty, providers fall back to their default line for Type.
+type NotificationEvent struct {
+	Type    string
+	Channel string
+	User    string
+	Message string
+	Title   string
+	Text    string
+}
+
+// Notification outbox statuses stored in notification_deliveries.status.
+const (
+	NotificationDeliveryPending   = "pending"
+	NotificationDeliverySending   = "sending"
+	NotificationDeliveryDelivered = "delivered"
+	NotificationDeliveryDead      = "dead"
+	// NotificationDeliverySuppressed rows were never sent (quiet hours, snooze, flood control or a disabled entry);
+	// LastError holds the reason.
+	NotificationDeliverySuppressed = "suppressed"
+)
+
+// Reasons stored in LastError of suppressed deliveries.
+const (
+	NotificationSuppressedQuietHours = "quiet hours"
+	NotificationSuppressedSnoozed    = "snoozed"
+	NotificationSuppressedFlood      = "flood control"
+	NotificationSuppressedDisabled   = "entry disabled"
+)
+
+// NotificationDelivery is one outbox row: an event queued for a single notification entry.
+type NotificationDelivery struct {
+	ID int64
+	// EventID is a UUID that stays the same across retries (webhook idempotency key).
+	EventID             string
+	NotificationEntryID int64
+	Event               NotificationEvent
+	Status              string
+	Attempts            int
+	NextAttemptAt       time.Time
+	LastStatusCode      *int
+	LastError           *string
+	DeliveredAt         *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	// AttemptLog is filled by delivery-log listings (newest first).
+	AttemptLog []NotificationDeliveryAttempt
+}
+
+// NotificationDeliveryAttempt records one provider call for the delivery log.
+type NotificationDeliveryAttempt struct {
+	ID              int64
+	DeliveryID      int64
+	AttemptedAt     time.Time
+	StatusCode      *int
+	ResponseSnippet string
+	Error           string
+	DurationMs      int64
+}
+
+// NotificationTestResult is the outcome of one synchronous test send; it is not stored in the outbox.
+type NotificationTestResult struct {
+	EventType string
+	// Text is the rendered alert line that was sent.
+	Text       string
+	StatusCode *int
+	// ResponseSnippet and Error have entry secrets (tokens, URL userinfo) redacted.
+	ResponseSnippet string
+	Error           string
+	Delivered       bool
+	DurationMs      int64
+}
+
+// NotificationDeliveryJob is a claimed outbox row together with its target entry.
+type NotificationDeliveryJob struct {
+	Delivery NotificationDelivery
+	Entry    NotificationEntry
+}
+
+// NotificationDeliveryListFilter paginates the delivery log (newest first).
+type NotificationDeliveryListFilter struct {
+	NotificationEntryID *int64
+	Status              string
+	Limit               int
+	CursorCreatedAt     *time.Time
+	CursorID            *int64
+}
+
 // RuleTriggerEvent is one executed rule action (notify or send_chat) for the bell feed.
 type RuleTriggerEvent struct {
 	ID           int64
@@ -293,7 +722,9 @@ type Stream struct {
 
 // StreamListFilter lists streams for the Streams UI (newest first).
 type StreamListFilter struct {
-	ChannelLogin    string
+	ChannelLogin string
+	// LiveOnly keeps streams that have not ended.
+	LiveOnly        bool
 	Limit           int
 	CursorStartedAt *time.Time
 	CursorID        *int64
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationQuietHours_Active(t *testing.T) {
+	overnight := NotificationQuietHours{Start: "22:00", End: "07:00", Timezone: "Europe/Berlin"}
+
+	// 21:30 UTC is 23:30 in Berlin (CEST).
+	assert.True(t, overnight.Active(time.Date(2026, 7, 1, 21, 30, 0, 0, time.UTC)))
+	assert.True(t, overnight.Active(time.Date(2026, 7, 1, 4, 59, 0, 0, time.UTC)))
+	assert.False(t, overnight.Active(time.Date(2026, 7, 1, 5, 0, 0, 0, time.UTC)))
+	assert.False(t, overnight.Active(time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)))
+
+	daytime := NotificationQuietHours{Start: "09:00", End: "17:00", Timezone: "UTC"}
+	assert.True(t, daytime.Active(time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)))
+	assert.False(t, daytime.Active(time.Date(2026, 7, 1, 17, 0, 0, 0, time.UTC)))
+
+	assert.False(t, NotificationQuietHours{Start: "22:00", End: "07:00", Timezone: "Nowhere/City"}.Active(time.Now()))
+}
+
+func TestNotificationSnooze_Matches(t *testing.T) {
+	entry := int64(3)
+
+	assert.True(t, NotificationSnooze{}.Matches(1, "chan"))
+	assert.True(t, NotificationSnooze{NotificationEntryID: &entry}.Matches(3, "chan"))
+	assert.False(t, NotificationSnooze{NotificationEntryID: &entry}.Matches(4, "chan"))
+	assert.True(t, NotificationSnooze{Channel: "chan"}.Matches(4, "chan"))
+	assert.False(t, NotificationSnooze{Channel: "chan"}.Matches(4, "other"))
+}
@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StreamRecapSettings controls what happens when a stream ends. AISummary adds an LLM-written summary when AI
+// settings are configured; Notify delivers the recap to notification entries as a stream_end event.
+type StreamRecapSettings struct {
+	AISummary bool
+	Notify    bool
+}
+
+// StreamRecapChatter is one of a stream's top chatters (by messages, from the stream leaderboard).
+type StreamRecapChatter struct {
+	UserTwitchID    int64  `json:"user_twitch_id"`
+	Login           string `json:"login"`
+	MessageCount    int64  `json:"message_count"`
+	PresenceSeconds int64  `json:"presence_seconds"`
+}
+
+// StreamRecapUser is a user flagged suspicious who chatted or was present during a stream.
+type StreamRecapUser struct {
+	UserTwitchID   int64   `json:"user_twitch_id"`
+	Login          string  `json:"login"`
+	SusType        *string `json:"sus_type,omitempty"`
+	SusDescription *string `json:"sus_description,omitempty"`
+}
+
+// StreamRecapRule counts how often a rule fired for the channel during a stream. RuleID is nil once the rule is
+// deleted.
+type StreamRecapRule struct {
+	RuleID   *int64 `json:"rule_id,omitempty"`
+	RuleName string `json:"rule_name"`
+	Triggers int64  `json:"triggers"`
+}
+
+// StreamRecapMoment is one of a stream's busiest chat windows, starting at Start.
+type StreamRecapMoment struct {
+	Start    time.Time `json:"start"`
+	Messages int64     `json:"messages"`
+	Chatters int64     `json:"chatters"`
+}
+
+// StreamRecap is what happened during a finished stream, computed once when the stream is closed. Viewer figures
+// are nil when Helix never reported a viewer count; NewChatters never chatted in the channel before the stream.
+type StreamRecap struct {
+	StreamID        int64                `json:"stream_id"`
+	DurationSeconds int64                `json:"duration_seconds"`
+	PeakViewers     *int64               `json:"peak_viewers,omitempty"`
+	AverageViewers  *int64               `json:"average_viewers,omitempty"`
+	Messages        int64                `json:"messages"`
+	UniqueChatters  int64                `json:"unique_chatters"`
+	NewChatters     int64                `json:"new_chatters"`
+	TopChatters     []StreamRecapChatter `json:"top_chatters"`
+	SuspiciousUsers []StreamRecapUser    `json:"suspicious_users"`
+	RuleTriggers    []StreamRecapRule    `json:"rule_triggers"`
+	BusiestMoments  []StreamRecapMoment  `json:"busiest_moments"`
+	Summary         string               `json:"summary,omitempty"`
+	CreatedAt       time.Time            `json:"created_at"`
+}
+
+// DigestText renders the recap as the plain-text body of a stream_end notification.
+func (r StreamRecap) DigestText(channel string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "[recap] #%s streamed %s", channel, formatRecapDuration(time.Duration(r.DurationSeconds)*time.Second))
+
+	if r.PeakViewers != nil {
+		fmt.Fprintf(&b, ", peak %d viewers", *r.PeakViewers)
+
+		if r.AverageViewers != nil {
+			fmt.Fprintf(&b, " (avg %d)", *r.AverageViewers)
+		}
+	}
+
+	fmt.Fprintf(&b, "\n%d messages from %d chatters (%d new)", r.Messages, r.UniqueChatters, r.NewChatters)
+
+	if len(r.TopChatters) > 0 {
+		parts := make([]string, 0, len(r.TopChatters))
+		for _, c := range r.TopChatters {
+			parts = append(parts, fmt.Sprintf("%s (%d)", c.Login, c.MessageCount))
+		}
+
+		b.WriteString("\nTop chatters: " + strings.Join(parts, ", "))
+	}
+
+	if len(r.SuspiciousUsers) > 0 {
+		parts := make([]string, 0, len(r.SuspiciousUsers))
+		for _, u := range r.SuspiciousUsers {
+			parts = append(parts, u.Login)
+		}
+
+		b.WriteString("\nSuspicious users: " + strings.Join(parts, ", "))
+	}
+
+	if len(r.RuleTriggers) > 0 {
+		parts := make([]string, 0, len(r.RuleTriggers))
+		for _, t := range r.RuleTriggers {
+			parts = append(parts, fmt.Sprintf("%s ×%d", t.RuleName, t.Triggers))
+		}
+
+		b.WriteString("\nRules triggered: " + strings.Join(parts, ", "))
+	}
+
+	if len(r.BusiestMoments) > 0 {
+		m := r.BusiestMoments[0]
+		fmt.Fprintf(&b, "\nBusiest moment: %s UTC (%d messages)", m.Start.UTC().Format("15:04"), m.Messages)
+	}
+
+	if s := strings.TrimSpace(r.Summary); s != "" {
+		b.WriteString("\n\n" + s)
+	}
+
+	return b.String()
+}
+
+func formatRecapDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Hour {
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+
+	return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamRecap_DigestText(t *testing.T) {
+	r := StreamRecap{
+		DurationSeconds: int64((3*time.Hour + 12*time.Minute).Seconds()),
+		PeakViewers:     ToPointer(int64(1200)),
+		AverageViewers:  ToPointer(int64(900)),
+		Messages:        5432,
+		UniqueChatters:  321,
+		NewChatters:     45,
+		TopChatters:     []StreamRecapChatter{{Login: "alice", MessageCount: 120}, {Login: "bob", MessageCount: 98}},
+		SuspiciousUsers: []StreamRecapUser{{Login: "spam_1234"}},
+		RuleTriggers:    []StreamRecapRule{{RuleName: "links", Triggers: 3}},
+		BusiestMoments:  []StreamRecapMoment{{Start: time.Date(2026, 3, 9, 19, 5, 0, 0, time.UTC), Messages: 340}},
+		Summary:         "  Boss fight, then a speedrun PB.  ",
+	}
+
+	assert.Equal(t, "[recap] #streamer streamed 3h12m, peak 1200 viewers (avg 900)\n"+
+		"5432 messages from 321 chatters (45 new)\n"+
+		"Top chatters: alice (120), bob (98)\n"+
+		"Suspicious users: spam_1234\n"+
+		"Rules triggered: links ×3\n"+
+		"Busiest moment: 19:05 UTC (340 messages)\n\n"+
+		"Boss fight, then a speedrun PB.", r.DigestText("streamer"))
+
+	assert.Equal(t, "[recap] #quiet streamed 42m\n0 messages from 0 chatters (0 new)",
+		StreamRecap{DurationSeconds: 42 * 60}.DigestText("quiet"))
+}
@@ -62,10 +62,36 @@ type Invoker interface {
 	//
 	// POST /api/v1/ai/conversations/{conversationId}/messages
 	CreateAiMessage(ctx context.Context, request *CreateAiMessageRequest, params CreateAiMessageParams) (CreateAiMessageRes, error)
+	// CreateBlocklist invokes createBlocklist operation.
+	//
+	// Creates an empty named blocklist. Lists with `source_url` are pulled every `sync_interval_minutes`
+	// (first pull within 5 minutes, or use `/settings/blocklists/sync`); others are filled by uploads.
+	// Chatters whose id or login is listed are marked suspicious with sus_type `auto_blocklist` during
+	// enrichment.
+	//
+	// POST /api/v1/settings/blocklists
+	CreateBlocklist(ctx context.Context, request *CreateBlocklistRequest) (CreateBlocklistRes, error)
+	// CreateLoginPattern invokes createLoginPattern operation.
+	//
+	// Adds a regex or glob to the login pattern blacklist (re-adding the same kind and pattern updates
+	// its
+	// description). Matching chatters are marked suspicious with sus_type `auto_login_pattern`; all
+	// known users
+	// are re-evaluated in the background.
+	//
+	// POST /api/v1/settings/login-patterns
+	CreateLoginPattern(ctx context.Context, request *CreateLoginPatternRequest) (CreateLoginPatternRes, error)
 	// CreateNotification invokes createNotification operation.
 	//
 	// POST /api/v1/settings/notifications
 	CreateNotification(ctx context.Context, request *CreateNotificationRequest) (*NotificationEntry, error)
+	// CreateNotificationSnooze invokes createNotificationSnooze operation.
+	//
+	// Temporarily mute notifications for one entry, one channel, or both. Muted events are recorded as
+	// suppressed.
+	//
+	// POST /api/v1/settings/notifications/snoozes
+	CreateNotificationSnooze(ctx context.Context, request *CreateNotificationSnoozeRequest) (CreateNotificationSnoozeRes, error)
 	// CreateRule invokes createRule operation.
 	//
 	// POST /api/v1/settings/rules
@@ -82,10 +108,28 @@ type Invoker interface {
 	//
 	// DELETE /api/v1/ai/conversations/{conversationId}
 	DeleteAiConversation(ctx context.Context, params DeleteAiConversationParams) (DeleteAiConversationRes, error)
+	// DeleteBlocklist invokes deleteBlocklist operation.
+	//
+	// POST /api/v1/settings/blocklists/delete
+	DeleteBlocklist(ctx context.Context, request *DeleteByIDRequest) (DeleteBlocklistRes, error)
+	// DeleteEmoteSet invokes deleteEmoteSet operation.
+	//
+	// POST /api/v1/settings/emote-sets/delete
+	DeleteEmoteSet(ctx context.Context, request *DeleteByIDRequest) (DeleteEmoteSetRes, error)
+	// DeleteLoginPattern invokes deleteLoginPattern operation.
+	//
+	// POST /api/v1/settings/login-patterns/delete
+	DeleteLoginPattern(ctx context.Context, request *DeleteByIDRequest) (DeleteLoginPatternRes, error)
 	// DeleteNotification invokes deleteNotification operation.
 	//
 	// POST /api/v1/settings/notifications/delete
 	DeleteNotification(ctx context.Context, request *DeleteByIDRequest) (DeleteNotificationRes, error)
+	// DeleteNotificationSnooze invokes deleteNotificationSnooze operation.
+	//
+	// End a snooze early.
+	//
+	// POST /api/v1/settings/notifications/snoozes/delete
+	DeleteNotificationSnooze(ctx context.Context, request *DeleteByIDRequest) (DeleteNotificationSnoozeRes, error)
 	// DeleteRule invokes deleteRule operation.
 	//
 	// POST /api/v1/settings/rules/delete
@@ -94,6 +138,10 @@ type Invoker interface {
 	//
 	// POST /api/v1/settings/twitch-accounts/delete
 	DeleteTwitchAccount(ctx context.Context, request *DeleteByIDRequest) (DeleteTwitchAccountRes, error)
+	// DeleteTwitchUserLink invokes deleteTwitchUserLink operation.
+	//
+	// POST /api/v1/twitch/users/links/delete
+	DeleteTwitchUserLink(ctx context.Context, request *DeleteTwitchUserLinkRequest) (DeleteTwitchUserLinkRes, error)
 	// DenyChannelDiscoveryCandidate invokes denyChannelDiscoveryCandidate operation.
 	//
 	// POST /api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny
@@ -102,14 +150,63 @@ type Invoker interface {
 	//
 	// GET /api/v1/ai/settings
 	GetAiSettings(ctx context.Context) (*AiSettings, error)
+	// GetAudienceOverlap invokes getAudienceOverlap operation.
+	//
+	// Audience overlap between monitored channels over a trailing period. A channel's audience is
+	// everyone who chatted or was present in IRC during the period (likely bots excluded when bot
+	// detection hides them from stats). Returns Jaccard (shared / union) and overlap (shared / smaller
+	// audience) matrices in channels order, the channel pairs with their top shared users, and per
+	// channel the channels its community also frequents (shared monitored audience and GQL follows).
+	// Results are cached per period for an hour.
+	//
+	// GET /api/v1/twitch/audience/overlap
+	GetAudienceOverlap(ctx context.Context, params GetAudienceOverlapParams) (*AudienceOverlap, error)
+	// GetBotDetectionSettings invokes getBotDetectionSettings operation.
+	//
+	// GET /api/v1/settings/bot-detection
+	GetBotDetectionSettings(ctx context.Context) (*BotDetectionSettings, error)
+	// GetChannelActivityHeatmap invokes getChannelActivityHeatmap operation.
+	//
+	// When a monitored channel's chat is busiest: chatters' summed IRC presence seconds and chat
+	// messages per local weekday and hour.
+	//
+	// GET /api/v1/twitch/channels/{login}/heatmap
+	GetChannelActivityHeatmap(ctx context.Context, params GetChannelActivityHeatmapParams) (GetChannelActivityHeatmapRes, error)
+	// GetChannelAnalytics invokes getChannelAnalytics operation.
+	//
+	// Time-bucketed chat activity (messages, unique/new chatters, average IRC presence) for a monitored
+	// channel and stream-over-stream chatter retention of the streams started in the range. Buckets are
+	// UTC-aligned; hour and day series come from rollups refreshed every few minutes (see
+	// rolled_up_until), minute series are computed live. Ranges are limited to 24h (minute), 31d (hour)
+	// and 366d (day).
+	//
+	// GET /api/v1/twitch/channels/{login}/analytics
+	GetChannelAnalytics(ctx context.Context, params GetChannelAnalyticsParams) (GetChannelAnalyticsRes, error)
 	// GetChannelDiscoverySettings invokes getChannelDiscoverySettings operation.
 	//
 	// GET /api/v1/settings/channel-discovery
 	GetChannelDiscoverySettings(ctx context.Context) (*ChannelDiscoverySettings, error)
+	// GetChannelEmoteUsage invokes getChannelEmoteUsage operation.
+	//
+	// Emotes used most in a monitored channel's chat.
+	//
+	// GET /api/v1/twitch/channels/{login}/emotes
+	GetChannelEmoteUsage(ctx context.Context, params GetChannelEmoteUsageParams) (GetChannelEmoteUsageRes, error)
+	// GetChannelLeaderboard invokes getChannelLeaderboard operation.
+	//
+	// Chatters of a monitored channel ranked by IRC presence, messages or account age over whole UTC
+	// days. Totals come from daily rollups refreshed every few minutes (see rolled_up_until).
+	//
+	// GET /api/v1/twitch/channels/{login}/leaderboard
+	GetChannelLeaderboard(ctx context.Context, params GetChannelLeaderboardParams) (GetChannelLeaderboardRes, error)
 	// GetChannelLive invokes getChannelLive operation.
 	//
 	// POST /api/v1/twitch/channels/live
 	GetChannelLive(ctx context.Context, request *GetChannelLiveRequest) (GetChannelLiveRes, error)
+	// GetChatClassifierSettings invokes getChatClassifierSettings operation.
+	//
+	// GET /api/v1/settings/chat-classifier
+	GetChatClassifierSettings(ctx context.Context) (*ChatClassifierSettings, error)
 	// GetIrcMonitorSettings invokes getIrcMonitorSettings operation.
 	//
 	// GET /api/v1/settings/irc-monitor-settings
@@ -118,14 +215,56 @@ type Invoker interface {
 	//
 	// GET /api/v1/twitch/irc-monitor/status
 	GetIrcMonitorStatus(ctx context.Context) (*IrcMonitorStatus, error)
+	// GetMonitoredLeaderboard invokes getMonitoredLeaderboard operation.
+	//
+	// Chatters ranked across all monitored channels over whole UTC days; presence and messages are
+	// summed over channels. Same filters and rollup lag as the per-channel leaderboard.
+	//
+	// GET /api/v1/twitch/leaderboard
+	GetMonitoredLeaderboard(ctx context.Context, params GetMonitoredLeaderboardParams) (GetMonitoredLeaderboardRes, error)
 	// GetRecordedStream invokes getRecordedStream operation.
 	//
 	// GET /api/v1/twitch/streams/{streamId}
 	GetRecordedStream(ctx context.Context, params GetRecordedStreamParams) (GetRecordedStreamRes, error)
+	// GetRecordedStreamEmotes invokes getRecordedStreamEmotes operation.
+	//
+	// The stream's most used emotes and a timeline of their uses from stream start, in buckets of whole
+	// minutes
+	// (about 60 buckets per stream). Timeline uses are aligned with `top`.
+	//
+	// GET /api/v1/twitch/streams/{streamId}/emotes
+	GetRecordedStreamEmotes(ctx context.Context, params GetRecordedStreamEmotesParams) (GetRecordedStreamEmotesRes, error)
 	// GetRecordedStreamLeaderboard invokes getRecordedStreamLeaderboard operation.
 	//
 	// GET /api/v1/twitch/streams/{streamId}/leaderboard
 	GetRecordedStreamLeaderboard(ctx context.Context, params GetRecordedStreamLeaderboardParams) (GetRecordedStreamLeaderboardRes, error)
+	// GetRecordedStreamTermSeries invokes getRecordedStreamTermSeries operation.
+	//
+	// How many messages used a term over the stream, in buckets of whole minutes from stream start
+	// (about 60
+	// buckets per stream). The term is normalized like trending terms; emote codes match
+	// case-insensitively.
+	//
+	// GET /api/v1/twitch/streams/{streamId}/terms/series
+	GetRecordedStreamTermSeries(ctx context.Context, params GetRecordedStreamTermSeriesParams) (GetRecordedStreamTermSeriesRes, error)
+	// GetRecordedStreamTerms invokes getRecordedStreamTerms operation.
+	//
+	// Trending chat terms of a stream (or a window of it): words, 2-3 word phrases and emotes ranked by
+	// TF-IDF,
+	// i.e. the share of the window's messages using the term weighted by how rare the term is in the
+	// channel's
+	// recent streams. Stopwords, mentions and links are ignored.
+	//
+	// GET /api/v1/twitch/streams/{streamId}/terms
+	GetRecordedStreamTerms(ctx context.Context, params GetRecordedStreamTermsParams) (GetRecordedStreamTermsRes, error)
+	// GetStreamRecapSettings invokes getStreamRecapSettings operation.
+	//
+	// GET /api/v1/settings/stream-recap
+	GetStreamRecapSettings(ctx context.Context) (*StreamRecapSettings, error)
+	// GetSuspicionReevaluation invokes getSuspicionReevaluation operation.
+	//
+	// GET /api/v1/settings/suspicion-settings/reevaluate
+	GetSuspicionReevaluation(ctx context.Context) (*SuspicionReevaluation, error)
 	// GetSuspicionSettings invokes getSuspicionSettings operation.
 	//
 	// GET /api/v1/settings/suspicion-settings
@@ -137,10 +276,23 @@ type Invoker interface {
 	//
 	// GET /api/v1/stats
 	GetSystemStats(ctx context.Context) (GetSystemStatsRes, error)
+	// GetTwitchUserActivityHeatmap invokes getTwitchUserActivityHeatmap operation.
+	//
+	// When the user is usually online and chatting: IRC presence seconds (summed over monitored
+	// channels) and chat messages per local weekday and hour.
+	//
+	// GET /api/v1/twitch/users/{twitch_user_id}/heatmap
+	GetTwitchUserActivityHeatmap(ctx context.Context, params GetTwitchUserActivityHeatmapParams) (GetTwitchUserActivityHeatmapRes, error)
 	// GetTwitchUserActivityTimeline invokes getTwitchUserActivityTimeline operation.
 	//
 	// POST /api/v1/twitch/users/activity/timeline
 	GetTwitchUserActivityTimeline(ctx context.Context, request *GetTwitchUserActivityTimelineRequest) (GetTwitchUserActivityTimelineRes, error)
+	// GetTwitchUserEmoteUsage invokes getTwitchUserEmoteUsage operation.
+	//
+	// Emotes the user used most in monitored channels' chat.
+	//
+	// GET /api/v1/twitch/users/{twitch_user_id}/emotes
+	GetTwitchUserEmoteUsage(ctx context.Context, params GetTwitchUserEmoteUsageParams) (GetTwitchUserEmoteUsageRes, error)
 	// GetTwitchUserProfile invokes getTwitchUserProfile operation.
 	//
 	// POST /api/v1/twitch/users/profile
@@ -157,10 +309,20 @@ type Invoker interface {
 	//
 	// GET /api/v1/ai/conversations/{conversationId}/messages
 	ListAiMessages(ctx context.Context, params ListAiMessagesParams) (ListAiMessagesRes, error)
+	// ListBlocklists invokes listBlocklists operation.
+	//
+	// GET /api/v1/settings/blocklists
+	ListBlocklists(ctx context.Context) ([]Blocklist, error)
 	// ListChannelBlacklist invokes listChannelBlacklist operation.
 	//
 	// GET /api/v1/settings/channel-blacklist
 	ListChannelBlacklist(ctx context.Context) ([]string, error)
+	// ListChannelBotEstimates invokes listChannelBotEstimates operation.
+	//
+	// Estimated bot share of each live monitored channel from the latest bot detection run.
+	//
+	// GET /api/v1/twitch/bots/channels
+	ListChannelBotEstimates(ctx context.Context) ([]ChannelBotEstimate, error)
 	// ListChannelChatters invokes listChannelChatters operation.
 	//
 	// POST /api/v1/twitch/channels/chatters
@@ -173,12 +335,41 @@ type Invoker interface {
 	//
 	// GET /api/v1/twitch/chat/history
 	ListChatHistory(ctx context.Context, params ListChatHistoryParams) (ListChatHistoryRes, error)
+	// ListEmoteSets invokes listEmoteSets operation.
+	//
+	// GET /api/v1/settings/emote-sets
+	ListEmoteSets(ctx context.Context) ([]EmoteSet, error)
 	// ListIrcMonitorJoinedHistory invokes listIrcMonitorJoinedHistory operation.
 	//
 	// Historical IRC joined channel counts (periodic samples).
 	//
 	// GET /api/v1/twitch/irc-monitor/joined-history
 	ListIrcMonitorJoinedHistory(ctx context.Context, params ListIrcMonitorJoinedHistoryParams) ([]IrcJoinedSample, error)
+	// ListLikelyBots invokes listLikelyBots operation.
+	//
+	// Accounts present in many monitored channels at once that (almost) never chat, as flagged by the
+	// periodic bot detection. Users stay listed for 7 days after they were last detected.
+	//
+	// GET /api/v1/twitch/bots
+	ListLikelyBots(ctx context.Context, params ListLikelyBotsParams) ([]LikelyBot, error)
+	// ListLoginPatterns invokes listLoginPatterns operation.
+	//
+	// GET /api/v1/settings/login-patterns
+	ListLoginPatterns(ctx context.Context) ([]LoginPattern, error)
+	// ListNotificationDeliveries invokes listNotificationDeliveries operation.
+	//
+	// Notification outbox delivery log (newest first) with per-attempt status codes, response snippets
+	// and errors.
+	// Cursor-based incremental loading like listNotifications.
+	//
+	// GET /api/v1/settings/notifications/deliveries
+	ListNotificationDeliveries(ctx context.Context, params ListNotificationDeliveriesParams) ([]NotificationDelivery, error)
+	// ListNotificationSnoozes invokes listNotificationSnoozes operation.
+	//
+	// Active snoozes, soonest expiry first.
+	//
+	// GET /api/v1/settings/notifications/snoozes
+	ListNotificationSnoozes(ctx context.Context) ([]NotificationSnooze, error)
 	// ListNotifications invokes listNotifications operation.
 	//
 	// List notification entries (newest first) with cursor-based incremental loading.
@@ -219,6 +410,12 @@ type Invoker interface {
 	//
 	// GET /api/v1/settings/rules
 	ListRules(ctx context.Context) ([]Rule, error)
+	// ListSuspicionEvents invokes listSuspicionEvents operation.
+	//
+	// Suspicion audit log (newest first) with filters and cursor-based incremental loading.
+	//
+	// GET /api/v1/twitch/suspicion-events
+	ListSuspicionEvents(ctx context.Context, params ListSuspicionEventsParams) ([]SuspicionEvent, error)
 	// ListTwitchAccounts invokes listTwitchAccounts operation.
 	//
 	// GET /api/v1/settings/twitch-accounts
@@ -255,6 +452,42 @@ type Invoker interface {
 	//
 	// PATCH /api/v1/ai/settings
 	PatchAiSettings(ctx context.Context, request *PatchAiSettingsRequest) (*AiSettings, error)
+	// PreviewRuleNotify invokes previewRuleNotify operation.
+	//
+	// Expands a notify action's `text` template (or the engine default when empty) for a sample event.
+	// Sample fields that are omitted use placeholder values.
+	//
+	// POST /api/v1/settings/rules/notify-preview
+	PreviewRuleNotify(ctx context.Context, request *PreviewRuleNotifyRequest) (PreviewRuleNotifyRes, error)
+	// ResendNotificationDelivery invokes resendNotificationDelivery operation.
+	//
+	// Requeue a delivery (typically dead) as pending with a fresh retry budget; earlier attempts stay in
+	// the log.
+	//
+	// POST /api/v1/settings/notifications/deliveries/resend
+	ResendNotificationDelivery(ctx context.Context, request *ResendNotificationDeliveryRequest) (ResendNotificationDeliveryRes, error)
+	// SaveEmoteSet invokes saveEmoteSet operation.
+	//
+	// Creates a third-party emote set or replaces the codes of the set with the same name. Words of
+	// incoming chat
+	// messages equal to a code (case-sensitive) are stored as emote occurrences; sets scoped to a
+	// channel win over
+	// global ones. The IRC monitor reloads sets every minute and stored messages are not re-scanned.
+	//
+	// POST /api/v1/settings/emote-sets
+	SaveEmoteSet(ctx context.Context, request *SaveEmoteSetRequest) (SaveEmoteSetRes, error)
+	// ScanLikelyBots invokes scanLikelyBots operation.
+	//
+	// Runs bot detection now instead of waiting for the next periodic run.
+	//
+	// POST /api/v1/twitch/bots/scan
+	ScanLikelyBots(ctx context.Context) error
+	// ScanTwitchUserAlts invokes scanTwitchUserAlts operation.
+	//
+	// Re-scores the user's possible alts now instead of waiting for the hourly alt-detection run.
+	//
+	// POST /api/v1/twitch/users/{twitch_user_id}/alts/scan
+	ScanTwitchUserAlts(ctx context.Context, params ScanTwitchUserAltsParams) (ScanTwitchUserAltsRes, error)
 	// SendMessage invokes sendMessage operation.
 	//
 	// POST /api/v1/twitch/send
@@ -263,6 +496,19 @@ type Invoker interface {
 	//
 	// POST /api/v1/settings/channel-blacklist
 	SetChannelBlacklist(ctx context.Context, request *ChannelBlacklistChange) (SetChannelBlacklistRes, error)
+	// SetTwitchUserLink invokes setTwitchUserLink operation.
+	//
+	// Confirms or rejects that two users are the same person.
+	//
+	// POST /api/v1/twitch/users/links
+	SetTwitchUserLink(ctx context.Context, request *SetTwitchUserLinkRequest) (SetTwitchUserLinkRes, error)
+	// StartSuspicionReevaluation invokes startSuspicionReevaluation operation.
+	//
+	// Re-scores every known user from cached enrichment data in the background. Progress is also pushed
+	// over /ws as suspicion_reevaluation messages. A request made while a run is in progress is queued.
+	//
+	// POST /api/v1/settings/suspicion-settings/reevaluate
+	StartSuspicionReevaluation(ctx context.Context, request OptStartSuspicionReevaluationRequest) (*SuspicionReevaluation, error)
 	// StartTwitchOAuth invokes startTwitchOAuth operation.
 	//
 	// Start Twitch authorization (browser) to link an account without pasting a refresh token.
@@ -273,14 +519,46 @@ type Invoker interface {
 	//
 	// POST /api/v1/ai/conversations/{conversationId}/stop
 	StopAiAgent(ctx context.Context, params StopAiAgentParams) (StopAiAgentRes, error)
+	// SyncBlocklist invokes syncBlocklist operation.
+	//
+	// Pulls a list's `source_url` now. Download and parse failures are reported in `last_sync_error` of
+	// the
+	// returned list; unchanged content keeps the current entries.
+	//
+	// POST /api/v1/settings/blocklists/sync
+	SyncBlocklist(ctx context.Context, request *SyncBlocklistRequest) (SyncBlocklistRes, error)
+	// TestLoginPattern invokes testLoginPattern operation.
+	//
+	// Checks a pattern against sample logins without saving it; shows how the pattern and each login are
+	// normalized before matching.
+	//
+	// POST /api/v1/settings/login-patterns/test
+	TestLoginPattern(ctx context.Context, request *TestLoginPatternRequest) (*TestLoginPatternResponse, error)
+	// TestNotification invokes testNotification operation.
+	//
+	// Renders a sample event of each type (or only `event_types`) and sends it through the entry's
+	// provider
+	// synchronously, bypassing the outbox, snoozes and the entry's policy. Works for disabled entries.
+	// Tokens and URL credentials from the entry settings are redacted from responses and errors.
+	//
+	// POST /api/v1/settings/notifications/{id}/test
+	TestNotification(ctx context.Context, request OptTestNotificationRequest, params TestNotificationParams) (TestNotificationRes, error)
 	// TestRuleRegex invokes testRuleRegex operation.
 	//
 	// POST /api/v1/settings/rules/test-regex
 	TestRuleRegex(ctx context.Context, request *TestRuleRegexRequest) (*TestRuleRegexResponse, error)
+	// UpdateBotDetectionSettings invokes updateBotDetectionSettings operation.
+	//
+	// PATCH /api/v1/settings/bot-detection
+	UpdateBotDetectionSettings(ctx context.Context, request *BotDetectionSettings) (UpdateBotDetectionSettingsRes, error)
 	// UpdateChannelDiscoverySettings invokes updateChannelDiscoverySettings operation.
 	//
 	// PATCH /api/v1/settings/channel-discovery
 	UpdateChannelDiscoverySettings(ctx context.Context, request *ChannelDiscoverySettings) (UpdateChannelDiscoverySettingsRes, error)
+	// UpdateChatClassifierSettings invokes updateChatClassifierSettings operation.
+	//
+	// PATCH /api/v1/settings/chat-classifier
+	UpdateChatClassifierSettings(ctx context.Context, request *ChatClassifierSettings) (UpdateChatClassifierSettingsRes, error)
 	// UpdateIrcMonitorSettings invokes updateIrcMonitorSettings operation.
 	//
 	// PATCH /api/v1/settings/irc-monitor-settings
@@ -293,10 +571,14 @@ type Invoker interface {
 	//
 	// POST /api/v1/settings/rules/update
 	UpdateRule(ctx context.Context, request *UpdateRulePostRequest) (UpdateRuleRes, error)
+	// UpdateStreamRecapSettings invokes updateStreamRecapSettings operation.
+	//
+	// PATCH /api/v1/settings/stream-recap
+	UpdateStreamRecapSettings(ctx context.Context, request *StreamRecapSettings) (*StreamRecapSettings, error)
 	// UpdateSuspicionSettings invokes updateSuspicionSettings operation.
 	//
 	// PATCH /api/v1/settings/suspicion-settings
-	UpdateSuspicionSettings(ctx context.Context, request *SuspicionSettings) (*SuspicionSettings, error)
+	UpdateSuspicionSettings(ctx context.Context, request *SuspicionSettings) (UpdateSuspicionSettingsRes, error)
 	// UpdateTwitchAccount invokes updateTwitchAccount operation.
 	//
 	// POST /api/v1/settings/twitch-accounts/update
@@ -305,6 +587,12 @@ type Invoker interface {
 	//
 	// POST /api/v1/settings/twitch-users/update
 	UpdateTwitchUser(ctx context.Context, request *UpdateTwitchUserPostRequest) (UpdateTwitchUserRes, error)
+	// UploadBlocklist invokes uploadBlocklist operation.
+	//
+	// Replaces a blocklist's entries with file content parsed in the list's format.
+	//
+	// POST /api/v1/settings/blocklists/upload
+	UploadBlocklist(ctx context.Context, request *UploadBlocklistRequest) (UploadBlocklistRes, error)
 }
 
 // Client implements OAS client.
@@ -1400,19 +1688,24 @@ func (c *Client) sendCreateAiMessage(ctx context.Context, request *CreateAiMessa
 	return result, nil
 }
 
-// CreateNotification invokes createNotification operation.
+// CreateBlocklist invokes createBlocklist operation.
 //
-// POST /api/v1/settings/notifications
-func (c *Client) CreateNotification(ctx context.Context, request *CreateNotificationRequest) (*NotificationEntry, error) {
-	res, err := c.sendCreateNotification(ctx, request)
+// Creates an empty named blocklist. Lists with `source_url` are pulled every `sync_interval_minutes`
+// (first pull within 5 minutes, or use `/settings/blocklists/sync`); others are filled by uploads.
+// Chatters whose id or login is listed are marked suspicious with sus_type `auto_blocklist` during
+// enrichment.
+//
+// POST /api/v1/settings/blocklists
+func (c *Client) CreateBlocklist(ctx context.Context, request *CreateBlocklistRequest) (CreateBlocklistRes, error) {
+	res, err := c.sendCreateBlocklist(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateNotification(ctx context.Context, request *CreateNotificationRequest) (res *NotificationEntry, err error) {
+func (c *Client) sendCreateBlocklist(ctx context.Context, request *CreateBlocklistRequest) (res CreateBlocklistRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createNotification"),
+		otelogen.OperationID("createBlocklist"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/notifications"),
+		semconv.URLTemplateKey.String("/api/v1/settings/blocklists"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1428,7 +1721,7 @@ func (c *Client) sendCreateNotification(ctx context.Context, request *CreateNoti
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateNotificationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateBlocklistOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1446,7 +1739,7 @@ func (c *Client) sendCreateNotification(ctx context.Context, request *CreateNoti
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/notifications"
+	pathParts[0] = "/api/v1/settings/blocklists"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1454,7 +1747,7 @@ func (c *Client) sendCreateNotification(ctx context.Context, request *CreateNoti
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateNotificationRequest(request, r); err != nil {
+	if err := encodeCreateBlocklistRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1463,7 +1756,7 @@ func (c *Client) sendCreateNotification(ctx context.Context, request *CreateNoti
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateNotificationOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateBlocklistOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1500,7 +1793,7 @@ func (c *Client) sendCreateNotification(ctx context.Context, request *CreateNoti
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateNotificationResponse(resp)
+	result, err := decodeCreateBlocklistResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1508,19 +1801,25 @@ func (c *Client) sendCreateNotification(ctx context.Context, request *CreateNoti
 	return result, nil
 }
 
-// CreateRule invokes createRule operation.
+// CreateLoginPattern invokes createLoginPattern operation.
 //
-// POST /api/v1/settings/rules
-func (c *Client) CreateRule(ctx context.Context, request *CreateRuleRequest) (*Rule, error) {
-	res, err := c.sendCreateRule(ctx, request)
+// Adds a regex or glob to the login pattern blacklist (re-adding the same kind and pattern updates
+// its
+// description). Matching chatters are marked suspicious with sus_type `auto_login_pattern`; all
+// known users
+// are re-evaluated in the background.
+//
+// POST /api/v1/settings/login-patterns
+func (c *Client) CreateLoginPattern(ctx context.Context, request *CreateLoginPatternRequest) (CreateLoginPatternRes, error) {
+	res, err := c.sendCreateLoginPattern(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateRule(ctx context.Context, request *CreateRuleRequest) (res *Rule, err error) {
+func (c *Client) sendCreateLoginPattern(ctx context.Context, request *CreateLoginPatternRequest) (res CreateLoginPatternRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createRule"),
+		otelogen.OperationID("createLoginPattern"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/rules"),
+		semconv.URLTemplateKey.String("/api/v1/settings/login-patterns"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1536,7 +1835,7 @@ func (c *Client) sendCreateRule(ctx context.Context, request *CreateRuleRequest)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateRuleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateLoginPatternOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1554,7 +1853,7 @@ func (c *Client) sendCreateRule(ctx context.Context, request *CreateRuleRequest)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/rules"
+	pathParts[0] = "/api/v1/settings/login-patterns"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1562,7 +1861,7 @@ func (c *Client) sendCreateRule(ctx context.Context, request *CreateRuleRequest)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateRuleRequest(request, r); err != nil {
+	if err := encodeCreateLoginPatternRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1571,7 +1870,7 @@ func (c *Client) sendCreateRule(ctx context.Context, request *CreateRuleRequest)
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateRuleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateLoginPatternOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1608,7 +1907,7 @@ func (c *Client) sendCreateRule(ctx context.Context, request *CreateRuleRequest)
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateRuleResponse(resp)
+	result, err := decodeCreateLoginPatternResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1616,19 +1915,19 @@ func (c *Client) sendCreateRule(ctx context.Context, request *CreateRuleRequest)
 	return result, nil
 }
 
-// CreateTwitchAccount invokes createTwitchAccount operation.
+// CreateNotification invokes createNotification operation.
 //
-// POST /api/v1/settings/twitch-accounts
-func (c *Client) CreateTwitchAccount(ctx context.Context, request *CreateTwitchAccountRequest) (*TwitchAccount, error) {
-	res, err := c.sendCreateTwitchAccount(ctx, request)
+// POST /api/v1/settings/notifications
+func (c *Client) CreateNotification(ctx context.Context, request *CreateNotificationRequest) (*NotificationEntry, error) {
+	res, err := c.sendCreateNotification(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateTwitchAccount(ctx context.Context, request *CreateTwitchAccountRequest) (res *TwitchAccount, err error) {
+func (c *Client) sendCreateNotification(ctx context.Context, request *CreateNotificationRequest) (res *NotificationEntry, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createTwitchAccount"),
+		otelogen.OperationID("createNotification"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1644,7 +1943,7 @@ func (c *Client) sendCreateTwitchAccount(ctx context.Context, request *CreateTwi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateTwitchAccountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1662,7 +1961,7 @@ func (c *Client) sendCreateTwitchAccount(ctx context.Context, request *CreateTwi
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/twitch-accounts"
+	pathParts[0] = "/api/v1/settings/notifications"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1670,7 +1969,7 @@ func (c *Client) sendCreateTwitchAccount(ctx context.Context, request *CreateTwi
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateTwitchAccountRequest(request, r); err != nil {
+	if err := encodeCreateNotificationRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1679,7 +1978,7 @@ func (c *Client) sendCreateTwitchAccount(ctx context.Context, request *CreateTwi
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateTwitchAccountOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateNotificationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1716,7 +2015,7 @@ func (c *Client) sendCreateTwitchAccount(ctx context.Context, request *CreateTwi
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateTwitchAccountResponse(resp)
+	result, err := decodeCreateNotificationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1724,19 +2023,22 @@ func (c *Client) sendCreateTwitchAccount(ctx context.Context, request *CreateTwi
 	return result, nil
 }
 
-// CreateTwitchUser invokes createTwitchUser operation.
+// CreateNotificationSnooze invokes createNotificationSnooze operation.
 //
-// POST /api/v1/settings/twitch-users
-func (c *Client) CreateTwitchUser(ctx context.Context, request *CreateTwitchUserRequest) (CreateTwitchUserRes, error) {
-	res, err := c.sendCreateTwitchUser(ctx, request)
+// Temporarily mute notifications for one entry, one channel, or both. Muted events are recorded as
+// suppressed.
+//
+// POST /api/v1/settings/notifications/snoozes
+func (c *Client) CreateNotificationSnooze(ctx context.Context, request *CreateNotificationSnoozeRequest) (CreateNotificationSnoozeRes, error) {
+	res, err := c.sendCreateNotificationSnooze(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateTwitchUser(ctx context.Context, request *CreateTwitchUserRequest) (res CreateTwitchUserRes, err error) {
+func (c *Client) sendCreateNotificationSnooze(ctx context.Context, request *CreateNotificationSnoozeRequest) (res CreateNotificationSnoozeRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createTwitchUser"),
+		otelogen.OperationID("createNotificationSnooze"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/twitch-users"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications/snoozes"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1752,7 +2054,7 @@ func (c *Client) sendCreateTwitchUser(ctx context.Context, request *CreateTwitch
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateTwitchUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateNotificationSnoozeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1770,7 +2072,7 @@ func (c *Client) sendCreateTwitchUser(ctx context.Context, request *CreateTwitch
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/twitch-users"
+	pathParts[0] = "/api/v1/settings/notifications/snoozes"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1778,7 +2080,7 @@ func (c *Client) sendCreateTwitchUser(ctx context.Context, request *CreateTwitch
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateTwitchUserRequest(request, r); err != nil {
+	if err := encodeCreateNotificationSnoozeRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1787,7 +2089,7 @@ func (c *Client) sendCreateTwitchUser(ctx context.Context, request *CreateTwitch
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateTwitchUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateNotificationSnoozeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1824,7 +2126,7 @@ func (c *Client) sendCreateTwitchUser(ctx context.Context, request *CreateTwitch
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateTwitchUserResponse(resp)
+	result, err := decodeCreateNotificationSnoozeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1832,19 +2134,19 @@ func (c *Client) sendCreateTwitchUser(ctx context.Context, request *CreateTwitch
 	return result, nil
 }
 
-// DeleteAiConversation invokes deleteAiConversation operation.
+// CreateRule invokes createRule operation.
 //
-// DELETE /api/v1/ai/conversations/{conversationId}
-func (c *Client) DeleteAiConversation(ctx context.Context, params DeleteAiConversationParams) (DeleteAiConversationRes, error) {
-	res, err := c.sendDeleteAiConversation(ctx, params)
+// POST /api/v1/settings/rules
+func (c *Client) CreateRule(ctx context.Context, request *CreateRuleRequest) (*Rule, error) {
+	res, err := c.sendCreateRule(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteAiConversation(ctx context.Context, params DeleteAiConversationParams) (res DeleteAiConversationRes, err error) {
+func (c *Client) sendCreateRule(ctx context.Context, request *CreateRuleRequest) (res *Rule, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteAiConversation"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/api/v1/ai/conversations/{conversationId}"),
+		otelogen.OperationID("createRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/rules"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1860,7 +2162,7 @@ func (c *Client) sendDeleteAiConversation(ctx context.Context, params DeleteAiCo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteAiConversationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1877,40 +2179,25 @@ func (c *Client) sendDeleteAiConversation(ctx context.Context, params DeleteAiCo
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/api/v1/ai/conversations/"
-	{
-		// Encode "conversationId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "conversationId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.Int64ToString(params.ConversationId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/rules"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateRuleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteAiConversationOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1947,7 +2234,7 @@ func (c *Client) sendDeleteAiConversation(ctx context.Context, params DeleteAiCo
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteAiConversationResponse(resp)
+	result, err := decodeCreateRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1955,19 +2242,19 @@ func (c *Client) sendDeleteAiConversation(ctx context.Context, params DeleteAiCo
 	return result, nil
 }
 
-// DeleteNotification invokes deleteNotification operation.
+// CreateTwitchAccount invokes createTwitchAccount operation.
 //
-// POST /api/v1/settings/notifications/delete
-func (c *Client) DeleteNotification(ctx context.Context, request *DeleteByIDRequest) (DeleteNotificationRes, error) {
-	res, err := c.sendDeleteNotification(ctx, request)
+// POST /api/v1/settings/twitch-accounts
+func (c *Client) CreateTwitchAccount(ctx context.Context, request *CreateTwitchAccountRequest) (*TwitchAccount, error) {
+	res, err := c.sendCreateTwitchAccount(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteNotification(ctx context.Context, request *DeleteByIDRequest) (res DeleteNotificationRes, err error) {
+func (c *Client) sendCreateTwitchAccount(ctx context.Context, request *CreateTwitchAccountRequest) (res *TwitchAccount, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteNotification"),
+		otelogen.OperationID("createTwitchAccount"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/notifications/delete"),
+		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1983,7 +2270,7 @@ func (c *Client) sendDeleteNotification(ctx context.Context, request *DeleteByID
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteNotificationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateTwitchAccountOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2001,7 +2288,7 @@ func (c *Client) sendDeleteNotification(ctx context.Context, request *DeleteByID
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/notifications/delete"
+	pathParts[0] = "/api/v1/settings/twitch-accounts"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2009,7 +2296,7 @@ func (c *Client) sendDeleteNotification(ctx context.Context, request *DeleteByID
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDeleteNotificationRequest(request, r); err != nil {
+	if err := encodeCreateTwitchAccountRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -2018,7 +2305,7 @@ func (c *Client) sendDeleteNotification(ctx context.Context, request *DeleteByID
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteNotificationOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateTwitchAccountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2055,7 +2342,7 @@ func (c *Client) sendDeleteNotification(ctx context.Context, request *DeleteByID
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteNotificationResponse(resp)
+	result, err := decodeCreateTwitchAccountResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2063,19 +2350,19 @@ func (c *Client) sendDeleteNotification(ctx context.Context, request *DeleteByID
 	return result, nil
 }
 
-// DeleteRule invokes deleteRule operation.
+// CreateTwitchUser invokes createTwitchUser operation.
 //
-// POST /api/v1/settings/rules/delete
-func (c *Client) DeleteRule(ctx context.Context, request *DeleteByIDRequest) (DeleteRuleRes, error) {
-	res, err := c.sendDeleteRule(ctx, request)
+// POST /api/v1/settings/twitch-users
+func (c *Client) CreateTwitchUser(ctx context.Context, request *CreateTwitchUserRequest) (CreateTwitchUserRes, error) {
+	res, err := c.sendCreateTwitchUser(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteRule(ctx context.Context, request *DeleteByIDRequest) (res DeleteRuleRes, err error) {
+func (c *Client) sendCreateTwitchUser(ctx context.Context, request *CreateTwitchUserRequest) (res CreateTwitchUserRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteRule"),
+		otelogen.OperationID("createTwitchUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/rules/delete"),
+		semconv.URLTemplateKey.String("/api/v1/settings/twitch-users"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2091,7 +2378,7 @@ func (c *Client) sendDeleteRule(ctx context.Context, request *DeleteByIDRequest)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteRuleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateTwitchUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2109,7 +2396,7 @@ func (c *Client) sendDeleteRule(ctx context.Context, request *DeleteByIDRequest)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/rules/delete"
+	pathParts[0] = "/api/v1/settings/twitch-users"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2117,7 +2404,7 @@ func (c *Client) sendDeleteRule(ctx context.Context, request *DeleteByIDRequest)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDeleteRuleRequest(request, r); err != nil {
+	if err := encodeCreateTwitchUserRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -2126,7 +2413,7 @@ func (c *Client) sendDeleteRule(ctx context.Context, request *DeleteByIDRequest)
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteRuleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateTwitchUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2163,7 +2450,7 @@ func (c *Client) sendDeleteRule(ctx context.Context, request *DeleteByIDRequest)
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteRuleResponse(resp)
+	result, err := decodeCreateTwitchUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2171,19 +2458,19 @@ func (c *Client) sendDeleteRule(ctx context.Context, request *DeleteByIDRequest)
 	return result, nil
 }
 
-// DeleteTwitchAccount invokes deleteTwitchAccount operation.
+// DeleteAiConversation invokes deleteAiConversation operation.
 //
-// POST /api/v1/settings/twitch-accounts/delete
-func (c *Client) DeleteTwitchAccount(ctx context.Context, request *DeleteByIDRequest) (DeleteTwitchAccountRes, error) {
-	res, err := c.sendDeleteTwitchAccount(ctx, request)
+// DELETE /api/v1/ai/conversations/{conversationId}
+func (c *Client) DeleteAiConversation(ctx context.Context, params DeleteAiConversationParams) (DeleteAiConversationRes, error) {
+	res, err := c.sendDeleteAiConversation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteTwitchAccount(ctx context.Context, request *DeleteByIDRequest) (res DeleteTwitchAccountRes, err error) {
+func (c *Client) sendDeleteAiConversation(ctx context.Context, params DeleteAiConversationParams) (res DeleteAiConversationRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteTwitchAccount"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts/delete"),
+		otelogen.OperationID("deleteAiConversation"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/v1/ai/conversations/{conversationId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2199,7 +2486,7 @@ func (c *Client) sendDeleteTwitchAccount(ctx context.Context, request *DeleteByI
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteTwitchAccountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteAiConversationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2216,25 +2503,40 @@ func (c *Client) sendDeleteTwitchAccount(ctx context.Context, request *DeleteByI
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/twitch-accounts/delete"
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/ai/conversations/"
+	{
+		// Encode "conversationId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "conversationId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.ConversationId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDeleteTwitchAccountRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteTwitchAccountOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteAiConversationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2271,7 +2573,7 @@ func (c *Client) sendDeleteTwitchAccount(ctx context.Context, request *DeleteByI
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteTwitchAccountResponse(resp)
+	result, err := decodeDeleteAiConversationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2279,19 +2581,19 @@ func (c *Client) sendDeleteTwitchAccount(ctx context.Context, request *DeleteByI
 	return result, nil
 }
 
-// DenyChannelDiscoveryCandidate invokes denyChannelDiscoveryCandidate operation.
+// DeleteBlocklist invokes deleteBlocklist operation.
 //
-// POST /api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny
-func (c *Client) DenyChannelDiscoveryCandidate(ctx context.Context, params DenyChannelDiscoveryCandidateParams) (DenyChannelDiscoveryCandidateRes, error) {
-	res, err := c.sendDenyChannelDiscoveryCandidate(ctx, params)
+// POST /api/v1/settings/blocklists/delete
+func (c *Client) DeleteBlocklist(ctx context.Context, request *DeleteByIDRequest) (DeleteBlocklistRes, error) {
+	res, err := c.sendDeleteBlocklist(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDenyChannelDiscoveryCandidate(ctx context.Context, params DenyChannelDiscoveryCandidateParams) (res DenyChannelDiscoveryCandidateRes, err error) {
+func (c *Client) sendDeleteBlocklist(ctx context.Context, request *DeleteByIDRequest) (res DeleteBlocklistRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("denyChannelDiscoveryCandidate"),
+		otelogen.OperationID("deleteBlocklist"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny"),
+		semconv.URLTemplateKey.String("/api/v1/settings/blocklists/delete"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2307,7 +2609,7 @@ func (c *Client) sendDenyChannelDiscoveryCandidate(ctx context.Context, params D
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DenyChannelDiscoveryCandidateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteBlocklistOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2324,27 +2626,8 @@ func (c *Client) sendDenyChannelDiscoveryCandidate(ctx context.Context, params D
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/settings/channel-discovery/candidates/"
-	{
-		// Encode "twitch_user_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "twitch_user_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.Int64ToString(params.TwitchUserID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/deny"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/blocklists/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2352,13 +2635,16 @@ func (c *Client) sendDenyChannelDiscoveryCandidate(ctx context.Context, params D
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteBlocklistRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DenyChannelDiscoveryCandidateOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteBlocklistOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2395,7 +2681,7 @@ func (c *Client) sendDenyChannelDiscoveryCandidate(ctx context.Context, params D
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDenyChannelDiscoveryCandidateResponse(resp)
+	result, err := decodeDeleteBlocklistResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2403,19 +2689,19 @@ func (c *Client) sendDenyChannelDiscoveryCandidate(ctx context.Context, params D
 	return result, nil
 }
 
-// GetAiSettings invokes getAiSettings operation.
+// DeleteEmoteSet invokes deleteEmoteSet operation.
 //
-// GET /api/v1/ai/settings
-func (c *Client) GetAiSettings(ctx context.Context) (*AiSettings, error) {
-	res, err := c.sendGetAiSettings(ctx)
+// POST /api/v1/settings/emote-sets/delete
+func (c *Client) DeleteEmoteSet(ctx context.Context, request *DeleteByIDRequest) (DeleteEmoteSetRes, error) {
+	res, err := c.sendDeleteEmoteSet(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetAiSettings(ctx context.Context) (res *AiSettings, err error) {
+func (c *Client) sendDeleteEmoteSet(ctx context.Context, request *DeleteByIDRequest) (res DeleteEmoteSetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAiSettings"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/ai/settings"),
+		otelogen.OperationID("deleteEmoteSet"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/emote-sets/delete"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2431,7 +2717,7 @@ func (c *Client) sendGetAiSettings(ctx context.Context) (res *AiSettings, err er
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetAiSettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteEmoteSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2449,21 +2735,24 @@ func (c *Client) sendGetAiSettings(ctx context.Context) (res *AiSettings, err er
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/ai/settings"
+	pathParts[0] = "/api/v1/settings/emote-sets/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteEmoteSetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetAiSettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteEmoteSetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2500,7 +2789,7 @@ func (c *Client) sendGetAiSettings(ctx context.Context) (res *AiSettings, err er
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetAiSettingsResponse(resp)
+	result, err := decodeDeleteEmoteSetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2508,19 +2797,19 @@ func (c *Client) sendGetAiSettings(ctx context.Context) (res *AiSettings, err er
 	return result, nil
 }
 
-// GetChannelDiscoverySettings invokes getChannelDiscoverySettings operation.
+// DeleteLoginPattern invokes deleteLoginPattern operation.
 //
-// GET /api/v1/settings/channel-discovery
-func (c *Client) GetChannelDiscoverySettings(ctx context.Context) (*ChannelDiscoverySettings, error) {
-	res, err := c.sendGetChannelDiscoverySettings(ctx)
+// POST /api/v1/settings/login-patterns/delete
+func (c *Client) DeleteLoginPattern(ctx context.Context, request *DeleteByIDRequest) (DeleteLoginPatternRes, error) {
+	res, err := c.sendDeleteLoginPattern(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetChannelDiscoverySettings(ctx context.Context) (res *ChannelDiscoverySettings, err error) {
+func (c *Client) sendDeleteLoginPattern(ctx context.Context, request *DeleteByIDRequest) (res DeleteLoginPatternRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getChannelDiscoverySettings"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/channel-discovery"),
+		otelogen.OperationID("deleteLoginPattern"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/login-patterns/delete"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2536,7 +2825,7 @@ func (c *Client) sendGetChannelDiscoverySettings(ctx context.Context) (res *Chan
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetChannelDiscoverySettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteLoginPatternOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2554,21 +2843,24 @@ func (c *Client) sendGetChannelDiscoverySettings(ctx context.Context) (res *Chan
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/channel-discovery"
+	pathParts[0] = "/api/v1/settings/login-patterns/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteLoginPatternRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetChannelDiscoverySettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteLoginPatternOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2605,7 +2897,7 @@ func (c *Client) sendGetChannelDiscoverySettings(ctx context.Context) (res *Chan
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetChannelDiscoverySettingsResponse(resp)
+	result, err := decodeDeleteLoginPatternResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2613,19 +2905,19 @@ func (c *Client) sendGetChannelDiscoverySettings(ctx context.Context) (res *Chan
 	return result, nil
 }
 
-// GetChannelLive invokes getChannelLive operation.
+// DeleteNotification invokes deleteNotification operation.
 //
-// POST /api/v1/twitch/channels/live
-func (c *Client) GetChannelLive(ctx context.Context, request *GetChannelLiveRequest) (GetChannelLiveRes, error) {
-	res, err := c.sendGetChannelLive(ctx, request)
+// POST /api/v1/settings/notifications/delete
+func (c *Client) DeleteNotification(ctx context.Context, request *DeleteByIDRequest) (DeleteNotificationRes, error) {
+	res, err := c.sendDeleteNotification(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetChannelLive(ctx context.Context, request *GetChannelLiveRequest) (res GetChannelLiveRes, err error) {
+func (c *Client) sendDeleteNotification(ctx context.Context, request *DeleteByIDRequest) (res DeleteNotificationRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getChannelLive"),
+		otelogen.OperationID("deleteNotification"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/channels/live"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications/delete"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2641,7 +2933,7 @@ func (c *Client) sendGetChannelLive(ctx context.Context, request *GetChannelLive
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetChannelLiveOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2659,7 +2951,7 @@ func (c *Client) sendGetChannelLive(ctx context.Context, request *GetChannelLive
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/channels/live"
+	pathParts[0] = "/api/v1/settings/notifications/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2667,7 +2959,7 @@ func (c *Client) sendGetChannelLive(ctx context.Context, request *GetChannelLive
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetChannelLiveRequest(request, r); err != nil {
+	if err := encodeDeleteNotificationRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -2676,7 +2968,7 @@ func (c *Client) sendGetChannelLive(ctx context.Context, request *GetChannelLive
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetChannelLiveOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteNotificationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2713,7 +3005,7 @@ func (c *Client) sendGetChannelLive(ctx context.Context, request *GetChannelLive
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetChannelLiveResponse(resp)
+	result, err := decodeDeleteNotificationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2721,19 +3013,21 @@ func (c *Client) sendGetChannelLive(ctx context.Context, request *GetChannelLive
 	return result, nil
 }
 
-// GetIrcMonitorSettings invokes getIrcMonitorSettings operation.
+// DeleteNotificationSnooze invokes deleteNotificationSnooze operation.
 //
-// GET /api/v1/settings/irc-monitor-settings
-func (c *Client) GetIrcMonitorSettings(ctx context.Context) (*IrcMonitorSettings, error) {
-	res, err := c.sendGetIrcMonitorSettings(ctx)
+// End a snooze early.
+//
+// POST /api/v1/settings/notifications/snoozes/delete
+func (c *Client) DeleteNotificationSnooze(ctx context.Context, request *DeleteByIDRequest) (DeleteNotificationSnoozeRes, error) {
+	res, err := c.sendDeleteNotificationSnooze(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetIrcMonitorSettings(ctx context.Context) (res *IrcMonitorSettings, err error) {
+func (c *Client) sendDeleteNotificationSnooze(ctx context.Context, request *DeleteByIDRequest) (res DeleteNotificationSnoozeRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIrcMonitorSettings"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/irc-monitor-settings"),
+		otelogen.OperationID("deleteNotificationSnooze"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications/snoozes/delete"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2749,7 +3043,7 @@ func (c *Client) sendGetIrcMonitorSettings(ctx context.Context) (res *IrcMonitor
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetIrcMonitorSettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteNotificationSnoozeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2767,21 +3061,24 @@ func (c *Client) sendGetIrcMonitorSettings(ctx context.Context) (res *IrcMonitor
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/irc-monitor-settings"
+	pathParts[0] = "/api/v1/settings/notifications/snoozes/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteNotificationSnoozeRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetIrcMonitorSettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteNotificationSnoozeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2818,7 +3115,7 @@ func (c *Client) sendGetIrcMonitorSettings(ctx context.Context) (res *IrcMonitor
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetIrcMonitorSettingsResponse(resp)
+	result, err := decodeDeleteNotificationSnoozeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2826,19 +3123,19 @@ func (c *Client) sendGetIrcMonitorSettings(ctx context.Context) (res *IrcMonitor
 	return result, nil
 }
 
-// GetIrcMonitorStatus invokes getIrcMonitorStatus operation.
+// DeleteRule invokes deleteRule operation.
 //
-// GET /api/v1/twitch/irc-monitor/status
-func (c *Client) GetIrcMonitorStatus(ctx context.Context) (*IrcMonitorStatus, error) {
-	res, err := c.sendGetIrcMonitorStatus(ctx)
+// POST /api/v1/settings/rules/delete
+func (c *Client) DeleteRule(ctx context.Context, request *DeleteByIDRequest) (DeleteRuleRes, error) {
+	res, err := c.sendDeleteRule(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetIrcMonitorStatus(ctx context.Context) (res *IrcMonitorStatus, err error) {
+func (c *Client) sendDeleteRule(ctx context.Context, request *DeleteByIDRequest) (res DeleteRuleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIrcMonitorStatus"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/irc-monitor/status"),
+		otelogen.OperationID("deleteRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/rules/delete"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2854,7 +3151,7 @@ func (c *Client) sendGetIrcMonitorStatus(ctx context.Context) (res *IrcMonitorSt
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetIrcMonitorStatusOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2872,21 +3169,24 @@ func (c *Client) sendGetIrcMonitorStatus(ctx context.Context) (res *IrcMonitorSt
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/irc-monitor/status"
+	pathParts[0] = "/api/v1/settings/rules/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteRuleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetIrcMonitorStatusOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2923,7 +3223,7 @@ func (c *Client) sendGetIrcMonitorStatus(ctx context.Context) (res *IrcMonitorSt
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetIrcMonitorStatusResponse(resp)
+	result, err := decodeDeleteRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2931,19 +3231,19 @@ func (c *Client) sendGetIrcMonitorStatus(ctx context.Context) (res *IrcMonitorSt
 	return result, nil
 }
 
-// GetRecordedStream invokes getRecordedStream operation.
+// DeleteTwitchAccount invokes deleteTwitchAccount operation.
 //
-// GET /api/v1/twitch/streams/{streamId}
-func (c *Client) GetRecordedStream(ctx context.Context, params GetRecordedStreamParams) (GetRecordedStreamRes, error) {
-	res, err := c.sendGetRecordedStream(ctx, params)
+// POST /api/v1/settings/twitch-accounts/delete
+func (c *Client) DeleteTwitchAccount(ctx context.Context, request *DeleteByIDRequest) (DeleteTwitchAccountRes, error) {
+	res, err := c.sendDeleteTwitchAccount(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetRecordedStream(ctx context.Context, params GetRecordedStreamParams) (res GetRecordedStreamRes, err error) {
+func (c *Client) sendDeleteTwitchAccount(ctx context.Context, request *DeleteByIDRequest) (res DeleteTwitchAccountRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRecordedStream"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}"),
+		otelogen.OperationID("deleteTwitchAccount"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts/delete"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2959,7 +3259,7 @@ func (c *Client) sendGetRecordedStream(ctx context.Context, params GetRecordedSt
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordedStreamOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteTwitchAccountOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2976,40 +3276,25 @@ func (c *Client) sendGetRecordedStream(ctx context.Context, params GetRecordedSt
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/api/v1/twitch/streams/"
-	{
-		// Encode "streamId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "streamId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.Int64ToString(params.StreamId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/twitch-accounts/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteTwitchAccountRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetRecordedStreamOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteTwitchAccountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3046,7 +3331,7 @@ func (c *Client) sendGetRecordedStream(ctx context.Context, params GetRecordedSt
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetRecordedStreamResponse(resp)
+	result, err := decodeDeleteTwitchAccountResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3054,19 +3339,19 @@ func (c *Client) sendGetRecordedStream(ctx context.Context, params GetRecordedSt
 	return result, nil
 }
 
-// GetRecordedStreamLeaderboard invokes getRecordedStreamLeaderboard operation.
+// DeleteTwitchUserLink invokes deleteTwitchUserLink operation.
 //
-// GET /api/v1/twitch/streams/{streamId}/leaderboard
-func (c *Client) GetRecordedStreamLeaderboard(ctx context.Context, params GetRecordedStreamLeaderboardParams) (GetRecordedStreamLeaderboardRes, error) {
-	res, err := c.sendGetRecordedStreamLeaderboard(ctx, params)
+// POST /api/v1/twitch/users/links/delete
+func (c *Client) DeleteTwitchUserLink(ctx context.Context, request *DeleteTwitchUserLinkRequest) (DeleteTwitchUserLinkRes, error) {
+	res, err := c.sendDeleteTwitchUserLink(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetRecordedStreamLeaderboard(ctx context.Context, params GetRecordedStreamLeaderboardParams) (res GetRecordedStreamLeaderboardRes, err error) {
+func (c *Client) sendDeleteTwitchUserLink(ctx context.Context, request *DeleteTwitchUserLinkRequest) (res DeleteTwitchUserLinkRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRecordedStreamLeaderboard"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/leaderboard"),
+		otelogen.OperationID("deleteTwitchUserLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users/links/delete"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3082,7 +3367,7 @@ func (c *Client) sendGetRecordedStreamLeaderboard(ctx context.Context, params Ge
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordedStreamLeaderboardOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteTwitchUserLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3099,79 +3384,25 @@ func (c *Client) sendGetRecordedStreamLeaderboard(ctx context.Context, params Ge
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/twitch/streams/"
-	{
-		// Encode "streamId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "streamId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.Int64ToString(params.StreamId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/leaderboard"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/users/links/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "sort" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sort",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Sort.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "q" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "q",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Q.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteTwitchUserLinkRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetRecordedStreamLeaderboardOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteTwitchUserLinkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3208,7 +3439,7 @@ func (c *Client) sendGetRecordedStreamLeaderboard(ctx context.Context, params Ge
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetRecordedStreamLeaderboardResponse(resp)
+	result, err := decodeDeleteTwitchUserLinkResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3216,19 +3447,19 @@ func (c *Client) sendGetRecordedStreamLeaderboard(ctx context.Context, params Ge
 	return result, nil
 }
 
-// GetSuspicionSettings invokes getSuspicionSettings operation.
+// DenyChannelDiscoveryCandidate invokes denyChannelDiscoveryCandidate operation.
 //
-// GET /api/v1/settings/suspicion-settings
-func (c *Client) GetSuspicionSettings(ctx context.Context) (*SuspicionSettings, error) {
-	res, err := c.sendGetSuspicionSettings(ctx)
+// POST /api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny
+func (c *Client) DenyChannelDiscoveryCandidate(ctx context.Context, params DenyChannelDiscoveryCandidateParams) (DenyChannelDiscoveryCandidateRes, error) {
+	res, err := c.sendDenyChannelDiscoveryCandidate(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetSuspicionSettings(ctx context.Context) (res *SuspicionSettings, err error) {
+func (c *Client) sendDenyChannelDiscoveryCandidate(ctx context.Context, params DenyChannelDiscoveryCandidateParams) (res DenyChannelDiscoveryCandidateRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSuspicionSettings"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/suspicion-settings"),
+		otelogen.OperationID("denyChannelDiscoveryCandidate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3244,7 +3475,7 @@ func (c *Client) sendGetSuspicionSettings(ctx context.Context) (res *SuspicionSe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetSuspicionSettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DenyChannelDiscoveryCandidateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3261,12 +3492,31 @@ func (c *Client) sendGetSuspicionSettings(ctx context.Context) (res *SuspicionSe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/suspicion-settings"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/settings/channel-discovery/candidates/"
+	{
+		// Encode "twitch_user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "twitch_user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.TwitchUserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/deny"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3276,7 +3526,7 @@ func (c *Client) sendGetSuspicionSettings(ctx context.Context) (res *SuspicionSe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetSuspicionSettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DenyChannelDiscoveryCandidateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3313,7 +3563,7 @@ func (c *Client) sendGetSuspicionSettings(ctx context.Context) (res *SuspicionSe
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetSuspicionSettingsResponse(resp)
+	result, err := decodeDenyChannelDiscoveryCandidateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3321,22 +3571,19 @@ func (c *Client) sendGetSuspicionSettings(ctx context.Context) (res *SuspicionSe
 	return result, nil
 }
 
-// GetSystemStats invokes getSystemStats operation.
-//
-// Admin-only. Snapshot values are cached server-side for up to 5 seconds; repeated calls within that
-// window return the same payload and captured_at.
+// GetAiSettings invokes getAiSettings operation.
 //
-// GET /api/v1/stats
-func (c *Client) GetSystemStats(ctx context.Context) (GetSystemStatsRes, error) {
-	res, err := c.sendGetSystemStats(ctx)
+// GET /api/v1/ai/settings
+func (c *Client) GetAiSettings(ctx context.Context) (*AiSettings, error) {
+	res, err := c.sendGetAiSettings(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetSystemStats(ctx context.Context) (res GetSystemStatsRes, err error) {
+func (c *Client) sendGetAiSettings(ctx context.Context) (res *AiSettings, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSystemStats"),
+		otelogen.OperationID("getAiSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/stats"),
+		semconv.URLTemplateKey.String("/api/v1/ai/settings"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3352,7 +3599,7 @@ func (c *Client) sendGetSystemStats(ctx context.Context) (res GetSystemStatsRes,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetSystemStatsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAiSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3370,7 +3617,7 @@ func (c *Client) sendGetSystemStats(ctx context.Context) (res GetSystemStatsRes,
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/stats"
+	pathParts[0] = "/api/v1/ai/settings"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3384,7 +3631,7 @@ func (c *Client) sendGetSystemStats(ctx context.Context) (res GetSystemStatsRes,
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetSystemStatsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetAiSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3421,7 +3668,7 @@ func (c *Client) sendGetSystemStats(ctx context.Context) (res GetSystemStatsRes,
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetSystemStatsResponse(resp)
+	result, err := decodeGetAiSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3429,19 +3676,26 @@ func (c *Client) sendGetSystemStats(ctx context.Context) (res GetSystemStatsRes,
 	return result, nil
 }
 
-// GetTwitchUserActivityTimeline invokes getTwitchUserActivityTimeline operation.
+// GetAudienceOverlap invokes getAudienceOverlap operation.
 //
-// POST /api/v1/twitch/users/activity/timeline
-func (c *Client) GetTwitchUserActivityTimeline(ctx context.Context, request *GetTwitchUserActivityTimelineRequest) (GetTwitchUserActivityTimelineRes, error) {
-	res, err := c.sendGetTwitchUserActivityTimeline(ctx, request)
+// Audience overlap between monitored channels over a trailing period. A channel's audience is
+// everyone who chatted or was present in IRC during the period (likely bots excluded when bot
+// detection hides them from stats). Returns Jaccard (shared / union) and overlap (shared / smaller
+// audience) matrices in channels order, the channel pairs with their top shared users, and per
+// channel the channels its community also frequents (shared monitored audience and GQL follows).
+// Results are cached per period for an hour.
+//
+// GET /api/v1/twitch/audience/overlap
+func (c *Client) GetAudienceOverlap(ctx context.Context, params GetAudienceOverlapParams) (*AudienceOverlap, error) {
+	res, err := c.sendGetAudienceOverlap(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetTwitchUserActivityTimeline(ctx context.Context, request *GetTwitchUserActivityTimelineRequest) (res GetTwitchUserActivityTimelineRes, err error) {
+func (c *Client) sendGetAudienceOverlap(ctx context.Context, params GetAudienceOverlapParams) (res *AudienceOverlap, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTwitchUserActivityTimeline"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/users/activity/timeline"),
+		otelogen.OperationID("getAudienceOverlap"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/audience/overlap"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3457,7 +3711,7 @@ func (c *Client) sendGetTwitchUserActivityTimeline(ctx context.Context, request
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetTwitchUserActivityTimelineOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAudienceOverlapOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3475,24 +3729,59 @@ func (c *Client) sendGetTwitchUserActivityTimeline(ctx context.Context, request
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/users/activity/timeline"
+	pathParts[0] = "/api/v1/twitch/audience/overlap"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "period" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Period.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "refresh" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "refresh",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Refresh.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetTwitchUserActivityTimelineRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetTwitchUserActivityTimelineOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetAudienceOverlapOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3529,7 +3818,7 @@ func (c *Client) sendGetTwitchUserActivityTimeline(ctx context.Context, request
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetTwitchUserActivityTimelineResponse(resp)
+	result, err := decodeGetAudienceOverlapResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3537,19 +3826,19 @@ func (c *Client) sendGetTwitchUserActivityTimeline(ctx context.Context, request
 	return result, nil
 }
 
-// GetTwitchUserProfile invokes getTwitchUserProfile operation.
+// GetBotDetectionSettings invokes getBotDetectionSettings operation.
 //
-// POST /api/v1/twitch/users/profile
-func (c *Client) GetTwitchUserProfile(ctx context.Context, request *GetTwitchUserProfileRequest) (GetTwitchUserProfileRes, error) {
-	res, err := c.sendGetTwitchUserProfile(ctx, request)
+// GET /api/v1/settings/bot-detection
+func (c *Client) GetBotDetectionSettings(ctx context.Context) (*BotDetectionSettings, error) {
+	res, err := c.sendGetBotDetectionSettings(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetTwitchUserProfile(ctx context.Context, request *GetTwitchUserProfileRequest) (res GetTwitchUserProfileRes, err error) {
+func (c *Client) sendGetBotDetectionSettings(ctx context.Context) (res *BotDetectionSettings, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTwitchUserProfile"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/users/profile"),
+		otelogen.OperationID("getBotDetectionSettings"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/bot-detection"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3565,7 +3854,7 @@ func (c *Client) sendGetTwitchUserProfile(ctx context.Context, request *GetTwitc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetTwitchUserProfileOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBotDetectionSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3583,24 +3872,21 @@ func (c *Client) sendGetTwitchUserProfile(ctx context.Context, request *GetTwitc
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/users/profile"
+	pathParts[0] = "/api/v1/settings/bot-detection"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGetTwitchUserProfileRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetTwitchUserProfileOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetBotDetectionSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3637,7 +3923,7 @@ func (c *Client) sendGetTwitchUserProfile(ctx context.Context, request *GetTwitc
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetTwitchUserProfileResponse(resp)
+	result, err := decodeGetBotDetectionSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3645,19 +3931,22 @@ func (c *Client) sendGetTwitchUserProfile(ctx context.Context, request *GetTwitc
 	return result, nil
 }
 
-// GetWatchUiHints invokes getWatchUiHints operation.
+// GetChannelActivityHeatmap invokes getChannelActivityHeatmap operation.
 //
-// GET /api/v1/twitch/watch/hints
-func (c *Client) GetWatchUiHints(ctx context.Context) (*WatchUiHints, error) {
-	res, err := c.sendGetWatchUiHints(ctx)
+// When a monitored channel's chat is busiest: chatters' summed IRC presence seconds and chat
+// messages per local weekday and hour.
+//
+// GET /api/v1/twitch/channels/{login}/heatmap
+func (c *Client) GetChannelActivityHeatmap(ctx context.Context, params GetChannelActivityHeatmapParams) (GetChannelActivityHeatmapRes, error) {
+	res, err := c.sendGetChannelActivityHeatmap(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetWatchUiHints(ctx context.Context) (res *WatchUiHints, err error) {
+func (c *Client) sendGetChannelActivityHeatmap(ctx context.Context, params GetChannelActivityHeatmapParams) (res GetChannelActivityHeatmapRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWatchUiHints"),
+		otelogen.OperationID("getChannelActivityHeatmap"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/watch/hints"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/channels/{login}/heatmap"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3673,7 +3962,7 @@ func (c *Client) sendGetWatchUiHints(ctx context.Context) (res *WatchUiHints, er
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetWatchUiHintsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetChannelActivityHeatmapOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3690,10 +3979,84 @@ func (c *Client) sendGetWatchUiHints(ctx context.Context) (res *WatchUiHints, er
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/watch/hints"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/channels/"
+	{
+		// Encode "login" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "login",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Login))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/heatmap"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timezone" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timezone",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Timezone.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -3705,7 +4068,7 @@ func (c *Client) sendGetWatchUiHints(ctx context.Context) (res *WatchUiHints, er
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetWatchUiHintsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetChannelActivityHeatmapOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3742,7 +4105,7 @@ func (c *Client) sendGetWatchUiHints(ctx context.Context) (res *WatchUiHints, er
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetWatchUiHintsResponse(resp)
+	result, err := decodeGetChannelActivityHeatmapResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3750,19 +4113,25 @@ func (c *Client) sendGetWatchUiHints(ctx context.Context) (res *WatchUiHints, er
 	return result, nil
 }
 
-// ListAiConversations invokes listAiConversations operation.
+// GetChannelAnalytics invokes getChannelAnalytics operation.
 //
-// GET /api/v1/ai/conversations
-func (c *Client) ListAiConversations(ctx context.Context) ([]AiConversation, error) {
-	res, err := c.sendListAiConversations(ctx)
+// Time-bucketed chat activity (messages, unique/new chatters, average IRC presence) for a monitored
+// channel and stream-over-stream chatter retention of the streams started in the range. Buckets are
+// UTC-aligned; hour and day series come from rollups refreshed every few minutes (see
+// rolled_up_until), minute series are computed live. Ranges are limited to 24h (minute), 31d (hour)
+// and 366d (day).
+//
+// GET /api/v1/twitch/channels/{login}/analytics
+func (c *Client) GetChannelAnalytics(ctx context.Context, params GetChannelAnalyticsParams) (GetChannelAnalyticsRes, error) {
+	res, err := c.sendGetChannelAnalytics(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListAiConversations(ctx context.Context) (res []AiConversation, err error) {
+func (c *Client) sendGetChannelAnalytics(ctx context.Context, params GetChannelAnalyticsParams) (res GetChannelAnalyticsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listAiConversations"),
+		otelogen.OperationID("getChannelAnalytics"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/ai/conversations"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/channels/{login}/analytics"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3778,7 +4147,7 @@ func (c *Client) sendListAiConversations(ctx context.Context) (res []AiConversat
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListAiConversationsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetChannelAnalyticsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3795,10 +4164,84 @@ func (c *Client) sendListAiConversations(ctx context.Context) (res []AiConversat
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/ai/conversations"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/channels/"
+	{
+		// Encode "login" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "login",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Login))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/analytics"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "bucket" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "bucket",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Bucket.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -3810,7 +4253,7 @@ func (c *Client) sendListAiConversations(ctx context.Context) (res []AiConversat
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListAiConversationsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetChannelAnalyticsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3847,7 +4290,7 @@ func (c *Client) sendListAiConversations(ctx context.Context) (res []AiConversat
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListAiConversationsResponse(resp)
+	result, err := decodeGetChannelAnalyticsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3855,19 +4298,19 @@ func (c *Client) sendListAiConversations(ctx context.Context) (res []AiConversat
 	return result, nil
 }
 
-// ListAiMessages invokes listAiMessages operation.
+// GetChannelDiscoverySettings invokes getChannelDiscoverySettings operation.
 //
-// GET /api/v1/ai/conversations/{conversationId}/messages
-func (c *Client) ListAiMessages(ctx context.Context, params ListAiMessagesParams) (ListAiMessagesRes, error) {
-	res, err := c.sendListAiMessages(ctx, params)
+// GET /api/v1/settings/channel-discovery
+func (c *Client) GetChannelDiscoverySettings(ctx context.Context) (*ChannelDiscoverySettings, error) {
+	res, err := c.sendGetChannelDiscoverySettings(ctx)
 	return res, err
 }
 
-func (c *Client) sendListAiMessages(ctx context.Context, params ListAiMessagesParams) (res ListAiMessagesRes, err error) {
+func (c *Client) sendGetChannelDiscoverySettings(ctx context.Context) (res *ChannelDiscoverySettings, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listAiMessages"),
+		otelogen.OperationID("getChannelDiscoverySettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/ai/conversations/{conversationId}/messages"),
+		semconv.URLTemplateKey.String("/api/v1/settings/channel-discovery"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3883,7 +4326,7 @@ func (c *Client) sendListAiMessages(ctx context.Context, params ListAiMessagesPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListAiMessagesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetChannelDiscoverySettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3900,27 +4343,8 @@ func (c *Client) sendListAiMessages(ctx context.Context, params ListAiMessagesPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/ai/conversations/"
-	{
-		// Encode "conversationId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "conversationId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.Int64ToString(params.ConversationId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/messages"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/channel-discovery"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3934,7 +4358,7 @@ func (c *Client) sendListAiMessages(ctx context.Context, params ListAiMessagesPa
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListAiMessagesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetChannelDiscoverySettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3971,7 +4395,7 @@ func (c *Client) sendListAiMessages(ctx context.Context, params ListAiMessagesPa
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListAiMessagesResponse(resp)
+	result, err := decodeGetChannelDiscoverySettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3979,19 +4403,21 @@ func (c *Client) sendListAiMessages(ctx context.Context, params ListAiMessagesPa
 	return result, nil
 }
 
-// ListChannelBlacklist invokes listChannelBlacklist operation.
+// GetChannelEmoteUsage invokes getChannelEmoteUsage operation.
 //
-// GET /api/v1/settings/channel-blacklist
-func (c *Client) ListChannelBlacklist(ctx context.Context) ([]string, error) {
-	res, err := c.sendListChannelBlacklist(ctx)
+// Emotes used most in a monitored channel's chat.
+//
+// GET /api/v1/twitch/channels/{login}/emotes
+func (c *Client) GetChannelEmoteUsage(ctx context.Context, params GetChannelEmoteUsageParams) (GetChannelEmoteUsageRes, error) {
+	res, err := c.sendGetChannelEmoteUsage(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListChannelBlacklist(ctx context.Context) (res []string, err error) {
+func (c *Client) sendGetChannelEmoteUsage(ctx context.Context, params GetChannelEmoteUsageParams) (res GetChannelEmoteUsageRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listChannelBlacklist"),
+		otelogen.OperationID("getChannelEmoteUsage"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/channel-blacklist"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/channels/{login}/emotes"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4007,7 +4433,7 @@ func (c *Client) sendListChannelBlacklist(ctx context.Context) (res []string, er
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListChannelBlacklistOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetChannelEmoteUsageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4024,10 +4450,84 @@ func (c *Client) sendListChannelBlacklist(ctx context.Context) (res []string, er
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/channel-blacklist"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/channels/"
+	{
+		// Encode "login" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "login",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Login))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/emotes"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -4039,7 +4539,7 @@ func (c *Client) sendListChannelBlacklist(ctx context.Context) (res []string, er
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListChannelBlacklistOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetChannelEmoteUsageOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4076,7 +4576,7 @@ func (c *Client) sendListChannelBlacklist(ctx context.Context) (res []string, er
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListChannelBlacklistResponse(resp)
+	result, err := decodeGetChannelEmoteUsageResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4084,19 +4584,22 @@ func (c *Client) sendListChannelBlacklist(ctx context.Context) (res []string, er
 	return result, nil
 }
 
-// ListChannelChatters invokes listChannelChatters operation.
+// GetChannelLeaderboard invokes getChannelLeaderboard operation.
 //
-// POST /api/v1/twitch/channels/chatters
-func (c *Client) ListChannelChatters(ctx context.Context, request *ListChannelChattersRequest) (ListChannelChattersRes, error) {
-	res, err := c.sendListChannelChatters(ctx, request)
+// Chatters of a monitored channel ranked by IRC presence, messages or account age over whole UTC
+// days. Totals come from daily rollups refreshed every few minutes (see rolled_up_until).
+//
+// GET /api/v1/twitch/channels/{login}/leaderboard
+func (c *Client) GetChannelLeaderboard(ctx context.Context, params GetChannelLeaderboardParams) (GetChannelLeaderboardRes, error) {
+	res, err := c.sendGetChannelLeaderboard(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListChannelChatters(ctx context.Context, request *ListChannelChattersRequest) (res ListChannelChattersRes, err error) {
+func (c *Client) sendGetChannelLeaderboard(ctx context.Context, params GetChannelLeaderboardParams) (res GetChannelLeaderboardRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listChannelChatters"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/channels/chatters"),
+		otelogen.OperationID("getChannelLeaderboard"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/channels/{login}/leaderboard"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4112,7 +4615,7 @@ func (c *Client) sendListChannelChatters(ctx context.Context, request *ListChann
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListChannelChattersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetChannelLeaderboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4129,41 +4632,214 @@ func (c *Client) sendListChannelChatters(ctx context.Context, request *ListChann
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/channels/chatters"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-	if err := encodeListChannelChattersRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/channels/"
+	{
+		// Encode "login" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "login",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Login))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/leaderboard"
+	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListChannelChattersOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Q.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "exclude_bots" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "exclude_bots",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ExcludeBots.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "exclude_marked" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "exclude_marked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ExcludeMarked.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "exclude_linked" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "exclude_linked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ExcludeLinked.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetChannelLeaderboardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
 						continue nextRequirement
 					}
 				}
@@ -4184,7 +4860,7 @@ func (c *Client) sendListChannelChatters(ctx context.Context, request *ListChann
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListChannelChattersResponse(resp)
+	result, err := decodeGetChannelLeaderboardResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4192,19 +4868,19 @@ func (c *Client) sendListChannelChatters(ctx context.Context, request *ListChann
 	return result, nil
 }
 
-// ListChannelDiscoveryCandidates invokes listChannelDiscoveryCandidates operation.
+// GetChannelLive invokes getChannelLive operation.
 //
-// GET /api/v1/settings/channel-discovery/candidates
-func (c *Client) ListChannelDiscoveryCandidates(ctx context.Context) ([]DiscoveryCandidate, error) {
-	res, err := c.sendListChannelDiscoveryCandidates(ctx)
+// POST /api/v1/twitch/channels/live
+func (c *Client) GetChannelLive(ctx context.Context, request *GetChannelLiveRequest) (GetChannelLiveRes, error) {
+	res, err := c.sendGetChannelLive(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListChannelDiscoveryCandidates(ctx context.Context) (res []DiscoveryCandidate, err error) {
+func (c *Client) sendGetChannelLive(ctx context.Context, request *GetChannelLiveRequest) (res GetChannelLiveRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listChannelDiscoveryCandidates"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/channel-discovery/candidates"),
+		otelogen.OperationID("getChannelLive"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/channels/live"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4220,7 +4896,7 @@ func (c *Client) sendListChannelDiscoveryCandidates(ctx context.Context) (res []
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListChannelDiscoveryCandidatesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetChannelLiveOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4238,21 +4914,24 @@ func (c *Client) sendListChannelDiscoveryCandidates(ctx context.Context) (res []
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/channel-discovery/candidates"
+	pathParts[0] = "/api/v1/twitch/channels/live"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeGetChannelLiveRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListChannelDiscoveryCandidatesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetChannelLiveOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4289,7 +4968,7 @@ func (c *Client) sendListChannelDiscoveryCandidates(ctx context.Context) (res []
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListChannelDiscoveryCandidatesResponse(resp)
+	result, err := decodeGetChannelLiveResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4297,19 +4976,19 @@ func (c *Client) sendListChannelDiscoveryCandidates(ctx context.Context) (res []
 	return result, nil
 }
 
-// ListChatHistory invokes listChatHistory operation.
+// GetChatClassifierSettings invokes getChatClassifierSettings operation.
 //
-// GET /api/v1/twitch/chat/history
-func (c *Client) ListChatHistory(ctx context.Context, params ListChatHistoryParams) (ListChatHistoryRes, error) {
-	res, err := c.sendListChatHistory(ctx, params)
+// GET /api/v1/settings/chat-classifier
+func (c *Client) GetChatClassifierSettings(ctx context.Context) (*ChatClassifierSettings, error) {
+	res, err := c.sendGetChatClassifierSettings(ctx)
 	return res, err
 }
 
-func (c *Client) sendListChatHistory(ctx context.Context, params ListChatHistoryParams) (res ListChatHistoryRes, err error) {
+func (c *Client) sendGetChatClassifierSettings(ctx context.Context) (res *ChatClassifierSettings, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listChatHistory"),
+		otelogen.OperationID("getChatClassifierSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/chat/history"),
+		semconv.URLTemplateKey.String("/api/v1/settings/chat-classifier"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4325,7 +5004,7 @@ func (c *Client) sendListChatHistory(ctx context.Context, params ListChatHistory
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListChatHistoryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetChatClassifierSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4343,44 +5022,9 @@ func (c *Client) sendListChatHistory(ctx context.Context, params ListChatHistory
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/chat/history"
+	pathParts[0] = "/api/v1/settings/chat-classifier"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "channel" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "channel",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Channel))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -4392,7 +5036,7 @@ func (c *Client) sendListChatHistory(ctx context.Context, params ListChatHistory
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListChatHistoryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetChatClassifierSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4429,7 +5073,7 @@ func (c *Client) sendListChatHistory(ctx context.Context, params ListChatHistory
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListChatHistoryResponse(resp)
+	result, err := decodeGetChatClassifierSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4437,21 +5081,19 @@ func (c *Client) sendListChatHistory(ctx context.Context, params ListChatHistory
 	return result, nil
 }
 
-// ListIrcMonitorJoinedHistory invokes listIrcMonitorJoinedHistory operation.
-//
-// Historical IRC joined channel counts (periodic samples).
+// GetIrcMonitorSettings invokes getIrcMonitorSettings operation.
 //
-// GET /api/v1/twitch/irc-monitor/joined-history
-func (c *Client) ListIrcMonitorJoinedHistory(ctx context.Context, params ListIrcMonitorJoinedHistoryParams) ([]IrcJoinedSample, error) {
-	res, err := c.sendListIrcMonitorJoinedHistory(ctx, params)
+// GET /api/v1/settings/irc-monitor-settings
+func (c *Client) GetIrcMonitorSettings(ctx context.Context) (*IrcMonitorSettings, error) {
+	res, err := c.sendGetIrcMonitorSettings(ctx)
 	return res, err
 }
 
-func (c *Client) sendListIrcMonitorJoinedHistory(ctx context.Context, params ListIrcMonitorJoinedHistoryParams) (res []IrcJoinedSample, err error) {
+func (c *Client) sendGetIrcMonitorSettings(ctx context.Context) (res *IrcMonitorSettings, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listIrcMonitorJoinedHistory"),
+		otelogen.OperationID("getIrcMonitorSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/irc-monitor/joined-history"),
+		semconv.URLTemplateKey.String("/api/v1/settings/irc-monitor-settings"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4467,7 +5109,7 @@ func (c *Client) sendListIrcMonitorJoinedHistory(ctx context.Context, params Lis
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListIrcMonitorJoinedHistoryOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetIrcMonitorSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4485,30 +5127,9 @@ func (c *Client) sendListIrcMonitorJoinedHistory(ctx context.Context, params Lis
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/irc-monitor/joined-history"
+	pathParts[0] = "/api/v1/settings/irc-monitor-settings"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "days" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "days",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Days.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -4520,7 +5141,7 @@ func (c *Client) sendListIrcMonitorJoinedHistory(ctx context.Context, params Lis
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListIrcMonitorJoinedHistoryOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetIrcMonitorSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4557,7 +5178,7 @@ func (c *Client) sendListIrcMonitorJoinedHistory(ctx context.Context, params Lis
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListIrcMonitorJoinedHistoryResponse(resp)
+	result, err := decodeGetIrcMonitorSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4565,21 +5186,19 @@ func (c *Client) sendListIrcMonitorJoinedHistory(ctx context.Context, params Lis
 	return result, nil
 }
 
-// ListNotifications invokes listNotifications operation.
-//
-// List notification entries (newest first) with cursor-based incremental loading.
+// GetIrcMonitorStatus invokes getIrcMonitorStatus operation.
 //
-// GET /api/v1/settings/notifications
-func (c *Client) ListNotifications(ctx context.Context, params ListNotificationsParams) ([]NotificationEntry, error) {
-	res, err := c.sendListNotifications(ctx, params)
+// GET /api/v1/twitch/irc-monitor/status
+func (c *Client) GetIrcMonitorStatus(ctx context.Context) (*IrcMonitorStatus, error) {
+	res, err := c.sendGetIrcMonitorStatus(ctx)
 	return res, err
 }
 
-func (c *Client) sendListNotifications(ctx context.Context, params ListNotificationsParams) (res []NotificationEntry, err error) {
+func (c *Client) sendGetIrcMonitorStatus(ctx context.Context) (res *IrcMonitorStatus, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listNotifications"),
+		otelogen.OperationID("getIrcMonitorStatus"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/notifications"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/irc-monitor/status"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4595,7 +5214,7 @@ func (c *Client) sendListNotifications(ctx context.Context, params ListNotificat
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListNotificationsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetIrcMonitorStatusOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4613,64 +5232,9 @@ func (c *Client) sendListNotifications(ctx context.Context, params ListNotificat
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/notifications"
+	pathParts[0] = "/api/v1/twitch/irc-monitor/status"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "cursor_created_at" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_created_at",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorCreatedAt.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "cursor_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -4682,7 +5246,7 @@ func (c *Client) sendListNotifications(ctx context.Context, params ListNotificat
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListNotificationsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetIrcMonitorStatusOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4719,7 +5283,7 @@ func (c *Client) sendListNotifications(ctx context.Context, params ListNotificat
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListNotificationsResponse(resp)
+	result, err := decodeGetIrcMonitorStatusResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4727,21 +5291,22 @@ func (c *Client) sendListNotifications(ctx context.Context, params ListNotificat
 	return result, nil
 }
 
-// ListRecordedStreamActivity invokes listRecordedStreamActivity operation.
+// GetMonitoredLeaderboard invokes getMonitoredLeaderboard operation.
 //
-// Non-message activity in the stream time window (newest first).
+// Chatters ranked across all monitored channels over whole UTC days; presence and messages are
+// summed over channels. Same filters and rollup lag as the per-channel leaderboard.
 //
-// GET /api/v1/twitch/streams/{streamId}/activity
-func (c *Client) ListRecordedStreamActivity(ctx context.Context, params ListRecordedStreamActivityParams) (ListRecordedStreamActivityRes, error) {
-	res, err := c.sendListRecordedStreamActivity(ctx, params)
+// GET /api/v1/twitch/leaderboard
+func (c *Client) GetMonitoredLeaderboard(ctx context.Context, params GetMonitoredLeaderboardParams) (GetMonitoredLeaderboardRes, error) {
+	res, err := c.sendGetMonitoredLeaderboard(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params ListRecordedStreamActivityParams) (res ListRecordedStreamActivityRes, err error) {
+func (c *Client) sendGetMonitoredLeaderboard(ctx context.Context, params GetMonitoredLeaderboardParams) (res GetMonitoredLeaderboardRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRecordedStreamActivity"),
+		otelogen.OperationID("getMonitoredLeaderboard"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/activity"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/leaderboard"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4757,7 +5322,7 @@ func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params List
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListRecordedStreamActivityOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMonitoredLeaderboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4774,42 +5339,23 @@ func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params List
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/twitch/streams/"
-	{
-		// Encode "streamId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "streamId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.Int64ToString(params.StreamId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/activity"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/leaderboard"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "limit" parameter.
+		// Encode "from" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
+			Name:    "from",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4817,15 +5363,15 @@ func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params List
 		}
 	}
 	{
-		// Encode "cursor_created_at" parameter.
+		// Encode "to" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_created_at",
+			Name:    "to",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorCreatedAt.Get(); ok {
+			if val, ok := params.To.Get(); ok {
 				return e.EncodeValue(conv.DateTimeToString(val))
 			}
 			return nil
@@ -4834,16 +5380,118 @@ func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params List
 		}
 	}
 	{
-		// Encode "cursor_id" parameter.
+		// Encode "sort" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_id",
+			Name:    "sort",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Q.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "exclude_bots" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "exclude_bots",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ExcludeBots.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "exclude_marked" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "exclude_marked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ExcludeMarked.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "exclude_linked" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "exclude_linked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ExcludeLinked.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4863,7 +5511,7 @@ func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params List
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListRecordedStreamActivityOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetMonitoredLeaderboardOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4900,7 +5548,7 @@ func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params List
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListRecordedStreamActivityResponse(resp)
+	result, err := decodeGetMonitoredLeaderboardResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4908,21 +5556,19 @@ func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params List
 	return result, nil
 }
 
-// ListRecordedStreamMessages invokes listRecordedStreamMessages operation.
-//
-// Chat messages tagged with this stream (newest first).
+// GetRecordedStream invokes getRecordedStream operation.
 //
-// GET /api/v1/twitch/streams/{streamId}/messages
-func (c *Client) ListRecordedStreamMessages(ctx context.Context, params ListRecordedStreamMessagesParams) (ListRecordedStreamMessagesRes, error) {
-	res, err := c.sendListRecordedStreamMessages(ctx, params)
+// GET /api/v1/twitch/streams/{streamId}
+func (c *Client) GetRecordedStream(ctx context.Context, params GetRecordedStreamParams) (GetRecordedStreamRes, error) {
+	res, err := c.sendGetRecordedStream(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListRecordedStreamMessages(ctx context.Context, params ListRecordedStreamMessagesParams) (res ListRecordedStreamMessagesRes, err error) {
+func (c *Client) sendGetRecordedStream(ctx context.Context, params GetRecordedStreamParams) (res GetRecordedStreamRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRecordedStreamMessages"),
+		otelogen.OperationID("getRecordedStream"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/messages"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -4938,7 +5584,7 @@ func (c *Client) sendListRecordedStreamMessages(ctx context.Context, params List
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListRecordedStreamMessagesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordedStreamOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4955,7 +5601,7 @@ func (c *Client) sendListRecordedStreamMessages(ctx context.Context, params List
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [2]string
 	pathParts[0] = "/api/v1/twitch/streams/"
 	{
 		// Encode "streamId" parameter.
@@ -4975,115 +5621,8 @@ func (c *Client) sendListRecordedStreamMessages(ctx context.Context, params List
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/messages"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "cursor_created_at" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_created_at",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorCreatedAt.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "cursor_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "username" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "username",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Username.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "text" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "text",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Text.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "chatter_user_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "chatter_user_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ChatterUserID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -5095,7 +5634,7 @@ func (c *Client) sendListRecordedStreamMessages(ctx context.Context, params List
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListRecordedStreamMessagesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetRecordedStreamOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5132,7 +5671,7 @@ func (c *Client) sendListRecordedStreamMessages(ctx context.Context, params List
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListRecordedStreamMessagesResponse(resp)
+	result, err := decodeGetRecordedStreamResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5140,21 +5679,23 @@ func (c *Client) sendListRecordedStreamMessages(ctx context.Context, params List
 	return result, nil
 }
 
-// ListRecordedStreams invokes listRecordedStreams operation.
+// GetRecordedStreamEmotes invokes getRecordedStreamEmotes operation.
 //
-// Recorded stream sessions for monitored channels (newest first).
+// The stream's most used emotes and a timeline of their uses from stream start, in buckets of whole
+// minutes
+// (about 60 buckets per stream). Timeline uses are aligned with `top`.
 //
-// GET /api/v1/twitch/streams
-func (c *Client) ListRecordedStreams(ctx context.Context, params ListRecordedStreamsParams) ([]RecordedStream, error) {
-	res, err := c.sendListRecordedStreams(ctx, params)
+// GET /api/v1/twitch/streams/{streamId}/emotes
+func (c *Client) GetRecordedStreamEmotes(ctx context.Context, params GetRecordedStreamEmotesParams) (GetRecordedStreamEmotesRes, error) {
+	res, err := c.sendGetRecordedStreamEmotes(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListRecordedStreams(ctx context.Context, params ListRecordedStreamsParams) (res []RecordedStream, err error) {
+func (c *Client) sendGetRecordedStreamEmotes(ctx context.Context, params GetRecordedStreamEmotesParams) (res GetRecordedStreamEmotesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRecordedStreams"),
+		otelogen.OperationID("getRecordedStreamEmotes"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/streams"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/emotes"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5170,7 +5711,7 @@ func (c *Client) sendListRecordedStreams(ctx context.Context, params ListRecorde
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListRecordedStreamsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordedStreamEmotesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5187,29 +5728,31 @@ func (c *Client) sendListRecordedStreams(ctx context.Context, params ListRecorde
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/streams"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/streams/"
 	{
-		// Encode "channel_login" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "channel_login",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "streamId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "streamId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.StreamId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ChannelLogin.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/emotes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
 		// Encode "limit" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -5227,40 +5770,6 @@ func (c *Client) sendListRecordedStreams(ctx context.Context, params ListRecorde
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "cursor_started_at" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_started_at",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorStartedAt.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "cursor_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
@@ -5274,7 +5783,7 @@ func (c *Client) sendListRecordedStreams(ctx context.Context, params ListRecorde
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListRecordedStreamsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetRecordedStreamEmotesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5311,7 +5820,7 @@ func (c *Client) sendListRecordedStreams(ctx context.Context, params ListRecorde
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListRecordedStreamsResponse(resp)
+	result, err := decodeGetRecordedStreamEmotesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5319,21 +5828,19 @@ func (c *Client) sendListRecordedStreams(ctx context.Context, params ListRecorde
 	return result, nil
 }
 
-// ListRuleTemplateVariables invokes listRuleTemplateVariables operation.
-//
-// Names and descriptions for `$NAME` placeholders in notify and send_chat message templates.
+// GetRecordedStreamLeaderboard invokes getRecordedStreamLeaderboard operation.
 //
-// GET /api/v1/settings/rules/template-variables
-func (c *Client) ListRuleTemplateVariables(ctx context.Context) (*RuleTemplateVariablesResponse, error) {
-	res, err := c.sendListRuleTemplateVariables(ctx)
+// GET /api/v1/twitch/streams/{streamId}/leaderboard
+func (c *Client) GetRecordedStreamLeaderboard(ctx context.Context, params GetRecordedStreamLeaderboardParams) (GetRecordedStreamLeaderboardRes, error) {
+	res, err := c.sendGetRecordedStreamLeaderboard(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListRuleTemplateVariables(ctx context.Context) (res *RuleTemplateVariablesResponse, err error) {
+func (c *Client) sendGetRecordedStreamLeaderboard(ctx context.Context, params GetRecordedStreamLeaderboardParams) (res GetRecordedStreamLeaderboardRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRuleTemplateVariables"),
+		otelogen.OperationID("getRecordedStreamLeaderboard"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/rules/template-variables"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/leaderboard"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5349,7 +5856,7 @@ func (c *Client) sendListRuleTemplateVariables(ctx context.Context) (res *RuleTe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListRuleTemplateVariablesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordedStreamLeaderboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5366,24 +5873,81 @@ func (c *Client) sendListRuleTemplateVariables(ctx context.Context) (res *RuleTe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/rules/template-variables"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/streams/"
+	{
+		// Encode "streamId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "streamId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.StreamId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/leaderboard"
+	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListRuleTemplateVariablesOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Q.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetRecordedStreamLeaderboardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
 				// Skip this security.
 			default:
@@ -5418,7 +5982,7 @@ func (c *Client) sendListRuleTemplateVariables(ctx context.Context) (res *RuleTe
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListRuleTemplateVariablesResponse(resp)
+	result, err := decodeGetRecordedStreamLeaderboardResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5426,21 +5990,24 @@ func (c *Client) sendListRuleTemplateVariables(ctx context.Context) (res *RuleTe
 	return result, nil
 }
 
-// ListRuleTriggers invokes listRuleTriggers operation.
+// GetRecordedStreamTermSeries invokes getRecordedStreamTermSeries operation.
 //
-// List rule trigger events (newest first) with cursor-based incremental loading.
+// How many messages used a term over the stream, in buckets of whole minutes from stream start
+// (about 60
+// buckets per stream). The term is normalized like trending terms; emote codes match
+// case-insensitively.
 //
-// GET /api/v1/settings/rule-triggers
-func (c *Client) ListRuleTriggers(ctx context.Context, params ListRuleTriggersParams) ([]RuleTrigger, error) {
-	res, err := c.sendListRuleTriggers(ctx, params)
+// GET /api/v1/twitch/streams/{streamId}/terms/series
+func (c *Client) GetRecordedStreamTermSeries(ctx context.Context, params GetRecordedStreamTermSeriesParams) (GetRecordedStreamTermSeriesRes, error) {
+	res, err := c.sendGetRecordedStreamTermSeries(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListRuleTriggers(ctx context.Context, params ListRuleTriggersParams) (res []RuleTrigger, err error) {
+func (c *Client) sendGetRecordedStreamTermSeries(ctx context.Context, params GetRecordedStreamTermSeriesParams) (res GetRecordedStreamTermSeriesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRuleTriggers"),
+		otelogen.OperationID("getRecordedStreamTermSeries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/rule-triggers"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/terms/series"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5456,7 +6023,7 @@ func (c *Client) sendListRuleTriggers(ctx context.Context, params ListRuleTrigge
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListRuleTriggersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordedStreamTermSeriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5473,59 +6040,41 @@ func (c *Client) sendListRuleTriggers(ctx context.Context, params ListRuleTrigge
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/rule-triggers"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/streams/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "streamId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "streamId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.StreamId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	{
-		// Encode "cursor_created_at" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_created_at",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	pathParts[2] = "/terms/series"
+	uri.AddPathParts(u, pathParts[:]...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorCreatedAt.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "cursor_id" parameter.
+		// Encode "term" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_id",
+			Name:    "term",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
-			}
-			return nil
+			return e.EncodeValue(conv.StringToString(params.Term))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -5543,7 +6092,7 @@ func (c *Client) sendListRuleTriggers(ctx context.Context, params ListRuleTrigge
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListRuleTriggersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetRecordedStreamTermSeriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5580,7 +6129,7 @@ func (c *Client) sendListRuleTriggers(ctx context.Context, params ListRuleTrigge
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListRuleTriggersResponse(resp)
+	result, err := decodeGetRecordedStreamTermSeriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5588,19 +6137,25 @@ func (c *Client) sendListRuleTriggers(ctx context.Context, params ListRuleTrigge
 	return result, nil
 }
 
-// ListRules invokes listRules operation.
+// GetRecordedStreamTerms invokes getRecordedStreamTerms operation.
 //
-// GET /api/v1/settings/rules
-func (c *Client) ListRules(ctx context.Context) ([]Rule, error) {
-	res, err := c.sendListRules(ctx)
+// Trending chat terms of a stream (or a window of it): words, 2-3 word phrases and emotes ranked by
+// TF-IDF,
+// i.e. the share of the window's messages using the term weighted by how rare the term is in the
+// channel's
+// recent streams. Stopwords, mentions and links are ignored.
+//
+// GET /api/v1/twitch/streams/{streamId}/terms
+func (c *Client) GetRecordedStreamTerms(ctx context.Context, params GetRecordedStreamTermsParams) (GetRecordedStreamTermsRes, error) {
+	res, err := c.sendGetRecordedStreamTerms(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListRules(ctx context.Context) (res []Rule, err error) {
+func (c *Client) sendGetRecordedStreamTerms(ctx context.Context, params GetRecordedStreamTermsParams) (res GetRecordedStreamTermsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRules"),
+		otelogen.OperationID("getRecordedStreamTerms"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/rules"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/terms"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5616,7 +6171,7 @@ func (c *Client) sendListRules(ctx context.Context) (res []Rule, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListRulesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecordedStreamTermsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5633,10 +6188,84 @@ func (c *Client) sendListRules(ctx context.Context) (res []Rule, err error) {
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/rules"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/streams/"
+	{
+		// Encode "streamId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "streamId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.StreamId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/terms"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -5648,7 +6277,7 @@ func (c *Client) sendListRules(ctx context.Context) (res []Rule, err error) {
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListRulesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetRecordedStreamTermsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5685,7 +6314,7 @@ func (c *Client) sendListRules(ctx context.Context) (res []Rule, err error) {
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListRulesResponse(resp)
+	result, err := decodeGetRecordedStreamTermsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5693,19 +6322,19 @@ func (c *Client) sendListRules(ctx context.Context) (res []Rule, err error) {
 	return result, nil
 }
 
-// ListTwitchAccounts invokes listTwitchAccounts operation.
+// GetStreamRecapSettings invokes getStreamRecapSettings operation.
 //
-// GET /api/v1/settings/twitch-accounts
-func (c *Client) ListTwitchAccounts(ctx context.Context) ([]TwitchAccount, error) {
-	res, err := c.sendListTwitchAccounts(ctx)
+// GET /api/v1/settings/stream-recap
+func (c *Client) GetStreamRecapSettings(ctx context.Context) (*StreamRecapSettings, error) {
+	res, err := c.sendGetStreamRecapSettings(ctx)
 	return res, err
 }
 
-func (c *Client) sendListTwitchAccounts(ctx context.Context) (res []TwitchAccount, err error) {
+func (c *Client) sendGetStreamRecapSettings(ctx context.Context) (res *StreamRecapSettings, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchAccounts"),
+		otelogen.OperationID("getStreamRecapSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts"),
+		semconv.URLTemplateKey.String("/api/v1/settings/stream-recap"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5721,7 +6350,7 @@ func (c *Client) sendListTwitchAccounts(ctx context.Context) (res []TwitchAccoun
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchAccountsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetStreamRecapSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5739,7 +6368,7 @@ func (c *Client) sendListTwitchAccounts(ctx context.Context) (res []TwitchAccoun
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/twitch-accounts"
+	pathParts[0] = "/api/v1/settings/stream-recap"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -5753,7 +6382,7 @@ func (c *Client) sendListTwitchAccounts(ctx context.Context) (res []TwitchAccoun
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTwitchAccountsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetStreamRecapSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5790,7 +6419,7 @@ func (c *Client) sendListTwitchAccounts(ctx context.Context) (res []TwitchAccoun
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTwitchAccountsResponse(resp)
+	result, err := decodeGetStreamRecapSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5798,21 +6427,19 @@ func (c *Client) sendListTwitchAccounts(ctx context.Context) (res []TwitchAccoun
 	return result, nil
 }
 
-// ListTwitchDirectoryUsers invokes listTwitchDirectoryUsers operation.
+// GetSuspicionReevaluation invokes getSuspicionReevaluation operation.
 //
-// List known Twitch users (chatters and channels) for directory search.
-//
-// GET /api/v1/twitch/users
-func (c *Client) ListTwitchDirectoryUsers(ctx context.Context, params ListTwitchDirectoryUsersParams) ([]TwitchUser, error) {
-	res, err := c.sendListTwitchDirectoryUsers(ctx, params)
+// GET /api/v1/settings/suspicion-settings/reevaluate
+func (c *Client) GetSuspicionReevaluation(ctx context.Context) (*SuspicionReevaluation, error) {
+	res, err := c.sendGetSuspicionReevaluation(ctx)
 	return res, err
 }
 
-func (c *Client) sendListTwitchDirectoryUsers(ctx context.Context, params ListTwitchDirectoryUsersParams) (res []TwitchUser, err error) {
+func (c *Client) sendGetSuspicionReevaluation(ctx context.Context) (res *SuspicionReevaluation, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchDirectoryUsers"),
+		otelogen.OperationID("getSuspicionReevaluation"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/users"),
+		semconv.URLTemplateKey.String("/api/v1/settings/suspicion-settings/reevaluate"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -5828,7 +6455,7 @@ func (c *Client) sendListTwitchDirectoryUsers(ctx context.Context, params ListTw
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchDirectoryUsersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSuspicionReevaluationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5846,80 +6473,221 @@ func (c *Client) sendListTwitchDirectoryUsers(ctx context.Context, params ListTw
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/users"
+	pathParts[0] = "/api/v1/settings/suspicion-settings/reevaluate"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
-		// Encode "username" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "username",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetSuspicionReevaluationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Username.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "cursor_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	body := resp.Body
+	defer body.Close()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+	stage = "DecodeResponse"
+	result, err := decodeGetSuspicionReevaluationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetSuspicionSettings invokes getSuspicionSettings operation.
+//
+// GET /api/v1/settings/suspicion-settings
+func (c *Client) GetSuspicionSettings(ctx context.Context) (*SuspicionSettings, error) {
+	res, err := c.sendGetSuspicionSettings(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetSuspicionSettings(ctx context.Context) (res *SuspicionSettings, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getSuspicionSettings"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/suspicion-settings"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSuspicionSettingsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/suspicion-settings"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
+
 	{
-		// Encode "monitored_only" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "monitored_only",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetSuspicionSettingsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.MonitoredOnly.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	u.RawQuery = q.Values().Encode()
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetSuspicionSettingsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetSystemStats invokes getSystemStats operation.
+//
+// Admin-only. Snapshot values are cached server-side for up to 5 seconds; repeated calls within that
+// window return the same payload and captured_at.
+//
+// GET /api/v1/stats
+func (c *Client) GetSystemStats(ctx context.Context) (GetSystemStatsRes, error) {
+	res, err := c.sendGetSystemStats(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetSystemStats(ctx context.Context) (res GetSystemStatsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getSystemStats"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/stats"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSystemStatsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/stats"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -5932,7 +6700,7 @@ func (c *Client) sendListTwitchDirectoryUsers(ctx context.Context, params ListTw
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTwitchDirectoryUsersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetSystemStatsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5969,7 +6737,7 @@ func (c *Client) sendListTwitchDirectoryUsers(ctx context.Context, params ListTw
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTwitchDirectoryUsersResponse(resp)
+	result, err := decodeGetSystemStatsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5977,21 +6745,22 @@ func (c *Client) sendListTwitchDirectoryUsers(ctx context.Context, params ListTw
 	return result, nil
 }
 
-// ListTwitchMessages invokes listTwitchMessages operation.
+// GetTwitchUserActivityHeatmap invokes getTwitchUserActivityHeatmap operation.
 //
-// Search persisted chat messages (newest first). Omit filters to list recent messages.
+// When the user is usually online and chatting: IRC presence seconds (summed over monitored
+// channels) and chat messages per local weekday and hour.
 //
-// GET /api/v1/twitch/messages
-func (c *Client) ListTwitchMessages(ctx context.Context, params ListTwitchMessagesParams) ([]ChatHistoryEntry, error) {
-	res, err := c.sendListTwitchMessages(ctx, params)
+// GET /api/v1/twitch/users/{twitch_user_id}/heatmap
+func (c *Client) GetTwitchUserActivityHeatmap(ctx context.Context, params GetTwitchUserActivityHeatmapParams) (GetTwitchUserActivityHeatmapRes, error) {
+	res, err := c.sendGetTwitchUserActivityHeatmap(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMessagesParams) (res []ChatHistoryEntry, err error) {
+func (c *Client) sendGetTwitchUserActivityHeatmap(ctx context.Context, params GetTwitchUserActivityHeatmapParams) (res GetTwitchUserActivityHeatmapRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchMessages"),
+		otelogen.OperationID("getTwitchUserActivityHeatmap"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/messages"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users/{twitch_user_id}/heatmap"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6007,7 +6776,7 @@ func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchMessagesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTwitchUserActivityHeatmapOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6024,23 +6793,42 @@ func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/messages"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/users/"
+	{
+		// Encode "twitch_user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "twitch_user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.TwitchUserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/heatmap"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "limit" parameter.
+		// Encode "from" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
+			Name:    "from",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -6048,15 +6836,15 @@ func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMe
 		}
 	}
 	{
-		// Encode "cursor_created_at" parameter.
+		// Encode "to" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_created_at",
+			Name:    "to",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorCreatedAt.Get(); ok {
+			if val, ok := params.To.Get(); ok {
 				return e.EncodeValue(conv.DateTimeToString(val))
 			}
 			return nil
@@ -6065,67 +6853,271 @@ func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMe
 		}
 	}
 	{
-		// Encode "cursor_id" parameter.
+		// Encode "timezone" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor_id",
+			Name:    "timezone",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CursorID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
+			if val, ok := params.Timezone.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
-		// Encode "username" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "username",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTwitchUserActivityHeatmapOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Username.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetTwitchUserActivityHeatmapResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTwitchUserActivityTimeline invokes getTwitchUserActivityTimeline operation.
+//
+// POST /api/v1/twitch/users/activity/timeline
+func (c *Client) GetTwitchUserActivityTimeline(ctx context.Context, request *GetTwitchUserActivityTimelineRequest) (GetTwitchUserActivityTimelineRes, error) {
+	res, err := c.sendGetTwitchUserActivityTimeline(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetTwitchUserActivityTimeline(ctx context.Context, request *GetTwitchUserActivityTimelineRequest) (res GetTwitchUserActivityTimelineRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTwitchUserActivityTimeline"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users/activity/timeline"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTwitchUserActivityTimelineOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/users/activity/timeline"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetTwitchUserActivityTimelineRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
 	}
+
 	{
-		// Encode "text" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "text",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTwitchUserActivityTimelineOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Text.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetTwitchUserActivityTimelineResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTwitchUserEmoteUsage invokes getTwitchUserEmoteUsage operation.
+//
+// Emotes the user used most in monitored channels' chat.
+//
+// GET /api/v1/twitch/users/{twitch_user_id}/emotes
+func (c *Client) GetTwitchUserEmoteUsage(ctx context.Context, params GetTwitchUserEmoteUsageParams) (GetTwitchUserEmoteUsageRes, error) {
+	res, err := c.sendGetTwitchUserEmoteUsage(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTwitchUserEmoteUsage(ctx context.Context, params GetTwitchUserEmoteUsageParams) (res GetTwitchUserEmoteUsageRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTwitchUserEmoteUsage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users/{twitch_user_id}/emotes"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTwitchUserEmoteUsageOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/users/"
+	{
+		// Encode "twitch_user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "twitch_user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.TwitchUserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/emotes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "channel" parameter.
+		// Encode "from" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "channel",
+			Name:    "from",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Channel.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -6133,15 +7125,15 @@ func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMe
 		}
 	}
 	{
-		// Encode "created_from" parameter.
+		// Encode "to" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "created_from",
+			Name:    "to",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CreatedFrom.Get(); ok {
+			if val, ok := params.To.Get(); ok {
 				return e.EncodeValue(conv.DateTimeToString(val))
 			}
 			return nil
@@ -6150,53 +7142,5337 @@ func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMe
 		}
 	}
 	{
-		// Encode "created_to" parameter.
+		// Encode "limit" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "created_to",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CreatedTo.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
 	{
-		// Encode "chatter_user_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "chatter_user_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTwitchUserEmoteUsageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetTwitchUserEmoteUsageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTwitchUserProfile invokes getTwitchUserProfile operation.
+//
+// POST /api/v1/twitch/users/profile
+func (c *Client) GetTwitchUserProfile(ctx context.Context, request *GetTwitchUserProfileRequest) (GetTwitchUserProfileRes, error) {
+	res, err := c.sendGetTwitchUserProfile(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGetTwitchUserProfile(ctx context.Context, request *GetTwitchUserProfileRequest) (res GetTwitchUserProfileRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTwitchUserProfile"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users/profile"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTwitchUserProfileOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/users/profile"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGetTwitchUserProfileRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTwitchUserProfileOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetTwitchUserProfileResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetWatchUiHints invokes getWatchUiHints operation.
+//
+// GET /api/v1/twitch/watch/hints
+func (c *Client) GetWatchUiHints(ctx context.Context) (*WatchUiHints, error) {
+	res, err := c.sendGetWatchUiHints(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetWatchUiHints(ctx context.Context) (res *WatchUiHints, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getWatchUiHints"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/watch/hints"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetWatchUiHintsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/watch/hints"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetWatchUiHintsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetWatchUiHintsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListAiConversations invokes listAiConversations operation.
+//
+// GET /api/v1/ai/conversations
+func (c *Client) ListAiConversations(ctx context.Context) ([]AiConversation, error) {
+	res, err := c.sendListAiConversations(ctx)
+	return res, err
+}
+
+func (c *Client) sendListAiConversations(ctx context.Context) (res []AiConversation, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAiConversations"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/ai/conversations"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListAiConversationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/ai/conversations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListAiConversationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListAiConversationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListAiMessages invokes listAiMessages operation.
+//
+// GET /api/v1/ai/conversations/{conversationId}/messages
+func (c *Client) ListAiMessages(ctx context.Context, params ListAiMessagesParams) (ListAiMessagesRes, error) {
+	res, err := c.sendListAiMessages(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListAiMessages(ctx context.Context, params ListAiMessagesParams) (res ListAiMessagesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAiMessages"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/ai/conversations/{conversationId}/messages"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListAiMessagesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/ai/conversations/"
+	{
+		// Encode "conversationId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "conversationId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.ConversationId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/messages"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListAiMessagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListAiMessagesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListBlocklists invokes listBlocklists operation.
+//
+// GET /api/v1/settings/blocklists
+func (c *Client) ListBlocklists(ctx context.Context) ([]Blocklist, error) {
+	res, err := c.sendListBlocklists(ctx)
+	return res, err
+}
+
+func (c *Client) sendListBlocklists(ctx context.Context) (res []Blocklist, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listBlocklists"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/blocklists"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListBlocklistsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/blocklists"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListBlocklistsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListBlocklistsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListChannelBlacklist invokes listChannelBlacklist operation.
+//
+// GET /api/v1/settings/channel-blacklist
+func (c *Client) ListChannelBlacklist(ctx context.Context) ([]string, error) {
+	res, err := c.sendListChannelBlacklist(ctx)
+	return res, err
+}
+
+func (c *Client) sendListChannelBlacklist(ctx context.Context) (res []string, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChannelBlacklist"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/channel-blacklist"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListChannelBlacklistOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/channel-blacklist"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListChannelBlacklistOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListChannelBlacklistResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListChannelBotEstimates invokes listChannelBotEstimates operation.
+//
+// Estimated bot share of each live monitored channel from the latest bot detection run.
+//
+// GET /api/v1/twitch/bots/channels
+func (c *Client) ListChannelBotEstimates(ctx context.Context) ([]ChannelBotEstimate, error) {
+	res, err := c.sendListChannelBotEstimates(ctx)
+	return res, err
+}
+
+func (c *Client) sendListChannelBotEstimates(ctx context.Context) (res []ChannelBotEstimate, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChannelBotEstimates"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/bots/channels"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListChannelBotEstimatesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/bots/channels"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListChannelBotEstimatesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListChannelBotEstimatesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListChannelChatters invokes listChannelChatters operation.
+//
+// POST /api/v1/twitch/channels/chatters
+func (c *Client) ListChannelChatters(ctx context.Context, request *ListChannelChattersRequest) (ListChannelChattersRes, error) {
+	res, err := c.sendListChannelChatters(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendListChannelChatters(ctx context.Context, request *ListChannelChattersRequest) (res ListChannelChattersRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChannelChatters"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/channels/chatters"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListChannelChattersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/channels/chatters"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeListChannelChattersRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListChannelChattersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListChannelChattersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListChannelDiscoveryCandidates invokes listChannelDiscoveryCandidates operation.
+//
+// GET /api/v1/settings/channel-discovery/candidates
+func (c *Client) ListChannelDiscoveryCandidates(ctx context.Context) ([]DiscoveryCandidate, error) {
+	res, err := c.sendListChannelDiscoveryCandidates(ctx)
+	return res, err
+}
+
+func (c *Client) sendListChannelDiscoveryCandidates(ctx context.Context) (res []DiscoveryCandidate, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChannelDiscoveryCandidates"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/channel-discovery/candidates"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListChannelDiscoveryCandidatesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/channel-discovery/candidates"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListChannelDiscoveryCandidatesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListChannelDiscoveryCandidatesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListChatHistory invokes listChatHistory operation.
+//
+// GET /api/v1/twitch/chat/history
+func (c *Client) ListChatHistory(ctx context.Context, params ListChatHistoryParams) (ListChatHistoryRes, error) {
+	res, err := c.sendListChatHistory(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListChatHistory(ctx context.Context, params ListChatHistoryParams) (res ListChatHistoryRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChatHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/chat/history"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListChatHistoryOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/chat/history"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "channel" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "channel",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Channel))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListChatHistoryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListChatHistoryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListEmoteSets invokes listEmoteSets operation.
+//
+// GET /api/v1/settings/emote-sets
+func (c *Client) ListEmoteSets(ctx context.Context) ([]EmoteSet, error) {
+	res, err := c.sendListEmoteSets(ctx)
+	return res, err
+}
+
+func (c *Client) sendListEmoteSets(ctx context.Context) (res []EmoteSet, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listEmoteSets"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/emote-sets"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListEmoteSetsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/emote-sets"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListEmoteSetsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListEmoteSetsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListIrcMonitorJoinedHistory invokes listIrcMonitorJoinedHistory operation.
+//
+// Historical IRC joined channel counts (periodic samples).
+//
+// GET /api/v1/twitch/irc-monitor/joined-history
+func (c *Client) ListIrcMonitorJoinedHistory(ctx context.Context, params ListIrcMonitorJoinedHistoryParams) ([]IrcJoinedSample, error) {
+	res, err := c.sendListIrcMonitorJoinedHistory(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListIrcMonitorJoinedHistory(ctx context.Context, params ListIrcMonitorJoinedHistoryParams) (res []IrcJoinedSample, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listIrcMonitorJoinedHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/irc-monitor/joined-history"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListIrcMonitorJoinedHistoryOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/irc-monitor/joined-history"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "days" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "days",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Days.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListIrcMonitorJoinedHistoryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListIrcMonitorJoinedHistoryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListLikelyBots invokes listLikelyBots operation.
+//
+// Accounts present in many monitored channels at once that (almost) never chat, as flagged by the
+// periodic bot detection. Users stay listed for 7 days after they were last detected.
+//
+// GET /api/v1/twitch/bots
+func (c *Client) ListLikelyBots(ctx context.Context, params ListLikelyBotsParams) ([]LikelyBot, error) {
+	res, err := c.sendListLikelyBots(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListLikelyBots(ctx context.Context, params ListLikelyBotsParams) (res []LikelyBot, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listLikelyBots"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/bots"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListLikelyBotsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/bots"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListLikelyBotsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListLikelyBotsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListLoginPatterns invokes listLoginPatterns operation.
+//
+// GET /api/v1/settings/login-patterns
+func (c *Client) ListLoginPatterns(ctx context.Context) ([]LoginPattern, error) {
+	res, err := c.sendListLoginPatterns(ctx)
+	return res, err
+}
+
+func (c *Client) sendListLoginPatterns(ctx context.Context) (res []LoginPattern, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listLoginPatterns"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/login-patterns"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListLoginPatternsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/login-patterns"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListLoginPatternsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListLoginPatternsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListNotificationDeliveries invokes listNotificationDeliveries operation.
+//
+// Notification outbox delivery log (newest first) with per-attempt status codes, response snippets
+// and errors.
+// Cursor-based incremental loading like listNotifications.
+//
+// GET /api/v1/settings/notifications/deliveries
+func (c *Client) ListNotificationDeliveries(ctx context.Context, params ListNotificationDeliveriesParams) ([]NotificationDelivery, error) {
+	res, err := c.sendListNotificationDeliveries(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListNotificationDeliveries(ctx context.Context, params ListNotificationDeliveriesParams) (res []NotificationDelivery, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listNotificationDeliveries"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications/deliveries"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListNotificationDeliveriesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/notifications/deliveries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "notification_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "notification_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.NotificationID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_created_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorCreatedAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListNotificationDeliveriesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListNotificationDeliveriesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListNotificationSnoozes invokes listNotificationSnoozes operation.
+//
+// Active snoozes, soonest expiry first.
+//
+// GET /api/v1/settings/notifications/snoozes
+func (c *Client) ListNotificationSnoozes(ctx context.Context) ([]NotificationSnooze, error) {
+	res, err := c.sendListNotificationSnoozes(ctx)
+	return res, err
+}
+
+func (c *Client) sendListNotificationSnoozes(ctx context.Context) (res []NotificationSnooze, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listNotificationSnoozes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications/snoozes"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListNotificationSnoozesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/notifications/snoozes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListNotificationSnoozesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListNotificationSnoozesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListNotifications invokes listNotifications operation.
+//
+// List notification entries (newest first) with cursor-based incremental loading.
+//
+// GET /api/v1/settings/notifications
+func (c *Client) ListNotifications(ctx context.Context, params ListNotificationsParams) ([]NotificationEntry, error) {
+	res, err := c.sendListNotifications(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListNotifications(ctx context.Context, params ListNotificationsParams) (res []NotificationEntry, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listNotifications"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListNotificationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/notifications"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_created_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorCreatedAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListNotificationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListNotificationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRecordedStreamActivity invokes listRecordedStreamActivity operation.
+//
+// Non-message activity in the stream time window (newest first).
+//
+// GET /api/v1/twitch/streams/{streamId}/activity
+func (c *Client) ListRecordedStreamActivity(ctx context.Context, params ListRecordedStreamActivityParams) (ListRecordedStreamActivityRes, error) {
+	res, err := c.sendListRecordedStreamActivity(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRecordedStreamActivity(ctx context.Context, params ListRecordedStreamActivityParams) (res ListRecordedStreamActivityRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRecordedStreamActivity"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/activity"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRecordedStreamActivityOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/streams/"
+	{
+		// Encode "streamId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "streamId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.StreamId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/activity"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_created_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorCreatedAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRecordedStreamActivityOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRecordedStreamActivityResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRecordedStreamMessages invokes listRecordedStreamMessages operation.
+//
+// Chat messages tagged with this stream (newest first).
+//
+// GET /api/v1/twitch/streams/{streamId}/messages
+func (c *Client) ListRecordedStreamMessages(ctx context.Context, params ListRecordedStreamMessagesParams) (ListRecordedStreamMessagesRes, error) {
+	res, err := c.sendListRecordedStreamMessages(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRecordedStreamMessages(ctx context.Context, params ListRecordedStreamMessagesParams) (res ListRecordedStreamMessagesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRecordedStreamMessages"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/streams/{streamId}/messages"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRecordedStreamMessagesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/streams/"
+	{
+		// Encode "streamId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "streamId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.StreamId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/messages"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_created_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorCreatedAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "username" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "username",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Username.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "text" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "text",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Text.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "chatter_user_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "chatter_user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ChatterUserID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRecordedStreamMessagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRecordedStreamMessagesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRecordedStreams invokes listRecordedStreams operation.
+//
+// Recorded stream sessions for monitored channels (newest first).
+//
+// GET /api/v1/twitch/streams
+func (c *Client) ListRecordedStreams(ctx context.Context, params ListRecordedStreamsParams) ([]RecordedStream, error) {
+	res, err := c.sendListRecordedStreams(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRecordedStreams(ctx context.Context, params ListRecordedStreamsParams) (res []RecordedStream, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRecordedStreams"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/streams"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRecordedStreamsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/streams"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "channel_login" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "channel_login",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ChannelLogin.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_started_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_started_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorStartedAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRecordedStreamsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRecordedStreamsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRuleTemplateVariables invokes listRuleTemplateVariables operation.
+//
+// Names and descriptions for `$NAME` placeholders in notify and send_chat message templates.
+//
+// GET /api/v1/settings/rules/template-variables
+func (c *Client) ListRuleTemplateVariables(ctx context.Context) (*RuleTemplateVariablesResponse, error) {
+	res, err := c.sendListRuleTemplateVariables(ctx)
+	return res, err
+}
+
+func (c *Client) sendListRuleTemplateVariables(ctx context.Context) (res *RuleTemplateVariablesResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRuleTemplateVariables"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/rules/template-variables"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRuleTemplateVariablesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/rules/template-variables"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRuleTemplateVariablesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRuleTemplateVariablesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRuleTriggers invokes listRuleTriggers operation.
+//
+// List rule trigger events (newest first) with cursor-based incremental loading.
+//
+// GET /api/v1/settings/rule-triggers
+func (c *Client) ListRuleTriggers(ctx context.Context, params ListRuleTriggersParams) ([]RuleTrigger, error) {
+	res, err := c.sendListRuleTriggers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRuleTriggers(ctx context.Context, params ListRuleTriggersParams) (res []RuleTrigger, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRuleTriggers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/rule-triggers"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRuleTriggersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/rule-triggers"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_created_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorCreatedAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRuleTriggersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRuleTriggersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRules invokes listRules operation.
+//
+// GET /api/v1/settings/rules
+func (c *Client) ListRules(ctx context.Context) ([]Rule, error) {
+	res, err := c.sendListRules(ctx)
+	return res, err
+}
+
+func (c *Client) sendListRules(ctx context.Context) (res []Rule, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRules"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/rules"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRulesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/rules"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRulesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRulesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListSuspicionEvents invokes listSuspicionEvents operation.
+//
+// Suspicion audit log (newest first) with filters and cursor-based incremental loading.
+//
+// GET /api/v1/twitch/suspicion-events
+func (c *Client) ListSuspicionEvents(ctx context.Context, params ListSuspicionEventsParams) ([]SuspicionEvent, error) {
+	res, err := c.sendListSuspicionEvents(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListSuspicionEvents(ctx context.Context, params ListSuspicionEventsParams) (res []SuspicionEvent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listSuspicionEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/suspicion-events"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListSuspicionEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/suspicion-events"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "twitch_user_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "twitch_user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.TwitchUserID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "source" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "source",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Source.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "new_is_sus" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "new_is_sus",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.NewIsSus.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_created_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorCreatedAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListSuspicionEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListSuspicionEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTwitchAccounts invokes listTwitchAccounts operation.
+//
+// GET /api/v1/settings/twitch-accounts
+func (c *Client) ListTwitchAccounts(ctx context.Context) ([]TwitchAccount, error) {
+	res, err := c.sendListTwitchAccounts(ctx)
+	return res, err
+}
+
+func (c *Client) sendListTwitchAccounts(ctx context.Context) (res []TwitchAccount, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchAccounts"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchAccountsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/twitch-accounts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTwitchAccountsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTwitchAccountsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTwitchDirectoryUsers invokes listTwitchDirectoryUsers operation.
+//
+// List known Twitch users (chatters and channels) for directory search.
+//
+// GET /api/v1/twitch/users
+func (c *Client) ListTwitchDirectoryUsers(ctx context.Context, params ListTwitchDirectoryUsersParams) ([]TwitchUser, error) {
+	res, err := c.sendListTwitchDirectoryUsers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTwitchDirectoryUsers(ctx context.Context, params ListTwitchDirectoryUsersParams) (res []TwitchUser, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchDirectoryUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchDirectoryUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "username" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "username",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Username.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "monitored_only" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "monitored_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MonitoredOnly.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTwitchDirectoryUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTwitchDirectoryUsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTwitchMessages invokes listTwitchMessages operation.
+//
+// Search persisted chat messages (newest first). Omit filters to list recent messages.
+//
+// GET /api/v1/twitch/messages
+func (c *Client) ListTwitchMessages(ctx context.Context, params ListTwitchMessagesParams) ([]ChatHistoryEntry, error) {
+	res, err := c.sendListTwitchMessages(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMessagesParams) (res []ChatHistoryEntry, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchMessages"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/messages"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchMessagesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/messages"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_created_at" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorCreatedAt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CursorID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "username" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "username",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Username.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "text" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "text",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Text.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "channel" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "channel",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Channel.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "created_from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "created_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreatedFrom.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "created_to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "created_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CreatedTo.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "chatter_user_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "chatter_user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ChatterUserID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTwitchMessagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTwitchMessagesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTwitchUserActivity invokes listTwitchUserActivity operation.
+//
+// POST /api/v1/twitch/users/activity
+func (c *Client) ListTwitchUserActivity(ctx context.Context, request *ListTwitchUserActivityRequest) (ListTwitchUserActivityRes, error) {
+	res, err := c.sendListTwitchUserActivity(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendListTwitchUserActivity(ctx context.Context, request *ListTwitchUserActivityRequest) (res ListTwitchUserActivityRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchUserActivity"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users/activity"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchUserActivityOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/users/activity"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeListTwitchUserActivityRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTwitchUserActivityOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTwitchUserActivityResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTwitchUsers invokes listTwitchUsers operation.
+//
+// GET /api/v1/settings/twitch-users
+func (c *Client) ListTwitchUsers(ctx context.Context, params ListTwitchUsersParams) ([]TwitchUser, error) {
+	res, err := c.sendListTwitchUsers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTwitchUsers(ctx context.Context, params ListTwitchUsersParams) (res []TwitchUser, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/settings/twitch-users"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/twitch-users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "monitored_only" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "monitored_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MonitoredOnly.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTwitchUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTwitchUsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Login invokes login operation.
+//
+// POST /api/v1/auth/login
+func (c *Client) Login(ctx context.Context, request *LoginRequest) (LoginRes, error) {
+	res, err := c.sendLogin(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res LoginRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/auth/login"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLoginRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Me invokes me operation.
+//
+// GET /api/v1/me
+func (c *Client) Me(ctx context.Context) (MeRes, error) {
+	res, err := c.sendMe(ctx)
+	return res, err
+}
+
+func (c *Client) sendMe(ctx context.Context) (res MeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("me"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/me"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/me"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PatchAiSettings invokes patchAiSettings operation.
+//
+// PATCH /api/v1/ai/settings
+func (c *Client) PatchAiSettings(ctx context.Context, request *PatchAiSettingsRequest) (*AiSettings, error) {
+	res, err := c.sendPatchAiSettings(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPatchAiSettings(ctx context.Context, request *PatchAiSettingsRequest) (res *AiSettings, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("patchAiSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/v1/ai/settings"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PatchAiSettingsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/ai/settings"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePatchAiSettingsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PatchAiSettingsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePatchAiSettingsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PreviewRuleNotify invokes previewRuleNotify operation.
+//
+// Expands a notify action's `text` template (or the engine default when empty) for a sample event.
+// Sample fields that are omitted use placeholder values.
+//
+// POST /api/v1/settings/rules/notify-preview
+func (c *Client) PreviewRuleNotify(ctx context.Context, request *PreviewRuleNotifyRequest) (PreviewRuleNotifyRes, error) {
+	res, err := c.sendPreviewRuleNotify(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPreviewRuleNotify(ctx context.Context, request *PreviewRuleNotifyRequest) (res PreviewRuleNotifyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("previewRuleNotify"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/rules/notify-preview"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PreviewRuleNotifyOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/rules/notify-preview"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePreviewRuleNotifyRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PreviewRuleNotifyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePreviewRuleNotifyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ResendNotificationDelivery invokes resendNotificationDelivery operation.
+//
+// Requeue a delivery (typically dead) as pending with a fresh retry budget; earlier attempts stay in
+// the log.
+//
+// POST /api/v1/settings/notifications/deliveries/resend
+func (c *Client) ResendNotificationDelivery(ctx context.Context, request *ResendNotificationDeliveryRequest) (ResendNotificationDeliveryRes, error) {
+	res, err := c.sendResendNotificationDelivery(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendResendNotificationDelivery(ctx context.Context, request *ResendNotificationDeliveryRequest) (res ResendNotificationDeliveryRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("resendNotificationDelivery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications/deliveries/resend"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ResendNotificationDeliveryOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/notifications/deliveries/resend"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeResendNotificationDeliveryRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ResendNotificationDeliveryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeResendNotificationDeliveryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SaveEmoteSet invokes saveEmoteSet operation.
+//
+// Creates a third-party emote set or replaces the codes of the set with the same name. Words of
+// incoming chat
+// messages equal to a code (case-sensitive) are stored as emote occurrences; sets scoped to a
+// channel win over
+// global ones. The IRC monitor reloads sets every minute and stored messages are not re-scanned.
+//
+// POST /api/v1/settings/emote-sets
+func (c *Client) SaveEmoteSet(ctx context.Context, request *SaveEmoteSetRequest) (SaveEmoteSetRes, error) {
+	res, err := c.sendSaveEmoteSet(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSaveEmoteSet(ctx context.Context, request *SaveEmoteSetRequest) (res SaveEmoteSetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("saveEmoteSet"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/emote-sets"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SaveEmoteSetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/emote-sets"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSaveEmoteSetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SaveEmoteSetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSaveEmoteSetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ScanLikelyBots invokes scanLikelyBots operation.
+//
+// Runs bot detection now instead of waiting for the next periodic run.
+//
+// POST /api/v1/twitch/bots/scan
+func (c *Client) ScanLikelyBots(ctx context.Context) error {
+	_, err := c.sendScanLikelyBots(ctx)
+	return err
+}
+
+func (c *Client) sendScanLikelyBots(ctx context.Context) (res *ScanLikelyBotsNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scanLikelyBots"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/bots/scan"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ScanLikelyBotsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/bots/scan"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ScanLikelyBotsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeScanLikelyBotsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ScanTwitchUserAlts invokes scanTwitchUserAlts operation.
+//
+// Re-scores the user's possible alts now instead of waiting for the hourly alt-detection run.
+//
+// POST /api/v1/twitch/users/{twitch_user_id}/alts/scan
+func (c *Client) ScanTwitchUserAlts(ctx context.Context, params ScanTwitchUserAltsParams) (ScanTwitchUserAltsRes, error) {
+	res, err := c.sendScanTwitchUserAlts(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendScanTwitchUserAlts(ctx context.Context, params ScanTwitchUserAltsParams) (res ScanTwitchUserAltsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scanTwitchUserAlts"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users/{twitch_user_id}/alts/scan"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ScanTwitchUserAltsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/twitch/users/"
+	{
+		// Encode "twitch_user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "twitch_user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.TwitchUserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/alts/scan"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ScanTwitchUserAltsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeScanTwitchUserAltsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SendMessage invokes sendMessage operation.
+//
+// POST /api/v1/twitch/send
+func (c *Client) SendMessage(ctx context.Context, request *SendMessageRequest) (SendMessageRes, error) {
+	res, err := c.sendSendMessage(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSendMessage(ctx context.Context, request *SendMessageRequest) (res SendMessageRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("sendMessage"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/send"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SendMessageOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/send"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSendMessageRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SendMessageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSendMessageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SetChannelBlacklist invokes setChannelBlacklist operation.
+//
+// POST /api/v1/settings/channel-blacklist
+func (c *Client) SetChannelBlacklist(ctx context.Context, request *ChannelBlacklistChange) (SetChannelBlacklistRes, error) {
+	res, err := c.sendSetChannelBlacklist(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSetChannelBlacklist(ctx context.Context, request *ChannelBlacklistChange) (res SetChannelBlacklistRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("setChannelBlacklist"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/channel-blacklist"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SetChannelBlacklistOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/channel-blacklist"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSetChannelBlacklistRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SetChannelBlacklistOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSetChannelBlacklistResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SetTwitchUserLink invokes setTwitchUserLink operation.
+//
+// Confirms or rejects that two users are the same person.
+//
+// POST /api/v1/twitch/users/links
+func (c *Client) SetTwitchUserLink(ctx context.Context, request *SetTwitchUserLinkRequest) (SetTwitchUserLinkRes, error) {
+	res, err := c.sendSetTwitchUserLink(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendSetTwitchUserLink(ctx context.Context, request *SetTwitchUserLinkRequest) (res SetTwitchUserLinkRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("setTwitchUserLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/twitch/users/links"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SetTwitchUserLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/twitch/users/links"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSetTwitchUserLinkRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SetTwitchUserLinkOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSetTwitchUserLinkResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// StartSuspicionReevaluation invokes startSuspicionReevaluation operation.
+//
+// Re-scores every known user from cached enrichment data in the background. Progress is also pushed
+// over /ws as suspicion_reevaluation messages. A request made while a run is in progress is queued.
+//
+// POST /api/v1/settings/suspicion-settings/reevaluate
+func (c *Client) StartSuspicionReevaluation(ctx context.Context, request OptStartSuspicionReevaluationRequest) (*SuspicionReevaluation, error) {
+	res, err := c.sendStartSuspicionReevaluation(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendStartSuspicionReevaluation(ctx context.Context, request OptStartSuspicionReevaluationRequest) (res *SuspicionReevaluation, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("startSuspicionReevaluation"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/suspicion-settings/reevaluate"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, StartSuspicionReevaluationOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ChatterUserID.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/suspicion-settings/reevaluate"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeStartSuspicionReevaluationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTwitchMessagesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StartSuspicionReevaluationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6233,7 +12509,7 @@ func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMe
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTwitchMessagesResponse(resp)
+	result, err := decodeStartSuspicionReevaluationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6241,19 +12517,21 @@ func (c *Client) sendListTwitchMessages(ctx context.Context, params ListTwitchMe
 	return result, nil
 }
 
-// ListTwitchUserActivity invokes listTwitchUserActivity operation.
+// StartTwitchOAuth invokes startTwitchOAuth operation.
 //
-// POST /api/v1/twitch/users/activity
-func (c *Client) ListTwitchUserActivity(ctx context.Context, request *ListTwitchUserActivityRequest) (ListTwitchUserActivityRes, error) {
-	res, err := c.sendListTwitchUserActivity(ctx, request)
+// Start Twitch authorization (browser) to link an account without pasting a refresh token.
+//
+// POST /api/v1/settings/twitch-accounts/oauth/start
+func (c *Client) StartTwitchOAuth(ctx context.Context, request OptStartTwitchOAuthRequest) (*StartTwitchOAuthResponse, error) {
+	res, err := c.sendStartTwitchOAuth(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListTwitchUserActivity(ctx context.Context, request *ListTwitchUserActivityRequest) (res ListTwitchUserActivityRes, err error) {
+func (c *Client) sendStartTwitchOAuth(ctx context.Context, request OptStartTwitchOAuthRequest) (res *StartTwitchOAuthResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchUserActivity"),
+		otelogen.OperationID("startTwitchOAuth"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/users/activity"),
+		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts/oauth/start"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6269,7 +12547,7 @@ func (c *Client) sendListTwitchUserActivity(ctx context.Context, request *ListTw
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchUserActivityOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StartTwitchOAuthOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6287,7 +12565,7 @@ func (c *Client) sendListTwitchUserActivity(ctx context.Context, request *ListTw
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/users/activity"
+	pathParts[0] = "/api/v1/settings/twitch-accounts/oauth/start"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6295,7 +12573,7 @@ func (c *Client) sendListTwitchUserActivity(ctx context.Context, request *ListTw
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeListTwitchUserActivityRequest(request, r); err != nil {
+	if err := encodeStartTwitchOAuthRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -6304,7 +12582,7 @@ func (c *Client) sendListTwitchUserActivity(ctx context.Context, request *ListTw
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTwitchUserActivityOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StartTwitchOAuthOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6341,7 +12619,7 @@ func (c *Client) sendListTwitchUserActivity(ctx context.Context, request *ListTw
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTwitchUserActivityResponse(resp)
+	result, err := decodeStartTwitchOAuthResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6349,19 +12627,19 @@ func (c *Client) sendListTwitchUserActivity(ctx context.Context, request *ListTw
 	return result, nil
 }
 
-// ListTwitchUsers invokes listTwitchUsers operation.
+// StopAiAgent invokes stopAiAgent operation.
 //
-// GET /api/v1/settings/twitch-users
-func (c *Client) ListTwitchUsers(ctx context.Context, params ListTwitchUsersParams) ([]TwitchUser, error) {
-	res, err := c.sendListTwitchUsers(ctx, params)
+// POST /api/v1/ai/conversations/{conversationId}/stop
+func (c *Client) StopAiAgent(ctx context.Context, params StopAiAgentParams) (StopAiAgentRes, error) {
+	res, err := c.sendStopAiAgent(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListTwitchUsers(ctx context.Context, params ListTwitchUsersParams) (res []TwitchUser, err error) {
+func (c *Client) sendStopAiAgent(ctx context.Context, params StopAiAgentParams) (res StopAiAgentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchUsers"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/settings/twitch-users"),
+		otelogen.OperationID("stopAiAgent"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/ai/conversations/{conversationId}/stop"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6377,7 +12655,7 @@ func (c *Client) sendListTwitchUsers(ctx context.Context, params ListTwitchUsers
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTwitchUsersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, StopAiAgentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6394,33 +12672,31 @@ func (c *Client) sendListTwitchUsers(ctx context.Context, params ListTwitchUsers
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/twitch-users"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/ai/conversations/"
 	{
-		// Encode "monitored_only" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "monitored_only",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "conversationId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "conversationId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.ConversationId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.MonitoredOnly.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/stop"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -6430,7 +12706,7 @@ func (c *Client) sendListTwitchUsers(ctx context.Context, params ListTwitchUsers
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTwitchUsersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, StopAiAgentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6467,7 +12743,7 @@ func (c *Client) sendListTwitchUsers(ctx context.Context, params ListTwitchUsers
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTwitchUsersResponse(resp)
+	result, err := decodeStopAiAgentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6475,19 +12751,23 @@ func (c *Client) sendListTwitchUsers(ctx context.Context, params ListTwitchUsers
 	return result, nil
 }
 
-// Login invokes login operation.
+// SyncBlocklist invokes syncBlocklist operation.
 //
-// POST /api/v1/auth/login
-func (c *Client) Login(ctx context.Context, request *LoginRequest) (LoginRes, error) {
-	res, err := c.sendLogin(ctx, request)
+// Pulls a list's `source_url` now. Download and parse failures are reported in `last_sync_error` of
+// the
+// returned list; unchanged content keeps the current entries.
+//
+// POST /api/v1/settings/blocklists/sync
+func (c *Client) SyncBlocklist(ctx context.Context, request *SyncBlocklistRequest) (SyncBlocklistRes, error) {
+	res, err := c.sendSyncBlocklist(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res LoginRes, err error) {
+func (c *Client) sendSyncBlocklist(ctx context.Context, request *SyncBlocklistRequest) (res SyncBlocklistRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("login"),
+		otelogen.OperationID("syncBlocklist"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/auth/login"),
+		semconv.URLTemplateKey.String("/api/v1/settings/blocklists/sync"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6503,7 +12783,7 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LoginOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SyncBlocklistOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6521,7 +12801,7 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/login"
+	pathParts[0] = "/api/v1/settings/blocklists/sync"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6529,10 +12809,43 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeLoginRequest(request, r); err != nil {
+	if err := encodeSyncBlocklistRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SyncBlocklistOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -6542,7 +12855,7 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeLoginResponse(resp)
+	result, err := decodeSyncBlocklistResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6550,19 +12863,22 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 	return result, nil
 }
 
-// Me invokes me operation.
+// TestLoginPattern invokes testLoginPattern operation.
 //
-// GET /api/v1/me
-func (c *Client) Me(ctx context.Context) (MeRes, error) {
-	res, err := c.sendMe(ctx)
+// Checks a pattern against sample logins without saving it; shows how the pattern and each login are
+// normalized before matching.
+//
+// POST /api/v1/settings/login-patterns/test
+func (c *Client) TestLoginPattern(ctx context.Context, request *TestLoginPatternRequest) (*TestLoginPatternResponse, error) {
+	res, err := c.sendTestLoginPattern(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendMe(ctx context.Context) (res MeRes, err error) {
+func (c *Client) sendTestLoginPattern(ctx context.Context, request *TestLoginPatternRequest) (res *TestLoginPatternResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("me"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/me"),
+		otelogen.OperationID("testLoginPattern"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/login-patterns/test"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6578,7 +12894,7 @@ func (c *Client) sendMe(ctx context.Context) (res MeRes, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TestLoginPatternOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6596,21 +12912,24 @@ func (c *Client) sendMe(ctx context.Context) (res MeRes, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/me"
+	pathParts[0] = "/api/v1/settings/login-patterns/test"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeTestLoginPatternRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MeOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TestLoginPatternOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6647,7 +12966,7 @@ func (c *Client) sendMe(ctx context.Context) (res MeRes, err error) {
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMeResponse(resp)
+	result, err := decodeTestLoginPatternResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6655,19 +12974,24 @@ func (c *Client) sendMe(ctx context.Context) (res MeRes, err error) {
 	return result, nil
 }
 
-// PatchAiSettings invokes patchAiSettings operation.
+// TestNotification invokes testNotification operation.
 //
-// PATCH /api/v1/ai/settings
-func (c *Client) PatchAiSettings(ctx context.Context, request *PatchAiSettingsRequest) (*AiSettings, error) {
-	res, err := c.sendPatchAiSettings(ctx, request)
+// Renders a sample event of each type (or only `event_types`) and sends it through the entry's
+// provider
+// synchronously, bypassing the outbox, snoozes and the entry's policy. Works for disabled entries.
+// Tokens and URL credentials from the entry settings are redacted from responses and errors.
+//
+// POST /api/v1/settings/notifications/{id}/test
+func (c *Client) TestNotification(ctx context.Context, request OptTestNotificationRequest, params TestNotificationParams) (TestNotificationRes, error) {
+	res, err := c.sendTestNotification(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPatchAiSettings(ctx context.Context, request *PatchAiSettingsRequest) (res *AiSettings, err error) {
+func (c *Client) sendTestNotification(ctx context.Context, request OptTestNotificationRequest, params TestNotificationParams) (res TestNotificationRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("patchAiSettings"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.URLTemplateKey.String("/api/v1/ai/settings"),
+		otelogen.OperationID("testNotification"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications/{id}/test"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6683,7 +13007,7 @@ func (c *Client) sendPatchAiSettings(ctx context.Context, request *PatchAiSettin
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PatchAiSettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TestNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6700,16 +13024,35 @@ func (c *Client) sendPatchAiSettings(ctx context.Context, request *PatchAiSettin
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/ai/settings"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/settings/notifications/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.Int64ToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/test"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePatchAiSettingsRequest(request, r); err != nil {
+	if err := encodeTestNotificationRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -6718,7 +13061,7 @@ func (c *Client) sendPatchAiSettings(ctx context.Context, request *PatchAiSettin
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PatchAiSettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TestNotificationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6755,7 +13098,7 @@ func (c *Client) sendPatchAiSettings(ctx context.Context, request *PatchAiSettin
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePatchAiSettingsResponse(resp)
+	result, err := decodeTestNotificationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6763,19 +13106,19 @@ func (c *Client) sendPatchAiSettings(ctx context.Context, request *PatchAiSettin
 	return result, nil
 }
 
-// SendMessage invokes sendMessage operation.
+// TestRuleRegex invokes testRuleRegex operation.
 //
-// POST /api/v1/twitch/send
-func (c *Client) SendMessage(ctx context.Context, request *SendMessageRequest) (SendMessageRes, error) {
-	res, err := c.sendSendMessage(ctx, request)
+// POST /api/v1/settings/rules/test-regex
+func (c *Client) TestRuleRegex(ctx context.Context, request *TestRuleRegexRequest) (*TestRuleRegexResponse, error) {
+	res, err := c.sendTestRuleRegex(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendSendMessage(ctx context.Context, request *SendMessageRequest) (res SendMessageRes, err error) {
+func (c *Client) sendTestRuleRegex(ctx context.Context, request *TestRuleRegexRequest) (res *TestRuleRegexResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("sendMessage"),
+		otelogen.OperationID("testRuleRegex"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/twitch/send"),
+		semconv.URLTemplateKey.String("/api/v1/settings/rules/test-regex"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6791,7 +13134,7 @@ func (c *Client) sendSendMessage(ctx context.Context, request *SendMessageReques
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SendMessageOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TestRuleRegexOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6809,7 +13152,7 @@ func (c *Client) sendSendMessage(ctx context.Context, request *SendMessageReques
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/twitch/send"
+	pathParts[0] = "/api/v1/settings/rules/test-regex"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6817,7 +13160,7 @@ func (c *Client) sendSendMessage(ctx context.Context, request *SendMessageReques
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSendMessageRequest(request, r); err != nil {
+	if err := encodeTestRuleRegexRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -6826,7 +13169,7 @@ func (c *Client) sendSendMessage(ctx context.Context, request *SendMessageReques
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SendMessageOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TestRuleRegexOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6863,7 +13206,7 @@ func (c *Client) sendSendMessage(ctx context.Context, request *SendMessageReques
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSendMessageResponse(resp)
+	result, err := decodeTestRuleRegexResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6871,19 +13214,19 @@ func (c *Client) sendSendMessage(ctx context.Context, request *SendMessageReques
 	return result, nil
 }
 
-// SetChannelBlacklist invokes setChannelBlacklist operation.
+// UpdateBotDetectionSettings invokes updateBotDetectionSettings operation.
 //
-// POST /api/v1/settings/channel-blacklist
-func (c *Client) SetChannelBlacklist(ctx context.Context, request *ChannelBlacklistChange) (SetChannelBlacklistRes, error) {
-	res, err := c.sendSetChannelBlacklist(ctx, request)
+// PATCH /api/v1/settings/bot-detection
+func (c *Client) UpdateBotDetectionSettings(ctx context.Context, request *BotDetectionSettings) (UpdateBotDetectionSettingsRes, error) {
+	res, err := c.sendUpdateBotDetectionSettings(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendSetChannelBlacklist(ctx context.Context, request *ChannelBlacklistChange) (res SetChannelBlacklistRes, err error) {
+func (c *Client) sendUpdateBotDetectionSettings(ctx context.Context, request *BotDetectionSettings) (res UpdateBotDetectionSettingsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setChannelBlacklist"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/channel-blacklist"),
+		otelogen.OperationID("updateBotDetectionSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/v1/settings/bot-detection"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -6899,7 +13242,7 @@ func (c *Client) sendSetChannelBlacklist(ctx context.Context, request *ChannelBl
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SetChannelBlacklistOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateBotDetectionSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6917,15 +13260,15 @@ func (c *Client) sendSetChannelBlacklist(ctx context.Context, request *ChannelBl
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/channel-blacklist"
+	pathParts[0] = "/api/v1/settings/bot-detection"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetChannelBlacklistRequest(request, r); err != nil {
+	if err := encodeUpdateBotDetectionSettingsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -6934,7 +13277,7 @@ func (c *Client) sendSetChannelBlacklist(ctx context.Context, request *ChannelBl
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SetChannelBlacklistOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateBotDetectionSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6971,29 +13314,27 @@ func (c *Client) sendSetChannelBlacklist(ctx context.Context, request *ChannelBl
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetChannelBlacklistResponse(resp)
+	result, err := decodeUpdateBotDetectionSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
 
 	return result, nil
-}
-
-// StartTwitchOAuth invokes startTwitchOAuth operation.
-//
-// Start Twitch authorization (browser) to link an account without pasting a refresh token.
+}
+
+// UpdateChannelDiscoverySettings invokes updateChannelDiscoverySettings operation.
 //
-// POST /api/v1/settings/twitch-accounts/oauth/start
-func (c *Client) StartTwitchOAuth(ctx context.Context, request OptStartTwitchOAuthRequest) (*StartTwitchOAuthResponse, error) {
-	res, err := c.sendStartTwitchOAuth(ctx, request)
+// PATCH /api/v1/settings/channel-discovery
+func (c *Client) UpdateChannelDiscoverySettings(ctx context.Context, request *ChannelDiscoverySettings) (UpdateChannelDiscoverySettingsRes, error) {
+	res, err := c.sendUpdateChannelDiscoverySettings(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendStartTwitchOAuth(ctx context.Context, request OptStartTwitchOAuthRequest) (res *StartTwitchOAuthResponse, err error) {
+func (c *Client) sendUpdateChannelDiscoverySettings(ctx context.Context, request *ChannelDiscoverySettings) (res UpdateChannelDiscoverySettingsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("startTwitchOAuth"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts/oauth/start"),
+		otelogen.OperationID("updateChannelDiscoverySettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/v1/settings/channel-discovery"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7009,7 +13350,7 @@ func (c *Client) sendStartTwitchOAuth(ctx context.Context, request OptStartTwitc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StartTwitchOAuthOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateChannelDiscoverySettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7027,15 +13368,15 @@ func (c *Client) sendStartTwitchOAuth(ctx context.Context, request OptStartTwitc
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/twitch-accounts/oauth/start"
+	pathParts[0] = "/api/v1/settings/channel-discovery"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeStartTwitchOAuthRequest(request, r); err != nil {
+	if err := encodeUpdateChannelDiscoverySettingsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7044,7 +13385,7 @@ func (c *Client) sendStartTwitchOAuth(ctx context.Context, request OptStartTwitc
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StartTwitchOAuthOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateChannelDiscoverySettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7081,7 +13422,7 @@ func (c *Client) sendStartTwitchOAuth(ctx context.Context, request OptStartTwitc
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStartTwitchOAuthResponse(resp)
+	result, err := decodeUpdateChannelDiscoverySettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7089,19 +13430,19 @@ func (c *Client) sendStartTwitchOAuth(ctx context.Context, request OptStartTwitc
 	return result, nil
 }
 
-// StopAiAgent invokes stopAiAgent operation.
+// UpdateChatClassifierSettings invokes updateChatClassifierSettings operation.
 //
-// POST /api/v1/ai/conversations/{conversationId}/stop
-func (c *Client) StopAiAgent(ctx context.Context, params StopAiAgentParams) (StopAiAgentRes, error) {
-	res, err := c.sendStopAiAgent(ctx, params)
+// PATCH /api/v1/settings/chat-classifier
+func (c *Client) UpdateChatClassifierSettings(ctx context.Context, request *ChatClassifierSettings) (UpdateChatClassifierSettingsRes, error) {
+	res, err := c.sendUpdateChatClassifierSettings(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendStopAiAgent(ctx context.Context, params StopAiAgentParams) (res StopAiAgentRes, err error) {
+func (c *Client) sendUpdateChatClassifierSettings(ctx context.Context, request *ChatClassifierSettings) (res UpdateChatClassifierSettingsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("stopAiAgent"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/ai/conversations/{conversationId}/stop"),
+		otelogen.OperationID("updateChatClassifierSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/v1/settings/chat-classifier"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7117,7 +13458,7 @@ func (c *Client) sendStopAiAgent(ctx context.Context, params StopAiAgentParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, StopAiAgentOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateChatClassifierSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7134,41 +13475,25 @@ func (c *Client) sendStopAiAgent(ctx context.Context, params StopAiAgentParams)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/ai/conversations/"
-	{
-		// Encode "conversationId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "conversationId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.Int64ToString(params.ConversationId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/stop"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/settings/chat-classifier"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateChatClassifierSettingsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, StopAiAgentOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateChatClassifierSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7205,7 +13530,7 @@ func (c *Client) sendStopAiAgent(ctx context.Context, params StopAiAgentParams)
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeStopAiAgentResponse(resp)
+	result, err := decodeUpdateChatClassifierSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7213,19 +13538,19 @@ func (c *Client) sendStopAiAgent(ctx context.Context, params StopAiAgentParams)
 	return result, nil
 }
 
-// TestRuleRegex invokes testRuleRegex operation.
+// UpdateIrcMonitorSettings invokes updateIrcMonitorSettings operation.
 //
-// POST /api/v1/settings/rules/test-regex
-func (c *Client) TestRuleRegex(ctx context.Context, request *TestRuleRegexRequest) (*TestRuleRegexResponse, error) {
-	res, err := c.sendTestRuleRegex(ctx, request)
+// PATCH /api/v1/settings/irc-monitor-settings
+func (c *Client) UpdateIrcMonitorSettings(ctx context.Context, request *IrcMonitorSettings) (*IrcMonitorSettings, error) {
+	res, err := c.sendUpdateIrcMonitorSettings(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendTestRuleRegex(ctx context.Context, request *TestRuleRegexRequest) (res *TestRuleRegexResponse, err error) {
+func (c *Client) sendUpdateIrcMonitorSettings(ctx context.Context, request *IrcMonitorSettings) (res *IrcMonitorSettings, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("testRuleRegex"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/rules/test-regex"),
+		otelogen.OperationID("updateIrcMonitorSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/v1/settings/irc-monitor-settings"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7241,7 +13566,7 @@ func (c *Client) sendTestRuleRegex(ctx context.Context, request *TestRuleRegexRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, TestRuleRegexOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateIrcMonitorSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7259,15 +13584,15 @@ func (c *Client) sendTestRuleRegex(ctx context.Context, request *TestRuleRegexRe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/rules/test-regex"
+	pathParts[0] = "/api/v1/settings/irc-monitor-settings"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeTestRuleRegexRequest(request, r); err != nil {
+	if err := encodeUpdateIrcMonitorSettingsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7276,7 +13601,7 @@ func (c *Client) sendTestRuleRegex(ctx context.Context, request *TestRuleRegexRe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, TestRuleRegexOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateIrcMonitorSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7313,7 +13638,7 @@ func (c *Client) sendTestRuleRegex(ctx context.Context, request *TestRuleRegexRe
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeTestRuleRegexResponse(resp)
+	result, err := decodeUpdateIrcMonitorSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7321,19 +13646,19 @@ func (c *Client) sendTestRuleRegex(ctx context.Context, request *TestRuleRegexRe
 	return result, nil
 }
 
-// UpdateChannelDiscoverySettings invokes updateChannelDiscoverySettings operation.
+// UpdateNotification invokes updateNotification operation.
 //
-// PATCH /api/v1/settings/channel-discovery
-func (c *Client) UpdateChannelDiscoverySettings(ctx context.Context, request *ChannelDiscoverySettings) (UpdateChannelDiscoverySettingsRes, error) {
-	res, err := c.sendUpdateChannelDiscoverySettings(ctx, request)
+// POST /api/v1/settings/notifications/update
+func (c *Client) UpdateNotification(ctx context.Context, request *UpdateNotificationPostRequest) (UpdateNotificationRes, error) {
+	res, err := c.sendUpdateNotification(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateChannelDiscoverySettings(ctx context.Context, request *ChannelDiscoverySettings) (res UpdateChannelDiscoverySettingsRes, err error) {
+func (c *Client) sendUpdateNotification(ctx context.Context, request *UpdateNotificationPostRequest) (res UpdateNotificationRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateChannelDiscoverySettings"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.URLTemplateKey.String("/api/v1/settings/channel-discovery"),
+		otelogen.OperationID("updateNotification"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/notifications/update"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7349,7 +13674,7 @@ func (c *Client) sendUpdateChannelDiscoverySettings(ctx context.Context, request
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateChannelDiscoverySettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7367,15 +13692,15 @@ func (c *Client) sendUpdateChannelDiscoverySettings(ctx context.Context, request
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/channel-discovery"
+	pathParts[0] = "/api/v1/settings/notifications/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateChannelDiscoverySettingsRequest(request, r); err != nil {
+	if err := encodeUpdateNotificationRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7384,7 +13709,7 @@ func (c *Client) sendUpdateChannelDiscoverySettings(ctx context.Context, request
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateChannelDiscoverySettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateNotificationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7421,7 +13746,7 @@ func (c *Client) sendUpdateChannelDiscoverySettings(ctx context.Context, request
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateChannelDiscoverySettingsResponse(resp)
+	result, err := decodeUpdateNotificationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7429,19 +13754,19 @@ func (c *Client) sendUpdateChannelDiscoverySettings(ctx context.Context, request
 	return result, nil
 }
 
-// UpdateIrcMonitorSettings invokes updateIrcMonitorSettings operation.
+// UpdateRule invokes updateRule operation.
 //
-// PATCH /api/v1/settings/irc-monitor-settings
-func (c *Client) UpdateIrcMonitorSettings(ctx context.Context, request *IrcMonitorSettings) (*IrcMonitorSettings, error) {
-	res, err := c.sendUpdateIrcMonitorSettings(ctx, request)
+// POST /api/v1/settings/rules/update
+func (c *Client) UpdateRule(ctx context.Context, request *UpdateRulePostRequest) (UpdateRuleRes, error) {
+	res, err := c.sendUpdateRule(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateIrcMonitorSettings(ctx context.Context, request *IrcMonitorSettings) (res *IrcMonitorSettings, err error) {
+func (c *Client) sendUpdateRule(ctx context.Context, request *UpdateRulePostRequest) (res UpdateRuleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateIrcMonitorSettings"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.URLTemplateKey.String("/api/v1/settings/irc-monitor-settings"),
+		otelogen.OperationID("updateRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/rules/update"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7457,7 +13782,7 @@ func (c *Client) sendUpdateIrcMonitorSettings(ctx context.Context, request *IrcM
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateIrcMonitorSettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7475,15 +13800,15 @@ func (c *Client) sendUpdateIrcMonitorSettings(ctx context.Context, request *IrcM
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/irc-monitor-settings"
+	pathParts[0] = "/api/v1/settings/rules/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateIrcMonitorSettingsRequest(request, r); err != nil {
+	if err := encodeUpdateRuleRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7492,7 +13817,7 @@ func (c *Client) sendUpdateIrcMonitorSettings(ctx context.Context, request *IrcM
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateIrcMonitorSettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7529,7 +13854,7 @@ func (c *Client) sendUpdateIrcMonitorSettings(ctx context.Context, request *IrcM
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateIrcMonitorSettingsResponse(resp)
+	result, err := decodeUpdateRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7537,19 +13862,19 @@ func (c *Client) sendUpdateIrcMonitorSettings(ctx context.Context, request *IrcM
 	return result, nil
 }
 
-// UpdateNotification invokes updateNotification operation.
+// UpdateStreamRecapSettings invokes updateStreamRecapSettings operation.
 //
-// POST /api/v1/settings/notifications/update
-func (c *Client) UpdateNotification(ctx context.Context, request *UpdateNotificationPostRequest) (UpdateNotificationRes, error) {
-	res, err := c.sendUpdateNotification(ctx, request)
+// PATCH /api/v1/settings/stream-recap
+func (c *Client) UpdateStreamRecapSettings(ctx context.Context, request *StreamRecapSettings) (*StreamRecapSettings, error) {
+	res, err := c.sendUpdateStreamRecapSettings(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateNotification(ctx context.Context, request *UpdateNotificationPostRequest) (res UpdateNotificationRes, err error) {
+func (c *Client) sendUpdateStreamRecapSettings(ctx context.Context, request *StreamRecapSettings) (res *StreamRecapSettings, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateNotification"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/notifications/update"),
+		otelogen.OperationID("updateStreamRecapSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/v1/settings/stream-recap"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7565,7 +13890,7 @@ func (c *Client) sendUpdateNotification(ctx context.Context, request *UpdateNoti
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateNotificationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateStreamRecapSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7583,15 +13908,15 @@ func (c *Client) sendUpdateNotification(ctx context.Context, request *UpdateNoti
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/notifications/update"
+	pathParts[0] = "/api/v1/settings/stream-recap"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateNotificationRequest(request, r); err != nil {
+	if err := encodeUpdateStreamRecapSettingsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7600,7 +13925,7 @@ func (c *Client) sendUpdateNotification(ctx context.Context, request *UpdateNoti
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateNotificationOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateStreamRecapSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7637,7 +13962,7 @@ func (c *Client) sendUpdateNotification(ctx context.Context, request *UpdateNoti
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateNotificationResponse(resp)
+	result, err := decodeUpdateStreamRecapSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7645,19 +13970,19 @@ func (c *Client) sendUpdateNotification(ctx context.Context, request *UpdateNoti
 	return result, nil
 }
 
-// UpdateRule invokes updateRule operation.
+// UpdateSuspicionSettings invokes updateSuspicionSettings operation.
 //
-// POST /api/v1/settings/rules/update
-func (c *Client) UpdateRule(ctx context.Context, request *UpdateRulePostRequest) (UpdateRuleRes, error) {
-	res, err := c.sendUpdateRule(ctx, request)
+// PATCH /api/v1/settings/suspicion-settings
+func (c *Client) UpdateSuspicionSettings(ctx context.Context, request *SuspicionSettings) (UpdateSuspicionSettingsRes, error) {
+	res, err := c.sendUpdateSuspicionSettings(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateRule(ctx context.Context, request *UpdateRulePostRequest) (res UpdateRuleRes, err error) {
+func (c *Client) sendUpdateSuspicionSettings(ctx context.Context, request *SuspicionSettings) (res UpdateSuspicionSettingsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateRule"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/rules/update"),
+		otelogen.OperationID("updateSuspicionSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/v1/settings/suspicion-settings"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7673,7 +13998,7 @@ func (c *Client) sendUpdateRule(ctx context.Context, request *UpdateRulePostRequ
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateRuleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateSuspicionSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7691,15 +14016,15 @@ func (c *Client) sendUpdateRule(ctx context.Context, request *UpdateRulePostRequ
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/rules/update"
+	pathParts[0] = "/api/v1/settings/suspicion-settings"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateRuleRequest(request, r); err != nil {
+	if err := encodeUpdateSuspicionSettingsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7708,7 +14033,7 @@ func (c *Client) sendUpdateRule(ctx context.Context, request *UpdateRulePostRequ
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateRuleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateSuspicionSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7745,7 +14070,7 @@ func (c *Client) sendUpdateRule(ctx context.Context, request *UpdateRulePostRequ
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateRuleResponse(resp)
+	result, err := decodeUpdateSuspicionSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7753,19 +14078,19 @@ func (c *Client) sendUpdateRule(ctx context.Context, request *UpdateRulePostRequ
 	return result, nil
 }
 
-// UpdateSuspicionSettings invokes updateSuspicionSettings operation.
+// UpdateTwitchAccount invokes updateTwitchAccount operation.
 //
-// PATCH /api/v1/settings/suspicion-settings
-func (c *Client) UpdateSuspicionSettings(ctx context.Context, request *SuspicionSettings) (*SuspicionSettings, error) {
-	res, err := c.sendUpdateSuspicionSettings(ctx, request)
+// POST /api/v1/settings/twitch-accounts/update
+func (c *Client) UpdateTwitchAccount(ctx context.Context, request *UpdateTwitchAccountPostRequest) (UpdateTwitchAccountRes, error) {
+	res, err := c.sendUpdateTwitchAccount(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateSuspicionSettings(ctx context.Context, request *SuspicionSettings) (res *SuspicionSettings, err error) {
+func (c *Client) sendUpdateTwitchAccount(ctx context.Context, request *UpdateTwitchAccountPostRequest) (res UpdateTwitchAccountRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateSuspicionSettings"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.URLTemplateKey.String("/api/v1/settings/suspicion-settings"),
+		otelogen.OperationID("updateTwitchAccount"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts/update"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7781,7 +14106,7 @@ func (c *Client) sendUpdateSuspicionSettings(ctx context.Context, request *Suspi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateSuspicionSettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateTwitchAccountOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7799,15 +14124,15 @@ func (c *Client) sendUpdateSuspicionSettings(ctx context.Context, request *Suspi
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/suspicion-settings"
+	pathParts[0] = "/api/v1/settings/twitch-accounts/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateSuspicionSettingsRequest(request, r); err != nil {
+	if err := encodeUpdateTwitchAccountRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7816,7 +14141,7 @@ func (c *Client) sendUpdateSuspicionSettings(ctx context.Context, request *Suspi
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateSuspicionSettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateTwitchAccountOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7853,7 +14178,7 @@ func (c *Client) sendUpdateSuspicionSettings(ctx context.Context, request *Suspi
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateSuspicionSettingsResponse(resp)
+	result, err := decodeUpdateTwitchAccountResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7861,19 +14186,19 @@ func (c *Client) sendUpdateSuspicionSettings(ctx context.Context, request *Suspi
 	return result, nil
 }
 
-// UpdateTwitchAccount invokes updateTwitchAccount operation.
+// UpdateTwitchUser invokes updateTwitchUser operation.
 //
-// POST /api/v1/settings/twitch-accounts/update
-func (c *Client) UpdateTwitchAccount(ctx context.Context, request *UpdateTwitchAccountPostRequest) (UpdateTwitchAccountRes, error) {
-	res, err := c.sendUpdateTwitchAccount(ctx, request)
+// POST /api/v1/settings/twitch-users/update
+func (c *Client) UpdateTwitchUser(ctx context.Context, request *UpdateTwitchUserPostRequest) (UpdateTwitchUserRes, error) {
+	res, err := c.sendUpdateTwitchUser(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateTwitchAccount(ctx context.Context, request *UpdateTwitchAccountPostRequest) (res UpdateTwitchAccountRes, err error) {
+func (c *Client) sendUpdateTwitchUser(ctx context.Context, request *UpdateTwitchUserPostRequest) (res UpdateTwitchUserRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateTwitchAccount"),
+		otelogen.OperationID("updateTwitchUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/twitch-accounts/update"),
+		semconv.URLTemplateKey.String("/api/v1/settings/twitch-users/update"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7889,7 +14214,7 @@ func (c *Client) sendUpdateTwitchAccount(ctx context.Context, request *UpdateTwi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateTwitchAccountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateTwitchUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7907,7 +14232,7 @@ func (c *Client) sendUpdateTwitchAccount(ctx context.Context, request *UpdateTwi
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/twitch-accounts/update"
+	pathParts[0] = "/api/v1/settings/twitch-users/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -7915,7 +14240,7 @@ func (c *Client) sendUpdateTwitchAccount(ctx context.Context, request *UpdateTwi
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateTwitchAccountRequest(request, r); err != nil {
+	if err := encodeUpdateTwitchUserRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7924,7 +14249,7 @@ func (c *Client) sendUpdateTwitchAccount(ctx context.Context, request *UpdateTwi
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateTwitchAccountOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateTwitchUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7961,7 +14286,7 @@ func (c *Client) sendUpdateTwitchAccount(ctx context.Context, request *UpdateTwi
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateTwitchAccountResponse(resp)
+	result, err := decodeUpdateTwitchUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7969,19 +14294,21 @@ func (c *Client) sendUpdateTwitchAccount(ctx context.Context, request *UpdateTwi
 	return result, nil
 }
 
-// UpdateTwitchUser invokes updateTwitchUser operation.
+// UploadBlocklist invokes uploadBlocklist operation.
 //
-// POST /api/v1/settings/twitch-users/update
-func (c *Client) UpdateTwitchUser(ctx context.Context, request *UpdateTwitchUserPostRequest) (UpdateTwitchUserRes, error) {
-	res, err := c.sendUpdateTwitchUser(ctx, request)
+// Replaces a blocklist's entries with file content parsed in the list's format.
+//
+// POST /api/v1/settings/blocklists/upload
+func (c *Client) UploadBlocklist(ctx context.Context, request *UploadBlocklistRequest) (UploadBlocklistRes, error) {
+	res, err := c.sendUploadBlocklist(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateTwitchUser(ctx context.Context, request *UpdateTwitchUserPostRequest) (res UpdateTwitchUserRes, err error) {
+func (c *Client) sendUploadBlocklist(ctx context.Context, request *UploadBlocklistRequest) (res UploadBlocklistRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateTwitchUser"),
+		otelogen.OperationID("uploadBlocklist"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/v1/settings/twitch-users/update"),
+		semconv.URLTemplateKey.String("/api/v1/settings/blocklists/upload"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -7997,7 +14324,7 @@ func (c *Client) sendUpdateTwitchUser(ctx context.Context, request *UpdateTwitch
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateTwitchUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UploadBlocklistOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8015,7 +14342,7 @@ func (c *Client) sendUpdateTwitchUser(ctx context.Context, request *UpdateTwitch
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/settings/twitch-users/update"
+	pathParts[0] = "/api/v1/settings/blocklists/upload"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -8023,7 +14350,7 @@ func (c *Client) sendUpdateTwitchUser(ctx context.Context, request *UpdateTwitch
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateTwitchUserRequest(request, r); err != nil {
+	if err := encodeUploadBlocklistRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -8032,7 +14359,7 @@ func (c *Client) sendUpdateTwitchUser(ctx context.Context, request *UpdateTwitch
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateTwitchUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UploadBlocklistOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8069,7 +14396,7 @@ func (c *Client) sendUpdateTwitchUser(ctx context.Context, request *UpdateTwitch
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateTwitchUserResponse(resp)
+	result, err := decodeUploadBlocklistResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
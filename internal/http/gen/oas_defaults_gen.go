@@ -2,6 +2,30 @@
 
 package gen
 
+// setDefaults set default value of fields.
+func (s *AudienceOverlap) setDefaults() {
+	{
+		val := AudienceOverlapPeriod("7d")
+		s.Period = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *ChannelAnalytics) setDefaults() {
+	{
+		val := ChannelAnalyticsBucket("hour")
+		s.Bucket = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CreateBlocklistRequest) setDefaults() {
+	{
+		val := bool(true)
+		s.Enabled.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *CreateNotificationRequest) setDefaults() {
 	{
@@ -1539,22 +1539,27 @@ func (s *Server) handleCreateAiMessageRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleCreateNotificationRequest handles createNotification operation.
+// handleCreateBlocklistRequest handles createBlocklist operation.
 //
-// POST /api/v1/settings/notifications
-func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Creates an empty named blocklist. Lists with `source_url` are pulled every `sync_interval_minutes`
+// (first pull within 5 minutes, or use `/settings/blocklists/sync`); others are filled by uploads.
+// Chatters whose id or login is listed are marked suspicious with sus_type `auto_blocklist` during
+// enrichment.
+//
+// POST /api/v1/settings/blocklists
+func (s *Server) handleCreateBlocklistRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createNotification"),
+		otelogen.OperationID("createBlocklist"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/notifications"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/blocklists"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateNotificationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateBlocklistOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1609,15 +1614,15 @@ func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateNotificationOperation,
-			ID:   "createNotification",
+			Name: CreateBlocklistOperation,
+			ID:   "createBlocklist",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateNotificationOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateBlocklistOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1659,7 +1664,7 @@ func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped boo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateNotificationRequest(r)
+	request, rawBody, close, err := s.decodeCreateBlocklistRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1675,13 +1680,13 @@ func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped boo
 		}
 	}()
 
-	var response *NotificationEntry
+	var response CreateBlocklistRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateNotificationOperation,
+			OperationName:    CreateBlocklistOperation,
 			OperationSummary: "",
-			OperationID:      "createNotification",
+			OperationID:      "createBlocklist",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -1689,9 +1694,9 @@ func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped boo
 		}
 
 		type (
-			Request  = *CreateNotificationRequest
+			Request  = *CreateBlocklistRequest
 			Params   = struct{}
-			Response = *NotificationEntry
+			Response = CreateBlocklistRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1702,12 +1707,12 @@ func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped boo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateNotification(ctx, request)
+				response, err = s.h.CreateBlocklist(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateNotification(ctx, request)
+		response, err = s.h.CreateBlocklist(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1715,7 +1720,7 @@ func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeCreateNotificationResponse(response, w, span); err != nil {
+	if err := encodeCreateBlocklistResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1724,22 +1729,28 @@ func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleCreateRuleRequest handles createRule operation.
+// handleCreateLoginPatternRequest handles createLoginPattern operation.
 //
-// POST /api/v1/settings/rules
-func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Adds a regex or glob to the login pattern blacklist (re-adding the same kind and pattern updates
+// its
+// description). Matching chatters are marked suspicious with sus_type `auto_login_pattern`; all
+// known users
+// are re-evaluated in the background.
+//
+// POST /api/v1/settings/login-patterns
+func (s *Server) handleCreateLoginPatternRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createRule"),
+		otelogen.OperationID("createLoginPattern"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/rules"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/login-patterns"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateRuleOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateLoginPatternOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1794,15 +1805,15 @@ func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateRuleOperation,
-			ID:   "createRule",
+			Name: CreateLoginPatternOperation,
+			ID:   "createLoginPattern",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateRuleOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateLoginPatternOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1844,7 +1855,7 @@ func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateRuleRequest(r)
+	request, rawBody, close, err := s.decodeCreateLoginPatternRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1860,13 +1871,13 @@ func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response *Rule
+	var response CreateLoginPatternRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateRuleOperation,
+			OperationName:    CreateLoginPatternOperation,
 			OperationSummary: "",
-			OperationID:      "createRule",
+			OperationID:      "createLoginPattern",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -1874,9 +1885,9 @@ func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 
 		type (
-			Request  = *CreateRuleRequest
+			Request  = *CreateLoginPatternRequest
 			Params   = struct{}
-			Response = *Rule
+			Response = CreateLoginPatternRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1887,12 +1898,12 @@ func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateRule(ctx, request)
+				response, err = s.h.CreateLoginPattern(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateRule(ctx, request)
+		response, err = s.h.CreateLoginPattern(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1900,7 +1911,7 @@ func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCreateRuleResponse(response, w, span); err != nil {
+	if err := encodeCreateLoginPatternResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1909,22 +1920,22 @@ func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleCreateTwitchAccountRequest handles createTwitchAccount operation.
+// handleCreateNotificationRequest handles createNotification operation.
 //
-// POST /api/v1/settings/twitch-accounts
-func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/notifications
+func (s *Server) handleCreateNotificationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createTwitchAccount"),
+		otelogen.OperationID("createNotification"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateTwitchAccountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1979,15 +1990,15 @@ func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateTwitchAccountOperation,
-			ID:   "createTwitchAccount",
+			Name: CreateNotificationOperation,
+			ID:   "createNotification",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateTwitchAccountOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateNotificationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2029,7 +2040,7 @@ func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateTwitchAccountRequest(r)
+	request, rawBody, close, err := s.decodeCreateNotificationRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2045,13 +2056,13 @@ func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bo
 		}
 	}()
 
-	var response *TwitchAccount
+	var response *NotificationEntry
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateTwitchAccountOperation,
+			OperationName:    CreateNotificationOperation,
 			OperationSummary: "",
-			OperationID:      "createTwitchAccount",
+			OperationID:      "createNotification",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -2059,9 +2070,9 @@ func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bo
 		}
 
 		type (
-			Request  = *CreateTwitchAccountRequest
+			Request  = *CreateNotificationRequest
 			Params   = struct{}
-			Response = *TwitchAccount
+			Response = *NotificationEntry
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2072,12 +2083,12 @@ func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateTwitchAccount(ctx, request)
+				response, err = s.h.CreateNotification(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateTwitchAccount(ctx, request)
+		response, err = s.h.CreateNotification(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2085,7 +2096,7 @@ func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeCreateTwitchAccountResponse(response, w, span); err != nil {
+	if err := encodeCreateNotificationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2094,22 +2105,25 @@ func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleCreateTwitchUserRequest handles createTwitchUser operation.
+// handleCreateNotificationSnoozeRequest handles createNotificationSnooze operation.
 //
-// POST /api/v1/settings/twitch-users
-func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Temporarily mute notifications for one entry, one channel, or both. Muted events are recorded as
+// suppressed.
+//
+// POST /api/v1/settings/notifications/snoozes
+func (s *Server) handleCreateNotificationSnoozeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createTwitchUser"),
+		otelogen.OperationID("createNotificationSnooze"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-users"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/snoozes"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateTwitchUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateNotificationSnoozeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2164,15 +2178,15 @@ func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateTwitchUserOperation,
-			ID:   "createTwitchUser",
+			Name: CreateNotificationSnoozeOperation,
+			ID:   "createNotificationSnooze",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateTwitchUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateNotificationSnoozeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2214,7 +2228,7 @@ func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateTwitchUserRequest(r)
+	request, rawBody, close, err := s.decodeCreateNotificationSnoozeRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2230,13 +2244,13 @@ func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response CreateTwitchUserRes
+	var response CreateNotificationSnoozeRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateTwitchUserOperation,
+			OperationName:    CreateNotificationSnoozeOperation,
 			OperationSummary: "",
-			OperationID:      "createTwitchUser",
+			OperationID:      "createNotificationSnooze",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -2244,9 +2258,9 @@ func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = *CreateTwitchUserRequest
+			Request  = *CreateNotificationSnoozeRequest
 			Params   = struct{}
-			Response = CreateTwitchUserRes
+			Response = CreateNotificationSnoozeRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2257,12 +2271,12 @@ func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool,
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateTwitchUser(ctx, request)
+				response, err = s.h.CreateNotificationSnooze(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateTwitchUser(ctx, request)
+		response, err = s.h.CreateNotificationSnooze(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2270,7 +2284,7 @@ func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeCreateTwitchUserResponse(response, w, span); err != nil {
+	if err := encodeCreateNotificationSnoozeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2279,22 +2293,22 @@ func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleDeleteAiConversationRequest handles deleteAiConversation operation.
+// handleCreateRuleRequest handles createRule operation.
 //
-// DELETE /api/v1/ai/conversations/{conversationId}
-func (s *Server) handleDeleteAiConversationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/rules
+func (s *Server) handleCreateRuleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteAiConversation"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/ai/conversations/{conversationId}"),
+		otelogen.OperationID("createRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/rules"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteAiConversationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2349,15 +2363,15 @@ func (s *Server) handleDeleteAiConversationRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteAiConversationOperation,
-			ID:   "deleteAiConversation",
+			Name: CreateRuleOperation,
+			ID:   "createRule",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteAiConversationOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateRuleOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2397,41 +2411,41 @@ func (s *Server) handleDeleteAiConversationRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeDeleteAiConversationParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateRuleRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response DeleteAiConversationRes
+	var response *Rule
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteAiConversationOperation,
+			OperationName:    CreateRuleOperation,
 			OperationSummary: "",
-			OperationID:      "deleteAiConversation",
-			Body:             nil,
+			OperationID:      "createRule",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "conversationId",
-					In:   "path",
-				}: params.ConversationId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteAiConversationParams
-			Response = DeleteAiConversationRes
+			Request  = *CreateRuleRequest
+			Params   = struct{}
+			Response = *Rule
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2440,14 +2454,14 @@ func (s *Server) handleDeleteAiConversationRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackDeleteAiConversationParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteAiConversation(ctx, params)
+				response, err = s.h.CreateRule(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteAiConversation(ctx, params)
+		response, err = s.h.CreateRule(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2455,7 +2469,7 @@ func (s *Server) handleDeleteAiConversationRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeDeleteAiConversationResponse(response, w, span); err != nil {
+	if err := encodeCreateRuleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2464,22 +2478,22 @@ func (s *Server) handleDeleteAiConversationRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleDeleteNotificationRequest handles deleteNotification operation.
+// handleCreateTwitchAccountRequest handles createTwitchAccount operation.
 //
-// POST /api/v1/settings/notifications/delete
-func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/twitch-accounts
+func (s *Server) handleCreateTwitchAccountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteNotification"),
+		otelogen.OperationID("createTwitchAccount"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/delete"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteNotificationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateTwitchAccountOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2534,15 +2548,15 @@ func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteNotificationOperation,
-			ID:   "deleteNotification",
+			Name: CreateTwitchAccountOperation,
+			ID:   "createTwitchAccount",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteNotificationOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateTwitchAccountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2584,7 +2598,7 @@ func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped boo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeDeleteNotificationRequest(r)
+	request, rawBody, close, err := s.decodeCreateTwitchAccountRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2600,13 +2614,13 @@ func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped boo
 		}
 	}()
 
-	var response DeleteNotificationRes
+	var response *TwitchAccount
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteNotificationOperation,
+			OperationName:    CreateTwitchAccountOperation,
 			OperationSummary: "",
-			OperationID:      "deleteNotification",
+			OperationID:      "createTwitchAccount",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -2614,9 +2628,9 @@ func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped boo
 		}
 
 		type (
-			Request  = *DeleteByIDRequest
+			Request  = *CreateTwitchAccountRequest
 			Params   = struct{}
-			Response = DeleteNotificationRes
+			Response = *TwitchAccount
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2627,12 +2641,12 @@ func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped boo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteNotification(ctx, request)
+				response, err = s.h.CreateTwitchAccount(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteNotification(ctx, request)
+		response, err = s.h.CreateTwitchAccount(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2640,7 +2654,7 @@ func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeDeleteNotificationResponse(response, w, span); err != nil {
+	if err := encodeCreateTwitchAccountResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2649,22 +2663,22 @@ func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleDeleteRuleRequest handles deleteRule operation.
+// handleCreateTwitchUserRequest handles createTwitchUser operation.
 //
-// POST /api/v1/settings/rules/delete
-func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/twitch-users
+func (s *Server) handleCreateTwitchUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteRule"),
+		otelogen.OperationID("createTwitchUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/rules/delete"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-users"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteRuleOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateTwitchUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2719,15 +2733,15 @@ func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteRuleOperation,
-			ID:   "deleteRule",
+			Name: CreateTwitchUserOperation,
+			ID:   "createTwitchUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteRuleOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateTwitchUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2769,7 +2783,7 @@ func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeDeleteRuleRequest(r)
+	request, rawBody, close, err := s.decodeCreateTwitchUserRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2785,13 +2799,13 @@ func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response DeleteRuleRes
+	var response CreateTwitchUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteRuleOperation,
+			OperationName:    CreateTwitchUserOperation,
 			OperationSummary: "",
-			OperationID:      "deleteRule",
+			OperationID:      "createTwitchUser",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -2799,9 +2813,9 @@ func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 
 		type (
-			Request  = *DeleteByIDRequest
+			Request  = *CreateTwitchUserRequest
 			Params   = struct{}
-			Response = DeleteRuleRes
+			Response = CreateTwitchUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2812,12 +2826,12 @@ func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteRule(ctx, request)
+				response, err = s.h.CreateTwitchUser(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteRule(ctx, request)
+		response, err = s.h.CreateTwitchUser(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2825,7 +2839,7 @@ func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteRuleResponse(response, w, span); err != nil {
+	if err := encodeCreateTwitchUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2834,22 +2848,22 @@ func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeleteTwitchAccountRequest handles deleteTwitchAccount operation.
+// handleDeleteAiConversationRequest handles deleteAiConversation operation.
 //
-// POST /api/v1/settings/twitch-accounts/delete
-func (s *Server) handleDeleteTwitchAccountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api/v1/ai/conversations/{conversationId}
+func (s *Server) handleDeleteAiConversationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteTwitchAccount"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts/delete"),
+		otelogen.OperationID("deleteAiConversation"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/ai/conversations/{conversationId}"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteTwitchAccountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteAiConversationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2904,15 +2918,15 @@ func (s *Server) handleDeleteTwitchAccountRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteTwitchAccountOperation,
-			ID:   "deleteTwitchAccount",
+			Name: DeleteAiConversationOperation,
+			ID:   "deleteAiConversation",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteTwitchAccountOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteAiConversationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2952,41 +2966,41 @@ func (s *Server) handleDeleteTwitchAccountRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-
-	var rawBody []byte
-	request, rawBody, close, err := s.decodeDeleteTwitchAccountRequest(r)
+	params, err := decodeDeleteAiConversationParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response DeleteTwitchAccountRes
+	var rawBody []byte
+
+	var response DeleteAiConversationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteTwitchAccountOperation,
+			OperationName:    DeleteAiConversationOperation,
 			OperationSummary: "",
-			OperationID:      "deleteTwitchAccount",
-			Body:             request,
+			OperationID:      "deleteAiConversation",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "conversationId",
+					In:   "path",
+				}: params.ConversationId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *DeleteByIDRequest
-			Params   = struct{}
-			Response = DeleteTwitchAccountRes
+			Request  = struct{}
+			Params   = DeleteAiConversationParams
+			Response = DeleteAiConversationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2995,14 +3009,14 @@ func (s *Server) handleDeleteTwitchAccountRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteAiConversationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteTwitchAccount(ctx, request)
+				response, err = s.h.DeleteAiConversation(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteTwitchAccount(ctx, request)
+		response, err = s.h.DeleteAiConversation(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3010,7 +3024,7 @@ func (s *Server) handleDeleteTwitchAccountRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeDeleteTwitchAccountResponse(response, w, span); err != nil {
+	if err := encodeDeleteAiConversationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3019,22 +3033,22 @@ func (s *Server) handleDeleteTwitchAccountRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleDenyChannelDiscoveryCandidateRequest handles denyChannelDiscoveryCandidate operation.
+// handleDeleteBlocklistRequest handles deleteBlocklist operation.
 //
-// POST /api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny
-func (s *Server) handleDenyChannelDiscoveryCandidateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/blocklists/delete
+func (s *Server) handleDeleteBlocklistRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("denyChannelDiscoveryCandidate"),
+		otelogen.OperationID("deleteBlocklist"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/blocklists/delete"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DenyChannelDiscoveryCandidateOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteBlocklistOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3089,15 +3103,15 @@ func (s *Server) handleDenyChannelDiscoveryCandidateRequest(args [1]string, args
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DenyChannelDiscoveryCandidateOperation,
-			ID:   "denyChannelDiscoveryCandidate",
+			Name: DeleteBlocklistOperation,
+			ID:   "deleteBlocklist",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DenyChannelDiscoveryCandidateOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteBlocklistOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3137,41 +3151,41 @@ func (s *Server) handleDenyChannelDiscoveryCandidateRequest(args [1]string, args
 			return
 		}
 	}
-	params, err := decodeDenyChannelDiscoveryCandidateParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeDeleteBlocklistRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response DenyChannelDiscoveryCandidateRes
+	var response DeleteBlocklistRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DenyChannelDiscoveryCandidateOperation,
+			OperationName:    DeleteBlocklistOperation,
 			OperationSummary: "",
-			OperationID:      "denyChannelDiscoveryCandidate",
-			Body:             nil,
+			OperationID:      "deleteBlocklist",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "twitch_user_id",
-					In:   "path",
-				}: params.TwitchUserID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DenyChannelDiscoveryCandidateParams
-			Response = DenyChannelDiscoveryCandidateRes
+			Request  = *DeleteByIDRequest
+			Params   = struct{}
+			Response = DeleteBlocklistRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3180,14 +3194,14 @@ func (s *Server) handleDenyChannelDiscoveryCandidateRequest(args [1]string, args
 		](
 			m,
 			mreq,
-			unpackDenyChannelDiscoveryCandidateParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DenyChannelDiscoveryCandidate(ctx, params)
+				response, err = s.h.DeleteBlocklist(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DenyChannelDiscoveryCandidate(ctx, params)
+		response, err = s.h.DeleteBlocklist(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3195,7 +3209,7 @@ func (s *Server) handleDenyChannelDiscoveryCandidateRequest(args [1]string, args
 		return
 	}
 
-	if err := encodeDenyChannelDiscoveryCandidateResponse(response, w, span); err != nil {
+	if err := encodeDeleteBlocklistResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3204,22 +3218,22 @@ func (s *Server) handleDenyChannelDiscoveryCandidateRequest(args [1]string, args
 	}
 }
 
-// handleGetAiSettingsRequest handles getAiSettings operation.
+// handleDeleteEmoteSetRequest handles deleteEmoteSet operation.
 //
-// GET /api/v1/ai/settings
-func (s *Server) handleGetAiSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/emote-sets/delete
+func (s *Server) handleDeleteEmoteSetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAiSettings"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/ai/settings"),
+		otelogen.OperationID("deleteEmoteSet"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/emote-sets/delete"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAiSettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteEmoteSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3274,15 +3288,15 @@ func (s *Server) handleGetAiSettingsRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAiSettingsOperation,
-			ID:   "getAiSettings",
+			Name: DeleteEmoteSetOperation,
+			ID:   "deleteEmoteSet",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetAiSettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteEmoteSetOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3324,24 +3338,39 @@ func (s *Server) handleGetAiSettingsRequest(args [0]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeDeleteEmoteSetRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *AiSettings
+	var response DeleteEmoteSetRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAiSettingsOperation,
+			OperationName:    DeleteEmoteSetOperation,
 			OperationSummary: "",
-			OperationID:      "getAiSettings",
-			Body:             nil,
+			OperationID:      "deleteEmoteSet",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *DeleteByIDRequest
 			Params   = struct{}
-			Response = *AiSettings
+			Response = DeleteEmoteSetRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3352,12 +3381,12 @@ func (s *Server) handleGetAiSettingsRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAiSettings(ctx)
+				response, err = s.h.DeleteEmoteSet(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAiSettings(ctx)
+		response, err = s.h.DeleteEmoteSet(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3365,7 +3394,7 @@ func (s *Server) handleGetAiSettingsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetAiSettingsResponse(response, w, span); err != nil {
+	if err := encodeDeleteEmoteSetResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3374,22 +3403,22 @@ func (s *Server) handleGetAiSettingsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetChannelDiscoverySettingsRequest handles getChannelDiscoverySettings operation.
+// handleDeleteLoginPatternRequest handles deleteLoginPattern operation.
 //
-// GET /api/v1/settings/channel-discovery
-func (s *Server) handleGetChannelDiscoverySettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/login-patterns/delete
+func (s *Server) handleDeleteLoginPatternRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getChannelDiscoverySettings"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/channel-discovery"),
+		otelogen.OperationID("deleteLoginPattern"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/login-patterns/delete"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChannelDiscoverySettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteLoginPatternOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3444,15 +3473,15 @@ func (s *Server) handleGetChannelDiscoverySettingsRequest(args [0]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetChannelDiscoverySettingsOperation,
-			ID:   "getChannelDiscoverySettings",
+			Name: DeleteLoginPatternOperation,
+			ID:   "deleteLoginPattern",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetChannelDiscoverySettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteLoginPatternOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3494,24 +3523,39 @@ func (s *Server) handleGetChannelDiscoverySettingsRequest(args [0]string, argsEs
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeDeleteLoginPatternRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *ChannelDiscoverySettings
+	var response DeleteLoginPatternRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetChannelDiscoverySettingsOperation,
+			OperationName:    DeleteLoginPatternOperation,
 			OperationSummary: "",
-			OperationID:      "getChannelDiscoverySettings",
-			Body:             nil,
+			OperationID:      "deleteLoginPattern",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *DeleteByIDRequest
 			Params   = struct{}
-			Response = *ChannelDiscoverySettings
+			Response = DeleteLoginPatternRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3522,12 +3566,12 @@ func (s *Server) handleGetChannelDiscoverySettingsRequest(args [0]string, argsEs
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetChannelDiscoverySettings(ctx)
+				response, err = s.h.DeleteLoginPattern(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetChannelDiscoverySettings(ctx)
+		response, err = s.h.DeleteLoginPattern(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3535,7 +3579,7 @@ func (s *Server) handleGetChannelDiscoverySettingsRequest(args [0]string, argsEs
 		return
 	}
 
-	if err := encodeGetChannelDiscoverySettingsResponse(response, w, span); err != nil {
+	if err := encodeDeleteLoginPatternResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3544,22 +3588,22 @@ func (s *Server) handleGetChannelDiscoverySettingsRequest(args [0]string, argsEs
 	}
 }
 
-// handleGetChannelLiveRequest handles getChannelLive operation.
+// handleDeleteNotificationRequest handles deleteNotification operation.
 //
-// POST /api/v1/twitch/channels/live
-func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/notifications/delete
+func (s *Server) handleDeleteNotificationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getChannelLive"),
+		otelogen.OperationID("deleteNotification"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/channels/live"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/delete"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChannelLiveOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3614,15 +3658,15 @@ func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetChannelLiveOperation,
-			ID:   "getChannelLive",
+			Name: DeleteNotificationOperation,
+			ID:   "deleteNotification",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetChannelLiveOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteNotificationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3664,7 +3708,7 @@ func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeGetChannelLiveRequest(r)
+	request, rawBody, close, err := s.decodeDeleteNotificationRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -3680,13 +3724,13 @@ func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response GetChannelLiveRes
+	var response DeleteNotificationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetChannelLiveOperation,
+			OperationName:    DeleteNotificationOperation,
 			OperationSummary: "",
-			OperationID:      "getChannelLive",
+			OperationID:      "deleteNotification",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -3694,9 +3738,9 @@ func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = *GetChannelLiveRequest
+			Request  = *DeleteByIDRequest
 			Params   = struct{}
-			Response = GetChannelLiveRes
+			Response = DeleteNotificationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3707,12 +3751,12 @@ func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetChannelLive(ctx, request)
+				response, err = s.h.DeleteNotification(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetChannelLive(ctx, request)
+		response, err = s.h.DeleteNotification(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3720,7 +3764,7 @@ func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetChannelLiveResponse(response, w, span); err != nil {
+	if err := encodeDeleteNotificationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3729,22 +3773,24 @@ func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetIrcMonitorSettingsRequest handles getIrcMonitorSettings operation.
+// handleDeleteNotificationSnoozeRequest handles deleteNotificationSnooze operation.
 //
-// GET /api/v1/settings/irc-monitor-settings
-func (s *Server) handleGetIrcMonitorSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// End a snooze early.
+//
+// POST /api/v1/settings/notifications/snoozes/delete
+func (s *Server) handleDeleteNotificationSnoozeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIrcMonitorSettings"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/irc-monitor-settings"),
+		otelogen.OperationID("deleteNotificationSnooze"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/snoozes/delete"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIrcMonitorSettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteNotificationSnoozeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3799,15 +3845,15 @@ func (s *Server) handleGetIrcMonitorSettingsRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIrcMonitorSettingsOperation,
-			ID:   "getIrcMonitorSettings",
+			Name: DeleteNotificationSnoozeOperation,
+			ID:   "deleteNotificationSnooze",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetIrcMonitorSettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteNotificationSnoozeOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3849,24 +3895,39 @@ func (s *Server) handleGetIrcMonitorSettingsRequest(args [0]string, argsEscaped
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeDeleteNotificationSnoozeRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *IrcMonitorSettings
+	var response DeleteNotificationSnoozeRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIrcMonitorSettingsOperation,
+			OperationName:    DeleteNotificationSnoozeOperation,
 			OperationSummary: "",
-			OperationID:      "getIrcMonitorSettings",
-			Body:             nil,
+			OperationID:      "deleteNotificationSnooze",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *DeleteByIDRequest
 			Params   = struct{}
-			Response = *IrcMonitorSettings
+			Response = DeleteNotificationSnoozeRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3877,12 +3938,12 @@ func (s *Server) handleGetIrcMonitorSettingsRequest(args [0]string, argsEscaped
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIrcMonitorSettings(ctx)
+				response, err = s.h.DeleteNotificationSnooze(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIrcMonitorSettings(ctx)
+		response, err = s.h.DeleteNotificationSnooze(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3890,7 +3951,7 @@ func (s *Server) handleGetIrcMonitorSettingsRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetIrcMonitorSettingsResponse(response, w, span); err != nil {
+	if err := encodeDeleteNotificationSnoozeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3899,22 +3960,22 @@ func (s *Server) handleGetIrcMonitorSettingsRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleGetIrcMonitorStatusRequest handles getIrcMonitorStatus operation.
+// handleDeleteRuleRequest handles deleteRule operation.
 //
-// GET /api/v1/twitch/irc-monitor/status
-func (s *Server) handleGetIrcMonitorStatusRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/rules/delete
+func (s *Server) handleDeleteRuleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getIrcMonitorStatus"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/irc-monitor/status"),
+		otelogen.OperationID("deleteRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/rules/delete"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIrcMonitorStatusOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3969,15 +4030,15 @@ func (s *Server) handleGetIrcMonitorStatusRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIrcMonitorStatusOperation,
-			ID:   "getIrcMonitorStatus",
+			Name: DeleteRuleOperation,
+			ID:   "deleteRule",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetIrcMonitorStatusOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteRuleOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4019,24 +4080,39 @@ func (s *Server) handleGetIrcMonitorStatusRequest(args [0]string, argsEscaped bo
 	}
 
 	var rawBody []byte
-
-	var response *IrcMonitorStatus
-	if m := s.cfg.Middleware; m != nil {
+	request, rawBody, close, err := s.decodeDeleteRuleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response DeleteRuleRes
+	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIrcMonitorStatusOperation,
+			OperationName:    DeleteRuleOperation,
 			OperationSummary: "",
-			OperationID:      "getIrcMonitorStatus",
-			Body:             nil,
+			OperationID:      "deleteRule",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *DeleteByIDRequest
 			Params   = struct{}
-			Response = *IrcMonitorStatus
+			Response = DeleteRuleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4047,12 +4123,12 @@ func (s *Server) handleGetIrcMonitorStatusRequest(args [0]string, argsEscaped bo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIrcMonitorStatus(ctx)
+				response, err = s.h.DeleteRule(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIrcMonitorStatus(ctx)
+		response, err = s.h.DeleteRule(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4060,7 +4136,7 @@ func (s *Server) handleGetIrcMonitorStatusRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetIrcMonitorStatusResponse(response, w, span); err != nil {
+	if err := encodeDeleteRuleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4069,22 +4145,22 @@ func (s *Server) handleGetIrcMonitorStatusRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleGetRecordedStreamRequest handles getRecordedStream operation.
+// handleDeleteTwitchAccountRequest handles deleteTwitchAccount operation.
 //
-// GET /api/v1/twitch/streams/{streamId}
-func (s *Server) handleGetRecordedStreamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/twitch-accounts/delete
+func (s *Server) handleDeleteTwitchAccountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRecordedStream"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}"),
+		otelogen.OperationID("deleteTwitchAccount"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts/delete"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordedStreamOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteTwitchAccountOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4139,15 +4215,15 @@ func (s *Server) handleGetRecordedStreamRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetRecordedStreamOperation,
-			ID:   "getRecordedStream",
+			Name: DeleteTwitchAccountOperation,
+			ID:   "deleteTwitchAccount",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordedStreamOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteTwitchAccountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4187,41 +4263,41 @@ func (s *Server) handleGetRecordedStreamRequest(args [1]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeGetRecordedStreamParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeDeleteTwitchAccountRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response GetRecordedStreamRes
+	var response DeleteTwitchAccountRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetRecordedStreamOperation,
+			OperationName:    DeleteTwitchAccountOperation,
 			OperationSummary: "",
-			OperationID:      "getRecordedStream",
-			Body:             nil,
+			OperationID:      "deleteTwitchAccount",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "streamId",
-					In:   "path",
-				}: params.StreamId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetRecordedStreamParams
-			Response = GetRecordedStreamRes
+			Request  = *DeleteByIDRequest
+			Params   = struct{}
+			Response = DeleteTwitchAccountRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4230,14 +4306,14 @@ func (s *Server) handleGetRecordedStreamRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetRecordedStreamParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRecordedStream(ctx, params)
+				response, err = s.h.DeleteTwitchAccount(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRecordedStream(ctx, params)
+		response, err = s.h.DeleteTwitchAccount(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4245,7 +4321,7 @@ func (s *Server) handleGetRecordedStreamRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetRecordedStreamResponse(response, w, span); err != nil {
+	if err := encodeDeleteTwitchAccountResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4254,22 +4330,22 @@ func (s *Server) handleGetRecordedStreamRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleGetRecordedStreamLeaderboardRequest handles getRecordedStreamLeaderboard operation.
+// handleDeleteTwitchUserLinkRequest handles deleteTwitchUserLink operation.
 //
-// GET /api/v1/twitch/streams/{streamId}/leaderboard
-func (s *Server) handleGetRecordedStreamLeaderboardRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/twitch/users/links/delete
+func (s *Server) handleDeleteTwitchUserLinkRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getRecordedStreamLeaderboard"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/leaderboard"),
+		otelogen.OperationID("deleteTwitchUserLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users/links/delete"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordedStreamLeaderboardOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteTwitchUserLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4324,15 +4400,15 @@ func (s *Server) handleGetRecordedStreamLeaderboardRequest(args [1]string, argsE
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetRecordedStreamLeaderboardOperation,
-			ID:   "getRecordedStreamLeaderboard",
+			Name: DeleteTwitchUserLinkOperation,
+			ID:   "deleteTwitchUserLink",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordedStreamLeaderboardOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteTwitchUserLinkOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4372,49 +4448,41 @@ func (s *Server) handleGetRecordedStreamLeaderboardRequest(args [1]string, argsE
 			return
 		}
 	}
-	params, err := decodeGetRecordedStreamLeaderboardParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeDeleteTwitchUserLinkRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response GetRecordedStreamLeaderboardRes
+	var response DeleteTwitchUserLinkRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetRecordedStreamLeaderboardOperation,
+			OperationName:    DeleteTwitchUserLinkOperation,
 			OperationSummary: "",
-			OperationID:      "getRecordedStreamLeaderboard",
-			Body:             nil,
+			OperationID:      "deleteTwitchUserLink",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "streamId",
-					In:   "path",
-				}: params.StreamId,
-				{
-					Name: "sort",
-					In:   "query",
-				}: params.Sort,
-				{
-					Name: "q",
-					In:   "query",
-				}: params.Q,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetRecordedStreamLeaderboardParams
-			Response = GetRecordedStreamLeaderboardRes
+			Request  = *DeleteTwitchUserLinkRequest
+			Params   = struct{}
+			Response = DeleteTwitchUserLinkRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4423,14 +4491,14 @@ func (s *Server) handleGetRecordedStreamLeaderboardRequest(args [1]string, argsE
 		](
 			m,
 			mreq,
-			unpackGetRecordedStreamLeaderboardParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRecordedStreamLeaderboard(ctx, params)
+				response, err = s.h.DeleteTwitchUserLink(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRecordedStreamLeaderboard(ctx, params)
+		response, err = s.h.DeleteTwitchUserLink(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4438,7 +4506,7 @@ func (s *Server) handleGetRecordedStreamLeaderboardRequest(args [1]string, argsE
 		return
 	}
 
-	if err := encodeGetRecordedStreamLeaderboardResponse(response, w, span); err != nil {
+	if err := encodeDeleteTwitchUserLinkResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4447,22 +4515,22 @@ func (s *Server) handleGetRecordedStreamLeaderboardRequest(args [1]string, argsE
 	}
 }
 
-// handleGetSuspicionSettingsRequest handles getSuspicionSettings operation.
+// handleDenyChannelDiscoveryCandidateRequest handles denyChannelDiscoveryCandidate operation.
 //
-// GET /api/v1/settings/suspicion-settings
-func (s *Server) handleGetSuspicionSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny
+func (s *Server) handleDenyChannelDiscoveryCandidateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSuspicionSettings"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/suspicion-settings"),
+		otelogen.OperationID("denyChannelDiscoveryCandidate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSuspicionSettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DenyChannelDiscoveryCandidateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4517,15 +4585,15 @@ func (s *Server) handleGetSuspicionSettingsRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetSuspicionSettingsOperation,
-			ID:   "getSuspicionSettings",
+			Name: DenyChannelDiscoveryCandidateOperation,
+			ID:   "denyChannelDiscoveryCandidate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetSuspicionSettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DenyChannelDiscoveryCandidateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4565,26 +4633,41 @@ func (s *Server) handleGetSuspicionSettingsRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
+	params, err := decodeDenyChannelDiscoveryCandidateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response *SuspicionSettings
+	var response DenyChannelDiscoveryCandidateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetSuspicionSettingsOperation,
+			OperationName:    DenyChannelDiscoveryCandidateOperation,
 			OperationSummary: "",
-			OperationID:      "getSuspicionSettings",
+			OperationID:      "denyChannelDiscoveryCandidate",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "twitch_user_id",
+					In:   "path",
+				}: params.TwitchUserID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *SuspicionSettings
+			Params   = DenyChannelDiscoveryCandidateParams
+			Response = DenyChannelDiscoveryCandidateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4593,14 +4676,14 @@ func (s *Server) handleGetSuspicionSettingsRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackDenyChannelDiscoveryCandidateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetSuspicionSettings(ctx)
+				response, err = s.h.DenyChannelDiscoveryCandidate(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetSuspicionSettings(ctx)
+		response, err = s.h.DenyChannelDiscoveryCandidate(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4608,7 +4691,7 @@ func (s *Server) handleGetSuspicionSettingsRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetSuspicionSettingsResponse(response, w, span); err != nil {
+	if err := encodeDenyChannelDiscoveryCandidateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4617,25 +4700,22 @@ func (s *Server) handleGetSuspicionSettingsRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGetSystemStatsRequest handles getSystemStats operation.
-//
-// Admin-only. Snapshot values are cached server-side for up to 5 seconds; repeated calls within that
-// window return the same payload and captured_at.
+// handleGetAiSettingsRequest handles getAiSettings operation.
 //
-// GET /api/v1/stats
-func (s *Server) handleGetSystemStatsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/ai/settings
+func (s *Server) handleGetAiSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getSystemStats"),
+		otelogen.OperationID("getAiSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/stats"),
+		semconv.HTTPRouteKey.String("/api/v1/ai/settings"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSystemStatsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAiSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4690,15 +4770,15 @@ func (s *Server) handleGetSystemStatsRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetSystemStatsOperation,
-			ID:   "getSystemStats",
+			Name: GetAiSettingsOperation,
+			ID:   "getAiSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetSystemStatsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetAiSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4741,13 +4821,13 @@ func (s *Server) handleGetSystemStatsRequest(args [0]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetSystemStatsRes
+	var response *AiSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetSystemStatsOperation,
-			OperationSummary: "Aggregated DB counts, process, host, and cache snapshot",
-			OperationID:      "getSystemStats",
+			OperationName:    GetAiSettingsOperation,
+			OperationSummary: "",
+			OperationID:      "getAiSettings",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -4757,7 +4837,7 @@ func (s *Server) handleGetSystemStatsRequest(args [0]string, argsEscaped bool, w
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = GetSystemStatsRes
+			Response = *AiSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4768,12 +4848,12 @@ func (s *Server) handleGetSystemStatsRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetSystemStats(ctx)
+				response, err = s.h.GetAiSettings(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetSystemStats(ctx)
+		response, err = s.h.GetAiSettings(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4781,7 +4861,7 @@ func (s *Server) handleGetSystemStatsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetSystemStatsResponse(response, w, span); err != nil {
+	if err := encodeGetAiSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4790,22 +4870,29 @@ func (s *Server) handleGetSystemStatsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetTwitchUserActivityTimelineRequest handles getTwitchUserActivityTimeline operation.
+// handleGetAudienceOverlapRequest handles getAudienceOverlap operation.
 //
-// POST /api/v1/twitch/users/activity/timeline
-func (s *Server) handleGetTwitchUserActivityTimelineRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Audience overlap between monitored channels over a trailing period. A channel's audience is
+// everyone who chatted or was present in IRC during the period (likely bots excluded when bot
+// detection hides them from stats). Returns Jaccard (shared / union) and overlap (shared / smaller
+// audience) matrices in channels order, the channel pairs with their top shared users, and per
+// channel the channels its community also frequents (shared monitored audience and GQL follows).
+// Results are cached per period for an hour.
+//
+// GET /api/v1/twitch/audience/overlap
+func (s *Server) handleGetAudienceOverlapRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTwitchUserActivityTimeline"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/users/activity/timeline"),
+		otelogen.OperationID("getAudienceOverlap"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/audience/overlap"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTwitchUserActivityTimelineOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAudienceOverlapOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4860,15 +4947,15 @@ func (s *Server) handleGetTwitchUserActivityTimelineRequest(args [0]string, args
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTwitchUserActivityTimelineOperation,
-			ID:   "getTwitchUserActivityTimeline",
+			Name: GetAudienceOverlapOperation,
+			ID:   "getAudienceOverlap",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetTwitchUserActivityTimelineOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetAudienceOverlapOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4908,41 +4995,45 @@ func (s *Server) handleGetTwitchUserActivityTimelineRequest(args [0]string, args
 			return
 		}
 	}
-
-	var rawBody []byte
-	request, rawBody, close, err := s.decodeGetTwitchUserActivityTimelineRequest(r)
+	params, err := decodeGetAudienceOverlapParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response GetTwitchUserActivityTimelineRes
+	var rawBody []byte
+
+	var response *AudienceOverlap
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTwitchUserActivityTimelineOperation,
+			OperationName:    GetAudienceOverlapOperation,
 			OperationSummary: "",
-			OperationID:      "getTwitchUserActivityTimeline",
-			Body:             request,
+			OperationID:      "getAudienceOverlap",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "period",
+					In:   "query",
+				}: params.Period,
+				{
+					Name: "refresh",
+					In:   "query",
+				}: params.Refresh,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *GetTwitchUserActivityTimelineRequest
-			Params   = struct{}
-			Response = GetTwitchUserActivityTimelineRes
+			Request  = struct{}
+			Params   = GetAudienceOverlapParams
+			Response = *AudienceOverlap
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4951,14 +5042,14 @@ func (s *Server) handleGetTwitchUserActivityTimelineRequest(args [0]string, args
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetAudienceOverlapParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTwitchUserActivityTimeline(ctx, request)
+				response, err = s.h.GetAudienceOverlap(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTwitchUserActivityTimeline(ctx, request)
+		response, err = s.h.GetAudienceOverlap(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4966,7 +5057,7 @@ func (s *Server) handleGetTwitchUserActivityTimelineRequest(args [0]string, args
 		return
 	}
 
-	if err := encodeGetTwitchUserActivityTimelineResponse(response, w, span); err != nil {
+	if err := encodeGetAudienceOverlapResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4975,22 +5066,22 @@ func (s *Server) handleGetTwitchUserActivityTimelineRequest(args [0]string, args
 	}
 }
 
-// handleGetTwitchUserProfileRequest handles getTwitchUserProfile operation.
+// handleGetBotDetectionSettingsRequest handles getBotDetectionSettings operation.
 //
-// POST /api/v1/twitch/users/profile
-func (s *Server) handleGetTwitchUserProfileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/settings/bot-detection
+func (s *Server) handleGetBotDetectionSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTwitchUserProfile"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/users/profile"),
+		otelogen.OperationID("getBotDetectionSettings"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/bot-detection"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTwitchUserProfileOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBotDetectionSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5045,15 +5136,15 @@ func (s *Server) handleGetTwitchUserProfileRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTwitchUserProfileOperation,
-			ID:   "getTwitchUserProfile",
+			Name: GetBotDetectionSettingsOperation,
+			ID:   "getBotDetectionSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetTwitchUserProfileOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetBotDetectionSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5095,39 +5186,24 @@ func (s *Server) handleGetTwitchUserProfileRequest(args [0]string, argsEscaped b
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeGetTwitchUserProfileRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response GetTwitchUserProfileRes
+	var response *BotDetectionSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTwitchUserProfileOperation,
+			OperationName:    GetBotDetectionSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "getTwitchUserProfile",
-			Body:             request,
+			OperationID:      "getBotDetectionSettings",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *GetTwitchUserProfileRequest
+			Request  = struct{}
 			Params   = struct{}
-			Response = GetTwitchUserProfileRes
+			Response = *BotDetectionSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5138,12 +5214,12 @@ func (s *Server) handleGetTwitchUserProfileRequest(args [0]string, argsEscaped b
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTwitchUserProfile(ctx, request)
+				response, err = s.h.GetBotDetectionSettings(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTwitchUserProfile(ctx, request)
+		response, err = s.h.GetBotDetectionSettings(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5151,7 +5227,7 @@ func (s *Server) handleGetTwitchUserProfileRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetTwitchUserProfileResponse(response, w, span); err != nil {
+	if err := encodeGetBotDetectionSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5160,22 +5236,25 @@ func (s *Server) handleGetTwitchUserProfileRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGetWatchUiHintsRequest handles getWatchUiHints operation.
+// handleGetChannelActivityHeatmapRequest handles getChannelActivityHeatmap operation.
 //
-// GET /api/v1/twitch/watch/hints
-func (s *Server) handleGetWatchUiHintsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// When a monitored channel's chat is busiest: chatters' summed IRC presence seconds and chat
+// messages per local weekday and hour.
+//
+// GET /api/v1/twitch/channels/{login}/heatmap
+func (s *Server) handleGetChannelActivityHeatmapRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWatchUiHints"),
+		otelogen.OperationID("getChannelActivityHeatmap"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/watch/hints"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/channels/{login}/heatmap"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWatchUiHintsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChannelActivityHeatmapOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5230,15 +5309,15 @@ func (s *Server) handleGetWatchUiHintsRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetWatchUiHintsOperation,
-			ID:   "getWatchUiHints",
+			Name: GetChannelActivityHeatmapOperation,
+			ID:   "getChannelActivityHeatmap",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetWatchUiHintsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetChannelActivityHeatmapOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5278,26 +5357,53 @@ func (s *Server) handleGetWatchUiHintsRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeGetChannelActivityHeatmapParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response *WatchUiHints
+	var response GetChannelActivityHeatmapRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetWatchUiHintsOperation,
+			OperationName:    GetChannelActivityHeatmapOperation,
 			OperationSummary: "",
-			OperationID:      "getWatchUiHints",
+			OperationID:      "getChannelActivityHeatmap",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "login",
+					In:   "path",
+				}: params.Login,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "timezone",
+					In:   "query",
+				}: params.Timezone,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *WatchUiHints
+			Params   = GetChannelActivityHeatmapParams
+			Response = GetChannelActivityHeatmapRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5306,14 +5412,14 @@ func (s *Server) handleGetWatchUiHintsRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetChannelActivityHeatmapParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetWatchUiHints(ctx)
+				response, err = s.h.GetChannelActivityHeatmap(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetWatchUiHints(ctx)
+		response, err = s.h.GetChannelActivityHeatmap(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5321,7 +5427,7 @@ func (s *Server) handleGetWatchUiHintsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetWatchUiHintsResponse(response, w, span); err != nil {
+	if err := encodeGetChannelActivityHeatmapResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5330,22 +5436,28 @@ func (s *Server) handleGetWatchUiHintsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListAiConversationsRequest handles listAiConversations operation.
+// handleGetChannelAnalyticsRequest handles getChannelAnalytics operation.
 //
-// GET /api/v1/ai/conversations
-func (s *Server) handleListAiConversationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Time-bucketed chat activity (messages, unique/new chatters, average IRC presence) for a monitored
+// channel and stream-over-stream chatter retention of the streams started in the range. Buckets are
+// UTC-aligned; hour and day series come from rollups refreshed every few minutes (see
+// rolled_up_until), minute series are computed live. Ranges are limited to 24h (minute), 31d (hour)
+// and 366d (day).
+//
+// GET /api/v1/twitch/channels/{login}/analytics
+func (s *Server) handleGetChannelAnalyticsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listAiConversations"),
+		otelogen.OperationID("getChannelAnalytics"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/ai/conversations"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/channels/{login}/analytics"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListAiConversationsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChannelAnalyticsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5400,15 +5512,15 @@ func (s *Server) handleListAiConversationsRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListAiConversationsOperation,
-			ID:   "listAiConversations",
+			Name: GetChannelAnalyticsOperation,
+			ID:   "getChannelAnalytics",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListAiConversationsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetChannelAnalyticsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5448,26 +5560,53 @@ func (s *Server) handleListAiConversationsRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
+	params, err := decodeGetChannelAnalyticsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response []AiConversation
+	var response GetChannelAnalyticsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListAiConversationsOperation,
+			OperationName:    GetChannelAnalyticsOperation,
 			OperationSummary: "",
-			OperationID:      "listAiConversations",
+			OperationID:      "getChannelAnalytics",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "login",
+					In:   "path",
+				}: params.Login,
+				{
+					Name: "bucket",
+					In:   "query",
+				}: params.Bucket,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []AiConversation
+			Params   = GetChannelAnalyticsParams
+			Response = GetChannelAnalyticsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5476,14 +5615,14 @@ func (s *Server) handleListAiConversationsRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetChannelAnalyticsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListAiConversations(ctx)
+				response, err = s.h.GetChannelAnalytics(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListAiConversations(ctx)
+		response, err = s.h.GetChannelAnalytics(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5491,7 +5630,7 @@ func (s *Server) handleListAiConversationsRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeListAiConversationsResponse(response, w, span); err != nil {
+	if err := encodeGetChannelAnalyticsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5500,22 +5639,22 @@ func (s *Server) handleListAiConversationsRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleListAiMessagesRequest handles listAiMessages operation.
+// handleGetChannelDiscoverySettingsRequest handles getChannelDiscoverySettings operation.
 //
-// GET /api/v1/ai/conversations/{conversationId}/messages
-func (s *Server) handleListAiMessagesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/settings/channel-discovery
+func (s *Server) handleGetChannelDiscoverySettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listAiMessages"),
+		otelogen.OperationID("getChannelDiscoverySettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/ai/conversations/{conversationId}/messages"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/channel-discovery"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListAiMessagesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChannelDiscoverySettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5570,15 +5709,15 @@ func (s *Server) handleListAiMessagesRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListAiMessagesOperation,
-			ID:   "listAiMessages",
+			Name: GetChannelDiscoverySettingsOperation,
+			ID:   "getChannelDiscoverySettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListAiMessagesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetChannelDiscoverySettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5618,41 +5757,26 @@ func (s *Server) handleListAiMessagesRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeListAiMessagesParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response ListAiMessagesRes
+	var response *ChannelDiscoverySettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListAiMessagesOperation,
+			OperationName:    GetChannelDiscoverySettingsOperation,
 			OperationSummary: "",
-			OperationID:      "listAiMessages",
+			OperationID:      "getChannelDiscoverySettings",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "conversationId",
-					In:   "path",
-				}: params.ConversationId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListAiMessagesParams
-			Response = ListAiMessagesRes
+			Params   = struct{}
+			Response = *ChannelDiscoverySettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5661,14 +5785,14 @@ func (s *Server) handleListAiMessagesRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackListAiMessagesParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListAiMessages(ctx, params)
+				response, err = s.h.GetChannelDiscoverySettings(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListAiMessages(ctx, params)
+		response, err = s.h.GetChannelDiscoverySettings(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5676,7 +5800,7 @@ func (s *Server) handleListAiMessagesRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListAiMessagesResponse(response, w, span); err != nil {
+	if err := encodeGetChannelDiscoverySettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5685,22 +5809,24 @@ func (s *Server) handleListAiMessagesRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleListChannelBlacklistRequest handles listChannelBlacklist operation.
+// handleGetChannelEmoteUsageRequest handles getChannelEmoteUsage operation.
 //
-// GET /api/v1/settings/channel-blacklist
-func (s *Server) handleListChannelBlacklistRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Emotes used most in a monitored channel's chat.
+//
+// GET /api/v1/twitch/channels/{login}/emotes
+func (s *Server) handleGetChannelEmoteUsageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listChannelBlacklist"),
+		otelogen.OperationID("getChannelEmoteUsage"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/channel-blacklist"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/channels/{login}/emotes"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChannelBlacklistOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChannelEmoteUsageOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5755,15 +5881,15 @@ func (s *Server) handleListChannelBlacklistRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListChannelBlacklistOperation,
-			ID:   "listChannelBlacklist",
+			Name: GetChannelEmoteUsageOperation,
+			ID:   "getChannelEmoteUsage",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListChannelBlacklistOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetChannelEmoteUsageOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5803,26 +5929,53 @@ func (s *Server) handleListChannelBlacklistRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
+	params, err := decodeGetChannelEmoteUsageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response []string
+	var response GetChannelEmoteUsageRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListChannelBlacklistOperation,
+			OperationName:    GetChannelEmoteUsageOperation,
 			OperationSummary: "",
-			OperationID:      "listChannelBlacklist",
+			OperationID:      "getChannelEmoteUsage",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "login",
+					In:   "path",
+				}: params.Login,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []string
+			Params   = GetChannelEmoteUsageParams
+			Response = GetChannelEmoteUsageRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5831,14 +5984,14 @@ func (s *Server) handleListChannelBlacklistRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetChannelEmoteUsageParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListChannelBlacklist(ctx)
+				response, err = s.h.GetChannelEmoteUsage(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListChannelBlacklist(ctx)
+		response, err = s.h.GetChannelEmoteUsage(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5846,7 +5999,7 @@ func (s *Server) handleListChannelBlacklistRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeListChannelBlacklistResponse(response, w, span); err != nil {
+	if err := encodeGetChannelEmoteUsageResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5855,22 +6008,25 @@ func (s *Server) handleListChannelBlacklistRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleListChannelChattersRequest handles listChannelChatters operation.
+// handleGetChannelLeaderboardRequest handles getChannelLeaderboard operation.
 //
-// POST /api/v1/twitch/channels/chatters
-func (s *Server) handleListChannelChattersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Chatters of a monitored channel ranked by IRC presence, messages or account age over whole UTC
+// days. Totals come from daily rollups refreshed every few minutes (see rolled_up_until).
+//
+// GET /api/v1/twitch/channels/{login}/leaderboard
+func (s *Server) handleGetChannelLeaderboardRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listChannelChatters"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/channels/chatters"),
+		otelogen.OperationID("getChannelLeaderboard"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/channels/{login}/leaderboard"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChannelChattersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChannelLeaderboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5925,15 +6081,15 @@ func (s *Server) handleListChannelChattersRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListChannelChattersOperation,
-			ID:   "listChannelChatters",
+			Name: GetChannelLeaderboardOperation,
+			ID:   "getChannelLeaderboard",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListChannelChattersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetChannelLeaderboardOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5973,41 +6129,77 @@ func (s *Server) handleListChannelChattersRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-
-	var rawBody []byte
-	request, rawBody, close, err := s.decodeListChannelChattersRequest(r)
+	params, err := decodeGetChannelLeaderboardParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response ListChannelChattersRes
+	var rawBody []byte
+
+	var response GetChannelLeaderboardRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListChannelChattersOperation,
+			OperationName:    GetChannelLeaderboardOperation,
 			OperationSummary: "",
-			OperationID:      "listChannelChatters",
-			Body:             request,
+			OperationID:      "getChannelLeaderboard",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "login",
+					In:   "path",
+				}: params.Login,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "q",
+					In:   "query",
+				}: params.Q,
+				{
+					Name: "exclude_bots",
+					In:   "query",
+				}: params.ExcludeBots,
+				{
+					Name: "exclude_marked",
+					In:   "query",
+				}: params.ExcludeMarked,
+				{
+					Name: "exclude_linked",
+					In:   "query",
+				}: params.ExcludeLinked,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *ListChannelChattersRequest
-			Params   = struct{}
-			Response = ListChannelChattersRes
+			Request  = struct{}
+			Params   = GetChannelLeaderboardParams
+			Response = GetChannelLeaderboardRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6016,14 +6208,14 @@ func (s *Server) handleListChannelChattersRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetChannelLeaderboardParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListChannelChatters(ctx, request)
+				response, err = s.h.GetChannelLeaderboard(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListChannelChatters(ctx, request)
+		response, err = s.h.GetChannelLeaderboard(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6031,7 +6223,7 @@ func (s *Server) handleListChannelChattersRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeListChannelChattersResponse(response, w, span); err != nil {
+	if err := encodeGetChannelLeaderboardResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6040,22 +6232,22 @@ func (s *Server) handleListChannelChattersRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleListChannelDiscoveryCandidatesRequest handles listChannelDiscoveryCandidates operation.
+// handleGetChannelLiveRequest handles getChannelLive operation.
 //
-// GET /api/v1/settings/channel-discovery/candidates
-func (s *Server) handleListChannelDiscoveryCandidatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/twitch/channels/live
+func (s *Server) handleGetChannelLiveRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listChannelDiscoveryCandidates"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/channel-discovery/candidates"),
+		otelogen.OperationID("getChannelLive"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/channels/live"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChannelDiscoveryCandidatesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChannelLiveOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6110,15 +6302,15 @@ func (s *Server) handleListChannelDiscoveryCandidatesRequest(args [0]string, arg
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListChannelDiscoveryCandidatesOperation,
-			ID:   "listChannelDiscoveryCandidates",
+			Name: GetChannelLiveOperation,
+			ID:   "getChannelLive",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListChannelDiscoveryCandidatesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetChannelLiveOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6160,24 +6352,39 @@ func (s *Server) handleListChannelDiscoveryCandidatesRequest(args [0]string, arg
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeGetChannelLiveRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []DiscoveryCandidate
+	var response GetChannelLiveRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListChannelDiscoveryCandidatesOperation,
+			OperationName:    GetChannelLiveOperation,
 			OperationSummary: "",
-			OperationID:      "listChannelDiscoveryCandidates",
-			Body:             nil,
+			OperationID:      "getChannelLive",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *GetChannelLiveRequest
 			Params   = struct{}
-			Response = []DiscoveryCandidate
+			Response = GetChannelLiveRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6188,12 +6395,12 @@ func (s *Server) handleListChannelDiscoveryCandidatesRequest(args [0]string, arg
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListChannelDiscoveryCandidates(ctx)
+				response, err = s.h.GetChannelLive(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListChannelDiscoveryCandidates(ctx)
+		response, err = s.h.GetChannelLive(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6201,7 +6408,7 @@ func (s *Server) handleListChannelDiscoveryCandidatesRequest(args [0]string, arg
 		return
 	}
 
-	if err := encodeListChannelDiscoveryCandidatesResponse(response, w, span); err != nil {
+	if err := encodeGetChannelLiveResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6210,22 +6417,22 @@ func (s *Server) handleListChannelDiscoveryCandidatesRequest(args [0]string, arg
 	}
 }
 
-// handleListChatHistoryRequest handles listChatHistory operation.
+// handleGetChatClassifierSettingsRequest handles getChatClassifierSettings operation.
 //
-// GET /api/v1/twitch/chat/history
-func (s *Server) handleListChatHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/settings/chat-classifier
+func (s *Server) handleGetChatClassifierSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listChatHistory"),
+		otelogen.OperationID("getChatClassifierSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/chat/history"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/chat-classifier"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChatHistoryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetChatClassifierSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6280,15 +6487,15 @@ func (s *Server) handleListChatHistoryRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListChatHistoryOperation,
-			ID:   "listChatHistory",
+			Name: GetChatClassifierSettingsOperation,
+			ID:   "getChatClassifierSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListChatHistoryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetChatClassifierSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6328,45 +6535,26 @@ func (s *Server) handleListChatHistoryRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeListChatHistoryParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response ListChatHistoryRes
+	var response *ChatClassifierSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListChatHistoryOperation,
+			OperationName:    GetChatClassifierSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "listChatHistory",
+			OperationID:      "getChatClassifierSettings",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "channel",
-					In:   "query",
-				}: params.Channel,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListChatHistoryParams
-			Response = ListChatHistoryRes
+			Params   = struct{}
+			Response = *ChatClassifierSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6375,14 +6563,14 @@ func (s *Server) handleListChatHistoryRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackListChatHistoryParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListChatHistory(ctx, params)
+				response, err = s.h.GetChatClassifierSettings(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListChatHistory(ctx, params)
+		response, err = s.h.GetChatClassifierSettings(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6390,7 +6578,7 @@ func (s *Server) handleListChatHistoryRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListChatHistoryResponse(response, w, span); err != nil {
+	if err := encodeGetChatClassifierSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6399,24 +6587,22 @@ func (s *Server) handleListChatHistoryRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListIrcMonitorJoinedHistoryRequest handles listIrcMonitorJoinedHistory operation.
-//
-// Historical IRC joined channel counts (periodic samples).
+// handleGetIrcMonitorSettingsRequest handles getIrcMonitorSettings operation.
 //
-// GET /api/v1/twitch/irc-monitor/joined-history
-func (s *Server) handleListIrcMonitorJoinedHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/settings/irc-monitor-settings
+func (s *Server) handleGetIrcMonitorSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listIrcMonitorJoinedHistory"),
+		otelogen.OperationID("getIrcMonitorSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/irc-monitor/joined-history"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/irc-monitor-settings"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListIrcMonitorJoinedHistoryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIrcMonitorSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6471,15 +6657,15 @@ func (s *Server) handleListIrcMonitorJoinedHistoryRequest(args [0]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListIrcMonitorJoinedHistoryOperation,
-			ID:   "listIrcMonitorJoinedHistory",
+			Name: GetIrcMonitorSettingsOperation,
+			ID:   "getIrcMonitorSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListIrcMonitorJoinedHistoryOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetIrcMonitorSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6519,41 +6705,26 @@ func (s *Server) handleListIrcMonitorJoinedHistoryRequest(args [0]string, argsEs
 			return
 		}
 	}
-	params, err := decodeListIrcMonitorJoinedHistoryParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response []IrcJoinedSample
+	var response *IrcMonitorSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListIrcMonitorJoinedHistoryOperation,
-			OperationSummary: "Historical IRC joined channel counts (periodic samples)",
-			OperationID:      "listIrcMonitorJoinedHistory",
+			OperationName:    GetIrcMonitorSettingsOperation,
+			OperationSummary: "",
+			OperationID:      "getIrcMonitorSettings",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "days",
-					In:   "query",
-				}: params.Days,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListIrcMonitorJoinedHistoryParams
-			Response = []IrcJoinedSample
+			Params   = struct{}
+			Response = *IrcMonitorSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6562,14 +6733,14 @@ func (s *Server) handleListIrcMonitorJoinedHistoryRequest(args [0]string, argsEs
 		](
 			m,
 			mreq,
-			unpackListIrcMonitorJoinedHistoryParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListIrcMonitorJoinedHistory(ctx, params)
+				response, err = s.h.GetIrcMonitorSettings(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListIrcMonitorJoinedHistory(ctx, params)
+		response, err = s.h.GetIrcMonitorSettings(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6577,7 +6748,7 @@ func (s *Server) handleListIrcMonitorJoinedHistoryRequest(args [0]string, argsEs
 		return
 	}
 
-	if err := encodeListIrcMonitorJoinedHistoryResponse(response, w, span); err != nil {
+	if err := encodeGetIrcMonitorSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6586,24 +6757,22 @@ func (s *Server) handleListIrcMonitorJoinedHistoryRequest(args [0]string, argsEs
 	}
 }
 
-// handleListNotificationsRequest handles listNotifications operation.
-//
-// List notification entries (newest first) with cursor-based incremental loading.
+// handleGetIrcMonitorStatusRequest handles getIrcMonitorStatus operation.
 //
-// GET /api/v1/settings/notifications
-func (s *Server) handleListNotificationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/twitch/irc-monitor/status
+func (s *Server) handleGetIrcMonitorStatusRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listNotifications"),
+		otelogen.OperationID("getIrcMonitorStatus"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/notifications"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/irc-monitor/status"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListNotificationsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIrcMonitorStatusOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6658,15 +6827,15 @@ func (s *Server) handleListNotificationsRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListNotificationsOperation,
-			ID:   "listNotifications",
+			Name: GetIrcMonitorStatusOperation,
+			ID:   "getIrcMonitorStatus",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListNotificationsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetIrcMonitorStatusOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6706,49 +6875,26 @@ func (s *Server) handleListNotificationsRequest(args [0]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeListNotificationsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response []NotificationEntry
+	var response *IrcMonitorStatus
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListNotificationsOperation,
+			OperationName:    GetIrcMonitorStatusOperation,
 			OperationSummary: "",
-			OperationID:      "listNotifications",
+			OperationID:      "getIrcMonitorStatus",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "cursor_created_at",
-					In:   "query",
-				}: params.CursorCreatedAt,
-				{
-					Name: "cursor_id",
-					In:   "query",
-				}: params.CursorID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListNotificationsParams
-			Response = []NotificationEntry
+			Params   = struct{}
+			Response = *IrcMonitorStatus
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6757,14 +6903,14 @@ func (s *Server) handleListNotificationsRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackListNotificationsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListNotifications(ctx, params)
+				response, err = s.h.GetIrcMonitorStatus(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListNotifications(ctx, params)
+		response, err = s.h.GetIrcMonitorStatus(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6772,7 +6918,7 @@ func (s *Server) handleListNotificationsRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeListNotificationsResponse(response, w, span); err != nil {
+	if err := encodeGetIrcMonitorStatusResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6781,24 +6927,25 @@ func (s *Server) handleListNotificationsRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleListRecordedStreamActivityRequest handles listRecordedStreamActivity operation.
+// handleGetMonitoredLeaderboardRequest handles getMonitoredLeaderboard operation.
 //
-// Non-message activity in the stream time window (newest first).
+// Chatters ranked across all monitored channels over whole UTC days; presence and messages are
+// summed over channels. Same filters and rollup lag as the per-channel leaderboard.
 //
-// GET /api/v1/twitch/streams/{streamId}/activity
-func (s *Server) handleListRecordedStreamActivityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/twitch/leaderboard
+func (s *Server) handleGetMonitoredLeaderboardRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRecordedStreamActivity"),
+		otelogen.OperationID("getMonitoredLeaderboard"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/activity"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/leaderboard"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRecordedStreamActivityOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMonitoredLeaderboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6853,15 +7000,15 @@ func (s *Server) handleListRecordedStreamActivityRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListRecordedStreamActivityOperation,
-			ID:   "listRecordedStreamActivity",
+			Name: GetMonitoredLeaderboardOperation,
+			ID:   "getMonitoredLeaderboard",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListRecordedStreamActivityOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetMonitoredLeaderboardOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6901,7 +7048,7 @@ func (s *Server) handleListRecordedStreamActivityRequest(args [1]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeListRecordedStreamActivityParams(args, argsEscaped, r)
+	params, err := decodeGetMonitoredLeaderboardParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6914,40 +7061,60 @@ func (s *Server) handleListRecordedStreamActivityRequest(args [1]string, argsEsc
 
 	var rawBody []byte
 
-	var response ListRecordedStreamActivityRes
+	var response GetMonitoredLeaderboardRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListRecordedStreamActivityOperation,
+			OperationName:    GetMonitoredLeaderboardOperation,
 			OperationSummary: "",
-			OperationID:      "listRecordedStreamActivity",
+			OperationID:      "getMonitoredLeaderboard",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "streamId",
-					In:   "path",
-				}: params.StreamId,
+					Name: "from",
+					In:   "query",
+				}: params.From,
 				{
-					Name: "limit",
+					Name: "to",
 					In:   "query",
-				}: params.Limit,
+				}: params.To,
 				{
-					Name: "cursor_created_at",
+					Name: "sort",
 					In:   "query",
-				}: params.CursorCreatedAt,
+				}: params.Sort,
 				{
-					Name: "cursor_id",
+					Name: "q",
 					In:   "query",
-				}: params.CursorID,
+				}: params.Q,
+				{
+					Name: "exclude_bots",
+					In:   "query",
+				}: params.ExcludeBots,
+				{
+					Name: "exclude_marked",
+					In:   "query",
+				}: params.ExcludeMarked,
+				{
+					Name: "exclude_linked",
+					In:   "query",
+				}: params.ExcludeLinked,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListRecordedStreamActivityParams
-			Response = ListRecordedStreamActivityRes
+			Params   = GetMonitoredLeaderboardParams
+			Response = GetMonitoredLeaderboardRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6956,14 +7123,14 @@ func (s *Server) handleListRecordedStreamActivityRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackListRecordedStreamActivityParams,
+			unpackGetMonitoredLeaderboardParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListRecordedStreamActivity(ctx, params)
+				response, err = s.h.GetMonitoredLeaderboard(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListRecordedStreamActivity(ctx, params)
+		response, err = s.h.GetMonitoredLeaderboard(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6971,7 +7138,7 @@ func (s *Server) handleListRecordedStreamActivityRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeListRecordedStreamActivityResponse(response, w, span); err != nil {
+	if err := encodeGetMonitoredLeaderboardResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6980,24 +7147,22 @@ func (s *Server) handleListRecordedStreamActivityRequest(args [1]string, argsEsc
 	}
 }
 
-// handleListRecordedStreamMessagesRequest handles listRecordedStreamMessages operation.
-//
-// Chat messages tagged with this stream (newest first).
+// handleGetRecordedStreamRequest handles getRecordedStream operation.
 //
-// GET /api/v1/twitch/streams/{streamId}/messages
-func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/twitch/streams/{streamId}
+func (s *Server) handleGetRecordedStreamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRecordedStreamMessages"),
+		otelogen.OperationID("getRecordedStream"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/messages"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRecordedStreamMessagesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordedStreamOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7052,15 +7217,15 @@ func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListRecordedStreamMessagesOperation,
-			ID:   "listRecordedStreamMessages",
+			Name: GetRecordedStreamOperation,
+			ID:   "getRecordedStream",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListRecordedStreamMessagesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordedStreamOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7100,7 +7265,7 @@ func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEsc
 			return
 		}
 	}
-	params, err := decodeListRecordedStreamMessagesParams(args, argsEscaped, r)
+	params, err := decodeGetRecordedStreamParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7113,13 +7278,13 @@ func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEsc
 
 	var rawBody []byte
 
-	var response ListRecordedStreamMessagesRes
+	var response GetRecordedStreamRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListRecordedStreamMessagesOperation,
+			OperationName:    GetRecordedStreamOperation,
 			OperationSummary: "",
-			OperationID:      "listRecordedStreamMessages",
+			OperationID:      "getRecordedStream",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -7127,38 +7292,14 @@ func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEsc
 					Name: "streamId",
 					In:   "path",
 				}: params.StreamId,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "cursor_created_at",
-					In:   "query",
-				}: params.CursorCreatedAt,
-				{
-					Name: "cursor_id",
-					In:   "query",
-				}: params.CursorID,
-				{
-					Name: "username",
-					In:   "query",
-				}: params.Username,
-				{
-					Name: "text",
-					In:   "query",
-				}: params.Text,
-				{
-					Name: "chatter_user_id",
-					In:   "query",
-				}: params.ChatterUserID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListRecordedStreamMessagesParams
-			Response = ListRecordedStreamMessagesRes
+			Params   = GetRecordedStreamParams
+			Response = GetRecordedStreamRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7167,14 +7308,14 @@ func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEsc
 		](
 			m,
 			mreq,
-			unpackListRecordedStreamMessagesParams,
+			unpackGetRecordedStreamParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListRecordedStreamMessages(ctx, params)
+				response, err = s.h.GetRecordedStream(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListRecordedStreamMessages(ctx, params)
+		response, err = s.h.GetRecordedStream(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7182,7 +7323,7 @@ func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEsc
 		return
 	}
 
-	if err := encodeListRecordedStreamMessagesResponse(response, w, span); err != nil {
+	if err := encodeGetRecordedStreamResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7191,24 +7332,26 @@ func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEsc
 	}
 }
 
-// handleListRecordedStreamsRequest handles listRecordedStreams operation.
+// handleGetRecordedStreamEmotesRequest handles getRecordedStreamEmotes operation.
 //
-// Recorded stream sessions for monitored channels (newest first).
+// The stream's most used emotes and a timeline of their uses from stream start, in buckets of whole
+// minutes
+// (about 60 buckets per stream). Timeline uses are aligned with `top`.
 //
-// GET /api/v1/twitch/streams
-func (s *Server) handleListRecordedStreamsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/twitch/streams/{streamId}/emotes
+func (s *Server) handleGetRecordedStreamEmotesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRecordedStreams"),
+		otelogen.OperationID("getRecordedStreamEmotes"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/streams"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/emotes"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRecordedStreamsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordedStreamEmotesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7263,15 +7406,15 @@ func (s *Server) handleListRecordedStreamsRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListRecordedStreamsOperation,
-			ID:   "listRecordedStreams",
+			Name: GetRecordedStreamEmotesOperation,
+			ID:   "getRecordedStreamEmotes",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListRecordedStreamsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordedStreamEmotesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7311,7 +7454,7 @@ func (s *Server) handleListRecordedStreamsRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeListRecordedStreamsParams(args, argsEscaped, r)
+	params, err := decodeGetRecordedStreamEmotesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7324,40 +7467,32 @@ func (s *Server) handleListRecordedStreamsRequest(args [0]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response []RecordedStream
+	var response GetRecordedStreamEmotesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListRecordedStreamsOperation,
+			OperationName:    GetRecordedStreamEmotesOperation,
 			OperationSummary: "",
-			OperationID:      "listRecordedStreams",
+			OperationID:      "getRecordedStreamEmotes",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "channel_login",
-					In:   "query",
-				}: params.ChannelLogin,
+					Name: "streamId",
+					In:   "path",
+				}: params.StreamId,
 				{
 					Name: "limit",
 					In:   "query",
 				}: params.Limit,
-				{
-					Name: "cursor_started_at",
-					In:   "query",
-				}: params.CursorStartedAt,
-				{
-					Name: "cursor_id",
-					In:   "query",
-				}: params.CursorID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListRecordedStreamsParams
-			Response = []RecordedStream
+			Params   = GetRecordedStreamEmotesParams
+			Response = GetRecordedStreamEmotesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7366,14 +7501,14 @@ func (s *Server) handleListRecordedStreamsRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackListRecordedStreamsParams,
+			unpackGetRecordedStreamEmotesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListRecordedStreams(ctx, params)
+				response, err = s.h.GetRecordedStreamEmotes(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListRecordedStreams(ctx, params)
+		response, err = s.h.GetRecordedStreamEmotes(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7381,7 +7516,7 @@ func (s *Server) handleListRecordedStreamsRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeListRecordedStreamsResponse(response, w, span); err != nil {
+	if err := encodeGetRecordedStreamEmotesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7390,24 +7525,22 @@ func (s *Server) handleListRecordedStreamsRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleListRuleTemplateVariablesRequest handles listRuleTemplateVariables operation.
-//
-// Names and descriptions for `$NAME` placeholders in notify and send_chat message templates.
+// handleGetRecordedStreamLeaderboardRequest handles getRecordedStreamLeaderboard operation.
 //
-// GET /api/v1/settings/rules/template-variables
-func (s *Server) handleListRuleTemplateVariablesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/twitch/streams/{streamId}/leaderboard
+func (s *Server) handleGetRecordedStreamLeaderboardRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRuleTemplateVariables"),
+		otelogen.OperationID("getRecordedStreamLeaderboard"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/rules/template-variables"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/leaderboard"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRuleTemplateVariablesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordedStreamLeaderboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7462,15 +7595,15 @@ func (s *Server) handleListRuleTemplateVariablesRequest(args [0]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListRuleTemplateVariablesOperation,
-			ID:   "listRuleTemplateVariables",
+			Name: GetRecordedStreamLeaderboardOperation,
+			ID:   "getRecordedStreamLeaderboard",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListRuleTemplateVariablesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordedStreamLeaderboardOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7510,26 +7643,49 @@ func (s *Server) handleListRuleTemplateVariablesRequest(args [0]string, argsEsca
 			return
 		}
 	}
+	params, err := decodeGetRecordedStreamLeaderboardParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response *RuleTemplateVariablesResponse
+	var response GetRecordedStreamLeaderboardRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListRuleTemplateVariablesOperation,
-			OperationSummary: "List rule message template placeholders",
-			OperationID:      "listRuleTemplateVariables",
+			OperationName:    GetRecordedStreamLeaderboardOperation,
+			OperationSummary: "",
+			OperationID:      "getRecordedStreamLeaderboard",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "streamId",
+					In:   "path",
+				}: params.StreamId,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "q",
+					In:   "query",
+				}: params.Q,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *RuleTemplateVariablesResponse
+			Params   = GetRecordedStreamLeaderboardParams
+			Response = GetRecordedStreamLeaderboardRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7538,14 +7694,14 @@ func (s *Server) handleListRuleTemplateVariablesRequest(args [0]string, argsEsca
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetRecordedStreamLeaderboardParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListRuleTemplateVariables(ctx)
+				response, err = s.h.GetRecordedStreamLeaderboard(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListRuleTemplateVariables(ctx)
+		response, err = s.h.GetRecordedStreamLeaderboard(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7553,7 +7709,7 @@ func (s *Server) handleListRuleTemplateVariablesRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeListRuleTemplateVariablesResponse(response, w, span); err != nil {
+	if err := encodeGetRecordedStreamLeaderboardResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7562,24 +7718,27 @@ func (s *Server) handleListRuleTemplateVariablesRequest(args [0]string, argsEsca
 	}
 }
 
-// handleListRuleTriggersRequest handles listRuleTriggers operation.
+// handleGetRecordedStreamTermSeriesRequest handles getRecordedStreamTermSeries operation.
 //
-// List rule trigger events (newest first) with cursor-based incremental loading.
+// How many messages used a term over the stream, in buckets of whole minutes from stream start
+// (about 60
+// buckets per stream). The term is normalized like trending terms; emote codes match
+// case-insensitively.
 //
-// GET /api/v1/settings/rule-triggers
-func (s *Server) handleListRuleTriggersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/twitch/streams/{streamId}/terms/series
+func (s *Server) handleGetRecordedStreamTermSeriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRuleTriggers"),
+		otelogen.OperationID("getRecordedStreamTermSeries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/rule-triggers"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/terms/series"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRuleTriggersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordedStreamTermSeriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7634,15 +7793,15 @@ func (s *Server) handleListRuleTriggersRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListRuleTriggersOperation,
-			ID:   "listRuleTriggers",
+			Name: GetRecordedStreamTermSeriesOperation,
+			ID:   "getRecordedStreamTermSeries",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListRuleTriggersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordedStreamTermSeriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7682,7 +7841,7 @@ func (s *Server) handleListRuleTriggersRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeListRuleTriggersParams(args, argsEscaped, r)
+	params, err := decodeGetRecordedStreamTermSeriesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7695,36 +7854,32 @@ func (s *Server) handleListRuleTriggersRequest(args [0]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response []RuleTrigger
+	var response GetRecordedStreamTermSeriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListRuleTriggersOperation,
+			OperationName:    GetRecordedStreamTermSeriesOperation,
 			OperationSummary: "",
-			OperationID:      "listRuleTriggers",
+			OperationID:      "getRecordedStreamTermSeries",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "cursor_created_at",
-					In:   "query",
-				}: params.CursorCreatedAt,
+					Name: "streamId",
+					In:   "path",
+				}: params.StreamId,
 				{
-					Name: "cursor_id",
+					Name: "term",
 					In:   "query",
-				}: params.CursorID,
+				}: params.Term,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListRuleTriggersParams
-			Response = []RuleTrigger
+			Params   = GetRecordedStreamTermSeriesParams
+			Response = GetRecordedStreamTermSeriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7733,14 +7888,14 @@ func (s *Server) handleListRuleTriggersRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackListRuleTriggersParams,
+			unpackGetRecordedStreamTermSeriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListRuleTriggers(ctx, params)
+				response, err = s.h.GetRecordedStreamTermSeries(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListRuleTriggers(ctx, params)
+		response, err = s.h.GetRecordedStreamTermSeries(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7748,7 +7903,7 @@ func (s *Server) handleListRuleTriggersRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListRuleTriggersResponse(response, w, span); err != nil {
+	if err := encodeGetRecordedStreamTermSeriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7757,22 +7912,28 @@ func (s *Server) handleListRuleTriggersRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListRulesRequest handles listRules operation.
+// handleGetRecordedStreamTermsRequest handles getRecordedStreamTerms operation.
 //
-// GET /api/v1/settings/rules
-func (s *Server) handleListRulesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Trending chat terms of a stream (or a window of it): words, 2-3 word phrases and emotes ranked by
+// TF-IDF,
+// i.e. the share of the window's messages using the term weighted by how rare the term is in the
+// channel's
+// recent streams. Stopwords, mentions and links are ignored.
+//
+// GET /api/v1/twitch/streams/{streamId}/terms
+func (s *Server) handleGetRecordedStreamTermsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listRules"),
+		otelogen.OperationID("getRecordedStreamTerms"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/rules"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/terms"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRulesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecordedStreamTermsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7827,15 +7988,15 @@ func (s *Server) handleListRulesRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListRulesOperation,
-			ID:   "listRules",
+			Name: GetRecordedStreamTermsOperation,
+			ID:   "getRecordedStreamTerms",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListRulesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRecordedStreamTermsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7875,26 +8036,53 @@ func (s *Server) handleListRulesRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
+	params, err := decodeGetRecordedStreamTermsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response []Rule
+	var response GetRecordedStreamTermsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListRulesOperation,
+			OperationName:    GetRecordedStreamTermsOperation,
 			OperationSummary: "",
-			OperationID:      "listRules",
+			OperationID:      "getRecordedStreamTerms",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "streamId",
+					In:   "path",
+				}: params.StreamId,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []Rule
+			Params   = GetRecordedStreamTermsParams
+			Response = GetRecordedStreamTermsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7903,14 +8091,14 @@ func (s *Server) handleListRulesRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetRecordedStreamTermsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListRules(ctx)
+				response, err = s.h.GetRecordedStreamTerms(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListRules(ctx)
+		response, err = s.h.GetRecordedStreamTerms(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7918,7 +8106,7 @@ func (s *Server) handleListRulesRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListRulesResponse(response, w, span); err != nil {
+	if err := encodeGetRecordedStreamTermsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7927,22 +8115,22 @@ func (s *Server) handleListRulesRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleListTwitchAccountsRequest handles listTwitchAccounts operation.
+// handleGetStreamRecapSettingsRequest handles getStreamRecapSettings operation.
 //
-// GET /api/v1/settings/twitch-accounts
-func (s *Server) handleListTwitchAccountsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/settings/stream-recap
+func (s *Server) handleGetStreamRecapSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchAccounts"),
+		otelogen.OperationID("getStreamRecapSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/stream-recap"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchAccountsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetStreamRecapSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7997,15 +8185,15 @@ func (s *Server) handleListTwitchAccountsRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTwitchAccountsOperation,
-			ID:   "listTwitchAccounts",
+			Name: GetStreamRecapSettingsOperation,
+			ID:   "getStreamRecapSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchAccountsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetStreamRecapSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8048,13 +8236,13 @@ func (s *Server) handleListTwitchAccountsRequest(args [0]string, argsEscaped boo
 
 	var rawBody []byte
 
-	var response []TwitchAccount
+	var response *StreamRecapSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTwitchAccountsOperation,
+			OperationName:    GetStreamRecapSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "listTwitchAccounts",
+			OperationID:      "getStreamRecapSettings",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -8064,7 +8252,7 @@ func (s *Server) handleListTwitchAccountsRequest(args [0]string, argsEscaped boo
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = []TwitchAccount
+			Response = *StreamRecapSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8075,12 +8263,12 @@ func (s *Server) handleListTwitchAccountsRequest(args [0]string, argsEscaped boo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTwitchAccounts(ctx)
+				response, err = s.h.GetStreamRecapSettings(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTwitchAccounts(ctx)
+		response, err = s.h.GetStreamRecapSettings(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8088,7 +8276,7 @@ func (s *Server) handleListTwitchAccountsRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeListTwitchAccountsResponse(response, w, span); err != nil {
+	if err := encodeGetStreamRecapSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8097,24 +8285,22 @@ func (s *Server) handleListTwitchAccountsRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleListTwitchDirectoryUsersRequest handles listTwitchDirectoryUsers operation.
-//
-// List known Twitch users (chatters and channels) for directory search.
+// handleGetSuspicionReevaluationRequest handles getSuspicionReevaluation operation.
 //
-// GET /api/v1/twitch/users
-func (s *Server) handleListTwitchDirectoryUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/settings/suspicion-settings/reevaluate
+func (s *Server) handleGetSuspicionReevaluationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchDirectoryUsers"),
+		otelogen.OperationID("getSuspicionReevaluation"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/users"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/suspicion-settings/reevaluate"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchDirectoryUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSuspicionReevaluationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8169,15 +8355,15 @@ func (s *Server) handleListTwitchDirectoryUsersRequest(args [0]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTwitchDirectoryUsersOperation,
-			ID:   "listTwitchDirectoryUsers",
+			Name: GetSuspicionReevaluationOperation,
+			ID:   "getSuspicionReevaluation",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchDirectoryUsersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetSuspicionReevaluationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8217,53 +8403,26 @@ func (s *Server) handleListTwitchDirectoryUsersRequest(args [0]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeListTwitchDirectoryUsersParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response []TwitchUser
+	var response *SuspicionReevaluation
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTwitchDirectoryUsersOperation,
+			OperationName:    GetSuspicionReevaluationOperation,
 			OperationSummary: "",
-			OperationID:      "listTwitchDirectoryUsers",
+			OperationID:      "getSuspicionReevaluation",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "username",
-					In:   "query",
-				}: params.Username,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "cursor_id",
-					In:   "query",
-				}: params.CursorID,
-				{
-					Name: "monitored_only",
-					In:   "query",
-				}: params.MonitoredOnly,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListTwitchDirectoryUsersParams
-			Response = []TwitchUser
+			Params   = struct{}
+			Response = *SuspicionReevaluation
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8272,14 +8431,14 @@ func (s *Server) handleListTwitchDirectoryUsersRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackListTwitchDirectoryUsersParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTwitchDirectoryUsers(ctx, params)
+				response, err = s.h.GetSuspicionReevaluation(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTwitchDirectoryUsers(ctx, params)
+		response, err = s.h.GetSuspicionReevaluation(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8287,7 +8446,7 @@ func (s *Server) handleListTwitchDirectoryUsersRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeListTwitchDirectoryUsersResponse(response, w, span); err != nil {
+	if err := encodeGetSuspicionReevaluationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8296,24 +8455,22 @@ func (s *Server) handleListTwitchDirectoryUsersRequest(args [0]string, argsEscap
 	}
 }
 
-// handleListTwitchMessagesRequest handles listTwitchMessages operation.
-//
-// Search persisted chat messages (newest first). Omit filters to list recent messages.
+// handleGetSuspicionSettingsRequest handles getSuspicionSettings operation.
 //
-// GET /api/v1/twitch/messages
-func (s *Server) handleListTwitchMessagesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/settings/suspicion-settings
+func (s *Server) handleGetSuspicionSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchMessages"),
+		otelogen.OperationID("getSuspicionSettings"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/messages"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/suspicion-settings"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchMessagesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSuspicionSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8368,15 +8525,15 @@ func (s *Server) handleListTwitchMessagesRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTwitchMessagesOperation,
-			ID:   "listTwitchMessages",
+			Name: GetSuspicionSettingsOperation,
+			ID:   "getSuspicionSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchMessagesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetSuspicionSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8416,73 +8573,26 @@ func (s *Server) handleListTwitchMessagesRequest(args [0]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeListTwitchMessagesParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response []ChatHistoryEntry
+	var response *SuspicionSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTwitchMessagesOperation,
+			OperationName:    GetSuspicionSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "listTwitchMessages",
+			OperationID:      "getSuspicionSettings",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "cursor_created_at",
-					In:   "query",
-				}: params.CursorCreatedAt,
-				{
-					Name: "cursor_id",
-					In:   "query",
-				}: params.CursorID,
-				{
-					Name: "username",
-					In:   "query",
-				}: params.Username,
-				{
-					Name: "text",
-					In:   "query",
-				}: params.Text,
-				{
-					Name: "channel",
-					In:   "query",
-				}: params.Channel,
-				{
-					Name: "created_from",
-					In:   "query",
-				}: params.CreatedFrom,
-				{
-					Name: "created_to",
-					In:   "query",
-				}: params.CreatedTo,
-				{
-					Name: "chatter_user_id",
-					In:   "query",
-				}: params.ChatterUserID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListTwitchMessagesParams
-			Response = []ChatHistoryEntry
+			Params   = struct{}
+			Response = *SuspicionSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8491,14 +8601,14 @@ func (s *Server) handleListTwitchMessagesRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackListTwitchMessagesParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTwitchMessages(ctx, params)
+				response, err = s.h.GetSuspicionSettings(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTwitchMessages(ctx, params)
+		response, err = s.h.GetSuspicionSettings(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8506,7 +8616,7 @@ func (s *Server) handleListTwitchMessagesRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeListTwitchMessagesResponse(response, w, span); err != nil {
+	if err := encodeGetSuspicionSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8515,22 +8625,25 @@ func (s *Server) handleListTwitchMessagesRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleListTwitchUserActivityRequest handles listTwitchUserActivity operation.
+// handleGetSystemStatsRequest handles getSystemStats operation.
 //
-// POST /api/v1/twitch/users/activity
-func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Admin-only. Snapshot values are cached server-side for up to 5 seconds; repeated calls within that
+// window return the same payload and captured_at.
+//
+// GET /api/v1/stats
+func (s *Server) handleGetSystemStatsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchUserActivity"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/users/activity"),
+		otelogen.OperationID("getSystemStats"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/stats"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchUserActivityOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSystemStatsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8585,15 +8698,15 @@ func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTwitchUserActivityOperation,
-			ID:   "listTwitchUserActivity",
+			Name: GetSystemStatsOperation,
+			ID:   "getSystemStats",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchUserActivityOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetSystemStatsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8635,12 +8748,8299 @@ func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeListTwitchUserActivityRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
+
+	var response GetSystemStatsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetSystemStatsOperation,
+			OperationSummary: "Aggregated DB counts, process, host, and cache snapshot",
+			OperationID:      "getSystemStats",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetSystemStatsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetSystemStats(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetSystemStats(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetSystemStatsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTwitchUserActivityHeatmapRequest handles getTwitchUserActivityHeatmap operation.
+//
+// When the user is usually online and chatting: IRC presence seconds (summed over monitored
+// channels) and chat messages per local weekday and hour.
+//
+// GET /api/v1/twitch/users/{twitch_user_id}/heatmap
+func (s *Server) handleGetTwitchUserActivityHeatmapRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTwitchUserActivityHeatmap"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users/{twitch_user_id}/heatmap"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTwitchUserActivityHeatmapOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTwitchUserActivityHeatmapOperation,
+			ID:   "getTwitchUserActivityHeatmap",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTwitchUserActivityHeatmapOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTwitchUserActivityHeatmapParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetTwitchUserActivityHeatmapRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTwitchUserActivityHeatmapOperation,
+			OperationSummary: "",
+			OperationID:      "getTwitchUserActivityHeatmap",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "twitch_user_id",
+					In:   "path",
+				}: params.TwitchUserID,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "timezone",
+					In:   "query",
+				}: params.Timezone,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTwitchUserActivityHeatmapParams
+			Response = GetTwitchUserActivityHeatmapRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTwitchUserActivityHeatmapParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTwitchUserActivityHeatmap(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTwitchUserActivityHeatmap(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTwitchUserActivityHeatmapResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTwitchUserActivityTimelineRequest handles getTwitchUserActivityTimeline operation.
+//
+// POST /api/v1/twitch/users/activity/timeline
+func (s *Server) handleGetTwitchUserActivityTimelineRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTwitchUserActivityTimeline"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users/activity/timeline"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTwitchUserActivityTimelineOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTwitchUserActivityTimelineOperation,
+			ID:   "getTwitchUserActivityTimeline",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTwitchUserActivityTimelineOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeGetTwitchUserActivityTimelineRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response GetTwitchUserActivityTimelineRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTwitchUserActivityTimelineOperation,
+			OperationSummary: "",
+			OperationID:      "getTwitchUserActivityTimeline",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetTwitchUserActivityTimelineRequest
+			Params   = struct{}
+			Response = GetTwitchUserActivityTimelineRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTwitchUserActivityTimeline(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTwitchUserActivityTimeline(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTwitchUserActivityTimelineResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTwitchUserEmoteUsageRequest handles getTwitchUserEmoteUsage operation.
+//
+// Emotes the user used most in monitored channels' chat.
+//
+// GET /api/v1/twitch/users/{twitch_user_id}/emotes
+func (s *Server) handleGetTwitchUserEmoteUsageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTwitchUserEmoteUsage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users/{twitch_user_id}/emotes"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTwitchUserEmoteUsageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTwitchUserEmoteUsageOperation,
+			ID:   "getTwitchUserEmoteUsage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTwitchUserEmoteUsageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTwitchUserEmoteUsageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetTwitchUserEmoteUsageRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTwitchUserEmoteUsageOperation,
+			OperationSummary: "",
+			OperationID:      "getTwitchUserEmoteUsage",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "twitch_user_id",
+					In:   "path",
+				}: params.TwitchUserID,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTwitchUserEmoteUsageParams
+			Response = GetTwitchUserEmoteUsageRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTwitchUserEmoteUsageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTwitchUserEmoteUsage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTwitchUserEmoteUsage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTwitchUserEmoteUsageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTwitchUserProfileRequest handles getTwitchUserProfile operation.
+//
+// POST /api/v1/twitch/users/profile
+func (s *Server) handleGetTwitchUserProfileRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTwitchUserProfile"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users/profile"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTwitchUserProfileOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTwitchUserProfileOperation,
+			ID:   "getTwitchUserProfile",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTwitchUserProfileOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeGetTwitchUserProfileRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response GetTwitchUserProfileRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTwitchUserProfileOperation,
+			OperationSummary: "",
+			OperationID:      "getTwitchUserProfile",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GetTwitchUserProfileRequest
+			Params   = struct{}
+			Response = GetTwitchUserProfileRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTwitchUserProfile(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTwitchUserProfile(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTwitchUserProfileResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetWatchUiHintsRequest handles getWatchUiHints operation.
+//
+// GET /api/v1/twitch/watch/hints
+func (s *Server) handleGetWatchUiHintsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getWatchUiHints"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/watch/hints"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetWatchUiHintsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetWatchUiHintsOperation,
+			ID:   "getWatchUiHints",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetWatchUiHintsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response *WatchUiHints
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetWatchUiHintsOperation,
+			OperationSummary: "",
+			OperationID:      "getWatchUiHints",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *WatchUiHints
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetWatchUiHints(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetWatchUiHints(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetWatchUiHintsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListAiConversationsRequest handles listAiConversations operation.
+//
+// GET /api/v1/ai/conversations
+func (s *Server) handleListAiConversationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAiConversations"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/ai/conversations"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListAiConversationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListAiConversationsOperation,
+			ID:   "listAiConversations",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListAiConversationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []AiConversation
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListAiConversationsOperation,
+			OperationSummary: "",
+			OperationID:      "listAiConversations",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []AiConversation
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListAiConversations(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListAiConversations(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListAiConversationsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListAiMessagesRequest handles listAiMessages operation.
+//
+// GET /api/v1/ai/conversations/{conversationId}/messages
+func (s *Server) handleListAiMessagesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAiMessages"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/ai/conversations/{conversationId}/messages"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListAiMessagesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListAiMessagesOperation,
+			ID:   "listAiMessages",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListAiMessagesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListAiMessagesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ListAiMessagesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListAiMessagesOperation,
+			OperationSummary: "",
+			OperationID:      "listAiMessages",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "conversationId",
+					In:   "path",
+				}: params.ConversationId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListAiMessagesParams
+			Response = ListAiMessagesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListAiMessagesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListAiMessages(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListAiMessages(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListAiMessagesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListBlocklistsRequest handles listBlocklists operation.
+//
+// GET /api/v1/settings/blocklists
+func (s *Server) handleListBlocklistsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listBlocklists"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/blocklists"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListBlocklistsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListBlocklistsOperation,
+			ID:   "listBlocklists",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListBlocklistsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []Blocklist
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListBlocklistsOperation,
+			OperationSummary: "",
+			OperationID:      "listBlocklists",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []Blocklist
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListBlocklists(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListBlocklists(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListBlocklistsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListChannelBlacklistRequest handles listChannelBlacklist operation.
+//
+// GET /api/v1/settings/channel-blacklist
+func (s *Server) handleListChannelBlacklistRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChannelBlacklist"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/channel-blacklist"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChannelBlacklistOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListChannelBlacklistOperation,
+			ID:   "listChannelBlacklist",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListChannelBlacklistOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []string
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListChannelBlacklistOperation,
+			OperationSummary: "",
+			OperationID:      "listChannelBlacklist",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []string
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListChannelBlacklist(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListChannelBlacklist(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListChannelBlacklistResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListChannelBotEstimatesRequest handles listChannelBotEstimates operation.
+//
+// Estimated bot share of each live monitored channel from the latest bot detection run.
+//
+// GET /api/v1/twitch/bots/channels
+func (s *Server) handleListChannelBotEstimatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChannelBotEstimates"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/bots/channels"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChannelBotEstimatesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListChannelBotEstimatesOperation,
+			ID:   "listChannelBotEstimates",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListChannelBotEstimatesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []ChannelBotEstimate
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListChannelBotEstimatesOperation,
+			OperationSummary: "",
+			OperationID:      "listChannelBotEstimates",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []ChannelBotEstimate
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListChannelBotEstimates(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListChannelBotEstimates(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListChannelBotEstimatesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListChannelChattersRequest handles listChannelChatters operation.
+//
+// POST /api/v1/twitch/channels/chatters
+func (s *Server) handleListChannelChattersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChannelChatters"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/channels/chatters"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChannelChattersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListChannelChattersOperation,
+			ID:   "listChannelChatters",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListChannelChattersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeListChannelChattersRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ListChannelChattersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListChannelChattersOperation,
+			OperationSummary: "",
+			OperationID:      "listChannelChatters",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *ListChannelChattersRequest
+			Params   = struct{}
+			Response = ListChannelChattersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListChannelChatters(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListChannelChatters(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListChannelChattersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListChannelDiscoveryCandidatesRequest handles listChannelDiscoveryCandidates operation.
+//
+// GET /api/v1/settings/channel-discovery/candidates
+func (s *Server) handleListChannelDiscoveryCandidatesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChannelDiscoveryCandidates"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/channel-discovery/candidates"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChannelDiscoveryCandidatesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListChannelDiscoveryCandidatesOperation,
+			ID:   "listChannelDiscoveryCandidates",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListChannelDiscoveryCandidatesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []DiscoveryCandidate
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListChannelDiscoveryCandidatesOperation,
+			OperationSummary: "",
+			OperationID:      "listChannelDiscoveryCandidates",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []DiscoveryCandidate
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListChannelDiscoveryCandidates(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListChannelDiscoveryCandidates(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListChannelDiscoveryCandidatesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListChatHistoryRequest handles listChatHistory operation.
+//
+// GET /api/v1/twitch/chat/history
+func (s *Server) handleListChatHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listChatHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/chat/history"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListChatHistoryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListChatHistoryOperation,
+			ID:   "listChatHistory",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListChatHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListChatHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ListChatHistoryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListChatHistoryOperation,
+			OperationSummary: "",
+			OperationID:      "listChatHistory",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "channel",
+					In:   "query",
+				}: params.Channel,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListChatHistoryParams
+			Response = ListChatHistoryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListChatHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListChatHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListChatHistory(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListChatHistoryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListEmoteSetsRequest handles listEmoteSets operation.
+//
+// GET /api/v1/settings/emote-sets
+func (s *Server) handleListEmoteSetsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listEmoteSets"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/emote-sets"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListEmoteSetsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListEmoteSetsOperation,
+			ID:   "listEmoteSets",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListEmoteSetsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []EmoteSet
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListEmoteSetsOperation,
+			OperationSummary: "",
+			OperationID:      "listEmoteSets",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []EmoteSet
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListEmoteSets(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListEmoteSets(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListEmoteSetsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListIrcMonitorJoinedHistoryRequest handles listIrcMonitorJoinedHistory operation.
+//
+// Historical IRC joined channel counts (periodic samples).
+//
+// GET /api/v1/twitch/irc-monitor/joined-history
+func (s *Server) handleListIrcMonitorJoinedHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listIrcMonitorJoinedHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/irc-monitor/joined-history"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListIrcMonitorJoinedHistoryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListIrcMonitorJoinedHistoryOperation,
+			ID:   "listIrcMonitorJoinedHistory",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListIrcMonitorJoinedHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListIrcMonitorJoinedHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []IrcJoinedSample
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListIrcMonitorJoinedHistoryOperation,
+			OperationSummary: "Historical IRC joined channel counts (periodic samples)",
+			OperationID:      "listIrcMonitorJoinedHistory",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "days",
+					In:   "query",
+				}: params.Days,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListIrcMonitorJoinedHistoryParams
+			Response = []IrcJoinedSample
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListIrcMonitorJoinedHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListIrcMonitorJoinedHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListIrcMonitorJoinedHistory(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListIrcMonitorJoinedHistoryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListLikelyBotsRequest handles listLikelyBots operation.
+//
+// Accounts present in many monitored channels at once that (almost) never chat, as flagged by the
+// periodic bot detection. Users stay listed for 7 days after they were last detected.
+//
+// GET /api/v1/twitch/bots
+func (s *Server) handleListLikelyBotsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listLikelyBots"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/bots"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListLikelyBotsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListLikelyBotsOperation,
+			ID:   "listLikelyBots",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListLikelyBotsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListLikelyBotsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []LikelyBot
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListLikelyBotsOperation,
+			OperationSummary: "",
+			OperationID:      "listLikelyBots",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListLikelyBotsParams
+			Response = []LikelyBot
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListLikelyBotsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListLikelyBots(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListLikelyBots(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListLikelyBotsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListLoginPatternsRequest handles listLoginPatterns operation.
+//
+// GET /api/v1/settings/login-patterns
+func (s *Server) handleListLoginPatternsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listLoginPatterns"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/login-patterns"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListLoginPatternsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListLoginPatternsOperation,
+			ID:   "listLoginPatterns",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListLoginPatternsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []LoginPattern
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListLoginPatternsOperation,
+			OperationSummary: "",
+			OperationID:      "listLoginPatterns",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []LoginPattern
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListLoginPatterns(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListLoginPatterns(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListLoginPatternsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListNotificationDeliveriesRequest handles listNotificationDeliveries operation.
+//
+// Notification outbox delivery log (newest first) with per-attempt status codes, response snippets
+// and errors.
+// Cursor-based incremental loading like listNotifications.
+//
+// GET /api/v1/settings/notifications/deliveries
+func (s *Server) handleListNotificationDeliveriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listNotificationDeliveries"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/deliveries"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListNotificationDeliveriesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListNotificationDeliveriesOperation,
+			ID:   "listNotificationDeliveries",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListNotificationDeliveriesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListNotificationDeliveriesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []NotificationDelivery
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListNotificationDeliveriesOperation,
+			OperationSummary: "",
+			OperationID:      "listNotificationDeliveries",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "notification_id",
+					In:   "query",
+				}: params.NotificationID,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_created_at",
+					In:   "query",
+				}: params.CursorCreatedAt,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListNotificationDeliveriesParams
+			Response = []NotificationDelivery
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListNotificationDeliveriesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListNotificationDeliveries(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListNotificationDeliveries(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListNotificationDeliveriesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListNotificationSnoozesRequest handles listNotificationSnoozes operation.
+//
+// Active snoozes, soonest expiry first.
+//
+// GET /api/v1/settings/notifications/snoozes
+func (s *Server) handleListNotificationSnoozesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listNotificationSnoozes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/snoozes"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListNotificationSnoozesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListNotificationSnoozesOperation,
+			ID:   "listNotificationSnoozes",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListNotificationSnoozesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []NotificationSnooze
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListNotificationSnoozesOperation,
+			OperationSummary: "",
+			OperationID:      "listNotificationSnoozes",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []NotificationSnooze
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListNotificationSnoozes(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListNotificationSnoozes(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListNotificationSnoozesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListNotificationsRequest handles listNotifications operation.
+//
+// List notification entries (newest first) with cursor-based incremental loading.
+//
+// GET /api/v1/settings/notifications
+func (s *Server) handleListNotificationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listNotifications"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListNotificationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListNotificationsOperation,
+			ID:   "listNotifications",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListNotificationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListNotificationsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []NotificationEntry
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListNotificationsOperation,
+			OperationSummary: "",
+			OperationID:      "listNotifications",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_created_at",
+					In:   "query",
+				}: params.CursorCreatedAt,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListNotificationsParams
+			Response = []NotificationEntry
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListNotificationsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListNotifications(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListNotifications(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListNotificationsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRecordedStreamActivityRequest handles listRecordedStreamActivity operation.
+//
+// Non-message activity in the stream time window (newest first).
+//
+// GET /api/v1/twitch/streams/{streamId}/activity
+func (s *Server) handleListRecordedStreamActivityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRecordedStreamActivity"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/activity"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRecordedStreamActivityOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRecordedStreamActivityOperation,
+			ID:   "listRecordedStreamActivity",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListRecordedStreamActivityOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListRecordedStreamActivityParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ListRecordedStreamActivityRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRecordedStreamActivityOperation,
+			OperationSummary: "",
+			OperationID:      "listRecordedStreamActivity",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "streamId",
+					In:   "path",
+				}: params.StreamId,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_created_at",
+					In:   "query",
+				}: params.CursorCreatedAt,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListRecordedStreamActivityParams
+			Response = ListRecordedStreamActivityRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListRecordedStreamActivityParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRecordedStreamActivity(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRecordedStreamActivity(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListRecordedStreamActivityResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRecordedStreamMessagesRequest handles listRecordedStreamMessages operation.
+//
+// Chat messages tagged with this stream (newest first).
+//
+// GET /api/v1/twitch/streams/{streamId}/messages
+func (s *Server) handleListRecordedStreamMessagesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRecordedStreamMessages"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/streams/{streamId}/messages"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRecordedStreamMessagesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRecordedStreamMessagesOperation,
+			ID:   "listRecordedStreamMessages",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListRecordedStreamMessagesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListRecordedStreamMessagesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ListRecordedStreamMessagesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRecordedStreamMessagesOperation,
+			OperationSummary: "",
+			OperationID:      "listRecordedStreamMessages",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "streamId",
+					In:   "path",
+				}: params.StreamId,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_created_at",
+					In:   "query",
+				}: params.CursorCreatedAt,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+				{
+					Name: "username",
+					In:   "query",
+				}: params.Username,
+				{
+					Name: "text",
+					In:   "query",
+				}: params.Text,
+				{
+					Name: "chatter_user_id",
+					In:   "query",
+				}: params.ChatterUserID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListRecordedStreamMessagesParams
+			Response = ListRecordedStreamMessagesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListRecordedStreamMessagesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRecordedStreamMessages(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRecordedStreamMessages(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListRecordedStreamMessagesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRecordedStreamsRequest handles listRecordedStreams operation.
+//
+// Recorded stream sessions for monitored channels (newest first).
+//
+// GET /api/v1/twitch/streams
+func (s *Server) handleListRecordedStreamsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRecordedStreams"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/streams"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRecordedStreamsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRecordedStreamsOperation,
+			ID:   "listRecordedStreams",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListRecordedStreamsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListRecordedStreamsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []RecordedStream
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRecordedStreamsOperation,
+			OperationSummary: "",
+			OperationID:      "listRecordedStreams",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "channel_login",
+					In:   "query",
+				}: params.ChannelLogin,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_started_at",
+					In:   "query",
+				}: params.CursorStartedAt,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListRecordedStreamsParams
+			Response = []RecordedStream
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListRecordedStreamsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRecordedStreams(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRecordedStreams(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListRecordedStreamsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRuleTemplateVariablesRequest handles listRuleTemplateVariables operation.
+//
+// Names and descriptions for `$NAME` placeholders in notify and send_chat message templates.
+//
+// GET /api/v1/settings/rules/template-variables
+func (s *Server) handleListRuleTemplateVariablesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRuleTemplateVariables"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/rules/template-variables"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRuleTemplateVariablesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRuleTemplateVariablesOperation,
+			ID:   "listRuleTemplateVariables",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListRuleTemplateVariablesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response *RuleTemplateVariablesResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRuleTemplateVariablesOperation,
+			OperationSummary: "List rule message template placeholders",
+			OperationID:      "listRuleTemplateVariables",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *RuleTemplateVariablesResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRuleTemplateVariables(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRuleTemplateVariables(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListRuleTemplateVariablesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRuleTriggersRequest handles listRuleTriggers operation.
+//
+// List rule trigger events (newest first) with cursor-based incremental loading.
+//
+// GET /api/v1/settings/rule-triggers
+func (s *Server) handleListRuleTriggersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRuleTriggers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/rule-triggers"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRuleTriggersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRuleTriggersOperation,
+			ID:   "listRuleTriggers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListRuleTriggersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListRuleTriggersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []RuleTrigger
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRuleTriggersOperation,
+			OperationSummary: "",
+			OperationID:      "listRuleTriggers",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_created_at",
+					In:   "query",
+				}: params.CursorCreatedAt,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListRuleTriggersParams
+			Response = []RuleTrigger
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListRuleTriggersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRuleTriggers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRuleTriggers(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListRuleTriggersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRulesRequest handles listRules operation.
+//
+// GET /api/v1/settings/rules
+func (s *Server) handleListRulesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRules"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/rules"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListRulesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRulesOperation,
+			ID:   "listRules",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListRulesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []Rule
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRulesOperation,
+			OperationSummary: "",
+			OperationID:      "listRules",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []Rule
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRules(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRules(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListRulesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListSuspicionEventsRequest handles listSuspicionEvents operation.
+//
+// Suspicion audit log (newest first) with filters and cursor-based incremental loading.
+//
+// GET /api/v1/twitch/suspicion-events
+func (s *Server) handleListSuspicionEventsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listSuspicionEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/suspicion-events"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListSuspicionEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListSuspicionEventsOperation,
+			ID:   "listSuspicionEvents",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListSuspicionEventsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListSuspicionEventsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []SuspicionEvent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListSuspicionEventsOperation,
+			OperationSummary: "",
+			OperationID:      "listSuspicionEvents",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "twitch_user_id",
+					In:   "query",
+				}: params.TwitchUserID,
+				{
+					Name: "source",
+					In:   "query",
+				}: params.Source,
+				{
+					Name: "new_is_sus",
+					In:   "query",
+				}: params.NewIsSus,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_created_at",
+					In:   "query",
+				}: params.CursorCreatedAt,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListSuspicionEventsParams
+			Response = []SuspicionEvent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListSuspicionEventsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListSuspicionEvents(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListSuspicionEvents(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListSuspicionEventsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTwitchAccountsRequest handles listTwitchAccounts operation.
+//
+// GET /api/v1/settings/twitch-accounts
+func (s *Server) handleListTwitchAccountsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchAccounts"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchAccountsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTwitchAccountsOperation,
+			ID:   "listTwitchAccounts",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchAccountsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response []TwitchAccount
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTwitchAccountsOperation,
+			OperationSummary: "",
+			OperationID:      "listTwitchAccounts",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []TwitchAccount
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTwitchAccounts(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTwitchAccounts(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListTwitchAccountsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTwitchDirectoryUsersRequest handles listTwitchDirectoryUsers operation.
+//
+// List known Twitch users (chatters and channels) for directory search.
+//
+// GET /api/v1/twitch/users
+func (s *Server) handleListTwitchDirectoryUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchDirectoryUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchDirectoryUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTwitchDirectoryUsersOperation,
+			ID:   "listTwitchDirectoryUsers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchDirectoryUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListTwitchDirectoryUsersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []TwitchUser
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTwitchDirectoryUsersOperation,
+			OperationSummary: "",
+			OperationID:      "listTwitchDirectoryUsers",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "username",
+					In:   "query",
+				}: params.Username,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+				{
+					Name: "monitored_only",
+					In:   "query",
+				}: params.MonitoredOnly,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListTwitchDirectoryUsersParams
+			Response = []TwitchUser
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListTwitchDirectoryUsersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTwitchDirectoryUsers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTwitchDirectoryUsers(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListTwitchDirectoryUsersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTwitchMessagesRequest handles listTwitchMessages operation.
+//
+// Search persisted chat messages (newest first). Omit filters to list recent messages.
+//
+// GET /api/v1/twitch/messages
+func (s *Server) handleListTwitchMessagesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchMessages"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/messages"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchMessagesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTwitchMessagesOperation,
+			ID:   "listTwitchMessages",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchMessagesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListTwitchMessagesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []ChatHistoryEntry
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTwitchMessagesOperation,
+			OperationSummary: "",
+			OperationID:      "listTwitchMessages",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor_created_at",
+					In:   "query",
+				}: params.CursorCreatedAt,
+				{
+					Name: "cursor_id",
+					In:   "query",
+				}: params.CursorID,
+				{
+					Name: "username",
+					In:   "query",
+				}: params.Username,
+				{
+					Name: "text",
+					In:   "query",
+				}: params.Text,
+				{
+					Name: "channel",
+					In:   "query",
+				}: params.Channel,
+				{
+					Name: "created_from",
+					In:   "query",
+				}: params.CreatedFrom,
+				{
+					Name: "created_to",
+					In:   "query",
+				}: params.CreatedTo,
+				{
+					Name: "chatter_user_id",
+					In:   "query",
+				}: params.ChatterUserID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListTwitchMessagesParams
+			Response = []ChatHistoryEntry
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListTwitchMessagesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTwitchMessages(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTwitchMessages(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListTwitchMessagesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTwitchUserActivityRequest handles listTwitchUserActivity operation.
+//
+// POST /api/v1/twitch/users/activity
+func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchUserActivity"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users/activity"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchUserActivityOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTwitchUserActivityOperation,
+			ID:   "listTwitchUserActivity",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchUserActivityOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeListTwitchUserActivityRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ListTwitchUserActivityRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTwitchUserActivityOperation,
+			OperationSummary: "",
+			OperationID:      "listTwitchUserActivity",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *ListTwitchUserActivityRequest
+			Params   = struct{}
+			Response = ListTwitchUserActivityRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTwitchUserActivity(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTwitchUserActivity(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListTwitchUserActivityResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTwitchUsersRequest handles listTwitchUsers operation.
+//
+// GET /api/v1/settings/twitch-users
+func (s *Server) handleListTwitchUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTwitchUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-users"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTwitchUsersOperation,
+			ID:   "listTwitchUsers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListTwitchUsersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []TwitchUser
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTwitchUsersOperation,
+			OperationSummary: "",
+			OperationID:      "listTwitchUsers",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "monitored_only",
+					In:   "query",
+				}: params.MonitoredOnly,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListTwitchUsersParams
+			Response = []TwitchUser
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListTwitchUsersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTwitchUsers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTwitchUsers(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListTwitchUsersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLoginRequest handles login operation.
+//
+// POST /api/v1/auth/login
+func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/login"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: LoginOperation,
+			ID:   "login",
+		}
+	)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeLoginRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response LoginRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    LoginOperation,
+			OperationSummary: "",
+			OperationID:      "login",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *LoginRequest
+			Params   = struct{}
+			Response = LoginRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Login(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Login(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeLoginResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMeRequest handles me operation.
+//
+// GET /api/v1/me
+func (s *Server) handleMeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("me"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/me"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MeOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MeOperation,
+			ID:   "me",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MeOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response MeRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MeOperation,
+			OperationSummary: "",
+			OperationID:      "me",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = MeRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Me(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Me(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeMeResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePatchAiSettingsRequest handles patchAiSettings operation.
+//
+// PATCH /api/v1/ai/settings
+func (s *Server) handlePatchAiSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("patchAiSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/api/v1/ai/settings"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PatchAiSettingsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PatchAiSettingsOperation,
+			ID:   "patchAiSettings",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PatchAiSettingsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePatchAiSettingsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *AiSettings
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PatchAiSettingsOperation,
+			OperationSummary: "",
+			OperationID:      "patchAiSettings",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *PatchAiSettingsRequest
+			Params   = struct{}
+			Response = *AiSettings
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PatchAiSettings(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PatchAiSettings(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePatchAiSettingsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePreviewRuleNotifyRequest handles previewRuleNotify operation.
+//
+// Expands a notify action's `text` template (or the engine default when empty) for a sample event.
+// Sample fields that are omitted use placeholder values.
+//
+// POST /api/v1/settings/rules/notify-preview
+func (s *Server) handlePreviewRuleNotifyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("previewRuleNotify"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/rules/notify-preview"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PreviewRuleNotifyOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PreviewRuleNotifyOperation,
+			ID:   "previewRuleNotify",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PreviewRuleNotifyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodePreviewRuleNotifyRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PreviewRuleNotifyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PreviewRuleNotifyOperation,
+			OperationSummary: "Render a notify action without sending",
+			OperationID:      "previewRuleNotify",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *PreviewRuleNotifyRequest
+			Params   = struct{}
+			Response = PreviewRuleNotifyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PreviewRuleNotify(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PreviewRuleNotify(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePreviewRuleNotifyResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleResendNotificationDeliveryRequest handles resendNotificationDelivery operation.
+//
+// Requeue a delivery (typically dead) as pending with a fresh retry budget; earlier attempts stay in
+// the log.
+//
+// POST /api/v1/settings/notifications/deliveries/resend
+func (s *Server) handleResendNotificationDeliveryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("resendNotificationDelivery"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/deliveries/resend"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ResendNotificationDeliveryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ResendNotificationDeliveryOperation,
+			ID:   "resendNotificationDelivery",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ResendNotificationDeliveryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeResendNotificationDeliveryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ResendNotificationDeliveryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ResendNotificationDeliveryOperation,
+			OperationSummary: "",
+			OperationID:      "resendNotificationDelivery",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *ResendNotificationDeliveryRequest
+			Params   = struct{}
+			Response = ResendNotificationDeliveryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ResendNotificationDelivery(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ResendNotificationDelivery(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeResendNotificationDeliveryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSaveEmoteSetRequest handles saveEmoteSet operation.
+//
+// Creates a third-party emote set or replaces the codes of the set with the same name. Words of
+// incoming chat
+// messages equal to a code (case-sensitive) are stored as emote occurrences; sets scoped to a
+// channel win over
+// global ones. The IRC monitor reloads sets every minute and stored messages are not re-scanned.
+//
+// POST /api/v1/settings/emote-sets
+func (s *Server) handleSaveEmoteSetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("saveEmoteSet"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/emote-sets"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SaveEmoteSetOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SaveEmoteSetOperation,
+			ID:   "saveEmoteSet",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SaveEmoteSetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeSaveEmoteSetRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response SaveEmoteSetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SaveEmoteSetOperation,
+			OperationSummary: "",
+			OperationID:      "saveEmoteSet",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *SaveEmoteSetRequest
+			Params   = struct{}
+			Response = SaveEmoteSetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SaveEmoteSet(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SaveEmoteSet(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeSaveEmoteSetResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleScanLikelyBotsRequest handles scanLikelyBots operation.
+//
+// Runs bot detection now instead of waiting for the next periodic run.
+//
+// POST /api/v1/twitch/bots/scan
+func (s *Server) handleScanLikelyBotsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scanLikelyBots"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/bots/scan"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ScanLikelyBotsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ScanLikelyBotsOperation,
+			ID:   "scanLikelyBots",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ScanLikelyBotsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response *ScanLikelyBotsNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ScanLikelyBotsOperation,
+			OperationSummary: "",
+			OperationID:      "scanLikelyBots",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *ScanLikelyBotsNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.ScanLikelyBots(ctx)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.ScanLikelyBots(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeScanLikelyBotsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleScanTwitchUserAltsRequest handles scanTwitchUserAlts operation.
+//
+// Re-scores the user's possible alts now instead of waiting for the hourly alt-detection run.
+//
+// POST /api/v1/twitch/users/{twitch_user_id}/alts/scan
+func (s *Server) handleScanTwitchUserAltsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("scanTwitchUserAlts"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users/{twitch_user_id}/alts/scan"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ScanTwitchUserAltsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ScanTwitchUserAltsOperation,
+			ID:   "scanTwitchUserAlts",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ScanTwitchUserAltsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeScanTwitchUserAltsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ScanTwitchUserAltsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ScanTwitchUserAltsOperation,
+			OperationSummary: "",
+			OperationID:      "scanTwitchUserAlts",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "twitch_user_id",
+					In:   "path",
+				}: params.TwitchUserID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ScanTwitchUserAltsParams
+			Response = ScanTwitchUserAltsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackScanTwitchUserAltsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ScanTwitchUserAlts(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ScanTwitchUserAlts(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeScanTwitchUserAltsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSendMessageRequest handles sendMessage operation.
+//
+// POST /api/v1/twitch/send
+func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("sendMessage"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/send"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SendMessageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SendMessageOperation,
+			ID:   "sendMessage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SendMessageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeSendMessageRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response SendMessageRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SendMessageOperation,
+			OperationSummary: "",
+			OperationID:      "sendMessage",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *SendMessageRequest
+			Params   = struct{}
+			Response = SendMessageRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SendMessage(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SendMessage(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeSendMessageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSetChannelBlacklistRequest handles setChannelBlacklist operation.
+//
+// POST /api/v1/settings/channel-blacklist
+func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("setChannelBlacklist"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/channel-blacklist"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SetChannelBlacklistOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SetChannelBlacklistOperation,
+			ID:   "setChannelBlacklist",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SetChannelBlacklistOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeSetChannelBlacklistRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response SetChannelBlacklistRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SetChannelBlacklistOperation,
+			OperationSummary: "",
+			OperationID:      "setChannelBlacklist",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *ChannelBlacklistChange
+			Params   = struct{}
+			Response = SetChannelBlacklistRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SetChannelBlacklist(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SetChannelBlacklist(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeSetChannelBlacklistResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSetTwitchUserLinkRequest handles setTwitchUserLink operation.
+//
+// Confirms or rejects that two users are the same person.
+//
+// POST /api/v1/twitch/users/links
+func (s *Server) handleSetTwitchUserLinkRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("setTwitchUserLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/twitch/users/links"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SetTwitchUserLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SetTwitchUserLinkOperation,
+			ID:   "setTwitchUserLink",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SetTwitchUserLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeSetTwitchUserLinkRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response SetTwitchUserLinkRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SetTwitchUserLinkOperation,
+			OperationSummary: "",
+			OperationID:      "setTwitchUserLink",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *SetTwitchUserLinkRequest
+			Params   = struct{}
+			Response = SetTwitchUserLinkRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SetTwitchUserLink(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SetTwitchUserLink(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeSetTwitchUserLinkResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleStartSuspicionReevaluationRequest handles startSuspicionReevaluation operation.
+//
+// Re-scores every known user from cached enrichment data in the background. Progress is also pushed
+// over /ws as suspicion_reevaluation messages. A request made while a run is in progress is queued.
+//
+// POST /api/v1/settings/suspicion-settings/reevaluate
+func (s *Server) handleStartSuspicionReevaluationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("startSuspicionReevaluation"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/suspicion-settings/reevaluate"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StartSuspicionReevaluationOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: StartSuspicionReevaluationOperation,
+			ID:   "startSuspicionReevaluation",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, StartSuspicionReevaluationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeStartSuspicionReevaluationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *SuspicionReevaluation
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    StartSuspicionReevaluationOperation,
+			OperationSummary: "",
+			OperationID:      "startSuspicionReevaluation",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = OptStartSuspicionReevaluationRequest
+			Params   = struct{}
+			Response = *SuspicionReevaluation
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.StartSuspicionReevaluation(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.StartSuspicionReevaluation(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeStartSuspicionReevaluationResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleStartTwitchOAuthRequest handles startTwitchOAuth operation.
+//
+// Start Twitch authorization (browser) to link an account without pasting a refresh token.
+//
+// POST /api/v1/settings/twitch-accounts/oauth/start
+func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("startTwitchOAuth"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts/oauth/start"),
+	}
+	// Add attributes from config.
+	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StartTwitchOAuthOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: StartTwitchOAuthOperation,
+			ID:   "startTwitchOAuth",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, StartTwitchOAuthOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeStartTwitchOAuthRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
 		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
@@ -8651,13 +17051,13 @@ func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped
 		}
 	}()
 
-	var response ListTwitchUserActivityRes
+	var response *StartTwitchOAuthResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTwitchUserActivityOperation,
-			OperationSummary: "",
-			OperationID:      "listTwitchUserActivity",
+			OperationName:    StartTwitchOAuthOperation,
+			OperationSummary: "Start Twitch authorization (browser) to link an account without pasting a refresh token",
+			OperationID:      "startTwitchOAuth",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -8665,9 +17065,9 @@ func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped
 		}
 
 		type (
-			Request  = *ListTwitchUserActivityRequest
+			Request  = OptStartTwitchOAuthRequest
 			Params   = struct{}
-			Response = ListTwitchUserActivityRes
+			Response = *StartTwitchOAuthResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8678,12 +17078,12 @@ func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTwitchUserActivity(ctx, request)
+				response, err = s.h.StartTwitchOAuth(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTwitchUserActivity(ctx, request)
+		response, err = s.h.StartTwitchOAuth(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8691,7 +17091,7 @@ func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeListTwitchUserActivityResponse(response, w, span); err != nil {
+	if err := encodeStartTwitchOAuthResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8700,22 +17100,22 @@ func (s *Server) handleListTwitchUserActivityRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleListTwitchUsersRequest handles listTwitchUsers operation.
+// handleStopAiAgentRequest handles stopAiAgent operation.
 //
-// GET /api/v1/settings/twitch-users
-func (s *Server) handleListTwitchUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/ai/conversations/{conversationId}/stop
+func (s *Server) handleStopAiAgentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTwitchUsers"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-users"),
+		otelogen.OperationID("stopAiAgent"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/ai/conversations/{conversationId}/stop"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTwitchUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), StopAiAgentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8770,15 +17170,15 @@ func (s *Server) handleListTwitchUsersRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTwitchUsersOperation,
-			ID:   "listTwitchUsers",
+			Name: StopAiAgentOperation,
+			ID:   "stopAiAgent",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListTwitchUsersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, StopAiAgentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8818,7 +17218,7 @@ func (s *Server) handleListTwitchUsersRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeListTwitchUsersParams(args, argsEscaped, r)
+	params, err := decodeStopAiAgentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8831,28 +17231,28 @@ func (s *Server) handleListTwitchUsersRequest(args [0]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response []TwitchUser
+	var response StopAiAgentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTwitchUsersOperation,
+			OperationName:    StopAiAgentOperation,
 			OperationSummary: "",
-			OperationID:      "listTwitchUsers",
+			OperationID:      "stopAiAgent",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "monitored_only",
-					In:   "query",
-				}: params.MonitoredOnly,
+					Name: "conversationId",
+					In:   "path",
+				}: params.ConversationId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListTwitchUsersParams
-			Response = []TwitchUser
+			Params   = StopAiAgentParams
+			Response = StopAiAgentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8861,14 +17261,14 @@ func (s *Server) handleListTwitchUsersRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackListTwitchUsersParams,
+			unpackStopAiAgentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTwitchUsers(ctx, params)
+				response, err = s.h.StopAiAgent(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTwitchUsers(ctx, params)
+		response, err = s.h.StopAiAgent(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8876,7 +17276,7 @@ func (s *Server) handleListTwitchUsersRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListTwitchUsersResponse(response, w, span); err != nil {
+	if err := encodeStopAiAgentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8885,22 +17285,26 @@ func (s *Server) handleListTwitchUsersRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleLoginRequest handles login operation.
+// handleSyncBlocklistRequest handles syncBlocklist operation.
 //
-// POST /api/v1/auth/login
-func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Pulls a list's `source_url` now. Download and parse failures are reported in `last_sync_error` of
+// the
+// returned list; unchanged content keeps the current entries.
+//
+// POST /api/v1/settings/blocklists/sync
+func (s *Server) handleSyncBlocklistRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("login"),
+		otelogen.OperationID("syncBlocklist"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/login"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/blocklists/sync"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SyncBlocklistOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8950,18 +17354,62 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: LoginOperation,
-			ID:   "login",
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SyncBlocklistOperation,
+			ID:   "syncBlocklist",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SyncBlocklistOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-	)
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeLoginRequest(r)
+	request, rawBody, close, err := s.decodeSyncBlocklistRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -8977,13 +17425,13 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		}
 	}()
 
-	var response LoginRes
+	var response SyncBlocklistRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LoginOperation,
+			OperationName:    SyncBlocklistOperation,
 			OperationSummary: "",
-			OperationID:      "login",
+			OperationID:      "syncBlocklist",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -8991,9 +17439,9 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		}
 
 		type (
-			Request  = *LoginRequest
+			Request  = *SyncBlocklistRequest
 			Params   = struct{}
-			Response = LoginRes
+			Response = SyncBlocklistRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9004,12 +17452,12 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Login(ctx, request)
+				response, err = s.h.SyncBlocklist(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Login(ctx, request)
+		response, err = s.h.SyncBlocklist(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9017,7 +17465,7 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		return
 	}
 
-	if err := encodeLoginResponse(response, w, span); err != nil {
+	if err := encodeSyncBlocklistResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9026,22 +17474,25 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 	}
 }
 
-// handleMeRequest handles me operation.
+// handleTestLoginPatternRequest handles testLoginPattern operation.
 //
-// GET /api/v1/me
-func (s *Server) handleMeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Checks a pattern against sample logins without saving it; shows how the pattern and each login are
+// normalized before matching.
+//
+// POST /api/v1/settings/login-patterns/test
+func (s *Server) handleTestLoginPatternRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("me"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/me"),
+		otelogen.OperationID("testLoginPattern"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/login-patterns/test"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MeOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TestLoginPatternOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9096,15 +17547,15 @@ func (s *Server) handleMeRequest(args [0]string, argsEscaped bool, w http.Respon
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MeOperation,
-			ID:   "me",
+			Name: TestLoginPatternOperation,
+			ID:   "testLoginPattern",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MeOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, TestLoginPatternOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9146,24 +17597,39 @@ func (s *Server) handleMeRequest(args [0]string, argsEscaped bool, w http.Respon
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeTestLoginPatternRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response MeRes
+	var response *TestLoginPatternResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MeOperation,
+			OperationName:    TestLoginPatternOperation,
 			OperationSummary: "",
-			OperationID:      "me",
-			Body:             nil,
+			OperationID:      "testLoginPattern",
+			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *TestLoginPatternRequest
 			Params   = struct{}
-			Response = MeRes
+			Response = *TestLoginPatternResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9174,12 +17640,12 @@ func (s *Server) handleMeRequest(args [0]string, argsEscaped bool, w http.Respon
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Me(ctx)
+				response, err = s.h.TestLoginPattern(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Me(ctx)
+		response, err = s.h.TestLoginPattern(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9187,7 +17653,7 @@ func (s *Server) handleMeRequest(args [0]string, argsEscaped bool, w http.Respon
 		return
 	}
 
-	if err := encodeMeResponse(response, w, span); err != nil {
+	if err := encodeTestLoginPatternResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9196,22 +17662,27 @@ func (s *Server) handleMeRequest(args [0]string, argsEscaped bool, w http.Respon
 	}
 }
 
-// handlePatchAiSettingsRequest handles patchAiSettings operation.
+// handleTestNotificationRequest handles testNotification operation.
 //
-// PATCH /api/v1/ai/settings
-func (s *Server) handlePatchAiSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Renders a sample event of each type (or only `event_types`) and sends it through the entry's
+// provider
+// synchronously, bypassing the outbox, snoozes and the entry's policy. Works for disabled entries.
+// Tokens and URL credentials from the entry settings are redacted from responses and errors.
+//
+// POST /api/v1/settings/notifications/{id}/test
+func (s *Server) handleTestNotificationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("patchAiSettings"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/api/v1/ai/settings"),
+		otelogen.OperationID("testNotification"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/{id}/test"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PatchAiSettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TestNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9266,15 +17737,15 @@ func (s *Server) handlePatchAiSettingsRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PatchAiSettingsOperation,
-			ID:   "patchAiSettings",
+			Name: TestNotificationOperation,
+			ID:   "testNotification",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, PatchAiSettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, TestNotificationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9314,9 +17785,19 @@ func (s *Server) handlePatchAiSettingsRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeTestNotificationParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePatchAiSettingsRequest(r)
+	request, rawBody, close, err := s.decodeTestNotificationRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -9332,23 +17813,28 @@ func (s *Server) handlePatchAiSettingsRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response *AiSettings
+	var response TestNotificationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PatchAiSettingsOperation,
-			OperationSummary: "",
-			OperationID:      "patchAiSettings",
+			OperationName:    TestNotificationOperation,
+			OperationSummary: "Send sample notifications",
+			OperationID:      "testNotification",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *PatchAiSettingsRequest
-			Params   = struct{}
-			Response = *AiSettings
+			Request  = OptTestNotificationRequest
+			Params   = TestNotificationParams
+			Response = TestNotificationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9357,14 +17843,14 @@ func (s *Server) handlePatchAiSettingsRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackTestNotificationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PatchAiSettings(ctx, request)
+				response, err = s.h.TestNotification(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PatchAiSettings(ctx, request)
+		response, err = s.h.TestNotification(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9372,7 +17858,7 @@ func (s *Server) handlePatchAiSettingsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodePatchAiSettingsResponse(response, w, span); err != nil {
+	if err := encodeTestNotificationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9381,22 +17867,22 @@ func (s *Server) handlePatchAiSettingsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleSendMessageRequest handles sendMessage operation.
+// handleTestRuleRegexRequest handles testRuleRegex operation.
 //
-// POST /api/v1/twitch/send
-func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/rules/test-regex
+func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("sendMessage"),
+		otelogen.OperationID("testRuleRegex"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/twitch/send"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/rules/test-regex"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SendMessageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TestRuleRegexOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9451,15 +17937,15 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SendMessageOperation,
-			ID:   "sendMessage",
+			Name: TestRuleRegexOperation,
+			ID:   "testRuleRegex",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SendMessageOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, TestRuleRegexOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9501,7 +17987,7 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeSendMessageRequest(r)
+	request, rawBody, close, err := s.decodeTestRuleRegexRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -9517,13 +18003,13 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 		}
 	}()
 
-	var response SendMessageRes
+	var response *TestRuleRegexResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SendMessageOperation,
+			OperationName:    TestRuleRegexOperation,
 			OperationSummary: "",
-			OperationID:      "sendMessage",
+			OperationID:      "testRuleRegex",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -9531,9 +18017,9 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 		}
 
 		type (
-			Request  = *SendMessageRequest
+			Request  = *TestRuleRegexRequest
 			Params   = struct{}
-			Response = SendMessageRes
+			Response = *TestRuleRegexResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9544,12 +18030,12 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SendMessage(ctx, request)
+				response, err = s.h.TestRuleRegex(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SendMessage(ctx, request)
+		response, err = s.h.TestRuleRegex(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9557,7 +18043,7 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeSendMessageResponse(response, w, span); err != nil {
+	if err := encodeTestRuleRegexResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9566,22 +18052,22 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleSetChannelBlacklistRequest handles setChannelBlacklist operation.
+// handleUpdateBotDetectionSettingsRequest handles updateBotDetectionSettings operation.
 //
-// POST /api/v1/settings/channel-blacklist
-func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /api/v1/settings/bot-detection
+func (s *Server) handleUpdateBotDetectionSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("setChannelBlacklist"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/channel-blacklist"),
+		otelogen.OperationID("updateBotDetectionSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/bot-detection"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SetChannelBlacklistOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateBotDetectionSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9636,15 +18122,15 @@ func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SetChannelBlacklistOperation,
-			ID:   "setChannelBlacklist",
+			Name: UpdateBotDetectionSettingsOperation,
+			ID:   "updateBotDetectionSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SetChannelBlacklistOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateBotDetectionSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9686,7 +18172,7 @@ func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeSetChannelBlacklistRequest(r)
+	request, rawBody, close, err := s.decodeUpdateBotDetectionSettingsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -9702,13 +18188,13 @@ func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bo
 		}
 	}()
 
-	var response SetChannelBlacklistRes
+	var response UpdateBotDetectionSettingsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SetChannelBlacklistOperation,
+			OperationName:    UpdateBotDetectionSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "setChannelBlacklist",
+			OperationID:      "updateBotDetectionSettings",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -9716,9 +18202,9 @@ func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bo
 		}
 
 		type (
-			Request  = *ChannelBlacklistChange
+			Request  = *BotDetectionSettings
 			Params   = struct{}
-			Response = SetChannelBlacklistRes
+			Response = UpdateBotDetectionSettingsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9729,12 +18215,12 @@ func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetChannelBlacklist(ctx, request)
+				response, err = s.h.UpdateBotDetectionSettings(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetChannelBlacklist(ctx, request)
+		response, err = s.h.UpdateBotDetectionSettings(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9742,7 +18228,7 @@ func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeSetChannelBlacklistResponse(response, w, span); err != nil {
+	if err := encodeUpdateBotDetectionSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9751,24 +18237,22 @@ func (s *Server) handleSetChannelBlacklistRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleStartTwitchOAuthRequest handles startTwitchOAuth operation.
-//
-// Start Twitch authorization (browser) to link an account without pasting a refresh token.
+// handleUpdateChannelDiscoverySettingsRequest handles updateChannelDiscoverySettings operation.
 //
-// POST /api/v1/settings/twitch-accounts/oauth/start
-func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /api/v1/settings/channel-discovery
+func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("startTwitchOAuth"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts/oauth/start"),
+		otelogen.OperationID("updateChannelDiscoverySettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/channel-discovery"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StartTwitchOAuthOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateChannelDiscoverySettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9823,15 +18307,15 @@ func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StartTwitchOAuthOperation,
-			ID:   "startTwitchOAuth",
+			Name: UpdateChannelDiscoverySettingsOperation,
+			ID:   "updateChannelDiscoverySettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StartTwitchOAuthOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateChannelDiscoverySettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9873,7 +18357,7 @@ func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeStartTwitchOAuthRequest(r)
+	request, rawBody, close, err := s.decodeUpdateChannelDiscoverySettingsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -9889,13 +18373,13 @@ func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response *StartTwitchOAuthResponse
+	var response UpdateChannelDiscoverySettingsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StartTwitchOAuthOperation,
-			OperationSummary: "Start Twitch authorization (browser) to link an account without pasting a refresh token",
-			OperationID:      "startTwitchOAuth",
+			OperationName:    UpdateChannelDiscoverySettingsOperation,
+			OperationSummary: "",
+			OperationID:      "updateChannelDiscoverySettings",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -9903,9 +18387,9 @@ func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = OptStartTwitchOAuthRequest
+			Request  = *ChannelDiscoverySettings
 			Params   = struct{}
-			Response = *StartTwitchOAuthResponse
+			Response = UpdateChannelDiscoverySettingsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9916,12 +18400,12 @@ func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool,
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StartTwitchOAuth(ctx, request)
+				response, err = s.h.UpdateChannelDiscoverySettings(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StartTwitchOAuth(ctx, request)
+		response, err = s.h.UpdateChannelDiscoverySettings(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9929,7 +18413,7 @@ func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeStartTwitchOAuthResponse(response, w, span); err != nil {
+	if err := encodeUpdateChannelDiscoverySettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9938,22 +18422,22 @@ func (s *Server) handleStartTwitchOAuthRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleStopAiAgentRequest handles stopAiAgent operation.
+// handleUpdateChatClassifierSettingsRequest handles updateChatClassifierSettings operation.
 //
-// POST /api/v1/ai/conversations/{conversationId}/stop
-func (s *Server) handleStopAiAgentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /api/v1/settings/chat-classifier
+func (s *Server) handleUpdateChatClassifierSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("stopAiAgent"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/ai/conversations/{conversationId}/stop"),
+		otelogen.OperationID("updateChatClassifierSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/chat-classifier"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), StopAiAgentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateChatClassifierSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10008,15 +18492,15 @@ func (s *Server) handleStopAiAgentRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: StopAiAgentOperation,
-			ID:   "stopAiAgent",
+			Name: UpdateChatClassifierSettingsOperation,
+			ID:   "updateChatClassifierSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, StopAiAgentOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateChatClassifierSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10056,41 +18540,41 @@ func (s *Server) handleStopAiAgentRequest(args [1]string, argsEscaped bool, w ht
 			return
 		}
 	}
-	params, err := decodeStopAiAgentParams(args, argsEscaped, r)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeUpdateChatClassifierSettingsRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var rawBody []byte
-
-	var response StopAiAgentRes
+	var response UpdateChatClassifierSettingsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    StopAiAgentOperation,
+			OperationName:    UpdateChatClassifierSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "stopAiAgent",
-			Body:             nil,
+			OperationID:      "updateChatClassifierSettings",
+			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "conversationId",
-					In:   "path",
-				}: params.ConversationId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = StopAiAgentParams
-			Response = StopAiAgentRes
+			Request  = *ChatClassifierSettings
+			Params   = struct{}
+			Response = UpdateChatClassifierSettingsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10099,14 +18583,14 @@ func (s *Server) handleStopAiAgentRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackStopAiAgentParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.StopAiAgent(ctx, params)
+				response, err = s.h.UpdateChatClassifierSettings(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.StopAiAgent(ctx, params)
+		response, err = s.h.UpdateChatClassifierSettings(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10114,7 +18598,7 @@ func (s *Server) handleStopAiAgentRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeStopAiAgentResponse(response, w, span); err != nil {
+	if err := encodeUpdateChatClassifierSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10123,22 +18607,22 @@ func (s *Server) handleStopAiAgentRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleTestRuleRegexRequest handles testRuleRegex operation.
+// handleUpdateIrcMonitorSettingsRequest handles updateIrcMonitorSettings operation.
 //
-// POST /api/v1/settings/rules/test-regex
-func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /api/v1/settings/irc-monitor-settings
+func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
-	w = statusWriter
-	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("testRuleRegex"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/rules/test-regex"),
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateIrcMonitorSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/irc-monitor-settings"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TestRuleRegexOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateIrcMonitorSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10193,15 +18677,15 @@ func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TestRuleRegexOperation,
-			ID:   "testRuleRegex",
+			Name: UpdateIrcMonitorSettingsOperation,
+			ID:   "updateIrcMonitorSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, TestRuleRegexOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateIrcMonitorSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10243,7 +18727,7 @@ func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeTestRuleRegexRequest(r)
+	request, rawBody, close, err := s.decodeUpdateIrcMonitorSettingsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10259,13 +18743,13 @@ func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *TestRuleRegexResponse
+	var response *IrcMonitorSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TestRuleRegexOperation,
+			OperationName:    UpdateIrcMonitorSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "testRuleRegex",
+			OperationID:      "updateIrcMonitorSettings",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -10273,9 +18757,9 @@ func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = *TestRuleRegexRequest
+			Request  = *IrcMonitorSettings
 			Params   = struct{}
-			Response = *TestRuleRegexResponse
+			Response = *IrcMonitorSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10286,12 +18770,12 @@ func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TestRuleRegex(ctx, request)
+				response, err = s.h.UpdateIrcMonitorSettings(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TestRuleRegex(ctx, request)
+		response, err = s.h.UpdateIrcMonitorSettings(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10299,7 +18783,7 @@ func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeTestRuleRegexResponse(response, w, span); err != nil {
+	if err := encodeUpdateIrcMonitorSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10308,22 +18792,22 @@ func (s *Server) handleTestRuleRegexRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleUpdateChannelDiscoverySettingsRequest handles updateChannelDiscoverySettings operation.
+// handleUpdateNotificationRequest handles updateNotification operation.
 //
-// PATCH /api/v1/settings/channel-discovery
-func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/notifications/update
+func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateChannelDiscoverySettings"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/channel-discovery"),
+		otelogen.OperationID("updateNotification"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/update"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateChannelDiscoverySettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10378,15 +18862,15 @@ func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, arg
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateChannelDiscoverySettingsOperation,
-			ID:   "updateChannelDiscoverySettings",
+			Name: UpdateNotificationOperation,
+			ID:   "updateNotification",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateChannelDiscoverySettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateNotificationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10428,7 +18912,7 @@ func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, arg
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateChannelDiscoverySettingsRequest(r)
+	request, rawBody, close, err := s.decodeUpdateNotificationRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10444,13 +18928,13 @@ func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, arg
 		}
 	}()
 
-	var response UpdateChannelDiscoverySettingsRes
+	var response UpdateNotificationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateChannelDiscoverySettingsOperation,
+			OperationName:    UpdateNotificationOperation,
 			OperationSummary: "",
-			OperationID:      "updateChannelDiscoverySettings",
+			OperationID:      "updateNotification",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -10458,9 +18942,9 @@ func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, arg
 		}
 
 		type (
-			Request  = *ChannelDiscoverySettings
+			Request  = *UpdateNotificationPostRequest
 			Params   = struct{}
-			Response = UpdateChannelDiscoverySettingsRes
+			Response = UpdateNotificationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10471,12 +18955,12 @@ func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, arg
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateChannelDiscoverySettings(ctx, request)
+				response, err = s.h.UpdateNotification(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateChannelDiscoverySettings(ctx, request)
+		response, err = s.h.UpdateNotification(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10484,7 +18968,7 @@ func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, arg
 		return
 	}
 
-	if err := encodeUpdateChannelDiscoverySettingsResponse(response, w, span); err != nil {
+	if err := encodeUpdateNotificationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10493,22 +18977,22 @@ func (s *Server) handleUpdateChannelDiscoverySettingsRequest(args [0]string, arg
 	}
 }
 
-// handleUpdateIrcMonitorSettingsRequest handles updateIrcMonitorSettings operation.
+// handleUpdateRuleRequest handles updateRule operation.
 //
-// PATCH /api/v1/settings/irc-monitor-settings
-func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/rules/update
+func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateIrcMonitorSettings"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/irc-monitor-settings"),
+		otelogen.OperationID("updateRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/rules/update"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateIrcMonitorSettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10563,15 +19047,15 @@ func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateIrcMonitorSettingsOperation,
-			ID:   "updateIrcMonitorSettings",
+			Name: UpdateRuleOperation,
+			ID:   "updateRule",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateIrcMonitorSettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateRuleOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10613,7 +19097,7 @@ func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscap
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateIrcMonitorSettingsRequest(r)
+	request, rawBody, close, err := s.decodeUpdateRuleRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10629,13 +19113,13 @@ func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscap
 		}
 	}()
 
-	var response *IrcMonitorSettings
+	var response UpdateRuleRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateIrcMonitorSettingsOperation,
+			OperationName:    UpdateRuleOperation,
 			OperationSummary: "",
-			OperationID:      "updateIrcMonitorSettings",
+			OperationID:      "updateRule",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -10643,9 +19127,9 @@ func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscap
 		}
 
 		type (
-			Request  = *IrcMonitorSettings
+			Request  = *UpdateRulePostRequest
 			Params   = struct{}
-			Response = *IrcMonitorSettings
+			Response = UpdateRuleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10656,12 +19140,12 @@ func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscap
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateIrcMonitorSettings(ctx, request)
+				response, err = s.h.UpdateRule(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateIrcMonitorSettings(ctx, request)
+		response, err = s.h.UpdateRule(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10669,7 +19153,7 @@ func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeUpdateIrcMonitorSettingsResponse(response, w, span); err != nil {
+	if err := encodeUpdateRuleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10678,22 +19162,22 @@ func (s *Server) handleUpdateIrcMonitorSettingsRequest(args [0]string, argsEscap
 	}
 }
 
-// handleUpdateNotificationRequest handles updateNotification operation.
+// handleUpdateStreamRecapSettingsRequest handles updateStreamRecapSettings operation.
 //
-// POST /api/v1/settings/notifications/update
-func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /api/v1/settings/stream-recap
+func (s *Server) handleUpdateStreamRecapSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateNotification"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/notifications/update"),
+		otelogen.OperationID("updateStreamRecapSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/stream-recap"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateNotificationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateStreamRecapSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10748,15 +19232,15 @@ func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateNotificationOperation,
-			ID:   "updateNotification",
+			Name: UpdateStreamRecapSettingsOperation,
+			ID:   "updateStreamRecapSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateNotificationOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateStreamRecapSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10798,7 +19282,7 @@ func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped boo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateNotificationRequest(r)
+	request, rawBody, close, err := s.decodeUpdateStreamRecapSettingsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10814,13 +19298,13 @@ func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped boo
 		}
 	}()
 
-	var response UpdateNotificationRes
+	var response *StreamRecapSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateNotificationOperation,
+			OperationName:    UpdateStreamRecapSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "updateNotification",
+			OperationID:      "updateStreamRecapSettings",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -10828,9 +19312,9 @@ func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped boo
 		}
 
 		type (
-			Request  = *UpdateNotificationPostRequest
+			Request  = *StreamRecapSettings
 			Params   = struct{}
-			Response = UpdateNotificationRes
+			Response = *StreamRecapSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10841,12 +19325,12 @@ func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped boo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateNotification(ctx, request)
+				response, err = s.h.UpdateStreamRecapSettings(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateNotification(ctx, request)
+		response, err = s.h.UpdateStreamRecapSettings(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10854,7 +19338,7 @@ func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeUpdateNotificationResponse(response, w, span); err != nil {
+	if err := encodeUpdateStreamRecapSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10863,22 +19347,22 @@ func (s *Server) handleUpdateNotificationRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleUpdateRuleRequest handles updateRule operation.
+// handleUpdateSuspicionSettingsRequest handles updateSuspicionSettings operation.
 //
-// POST /api/v1/settings/rules/update
-func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /api/v1/settings/suspicion-settings
+func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateRule"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/rules/update"),
+		otelogen.OperationID("updateSuspicionSettings"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/suspicion-settings"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateRuleOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateSuspicionSettingsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10933,15 +19417,15 @@ func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateRuleOperation,
-			ID:   "updateRule",
+			Name: UpdateSuspicionSettingsOperation,
+			ID:   "updateSuspicionSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateRuleOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateSuspicionSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10983,7 +19467,7 @@ func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateRuleRequest(r)
+	request, rawBody, close, err := s.decodeUpdateSuspicionSettingsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10999,13 +19483,13 @@ func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response UpdateRuleRes
+	var response UpdateSuspicionSettingsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateRuleOperation,
+			OperationName:    UpdateSuspicionSettingsOperation,
 			OperationSummary: "",
-			OperationID:      "updateRule",
+			OperationID:      "updateSuspicionSettings",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -11013,9 +19497,9 @@ func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w htt
 		}
 
 		type (
-			Request  = *UpdateRulePostRequest
+			Request  = *SuspicionSettings
 			Params   = struct{}
-			Response = UpdateRuleRes
+			Response = UpdateSuspicionSettingsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11026,12 +19510,12 @@ func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateRule(ctx, request)
+				response, err = s.h.UpdateSuspicionSettings(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateRule(ctx, request)
+		response, err = s.h.UpdateSuspicionSettings(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11039,7 +19523,7 @@ func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeUpdateRuleResponse(response, w, span); err != nil {
+	if err := encodeUpdateSuspicionSettingsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11048,22 +19532,22 @@ func (s *Server) handleUpdateRuleRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleUpdateSuspicionSettingsRequest handles updateSuspicionSettings operation.
+// handleUpdateTwitchAccountRequest handles updateTwitchAccount operation.
 //
-// PATCH /api/v1/settings/suspicion-settings
-func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/twitch-accounts/update
+func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateSuspicionSettings"),
-		semconv.HTTPRequestMethodKey.String("PATCH"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/suspicion-settings"),
+		otelogen.OperationID("updateTwitchAccount"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts/update"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateSuspicionSettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateTwitchAccountOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11118,15 +19602,15 @@ func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateSuspicionSettingsOperation,
-			ID:   "updateSuspicionSettings",
+			Name: UpdateTwitchAccountOperation,
+			ID:   "updateTwitchAccount",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateSuspicionSettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateTwitchAccountOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11168,7 +19652,7 @@ func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscape
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateSuspicionSettingsRequest(r)
+	request, rawBody, close, err := s.decodeUpdateTwitchAccountRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11184,13 +19668,13 @@ func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscape
 		}
 	}()
 
-	var response *SuspicionSettings
+	var response UpdateTwitchAccountRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateSuspicionSettingsOperation,
+			OperationName:    UpdateTwitchAccountOperation,
 			OperationSummary: "",
-			OperationID:      "updateSuspicionSettings",
+			OperationID:      "updateTwitchAccount",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -11198,9 +19682,9 @@ func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscape
 		}
 
 		type (
-			Request  = *SuspicionSettings
+			Request  = *UpdateTwitchAccountPostRequest
 			Params   = struct{}
-			Response = *SuspicionSettings
+			Response = UpdateTwitchAccountRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11211,12 +19695,12 @@ func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscape
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateSuspicionSettings(ctx, request)
+				response, err = s.h.UpdateTwitchAccount(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateSuspicionSettings(ctx, request)
+		response, err = s.h.UpdateTwitchAccount(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11224,7 +19708,7 @@ func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscape
 		return
 	}
 
-	if err := encodeUpdateSuspicionSettingsResponse(response, w, span); err != nil {
+	if err := encodeUpdateTwitchAccountResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11233,22 +19717,22 @@ func (s *Server) handleUpdateSuspicionSettingsRequest(args [0]string, argsEscape
 	}
 }
 
-// handleUpdateTwitchAccountRequest handles updateTwitchAccount operation.
+// handleUpdateTwitchUserRequest handles updateTwitchUser operation.
 //
-// POST /api/v1/settings/twitch-accounts/update
-func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/settings/twitch-users/update
+func (s *Server) handleUpdateTwitchUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateTwitchAccount"),
+		otelogen.OperationID("updateTwitchUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-accounts/update"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-users/update"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateTwitchAccountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateTwitchUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11303,15 +19787,15 @@ func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateTwitchAccountOperation,
-			ID:   "updateTwitchAccount",
+			Name: UpdateTwitchUserOperation,
+			ID:   "updateTwitchUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateTwitchAccountOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateTwitchUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11353,7 +19837,7 @@ func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bo
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateTwitchAccountRequest(r)
+	request, rawBody, close, err := s.decodeUpdateTwitchUserRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11369,13 +19853,13 @@ func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bo
 		}
 	}()
 
-	var response UpdateTwitchAccountRes
+	var response UpdateTwitchUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateTwitchAccountOperation,
+			OperationName:    UpdateTwitchUserOperation,
 			OperationSummary: "",
-			OperationID:      "updateTwitchAccount",
+			OperationID:      "updateTwitchUser",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -11383,9 +19867,9 @@ func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bo
 		}
 
 		type (
-			Request  = *UpdateTwitchAccountPostRequest
+			Request  = *UpdateTwitchUserPostRequest
 			Params   = struct{}
-			Response = UpdateTwitchAccountRes
+			Response = UpdateTwitchUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11396,12 +19880,12 @@ func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateTwitchAccount(ctx, request)
+				response, err = s.h.UpdateTwitchUser(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateTwitchAccount(ctx, request)
+		response, err = s.h.UpdateTwitchUser(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11409,7 +19893,7 @@ func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeUpdateTwitchAccountResponse(response, w, span); err != nil {
+	if err := encodeUpdateTwitchUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11418,22 +19902,24 @@ func (s *Server) handleUpdateTwitchAccountRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleUpdateTwitchUserRequest handles updateTwitchUser operation.
+// handleUploadBlocklistRequest handles uploadBlocklist operation.
 //
-// POST /api/v1/settings/twitch-users/update
-func (s *Server) handleUpdateTwitchUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// Replaces a blocklist's entries with file content parsed in the list's format.
+//
+// POST /api/v1/settings/blocklists/upload
+func (s *Server) handleUploadBlocklistRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateTwitchUser"),
+		otelogen.OperationID("uploadBlocklist"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/settings/twitch-users/update"),
+		semconv.HTTPRouteKey.String("/api/v1/settings/blocklists/upload"),
 	}
 	// Add attributes from config.
 	otelAttrs = append(otelAttrs, s.cfg.Attributes...)
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateTwitchUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UploadBlocklistOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11488,15 +19974,15 @@ func (s *Server) handleUpdateTwitchUserRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateTwitchUserOperation,
-			ID:   "updateTwitchUser",
+			Name: UploadBlocklistOperation,
+			ID:   "uploadBlocklist",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateTwitchUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UploadBlocklistOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11538,7 +20024,7 @@ func (s *Server) handleUpdateTwitchUserRequest(args [0]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateTwitchUserRequest(r)
+	request, rawBody, close, err := s.decodeUploadBlocklistRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11554,13 +20040,13 @@ func (s *Server) handleUpdateTwitchUserRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response UpdateTwitchUserRes
+	var response UploadBlocklistRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateTwitchUserOperation,
+			OperationName:    UploadBlocklistOperation,
 			OperationSummary: "",
-			OperationID:      "updateTwitchUser",
+			OperationID:      "uploadBlocklist",
 			Body:             request,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -11568,9 +20054,9 @@ func (s *Server) handleUpdateTwitchUserRequest(args [0]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = *UpdateTwitchUserPostRequest
+			Request  = *UploadBlocklistRequest
 			Params   = struct{}
-			Response = UpdateTwitchUserRes
+			Response = UploadBlocklistRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11581,12 +20067,12 @@ func (s *Server) handleUpdateTwitchUserRequest(args [0]string, argsEscaped bool,
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateTwitchUser(ctx, request)
+				response, err = s.h.UploadBlocklist(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateTwitchUser(ctx, request)
+		response, err = s.h.UploadBlocklist(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11594,7 +20080,7 @@ func (s *Server) handleUpdateTwitchUserRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeUpdateTwitchUserResponse(response, w, span); err != nil {
+	if err := encodeUploadBlocklistResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
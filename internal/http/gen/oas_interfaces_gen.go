@@ -13,6 +13,18 @@ type CreateAiMessageRes interface {
 	createAiMessageRes()
 }
 
+type CreateBlocklistRes interface {
+	createBlocklistRes()
+}
+
+type CreateLoginPatternRes interface {
+	createLoginPatternRes()
+}
+
+type CreateNotificationSnoozeRes interface {
+	createNotificationSnoozeRes()
+}
+
 type CreateTwitchUserRes interface {
 	createTwitchUserRes()
 }
@@ -21,10 +33,26 @@ type DeleteAiConversationRes interface {
 	deleteAiConversationRes()
 }
 
+type DeleteBlocklistRes interface {
+	deleteBlocklistRes()
+}
+
+type DeleteEmoteSetRes interface {
+	deleteEmoteSetRes()
+}
+
+type DeleteLoginPatternRes interface {
+	deleteLoginPatternRes()
+}
+
 type DeleteNotificationRes interface {
 	deleteNotificationRes()
 }
 
+type DeleteNotificationSnoozeRes interface {
+	deleteNotificationSnoozeRes()
+}
+
 type DeleteRuleRes interface {
 	deleteRuleRes()
 }
@@ -33,14 +61,42 @@ type DeleteTwitchAccountRes interface {
 	deleteTwitchAccountRes()
 }
 
+type DeleteTwitchUserLinkRes interface {
+	deleteTwitchUserLinkRes()
+}
+
 type DenyChannelDiscoveryCandidateRes interface {
 	denyChannelDiscoveryCandidateRes()
 }
 
+type GetChannelActivityHeatmapRes interface {
+	getChannelActivityHeatmapRes()
+}
+
+type GetChannelAnalyticsRes interface {
+	getChannelAnalyticsRes()
+}
+
+type GetChannelEmoteUsageRes interface {
+	getChannelEmoteUsageRes()
+}
+
+type GetChannelLeaderboardRes interface {
+	getChannelLeaderboardRes()
+}
+
 type GetChannelLiveRes interface {
 	getChannelLiveRes()
 }
 
+type GetMonitoredLeaderboardRes interface {
+	getMonitoredLeaderboardRes()
+}
+
+type GetRecordedStreamEmotesRes interface {
+	getRecordedStreamEmotesRes()
+}
+
 type GetRecordedStreamLeaderboardRes interface {
 	getRecordedStreamLeaderboardRes()
 }
@@ -49,14 +105,30 @@ type GetRecordedStreamRes interface {
 	getRecordedStreamRes()
 }
 
+type GetRecordedStreamTermSeriesRes interface {
+	getRecordedStreamTermSeriesRes()
+}
+
+type GetRecordedStreamTermsRes interface {
+	getRecordedStreamTermsRes()
+}
+
 type GetSystemStatsRes interface {
 	getSystemStatsRes()
 }
 
+type GetTwitchUserActivityHeatmapRes interface {
+	getTwitchUserActivityHeatmapRes()
+}
+
 type GetTwitchUserActivityTimelineRes interface {
 	getTwitchUserActivityTimelineRes()
 }
 
+type GetTwitchUserEmoteUsageRes interface {
+	getTwitchUserEmoteUsageRes()
+}
+
 type GetTwitchUserProfileRes interface {
 	getTwitchUserProfileRes()
 }
@@ -93,6 +165,22 @@ type MeRes interface {
 	meRes()
 }
 
+type PreviewRuleNotifyRes interface {
+	previewRuleNotifyRes()
+}
+
+type ResendNotificationDeliveryRes interface {
+	resendNotificationDeliveryRes()
+}
+
+type SaveEmoteSetRes interface {
+	saveEmoteSetRes()
+}
+
+type ScanTwitchUserAltsRes interface {
+	scanTwitchUserAltsRes()
+}
+
 type SendMessageRes interface {
 	sendMessageRes()
 }
@@ -101,14 +189,34 @@ type SetChannelBlacklistRes interface {
 	setChannelBlacklistRes()
 }
 
+type SetTwitchUserLinkRes interface {
+	setTwitchUserLinkRes()
+}
+
 type StopAiAgentRes interface {
 	stopAiAgentRes()
 }
 
+type SyncBlocklistRes interface {
+	syncBlocklistRes()
+}
+
+type TestNotificationRes interface {
+	testNotificationRes()
+}
+
+type UpdateBotDetectionSettingsRes interface {
+	updateBotDetectionSettingsRes()
+}
+
 type UpdateChannelDiscoverySettingsRes interface {
 	updateChannelDiscoverySettingsRes()
 }
 
+type UpdateChatClassifierSettingsRes interface {
+	updateChatClassifierSettingsRes()
+}
+
 type UpdateNotificationRes interface {
 	updateNotificationRes()
 }
@@ -117,6 +225,10 @@ type UpdateRuleRes interface {
 	updateRuleRes()
 }
 
+type UpdateSuspicionSettingsRes interface {
+	updateSuspicionSettingsRes()
+}
+
 type UpdateTwitchAccountRes interface {
 	updateTwitchAccountRes()
 }
@@ -124,3 +236,7 @@ type UpdateTwitchAccountRes interface {
 type UpdateTwitchUserRes interface {
 	updateTwitchUserRes()
 }
+
+type UploadBlocklistRes interface {
+	uploadBlocklistRes()
+}
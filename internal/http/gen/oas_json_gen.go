@@ -143,6 +143,218 @@ func (s *Account) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *ActivityHeatmap) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ActivityHeatmap) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("timezone")
+		e.Str(s.Timezone)
+	}
+	{
+		e.FieldStart("from")
+		json.EncodeDateTime(e, s.From)
+	}
+	{
+		e.FieldStart("to")
+		json.EncodeDateTime(e, s.To)
+	}
+	{
+		e.FieldStart("presence_seconds")
+		e.ArrStart()
+		for _, elem := range s.PresenceSeconds {
+			e.ArrStart()
+			for _, elem := range elem {
+				e.Int64(elem)
+			}
+			e.ArrEnd()
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("messages")
+		e.ArrStart()
+		for _, elem := range s.Messages {
+			e.ArrStart()
+			for _, elem := range elem {
+				e.Int64(elem)
+			}
+			e.ArrEnd()
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfActivityHeatmap = [5]string{
+	0: "timezone",
+	1: "from",
+	2: "to",
+	3: "presence_seconds",
+	4: "messages",
+}
+
+// Decode decodes ActivityHeatmap from json.
+func (s *ActivityHeatmap) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ActivityHeatmap to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "timezone":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Timezone = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timezone\"")
+			}
+		case "from":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.From = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"from\"")
+			}
+		case "to":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.To = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"to\"")
+			}
+		case "presence_seconds":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.PresenceSeconds = make([][]int64, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem []int64
+					elem = make([]int64, 0)
+					if err := d.Arr(func(d *jx.Decoder) error {
+						var elemElem int64
+						v, err := d.Int64()
+						elemElem = int64(v)
+						if err != nil {
+							return err
+						}
+						elem = append(elem, elemElem)
+						return nil
+					}); err != nil {
+						return err
+					}
+					s.PresenceSeconds = append(s.PresenceSeconds, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"presence_seconds\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Messages = make([][]int64, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem []int64
+					elem = make([]int64, 0)
+					if err := d.Arr(func(d *jx.Decoder) error {
+						var elemElem int64
+						v, err := d.Int64()
+						elemElem = int64(v)
+						if err != nil {
+							return err
+						}
+						elem = append(elem, elemElem)
+						return nil
+					}); err != nil {
+						return err
+					}
+					s.Messages = append(s.Messages, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ActivityHeatmap")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfActivityHeatmap) {
+					name = jsonFieldsNameOfActivityHeatmap[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ActivityHeatmap) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ActivityHeatmap) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *ActivityTimelineSegment) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -974,150 +1186,135 @@ func (s *AiSettings) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
-// Encode encodes ApproveChannelDiscoveryCandidateBadRequest as json.
-func (s *ApproveChannelDiscoveryCandidateBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorMessage)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes ApproveChannelDiscoveryCandidateBadRequest from json.
-func (s *ApproveChannelDiscoveryCandidateBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ApproveChannelDiscoveryCandidateBadRequest to nil")
-	}
-	var unwrapped ErrorMessage
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = ApproveChannelDiscoveryCandidateBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *ApproveChannelDiscoveryCandidateBadRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ApproveChannelDiscoveryCandidateBadRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ApproveChannelDiscoveryCandidateNotFound as json.
-func (s *ApproveChannelDiscoveryCandidateNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorMessage)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes ApproveChannelDiscoveryCandidateNotFound from json.
-func (s *ApproveChannelDiscoveryCandidateNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ApproveChannelDiscoveryCandidateNotFound to nil")
-	}
-	var unwrapped ErrorMessage
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = ApproveChannelDiscoveryCandidateNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *ApproveChannelDiscoveryCandidateNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ApproveChannelDiscoveryCandidateNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
 // Encode implements json.Marshaler.
-func (s *ChannelBlacklistChange) Encode(e *jx.Encoder) {
+func (s *AltCandidate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ChannelBlacklistChange) encodeFields(e *jx.Encoder) {
+func (s *AltCandidate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("login")
-		e.Str(s.Login)
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
 	}
 	{
-		e.FieldStart("add")
-		e.Bool(s.Add)
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
+	}
+	{
+		e.FieldStart("signals")
+		e.ArrStart()
+		for _, elem := range s.Signals {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("computed_at")
+		json.EncodeDateTime(e, s.ComputedAt)
 	}
 }
 
-var jsonFieldsNameOfChannelBlacklistChange = [2]string{
-	0: "login",
-	1: "add",
+var jsonFieldsNameOfAltCandidate = [5]string{
+	0: "twitch_user_id",
+	1: "username",
+	2: "score",
+	3: "signals",
+	4: "computed_at",
 }
 
-// Decode decodes ChannelBlacklistChange from json.
-func (s *ChannelBlacklistChange) Decode(d *jx.Decoder) error {
+// Decode decodes AltCandidate from json.
+func (s *AltCandidate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ChannelBlacklistChange to nil")
+		return errors.New("invalid: unable to decode AltCandidate to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "login":
+		case "twitch_user_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Login = string(v)
+				v, err := d.Int64()
+				s.TwitchUserID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login\"")
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
 			}
-		case "add":
+		case "username":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Bool()
-				s.Add = bool(v)
+				v, err := d.Str()
+				s.Username = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"add\"")
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "signals":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Signals = make([]AltSignal, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AltSignal
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Signals = append(s.Signals, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"signals\"")
+			}
+		case "computed_at":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.ComputedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"computed_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ChannelBlacklistChange")
+		return errors.Wrap(err, "decode AltCandidate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1129,8 +1326,8 @@ func (s *ChannelBlacklistChange) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfChannelBlacklistChange) {
-					name = jsonFieldsNameOfChannelBlacklistChange[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAltCandidate) {
+					name = jsonFieldsNameOfAltCandidate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1151,137 +1348,118 @@ func (s *ChannelBlacklistChange) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ChannelBlacklistChange) MarshalJSON() ([]byte, error) {
+func (s *AltCandidate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChannelBlacklistChange) UnmarshalJSON(data []byte) error {
+func (s *AltCandidate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ChannelChatterEntry) Encode(e *jx.Encoder) {
+func (s *AltSignal) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ChannelChatterEntry) encodeFields(e *jx.Encoder) {
+func (s *AltSignal) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("login")
-		e.Str(s.Login)
-	}
-	{
-		e.FieldStart("user_twitch_id")
-		e.Int64(s.UserTwitchID)
+		e.FieldStart("key")
+		s.Key.Encode(e)
 	}
 	{
-		e.FieldStart("present_since")
-		json.EncodeDateTime(e, s.PresentSince)
+		e.FieldStart("score")
+		e.Int(s.Score)
 	}
 	{
-		if s.AccountCreatedAt.Set {
-			e.FieldStart("account_created_at")
-			s.AccountCreatedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("weight")
+		e.Int(s.Weight)
 	}
 	{
-		if s.MessageCount.Set {
-			e.FieldStart("message_count")
-			s.MessageCount.Encode(e)
-		}
+		e.FieldStart("detail")
+		e.Str(s.Detail)
 	}
 }
 
-var jsonFieldsNameOfChannelChatterEntry = [5]string{
-	0: "login",
-	1: "user_twitch_id",
-	2: "present_since",
-	3: "account_created_at",
-	4: "message_count",
+var jsonFieldsNameOfAltSignal = [4]string{
+	0: "key",
+	1: "score",
+	2: "weight",
+	3: "detail",
 }
 
-// Decode decodes ChannelChatterEntry from json.
-func (s *ChannelChatterEntry) Decode(d *jx.Decoder) error {
+// Decode decodes AltSignal from json.
+func (s *AltSignal) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ChannelChatterEntry to nil")
+		return errors.New("invalid: unable to decode AltSignal to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "login":
+		case "key":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Login = string(v)
-				if err != nil {
+				if err := s.Key.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login\"")
+				return errors.Wrap(err, "decode field \"key\"")
 			}
-		case "user_twitch_id":
+		case "score":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int64()
-				s.UserTwitchID = int64(v)
+				v, err := d.Int()
+				s.Score = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_twitch_id\"")
+				return errors.Wrap(err, "decode field \"score\"")
 			}
-		case "present_since":
+		case "weight":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.PresentSince = v
+				v, err := d.Int()
+				s.Weight = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"present_since\"")
-			}
-		case "account_created_at":
-			if err := func() error {
-				s.AccountCreatedAt.Reset()
-				if err := s.AccountCreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"account_created_at\"")
+				return errors.Wrap(err, "decode field \"weight\"")
 			}
-		case "message_count":
+		case "detail":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.MessageCount.Reset()
-				if err := s.MessageCount.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Detail = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message_count\"")
+				return errors.Wrap(err, "decode field \"detail\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ChannelChatterEntry")
+		return errors.Wrap(err, "decode AltSignal")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1293,8 +1471,8 @@ func (s *ChannelChatterEntry) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfChannelChatterEntry) {
-					name = jsonFieldsNameOfChannelChatterEntry[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAltSignal) {
+					name = jsonFieldsNameOfAltSignal[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1315,161 +1493,283 @@ func (s *ChannelChatterEntry) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ChannelChatterEntry) MarshalJSON() ([]byte, error) {
+func (s *AltSignal) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChannelChatterEntry) UnmarshalJSON(data []byte) error {
+func (s *AltSignal) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AltSignalKey as json.
+func (s AltSignalKey) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes AltSignalKey from json.
+func (s *AltSignalKey) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AltSignalKey to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch AltSignalKey(v) {
+	case AltSignalKeyFollowOverlap:
+		*s = AltSignalKeyFollowOverlap
+	case AltSignalKeyLoginSimilarity:
+		*s = AltSignalKeyLoginSimilarity
+	case AltSignalKeyAccountCreated:
+		*s = AltSignalKeyAccountCreated
+	case AltSignalKeySharedPresence:
+		*s = AltSignalKeySharedPresence
+	case AltSignalKeyStylometry:
+		*s = AltSignalKeyStylometry
+	case AltSignalKeyTiming:
+		*s = AltSignalKeyTiming
+	default:
+		*s = AltSignalKey(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s AltSignalKey) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AltSignalKey) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ApproveChannelDiscoveryCandidateBadRequest as json.
+func (s *ApproveChannelDiscoveryCandidateBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ApproveChannelDiscoveryCandidateBadRequest from json.
+func (s *ApproveChannelDiscoveryCandidateBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ApproveChannelDiscoveryCandidateBadRequest to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ApproveChannelDiscoveryCandidateBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ApproveChannelDiscoveryCandidateBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ApproveChannelDiscoveryCandidateBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ApproveChannelDiscoveryCandidateNotFound as json.
+func (s *ApproveChannelDiscoveryCandidateNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes ApproveChannelDiscoveryCandidateNotFound from json.
+func (s *ApproveChannelDiscoveryCandidateNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ApproveChannelDiscoveryCandidateNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ApproveChannelDiscoveryCandidateNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ApproveChannelDiscoveryCandidateNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ApproveChannelDiscoveryCandidateNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ChannelDiscoverySettings) Encode(e *jx.Encoder) {
+func (s *AudienceChannel) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ChannelDiscoverySettings) encodeFields(e *jx.Encoder) {
+func (s *AudienceChannel) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("enabled")
-		e.Bool(s.Enabled)
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
 	}
 	{
-		e.FieldStart("poll_interval_seconds")
-		e.Int(s.PollIntervalSeconds)
+		e.FieldStart("login")
+		e.Str(s.Login)
 	}
 	{
-		e.FieldStart("game_id")
-		e.Str(s.GameID)
+		e.FieldStart("chatters")
+		e.Int(s.Chatters)
 	}
 	{
-		e.FieldStart("min_live_viewers")
-		e.Int(s.MinLiveViewers)
+		e.FieldStart("present_users")
+		e.Int(s.PresentUsers)
 	}
 	{
-		e.FieldStart("required_stream_tags")
+		e.FieldStart("audience")
+		e.Int(s.Audience)
+	}
+	{
+		e.FieldStart("community")
 		e.ArrStart()
-		for _, elem := range s.RequiredStreamTags {
-			e.Str(elem)
+		for _, elem := range s.Community {
+			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
-	{
-		e.FieldStart("max_stream_pages_per_run")
-		e.Int(s.MaxStreamPagesPerRun)
-	}
 }
 
-var jsonFieldsNameOfChannelDiscoverySettings = [6]string{
-	0: "enabled",
-	1: "poll_interval_seconds",
-	2: "game_id",
-	3: "min_live_viewers",
-	4: "required_stream_tags",
-	5: "max_stream_pages_per_run",
+var jsonFieldsNameOfAudienceChannel = [6]string{
+	0: "twitch_user_id",
+	1: "login",
+	2: "chatters",
+	3: "present_users",
+	4: "audience",
+	5: "community",
 }
 
-// Decode decodes ChannelDiscoverySettings from json.
-func (s *ChannelDiscoverySettings) Decode(d *jx.Decoder) error {
+// Decode decodes AudienceChannel from json.
+func (s *AudienceChannel) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ChannelDiscoverySettings to nil")
+		return errors.New("invalid: unable to decode AudienceChannel to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "enabled":
+		case "twitch_user_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.Enabled = bool(v)
+				v, err := d.Int64()
+				s.TwitchUserID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"enabled\"")
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
 			}
-		case "poll_interval_seconds":
+		case "login":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.PollIntervalSeconds = int(v)
+				v, err := d.Str()
+				s.Login = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"poll_interval_seconds\"")
+				return errors.Wrap(err, "decode field \"login\"")
 			}
-		case "game_id":
+		case "chatters":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.GameID = string(v)
+				v, err := d.Int()
+				s.Chatters = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"game_id\"")
+				return errors.Wrap(err, "decode field \"chatters\"")
 			}
-		case "min_live_viewers":
+		case "present_users":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := d.Int()
-				s.MinLiveViewers = int(v)
+				s.PresentUsers = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"min_live_viewers\"")
+				return errors.Wrap(err, "decode field \"present_users\"")
 			}
-		case "required_stream_tags":
+		case "audience":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.RequiredStreamTags = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.RequiredStreamTags = append(s.RequiredStreamTags, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int()
+				s.Audience = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"required_stream_tags\"")
+				return errors.Wrap(err, "decode field \"audience\"")
 			}
-		case "max_stream_pages_per_run":
+		case "community":
 			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				v, err := d.Int()
-				s.MaxStreamPagesPerRun = int(v)
-				if err != nil {
+				s.Community = make([]AudienceCommunityChannel, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AudienceCommunityChannel
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Community = append(s.Community, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"max_stream_pages_per_run\"")
+				return errors.Wrap(err, "decode field \"community\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ChannelDiscoverySettings")
+		return errors.Wrap(err, "decode AudienceChannel")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -1486,8 +1786,8 @@ func (s *ChannelDiscoverySettings) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfChannelDiscoverySettings) {
-					name = jsonFieldsNameOfChannelDiscoverySettings[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAudienceChannel) {
+					name = jsonFieldsNameOfAudienceChannel[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1508,223 +1808,137 @@ func (s *ChannelDiscoverySettings) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ChannelDiscoverySettings) MarshalJSON() ([]byte, error) {
+func (s *AudienceChannel) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChannelDiscoverySettings) UnmarshalJSON(data []byte) error {
+func (s *AudienceChannel) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ChannelLive) Encode(e *jx.Encoder) {
+func (s *AudienceCommunityChannel) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ChannelLive) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("broadcaster_id")
-		e.Int64(s.BroadcasterID)
-	}
-	{
-		e.FieldStart("broadcaster_login")
-		e.Str(s.BroadcasterLogin)
-	}
-	{
-		e.FieldStart("display_name")
-		e.Str(s.DisplayName)
-	}
-	{
-		e.FieldStart("profile_image_url")
-		e.Str(s.ProfileImageURL)
-	}
-	{
-		e.FieldStart("is_live")
-		e.Bool(s.IsLive)
-	}
+func (s *AudienceCommunityChannel) encodeFields(e *jx.Encoder) {
 	{
-		if s.Title.Set {
-			e.FieldStart("title")
-			s.Title.Encode(e)
-		}
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
 	}
 	{
-		if s.GameName.Set {
-			e.FieldStart("game_name")
-			s.GameName.Encode(e)
-		}
+		e.FieldStart("login")
+		e.Str(s.Login)
 	}
 	{
-		if s.ViewerCount.Set {
-			e.FieldStart("viewer_count")
-			s.ViewerCount.Encode(e)
-		}
+		e.FieldStart("monitored")
+		e.Bool(s.Monitored)
 	}
 	{
-		if s.ChannelChatterCount.Set {
-			e.FieldStart("channel_chatter_count")
-			s.ChannelChatterCount.Encode(e)
-		}
+		e.FieldStart("active_users")
+		e.Int(s.ActiveUsers)
 	}
 	{
-		if s.StartedAt.Set {
-			e.FieldStart("started_at")
-			s.StartedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("followers")
+		e.Int(s.Followers)
 	}
 }
 
-var jsonFieldsNameOfChannelLive = [10]string{
-	0: "broadcaster_id",
-	1: "broadcaster_login",
-	2: "display_name",
-	3: "profile_image_url",
-	4: "is_live",
-	5: "title",
-	6: "game_name",
-	7: "viewer_count",
-	8: "channel_chatter_count",
-	9: "started_at",
+var jsonFieldsNameOfAudienceCommunityChannel = [5]string{
+	0: "twitch_user_id",
+	1: "login",
+	2: "monitored",
+	3: "active_users",
+	4: "followers",
 }
 
-// Decode decodes ChannelLive from json.
-func (s *ChannelLive) Decode(d *jx.Decoder) error {
+// Decode decodes AudienceCommunityChannel from json.
+func (s *AudienceCommunityChannel) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ChannelLive to nil")
+		return errors.New("invalid: unable to decode AudienceCommunityChannel to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "broadcaster_id":
+		case "twitch_user_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.BroadcasterID = int64(v)
+				s.TwitchUserID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"broadcaster_id\"")
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
 			}
-		case "broadcaster_login":
+		case "login":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.BroadcasterLogin = string(v)
+				s.Login = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"broadcaster_login\"")
+				return errors.Wrap(err, "decode field \"login\"")
 			}
-		case "display_name":
+		case "monitored":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.DisplayName = string(v)
+				v, err := d.Bool()
+				s.Monitored = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"display_name\"")
+				return errors.Wrap(err, "decode field \"monitored\"")
 			}
-		case "profile_image_url":
+		case "active_users":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Str()
-				s.ProfileImageURL = string(v)
+				v, err := d.Int()
+				s.ActiveUsers = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"profile_image_url\"")
+				return errors.Wrap(err, "decode field \"active_users\"")
 			}
-		case "is_live":
+		case "followers":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				v, err := d.Bool()
-				s.IsLive = bool(v)
+				v, err := d.Int()
+				s.Followers = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_live\"")
-			}
-		case "title":
-			if err := func() error {
-				s.Title.Reset()
-				if err := s.Title.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
-			}
-		case "game_name":
-			if err := func() error {
-				s.GameName.Reset()
-				if err := s.GameName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"game_name\"")
-			}
-		case "viewer_count":
-			if err := func() error {
-				s.ViewerCount.Reset()
-				if err := s.ViewerCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"viewer_count\"")
-			}
-		case "channel_chatter_count":
-			if err := func() error {
-				s.ChannelChatterCount.Reset()
-				if err := s.ChannelChatterCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_chatter_count\"")
-			}
-		case "started_at":
-			if err := func() error {
-				s.StartedAt.Reset()
-				if err := s.StartedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"started_at\"")
+				return errors.Wrap(err, "decode field \"followers\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ChannelLive")
+		return errors.Wrap(err, "decode AudienceCommunityChannel")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
+	for i, mask := range [1]uint8{
 		0b00011111,
-		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1736,8 +1950,8 @@ func (s *ChannelLive) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfChannelLive) {
-					name = jsonFieldsNameOfChannelLive[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAudienceCommunityChannel) {
+					name = jsonFieldsNameOfAudienceCommunityChannel[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1758,265 +1972,446 @@ func (s *ChannelLive) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ChannelLive) MarshalJSON() ([]byte, error) {
+func (s *AudienceCommunityChannel) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChannelLive) UnmarshalJSON(data []byte) error {
+func (s *AudienceCommunityChannel) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ChatHistoryEntry) Encode(e *jx.Encoder) {
+func (s *AudienceOverlap) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ChatHistoryEntry) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		e.FieldStart("channel")
-		e.Str(s.Channel)
-	}
-	{
-		e.FieldStart("user")
-		e.Str(s.User)
-	}
-	{
-		if s.ChatterUserID.Set {
-			e.FieldStart("chatter_user_id")
-			s.ChatterUserID.Encode(e)
-		}
-	}
+func (s *AudienceOverlap) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("chatter_marked")
-		e.Bool(s.ChatterMarked)
+		e.FieldStart("period")
+		s.Period.Encode(e)
 	}
 	{
-		e.FieldStart("chatter_is_sus")
-		e.Bool(s.ChatterIsSus)
+		e.FieldStart("from")
+		json.EncodeDateTime(e, s.From)
 	}
 	{
-		e.FieldStart("first_message")
-		e.Bool(s.FirstMessage)
+		e.FieldStart("to")
+		json.EncodeDateTime(e, s.To)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("computed_at")
+		json.EncodeDateTime(e, s.ComputedAt)
 	}
 	{
-		e.FieldStart("keyword_match")
-		e.Bool(s.KeywordMatch)
+		e.FieldStart("channels")
+		e.ArrStart()
+		for _, elem := range s.Channels {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("source")
-		s.Source.Encode(e)
+		e.FieldStart("pairs")
+		e.ArrStart()
+		for _, elem := range s.Pairs {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("jaccard_matrix")
+		e.ArrStart()
+		for _, elem := range s.JaccardMatrix {
+			e.ArrStart()
+			for _, elem := range elem {
+				e.Float64(elem)
+			}
+			e.ArrEnd()
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("badge_tags")
+		e.FieldStart("overlap_matrix")
 		e.ArrStart()
-		for _, elem := range s.BadgeTags {
-			elem.Encode(e)
+		for _, elem := range s.OverlapMatrix {
+			e.ArrStart()
+			for _, elem := range elem {
+				e.Float64(elem)
+			}
+			e.ArrEnd()
 		}
 		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfChatHistoryEntry = [12]string{
-	0:  "id",
-	1:  "channel",
-	2:  "user",
-	3:  "chatter_user_id",
-	4:  "chatter_marked",
-	5:  "chatter_is_sus",
-	6:  "first_message",
-	7:  "message",
-	8:  "keyword_match",
-	9:  "source",
-	10: "created_at",
-	11: "badge_tags",
+var jsonFieldsNameOfAudienceOverlap = [8]string{
+	0: "period",
+	1: "from",
+	2: "to",
+	3: "computed_at",
+	4: "channels",
+	5: "pairs",
+	6: "jaccard_matrix",
+	7: "overlap_matrix",
 }
 
-// Decode decodes ChatHistoryEntry from json.
-func (s *ChatHistoryEntry) Decode(d *jx.Decoder) error {
+// Decode decodes AudienceOverlap from json.
+func (s *AudienceOverlap) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ChatHistoryEntry to nil")
+		return errors.New("invalid: unable to decode AudienceOverlap to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "period":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
+				if err := s.Period.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"period\"")
 			}
-		case "channel":
+		case "from":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Channel = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.From = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel\"")
+				return errors.Wrap(err, "decode field \"from\"")
 			}
-		case "user":
+		case "to":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.User = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.To = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user\"")
+				return errors.Wrap(err, "decode field \"to\"")
 			}
-		case "chatter_user_id":
+		case "computed_at":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.ChatterUserID.Reset()
-				if err := s.ChatterUserID.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.ComputedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"chatter_user_id\"")
+				return errors.Wrap(err, "decode field \"computed_at\"")
 			}
-		case "chatter_marked":
+		case "channels":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				v, err := d.Bool()
-				s.ChatterMarked = bool(v)
-				if err != nil {
+				s.Channels = make([]AudienceChannel, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AudienceChannel
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Channels = append(s.Channels, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"chatter_marked\"")
+				return errors.Wrap(err, "decode field \"channels\"")
 			}
-		case "chatter_is_sus":
+		case "pairs":
 			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				v, err := d.Bool()
-				s.ChatterIsSus = bool(v)
-				if err != nil {
+				s.Pairs = make([]AudienceOverlapPair, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AudienceOverlapPair
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Pairs = append(s.Pairs, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"chatter_is_sus\"")
+				return errors.Wrap(err, "decode field \"pairs\"")
 			}
-		case "first_message":
+		case "jaccard_matrix":
 			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				v, err := d.Bool()
-				s.FirstMessage = bool(v)
-				if err != nil {
+				s.JaccardMatrix = make([][]float64, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem []float64
+					elem = make([]float64, 0)
+					if err := d.Arr(func(d *jx.Decoder) error {
+						var elemElem float64
+						v, err := d.Float64()
+						elemElem = float64(v)
+						if err != nil {
+							return err
+						}
+						elem = append(elem, elemElem)
+						return nil
+					}); err != nil {
+						return err
+					}
+					s.JaccardMatrix = append(s.JaccardMatrix, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"first_message\"")
+				return errors.Wrap(err, "decode field \"jaccard_matrix\"")
 			}
-		case "message":
+		case "overlap_matrix":
 			requiredBitSet[0] |= 1 << 7
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
+				s.OverlapMatrix = make([][]float64, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem []float64
+					elem = make([]float64, 0)
+					if err := d.Arr(func(d *jx.Decoder) error {
+						var elemElem float64
+						v, err := d.Float64()
+						elemElem = float64(v)
+						if err != nil {
+							return err
+						}
+						elem = append(elem, elemElem)
+						return nil
+					}); err != nil {
+						return err
+					}
+					s.OverlapMatrix = append(s.OverlapMatrix, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"overlap_matrix\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AudienceOverlap")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b11111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAudienceOverlap) {
+					name = jsonFieldsNameOfAudienceOverlap[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AudienceOverlap) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AudienceOverlap) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *AudienceOverlapPair) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *AudienceOverlapPair) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("channel_a")
+		e.Int64(s.ChannelA)
+	}
+	{
+		e.FieldStart("channel_b")
+		e.Int64(s.ChannelB)
+	}
+	{
+		e.FieldStart("shared_users")
+		e.Int(s.SharedUsers)
+	}
+	{
+		e.FieldStart("jaccard")
+		e.Float64(s.Jaccard)
+	}
+	{
+		e.FieldStart("overlap")
+		e.Float64(s.Overlap)
+	}
+	{
+		e.FieldStart("top_shared")
+		e.ArrStart()
+		for _, elem := range s.TopShared {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfAudienceOverlapPair = [6]string{
+	0: "channel_a",
+	1: "channel_b",
+	2: "shared_users",
+	3: "jaccard",
+	4: "overlap",
+	5: "top_shared",
+}
+
+// Decode decodes AudienceOverlapPair from json.
+func (s *AudienceOverlapPair) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AudienceOverlapPair to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "channel_a":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ChannelA = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"channel_a\"")
 			}
-		case "keyword_match":
-			requiredBitSet[1] |= 1 << 0
+		case "channel_b":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Bool()
-				s.KeywordMatch = bool(v)
+				v, err := d.Int64()
+				s.ChannelB = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"keyword_match\"")
+				return errors.Wrap(err, "decode field \"channel_b\"")
 			}
-		case "source":
-			requiredBitSet[1] |= 1 << 1
+		case "shared_users":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.Source.Decode(d); err != nil {
+				v, err := d.Int()
+				s.SharedUsers = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"source\"")
+				return errors.Wrap(err, "decode field \"shared_users\"")
 			}
-		case "created_at":
-			requiredBitSet[1] |= 1 << 2
+		case "jaccard":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := d.Float64()
+				s.Jaccard = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"jaccard\"")
 			}
-		case "badge_tags":
-			requiredBitSet[1] |= 1 << 3
+		case "overlap":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.BadgeTags = make([]ChatHistoryEntryBadgeTagsItem, 0)
+				v, err := d.Float64()
+				s.Overlap = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"overlap\"")
+			}
+		case "top_shared":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.TopShared = make([]AudienceSharedUser, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem ChatHistoryEntryBadgeTagsItem
+					var elem AudienceSharedUser
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.BadgeTags = append(s.BadgeTags, elem)
+					s.TopShared = append(s.TopShared, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"badge_tags\"")
+				return errors.Wrap(err, "decode field \"top_shared\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ChatHistoryEntry")
+		return errors.Wrap(err, "decode AudienceOverlapPair")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b11110111,
-		0b00001111,
+	for i, mask := range [1]uint8{
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2028,8 +2423,8 @@ func (s *ChatHistoryEntry) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfChatHistoryEntry) {
-					name = jsonFieldsNameOfChatHistoryEntry[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAudienceOverlapPair) {
+					name = jsonFieldsNameOfAudienceOverlapPair[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2050,202 +2445,164 @@ func (s *ChatHistoryEntry) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ChatHistoryEntry) MarshalJSON() ([]byte, error) {
+func (s *AudienceOverlapPair) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChatHistoryEntry) UnmarshalJSON(data []byte) error {
+func (s *AudienceOverlapPair) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ChatHistoryEntryBadgeTagsItem as json.
-func (s ChatHistoryEntryBadgeTagsItem) Encode(e *jx.Encoder) {
+// Encode encodes AudienceOverlapPeriod as json.
+func (s AudienceOverlapPeriod) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes ChatHistoryEntryBadgeTagsItem from json.
-func (s *ChatHistoryEntryBadgeTagsItem) Decode(d *jx.Decoder) error {
+// Decode decodes AudienceOverlapPeriod from json.
+func (s *AudienceOverlapPeriod) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ChatHistoryEntryBadgeTagsItem to nil")
+		return errors.New("invalid: unable to decode AudienceOverlapPeriod to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch ChatHistoryEntryBadgeTagsItem(v) {
-	case ChatHistoryEntryBadgeTagsItemModerator:
-		*s = ChatHistoryEntryBadgeTagsItemModerator
-	case ChatHistoryEntryBadgeTagsItemVip:
-		*s = ChatHistoryEntryBadgeTagsItemVip
-	case ChatHistoryEntryBadgeTagsItemBot:
-		*s = ChatHistoryEntryBadgeTagsItemBot
-	case ChatHistoryEntryBadgeTagsItemOther:
-		*s = ChatHistoryEntryBadgeTagsItemOther
+	switch AudienceOverlapPeriod(v) {
+	case AudienceOverlapPeriod24h:
+		*s = AudienceOverlapPeriod24h
+	case AudienceOverlapPeriod7d:
+		*s = AudienceOverlapPeriod7d
+	case AudienceOverlapPeriod30d:
+		*s = AudienceOverlapPeriod30d
+	case AudienceOverlapPeriod90d:
+		*s = AudienceOverlapPeriod90d
 	default:
-		*s = ChatHistoryEntryBadgeTagsItem(v)
+		*s = AudienceOverlapPeriod(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ChatHistoryEntryBadgeTagsItem) MarshalJSON() ([]byte, error) {
+func (s AudienceOverlapPeriod) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChatHistoryEntryBadgeTagsItem) UnmarshalJSON(data []byte) error {
+func (s *AudienceOverlapPeriod) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ChatHistoryEntrySource as json.
-func (s ChatHistoryEntrySource) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *AudienceSharedUser) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes ChatHistoryEntrySource from json.
-func (s *ChatHistoryEntrySource) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ChatHistoryEntrySource to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch ChatHistoryEntrySource(v) {
-	case ChatHistoryEntrySourceIrc:
-		*s = ChatHistoryEntrySourceIrc
-	case ChatHistoryEntrySourceSent:
-		*s = ChatHistoryEntrySourceSent
-	default:
-		*s = ChatHistoryEntrySource(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ChatHistoryEntrySource) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChatHistoryEntrySource) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *ClientNotice) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *ClientNotice) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("severity")
-		s.Severity.Encode(e)
+// encodeFields encodes fields.
+func (s *AudienceSharedUser) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
 	}
 	{
-		e.FieldStart("code")
-		e.Str(s.Code)
+		e.FieldStart("login")
+		e.Str(s.Login)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("messages_a")
+		e.Int64(s.MessagesA)
 	}
 	{
-		if s.Details.Set {
-			e.FieldStart("details")
-			s.Details.Encode(e)
-		}
+		e.FieldStart("messages_b")
+		e.Int64(s.MessagesB)
 	}
 }
 
-var jsonFieldsNameOfClientNotice = [4]string{
-	0: "severity",
-	1: "code",
-	2: "message",
-	3: "details",
+var jsonFieldsNameOfAudienceSharedUser = [4]string{
+	0: "twitch_user_id",
+	1: "login",
+	2: "messages_a",
+	3: "messages_b",
 }
 
-// Decode decodes ClientNotice from json.
-func (s *ClientNotice) Decode(d *jx.Decoder) error {
+// Decode decodes AudienceSharedUser from json.
+func (s *AudienceSharedUser) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ClientNotice to nil")
+		return errors.New("invalid: unable to decode AudienceSharedUser to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "severity":
+		case "twitch_user_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Severity.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.TwitchUserID = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"severity\"")
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
 			}
-		case "code":
+		case "login":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Code = string(v)
+				s.Login = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"login\"")
 			}
-		case "message":
+		case "messages_a":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
+				v, err := d.Int64()
+				s.MessagesA = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"messages_a\"")
 			}
-		case "details":
+		case "messages_b":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Details.Reset()
-				if err := s.Details.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.MessagesB = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"details\"")
+				return errors.Wrap(err, "decode field \"messages_b\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ClientNotice")
+		return errors.Wrap(err, "decode AudienceSharedUser")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2257,8 +2614,8 @@ func (s *ClientNotice) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfClientNotice) {
-					name = jsonFieldsNameOfClientNotice[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAudienceSharedUser) {
+					name = jsonFieldsNameOfAudienceSharedUser[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2279,197 +2636,273 @@ func (s *ClientNotice) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ClientNotice) MarshalJSON() ([]byte, error) {
+func (s *AudienceSharedUser) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ClientNotice) UnmarshalJSON(data []byte) error {
+func (s *AudienceSharedUser) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s ClientNoticeDetails) Encode(e *jx.Encoder) {
+func (s *Blocklist) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s ClientNoticeDetails) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+// encodeFields encodes fields.
+func (s *Blocklist) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
-}
-
-// Decode decodes ClientNoticeDetails from json.
-func (s *ClientNoticeDetails) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ClientNoticeDetails to nil")
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ClientNoticeDetails")
+	{
+		e.FieldStart("format")
+		s.Format.Encode(e)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ClientNoticeDetails) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ClientNoticeDetails) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ClientNoticeSeverity as json.
-func (s ClientNoticeSeverity) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes ClientNoticeSeverity from json.
-func (s *ClientNoticeSeverity) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ClientNoticeSeverity to nil")
+	{
+		e.FieldStart("source_url")
+		s.SourceURL.Encode(e)
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		e.FieldStart("sync_interval_minutes")
+		e.Int(s.SyncIntervalMinutes)
 	}
-	// Try to use constant string.
-	switch ClientNoticeSeverity(v) {
-	case ClientNoticeSeverityError:
-		*s = ClientNoticeSeverityError
-	case ClientNoticeSeverityWarning:
-		*s = ClientNoticeSeverityWarning
-	default:
-		*s = ClientNoticeSeverity(v)
+	{
+		e.FieldStart("enabled")
+		e.Bool(s.Enabled)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ClientNoticeSeverity) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ClientNoticeSeverity) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *ConfirmAiToolRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *ConfirmAiToolRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("tool_call_id")
-		e.Str(s.ToolCallID)
+		e.FieldStart("source_filename")
+		s.SourceFilename.Encode(e)
 	}
 	{
-		e.FieldStart("approve")
-		e.Bool(s.Approve)
+		e.FieldStart("content_sha256")
+		s.ContentSHA256.Encode(e)
+	}
+	{
+		e.FieldStart("entry_count")
+		e.Int(s.EntryCount)
+	}
+	{
+		e.FieldStart("last_imported_at")
+		s.LastImportedAt.Encode(e, json.EncodeDateTime)
+	}
+	{
+		e.FieldStart("last_synced_at")
+		s.LastSyncedAt.Encode(e, json.EncodeDateTime)
+	}
+	{
+		e.FieldStart("last_sync_error")
+		s.LastSyncError.Encode(e)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 }
 
-var jsonFieldsNameOfConfirmAiToolRequest = [2]string{
-	0: "tool_call_id",
-	1: "approve",
-}
-
-// Decode decodes ConfirmAiToolRequest from json.
-func (s *ConfirmAiToolRequest) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfBlocklist = [13]string{
+	0:  "id",
+	1:  "name",
+	2:  "format",
+	3:  "source_url",
+	4:  "sync_interval_minutes",
+	5:  "enabled",
+	6:  "source_filename",
+	7:  "content_sha256",
+	8:  "entry_count",
+	9:  "last_imported_at",
+	10: "last_synced_at",
+	11: "last_sync_error",
+	12: "created_at",
+}
+
+// Decode decodes Blocklist from json.
+func (s *Blocklist) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ConfirmAiToolRequest to nil")
+		return errors.New("invalid: unable to decode Blocklist to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "tool_call_id":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.ToolCallID = string(v)
+				v, err := d.Int64()
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tool_call_id\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "approve":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Bool()
-				s.Approve = bool(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"approve\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ConfirmAiToolRequest")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+		case "format":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Format.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"format\"")
+			}
+		case "source_url":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.SourceURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source_url\"")
+			}
+		case "sync_interval_minutes":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.SyncIntervalMinutes = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sync_interval_minutes\"")
+			}
+		case "enabled":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.Enabled = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "source_filename":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.SourceFilename.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source_filename\"")
+			}
+		case "content_sha256":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				if err := s.ContentSHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"content_sha256\"")
+			}
+		case "entry_count":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.EntryCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"entry_count\"")
+			}
+		case "last_imported_at":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				if err := s.LastImportedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_imported_at\"")
+			}
+		case "last_synced_at":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				if err := s.LastSyncedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_synced_at\"")
+			}
+		case "last_sync_error":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				if err := s.LastSyncError.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_sync_error\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Blocklist")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
 			// Bits of fields which would be set are actually bits of missed fields.
 			missed := bits.OnesCount8(result)
 			for bitN := 0; bitN < missed; bitN++ {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfConfirmAiToolRequest) {
-					name = jsonFieldsNameOfConfirmAiToolRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfBlocklist) {
+					name = jsonFieldsNameOfBlocklist[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2490,69 +2923,179 @@ func (s *ConfirmAiToolRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ConfirmAiToolRequest) MarshalJSON() ([]byte, error) {
+func (s *Blocklist) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ConfirmAiToolRequest) UnmarshalJSON(data []byte) error {
+func (s *Blocklist) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BlocklistFormat as json.
+func (s BlocklistFormat) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes BlocklistFormat from json.
+func (s *BlocklistFormat) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode BlocklistFormat to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch BlocklistFormat(v) {
+	case BlocklistFormatText:
+		*s = BlocklistFormatText
+	case BlocklistFormatCsv:
+		*s = BlocklistFormatCsv
+	case BlocklistFormatJSON:
+		*s = BlocklistFormatJSON
+	default:
+		*s = BlocklistFormat(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s BlocklistFormat) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *BlocklistFormat) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CountResponse) Encode(e *jx.Encoder) {
+func (s *BotDetectionSettings) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CountResponse) encodeFields(e *jx.Encoder) {
+func (s *BotDetectionSettings) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("total")
-		e.Int64(s.Total)
+		e.FieldStart("enabled")
+		e.Bool(s.Enabled)
+	}
+	{
+		e.FieldStart("min_concurrent_channels")
+		e.Int(s.MinConcurrentChannels)
+	}
+	{
+		e.FieldStart("max_messages_per_hour")
+		e.Float64(s.MaxMessagesPerHour)
+	}
+	{
+		e.FieldStart("viewers_per_chatter")
+		e.Float64(s.ViewersPerChatter)
+	}
+	{
+		e.FieldStart("exclude_from_stats")
+		e.Bool(s.ExcludeFromStats)
 	}
 }
 
-var jsonFieldsNameOfCountResponse = [1]string{
-	0: "total",
+var jsonFieldsNameOfBotDetectionSettings = [5]string{
+	0: "enabled",
+	1: "min_concurrent_channels",
+	2: "max_messages_per_hour",
+	3: "viewers_per_chatter",
+	4: "exclude_from_stats",
 }
 
-// Decode decodes CountResponse from json.
-func (s *CountResponse) Decode(d *jx.Decoder) error {
+// Decode decodes BotDetectionSettings from json.
+func (s *BotDetectionSettings) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CountResponse to nil")
+		return errors.New("invalid: unable to decode BotDetectionSettings to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "total":
+		case "enabled":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.Total = int64(v)
+				v, err := d.Bool()
+				s.Enabled = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total\"")
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "min_concurrent_channels":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.MinConcurrentChannels = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"min_concurrent_channels\"")
+			}
+		case "max_messages_per_hour":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.MaxMessagesPerHour = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_messages_per_hour\"")
+			}
+		case "viewers_per_chatter":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.ViewersPerChatter = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"viewers_per_chatter\"")
+			}
+		case "exclude_from_stats":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.ExcludeFromStats = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"exclude_from_stats\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CountResponse")
+		return errors.Wrap(err, "decode BotDetectionSettings")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2564,8 +3107,8 @@ func (s *CountResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCountResponse) {
-					name = jsonFieldsNameOfCountResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfBotDetectionSettings) {
+					name = jsonFieldsNameOfBotDetectionSettings[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2586,132 +3129,188 @@ func (s *CountResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CountResponse) MarshalJSON() ([]byte, error) {
+func (s *BotDetectionSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CountResponse) UnmarshalJSON(data []byte) error {
+func (s *BotDetectionSettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateAiConversationRequest) Encode(e *jx.Encoder) {
+func (s *ChannelAnalytics) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateAiConversationRequest) encodeFields(e *jx.Encoder) {
+func (s *ChannelAnalytics) encodeFields(e *jx.Encoder) {
 	{
-		if s.Title.Set {
-			e.FieldStart("title")
-			s.Title.Encode(e)
+		e.FieldStart("channel_login")
+		e.Str(s.ChannelLogin)
+	}
+	{
+		e.FieldStart("bucket")
+		s.Bucket.Encode(e)
+	}
+	{
+		e.FieldStart("from")
+		json.EncodeDateTime(e, s.From)
+	}
+	{
+		e.FieldStart("to")
+		json.EncodeDateTime(e, s.To)
+	}
+	{
+		e.FieldStart("series")
+		e.ArrStart()
+		for _, elem := range s.Series {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("retention")
+		e.ArrStart()
+		for _, elem := range s.Retention {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("rolled_up_until")
+		s.RolledUpUntil.Encode(e, json.EncodeDateTime)
 	}
 }
 
-var jsonFieldsNameOfCreateAiConversationRequest = [1]string{
-	0: "title",
+var jsonFieldsNameOfChannelAnalytics = [7]string{
+	0: "channel_login",
+	1: "bucket",
+	2: "from",
+	3: "to",
+	4: "series",
+	5: "retention",
+	6: "rolled_up_until",
 }
 
-// Decode decodes CreateAiConversationRequest from json.
-func (s *CreateAiConversationRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ChannelAnalytics from json.
+func (s *ChannelAnalytics) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateAiConversationRequest to nil")
+		return errors.New("invalid: unable to decode ChannelAnalytics to nil")
 	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "title":
+		case "channel_login":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Title.Reset()
-				if err := s.Title.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ChannelLogin = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
+				return errors.Wrap(err, "decode field \"channel_login\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CreateAiConversationRequest")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreateAiConversationRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateAiConversationRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CreateAiMessageRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CreateAiMessageRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("content")
-		e.Str(s.Content)
-	}
-}
-
-var jsonFieldsNameOfCreateAiMessageRequest = [1]string{
-	0: "content",
-}
-
-// Decode decodes CreateAiMessageRequest from json.
-func (s *CreateAiMessageRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateAiMessageRequest to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "content":
-			requiredBitSet[0] |= 1 << 0
+		case "bucket":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Content = string(v)
+				if err := s.Bucket.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bucket\"")
+			}
+		case "from":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.From = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"content\"")
+				return errors.Wrap(err, "decode field \"from\"")
+			}
+		case "to":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.To = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"to\"")
+			}
+		case "series":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Series = make([]ChannelAnalyticsPoint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ChannelAnalyticsPoint
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Series = append(s.Series, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"series\"")
+			}
+		case "retention":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.Retention = make([]StreamRetentionEntry, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StreamRetentionEntry
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Retention = append(s.Retention, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"retention\"")
+			}
+		case "rolled_up_until":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.RolledUpUntil.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rolled_up_until\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateAiMessageRequest")
+		return errors.Wrap(err, "decode ChannelAnalytics")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b01111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2723,8 +3322,8 @@ func (s *CreateAiMessageRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateAiMessageRequest) {
-					name = jsonFieldsNameOfCreateAiMessageRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChannelAnalytics) {
+					name = jsonFieldsNameOfChannelAnalytics[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2745,100 +3344,196 @@ func (s *CreateAiMessageRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateAiMessageRequest) MarshalJSON() ([]byte, error) {
+func (s *ChannelAnalytics) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateAiMessageRequest) UnmarshalJSON(data []byte) error {
+func (s *ChannelAnalytics) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ChannelAnalyticsBucket as json.
+func (s ChannelAnalyticsBucket) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ChannelAnalyticsBucket from json.
+func (s *ChannelAnalyticsBucket) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ChannelAnalyticsBucket to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ChannelAnalyticsBucket(v) {
+	case ChannelAnalyticsBucketMinute:
+		*s = ChannelAnalyticsBucketMinute
+	case ChannelAnalyticsBucketHour:
+		*s = ChannelAnalyticsBucketHour
+	case ChannelAnalyticsBucketDay:
+		*s = ChannelAnalyticsBucketDay
+	default:
+		*s = ChannelAnalyticsBucket(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ChannelAnalyticsBucket) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ChannelAnalyticsBucket) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateNotificationRequest) Encode(e *jx.Encoder) {
+func (s *ChannelAnalyticsPoint) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateNotificationRequest) encodeFields(e *jx.Encoder) {
+func (s *ChannelAnalyticsPoint) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("provider")
-		s.Provider.Encode(e)
+		e.FieldStart("bucket_start")
+		json.EncodeDateTime(e, s.BucketStart)
 	}
 	{
-		e.FieldStart("settings")
-		s.Settings.Encode(e)
+		e.FieldStart("messages")
+		e.Int64(s.Messages)
 	}
 	{
-		if s.Enabled.Set {
-			e.FieldStart("enabled")
-			s.Enabled.Encode(e)
-		}
+		e.FieldStart("unique_chatters")
+		e.Int(s.UniqueChatters)
+	}
+	{
+		e.FieldStart("new_chatters")
+		e.Int(s.NewChatters)
+	}
+	{
+		e.FieldStart("present_chatters")
+		e.Int(s.PresentChatters)
+	}
+	{
+		e.FieldStart("average_presence_seconds")
+		e.Float64(s.AveragePresenceSeconds)
 	}
 }
 
-var jsonFieldsNameOfCreateNotificationRequest = [3]string{
-	0: "provider",
-	1: "settings",
-	2: "enabled",
+var jsonFieldsNameOfChannelAnalyticsPoint = [6]string{
+	0: "bucket_start",
+	1: "messages",
+	2: "unique_chatters",
+	3: "new_chatters",
+	4: "present_chatters",
+	5: "average_presence_seconds",
 }
 
-// Decode decodes CreateNotificationRequest from json.
-func (s *CreateNotificationRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ChannelAnalyticsPoint from json.
+func (s *ChannelAnalyticsPoint) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateNotificationRequest to nil")
+		return errors.New("invalid: unable to decode ChannelAnalyticsPoint to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "provider":
+		case "bucket_start":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Provider.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.BucketStart = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
+				return errors.Wrap(err, "decode field \"bucket_start\"")
 			}
-		case "settings":
+		case "messages":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Settings.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.Messages = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
+				return errors.Wrap(err, "decode field \"messages\"")
 			}
-		case "enabled":
+		case "unique_chatters":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Enabled.Reset()
-				if err := s.Enabled.Decode(d); err != nil {
+				v, err := d.Int()
+				s.UniqueChatters = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"enabled\"")
+				return errors.Wrap(err, "decode field \"unique_chatters\"")
+			}
+		case "new_chatters":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.NewChatters = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new_chatters\"")
+			}
+		case "present_chatters":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.PresentChatters = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"present_chatters\"")
+			}
+		case "average_presence_seconds":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.AveragePresenceSeconds = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"average_presence_seconds\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateNotificationRequest")
+		return errors.Wrap(err, "decode ChannelAnalyticsPoint")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2850,8 +3545,8 @@ func (s *CreateNotificationRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateNotificationRequest) {
-					name = jsonFieldsNameOfCreateNotificationRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChannelAnalyticsPoint) {
+					name = jsonFieldsNameOfChannelAnalyticsPoint[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2872,289 +3567,301 @@ func (s *CreateNotificationRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateNotificationRequest) MarshalJSON() ([]byte, error) {
+func (s *ChannelAnalyticsPoint) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateNotificationRequest) UnmarshalJSON(data []byte) error {
+func (s *ChannelAnalyticsPoint) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateNotificationRequestProvider as json.
-func (s CreateNotificationRequestProvider) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *ChannelBlacklistChange) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CreateNotificationRequestProvider from json.
-func (s *CreateNotificationRequestProvider) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateNotificationRequestProvider to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *ChannelBlacklistChange) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
 	}
-	// Try to use constant string.
-	switch CreateNotificationRequestProvider(v) {
-	case CreateNotificationRequestProviderTelegram:
-		*s = CreateNotificationRequestProviderTelegram
-	case CreateNotificationRequestProviderWebhook:
-		*s = CreateNotificationRequestProviderWebhook
-	default:
-		*s = CreateNotificationRequestProvider(v)
+	{
+		e.FieldStart("add")
+		e.Bool(s.Add)
 	}
+}
 
-	return nil
+var jsonFieldsNameOfChannelBlacklistChange = [2]string{
+	0: "login",
+	1: "add",
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s CreateNotificationRequestProvider) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateNotificationRequestProvider) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s CreateNotificationRequestSettings) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s CreateNotificationRequestSettings) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
-	}
-}
-
-// Decode decodes CreateNotificationRequestSettings from json.
-func (s *CreateNotificationRequestSettings) Decode(d *jx.Decoder) error {
+// Decode decodes ChannelBlacklistChange from json.
+func (s *ChannelBlacklistChange) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateNotificationRequestSettings to nil")
+		return errors.New("invalid: unable to decode ChannelBlacklistChange to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "login":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "add":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.Add = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"add\"")
+			}
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateNotificationRequestSettings")
+		return errors.Wrap(err, "decode ChannelBlacklistChange")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfChannelBlacklistChange) {
+					name = jsonFieldsNameOfChannelBlacklistChange[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CreateNotificationRequestSettings) MarshalJSON() ([]byte, error) {
+func (s *ChannelBlacklistChange) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateNotificationRequestSettings) UnmarshalJSON(data []byte) error {
+func (s *ChannelBlacklistChange) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateRuleRequest) Encode(e *jx.Encoder) {
+func (s *ChannelBotEstimate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateRuleRequest) encodeFields(e *jx.Encoder) {
+func (s *ChannelBotEstimate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("channel_twitch_user_id")
+		e.Int64(s.ChannelTwitchUserID)
 	}
 	{
-		if s.Enabled.Set {
-			e.FieldStart("enabled")
-			s.Enabled.Encode(e)
-		}
+		e.FieldStart("channel_login")
+		e.Str(s.ChannelLogin)
 	}
 	{
-		e.FieldStart("event_type")
-		s.EventType.Encode(e)
+		e.FieldStart("viewer_count")
+		e.Int64(s.ViewerCount)
 	}
 	{
-		e.FieldStart("event_settings")
-		s.EventSettings.Encode(e)
+		e.FieldStart("chatter_count")
+		e.Int64(s.ChatterCount)
 	}
 	{
-		e.FieldStart("middlewares")
-		e.ArrStart()
-		for _, elem := range s.Middlewares {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("bot_chatter_count")
+		e.Int64(s.BotChatterCount)
 	}
 	{
-		e.FieldStart("action_type")
-		s.ActionType.Encode(e)
+		e.FieldStart("bot_share")
+		e.Float64(s.BotShare)
 	}
 	{
-		e.FieldStart("action_settings")
-		s.ActionSettings.Encode(e)
+		e.FieldStart("viewer_inflated")
+		e.Bool(s.ViewerInflated)
 	}
 	{
-		if s.UseSharedPool.Set {
-			e.FieldStart("use_shared_pool")
-			s.UseSharedPool.Encode(e)
-		}
+		e.FieldStart("computed_at")
+		json.EncodeDateTime(e, s.ComputedAt)
 	}
 }
 
-var jsonFieldsNameOfCreateRuleRequest = [8]string{
-	0: "name",
-	1: "enabled",
-	2: "event_type",
-	3: "event_settings",
-	4: "middlewares",
-	5: "action_type",
-	6: "action_settings",
-	7: "use_shared_pool",
+var jsonFieldsNameOfChannelBotEstimate = [8]string{
+	0: "channel_twitch_user_id",
+	1: "channel_login",
+	2: "viewer_count",
+	3: "chatter_count",
+	4: "bot_chatter_count",
+	5: "bot_share",
+	6: "viewer_inflated",
+	7: "computed_at",
 }
 
-// Decode decodes CreateRuleRequest from json.
-func (s *CreateRuleRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ChannelBotEstimate from json.
+func (s *ChannelBotEstimate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateRuleRequest to nil")
+		return errors.New("invalid: unable to decode ChannelBotEstimate to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "channel_twitch_user_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := d.Int64()
+				s.ChannelTwitchUserID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"channel_twitch_user_id\"")
 			}
-		case "enabled":
+		case "channel_login":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Enabled.Reset()
-				if err := s.Enabled.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ChannelLogin = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"enabled\"")
+				return errors.Wrap(err, "decode field \"channel_login\"")
 			}
-		case "event_type":
+		case "viewer_count":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.EventType.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.ViewerCount = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"event_type\"")
+				return errors.Wrap(err, "decode field \"viewer_count\"")
 			}
-		case "event_settings":
+		case "chatter_count":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				if err := s.EventSettings.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.ChatterCount = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"event_settings\"")
+				return errors.Wrap(err, "decode field \"chatter_count\"")
 			}
-		case "middlewares":
+		case "bot_chatter_count":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.Middlewares = make([]RuleMiddleware, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RuleMiddleware
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Middlewares = append(s.Middlewares, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int64()
+				s.BotChatterCount = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"middlewares\"")
+				return errors.Wrap(err, "decode field \"bot_chatter_count\"")
 			}
-		case "action_type":
+		case "bot_share":
 			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				if err := s.ActionType.Decode(d); err != nil {
+				v, err := d.Float64()
+				s.BotShare = float64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"action_type\"")
+				return errors.Wrap(err, "decode field \"bot_share\"")
 			}
-		case "action_settings":
+		case "viewer_inflated":
 			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				if err := s.ActionSettings.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.ViewerInflated = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"action_settings\"")
+				return errors.Wrap(err, "decode field \"viewer_inflated\"")
 			}
-		case "use_shared_pool":
+		case "computed_at":
+			requiredBitSet[0] |= 1 << 7
 			if err := func() error {
-				s.UseSharedPool.Reset()
-				if err := s.UseSharedPool.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.ComputedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"use_shared_pool\"")
+				return errors.Wrap(err, "decode field \"computed_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateRuleRequest")
+		return errors.Wrap(err, "decode ChannelBotEstimate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111101,
+		0b11111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3166,8 +3873,8 @@ func (s *CreateRuleRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateRuleRequest) {
-					name = jsonFieldsNameOfCreateRuleRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChannelBotEstimate) {
+					name = jsonFieldsNameOfChannelBotEstimate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3188,232 +3895,132 @@ func (s *CreateRuleRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateRuleRequest) MarshalJSON() ([]byte, error) {
+func (s *ChannelBotEstimate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateRuleRequest) UnmarshalJSON(data []byte) error {
+func (s *ChannelBotEstimate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s CreateRuleRequestActionSettings) Encode(e *jx.Encoder) {
+func (s *ChannelChatterEntry) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s CreateRuleRequestActionSettings) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+// encodeFields encodes fields.
+func (s *ChannelChatterEntry) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
+	}
+	{
+		e.FieldStart("user_twitch_id")
+		e.Int64(s.UserTwitchID)
+	}
+	{
+		e.FieldStart("present_since")
+		json.EncodeDateTime(e, s.PresentSince)
+	}
+	{
+		if s.AccountCreatedAt.Set {
+			e.FieldStart("account_created_at")
+			s.AccountCreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.MessageCount.Set {
+			e.FieldStart("message_count")
+			s.MessageCount.Encode(e)
 		}
 	}
 }
 
-// Decode decodes CreateRuleRequestActionSettings from json.
-func (s *CreateRuleRequestActionSettings) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateRuleRequestActionSettings to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CreateRuleRequestActionSettings")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CreateRuleRequestActionSettings) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateRuleRequestActionSettings) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s CreateRuleRequestEventSettings) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s CreateRuleRequestEventSettings) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
-	}
-}
-
-// Decode decodes CreateRuleRequestEventSettings from json.
-func (s *CreateRuleRequestEventSettings) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateRuleRequestEventSettings to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CreateRuleRequestEventSettings")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CreateRuleRequestEventSettings) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateRuleRequestEventSettings) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *CreateTwitchAccountRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *CreateTwitchAccountRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		e.FieldStart("username")
-		e.Str(s.Username)
-	}
-	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
-	}
-	{
-		if s.AccountType.Set {
-			e.FieldStart("account_type")
-			s.AccountType.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfCreateTwitchAccountRequest = [4]string{
-	0: "id",
-	1: "username",
-	2: "refresh_token",
-	3: "account_type",
+var jsonFieldsNameOfChannelChatterEntry = [5]string{
+	0: "login",
+	1: "user_twitch_id",
+	2: "present_since",
+	3: "account_created_at",
+	4: "message_count",
 }
 
-// Decode decodes CreateTwitchAccountRequest from json.
-func (s *CreateTwitchAccountRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ChannelChatterEntry from json.
+func (s *ChannelChatterEntry) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateTwitchAccountRequest to nil")
+		return errors.New("invalid: unable to decode ChannelChatterEntry to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "login":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
+				v, err := d.Str()
+				s.Login = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"login\"")
 			}
-		case "username":
+		case "user_twitch_id":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
+				v, err := d.Int64()
+				s.UserTwitchID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
+				return errors.Wrap(err, "decode field \"user_twitch_id\"")
 			}
-		case "refresh_token":
+		case "present_since":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.PresentSince = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
+				return errors.Wrap(err, "decode field \"present_since\"")
 			}
-		case "account_type":
+		case "account_created_at":
 			if err := func() error {
-				s.AccountType.Reset()
-				if err := s.AccountType.Decode(d); err != nil {
+				s.AccountCreatedAt.Reset()
+				if err := s.AccountCreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"account_type\"")
+				return errors.Wrap(err, "decode field \"account_created_at\"")
+			}
+		case "message_count":
+			if err := func() error {
+				s.MessageCount.Reset()
+				if err := s.MessageCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message_count\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateTwitchAccountRequest")
+		return errors.Wrap(err, "decode ChannelChatterEntry")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3430,8 +4037,8 @@ func (s *CreateTwitchAccountRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateTwitchAccountRequest) {
-					name = jsonFieldsNameOfCreateTwitchAccountRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChannelChatterEntry) {
+					name = jsonFieldsNameOfChannelChatterEntry[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3452,109 +4059,166 @@ func (s *CreateTwitchAccountRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateTwitchAccountRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateTwitchAccountRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreateTwitchAccountRequestAccountType as json.
-func (s CreateTwitchAccountRequestAccountType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes CreateTwitchAccountRequestAccountType from json.
-func (s *CreateTwitchAccountRequestAccountType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateTwitchAccountRequestAccountType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch CreateTwitchAccountRequestAccountType(v) {
-	case CreateTwitchAccountRequestAccountTypeMain:
-		*s = CreateTwitchAccountRequestAccountTypeMain
-	case CreateTwitchAccountRequestAccountTypeBot:
-		*s = CreateTwitchAccountRequestAccountTypeBot
-	default:
-		*s = CreateTwitchAccountRequestAccountType(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CreateTwitchAccountRequestAccountType) MarshalJSON() ([]byte, error) {
+func (s *ChannelChatterEntry) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateTwitchAccountRequestAccountType) UnmarshalJSON(data []byte) error {
+func (s *ChannelChatterEntry) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateTwitchUserRequest) Encode(e *jx.Encoder) {
+func (s *ChannelDiscoverySettings) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateTwitchUserRequest) encodeFields(e *jx.Encoder) {
+func (s *ChannelDiscoverySettings) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("enabled")
+		e.Bool(s.Enabled)
 	}
-}
-
-var jsonFieldsNameOfCreateTwitchUserRequest = [1]string{
-	0: "name",
-}
-
-// Decode decodes CreateTwitchUserRequest from json.
-func (s *CreateTwitchUserRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateTwitchUserRequest to nil")
+	{
+		e.FieldStart("poll_interval_seconds")
+		e.Int(s.PollIntervalSeconds)
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
+	{
+		e.FieldStart("game_id")
+		e.Str(s.GameID)
+	}
+	{
+		e.FieldStart("min_live_viewers")
+		e.Int(s.MinLiveViewers)
+	}
+	{
+		e.FieldStart("required_stream_tags")
+		e.ArrStart()
+		for _, elem := range s.RequiredStreamTags {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("max_stream_pages_per_run")
+		e.Int(s.MaxStreamPagesPerRun)
+	}
+}
+
+var jsonFieldsNameOfChannelDiscoverySettings = [6]string{
+	0: "enabled",
+	1: "poll_interval_seconds",
+	2: "game_id",
+	3: "min_live_viewers",
+	4: "required_stream_tags",
+	5: "max_stream_pages_per_run",
+}
+
+// Decode decodes ChannelDiscoverySettings from json.
+func (s *ChannelDiscoverySettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ChannelDiscoverySettings to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "enabled":
 			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Enabled = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "poll_interval_seconds":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.PollIntervalSeconds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"poll_interval_seconds\"")
+			}
+		case "game_id":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.Name = string(v)
+				s.GameID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"game_id\"")
+			}
+		case "min_live_viewers":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.MinLiveViewers = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"min_live_viewers\"")
+			}
+		case "required_stream_tags":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.RequiredStreamTags = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.RequiredStreamTags = append(s.RequiredStreamTags, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"required_stream_tags\"")
+			}
+		case "max_stream_pages_per_run":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.MaxStreamPagesPerRun = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_stream_pages_per_run\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateTwitchUserRequest")
+		return errors.Wrap(err, "decode ChannelDiscoverySettings")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3566,8 +4230,8 @@ func (s *CreateTwitchUserRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateTwitchUserRequest) {
-					name = jsonFieldsNameOfCreateTwitchUserRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChannelDiscoverySettings) {
+					name = jsonFieldsNameOfChannelDiscoverySettings[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3588,69 +4252,162 @@ func (s *CreateTwitchUserRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateTwitchUserRequest) MarshalJSON() ([]byte, error) {
+func (s *ChannelDiscoverySettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateTwitchUserRequest) UnmarshalJSON(data []byte) error {
+func (s *ChannelDiscoverySettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DeleteByIDRequest) Encode(e *jx.Encoder) {
+func (s *ChannelLeaderboard) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DeleteByIDRequest) encodeFields(e *jx.Encoder) {
+func (s *ChannelLeaderboard) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
+		if s.ChannelLogin.Set {
+			e.FieldStart("channel_login")
+			s.ChannelLogin.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("from")
+		json.EncodeDateTime(e, s.From)
+	}
+	{
+		e.FieldStart("to")
+		json.EncodeDateTime(e, s.To)
+	}
+	{
+		e.FieldStart("total")
+		e.Int64(s.Total)
+	}
+	{
+		e.FieldStart("entries")
+		e.ArrStart()
+		for _, elem := range s.Entries {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("rolled_up_until")
+		s.RolledUpUntil.Encode(e, json.EncodeDateTime)
 	}
 }
 
-var jsonFieldsNameOfDeleteByIDRequest = [1]string{
-	0: "id",
+var jsonFieldsNameOfChannelLeaderboard = [6]string{
+	0: "channel_login",
+	1: "from",
+	2: "to",
+	3: "total",
+	4: "entries",
+	5: "rolled_up_until",
 }
 
-// Decode decodes DeleteByIDRequest from json.
-func (s *DeleteByIDRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ChannelLeaderboard from json.
+func (s *ChannelLeaderboard) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeleteByIDRequest to nil")
+		return errors.New("invalid: unable to decode ChannelLeaderboard to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "channel_login":
+			if err := func() error {
+				s.ChannelLogin.Reset()
+				if err := s.ChannelLogin.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel_login\"")
+			}
+		case "from":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.From = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"from\"")
+			}
+		case "to":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.To = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"to\"")
+			}
+		case "total":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := d.Int64()
-				s.ID = int64(v)
+				s.Total = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "entries":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Entries = make([]ChannelLeaderboardEntry, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ChannelLeaderboardEntry
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Entries = append(s.Entries, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"entries\"")
+			}
+		case "rolled_up_until":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.RolledUpUntil.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rolled_up_until\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DeleteByIDRequest")
+		return errors.Wrap(err, "decode ChannelLeaderboard")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00111110,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3662,8 +4419,8 @@ func (s *DeleteByIDRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDeleteByIDRequest) {
-					name = jsonFieldsNameOfDeleteByIDRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChannelLeaderboard) {
+					name = jsonFieldsNameOfChannelLeaderboard[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3684,181 +4441,154 @@ func (s *DeleteByIDRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeleteByIDRequest) MarshalJSON() ([]byte, error) {
+func (s *ChannelLeaderboard) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeleteByIDRequest) UnmarshalJSON(data []byte) error {
+func (s *ChannelLeaderboard) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DiscoveryCandidate) Encode(e *jx.Encoder) {
+func (s *ChannelLeaderboardEntry) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DiscoveryCandidate) encodeFields(e *jx.Encoder) {
+func (s *ChannelLeaderboardEntry) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("user")
-		s.User.Encode(e)
+		e.FieldStart("login")
+		e.Str(s.Login)
 	}
 	{
-		e.FieldStart("discovered_at")
-		json.EncodeDateTime(e, s.DiscoveredAt)
+		e.FieldStart("user_twitch_id")
+		e.Int64(s.UserTwitchID)
 	}
 	{
-		e.FieldStart("last_seen_at")
-		json.EncodeDateTime(e, s.LastSeenAt)
+		e.FieldStart("presence_seconds")
+		e.Int64(s.PresenceSeconds)
 	}
 	{
-		if s.ViewerCount.Set {
-			e.FieldStart("viewer_count")
-			s.ViewerCount.Encode(e)
-		}
+		e.FieldStart("message_count")
+		e.Int64(s.MessageCount)
 	}
 	{
-		if s.Title.Set {
-			e.FieldStart("title")
-			s.Title.Encode(e)
-		}
+		e.FieldStart("channels")
+		e.Int(s.Channels)
 	}
 	{
-		if s.GameName.Set {
-			e.FieldStart("game_name")
-			s.GameName.Encode(e)
+		if s.AccountCreatedAt.Set {
+			e.FieldStart("account_created_at")
+			s.AccountCreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
-	{
-		e.FieldStart("stream_tags")
-		e.ArrStart()
-		for _, elem := range s.StreamTags {
-			e.Str(elem)
-		}
-		e.ArrEnd()
-	}
 }
 
-var jsonFieldsNameOfDiscoveryCandidate = [7]string{
-	0: "user",
-	1: "discovered_at",
-	2: "last_seen_at",
-	3: "viewer_count",
-	4: "title",
-	5: "game_name",
-	6: "stream_tags",
+var jsonFieldsNameOfChannelLeaderboardEntry = [6]string{
+	0: "login",
+	1: "user_twitch_id",
+	2: "presence_seconds",
+	3: "message_count",
+	4: "channels",
+	5: "account_created_at",
 }
 
-// Decode decodes DiscoveryCandidate from json.
-func (s *DiscoveryCandidate) Decode(d *jx.Decoder) error {
+// Decode decodes ChannelLeaderboardEntry from json.
+func (s *ChannelLeaderboardEntry) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DiscoveryCandidate to nil")
+		return errors.New("invalid: unable to decode ChannelLeaderboardEntry to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "user":
+		case "login":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.User.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user\"")
+				return errors.Wrap(err, "decode field \"login\"")
 			}
-		case "discovered_at":
+		case "user_twitch_id":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.DiscoveredAt = v
+				v, err := d.Int64()
+				s.UserTwitchID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"discovered_at\"")
+				return errors.Wrap(err, "decode field \"user_twitch_id\"")
 			}
-		case "last_seen_at":
+		case "presence_seconds":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.LastSeenAt = v
+				v, err := d.Int64()
+				s.PresenceSeconds = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_seen_at\"")
-			}
-		case "viewer_count":
-			if err := func() error {
-				s.ViewerCount.Reset()
-				if err := s.ViewerCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"viewer_count\"")
+				return errors.Wrap(err, "decode field \"presence_seconds\"")
 			}
-		case "title":
+		case "message_count":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Title.Reset()
-				if err := s.Title.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.MessageCount = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
+				return errors.Wrap(err, "decode field \"message_count\"")
 			}
-		case "game_name":
+		case "channels":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.GameName.Reset()
-				if err := s.GameName.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Channels = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"game_name\"")
+				return errors.Wrap(err, "decode field \"channels\"")
 			}
-		case "stream_tags":
-			requiredBitSet[0] |= 1 << 6
+		case "account_created_at":
 			if err := func() error {
-				s.StreamTags = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.StreamTags = append(s.StreamTags, elem)
-					return nil
-				}); err != nil {
+				s.AccountCreatedAt.Reset()
+				if err := s.AccountCreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"stream_tags\"")
+				return errors.Wrap(err, "decode field \"account_created_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DiscoveryCandidate")
+		return errors.Wrap(err, "decode ChannelLeaderboardEntry")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01000111,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3870,8 +4600,8 @@ func (s *DiscoveryCandidate) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDiscoveryCandidate) {
-					name = jsonFieldsNameOfDiscoveryCandidate[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChannelLeaderboardEntry) {
+					name = jsonFieldsNameOfChannelLeaderboardEntry[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3892,216 +4622,223 @@ func (s *DiscoveryCandidate) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DiscoveryCandidate) MarshalJSON() ([]byte, error) {
+func (s *ChannelLeaderboardEntry) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DiscoveryCandidate) UnmarshalJSON(data []byte) error {
+func (s *ChannelLeaderboardEntry) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorMessage) Encode(e *jx.Encoder) {
+func (s *ChannelLive) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorMessage) encodeFields(e *jx.Encoder) {
+func (s *ChannelLive) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("broadcaster_id")
+		e.Int64(s.BroadcasterID)
 	}
-}
-
-var jsonFieldsNameOfErrorMessage = [1]string{
-	0: "message",
-}
-
-// Decode decodes ErrorMessage from json.
-func (s *ErrorMessage) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ErrorMessage to nil")
+	{
+		e.FieldStart("broadcaster_login")
+		e.Str(s.BroadcasterLogin)
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "message":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ErrorMessage")
+	{
+		e.FieldStart("display_name")
+		e.Str(s.DisplayName)
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorMessage) {
-					name = jsonFieldsNameOfErrorMessage[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+	{
+		e.FieldStart("profile_image_url")
+		e.Str(s.ProfileImageURL)
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	{
+		e.FieldStart("is_live")
+		e.Bool(s.IsLive)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorMessage) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorMessage) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *FollowedChannelEntry) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *FollowedChannelEntry) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("channel_id")
-		e.Int64(s.ChannelID)
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("channel_login")
-		e.Str(s.ChannelLogin)
+		if s.GameName.Set {
+			e.FieldStart("game_name")
+			s.GameName.Encode(e)
+		}
 	}
 	{
-		if s.FollowedAt.Set {
-			e.FieldStart("followed_at")
-			s.FollowedAt.Encode(e, json.EncodeDateTime)
+		if s.ViewerCount.Set {
+			e.FieldStart("viewer_count")
+			s.ViewerCount.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("on_blacklist")
-		e.Bool(s.OnBlacklist)
+		if s.ChannelChatterCount.Set {
+			e.FieldStart("channel_chatter_count")
+			s.ChannelChatterCount.Encode(e)
+		}
+	}
+	{
+		if s.StartedAt.Set {
+			e.FieldStart("started_at")
+			s.StartedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 }
 
-var jsonFieldsNameOfFollowedChannelEntry = [4]string{
-	0: "channel_id",
-	1: "channel_login",
-	2: "followed_at",
-	3: "on_blacklist",
+var jsonFieldsNameOfChannelLive = [10]string{
+	0: "broadcaster_id",
+	1: "broadcaster_login",
+	2: "display_name",
+	3: "profile_image_url",
+	4: "is_live",
+	5: "title",
+	6: "game_name",
+	7: "viewer_count",
+	8: "channel_chatter_count",
+	9: "started_at",
 }
 
-// Decode decodes FollowedChannelEntry from json.
-func (s *FollowedChannelEntry) Decode(d *jx.Decoder) error {
+// Decode decodes ChannelLive from json.
+func (s *ChannelLive) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode FollowedChannelEntry to nil")
+		return errors.New("invalid: unable to decode ChannelLive to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "channel_id":
+		case "broadcaster_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.ChannelID = int64(v)
+				s.BroadcasterID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_id\"")
+				return errors.Wrap(err, "decode field \"broadcaster_id\"")
 			}
-		case "channel_login":
+		case "broadcaster_login":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.ChannelLogin = string(v)
+				s.BroadcasterLogin = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_login\"")
+				return errors.Wrap(err, "decode field \"broadcaster_login\"")
 			}
-		case "followed_at":
+		case "display_name":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.FollowedAt.Reset()
-				if err := s.FollowedAt.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.DisplayName = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"followed_at\"")
+				return errors.Wrap(err, "decode field \"display_name\"")
 			}
-		case "on_blacklist":
+		case "profile_image_url":
 			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.ProfileImageURL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"profile_image_url\"")
+			}
+		case "is_live":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := d.Bool()
-				s.OnBlacklist = bool(v)
+				s.IsLive = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"on_blacklist\"")
+				return errors.Wrap(err, "decode field \"is_live\"")
+			}
+		case "title":
+			if err := func() error {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "game_name":
+			if err := func() error {
+				s.GameName.Reset()
+				if err := s.GameName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"game_name\"")
+			}
+		case "viewer_count":
+			if err := func() error {
+				s.ViewerCount.Reset()
+				if err := s.ViewerCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"viewer_count\"")
+			}
+		case "channel_chatter_count":
+			if err := func() error {
+				s.ChannelChatterCount.Reset()
+				if err := s.ChannelChatterCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel_chatter_count\"")
+			}
+		case "started_at":
+			if err := func() error {
+				s.StartedAt.Reset()
+				if err := s.StartedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"started_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode FollowedChannelEntry")
+		return errors.Wrap(err, "decode ChannelLive")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001011,
+	for i, mask := range [2]uint8{
+		0b00011111,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4113,8 +4850,8 @@ func (s *FollowedChannelEntry) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfFollowedChannelEntry) {
-					name = jsonFieldsNameOfFollowedChannelEntry[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChannelLive) {
+					name = jsonFieldsNameOfChannelLive[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4135,103 +4872,166 @@ func (s *FollowedChannelEntry) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *FollowedChannelEntry) MarshalJSON() ([]byte, error) {
+func (s *ChannelLive) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *FollowedChannelEntry) UnmarshalJSON(data []byte) error {
+func (s *ChannelLive) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *FollowedMonitoredChannel) Encode(e *jx.Encoder) {
+func (s *ChatClassifierSettings) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *FollowedMonitoredChannel) encodeFields(e *jx.Encoder) {
+func (s *ChatClassifierSettings) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("channel_id")
-		e.Int64(s.ChannelID)
+		e.FieldStart("enabled")
+		e.Bool(s.Enabled)
 	}
 	{
-		e.FieldStart("channel_login")
-		e.Str(s.ChannelLogin)
+		e.FieldStart("channels")
+		e.ArrStart()
+		for _, elem := range s.Channels {
+			e.Str(elem)
+		}
+		e.ArrEnd()
 	}
 	{
-		if s.FollowedAt.Set {
-			e.FieldStart("followed_at")
-			s.FollowedAt.Encode(e, json.EncodeDateTime)
+		e.FieldStart("sample_rate")
+		e.Float64(s.SampleRate)
+	}
+	{
+		e.FieldStart("batch_size")
+		e.Int(s.BatchSize)
+	}
+	{
+		e.FieldStart("daily_token_budget")
+		e.Int64(s.DailyTokenBudget)
+	}
+	{
+		if s.TokensUsedToday.Set {
+			e.FieldStart("tokens_used_today")
+			s.TokensUsedToday.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfFollowedMonitoredChannel = [3]string{
-	0: "channel_id",
-	1: "channel_login",
-	2: "followed_at",
+var jsonFieldsNameOfChatClassifierSettings = [6]string{
+	0: "enabled",
+	1: "channels",
+	2: "sample_rate",
+	3: "batch_size",
+	4: "daily_token_budget",
+	5: "tokens_used_today",
 }
 
-// Decode decodes FollowedMonitoredChannel from json.
-func (s *FollowedMonitoredChannel) Decode(d *jx.Decoder) error {
+// Decode decodes ChatClassifierSettings from json.
+func (s *ChatClassifierSettings) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode FollowedMonitoredChannel to nil")
+		return errors.New("invalid: unable to decode ChatClassifierSettings to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "channel_id":
+		case "enabled":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ChannelID = int64(v)
+				v, err := d.Bool()
+				s.Enabled = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_id\"")
+				return errors.Wrap(err, "decode field \"enabled\"")
 			}
-		case "channel_login":
+		case "channels":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.ChannelLogin = string(v)
+				s.Channels = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Channels = append(s.Channels, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channels\"")
+			}
+		case "sample_rate":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.SampleRate = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_login\"")
+				return errors.Wrap(err, "decode field \"sample_rate\"")
 			}
-		case "followed_at":
+		case "batch_size":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.FollowedAt.Reset()
-				if err := s.FollowedAt.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Int()
+				s.BatchSize = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"followed_at\"")
+				return errors.Wrap(err, "decode field \"batch_size\"")
+			}
+		case "daily_token_budget":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.DailyTokenBudget = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"daily_token_budget\"")
+			}
+		case "tokens_used_today":
+			if err := func() error {
+				s.TokensUsedToday.Reset()
+				if err := s.TokensUsedToday.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tokens_used_today\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode FollowedMonitoredChannel")
+		return errors.Wrap(err, "decode ChatClassifierSettings")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4243,8 +5043,8 @@ func (s *FollowedMonitoredChannel) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfFollowedMonitoredChannel) {
-					name = jsonFieldsNameOfFollowedMonitoredChannel[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChatClassifierSettings) {
+					name = jsonFieldsNameOfChatClassifierSettings[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4265,253 +5065,104 @@ func (s *FollowedMonitoredChannel) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *FollowedMonitoredChannel) MarshalJSON() ([]byte, error) {
+func (s *ChatClassifierSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *FollowedMonitoredChannel) UnmarshalJSON(data []byte) error {
+func (s *ChatClassifierSettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GetChannelLiveRequest) Encode(e *jx.Encoder) {
+func (s *ChatHistoryEntry) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GetChannelLiveRequest) encodeFields(e *jx.Encoder) {
+func (s *ChatHistoryEntry) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("login")
-		e.Str(s.Login)
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
-}
-
-var jsonFieldsNameOfGetChannelLiveRequest = [1]string{
-	0: "login",
-}
-
-// Decode decodes GetChannelLiveRequest from json.
-func (s *GetChannelLiveRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetChannelLiveRequest to nil")
+	{
+		e.FieldStart("channel")
+		e.Str(s.Channel)
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "login":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Login = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login\"")
-			}
-		default:
-			return d.Skip()
+	{
+		e.FieldStart("user")
+		e.Str(s.User)
+	}
+	{
+		if s.ChatterUserID.Set {
+			e.FieldStart("chatter_user_id")
+			s.ChatterUserID.Encode(e)
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode GetChannelLiveRequest")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetChannelLiveRequest) {
-					name = jsonFieldsNameOfGetChannelLiveRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetChannelLiveRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetChannelLiveRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes GetRecordedStreamLeaderboardOKApplicationJSON as json.
-func (s GetRecordedStreamLeaderboardOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []StreamLeaderboardEntry(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes GetRecordedStreamLeaderboardOKApplicationJSON from json.
-func (s *GetRecordedStreamLeaderboardOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetRecordedStreamLeaderboardOKApplicationJSON to nil")
+	{
+		e.FieldStart("chatter_marked")
+		e.Bool(s.ChatterMarked)
 	}
-	var unwrapped []StreamLeaderboardEntry
-	if err := func() error {
-		unwrapped = make([]StreamLeaderboardEntry, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem StreamLeaderboardEntry
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	{
+		e.FieldStart("chatter_is_sus")
+		e.Bool(s.ChatterIsSus)
 	}
-	*s = GetRecordedStreamLeaderboardOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s GetRecordedStreamLeaderboardOKApplicationJSON) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetRecordedStreamLeaderboardOKApplicationJSON) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes GetTwitchUserActivityTimelineOKApplicationJSON as json.
-func (s GetTwitchUserActivityTimelineOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []ActivityTimelineSegment(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+	{
+		e.FieldStart("first_message")
+		e.Bool(s.FirstMessage)
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes GetTwitchUserActivityTimelineOKApplicationJSON from json.
-func (s *GetTwitchUserActivityTimelineOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetTwitchUserActivityTimelineOKApplicationJSON to nil")
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
-	var unwrapped []ActivityTimelineSegment
-	if err := func() error {
-		unwrapped = make([]ActivityTimelineSegment, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem ActivityTimelineSegment
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	{
+		e.FieldStart("keyword_match")
+		e.Bool(s.KeywordMatch)
 	}
-	*s = GetTwitchUserActivityTimelineOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s GetTwitchUserActivityTimelineOKApplicationJSON) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetTwitchUserActivityTimelineOKApplicationJSON) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *GetTwitchUserActivityTimelineRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *GetTwitchUserActivityTimelineRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
+		e.FieldStart("source")
+		s.Source.Encode(e)
 	}
 	{
-		if s.From.Set {
-			e.FieldStart("from")
-			s.From.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 	{
-		if s.To.Set {
-			e.FieldStart("to")
-			s.To.Encode(e, json.EncodeDateTime)
+		e.FieldStart("badge_tags")
+		e.ArrStart()
+		for _, elem := range s.BadgeTags {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfGetTwitchUserActivityTimelineRequest = [3]string{
-	0: "id",
-	1: "from",
-	2: "to",
+var jsonFieldsNameOfChatHistoryEntry = [12]string{
+	0:  "id",
+	1:  "channel",
+	2:  "user",
+	3:  "chatter_user_id",
+	4:  "chatter_marked",
+	5:  "chatter_is_sus",
+	6:  "first_message",
+	7:  "message",
+	8:  "keyword_match",
+	9:  "source",
+	10: "created_at",
+	11: "badge_tags",
 }
 
-// Decode decodes GetTwitchUserActivityTimelineRequest from json.
-func (s *GetTwitchUserActivityTimelineRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ChatHistoryEntry from json.
+func (s *ChatHistoryEntry) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetTwitchUserActivityTimelineRequest to nil")
+		return errors.New("invalid: unable to decode ChatHistoryEntry to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -4527,246 +5178,152 @@ func (s *GetTwitchUserActivityTimelineRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "from":
+		case "channel":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.From.Reset()
-				if err := s.From.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.Channel = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"from\"")
+				return errors.Wrap(err, "decode field \"channel\"")
 			}
-		case "to":
+		case "user":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.To.Reset()
-				if err := s.To.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.User = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"to\"")
+				return errors.Wrap(err, "decode field \"user\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode GetTwitchUserActivityTimelineRequest")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetTwitchUserActivityTimelineRequest) {
-					name = jsonFieldsNameOfGetTwitchUserActivityTimelineRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "chatter_user_id":
+			if err := func() error {
+				s.ChatterUserID.Reset()
+				if err := s.ChatterUserID.Decode(d); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chatter_user_id\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetTwitchUserActivityTimelineRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetTwitchUserActivityTimelineRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *GetTwitchUserProfileRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *GetTwitchUserProfileRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-}
-
-var jsonFieldsNameOfGetTwitchUserProfileRequest = [1]string{
-	0: "id",
-}
-
-// Decode decodes GetTwitchUserProfileRequest from json.
-func (s *GetTwitchUserProfileRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetTwitchUserProfileRequest to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "chatter_marked":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
+				v, err := d.Bool()
+				s.ChatterMarked = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"chatter_marked\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode GetTwitchUserProfileRequest")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetTwitchUserProfileRequest) {
-					name = jsonFieldsNameOfGetTwitchUserProfileRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "chatter_is_sus":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.ChatterIsSus = bool(v)
+				if err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chatter_is_sus\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetTwitchUserProfileRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetTwitchUserProfileRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *IrcJoinedSample) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *IrcJoinedSample) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("captured_at")
-		json.EncodeDateTime(e, s.CapturedAt)
-	}
-	{
-		e.FieldStart("joined_count")
-		e.Int(s.JoinedCount)
-	}
-}
-
-var jsonFieldsNameOfIrcJoinedSample = [2]string{
-	0: "captured_at",
-	1: "joined_count",
-}
-
-// Decode decodes IrcJoinedSample from json.
-func (s *IrcJoinedSample) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode IrcJoinedSample to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "captured_at":
-			requiredBitSet[0] |= 1 << 0
+		case "first_message":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CapturedAt = v
+				v, err := d.Bool()
+				s.FirstMessage = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"captured_at\"")
+				return errors.Wrap(err, "decode field \"first_message\"")
 			}
-		case "joined_count":
-			requiredBitSet[0] |= 1 << 1
+		case "message":
+			requiredBitSet[0] |= 1 << 7
 			if err := func() error {
-				v, err := d.Int()
-				s.JoinedCount = int(v)
+				v, err := d.Str()
+				s.Message = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"joined_count\"")
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "keyword_match":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.KeywordMatch = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"keyword_match\"")
+			}
+		case "source":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				if err := s.Source.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "badge_tags":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				s.BadgeTags = make([]ChatHistoryEntryBadgeTagsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ChatHistoryEntryBadgeTagsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.BadgeTags = append(s.BadgeTags, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"badge_tags\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode IrcJoinedSample")
+		return errors.Wrap(err, "decode ChatHistoryEntry")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b11110111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4778,8 +5335,8 @@ func (s *IrcJoinedSample) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfIrcJoinedSample) {
-					name = jsonFieldsNameOfIrcJoinedSample[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChatHistoryEntry) {
+					name = jsonFieldsNameOfChatHistoryEntry[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4800,83 +5357,314 @@ func (s *IrcJoinedSample) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *IrcJoinedSample) MarshalJSON() ([]byte, error) {
+func (s *ChatHistoryEntry) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IrcJoinedSample) UnmarshalJSON(data []byte) error {
+func (s *ChatHistoryEntry) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *IrcMonitorSettings) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes ChatHistoryEntryBadgeTagsItem as json.
+func (s ChatHistoryEntryBadgeTagsItem) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ChatHistoryEntryBadgeTagsItem from json.
+func (s *ChatHistoryEntryBadgeTagsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ChatHistoryEntryBadgeTagsItem to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ChatHistoryEntryBadgeTagsItem(v) {
+	case ChatHistoryEntryBadgeTagsItemModerator:
+		*s = ChatHistoryEntryBadgeTagsItemModerator
+	case ChatHistoryEntryBadgeTagsItemVip:
+		*s = ChatHistoryEntryBadgeTagsItemVip
+	case ChatHistoryEntryBadgeTagsItemBot:
+		*s = ChatHistoryEntryBadgeTagsItemBot
+	case ChatHistoryEntryBadgeTagsItemOther:
+		*s = ChatHistoryEntryBadgeTagsItemOther
+	default:
+		*s = ChatHistoryEntryBadgeTagsItem(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ChatHistoryEntryBadgeTagsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ChatHistoryEntryBadgeTagsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ChatHistoryEntrySource as json.
+func (s ChatHistoryEntrySource) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ChatHistoryEntrySource from json.
+func (s *ChatHistoryEntrySource) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ChatHistoryEntrySource to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ChatHistoryEntrySource(v) {
+	case ChatHistoryEntrySourceIrc:
+		*s = ChatHistoryEntrySourceIrc
+	case ChatHistoryEntrySourceSent:
+		*s = ChatHistoryEntrySourceSent
+	default:
+		*s = ChatHistoryEntrySource(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ChatHistoryEntrySource) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ChatHistoryEntrySource) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ChatMoment) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *IrcMonitorSettings) encodeFields(e *jx.Encoder) {
+func (s *ChatMoment) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("oauth_twitch_account_id")
-		s.OAuthTwitchAccountID.Encode(e)
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
 	{
-		e.FieldStart("enrichment_cooldown_hours")
-		e.Int(s.EnrichmentCooldownHours)
+		e.FieldStart("kind")
+		s.Kind.Encode(e)
+	}
+	{
+		e.FieldStart("label")
+		e.Str(s.Label)
+	}
+	{
+		e.FieldStart("started_at")
+		json.EncodeDateTime(e, s.StartedAt)
+	}
+	{
+		e.FieldStart("ended_at")
+		json.EncodeDateTime(e, s.EndedAt)
+	}
+	{
+		e.FieldStart("offset_seconds")
+		e.Int64(s.OffsetSeconds)
+	}
+	{
+		e.FieldStart("messages")
+		e.Int64(s.Messages)
+	}
+	{
+		e.FieldStart("chatters")
+		e.Int64(s.Chatters)
+	}
+	{
+		e.FieldStart("intensity")
+		e.Float64(s.Intensity)
+	}
+	{
+		e.FieldStart("sample_messages")
+		e.ArrStart()
+		for _, elem := range s.SampleMessages {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfIrcMonitorSettings = [2]string{
-	0: "oauth_twitch_account_id",
-	1: "enrichment_cooldown_hours",
-}
-
-// Decode decodes IrcMonitorSettings from json.
-func (s *IrcMonitorSettings) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfChatMoment = [10]string{
+	0: "id",
+	1: "kind",
+	2: "label",
+	3: "started_at",
+	4: "ended_at",
+	5: "offset_seconds",
+	6: "messages",
+	7: "chatters",
+	8: "intensity",
+	9: "sample_messages",
+}
+
+// Decode decodes ChatMoment from json.
+func (s *ChatMoment) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode IrcMonitorSettings to nil")
+		return errors.New("invalid: unable to decode ChatMoment to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "oauth_twitch_account_id":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.OAuthTwitchAccountID.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"oauth_twitch_account_id\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "enrichment_cooldown_hours":
+		case "kind":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.EnrichmentCooldownHours = int(v)
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "label":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Label = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"enrichment_cooldown_hours\"")
+				return errors.Wrap(err, "decode field \"label\"")
+			}
+		case "started_at":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"started_at\"")
+			}
+		case "ended_at":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ended_at\"")
+			}
+		case "offset_seconds":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int64()
+				s.OffsetSeconds = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"offset_seconds\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int64()
+				s.Messages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		case "chatters":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int64()
+				s.Chatters = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chatters\"")
+			}
+		case "intensity":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Float64()
+				s.Intensity = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"intensity\"")
+			}
+		case "sample_messages":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				s.SampleMessages = make([]ChatMomentMessage, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ChatMomentMessage
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.SampleMessages = append(s.SampleMessages, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sample_messages\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode IrcMonitorSettings")
+		return errors.Wrap(err, "decode ChatMoment")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
+	for i, mask := range [2]uint8{
+		0b11111111,
 		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -4889,8 +5677,8 @@ func (s *IrcMonitorSettings) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfIrcMonitorSettings) {
-					name = jsonFieldsNameOfIrcMonitorSettings[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChatMoment) {
+					name = jsonFieldsNameOfChatMoment[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4911,96 +5699,145 @@ func (s *IrcMonitorSettings) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *IrcMonitorSettings) MarshalJSON() ([]byte, error) {
+func (s *ChatMoment) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IrcMonitorSettings) UnmarshalJSON(data []byte) error {
+func (s *ChatMoment) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ChatMomentKind as json.
+func (s ChatMomentKind) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ChatMomentKind from json.
+func (s *ChatMomentKind) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ChatMomentKind to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ChatMomentKind(v) {
+	case ChatMomentKindVelocity:
+		*s = ChatMomentKindVelocity
+	case ChatMomentKindEmoteFlood:
+		*s = ChatMomentKindEmoteFlood
+	case ChatMomentKindPhrase:
+		*s = ChatMomentKindPhrase
+	default:
+		*s = ChatMomentKind(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ChatMomentKind) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ChatMomentKind) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *IrcMonitorStatus) Encode(e *jx.Encoder) {
+func (s *ChatMomentMessage) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *IrcMonitorStatus) encodeFields(e *jx.Encoder) {
+func (s *ChatMomentMessage) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("connected")
-		e.Bool(s.Connected)
+		e.FieldStart("login")
+		e.Str(s.Login)
 	}
 	{
-		e.FieldStart("channels")
-		e.ArrStart()
-		for _, elem := range s.Channels {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("body")
+		e.Str(s.Body)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 }
 
-var jsonFieldsNameOfIrcMonitorStatus = [2]string{
-	0: "connected",
-	1: "channels",
+var jsonFieldsNameOfChatMomentMessage = [3]string{
+	0: "login",
+	1: "body",
+	2: "created_at",
 }
 
-// Decode decodes IrcMonitorStatus from json.
-func (s *IrcMonitorStatus) Decode(d *jx.Decoder) error {
+// Decode decodes ChatMomentMessage from json.
+func (s *ChatMomentMessage) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode IrcMonitorStatus to nil")
+		return errors.New("invalid: unable to decode ChatMomentMessage to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "connected":
+		case "login":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.Connected = bool(v)
+				v, err := d.Str()
+				s.Login = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"connected\"")
+				return errors.Wrap(err, "decode field \"login\"")
 			}
-		case "channels":
+		case "body":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Channels = make([]IrcMonitorStatusChannelsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem IrcMonitorStatusChannelsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Channels = append(s.Channels, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Str()
+				s.Body = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channels\"")
+				return errors.Wrap(err, "decode field \"body\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode IrcMonitorStatus")
+		return errors.Wrap(err, "decode ChatMomentMessage")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5012,8 +5849,8 @@ func (s *IrcMonitorStatus) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfIrcMonitorStatus) {
-					name = jsonFieldsNameOfIrcMonitorStatus[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChatMomentMessage) {
+					name = jsonFieldsNameOfChatMomentMessage[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5034,86 +5871,137 @@ func (s *IrcMonitorStatus) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *IrcMonitorStatus) MarshalJSON() ([]byte, error) {
+func (s *ChatMomentMessage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IrcMonitorStatus) UnmarshalJSON(data []byte) error {
+func (s *ChatMomentMessage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *IrcMonitorStatusChannelsItem) Encode(e *jx.Encoder) {
+func (s *ChatToxicity) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *IrcMonitorStatusChannelsItem) encodeFields(e *jx.Encoder) {
+func (s *ChatToxicity) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("login")
-		e.Str(s.Login)
+		e.FieldStart("classified_messages")
+		e.Int64(s.ClassifiedMessages)
 	}
 	{
-		e.FieldStart("irc_ok")
-		e.Bool(s.IrcOk)
+		e.FieldStart("toxic_messages")
+		e.Int64(s.ToxicMessages)
+	}
+	{
+		e.FieldStart("avg_toxicity")
+		e.Float64(s.AvgToxicity)
+	}
+	{
+		e.FieldStart("avg_harassment")
+		e.Float64(s.AvgHarassment)
+	}
+	{
+		e.FieldStart("avg_sentiment")
+		e.Float64(s.AvgSentiment)
 	}
 }
 
-var jsonFieldsNameOfIrcMonitorStatusChannelsItem = [2]string{
-	0: "login",
-	1: "irc_ok",
+var jsonFieldsNameOfChatToxicity = [5]string{
+	0: "classified_messages",
+	1: "toxic_messages",
+	2: "avg_toxicity",
+	3: "avg_harassment",
+	4: "avg_sentiment",
 }
 
-// Decode decodes IrcMonitorStatusChannelsItem from json.
-func (s *IrcMonitorStatusChannelsItem) Decode(d *jx.Decoder) error {
+// Decode decodes ChatToxicity from json.
+func (s *ChatToxicity) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode IrcMonitorStatusChannelsItem to nil")
+		return errors.New("invalid: unable to decode ChatToxicity to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "login":
+		case "classified_messages":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Login = string(v)
+				v, err := d.Int64()
+				s.ClassifiedMessages = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login\"")
+				return errors.Wrap(err, "decode field \"classified_messages\"")
 			}
-		case "irc_ok":
+		case "toxic_messages":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Bool()
-				s.IrcOk = bool(v)
+				v, err := d.Int64()
+				s.ToxicMessages = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"irc_ok\"")
+				return errors.Wrap(err, "decode field \"toxic_messages\"")
+			}
+		case "avg_toxicity":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.AvgToxicity = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avg_toxicity\"")
+			}
+		case "avg_harassment":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.AvgHarassment = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avg_harassment\"")
+			}
+		case "avg_sentiment":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.AvgSentiment = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avg_sentiment\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode IrcMonitorStatusChannelsItem")
+		return errors.Wrap(err, "decode ChatToxicity")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5125,8 +6013,8 @@ func (s *IrcMonitorStatusChannelsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfIrcMonitorStatusChannelsItem) {
-					name = jsonFieldsNameOfIrcMonitorStatusChannelsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfChatToxicity) {
+					name = jsonFieldsNameOfChatToxicity[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5147,203 +6035,118 @@ func (s *IrcMonitorStatusChannelsItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *IrcMonitorStatusChannelsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IrcMonitorStatusChannelsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ListAiMessagesOKApplicationJSON as json.
-func (s ListAiMessagesOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []AiMessage(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes ListAiMessagesOKApplicationJSON from json.
-func (s *ListAiMessagesOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListAiMessagesOKApplicationJSON to nil")
-	}
-	var unwrapped []AiMessage
-	if err := func() error {
-		unwrapped = make([]AiMessage, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem AiMessage
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = ListAiMessagesOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ListAiMessagesOKApplicationJSON) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListAiMessagesOKApplicationJSON) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ListChannelChattersOKApplicationJSON as json.
-func (s ListChannelChattersOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []ChannelChatterEntry(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes ListChannelChattersOKApplicationJSON from json.
-func (s *ListChannelChattersOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListChannelChattersOKApplicationJSON to nil")
-	}
-	var unwrapped []ChannelChatterEntry
-	if err := func() error {
-		unwrapped = make([]ChannelChatterEntry, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem ChannelChatterEntry
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = ListChannelChattersOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ListChannelChattersOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *ChatToxicity) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListChannelChattersOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *ChatToxicity) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ListChannelChattersRequest) Encode(e *jx.Encoder) {
+func (s *ClientNotice) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ListChannelChattersRequest) encodeFields(e *jx.Encoder) {
+func (s *ClientNotice) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("account_id")
-		e.Int64(s.AccountID)
+		e.FieldStart("severity")
+		s.Severity.Encode(e)
 	}
 	{
-		e.FieldStart("login")
-		e.Str(s.Login)
+		e.FieldStart("code")
+		e.Str(s.Code)
 	}
 	{
-		if s.SessionStartedAt.Set {
-			e.FieldStart("session_started_at")
-			s.SessionStartedAt.Encode(e, json.EncodeDateTime)
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+	{
+		if s.Details.Set {
+			e.FieldStart("details")
+			s.Details.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfListChannelChattersRequest = [3]string{
-	0: "account_id",
-	1: "login",
-	2: "session_started_at",
+var jsonFieldsNameOfClientNotice = [4]string{
+	0: "severity",
+	1: "code",
+	2: "message",
+	3: "details",
 }
 
-// Decode decodes ListChannelChattersRequest from json.
-func (s *ListChannelChattersRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ClientNotice from json.
+func (s *ClientNotice) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListChannelChattersRequest to nil")
+		return errors.New("invalid: unable to decode ClientNotice to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "account_id":
+		case "severity":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.AccountID = int64(v)
-				if err != nil {
+				if err := s.Severity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"account_id\"")
+				return errors.Wrap(err, "decode field \"severity\"")
 			}
-		case "login":
+		case "code":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Login = string(v)
+				s.Code = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-		case "session_started_at":
+		case "message":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.SessionStartedAt.Reset()
-				if err := s.SessionStartedAt.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"session_started_at\"")
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "details":
+			if err := func() error {
+				s.Details.Reset()
+				if err := s.Details.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"details\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ListChannelChattersRequest")
+		return errors.Wrap(err, "decode ClientNotice")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5355,8 +6158,8 @@ func (s *ListChannelChattersRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfListChannelChattersRequest) {
-					name = jsonFieldsNameOfListChannelChattersRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfClientNotice) {
+					name = jsonFieldsNameOfClientNotice[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5377,321 +6180,184 @@ func (s *ListChannelChattersRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListChannelChattersRequest) MarshalJSON() ([]byte, error) {
+func (s *ClientNotice) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListChannelChattersRequest) UnmarshalJSON(data []byte) error {
+func (s *ClientNotice) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListChatHistoryOKApplicationJSON as json.
-func (s ListChatHistoryOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []ChatHistoryEntry(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes ListChatHistoryOKApplicationJSON from json.
-func (s *ListChatHistoryOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListChatHistoryOKApplicationJSON to nil")
-	}
-	var unwrapped []ChatHistoryEntry
-	if err := func() error {
-		unwrapped = make([]ChatHistoryEntry, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem ChatHistoryEntry
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = ListChatHistoryOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ListChatHistoryOKApplicationJSON) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListChatHistoryOKApplicationJSON) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+// Encode implements json.Marshaler.
+func (s ClientNoticeDetails) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Encode encodes ListRecordedStreamActivityOKApplicationJSON as json.
-func (s ListRecordedStreamActivityOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []UserActivityEvent(s)
+// encodeFields implements json.Marshaler.
+func (s ClientNoticeDetails) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes ListRecordedStreamActivityOKApplicationJSON from json.
-func (s *ListRecordedStreamActivityOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes ClientNoticeDetails from json.
+func (s *ClientNoticeDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListRecordedStreamActivityOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode ClientNoticeDetails to nil")
 	}
-	var unwrapped []UserActivityEvent
-	if err := func() error {
-		unwrapped = make([]UserActivityEvent, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem UserActivityEvent
-			if err := elem.Decode(d); err != nil {
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
 				return err
 			}
-			unwrapped = append(unwrapped, elem)
 			return nil
-		}); err != nil {
-			return err
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode ClientNoticeDetails")
 	}
-	*s = ListRecordedStreamActivityOKApplicationJSON(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListRecordedStreamActivityOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s ClientNoticeDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListRecordedStreamActivityOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *ClientNoticeDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListRecordedStreamMessagesOKApplicationJSON as json.
-func (s ListRecordedStreamMessagesOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []ChatHistoryEntry(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+// Encode encodes ClientNoticeSeverity as json.
+func (s ClientNoticeSeverity) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes ListRecordedStreamMessagesOKApplicationJSON from json.
-func (s *ListRecordedStreamMessagesOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes ClientNoticeSeverity from json.
+func (s *ClientNoticeSeverity) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListRecordedStreamMessagesOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode ClientNoticeSeverity to nil")
 	}
-	var unwrapped []ChatHistoryEntry
-	if err := func() error {
-		unwrapped = make([]ChatHistoryEntry, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem ChatHistoryEntry
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = ListRecordedStreamMessagesOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ListRecordedStreamMessagesOKApplicationJSON) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListRecordedStreamMessagesOKApplicationJSON) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ListTwitchUserActivityOKApplicationJSON as json.
-func (s ListTwitchUserActivityOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []UserActivityEvent(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+	// Try to use constant string.
+	switch ClientNoticeSeverity(v) {
+	case ClientNoticeSeverityError:
+		*s = ClientNoticeSeverityError
+	case ClientNoticeSeverityWarning:
+		*s = ClientNoticeSeverityWarning
+	default:
+		*s = ClientNoticeSeverity(v)
 	}
-	e.ArrEnd()
-}
 
-// Decode decodes ListTwitchUserActivityOKApplicationJSON from json.
-func (s *ListTwitchUserActivityOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListTwitchUserActivityOKApplicationJSON to nil")
-	}
-	var unwrapped []UserActivityEvent
-	if err := func() error {
-		unwrapped = make([]UserActivityEvent, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem UserActivityEvent
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = ListTwitchUserActivityOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListTwitchUserActivityOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s ClientNoticeSeverity) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListTwitchUserActivityOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *ClientNoticeSeverity) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ListTwitchUserActivityRequest) Encode(e *jx.Encoder) {
+func (s *ConfirmAiToolRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ListTwitchUserActivityRequest) encodeFields(e *jx.Encoder) {
+func (s *ConfirmAiToolRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		if s.Limit.Set {
-			e.FieldStart("limit")
-			s.Limit.Encode(e)
-		}
-	}
-	{
-		if s.CursorCreatedAt.Set {
-			e.FieldStart("cursor_created_at")
-			s.CursorCreatedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("tool_call_id")
+		e.Str(s.ToolCallID)
 	}
 	{
-		if s.CursorID.Set {
-			e.FieldStart("cursor_id")
-			s.CursorID.Encode(e)
-		}
+		e.FieldStart("approve")
+		e.Bool(s.Approve)
 	}
 }
 
-var jsonFieldsNameOfListTwitchUserActivityRequest = [4]string{
-	0: "id",
-	1: "limit",
-	2: "cursor_created_at",
-	3: "cursor_id",
+var jsonFieldsNameOfConfirmAiToolRequest = [2]string{
+	0: "tool_call_id",
+	1: "approve",
 }
 
-// Decode decodes ListTwitchUserActivityRequest from json.
-func (s *ListTwitchUserActivityRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ConfirmAiToolRequest from json.
+func (s *ConfirmAiToolRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListTwitchUserActivityRequest to nil")
+		return errors.New("invalid: unable to decode ConfirmAiToolRequest to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "tool_call_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
+				v, err := d.Str()
+				s.ToolCallID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "limit":
-			if err := func() error {
-				s.Limit.Reset()
-				if err := s.Limit.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"limit\"")
-			}
-		case "cursor_created_at":
-			if err := func() error {
-				s.CursorCreatedAt.Reset()
-				if err := s.CursorCreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cursor_created_at\"")
+				return errors.Wrap(err, "decode field \"tool_call_id\"")
 			}
-		case "cursor_id":
+		case "approve":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.CursorID.Reset()
-				if err := s.CursorID.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.Approve = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cursor_id\"")
+				return errors.Wrap(err, "decode field \"approve\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ListTwitchUserActivityRequest")
+		return errors.Wrap(err, "decode ConfirmAiToolRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5703,8 +6369,8 @@ func (s *ListTwitchUserActivityRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfListTwitchUserActivityRequest) {
-					name = jsonFieldsNameOfListTwitchUserActivityRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfConfirmAiToolRequest) {
+					name = jsonFieldsNameOfConfirmAiToolRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5725,86 +6391,69 @@ func (s *ListTwitchUserActivityRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListTwitchUserActivityRequest) MarshalJSON() ([]byte, error) {
+func (s *ConfirmAiToolRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListTwitchUserActivityRequest) UnmarshalJSON(data []byte) error {
+func (s *ConfirmAiToolRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *LoginRequest) Encode(e *jx.Encoder) {
+func (s *CountResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *LoginRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("email")
-		e.Str(s.Email)
-	}
+func (s *CountResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		e.FieldStart("total")
+		e.Int64(s.Total)
 	}
 }
 
-var jsonFieldsNameOfLoginRequest = [2]string{
-	0: "email",
-	1: "password",
+var jsonFieldsNameOfCountResponse = [1]string{
+	0: "total",
 }
 
-// Decode decodes LoginRequest from json.
-func (s *LoginRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CountResponse from json.
+func (s *CountResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LoginRequest to nil")
+		return errors.New("invalid: unable to decode CountResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "email":
+		case "total":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
-			}
-		case "password":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
+				v, err := d.Int64()
+				s.Total = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"total\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode LoginRequest")
+		return errors.Wrap(err, "decode CountResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5816,8 +6465,8 @@ func (s *LoginRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfLoginRequest) {
-					name = jsonFieldsNameOfLoginRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCountResponse) {
+					name = jsonFieldsNameOfCountResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5838,64 +6487,127 @@ func (s *LoginRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginRequest) MarshalJSON() ([]byte, error) {
+func (s *CountResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginRequest) UnmarshalJSON(data []byte) error {
+func (s *CountResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *LoginResponse) Encode(e *jx.Encoder) {
+func (s *CreateAiConversationRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *LoginResponse) encodeFields(e *jx.Encoder) {
+func (s *CreateAiConversationRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("token")
-		e.Str(s.Token)
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfLoginResponse = [1]string{
-	0: "token",
+var jsonFieldsNameOfCreateAiConversationRequest = [1]string{
+	0: "title",
 }
 
-// Decode decodes LoginResponse from json.
-func (s *LoginResponse) Decode(d *jx.Decoder) error {
+// Decode decodes CreateAiConversationRequest from json.
+func (s *CreateAiConversationRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LoginResponse to nil")
+		return errors.New("invalid: unable to decode CreateAiConversationRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "title":
+			if err := func() error {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateAiConversationRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateAiConversationRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateAiConversationRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreateAiMessageRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreateAiMessageRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("content")
+		e.Str(s.Content)
+	}
+}
+
+var jsonFieldsNameOfCreateAiMessageRequest = [1]string{
+	0: "content",
+}
+
+// Decode decodes CreateAiMessageRequest from json.
+func (s *CreateAiMessageRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateAiMessageRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "token":
+		case "content":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Token = string(v)
+				s.Content = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"token\"")
+				return errors.Wrap(err, "decode field \"content\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode LoginResponse")
+		return errors.Wrap(err, "decode CreateAiMessageRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -5912,8 +6624,8 @@ func (s *LoginResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfLoginResponse) {
-					name = jsonFieldsNameOfLoginResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateAiMessageRequest) {
+					name = jsonFieldsNameOfCreateAiMessageRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5934,225 +6646,136 @@ func (s *LoginResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginResponse) MarshalJSON() ([]byte, error) {
+func (s *CreateAiMessageRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginResponse) UnmarshalJSON(data []byte) error {
+func (s *CreateAiMessageRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes float64 as json.
-func (o NilFloat64) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Float64(float64(o.Value))
-}
-
-// Decode decodes float64 from json.
-func (o *NilFloat64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilFloat64 to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
-
-		var v float64
-		o.Value = v
-		o.Null = true
-		return nil
-	}
-	o.Null = false
-	v, err := d.Float64()
-	if err != nil {
-		return err
-	}
-	o.Value = float64(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilFloat64) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilFloat64) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes int64 as json.
-func (o NilInt64) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Int64(int64(o.Value))
-}
-
-// Decode decodes int64 from json.
-func (o *NilInt64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilInt64 to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
-
-		var v int64
-		o.Value = v
-		o.Null = true
-		return nil
-	}
-	o.Null = false
-	v, err := d.Int64()
-	if err != nil {
-		return err
-	}
-	o.Value = int64(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilInt64) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilInt64) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *NotificationEntry) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode implements json.Marshaler.
+func (s *CreateBlocklistRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NotificationEntry) encodeFields(e *jx.Encoder) {
+func (s *CreateBlocklistRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("provider")
-		s.Provider.Encode(e)
+		e.FieldStart("format")
+		s.Format.Encode(e)
 	}
 	{
-		e.FieldStart("settings")
-		s.Settings.Encode(e)
+		if s.SourceURL.Set {
+			e.FieldStart("source_url")
+			s.SourceURL.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("enabled")
-		e.Bool(s.Enabled)
+		if s.SyncIntervalMinutes.Set {
+			e.FieldStart("sync_interval_minutes")
+			s.SyncIntervalMinutes.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		if s.Enabled.Set {
+			e.FieldStart("enabled")
+			s.Enabled.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfNotificationEntry = [5]string{
-	0: "id",
-	1: "provider",
-	2: "settings",
-	3: "enabled",
-	4: "created_at",
+var jsonFieldsNameOfCreateBlocklistRequest = [5]string{
+	0: "name",
+	1: "format",
+	2: "source_url",
+	3: "sync_interval_minutes",
+	4: "enabled",
 }
 
-// Decode decodes NotificationEntry from json.
-func (s *NotificationEntry) Decode(d *jx.Decoder) error {
+// Decode decodes CreateBlocklistRequest from json.
+func (s *CreateBlocklistRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NotificationEntry to nil")
+		return errors.New("invalid: unable to decode CreateBlocklistRequest to nil")
 	}
 	var requiredBitSet [1]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "provider":
+		case "format":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Provider.Decode(d); err != nil {
+				if err := s.Format.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provider\"")
+				return errors.Wrap(err, "decode field \"format\"")
 			}
-		case "settings":
-			requiredBitSet[0] |= 1 << 2
+		case "source_url":
 			if err := func() error {
-				if err := s.Settings.Decode(d); err != nil {
+				s.SourceURL.Reset()
+				if err := s.SourceURL.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
+				return errors.Wrap(err, "decode field \"source_url\"")
 			}
-		case "enabled":
-			requiredBitSet[0] |= 1 << 3
+		case "sync_interval_minutes":
 			if err := func() error {
-				v, err := d.Bool()
-				s.Enabled = bool(v)
-				if err != nil {
+				s.SyncIntervalMinutes.Reset()
+				if err := s.SyncIntervalMinutes.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"enabled\"")
+				return errors.Wrap(err, "decode field \"sync_interval_minutes\"")
 			}
-		case "created_at":
-			requiredBitSet[0] |= 1 << 4
+		case "enabled":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
+				s.Enabled.Reset()
+				if err := s.Enabled.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"enabled\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NotificationEntry")
+		return errors.Wrap(err, "decode CreateBlocklistRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6164,8 +6787,8 @@ func (s *NotificationEntry) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfNotificationEntry) {
-					name = jsonFieldsNameOfNotificationEntry[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateBlocklistRequest) {
+					name = jsonFieldsNameOfCreateBlocklistRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6186,1028 +6809,1382 @@ func (s *NotificationEntry) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NotificationEntry) MarshalJSON() ([]byte, error) {
+func (s *CreateBlocklistRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotificationEntry) UnmarshalJSON(data []byte) error {
+func (s *CreateBlocklistRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NotificationEntryProvider as json.
-func (s NotificationEntryProvider) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *CreateLoginPatternRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes NotificationEntryProvider from json.
-func (s *NotificationEntryProvider) Decode(d *jx.Decoder) error {
+// encodeFields encodes fields.
+func (s *CreateLoginPatternRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("kind")
+		s.Kind.Encode(e)
+	}
+	{
+		e.FieldStart("pattern")
+		e.Str(s.Pattern)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCreateLoginPatternRequest = [3]string{
+	0: "kind",
+	1: "pattern",
+	2: "description",
+}
+
+// Decode decodes CreateLoginPatternRequest from json.
+func (s *CreateLoginPatternRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NotificationEntryProvider to nil")
+		return errors.New("invalid: unable to decode CreateLoginPatternRequest to nil")
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "kind":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "pattern":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Pattern = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pattern\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateLoginPatternRequest")
 	}
-	// Try to use constant string.
-	switch NotificationEntryProvider(v) {
-	case NotificationEntryProviderTelegram:
-		*s = NotificationEntryProviderTelegram
-	case NotificationEntryProviderWebhook:
-		*s = NotificationEntryProviderWebhook
-	default:
-		*s = NotificationEntryProvider(v)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateLoginPatternRequest) {
+					name = jsonFieldsNameOfCreateLoginPatternRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s NotificationEntryProvider) MarshalJSON() ([]byte, error) {
+func (s *CreateLoginPatternRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotificationEntryProvider) UnmarshalJSON(data []byte) error {
+func (s *CreateLoginPatternRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s NotificationEntrySettings) Encode(e *jx.Encoder) {
+func (s *CreateNotificationRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s NotificationEntrySettings) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
+// encodeFields encodes fields.
+func (s *CreateNotificationRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		e.FieldStart("settings")
+		s.Settings.Encode(e)
+	}
+	{
+		if s.Enabled.Set {
+			e.FieldStart("enabled")
+			s.Enabled.Encode(e)
+		}
+	}
+	{
+		if s.Tags != nil {
+			e.FieldStart("tags")
+			e.ArrStart()
+			for _, elem := range s.Tags {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.EventTypes != nil {
+			e.FieldStart("event_types")
+			e.ArrStart()
+			for _, elem := range s.EventTypes {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Channels != nil {
+			e.FieldStart("channels")
+			e.ArrStart()
+			for _, elem := range s.Channels {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Policy.Set {
+			e.FieldStart("policy")
+			s.Policy.Encode(e)
 		}
 	}
 }
 
-// Decode decodes NotificationEntrySettings from json.
-func (s *NotificationEntrySettings) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfCreateNotificationRequest = [7]string{
+	0: "provider",
+	1: "settings",
+	2: "enabled",
+	3: "tags",
+	4: "event_types",
+	5: "channels",
+	6: "policy",
+}
+
+// Decode decodes CreateNotificationRequest from json.
+func (s *CreateNotificationRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NotificationEntrySettings to nil")
+		return errors.New("invalid: unable to decode CreateNotificationRequest to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "provider":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "settings":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Settings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"settings\"")
+			}
+		case "enabled":
+			if err := func() error {
+				s.Enabled.Reset()
+				if err := s.Enabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "tags":
+			if err := func() error {
+				s.Tags = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Tags = append(s.Tags, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "event_types":
+			if err := func() error {
+				s.EventTypes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.EventTypes = append(s.EventTypes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_types\"")
+			}
+		case "channels":
+			if err := func() error {
+				s.Channels = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Channels = append(s.Channels, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channels\"")
+			}
+		case "policy":
+			if err := func() error {
+				s.Policy.Reset()
+				if err := s.Policy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"policy\"")
+			}
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NotificationEntrySettings")
+		return errors.Wrap(err, "decode CreateNotificationRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateNotificationRequest) {
+					name = jsonFieldsNameOfCreateNotificationRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s NotificationEntrySettings) MarshalJSON() ([]byte, error) {
+func (s *CreateNotificationRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotificationEntrySettings) UnmarshalJSON(data []byte) error {
+func (s *CreateNotificationRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Bool(bool(o.Value))
+// Encode encodes CreateNotificationRequestProvider as json.
+func (s CreateNotificationRequestProvider) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
+// Decode decodes CreateNotificationRequestProvider from json.
+func (s *CreateNotificationRequestProvider) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateNotificationRequestProvider to nil")
 	}
-	o.Set = true
-	v, err := d.Bool()
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = bool(v)
+	// Try to use constant string.
+	switch CreateNotificationRequestProvider(v) {
+	case CreateNotificationRequestProviderTelegram:
+		*s = CreateNotificationRequestProviderTelegram
+	case CreateNotificationRequestProviderWebhook:
+		*s = CreateNotificationRequestProviderWebhook
+	case CreateNotificationRequestProviderDiscord:
+		*s = CreateNotificationRequestProviderDiscord
+	case CreateNotificationRequestProviderMatrix:
+		*s = CreateNotificationRequestProviderMatrix
+	case CreateNotificationRequestProviderNtfy:
+		*s = CreateNotificationRequestProviderNtfy
+	case CreateNotificationRequestProviderEmail:
+		*s = CreateNotificationRequestProviderEmail
+	default:
+		*s = CreateNotificationRequestProvider(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
+func (s CreateNotificationRequestProvider) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
+func (s *CreateNotificationRequestProvider) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ClientNoticeDetails as json.
-func (o OptClientNoticeDetails) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s CreateNotificationRequestSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes ClientNoticeDetails from json.
-func (o *OptClientNoticeDetails) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptClientNoticeDetails to nil")
-	}
-	o.Set = true
-	o.Value = make(ClientNoticeDetails)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+// encodeFields implements json.Marshaler.
+func (s CreateNotificationRequestSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptClientNoticeDetails) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptClientNoticeDetails) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CreateAiConversationRequest as json.
-func (o OptCreateAiConversationRequest) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes CreateAiConversationRequest from json.
-func (o *OptCreateAiConversationRequest) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCreateAiConversationRequest to nil")
+// Decode decodes CreateNotificationRequestSettings from json.
+func (s *CreateNotificationRequestSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateNotificationRequestSettings to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateNotificationRequestSettings")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptCreateAiConversationRequest) MarshalJSON() ([]byte, error) {
+func (s CreateNotificationRequestSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCreateAiConversationRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateNotificationRequestSettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateTwitchAccountRequestAccountType as json.
-func (o OptCreateTwitchAccountRequestAccountType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *CreateNotificationSnoozeRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CreateTwitchAccountRequestAccountType from json.
-func (o *OptCreateTwitchAccountRequestAccountType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCreateTwitchAccountRequestAccountType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *CreateNotificationSnoozeRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.NotificationID.Set {
+			e.FieldStart("notification_id")
+			s.NotificationID.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCreateTwitchAccountRequestAccountType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCreateTwitchAccountRequestAccountType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes time.Time as json.
-func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
+	{
+		if s.Channel.Set {
+			e.FieldStart("channel")
+			s.Channel.Encode(e)
+		}
 	}
-	format(e, o.Value)
-}
-
-// Decode decodes time.Time from json.
-func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDateTime to nil")
+	{
+		if s.Until.Set {
+			e.FieldStart("until")
+			s.Until.Encode(e, json.EncodeDateTime)
+		}
 	}
-	o.Set = true
-	v, err := format(d)
-	if err != nil {
-		return err
+	{
+		if s.Minutes.Set {
+			e.FieldStart("minutes")
+			s.Minutes.Encode(e)
+		}
 	}
-	o.Value = v
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptDateTime) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDateTime) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+var jsonFieldsNameOfCreateNotificationSnoozeRequest = [4]string{
+	0: "notification_id",
+	1: "channel",
+	2: "until",
+	3: "minutes",
 }
 
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes CreateNotificationSnoozeRequest from json.
+func (s *CreateNotificationSnoozeRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateNotificationSnoozeRequest to nil")
 	}
-	e.Int(int(o.Value))
-}
 
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
-	}
-	o.Set = true
-	v, err := d.Int()
-	if err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "notification_id":
+			if err := func() error {
+				s.NotificationID.Reset()
+				if err := s.NotificationID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notification_id\"")
+			}
+		case "channel":
+			if err := func() error {
+				s.Channel.Reset()
+				if err := s.Channel.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel\"")
+			}
+		case "until":
+			if err := func() error {
+				s.Until.Reset()
+				if err := s.Until.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"until\"")
+			}
+		case "minutes":
+			if err := func() error {
+				s.Minutes.Reset()
+				if err := s.Minutes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"minutes\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateNotificationSnoozeRequest")
 	}
-	o.Value = int(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
+func (s *CreateNotificationSnoozeRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
+func (s *CreateNotificationSnoozeRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int64 as json.
-func (o OptInt64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Int64(int64(o.Value))
+// Encode implements json.Marshaler.
+func (s *CreateRuleRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes int64 from json.
-func (o *OptInt64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt64 to nil")
+// encodeFields encodes fields.
+func (s *CreateRuleRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
-	o.Set = true
-	v, err := d.Int64()
-	if err != nil {
-		return err
+	{
+		if s.Enabled.Set {
+			e.FieldStart("enabled")
+			s.Enabled.Encode(e)
+		}
 	}
-	o.Value = int64(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptInt64) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt64) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ChannelLive as json.
-func (o OptNilChannelLive) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		e.FieldStart("event_type")
+		s.EventType.Encode(e)
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		e.FieldStart("event_settings")
+		s.EventSettings.Encode(e)
 	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes ChannelLive from json.
-func (o *OptNilChannelLive) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilChannelLive to nil")
+	{
+		e.FieldStart("middlewares")
+		e.ArrStart()
+		for _, elem := range s.Middlewares {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		e.FieldStart("action_type")
+		s.ActionType.Encode(e)
+	}
+	{
+		e.FieldStart("action_settings")
+		s.ActionSettings.Encode(e)
+	}
+	{
+		if s.UseSharedPool.Set {
+			e.FieldStart("use_shared_pool")
+			s.UseSharedPool.Encode(e)
 		}
+	}
+}
 
-		var v ChannelLive
-		o.Value = v
-		o.Set = true
-		o.Null = true
+var jsonFieldsNameOfCreateRuleRequest = [8]string{
+	0: "name",
+	1: "enabled",
+	2: "event_type",
+	3: "event_settings",
+	4: "middlewares",
+	5: "action_type",
+	6: "action_settings",
+	7: "use_shared_pool",
+}
+
+// Decode decodes CreateRuleRequest from json.
+func (s *CreateRuleRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateRuleRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "enabled":
+			if err := func() error {
+				s.Enabled.Reset()
+				if err := s.Enabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "event_type":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.EventType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_type\"")
+			}
+		case "event_settings":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.EventSettings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_settings\"")
+			}
+		case "middlewares":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Middlewares = make([]RuleMiddleware, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RuleMiddleware
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Middlewares = append(s.Middlewares, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"middlewares\"")
+			}
+		case "action_type":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.ActionType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action_type\"")
+			}
+		case "action_settings":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.ActionSettings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action_settings\"")
+			}
+		case "use_shared_pool":
+			if err := func() error {
+				s.UseSharedPool.Reset()
+				if err := s.UseSharedPool.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"use_shared_pool\"")
+			}
+		default:
+			return d.Skip()
+		}
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateRuleRequest")
 	}
-	o.Set = true
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateRuleRequest) {
+					name = jsonFieldsNameOfCreateRuleRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilChannelLive) MarshalJSON() ([]byte, error) {
+func (s *CreateRuleRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilChannelLive) UnmarshalJSON(data []byte) error {
+func (s *CreateRuleRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes time.Time as json.
-func (o OptNilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
+// Encode implements json.Marshaler.
+func (s CreateRuleRequestActionSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s CreateRuleRequestActionSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	format(e, o.Value)
 }
 
-// Decode decodes time.Time from json.
-func (o *OptNilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilDateTime to nil")
+// Decode decodes CreateRuleRequestActionSettings from json.
+func (s *CreateRuleRequestActionSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateRuleRequestActionSettings to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
-
-		var v time.Time
-		o.Value = v
-		o.Set = true
-		o.Null = true
+		m[string(k)] = elem
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateRuleRequestActionSettings")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := format(d)
-	if err != nil {
-		return err
-	}
-	o.Value = v
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilDateTime) MarshalJSON() ([]byte, error) {
+func (s CreateRuleRequestActionSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilDateTime) UnmarshalJSON(data []byte) error {
+func (s *CreateRuleRequestActionSettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+	return s.Decode(d)
 }
 
-// Encode encodes int64 as json.
-func (o OptNilInt64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Int64(int64(o.Value))
+// Encode implements json.Marshaler.
+func (s CreateRuleRequestEventSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes int64 from json.
-func (o *OptNilInt64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilInt64 to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+// encodeFields implements json.Marshaler.
+func (s CreateRuleRequestEventSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
+	}
+}
 
-		var v int64
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
+// Decode decodes CreateRuleRequestEventSettings from json.
+func (s *CreateRuleRequestEventSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateRuleRequestEventSettings to nil")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Int64()
-	if err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateRuleRequestEventSettings")
 	}
-	o.Value = int64(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilInt64) MarshalJSON() ([]byte, error) {
+func (s CreateRuleRequestEventSettings) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilInt64) UnmarshalJSON(data []byte) error {
+func (s *CreateRuleRequestEventSettings) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptNilString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *CreateTwitchAccountRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes string from json.
-func (o *OptNilString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilString to nil")
+// encodeFields encodes fields.
+func (s *CreateTwitchAccountRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
-
-		var v string
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Str()
-	if err != nil {
-		return err
+	{
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
+	}
+	{
+		if s.AccountType.Set {
+			e.FieldStart("account_type")
+			s.AccountType.Encode(e)
+		}
 	}
-	o.Value = string(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilString) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilString) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfCreateTwitchAccountRequest = [4]string{
+	0: "id",
+	1: "username",
+	2: "refresh_token",
+	3: "account_type",
 }
 
-// Encode encodes UserActivityEventDetails as json.
-func (o OptNilUserActivityEventDetails) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
+// Decode decodes CreateTwitchAccountRequest from json.
+func (s *CreateTwitchAccountRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateTwitchAccountRequest to nil")
 	}
-	o.Value.Encode(e)
-}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
 
-// Decode decodes UserActivityEventDetails from json.
-func (o *OptNilUserActivityEventDetails) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilUserActivityEventDetails to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "username":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "refresh_token":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refresh_token\"")
+			}
+		case "account_type":
+			if err := func() error {
+				s.AccountType.Reset()
+				if err := s.AccountType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"account_type\"")
+			}
+		default:
+			return d.Skip()
 		}
-
-		var v UserActivityEventDetails
-		o.Value = v
-		o.Set = true
-		o.Null = true
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateTwitchAccountRequest")
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make(UserActivityEventDetails)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateTwitchAccountRequest) {
+					name = jsonFieldsNameOfCreateTwitchAccountRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilUserActivityEventDetails) MarshalJSON() ([]byte, error) {
+func (s *CreateTwitchAccountRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilUserActivityEventDetails) UnmarshalJSON(data []byte) error {
+func (s *CreateTwitchAccountRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes StartTwitchOAuthRequest as json.
-func (o OptStartTwitchOAuthRequest) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes StartTwitchOAuthRequest from json.
-func (o *OptStartTwitchOAuthRequest) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptStartTwitchOAuthRequest to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptStartTwitchOAuthRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptStartTwitchOAuthRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode encodes CreateTwitchAccountRequestAccountType as json.
+func (s CreateTwitchAccountRequestAccountType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
+// Decode decodes CreateTwitchAccountRequestAccountType from json.
+func (s *CreateTwitchAccountRequestAccountType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateTwitchAccountRequestAccountType to nil")
 	}
-	o.Set = true
-	v, err := d.Str()
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = string(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes UpdateNotificationPostRequestProvider as json.
-func (o OptUpdateNotificationPostRequestProvider) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes UpdateNotificationPostRequestProvider from json.
-func (o *OptUpdateNotificationPostRequestProvider) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUpdateNotificationPostRequestProvider to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptUpdateNotificationPostRequestProvider) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUpdateNotificationPostRequestProvider) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes UpdateNotificationPostRequestSettings as json.
-func (o OptUpdateNotificationPostRequestSettings) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	// Try to use constant string.
+	switch CreateTwitchAccountRequestAccountType(v) {
+	case CreateTwitchAccountRequestAccountTypeMain:
+		*s = CreateTwitchAccountRequestAccountTypeMain
+	case CreateTwitchAccountRequestAccountTypeBot:
+		*s = CreateTwitchAccountRequestAccountTypeBot
+	default:
+		*s = CreateTwitchAccountRequestAccountType(v)
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes UpdateNotificationPostRequestSettings from json.
-func (o *OptUpdateNotificationPostRequestSettings) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUpdateNotificationPostRequestSettings to nil")
-	}
-	o.Set = true
-	o.Value = make(UpdateNotificationPostRequestSettings)
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUpdateNotificationPostRequestSettings) MarshalJSON() ([]byte, error) {
+func (s CreateTwitchAccountRequestAccountType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUpdateNotificationPostRequestSettings) UnmarshalJSON(data []byte) error {
+func (s *CreateTwitchAccountRequestAccountType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PatchAiSettingsRequest) Encode(e *jx.Encoder) {
+func (s *CreateTwitchUserRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PatchAiSettingsRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.BaseURL.Set {
-			e.FieldStart("base_url")
-			s.BaseURL.Encode(e)
-		}
-	}
-	{
-		if s.Model.Set {
-			e.FieldStart("model")
-			s.Model.Encode(e)
-		}
-	}
+func (s *CreateTwitchUserRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.APIToken.Set {
-			e.FieldStart("api_token")
-			s.APIToken.Encode(e)
-		}
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 }
 
-var jsonFieldsNameOfPatchAiSettingsRequest = [3]string{
-	0: "base_url",
-	1: "model",
-	2: "api_token",
+var jsonFieldsNameOfCreateTwitchUserRequest = [1]string{
+	0: "name",
 }
 
-// Decode decodes PatchAiSettingsRequest from json.
-func (s *PatchAiSettingsRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateTwitchUserRequest from json.
+func (s *CreateTwitchUserRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PatchAiSettingsRequest to nil")
+		return errors.New("invalid: unable to decode CreateTwitchUserRequest to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "base_url":
-			if err := func() error {
-				s.BaseURL.Reset()
-				if err := s.BaseURL.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"base_url\"")
-			}
-		case "model":
-			if err := func() error {
-				s.Model.Reset()
-				if err := s.Model.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"model\"")
-			}
-		case "api_token":
+		case "name":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.APIToken.Reset()
-				if err := s.APIToken.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"api_token\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PatchAiSettingsRequest")
+		return errors.Wrap(err, "decode CreateTwitchUserRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateTwitchUserRequest) {
+					name = jsonFieldsNameOfCreateTwitchUserRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PatchAiSettingsRequest) MarshalJSON() ([]byte, error) {
+func (s *CreateTwitchUserRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PatchAiSettingsRequest) UnmarshalJSON(data []byte) error {
+func (s *CreateTwitchUserRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RecordedStream) Encode(e *jx.Encoder) {
+func (s *DeleteByIDRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RecordedStream) encodeFields(e *jx.Encoder) {
+func (s *DeleteByIDRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.Int64(s.ID)
 	}
-	{
-		e.FieldStart("channel_id")
-		e.Int64(s.ChannelID)
-	}
-	{
-		e.FieldStart("channel_login")
-		e.Str(s.ChannelLogin)
-	}
-	{
-		e.FieldStart("helix_stream_id")
-		e.Str(s.HelixStreamID)
+}
+
+var jsonFieldsNameOfDeleteByIDRequest = [1]string{
+	0: "id",
+}
+
+// Decode decodes DeleteByIDRequest from json.
+func (s *DeleteByIDRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteByIDRequest to nil")
 	}
-	{
-		e.FieldStart("started_at")
-		json.EncodeDateTime(e, s.StartedAt)
-	}
-	{
-		if s.EndedAt.Set {
-			e.FieldStart("ended_at")
-			s.EndedAt.Encode(e, json.EncodeDateTime)
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DeleteByIDRequest")
 	}
-	{
-		if s.Title.Set {
-			e.FieldStart("title")
-			s.Title.Encode(e)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDeleteByIDRequest) {
+					name = jsonFieldsNameOfDeleteByIDRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
 	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteByIDRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteByIDRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DeleteTwitchUserLinkRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DeleteTwitchUserLinkRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.GameName.Set {
-			e.FieldStart("game_name")
-			s.GameName.Encode(e)
-		}
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("linked_user_id")
+		e.Int64(s.LinkedUserID)
 	}
 }
 
-var jsonFieldsNameOfRecordedStream = [9]string{
-	0: "id",
-	1: "channel_id",
-	2: "channel_login",
-	3: "helix_stream_id",
-	4: "started_at",
-	5: "ended_at",
-	6: "title",
-	7: "game_name",
-	8: "created_at",
+var jsonFieldsNameOfDeleteTwitchUserLinkRequest = [2]string{
+	0: "twitch_user_id",
+	1: "linked_user_id",
 }
 
-// Decode decodes RecordedStream from json.
-func (s *RecordedStream) Decode(d *jx.Decoder) error {
+// Decode decodes DeleteTwitchUserLinkRequest from json.
+func (s *DeleteTwitchUserLinkRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RecordedStream to nil")
+		return errors.New("invalid: unable to decode DeleteTwitchUserLinkRequest to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "twitch_user_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.ID = int64(v)
+				s.TwitchUserID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
 			}
-		case "channel_id":
+		case "linked_user_id":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Int64()
-				s.ChannelID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_id\"")
-			}
-		case "channel_login":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.ChannelLogin = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_login\"")
-			}
-		case "helix_stream_id":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.HelixStreamID = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"helix_stream_id\"")
-			}
-		case "started_at":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartedAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"started_at\"")
-			}
-		case "ended_at":
-			if err := func() error {
-				s.EndedAt.Reset()
-				if err := s.EndedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ended_at\"")
-			}
-		case "title":
-			if err := func() error {
-				s.Title.Reset()
-				if err := s.Title.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
-			}
-		case "game_name":
-			if err := func() error {
-				s.GameName.Reset()
-				if err := s.GameName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"game_name\"")
-			}
-		case "created_at":
-			requiredBitSet[1] |= 1 << 0
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				s.LinkedUserID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"linked_user_id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RecordedStream")
+		return errors.Wrap(err, "decode DeleteTwitchUserLinkRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00011111,
-		0b00000001,
+	for i, mask := range [1]uint8{
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7219,8 +8196,8 @@ func (s *RecordedStream) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRecordedStream) {
-					name = jsonFieldsNameOfRecordedStream[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfDeleteTwitchUserLinkRequest) {
+					name = jsonFieldsNameOfDeleteTwitchUserLinkRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7241,208 +8218,344 @@ func (s *RecordedStream) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RecordedStream) MarshalJSON() ([]byte, error) {
+func (s *DeleteTwitchUserLinkRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RecordedStream) UnmarshalJSON(data []byte) error {
+func (s *DeleteTwitchUserLinkRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Rule) Encode(e *jx.Encoder) {
+func (s *DiscoveryCandidate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Rule) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
+func (s *DiscoveryCandidate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("enabled")
-		e.Bool(s.Enabled)
+		e.FieldStart("user")
+		s.User.Encode(e)
 	}
 	{
-		e.FieldStart("event_type")
-		s.EventType.Encode(e)
+		e.FieldStart("discovered_at")
+		json.EncodeDateTime(e, s.DiscoveredAt)
 	}
 	{
-		e.FieldStart("event_settings")
-		s.EventSettings.Encode(e)
+		e.FieldStart("last_seen_at")
+		json.EncodeDateTime(e, s.LastSeenAt)
 	}
 	{
-		e.FieldStart("middlewares")
-		e.ArrStart()
-		for _, elem := range s.Middlewares {
-			elem.Encode(e)
+		if s.ViewerCount.Set {
+			e.FieldStart("viewer_count")
+			s.ViewerCount.Encode(e)
 		}
-		e.ArrEnd()
 	}
 	{
-		e.FieldStart("action_type")
-		s.ActionType.Encode(e)
-	}
-	{
-		e.FieldStart("action_settings")
-		s.ActionSettings.Encode(e)
-	}
-	{
-		e.FieldStart("use_shared_pool")
-		e.Bool(s.UseSharedPool)
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		if s.GameName.Set {
+			e.FieldStart("game_name")
+			s.GameName.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("updated_at")
-		json.EncodeDateTime(e, s.UpdatedAt)
+		e.FieldStart("stream_tags")
+		e.ArrStart()
+		for _, elem := range s.StreamTags {
+			e.Str(elem)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfRule = [11]string{
-	0:  "id",
-	1:  "name",
-	2:  "enabled",
-	3:  "event_type",
-	4:  "event_settings",
-	5:  "middlewares",
-	6:  "action_type",
-	7:  "action_settings",
-	8:  "use_shared_pool",
-	9:  "created_at",
-	10: "updated_at",
+var jsonFieldsNameOfDiscoveryCandidate = [7]string{
+	0: "user",
+	1: "discovered_at",
+	2: "last_seen_at",
+	3: "viewer_count",
+	4: "title",
+	5: "game_name",
+	6: "stream_tags",
 }
 
-// Decode decodes Rule from json.
-func (s *Rule) Decode(d *jx.Decoder) error {
+// Decode decodes DiscoveryCandidate from json.
+func (s *DiscoveryCandidate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Rule to nil")
+		return errors.New("invalid: unable to decode DiscoveryCandidate to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "user":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.ID = int64(v)
-				if err != nil {
+				if err := s.User.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"user\"")
 			}
-		case "name":
+		case "discovered_at":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.DiscoveredAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"discovered_at\"")
 			}
-		case "enabled":
+		case "last_seen_at":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Bool()
-				s.Enabled = bool(v)
+				v, err := json.DecodeDateTime(d)
+				s.LastSeenAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"enabled\"")
+				return errors.Wrap(err, "decode field \"last_seen_at\"")
 			}
-		case "event_type":
-			requiredBitSet[0] |= 1 << 3
+		case "viewer_count":
 			if err := func() error {
-				if err := s.EventType.Decode(d); err != nil {
+				s.ViewerCount.Reset()
+				if err := s.ViewerCount.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"event_type\"")
+				return errors.Wrap(err, "decode field \"viewer_count\"")
 			}
-		case "event_settings":
-			requiredBitSet[0] |= 1 << 4
+		case "title":
 			if err := func() error {
-				if err := s.EventSettings.Decode(d); err != nil {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"event_settings\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		case "middlewares":
-			requiredBitSet[0] |= 1 << 5
+		case "game_name":
 			if err := func() error {
-				s.Middlewares = make([]RuleMiddleware, 0)
+				s.GameName.Reset()
+				if err := s.GameName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"game_name\"")
+			}
+		case "stream_tags":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				s.StreamTags = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RuleMiddleware
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.Middlewares = append(s.Middlewares, elem)
+					s.StreamTags = append(s.StreamTags, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"middlewares\"")
+				return errors.Wrap(err, "decode field \"stream_tags\"")
 			}
-		case "action_type":
-			requiredBitSet[0] |= 1 << 6
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DiscoveryCandidate")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDiscoveryCandidate) {
+					name = jsonFieldsNameOfDiscoveryCandidate[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DiscoveryCandidate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DiscoveryCandidate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EmoteSet) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EmoteSet) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		e.FieldStart("channel")
+		e.Str(s.Channel)
+	}
+	{
+		e.FieldStart("emote_count")
+		e.Int(s.EmoteCount)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfEmoteSet = [7]string{
+	0: "id",
+	1: "name",
+	2: "provider",
+	3: "channel",
+	4: "emote_count",
+	5: "created_at",
+	6: "updated_at",
+}
+
+// Decode decodes EmoteSet from json.
+func (s *EmoteSet) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EmoteSet to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.ActionType.Decode(d); err != nil {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"action_type\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "action_settings":
-			requiredBitSet[0] |= 1 << 7
+		case "name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.ActionSettings.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"action_settings\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "use_shared_pool":
-			requiredBitSet[1] |= 1 << 0
+		case "provider":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Bool()
-				s.UseSharedPool = bool(v)
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "channel":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Channel = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"use_shared_pool\"")
+				return errors.Wrap(err, "decode field \"channel\"")
+			}
+		case "emote_count":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.EmoteCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"emote_count\"")
 			}
 		case "created_at":
-			requiredBitSet[1] |= 1 << 1
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				v, err := json.DecodeDateTime(d)
 				s.CreatedAt = v
@@ -7454,7 +8567,7 @@ func (s *Rule) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"created_at\"")
 			}
 		case "updated_at":
-			requiredBitSet[1] |= 1 << 2
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
 				v, err := json.DecodeDateTime(d)
 				s.UpdatedAt = v
@@ -7470,13 +8583,12 @@ func (s *Rule) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Rule")
+		return errors.Wrap(err, "decode EmoteSet")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b11111111,
-		0b00000111,
+	for i, mask := range [1]uint8{
+		0b01111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7488,8 +8600,8 @@ func (s *Rule) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRule) {
-					name = jsonFieldsNameOfRule[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfEmoteSet) {
+					name = jsonFieldsNameOfEmoteSet[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7510,279 +8622,250 @@ func (s *Rule) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Rule) MarshalJSON() ([]byte, error) {
+func (s *EmoteSet) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Rule) UnmarshalJSON(data []byte) error {
+func (s *EmoteSet) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s RuleActionSettings) Encode(e *jx.Encoder) {
+func (s *EmoteSetEmote) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s RuleActionSettings) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
-	}
-}
-
-// Decode decodes RuleActionSettings from json.
-func (s *RuleActionSettings) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RuleActionSettings to nil")
+// encodeFields encodes fields.
+func (s *EmoteSetEmote) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("code")
+		e.Str(s.Code)
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RuleActionSettings")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s RuleActionSettings) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleActionSettings) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes RuleActionType as json.
-func (s RuleActionType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+var jsonFieldsNameOfEmoteSetEmote = [2]string{
+	0: "code",
+	1: "id",
 }
 
-// Decode decodes RuleActionType from json.
-func (s *RuleActionType) Decode(d *jx.Decoder) error {
+// Decode decodes EmoteSetEmote from json.
+func (s *EmoteSetEmote) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RuleActionType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch RuleActionType(v) {
-	case RuleActionTypeNotify:
-		*s = RuleActionTypeNotify
-	case RuleActionTypeSendChat:
-		*s = RuleActionTypeSendChat
-	default:
-		*s = RuleActionType(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s RuleActionType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleActionType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s RuleEventSettings) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s RuleEventSettings) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+		return errors.New("invalid: unable to decode EmoteSetEmote to nil")
 	}
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes RuleEventSettings from json.
-func (s *RuleEventSettings) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RuleEventSettings to nil")
-	}
-	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Code = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RuleEventSettings")
+		return errors.Wrap(err, "decode EmoteSetEmote")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEmoteSetEmote) {
+					name = jsonFieldsNameOfEmoteSetEmote[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s RuleEventSettings) MarshalJSON() ([]byte, error) {
+func (s *EmoteSetEmote) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleEventSettings) UnmarshalJSON(data []byte) error {
+func (s *EmoteSetEmote) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes RuleEventType as json.
-func (s RuleEventType) Encode(e *jx.Encoder) {
+// Encode encodes EmoteSetProvider as json.
+func (s EmoteSetProvider) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes RuleEventType from json.
-func (s *RuleEventType) Decode(d *jx.Decoder) error {
+// Decode decodes EmoteSetProvider from json.
+func (s *EmoteSetProvider) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RuleEventType to nil")
+		return errors.New("invalid: unable to decode EmoteSetProvider to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch RuleEventType(v) {
-	case RuleEventTypeChatMessage:
-		*s = RuleEventTypeChatMessage
-	case RuleEventTypeStreamStart:
-		*s = RuleEventTypeStreamStart
-	case RuleEventTypeStreamEnd:
-		*s = RuleEventTypeStreamEnd
-	case RuleEventTypeInterval:
-		*s = RuleEventTypeInterval
+	switch EmoteSetProvider(v) {
+	case EmoteSetProviderBttv:
+		*s = EmoteSetProviderBttv
+	case EmoteSetProviderFfz:
+		*s = EmoteSetProviderFfz
+	case EmoteSetProvider7tv:
+		*s = EmoteSetProvider7tv
+	case EmoteSetProviderCustom:
+		*s = EmoteSetProviderCustom
 	default:
-		*s = RuleEventType(v)
+		*s = EmoteSetProvider(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s RuleEventType) MarshalJSON() ([]byte, error) {
+func (s EmoteSetProvider) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleEventType) UnmarshalJSON(data []byte) error {
+func (s *EmoteSetProvider) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RuleMiddleware) Encode(e *jx.Encoder) {
+func (s *EmoteTimelineBucket) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RuleMiddleware) encodeFields(e *jx.Encoder) {
+func (s *EmoteTimelineBucket) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("type")
-		e.Str(s.Type)
+		e.FieldStart("start")
+		json.EncodeDateTime(e, s.Start)
 	}
 	{
-		e.FieldStart("settings")
-		s.Settings.Encode(e)
+		e.FieldStart("uses")
+		e.ArrStart()
+		for _, elem := range s.Uses {
+			e.Int64(elem)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfRuleMiddleware = [2]string{
-	0: "type",
-	1: "settings",
+var jsonFieldsNameOfEmoteTimelineBucket = [2]string{
+	0: "start",
+	1: "uses",
 }
 
-// Decode decodes RuleMiddleware from json.
-func (s *RuleMiddleware) Decode(d *jx.Decoder) error {
+// Decode decodes EmoteTimelineBucket from json.
+func (s *EmoteTimelineBucket) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RuleMiddleware to nil")
+		return errors.New("invalid: unable to decode EmoteTimelineBucket to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "type":
+		case "start":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Type = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.Start = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"start\"")
 			}
-		case "settings":
+		case "uses":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Settings.Decode(d); err != nil {
+				s.Uses = make([]int64, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int64
+					v, err := d.Int64()
+					elem = int64(v)
+					if err != nil {
+						return err
+					}
+					s.Uses = append(s.Uses, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"settings\"")
+				return errors.Wrap(err, "decode field \"uses\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RuleMiddleware")
+		return errors.Wrap(err, "decode EmoteTimelineBucket")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -7799,8 +8882,8 @@ func (s *RuleMiddleware) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRuleMiddleware) {
-					name = jsonFieldsNameOfRuleMiddleware[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfEmoteTimelineBucket) {
+					name = jsonFieldsNameOfEmoteTimelineBucket[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7821,144 +8904,154 @@ func (s *RuleMiddleware) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RuleMiddleware) MarshalJSON() ([]byte, error) {
+func (s *EmoteTimelineBucket) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleMiddleware) UnmarshalJSON(data []byte) error {
+func (s *EmoteTimelineBucket) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s RuleMiddlewareSettings) Encode(e *jx.Encoder) {
+func (s *EmoteUsage) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s RuleMiddlewareSettings) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+// encodeFields encodes fields.
+func (s *EmoteUsage) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("provider")
+		e.Str(s.Provider)
 	}
-}
-
-// Decode decodes RuleMiddlewareSettings from json.
-func (s *RuleMiddlewareSettings) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RuleMiddlewareSettings to nil")
+	{
+		e.FieldStart("emote_id")
+		e.Str(s.EmoteID)
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RuleMiddlewareSettings")
+	{
+		e.FieldStart("code")
+		e.Str(s.Code)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s RuleMiddlewareSettings) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleMiddlewareSettings) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *RuleTemplateVariable) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *RuleTemplateVariable) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("uses")
+		e.Int64(s.Uses)
 	}
 	{
-		e.FieldStart("description")
-		e.Str(s.Description)
+		e.FieldStart("messages")
+		e.Int64(s.Messages)
+	}
+	{
+		e.FieldStart("chatters")
+		e.Int64(s.Chatters)
 	}
 }
 
-var jsonFieldsNameOfRuleTemplateVariable = [2]string{
-	0: "name",
-	1: "description",
+var jsonFieldsNameOfEmoteUsage = [6]string{
+	0: "provider",
+	1: "emote_id",
+	2: "code",
+	3: "uses",
+	4: "messages",
+	5: "chatters",
 }
 
-// Decode decodes RuleTemplateVariable from json.
-func (s *RuleTemplateVariable) Decode(d *jx.Decoder) error {
+// Decode decodes EmoteUsage from json.
+func (s *EmoteUsage) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RuleTemplateVariable to nil")
+		return errors.New("invalid: unable to decode EmoteUsage to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "provider":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Name = string(v)
+				s.Provider = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"provider\"")
 			}
-		case "description":
+		case "emote_id":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Description = string(v)
+				s.EmoteID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"emote_id\"")
+			}
+		case "code":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Code = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "uses":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.Uses = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uses\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.Messages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		case "chatters":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int64()
+				s.Chatters = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chatters\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RuleTemplateVariable")
+		return errors.Wrap(err, "decode EmoteUsage")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7970,8 +9063,8 @@ func (s *RuleTemplateVariable) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRuleTemplateVariable) {
-					name = jsonFieldsNameOfRuleTemplateVariable[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfEmoteUsage) {
+					name = jsonFieldsNameOfEmoteUsage[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7992,74 +9085,64 @@ func (s *RuleTemplateVariable) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RuleTemplateVariable) MarshalJSON() ([]byte, error) {
+func (s *EmoteUsage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleTemplateVariable) UnmarshalJSON(data []byte) error {
+func (s *EmoteUsage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RuleTemplateVariablesResponse) Encode(e *jx.Encoder) {
+func (s *ErrorMessage) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RuleTemplateVariablesResponse) encodeFields(e *jx.Encoder) {
+func (s *ErrorMessage) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("variables")
-		e.ArrStart()
-		for _, elem := range s.Variables {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
 }
 
-var jsonFieldsNameOfRuleTemplateVariablesResponse = [1]string{
-	0: "variables",
+var jsonFieldsNameOfErrorMessage = [1]string{
+	0: "message",
 }
 
-// Decode decodes RuleTemplateVariablesResponse from json.
-func (s *RuleTemplateVariablesResponse) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorMessage from json.
+func (s *ErrorMessage) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RuleTemplateVariablesResponse to nil")
+		return errors.New("invalid: unable to decode ErrorMessage to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "variables":
+		case "message":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Variables = make([]RuleTemplateVariable, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RuleTemplateVariable
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Variables = append(s.Variables, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"variables\"")
+				return errors.Wrap(err, "decode field \"message\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RuleTemplateVariablesResponse")
+		return errors.Wrap(err, "decode ErrorMessage")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -8076,8 +9159,8 @@ func (s *RuleTemplateVariablesResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRuleTemplateVariablesResponse) {
-					name = jsonFieldsNameOfRuleTemplateVariablesResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorMessage) {
+					name = jsonFieldsNameOfErrorMessage[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -8098,171 +9181,120 @@ func (s *RuleTemplateVariablesResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RuleTemplateVariablesResponse) MarshalJSON() ([]byte, error) {
+func (s *ErrorMessage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleTemplateVariablesResponse) UnmarshalJSON(data []byte) error {
+func (s *ErrorMessage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RuleTrigger) Encode(e *jx.Encoder) {
+func (s *FollowedChannelEntry) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RuleTrigger) encodeFields(e *jx.Encoder) {
+func (s *FollowedChannelEntry) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int64(s.ID)
+		e.FieldStart("channel_id")
+		e.Int64(s.ChannelID)
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("channel_login")
+		e.Str(s.ChannelLogin)
 	}
 	{
-		if s.RuleID.Set {
-			e.FieldStart("rule_id")
-			s.RuleID.Encode(e)
+		if s.FollowedAt.Set {
+			e.FieldStart("followed_at")
+			s.FollowedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		e.FieldStart("rule_name")
-		e.Str(s.RuleName)
-	}
-	{
-		e.FieldStart("trigger_event")
-		e.Str(s.TriggerEvent)
-	}
-	{
-		e.FieldStart("action_type")
-		e.Str(s.ActionType)
-	}
-	{
-		e.FieldStart("display_text")
-		e.Str(s.DisplayText)
+		e.FieldStart("on_blacklist")
+		e.Bool(s.OnBlacklist)
 	}
 }
 
-var jsonFieldsNameOfRuleTrigger = [7]string{
-	0: "id",
-	1: "created_at",
-	2: "rule_id",
-	3: "rule_name",
-	4: "trigger_event",
-	5: "action_type",
-	6: "display_text",
+var jsonFieldsNameOfFollowedChannelEntry = [4]string{
+	0: "channel_id",
+	1: "channel_login",
+	2: "followed_at",
+	3: "on_blacklist",
 }
 
-// Decode decodes RuleTrigger from json.
-func (s *RuleTrigger) Decode(d *jx.Decoder) error {
+// Decode decodes FollowedChannelEntry from json.
+func (s *FollowedChannelEntry) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RuleTrigger to nil")
+		return errors.New("invalid: unable to decode FollowedChannelEntry to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "channel_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.ID = int64(v)
+				s.ChannelID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"channel_id\"")
 			}
-		case "created_at":
+		case "channel_login":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := d.Str()
+				s.ChannelLogin = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"channel_login\"")
 			}
-		case "rule_id":
+		case "followed_at":
 			if err := func() error {
-				s.RuleID.Reset()
-				if err := s.RuleID.Decode(d); err != nil {
+				s.FollowedAt.Reset()
+				if err := s.FollowedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rule_id\"")
+				return errors.Wrap(err, "decode field \"followed_at\"")
 			}
-		case "rule_name":
+		case "on_blacklist":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Str()
-				s.RuleName = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rule_name\"")
-			}
-		case "trigger_event":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Str()
-				s.TriggerEvent = string(v)
+				v, err := d.Bool()
+				s.OnBlacklist = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"trigger_event\"")
-			}
-		case "action_type":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Str()
-				s.ActionType = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"action_type\"")
-			}
-		case "display_text":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Str()
-				s.DisplayText = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"display_text\"")
+				return errors.Wrap(err, "decode field \"on_blacklist\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RuleTrigger")
+		return errors.Wrap(err, "decode FollowedChannelEntry")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111011,
+		0b00001011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -8274,8 +9306,8 @@ func (s *RuleTrigger) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRuleTrigger) {
-					name = jsonFieldsNameOfRuleTrigger[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfFollowedChannelEntry) {
+					name = jsonFieldsNameOfFollowedChannelEntry[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -8296,179 +9328,103 @@ func (s *RuleTrigger) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RuleTrigger) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RuleTrigger) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes SendMessageBadGateway as json.
-func (s *SendMessageBadGateway) Encode(e *jx.Encoder) {
-	unwrapped := (*ClientNotice)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes SendMessageBadGateway from json.
-func (s *SendMessageBadGateway) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode SendMessageBadGateway to nil")
-	}
-	var unwrapped ClientNotice
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = SendMessageBadGateway(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *SendMessageBadGateway) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SendMessageBadGateway) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes SendMessageBadRequest as json.
-func (s *SendMessageBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ClientNotice)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes SendMessageBadRequest from json.
-func (s *SendMessageBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode SendMessageBadRequest to nil")
-	}
-	var unwrapped ClientNotice
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = SendMessageBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *SendMessageBadRequest) MarshalJSON() ([]byte, error) {
+func (s *FollowedChannelEntry) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SendMessageBadRequest) UnmarshalJSON(data []byte) error {
+func (s *FollowedChannelEntry) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SendMessageRequest) Encode(e *jx.Encoder) {
+func (s *FollowedMonitoredChannel) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SendMessageRequest) encodeFields(e *jx.Encoder) {
+func (s *FollowedMonitoredChannel) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("account_id")
-		e.Int64(s.AccountID)
+		e.FieldStart("channel_id")
+		e.Int64(s.ChannelID)
 	}
 	{
-		e.FieldStart("channel")
-		e.Str(s.Channel)
+		e.FieldStart("channel_login")
+		e.Str(s.ChannelLogin)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		if s.FollowedAt.Set {
+			e.FieldStart("followed_at")
+			s.FollowedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 }
 
-var jsonFieldsNameOfSendMessageRequest = [3]string{
-	0: "account_id",
-	1: "channel",
-	2: "message",
+var jsonFieldsNameOfFollowedMonitoredChannel = [3]string{
+	0: "channel_id",
+	1: "channel_login",
+	2: "followed_at",
 }
 
-// Decode decodes SendMessageRequest from json.
-func (s *SendMessageRequest) Decode(d *jx.Decoder) error {
+// Decode decodes FollowedMonitoredChannel from json.
+func (s *FollowedMonitoredChannel) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SendMessageRequest to nil")
+		return errors.New("invalid: unable to decode FollowedMonitoredChannel to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "account_id":
+		case "channel_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.AccountID = int64(v)
+				s.ChannelID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"account_id\"")
+				return errors.Wrap(err, "decode field \"channel_id\"")
 			}
-		case "channel":
+		case "channel_login":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Channel = string(v)
+				s.ChannelLogin = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel\"")
+				return errors.Wrap(err, "decode field \"channel_login\"")
 			}
-		case "message":
-			requiredBitSet[0] |= 1 << 2
+		case "followed_at":
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
-				if err != nil {
+				s.FollowedAt.Reset()
+				if err := s.FollowedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"followed_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SendMessageRequest")
+		return errors.Wrap(err, "decode FollowedMonitoredChannel")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -8480,8 +9436,8 @@ func (s *SendMessageRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSendMessageRequest) {
-					name = jsonFieldsNameOfSendMessageRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfFollowedMonitoredChannel) {
+					name = jsonFieldsNameOfFollowedMonitoredChannel[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -8502,31 +9458,31 @@ func (s *SendMessageRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SendMessageRequest) MarshalJSON() ([]byte, error) {
+func (s *FollowedMonitoredChannel) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SendMessageRequest) UnmarshalJSON(data []byte) error {
+func (s *FollowedMonitoredChannel) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SendMessageUnauthorized as json.
-func (s *SendMessageUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ClientNotice)(s)
+// Encode encodes GetChannelActivityHeatmapBadRequest as json.
+func (s *GetChannelActivityHeatmapBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes SendMessageUnauthorized from json.
-func (s *SendMessageUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetChannelActivityHeatmapBadRequest from json.
+func (s *GetChannelActivityHeatmapBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SendMessageUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetChannelActivityHeatmapBadRequest to nil")
 	}
-	var unwrapped ClientNotice
+	var unwrapped ErrorMessage
 	if err := func() error {
 		if err := unwrapped.Decode(d); err != nil {
 			return err
@@ -8535,36 +9491,36 @@ func (s *SendMessageUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = SendMessageUnauthorized(unwrapped)
+	*s = GetChannelActivityHeatmapBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SendMessageUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetChannelActivityHeatmapBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SendMessageUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetChannelActivityHeatmapBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SendMessageUnprocessableEntity as json.
-func (s *SendMessageUnprocessableEntity) Encode(e *jx.Encoder) {
-	unwrapped := (*ClientNotice)(s)
+// Encode encodes GetChannelActivityHeatmapNotFound as json.
+func (s *GetChannelActivityHeatmapNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes SendMessageUnprocessableEntity from json.
-func (s *SendMessageUnprocessableEntity) Decode(d *jx.Decoder) error {
+// Decode decodes GetChannelActivityHeatmapNotFound from json.
+func (s *GetChannelActivityHeatmapNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SendMessageUnprocessableEntity to nil")
+		return errors.New("invalid: unable to decode GetChannelActivityHeatmapNotFound to nil")
 	}
-	var unwrapped ClientNotice
+	var unwrapped ErrorMessage
 	if err := func() error {
 		if err := unwrapped.Decode(d); err != nil {
 			return err
@@ -8573,132 +9529,347 @@ func (s *SendMessageUnprocessableEntity) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = SendMessageUnprocessableEntity(unwrapped)
+	*s = GetChannelActivityHeatmapNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SendMessageUnprocessableEntity) MarshalJSON() ([]byte, error) {
+func (s *GetChannelActivityHeatmapNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SendMessageUnprocessableEntity) UnmarshalJSON(data []byte) error {
+func (s *GetChannelActivityHeatmapNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *StartTwitchOAuthRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *StartTwitchOAuthRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.ReturnURL.Set {
-			e.FieldStart("return_url")
-			s.ReturnURL.Encode(e)
-		}
-	}
-}
+// Encode encodes GetChannelAnalyticsBadRequest as json.
+func (s *GetChannelAnalyticsBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
 
-var jsonFieldsNameOfStartTwitchOAuthRequest = [1]string{
-	0: "return_url",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes StartTwitchOAuthRequest from json.
-func (s *StartTwitchOAuthRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetChannelAnalyticsBadRequest from json.
+func (s *GetChannelAnalyticsBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StartTwitchOAuthRequest to nil")
+		return errors.New("invalid: unable to decode GetChannelAnalyticsBadRequest to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "return_url":
-			if err := func() error {
-				s.ReturnURL.Reset()
-				if err := s.ReturnURL.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"return_url\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode StartTwitchOAuthRequest")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetChannelAnalyticsBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StartTwitchOAuthRequest) MarshalJSON() ([]byte, error) {
+func (s *GetChannelAnalyticsBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StartTwitchOAuthRequest) UnmarshalJSON(data []byte) error {
+func (s *GetChannelAnalyticsBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *StartTwitchOAuthResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetChannelAnalyticsNotFound as json.
+func (s *GetChannelAnalyticsNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *StartTwitchOAuthResponse) encodeFields(e *jx.Encoder) {
+// Decode decodes GetChannelAnalyticsNotFound from json.
+func (s *GetChannelAnalyticsNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetChannelAnalyticsNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetChannelAnalyticsNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetChannelAnalyticsNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetChannelAnalyticsNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetChannelEmoteUsageBadRequest as json.
+func (s *GetChannelEmoteUsageBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetChannelEmoteUsageBadRequest from json.
+func (s *GetChannelEmoteUsageBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetChannelEmoteUsageBadRequest to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetChannelEmoteUsageBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetChannelEmoteUsageBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetChannelEmoteUsageBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetChannelEmoteUsageNotFound as json.
+func (s *GetChannelEmoteUsageNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetChannelEmoteUsageNotFound from json.
+func (s *GetChannelEmoteUsageNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetChannelEmoteUsageNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetChannelEmoteUsageNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetChannelEmoteUsageNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetChannelEmoteUsageNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetChannelEmoteUsageOKApplicationJSON as json.
+func (s GetChannelEmoteUsageOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []EmoteUsage(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes GetChannelEmoteUsageOKApplicationJSON from json.
+func (s *GetChannelEmoteUsageOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetChannelEmoteUsageOKApplicationJSON to nil")
+	}
+	var unwrapped []EmoteUsage
+	if err := func() error {
+		unwrapped = make([]EmoteUsage, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem EmoteUsage
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetChannelEmoteUsageOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetChannelEmoteUsageOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetChannelEmoteUsageOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetChannelLeaderboardBadRequest as json.
+func (s *GetChannelLeaderboardBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetChannelLeaderboardBadRequest from json.
+func (s *GetChannelLeaderboardBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetChannelLeaderboardBadRequest to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetChannelLeaderboardBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetChannelLeaderboardBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetChannelLeaderboardBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetChannelLeaderboardNotFound as json.
+func (s *GetChannelLeaderboardNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetChannelLeaderboardNotFound from json.
+func (s *GetChannelLeaderboardNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetChannelLeaderboardNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetChannelLeaderboardNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetChannelLeaderboardNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetChannelLeaderboardNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetChannelLiveRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetChannelLiveRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("authorize_url")
-		e.Str(s.AuthorizeURL)
+		e.FieldStart("login")
+		e.Str(s.Login)
 	}
 }
 
-var jsonFieldsNameOfStartTwitchOAuthResponse = [1]string{
-	0: "authorize_url",
+var jsonFieldsNameOfGetChannelLiveRequest = [1]string{
+	0: "login",
 }
 
-// Decode decodes StartTwitchOAuthResponse from json.
-func (s *StartTwitchOAuthResponse) Decode(d *jx.Decoder) error {
+// Decode decodes GetChannelLiveRequest from json.
+func (s *GetChannelLiveRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StartTwitchOAuthResponse to nil")
+		return errors.New("invalid: unable to decode GetChannelLiveRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "authorize_url":
+		case "login":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.AuthorizeURL = string(v)
+				s.Login = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"authorize_url\"")
+				return errors.Wrap(err, "decode field \"login\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode StartTwitchOAuthResponse")
+		return errors.Wrap(err, "decode GetChannelLiveRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -8715,8 +9886,8 @@ func (s *StartTwitchOAuthResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfStartTwitchOAuthResponse) {
-					name = jsonFieldsNameOfStartTwitchOAuthResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfGetChannelLiveRequest) {
+					name = jsonFieldsNameOfGetChannelLiveRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -8737,318 +9908,431 @@ func (s *StartTwitchOAuthResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StartTwitchOAuthResponse) MarshalJSON() ([]byte, error) {
+func (s *GetChannelLiveRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StartTwitchOAuthResponse) UnmarshalJSON(data []byte) error {
+func (s *GetChannelLiveRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *StreamLeaderboardEntry) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
+// Encode encodes GetRecordedStreamLeaderboardOKApplicationJSON as json.
+func (s GetRecordedStreamLeaderboardOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []StreamLeaderboardEntry(s)
 
-// encodeFields encodes fields.
-func (s *StreamLeaderboardEntry) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("login")
-		e.Str(s.Login)
-	}
-	{
-		e.FieldStart("user_twitch_id")
-		e.Int64(s.UserTwitchID)
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	{
-		e.FieldStart("presence_seconds")
-		e.Int64(s.PresenceSeconds)
+	e.ArrEnd()
+}
+
+// Decode decodes GetRecordedStreamLeaderboardOKApplicationJSON from json.
+func (s *GetRecordedStreamLeaderboardOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetRecordedStreamLeaderboardOKApplicationJSON to nil")
 	}
-	{
-		e.FieldStart("message_count")
-		e.Int64(s.MessageCount)
-	}
-	{
-		if s.AccountCreatedAt.Set {
-			e.FieldStart("account_created_at")
-			s.AccountCreatedAt.Encode(e, json.EncodeDateTime)
+	var unwrapped []StreamLeaderboardEntry
+	if err := func() error {
+		unwrapped = make([]StreamLeaderboardEntry, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem StreamLeaderboardEntry
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetRecordedStreamLeaderboardOKApplicationJSON(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfStreamLeaderboardEntry = [5]string{
-	0: "login",
-	1: "user_twitch_id",
-	2: "presence_seconds",
-	3: "message_count",
-	4: "account_created_at",
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetRecordedStreamLeaderboardOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes StreamLeaderboardEntry from json.
-func (s *StreamLeaderboardEntry) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetRecordedStreamLeaderboardOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetRecordedStreamTermSeriesBadRequest as json.
+func (s *GetRecordedStreamTermSeriesBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetRecordedStreamTermSeriesBadRequest from json.
+func (s *GetRecordedStreamTermSeriesBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode StreamLeaderboardEntry to nil")
+		return errors.New("invalid: unable to decode GetRecordedStreamTermSeriesBadRequest to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "login":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Login = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login\"")
-			}
-		case "user_twitch_id":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int64()
-				s.UserTwitchID = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_twitch_id\"")
-			}
-		case "presence_seconds":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int64()
-				s.PresenceSeconds = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"presence_seconds\"")
-			}
-		case "message_count":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Int64()
-				s.MessageCount = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message_count\"")
-			}
-		case "account_created_at":
-			if err := func() error {
-				s.AccountCreatedAt.Reset()
-				if err := s.AccountCreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"account_created_at\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode StreamLeaderboardEntry")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfStreamLeaderboardEntry) {
-					name = jsonFieldsNameOfStreamLeaderboardEntry[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetRecordedStreamTermSeriesBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *StreamLeaderboardEntry) MarshalJSON() ([]byte, error) {
+func (s *GetRecordedStreamTermSeriesBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *StreamLeaderboardEntry) UnmarshalJSON(data []byte) error {
+func (s *GetRecordedStreamTermSeriesBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *SuspicionSettings) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetRecordedStreamTermSeriesNotFound as json.
+func (s *GetRecordedStreamTermSeriesNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *SuspicionSettings) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("auto_check_account_age")
-		e.Bool(s.AutoCheckAccountAge)
-	}
-	{
-		e.FieldStart("account_age_sus_days")
-		e.Int(s.AccountAgeSusDays)
-	}
-	{
-		e.FieldStart("auto_check_blacklist")
-		e.Bool(s.AutoCheckBlacklist)
+// Decode decodes GetRecordedStreamTermSeriesNotFound from json.
+func (s *GetRecordedStreamTermSeriesNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetRecordedStreamTermSeriesNotFound to nil")
 	}
-	{
-		e.FieldStart("auto_check_low_follows")
-		e.Bool(s.AutoCheckLowFollows)
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		e.FieldStart("low_follows_threshold")
-		e.Int(s.LowFollowsThreshold)
+	*s = GetRecordedStreamTermSeriesNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetRecordedStreamTermSeriesNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetRecordedStreamTermSeriesNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetRecordedStreamTermsBadRequest as json.
+func (s *GetRecordedStreamTermsBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetRecordedStreamTermsBadRequest from json.
+func (s *GetRecordedStreamTermsBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetRecordedStreamTermsBadRequest to nil")
 	}
-	{
-		e.FieldStart("max_gql_follow_pages")
-		e.Int(s.MaxGqlFollowPages)
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetRecordedStreamTermsBadRequest(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfSuspicionSettings = [6]string{
-	0: "auto_check_account_age",
-	1: "account_age_sus_days",
-	2: "auto_check_blacklist",
-	3: "auto_check_low_follows",
-	4: "low_follows_threshold",
-	5: "max_gql_follow_pages",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetRecordedStreamTermsBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes SuspicionSettings from json.
-func (s *SuspicionSettings) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetRecordedStreamTermsBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetRecordedStreamTermsNotFound as json.
+func (s *GetRecordedStreamTermsNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetRecordedStreamTermsNotFound from json.
+func (s *GetRecordedStreamTermsNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SuspicionSettings to nil")
+		return errors.New("invalid: unable to decode GetRecordedStreamTermsNotFound to nil")
 	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "auto_check_account_age":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Bool()
-				s.AutoCheckAccountAge = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"auto_check_account_age\"")
-			}
-		case "account_age_sus_days":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.AccountAgeSusDays = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"account_age_sus_days\"")
-			}
-		case "auto_check_blacklist":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Bool()
-				s.AutoCheckBlacklist = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"auto_check_blacklist\"")
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetRecordedStreamTermsNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetRecordedStreamTermsNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetRecordedStreamTermsNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetTwitchUserActivityHeatmapBadRequest as json.
+func (s *GetTwitchUserActivityHeatmapBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetTwitchUserActivityHeatmapBadRequest from json.
+func (s *GetTwitchUserActivityHeatmapBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetTwitchUserActivityHeatmapBadRequest to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetTwitchUserActivityHeatmapBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetTwitchUserActivityHeatmapBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetTwitchUserActivityHeatmapBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetTwitchUserActivityHeatmapNotFound as json.
+func (s *GetTwitchUserActivityHeatmapNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetTwitchUserActivityHeatmapNotFound from json.
+func (s *GetTwitchUserActivityHeatmapNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetTwitchUserActivityHeatmapNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetTwitchUserActivityHeatmapNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetTwitchUserActivityHeatmapNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetTwitchUserActivityHeatmapNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetTwitchUserActivityTimelineOKApplicationJSON as json.
+func (s GetTwitchUserActivityTimelineOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []ActivityTimelineSegment(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes GetTwitchUserActivityTimelineOKApplicationJSON from json.
+func (s *GetTwitchUserActivityTimelineOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetTwitchUserActivityTimelineOKApplicationJSON to nil")
+	}
+	var unwrapped []ActivityTimelineSegment
+	if err := func() error {
+		unwrapped = make([]ActivityTimelineSegment, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem ActivityTimelineSegment
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		case "auto_check_low_follows":
-			requiredBitSet[0] |= 1 << 3
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetTwitchUserActivityTimelineOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetTwitchUserActivityTimelineOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetTwitchUserActivityTimelineOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetTwitchUserActivityTimelineRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetTwitchUserActivityTimelineRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		if s.From.Set {
+			e.FieldStart("from")
+			s.From.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.To.Set {
+			e.FieldStart("to")
+			s.To.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfGetTwitchUserActivityTimelineRequest = [3]string{
+	0: "id",
+	1: "from",
+	2: "to",
+}
+
+// Decode decodes GetTwitchUserActivityTimelineRequest from json.
+func (s *GetTwitchUserActivityTimelineRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetTwitchUserActivityTimelineRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.AutoCheckLowFollows = bool(v)
+				v, err := d.Int64()
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"auto_check_low_follows\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "low_follows_threshold":
-			requiredBitSet[0] |= 1 << 4
+		case "from":
 			if err := func() error {
-				v, err := d.Int()
-				s.LowFollowsThreshold = int(v)
-				if err != nil {
+				s.From.Reset()
+				if err := s.From.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"low_follows_threshold\"")
+				return errors.Wrap(err, "decode field \"from\"")
 			}
-		case "max_gql_follow_pages":
-			requiredBitSet[0] |= 1 << 5
+		case "to":
 			if err := func() error {
-				v, err := d.Int()
-				s.MaxGqlFollowPages = int(v)
-				if err != nil {
+				s.To.Reset()
+				if err := s.To.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"max_gql_follow_pages\"")
+				return errors.Wrap(err, "decode field \"to\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SuspicionSettings")
+		return errors.Wrap(err, "decode GetTwitchUserActivityTimelineRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00111111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9060,8 +10344,8 @@ func (s *SuspicionSettings) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSuspicionSettings) {
-					name = jsonFieldsNameOfSuspicionSettings[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfGetTwitchUserActivityTimelineRequest) {
+					name = jsonFieldsNameOfGetTwitchUserActivityTimelineRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9082,205 +10366,194 @@ func (s *SuspicionSettings) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SuspicionSettings) MarshalJSON() ([]byte, error) {
+func (s *GetTwitchUserActivityTimelineRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SuspicionSettings) UnmarshalJSON(data []byte) error {
+func (s *GetTwitchUserActivityTimelineRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *SystemStatsCaches) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetTwitchUserEmoteUsageBadRequest as json.
+func (s *GetTwitchUserEmoteUsageBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *SystemStatsCaches) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("helix_user_oauth_cache_entries")
-		e.Int32(s.HelixUserOAuthCacheEntries)
-	}
-	{
-		e.FieldStart("helix_app_access_token_cached")
-		e.Bool(s.HelixAppAccessTokenCached)
+// Decode decodes GetTwitchUserEmoteUsageBadRequest from json.
+func (s *GetTwitchUserEmoteUsageBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetTwitchUserEmoteUsageBadRequest to nil")
 	}
-	{
-		e.FieldStart("login_limiter_tracked_ips")
-		e.Int32(s.LoginLimiterTrackedIps)
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		e.FieldStart("pgx_acquired_conns")
-		e.Int32(s.PgxAcquiredConns)
+	*s = GetTwitchUserEmoteUsageBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetTwitchUserEmoteUsageBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetTwitchUserEmoteUsageBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetTwitchUserEmoteUsageNotFound as json.
+func (s *GetTwitchUserEmoteUsageNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetTwitchUserEmoteUsageNotFound from json.
+func (s *GetTwitchUserEmoteUsageNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetTwitchUserEmoteUsageNotFound to nil")
 	}
-	{
-		e.FieldStart("pgx_idle_conns")
-		e.Int32(s.PgxIdleConns)
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		e.FieldStart("pgx_total_conns")
-		e.Int32(s.PgxTotalConns)
+	*s = GetTwitchUserEmoteUsageNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetTwitchUserEmoteUsageNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetTwitchUserEmoteUsageNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetTwitchUserEmoteUsageOKApplicationJSON as json.
+func (s GetTwitchUserEmoteUsageOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []EmoteUsage(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
-	{
-		e.FieldStart("pgx_max_conns")
-		e.Int32(s.PgxMaxConns)
+	e.ArrEnd()
+}
+
+// Decode decodes GetTwitchUserEmoteUsageOKApplicationJSON from json.
+func (s *GetTwitchUserEmoteUsageOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetTwitchUserEmoteUsageOKApplicationJSON to nil")
 	}
-	{
-		e.FieldStart("pgx_acquire_count")
-		e.Int64(s.PgxAcquireCount)
+	var unwrapped []EmoteUsage
+	if err := func() error {
+		unwrapped = make([]EmoteUsage, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem EmoteUsage
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetTwitchUserEmoteUsageOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetTwitchUserEmoteUsageOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetTwitchUserEmoteUsageOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetTwitchUserProfileRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetTwitchUserProfileRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("pgx_canceled_acquire_count")
-		e.Int64(s.PgxCanceledAcquireCount)
+		e.FieldStart("id")
+		e.Int64(s.ID)
 	}
 }
 
-var jsonFieldsNameOfSystemStatsCaches = [9]string{
-	0: "helix_user_oauth_cache_entries",
-	1: "helix_app_access_token_cached",
-	2: "login_limiter_tracked_ips",
-	3: "pgx_acquired_conns",
-	4: "pgx_idle_conns",
-	5: "pgx_total_conns",
-	6: "pgx_max_conns",
-	7: "pgx_acquire_count",
-	8: "pgx_canceled_acquire_count",
+var jsonFieldsNameOfGetTwitchUserProfileRequest = [1]string{
+	0: "id",
 }
 
-// Decode decodes SystemStatsCaches from json.
-func (s *SystemStatsCaches) Decode(d *jx.Decoder) error {
+// Decode decodes GetTwitchUserProfileRequest from json.
+func (s *GetTwitchUserProfileRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SystemStatsCaches to nil")
+		return errors.New("invalid: unable to decode GetTwitchUserProfileRequest to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "helix_user_oauth_cache_entries":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int32()
-				s.HelixUserOAuthCacheEntries = int32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"helix_user_oauth_cache_entries\"")
-			}
-		case "helix_app_access_token_cached":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Bool()
-				s.HelixAppAccessTokenCached = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"helix_app_access_token_cached\"")
-			}
-		case "login_limiter_tracked_ips":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int32()
-				s.LoginLimiterTrackedIps = int32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login_limiter_tracked_ips\"")
-			}
-		case "pgx_acquired_conns":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Int32()
-				s.PgxAcquiredConns = int32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pgx_acquired_conns\"")
-			}
-		case "pgx_idle_conns":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Int32()
-				s.PgxIdleConns = int32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pgx_idle_conns\"")
-			}
-		case "pgx_total_conns":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Int32()
-				s.PgxTotalConns = int32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pgx_total_conns\"")
-			}
-		case "pgx_max_conns":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Int32()
-				s.PgxMaxConns = int32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pgx_max_conns\"")
-			}
-		case "pgx_acquire_count":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				v, err := d.Int64()
-				s.PgxAcquireCount = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pgx_acquire_count\"")
-			}
-		case "pgx_canceled_acquire_count":
-			requiredBitSet[1] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.PgxCanceledAcquireCount = int64(v)
+				s.ID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pgx_canceled_acquire_count\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SystemStatsCaches")
+		return errors.Wrap(err, "decode GetTwitchUserProfileRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b11111111,
+	for i, mask := range [1]uint8{
 		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -9293,8 +10566,8 @@ func (s *SystemStatsCaches) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSystemStatsCaches) {
-					name = jsonFieldsNameOfSystemStatsCaches[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfGetTwitchUserProfileRequest) {
+					name = jsonFieldsNameOfGetTwitchUserProfileRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9315,186 +10588,86 @@ func (s *SystemStatsCaches) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SystemStatsCaches) MarshalJSON() ([]byte, error) {
+func (s *GetTwitchUserProfileRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SystemStatsCaches) UnmarshalJSON(data []byte) error {
+func (s *GetTwitchUserProfileRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SystemStatsHost) Encode(e *jx.Encoder) {
+func (s *IrcJoinedSample) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SystemStatsHost) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("cpu_percent")
-		s.CPUPercent.Encode(e)
-	}
-	{
-		e.FieldStart("memory_total_bytes")
-		e.Int64(s.MemoryTotalBytes)
-	}
-	{
-		e.FieldStart("memory_used_bytes")
-		e.Int64(s.MemoryUsedBytes)
-	}
-	{
-		e.FieldStart("memory_used_percent")
-		e.Float64(s.MemoryUsedPercent)
-	}
-	{
-		e.FieldStart("disk_path")
-		e.Str(s.DiskPath)
-	}
-	{
-		e.FieldStart("disk_total_bytes")
-		e.Int64(s.DiskTotalBytes)
-	}
+func (s *IrcJoinedSample) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("disk_used_bytes")
-		e.Int64(s.DiskUsedBytes)
+		e.FieldStart("captured_at")
+		json.EncodeDateTime(e, s.CapturedAt)
 	}
 	{
-		e.FieldStart("disk_used_percent")
-		e.Float64(s.DiskUsedPercent)
+		e.FieldStart("joined_count")
+		e.Int(s.JoinedCount)
 	}
 }
 
-var jsonFieldsNameOfSystemStatsHost = [8]string{
-	0: "cpu_percent",
-	1: "memory_total_bytes",
-	2: "memory_used_bytes",
-	3: "memory_used_percent",
-	4: "disk_path",
-	5: "disk_total_bytes",
-	6: "disk_used_bytes",
-	7: "disk_used_percent",
+var jsonFieldsNameOfIrcJoinedSample = [2]string{
+	0: "captured_at",
+	1: "joined_count",
 }
 
-// Decode decodes SystemStatsHost from json.
-func (s *SystemStatsHost) Decode(d *jx.Decoder) error {
+// Decode decodes IrcJoinedSample from json.
+func (s *IrcJoinedSample) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SystemStatsHost to nil")
+		return errors.New("invalid: unable to decode IrcJoinedSample to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "cpu_percent":
+		case "captured_at":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.CPUPercent.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cpu_percent\"")
-			}
-		case "memory_total_bytes":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int64()
-				s.MemoryTotalBytes = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"memory_total_bytes\"")
-			}
-		case "memory_used_bytes":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int64()
-				s.MemoryUsedBytes = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"memory_used_bytes\"")
-			}
-		case "memory_used_percent":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Float64()
-				s.MemoryUsedPercent = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"memory_used_percent\"")
-			}
-		case "disk_path":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Str()
-				s.DiskPath = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"disk_path\"")
-			}
-		case "disk_total_bytes":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Int64()
-				s.DiskTotalBytes = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"disk_total_bytes\"")
-			}
-		case "disk_used_bytes":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Int64()
-				s.DiskUsedBytes = int64(v)
+				v, err := json.DecodeDateTime(d)
+				s.CapturedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"disk_used_bytes\"")
+				return errors.Wrap(err, "decode field \"captured_at\"")
 			}
-		case "disk_used_percent":
-			requiredBitSet[0] |= 1 << 7
+		case "joined_count":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Float64()
-				s.DiskUsedPercent = float64(v)
+				v, err := d.Int()
+				s.JoinedCount = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"disk_used_percent\"")
+				return errors.Wrap(err, "decode field \"joined_count\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SystemStatsHost")
+		return errors.Wrap(err, "decode IrcJoinedSample")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b11111111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9506,8 +10679,8 @@ func (s *SystemStatsHost) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSystemStatsHost) {
-					name = jsonFieldsNameOfSystemStatsHost[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfIrcJoinedSample) {
+					name = jsonFieldsNameOfIrcJoinedSample[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9528,171 +10701,207 @@ func (s *SystemStatsHost) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SystemStatsHost) MarshalJSON() ([]byte, error) {
+func (s *IrcJoinedSample) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SystemStatsHost) UnmarshalJSON(data []byte) error {
+func (s *IrcJoinedSample) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SystemStatsProcess) Encode(e *jx.Encoder) {
+func (s *IrcMonitorSettings) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SystemStatsProcess) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("goroutines")
-		e.Int32(s.Goroutines)
-	}
-	{
-		e.FieldStart("heap_alloc_bytes")
-		e.Int64(s.HeapAllocBytes)
-	}
-	{
-		e.FieldStart("heap_sys_bytes")
-		e.Int64(s.HeapSysBytes)
-	}
-	{
-		e.FieldStart("sys_bytes")
-		e.Int64(s.SysBytes)
-	}
-	{
-		e.FieldStart("total_alloc_bytes")
-		e.Int64(s.TotalAllocBytes)
-	}
+func (s *IrcMonitorSettings) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("num_gc")
-		e.Int32(s.NumGc)
+		e.FieldStart("oauth_twitch_account_id")
+		s.OAuthTwitchAccountID.Encode(e)
 	}
 	{
-		e.FieldStart("gc_cpu_fraction")
-		e.Float64(s.GcCPUFraction)
+		e.FieldStart("enrichment_cooldown_hours")
+		e.Int(s.EnrichmentCooldownHours)
 	}
 }
 
-var jsonFieldsNameOfSystemStatsProcess = [7]string{
-	0: "goroutines",
-	1: "heap_alloc_bytes",
-	2: "heap_sys_bytes",
-	3: "sys_bytes",
-	4: "total_alloc_bytes",
-	5: "num_gc",
-	6: "gc_cpu_fraction",
+var jsonFieldsNameOfIrcMonitorSettings = [2]string{
+	0: "oauth_twitch_account_id",
+	1: "enrichment_cooldown_hours",
 }
 
-// Decode decodes SystemStatsProcess from json.
-func (s *SystemStatsProcess) Decode(d *jx.Decoder) error {
+// Decode decodes IrcMonitorSettings from json.
+func (s *IrcMonitorSettings) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SystemStatsProcess to nil")
+		return errors.New("invalid: unable to decode IrcMonitorSettings to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "goroutines":
+		case "oauth_twitch_account_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int32()
-				s.Goroutines = int32(v)
-				if err != nil {
+				if err := s.OAuthTwitchAccountID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"goroutines\"")
+				return errors.Wrap(err, "decode field \"oauth_twitch_account_id\"")
 			}
-		case "heap_alloc_bytes":
+		case "enrichment_cooldown_hours":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int64()
-				s.HeapAllocBytes = int64(v)
+				v, err := d.Int()
+				s.EnrichmentCooldownHours = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"heap_alloc_bytes\"")
+				return errors.Wrap(err, "decode field \"enrichment_cooldown_hours\"")
 			}
-		case "heap_sys_bytes":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int64()
-				s.HeapSysBytes = int64(v)
-				if err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IrcMonitorSettings")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIrcMonitorSettings) {
+					name = jsonFieldsNameOfIrcMonitorSettings[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"heap_sys_bytes\"")
-			}
-		case "sys_bytes":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Int64()
-				s.SysBytes = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sys_bytes\"")
-			}
-		case "total_alloc_bytes":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Int64()
-				s.TotalAllocBytes = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total_alloc_bytes\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "num_gc":
-			requiredBitSet[0] |= 1 << 5
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IrcMonitorSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IrcMonitorSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IrcMonitorStatus) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IrcMonitorStatus) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("connected")
+		e.Bool(s.Connected)
+	}
+	{
+		e.FieldStart("channels")
+		e.ArrStart()
+		for _, elem := range s.Channels {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfIrcMonitorStatus = [2]string{
+	0: "connected",
+	1: "channels",
+}
+
+// Decode decodes IrcMonitorStatus from json.
+func (s *IrcMonitorStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IrcMonitorStatus to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "connected":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int32()
-				s.NumGc = int32(v)
+				v, err := d.Bool()
+				s.Connected = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"num_gc\"")
+				return errors.Wrap(err, "decode field \"connected\"")
 			}
-		case "gc_cpu_fraction":
-			requiredBitSet[0] |= 1 << 6
+		case "channels":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Float64()
-				s.GcCPUFraction = float64(v)
-				if err != nil {
+				s.Channels = make([]IrcMonitorStatusChannelsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IrcMonitorStatusChannelsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Channels = append(s.Channels, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"gc_cpu_fraction\"")
+				return errors.Wrap(err, "decode field \"channels\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SystemStatsProcess")
+		return errors.Wrap(err, "decode IrcMonitorStatus")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9704,8 +10913,8 @@ func (s *SystemStatsProcess) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSystemStatsProcess) {
-					name = jsonFieldsNameOfSystemStatsProcess[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfIrcMonitorStatus) {
+					name = jsonFieldsNameOfIrcMonitorStatus[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9726,129 +10935,86 @@ func (s *SystemStatsProcess) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SystemStatsProcess) MarshalJSON() ([]byte, error) {
+func (s *IrcMonitorStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SystemStatsProcess) UnmarshalJSON(data []byte) error {
+func (s *IrcMonitorStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SystemStatsResponse) Encode(e *jx.Encoder) {
+func (s *IrcMonitorStatusChannelsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SystemStatsResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("captured_at")
-		json.EncodeDateTime(e, s.CapturedAt)
-	}
-	{
-		e.FieldStart("tables")
-		s.Tables.Encode(e)
-	}
-	{
-		e.FieldStart("process")
-		s.Process.Encode(e)
-	}
+func (s *IrcMonitorStatusChannelsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("host")
-		s.Host.Encode(e)
+		e.FieldStart("login")
+		e.Str(s.Login)
 	}
 	{
-		e.FieldStart("caches")
-		s.Caches.Encode(e)
+		e.FieldStart("irc_ok")
+		e.Bool(s.IrcOk)
 	}
 }
 
-var jsonFieldsNameOfSystemStatsResponse = [5]string{
-	0: "captured_at",
-	1: "tables",
-	2: "process",
-	3: "host",
-	4: "caches",
+var jsonFieldsNameOfIrcMonitorStatusChannelsItem = [2]string{
+	0: "login",
+	1: "irc_ok",
 }
 
-// Decode decodes SystemStatsResponse from json.
-func (s *SystemStatsResponse) Decode(d *jx.Decoder) error {
+// Decode decodes IrcMonitorStatusChannelsItem from json.
+func (s *IrcMonitorStatusChannelsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SystemStatsResponse to nil")
+		return errors.New("invalid: unable to decode IrcMonitorStatusChannelsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "captured_at":
+		case "login":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CapturedAt = v
+				v, err := d.Str()
+				s.Login = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"captured_at\"")
+				return errors.Wrap(err, "decode field \"login\"")
 			}
-		case "tables":
+		case "irc_ok":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Tables.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tables\"")
-			}
-		case "process":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.Process.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"process\"")
-			}
-		case "host":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				if err := s.Host.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"host\"")
-			}
-		case "caches":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				if err := s.Caches.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.IrcOk = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"caches\"")
+				return errors.Wrap(err, "decode field \"irc_ok\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SystemStatsResponse")
+		return errors.Wrap(err, "decode IrcMonitorStatusChannelsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00011111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9860,8 +11026,8 @@ func (s *SystemStatsResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSystemStatsResponse) {
-					name = jsonFieldsNameOfSystemStatsResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfIrcMonitorStatusChannelsItem) {
+					name = jsonFieldsNameOfIrcMonitorStatusChannelsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9882,394 +11048,12435 @@ func (s *SystemStatsResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SystemStatsResponse) MarshalJSON() ([]byte, error) {
+func (s *IrcMonitorStatusChannelsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SystemStatsResponse) UnmarshalJSON(data []byte) error {
+func (s *IrcMonitorStatusChannelsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SystemStatsTables) Encode(e *jx.Encoder) {
+func (s *LikelyBot) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SystemStatsTables) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("twitch_users")
-		e.Int64(s.TwitchUsers)
-	}
-	{
-		e.FieldStart("twitch_accounts_active")
-		e.Int64(s.TwitchAccountsActive)
-	}
+func (s *LikelyBot) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("twitch_accounts_all")
-		e.Int64(s.TwitchAccountsAll)
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
 	}
 	{
-		e.FieldStart("rules")
-		e.Int64(s.Rules)
+		e.FieldStart("username")
+		e.Str(s.Username)
 	}
 	{
-		e.FieldStart("notification_entries")
-		e.Int64(s.NotificationEntries)
+		e.FieldStart("concurrent_channels")
+		e.Int(s.ConcurrentChannels)
 	}
 	{
-		e.FieldStart("streams")
-		e.Int64(s.Streams)
+		e.FieldStart("present_seconds")
+		e.Int64(s.PresentSeconds)
 	}
 	{
-		e.FieldStart("streams_open")
-		e.Int64(s.StreamsOpen)
+		e.FieldStart("message_count")
+		e.Int64(s.MessageCount)
 	}
 	{
-		e.FieldStart("chat_messages")
-		e.Int64(s.ChatMessages)
+		e.FieldStart("first_detected_at")
+		json.EncodeDateTime(e, s.FirstDetectedAt)
 	}
 	{
-		e.FieldStart("channel_chatters")
-		e.Int64(s.ChannelChatters)
-	}
-	{
-		e.FieldStart("user_activity_events")
-		e.Int64(s.UserActivityEvents)
-	}
-	{
-		e.FieldStart("twitch_user_helix_meta")
-		e.Int64(s.TwitchUserHelixMeta)
-	}
-	{
-		e.FieldStart("twitch_user_channel_follows")
-		e.Int64(s.TwitchUserChannelFollows)
-	}
-	{
-		e.FieldStart("user_followed_channels")
-		e.Int64(s.UserFollowedChannels)
-	}
-	{
-		e.FieldStart("channel_blacklist")
-		e.Int64(s.ChannelBlacklist)
-	}
-	{
-		e.FieldStart("rule_trigger_events")
-		e.Int64(s.RuleTriggerEvents)
-	}
-	{
-		e.FieldStart("irc_joined_samples")
-		e.Int64(s.IrcJoinedSamples)
-	}
-	{
-		e.FieldStart("twitch_discovery_candidates")
-		e.Int64(s.TwitchDiscoveryCandidates)
-	}
-	{
-		e.FieldStart("twitch_discovery_denied")
-		e.Int64(s.TwitchDiscoveryDenied)
-	}
-	{
-		e.FieldStart("ai_conversations")
-		e.Int64(s.AiConversations)
-	}
-	{
-		e.FieldStart("ai_messages")
-		e.Int64(s.AiMessages)
+		e.FieldStart("last_detected_at")
+		json.EncodeDateTime(e, s.LastDetectedAt)
 	}
 }
 
-var jsonFieldsNameOfSystemStatsTables = [20]string{
-	0:  "twitch_users",
-	1:  "twitch_accounts_active",
-	2:  "twitch_accounts_all",
-	3:  "rules",
-	4:  "notification_entries",
-	5:  "streams",
-	6:  "streams_open",
-	7:  "chat_messages",
-	8:  "channel_chatters",
-	9:  "user_activity_events",
-	10: "twitch_user_helix_meta",
-	11: "twitch_user_channel_follows",
-	12: "user_followed_channels",
-	13: "channel_blacklist",
-	14: "rule_trigger_events",
-	15: "irc_joined_samples",
-	16: "twitch_discovery_candidates",
-	17: "twitch_discovery_denied",
-	18: "ai_conversations",
-	19: "ai_messages",
+var jsonFieldsNameOfLikelyBot = [7]string{
+	0: "twitch_user_id",
+	1: "username",
+	2: "concurrent_channels",
+	3: "present_seconds",
+	4: "message_count",
+	5: "first_detected_at",
+	6: "last_detected_at",
 }
 
-// Decode decodes SystemStatsTables from json.
-func (s *SystemStatsTables) Decode(d *jx.Decoder) error {
+// Decode decodes LikelyBot from json.
+func (s *LikelyBot) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SystemStatsTables to nil")
+		return errors.New("invalid: unable to decode LikelyBot to nil")
 	}
-	var requiredBitSet [3]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "twitch_users":
+		case "twitch_user_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int64()
-				s.TwitchUsers = int64(v)
+				s.TwitchUserID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitch_users\"")
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
 			}
-		case "twitch_accounts_active":
+		case "username":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int64()
-				s.TwitchAccountsActive = int64(v)
+				v, err := d.Str()
+				s.Username = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitch_accounts_active\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
-		case "twitch_accounts_all":
+		case "concurrent_channels":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Int64()
-				s.TwitchAccountsAll = int64(v)
+				v, err := d.Int()
+				s.ConcurrentChannels = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitch_accounts_all\"")
+				return errors.Wrap(err, "decode field \"concurrent_channels\"")
 			}
-		case "rules":
+		case "present_seconds":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
 				v, err := d.Int64()
-				s.Rules = int64(v)
+				s.PresentSeconds = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rules\"")
+				return errors.Wrap(err, "decode field \"present_seconds\"")
 			}
-		case "notification_entries":
+		case "message_count":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := d.Int64()
-				s.NotificationEntries = int64(v)
+				s.MessageCount = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"notification_entries\"")
+				return errors.Wrap(err, "decode field \"message_count\"")
 			}
-		case "streams":
+		case "first_detected_at":
 			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				v, err := d.Int64()
-				s.Streams = int64(v)
+				v, err := json.DecodeDateTime(d)
+				s.FirstDetectedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"streams\"")
+				return errors.Wrap(err, "decode field \"first_detected_at\"")
 			}
-		case "streams_open":
+		case "last_detected_at":
 			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				v, err := d.Int64()
-				s.StreamsOpen = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"streams_open\"")
-			}
-		case "chat_messages":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				v, err := d.Int64()
-				s.ChatMessages = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"chat_messages\"")
-			}
-		case "channel_chatters":
-			requiredBitSet[1] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int64()
-				s.ChannelChatters = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_chatters\"")
-			}
-		case "user_activity_events":
-			requiredBitSet[1] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int64()
-				s.UserActivityEvents = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_activity_events\"")
-			}
-		case "twitch_user_helix_meta":
-			requiredBitSet[1] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int64()
-				s.TwitchUserHelixMeta = int64(v)
+				v, err := json.DecodeDateTime(d)
+				s.LastDetectedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitch_user_helix_meta\"")
+				return errors.Wrap(err, "decode field \"last_detected_at\"")
 			}
-		case "twitch_user_channel_follows":
-			requiredBitSet[1] |= 1 << 3
-			if err := func() error {
-				v, err := d.Int64()
-				s.TwitchUserChannelFollows = int64(v)
-				if err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LikelyBot")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLikelyBot) {
+					name = jsonFieldsNameOfLikelyBot[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitch_user_channel_follows\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "user_followed_channels":
-			requiredBitSet[1] |= 1 << 4
-			if err := func() error {
-				v, err := d.Int64()
-				s.UserFollowedChannels = int64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_followed_channels\"")
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LikelyBot) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LikelyBot) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListAiMessagesOKApplicationJSON as json.
+func (s ListAiMessagesOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []AiMessage(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListAiMessagesOKApplicationJSON from json.
+func (s *ListAiMessagesOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListAiMessagesOKApplicationJSON to nil")
+	}
+	var unwrapped []AiMessage
+	if err := func() error {
+		unwrapped = make([]AiMessage, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem AiMessage
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		case "channel_blacklist":
-			requiredBitSet[1] |= 1 << 5
-			if err := func() error {
-				v, err := d.Int64()
-				s.ChannelBlacklist = int64(v)
-				if err != nil {
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListAiMessagesOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListAiMessagesOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListAiMessagesOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListChannelChattersOKApplicationJSON as json.
+func (s ListChannelChattersOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []ChannelChatterEntry(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListChannelChattersOKApplicationJSON from json.
+func (s *ListChannelChattersOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListChannelChattersOKApplicationJSON to nil")
+	}
+	var unwrapped []ChannelChatterEntry
+	if err := func() error {
+		unwrapped = make([]ChannelChatterEntry, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem ChannelChatterEntry
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListChannelChattersOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListChannelChattersOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListChannelChattersOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListChannelChattersRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListChannelChattersRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("account_id")
+		e.Int64(s.AccountID)
+	}
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
+	}
+	{
+		if s.SessionStartedAt.Set {
+			e.FieldStart("session_started_at")
+			s.SessionStartedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfListChannelChattersRequest = [3]string{
+	0: "account_id",
+	1: "login",
+	2: "session_started_at",
+}
+
+// Decode decodes ListChannelChattersRequest from json.
+func (s *ListChannelChattersRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListChannelChattersRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "account_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.AccountID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"account_id\"")
+			}
+		case "login":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login\"")
+			}
+		case "session_started_at":
+			if err := func() error {
+				s.SessionStartedAt.Reset()
+				if err := s.SessionStartedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"session_started_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListChannelChattersRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListChannelChattersRequest) {
+					name = jsonFieldsNameOfListChannelChattersRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListChannelChattersRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListChannelChattersRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListChatHistoryOKApplicationJSON as json.
+func (s ListChatHistoryOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []ChatHistoryEntry(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListChatHistoryOKApplicationJSON from json.
+func (s *ListChatHistoryOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListChatHistoryOKApplicationJSON to nil")
+	}
+	var unwrapped []ChatHistoryEntry
+	if err := func() error {
+		unwrapped = make([]ChatHistoryEntry, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem ChatHistoryEntry
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListChatHistoryOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListChatHistoryOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListChatHistoryOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListRecordedStreamActivityOKApplicationJSON as json.
+func (s ListRecordedStreamActivityOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []UserActivityEvent(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListRecordedStreamActivityOKApplicationJSON from json.
+func (s *ListRecordedStreamActivityOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListRecordedStreamActivityOKApplicationJSON to nil")
+	}
+	var unwrapped []UserActivityEvent
+	if err := func() error {
+		unwrapped = make([]UserActivityEvent, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem UserActivityEvent
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListRecordedStreamActivityOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListRecordedStreamActivityOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListRecordedStreamActivityOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListRecordedStreamMessagesOKApplicationJSON as json.
+func (s ListRecordedStreamMessagesOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []ChatHistoryEntry(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListRecordedStreamMessagesOKApplicationJSON from json.
+func (s *ListRecordedStreamMessagesOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListRecordedStreamMessagesOKApplicationJSON to nil")
+	}
+	var unwrapped []ChatHistoryEntry
+	if err := func() error {
+		unwrapped = make([]ChatHistoryEntry, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem ChatHistoryEntry
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListRecordedStreamMessagesOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListRecordedStreamMessagesOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListRecordedStreamMessagesOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListTwitchUserActivityOKApplicationJSON as json.
+func (s ListTwitchUserActivityOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []UserActivityEvent(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListTwitchUserActivityOKApplicationJSON from json.
+func (s *ListTwitchUserActivityOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListTwitchUserActivityOKApplicationJSON to nil")
+	}
+	var unwrapped []UserActivityEvent
+	if err := func() error {
+		unwrapped = make([]UserActivityEvent, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem UserActivityEvent
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListTwitchUserActivityOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListTwitchUserActivityOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListTwitchUserActivityOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListTwitchUserActivityRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListTwitchUserActivityRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		if s.Limit.Set {
+			e.FieldStart("limit")
+			s.Limit.Encode(e)
+		}
+	}
+	{
+		if s.CursorCreatedAt.Set {
+			e.FieldStart("cursor_created_at")
+			s.CursorCreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.CursorID.Set {
+			e.FieldStart("cursor_id")
+			s.CursorID.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfListTwitchUserActivityRequest = [4]string{
+	0: "id",
+	1: "limit",
+	2: "cursor_created_at",
+	3: "cursor_id",
+}
+
+// Decode decodes ListTwitchUserActivityRequest from json.
+func (s *ListTwitchUserActivityRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListTwitchUserActivityRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "limit":
+			if err := func() error {
+				s.Limit.Reset()
+				if err := s.Limit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"limit\"")
+			}
+		case "cursor_created_at":
+			if err := func() error {
+				s.CursorCreatedAt.Reset()
+				if err := s.CursorCreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cursor_created_at\"")
+			}
+		case "cursor_id":
+			if err := func() error {
+				s.CursorID.Reset()
+				if err := s.CursorID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cursor_id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListTwitchUserActivityRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListTwitchUserActivityRequest) {
+					name = jsonFieldsNameOfListTwitchUserActivityRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListTwitchUserActivityRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListTwitchUserActivityRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginPattern) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginPattern) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("kind")
+		s.Kind.Encode(e)
+	}
+	{
+		e.FieldStart("pattern")
+		e.Str(s.Pattern)
+	}
+	{
+		e.FieldStart("description")
+		e.Str(s.Description)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfLoginPattern = [5]string{
+	0: "id",
+	1: "kind",
+	2: "pattern",
+	3: "description",
+	4: "created_at",
+}
+
+// Decode decodes LoginPattern from json.
+func (s *LoginPattern) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginPattern to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "kind":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "pattern":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Pattern = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pattern\"")
+			}
+		case "description":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Description = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginPattern")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginPattern) {
+					name = jsonFieldsNameOfLoginPattern[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginPattern) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginPattern) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LoginPatternKind as json.
+func (s LoginPatternKind) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes LoginPatternKind from json.
+func (s *LoginPatternKind) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginPatternKind to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch LoginPatternKind(v) {
+	case LoginPatternKindRegex:
+		*s = LoginPatternKindRegex
+	case LoginPatternKindGlob:
+		*s = LoginPatternKindGlob
+	default:
+		*s = LoginPatternKind(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s LoginPatternKind) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginPatternKind) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginPatternTestResult) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginPatternTestResult) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
+	}
+	{
+		e.FieldStart("skeleton")
+		e.Str(s.Skeleton)
+	}
+	{
+		e.FieldStart("glob_skeleton")
+		e.Str(s.GlobSkeleton)
+	}
+	{
+		e.FieldStart("matches")
+		e.Bool(s.Matches)
+	}
+}
+
+var jsonFieldsNameOfLoginPatternTestResult = [4]string{
+	0: "login",
+	1: "skeleton",
+	2: "glob_skeleton",
+	3: "matches",
+}
+
+// Decode decodes LoginPatternTestResult from json.
+func (s *LoginPatternTestResult) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginPatternTestResult to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "login":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login\"")
+			}
+		case "skeleton":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Skeleton = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"skeleton\"")
+			}
+		case "glob_skeleton":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.GlobSkeleton = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"glob_skeleton\"")
+			}
+		case "matches":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.Matches = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"matches\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginPatternTestResult")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginPatternTestResult) {
+					name = jsonFieldsNameOfLoginPatternTestResult[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginPatternTestResult) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginPatternTestResult) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+}
+
+var jsonFieldsNameOfLoginRequest = [2]string{
+	0: "email",
+	1: "password",
+}
+
+// Decode decodes LoginRequest from json.
+func (s *LoginRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "email":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginRequest) {
+					name = jsonFieldsNameOfLoginRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("token")
+		e.Str(s.Token)
+	}
+}
+
+var jsonFieldsNameOfLoginResponse = [1]string{
+	0: "token",
+}
+
+// Decode decodes LoginResponse from json.
+func (s *LoginResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Token = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"token\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginResponse) {
+					name = jsonFieldsNameOfLoginResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o NilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *NilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilDateTime to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v time.Time
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes float64 as json.
+func (o NilFloat64) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Float64(float64(o.Value))
+}
+
+// Decode decodes float64 from json.
+func (o *NilFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilFloat64 to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v float64
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	v, err := d.Float64()
+	if err != nil {
+		return err
+	}
+	o.Value = float64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilFloat64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilFloat64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int64 as json.
+func (o NilInt64) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Int64(int64(o.Value))
+}
+
+// Decode decodes int64 from json.
+func (o *NilInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilInt64 to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v int64
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilInt64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilInt64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o NilString) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *NilString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilString to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v string
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationDelivery) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationDelivery) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("event_id")
+		e.Str(s.EventID)
+	}
+	{
+		e.FieldStart("notification_id")
+		e.Int64(s.NotificationID)
+	}
+	{
+		e.FieldStart("event_type")
+		e.Str(s.EventType)
+	}
+	{
+		e.FieldStart("channel")
+		e.Str(s.Channel)
+	}
+	{
+		e.FieldStart("text")
+		e.Str(s.Text)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		e.FieldStart("attempts")
+		e.Int(s.Attempts)
+	}
+	{
+		e.FieldStart("next_attempt_at")
+		json.EncodeDateTime(e, s.NextAttemptAt)
+	}
+	{
+		if s.LastStatusCode.Set {
+			e.FieldStart("last_status_code")
+			s.LastStatusCode.Encode(e)
+		}
+	}
+	{
+		if s.LastError.Set {
+			e.FieldStart("last_error")
+			s.LastError.Encode(e)
+		}
+	}
+	{
+		if s.DeliveredAt.Set {
+			e.FieldStart("delivered_at")
+			s.DeliveredAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+	{
+		e.FieldStart("attempt_log")
+		e.ArrStart()
+		for _, elem := range s.AttemptLog {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfNotificationDelivery = [15]string{
+	0:  "id",
+	1:  "event_id",
+	2:  "notification_id",
+	3:  "event_type",
+	4:  "channel",
+	5:  "text",
+	6:  "status",
+	7:  "attempts",
+	8:  "next_attempt_at",
+	9:  "last_status_code",
+	10: "last_error",
+	11: "delivered_at",
+	12: "created_at",
+	13: "updated_at",
+	14: "attempt_log",
+}
+
+// Decode decodes NotificationDelivery from json.
+func (s *NotificationDelivery) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationDelivery to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "event_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.EventID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_id\"")
+			}
+		case "notification_id":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.NotificationID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notification_id\"")
+			}
+		case "event_type":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.EventType = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_type\"")
+			}
+		case "channel":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Channel = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel\"")
+			}
+		case "text":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.Text = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"text\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "attempts":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int()
+				s.Attempts = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attempts\"")
+			}
+		case "next_attempt_at":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.NextAttemptAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next_attempt_at\"")
+			}
+		case "last_status_code":
+			if err := func() error {
+				s.LastStatusCode.Reset()
+				if err := s.LastStatusCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_status_code\"")
+			}
+		case "last_error":
+			if err := func() error {
+				s.LastError.Reset()
+				if err := s.LastError.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_error\"")
+			}
+		case "delivered_at":
+			if err := func() error {
+				s.DeliveredAt.Reset()
+				if err := s.DeliveredAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"delivered_at\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		case "attempt_log":
+			requiredBitSet[1] |= 1 << 6
+			if err := func() error {
+				s.AttemptLog = make([]NotificationDeliveryAttempt, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NotificationDeliveryAttempt
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.AttemptLog = append(s.AttemptLog, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attempt_log\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationDelivery")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b01110001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotificationDelivery) {
+					name = jsonFieldsNameOfNotificationDelivery[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationDelivery) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationDelivery) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationDeliveryAttempt) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationDeliveryAttempt) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("attempted_at")
+		json.EncodeDateTime(e, s.AttemptedAt)
+	}
+	{
+		if s.StatusCode.Set {
+			e.FieldStart("status_code")
+			s.StatusCode.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("response_snippet")
+		e.Str(s.ResponseSnippet)
+	}
+	{
+		e.FieldStart("error")
+		e.Str(s.Error)
+	}
+	{
+		e.FieldStart("duration_ms")
+		e.Int64(s.DurationMs)
+	}
+}
+
+var jsonFieldsNameOfNotificationDeliveryAttempt = [6]string{
+	0: "id",
+	1: "attempted_at",
+	2: "status_code",
+	3: "response_snippet",
+	4: "error",
+	5: "duration_ms",
+}
+
+// Decode decodes NotificationDeliveryAttempt from json.
+func (s *NotificationDeliveryAttempt) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationDeliveryAttempt to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "attempted_at":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.AttemptedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attempted_at\"")
+			}
+		case "status_code":
+			if err := func() error {
+				s.StatusCode.Reset()
+				if err := s.StatusCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status_code\"")
+			}
+		case "response_snippet":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.ResponseSnippet = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"response_snippet\"")
+			}
+		case "error":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Error = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "duration_ms":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int64()
+				s.DurationMs = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_ms\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationDeliveryAttempt")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotificationDeliveryAttempt) {
+					name = jsonFieldsNameOfNotificationDeliveryAttempt[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationDeliveryAttempt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationDeliveryAttempt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NotificationDeliveryStatus as json.
+func (s NotificationDeliveryStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NotificationDeliveryStatus from json.
+func (s *NotificationDeliveryStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationDeliveryStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NotificationDeliveryStatus(v) {
+	case NotificationDeliveryStatusPending:
+		*s = NotificationDeliveryStatusPending
+	case NotificationDeliveryStatusSending:
+		*s = NotificationDeliveryStatusSending
+	case NotificationDeliveryStatusDelivered:
+		*s = NotificationDeliveryStatusDelivered
+	case NotificationDeliveryStatusDead:
+		*s = NotificationDeliveryStatusDead
+	case NotificationDeliveryStatusSuppressed:
+		*s = NotificationDeliveryStatusSuppressed
+	default:
+		*s = NotificationDeliveryStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NotificationDeliveryStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationDeliveryStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationEntry) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationEntry) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		e.FieldStart("settings")
+		s.Settings.Encode(e)
+	}
+	{
+		e.FieldStart("enabled")
+		e.Bool(s.Enabled)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, elem := range s.Tags {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("event_types")
+		e.ArrStart()
+		for _, elem := range s.EventTypes {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("channels")
+		e.ArrStart()
+		for _, elem := range s.Channels {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("policy")
+		s.Policy.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfNotificationEntry = [9]string{
+	0: "id",
+	1: "provider",
+	2: "settings",
+	3: "enabled",
+	4: "created_at",
+	5: "tags",
+	6: "event_types",
+	7: "channels",
+	8: "policy",
+}
+
+// Decode decodes NotificationEntry from json.
+func (s *NotificationEntry) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationEntry to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "settings":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Settings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"settings\"")
+			}
+		case "enabled":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.Enabled = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "tags":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.Tags = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Tags = append(s.Tags, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "event_types":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				s.EventTypes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.EventTypes = append(s.EventTypes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_types\"")
+			}
+		case "channels":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				s.Channels = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Channels = append(s.Channels, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channels\"")
+			}
+		case "policy":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				if err := s.Policy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"policy\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationEntry")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotificationEntry) {
+					name = jsonFieldsNameOfNotificationEntry[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationEntry) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationEntry) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NotificationEntryProvider as json.
+func (s NotificationEntryProvider) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NotificationEntryProvider from json.
+func (s *NotificationEntryProvider) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationEntryProvider to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NotificationEntryProvider(v) {
+	case NotificationEntryProviderTelegram:
+		*s = NotificationEntryProviderTelegram
+	case NotificationEntryProviderWebhook:
+		*s = NotificationEntryProviderWebhook
+	case NotificationEntryProviderDiscord:
+		*s = NotificationEntryProviderDiscord
+	case NotificationEntryProviderMatrix:
+		*s = NotificationEntryProviderMatrix
+	case NotificationEntryProviderNtfy:
+		*s = NotificationEntryProviderNtfy
+	case NotificationEntryProviderEmail:
+		*s = NotificationEntryProviderEmail
+	default:
+		*s = NotificationEntryProvider(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NotificationEntryProvider) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationEntryProvider) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s NotificationEntrySettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s NotificationEntrySettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes NotificationEntrySettings from json.
+func (s *NotificationEntrySettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationEntrySettings to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationEntrySettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NotificationEntrySettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationEntrySettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationPolicy) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationPolicy) encodeFields(e *jx.Encoder) {
+	{
+		if s.QuietHours.Set {
+			e.FieldStart("quiet_hours")
+			s.QuietHours.Encode(e)
+		}
+	}
+	{
+		if s.FloodThreshold.Set {
+			e.FieldStart("flood_threshold")
+			s.FloodThreshold.Encode(e)
+		}
+	}
+	{
+		if s.FloodWindowSeconds.Set {
+			e.FieldStart("flood_window_seconds")
+			s.FloodWindowSeconds.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNotificationPolicy = [3]string{
+	0: "quiet_hours",
+	1: "flood_threshold",
+	2: "flood_window_seconds",
+}
+
+// Decode decodes NotificationPolicy from json.
+func (s *NotificationPolicy) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationPolicy to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "quiet_hours":
+			if err := func() error {
+				s.QuietHours.Reset()
+				if err := s.QuietHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"quiet_hours\"")
+			}
+		case "flood_threshold":
+			if err := func() error {
+				s.FloodThreshold.Reset()
+				if err := s.FloodThreshold.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flood_threshold\"")
+			}
+		case "flood_window_seconds":
+			if err := func() error {
+				s.FloodWindowSeconds.Reset()
+				if err := s.FloodWindowSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flood_window_seconds\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationPolicy")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationPolicy) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationPolicy) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationQuietHours) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationQuietHours) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("start")
+		e.Str(s.Start)
+	}
+	{
+		e.FieldStart("end")
+		e.Str(s.End)
+	}
+	{
+		if s.Timezone.Set {
+			e.FieldStart("timezone")
+			s.Timezone.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNotificationQuietHours = [3]string{
+	0: "start",
+	1: "end",
+	2: "timezone",
+}
+
+// Decode decodes NotificationQuietHours from json.
+func (s *NotificationQuietHours) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationQuietHours to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "start":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Start = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start\"")
+			}
+		case "end":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.End = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"end\"")
+			}
+		case "timezone":
+			if err := func() error {
+				s.Timezone.Reset()
+				if err := s.Timezone.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timezone\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationQuietHours")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotificationQuietHours) {
+					name = jsonFieldsNameOfNotificationQuietHours[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationQuietHours) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationQuietHours) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationSnooze) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationSnooze) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		if s.NotificationID.Set {
+			e.FieldStart("notification_id")
+			s.NotificationID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("channel")
+		e.Str(s.Channel)
+	}
+	{
+		e.FieldStart("until")
+		json.EncodeDateTime(e, s.Until)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfNotificationSnooze = [5]string{
+	0: "id",
+	1: "notification_id",
+	2: "channel",
+	3: "until",
+	4: "created_at",
+}
+
+// Decode decodes NotificationSnooze from json.
+func (s *NotificationSnooze) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationSnooze to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "notification_id":
+			if err := func() error {
+				s.NotificationID.Reset()
+				if err := s.NotificationID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notification_id\"")
+			}
+		case "channel":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Channel = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel\"")
+			}
+		case "until":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Until = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"until\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationSnooze")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotificationSnooze) {
+					name = jsonFieldsNameOfNotificationSnooze[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationSnooze) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationSnooze) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationTestResult) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationTestResult) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("event_type")
+		e.Str(s.EventType)
+	}
+	{
+		e.FieldStart("text")
+		e.Str(s.Text)
+	}
+	{
+		e.FieldStart("delivered")
+		e.Bool(s.Delivered)
+	}
+	{
+		if s.StatusCode.Set {
+			e.FieldStart("status_code")
+			s.StatusCode.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("response_snippet")
+		e.Str(s.ResponseSnippet)
+	}
+	{
+		e.FieldStart("error")
+		e.Str(s.Error)
+	}
+	{
+		e.FieldStart("duration_ms")
+		e.Int64(s.DurationMs)
+	}
+}
+
+var jsonFieldsNameOfNotificationTestResult = [7]string{
+	0: "event_type",
+	1: "text",
+	2: "delivered",
+	3: "status_code",
+	4: "response_snippet",
+	5: "error",
+	6: "duration_ms",
+}
+
+// Decode decodes NotificationTestResult from json.
+func (s *NotificationTestResult) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationTestResult to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "event_type":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.EventType = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_type\"")
+			}
+		case "text":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Text = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"text\"")
+			}
+		case "delivered":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.Delivered = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"delivered\"")
+			}
+		case "status_code":
+			if err := func() error {
+				s.StatusCode.Reset()
+				if err := s.StatusCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status_code\"")
+			}
+		case "response_snippet":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.ResponseSnippet = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"response_snippet\"")
+			}
+		case "error":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.Error = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "duration_ms":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int64()
+				s.DurationMs = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_ms\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationTestResult")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01110111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotificationTestResult) {
+					name = jsonFieldsNameOfNotificationTestResult[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationTestResult) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationTestResult) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ChatToxicity as json.
+func (o OptChatToxicity) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ChatToxicity from json.
+func (o *OptChatToxicity) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptChatToxicity to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptChatToxicity) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptChatToxicity) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ClientNoticeDetails as json.
+func (o OptClientNoticeDetails) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ClientNoticeDetails from json.
+func (o *OptClientNoticeDetails) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptClientNoticeDetails to nil")
+	}
+	o.Set = true
+	o.Value = make(ClientNoticeDetails)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptClientNoticeDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptClientNoticeDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateAiConversationRequest as json.
+func (o OptCreateAiConversationRequest) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CreateAiConversationRequest from json.
+func (o *OptCreateAiConversationRequest) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCreateAiConversationRequest to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCreateAiConversationRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCreateAiConversationRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateTwitchAccountRequestAccountType as json.
+func (o OptCreateTwitchAccountRequestAccountType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CreateTwitchAccountRequestAccountType from json.
+func (o *OptCreateTwitchAccountRequestAccountType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCreateTwitchAccountRequestAccountType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCreateTwitchAccountRequestAccountType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCreateTwitchAccountRequestAccountType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int64 as json.
+func (o OptInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int64(int64(o.Value))
+}
+
+// Decode decodes int64 from json.
+func (o *OptInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt64 to nil")
+	}
+	o.Set = true
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ChannelLive as json.
+func (o OptNilChannelLive) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ChannelLive from json.
+func (o *OptNilChannelLive) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilChannelLive to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v ChannelLive
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilChannelLive) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilChannelLive) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptNilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptNilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilDateTime to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v time.Time
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes int as json.
+func (o OptNilInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptNilInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilInt to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v int
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int64 as json.
+func (o OptNilInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Int64(int64(o.Value))
+}
+
+// Decode decodes int64 from json.
+func (o *OptNilInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilInt64 to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v int64
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilInt64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilInt64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NotificationQuietHours as json.
+func (o OptNilNotificationQuietHours) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes NotificationQuietHours from json.
+func (o *OptNilNotificationQuietHours) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilNotificationQuietHours to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v NotificationQuietHours
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilNotificationQuietHours) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilNotificationQuietHours) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptNilString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptNilString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilString to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v string
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SuspicionScore as json.
+func (o OptNilSuspicionScore) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes SuspicionScore from json.
+func (o *OptNilSuspicionScore) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilSuspicionScore to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v SuspicionScore
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilSuspicionScore) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilSuspicionScore) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UserActivityEventDetails as json.
+func (o OptNilUserActivityEventDetails) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes UserActivityEventDetails from json.
+func (o *OptNilUserActivityEventDetails) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilUserActivityEventDetails to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v UserActivityEventDetails
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	o.Value = make(UserActivityEventDetails)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilUserActivityEventDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilUserActivityEventDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NotificationPolicy as json.
+func (o OptNotificationPolicy) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes NotificationPolicy from json.
+func (o *OptNotificationPolicy) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNotificationPolicy to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNotificationPolicy) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNotificationPolicy) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StartSuspicionReevaluationRequest as json.
+func (o OptStartSuspicionReevaluationRequest) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes StartSuspicionReevaluationRequest from json.
+func (o *OptStartSuspicionReevaluationRequest) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStartSuspicionReevaluationRequest to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptStartSuspicionReevaluationRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptStartSuspicionReevaluationRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StartTwitchOAuthRequest as json.
+func (o OptStartTwitchOAuthRequest) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes StartTwitchOAuthRequest from json.
+func (o *OptStartTwitchOAuthRequest) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStartTwitchOAuthRequest to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptStartTwitchOAuthRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptStartTwitchOAuthRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StreamRecap as json.
+func (o OptStreamRecap) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes StreamRecap from json.
+func (o *OptStreamRecap) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStreamRecap to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptStreamRecap) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptStreamRecap) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SuspicionEventEvidence as json.
+func (o OptSuspicionEventEvidence) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes SuspicionEventEvidence from json.
+func (o *OptSuspicionEventEvidence) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptSuspicionEventEvidence to nil")
+	}
+	o.Set = true
+	o.Value = make(SuspicionEventEvidence)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptSuspicionEventEvidence) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptSuspicionEventEvidence) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SuspicionWeights as json.
+func (o OptSuspicionWeights) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes SuspicionWeights from json.
+func (o *OptSuspicionWeights) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptSuspicionWeights to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptSuspicionWeights) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptSuspicionWeights) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TestNotificationRequest as json.
+func (o OptTestNotificationRequest) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes TestNotificationRequest from json.
+func (o *OptTestNotificationRequest) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTestNotificationRequest to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTestNotificationRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTestNotificationRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateNotificationPostRequestProvider as json.
+func (o OptUpdateNotificationPostRequestProvider) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes UpdateNotificationPostRequestProvider from json.
+func (o *OptUpdateNotificationPostRequestProvider) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUpdateNotificationPostRequestProvider to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUpdateNotificationPostRequestProvider) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUpdateNotificationPostRequestProvider) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateNotificationPostRequestSettings as json.
+func (o OptUpdateNotificationPostRequestSettings) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes UpdateNotificationPostRequestSettings from json.
+func (o *OptUpdateNotificationPostRequestSettings) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUpdateNotificationPostRequestSettings to nil")
+	}
+	o.Set = true
+	o.Value = make(UpdateNotificationPostRequestSettings)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUpdateNotificationPostRequestSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUpdateNotificationPostRequestSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PatchAiSettingsRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PatchAiSettingsRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.BaseURL.Set {
+			e.FieldStart("base_url")
+			s.BaseURL.Encode(e)
+		}
+	}
+	{
+		if s.Model.Set {
+			e.FieldStart("model")
+			s.Model.Encode(e)
+		}
+	}
+	{
+		if s.APIToken.Set {
+			e.FieldStart("api_token")
+			s.APIToken.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPatchAiSettingsRequest = [3]string{
+	0: "base_url",
+	1: "model",
+	2: "api_token",
+}
+
+// Decode decodes PatchAiSettingsRequest from json.
+func (s *PatchAiSettingsRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchAiSettingsRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "base_url":
+			if err := func() error {
+				s.BaseURL.Reset()
+				if err := s.BaseURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"base_url\"")
+			}
+		case "model":
+			if err := func() error {
+				s.Model.Reset()
+				if err := s.Model.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"model\"")
+			}
+		case "api_token":
+			if err := func() error {
+				s.APIToken.Reset()
+				if err := s.APIToken.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"api_token\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchAiSettingsRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PatchAiSettingsRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PatchAiSettingsRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PreviewRuleNotifyRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PreviewRuleNotifyRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("event_type")
+		s.EventType.Encode(e)
+	}
+	{
+		e.FieldStart("action_settings")
+		s.ActionSettings.Encode(e)
+	}
+	{
+		if s.RuleID.Set {
+			e.FieldStart("rule_id")
+			s.RuleID.Encode(e)
+		}
+	}
+	{
+		if s.Channel.Set {
+			e.FieldStart("channel")
+			s.Channel.Encode(e)
+		}
+	}
+	{
+		if s.Username.Set {
+			e.FieldStart("username")
+			s.Username.Encode(e)
+		}
+	}
+	{
+		if s.Text.Set {
+			e.FieldStart("text")
+			s.Text.Encode(e)
+		}
+	}
+	{
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPreviewRuleNotifyRequest = [7]string{
+	0: "event_type",
+	1: "action_settings",
+	2: "rule_id",
+	3: "channel",
+	4: "username",
+	5: "text",
+	6: "title",
+}
+
+// Decode decodes PreviewRuleNotifyRequest from json.
+func (s *PreviewRuleNotifyRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PreviewRuleNotifyRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "event_type":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.EventType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_type\"")
+			}
+		case "action_settings":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.ActionSettings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action_settings\"")
+			}
+		case "rule_id":
+			if err := func() error {
+				s.RuleID.Reset()
+				if err := s.RuleID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rule_id\"")
+			}
+		case "channel":
+			if err := func() error {
+				s.Channel.Reset()
+				if err := s.Channel.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel\"")
+			}
+		case "username":
+			if err := func() error {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "text":
+			if err := func() error {
+				s.Text.Reset()
+				if err := s.Text.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"text\"")
+			}
+		case "title":
+			if err := func() error {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PreviewRuleNotifyRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPreviewRuleNotifyRequest) {
+					name = jsonFieldsNameOfPreviewRuleNotifyRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PreviewRuleNotifyRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PreviewRuleNotifyRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s PreviewRuleNotifyRequestActionSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s PreviewRuleNotifyRequestActionSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes PreviewRuleNotifyRequestActionSettings from json.
+func (s *PreviewRuleNotifyRequestActionSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PreviewRuleNotifyRequestActionSettings to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PreviewRuleNotifyRequestActionSettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s PreviewRuleNotifyRequestActionSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PreviewRuleNotifyRequestActionSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PreviewRuleNotifyResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PreviewRuleNotifyResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("text")
+		e.Str(s.Text)
+	}
+}
+
+var jsonFieldsNameOfPreviewRuleNotifyResponse = [1]string{
+	0: "text",
+}
+
+// Decode decodes PreviewRuleNotifyResponse from json.
+func (s *PreviewRuleNotifyResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PreviewRuleNotifyResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "text":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Text = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"text\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PreviewRuleNotifyResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPreviewRuleNotifyResponse) {
+					name = jsonFieldsNameOfPreviewRuleNotifyResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PreviewRuleNotifyResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PreviewRuleNotifyResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RecordedStream) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RecordedStream) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("channel_id")
+		e.Int64(s.ChannelID)
+	}
+	{
+		e.FieldStart("channel_login")
+		e.Str(s.ChannelLogin)
+	}
+	{
+		e.FieldStart("helix_stream_id")
+		e.Str(s.HelixStreamID)
+	}
+	{
+		e.FieldStart("started_at")
+		json.EncodeDateTime(e, s.StartedAt)
+	}
+	{
+		if s.EndedAt.Set {
+			e.FieldStart("ended_at")
+			s.EndedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
+		}
+	}
+	{
+		if s.GameName.Set {
+			e.FieldStart("game_name")
+			s.GameName.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.Recap.Set {
+			e.FieldStart("recap")
+			s.Recap.Encode(e)
+		}
+	}
+	{
+		if s.Moments != nil {
+			e.FieldStart("moments")
+			e.ArrStart()
+			for _, elem := range s.Moments {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.NewChatters.Set {
+			e.FieldStart("new_chatters")
+			s.NewChatters.Encode(e)
+		}
+	}
+	{
+		if s.ReturningChatters.Set {
+			e.FieldStart("returning_chatters")
+			s.ReturningChatters.Encode(e)
+		}
+	}
+	{
+		if s.ChatToxicity.Set {
+			e.FieldStart("chat_toxicity")
+			s.ChatToxicity.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRecordedStream = [14]string{
+	0:  "id",
+	1:  "channel_id",
+	2:  "channel_login",
+	3:  "helix_stream_id",
+	4:  "started_at",
+	5:  "ended_at",
+	6:  "title",
+	7:  "game_name",
+	8:  "created_at",
+	9:  "recap",
+	10: "moments",
+	11: "new_chatters",
+	12: "returning_chatters",
+	13: "chat_toxicity",
+}
+
+// Decode decodes RecordedStream from json.
+func (s *RecordedStream) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecordedStream to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "channel_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.ChannelID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel_id\"")
+			}
+		case "channel_login":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.ChannelLogin = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel_login\"")
+			}
+		case "helix_stream_id":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.HelixStreamID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"helix_stream_id\"")
+			}
+		case "started_at":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"started_at\"")
+			}
+		case "ended_at":
+			if err := func() error {
+				s.EndedAt.Reset()
+				if err := s.EndedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ended_at\"")
+			}
+		case "title":
+			if err := func() error {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "game_name":
+			if err := func() error {
+				s.GameName.Reset()
+				if err := s.GameName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"game_name\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "recap":
+			if err := func() error {
+				s.Recap.Reset()
+				if err := s.Recap.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recap\"")
+			}
+		case "moments":
+			if err := func() error {
+				s.Moments = make([]ChatMoment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ChatMoment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Moments = append(s.Moments, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moments\"")
+			}
+		case "new_chatters":
+			if err := func() error {
+				s.NewChatters.Reset()
+				if err := s.NewChatters.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new_chatters\"")
+			}
+		case "returning_chatters":
+			if err := func() error {
+				s.ReturningChatters.Reset()
+				if err := s.ReturningChatters.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"returning_chatters\"")
+			}
+		case "chat_toxicity":
+			if err := func() error {
+				s.ChatToxicity.Reset()
+				if err := s.ChatToxicity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chat_toxicity\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RecordedStream")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00011111,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRecordedStream) {
+					name = jsonFieldsNameOfRecordedStream[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RecordedStream) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RecordedStream) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ResendNotificationDeliveryRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ResendNotificationDeliveryRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+}
+
+var jsonFieldsNameOfResendNotificationDeliveryRequest = [1]string{
+	0: "id",
+}
+
+// Decode decodes ResendNotificationDeliveryRequest from json.
+func (s *ResendNotificationDeliveryRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ResendNotificationDeliveryRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ResendNotificationDeliveryRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfResendNotificationDeliveryRequest) {
+					name = jsonFieldsNameOfResendNotificationDeliveryRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ResendNotificationDeliveryRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ResendNotificationDeliveryRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Rule) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Rule) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("enabled")
+		e.Bool(s.Enabled)
+	}
+	{
+		e.FieldStart("event_type")
+		s.EventType.Encode(e)
+	}
+	{
+		e.FieldStart("event_settings")
+		s.EventSettings.Encode(e)
+	}
+	{
+		e.FieldStart("middlewares")
+		e.ArrStart()
+		for _, elem := range s.Middlewares {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("action_type")
+		s.ActionType.Encode(e)
+	}
+	{
+		e.FieldStart("action_settings")
+		s.ActionSettings.Encode(e)
+	}
+	{
+		e.FieldStart("use_shared_pool")
+		e.Bool(s.UseSharedPool)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfRule = [11]string{
+	0:  "id",
+	1:  "name",
+	2:  "enabled",
+	3:  "event_type",
+	4:  "event_settings",
+	5:  "middlewares",
+	6:  "action_type",
+	7:  "action_settings",
+	8:  "use_shared_pool",
+	9:  "created_at",
+	10: "updated_at",
+}
+
+// Decode decodes Rule from json.
+func (s *Rule) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Rule to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "enabled":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.Enabled = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "event_type":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.EventType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_type\"")
+			}
+		case "event_settings":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.EventSettings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_settings\"")
+			}
+		case "middlewares":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.Middlewares = make([]RuleMiddleware, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RuleMiddleware
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Middlewares = append(s.Middlewares, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"middlewares\"")
+			}
+		case "action_type":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.ActionType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action_type\"")
+			}
+		case "action_settings":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				if err := s.ActionSettings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action_settings\"")
+			}
+		case "use_shared_pool":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.UseSharedPool = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"use_shared_pool\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Rule")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRule) {
+					name = jsonFieldsNameOfRule[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Rule) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Rule) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s RuleActionSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s RuleActionSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes RuleActionSettings from json.
+func (s *RuleActionSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleActionSettings to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RuleActionSettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RuleActionSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleActionSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RuleActionType as json.
+func (s RuleActionType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes RuleActionType from json.
+func (s *RuleActionType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleActionType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch RuleActionType(v) {
+	case RuleActionTypeNotify:
+		*s = RuleActionTypeNotify
+	case RuleActionTypeSendChat:
+		*s = RuleActionTypeSendChat
+	case RuleActionTypeMarkSus:
+		*s = RuleActionTypeMarkSus
+	default:
+		*s = RuleActionType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RuleActionType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleActionType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s RuleEventSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s RuleEventSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes RuleEventSettings from json.
+func (s *RuleEventSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleEventSettings to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RuleEventSettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RuleEventSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleEventSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RuleEventType as json.
+func (s RuleEventType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes RuleEventType from json.
+func (s *RuleEventType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleEventType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch RuleEventType(v) {
+	case RuleEventTypeChatMessage:
+		*s = RuleEventTypeChatMessage
+	case RuleEventTypeStreamStart:
+		*s = RuleEventTypeStreamStart
+	case RuleEventTypeStreamEnd:
+		*s = RuleEventTypeStreamEnd
+	case RuleEventTypeInterval:
+		*s = RuleEventTypeInterval
+	default:
+		*s = RuleEventType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RuleEventType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleEventType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RuleMiddleware) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RuleMiddleware) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+	{
+		e.FieldStart("settings")
+		s.Settings.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfRuleMiddleware = [2]string{
+	0: "type",
+	1: "settings",
+}
+
+// Decode decodes RuleMiddleware from json.
+func (s *RuleMiddleware) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleMiddleware to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "type":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "settings":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Settings.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"settings\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RuleMiddleware")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRuleMiddleware) {
+					name = jsonFieldsNameOfRuleMiddleware[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RuleMiddleware) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleMiddleware) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s RuleMiddlewareSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s RuleMiddlewareSettings) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes RuleMiddlewareSettings from json.
+func (s *RuleMiddlewareSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleMiddlewareSettings to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RuleMiddlewareSettings")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RuleMiddlewareSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleMiddlewareSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RuleTemplateVariable) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RuleTemplateVariable) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("description")
+		e.Str(s.Description)
+	}
+}
+
+var jsonFieldsNameOfRuleTemplateVariable = [2]string{
+	0: "name",
+	1: "description",
+}
+
+// Decode decodes RuleTemplateVariable from json.
+func (s *RuleTemplateVariable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleTemplateVariable to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Description = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RuleTemplateVariable")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRuleTemplateVariable) {
+					name = jsonFieldsNameOfRuleTemplateVariable[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RuleTemplateVariable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleTemplateVariable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RuleTemplateVariablesResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RuleTemplateVariablesResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("variables")
+		e.ArrStart()
+		for _, elem := range s.Variables {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfRuleTemplateVariablesResponse = [1]string{
+	0: "variables",
+}
+
+// Decode decodes RuleTemplateVariablesResponse from json.
+func (s *RuleTemplateVariablesResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleTemplateVariablesResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "variables":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Variables = make([]RuleTemplateVariable, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RuleTemplateVariable
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Variables = append(s.Variables, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"variables\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RuleTemplateVariablesResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRuleTemplateVariablesResponse) {
+					name = jsonFieldsNameOfRuleTemplateVariablesResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RuleTemplateVariablesResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleTemplateVariablesResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RuleTrigger) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RuleTrigger) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.RuleID.Set {
+			e.FieldStart("rule_id")
+			s.RuleID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("rule_name")
+		e.Str(s.RuleName)
+	}
+	{
+		e.FieldStart("trigger_event")
+		e.Str(s.TriggerEvent)
+	}
+	{
+		e.FieldStart("action_type")
+		e.Str(s.ActionType)
+	}
+	{
+		e.FieldStart("display_text")
+		e.Str(s.DisplayText)
+	}
+}
+
+var jsonFieldsNameOfRuleTrigger = [7]string{
+	0: "id",
+	1: "created_at",
+	2: "rule_id",
+	3: "rule_name",
+	4: "trigger_event",
+	5: "action_type",
+	6: "display_text",
+}
+
+// Decode decodes RuleTrigger from json.
+func (s *RuleTrigger) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RuleTrigger to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "rule_id":
+			if err := func() error {
+				s.RuleID.Reset()
+				if err := s.RuleID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rule_id\"")
+			}
+		case "rule_name":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.RuleName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rule_name\"")
+			}
+		case "trigger_event":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.TriggerEvent = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"trigger_event\"")
+			}
+		case "action_type":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.ActionType = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action_type\"")
+			}
+		case "display_text":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Str()
+				s.DisplayText = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"display_text\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RuleTrigger")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRuleTrigger) {
+					name = jsonFieldsNameOfRuleTrigger[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RuleTrigger) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RuleTrigger) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SaveEmoteSetRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SaveEmoteSetRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("provider")
+		s.Provider.Encode(e)
+	}
+	{
+		if s.Channel.Set {
+			e.FieldStart("channel")
+			s.Channel.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("emotes")
+		e.ArrStart()
+		for _, elem := range s.Emotes {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfSaveEmoteSetRequest = [4]string{
+	0: "name",
+	1: "provider",
+	2: "channel",
+	3: "emotes",
+}
+
+// Decode decodes SaveEmoteSetRequest from json.
+func (s *SaveEmoteSetRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SaveEmoteSetRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "provider":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Provider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provider\"")
+			}
+		case "channel":
+			if err := func() error {
+				s.Channel.Reset()
+				if err := s.Channel.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel\"")
+			}
+		case "emotes":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Emotes = make([]EmoteSetEmote, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EmoteSetEmote
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Emotes = append(s.Emotes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"emotes\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SaveEmoteSetRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSaveEmoteSetRequest) {
+					name = jsonFieldsNameOfSaveEmoteSetRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SaveEmoteSetRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SaveEmoteSetRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ScanTwitchUserAltsOKApplicationJSON as json.
+func (s ScanTwitchUserAltsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []AltCandidate(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ScanTwitchUserAltsOKApplicationJSON from json.
+func (s *ScanTwitchUserAltsOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ScanTwitchUserAltsOKApplicationJSON to nil")
+	}
+	var unwrapped []AltCandidate
+	if err := func() error {
+		unwrapped = make([]AltCandidate, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem AltCandidate
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ScanTwitchUserAltsOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ScanTwitchUserAltsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ScanTwitchUserAltsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SendMessageBadGateway as json.
+func (s *SendMessageBadGateway) Encode(e *jx.Encoder) {
+	unwrapped := (*ClientNotice)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes SendMessageBadGateway from json.
+func (s *SendMessageBadGateway) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SendMessageBadGateway to nil")
+	}
+	var unwrapped ClientNotice
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = SendMessageBadGateway(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SendMessageBadGateway) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SendMessageBadGateway) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SendMessageBadRequest as json.
+func (s *SendMessageBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ClientNotice)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes SendMessageBadRequest from json.
+func (s *SendMessageBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SendMessageBadRequest to nil")
+	}
+	var unwrapped ClientNotice
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = SendMessageBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SendMessageBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SendMessageBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SendMessageRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SendMessageRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("account_id")
+		e.Int64(s.AccountID)
+	}
+	{
+		e.FieldStart("channel")
+		e.Str(s.Channel)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfSendMessageRequest = [3]string{
+	0: "account_id",
+	1: "channel",
+	2: "message",
+}
+
+// Decode decodes SendMessageRequest from json.
+func (s *SendMessageRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SendMessageRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "account_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.AccountID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"account_id\"")
+			}
+		case "channel":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Channel = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SendMessageRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSendMessageRequest) {
+					name = jsonFieldsNameOfSendMessageRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SendMessageRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SendMessageRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SendMessageUnauthorized as json.
+func (s *SendMessageUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ClientNotice)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes SendMessageUnauthorized from json.
+func (s *SendMessageUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SendMessageUnauthorized to nil")
+	}
+	var unwrapped ClientNotice
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = SendMessageUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SendMessageUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SendMessageUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SendMessageUnprocessableEntity as json.
+func (s *SendMessageUnprocessableEntity) Encode(e *jx.Encoder) {
+	unwrapped := (*ClientNotice)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes SendMessageUnprocessableEntity from json.
+func (s *SendMessageUnprocessableEntity) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SendMessageUnprocessableEntity to nil")
+	}
+	var unwrapped ClientNotice
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = SendMessageUnprocessableEntity(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SendMessageUnprocessableEntity) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SendMessageUnprocessableEntity) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SetTwitchUserLinkBadRequest as json.
+func (s *SetTwitchUserLinkBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes SetTwitchUserLinkBadRequest from json.
+func (s *SetTwitchUserLinkBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SetTwitchUserLinkBadRequest to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = SetTwitchUserLinkBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SetTwitchUserLinkBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SetTwitchUserLinkBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SetTwitchUserLinkNotFound as json.
+func (s *SetTwitchUserLinkNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes SetTwitchUserLinkNotFound from json.
+func (s *SetTwitchUserLinkNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SetTwitchUserLinkNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = SetTwitchUserLinkNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SetTwitchUserLinkNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SetTwitchUserLinkNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SetTwitchUserLinkRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SetTwitchUserLinkRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
+	}
+	{
+		e.FieldStart("linked_user_id")
+		e.Int64(s.LinkedUserID)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.Note.Set {
+			e.FieldStart("note")
+			s.Note.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfSetTwitchUserLinkRequest = [4]string{
+	0: "twitch_user_id",
+	1: "linked_user_id",
+	2: "status",
+	3: "note",
+}
+
+// Decode decodes SetTwitchUserLinkRequest from json.
+func (s *SetTwitchUserLinkRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SetTwitchUserLinkRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "twitch_user_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchUserID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
+			}
+		case "linked_user_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.LinkedUserID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"linked_user_id\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "note":
+			if err := func() error {
+				s.Note.Reset()
+				if err := s.Note.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"note\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SetTwitchUserLinkRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSetTwitchUserLinkRequest) {
+					name = jsonFieldsNameOfSetTwitchUserLinkRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SetTwitchUserLinkRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SetTwitchUserLinkRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StartSuspicionReevaluationRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StartSuspicionReevaluationRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.RefetchBudget.Set {
+			e.FieldStart("refetch_budget")
+			s.RefetchBudget.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStartSuspicionReevaluationRequest = [1]string{
+	0: "refetch_budget",
+}
+
+// Decode decodes StartSuspicionReevaluationRequest from json.
+func (s *StartSuspicionReevaluationRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StartSuspicionReevaluationRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "refetch_budget":
+			if err := func() error {
+				s.RefetchBudget.Reset()
+				if err := s.RefetchBudget.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refetch_budget\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StartSuspicionReevaluationRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StartSuspicionReevaluationRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StartSuspicionReevaluationRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StartTwitchOAuthRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StartTwitchOAuthRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.ReturnURL.Set {
+			e.FieldStart("return_url")
+			s.ReturnURL.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStartTwitchOAuthRequest = [1]string{
+	0: "return_url",
+}
+
+// Decode decodes StartTwitchOAuthRequest from json.
+func (s *StartTwitchOAuthRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StartTwitchOAuthRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "return_url":
+			if err := func() error {
+				s.ReturnURL.Reset()
+				if err := s.ReturnURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"return_url\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StartTwitchOAuthRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StartTwitchOAuthRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StartTwitchOAuthRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StartTwitchOAuthResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StartTwitchOAuthResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("authorize_url")
+		e.Str(s.AuthorizeURL)
+	}
+}
+
+var jsonFieldsNameOfStartTwitchOAuthResponse = [1]string{
+	0: "authorize_url",
+}
+
+// Decode decodes StartTwitchOAuthResponse from json.
+func (s *StartTwitchOAuthResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StartTwitchOAuthResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "authorize_url":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.AuthorizeURL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"authorize_url\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StartTwitchOAuthResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStartTwitchOAuthResponse) {
+					name = jsonFieldsNameOfStartTwitchOAuthResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StartTwitchOAuthResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StartTwitchOAuthResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamEmotes) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamEmotes) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("stream_id")
+		e.Int64(s.StreamID)
+	}
+	{
+		e.FieldStart("top")
+		e.ArrStart()
+		for _, elem := range s.Top {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("bucket_seconds")
+		e.Int(s.BucketSeconds)
+	}
+	{
+		e.FieldStart("timeline")
+		e.ArrStart()
+		for _, elem := range s.Timeline {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfStreamEmotes = [4]string{
+	0: "stream_id",
+	1: "top",
+	2: "bucket_seconds",
+	3: "timeline",
+}
+
+// Decode decodes StreamEmotes from json.
+func (s *StreamEmotes) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamEmotes to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "stream_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.StreamID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"stream_id\"")
+			}
+		case "top":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Top = make([]EmoteUsage, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EmoteUsage
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Top = append(s.Top, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"top\"")
+			}
+		case "bucket_seconds":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.BucketSeconds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bucket_seconds\"")
+			}
+		case "timeline":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Timeline = make([]EmoteTimelineBucket, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EmoteTimelineBucket
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Timeline = append(s.Timeline, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timeline\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamEmotes")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamEmotes) {
+					name = jsonFieldsNameOfStreamEmotes[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamEmotes) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamEmotes) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamLeaderboardEntry) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamLeaderboardEntry) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
+	}
+	{
+		e.FieldStart("user_twitch_id")
+		e.Int64(s.UserTwitchID)
+	}
+	{
+		e.FieldStart("presence_seconds")
+		e.Int64(s.PresenceSeconds)
+	}
+	{
+		e.FieldStart("message_count")
+		e.Int64(s.MessageCount)
+	}
+	{
+		if s.AccountCreatedAt.Set {
+			e.FieldStart("account_created_at")
+			s.AccountCreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfStreamLeaderboardEntry = [5]string{
+	0: "login",
+	1: "user_twitch_id",
+	2: "presence_seconds",
+	3: "message_count",
+	4: "account_created_at",
+}
+
+// Decode decodes StreamLeaderboardEntry from json.
+func (s *StreamLeaderboardEntry) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamLeaderboardEntry to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "login":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login\"")
+			}
+		case "user_twitch_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.UserTwitchID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_twitch_id\"")
+			}
+		case "presence_seconds":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.PresenceSeconds = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"presence_seconds\"")
+			}
+		case "message_count":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.MessageCount = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message_count\"")
+			}
+		case "account_created_at":
+			if err := func() error {
+				s.AccountCreatedAt.Reset()
+				if err := s.AccountCreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"account_created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamLeaderboardEntry")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamLeaderboardEntry) {
+					name = jsonFieldsNameOfStreamLeaderboardEntry[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamLeaderboardEntry) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamLeaderboardEntry) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamRecap) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamRecap) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("duration_seconds")
+		e.Int64(s.DurationSeconds)
+	}
+	{
+		if s.PeakViewers.Set {
+			e.FieldStart("peak_viewers")
+			s.PeakViewers.Encode(e)
+		}
+	}
+	{
+		if s.AverageViewers.Set {
+			e.FieldStart("average_viewers")
+			s.AverageViewers.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("messages")
+		e.Int64(s.Messages)
+	}
+	{
+		e.FieldStart("unique_chatters")
+		e.Int64(s.UniqueChatters)
+	}
+	{
+		e.FieldStart("new_chatters")
+		e.Int64(s.NewChatters)
+	}
+	{
+		e.FieldStart("top_chatters")
+		e.ArrStart()
+		for _, elem := range s.TopChatters {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("suspicious_users")
+		e.ArrStart()
+		for _, elem := range s.SuspiciousUsers {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("rule_triggers")
+		e.ArrStart()
+		for _, elem := range s.RuleTriggers {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("busiest_moments")
+		e.ArrStart()
+		for _, elem := range s.BusiestMoments {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.Summary.Set {
+			e.FieldStart("summary")
+			s.Summary.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfStreamRecap = [12]string{
+	0:  "duration_seconds",
+	1:  "peak_viewers",
+	2:  "average_viewers",
+	3:  "messages",
+	4:  "unique_chatters",
+	5:  "new_chatters",
+	6:  "top_chatters",
+	7:  "suspicious_users",
+	8:  "rule_triggers",
+	9:  "busiest_moments",
+	10: "summary",
+	11: "created_at",
+}
+
+// Decode decodes StreamRecap from json.
+func (s *StreamRecap) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamRecap to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "duration_seconds":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.DurationSeconds = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_seconds\"")
+			}
+		case "peak_viewers":
+			if err := func() error {
+				s.PeakViewers.Reset()
+				if err := s.PeakViewers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"peak_viewers\"")
+			}
+		case "average_viewers":
+			if err := func() error {
+				s.AverageViewers.Reset()
+				if err := s.AverageViewers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"average_viewers\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.Messages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		case "unique_chatters":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.UniqueChatters = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unique_chatters\"")
+			}
+		case "new_chatters":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int64()
+				s.NewChatters = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new_chatters\"")
+			}
+		case "top_chatters":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				s.TopChatters = make([]StreamRecapChatter, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StreamRecapChatter
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.TopChatters = append(s.TopChatters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"top_chatters\"")
+			}
+		case "suspicious_users":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				s.SuspiciousUsers = make([]StreamRecapUser, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StreamRecapUser
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.SuspiciousUsers = append(s.SuspiciousUsers, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"suspicious_users\"")
+			}
+		case "rule_triggers":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				s.RuleTriggers = make([]StreamRecapRule, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StreamRecapRule
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.RuleTriggers = append(s.RuleTriggers, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rule_triggers\"")
+			}
+		case "busiest_moments":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				s.BusiestMoments = make([]StreamRecapMoment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem StreamRecapMoment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.BusiestMoments = append(s.BusiestMoments, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"busiest_moments\"")
+			}
+		case "summary":
+			if err := func() error {
+				s.Summary.Reset()
+				if err := s.Summary.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"summary\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamRecap")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111001,
+		0b00001011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamRecap) {
+					name = jsonFieldsNameOfStreamRecap[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamRecap) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamRecap) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamRecapChatter) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamRecapChatter) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("user_twitch_id")
+		e.Int64(s.UserTwitchID)
+	}
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
+	}
+	{
+		e.FieldStart("message_count")
+		e.Int64(s.MessageCount)
+	}
+	{
+		e.FieldStart("presence_seconds")
+		e.Int64(s.PresenceSeconds)
+	}
+}
+
+var jsonFieldsNameOfStreamRecapChatter = [4]string{
+	0: "user_twitch_id",
+	1: "login",
+	2: "message_count",
+	3: "presence_seconds",
+}
+
+// Decode decodes StreamRecapChatter from json.
+func (s *StreamRecapChatter) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamRecapChatter to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "user_twitch_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.UserTwitchID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_twitch_id\"")
+			}
+		case "login":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login\"")
+			}
+		case "message_count":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.MessageCount = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message_count\"")
+			}
+		case "presence_seconds":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.PresenceSeconds = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"presence_seconds\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamRecapChatter")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamRecapChatter) {
+					name = jsonFieldsNameOfStreamRecapChatter[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamRecapChatter) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamRecapChatter) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamRecapMoment) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamRecapMoment) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("start")
+		json.EncodeDateTime(e, s.Start)
+	}
+	{
+		e.FieldStart("messages")
+		e.Int64(s.Messages)
+	}
+	{
+		e.FieldStart("chatters")
+		e.Int64(s.Chatters)
+	}
+}
+
+var jsonFieldsNameOfStreamRecapMoment = [3]string{
+	0: "start",
+	1: "messages",
+	2: "chatters",
+}
+
+// Decode decodes StreamRecapMoment from json.
+func (s *StreamRecapMoment) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamRecapMoment to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "start":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Start = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.Messages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		case "chatters":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.Chatters = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chatters\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamRecapMoment")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamRecapMoment) {
+					name = jsonFieldsNameOfStreamRecapMoment[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamRecapMoment) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamRecapMoment) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamRecapRule) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamRecapRule) encodeFields(e *jx.Encoder) {
+	{
+		if s.RuleID.Set {
+			e.FieldStart("rule_id")
+			s.RuleID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("rule_name")
+		e.Str(s.RuleName)
+	}
+	{
+		e.FieldStart("triggers")
+		e.Int64(s.Triggers)
+	}
+}
+
+var jsonFieldsNameOfStreamRecapRule = [3]string{
+	0: "rule_id",
+	1: "rule_name",
+	2: "triggers",
+}
+
+// Decode decodes StreamRecapRule from json.
+func (s *StreamRecapRule) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamRecapRule to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "rule_id":
+			if err := func() error {
+				s.RuleID.Reset()
+				if err := s.RuleID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rule_id\"")
+			}
+		case "rule_name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.RuleName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rule_name\"")
+			}
+		case "triggers":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.Triggers = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"triggers\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamRecapRule")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000110,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamRecapRule) {
+					name = jsonFieldsNameOfStreamRecapRule[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamRecapRule) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamRecapRule) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamRecapSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamRecapSettings) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ai_summary")
+		e.Bool(s.AiSummary)
+	}
+	{
+		e.FieldStart("notify")
+		e.Bool(s.Notify)
+	}
+}
+
+var jsonFieldsNameOfStreamRecapSettings = [2]string{
+	0: "ai_summary",
+	1: "notify",
+}
+
+// Decode decodes StreamRecapSettings from json.
+func (s *StreamRecapSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamRecapSettings to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ai_summary":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.AiSummary = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai_summary\"")
+			}
+		case "notify":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.Notify = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notify\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamRecapSettings")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamRecapSettings) {
+					name = jsonFieldsNameOfStreamRecapSettings[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamRecapSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamRecapSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamRecapUser) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamRecapUser) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("user_twitch_id")
+		e.Int64(s.UserTwitchID)
+	}
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
+	}
+	{
+		if s.SusType.Set {
+			e.FieldStart("sus_type")
+			s.SusType.Encode(e)
+		}
+	}
+	{
+		if s.SusDescription.Set {
+			e.FieldStart("sus_description")
+			s.SusDescription.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStreamRecapUser = [4]string{
+	0: "user_twitch_id",
+	1: "login",
+	2: "sus_type",
+	3: "sus_description",
+}
+
+// Decode decodes StreamRecapUser from json.
+func (s *StreamRecapUser) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamRecapUser to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "user_twitch_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.UserTwitchID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_twitch_id\"")
+			}
+		case "login":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login\"")
+			}
+		case "sus_type":
+			if err := func() error {
+				s.SusType.Reset()
+				if err := s.SusType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sus_type\"")
+			}
+		case "sus_description":
+			if err := func() error {
+				s.SusDescription.Reset()
+				if err := s.SusDescription.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sus_description\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamRecapUser")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamRecapUser) {
+					name = jsonFieldsNameOfStreamRecapUser[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamRecapUser) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamRecapUser) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StreamRetentionEntry) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StreamRetentionEntry) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("stream_id")
+		e.Int64(s.StreamID)
+	}
+	{
+		e.FieldStart("started_at")
+		json.EncodeDateTime(e, s.StartedAt)
+	}
+	{
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("chatters")
+		e.Int(s.Chatters)
+	}
+	{
+		e.FieldStart("returning_chatters")
+		e.Int(s.ReturningChatters)
+	}
+	{
+		e.FieldStart("next_stream_id")
+		s.NextStreamID.Encode(e)
+	}
+	{
+		e.FieldStart("retention")
+		s.Retention.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfStreamRetentionEntry = [7]string{
+	0: "stream_id",
+	1: "started_at",
+	2: "title",
+	3: "chatters",
+	4: "returning_chatters",
+	5: "next_stream_id",
+	6: "retention",
+}
+
+// Decode decodes StreamRetentionEntry from json.
+func (s *StreamRetentionEntry) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StreamRetentionEntry to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "stream_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.StreamID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"stream_id\"")
+			}
+		case "started_at":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"started_at\"")
+			}
+		case "title":
+			if err := func() error {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "chatters":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Chatters = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chatters\"")
+			}
+		case "returning_chatters":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.ReturningChatters = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"returning_chatters\"")
+			}
+		case "next_stream_id":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.NextStreamID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next_stream_id\"")
+			}
+		case "retention":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.Retention.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"retention\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StreamRetentionEntry")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfStreamRetentionEntry) {
+					name = jsonFieldsNameOfStreamRetentionEntry[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StreamRetentionEntry) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StreamRetentionEntry) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SuspicionEvent) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SuspicionEvent) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
+	}
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("source")
+		s.Source.Encode(e)
+	}
+	{
+		e.FieldStart("old_is_sus")
+		e.Bool(s.OldIsSus)
+	}
+	{
+		e.FieldStart("new_is_sus")
+		e.Bool(s.NewIsSus)
+	}
+	{
+		if s.OldSusType.Set {
+			e.FieldStart("old_sus_type")
+			s.OldSusType.Encode(e)
+		}
+	}
+	{
+		if s.NewSusType.Set {
+			e.FieldStart("new_sus_type")
+			s.NewSusType.Encode(e)
+		}
+	}
+	{
+		if s.OldSusDescription.Set {
+			e.FieldStart("old_sus_description")
+			s.OldSusDescription.Encode(e)
+		}
+	}
+	{
+		if s.NewSusDescription.Set {
+			e.FieldStart("new_sus_description")
+			s.NewSusDescription.Encode(e)
+		}
+	}
+	{
+		if s.Evidence.Set {
+			e.FieldStart("evidence")
+			s.Evidence.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfSuspicionEvent = [12]string{
+	0:  "id",
+	1:  "twitch_user_id",
+	2:  "username",
+	3:  "source",
+	4:  "old_is_sus",
+	5:  "new_is_sus",
+	6:  "old_sus_type",
+	7:  "new_sus_type",
+	8:  "old_sus_description",
+	9:  "new_sus_description",
+	10: "evidence",
+	11: "created_at",
+}
+
+// Decode decodes SuspicionEvent from json.
+func (s *SuspicionEvent) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionEvent to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "twitch_user_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchUserID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
+			}
+		case "username":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "source":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Source.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
+			}
+		case "old_is_sus":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.OldIsSus = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"old_is_sus\"")
+			}
+		case "new_is_sus":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.NewIsSus = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new_is_sus\"")
+			}
+		case "old_sus_type":
+			if err := func() error {
+				s.OldSusType.Reset()
+				if err := s.OldSusType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"old_sus_type\"")
+			}
+		case "new_sus_type":
+			if err := func() error {
+				s.NewSusType.Reset()
+				if err := s.NewSusType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new_sus_type\"")
+			}
+		case "old_sus_description":
+			if err := func() error {
+				s.OldSusDescription.Reset()
+				if err := s.OldSusDescription.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"old_sus_description\"")
+			}
+		case "new_sus_description":
+			if err := func() error {
+				s.NewSusDescription.Reset()
+				if err := s.NewSusDescription.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new_sus_description\"")
+			}
+		case "evidence":
+			if err := func() error {
+				s.Evidence.Reset()
+				if err := s.Evidence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"evidence\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SuspicionEvent")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00111111,
+		0b00001000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSuspicionEvent) {
+					name = jsonFieldsNameOfSuspicionEvent[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SuspicionEvent) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionEvent) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s SuspicionEventEvidence) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s SuspicionEventEvidence) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes SuspicionEventEvidence from json.
+func (s *SuspicionEventEvidence) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionEventEvidence to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SuspicionEventEvidence")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SuspicionEventEvidence) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionEventEvidence) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SuspicionEventSource as json.
+func (s SuspicionEventSource) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes SuspicionEventSource from json.
+func (s *SuspicionEventSource) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionEventSource to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch SuspicionEventSource(v) {
+	case SuspicionEventSourceAuto:
+		*s = SuspicionEventSourceAuto
+	case SuspicionEventSourceManual:
+		*s = SuspicionEventSourceManual
+	case SuspicionEventSourceTelegram:
+		*s = SuspicionEventSourceTelegram
+	case SuspicionEventSourceAi:
+		*s = SuspicionEventSourceAi
+	case SuspicionEventSourceRule:
+		*s = SuspicionEventSourceRule
+	default:
+		*s = SuspicionEventSource(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SuspicionEventSource) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionEventSource) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SuspicionReevaluation) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SuspicionReevaluation) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("running")
+		e.Bool(s.Running)
+	}
+	{
+		e.FieldStart("queued")
+		e.Bool(s.Queued)
+	}
+	{
+		e.FieldStart("reason")
+		s.Reason.Encode(e)
+	}
+	{
+		e.FieldStart("refetch_budget")
+		e.Int(s.RefetchBudget)
+	}
+	{
+		e.FieldStart("total")
+		e.Int64(s.Total)
+	}
+	{
+		e.FieldStart("processed")
+		e.Int(s.Processed)
+	}
+	{
+		e.FieldStart("evaluated")
+		e.Int(s.Evaluated)
+	}
+	{
+		e.FieldStart("refetched")
+		e.Int(s.Refetched)
+	}
+	{
+		e.FieldStart("skipped")
+		e.Int(s.Skipped)
+	}
+	{
+		e.FieldStart("failed")
+		e.Int(s.Failed)
+	}
+	{
+		if s.StartedAt.Set {
+			e.FieldStart("started_at")
+			s.StartedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.FinishedAt.Set {
+			e.FieldStart("finished_at")
+			s.FinishedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfSuspicionReevaluation = [12]string{
+	0:  "running",
+	1:  "queued",
+	2:  "reason",
+	3:  "refetch_budget",
+	4:  "total",
+	5:  "processed",
+	6:  "evaluated",
+	7:  "refetched",
+	8:  "skipped",
+	9:  "failed",
+	10: "started_at",
+	11: "finished_at",
+}
+
+// Decode decodes SuspicionReevaluation from json.
+func (s *SuspicionReevaluation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionReevaluation to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "running":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Running = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"running\"")
+			}
+		case "queued":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.Queued = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"queued\"")
+			}
+		case "reason":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Reason.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reason\"")
+			}
+		case "refetch_budget":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.RefetchBudget = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refetch_budget\"")
+			}
+		case "total":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.Total = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "processed":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.Processed = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"processed\"")
+			}
+		case "evaluated":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int()
+				s.Evaluated = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"evaluated\"")
+			}
+		case "refetched":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int()
+				s.Refetched = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refetched\"")
+			}
+		case "skipped":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Skipped = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"skipped\"")
+			}
+		case "failed":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Failed = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"failed\"")
+			}
+		case "started_at":
+			if err := func() error {
+				s.StartedAt.Reset()
+				if err := s.StartedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"started_at\"")
+			}
+		case "finished_at":
+			if err := func() error {
+				s.FinishedAt.Reset()
+				if err := s.FinishedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"finished_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SuspicionReevaluation")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSuspicionReevaluation) {
+					name = jsonFieldsNameOfSuspicionReevaluation[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SuspicionReevaluation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionReevaluation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SuspicionReevaluationReason as json.
+func (s SuspicionReevaluationReason) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes SuspicionReevaluationReason from json.
+func (s *SuspicionReevaluationReason) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionReevaluationReason to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch SuspicionReevaluationReason(v) {
+	case SuspicionReevaluationReasonEmpty:
+		*s = SuspicionReevaluationReasonEmpty
+	case SuspicionReevaluationReasonSuspicionSettings:
+		*s = SuspicionReevaluationReasonSuspicionSettings
+	case SuspicionReevaluationReasonBlacklist:
+		*s = SuspicionReevaluationReasonBlacklist
+	case SuspicionReevaluationReasonLoginPatterns:
+		*s = SuspicionReevaluationReasonLoginPatterns
+	case SuspicionReevaluationReasonBlocklists:
+		*s = SuspicionReevaluationReasonBlocklists
+	case SuspicionReevaluationReasonManual:
+		*s = SuspicionReevaluationReasonManual
+	default:
+		*s = SuspicionReevaluationReason(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SuspicionReevaluationReason) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionReevaluationReason) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SuspicionScore) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SuspicionScore) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
+	}
+	{
+		e.FieldStart("threshold")
+		e.Int(s.Threshold)
+	}
+	{
+		e.FieldStart("computed_at")
+		json.EncodeDateTime(e, s.ComputedAt)
+	}
+	{
+		e.FieldStart("signals")
+		e.ArrStart()
+		for _, elem := range s.Signals {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfSuspicionScore = [4]string{
+	0: "score",
+	1: "threshold",
+	2: "computed_at",
+	3: "signals",
+}
+
+// Decode decodes SuspicionScore from json.
+func (s *SuspicionScore) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionScore to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "score":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "threshold":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Threshold = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"threshold\"")
+			}
+		case "computed_at":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.ComputedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"computed_at\"")
+			}
+		case "signals":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Signals = make([]SuspicionSignal, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SuspicionSignal
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Signals = append(s.Signals, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"signals\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SuspicionScore")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSuspicionScore) {
+					name = jsonFieldsNameOfSuspicionScore[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SuspicionScore) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionScore) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SuspicionSettings) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SuspicionSettings) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("auto_check_account_age")
+		e.Bool(s.AutoCheckAccountAge)
+	}
+	{
+		e.FieldStart("account_age_sus_days")
+		e.Int(s.AccountAgeSusDays)
+	}
+	{
+		e.FieldStart("auto_check_blacklist")
+		e.Bool(s.AutoCheckBlacklist)
+	}
+	{
+		e.FieldStart("auto_check_low_follows")
+		e.Bool(s.AutoCheckLowFollows)
+	}
+	{
+		e.FieldStart("low_follows_threshold")
+		e.Int(s.LowFollowsThreshold)
+	}
+	{
+		e.FieldStart("max_gql_follow_pages")
+		e.Int(s.MaxGqlFollowPages)
+	}
+	{
+		if s.ScoreThreshold.Set {
+			e.FieldStart("score_threshold")
+			s.ScoreThreshold.Encode(e)
+		}
+	}
+	{
+		if s.Weights.Set {
+			e.FieldStart("weights")
+			s.Weights.Encode(e)
+		}
+	}
+	{
+		if s.NamePatterns != nil {
+			e.FieldStart("name_patterns")
+			e.ArrStart()
+			for _, elem := range s.NamePatterns {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.MultiChannelThreshold.Set {
+			e.FieldStart("multi_channel_threshold")
+			s.MultiChannelThreshold.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfSuspicionSettings = [10]string{
+	0: "auto_check_account_age",
+	1: "account_age_sus_days",
+	2: "auto_check_blacklist",
+	3: "auto_check_low_follows",
+	4: "low_follows_threshold",
+	5: "max_gql_follow_pages",
+	6: "score_threshold",
+	7: "weights",
+	8: "name_patterns",
+	9: "multi_channel_threshold",
+}
+
+// Decode decodes SuspicionSettings from json.
+func (s *SuspicionSettings) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionSettings to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "auto_check_account_age":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.AutoCheckAccountAge = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_check_account_age\"")
+			}
+		case "account_age_sus_days":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.AccountAgeSusDays = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"account_age_sus_days\"")
+			}
+		case "auto_check_blacklist":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.AutoCheckBlacklist = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_check_blacklist\"")
+			}
+		case "auto_check_low_follows":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.AutoCheckLowFollows = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_check_low_follows\"")
+			}
+		case "low_follows_threshold":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.LowFollowsThreshold = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"low_follows_threshold\"")
+			}
+		case "max_gql_follow_pages":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.MaxGqlFollowPages = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_gql_follow_pages\"")
+			}
+		case "score_threshold":
+			if err := func() error {
+				s.ScoreThreshold.Reset()
+				if err := s.ScoreThreshold.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score_threshold\"")
+			}
+		case "weights":
+			if err := func() error {
+				s.Weights.Reset()
+				if err := s.Weights.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"weights\"")
+			}
+		case "name_patterns":
+			if err := func() error {
+				s.NamePatterns = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.NamePatterns = append(s.NamePatterns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name_patterns\"")
+			}
+		case "multi_channel_threshold":
+			if err := func() error {
+				s.MultiChannelThreshold.Reset()
+				if err := s.MultiChannelThreshold.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"multi_channel_threshold\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SuspicionSettings")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00111111,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSuspicionSettings) {
+					name = jsonFieldsNameOfSuspicionSettings[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SuspicionSettings) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionSettings) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SuspicionSignal) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SuspicionSignal) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("key")
+		s.Key.Encode(e)
+	}
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
+	}
+	{
+		e.FieldStart("weight")
+		e.Int(s.Weight)
+	}
+	{
+		e.FieldStart("detail")
+		e.Str(s.Detail)
+	}
+}
+
+var jsonFieldsNameOfSuspicionSignal = [4]string{
+	0: "key",
+	1: "score",
+	2: "weight",
+	3: "detail",
+}
+
+// Decode decodes SuspicionSignal from json.
+func (s *SuspicionSignal) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionSignal to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "key":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Key.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"key\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "weight":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Weight = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"weight\"")
+			}
+		case "detail":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Detail = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"detail\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SuspicionSignal")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSuspicionSignal) {
+					name = jsonFieldsNameOfSuspicionSignal[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SuspicionSignal) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionSignal) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SuspicionSignalKey as json.
+func (s SuspicionSignalKey) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes SuspicionSignalKey from json.
+func (s *SuspicionSignalKey) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionSignalKey to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch SuspicionSignalKey(v) {
+	case SuspicionSignalKeyAccountAge:
+		*s = SuspicionSignalKeyAccountAge
+	case SuspicionSignalKeyBlacklistFollows:
+		*s = SuspicionSignalKeyBlacklistFollows
+	case SuspicionSignalKeyLowFollows:
+		*s = SuspicionSignalKeyLowFollows
+	case SuspicionSignalKeyNamePattern:
+		*s = SuspicionSignalKeyNamePattern
+	case SuspicionSignalKeyFirstMessage:
+		*s = SuspicionSignalKeyFirstMessage
+	case SuspicionSignalKeyMultiChannel:
+		*s = SuspicionSignalKeyMultiChannel
+	case SuspicionSignalKeyModeration:
+		*s = SuspicionSignalKeyModeration
+	default:
+		*s = SuspicionSignalKey(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SuspicionSignalKey) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionSignalKey) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SuspicionWeights) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SuspicionWeights) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("account_age")
+		e.Int(s.AccountAge)
+	}
+	{
+		e.FieldStart("blacklist_follows")
+		e.Int(s.BlacklistFollows)
+	}
+	{
+		e.FieldStart("low_follows")
+		e.Int(s.LowFollows)
+	}
+	{
+		e.FieldStart("name_pattern")
+		e.Int(s.NamePattern)
+	}
+	{
+		e.FieldStart("first_message")
+		e.Int(s.FirstMessage)
+	}
+	{
+		e.FieldStart("multi_channel")
+		e.Int(s.MultiChannel)
+	}
+	{
+		e.FieldStart("moderation")
+		e.Int(s.Moderation)
+	}
+}
+
+var jsonFieldsNameOfSuspicionWeights = [7]string{
+	0: "account_age",
+	1: "blacklist_follows",
+	2: "low_follows",
+	3: "name_pattern",
+	4: "first_message",
+	5: "multi_channel",
+	6: "moderation",
+}
+
+// Decode decodes SuspicionWeights from json.
+func (s *SuspicionWeights) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SuspicionWeights to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "account_age":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.AccountAge = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"account_age\"")
+			}
+		case "blacklist_follows":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.BlacklistFollows = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"blacklist_follows\"")
+			}
+		case "low_follows":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.LowFollows = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"low_follows\"")
+			}
+		case "name_pattern":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.NamePattern = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name_pattern\"")
+			}
+		case "first_message":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.FirstMessage = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"first_message\"")
+			}
+		case "multi_channel":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.MultiChannel = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"multi_channel\"")
+			}
+		case "moderation":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int()
+				s.Moderation = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moderation\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SuspicionWeights")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSuspicionWeights) {
+					name = jsonFieldsNameOfSuspicionWeights[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SuspicionWeights) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SuspicionWeights) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SyncBlocklistBadRequest as json.
+func (s *SyncBlocklistBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes SyncBlocklistBadRequest from json.
+func (s *SyncBlocklistBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SyncBlocklistBadRequest to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = SyncBlocklistBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SyncBlocklistBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SyncBlocklistBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SyncBlocklistNotFound as json.
+func (s *SyncBlocklistNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes SyncBlocklistNotFound from json.
+func (s *SyncBlocklistNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SyncBlocklistNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = SyncBlocklistNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SyncBlocklistNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SyncBlocklistNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SyncBlocklistRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SyncBlocklistRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+}
+
+var jsonFieldsNameOfSyncBlocklistRequest = [1]string{
+	0: "id",
+}
+
+// Decode decodes SyncBlocklistRequest from json.
+func (s *SyncBlocklistRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SyncBlocklistRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SyncBlocklistRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSyncBlocklistRequest) {
+					name = jsonFieldsNameOfSyncBlocklistRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SyncBlocklistRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SyncBlocklistRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SystemStatsCaches) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SystemStatsCaches) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("helix_user_oauth_cache_entries")
+		e.Int32(s.HelixUserOAuthCacheEntries)
+	}
+	{
+		e.FieldStart("helix_app_access_token_cached")
+		e.Bool(s.HelixAppAccessTokenCached)
+	}
+	{
+		e.FieldStart("login_limiter_tracked_ips")
+		e.Int32(s.LoginLimiterTrackedIps)
+	}
+	{
+		e.FieldStart("pgx_acquired_conns")
+		e.Int32(s.PgxAcquiredConns)
+	}
+	{
+		e.FieldStart("pgx_idle_conns")
+		e.Int32(s.PgxIdleConns)
+	}
+	{
+		e.FieldStart("pgx_total_conns")
+		e.Int32(s.PgxTotalConns)
+	}
+	{
+		e.FieldStart("pgx_max_conns")
+		e.Int32(s.PgxMaxConns)
+	}
+	{
+		e.FieldStart("pgx_acquire_count")
+		e.Int64(s.PgxAcquireCount)
+	}
+	{
+		e.FieldStart("pgx_canceled_acquire_count")
+		e.Int64(s.PgxCanceledAcquireCount)
+	}
+}
+
+var jsonFieldsNameOfSystemStatsCaches = [9]string{
+	0: "helix_user_oauth_cache_entries",
+	1: "helix_app_access_token_cached",
+	2: "login_limiter_tracked_ips",
+	3: "pgx_acquired_conns",
+	4: "pgx_idle_conns",
+	5: "pgx_total_conns",
+	6: "pgx_max_conns",
+	7: "pgx_acquire_count",
+	8: "pgx_canceled_acquire_count",
+}
+
+// Decode decodes SystemStatsCaches from json.
+func (s *SystemStatsCaches) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SystemStatsCaches to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "helix_user_oauth_cache_entries":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int32()
+				s.HelixUserOAuthCacheEntries = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"helix_user_oauth_cache_entries\"")
+			}
+		case "helix_app_access_token_cached":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.HelixAppAccessTokenCached = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"helix_app_access_token_cached\"")
+			}
+		case "login_limiter_tracked_ips":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int32()
+				s.LoginLimiterTrackedIps = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login_limiter_tracked_ips\"")
+			}
+		case "pgx_acquired_conns":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int32()
+				s.PgxAcquiredConns = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pgx_acquired_conns\"")
+			}
+		case "pgx_idle_conns":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int32()
+				s.PgxIdleConns = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pgx_idle_conns\"")
+			}
+		case "pgx_total_conns":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int32()
+				s.PgxTotalConns = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pgx_total_conns\"")
+			}
+		case "pgx_max_conns":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int32()
+				s.PgxMaxConns = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pgx_max_conns\"")
+			}
+		case "pgx_acquire_count":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int64()
+				s.PgxAcquireCount = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pgx_acquire_count\"")
+			}
+		case "pgx_canceled_acquire_count":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.PgxCanceledAcquireCount = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pgx_canceled_acquire_count\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SystemStatsCaches")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSystemStatsCaches) {
+					name = jsonFieldsNameOfSystemStatsCaches[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SystemStatsCaches) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SystemStatsCaches) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SystemStatsHost) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SystemStatsHost) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("cpu_percent")
+		s.CPUPercent.Encode(e)
+	}
+	{
+		e.FieldStart("memory_total_bytes")
+		e.Int64(s.MemoryTotalBytes)
+	}
+	{
+		e.FieldStart("memory_used_bytes")
+		e.Int64(s.MemoryUsedBytes)
+	}
+	{
+		e.FieldStart("memory_used_percent")
+		e.Float64(s.MemoryUsedPercent)
+	}
+	{
+		e.FieldStart("disk_path")
+		e.Str(s.DiskPath)
+	}
+	{
+		e.FieldStart("disk_total_bytes")
+		e.Int64(s.DiskTotalBytes)
+	}
+	{
+		e.FieldStart("disk_used_bytes")
+		e.Int64(s.DiskUsedBytes)
+	}
+	{
+		e.FieldStart("disk_used_percent")
+		e.Float64(s.DiskUsedPercent)
+	}
+}
+
+var jsonFieldsNameOfSystemStatsHost = [8]string{
+	0: "cpu_percent",
+	1: "memory_total_bytes",
+	2: "memory_used_bytes",
+	3: "memory_used_percent",
+	4: "disk_path",
+	5: "disk_total_bytes",
+	6: "disk_used_bytes",
+	7: "disk_used_percent",
+}
+
+// Decode decodes SystemStatsHost from json.
+func (s *SystemStatsHost) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SystemStatsHost to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "cpu_percent":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.CPUPercent.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cpu_percent\"")
+			}
+		case "memory_total_bytes":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.MemoryTotalBytes = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"memory_total_bytes\"")
+			}
+		case "memory_used_bytes":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.MemoryUsedBytes = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"memory_used_bytes\"")
+			}
+		case "memory_used_percent":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.MemoryUsedPercent = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"memory_used_percent\"")
+			}
+		case "disk_path":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.DiskPath = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"disk_path\"")
+			}
+		case "disk_total_bytes":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int64()
+				s.DiskTotalBytes = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"disk_total_bytes\"")
+			}
+		case "disk_used_bytes":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int64()
+				s.DiskUsedBytes = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"disk_used_bytes\"")
+			}
+		case "disk_used_percent":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Float64()
+				s.DiskUsedPercent = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"disk_used_percent\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SystemStatsHost")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b11111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSystemStatsHost) {
+					name = jsonFieldsNameOfSystemStatsHost[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SystemStatsHost) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SystemStatsHost) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SystemStatsProcess) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SystemStatsProcess) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("goroutines")
+		e.Int32(s.Goroutines)
+	}
+	{
+		e.FieldStart("heap_alloc_bytes")
+		e.Int64(s.HeapAllocBytes)
+	}
+	{
+		e.FieldStart("heap_sys_bytes")
+		e.Int64(s.HeapSysBytes)
+	}
+	{
+		e.FieldStart("sys_bytes")
+		e.Int64(s.SysBytes)
+	}
+	{
+		e.FieldStart("total_alloc_bytes")
+		e.Int64(s.TotalAllocBytes)
+	}
+	{
+		e.FieldStart("num_gc")
+		e.Int32(s.NumGc)
+	}
+	{
+		e.FieldStart("gc_cpu_fraction")
+		e.Float64(s.GcCPUFraction)
+	}
+}
+
+var jsonFieldsNameOfSystemStatsProcess = [7]string{
+	0: "goroutines",
+	1: "heap_alloc_bytes",
+	2: "heap_sys_bytes",
+	3: "sys_bytes",
+	4: "total_alloc_bytes",
+	5: "num_gc",
+	6: "gc_cpu_fraction",
+}
+
+// Decode decodes SystemStatsProcess from json.
+func (s *SystemStatsProcess) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SystemStatsProcess to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "goroutines":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int32()
+				s.Goroutines = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"goroutines\"")
+			}
+		case "heap_alloc_bytes":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.HeapAllocBytes = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"heap_alloc_bytes\"")
+			}
+		case "heap_sys_bytes":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.HeapSysBytes = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"heap_sys_bytes\"")
+			}
+		case "sys_bytes":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.SysBytes = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sys_bytes\"")
+			}
+		case "total_alloc_bytes":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.TotalAllocBytes = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total_alloc_bytes\"")
+			}
+		case "num_gc":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int32()
+				s.NumGc = int32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"num_gc\"")
+			}
+		case "gc_cpu_fraction":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.GcCPUFraction = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gc_cpu_fraction\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SystemStatsProcess")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSystemStatsProcess) {
+					name = jsonFieldsNameOfSystemStatsProcess[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SystemStatsProcess) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SystemStatsProcess) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SystemStatsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SystemStatsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("captured_at")
+		json.EncodeDateTime(e, s.CapturedAt)
+	}
+	{
+		e.FieldStart("tables")
+		s.Tables.Encode(e)
+	}
+	{
+		e.FieldStart("process")
+		s.Process.Encode(e)
+	}
+	{
+		e.FieldStart("host")
+		s.Host.Encode(e)
+	}
+	{
+		e.FieldStart("caches")
+		s.Caches.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfSystemStatsResponse = [5]string{
+	0: "captured_at",
+	1: "tables",
+	2: "process",
+	3: "host",
+	4: "caches",
+}
+
+// Decode decodes SystemStatsResponse from json.
+func (s *SystemStatsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SystemStatsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "captured_at":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CapturedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"captured_at\"")
+			}
+		case "tables":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Tables.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tables\"")
+			}
+		case "process":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Process.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"process\"")
+			}
+		case "host":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Host.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"host\"")
+			}
+		case "caches":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Caches.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"caches\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SystemStatsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSystemStatsResponse) {
+					name = jsonFieldsNameOfSystemStatsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SystemStatsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SystemStatsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SystemStatsTables) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SystemStatsTables) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("twitch_users")
+		e.Int64(s.TwitchUsers)
+	}
+	{
+		e.FieldStart("twitch_accounts_active")
+		e.Int64(s.TwitchAccountsActive)
+	}
+	{
+		e.FieldStart("twitch_accounts_all")
+		e.Int64(s.TwitchAccountsAll)
+	}
+	{
+		e.FieldStart("rules")
+		e.Int64(s.Rules)
+	}
+	{
+		e.FieldStart("notification_entries")
+		e.Int64(s.NotificationEntries)
+	}
+	{
+		e.FieldStart("streams")
+		e.Int64(s.Streams)
+	}
+	{
+		e.FieldStart("streams_open")
+		e.Int64(s.StreamsOpen)
+	}
+	{
+		e.FieldStart("chat_messages")
+		e.Int64(s.ChatMessages)
+	}
+	{
+		e.FieldStart("channel_chatters")
+		e.Int64(s.ChannelChatters)
+	}
+	{
+		e.FieldStart("user_activity_events")
+		e.Int64(s.UserActivityEvents)
+	}
+	{
+		e.FieldStart("twitch_user_helix_meta")
+		e.Int64(s.TwitchUserHelixMeta)
+	}
+	{
+		e.FieldStart("twitch_user_channel_follows")
+		e.Int64(s.TwitchUserChannelFollows)
+	}
+	{
+		e.FieldStart("user_followed_channels")
+		e.Int64(s.UserFollowedChannels)
+	}
+	{
+		e.FieldStart("channel_blacklist")
+		e.Int64(s.ChannelBlacklist)
+	}
+	{
+		e.FieldStart("rule_trigger_events")
+		e.Int64(s.RuleTriggerEvents)
+	}
+	{
+		e.FieldStart("irc_joined_samples")
+		e.Int64(s.IrcJoinedSamples)
+	}
+	{
+		e.FieldStart("twitch_discovery_candidates")
+		e.Int64(s.TwitchDiscoveryCandidates)
+	}
+	{
+		e.FieldStart("twitch_discovery_denied")
+		e.Int64(s.TwitchDiscoveryDenied)
+	}
+	{
+		e.FieldStart("ai_conversations")
+		e.Int64(s.AiConversations)
+	}
+	{
+		e.FieldStart("ai_messages")
+		e.Int64(s.AiMessages)
+	}
+}
+
+var jsonFieldsNameOfSystemStatsTables = [20]string{
+	0:  "twitch_users",
+	1:  "twitch_accounts_active",
+	2:  "twitch_accounts_all",
+	3:  "rules",
+	4:  "notification_entries",
+	5:  "streams",
+	6:  "streams_open",
+	7:  "chat_messages",
+	8:  "channel_chatters",
+	9:  "user_activity_events",
+	10: "twitch_user_helix_meta",
+	11: "twitch_user_channel_follows",
+	12: "user_followed_channels",
+	13: "channel_blacklist",
+	14: "rule_trigger_events",
+	15: "irc_joined_samples",
+	16: "twitch_discovery_candidates",
+	17: "twitch_discovery_denied",
+	18: "ai_conversations",
+	19: "ai_messages",
+}
+
+// Decode decodes SystemStatsTables from json.
+func (s *SystemStatsTables) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SystemStatsTables to nil")
+	}
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "twitch_users":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchUsers = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_users\"")
+			}
+		case "twitch_accounts_active":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchAccountsActive = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_accounts_active\"")
+			}
+		case "twitch_accounts_all":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchAccountsAll = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_accounts_all\"")
+			}
+		case "rules":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.Rules = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rules\"")
+			}
+		case "notification_entries":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.NotificationEntries = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notification_entries\"")
+			}
+		case "streams":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int64()
+				s.Streams = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"streams\"")
+			}
+		case "streams_open":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int64()
+				s.StreamsOpen = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"streams_open\"")
+			}
+		case "chat_messages":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int64()
+				s.ChatMessages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chat_messages\"")
+			}
+		case "channel_chatters":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ChannelChatters = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel_chatters\"")
+			}
+		case "user_activity_events":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.UserActivityEvents = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_activity_events\"")
+			}
+		case "twitch_user_helix_meta":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchUserHelixMeta = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_user_helix_meta\"")
+			}
+		case "twitch_user_channel_follows":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchUserChannelFollows = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_user_channel_follows\"")
+			}
+		case "user_followed_channels":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.UserFollowedChannels = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_followed_channels\"")
+			}
+		case "channel_blacklist":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int64()
+				s.ChannelBlacklist = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channel_blacklist\"")
+			}
+		case "rule_trigger_events":
+			requiredBitSet[1] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int64()
+				s.RuleTriggerEvents = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rule_trigger_events\"")
+			}
+		case "irc_joined_samples":
+			requiredBitSet[1] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int64()
+				s.IrcJoinedSamples = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"irc_joined_samples\"")
+			}
+		case "twitch_discovery_candidates":
+			requiredBitSet[2] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchDiscoveryCandidates = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_discovery_candidates\"")
+			}
+		case "twitch_discovery_denied":
+			requiredBitSet[2] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchDiscoveryDenied = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_discovery_denied\"")
+			}
+		case "ai_conversations":
+			requiredBitSet[2] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int64()
+				s.AiConversations = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai_conversations\"")
+			}
+		case "ai_messages":
+			requiredBitSet[2] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int64()
+				s.AiMessages = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ai_messages\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SystemStatsTables")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b11111111,
+		0b11111111,
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSystemStatsTables) {
+					name = jsonFieldsNameOfSystemStatsTables[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SystemStatsTables) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SystemStatsTables) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TermSeries) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TermSeries) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("stream_id")
+		e.Int64(s.StreamID)
+	}
+	{
+		e.FieldStart("term")
+		e.Str(s.Term)
+	}
+	{
+		e.FieldStart("bucket_seconds")
+		e.Int(s.BucketSeconds)
+	}
+	{
+		e.FieldStart("messages")
+		e.Int(s.Messages)
+	}
+	{
+		e.FieldStart("points")
+		e.ArrStart()
+		for _, elem := range s.Points {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfTermSeries = [5]string{
+	0: "stream_id",
+	1: "term",
+	2: "bucket_seconds",
+	3: "messages",
+	4: "points",
+}
+
+// Decode decodes TermSeries from json.
+func (s *TermSeries) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TermSeries to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "stream_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.StreamID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"stream_id\"")
+			}
+		case "term":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Term = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"term\"")
+			}
+		case "bucket_seconds":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.BucketSeconds = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bucket_seconds\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Messages = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		case "points":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Points = make([]TermSeriesPoint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TermSeriesPoint
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Points = append(s.Points, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"points\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TermSeries")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTermSeries) {
+					name = jsonFieldsNameOfTermSeries[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TermSeries) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TermSeries) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TermSeriesPoint) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TermSeriesPoint) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("start")
+		json.EncodeDateTime(e, s.Start)
+	}
+	{
+		e.FieldStart("messages")
+		e.Int(s.Messages)
+	}
+}
+
+var jsonFieldsNameOfTermSeriesPoint = [2]string{
+	0: "start",
+	1: "messages",
+}
+
+// Decode decodes TermSeriesPoint from json.
+func (s *TermSeriesPoint) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TermSeriesPoint to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "start":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Start = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Messages = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TermSeriesPoint")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTermSeriesPoint) {
+					name = jsonFieldsNameOfTermSeriesPoint[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TermSeriesPoint) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TermSeriesPoint) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TestLoginPatternRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TestLoginPatternRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("kind")
+		s.Kind.Encode(e)
+	}
+	{
+		e.FieldStart("pattern")
+		e.Str(s.Pattern)
+	}
+	{
+		e.FieldStart("logins")
+		e.ArrStart()
+		for _, elem := range s.Logins {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfTestLoginPatternRequest = [3]string{
+	0: "kind",
+	1: "pattern",
+	2: "logins",
+}
+
+// Decode decodes TestLoginPatternRequest from json.
+func (s *TestLoginPatternRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestLoginPatternRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "kind":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "pattern":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Pattern = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pattern\"")
+			}
+		case "logins":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Logins = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Logins = append(s.Logins, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"logins\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TestLoginPatternRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTestLoginPatternRequest) {
+					name = jsonFieldsNameOfTestLoginPatternRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TestLoginPatternRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestLoginPatternRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TestLoginPatternResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TestLoginPatternResponse) encodeFields(e *jx.Encoder) {
+	{
+		if s.CompileError.Set {
+			e.FieldStart("compile_error")
+			s.CompileError.Encode(e)
+		}
+	}
+	{
+		if s.NormalizedPattern.Set {
+			e.FieldStart("normalized_pattern")
+			s.NormalizedPattern.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("results")
+		e.ArrStart()
+		for _, elem := range s.Results {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfTestLoginPatternResponse = [3]string{
+	0: "compile_error",
+	1: "normalized_pattern",
+	2: "results",
+}
+
+// Decode decodes TestLoginPatternResponse from json.
+func (s *TestLoginPatternResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestLoginPatternResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "compile_error":
+			if err := func() error {
+				s.CompileError.Reset()
+				if err := s.CompileError.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"compile_error\"")
+			}
+		case "normalized_pattern":
+			if err := func() error {
+				s.NormalizedPattern.Reset()
+				if err := s.NormalizedPattern.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"normalized_pattern\"")
+			}
+		case "results":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Results = make([]LoginPatternTestResult, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem LoginPatternTestResult
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TestLoginPatternResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000100,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTestLoginPatternResponse) {
+					name = jsonFieldsNameOfTestLoginPatternResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TestLoginPatternResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestLoginPatternResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TestNotificationBadRequest as json.
+func (s *TestNotificationBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes TestNotificationBadRequest from json.
+func (s *TestNotificationBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestNotificationBadRequest to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = TestNotificationBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TestNotificationBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestNotificationBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TestNotificationNotFound as json.
+func (s *TestNotificationNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes TestNotificationNotFound from json.
+func (s *TestNotificationNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestNotificationNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = TestNotificationNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TestNotificationNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestNotificationNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TestNotificationRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TestNotificationRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.EventTypes != nil {
+			e.FieldStart("event_types")
+			e.ArrStart()
+			for _, elem := range s.EventTypes {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfTestNotificationRequest = [1]string{
+	0: "event_types",
+}
+
+// Decode decodes TestNotificationRequest from json.
+func (s *TestNotificationRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestNotificationRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "event_types":
+			if err := func() error {
+				s.EventTypes = make([]TestNotificationRequestEventTypesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TestNotificationRequestEventTypesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.EventTypes = append(s.EventTypes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_types\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TestNotificationRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TestNotificationRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestNotificationRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TestNotificationRequestEventTypesItem as json.
+func (s TestNotificationRequestEventTypesItem) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TestNotificationRequestEventTypesItem from json.
+func (s *TestNotificationRequestEventTypesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestNotificationRequestEventTypesItem to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TestNotificationRequestEventTypesItem(v) {
+	case TestNotificationRequestEventTypesItemKeywordMatch:
+		*s = TestNotificationRequestEventTypesItemKeywordMatch
+	case TestNotificationRequestEventTypesItemStreamStart:
+		*s = TestNotificationRequestEventTypesItemStreamStart
+	case TestNotificationRequestEventTypesItemStreamEnd:
+		*s = TestNotificationRequestEventTypesItemStreamEnd
+	case TestNotificationRequestEventTypesItemRuleText:
+		*s = TestNotificationRequestEventTypesItemRuleText
+	case TestNotificationRequestEventTypesItemFloodSummary:
+		*s = TestNotificationRequestEventTypesItemFloodSummary
+	default:
+		*s = TestNotificationRequestEventTypesItem(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TestNotificationRequestEventTypesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestNotificationRequestEventTypesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TestNotificationResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TestNotificationResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("results")
+		e.ArrStart()
+		for _, elem := range s.Results {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfTestNotificationResponse = [1]string{
+	0: "results",
+}
+
+// Decode decodes TestNotificationResponse from json.
+func (s *TestNotificationResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestNotificationResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "results":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Results = make([]NotificationTestResult, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NotificationTestResult
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Results = append(s.Results, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"channel_blacklist\"")
+				return errors.Wrap(err, "decode field \"results\"")
 			}
-		case "rule_trigger_events":
-			requiredBitSet[1] |= 1 << 6
-			if err := func() error {
-				v, err := d.Int64()
-				s.RuleTriggerEvents = int64(v)
-				if err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TestNotificationResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTestNotificationResponse) {
+					name = jsonFieldsNameOfTestNotificationResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"rule_trigger_events\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "irc_joined_samples":
-			requiredBitSet[1] |= 1 << 7
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TestNotificationResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestNotificationResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TestRuleRegexRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TestRuleRegexRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("pattern")
+		e.Str(s.Pattern)
+	}
+	{
+		e.FieldStart("sample")
+		e.Str(s.Sample)
+	}
+	{
+		if s.CaseInsensitive.Set {
+			e.FieldStart("case_insensitive")
+			s.CaseInsensitive.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfTestRuleRegexRequest = [3]string{
+	0: "pattern",
+	1: "sample",
+	2: "case_insensitive",
+}
+
+// Decode decodes TestRuleRegexRequest from json.
+func (s *TestRuleRegexRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestRuleRegexRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "pattern":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.IrcJoinedSamples = int64(v)
+				v, err := d.Str()
+				s.Pattern = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"irc_joined_samples\"")
+				return errors.Wrap(err, "decode field \"pattern\"")
 			}
-		case "twitch_discovery_candidates":
-			requiredBitSet[2] |= 1 << 0
+		case "sample":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int64()
-				s.TwitchDiscoveryCandidates = int64(v)
+				v, err := d.Str()
+				s.Sample = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitch_discovery_candidates\"")
+				return errors.Wrap(err, "decode field \"sample\"")
 			}
-		case "twitch_discovery_denied":
-			requiredBitSet[2] |= 1 << 1
+		case "case_insensitive":
 			if err := func() error {
-				v, err := d.Int64()
-				s.TwitchDiscoveryDenied = int64(v)
-				if err != nil {
+				s.CaseInsensitive.Reset()
+				if err := s.CaseInsensitive.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"twitch_discovery_denied\"")
+				return errors.Wrap(err, "decode field \"case_insensitive\"")
 			}
-		case "ai_conversations":
-			requiredBitSet[2] |= 1 << 2
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TestRuleRegexRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTestRuleRegexRequest) {
+					name = jsonFieldsNameOfTestRuleRegexRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TestRuleRegexRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TestRuleRegexRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TestRuleRegexResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TestRuleRegexResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("matches")
+		e.Bool(s.Matches)
+	}
+	{
+		if s.CompileError.Set {
+			e.FieldStart("compile_error")
+			s.CompileError.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfTestRuleRegexResponse = [2]string{
+	0: "matches",
+	1: "compile_error",
+}
+
+// Decode decodes TestRuleRegexResponse from json.
+func (s *TestRuleRegexResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TestRuleRegexResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "matches":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int64()
-				s.AiConversations = int64(v)
+				v, err := d.Bool()
+				s.Matches = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ai_conversations\"")
+				return errors.Wrap(err, "decode field \"matches\"")
 			}
-		case "ai_messages":
-			requiredBitSet[2] |= 1 << 3
+		case "compile_error":
 			if err := func() error {
-				v, err := d.Int64()
-				s.AiMessages = int64(v)
-				if err != nil {
+				s.CompileError.Reset()
+				if err := s.CompileError.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ai_messages\"")
+				return errors.Wrap(err, "decode field \"compile_error\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SystemStatsTables")
+		return errors.Wrap(err, "decode TestRuleRegexResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [3]uint8{
-		0b11111111,
-		0b11111111,
-		0b00001111,
+	for i, mask := range [1]uint8{
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10281,8 +23488,8 @@ func (s *SystemStatsTables) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSystemStatsTables) {
-					name = jsonFieldsNameOfSystemStatsTables[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTestRuleRegexResponse) {
+					name = jsonFieldsNameOfTestRuleRegexResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10303,104 +23510,171 @@ func (s *SystemStatsTables) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SystemStatsTables) MarshalJSON() ([]byte, error) {
+func (s *TestRuleRegexResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SystemStatsTables) UnmarshalJSON(data []byte) error {
+func (s *TestRuleRegexResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TestRuleRegexRequest) Encode(e *jx.Encoder) {
+func (s *TrendingTerm) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TestRuleRegexRequest) encodeFields(e *jx.Encoder) {
+func (s *TrendingTerm) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("pattern")
-		e.Str(s.Pattern)
+		e.FieldStart("term")
+		e.Str(s.Term)
 	}
 	{
-		e.FieldStart("sample")
-		e.Str(s.Sample)
+		e.FieldStart("emote")
+		e.Bool(s.Emote)
 	}
 	{
-		if s.CaseInsensitive.Set {
-			e.FieldStart("case_insensitive")
-			s.CaseInsensitive.Encode(e)
-		}
+		e.FieldStart("words")
+		e.Int(s.Words)
+	}
+	{
+		e.FieldStart("messages")
+		e.Int(s.Messages)
+	}
+	{
+		e.FieldStart("chatters")
+		e.Int(s.Chatters)
+	}
+	{
+		e.FieldStart("baseline_streams")
+		e.Int(s.BaselineStreams)
+	}
+	{
+		e.FieldStart("score")
+		e.Float64(s.Score)
 	}
 }
 
-var jsonFieldsNameOfTestRuleRegexRequest = [3]string{
-	0: "pattern",
-	1: "sample",
-	2: "case_insensitive",
+var jsonFieldsNameOfTrendingTerm = [7]string{
+	0: "term",
+	1: "emote",
+	2: "words",
+	3: "messages",
+	4: "chatters",
+	5: "baseline_streams",
+	6: "score",
 }
 
-// Decode decodes TestRuleRegexRequest from json.
-func (s *TestRuleRegexRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TrendingTerm from json.
+func (s *TrendingTerm) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TestRuleRegexRequest to nil")
+		return errors.New("invalid: unable to decode TrendingTerm to nil")
 	}
 	var requiredBitSet [1]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "pattern":
+		case "term":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Pattern = string(v)
+				s.Term = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"pattern\"")
+				return errors.Wrap(err, "decode field \"term\"")
 			}
-		case "sample":
+		case "emote":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Sample = string(v)
+				v, err := d.Bool()
+				s.Emote = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"sample\"")
+				return errors.Wrap(err, "decode field \"emote\"")
 			}
-		case "case_insensitive":
+		case "words":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.CaseInsensitive.Reset()
-				if err := s.CaseInsensitive.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Words = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"case_insensitive\"")
+				return errors.Wrap(err, "decode field \"words\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Messages = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		case "chatters":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.Chatters = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"chatters\"")
+			}
+		case "baseline_streams":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.BaselineStreams = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"baseline_streams\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.Score = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TestRuleRegexRequest")
+		return errors.Wrap(err, "decode TrendingTerm")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b01111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10412,8 +23686,8 @@ func (s *TestRuleRegexRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTestRuleRegexRequest) {
-					name = jsonFieldsNameOfTestRuleRegexRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTrendingTerm) {
+					name = jsonFieldsNameOfTrendingTerm[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10434,86 +23708,164 @@ func (s *TestRuleRegexRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TestRuleRegexRequest) MarshalJSON() ([]byte, error) {
+func (s *TrendingTerm) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TestRuleRegexRequest) UnmarshalJSON(data []byte) error {
+func (s *TrendingTerm) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TestRuleRegexResponse) Encode(e *jx.Encoder) {
+func (s *TrendingTerms) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TestRuleRegexResponse) encodeFields(e *jx.Encoder) {
+func (s *TrendingTerms) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("matches")
-		e.Bool(s.Matches)
+		e.FieldStart("stream_id")
+		e.Int64(s.StreamID)
 	}
 	{
-		if s.CompileError.Set {
-			e.FieldStart("compile_error")
-			s.CompileError.Encode(e)
+		e.FieldStart("from")
+		json.EncodeDateTime(e, s.From)
+	}
+	{
+		e.FieldStart("to")
+		json.EncodeDateTime(e, s.To)
+	}
+	{
+		e.FieldStart("messages")
+		e.Int(s.Messages)
+	}
+	{
+		e.FieldStart("baseline_streams")
+		e.Int(s.BaselineStreams)
+	}
+	{
+		e.FieldStart("terms")
+		e.ArrStart()
+		for _, elem := range s.Terms {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTestRuleRegexResponse = [2]string{
-	0: "matches",
-	1: "compile_error",
+var jsonFieldsNameOfTrendingTerms = [6]string{
+	0: "stream_id",
+	1: "from",
+	2: "to",
+	3: "messages",
+	4: "baseline_streams",
+	5: "terms",
 }
 
-// Decode decodes TestRuleRegexResponse from json.
-func (s *TestRuleRegexResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TrendingTerms from json.
+func (s *TrendingTerms) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TestRuleRegexResponse to nil")
+		return errors.New("invalid: unable to decode TrendingTerms to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "matches":
+		case "stream_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.Matches = bool(v)
+				v, err := d.Int64()
+				s.StreamID = int64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"matches\"")
+				return errors.Wrap(err, "decode field \"stream_id\"")
 			}
-		case "compile_error":
+		case "from":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.CompileError.Reset()
-				if err := s.CompileError.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.From = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"compile_error\"")
+				return errors.Wrap(err, "decode field \"from\"")
+			}
+		case "to":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.To = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"to\"")
+			}
+		case "messages":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.Messages = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		case "baseline_streams":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int()
+				s.BaselineStreams = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"baseline_streams\"")
+			}
+		case "terms":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.Terms = make([]TrendingTerm, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TrendingTerm
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Terms = append(s.Terms, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"terms\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TestRuleRegexResponse")
+		return errors.Wrap(err, "decode TrendingTerms")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10525,8 +23877,8 @@ func (s *TestRuleRegexResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTestRuleRegexResponse) {
-					name = jsonFieldsNameOfTestRuleRegexResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTrendingTerms) {
+					name = jsonFieldsNameOfTrendingTerms[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10547,14 +23899,14 @@ func (s *TestRuleRegexResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TestRuleRegexResponse) MarshalJSON() ([]byte, error) {
+func (s *TrendingTerms) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TestRuleRegexResponse) UnmarshalJSON(data []byte) error {
+func (s *TrendingTerms) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -11148,9 +24500,51 @@ func (s *TwitchUserProfile) encodeFields(e *jx.Encoder) {
 			s.ProfileImageURL.Encode(e)
 		}
 	}
+	{
+		if s.SuspicionScore.Set {
+			e.FieldStart("suspicion_score")
+			s.SuspicionScore.Encode(e)
+		}
+	}
+	{
+		if s.SuspicionHistory != nil {
+			e.FieldStart("suspicion_history")
+			e.ArrStart()
+			for _, elem := range s.SuspicionHistory {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.PossibleAlts != nil {
+			e.FieldStart("possible_alts")
+			e.ArrStart()
+			for _, elem := range s.PossibleAlts {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.LinkedUsers != nil {
+			e.FieldStart("linked_users")
+			e.ArrStart()
+			for _, elem := range s.LinkedUsers {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ChatToxicity.Set {
+			e.FieldStart("chat_toxicity")
+			s.ChatToxicity.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfTwitchUserProfile = [18]string{
+var jsonFieldsNameOfTwitchUserProfile = [23]string{
 	0:  "id",
 	1:  "username",
 	2:  "monitored",
@@ -11169,6 +24563,11 @@ var jsonFieldsNameOfTwitchUserProfile = [18]string{
 	15: "notify_off_stream_messages",
 	16: "notify_stream_start",
 	17: "profile_image_url",
+	18: "suspicion_score",
+	19: "suspicion_history",
+	20: "possible_alts",
+	21: "linked_users",
+	22: "chat_toxicity",
 }
 
 // Decode decodes TwitchUserProfile from json.
@@ -11364,48 +24763,119 @@ func (s *TwitchUserProfile) Decode(d *jx.Decoder) error {
 		case "irc_only_when_live":
 			requiredBitSet[1] |= 1 << 6
 			if err := func() error {
-				v, err := d.Bool()
-				s.IrcOnlyWhenLive = bool(v)
-				if err != nil {
+				v, err := d.Bool()
+				s.IrcOnlyWhenLive = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"irc_only_when_live\"")
+			}
+		case "notify_off_stream_messages":
+			requiredBitSet[1] |= 1 << 7
+			if err := func() error {
+				v, err := d.Bool()
+				s.NotifyOffStreamMessages = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notify_off_stream_messages\"")
+			}
+		case "notify_stream_start":
+			requiredBitSet[2] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.NotifyStreamStart = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notify_stream_start\"")
+			}
+		case "profile_image_url":
+			if err := func() error {
+				s.ProfileImageURL.Reset()
+				if err := s.ProfileImageURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"profile_image_url\"")
+			}
+		case "suspicion_score":
+			if err := func() error {
+				s.SuspicionScore.Reset()
+				if err := s.SuspicionScore.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"suspicion_score\"")
+			}
+		case "suspicion_history":
+			if err := func() error {
+				s.SuspicionHistory = make([]SuspicionEvent, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SuspicionEvent
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.SuspicionHistory = append(s.SuspicionHistory, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"irc_only_when_live\"")
+				return errors.Wrap(err, "decode field \"suspicion_history\"")
 			}
-		case "notify_off_stream_messages":
-			requiredBitSet[1] |= 1 << 7
+		case "possible_alts":
 			if err := func() error {
-				v, err := d.Bool()
-				s.NotifyOffStreamMessages = bool(v)
-				if err != nil {
+				s.PossibleAlts = make([]AltCandidate, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AltCandidate
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PossibleAlts = append(s.PossibleAlts, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"notify_off_stream_messages\"")
+				return errors.Wrap(err, "decode field \"possible_alts\"")
 			}
-		case "notify_stream_start":
-			requiredBitSet[2] |= 1 << 0
+		case "linked_users":
 			if err := func() error {
-				v, err := d.Bool()
-				s.NotifyStreamStart = bool(v)
-				if err != nil {
+				s.LinkedUsers = make([]UserLink, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem UserLink
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.LinkedUsers = append(s.LinkedUsers, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"notify_stream_start\"")
+				return errors.Wrap(err, "decode field \"linked_users\"")
 			}
-		case "profile_image_url":
+		case "chat_toxicity":
 			if err := func() error {
-				s.ProfileImageURL.Reset()
-				if err := s.ProfileImageURL.Decode(d); err != nil {
+				s.ChatToxicity.Reset()
+				if err := s.ChatToxicity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"profile_image_url\"")
+				return errors.Wrap(err, "decode field \"chat_toxicity\"")
 			}
 		default:
 			return d.Skip()
@@ -11496,13 +24966,53 @@ func (s *UpdateNotificationPostRequest) encodeFields(e *jx.Encoder) {
 			s.Enabled.Encode(e)
 		}
 	}
+	{
+		if s.Tags != nil {
+			e.FieldStart("tags")
+			e.ArrStart()
+			for _, elem := range s.Tags {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.EventTypes != nil {
+			e.FieldStart("event_types")
+			e.ArrStart()
+			for _, elem := range s.EventTypes {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Channels != nil {
+			e.FieldStart("channels")
+			e.ArrStart()
+			for _, elem := range s.Channels {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Policy.Set {
+			e.FieldStart("policy")
+			s.Policy.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfUpdateNotificationPostRequest = [4]string{
+var jsonFieldsNameOfUpdateNotificationPostRequest = [8]string{
 	0: "id",
 	1: "provider",
 	2: "settings",
 	3: "enabled",
+	4: "tags",
+	5: "event_types",
+	6: "channels",
+	7: "policy",
 }
 
 // Decode decodes UpdateNotificationPostRequest from json.
@@ -11556,6 +25066,73 @@ func (s *UpdateNotificationPostRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"enabled\"")
 			}
+		case "tags":
+			if err := func() error {
+				s.Tags = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Tags = append(s.Tags, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "event_types":
+			if err := func() error {
+				s.EventTypes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.EventTypes = append(s.EventTypes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_types\"")
+			}
+		case "channels":
+			if err := func() error {
+				s.Channels = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Channels = append(s.Channels, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"channels\"")
+			}
+		case "policy":
+			if err := func() error {
+				s.Policy.Reset()
+				if err := s.Policy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"policy\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -11632,6 +25209,14 @@ func (s *UpdateNotificationPostRequestProvider) Decode(d *jx.Decoder) error {
 		*s = UpdateNotificationPostRequestProviderTelegram
 	case UpdateNotificationPostRequestProviderWebhook:
 		*s = UpdateNotificationPostRequestProviderWebhook
+	case UpdateNotificationPostRequestProviderDiscord:
+		*s = UpdateNotificationPostRequestProviderDiscord
+	case UpdateNotificationPostRequestProviderMatrix:
+		*s = UpdateNotificationPostRequestProviderMatrix
+	case UpdateNotificationPostRequestProviderNtfy:
+		*s = UpdateNotificationPostRequestProviderNtfy
+	case UpdateNotificationPostRequestProviderEmail:
+		*s = UpdateNotificationPostRequestProviderEmail
 	default:
 		*s = UpdateNotificationPostRequestProvider(v)
 	}
@@ -12453,46 +26038,252 @@ func (s *UpdateTwitchUserPostRequest) Decode(d *jx.Decoder) error {
 			}
 		case "irc_only_when_live":
 			if err := func() error {
-				s.IrcOnlyWhenLive.Reset()
-				if err := s.IrcOnlyWhenLive.Decode(d); err != nil {
+				s.IrcOnlyWhenLive.Reset()
+				if err := s.IrcOnlyWhenLive.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"irc_only_when_live\"")
+			}
+		case "notify_off_stream_messages":
+			if err := func() error {
+				s.NotifyOffStreamMessages.Reset()
+				if err := s.NotifyOffStreamMessages.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notify_off_stream_messages\"")
+			}
+		case "notify_stream_start":
+			if err := func() error {
+				s.NotifyStreamStart.Reset()
+				if err := s.NotifyStreamStart.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notify_stream_start\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateTwitchUserPostRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000001,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUpdateTwitchUserPostRequest) {
+					name = jsonFieldsNameOfUpdateTwitchUserPostRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateTwitchUserPostRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateTwitchUserPostRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UploadBlocklistBadRequest as json.
+func (s *UploadBlocklistBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UploadBlocklistBadRequest from json.
+func (s *UploadBlocklistBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadBlocklistBadRequest to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UploadBlocklistBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UploadBlocklistBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadBlocklistBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UploadBlocklistNotFound as json.
+func (s *UploadBlocklistNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorMessage)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UploadBlocklistNotFound from json.
+func (s *UploadBlocklistNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadBlocklistNotFound to nil")
+	}
+	var unwrapped ErrorMessage
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UploadBlocklistNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UploadBlocklistNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UploadBlocklistNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UploadBlocklistRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UploadBlocklistRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int64(s.ID)
+	}
+	{
+		if s.Filename.Set {
+			e.FieldStart("filename")
+			s.Filename.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("content")
+		e.Str(s.Content)
+	}
+}
+
+var jsonFieldsNameOfUploadBlocklistRequest = [3]string{
+	0: "id",
+	1: "filename",
+	2: "content",
+}
+
+// Decode decodes UploadBlocklistRequest from json.
+func (s *UploadBlocklistRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadBlocklistRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.ID = int64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"irc_only_when_live\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "notify_off_stream_messages":
+		case "filename":
 			if err := func() error {
-				s.NotifyOffStreamMessages.Reset()
-				if err := s.NotifyOffStreamMessages.Decode(d); err != nil {
+				s.Filename.Reset()
+				if err := s.Filename.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"notify_off_stream_messages\"")
+				return errors.Wrap(err, "decode field \"filename\"")
 			}
-		case "notify_stream_start":
+		case "content":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.NotifyStreamStart.Reset()
-				if err := s.NotifyStreamStart.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Content = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"notify_stream_start\"")
+				return errors.Wrap(err, "decode field \"content\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UpdateTwitchUserPostRequest")
+		return errors.Wrap(err, "decode UploadBlocklistRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00000001,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000101,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -12504,8 +26295,8 @@ func (s *UpdateTwitchUserPostRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUpdateTwitchUserPostRequest) {
-					name = jsonFieldsNameOfUpdateTwitchUserPostRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUploadBlocklistRequest) {
+					name = jsonFieldsNameOfUploadBlocklistRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -12526,14 +26317,14 @@ func (s *UpdateTwitchUserPostRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UpdateTwitchUserPostRequest) MarshalJSON() ([]byte, error) {
+func (s *UploadBlocklistRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UpdateTwitchUserPostRequest) UnmarshalJSON(data []byte) error {
+func (s *UploadBlocklistRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -12797,6 +26588,12 @@ func (s *UserActivityEventEventType) Decode(d *jx.Decoder) error {
 		*s = UserActivityEventEventTypeChatOffline
 	case UserActivityEventEventTypeMessage:
 		*s = UserActivityEventEventTypeMessage
+	case UserActivityEventEventTypeBan:
+		*s = UserActivityEventEventTypeBan
+	case UserActivityEventEventTypeTimeout:
+		*s = UserActivityEventEventTypeTimeout
+	case UserActivityEventEventTypeFirstSeenInChannel:
+		*s = UserActivityEventEventTypeFirstSeenInChannel
 	default:
 		*s = UserActivityEventEventType(v)
 	}
@@ -12817,6 +26614,259 @@ func (s *UserActivityEventEventType) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *UserLink) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UserLink) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("twitch_user_id")
+		e.Int64(s.TwitchUserID)
+	}
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.Score.Set {
+			e.FieldStart("score")
+			s.Score.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("note")
+		e.Str(s.Note)
+	}
+	{
+		if s.DecidedByUserID.Set {
+			e.FieldStart("decided_by_user_id")
+			s.DecidedByUserID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfUserLink = [8]string{
+	0: "twitch_user_id",
+	1: "username",
+	2: "status",
+	3: "score",
+	4: "note",
+	5: "decided_by_user_id",
+	6: "created_at",
+	7: "updated_at",
+}
+
+// Decode decodes UserLink from json.
+func (s *UserLink) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UserLink to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "twitch_user_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int64()
+				s.TwitchUserID = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"twitch_user_id\"")
+			}
+		case "username":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "score":
+			if err := func() error {
+				s.Score.Reset()
+				if err := s.Score.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "note":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Note = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"note\"")
+			}
+		case "decided_by_user_id":
+			if err := func() error {
+				s.DecidedByUserID.Reset()
+				if err := s.DecidedByUserID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"decided_by_user_id\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UserLink")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b11010111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUserLink) {
+					name = jsonFieldsNameOfUserLink[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UserLink) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UserLink) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UserLinkStatus as json.
+func (s UserLinkStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes UserLinkStatus from json.
+func (s *UserLinkStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UserLinkStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch UserLinkStatus(v) {
+	case UserLinkStatusConfirmed:
+		*s = UserLinkStatusConfirmed
+	case UserLinkStatusRejected:
+		*s = UserLinkStatusRejected
+	default:
+		*s = UserLinkStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UserLinkStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UserLinkStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *WatchUiHints) Encode(e *jx.Encoder) {
 	e.ObjStart()
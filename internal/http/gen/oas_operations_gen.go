@@ -14,34 +14,64 @@ const (
 	CountTwitchMessagesOperation              OperationName = "CountTwitchMessages"
 	CreateAiConversationOperation             OperationName = "CreateAiConversation"
 	CreateAiMessageOperation                  OperationName = "CreateAiMessage"
+	CreateBlocklistOperation                  OperationName = "CreateBlocklist"
+	CreateLoginPatternOperation               OperationName = "CreateLoginPattern"
 	CreateNotificationOperation               OperationName = "CreateNotification"
+	CreateNotificationSnoozeOperation         OperationName = "CreateNotificationSnooze"
 	CreateRuleOperation                       OperationName = "CreateRule"
 	CreateTwitchAccountOperation              OperationName = "CreateTwitchAccount"
 	CreateTwitchUserOperation                 OperationName = "CreateTwitchUser"
 	DeleteAiConversationOperation             OperationName = "DeleteAiConversation"
+	DeleteBlocklistOperation                  OperationName = "DeleteBlocklist"
+	DeleteEmoteSetOperation                   OperationName = "DeleteEmoteSet"
+	DeleteLoginPatternOperation               OperationName = "DeleteLoginPattern"
 	DeleteNotificationOperation               OperationName = "DeleteNotification"
+	DeleteNotificationSnoozeOperation         OperationName = "DeleteNotificationSnooze"
 	DeleteRuleOperation                       OperationName = "DeleteRule"
 	DeleteTwitchAccountOperation              OperationName = "DeleteTwitchAccount"
+	DeleteTwitchUserLinkOperation             OperationName = "DeleteTwitchUserLink"
 	DenyChannelDiscoveryCandidateOperation    OperationName = "DenyChannelDiscoveryCandidate"
 	GetAiSettingsOperation                    OperationName = "GetAiSettings"
+	GetAudienceOverlapOperation               OperationName = "GetAudienceOverlap"
+	GetBotDetectionSettingsOperation          OperationName = "GetBotDetectionSettings"
+	GetChannelActivityHeatmapOperation        OperationName = "GetChannelActivityHeatmap"
+	GetChannelAnalyticsOperation              OperationName = "GetChannelAnalytics"
 	GetChannelDiscoverySettingsOperation      OperationName = "GetChannelDiscoverySettings"
+	GetChannelEmoteUsageOperation             OperationName = "GetChannelEmoteUsage"
+	GetChannelLeaderboardOperation            OperationName = "GetChannelLeaderboard"
 	GetChannelLiveOperation                   OperationName = "GetChannelLive"
+	GetChatClassifierSettingsOperation        OperationName = "GetChatClassifierSettings"
 	GetIrcMonitorSettingsOperation            OperationName = "GetIrcMonitorSettings"
 	GetIrcMonitorStatusOperation              OperationName = "GetIrcMonitorStatus"
+	GetMonitoredLeaderboardOperation          OperationName = "GetMonitoredLeaderboard"
 	GetRecordedStreamOperation                OperationName = "GetRecordedStream"
+	GetRecordedStreamEmotesOperation          OperationName = "GetRecordedStreamEmotes"
 	GetRecordedStreamLeaderboardOperation     OperationName = "GetRecordedStreamLeaderboard"
+	GetRecordedStreamTermSeriesOperation      OperationName = "GetRecordedStreamTermSeries"
+	GetRecordedStreamTermsOperation           OperationName = "GetRecordedStreamTerms"
+	GetStreamRecapSettingsOperation           OperationName = "GetStreamRecapSettings"
+	GetSuspicionReevaluationOperation         OperationName = "GetSuspicionReevaluation"
 	GetSuspicionSettingsOperation             OperationName = "GetSuspicionSettings"
 	GetSystemStatsOperation                   OperationName = "GetSystemStats"
+	GetTwitchUserActivityHeatmapOperation     OperationName = "GetTwitchUserActivityHeatmap"
 	GetTwitchUserActivityTimelineOperation    OperationName = "GetTwitchUserActivityTimeline"
+	GetTwitchUserEmoteUsageOperation          OperationName = "GetTwitchUserEmoteUsage"
 	GetTwitchUserProfileOperation             OperationName = "GetTwitchUserProfile"
 	GetWatchUiHintsOperation                  OperationName = "GetWatchUiHints"
 	ListAiConversationsOperation              OperationName = "ListAiConversations"
 	ListAiMessagesOperation                   OperationName = "ListAiMessages"
+	ListBlocklistsOperation                   OperationName = "ListBlocklists"
 	ListChannelBlacklistOperation             OperationName = "ListChannelBlacklist"
+	ListChannelBotEstimatesOperation          OperationName = "ListChannelBotEstimates"
 	ListChannelChattersOperation              OperationName = "ListChannelChatters"
 	ListChannelDiscoveryCandidatesOperation   OperationName = "ListChannelDiscoveryCandidates"
 	ListChatHistoryOperation                  OperationName = "ListChatHistory"
+	ListEmoteSetsOperation                    OperationName = "ListEmoteSets"
 	ListIrcMonitorJoinedHistoryOperation      OperationName = "ListIrcMonitorJoinedHistory"
+	ListLikelyBotsOperation                   OperationName = "ListLikelyBots"
+	ListLoginPatternsOperation                OperationName = "ListLoginPatterns"
+	ListNotificationDeliveriesOperation       OperationName = "ListNotificationDeliveries"
+	ListNotificationSnoozesOperation          OperationName = "ListNotificationSnoozes"
 	ListNotificationsOperation                OperationName = "ListNotifications"
 	ListRecordedStreamActivityOperation       OperationName = "ListRecordedStreamActivity"
 	ListRecordedStreamMessagesOperation       OperationName = "ListRecordedStreamMessages"
@@ -49,6 +79,7 @@ const (
 	ListRuleTemplateVariablesOperation        OperationName = "ListRuleTemplateVariables"
 	ListRuleTriggersOperation                 OperationName = "ListRuleTriggers"
 	ListRulesOperation                        OperationName = "ListRules"
+	ListSuspicionEventsOperation              OperationName = "ListSuspicionEvents"
 	ListTwitchAccountsOperation               OperationName = "ListTwitchAccounts"
 	ListTwitchDirectoryUsersOperation         OperationName = "ListTwitchDirectoryUsers"
 	ListTwitchMessagesOperation               OperationName = "ListTwitchMessages"
@@ -57,16 +88,30 @@ const (
 	LoginOperation                            OperationName = "Login"
 	MeOperation                               OperationName = "Me"
 	PatchAiSettingsOperation                  OperationName = "PatchAiSettings"
+	PreviewRuleNotifyOperation                OperationName = "PreviewRuleNotify"
+	ResendNotificationDeliveryOperation       OperationName = "ResendNotificationDelivery"
+	SaveEmoteSetOperation                     OperationName = "SaveEmoteSet"
+	ScanLikelyBotsOperation                   OperationName = "ScanLikelyBots"
+	ScanTwitchUserAltsOperation               OperationName = "ScanTwitchUserAlts"
 	SendMessageOperation                      OperationName = "SendMessage"
 	SetChannelBlacklistOperation              OperationName = "SetChannelBlacklist"
+	SetTwitchUserLinkOperation                OperationName = "SetTwitchUserLink"
+	StartSuspicionReevaluationOperation       OperationName = "StartSuspicionReevaluation"
 	StartTwitchOAuthOperation                 OperationName = "StartTwitchOAuth"
 	StopAiAgentOperation                      OperationName = "StopAiAgent"
+	SyncBlocklistOperation                    OperationName = "SyncBlocklist"
+	TestLoginPatternOperation                 OperationName = "TestLoginPattern"
+	TestNotificationOperation                 OperationName = "TestNotification"
 	TestRuleRegexOperation                    OperationName = "TestRuleRegex"
+	UpdateBotDetectionSettingsOperation       OperationName = "UpdateBotDetectionSettings"
 	UpdateChannelDiscoverySettingsOperation   OperationName = "UpdateChannelDiscoverySettings"
+	UpdateChatClassifierSettingsOperation     OperationName = "UpdateChatClassifierSettings"
 	UpdateIrcMonitorSettingsOperation         OperationName = "UpdateIrcMonitorSettings"
 	UpdateNotificationOperation               OperationName = "UpdateNotification"
 	UpdateRuleOperation                       OperationName = "UpdateRule"
+	UpdateStreamRecapSettingsOperation        OperationName = "UpdateStreamRecapSettings"
 	UpdateSuspicionSettingsOperation          OperationName = "UpdateSuspicionSettings"
 	UpdateTwitchAccountOperation              OperationName = "UpdateTwitchAccount"
 	UpdateTwitchUserOperation                 OperationName = "UpdateTwitchUser"
+	UploadBlocklistOperation                  OperationName = "UploadBlocklist"
 )
@@ -780,111 +780,199 @@ func decodeDenyChannelDiscoveryCandidateParams(args [1]string, argsEscaped bool,
 	return params, nil
 }
 
-// GetRecordedStreamParams is parameters of getRecordedStream operation.
-type GetRecordedStreamParams struct {
-	StreamId int64
+// GetAudienceOverlapParams is parameters of getAudienceOverlap operation.
+type GetAudienceOverlapParams struct {
+	Period OptAudienceOverlapPeriod `json:",omitempty,omitzero"`
+	// Recompute instead of serving the cached result.
+	Refresh OptBool `json:",omitempty,omitzero"`
 }
 
-func unpackGetRecordedStreamParams(packed middleware.Parameters) (params GetRecordedStreamParams) {
+func unpackGetAudienceOverlapParams(packed middleware.Parameters) (params GetAudienceOverlapParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "streamId",
-			In:   "path",
+			Name: "period",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Period = v.(OptAudienceOverlapPeriod)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "refresh",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Refresh = v.(OptBool)
 		}
-		params.StreamId = packed[key].(int64)
 	}
 	return params
 }
 
-func decodeGetRecordedStreamParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRecordedStreamParams, _ error) {
-	// Decode path: streamId.
+func decodeGetAudienceOverlapParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAudienceOverlapParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: period.
+	{
+		val := AudienceOverlapPeriod("7d")
+		params.Period.SetTo(val)
+	}
+	// Decode query: period.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "streamId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPeriodVal AudienceOverlapPeriod
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPeriodVal = AudienceOverlapPeriod(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Period.SetTo(paramsDotPeriodVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Period.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "period",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: refresh.
+	{
+		val := bool(false)
+		params.Refresh.SetTo(val)
+	}
+	// Decode query: refresh.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "refresh",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-				c, err := conv.ToInt64(val)
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRefreshVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRefreshVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.StreamId = c
+				params.Refresh.SetTo(paramsDotRefreshVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "streamId",
-			In:   "path",
+			Name: "refresh",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetRecordedStreamLeaderboardParams is parameters of getRecordedStreamLeaderboard operation.
-type GetRecordedStreamLeaderboardParams struct {
-	StreamId int64
-	Sort     OptStreamLeaderboardSort `json:",omitempty,omitzero"`
-	// Filter chatter login (substring, case-insensitive).
-	Q OptString `json:",omitempty,omitzero"`
+// GetChannelActivityHeatmapParams is parameters of getChannelActivityHeatmap operation.
+type GetChannelActivityHeatmapParams struct {
+	Login string
+	// Range start (default to minus 28 days).
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Range end (default and maximum now); ranges are limited to 92 days.
+	To OptDateTime `json:",omitempty,omitzero"`
+	// IANA timezone the weekdays and hours are read in.
+	Timezone OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetRecordedStreamLeaderboardParams(packed middleware.Parameters) (params GetRecordedStreamLeaderboardParams) {
+func unpackGetChannelActivityHeatmapParams(packed middleware.Parameters) (params GetChannelActivityHeatmapParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "streamId",
+			Name: "login",
 			In:   "path",
 		}
-		params.StreamId = packed[key].(int64)
+		params.Login = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sort",
+			Name: "from",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Sort = v.(OptStreamLeaderboardSort)
+			params.From = v.(OptDateTime)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "q",
+			Name: "to",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Q = v.(OptString)
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timezone",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Timezone = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetRecordedStreamLeaderboardParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRecordedStreamLeaderboardParams, _ error) {
+func decodeGetChannelActivityHeatmapParams(args [1]string, argsEscaped bool, r *http.Request) (params GetChannelActivityHeatmapParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: streamId.
+	// Decode path: login.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -896,7 +984,7 @@ func decodeGetRecordedStreamLeaderboardParams(args [1]string, argsEscaped bool,
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "streamId",
+				Param:   "login",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -908,12 +996,12 @@ func decodeGetRecordedStreamLeaderboardParams(args [1]string, argsEscaped bool,
 					return err
 				}
 
-				c, err := conv.ToInt64(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.StreamId = c
+				params.Login = c
 				return nil
 			}(); err != nil {
 				return err
@@ -924,78 +1012,109 @@ func decodeGetRecordedStreamLeaderboardParams(args [1]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "streamId",
+			Name: "login",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: sort.
+	// Decode query: from.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort",
+			Name:    "from",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortVal StreamLeaderboardSort
+				var paramsDotFromVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSortVal = StreamLeaderboardSort(c)
+					paramsDotFromVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sort.SetTo(paramsDotSortVal)
+				params.From.SetTo(paramsDotFromVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Sort.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
 						return err
 					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.To.SetTo(paramsDotToVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort",
+			Name: "to",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: q.
+	// Set default value for query: timezone.
+	{
+		val := string("UTC")
+		params.Timezone.SetTo(val)
+	}
+	// Decode query: timezone.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "q",
+			Name:    "timezone",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotQVal string
+				var paramsDotTimezoneVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1007,12 +1126,12 @@ func decodeGetRecordedStreamLeaderboardParams(args [1]string, argsEscaped bool,
 						return err
 					}
 
-					paramsDotQVal = c
+					paramsDotTimezoneVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Q.SetTo(paramsDotQVal)
+				params.Timezone.SetTo(paramsDotTimezoneVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1021,7 +1140,7 @@ func decodeGetRecordedStreamLeaderboardParams(args [1]string, argsEscaped bool,
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
+			Name: "timezone",
 			In:   "query",
 			Err:  err,
 		}
@@ -1029,24 +1148,57 @@ func decodeGetRecordedStreamLeaderboardParams(args [1]string, argsEscaped bool,
 	return params, nil
 }
 
-// ListAiMessagesParams is parameters of listAiMessages operation.
-type ListAiMessagesParams struct {
-	ConversationId int64
+// GetChannelAnalyticsParams is parameters of getChannelAnalytics operation.
+type GetChannelAnalyticsParams struct {
+	Login  string
+	Bucket OptChannelAnalyticsBucket `json:",omitempty,omitzero"`
+	// Range start (default to minus 3h, 7d or 90d by bucket); aligned down to the bucket.
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Range end (default and maximum now).
+	To OptDateTime `json:",omitempty,omitzero"`
 }
 
-func unpackListAiMessagesParams(packed middleware.Parameters) (params ListAiMessagesParams) {
+func unpackGetChannelAnalyticsParams(packed middleware.Parameters) (params GetChannelAnalyticsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "conversationId",
+			Name: "login",
 			In:   "path",
 		}
-		params.ConversationId = packed[key].(int64)
+		params.Login = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "bucket",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Bucket = v.(OptChannelAnalyticsBucket)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
 	}
 	return params
 }
 
-func decodeListAiMessagesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListAiMessagesParams, _ error) {
-	// Decode path: conversationId.
+func decodeGetChannelAnalyticsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetChannelAnalyticsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: login.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1058,7 +1210,7 @@ func decodeListAiMessagesParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "conversationId",
+				Param:   "login",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1070,12 +1222,12 @@ func decodeListAiMessagesParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				c, err := conv.ToInt64(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.ConversationId = c
+				params.Login = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1086,75 +1238,3838 @@ func decodeListAiMessagesParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "conversationId",
+			Name: "login",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ListChatHistoryParams is parameters of listChatHistory operation.
-type ListChatHistoryParams struct {
-	Channel string
-	Limit   OptInt `json:",omitempty,omitzero"`
-}
-
-func unpackListChatHistoryParams(packed middleware.Parameters) (params ListChatHistoryParams) {
+	// Set default value for query: bucket.
 	{
-		key := middleware.ParameterKey{
-			Name: "channel",
-			In:   "query",
-		}
-		params.Channel = packed[key].(string)
+		val := ChannelAnalyticsBucket("hour")
+		params.Bucket.SetTo(val)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
+	// Decode query: bucket.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "bucket",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBucketVal ChannelAnalyticsBucket
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBucketVal = ChannelAnalyticsBucket(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Bucket.SetTo(paramsDotBucketVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Bucket.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bucket",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetChannelEmoteUsageParams is parameters of getChannelEmoteUsage operation.
+type GetChannelEmoteUsageParams struct {
+	Login string
+	// Range start (default to minus 7 days).
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Range end (default now).
+	To    OptDateTime `json:",omitempty,omitzero"`
+	Limit OptInt      `json:",omitempty,omitzero"`
+}
+
+func unpackGetChannelEmoteUsageParams(packed middleware.Parameters) (params GetChannelEmoteUsageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "login",
+			In:   "path",
+		}
+		params.Login = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetChannelEmoteUsageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetChannelEmoteUsageParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: login.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "login",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Login = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "login",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetChannelLeaderboardParams is parameters of getChannelLeaderboard operation.
+type GetChannelLeaderboardParams struct {
+	Login string
+	// Range start (default to minus 30d); aligned down to a UTC day.
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Range end (default and maximum now); aligned up to a UTC day. Ranges are limited to 366d.
+	To   OptDateTime              `json:",omitempty,omitzero"`
+	Sort OptStreamLeaderboardSort `json:",omitempty,omitzero"`
+	// Filter chatter login (substring, case-insensitive).
+	Q OptString `json:",omitempty,omitzero"`
+	// Hide likely bots (default follows bot detection exclude_from_stats).
+	ExcludeBots OptBool `json:",omitempty,omitzero"`
+	// Hide marked users.
+	ExcludeMarked OptBool `json:",omitempty,omitzero"`
+	// Hide the monitor's own linked Twitch accounts.
+	ExcludeLinked OptBool `json:",omitempty,omitzero"`
+	Limit         OptInt  `json:",omitempty,omitzero"`
+	Offset        OptInt  `json:",omitempty,omitzero"`
+}
+
+func unpackGetChannelLeaderboardParams(packed middleware.Parameters) (params GetChannelLeaderboardParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "login",
+			In:   "path",
+		}
+		params.Login = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptStreamLeaderboardSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Q = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "exclude_bots",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludeBots = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "exclude_marked",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludeMarked = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "exclude_linked",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludeLinked = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetChannelLeaderboardParams(args [1]string, argsEscaped bool, r *http.Request) (params GetChannelLeaderboardParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: login.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "login",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Login = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "login",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal StreamLeaderboardSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = StreamLeaderboardSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Q.SetTo(paramsDotQVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: exclude_bots.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "exclude_bots",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotExcludeBotsVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotExcludeBotsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ExcludeBots.SetTo(paramsDotExcludeBotsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "exclude_bots",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: exclude_marked.
+	{
+		val := bool(false)
+		params.ExcludeMarked.SetTo(val)
+	}
+	// Decode query: exclude_marked.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "exclude_marked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotExcludeMarkedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotExcludeMarkedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ExcludeMarked.SetTo(paramsDotExcludeMarkedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "exclude_marked",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: exclude_linked.
+	{
+		val := bool(false)
+		params.ExcludeLinked.SetTo(val)
+	}
+	// Decode query: exclude_linked.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "exclude_linked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotExcludeLinkedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotExcludeLinkedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ExcludeLinked.SetTo(paramsDotExcludeLinkedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "exclude_linked",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMonitoredLeaderboardParams is parameters of getMonitoredLeaderboard operation.
+type GetMonitoredLeaderboardParams struct {
+	// Range start (default to minus 30d); aligned down to a UTC day.
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Range end (default and maximum now); aligned up to a UTC day. Ranges are limited to 366d.
+	To   OptDateTime              `json:",omitempty,omitzero"`
+	Sort OptStreamLeaderboardSort `json:",omitempty,omitzero"`
+	// Filter chatter login (substring, case-insensitive).
+	Q OptString `json:",omitempty,omitzero"`
+	// Hide likely bots (default follows bot detection exclude_from_stats).
+	ExcludeBots OptBool `json:",omitempty,omitzero"`
+	// Hide marked users.
+	ExcludeMarked OptBool `json:",omitempty,omitzero"`
+	// Hide the monitor's own linked Twitch accounts.
+	ExcludeLinked OptBool `json:",omitempty,omitzero"`
+	Limit         OptInt  `json:",omitempty,omitzero"`
+	Offset        OptInt  `json:",omitempty,omitzero"`
+}
+
+func unpackGetMonitoredLeaderboardParams(packed middleware.Parameters) (params GetMonitoredLeaderboardParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptStreamLeaderboardSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Q = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "exclude_bots",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludeBots = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "exclude_marked",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludeMarked = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "exclude_linked",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludeLinked = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetMonitoredLeaderboardParams(args [0]string, argsEscaped bool, r *http.Request) (params GetMonitoredLeaderboardParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal StreamLeaderboardSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = StreamLeaderboardSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Q.SetTo(paramsDotQVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: exclude_bots.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "exclude_bots",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotExcludeBotsVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotExcludeBotsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ExcludeBots.SetTo(paramsDotExcludeBotsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "exclude_bots",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: exclude_marked.
+	{
+		val := bool(false)
+		params.ExcludeMarked.SetTo(val)
+	}
+	// Decode query: exclude_marked.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "exclude_marked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotExcludeMarkedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotExcludeMarkedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ExcludeMarked.SetTo(paramsDotExcludeMarkedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "exclude_marked",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: exclude_linked.
+	{
+		val := bool(false)
+		params.ExcludeLinked.SetTo(val)
+	}
+	// Decode query: exclude_linked.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "exclude_linked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotExcludeLinkedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotExcludeLinkedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ExcludeLinked.SetTo(paramsDotExcludeLinkedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "exclude_linked",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecordedStreamParams is parameters of getRecordedStream operation.
+type GetRecordedStreamParams struct {
+	StreamId int64
+}
+
+func unpackGetRecordedStreamParams(packed middleware.Parameters) (params GetRecordedStreamParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "streamId",
+			In:   "path",
+		}
+		params.StreamId = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeGetRecordedStreamParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRecordedStreamParams, _ error) {
+	// Decode path: streamId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "streamId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.StreamId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "streamId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecordedStreamEmotesParams is parameters of getRecordedStreamEmotes operation.
+type GetRecordedStreamEmotesParams struct {
+	StreamId int64
+	// How many top emotes to return and chart.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetRecordedStreamEmotesParams(packed middleware.Parameters) (params GetRecordedStreamEmotesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "streamId",
+			In:   "path",
+		}
+		params.StreamId = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetRecordedStreamEmotesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRecordedStreamEmotesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: streamId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "streamId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.StreamId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "streamId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(10)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           50,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecordedStreamLeaderboardParams is parameters of getRecordedStreamLeaderboard operation.
+type GetRecordedStreamLeaderboardParams struct {
+	StreamId int64
+	Sort     OptStreamLeaderboardSort `json:",omitempty,omitzero"`
+	// Filter chatter login (substring, case-insensitive).
+	Q OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetRecordedStreamLeaderboardParams(packed middleware.Parameters) (params GetRecordedStreamLeaderboardParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "streamId",
+			In:   "path",
+		}
+		params.StreamId = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptStreamLeaderboardSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Q = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetRecordedStreamLeaderboardParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRecordedStreamLeaderboardParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: streamId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "streamId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.StreamId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "streamId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal StreamLeaderboardSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = StreamLeaderboardSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Q.SetTo(paramsDotQVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecordedStreamTermSeriesParams is parameters of getRecordedStreamTermSeries operation.
+type GetRecordedStreamTermSeriesParams struct {
+	StreamId int64
+	// A word, a phrase of up to 3 words, or an emote code.
+	Term string
+}
+
+func unpackGetRecordedStreamTermSeriesParams(packed middleware.Parameters) (params GetRecordedStreamTermSeriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "streamId",
+			In:   "path",
+		}
+		params.StreamId = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "term",
+			In:   "query",
+		}
+		params.Term = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetRecordedStreamTermSeriesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRecordedStreamTermSeriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: streamId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "streamId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.StreamId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "streamId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: term.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "term",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Term = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     200,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Term)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "term",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecordedStreamTermsParams is parameters of getRecordedStreamTerms operation.
+type GetRecordedStreamTermsParams struct {
+	StreamId int64
+	// Window start (default stream start).
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Window end, exclusive (default stream end or now).
+	To    OptDateTime `json:",omitempty,omitzero"`
+	Limit OptInt      `json:",omitempty,omitzero"`
+}
+
+func unpackGetRecordedStreamTermsParams(packed middleware.Parameters) (params GetRecordedStreamTermsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "streamId",
+			In:   "path",
+		}
+		params.StreamId = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetRecordedStreamTermsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRecordedStreamTermsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: streamId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "streamId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.StreamId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "streamId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(25)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTwitchUserActivityHeatmapParams is parameters of getTwitchUserActivityHeatmap operation.
+type GetTwitchUserActivityHeatmapParams struct {
+	TwitchUserID int64
+	// Range start (default to minus 28 days).
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Range end (default and maximum now); ranges are limited to 92 days.
+	To OptDateTime `json:",omitempty,omitzero"`
+	// IANA timezone the weekdays and hours are read in.
+	Timezone OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetTwitchUserActivityHeatmapParams(packed middleware.Parameters) (params GetTwitchUserActivityHeatmapParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "twitch_user_id",
+			In:   "path",
+		}
+		params.TwitchUserID = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "timezone",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Timezone = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetTwitchUserActivityHeatmapParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTwitchUserActivityHeatmapParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: twitch_user_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "twitch_user_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.TwitchUserID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "twitch_user_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: timezone.
+	{
+		val := string("UTC")
+		params.Timezone.SetTo(val)
+	}
+	// Decode query: timezone.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "timezone",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimezoneVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTimezoneVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Timezone.SetTo(paramsDotTimezoneVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "timezone",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTwitchUserEmoteUsageParams is parameters of getTwitchUserEmoteUsage operation.
+type GetTwitchUserEmoteUsageParams struct {
+	TwitchUserID int64
+	// Range start (default to minus 7 days).
+	From OptDateTime `json:",omitempty,omitzero"`
+	// Range end (default now).
+	To    OptDateTime `json:",omitempty,omitzero"`
+	Limit OptInt      `json:",omitempty,omitzero"`
+}
+
+func unpackGetTwitchUserEmoteUsageParams(packed middleware.Parameters) (params GetTwitchUserEmoteUsageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "twitch_user_id",
+			In:   "path",
+		}
+		params.TwitchUserID = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetTwitchUserEmoteUsageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTwitchUserEmoteUsageParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: twitch_user_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "twitch_user_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.TwitchUserID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "twitch_user_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAiMessagesParams is parameters of listAiMessages operation.
+type ListAiMessagesParams struct {
+	ConversationId int64
+}
+
+func unpackListAiMessagesParams(packed middleware.Parameters) (params ListAiMessagesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "conversationId",
+			In:   "path",
+		}
+		params.ConversationId = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeListAiMessagesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListAiMessagesParams, _ error) {
+	// Decode path: conversationId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "conversationId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.ConversationId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "conversationId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListChatHistoryParams is parameters of listChatHistory operation.
+type ListChatHistoryParams struct {
+	Channel string
+	Limit   OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListChatHistoryParams(packed middleware.Parameters) (params ListChatHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "channel",
+			In:   "query",
+		}
+		params.Channel = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListChatHistoryParams(args [0]string, argsEscaped bool, r *http.Request) (params ListChatHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: channel.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "channel",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Channel = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "channel",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListIrcMonitorJoinedHistoryParams is parameters of listIrcMonitorJoinedHistory operation.
+type ListIrcMonitorJoinedHistoryParams struct {
+	// Number of calendar days of history ending at request time (UTC window).
+	Days OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListIrcMonitorJoinedHistoryParams(packed middleware.Parameters) (params ListIrcMonitorJoinedHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "days",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Days = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListIrcMonitorJoinedHistoryParams(args [0]string, argsEscaped bool, r *http.Request) (params ListIrcMonitorJoinedHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: days.
+	{
+		val := int(7)
+		params.Days.SetTo(val)
+	}
+	// Decode query: days.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "days",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDaysVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDaysVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Days.SetTo(paramsDotDaysVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Days.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           90,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "days",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListLikelyBotsParams is parameters of listLikelyBots operation.
+type ListLikelyBotsParams struct {
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListLikelyBotsParams(packed middleware.Parameters) (params ListLikelyBotsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListLikelyBotsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListLikelyBotsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListNotificationDeliveriesParams is parameters of listNotificationDeliveries operation.
+type ListNotificationDeliveriesParams struct {
+	// Only deliveries for this notification entry.
+	NotificationID OptInt64                      `json:",omitempty,omitzero"`
+	Status         OptNotificationDeliveryStatus `json:",omitempty,omitzero"`
+	Limit          OptInt                        `json:",omitempty,omitzero"`
+	// Keyset cursor; use with cursor_id from the last delivery of the previous batch.
+	CursorCreatedAt OptDateTime `json:",omitempty,omitzero"`
+	CursorID        OptInt64    `json:",omitempty,omitzero"`
+}
+
+func unpackListNotificationDeliveriesParams(packed middleware.Parameters) (params ListNotificationDeliveriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "notification_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.NotificationID = v.(OptInt64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptNotificationDeliveryStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor_created_at",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CursorCreatedAt = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CursorID = v.(OptInt64)
+		}
+	}
+	return params
+}
+
+func decodeListNotificationDeliveriesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListNotificationDeliveriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: notification_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "notification_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNotificationIDVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNotificationIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.NotificationID.SetTo(paramsDotNotificationIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "notification_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal NotificationDeliveryStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = NotificationDeliveryStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor_created_at.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorCreatedAtVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorCreatedAtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CursorCreatedAt.SetTo(paramsDotCursorCreatedAtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor_created_at",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorIDVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CursorID.SetTo(paramsDotCursorIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListNotificationsParams is parameters of listNotifications operation.
+type ListNotificationsParams struct {
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Keyset cursor; use with cursor_id from the last entry of the previous batch.
+	CursorCreatedAt OptDateTime `json:",omitempty,omitzero"`
+	CursorID        OptInt64    `json:",omitempty,omitzero"`
+}
+
+func unpackListNotificationsParams(packed middleware.Parameters) (params ListNotificationsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor_created_at",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CursorCreatedAt = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CursorID = v.(OptInt64)
+		}
+	}
+	return params
+}
+
+func decodeListNotificationsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListNotificationsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor_created_at.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor_created_at",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorCreatedAtVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorCreatedAtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CursorCreatedAt.SetTo(paramsDotCursorCreatedAtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor_created_at",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorIDVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CursorID.SetTo(paramsDotCursorIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListRecordedStreamActivityParams is parameters of listRecordedStreamActivity operation.
+type ListRecordedStreamActivityParams struct {
+	StreamId        int64
+	Limit           OptInt      `json:",omitempty,omitzero"`
+	CursorCreatedAt OptDateTime `json:",omitempty,omitzero"`
+	CursorID        OptInt64    `json:",omitempty,omitzero"`
+}
+
+func unpackListRecordedStreamActivityParams(packed middleware.Parameters) (params ListRecordedStreamActivityParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "streamId",
+			In:   "path",
+		}
+		params.StreamId = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
 			params.Limit = v.(OptInt)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor_created_at",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CursorCreatedAt = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CursorID = v.(OptInt64)
+		}
+	}
 	return params
 }
 
-func decodeListChatHistoryParams(args [0]string, argsEscaped bool, r *http.Request) (params ListChatHistoryParams, _ error) {
+func decodeListRecordedStreamActivityParams(args [1]string, argsEscaped bool, r *http.Request) (params ListRecordedStreamActivityParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: channel.
+	// Decode path: streamId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "channel",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "streamId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt64(val)
 				if err != nil {
 					return err
 				}
 
-				params.Channel = c
+				params.StreamId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "channel",
-			In:   "query",
+			Name: "streamId",
+			In:   "path",
 			Err:  err,
 		}
 	}
@@ -1229,97 +5144,84 @@ func decodeListChatHistoryParams(args [0]string, argsEscaped bool, r *http.Reque
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ListIrcMonitorJoinedHistoryParams is parameters of listIrcMonitorJoinedHistory operation.
-type ListIrcMonitorJoinedHistoryParams struct {
-	// Number of calendar days of history ending at request time (UTC window).
-	Days OptInt `json:",omitempty,omitzero"`
-}
-
-func unpackListIrcMonitorJoinedHistoryParams(packed middleware.Parameters) (params ListIrcMonitorJoinedHistoryParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "days",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Days = v.(OptInt)
-		}
-	}
-	return params
-}
-
-func decodeListIrcMonitorJoinedHistoryParams(args [0]string, argsEscaped bool, r *http.Request) (params ListIrcMonitorJoinedHistoryParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: days.
-	{
-		val := int(7)
-		params.Days.SetTo(val)
-	}
-	// Decode query: days.
+	// Decode query: cursor_created_at.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "days",
+			Name:    "cursor_created_at",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDaysVal int
+				var paramsDotCursorCreatedAtVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotDaysVal = c
+					paramsDotCursorCreatedAtVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Days.SetTo(paramsDotDaysVal)
+				params.CursorCreatedAt.SetTo(paramsDotCursorCreatedAtVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Days.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           90,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor_created_at",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorIDVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
 						return err
 					}
+
+					paramsDotCursorIDVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.CursorID.SetTo(paramsDotCursorIDVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "days",
+			Name: "cursor_id",
 			In:   "query",
 			Err:  err,
 		}
@@ -1327,47 +5229,129 @@ func decodeListIrcMonitorJoinedHistoryParams(args [0]string, argsEscaped bool, r
 	return params, nil
 }
 
-// ListNotificationsParams is parameters of listNotifications operation.
-type ListNotificationsParams struct {
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Keyset cursor; use with cursor_id from the last entry of the previous batch.
+// ListRecordedStreamMessagesParams is parameters of listRecordedStreamMessages operation.
+type ListRecordedStreamMessagesParams struct {
+	StreamId        int64
+	Limit           OptInt      `json:",omitempty,omitzero"`
 	CursorCreatedAt OptDateTime `json:",omitempty,omitzero"`
 	CursorID        OptInt64    `json:",omitempty,omitzero"`
+	Username        OptString   `json:",omitempty,omitzero"`
+	Text            OptString   `json:",omitempty,omitzero"`
+	ChatterUserID   OptInt64    `json:",omitempty,omitzero"`
 }
 
-func unpackListNotificationsParams(packed middleware.Parameters) (params ListNotificationsParams) {
+func unpackListRecordedStreamMessagesParams(packed middleware.Parameters) (params ListRecordedStreamMessagesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "streamId",
+			In:   "path",
+		}
+		params.StreamId = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor_created_at",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CursorCreatedAt = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.CursorID = v.(OptInt64)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "username",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.Username = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "cursor_created_at",
+			Name: "text",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.CursorCreatedAt = v.(OptDateTime)
+			params.Text = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "cursor_id",
+			Name: "chatter_user_id",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.CursorID = v.(OptInt64)
+			params.ChatterUserID = v.(OptInt64)
 		}
 	}
 	return params
 }
 
-func decodeListNotificationsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListNotificationsParams, _ error) {
+func decodeListRecordedStreamMessagesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListRecordedStreamMessagesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: streamId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "streamId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.StreamId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "streamId",
+			In:   "path",
+			Err:  err,
+		}
+	}
 	// Set default value for query: limit.
 	{
 		val := int(50)
@@ -1521,24 +5505,151 @@ func decodeListNotificationsParams(args [0]string, argsEscaped bool, r *http.Req
 			Err:  err,
 		}
 	}
+	// Decode query: username.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "username",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUsernameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUsernameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Username.SetTo(paramsDotUsernameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "username",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: text.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "text",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTextVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTextVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Text.SetTo(paramsDotTextVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "text",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: chatter_user_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "chatter_user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotChatterUserIDVal int64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotChatterUserIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ChatterUserID.SetTo(paramsDotChatterUserIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "chatter_user_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// ListRecordedStreamActivityParams is parameters of listRecordedStreamActivity operation.
-type ListRecordedStreamActivityParams struct {
-	StreamId        int64
-	Limit           OptInt      `json:",omitempty,omitzero"`
-	CursorCreatedAt OptDateTime `json:",omitempty,omitzero"`
+// ListRecordedStreamsParams is parameters of listRecordedStreams operation.
+type ListRecordedStreamsParams struct {
+	// Filter by channel login.
+	ChannelLogin OptString `json:",omitempty,omitzero"`
+	Limit        OptInt    `json:",omitempty,omitzero"`
+	// Keyset cursor; use with cursor_id from the last row of the previous page.
+	CursorStartedAt OptDateTime `json:",omitempty,omitzero"`
 	CursorID        OptInt64    `json:",omitempty,omitzero"`
 }
 
-func unpackListRecordedStreamActivityParams(packed middleware.Parameters) (params ListRecordedStreamActivityParams) {
+func unpackListRecordedStreamsParams(packed middleware.Parameters) (params ListRecordedStreamsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "streamId",
-			In:   "path",
+			Name: "channel_login",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ChannelLogin = v.(OptString)
 		}
-		params.StreamId = packed[key].(int64)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -1551,11 +5662,11 @@ func unpackListRecordedStreamActivityParams(packed middleware.Parameters) (param
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "cursor_created_at",
+			Name: "cursor_started_at",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.CursorCreatedAt = v.(OptDateTime)
+			params.CursorStartedAt = v.(OptDateTime)
 		}
 	}
 	{
@@ -1570,50 +5681,46 @@ func unpackListRecordedStreamActivityParams(packed middleware.Parameters) (param
 	return params
 }
 
-func decodeListRecordedStreamActivityParams(args [1]string, argsEscaped bool, r *http.Request) (params ListRecordedStreamActivityParams, _ error) {
+func decodeListRecordedStreamsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListRecordedStreamsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: streamId.
+	// Decode query: channel_login.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "channel_login",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "streamId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotChannelLoginVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToInt64(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotChannelLoginVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.StreamId = c
+				params.ChannelLogin.SetTo(paramsDotChannelLoginVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "streamId",
-			In:   "path",
+			Name: "channel_login",
+			In:   "query",
 			Err:  err,
 		}
 	}
@@ -1688,17 +5795,17 @@ func decodeListRecordedStreamActivityParams(args [1]string, argsEscaped bool, r
 			Err:  err,
 		}
 	}
-	// Decode query: cursor_created_at.
+	// Decode query: cursor_started_at.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "cursor_created_at",
+			Name:    "cursor_started_at",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCursorCreatedAtVal time.Time
+				var paramsDotCursorStartedAtVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1710,12 +5817,12 @@ func decodeListRecordedStreamActivityParams(args [1]string, argsEscaped bool, r
 						return err
 					}
 
-					paramsDotCursorCreatedAtVal = c
+					paramsDotCursorStartedAtVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.CursorCreatedAt.SetTo(paramsDotCursorCreatedAtVal)
+				params.CursorStartedAt.SetTo(paramsDotCursorStartedAtVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1724,7 +5831,7 @@ func decodeListRecordedStreamActivityParams(args [1]string, argsEscaped bool, r
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "cursor_created_at",
+			Name: "cursor_started_at",
 			In:   "query",
 			Err:  err,
 		}
@@ -1773,25 +5880,15 @@ func decodeListRecordedStreamActivityParams(args [1]string, argsEscaped bool, r
 	return params, nil
 }
 
-// ListRecordedStreamMessagesParams is parameters of listRecordedStreamMessages operation.
-type ListRecordedStreamMessagesParams struct {
-	StreamId        int64
-	Limit           OptInt      `json:",omitempty,omitzero"`
+// ListRuleTriggersParams is parameters of listRuleTriggers operation.
+type ListRuleTriggersParams struct {
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Keyset cursor; use with cursor_id from the last entry of the previous batch.
 	CursorCreatedAt OptDateTime `json:",omitempty,omitzero"`
 	CursorID        OptInt64    `json:",omitempty,omitzero"`
-	Username        OptString   `json:",omitempty,omitzero"`
-	Text            OptString   `json:",omitempty,omitzero"`
-	ChatterUserID   OptInt64    `json:",omitempty,omitzero"`
 }
 
-func unpackListRecordedStreamMessagesParams(packed middleware.Parameters) (params ListRecordedStreamMessagesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "streamId",
-			In:   "path",
-		}
-		params.StreamId = packed[key].(int64)
-	}
+func unpackListRuleTriggersParams(packed middleware.Parameters) (params ListRuleTriggersParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "limit",
@@ -1819,83 +5916,11 @@ func unpackListRecordedStreamMessagesParams(packed middleware.Parameters) (param
 			params.CursorID = v.(OptInt64)
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "username",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Username = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "text",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Text = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "chatter_user_id",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ChatterUserID = v.(OptInt64)
-		}
-	}
 	return params
 }
 
-func decodeListRecordedStreamMessagesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListRecordedStreamMessagesParams, _ error) {
+func decodeListRuleTriggersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListRuleTriggersParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: streamId.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "streamId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToInt64(val)
-				if err != nil {
-					return err
-				}
-
-				params.StreamId = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "streamId",
-			In:   "path",
-			Err:  err,
-		}
-	}
 	// Set default value for query: limit.
 	{
 		val := int(50)
@@ -2049,150 +6074,47 @@ func decodeListRecordedStreamMessagesParams(args [1]string, argsEscaped bool, r
 			Err:  err,
 		}
 	}
-	// Decode query: username.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "username",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUsernameVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+// ListSuspicionEventsParams is parameters of listSuspicionEvents operation.
+type ListSuspicionEventsParams struct {
+	TwitchUserID OptInt64                `json:",omitempty,omitzero"`
+	Source       OptSuspicionEventSource `json:",omitempty,omitzero"`
+	// True for events that marked a user suspicious, false for events that cleared it.
+	NewIsSus OptBool `json:",omitempty,omitzero"`
+	Limit    OptInt  `json:",omitempty,omitzero"`
+	// Keyset cursor; use with cursor_id from the last entry of the previous batch.
+	CursorCreatedAt OptDateTime `json:",omitempty,omitzero"`
+	CursorID        OptInt64    `json:",omitempty,omitzero"`
+}
 
-					paramsDotUsernameVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Username.SetTo(paramsDotUsernameVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "username",
+func unpackListSuspicionEventsParams(packed middleware.Parameters) (params ListSuspicionEventsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "twitch_user_id",
 			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: text.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "text",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotTextVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotTextVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Text.SetTo(paramsDotTextVal)
-				return nil
-			}); err != nil {
-				return err
-			}
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "text",
-			In:   "query",
-			Err:  err,
+		if v, ok := packed[key]; ok {
+			params.TwitchUserID = v.(OptInt64)
 		}
 	}
-	// Decode query: chatter_user_id.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "chatter_user_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotChatterUserIDVal int64
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt64(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotChatterUserIDVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.ChatterUserID.SetTo(paramsDotChatterUserIDVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "chatter_user_id",
+	{
+		key := middleware.ParameterKey{
+			Name: "source",
 			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// ListRecordedStreamsParams is parameters of listRecordedStreams operation.
-type ListRecordedStreamsParams struct {
-	// Filter by channel login.
-	ChannelLogin OptString `json:",omitempty,omitzero"`
-	Limit        OptInt    `json:",omitempty,omitzero"`
-	// Keyset cursor; use with cursor_id from the last row of the previous page.
-	CursorStartedAt OptDateTime `json:",omitempty,omitzero"`
-	CursorID        OptInt64    `json:",omitempty,omitzero"`
-}
-
-func unpackListRecordedStreamsParams(packed middleware.Parameters) (params ListRecordedStreamsParams) {
+		}
+		if v, ok := packed[key]; ok {
+			params.Source = v.(OptSuspicionEventSource)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
-			Name: "channel_login",
+			Name: "new_is_sus",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.ChannelLogin = v.(OptString)
+			params.NewIsSus = v.(OptBool)
 		}
 	}
 	{
@@ -2206,11 +6128,11 @@ func unpackListRecordedStreamsParams(packed middleware.Parameters) (params ListR
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "cursor_started_at",
+			Name: "cursor_created_at",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.CursorStartedAt = v.(OptDateTime)
+			params.CursorCreatedAt = v.(OptDateTime)
 		}
 	}
 	{
@@ -2225,36 +6147,36 @@ func unpackListRecordedStreamsParams(packed middleware.Parameters) (params ListR
 	return params
 }
 
-func decodeListRecordedStreamsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListRecordedStreamsParams, _ error) {
+func decodeListSuspicionEventsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListSuspicionEventsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: channel_login.
+	// Decode query: twitch_user_id.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "channel_login",
+			Name:    "twitch_user_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotChannelLoginVal string
+				var paramsDotTwitchUserIDVal int64
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt64(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotChannelLoginVal = c
+					paramsDotTwitchUserIDVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ChannelLogin.SetTo(paramsDotChannelLoginVal)
+				params.TwitchUserID.SetTo(paramsDotTwitchUserIDVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2263,63 +6185,48 @@ func decodeListRecordedStreamsParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "channel_login",
+			Name: "twitch_user_id",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(50)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: source.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "source",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotSourceVal SuspicionEventSource
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotSourceVal = SuspicionEventSource(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Source.SetTo(paramsDotSourceVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.Source.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           200,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -2334,80 +6241,39 @@ func decodeListRecordedStreamsParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: cursor_started_at.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "cursor_started_at",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCursorStartedAtVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToDateTime(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotCursorStartedAtVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.CursorStartedAt.SetTo(paramsDotCursorStartedAtVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "cursor_started_at",
+			Name: "source",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: cursor_id.
+	// Decode query: new_is_sus.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "cursor_id",
+			Name:    "new_is_sus",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCursorIDVal int64
+				var paramsDotNewIsSusVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt64(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotCursorIDVal = c
+					paramsDotNewIsSusVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.CursorID.SetTo(paramsDotCursorIDVal)
+				params.NewIsSus.SetTo(paramsDotNewIsSusVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2416,55 +6282,11 @@ func decodeListRecordedStreamsParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "cursor_id",
+			Name: "new_is_sus",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ListRuleTriggersParams is parameters of listRuleTriggers operation.
-type ListRuleTriggersParams struct {
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Keyset cursor; use with cursor_id from the last entry of the previous batch.
-	CursorCreatedAt OptDateTime `json:",omitempty,omitzero"`
-	CursorID        OptInt64    `json:",omitempty,omitzero"`
-}
-
-func unpackListRuleTriggersParams(packed middleware.Parameters) (params ListRuleTriggersParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "cursor_created_at",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.CursorCreatedAt = v.(OptDateTime)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "cursor_id",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.CursorID = v.(OptInt64)
-		}
-	}
-	return params
-}
-
-func decodeListRuleTriggersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListRuleTriggersParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
 	// Set default value for query: limit.
 	{
 		val := int(50)
@@ -3455,6 +7277,71 @@ func decodeListTwitchUsersParams(args [0]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
+// ScanTwitchUserAltsParams is parameters of scanTwitchUserAlts operation.
+type ScanTwitchUserAltsParams struct {
+	TwitchUserID int64
+}
+
+func unpackScanTwitchUserAltsParams(packed middleware.Parameters) (params ScanTwitchUserAltsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "twitch_user_id",
+			In:   "path",
+		}
+		params.TwitchUserID = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeScanTwitchUserAltsParams(args [1]string, argsEscaped bool, r *http.Request) (params ScanTwitchUserAltsParams, _ error) {
+	// Decode path: twitch_user_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "twitch_user_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.TwitchUserID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "twitch_user_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // StopAiAgentParams is parameters of stopAiAgent operation.
 type StopAiAgentParams struct {
 	ConversationId int64
@@ -3519,3 +7406,68 @@ func decodeStopAiAgentParams(args [1]string, argsEscaped bool, r *http.Request)
 	}
 	return params, nil
 }
+
+// TestNotificationParams is parameters of testNotification operation.
+type TestNotificationParams struct {
+	ID int64
+}
+
+func unpackTestNotificationParams(packed middleware.Parameters) (params TestNotificationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeTestNotificationParams(args [1]string, argsEscaped bool, r *http.Request) (params TestNotificationParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
@@ -58,6 +58,34 @@ func encodeCreateAiMessageRequest(
 	return nil
 }
 
+func encodeCreateBlocklistRequest(
+	req *CreateBlocklistRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateLoginPatternRequest(
+	req *CreateLoginPatternRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateNotificationRequest(
 	req *CreateNotificationRequest,
 	r *http.Request,
@@ -72,6 +100,20 @@ func encodeCreateNotificationRequest(
 	return nil
 }
 
+func encodeCreateNotificationSnoozeRequest(
+	req *CreateNotificationSnoozeRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateRuleRequest(
 	req *CreateRuleRequest,
 	r *http.Request,
@@ -114,6 +156,48 @@ func encodeCreateTwitchUserRequest(
 	return nil
 }
 
+func encodeDeleteBlocklistRequest(
+	req *DeleteByIDRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeDeleteEmoteSetRequest(
+	req *DeleteByIDRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeDeleteLoginPatternRequest(
+	req *DeleteByIDRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeDeleteNotificationRequest(
 	req *DeleteByIDRequest,
 	r *http.Request,
@@ -128,6 +212,20 @@ func encodeDeleteNotificationRequest(
 	return nil
 }
 
+func encodeDeleteNotificationSnoozeRequest(
+	req *DeleteByIDRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeDeleteRuleRequest(
 	req *DeleteByIDRequest,
 	r *http.Request,
@@ -156,6 +254,20 @@ func encodeDeleteTwitchAccountRequest(
 	return nil
 }
 
+func encodeDeleteTwitchUserLinkRequest(
+	req *DeleteTwitchUserLinkRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeGetChannelLiveRequest(
 	req *GetChannelLiveRequest,
 	r *http.Request,
@@ -254,6 +366,48 @@ func encodePatchAiSettingsRequest(
 	return nil
 }
 
+func encodePreviewRuleNotifyRequest(
+	req *PreviewRuleNotifyRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeResendNotificationDeliveryRequest(
+	req *ResendNotificationDeliveryRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeSaveEmoteSetRequest(
+	req *SaveEmoteSetRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeSendMessageRequest(
 	req *SendMessageRequest,
 	r *http.Request,
@@ -282,6 +436,40 @@ func encodeSetChannelBlacklistRequest(
 	return nil
 }
 
+func encodeSetTwitchUserLinkRequest(
+	req *SetTwitchUserLinkRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeStartSuspicionReevaluationRequest(
+	req OptStartSuspicionReevaluationRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeStartTwitchOAuthRequest(
 	req OptStartTwitchOAuthRequest,
 	r *http.Request,
@@ -302,6 +490,54 @@ func encodeStartTwitchOAuthRequest(
 	return nil
 }
 
+func encodeSyncBlocklistRequest(
+	req *SyncBlocklistRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeTestLoginPatternRequest(
+	req *TestLoginPatternRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeTestNotificationRequest(
+	req OptTestNotificationRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeTestRuleRegexRequest(
 	req *TestRuleRegexRequest,
 	r *http.Request,
@@ -316,6 +552,20 @@ func encodeTestRuleRegexRequest(
 	return nil
 }
 
+func encodeUpdateBotDetectionSettingsRequest(
+	req *BotDetectionSettings,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateChannelDiscoverySettingsRequest(
 	req *ChannelDiscoverySettings,
 	r *http.Request,
@@ -330,6 +580,20 @@ func encodeUpdateChannelDiscoverySettingsRequest(
 	return nil
 }
 
+func encodeUpdateChatClassifierSettingsRequest(
+	req *ChatClassifierSettings,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateIrcMonitorSettingsRequest(
 	req *IrcMonitorSettings,
 	r *http.Request,
@@ -372,6 +636,20 @@ func encodeUpdateRuleRequest(
 	return nil
 }
 
+func encodeUpdateStreamRecapSettingsRequest(
+	req *StreamRecapSettings,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateSuspicionSettingsRequest(
 	req *SuspicionSettings,
 	r *http.Request,
@@ -413,3 +691,17 @@ func encodeUpdateTwitchUserRequest(
 	ht.SetBody(r, bytes.NewReader(encoded), contentType)
 	return nil
 }
+
+func encodeUploadBlocklistRequest(
+	req *UploadBlocklistRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
@@ -25,157 +25,276 @@ var (
 		"GET":  "Authorization",
 		"POST": "Authorization,Content-Type",
 	}
-	rn70AllowedHeaders = map[string]string{
+	rn113AllowedHeaders = map[string]string{
 		"POST": "Authorization",
 	}
-	rn30AllowedHeaders = map[string]string{
+	rn40AllowedHeaders = map[string]string{
 		"GET":   "Authorization",
 		"PATCH": "Authorization,Content-Type",
 	}
-	rn65AllowedHeaders = map[string]string{
+	rn103AllowedHeaders = map[string]string{
 		"POST": "Content-Type",
 	}
-	rn66AllowedHeaders = map[string]string{
+	rn104AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn46AllowedHeaders = map[string]string{
+	rn17AllowedHeaders = map[string]string{
 		"GET":  "Authorization",
 		"POST": "Authorization,Content-Type",
 	}
-	rn31AllowedHeaders = map[string]string{
+	rn25AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn115AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn126AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn43AllowedHeaders = map[string]string{
 		"GET":   "Authorization",
 		"PATCH": "Authorization,Content-Type",
 	}
+	rn79AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
+		"POST": "Authorization,Content-Type",
+	}
 	rn49AllowedHeaders = map[string]string{
+		"GET":   "Authorization",
+		"PATCH": "Authorization,Content-Type",
+	}
+	rn82AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
 	rn3AllowedHeaders = map[string]string{
 		"POST": "Authorization",
 	}
-	rn28AllowedHeaders = map[string]string{
+	rn38AllowedHeaders = map[string]string{
 		"POST": "Authorization",
 	}
-	rn33AllowedHeaders = map[string]string{
+	rn54AllowedHeaders = map[string]string{
 		"GET":   "Authorization",
 		"PATCH": "Authorization,Content-Type",
 	}
-	rn17AllowedHeaders = map[string]string{
+	rn85AllowedHeaders = map[string]string{
 		"GET":  "Authorization",
 		"POST": "Authorization,Content-Type",
 	}
-	rn22AllowedHeaders = map[string]string{
+	rn26AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn55AllowedHeaders = map[string]string{
+		"GET":   "Authorization",
+		"PATCH": "Authorization,Content-Type",
+	}
+	rn18AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
 		"POST": "Authorization,Content-Type",
 	}
-	rn74AllowedHeaders = map[string]string{
+	rn27AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn60AllowedHeaders = map[string]string{
+	rn117AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn19AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
+		"POST": "Authorization,Content-Type",
+	}
+	rn29AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn90AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn18AllowedHeaders = map[string]string{
+	rn106AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn20AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
+		"POST": "Authorization,Content-Type",
+	}
+	rn30AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn122AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn119AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn96AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn21AllowedHeaders = map[string]string{
 		"GET":  "Authorization",
 		"POST": "Authorization,Content-Type",
 	}
 	rn9AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn24AllowedHeaders = map[string]string{
+	rn32AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn105AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn58AllowedHeaders = map[string]string{
+	rn94AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn72AllowedHeaders = map[string]string{
+	rn121AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn75AllowedHeaders = map[string]string{
+	rn123AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn38AllowedHeaders = map[string]string{
+	rn65AllowedHeaders = map[string]string{
 		"GET":   "Authorization",
 		"PATCH": "Authorization,Content-Type",
 	}
-	rn19AllowedHeaders = map[string]string{
+	rn68AllowedHeaders = map[string]string{
+		"GET":   "Authorization",
+		"PATCH": "Authorization,Content-Type",
+	}
+	rn67AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
+		"POST": "Authorization,Content-Type",
+	}
+	rn22AllowedHeaders = map[string]string{
 		"GET":  "Authorization",
 		"POST": "Authorization,Content-Type",
 	}
 	rn10AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn26AllowedHeaders = map[string]string{
+	rn34AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn69AllowedHeaders = map[string]string{
+	rn112AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn76AllowedHeaders = map[string]string{
+	rn124AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn21AllowedHeaders = map[string]string{
+	rn24AllowedHeaders = map[string]string{
 		"GET":  "Authorization",
 		"POST": "Authorization,Content-Type",
 	}
-	rn77AllowedHeaders = map[string]string{
+	rn125AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn40AllowedHeaders = map[string]string{
+	rn70AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn48AllowedHeaders = map[string]string{
+	rn41AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn88AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn80AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn108AllowedHeaders = map[string]string{
+		"POST": "Authorization",
+	}
+	rn81AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn32AllowedHeaders = map[string]string{
+	rn52AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
+	rn48AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn50AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn46AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
 	rn51AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn53AllowedHeaders = map[string]string{
+	rn84AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn34AllowedHeaders = map[string]string{
+	rn87AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn62AllowedHeaders = map[string]string{
+	rn56AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn57AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn100AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
 	rn13AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn68AllowedHeaders = map[string]string{
+	rn110AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn57AllowedHeaders = map[string]string{
+	rn93AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn36AllowedHeaders = map[string]string{
+	rn59AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn55AllowedHeaders = map[string]string{
+	rn91AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn60AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn37AllowedHeaders = map[string]string{
+	rn62AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn56AllowedHeaders = map[string]string{
+	rn92AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn61AllowedHeaders = map[string]string{
+	rn64AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
 	rn63AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn98AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn99AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn101AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn42AllowedHeaders = map[string]string{
+	rn73AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
 	rn11AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn43AllowedHeaders = map[string]string{
+	rn111AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn36AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn76AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn44AllowedHeaders = map[string]string{
+	rn109AllowedHeaders = map[string]string{
+		"POST": "Authorization",
+	}
+	rn75AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn72AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn77AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
 )
@@ -404,7 +523,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "POST",
-												allowedHeaders: rn70AllowedHeaders,
+												allowedHeaders: rn113AllowedHeaders,
 												acceptPost:     "",
 												acceptPatch:    "",
 											})
@@ -437,7 +556,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							default:
 								s.notAllowed(w, r, notAllowedParams{
 									allowedMethods: "GET,PATCH",
-									allowedHeaders: rn30AllowedHeaders,
+									allowedHeaders: rn40AllowedHeaders,
 									acceptPost:     "",
 									acceptPatch:    "application/json",
 								})
@@ -464,7 +583,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						default:
 							s.notAllowed(w, r, notAllowedParams{
 								allowedMethods: "POST",
-								allowedHeaders: rn65AllowedHeaders,
+								allowedHeaders: rn103AllowedHeaders,
 								acceptPost:     "application/json",
 								acceptPatch:    "",
 							})
@@ -491,7 +610,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					default:
 						s.notAllowed(w, r, notAllowedParams{
 							allowedMethods: "GET",
-							allowedHeaders: rn66AllowedHeaders,
+							allowedHeaders: rn104AllowedHeaders,
 							acceptPost:     "",
 							acceptPatch:    "",
 						})
@@ -524,9 +643,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "channel-"
+					case 'b': // Prefix: "b"
 
-						if l := len("channel-"); len(elem) >= l && elem[0:l] == "channel-" {
+						if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
 							elem = elem[l:]
 						} else {
 							break
@@ -536,25 +655,24 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case 'b': // Prefix: "blacklist"
+						case 'l': // Prefix: "locklists"
 
-							if l := len("blacklist"); len(elem) >= l && elem[0:l] == "blacklist" {
+							if l := len("locklists"); len(elem) >= l && elem[0:l] == "locklists" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleListChannelBlacklistRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleListBlocklistsRequest([0]string{}, elemIsEscaped, w, r)
 								case "POST":
-									s.handleSetChannelBlacklistRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleCreateBlocklistRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "GET,POST",
-										allowedHeaders: rn46AllowedHeaders,
+										allowedHeaders: rn17AllowedHeaders,
 										acceptPost:     "application/json",
 										acceptPatch:    "",
 									})
@@ -562,25 +680,117 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'd': // Prefix: "delete"
+
+									if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleDeleteBlocklistRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "POST",
+												allowedHeaders: rn25AllowedHeaders,
+												acceptPost:     "application/json",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								case 's': // Prefix: "sync"
+
+									if l := len("sync"); len(elem) >= l && elem[0:l] == "sync" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleSyncBlocklistRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "POST",
+												allowedHeaders: rn115AllowedHeaders,
+												acceptPost:     "application/json",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								case 'u': // Prefix: "upload"
+
+									if l := len("upload"); len(elem) >= l && elem[0:l] == "upload" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUploadBlocklistRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "POST",
+												allowedHeaders: rn126AllowedHeaders,
+												acceptPost:     "application/json",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								}
+
+							}
 
-						case 'd': // Prefix: "discovery"
+						case 'o': // Prefix: "ot-detection"
 
-							if l := len("discovery"); len(elem) >= l && elem[0:l] == "discovery" {
+							if l := len("ot-detection"); len(elem) >= l && elem[0:l] == "ot-detection" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
+								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetChannelDiscoverySettingsRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleGetBotDetectionSettingsRequest([0]string{}, elemIsEscaped, w, r)
 								case "PATCH":
-									s.handleUpdateChannelDiscoverySettingsRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleUpdateBotDetectionSettingsRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "GET,PATCH",
-										allowedHeaders: rn31AllowedHeaders,
+										allowedHeaders: rn43AllowedHeaders,
 										acceptPost:     "",
 										acceptPatch:    "application/json",
 									})
@@ -588,51 +798,109 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								return
 							}
+
+						}
+
+					case 'c': // Prefix: "cha"
+
+						if l := len("cha"); len(elem) >= l && elem[0:l] == "cha" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'n': // Prefix: "nnel-"
+
+							if l := len("nnel-"); len(elem) >= l && elem[0:l] == "nnel-" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
 							switch elem[0] {
-							case '/': // Prefix: "/candidates"
+							case 'b': // Prefix: "blacklist"
 
-								if l := len("/candidates"); len(elem) >= l && elem[0:l] == "/candidates" {
+								if l := len("blacklist"); len(elem) >= l && elem[0:l] == "blacklist" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
+									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleListChannelDiscoveryCandidatesRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleListChannelBlacklistRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleSetChannelBlacklistRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
-											allowedMethods: "GET",
-											allowedHeaders: rn49AllowedHeaders,
-											acceptPost:     "",
+											allowedMethods: "GET,POST",
+											allowedHeaders: rn79AllowedHeaders,
+											acceptPost:     "application/json",
 											acceptPatch:    "",
 										})
 									}
 
 									return
 								}
-								switch elem[0] {
-								case '/': // Prefix: "/"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							case 'd': // Prefix: "discovery"
 
-									// Param: "twitch_user_id"
-									// Match until "/"
-									idx := strings.IndexByte(elem, '/')
-									if idx < 0 {
-										idx = len(elem)
-									}
-									args[0] = elem[:idx]
-									elem = elem[idx:]
+								if l := len("discovery"); len(elem) >= l && elem[0:l] == "discovery" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-									if len(elem) == 0 {
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetChannelDiscoverySettingsRequest([0]string{}, elemIsEscaped, w, r)
+									case "PATCH":
+										s.handleUpdateChannelDiscoverySettingsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET,PATCH",
+											allowedHeaders: rn49AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "application/json",
+										})
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/candidates"
+
+									if l := len("/candidates"); len(elem) >= l && elem[0:l] == "/candidates" {
+										elem = elem[l:]
+									} else {
 										break
 									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleListChannelDiscoveryCandidatesRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "GET",
+												allowedHeaders: rn82AllowedHeaders,
+												acceptPost:     "",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
 									switch elem[0] {
 									case '/': // Prefix: "/"
 
@@ -642,62 +910,85 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 											break
 										}
 
+										// Param: "twitch_user_id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
 										if len(elem) == 0 {
 											break
 										}
 										switch elem[0] {
-										case 'a': // Prefix: "approve"
+										case '/': // Prefix: "/"
 
-											if l := len("approve"); len(elem) >= l && elem[0:l] == "approve" {
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
 											if len(elem) == 0 {
-												// Leaf node.
-												switch r.Method {
-												case "POST":
-													s.handleApproveChannelDiscoveryCandidateRequest([1]string{
-														args[0],
-													}, elemIsEscaped, w, r)
-												default:
-													s.notAllowed(w, r, notAllowedParams{
-														allowedMethods: "POST",
-														allowedHeaders: rn3AllowedHeaders,
-														acceptPost:     "",
-														acceptPatch:    "",
-													})
+												break
+											}
+											switch elem[0] {
+											case 'a': // Prefix: "approve"
+
+												if l := len("approve"); len(elem) >= l && elem[0:l] == "approve" {
+													elem = elem[l:]
+												} else {
+													break
 												}
 
-												return
-											}
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleApproveChannelDiscoveryCandidateRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, notAllowedParams{
+															allowedMethods: "POST",
+															allowedHeaders: rn3AllowedHeaders,
+															acceptPost:     "",
+															acceptPatch:    "",
+														})
+													}
 
-										case 'd': // Prefix: "deny"
+													return
+												}
 
-											if l := len("deny"); len(elem) >= l && elem[0:l] == "deny" {
-												elem = elem[l:]
-											} else {
-												break
-											}
+											case 'd': // Prefix: "deny"
 
-											if len(elem) == 0 {
-												// Leaf node.
-												switch r.Method {
-												case "POST":
-													s.handleDenyChannelDiscoveryCandidateRequest([1]string{
-														args[0],
-													}, elemIsEscaped, w, r)
-												default:
-													s.notAllowed(w, r, notAllowedParams{
-														allowedMethods: "POST",
-														allowedHeaders: rn28AllowedHeaders,
-														acceptPost:     "",
-														acceptPatch:    "",
-													})
+												if l := len("deny"); len(elem) >= l && elem[0:l] == "deny" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleDenyChannelDiscoveryCandidateRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, notAllowedParams{
+															allowedMethods: "POST",
+															allowedHeaders: rn38AllowedHeaders,
+															acceptPost:     "",
+															acceptPatch:    "",
+														})
+													}
+
+													return
 												}
 
-												return
 											}
 
 										}
@@ -708,6 +999,86 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
+						case 't': // Prefix: "t-classifier"
+
+							if l := len("t-classifier"); len(elem) >= l && elem[0:l] == "t-classifier" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetChatClassifierSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUpdateChatClassifierSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET,PATCH",
+										allowedHeaders: rn54AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "application/json",
+									})
+								}
+
+								return
+							}
+
+						}
+
+					case 'e': // Prefix: "emote-sets"
+
+						if l := len("emote-sets"); len(elem) >= l && elem[0:l] == "emote-sets" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListEmoteSetsRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleSaveEmoteSetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET,POST",
+									allowedHeaders: rn85AllowedHeaders,
+									acceptPost:     "application/json",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/delete"
+
+							if l := len("/delete"); len(elem) >= l && elem[0:l] == "/delete" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleDeleteEmoteSetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn26AllowedHeaders,
+										acceptPost:     "application/json",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
 						}
 
 					case 'i': // Prefix: "irc-monitor-settings"
@@ -728,7 +1099,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							default:
 								s.notAllowed(w, r, notAllowedParams{
 									allowedMethods: "GET,PATCH",
-									allowedHeaders: rn33AllowedHeaders,
+									allowedHeaders: rn55AllowedHeaders,
 									acceptPost:     "",
 									acceptPatch:    "application/json",
 								})
@@ -737,9 +1108,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
-					case 'n': // Prefix: "notifications"
+					case 'l': // Prefix: "login-patterns"
 
-						if l := len("notifications"); len(elem) >= l && elem[0:l] == "notifications" {
+						if l := len("login-patterns"); len(elem) >= l && elem[0:l] == "login-patterns" {
 							elem = elem[l:]
 						} else {
 							break
@@ -748,13 +1119,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						if len(elem) == 0 {
 							switch r.Method {
 							case "GET":
-								s.handleListNotificationsRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleListLoginPatternsRequest([0]string{}, elemIsEscaped, w, r)
 							case "POST":
-								s.handleCreateNotificationRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleCreateLoginPatternRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, notAllowedParams{
 									allowedMethods: "GET,POST",
-									allowedHeaders: rn17AllowedHeaders,
+									allowedHeaders: rn18AllowedHeaders,
 									acceptPost:     "application/json",
 									acceptPatch:    "",
 								})
@@ -787,11 +1158,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handleDeleteNotificationRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleDeleteLoginPatternRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
 											allowedMethods: "POST",
-											allowedHeaders: rn22AllowedHeaders,
+											allowedHeaders: rn27AllowedHeaders,
 											acceptPost:     "application/json",
 											acceptPatch:    "",
 										})
@@ -800,9 +1171,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'u': // Prefix: "update"
+							case 't': // Prefix: "test"
 
-								if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+								if l := len("test"); len(elem) >= l && elem[0:l] == "test" {
 									elem = elem[l:]
 								} else {
 									break
@@ -812,11 +1183,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handleUpdateNotificationRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleTestLoginPatternRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
 											allowedMethods: "POST",
-											allowedHeaders: rn74AllowedHeaders,
+											allowedHeaders: rn117AllowedHeaders,
 											acceptPost:     "application/json",
 											acceptPatch:    "",
 										})
@@ -829,72 +1200,47 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
-					case 'r': // Prefix: "rule"
+					case 'n': // Prefix: "notifications"
 
-						if l := len("rule"); len(elem) >= l && elem[0:l] == "rule" {
+						if l := len("notifications"); len(elem) >= l && elem[0:l] == "notifications" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
+							switch r.Method {
+							case "GET":
+								s.handleListNotificationsRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleCreateNotificationRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET,POST",
+									allowedHeaders: rn19AllowedHeaders,
+									acceptPost:     "application/json",
+									acceptPatch:    "",
+								})
+							}
+
+							return
 						}
 						switch elem[0] {
-						case '-': // Prefix: "-triggers"
+						case '/': // Prefix: "/"
 
-							if l := len("-triggers"); len(elem) >= l && elem[0:l] == "-triggers" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleListRuleTriggersRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET",
-										allowedHeaders: rn60AllowedHeaders,
-										acceptPost:     "",
-										acceptPatch:    "",
-									})
-								}
-
-								return
-							}
-
-						case 's': // Prefix: "s"
-
-							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-								elem = elem[l:]
-							} else {
 								break
 							}
-
-							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleListRulesRequest([0]string{}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleCreateRuleRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET,POST",
-										allowedHeaders: rn18AllowedHeaders,
-										acceptPost:     "application/json",
-										acceptPatch:    "",
-									})
-								}
-
-								return
-							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
-
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							case 'd': // Prefix: "del"
+								origElem := elem
+								if l := len("del"); len(elem) >= l && elem[0:l] == "del" {
 									elem = elem[l:]
 								} else {
 									break
@@ -904,9 +1250,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "count"
+								case 'e': // Prefix: "ete"
 
-									if l := len("count"); len(elem) >= l && elem[0:l] == "count" {
+									if l := len("ete"); len(elem) >= l && elem[0:l] == "ete" {
 										elem = elem[l:]
 									} else {
 										break
@@ -915,13 +1261,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "GET":
-											s.handleCountRulesRequest([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleDeleteNotificationRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, notAllowedParams{
-												allowedMethods: "GET",
-												allowedHeaders: rn9AllowedHeaders,
-												acceptPost:     "",
+												allowedMethods: "POST",
+												allowedHeaders: rn29AllowedHeaders,
+												acceptPost:     "application/json",
 												acceptPatch:    "",
 											})
 										}
@@ -929,71 +1275,33 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								case 'd': // Prefix: "delete"
+								case 'i': // Prefix: "iveries"
 
-									if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+									if l := len("iveries"); len(elem) >= l && elem[0:l] == "iveries" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleDeleteRuleRequest([0]string{}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleListNotificationDeliveriesRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, notAllowedParams{
-												allowedMethods: "POST",
-												allowedHeaders: rn24AllowedHeaders,
-												acceptPost:     "application/json",
+												allowedMethods: "GET",
+												allowedHeaders: rn90AllowedHeaders,
+												acceptPost:     "",
 												acceptPatch:    "",
 											})
 										}
 
 										return
 									}
-
-								case 't': // Prefix: "te"
-
-									if l := len("te"); len(elem) >= l && elem[0:l] == "te" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										break
-									}
 									switch elem[0] {
-									case 'm': // Prefix: "mplate-variables"
-
-										if l := len("mplate-variables"); len(elem) >= l && elem[0:l] == "mplate-variables" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleListRuleTemplateVariablesRequest([0]string{}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, notAllowedParams{
-													allowedMethods: "GET",
-													allowedHeaders: rn58AllowedHeaders,
-													acceptPost:     "",
-													acceptPatch:    "",
-												})
-											}
-
-											return
-										}
-
-									case 's': // Prefix: "st-regex"
+									case '/': // Prefix: "/resend"
 
-										if l := len("st-regex"); len(elem) >= l && elem[0:l] == "st-regex" {
+										if l := len("/resend"); len(elem) >= l && elem[0:l] == "/resend" {
 											elem = elem[l:]
 										} else {
 											break
@@ -1003,11 +1311,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 											// Leaf node.
 											switch r.Method {
 											case "POST":
-												s.handleTestRuleRegexRequest([0]string{}, elemIsEscaped, w, r)
+												s.handleResendNotificationDeliveryRequest([0]string{}, elemIsEscaped, w, r)
 											default:
 												s.notAllowed(w, r, notAllowedParams{
 													allowedMethods: "POST",
-													allowedHeaders: rn72AllowedHeaders,
+													allowedHeaders: rn106AllowedHeaders,
 													acceptPost:     "application/json",
 													acceptPatch:    "",
 												})
@@ -1018,23 +1326,52 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 									}
 
-								case 'u': // Prefix: "update"
+								}
 
-									if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+								elem = origElem
+							case 's': // Prefix: "snoozes"
+								origElem := elem
+								if l := len("snoozes"); len(elem) >= l && elem[0:l] == "snoozes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListNotificationSnoozesRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateNotificationSnoozeRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET,POST",
+											allowedHeaders: rn20AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/delete"
+
+									if l := len("/delete"); len(elem) >= l && elem[0:l] == "/delete" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
 										case "POST":
-											s.handleUpdateRuleRequest([0]string{}, elemIsEscaped, w, r)
+											s.handleDeleteNotificationSnoozeRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "POST",
-												allowedHeaders: rn75AllowedHeaders,
+												allowedHeaders: rn30AllowedHeaders,
 												acceptPost:     "application/json",
 												acceptPatch:    "",
 											})
@@ -1045,40 +1382,81 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								}
 
+								elem = origElem
+							case 'u': // Prefix: "update"
+								origElem := elem
+								if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUpdateNotificationRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn122AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+								elem = origElem
 							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
-						}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/test"
+
+								if l := len("/test"); len(elem) >= l && elem[0:l] == "/test" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					case 's': // Prefix: "suspicion-settings"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleTestNotificationRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn119AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
 
-						if l := len("suspicion-settings"); len(elem) >= l && elem[0:l] == "suspicion-settings" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									return
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetSuspicionSettingsRequest([0]string{}, elemIsEscaped, w, r)
-							case "PATCH":
-								s.handleUpdateSuspicionSettingsRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, notAllowedParams{
-									allowedMethods: "GET,PATCH",
-									allowedHeaders: rn38AllowedHeaders,
-									acceptPost:     "",
-									acceptPatch:    "application/json",
-								})
 							}
 
-							return
 						}
 
-					case 't': // Prefix: "twitch-"
+					case 'r': // Prefix: "rule"
 
-						if l := len("twitch-"); len(elem) >= l && elem[0:l] == "twitch-" {
+						if l := len("rule"); len(elem) >= l && elem[0:l] == "rule" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1088,24 +1466,49 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case 'a': // Prefix: "accounts"
+						case '-': // Prefix: "-triggers"
 
-							if l := len("accounts"); len(elem) >= l && elem[0:l] == "accounts" {
+							if l := len("-triggers"); len(elem) >= l && elem[0:l] == "-triggers" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
+								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleListTwitchAccountsRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleListRuleTriggersRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn96AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "s"
+
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListRulesRequest([0]string{}, elemIsEscaped, w, r)
 								case "POST":
-									s.handleCreateTwitchAccountRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleCreateRuleRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "GET,POST",
-										allowedHeaders: rn19AllowedHeaders,
+										allowedHeaders: rn21AllowedHeaders,
 										acceptPost:     "application/json",
 										acceptPatch:    "",
 									})
@@ -1138,11 +1541,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleCountTwitchAccountsRequest([0]string{}, elemIsEscaped, w, r)
+											s.handleCountRulesRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "GET",
-												allowedHeaders: rn10AllowedHeaders,
+												allowedHeaders: rn9AllowedHeaders,
 												acceptPost:     "",
 												acceptPatch:    "",
 											})
@@ -1163,11 +1566,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "POST":
-											s.handleDeleteTwitchAccountRequest([0]string{}, elemIsEscaped, w, r)
+											s.handleDeleteRuleRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "POST",
-												allowedHeaders: rn26AllowedHeaders,
+												allowedHeaders: rn32AllowedHeaders,
 												acceptPost:     "application/json",
 												acceptPatch:    "",
 											})
@@ -1176,9 +1579,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								case 'o': // Prefix: "oauth/start"
+								case 'n': // Prefix: "notify-preview"
 
-									if l := len("oauth/start"); len(elem) >= l && elem[0:l] == "oauth/start" {
+									if l := len("notify-preview"); len(elem) >= l && elem[0:l] == "notify-preview" {
 										elem = elem[l:]
 									} else {
 										break
@@ -1188,11 +1591,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "POST":
-											s.handleStartTwitchOAuthRequest([0]string{}, elemIsEscaped, w, r)
+											s.handlePreviewRuleNotifyRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "POST",
-												allowedHeaders: rn69AllowedHeaders,
+												allowedHeaders: rn105AllowedHeaders,
 												acceptPost:     "application/json",
 												acceptPatch:    "",
 											})
@@ -1201,6 +1604,70 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
+								case 't': // Prefix: "te"
+
+									if l := len("te"); len(elem) >= l && elem[0:l] == "te" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'm': // Prefix: "mplate-variables"
+
+										if l := len("mplate-variables"); len(elem) >= l && elem[0:l] == "mplate-variables" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleListRuleTemplateVariablesRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "GET",
+													allowedHeaders: rn94AllowedHeaders,
+													acceptPost:     "",
+													acceptPatch:    "",
+												})
+											}
+
+											return
+										}
+
+									case 's': // Prefix: "st-regex"
+
+										if l := len("st-regex"); len(elem) >= l && elem[0:l] == "st-regex" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleTestRuleRegexRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "POST",
+													allowedHeaders: rn121AllowedHeaders,
+													acceptPost:     "application/json",
+													acceptPatch:    "",
+												})
+											}
+
+											return
+										}
+
+									}
+
 								case 'u': // Prefix: "update"
 
 									if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
@@ -1213,11 +1680,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "POST":
-											s.handleUpdateTwitchAccountRequest([0]string{}, elemIsEscaped, w, r)
+											s.handleUpdateRuleRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "POST",
-												allowedHeaders: rn76AllowedHeaders,
+												allowedHeaders: rn123AllowedHeaders,
 												acceptPost:     "application/json",
 												acceptPatch:    "",
 											})
@@ -1230,35 +1697,76 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
-						case 'u': // Prefix: "users"
+						}
 
-							if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 't': // Prefix: "tream-recap"
+
+							if l := len("tream-recap"); len(elem) >= l && elem[0:l] == "tream-recap" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
+								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleListTwitchUsersRequest([0]string{}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleCreateTwitchUserRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleGetStreamRecapSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUpdateStreamRecapSettingsRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "GET,POST",
-										allowedHeaders: rn21AllowedHeaders,
-										acceptPost:     "application/json",
-										acceptPatch:    "",
+										allowedMethods: "GET,PATCH",
+										allowedHeaders: rn65AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "application/json",
+									})
+								}
+
+								return
+							}
+
+						case 'u': // Prefix: "uspicion-settings"
+
+							if l := len("uspicion-settings"); len(elem) >= l && elem[0:l] == "uspicion-settings" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetSuspicionSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUpdateSuspicionSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET,PATCH",
+										allowedHeaders: rn68AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "application/json",
 									})
 								}
 
 								return
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/update"
+							case '/': // Prefix: "/reevaluate"
 
-								if l := len("/update"); len(elem) >= l && elem[0:l] == "/update" {
+								if l := len("/reevaluate"); len(elem) >= l && elem[0:l] == "/reevaluate" {
 									elem = elem[l:]
 								} else {
 									break
@@ -1267,12 +1775,223 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
+									case "GET":
+										s.handleGetSuspicionReevaluationRequest([0]string{}, elemIsEscaped, w, r)
 									case "POST":
-										s.handleUpdateTwitchUserRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleStartSuspicionReevaluationRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
-											allowedMethods: "POST",
-											allowedHeaders: rn77AllowedHeaders,
+											allowedMethods: "GET,POST",
+											allowedHeaders: rn67AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 't': // Prefix: "twitch-"
+
+						if l := len("twitch-"); len(elem) >= l && elem[0:l] == "twitch-" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "accounts"
+
+							if l := len("accounts"); len(elem) >= l && elem[0:l] == "accounts" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListTwitchAccountsRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleCreateTwitchAccountRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET,POST",
+										allowedHeaders: rn22AllowedHeaders,
+										acceptPost:     "application/json",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "count"
+
+									if l := len("count"); len(elem) >= l && elem[0:l] == "count" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleCountTwitchAccountsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "GET",
+												allowedHeaders: rn10AllowedHeaders,
+												acceptPost:     "",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								case 'd': // Prefix: "delete"
+
+									if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleDeleteTwitchAccountRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "POST",
+												allowedHeaders: rn34AllowedHeaders,
+												acceptPost:     "application/json",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								case 'o': // Prefix: "oauth/start"
+
+									if l := len("oauth/start"); len(elem) >= l && elem[0:l] == "oauth/start" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleStartTwitchOAuthRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "POST",
+												allowedHeaders: rn112AllowedHeaders,
+												acceptPost:     "application/json",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								case 'u': // Prefix: "update"
+
+									if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUpdateTwitchAccountRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "POST",
+												allowedHeaders: rn124AllowedHeaders,
+												acceptPost:     "application/json",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						case 'u': // Prefix: "users"
+
+							if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListTwitchUsersRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleCreateTwitchUserRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET,POST",
+										allowedHeaders: rn24AllowedHeaders,
+										acceptPost:     "application/json",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/update"
+
+								if l := len("/update"); len(elem) >= l && elem[0:l] == "/update" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUpdateTwitchUserRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn125AllowedHeaders,
 											acceptPost:     "application/json",
 											acceptPatch:    "",
 										})
@@ -1303,7 +2022,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						default:
 							s.notAllowed(w, r, notAllowedParams{
 								allowedMethods: "GET",
-								allowedHeaders: rn40AllowedHeaders,
+								allowedHeaders: rn70AllowedHeaders,
 								acceptPost:     "",
 								acceptPatch:    "",
 							})
@@ -1326,21 +2045,58 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'c': // Prefix: "cha"
+				case 'a': // Prefix: "audience/overlap"
 
-					if l := len("cha"); len(elem) >= l && elem[0:l] == "cha" {
+					if l := len("audience/overlap"); len(elem) >= l && elem[0:l] == "audience/overlap" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetAudienceOverlapRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn41AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				case 'b': // Prefix: "bots"
+
+					if l := len("bots"); len(elem) >= l && elem[0:l] == "bots" {
+						elem = elem[l:]
+					} else {
 						break
 					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListLikelyBotsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn88AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
 					switch elem[0] {
-					case 'n': // Prefix: "nnels/"
+					case '/': // Prefix: "/"
 
-						if l := len("nnels/"); len(elem) >= l && elem[0:l] == "nnels/" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1350,9 +2106,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case 'c': // Prefix: "chatters"
+						case 'c': // Prefix: "channels"
 
-							if l := len("chatters"); len(elem) >= l && elem[0:l] == "chatters" {
+							if l := len("channels"); len(elem) >= l && elem[0:l] == "channels" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1361,13 +2117,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "POST":
-									s.handleListChannelChattersRequest([0]string{}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleListChannelBotEstimatesRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, notAllowedParams{
-										allowedMethods: "POST",
-										allowedHeaders: rn48AllowedHeaders,
-										acceptPost:     "application/json",
+										allowedMethods: "GET",
+										allowedHeaders: rn80AllowedHeaders,
+										acceptPost:     "",
 										acceptPatch:    "",
 									})
 								}
@@ -1375,9 +2131,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
-						case 'l': // Prefix: "live"
+						case 's': // Prefix: "scan"
 
-							if l := len("live"); len(elem) >= l && elem[0:l] == "live" {
+							if l := len("scan"); len(elem) >= l && elem[0:l] == "scan" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1387,12 +2143,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "POST":
-									s.handleGetChannelLiveRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleScanLikelyBotsRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "POST",
-										allowedHeaders: rn32AllowedHeaders,
-										acceptPost:     "application/json",
+										allowedHeaders: rn108AllowedHeaders,
+										acceptPost:     "",
 										acceptPatch:    "",
 									})
 								}
@@ -1402,7 +2158,223 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
-					case 't': // Prefix: "t/history"
+					}
+
+				case 'c': // Prefix: "cha"
+
+					if l := len("cha"); len(elem) >= l && elem[0:l] == "cha" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'n': // Prefix: "nnels/"
+
+						if l := len("nnels/"); len(elem) >= l && elem[0:l] == "nnels/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "chatters"
+							origElem := elem
+							if l := len("chatters"); len(elem) >= l && elem[0:l] == "chatters" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleListChannelChattersRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn81AllowedHeaders,
+										acceptPost:     "application/json",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'l': // Prefix: "live"
+							origElem := elem
+							if l := len("live"); len(elem) >= l && elem[0:l] == "live" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleGetChannelLiveRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn52AllowedHeaders,
+										acceptPost:     "application/json",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+						// Param: "login"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "analytics"
+
+								if l := len("analytics"); len(elem) >= l && elem[0:l] == "analytics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetChannelAnalyticsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn48AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'e': // Prefix: "emotes"
+
+								if l := len("emotes"); len(elem) >= l && elem[0:l] == "emotes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetChannelEmoteUsageRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn50AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'h': // Prefix: "heatmap"
+
+								if l := len("heatmap"); len(elem) >= l && elem[0:l] == "heatmap" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetChannelActivityHeatmapRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn46AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'l': // Prefix: "leaderboard"
+
+								if l := len("leaderboard"); len(elem) >= l && elem[0:l] == "leaderboard" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetChannelLeaderboardRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn51AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 't': // Prefix: "t/history"
 
 						if l := len("t/history"); len(elem) >= l && elem[0:l] == "t/history" {
 							elem = elem[l:]
@@ -1418,7 +2390,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							default:
 								s.notAllowed(w, r, notAllowedParams{
 									allowedMethods: "GET",
-									allowedHeaders: rn51AllowedHeaders,
+									allowedHeaders: rn84AllowedHeaders,
 									acceptPost:     "",
 									acceptPatch:    "",
 								})
@@ -1457,7 +2429,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							default:
 								s.notAllowed(w, r, notAllowedParams{
 									allowedMethods: "GET",
-									allowedHeaders: rn53AllowedHeaders,
+									allowedHeaders: rn87AllowedHeaders,
 									acceptPost:     "",
 									acceptPatch:    "",
 								})
@@ -1482,7 +2454,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							default:
 								s.notAllowed(w, r, notAllowedParams{
 									allowedMethods: "GET",
-									allowedHeaders: rn34AllowedHeaders,
+									allowedHeaders: rn56AllowedHeaders,
 									acceptPost:     "",
 									acceptPatch:    "",
 								})
@@ -1493,6 +2465,31 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
+				case 'l': // Prefix: "leaderboard"
+
+					if l := len("leaderboard"); len(elem) >= l && elem[0:l] == "leaderboard" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetMonitoredLeaderboardRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn57AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
 				case 'm': // Prefix: "messages"
 
 					if l := len("messages"); len(elem) >= l && elem[0:l] == "messages" {
@@ -1508,7 +2505,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						default:
 							s.notAllowed(w, r, notAllowedParams{
 								allowedMethods: "GET",
-								allowedHeaders: rn62AllowedHeaders,
+								allowedHeaders: rn100AllowedHeaders,
 								acceptPost:     "",
 								acceptPatch:    "",
 							})
@@ -1572,7 +2569,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							default:
 								s.notAllowed(w, r, notAllowedParams{
 									allowedMethods: "POST",
-									allowedHeaders: rn68AllowedHeaders,
+									allowedHeaders: rn110AllowedHeaders,
 									acceptPost:     "application/json",
 									acceptPatch:    "",
 								})
@@ -1596,7 +2593,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							default:
 								s.notAllowed(w, r, notAllowedParams{
 									allowedMethods: "GET",
-									allowedHeaders: rn57AllowedHeaders,
+									allowedHeaders: rn93AllowedHeaders,
 									acceptPost:     "",
 									acceptPatch:    "",
 								})
@@ -1631,7 +2628,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "GET",
-										allowedHeaders: rn36AllowedHeaders,
+										allowedHeaders: rn59AllowedHeaders,
 										acceptPost:     "",
 										acceptPatch:    "",
 									})
@@ -1670,7 +2667,34 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "GET",
-												allowedHeaders: rn55AllowedHeaders,
+												allowedHeaders: rn91AllowedHeaders,
+												acceptPost:     "",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								case 'e': // Prefix: "emotes"
+
+									if l := len("emotes"); len(elem) >= l && elem[0:l] == "emotes" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetRecordedStreamEmotesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "GET",
+												allowedHeaders: rn60AllowedHeaders,
 												acceptPost:     "",
 												acceptPatch:    "",
 											})
@@ -1697,7 +2721,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "GET",
-												allowedHeaders: rn37AllowedHeaders,
+												allowedHeaders: rn62AllowedHeaders,
 												acceptPost:     "",
 												acceptPatch:    "",
 											})
@@ -1724,7 +2748,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										default:
 											s.notAllowed(w, r, notAllowedParams{
 												allowedMethods: "GET",
-												allowedHeaders: rn56AllowedHeaders,
+												allowedHeaders: rn92AllowedHeaders,
 												acceptPost:     "",
 												acceptPatch:    "",
 											})
@@ -1733,21 +2757,101 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								}
+								case 't': // Prefix: "terms"
 
-							}
+									if l := len("terms"); len(elem) >= l && elem[0:l] == "terms" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						}
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleGetRecordedStreamTermsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "GET",
+												allowedHeaders: rn64AllowedHeaders,
+												acceptPost:     "",
+												acceptPatch:    "",
+											})
+										}
 
-					}
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/series"
 
-				case 'u': // Prefix: "users"
+										if l := len("/series"); len(elem) >= l && elem[0:l] == "/series" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetRecordedStreamTermSeriesRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "GET",
+													allowedHeaders: rn63AllowedHeaders,
+													acceptPost:     "",
+													acceptPatch:    "",
+												})
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "uspicion-events"
+
+						if l := len("uspicion-events"); len(elem) >= l && elem[0:l] == "uspicion-events" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleListSuspicionEventsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn98AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				case 'u': // Prefix: "users"
+
+					if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
 					if len(elem) == 0 {
 						switch r.Method {
@@ -1756,7 +2860,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						default:
 							s.notAllowed(w, r, notAllowedParams{
 								allowedMethods: "GET",
-								allowedHeaders: rn61AllowedHeaders,
+								allowedHeaders: rn99AllowedHeaders,
 								acceptPost:     "",
 								acceptPatch:    "",
 							})
@@ -1778,7 +2882,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 						switch elem[0] {
 						case 'a': // Prefix: "activity"
-
+							origElem := elem
 							if l := len("activity"); len(elem) >= l && elem[0:l] == "activity" {
 								elem = elem[l:]
 							} else {
@@ -1792,7 +2896,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "POST",
-										allowedHeaders: rn63AllowedHeaders,
+										allowedHeaders: rn101AllowedHeaders,
 										acceptPost:     "application/json",
 										acceptPatch:    "",
 									})
@@ -1817,7 +2921,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									default:
 										s.notAllowed(w, r, notAllowedParams{
 											allowedMethods: "POST",
-											allowedHeaders: rn42AllowedHeaders,
+											allowedHeaders: rn73AllowedHeaders,
 											acceptPost:     "application/json",
 											acceptPatch:    "",
 										})
@@ -1828,8 +2932,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
+							elem = origElem
 						case 'c': // Prefix: "count"
-
+							origElem := elem
 							if l := len("count"); len(elem) >= l && elem[0:l] == "count" {
 								elem = elem[l:]
 							} else {
@@ -1853,8 +2958,61 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
-						case 'p': // Prefix: "profile"
+							elem = origElem
+						case 'l': // Prefix: "links"
+							origElem := elem
+							if l := len("links"); len(elem) >= l && elem[0:l] == "links" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "POST":
+									s.handleSetTwitchUserLinkRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn111AllowedHeaders,
+										acceptPost:     "application/json",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/delete"
+
+								if l := len("/delete"); len(elem) >= l && elem[0:l] == "/delete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleDeleteTwitchUserLinkRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn36AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
 
+							elem = origElem
+						case 'p': // Prefix: "profile"
+							origElem := elem
 							if l := len("profile"); len(elem) >= l && elem[0:l] == "profile" {
 								elem = elem[l:]
 							} else {
@@ -1869,7 +3027,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "POST",
-										allowedHeaders: rn43AllowedHeaders,
+										allowedHeaders: rn76AllowedHeaders,
 										acceptPost:     "application/json",
 										acceptPatch:    "",
 									})
@@ -1878,6 +3036,116 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
+							elem = origElem
+						}
+						// Param: "twitch_user_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "alts/scan"
+
+								if l := len("alts/scan"); len(elem) >= l && elem[0:l] == "alts/scan" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleScanTwitchUserAltsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn109AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'e': // Prefix: "emotes"
+
+								if l := len("emotes"); len(elem) >= l && elem[0:l] == "emotes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetTwitchUserEmoteUsageRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn75AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'h': // Prefix: "heatmap"
+
+								if l := len("heatmap"); len(elem) >= l && elem[0:l] == "heatmap" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetTwitchUserActivityHeatmapRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn72AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
 						}
 
 					}
@@ -1898,7 +3166,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						default:
 							s.notAllowed(w, r, notAllowedParams{
 								allowedMethods: "GET",
-								allowedHeaders: rn44AllowedHeaders,
+								allowedHeaders: rn77AllowedHeaders,
 								acceptPost:     "",
 								acceptPatch:    "",
 							})
@@ -2313,9 +3581,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "channel-"
+					case 'b': // Prefix: "b"
 
-						if l := len("channel-"); len(elem) >= l && elem[0:l] == "channel-" {
+						if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
 							elem = elem[l:]
 						} else {
 							break
@@ -2325,65 +3593,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
-						case 'b': // Prefix: "blacklist"
+						case 'l': // Prefix: "locklists"
 
-							if l := len("blacklist"); len(elem) >= l && elem[0:l] == "blacklist" {
+							if l := len("locklists"); len(elem) >= l && elem[0:l] == "locklists" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = ListChannelBlacklistOperation
+									r.name = ListBlocklistsOperation
 									r.summary = ""
-									r.operationID = "listChannelBlacklist"
+									r.operationID = "listBlocklists"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/settings/channel-blacklist"
+									r.pathPattern = "/api/v1/settings/blocklists"
 									r.args = args
 									r.count = 0
 									return r, true
 								case "POST":
-									r.name = SetChannelBlacklistOperation
-									r.summary = ""
-									r.operationID = "setChannelBlacklist"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/settings/channel-blacklist"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 'd': // Prefix: "discovery"
-
-							if l := len("discovery"); len(elem) >= l && elem[0:l] == "discovery" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = GetChannelDiscoverySettingsOperation
-									r.summary = ""
-									r.operationID = "getChannelDiscoverySettings"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/settings/channel-discovery"
-									r.args = args
-									r.count = 0
-									return r, true
-								case "PATCH":
-									r.name = UpdateChannelDiscoverySettingsOperation
+									r.name = CreateBlocklistOperation
 									r.summary = ""
-									r.operationID = "updateChannelDiscoverySettings"
+									r.operationID = "createBlocklist"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/settings/channel-discovery"
+									r.pathPattern = "/api/v1/settings/blocklists"
 									r.args = args
 									r.count = 0
 									return r, true
@@ -2392,155 +3626,568 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/candidates"
+							case '/': // Prefix: "/"
 
-								if l := len("/candidates"); len(elem) >= l && elem[0:l] == "/candidates" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										r.name = ListChannelDiscoveryCandidatesOperation
-										r.summary = ""
-										r.operationID = "listChannelDiscoveryCandidates"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/settings/channel-discovery/candidates"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
+									break
 								}
 								switch elem[0] {
-								case '/': // Prefix: "/"
+								case 'd': // Prefix: "delete"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "twitch_user_id"
-									// Match until "/"
-									idx := strings.IndexByte(elem, '/')
-									if idx < 0 {
-										idx = len(elem)
-									}
-									args[0] = elem[:idx]
-									elem = elem[idx:]
-
 									if len(elem) == 0 {
-										break
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = DeleteBlocklistOperation
+											r.summary = ""
+											r.operationID = "deleteBlocklist"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/settings/blocklists/delete"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
 									}
-									switch elem[0] {
-									case '/': // Prefix: "/"
 
-										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+								case 's': // Prefix: "sync"
 
-										if len(elem) == 0 {
-											break
-										}
-										switch elem[0] {
-										case 'a': // Prefix: "approve"
+									if l := len("sync"); len(elem) >= l && elem[0:l] == "sync" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-											if l := len("approve"); len(elem) >= l && elem[0:l] == "approve" {
-												elem = elem[l:]
-											} else {
-												break
-											}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = SyncBlocklistOperation
+											r.summary = ""
+											r.operationID = "syncBlocklist"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/settings/blocklists/sync"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
 
-											if len(elem) == 0 {
-												// Leaf node.
-												switch method {
-												case "POST":
-													r.name = ApproveChannelDiscoveryCandidateOperation
-													r.summary = ""
-													r.operationID = "approveChannelDiscoveryCandidate"
-													r.operationGroup = ""
-													r.pathPattern = "/api/v1/settings/channel-discovery/candidates/{twitch_user_id}/approve"
-													r.args = args
-													r.count = 1
-													return r, true
-												default:
-													return
-												}
-											}
+								case 'u': // Prefix: "upload"
+
+									if l := len("upload"); len(elem) >= l && elem[0:l] == "upload" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = UploadBlocklistOperation
+											r.summary = ""
+											r.operationID = "uploadBlocklist"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/settings/blocklists/upload"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'o': // Prefix: "ot-detection"
+
+							if l := len("ot-detection"); len(elem) >= l && elem[0:l] == "ot-detection" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetBotDetectionSettingsOperation
+									r.summary = ""
+									r.operationID = "getBotDetectionSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/bot-detection"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PATCH":
+									r.name = UpdateBotDetectionSettingsOperation
+									r.summary = ""
+									r.operationID = "updateBotDetectionSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/bot-detection"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'c': // Prefix: "cha"
+
+						if l := len("cha"); len(elem) >= l && elem[0:l] == "cha" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'n': // Prefix: "nnel-"
+
+							if l := len("nnel-"); len(elem) >= l && elem[0:l] == "nnel-" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'b': // Prefix: "blacklist"
 
-										case 'd': // Prefix: "deny"
+								if l := len("blacklist"); len(elem) >= l && elem[0:l] == "blacklist" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-											if l := len("deny"); len(elem) >= l && elem[0:l] == "deny" {
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ListChannelBlacklistOperation
+										r.summary = ""
+										r.operationID = "listChannelBlacklist"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/channel-blacklist"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = SetChannelBlacklistOperation
+										r.summary = ""
+										r.operationID = "setChannelBlacklist"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/channel-blacklist"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'd': // Prefix: "discovery"
+
+								if l := len("discovery"); len(elem) >= l && elem[0:l] == "discovery" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetChannelDiscoverySettingsOperation
+										r.summary = ""
+										r.operationID = "getChannelDiscoverySettings"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/channel-discovery"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "PATCH":
+										r.name = UpdateChannelDiscoverySettingsOperation
+										r.summary = ""
+										r.operationID = "updateChannelDiscoverySettings"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/channel-discovery"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/candidates"
+
+									if l := len("/candidates"); len(elem) >= l && elem[0:l] == "/candidates" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListChannelDiscoveryCandidatesOperation
+											r.summary = ""
+											r.operationID = "listChannelDiscoveryCandidates"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/settings/channel-discovery/candidates"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "twitch_user_id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
 											if len(elem) == 0 {
-												// Leaf node.
-												switch method {
-												case "POST":
-													r.name = DenyChannelDiscoveryCandidateOperation
-													r.summary = ""
-													r.operationID = "denyChannelDiscoveryCandidate"
-													r.operationGroup = ""
-													r.pathPattern = "/api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny"
-													r.args = args
-													r.count = 1
-													return r, true
-												default:
-													return
+												break
+											}
+											switch elem[0] {
+											case 'a': // Prefix: "approve"
+
+												if l := len("approve"); len(elem) >= l && elem[0:l] == "approve" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = ApproveChannelDiscoveryCandidateOperation
+														r.summary = ""
+														r.operationID = "approveChannelDiscoveryCandidate"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/settings/channel-discovery/candidates/{twitch_user_id}/approve"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
 												}
+
+											case 'd': // Prefix: "deny"
+
+												if l := len("deny"); len(elem) >= l && elem[0:l] == "deny" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = DenyChannelDiscoveryCandidateOperation
+														r.summary = ""
+														r.operationID = "denyChannelDiscoveryCandidate"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
 											}
 
 										}
 
 									}
-
+
+								}
+
+							}
+
+						case 't': // Prefix: "t-classifier"
+
+							if l := len("t-classifier"); len(elem) >= l && elem[0:l] == "t-classifier" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetChatClassifierSettingsOperation
+									r.summary = ""
+									r.operationID = "getChatClassifierSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/chat-classifier"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PATCH":
+									r.name = UpdateChatClassifierSettingsOperation
+									r.summary = ""
+									r.operationID = "updateChatClassifierSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/chat-classifier"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'e': // Prefix: "emote-sets"
+
+						if l := len("emote-sets"); len(elem) >= l && elem[0:l] == "emote-sets" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListEmoteSetsOperation
+								r.summary = ""
+								r.operationID = "listEmoteSets"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/settings/emote-sets"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = SaveEmoteSetOperation
+								r.summary = ""
+								r.operationID = "saveEmoteSet"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/settings/emote-sets"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/delete"
+
+							if l := len("/delete"); len(elem) >= l && elem[0:l] == "/delete" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = DeleteEmoteSetOperation
+									r.summary = ""
+									r.operationID = "deleteEmoteSet"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/emote-sets/delete"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'i': // Prefix: "irc-monitor-settings"
+
+						if l := len("irc-monitor-settings"); len(elem) >= l && elem[0:l] == "irc-monitor-settings" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetIrcMonitorSettingsOperation
+								r.summary = ""
+								r.operationID = "getIrcMonitorSettings"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/settings/irc-monitor-settings"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "PATCH":
+								r.name = UpdateIrcMonitorSettingsOperation
+								r.summary = ""
+								r.operationID = "updateIrcMonitorSettings"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/settings/irc-monitor-settings"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'l': // Prefix: "login-patterns"
+
+						if l := len("login-patterns"); len(elem) >= l && elem[0:l] == "login-patterns" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListLoginPatternsOperation
+								r.summary = ""
+								r.operationID = "listLoginPatterns"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/settings/login-patterns"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CreateLoginPatternOperation
+								r.summary = ""
+								r.operationID = "createLoginPattern"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/settings/login-patterns"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'd': // Prefix: "delete"
+
+								if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = DeleteLoginPatternOperation
+										r.summary = ""
+										r.operationID = "deleteLoginPattern"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/login-patterns/delete"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
 								}
 
-							}
-
-						}
+							case 't': // Prefix: "test"
 
-					case 'i': // Prefix: "irc-monitor-settings"
+								if l := len("test"); len(elem) >= l && elem[0:l] == "test" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						if l := len("irc-monitor-settings"); len(elem) >= l && elem[0:l] == "irc-monitor-settings" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = TestLoginPatternOperation
+										r.summary = ""
+										r.operationID = "testLoginPattern"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/login-patterns/test"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = GetIrcMonitorSettingsOperation
-								r.summary = ""
-								r.operationID = "getIrcMonitorSettings"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/settings/irc-monitor-settings"
-								r.args = args
-								r.count = 0
-								return r, true
-							case "PATCH":
-								r.name = UpdateIrcMonitorSettingsOperation
-								r.summary = ""
-								r.operationID = "updateIrcMonitorSettings"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/settings/irc-monitor-settings"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
 							}
+
 						}
 
 					case 'n': // Prefix: "notifications"
@@ -2588,23 +4235,122 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'd': // Prefix: "delete"
+							case 'd': // Prefix: "del"
+								origElem := elem
+								if l := len("del"); len(elem) >= l && elem[0:l] == "del" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-								if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "ete"
+
+									if l := len("ete"); len(elem) >= l && elem[0:l] == "ete" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = DeleteNotificationOperation
+											r.summary = ""
+											r.operationID = "deleteNotification"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/settings/notifications/delete"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'i': // Prefix: "iveries"
+
+									if l := len("iveries"); len(elem) >= l && elem[0:l] == "iveries" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListNotificationDeliveriesOperation
+											r.summary = ""
+											r.operationID = "listNotificationDeliveries"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/settings/notifications/deliveries"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/resend"
+
+										if l := len("/resend"); len(elem) >= l && elem[0:l] == "/resend" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ResendNotificationDeliveryOperation
+												r.summary = ""
+												r.operationID = "resendNotificationDelivery"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/settings/notifications/deliveries/resend"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							case 's': // Prefix: "snoozes"
+								origElem := elem
+								if l := len("snoozes"); len(elem) >= l && elem[0:l] == "snoozes" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch method {
+									case "GET":
+										r.name = ListNotificationSnoozesOperation
+										r.summary = ""
+										r.operationID = "listNotificationSnoozes"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/notifications/snoozes"
+										r.args = args
+										r.count = 0
+										return r, true
 									case "POST":
-										r.name = DeleteNotificationOperation
+										r.name = CreateNotificationSnoozeOperation
 										r.summary = ""
-										r.operationID = "deleteNotification"
+										r.operationID = "createNotificationSnooze"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/settings/notifications/delete"
+										r.pathPattern = "/api/v1/settings/notifications/snoozes"
 										r.args = args
 										r.count = 0
 										return r, true
@@ -2612,9 +4358,37 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										return
 									}
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/delete"
 
-							case 'u': // Prefix: "update"
+									if l := len("/delete"); len(elem) >= l && elem[0:l] == "/delete" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = DeleteNotificationSnoozeOperation
+											r.summary = ""
+											r.operationID = "deleteNotificationSnooze"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/settings/notifications/snoozes/delete"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
 
+								elem = origElem
+							case 'u': // Prefix: "update"
+								origElem := elem
 								if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
 									elem = elem[l:]
 								} else {
@@ -2638,6 +4412,46 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 								}
 
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/test"
+
+								if l := len("/test"); len(elem) >= l && elem[0:l] == "/test" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = TestNotificationOperation
+										r.summary = "Send sample notifications"
+										r.operationID = "testNotification"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/notifications/{id}/test"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
 							}
 
 						}
@@ -2774,6 +4588,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
+								case 'n': // Prefix: "notify-preview"
+
+									if l := len("notify-preview"); len(elem) >= l && elem[0:l] == "notify-preview" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = PreviewRuleNotifyOperation
+											r.summary = "Render a notify action without sending"
+											r.operationID = "previewRuleNotify"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/settings/rules/notify-preview"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
 								case 't': // Prefix: "te"
 
 									if l := len("te"); len(elem) >= l && elem[0:l] == "te" {
@@ -2864,43 +4703,126 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 
 								}
-
+
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 't': // Prefix: "tream-recap"
+
+							if l := len("tream-recap"); len(elem) >= l && elem[0:l] == "tream-recap" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetStreamRecapSettingsOperation
+									r.summary = ""
+									r.operationID = "getStreamRecapSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/stream-recap"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PATCH":
+									r.name = UpdateStreamRecapSettingsOperation
+									r.summary = ""
+									r.operationID = "updateStreamRecapSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/stream-recap"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'u': // Prefix: "uspicion-settings"
+
+							if l := len("uspicion-settings"); len(elem) >= l && elem[0:l] == "uspicion-settings" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetSuspicionSettingsOperation
+									r.summary = ""
+									r.operationID = "getSuspicionSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/suspicion-settings"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PATCH":
+									r.name = UpdateSuspicionSettingsOperation
+									r.summary = ""
+									r.operationID = "updateSuspicionSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/suspicion-settings"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/reevaluate"
 
-						}
-
-					case 's': // Prefix: "suspicion-settings"
+								if l := len("/reevaluate"); len(elem) >= l && elem[0:l] == "/reevaluate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						if l := len("suspicion-settings"); len(elem) >= l && elem[0:l] == "suspicion-settings" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetSuspicionReevaluationOperation
+										r.summary = ""
+										r.operationID = "getSuspicionReevaluation"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/suspicion-settings/reevaluate"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = StartSuspicionReevaluationOperation
+										r.summary = ""
+										r.operationID = "startSuspicionReevaluation"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/suspicion-settings/reevaluate"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = GetSuspicionSettingsOperation
-								r.summary = ""
-								r.operationID = "getSuspicionSettings"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/settings/suspicion-settings"
-								r.args = args
-								r.count = 0
-								return r, true
-							case "PATCH":
-								r.name = UpdateSuspicionSettingsOperation
-								r.summary = ""
-								r.operationID = "updateSuspicionSettings"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/settings/suspicion-settings"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
 							}
+
 						}
 
 					case 't': // Prefix: "twitch-"
@@ -3167,6 +5089,121 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
+				case 'a': // Prefix: "audience/overlap"
+
+					if l := len("audience/overlap"); len(elem) >= l && elem[0:l] == "audience/overlap" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetAudienceOverlapOperation
+							r.summary = ""
+							r.operationID = "getAudienceOverlap"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/twitch/audience/overlap"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'b': // Prefix: "bots"
+
+					if l := len("bots"); len(elem) >= l && elem[0:l] == "bots" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListLikelyBotsOperation
+							r.summary = ""
+							r.operationID = "listLikelyBots"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/twitch/bots"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "channels"
+
+							if l := len("channels"); len(elem) >= l && elem[0:l] == "channels" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListChannelBotEstimatesOperation
+									r.summary = ""
+									r.operationID = "listChannelBotEstimates"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/twitch/bots/channels"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "scan"
+
+							if l := len("scan"); len(elem) >= l && elem[0:l] == "scan" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ScanLikelyBotsOperation
+									r.summary = ""
+									r.operationID = "scanLikelyBots"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/twitch/bots/scan"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
 				case 'c': // Prefix: "cha"
 
 					if l := len("cha"); len(elem) >= l && elem[0:l] == "cha" {
@@ -3192,7 +5229,7 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 						switch elem[0] {
 						case 'c': // Prefix: "chatters"
-
+							origElem := elem
 							if l := len("chatters"); len(elem) >= l && elem[0:l] == "chatters" {
 								elem = elem[l:]
 							} else {
@@ -3216,29 +5253,159 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
+							elem = origElem
 						case 'l': // Prefix: "live"
+							origElem := elem
+							if l := len("live"); len(elem) >= l && elem[0:l] == "live" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = GetChannelLiveOperation
+									r.summary = ""
+									r.operationID = "getChannelLive"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/twitch/channels/live"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "login"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "analytics"
+
+								if l := len("analytics"); len(elem) >= l && elem[0:l] == "analytics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetChannelAnalyticsOperation
+										r.summary = ""
+										r.operationID = "getChannelAnalytics"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/twitch/channels/{login}/analytics"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'e': // Prefix: "emotes"
+
+								if l := len("emotes"); len(elem) >= l && elem[0:l] == "emotes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetChannelEmoteUsageOperation
+										r.summary = ""
+										r.operationID = "getChannelEmoteUsage"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/twitch/channels/{login}/emotes"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'h': // Prefix: "heatmap"
+
+								if l := len("heatmap"); len(elem) >= l && elem[0:l] == "heatmap" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetChannelActivityHeatmapOperation
+										r.summary = ""
+										r.operationID = "getChannelActivityHeatmap"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/twitch/channels/{login}/heatmap"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'l': // Prefix: "leaderboard"
 
-							if l := len("live"); len(elem) >= l && elem[0:l] == "live" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if l := len("leaderboard"); len(elem) >= l && elem[0:l] == "leaderboard" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = GetChannelLiveOperation
-									r.summary = ""
-									r.operationID = "getChannelLive"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/twitch/channels/live"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetChannelLeaderboardOperation
+										r.summary = ""
+										r.operationID = "getChannelLeaderboard"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/twitch/channels/{login}/leaderboard"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
 								}
+
 							}
 
 						}
@@ -3334,6 +5501,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
+				case 'l': // Prefix: "leaderboard"
+
+					if l := len("leaderboard"); len(elem) >= l && elem[0:l] == "leaderboard" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetMonitoredLeaderboardOperation
+							r.summary = ""
+							r.operationID = "getMonitoredLeaderboard"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/twitch/leaderboard"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
 				case 'm': // Prefix: "messages"
 
 					if l := len("messages"); len(elem) >= l && elem[0:l] == "messages" {
@@ -3516,6 +5708,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
+								case 'e': // Prefix: "emotes"
+
+									if l := len("emotes"); len(elem) >= l && elem[0:l] == "emotes" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetRecordedStreamEmotesOperation
+											r.summary = ""
+											r.operationID = "getRecordedStreamEmotes"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/twitch/streams/{streamId}/emotes"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
 								case 'l': // Prefix: "leaderboard"
 
 									if l := len("leaderboard"); len(elem) >= l && elem[0:l] == "leaderboard" {
@@ -3566,12 +5783,88 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
+								case 't': // Prefix: "terms"
+
+									if l := len("terms"); len(elem) >= l && elem[0:l] == "terms" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetRecordedStreamTermsOperation
+											r.summary = ""
+											r.operationID = "getRecordedStreamTerms"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/twitch/streams/{streamId}/terms"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/series"
+
+										if l := len("/series"); len(elem) >= l && elem[0:l] == "/series" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetRecordedStreamTermSeriesOperation
+												r.summary = ""
+												r.operationID = "getRecordedStreamTermSeries"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/twitch/streams/{streamId}/terms/series"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
 								}
 
 							}
 
 						}
 
+					case 'u': // Prefix: "uspicion-events"
+
+						if l := len("uspicion-events"); len(elem) >= l && elem[0:l] == "uspicion-events" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ListSuspicionEventsOperation
+								r.summary = ""
+								r.operationID = "listSuspicionEvents"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/twitch/suspicion-events"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 					}
 
 				case 'u': // Prefix: "users"
@@ -3611,7 +5904,7 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 						switch elem[0] {
 						case 'a': // Prefix: "activity"
-
+							origElem := elem
 							if l := len("activity"); len(elem) >= l && elem[0:l] == "activity" {
 								elem = elem[l:]
 							} else {
@@ -3661,8 +5954,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							}
 
+							elem = origElem
 						case 'c': // Prefix: "count"
-
+							origElem := elem
 							if l := len("count"); len(elem) >= l && elem[0:l] == "count" {
 								elem = elem[l:]
 							} else {
@@ -3686,8 +5980,61 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
-						case 'p': // Prefix: "profile"
+							elem = origElem
+						case 'l': // Prefix: "links"
+							origElem := elem
+							if l := len("links"); len(elem) >= l && elem[0:l] == "links" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									r.name = SetTwitchUserLinkOperation
+									r.summary = ""
+									r.operationID = "setTwitchUserLink"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/twitch/users/links"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/delete"
+
+								if l := len("/delete"); len(elem) >= l && elem[0:l] == "/delete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = DeleteTwitchUserLinkOperation
+										r.summary = ""
+										r.operationID = "deleteTwitchUserLink"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/twitch/users/links/delete"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
 
+							elem = origElem
+						case 'p': // Prefix: "profile"
+							origElem := elem
 							if l := len("profile"); len(elem) >= l && elem[0:l] == "profile" {
 								elem = elem[l:]
 							} else {
@@ -3711,6 +6058,110 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
+							elem = origElem
+						}
+						// Param: "twitch_user_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "alts/scan"
+
+								if l := len("alts/scan"); len(elem) >= l && elem[0:l] == "alts/scan" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ScanTwitchUserAltsOperation
+										r.summary = ""
+										r.operationID = "scanTwitchUserAlts"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/twitch/users/{twitch_user_id}/alts/scan"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'e': // Prefix: "emotes"
+
+								if l := len("emotes"); len(elem) >= l && elem[0:l] == "emotes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetTwitchUserEmoteUsageOperation
+										r.summary = ""
+										r.operationID = "getTwitchUserEmoteUsage"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/twitch/users/{twitch_user_id}/emotes"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'h': // Prefix: "heatmap"
+
+								if l := len("heatmap"); len(elem) >= l && elem[0:l] == "heatmap" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetTwitchUserActivityHeatmapOperation
+										r.summary = ""
+										r.operationID = "getTwitchUserActivityHeatmap"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/twitch/users/{twitch_user_id}/heatmap"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
 						}
 
 					}
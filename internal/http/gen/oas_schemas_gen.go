@@ -48,6 +48,70 @@ func (s *Account) SetRole(val string) {
 
 func (*Account) meRes() {}
 
+// Ref: #/components/schemas/ActivityHeatmap
+type ActivityHeatmap struct {
+	Timezone string    `json:"timezone"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	// 7 rows (Monday first) of 24 hourly cells.
+	PresenceSeconds [][]int64 `json:"presence_seconds"`
+	// 7 rows (Monday first) of 24 hourly cells.
+	Messages [][]int64 `json:"messages"`
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *ActivityHeatmap) GetTimezone() string {
+	return s.Timezone
+}
+
+// GetFrom returns the value of From.
+func (s *ActivityHeatmap) GetFrom() time.Time {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *ActivityHeatmap) GetTo() time.Time {
+	return s.To
+}
+
+// GetPresenceSeconds returns the value of PresenceSeconds.
+func (s *ActivityHeatmap) GetPresenceSeconds() [][]int64 {
+	return s.PresenceSeconds
+}
+
+// GetMessages returns the value of Messages.
+func (s *ActivityHeatmap) GetMessages() [][]int64 {
+	return s.Messages
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *ActivityHeatmap) SetTimezone(val string) {
+	s.Timezone = val
+}
+
+// SetFrom sets the value of From.
+func (s *ActivityHeatmap) SetFrom(val time.Time) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *ActivityHeatmap) SetTo(val time.Time) {
+	s.To = val
+}
+
+// SetPresenceSeconds sets the value of PresenceSeconds.
+func (s *ActivityHeatmap) SetPresenceSeconds(val [][]int64) {
+	s.PresenceSeconds = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *ActivityHeatmap) SetMessages(val [][]int64) {
+	s.Messages = val
+}
+
+func (*ActivityHeatmap) getChannelActivityHeatmapRes()    {}
+func (*ActivityHeatmap) getTwitchUserActivityHeatmapRes() {}
+
 // Ref: #/components/schemas/ActivityTimelineSegment
 type ActivityTimelineSegment struct {
 	ChannelID    int64     `json:"channel_id"`
@@ -353,499 +417,521 @@ func (s *AiSettings) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
-type ApproveChannelDiscoveryCandidateBadRequest ErrorMessage
+// Ref: #/components/schemas/AltCandidate
+type AltCandidate struct {
+	// The possible alt.
+	TwitchUserID int64  `json:"twitch_user_id"`
+	Username     string `json:"username"`
+	// Similarity score 0-100.
+	Score      int         `json:"score"`
+	Signals    []AltSignal `json:"signals"`
+	ComputedAt time.Time   `json:"computed_at"`
+}
 
-func (*ApproveChannelDiscoveryCandidateBadRequest) approveChannelDiscoveryCandidateRes() {}
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *AltCandidate) GetTwitchUserID() int64 {
+	return s.TwitchUserID
+}
 
-type ApproveChannelDiscoveryCandidateNotFound ErrorMessage
+// GetUsername returns the value of Username.
+func (s *AltCandidate) GetUsername() string {
+	return s.Username
+}
 
-func (*ApproveChannelDiscoveryCandidateNotFound) approveChannelDiscoveryCandidateRes() {}
+// GetScore returns the value of Score.
+func (s *AltCandidate) GetScore() int {
+	return s.Score
+}
 
-type BearerAuth struct {
-	Token string
-	Roles []string
+// GetSignals returns the value of Signals.
+func (s *AltCandidate) GetSignals() []AltSignal {
+	return s.Signals
 }
 
-// GetToken returns the value of Token.
-func (s *BearerAuth) GetToken() string {
-	return s.Token
+// GetComputedAt returns the value of ComputedAt.
+func (s *AltCandidate) GetComputedAt() time.Time {
+	return s.ComputedAt
 }
 
-// GetRoles returns the value of Roles.
-func (s *BearerAuth) GetRoles() []string {
-	return s.Roles
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *AltCandidate) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
 }
 
-// SetToken sets the value of Token.
-func (s *BearerAuth) SetToken(val string) {
-	s.Token = val
+// SetUsername sets the value of Username.
+func (s *AltCandidate) SetUsername(val string) {
+	s.Username = val
 }
 
-// SetRoles sets the value of Roles.
-func (s *BearerAuth) SetRoles(val []string) {
-	s.Roles = val
+// SetScore sets the value of Score.
+func (s *AltCandidate) SetScore(val int) {
+	s.Score = val
 }
 
-// Ref: #/components/schemas/ChannelBlacklistChange
-type ChannelBlacklistChange struct {
-	Login string `json:"login"`
-	// When true, add to blacklist; when false, remove.
-	Add bool `json:"add"`
+// SetSignals sets the value of Signals.
+func (s *AltCandidate) SetSignals(val []AltSignal) {
+	s.Signals = val
 }
 
-// GetLogin returns the value of Login.
-func (s *ChannelBlacklistChange) GetLogin() string {
-	return s.Login
+// SetComputedAt sets the value of ComputedAt.
+func (s *AltCandidate) SetComputedAt(val time.Time) {
+	s.ComputedAt = val
 }
 
-// GetAdd returns the value of Add.
-func (s *ChannelBlacklistChange) GetAdd() bool {
-	return s.Add
+// Ref: #/components/schemas/AltSignal
+type AltSignal struct {
+	Key AltSignalKey `json:"key"`
+	// Points this signal contributed.
+	Score int `json:"score"`
+	// Maximum points this signal can contribute.
+	Weight int `json:"weight"`
+	// Human-readable explanation.
+	Detail string `json:"detail"`
 }
 
-// SetLogin sets the value of Login.
-func (s *ChannelBlacklistChange) SetLogin(val string) {
-	s.Login = val
+// GetKey returns the value of Key.
+func (s *AltSignal) GetKey() AltSignalKey {
+	return s.Key
 }
 
-// SetAdd sets the value of Add.
-func (s *ChannelBlacklistChange) SetAdd(val bool) {
-	s.Add = val
+// GetScore returns the value of Score.
+func (s *AltSignal) GetScore() int {
+	return s.Score
 }
 
-// Ref: #/components/schemas/ChannelChatterEntry
-type ChannelChatterEntry struct {
-	// Chatter login (lowercase).
-	Login        string `json:"login"`
-	UserTwitchID int64  `json:"user_twitch_id"`
-	// When this chatter was first seen in the channel for the current stretch (IRC JOIN or NAMES sync).
-	PresentSince time.Time `json:"present_since"`
-	// Twitch account creation from Helix when enrichment has populated it.
-	AccountCreatedAt OptNilDateTime `json:"account_created_at"`
-	// Populated when session_started_at was sent in the request.
-	MessageCount OptNilInt64 `json:"message_count"`
+// GetWeight returns the value of Weight.
+func (s *AltSignal) GetWeight() int {
+	return s.Weight
 }
 
-// GetLogin returns the value of Login.
-func (s *ChannelChatterEntry) GetLogin() string {
-	return s.Login
+// GetDetail returns the value of Detail.
+func (s *AltSignal) GetDetail() string {
+	return s.Detail
 }
 
-// GetUserTwitchID returns the value of UserTwitchID.
-func (s *ChannelChatterEntry) GetUserTwitchID() int64 {
-	return s.UserTwitchID
+// SetKey sets the value of Key.
+func (s *AltSignal) SetKey(val AltSignalKey) {
+	s.Key = val
 }
 
-// GetPresentSince returns the value of PresentSince.
-func (s *ChannelChatterEntry) GetPresentSince() time.Time {
-	return s.PresentSince
+// SetScore sets the value of Score.
+func (s *AltSignal) SetScore(val int) {
+	s.Score = val
 }
 
-// GetAccountCreatedAt returns the value of AccountCreatedAt.
-func (s *ChannelChatterEntry) GetAccountCreatedAt() OptNilDateTime {
-	return s.AccountCreatedAt
+// SetWeight sets the value of Weight.
+func (s *AltSignal) SetWeight(val int) {
+	s.Weight = val
 }
 
-// GetMessageCount returns the value of MessageCount.
-func (s *ChannelChatterEntry) GetMessageCount() OptNilInt64 {
-	return s.MessageCount
+// SetDetail sets the value of Detail.
+func (s *AltSignal) SetDetail(val string) {
+	s.Detail = val
 }
 
-// SetLogin sets the value of Login.
-func (s *ChannelChatterEntry) SetLogin(val string) {
-	s.Login = val
+type AltSignalKey string
+
+const (
+	AltSignalKeyFollowOverlap   AltSignalKey = "follow_overlap"
+	AltSignalKeyLoginSimilarity AltSignalKey = "login_similarity"
+	AltSignalKeyAccountCreated  AltSignalKey = "account_created"
+	AltSignalKeySharedPresence  AltSignalKey = "shared_presence"
+	AltSignalKeyStylometry      AltSignalKey = "stylometry"
+	AltSignalKeyTiming          AltSignalKey = "timing"
+)
+
+// AllValues returns all AltSignalKey values.
+func (AltSignalKey) AllValues() []AltSignalKey {
+	return []AltSignalKey{
+		AltSignalKeyFollowOverlap,
+		AltSignalKeyLoginSimilarity,
+		AltSignalKeyAccountCreated,
+		AltSignalKeySharedPresence,
+		AltSignalKeyStylometry,
+		AltSignalKeyTiming,
+	}
 }
 
-// SetUserTwitchID sets the value of UserTwitchID.
-func (s *ChannelChatterEntry) SetUserTwitchID(val int64) {
-	s.UserTwitchID = val
+// MarshalText implements encoding.TextMarshaler.
+func (s AltSignalKey) MarshalText() ([]byte, error) {
+	switch s {
+	case AltSignalKeyFollowOverlap:
+		return []byte(s), nil
+	case AltSignalKeyLoginSimilarity:
+		return []byte(s), nil
+	case AltSignalKeyAccountCreated:
+		return []byte(s), nil
+	case AltSignalKeySharedPresence:
+		return []byte(s), nil
+	case AltSignalKeyStylometry:
+		return []byte(s), nil
+	case AltSignalKeyTiming:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetPresentSince sets the value of PresentSince.
-func (s *ChannelChatterEntry) SetPresentSince(val time.Time) {
-	s.PresentSince = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AltSignalKey) UnmarshalText(data []byte) error {
+	switch AltSignalKey(data) {
+	case AltSignalKeyFollowOverlap:
+		*s = AltSignalKeyFollowOverlap
+		return nil
+	case AltSignalKeyLoginSimilarity:
+		*s = AltSignalKeyLoginSimilarity
+		return nil
+	case AltSignalKeyAccountCreated:
+		*s = AltSignalKeyAccountCreated
+		return nil
+	case AltSignalKeySharedPresence:
+		*s = AltSignalKeySharedPresence
+		return nil
+	case AltSignalKeyStylometry:
+		*s = AltSignalKeyStylometry
+		return nil
+	case AltSignalKeyTiming:
+		*s = AltSignalKeyTiming
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetAccountCreatedAt sets the value of AccountCreatedAt.
-func (s *ChannelChatterEntry) SetAccountCreatedAt(val OptNilDateTime) {
-	s.AccountCreatedAt = val
+type ApproveChannelDiscoveryCandidateBadRequest ErrorMessage
+
+func (*ApproveChannelDiscoveryCandidateBadRequest) approveChannelDiscoveryCandidateRes() {}
+
+type ApproveChannelDiscoveryCandidateNotFound ErrorMessage
+
+func (*ApproveChannelDiscoveryCandidateNotFound) approveChannelDiscoveryCandidateRes() {}
+
+// Ref: #/components/schemas/AudienceChannel
+type AudienceChannel struct {
+	TwitchUserID int64  `json:"twitch_user_id"`
+	Login        string `json:"login"`
+	Chatters     int    `json:"chatters"`
+	PresentUsers int    `json:"present_users"`
+	// Distinct chatters and present users.
+	Audience int `json:"audience"`
+	// Channels this channel's community also frequents.
+	Community []AudienceCommunityChannel `json:"community"`
 }
 
-// SetMessageCount sets the value of MessageCount.
-func (s *ChannelChatterEntry) SetMessageCount(val OptNilInt64) {
-	s.MessageCount = val
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *AudienceChannel) GetTwitchUserID() int64 {
+	return s.TwitchUserID
 }
 
-// Ref: #/components/schemas/ChannelDiscoverySettings
-type ChannelDiscoverySettings struct {
-	// When true, the backend periodically scans Helix live streams for the configured game.
-	Enabled bool `json:"enabled"`
-	// Minimum seconds between discovery runs (default 3600).
-	PollIntervalSeconds int `json:"poll_interval_seconds"`
-	// Twitch Helix category id (game_id) passed to GET /helix/streams; required when enabled is true.
-	GameID string `json:"game_id"`
-	// Minimum concurrent viewers (Helix stream viewer_count) for a channel to be suggested.
-	MinLiveViewers int `json:"min_live_viewers"`
-	// When non-empty, a live stream must include every listed tag on its Helix `tags` array
-	// (case-insensitive match).
-	// Empty means no tag filter.
-	RequiredStreamTags []string `json:"required_stream_tags"`
-	// Max Helix /streams pages (100 streams each) per discovery run.
-	MaxStreamPagesPerRun int `json:"max_stream_pages_per_run"`
+// GetLogin returns the value of Login.
+func (s *AudienceChannel) GetLogin() string {
+	return s.Login
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *ChannelDiscoverySettings) GetEnabled() bool {
-	return s.Enabled
+// GetChatters returns the value of Chatters.
+func (s *AudienceChannel) GetChatters() int {
+	return s.Chatters
 }
 
-// GetPollIntervalSeconds returns the value of PollIntervalSeconds.
-func (s *ChannelDiscoverySettings) GetPollIntervalSeconds() int {
-	return s.PollIntervalSeconds
+// GetPresentUsers returns the value of PresentUsers.
+func (s *AudienceChannel) GetPresentUsers() int {
+	return s.PresentUsers
 }
 
-// GetGameID returns the value of GameID.
-func (s *ChannelDiscoverySettings) GetGameID() string {
-	return s.GameID
+// GetAudience returns the value of Audience.
+func (s *AudienceChannel) GetAudience() int {
+	return s.Audience
 }
 
-// GetMinLiveViewers returns the value of MinLiveViewers.
-func (s *ChannelDiscoverySettings) GetMinLiveViewers() int {
-	return s.MinLiveViewers
+// GetCommunity returns the value of Community.
+func (s *AudienceChannel) GetCommunity() []AudienceCommunityChannel {
+	return s.Community
 }
 
-// GetRequiredStreamTags returns the value of RequiredStreamTags.
-func (s *ChannelDiscoverySettings) GetRequiredStreamTags() []string {
-	return s.RequiredStreamTags
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *AudienceChannel) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
 }
 
-// GetMaxStreamPagesPerRun returns the value of MaxStreamPagesPerRun.
-func (s *ChannelDiscoverySettings) GetMaxStreamPagesPerRun() int {
-	return s.MaxStreamPagesPerRun
+// SetLogin sets the value of Login.
+func (s *AudienceChannel) SetLogin(val string) {
+	s.Login = val
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *ChannelDiscoverySettings) SetEnabled(val bool) {
-	s.Enabled = val
+// SetChatters sets the value of Chatters.
+func (s *AudienceChannel) SetChatters(val int) {
+	s.Chatters = val
 }
 
-// SetPollIntervalSeconds sets the value of PollIntervalSeconds.
-func (s *ChannelDiscoverySettings) SetPollIntervalSeconds(val int) {
-	s.PollIntervalSeconds = val
+// SetPresentUsers sets the value of PresentUsers.
+func (s *AudienceChannel) SetPresentUsers(val int) {
+	s.PresentUsers = val
 }
 
-// SetGameID sets the value of GameID.
-func (s *ChannelDiscoverySettings) SetGameID(val string) {
-	s.GameID = val
+// SetAudience sets the value of Audience.
+func (s *AudienceChannel) SetAudience(val int) {
+	s.Audience = val
 }
 
-// SetMinLiveViewers sets the value of MinLiveViewers.
-func (s *ChannelDiscoverySettings) SetMinLiveViewers(val int) {
-	s.MinLiveViewers = val
+// SetCommunity sets the value of Community.
+func (s *AudienceChannel) SetCommunity(val []AudienceCommunityChannel) {
+	s.Community = val
 }
 
-// SetRequiredStreamTags sets the value of RequiredStreamTags.
-func (s *ChannelDiscoverySettings) SetRequiredStreamTags(val []string) {
-	s.RequiredStreamTags = val
+// Ref: #/components/schemas/AudienceCommunityChannel
+type AudienceCommunityChannel struct {
+	TwitchUserID int64  `json:"twitch_user_id"`
+	Login        string `json:"login"`
+	Monitored    bool   `json:"monitored"`
+	// Audience members also in this channel's audience (monitored channels only).
+	ActiveUsers int `json:"active_users"`
+	// Audience members following this channel.
+	Followers int `json:"followers"`
 }
 
-// SetMaxStreamPagesPerRun sets the value of MaxStreamPagesPerRun.
-func (s *ChannelDiscoverySettings) SetMaxStreamPagesPerRun(val int) {
-	s.MaxStreamPagesPerRun = val
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *AudienceCommunityChannel) GetTwitchUserID() int64 {
+	return s.TwitchUserID
 }
 
-func (*ChannelDiscoverySettings) updateChannelDiscoverySettingsRes() {}
+// GetLogin returns the value of Login.
+func (s *AudienceCommunityChannel) GetLogin() string {
+	return s.Login
+}
 
-// Ref: #/components/schemas/ChannelLive
-type ChannelLive struct {
-	BroadcasterID    int64        `json:"broadcaster_id"`
-	BroadcasterLogin string       `json:"broadcaster_login"`
-	DisplayName      string       `json:"display_name"`
-	ProfileImageURL  string       `json:"profile_image_url"`
-	IsLive           bool         `json:"is_live"`
-	Title            OptNilString `json:"title"`
-	GameName         OptNilString `json:"game_name"`
-	ViewerCount      OptNilInt64  `json:"viewer_count"`
-	// Count of users in the IRC-maintained chat snapshot (channel_chatters). Compare to viewer_count for
-	// the delta shown in the watch UI.
-	ChannelChatterCount OptNilInt64    `json:"channel_chatter_count"`
-	StartedAt           OptNilDateTime `json:"started_at"`
+// GetMonitored returns the value of Monitored.
+func (s *AudienceCommunityChannel) GetMonitored() bool {
+	return s.Monitored
 }
 
-// GetBroadcasterID returns the value of BroadcasterID.
-func (s *ChannelLive) GetBroadcasterID() int64 {
-	return s.BroadcasterID
+// GetActiveUsers returns the value of ActiveUsers.
+func (s *AudienceCommunityChannel) GetActiveUsers() int {
+	return s.ActiveUsers
 }
 
-// GetBroadcasterLogin returns the value of BroadcasterLogin.
-func (s *ChannelLive) GetBroadcasterLogin() string {
-	return s.BroadcasterLogin
+// GetFollowers returns the value of Followers.
+func (s *AudienceCommunityChannel) GetFollowers() int {
+	return s.Followers
 }
 
-// GetDisplayName returns the value of DisplayName.
-func (s *ChannelLive) GetDisplayName() string {
-	return s.DisplayName
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *AudienceCommunityChannel) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
 }
 
-// GetProfileImageURL returns the value of ProfileImageURL.
-func (s *ChannelLive) GetProfileImageURL() string {
-	return s.ProfileImageURL
+// SetLogin sets the value of Login.
+func (s *AudienceCommunityChannel) SetLogin(val string) {
+	s.Login = val
 }
 
-// GetIsLive returns the value of IsLive.
-func (s *ChannelLive) GetIsLive() bool {
-	return s.IsLive
+// SetMonitored sets the value of Monitored.
+func (s *AudienceCommunityChannel) SetMonitored(val bool) {
+	s.Monitored = val
 }
 
-// GetTitle returns the value of Title.
-func (s *ChannelLive) GetTitle() OptNilString {
-	return s.Title
+// SetActiveUsers sets the value of ActiveUsers.
+func (s *AudienceCommunityChannel) SetActiveUsers(val int) {
+	s.ActiveUsers = val
 }
 
-// GetGameName returns the value of GameName.
-func (s *ChannelLive) GetGameName() OptNilString {
-	return s.GameName
-}
-
-// GetViewerCount returns the value of ViewerCount.
-func (s *ChannelLive) GetViewerCount() OptNilInt64 {
-	return s.ViewerCount
-}
-
-// GetChannelChatterCount returns the value of ChannelChatterCount.
-func (s *ChannelLive) GetChannelChatterCount() OptNilInt64 {
-	return s.ChannelChatterCount
-}
-
-// GetStartedAt returns the value of StartedAt.
-func (s *ChannelLive) GetStartedAt() OptNilDateTime {
-	return s.StartedAt
-}
-
-// SetBroadcasterID sets the value of BroadcasterID.
-func (s *ChannelLive) SetBroadcasterID(val int64) {
-	s.BroadcasterID = val
-}
-
-// SetBroadcasterLogin sets the value of BroadcasterLogin.
-func (s *ChannelLive) SetBroadcasterLogin(val string) {
-	s.BroadcasterLogin = val
-}
-
-// SetDisplayName sets the value of DisplayName.
-func (s *ChannelLive) SetDisplayName(val string) {
-	s.DisplayName = val
-}
-
-// SetProfileImageURL sets the value of ProfileImageURL.
-func (s *ChannelLive) SetProfileImageURL(val string) {
-	s.ProfileImageURL = val
-}
-
-// SetIsLive sets the value of IsLive.
-func (s *ChannelLive) SetIsLive(val bool) {
-	s.IsLive = val
+// SetFollowers sets the value of Followers.
+func (s *AudienceCommunityChannel) SetFollowers(val int) {
+	s.Followers = val
 }
 
-// SetTitle sets the value of Title.
-func (s *ChannelLive) SetTitle(val OptNilString) {
-	s.Title = val
+// Ref: #/components/schemas/AudienceOverlap
+type AudienceOverlap struct {
+	Period     AudienceOverlapPeriod `json:"period"`
+	From       time.Time             `json:"from"`
+	To         time.Time             `json:"to"`
+	ComputedAt time.Time             `json:"computed_at"`
+	Channels   []AudienceChannel     `json:"channels"`
+	// Channel pairs sharing at least one user.
+	Pairs         []AudienceOverlapPair `json:"pairs"`
+	JaccardMatrix [][]float64           `json:"jaccard_matrix"`
+	OverlapMatrix [][]float64           `json:"overlap_matrix"`
 }
 
-// SetGameName sets the value of GameName.
-func (s *ChannelLive) SetGameName(val OptNilString) {
-	s.GameName = val
+// GetPeriod returns the value of Period.
+func (s *AudienceOverlap) GetPeriod() AudienceOverlapPeriod {
+	return s.Period
 }
 
-// SetViewerCount sets the value of ViewerCount.
-func (s *ChannelLive) SetViewerCount(val OptNilInt64) {
-	s.ViewerCount = val
+// GetFrom returns the value of From.
+func (s *AudienceOverlap) GetFrom() time.Time {
+	return s.From
 }
 
-// SetChannelChatterCount sets the value of ChannelChatterCount.
-func (s *ChannelLive) SetChannelChatterCount(val OptNilInt64) {
-	s.ChannelChatterCount = val
+// GetTo returns the value of To.
+func (s *AudienceOverlap) GetTo() time.Time {
+	return s.To
 }
 
-// SetStartedAt sets the value of StartedAt.
-func (s *ChannelLive) SetStartedAt(val OptNilDateTime) {
-	s.StartedAt = val
+// GetComputedAt returns the value of ComputedAt.
+func (s *AudienceOverlap) GetComputedAt() time.Time {
+	return s.ComputedAt
 }
 
-func (*ChannelLive) getChannelLiveRes() {}
-
-// Ref: #/components/schemas/ChatHistoryEntry
-type ChatHistoryEntry struct {
-	ID int64 `json:"id"`
-	// Channel login where the message appeared.
-	Channel string `json:"channel"`
-	User    string `json:"user"`
-	// Twitch user id of the chatter when known.
-	ChatterUserID OptNilInt64 `json:"chatter_user_id"`
-	// Whether the chatter is marked in dredge.
-	ChatterMarked bool `json:"chatter_marked"`
-	// Whether the chatter is flagged as suspicious.
-	ChatterIsSus bool `json:"chatter_is_sus"`
-	// Whether this was the chatter's first message in this channel (Twitch IRC).
-	FirstMessage bool   `json:"first_message"`
-	Message      string `json:"message"`
-	KeywordMatch bool   `json:"keyword_match"`
-	// Irc: observed in chat; sent: posted via dredge.
-	Source    ChatHistoryEntrySource `json:"source"`
-	CreatedAt time.Time              `json:"created_at"`
-	// Twitch chat roles / badges for display (e.g. mod, VIP, verified bot, other badges).
-	BadgeTags []ChatHistoryEntryBadgeTagsItem `json:"badge_tags"`
+// GetChannels returns the value of Channels.
+func (s *AudienceOverlap) GetChannels() []AudienceChannel {
+	return s.Channels
 }
 
-// GetID returns the value of ID.
-func (s *ChatHistoryEntry) GetID() int64 {
-	return s.ID
+// GetPairs returns the value of Pairs.
+func (s *AudienceOverlap) GetPairs() []AudienceOverlapPair {
+	return s.Pairs
 }
 
-// GetChannel returns the value of Channel.
-func (s *ChatHistoryEntry) GetChannel() string {
-	return s.Channel
+// GetJaccardMatrix returns the value of JaccardMatrix.
+func (s *AudienceOverlap) GetJaccardMatrix() [][]float64 {
+	return s.JaccardMatrix
 }
 
-// GetUser returns the value of User.
-func (s *ChatHistoryEntry) GetUser() string {
-	return s.User
+// GetOverlapMatrix returns the value of OverlapMatrix.
+func (s *AudienceOverlap) GetOverlapMatrix() [][]float64 {
+	return s.OverlapMatrix
 }
 
-// GetChatterUserID returns the value of ChatterUserID.
-func (s *ChatHistoryEntry) GetChatterUserID() OptNilInt64 {
-	return s.ChatterUserID
+// SetPeriod sets the value of Period.
+func (s *AudienceOverlap) SetPeriod(val AudienceOverlapPeriod) {
+	s.Period = val
 }
 
-// GetChatterMarked returns the value of ChatterMarked.
-func (s *ChatHistoryEntry) GetChatterMarked() bool {
-	return s.ChatterMarked
+// SetFrom sets the value of From.
+func (s *AudienceOverlap) SetFrom(val time.Time) {
+	s.From = val
 }
 
-// GetChatterIsSus returns the value of ChatterIsSus.
-func (s *ChatHistoryEntry) GetChatterIsSus() bool {
-	return s.ChatterIsSus
+// SetTo sets the value of To.
+func (s *AudienceOverlap) SetTo(val time.Time) {
+	s.To = val
 }
 
-// GetFirstMessage returns the value of FirstMessage.
-func (s *ChatHistoryEntry) GetFirstMessage() bool {
-	return s.FirstMessage
+// SetComputedAt sets the value of ComputedAt.
+func (s *AudienceOverlap) SetComputedAt(val time.Time) {
+	s.ComputedAt = val
 }
 
-// GetMessage returns the value of Message.
-func (s *ChatHistoryEntry) GetMessage() string {
-	return s.Message
+// SetChannels sets the value of Channels.
+func (s *AudienceOverlap) SetChannels(val []AudienceChannel) {
+	s.Channels = val
 }
 
-// GetKeywordMatch returns the value of KeywordMatch.
-func (s *ChatHistoryEntry) GetKeywordMatch() bool {
-	return s.KeywordMatch
+// SetPairs sets the value of Pairs.
+func (s *AudienceOverlap) SetPairs(val []AudienceOverlapPair) {
+	s.Pairs = val
 }
 
-// GetSource returns the value of Source.
-func (s *ChatHistoryEntry) GetSource() ChatHistoryEntrySource {
-	return s.Source
+// SetJaccardMatrix sets the value of JaccardMatrix.
+func (s *AudienceOverlap) SetJaccardMatrix(val [][]float64) {
+	s.JaccardMatrix = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *ChatHistoryEntry) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetOverlapMatrix sets the value of OverlapMatrix.
+func (s *AudienceOverlap) SetOverlapMatrix(val [][]float64) {
+	s.OverlapMatrix = val
 }
 
-// GetBadgeTags returns the value of BadgeTags.
-func (s *ChatHistoryEntry) GetBadgeTags() []ChatHistoryEntryBadgeTagsItem {
-	return s.BadgeTags
+// Ref: #/components/schemas/AudienceOverlapPair
+type AudienceOverlapPair struct {
+	ChannelA    int64                `json:"channel_a"`
+	ChannelB    int64                `json:"channel_b"`
+	SharedUsers int                  `json:"shared_users"`
+	Jaccard     float64              `json:"jaccard"`
+	Overlap     float64              `json:"overlap"`
+	TopShared   []AudienceSharedUser `json:"top_shared"`
 }
 
-// SetID sets the value of ID.
-func (s *ChatHistoryEntry) SetID(val int64) {
-	s.ID = val
+// GetChannelA returns the value of ChannelA.
+func (s *AudienceOverlapPair) GetChannelA() int64 {
+	return s.ChannelA
 }
 
-// SetChannel sets the value of Channel.
-func (s *ChatHistoryEntry) SetChannel(val string) {
-	s.Channel = val
+// GetChannelB returns the value of ChannelB.
+func (s *AudienceOverlapPair) GetChannelB() int64 {
+	return s.ChannelB
 }
 
-// SetUser sets the value of User.
-func (s *ChatHistoryEntry) SetUser(val string) {
-	s.User = val
+// GetSharedUsers returns the value of SharedUsers.
+func (s *AudienceOverlapPair) GetSharedUsers() int {
+	return s.SharedUsers
 }
 
-// SetChatterUserID sets the value of ChatterUserID.
-func (s *ChatHistoryEntry) SetChatterUserID(val OptNilInt64) {
-	s.ChatterUserID = val
+// GetJaccard returns the value of Jaccard.
+func (s *AudienceOverlapPair) GetJaccard() float64 {
+	return s.Jaccard
 }
 
-// SetChatterMarked sets the value of ChatterMarked.
-func (s *ChatHistoryEntry) SetChatterMarked(val bool) {
-	s.ChatterMarked = val
+// GetOverlap returns the value of Overlap.
+func (s *AudienceOverlapPair) GetOverlap() float64 {
+	return s.Overlap
 }
 
-// SetChatterIsSus sets the value of ChatterIsSus.
-func (s *ChatHistoryEntry) SetChatterIsSus(val bool) {
-	s.ChatterIsSus = val
+// GetTopShared returns the value of TopShared.
+func (s *AudienceOverlapPair) GetTopShared() []AudienceSharedUser {
+	return s.TopShared
 }
 
-// SetFirstMessage sets the value of FirstMessage.
-func (s *ChatHistoryEntry) SetFirstMessage(val bool) {
-	s.FirstMessage = val
+// SetChannelA sets the value of ChannelA.
+func (s *AudienceOverlapPair) SetChannelA(val int64) {
+	s.ChannelA = val
 }
 
-// SetMessage sets the value of Message.
-func (s *ChatHistoryEntry) SetMessage(val string) {
-	s.Message = val
+// SetChannelB sets the value of ChannelB.
+func (s *AudienceOverlapPair) SetChannelB(val int64) {
+	s.ChannelB = val
 }
 
-// SetKeywordMatch sets the value of KeywordMatch.
-func (s *ChatHistoryEntry) SetKeywordMatch(val bool) {
-	s.KeywordMatch = val
+// SetSharedUsers sets the value of SharedUsers.
+func (s *AudienceOverlapPair) SetSharedUsers(val int) {
+	s.SharedUsers = val
 }
 
-// SetSource sets the value of Source.
-func (s *ChatHistoryEntry) SetSource(val ChatHistoryEntrySource) {
-	s.Source = val
+// SetJaccard sets the value of Jaccard.
+func (s *AudienceOverlapPair) SetJaccard(val float64) {
+	s.Jaccard = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *ChatHistoryEntry) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetOverlap sets the value of Overlap.
+func (s *AudienceOverlapPair) SetOverlap(val float64) {
+	s.Overlap = val
 }
 
-// SetBadgeTags sets the value of BadgeTags.
-func (s *ChatHistoryEntry) SetBadgeTags(val []ChatHistoryEntryBadgeTagsItem) {
-	s.BadgeTags = val
+// SetTopShared sets the value of TopShared.
+func (s *AudienceOverlapPair) SetTopShared(val []AudienceSharedUser) {
+	s.TopShared = val
 }
 
-type ChatHistoryEntryBadgeTagsItem string
+// Ref: #/components/schemas/AudienceOverlapPeriod
+type AudienceOverlapPeriod string
 
 const (
-	ChatHistoryEntryBadgeTagsItemModerator ChatHistoryEntryBadgeTagsItem = "moderator"
-	ChatHistoryEntryBadgeTagsItemVip       ChatHistoryEntryBadgeTagsItem = "vip"
-	ChatHistoryEntryBadgeTagsItemBot       ChatHistoryEntryBadgeTagsItem = "bot"
-	ChatHistoryEntryBadgeTagsItemOther     ChatHistoryEntryBadgeTagsItem = "other"
+	AudienceOverlapPeriod24h AudienceOverlapPeriod = "24h"
+	AudienceOverlapPeriod7d  AudienceOverlapPeriod = "7d"
+	AudienceOverlapPeriod30d AudienceOverlapPeriod = "30d"
+	AudienceOverlapPeriod90d AudienceOverlapPeriod = "90d"
 )
 
-// AllValues returns all ChatHistoryEntryBadgeTagsItem values.
-func (ChatHistoryEntryBadgeTagsItem) AllValues() []ChatHistoryEntryBadgeTagsItem {
-	return []ChatHistoryEntryBadgeTagsItem{
-		ChatHistoryEntryBadgeTagsItemModerator,
-		ChatHistoryEntryBadgeTagsItemVip,
-		ChatHistoryEntryBadgeTagsItemBot,
-		ChatHistoryEntryBadgeTagsItemOther,
+// AllValues returns all AudienceOverlapPeriod values.
+func (AudienceOverlapPeriod) AllValues() []AudienceOverlapPeriod {
+	return []AudienceOverlapPeriod{
+		AudienceOverlapPeriod24h,
+		AudienceOverlapPeriod7d,
+		AudienceOverlapPeriod30d,
+		AudienceOverlapPeriod90d,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s ChatHistoryEntryBadgeTagsItem) MarshalText() ([]byte, error) {
+func (s AudienceOverlapPeriod) MarshalText() ([]byte, error) {
 	switch s {
-	case ChatHistoryEntryBadgeTagsItemModerator:
+	case AudienceOverlapPeriod24h:
 		return []byte(s), nil
-	case ChatHistoryEntryBadgeTagsItemVip:
+	case AudienceOverlapPeriod7d:
 		return []byte(s), nil
-	case ChatHistoryEntryBadgeTagsItemBot:
+	case AudienceOverlapPeriod30d:
 		return []byte(s), nil
-	case ChatHistoryEntryBadgeTagsItemOther:
+	case AudienceOverlapPeriod90d:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -853,296 +939,287 @@ func (s ChatHistoryEntryBadgeTagsItem) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ChatHistoryEntryBadgeTagsItem) UnmarshalText(data []byte) error {
-	switch ChatHistoryEntryBadgeTagsItem(data) {
-	case ChatHistoryEntryBadgeTagsItemModerator:
-		*s = ChatHistoryEntryBadgeTagsItemModerator
+func (s *AudienceOverlapPeriod) UnmarshalText(data []byte) error {
+	switch AudienceOverlapPeriod(data) {
+	case AudienceOverlapPeriod24h:
+		*s = AudienceOverlapPeriod24h
 		return nil
-	case ChatHistoryEntryBadgeTagsItemVip:
-		*s = ChatHistoryEntryBadgeTagsItemVip
+	case AudienceOverlapPeriod7d:
+		*s = AudienceOverlapPeriod7d
 		return nil
-	case ChatHistoryEntryBadgeTagsItemBot:
-		*s = ChatHistoryEntryBadgeTagsItemBot
+	case AudienceOverlapPeriod30d:
+		*s = AudienceOverlapPeriod30d
 		return nil
-	case ChatHistoryEntryBadgeTagsItemOther:
-		*s = ChatHistoryEntryBadgeTagsItemOther
+	case AudienceOverlapPeriod90d:
+		*s = AudienceOverlapPeriod90d
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Irc: observed in chat; sent: posted via dredge.
-type ChatHistoryEntrySource string
-
-const (
-	ChatHistoryEntrySourceIrc  ChatHistoryEntrySource = "irc"
-	ChatHistoryEntrySourceSent ChatHistoryEntrySource = "sent"
-)
+// Ref: #/components/schemas/AudienceSharedUser
+type AudienceSharedUser struct {
+	TwitchUserID int64  `json:"twitch_user_id"`
+	Login        string `json:"login"`
+	MessagesA    int64  `json:"messages_a"`
+	MessagesB    int64  `json:"messages_b"`
+}
 
-// AllValues returns all ChatHistoryEntrySource values.
-func (ChatHistoryEntrySource) AllValues() []ChatHistoryEntrySource {
-	return []ChatHistoryEntrySource{
-		ChatHistoryEntrySourceIrc,
-		ChatHistoryEntrySourceSent,
-	}
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *AudienceSharedUser) GetTwitchUserID() int64 {
+	return s.TwitchUserID
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ChatHistoryEntrySource) MarshalText() ([]byte, error) {
-	switch s {
-	case ChatHistoryEntrySourceIrc:
-		return []byte(s), nil
-	case ChatHistoryEntrySourceSent:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetLogin returns the value of Login.
+func (s *AudienceSharedUser) GetLogin() string {
+	return s.Login
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ChatHistoryEntrySource) UnmarshalText(data []byte) error {
-	switch ChatHistoryEntrySource(data) {
-	case ChatHistoryEntrySourceIrc:
-		*s = ChatHistoryEntrySourceIrc
-		return nil
-	case ChatHistoryEntrySourceSent:
-		*s = ChatHistoryEntrySourceSent
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetMessagesA returns the value of MessagesA.
+func (s *AudienceSharedUser) GetMessagesA() int64 {
+	return s.MessagesA
 }
 
-// Ref: #/components/schemas/ClientNotice
-type ClientNotice struct {
-	Severity ClientNoticeSeverity   `json:"severity"`
-	Code     string                 `json:"code"`
-	Message  string                 `json:"message"`
-	Details  OptClientNoticeDetails `json:"details"`
+// GetMessagesB returns the value of MessagesB.
+func (s *AudienceSharedUser) GetMessagesB() int64 {
+	return s.MessagesB
 }
 
-// GetSeverity returns the value of Severity.
-func (s *ClientNotice) GetSeverity() ClientNoticeSeverity {
-	return s.Severity
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *AudienceSharedUser) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
 }
 
-// GetCode returns the value of Code.
-func (s *ClientNotice) GetCode() string {
-	return s.Code
+// SetLogin sets the value of Login.
+func (s *AudienceSharedUser) SetLogin(val string) {
+	s.Login = val
 }
 
-// GetMessage returns the value of Message.
-func (s *ClientNotice) GetMessage() string {
-	return s.Message
+// SetMessagesA sets the value of MessagesA.
+func (s *AudienceSharedUser) SetMessagesA(val int64) {
+	s.MessagesA = val
 }
 
-// GetDetails returns the value of Details.
-func (s *ClientNotice) GetDetails() OptClientNoticeDetails {
-	return s.Details
+// SetMessagesB sets the value of MessagesB.
+func (s *AudienceSharedUser) SetMessagesB(val int64) {
+	s.MessagesB = val
 }
 
-// SetSeverity sets the value of Severity.
-func (s *ClientNotice) SetSeverity(val ClientNoticeSeverity) {
-	s.Severity = val
+type BearerAuth struct {
+	Token string
+	Roles []string
 }
 
-// SetCode sets the value of Code.
-func (s *ClientNotice) SetCode(val string) {
-	s.Code = val
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
 }
 
-// SetMessage sets the value of Message.
-func (s *ClientNotice) SetMessage(val string) {
-	s.Message = val
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
 }
 
-// SetDetails sets the value of Details.
-func (s *ClientNotice) SetDetails(val OptClientNoticeDetails) {
-	s.Details = val
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
 }
 
-type ClientNoticeDetails map[string]jx.Raw
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
 
-func (s *ClientNoticeDetails) init() ClientNoticeDetails {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// Ref: #/components/schemas/Blocklist
+type Blocklist struct {
+	ID     int64           `json:"id"`
+	Name   string          `json:"name"`
+	Format BlocklistFormat `json:"format"`
+	// Pulled on a schedule when set.
+	SourceURL           NilString `json:"source_url"`
+	SyncIntervalMinutes int       `json:"sync_interval_minutes"`
+	// Disabled lists are neither pulled nor matched.
+	Enabled bool `json:"enabled"`
+	// Uploaded file the current entries came from (null when pulled from source_url).
+	SourceFilename NilString `json:"source_filename"`
+	// Digest of the imported content.
+	ContentSHA256  NilString   `json:"content_sha256"`
+	EntryCount     int         `json:"entry_count"`
+	LastImportedAt NilDateTime `json:"last_imported_at"`
+	// Last pull attempt from source_url.
+	LastSyncedAt NilDateTime `json:"last_synced_at"`
+	// Why the last pull failed (null after a successful one).
+	LastSyncError NilString `json:"last_sync_error"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
-type ClientNoticeSeverity string
+// GetID returns the value of ID.
+func (s *Blocklist) GetID() int64 {
+	return s.ID
+}
 
-const (
-	ClientNoticeSeverityError   ClientNoticeSeverity = "error"
-	ClientNoticeSeverityWarning ClientNoticeSeverity = "warning"
-)
+// GetName returns the value of Name.
+func (s *Blocklist) GetName() string {
+	return s.Name
+}
 
-// AllValues returns all ClientNoticeSeverity values.
-func (ClientNoticeSeverity) AllValues() []ClientNoticeSeverity {
-	return []ClientNoticeSeverity{
-		ClientNoticeSeverityError,
-		ClientNoticeSeverityWarning,
-	}
+// GetFormat returns the value of Format.
+func (s *Blocklist) GetFormat() BlocklistFormat {
+	return s.Format
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ClientNoticeSeverity) MarshalText() ([]byte, error) {
-	switch s {
-	case ClientNoticeSeverityError:
-		return []byte(s), nil
-	case ClientNoticeSeverityWarning:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetSourceURL returns the value of SourceURL.
+func (s *Blocklist) GetSourceURL() NilString {
+	return s.SourceURL
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ClientNoticeSeverity) UnmarshalText(data []byte) error {
-	switch ClientNoticeSeverity(data) {
-	case ClientNoticeSeverityError:
-		*s = ClientNoticeSeverityError
-		return nil
-	case ClientNoticeSeverityWarning:
-		*s = ClientNoticeSeverityWarning
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetSyncIntervalMinutes returns the value of SyncIntervalMinutes.
+func (s *Blocklist) GetSyncIntervalMinutes() int {
+	return s.SyncIntervalMinutes
 }
 
-// Ref: #/components/schemas/ConfirmAiToolRequest
-type ConfirmAiToolRequest struct {
-	ToolCallID string `json:"tool_call_id"`
-	Approve    bool   `json:"approve"`
+// GetEnabled returns the value of Enabled.
+func (s *Blocklist) GetEnabled() bool {
+	return s.Enabled
 }
 
-// GetToolCallID returns the value of ToolCallID.
-func (s *ConfirmAiToolRequest) GetToolCallID() string {
-	return s.ToolCallID
+// GetSourceFilename returns the value of SourceFilename.
+func (s *Blocklist) GetSourceFilename() NilString {
+	return s.SourceFilename
 }
 
-// GetApprove returns the value of Approve.
-func (s *ConfirmAiToolRequest) GetApprove() bool {
-	return s.Approve
+// GetContentSHA256 returns the value of ContentSHA256.
+func (s *Blocklist) GetContentSHA256() NilString {
+	return s.ContentSHA256
 }
 
-// SetToolCallID sets the value of ToolCallID.
-func (s *ConfirmAiToolRequest) SetToolCallID(val string) {
-	s.ToolCallID = val
+// GetEntryCount returns the value of EntryCount.
+func (s *Blocklist) GetEntryCount() int {
+	return s.EntryCount
 }
 
-// SetApprove sets the value of Approve.
-func (s *ConfirmAiToolRequest) SetApprove(val bool) {
-	s.Approve = val
+// GetLastImportedAt returns the value of LastImportedAt.
+func (s *Blocklist) GetLastImportedAt() NilDateTime {
+	return s.LastImportedAt
 }
 
-// Ref: #/components/schemas/CountResponse
-type CountResponse struct {
-	Total int64 `json:"total"`
+// GetLastSyncedAt returns the value of LastSyncedAt.
+func (s *Blocklist) GetLastSyncedAt() NilDateTime {
+	return s.LastSyncedAt
 }
 
-// GetTotal returns the value of Total.
-func (s *CountResponse) GetTotal() int64 {
-	return s.Total
+// GetLastSyncError returns the value of LastSyncError.
+func (s *Blocklist) GetLastSyncError() NilString {
+	return s.LastSyncError
 }
 
-// SetTotal sets the value of Total.
-func (s *CountResponse) SetTotal(val int64) {
-	s.Total = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Blocklist) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Ref: #/components/schemas/CreateAiConversationRequest
-type CreateAiConversationRequest struct {
-	Title OptString `json:"title"`
+// SetID sets the value of ID.
+func (s *Blocklist) SetID(val int64) {
+	s.ID = val
 }
 
-// GetTitle returns the value of Title.
-func (s *CreateAiConversationRequest) GetTitle() OptString {
-	return s.Title
+// SetName sets the value of Name.
+func (s *Blocklist) SetName(val string) {
+	s.Name = val
 }
 
-// SetTitle sets the value of Title.
-func (s *CreateAiConversationRequest) SetTitle(val OptString) {
-	s.Title = val
+// SetFormat sets the value of Format.
+func (s *Blocklist) SetFormat(val BlocklistFormat) {
+	s.Format = val
 }
 
-// Ref: #/components/schemas/CreateAiMessageRequest
-type CreateAiMessageRequest struct {
-	Content string `json:"content"`
+// SetSourceURL sets the value of SourceURL.
+func (s *Blocklist) SetSourceURL(val NilString) {
+	s.SourceURL = val
 }
 
-// GetContent returns the value of Content.
-func (s *CreateAiMessageRequest) GetContent() string {
-	return s.Content
+// SetSyncIntervalMinutes sets the value of SyncIntervalMinutes.
+func (s *Blocklist) SetSyncIntervalMinutes(val int) {
+	s.SyncIntervalMinutes = val
 }
 
-// SetContent sets the value of Content.
-func (s *CreateAiMessageRequest) SetContent(val string) {
-	s.Content = val
+// SetEnabled sets the value of Enabled.
+func (s *Blocklist) SetEnabled(val bool) {
+	s.Enabled = val
 }
 
-// Ref: #/components/schemas/CreateNotificationRequest
-type CreateNotificationRequest struct {
-	Provider CreateNotificationRequestProvider `json:"provider"`
-	Settings CreateNotificationRequestSettings `json:"settings"`
-	Enabled  OptBool                           `json:"enabled"`
+// SetSourceFilename sets the value of SourceFilename.
+func (s *Blocklist) SetSourceFilename(val NilString) {
+	s.SourceFilename = val
 }
 
-// GetProvider returns the value of Provider.
-func (s *CreateNotificationRequest) GetProvider() CreateNotificationRequestProvider {
-	return s.Provider
+// SetContentSHA256 sets the value of ContentSHA256.
+func (s *Blocklist) SetContentSHA256(val NilString) {
+	s.ContentSHA256 = val
 }
 
-// GetSettings returns the value of Settings.
-func (s *CreateNotificationRequest) GetSettings() CreateNotificationRequestSettings {
-	return s.Settings
+// SetEntryCount sets the value of EntryCount.
+func (s *Blocklist) SetEntryCount(val int) {
+	s.EntryCount = val
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *CreateNotificationRequest) GetEnabled() OptBool {
-	return s.Enabled
+// SetLastImportedAt sets the value of LastImportedAt.
+func (s *Blocklist) SetLastImportedAt(val NilDateTime) {
+	s.LastImportedAt = val
 }
 
-// SetProvider sets the value of Provider.
-func (s *CreateNotificationRequest) SetProvider(val CreateNotificationRequestProvider) {
-	s.Provider = val
+// SetLastSyncedAt sets the value of LastSyncedAt.
+func (s *Blocklist) SetLastSyncedAt(val NilDateTime) {
+	s.LastSyncedAt = val
 }
 
-// SetSettings sets the value of Settings.
-func (s *CreateNotificationRequest) SetSettings(val CreateNotificationRequestSettings) {
-	s.Settings = val
+// SetLastSyncError sets the value of LastSyncError.
+func (s *Blocklist) SetLastSyncError(val NilString) {
+	s.LastSyncError = val
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *CreateNotificationRequest) SetEnabled(val OptBool) {
-	s.Enabled = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Blocklist) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-type CreateNotificationRequestProvider string
+func (*Blocklist) createBlocklistRes() {}
+func (*Blocklist) syncBlocklistRes()   {}
+func (*Blocklist) uploadBlocklistRes() {}
+
+// `text`: one account per line, `#` comments. `csv`: a header with a login column (login, user_login,
+// username, user_name, name) and/or an id column (id, user_id, twitch_id, twitch_user_id), else the
+// first
+// column. `json`: an array of strings, numbers or objects with the csv keys, or an object holding
+// one under
+// users, accounts, entries, logins or data. Bare all-digit values are Twitch user ids.
+// Ref: #/components/schemas/BlocklistFormat
+type BlocklistFormat string
 
 const (
-	CreateNotificationRequestProviderTelegram CreateNotificationRequestProvider = "telegram"
-	CreateNotificationRequestProviderWebhook  CreateNotificationRequestProvider = "webhook"
+	BlocklistFormatText BlocklistFormat = "text"
+	BlocklistFormatCsv  BlocklistFormat = "csv"
+	BlocklistFormatJSON BlocklistFormat = "json"
 )
 
-// AllValues returns all CreateNotificationRequestProvider values.
-func (CreateNotificationRequestProvider) AllValues() []CreateNotificationRequestProvider {
-	return []CreateNotificationRequestProvider{
-		CreateNotificationRequestProviderTelegram,
-		CreateNotificationRequestProviderWebhook,
+// AllValues returns all BlocklistFormat values.
+func (BlocklistFormat) AllValues() []BlocklistFormat {
+	return []BlocklistFormat{
+		BlocklistFormatText,
+		BlocklistFormatCsv,
+		BlocklistFormatJSON,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s CreateNotificationRequestProvider) MarshalText() ([]byte, error) {
+func (s BlocklistFormat) MarshalText() ([]byte, error) {
 	switch s {
-	case CreateNotificationRequestProviderTelegram:
+	case BlocklistFormatText:
 		return []byte(s), nil
-	case CreateNotificationRequestProviderWebhook:
+	case BlocklistFormatCsv:
+		return []byte(s), nil
+	case BlocklistFormatJSON:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -1150,215 +1227,199 @@ func (s CreateNotificationRequestProvider) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *CreateNotificationRequestProvider) UnmarshalText(data []byte) error {
-	switch CreateNotificationRequestProvider(data) {
-	case CreateNotificationRequestProviderTelegram:
-		*s = CreateNotificationRequestProviderTelegram
+func (s *BlocklistFormat) UnmarshalText(data []byte) error {
+	switch BlocklistFormat(data) {
+	case BlocklistFormatText:
+		*s = BlocklistFormatText
 		return nil
-	case CreateNotificationRequestProviderWebhook:
-		*s = CreateNotificationRequestProviderWebhook
+	case BlocklistFormatCsv:
+		*s = BlocklistFormatCsv
+		return nil
+	case BlocklistFormatJSON:
+		*s = BlocklistFormatJSON
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type CreateNotificationRequestSettings map[string]jx.Raw
-
-func (s *CreateNotificationRequestSettings) init() CreateNotificationRequestSettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// Ref: #/components/schemas/BotDetectionSettings
+type BotDetectionSettings struct {
+	// When true, presence is analyzed for likely bots every 10 minutes.
+	Enabled bool `json:"enabled"`
+	// Channels a user must be present in at the same time to be considered (default 8).
+	MinConcurrentChannels int `json:"min_concurrent_channels"`
+	// Most messages per channel-hour of presence a likely bot may send (default 0.05).
+	MaxMessagesPerHour float64 `json:"max_messages_per_hour"`
+	// Helix viewers expected per human chatter before a channel's viewer count counts as inflated
+	// (default 4).
+	ViewersPerChatter float64 `json:"viewers_per_chatter"`
+	// Hide likely bots from stream leaderboards, channel chatter lists and chatter counts.
+	ExcludeFromStats bool `json:"exclude_from_stats"`
 }
 
-// Ref: #/components/schemas/CreateRuleRequest
-type CreateRuleRequest struct {
-	// Display name for this rule (required; non-empty).
-	Name           string                          `json:"name"`
-	Enabled        OptBool                         `json:"enabled"`
-	EventType      RuleEventType                   `json:"event_type"`
-	EventSettings  CreateRuleRequestEventSettings  `json:"event_settings"`
-	Middlewares    []RuleMiddleware                `json:"middlewares"`
-	ActionType     RuleActionType                  `json:"action_type"`
-	ActionSettings CreateRuleRequestActionSettings `json:"action_settings"`
-	UseSharedPool  OptBool                         `json:"use_shared_pool"`
+// GetEnabled returns the value of Enabled.
+func (s *BotDetectionSettings) GetEnabled() bool {
+	return s.Enabled
 }
 
-// GetName returns the value of Name.
-func (s *CreateRuleRequest) GetName() string {
-	return s.Name
+// GetMinConcurrentChannels returns the value of MinConcurrentChannels.
+func (s *BotDetectionSettings) GetMinConcurrentChannels() int {
+	return s.MinConcurrentChannels
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *CreateRuleRequest) GetEnabled() OptBool {
-	return s.Enabled
+// GetMaxMessagesPerHour returns the value of MaxMessagesPerHour.
+func (s *BotDetectionSettings) GetMaxMessagesPerHour() float64 {
+	return s.MaxMessagesPerHour
 }
 
-// GetEventType returns the value of EventType.
-func (s *CreateRuleRequest) GetEventType() RuleEventType {
-	return s.EventType
+// GetViewersPerChatter returns the value of ViewersPerChatter.
+func (s *BotDetectionSettings) GetViewersPerChatter() float64 {
+	return s.ViewersPerChatter
 }
 
-// GetEventSettings returns the value of EventSettings.
-func (s *CreateRuleRequest) GetEventSettings() CreateRuleRequestEventSettings {
-	return s.EventSettings
+// GetExcludeFromStats returns the value of ExcludeFromStats.
+func (s *BotDetectionSettings) GetExcludeFromStats() bool {
+	return s.ExcludeFromStats
 }
 
-// GetMiddlewares returns the value of Middlewares.
-func (s *CreateRuleRequest) GetMiddlewares() []RuleMiddleware {
-	return s.Middlewares
+// SetEnabled sets the value of Enabled.
+func (s *BotDetectionSettings) SetEnabled(val bool) {
+	s.Enabled = val
 }
 
-// GetActionType returns the value of ActionType.
-func (s *CreateRuleRequest) GetActionType() RuleActionType {
-	return s.ActionType
+// SetMinConcurrentChannels sets the value of MinConcurrentChannels.
+func (s *BotDetectionSettings) SetMinConcurrentChannels(val int) {
+	s.MinConcurrentChannels = val
 }
 
-// GetActionSettings returns the value of ActionSettings.
-func (s *CreateRuleRequest) GetActionSettings() CreateRuleRequestActionSettings {
-	return s.ActionSettings
+// SetMaxMessagesPerHour sets the value of MaxMessagesPerHour.
+func (s *BotDetectionSettings) SetMaxMessagesPerHour(val float64) {
+	s.MaxMessagesPerHour = val
 }
 
-// GetUseSharedPool returns the value of UseSharedPool.
-func (s *CreateRuleRequest) GetUseSharedPool() OptBool {
-	return s.UseSharedPool
+// SetViewersPerChatter sets the value of ViewersPerChatter.
+func (s *BotDetectionSettings) SetViewersPerChatter(val float64) {
+	s.ViewersPerChatter = val
 }
 
-// SetName sets the value of Name.
-func (s *CreateRuleRequest) SetName(val string) {
-	s.Name = val
+// SetExcludeFromStats sets the value of ExcludeFromStats.
+func (s *BotDetectionSettings) SetExcludeFromStats(val bool) {
+	s.ExcludeFromStats = val
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *CreateRuleRequest) SetEnabled(val OptBool) {
-	s.Enabled = val
-}
+func (*BotDetectionSettings) updateBotDetectionSettingsRes() {}
 
-// SetEventType sets the value of EventType.
-func (s *CreateRuleRequest) SetEventType(val RuleEventType) {
-	s.EventType = val
+// Ref: #/components/schemas/ChannelAnalytics
+type ChannelAnalytics struct {
+	ChannelLogin string                  `json:"channel_login"`
+	Bucket       ChannelAnalyticsBucket  `json:"bucket"`
+	From         time.Time               `json:"from"`
+	To           time.Time               `json:"to"`
+	Series       []ChannelAnalyticsPoint `json:"series"`
+	Retention    []StreamRetentionEntry  `json:"retention"`
+	// How far hour/day rollups reach; later buckets read as empty.
+	RolledUpUntil NilDateTime `json:"rolled_up_until"`
 }
 
-// SetEventSettings sets the value of EventSettings.
-func (s *CreateRuleRequest) SetEventSettings(val CreateRuleRequestEventSettings) {
-	s.EventSettings = val
+// GetChannelLogin returns the value of ChannelLogin.
+func (s *ChannelAnalytics) GetChannelLogin() string {
+	return s.ChannelLogin
 }
 
-// SetMiddlewares sets the value of Middlewares.
-func (s *CreateRuleRequest) SetMiddlewares(val []RuleMiddleware) {
-	s.Middlewares = val
+// GetBucket returns the value of Bucket.
+func (s *ChannelAnalytics) GetBucket() ChannelAnalyticsBucket {
+	return s.Bucket
 }
 
-// SetActionType sets the value of ActionType.
-func (s *CreateRuleRequest) SetActionType(val RuleActionType) {
-	s.ActionType = val
+// GetFrom returns the value of From.
+func (s *ChannelAnalytics) GetFrom() time.Time {
+	return s.From
 }
 
-// SetActionSettings sets the value of ActionSettings.
-func (s *CreateRuleRequest) SetActionSettings(val CreateRuleRequestActionSettings) {
-	s.ActionSettings = val
+// GetTo returns the value of To.
+func (s *ChannelAnalytics) GetTo() time.Time {
+	return s.To
 }
 
-// SetUseSharedPool sets the value of UseSharedPool.
-func (s *CreateRuleRequest) SetUseSharedPool(val OptBool) {
-	s.UseSharedPool = val
+// GetSeries returns the value of Series.
+func (s *ChannelAnalytics) GetSeries() []ChannelAnalyticsPoint {
+	return s.Series
 }
 
-type CreateRuleRequestActionSettings map[string]jx.Raw
+// GetRetention returns the value of Retention.
+func (s *ChannelAnalytics) GetRetention() []StreamRetentionEntry {
+	return s.Retention
+}
 
-func (s *CreateRuleRequestActionSettings) init() CreateRuleRequestActionSettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetRolledUpUntil returns the value of RolledUpUntil.
+func (s *ChannelAnalytics) GetRolledUpUntil() NilDateTime {
+	return s.RolledUpUntil
 }
 
-type CreateRuleRequestEventSettings map[string]jx.Raw
-
-func (s *CreateRuleRequestEventSettings) init() CreateRuleRequestEventSettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
-}
-
-// Ref: #/components/schemas/CreateTwitchAccountRequest
-type CreateTwitchAccountRequest struct {
-	// Twitch user id for this OAuth identity (same as Helix user id).
-	ID           int64                                    `json:"id"`
-	Username     string                                   `json:"username"`
-	RefreshToken string                                   `json:"refresh_token"`
-	AccountType  OptCreateTwitchAccountRequestAccountType `json:"account_type"`
-}
-
-// GetID returns the value of ID.
-func (s *CreateTwitchAccountRequest) GetID() int64 {
-	return s.ID
+// SetChannelLogin sets the value of ChannelLogin.
+func (s *ChannelAnalytics) SetChannelLogin(val string) {
+	s.ChannelLogin = val
 }
 
-// GetUsername returns the value of Username.
-func (s *CreateTwitchAccountRequest) GetUsername() string {
-	return s.Username
+// SetBucket sets the value of Bucket.
+func (s *ChannelAnalytics) SetBucket(val ChannelAnalyticsBucket) {
+	s.Bucket = val
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *CreateTwitchAccountRequest) GetRefreshToken() string {
-	return s.RefreshToken
+// SetFrom sets the value of From.
+func (s *ChannelAnalytics) SetFrom(val time.Time) {
+	s.From = val
 }
 
-// GetAccountType returns the value of AccountType.
-func (s *CreateTwitchAccountRequest) GetAccountType() OptCreateTwitchAccountRequestAccountType {
-	return s.AccountType
+// SetTo sets the value of To.
+func (s *ChannelAnalytics) SetTo(val time.Time) {
+	s.To = val
 }
 
-// SetID sets the value of ID.
-func (s *CreateTwitchAccountRequest) SetID(val int64) {
-	s.ID = val
+// SetSeries sets the value of Series.
+func (s *ChannelAnalytics) SetSeries(val []ChannelAnalyticsPoint) {
+	s.Series = val
 }
 
-// SetUsername sets the value of Username.
-func (s *CreateTwitchAccountRequest) SetUsername(val string) {
-	s.Username = val
+// SetRetention sets the value of Retention.
+func (s *ChannelAnalytics) SetRetention(val []StreamRetentionEntry) {
+	s.Retention = val
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *CreateTwitchAccountRequest) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// SetRolledUpUntil sets the value of RolledUpUntil.
+func (s *ChannelAnalytics) SetRolledUpUntil(val NilDateTime) {
+	s.RolledUpUntil = val
 }
 
-// SetAccountType sets the value of AccountType.
-func (s *CreateTwitchAccountRequest) SetAccountType(val OptCreateTwitchAccountRequestAccountType) {
-	s.AccountType = val
-}
+func (*ChannelAnalytics) getChannelAnalyticsRes() {}
 
-type CreateTwitchAccountRequestAccountType string
+// Ref: #/components/schemas/ChannelAnalyticsBucket
+type ChannelAnalyticsBucket string
 
 const (
-	CreateTwitchAccountRequestAccountTypeMain CreateTwitchAccountRequestAccountType = "main"
-	CreateTwitchAccountRequestAccountTypeBot  CreateTwitchAccountRequestAccountType = "bot"
+	ChannelAnalyticsBucketMinute ChannelAnalyticsBucket = "minute"
+	ChannelAnalyticsBucketHour   ChannelAnalyticsBucket = "hour"
+	ChannelAnalyticsBucketDay    ChannelAnalyticsBucket = "day"
 )
 
-// AllValues returns all CreateTwitchAccountRequestAccountType values.
-func (CreateTwitchAccountRequestAccountType) AllValues() []CreateTwitchAccountRequestAccountType {
-	return []CreateTwitchAccountRequestAccountType{
-		CreateTwitchAccountRequestAccountTypeMain,
-		CreateTwitchAccountRequestAccountTypeBot,
+// AllValues returns all ChannelAnalyticsBucket values.
+func (ChannelAnalyticsBucket) AllValues() []ChannelAnalyticsBucket {
+	return []ChannelAnalyticsBucket{
+		ChannelAnalyticsBucketMinute,
+		ChannelAnalyticsBucketHour,
+		ChannelAnalyticsBucketDay,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s CreateTwitchAccountRequestAccountType) MarshalText() ([]byte, error) {
+func (s ChannelAnalyticsBucket) MarshalText() ([]byte, error) {
 	switch s {
-	case CreateTwitchAccountRequestAccountTypeMain:
+	case ChannelAnalyticsBucketMinute:
 		return []byte(s), nil
-	case CreateTwitchAccountRequestAccountTypeBot:
+	case ChannelAnalyticsBucketHour:
+		return []byte(s), nil
+	case ChannelAnalyticsBucketDay:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -1366,813 +1427,928 @@ func (s CreateTwitchAccountRequestAccountType) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *CreateTwitchAccountRequestAccountType) UnmarshalText(data []byte) error {
-	switch CreateTwitchAccountRequestAccountType(data) {
-	case CreateTwitchAccountRequestAccountTypeMain:
-		*s = CreateTwitchAccountRequestAccountTypeMain
+func (s *ChannelAnalyticsBucket) UnmarshalText(data []byte) error {
+	switch ChannelAnalyticsBucket(data) {
+	case ChannelAnalyticsBucketMinute:
+		*s = ChannelAnalyticsBucketMinute
 		return nil
-	case CreateTwitchAccountRequestAccountTypeBot:
-		*s = CreateTwitchAccountRequestAccountTypeBot
+	case ChannelAnalyticsBucketHour:
+		*s = ChannelAnalyticsBucketHour
+		return nil
+	case ChannelAnalyticsBucketDay:
+		*s = ChannelAnalyticsBucketDay
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/CreateTwitchUserRequest
-type CreateTwitchUserRequest struct {
-	// Channel login or display name; resolved via Twitch Helix.
-	Name string `json:"name"`
+// Ref: #/components/schemas/ChannelAnalyticsPoint
+type ChannelAnalyticsPoint struct {
+	BucketStart time.Time `json:"bucket_start"`
+	Messages    int64     `json:"messages"`
+	// Distinct message senders.
+	UniqueChatters int `json:"unique_chatters"`
+	// Chatters whose first message in the channel falls in the bucket.
+	NewChatters int `json:"new_chatters"`
+	// Chatters with IRC presence in the bucket.
+	PresentChatters int `json:"present_chatters"`
+	// Mean presence of present_chatters within the bucket.
+	AveragePresenceSeconds float64 `json:"average_presence_seconds"`
 }
 
-// GetName returns the value of Name.
-func (s *CreateTwitchUserRequest) GetName() string {
-	return s.Name
+// GetBucketStart returns the value of BucketStart.
+func (s *ChannelAnalyticsPoint) GetBucketStart() time.Time {
+	return s.BucketStart
 }
 
-// SetName sets the value of Name.
-func (s *CreateTwitchUserRequest) SetName(val string) {
-	s.Name = val
+// GetMessages returns the value of Messages.
+func (s *ChannelAnalyticsPoint) GetMessages() int64 {
+	return s.Messages
 }
 
-// DeleteAiConversationNoContent is response for DeleteAiConversation operation.
-type DeleteAiConversationNoContent struct{}
+// GetUniqueChatters returns the value of UniqueChatters.
+func (s *ChannelAnalyticsPoint) GetUniqueChatters() int {
+	return s.UniqueChatters
+}
 
-func (*DeleteAiConversationNoContent) deleteAiConversationRes() {}
+// GetNewChatters returns the value of NewChatters.
+func (s *ChannelAnalyticsPoint) GetNewChatters() int {
+	return s.NewChatters
+}
 
-// Ref: #/components/schemas/DeleteByIDRequest
-type DeleteByIDRequest struct {
-	ID int64 `json:"id"`
+// GetPresentChatters returns the value of PresentChatters.
+func (s *ChannelAnalyticsPoint) GetPresentChatters() int {
+	return s.PresentChatters
 }
 
-// GetID returns the value of ID.
-func (s *DeleteByIDRequest) GetID() int64 {
-	return s.ID
+// GetAveragePresenceSeconds returns the value of AveragePresenceSeconds.
+func (s *ChannelAnalyticsPoint) GetAveragePresenceSeconds() float64 {
+	return s.AveragePresenceSeconds
 }
 
-// SetID sets the value of ID.
-func (s *DeleteByIDRequest) SetID(val int64) {
-	s.ID = val
+// SetBucketStart sets the value of BucketStart.
+func (s *ChannelAnalyticsPoint) SetBucketStart(val time.Time) {
+	s.BucketStart = val
 }
 
-// DeleteNotificationNoContent is response for DeleteNotification operation.
-type DeleteNotificationNoContent struct{}
+// SetMessages sets the value of Messages.
+func (s *ChannelAnalyticsPoint) SetMessages(val int64) {
+	s.Messages = val
+}
 
-func (*DeleteNotificationNoContent) deleteNotificationRes() {}
+// SetUniqueChatters sets the value of UniqueChatters.
+func (s *ChannelAnalyticsPoint) SetUniqueChatters(val int) {
+	s.UniqueChatters = val
+}
 
-// DeleteRuleNoContent is response for DeleteRule operation.
-type DeleteRuleNoContent struct{}
+// SetNewChatters sets the value of NewChatters.
+func (s *ChannelAnalyticsPoint) SetNewChatters(val int) {
+	s.NewChatters = val
+}
 
-func (*DeleteRuleNoContent) deleteRuleRes() {}
+// SetPresentChatters sets the value of PresentChatters.
+func (s *ChannelAnalyticsPoint) SetPresentChatters(val int) {
+	s.PresentChatters = val
+}
 
-// DeleteTwitchAccountNoContent is response for DeleteTwitchAccount operation.
-type DeleteTwitchAccountNoContent struct{}
+// SetAveragePresenceSeconds sets the value of AveragePresenceSeconds.
+func (s *ChannelAnalyticsPoint) SetAveragePresenceSeconds(val float64) {
+	s.AveragePresenceSeconds = val
+}
 
-func (*DeleteTwitchAccountNoContent) deleteTwitchAccountRes() {}
+// Ref: #/components/schemas/ChannelBlacklistChange
+type ChannelBlacklistChange struct {
+	Login string `json:"login"`
+	// When true, add to blacklist; when false, remove.
+	Add bool `json:"add"`
+}
 
-// DenyChannelDiscoveryCandidateNoContent is response for DenyChannelDiscoveryCandidate operation.
-type DenyChannelDiscoveryCandidateNoContent struct{}
+// GetLogin returns the value of Login.
+func (s *ChannelBlacklistChange) GetLogin() string {
+	return s.Login
+}
 
-func (*DenyChannelDiscoveryCandidateNoContent) denyChannelDiscoveryCandidateRes() {}
+// GetAdd returns the value of Add.
+func (s *ChannelBlacklistChange) GetAdd() bool {
+	return s.Add
+}
 
-// Ref: #/components/schemas/DiscoveryCandidate
-type DiscoveryCandidate struct {
-	User         TwitchUser   `json:"user"`
-	DiscoveredAt time.Time    `json:"discovered_at"`
-	LastSeenAt   time.Time    `json:"last_seen_at"`
-	ViewerCount  OptNilInt64  `json:"viewer_count"`
-	Title        OptNilString `json:"title"`
-	GameName     OptNilString `json:"game_name"`
-	// Stream tag snapshot from the last matching Helix /streams row.
-	StreamTags []string `json:"stream_tags"`
+// SetLogin sets the value of Login.
+func (s *ChannelBlacklistChange) SetLogin(val string) {
+	s.Login = val
 }
 
-// GetUser returns the value of User.
-func (s *DiscoveryCandidate) GetUser() TwitchUser {
-	return s.User
+// SetAdd sets the value of Add.
+func (s *ChannelBlacklistChange) SetAdd(val bool) {
+	s.Add = val
 }
 
-// GetDiscoveredAt returns the value of DiscoveredAt.
-func (s *DiscoveryCandidate) GetDiscoveredAt() time.Time {
-	return s.DiscoveredAt
+// Ref: #/components/schemas/ChannelBotEstimate
+type ChannelBotEstimate struct {
+	ChannelTwitchUserID int64  `json:"channel_twitch_user_id"`
+	ChannelLogin        string `json:"channel_login"`
+	// Helix viewer count of the open stream.
+	ViewerCount int64 `json:"viewer_count"`
+	// Users present in chat (IRC snapshot).
+	ChatterCount int64 `json:"chatter_count"`
+	// Present users flagged as likely bots.
+	BotChatterCount int64 `json:"bot_chatter_count"`
+	// Estimated share (0-1) of the audience that is bots, counting likely bots and viewers not explained
+	// by human chatters.
+	BotShare float64 `json:"bot_share"`
+	// Viewer count exceeds what human chatters explain at the configured viewers_per_chatter.
+	ViewerInflated bool      `json:"viewer_inflated"`
+	ComputedAt     time.Time `json:"computed_at"`
 }
 
-// GetLastSeenAt returns the value of LastSeenAt.
-func (s *DiscoveryCandidate) GetLastSeenAt() time.Time {
-	return s.LastSeenAt
+// GetChannelTwitchUserID returns the value of ChannelTwitchUserID.
+func (s *ChannelBotEstimate) GetChannelTwitchUserID() int64 {
+	return s.ChannelTwitchUserID
+}
+
+// GetChannelLogin returns the value of ChannelLogin.
+func (s *ChannelBotEstimate) GetChannelLogin() string {
+	return s.ChannelLogin
 }
 
 // GetViewerCount returns the value of ViewerCount.
-func (s *DiscoveryCandidate) GetViewerCount() OptNilInt64 {
+func (s *ChannelBotEstimate) GetViewerCount() int64 {
 	return s.ViewerCount
 }
 
-// GetTitle returns the value of Title.
-func (s *DiscoveryCandidate) GetTitle() OptNilString {
-	return s.Title
+// GetChatterCount returns the value of ChatterCount.
+func (s *ChannelBotEstimate) GetChatterCount() int64 {
+	return s.ChatterCount
 }
 
-// GetGameName returns the value of GameName.
-func (s *DiscoveryCandidate) GetGameName() OptNilString {
-	return s.GameName
+// GetBotChatterCount returns the value of BotChatterCount.
+func (s *ChannelBotEstimate) GetBotChatterCount() int64 {
+	return s.BotChatterCount
 }
 
-// GetStreamTags returns the value of StreamTags.
-func (s *DiscoveryCandidate) GetStreamTags() []string {
-	return s.StreamTags
+// GetBotShare returns the value of BotShare.
+func (s *ChannelBotEstimate) GetBotShare() float64 {
+	return s.BotShare
 }
 
-// SetUser sets the value of User.
-func (s *DiscoveryCandidate) SetUser(val TwitchUser) {
-	s.User = val
+// GetViewerInflated returns the value of ViewerInflated.
+func (s *ChannelBotEstimate) GetViewerInflated() bool {
+	return s.ViewerInflated
 }
 
-// SetDiscoveredAt sets the value of DiscoveredAt.
-func (s *DiscoveryCandidate) SetDiscoveredAt(val time.Time) {
-	s.DiscoveredAt = val
+// GetComputedAt returns the value of ComputedAt.
+func (s *ChannelBotEstimate) GetComputedAt() time.Time {
+	return s.ComputedAt
 }
 
-// SetLastSeenAt sets the value of LastSeenAt.
-func (s *DiscoveryCandidate) SetLastSeenAt(val time.Time) {
-	s.LastSeenAt = val
+// SetChannelTwitchUserID sets the value of ChannelTwitchUserID.
+func (s *ChannelBotEstimate) SetChannelTwitchUserID(val int64) {
+	s.ChannelTwitchUserID = val
+}
+
+// SetChannelLogin sets the value of ChannelLogin.
+func (s *ChannelBotEstimate) SetChannelLogin(val string) {
+	s.ChannelLogin = val
 }
 
 // SetViewerCount sets the value of ViewerCount.
-func (s *DiscoveryCandidate) SetViewerCount(val OptNilInt64) {
+func (s *ChannelBotEstimate) SetViewerCount(val int64) {
 	s.ViewerCount = val
 }
 
-// SetTitle sets the value of Title.
-func (s *DiscoveryCandidate) SetTitle(val OptNilString) {
-	s.Title = val
+// SetChatterCount sets the value of ChatterCount.
+func (s *ChannelBotEstimate) SetChatterCount(val int64) {
+	s.ChatterCount = val
 }
 
-// SetGameName sets the value of GameName.
-func (s *DiscoveryCandidate) SetGameName(val OptNilString) {
-	s.GameName = val
+// SetBotChatterCount sets the value of BotChatterCount.
+func (s *ChannelBotEstimate) SetBotChatterCount(val int64) {
+	s.BotChatterCount = val
 }
 
-// SetStreamTags sets the value of StreamTags.
-func (s *DiscoveryCandidate) SetStreamTags(val []string) {
-	s.StreamTags = val
+// SetBotShare sets the value of BotShare.
+func (s *ChannelBotEstimate) SetBotShare(val float64) {
+	s.BotShare = val
 }
 
-// Ref: #/components/schemas/ErrorMessage
-type ErrorMessage struct {
-	Message string `json:"message"`
+// SetViewerInflated sets the value of ViewerInflated.
+func (s *ChannelBotEstimate) SetViewerInflated(val bool) {
+	s.ViewerInflated = val
 }
 
-// GetMessage returns the value of Message.
-func (s *ErrorMessage) GetMessage() string {
-	return s.Message
+// SetComputedAt sets the value of ComputedAt.
+func (s *ChannelBotEstimate) SetComputedAt(val time.Time) {
+	s.ComputedAt = val
 }
 
-// SetMessage sets the value of Message.
-func (s *ErrorMessage) SetMessage(val string) {
-	s.Message = val
+// Ref: #/components/schemas/ChannelChatterEntry
+type ChannelChatterEntry struct {
+	// Chatter login (lowercase).
+	Login        string `json:"login"`
+	UserTwitchID int64  `json:"user_twitch_id"`
+	// When this chatter was first seen in the channel for the current stretch (IRC JOIN or NAMES sync).
+	PresentSince time.Time `json:"present_since"`
+	// Twitch account creation from Helix when enrichment has populated it.
+	AccountCreatedAt OptNilDateTime `json:"account_created_at"`
+	// Populated when session_started_at was sent in the request.
+	MessageCount OptNilInt64 `json:"message_count"`
 }
 
-func (*ErrorMessage) confirmAiToolRes()                  {}
-func (*ErrorMessage) createAiMessageRes()                {}
-func (*ErrorMessage) createTwitchUserRes()               {}
-func (*ErrorMessage) deleteAiConversationRes()           {}
-func (*ErrorMessage) deleteNotificationRes()             {}
-func (*ErrorMessage) deleteRuleRes()                     {}
-func (*ErrorMessage) deleteTwitchAccountRes()            {}
-func (*ErrorMessage) denyChannelDiscoveryCandidateRes()  {}
-func (*ErrorMessage) getChannelLiveRes()                 {}
-func (*ErrorMessage) getRecordedStreamLeaderboardRes()   {}
-func (*ErrorMessage) getRecordedStreamRes()              {}
-func (*ErrorMessage) getTwitchUserActivityTimelineRes()  {}
-func (*ErrorMessage) getTwitchUserProfileRes()           {}
-func (*ErrorMessage) listAiMessagesRes()                 {}
-func (*ErrorMessage) listChannelChattersRes()            {}
-func (*ErrorMessage) listChatHistoryRes()                {}
-func (*ErrorMessage) listRecordedStreamActivityRes()     {}
-func (*ErrorMessage) listRecordedStreamMessagesRes()     {}
-func (*ErrorMessage) listTwitchUserActivityRes()         {}
-func (*ErrorMessage) setChannelBlacklistRes()            {}
-func (*ErrorMessage) stopAiAgentRes()                    {}
-func (*ErrorMessage) updateChannelDiscoverySettingsRes() {}
-func (*ErrorMessage) updateNotificationRes()             {}
-func (*ErrorMessage) updateRuleRes()                     {}
-func (*ErrorMessage) updateTwitchAccountRes()            {}
-
-// Ref: #/components/schemas/FollowedChannelEntry
-type FollowedChannelEntry struct {
-	ChannelID    int64          `json:"channel_id"`
-	ChannelLogin string         `json:"channel_login"`
-	FollowedAt   OptNilDateTime `json:"followed_at"`
-	// True when this followed channel login is on the global blacklist.
-	OnBlacklist bool `json:"on_blacklist"`
+// GetLogin returns the value of Login.
+func (s *ChannelChatterEntry) GetLogin() string {
+	return s.Login
 }
 
-// GetChannelID returns the value of ChannelID.
-func (s *FollowedChannelEntry) GetChannelID() int64 {
-	return s.ChannelID
+// GetUserTwitchID returns the value of UserTwitchID.
+func (s *ChannelChatterEntry) GetUserTwitchID() int64 {
+	return s.UserTwitchID
 }
 
-// GetChannelLogin returns the value of ChannelLogin.
-func (s *FollowedChannelEntry) GetChannelLogin() string {
-	return s.ChannelLogin
+// GetPresentSince returns the value of PresentSince.
+func (s *ChannelChatterEntry) GetPresentSince() time.Time {
+	return s.PresentSince
 }
 
-// GetFollowedAt returns the value of FollowedAt.
-func (s *FollowedChannelEntry) GetFollowedAt() OptNilDateTime {
-	return s.FollowedAt
+// GetAccountCreatedAt returns the value of AccountCreatedAt.
+func (s *ChannelChatterEntry) GetAccountCreatedAt() OptNilDateTime {
+	return s.AccountCreatedAt
 }
 
-// GetOnBlacklist returns the value of OnBlacklist.
-func (s *FollowedChannelEntry) GetOnBlacklist() bool {
-	return s.OnBlacklist
+// GetMessageCount returns the value of MessageCount.
+func (s *ChannelChatterEntry) GetMessageCount() OptNilInt64 {
+	return s.MessageCount
 }
 
-// SetChannelID sets the value of ChannelID.
-func (s *FollowedChannelEntry) SetChannelID(val int64) {
-	s.ChannelID = val
+// SetLogin sets the value of Login.
+func (s *ChannelChatterEntry) SetLogin(val string) {
+	s.Login = val
 }
 
-// SetChannelLogin sets the value of ChannelLogin.
-func (s *FollowedChannelEntry) SetChannelLogin(val string) {
-	s.ChannelLogin = val
+// SetUserTwitchID sets the value of UserTwitchID.
+func (s *ChannelChatterEntry) SetUserTwitchID(val int64) {
+	s.UserTwitchID = val
 }
 
-// SetFollowedAt sets the value of FollowedAt.
-func (s *FollowedChannelEntry) SetFollowedAt(val OptNilDateTime) {
-	s.FollowedAt = val
+// SetPresentSince sets the value of PresentSince.
+func (s *ChannelChatterEntry) SetPresentSince(val time.Time) {
+	s.PresentSince = val
 }
 
-// SetOnBlacklist sets the value of OnBlacklist.
-func (s *FollowedChannelEntry) SetOnBlacklist(val bool) {
-	s.OnBlacklist = val
+// SetAccountCreatedAt sets the value of AccountCreatedAt.
+func (s *ChannelChatterEntry) SetAccountCreatedAt(val OptNilDateTime) {
+	s.AccountCreatedAt = val
 }
 
-// Ref: #/components/schemas/FollowedMonitoredChannel
-type FollowedMonitoredChannel struct {
-	ChannelID    int64          `json:"channel_id"`
-	ChannelLogin string         `json:"channel_login"`
-	FollowedAt   OptNilDateTime `json:"followed_at"`
+// SetMessageCount sets the value of MessageCount.
+func (s *ChannelChatterEntry) SetMessageCount(val OptNilInt64) {
+	s.MessageCount = val
 }
 
-// GetChannelID returns the value of ChannelID.
-func (s *FollowedMonitoredChannel) GetChannelID() int64 {
-	return s.ChannelID
+// Ref: #/components/schemas/ChannelDiscoverySettings
+type ChannelDiscoverySettings struct {
+	// When true, the backend periodically scans Helix live streams for the configured game.
+	Enabled bool `json:"enabled"`
+	// Minimum seconds between discovery runs (default 3600).
+	PollIntervalSeconds int `json:"poll_interval_seconds"`
+	// Twitch Helix category id (game_id) passed to GET /helix/streams; required when enabled is true.
+	GameID string `json:"game_id"`
+	// Minimum concurrent viewers (Helix stream viewer_count) for a channel to be suggested.
+	MinLiveViewers int `json:"min_live_viewers"`
+	// When non-empty, a live stream must include every listed tag on its Helix `tags` array
+	// (case-insensitive match).
+	// Empty means no tag filter.
+	RequiredStreamTags []string `json:"required_stream_tags"`
+	// Max Helix /streams pages (100 streams each) per discovery run.
+	MaxStreamPagesPerRun int `json:"max_stream_pages_per_run"`
 }
 
-// GetChannelLogin returns the value of ChannelLogin.
-func (s *FollowedMonitoredChannel) GetChannelLogin() string {
-	return s.ChannelLogin
+// GetEnabled returns the value of Enabled.
+func (s *ChannelDiscoverySettings) GetEnabled() bool {
+	return s.Enabled
 }
 
-// GetFollowedAt returns the value of FollowedAt.
-func (s *FollowedMonitoredChannel) GetFollowedAt() OptNilDateTime {
-	return s.FollowedAt
+// GetPollIntervalSeconds returns the value of PollIntervalSeconds.
+func (s *ChannelDiscoverySettings) GetPollIntervalSeconds() int {
+	return s.PollIntervalSeconds
 }
 
-// SetChannelID sets the value of ChannelID.
-func (s *FollowedMonitoredChannel) SetChannelID(val int64) {
-	s.ChannelID = val
+// GetGameID returns the value of GameID.
+func (s *ChannelDiscoverySettings) GetGameID() string {
+	return s.GameID
 }
 
-// SetChannelLogin sets the value of ChannelLogin.
-func (s *FollowedMonitoredChannel) SetChannelLogin(val string) {
-	s.ChannelLogin = val
+// GetMinLiveViewers returns the value of MinLiveViewers.
+func (s *ChannelDiscoverySettings) GetMinLiveViewers() int {
+	return s.MinLiveViewers
 }
 
-// SetFollowedAt sets the value of FollowedAt.
-func (s *FollowedMonitoredChannel) SetFollowedAt(val OptNilDateTime) {
-	s.FollowedAt = val
+// GetRequiredStreamTags returns the value of RequiredStreamTags.
+func (s *ChannelDiscoverySettings) GetRequiredStreamTags() []string {
+	return s.RequiredStreamTags
 }
 
-// Ref: #/components/schemas/GetChannelLiveRequest
-type GetChannelLiveRequest struct {
-	Login string `json:"login"`
+// GetMaxStreamPagesPerRun returns the value of MaxStreamPagesPerRun.
+func (s *ChannelDiscoverySettings) GetMaxStreamPagesPerRun() int {
+	return s.MaxStreamPagesPerRun
 }
 
-// GetLogin returns the value of Login.
-func (s *GetChannelLiveRequest) GetLogin() string {
-	return s.Login
+// SetEnabled sets the value of Enabled.
+func (s *ChannelDiscoverySettings) SetEnabled(val bool) {
+	s.Enabled = val
 }
 
-// SetLogin sets the value of Login.
-func (s *GetChannelLiveRequest) SetLogin(val string) {
-	s.Login = val
+// SetPollIntervalSeconds sets the value of PollIntervalSeconds.
+func (s *ChannelDiscoverySettings) SetPollIntervalSeconds(val int) {
+	s.PollIntervalSeconds = val
 }
 
-type GetRecordedStreamLeaderboardOKApplicationJSON []StreamLeaderboardEntry
-
-func (*GetRecordedStreamLeaderboardOKApplicationJSON) getRecordedStreamLeaderboardRes() {}
+// SetGameID sets the value of GameID.
+func (s *ChannelDiscoverySettings) SetGameID(val string) {
+	s.GameID = val
+}
 
-// GetSystemStatsUnauthorized is response for GetSystemStats operation.
-type GetSystemStatsUnauthorized struct{}
+// SetMinLiveViewers sets the value of MinLiveViewers.
+func (s *ChannelDiscoverySettings) SetMinLiveViewers(val int) {
+	s.MinLiveViewers = val
+}
 
-func (*GetSystemStatsUnauthorized) getSystemStatsRes() {}
+// SetRequiredStreamTags sets the value of RequiredStreamTags.
+func (s *ChannelDiscoverySettings) SetRequiredStreamTags(val []string) {
+	s.RequiredStreamTags = val
+}
 
-type GetTwitchUserActivityTimelineOKApplicationJSON []ActivityTimelineSegment
+// SetMaxStreamPagesPerRun sets the value of MaxStreamPagesPerRun.
+func (s *ChannelDiscoverySettings) SetMaxStreamPagesPerRun(val int) {
+	s.MaxStreamPagesPerRun = val
+}
 
-func (*GetTwitchUserActivityTimelineOKApplicationJSON) getTwitchUserActivityTimelineRes() {}
+func (*ChannelDiscoverySettings) updateChannelDiscoverySettingsRes() {}
 
-// Ref: #/components/schemas/GetTwitchUserActivityTimelineRequest
-type GetTwitchUserActivityTimelineRequest struct {
-	ID int64 `json:"id"`
-	// Range start (RFC3339). Defaults to 7 days before `to`.
-	From OptDateTime `json:"from"`
-	// Range end (RFC3339). Defaults to now.
-	To OptDateTime `json:"to"`
+// Ref: #/components/schemas/ChannelLeaderboard
+type ChannelLeaderboard struct {
+	// Absent for the cross-channel leaderboard.
+	ChannelLogin OptString `json:"channel_login"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+	// Chatters matching the filters, across all pages.
+	Total   int64                     `json:"total"`
+	Entries []ChannelLeaderboardEntry `json:"entries"`
+	// How far daily rollups reach; later activity is not counted yet.
+	RolledUpUntil NilDateTime `json:"rolled_up_until"`
 }
 
-// GetID returns the value of ID.
-func (s *GetTwitchUserActivityTimelineRequest) GetID() int64 {
-	return s.ID
+// GetChannelLogin returns the value of ChannelLogin.
+func (s *ChannelLeaderboard) GetChannelLogin() OptString {
+	return s.ChannelLogin
 }
 
 // GetFrom returns the value of From.
-func (s *GetTwitchUserActivityTimelineRequest) GetFrom() OptDateTime {
+func (s *ChannelLeaderboard) GetFrom() time.Time {
 	return s.From
 }
 
 // GetTo returns the value of To.
-func (s *GetTwitchUserActivityTimelineRequest) GetTo() OptDateTime {
+func (s *ChannelLeaderboard) GetTo() time.Time {
 	return s.To
 }
 
-// SetID sets the value of ID.
-func (s *GetTwitchUserActivityTimelineRequest) SetID(val int64) {
-	s.ID = val
+// GetTotal returns the value of Total.
+func (s *ChannelLeaderboard) GetTotal() int64 {
+	return s.Total
 }
 
-// SetFrom sets the value of From.
-func (s *GetTwitchUserActivityTimelineRequest) SetFrom(val OptDateTime) {
-	s.From = val
+// GetEntries returns the value of Entries.
+func (s *ChannelLeaderboard) GetEntries() []ChannelLeaderboardEntry {
+	return s.Entries
 }
 
-// SetTo sets the value of To.
-func (s *GetTwitchUserActivityTimelineRequest) SetTo(val OptDateTime) {
-	s.To = val
+// GetRolledUpUntil returns the value of RolledUpUntil.
+func (s *ChannelLeaderboard) GetRolledUpUntil() NilDateTime {
+	return s.RolledUpUntil
 }
 
-// Ref: #/components/schemas/GetTwitchUserProfileRequest
-type GetTwitchUserProfileRequest struct {
-	ID int64 `json:"id"`
+// SetChannelLogin sets the value of ChannelLogin.
+func (s *ChannelLeaderboard) SetChannelLogin(val OptString) {
+	s.ChannelLogin = val
 }
 
-// GetID returns the value of ID.
-func (s *GetTwitchUserProfileRequest) GetID() int64 {
-	return s.ID
+// SetFrom sets the value of From.
+func (s *ChannelLeaderboard) SetFrom(val time.Time) {
+	s.From = val
 }
 
-// SetID sets the value of ID.
-func (s *GetTwitchUserProfileRequest) SetID(val int64) {
-	s.ID = val
+// SetTo sets the value of To.
+func (s *ChannelLeaderboard) SetTo(val time.Time) {
+	s.To = val
 }
 
-// Ref: #/components/schemas/IrcJoinedSample
-type IrcJoinedSample struct {
-	CapturedAt time.Time `json:"captured_at"`
-	// Number of monitored channels joined on IRC at sample time.
-	JoinedCount int `json:"joined_count"`
+// SetTotal sets the value of Total.
+func (s *ChannelLeaderboard) SetTotal(val int64) {
+	s.Total = val
 }
 
-// GetCapturedAt returns the value of CapturedAt.
-func (s *IrcJoinedSample) GetCapturedAt() time.Time {
-	return s.CapturedAt
+// SetEntries sets the value of Entries.
+func (s *ChannelLeaderboard) SetEntries(val []ChannelLeaderboardEntry) {
+	s.Entries = val
 }
 
-// GetJoinedCount returns the value of JoinedCount.
-func (s *IrcJoinedSample) GetJoinedCount() int {
-	return s.JoinedCount
+// SetRolledUpUntil sets the value of RolledUpUntil.
+func (s *ChannelLeaderboard) SetRolledUpUntil(val NilDateTime) {
+	s.RolledUpUntil = val
 }
 
-// SetCapturedAt sets the value of CapturedAt.
-func (s *IrcJoinedSample) SetCapturedAt(val time.Time) {
-	s.CapturedAt = val
+func (*ChannelLeaderboard) getChannelLeaderboardRes()   {}
+func (*ChannelLeaderboard) getMonitoredLeaderboardRes() {}
+
+// Ref: #/components/schemas/ChannelLeaderboardEntry
+type ChannelLeaderboardEntry struct {
+	Login           string `json:"login"`
+	UserTwitchID    int64  `json:"user_twitch_id"`
+	PresenceSeconds int64  `json:"presence_seconds"`
+	MessageCount    int64  `json:"message_count"`
+	// Monitored channels the chatter was active in during the range.
+	Channels         int            `json:"channels"`
+	AccountCreatedAt OptNilDateTime `json:"account_created_at"`
 }
 
-// SetJoinedCount sets the value of JoinedCount.
-func (s *IrcJoinedSample) SetJoinedCount(val int) {
-	s.JoinedCount = val
+// GetLogin returns the value of Login.
+func (s *ChannelLeaderboardEntry) GetLogin() string {
+	return s.Login
 }
 
-// Ref: #/components/schemas/IrcMonitorSettings
-type IrcMonitorSettings struct {
-	// Linked Twitch account id (Helix user id) for authenticated IRC; null uses anonymous read-only IRC
-	// (default).
-	OAuthTwitchAccountID NilInt64 `json:"oauth_twitch_account_id"`
-	// Per-user enrichment cooldown used by worker queue checks; default 24h.
-	EnrichmentCooldownHours int `json:"enrichment_cooldown_hours"`
+// GetUserTwitchID returns the value of UserTwitchID.
+func (s *ChannelLeaderboardEntry) GetUserTwitchID() int64 {
+	return s.UserTwitchID
 }
 
-// GetOAuthTwitchAccountID returns the value of OAuthTwitchAccountID.
-func (s *IrcMonitorSettings) GetOAuthTwitchAccountID() NilInt64 {
-	return s.OAuthTwitchAccountID
+// GetPresenceSeconds returns the value of PresenceSeconds.
+func (s *ChannelLeaderboardEntry) GetPresenceSeconds() int64 {
+	return s.PresenceSeconds
 }
 
-// GetEnrichmentCooldownHours returns the value of EnrichmentCooldownHours.
-func (s *IrcMonitorSettings) GetEnrichmentCooldownHours() int {
-	return s.EnrichmentCooldownHours
+// GetMessageCount returns the value of MessageCount.
+func (s *ChannelLeaderboardEntry) GetMessageCount() int64 {
+	return s.MessageCount
 }
 
-// SetOAuthTwitchAccountID sets the value of OAuthTwitchAccountID.
-func (s *IrcMonitorSettings) SetOAuthTwitchAccountID(val NilInt64) {
-	s.OAuthTwitchAccountID = val
+// GetChannels returns the value of Channels.
+func (s *ChannelLeaderboardEntry) GetChannels() int {
+	return s.Channels
 }
 
-// SetEnrichmentCooldownHours sets the value of EnrichmentCooldownHours.
-func (s *IrcMonitorSettings) SetEnrichmentCooldownHours(val int) {
-	s.EnrichmentCooldownHours = val
+// GetAccountCreatedAt returns the value of AccountCreatedAt.
+func (s *ChannelLeaderboardEntry) GetAccountCreatedAt() OptNilDateTime {
+	return s.AccountCreatedAt
 }
 
-// Ref: #/components/schemas/IrcMonitorStatus
-type IrcMonitorStatus struct {
-	// IRC monitor TCP session is connected.
-	Connected bool                           `json:"connected"`
-	Channels  []IrcMonitorStatusChannelsItem `json:"channels"`
+// SetLogin sets the value of Login.
+func (s *ChannelLeaderboardEntry) SetLogin(val string) {
+	s.Login = val
 }
 
-// GetConnected returns the value of Connected.
-func (s *IrcMonitorStatus) GetConnected() bool {
-	return s.Connected
+// SetUserTwitchID sets the value of UserTwitchID.
+func (s *ChannelLeaderboardEntry) SetUserTwitchID(val int64) {
+	s.UserTwitchID = val
 }
 
-// GetChannels returns the value of Channels.
-func (s *IrcMonitorStatus) GetChannels() []IrcMonitorStatusChannelsItem {
-	return s.Channels
+// SetPresenceSeconds sets the value of PresenceSeconds.
+func (s *ChannelLeaderboardEntry) SetPresenceSeconds(val int64) {
+	s.PresenceSeconds = val
 }
 
-// SetConnected sets the value of Connected.
-func (s *IrcMonitorStatus) SetConnected(val bool) {
-	s.Connected = val
+// SetMessageCount sets the value of MessageCount.
+func (s *ChannelLeaderboardEntry) SetMessageCount(val int64) {
+	s.MessageCount = val
 }
 
 // SetChannels sets the value of Channels.
-func (s *IrcMonitorStatus) SetChannels(val []IrcMonitorStatusChannelsItem) {
+func (s *ChannelLeaderboardEntry) SetChannels(val int) {
 	s.Channels = val
 }
 
-type IrcMonitorStatusChannelsItem struct {
-	Login string `json:"login"`
-	// True when the IRC client's NAMES-backed userlist for this channel is non-empty (session state).
-	IrcOk bool `json:"irc_ok"`
+// SetAccountCreatedAt sets the value of AccountCreatedAt.
+func (s *ChannelLeaderboardEntry) SetAccountCreatedAt(val OptNilDateTime) {
+	s.AccountCreatedAt = val
 }
 
-// GetLogin returns the value of Login.
-func (s *IrcMonitorStatusChannelsItem) GetLogin() string {
-	return s.Login
+// Ref: #/components/schemas/ChannelLive
+type ChannelLive struct {
+	BroadcasterID    int64        `json:"broadcaster_id"`
+	BroadcasterLogin string       `json:"broadcaster_login"`
+	DisplayName      string       `json:"display_name"`
+	ProfileImageURL  string       `json:"profile_image_url"`
+	IsLive           bool         `json:"is_live"`
+	Title            OptNilString `json:"title"`
+	GameName         OptNilString `json:"game_name"`
+	ViewerCount      OptNilInt64  `json:"viewer_count"`
+	// Count of users in the IRC-maintained chat snapshot (channel_chatters). Compare to viewer_count for
+	// the delta shown in the watch UI.
+	ChannelChatterCount OptNilInt64    `json:"channel_chatter_count"`
+	StartedAt           OptNilDateTime `json:"started_at"`
 }
 
-// GetIrcOk returns the value of IrcOk.
-func (s *IrcMonitorStatusChannelsItem) GetIrcOk() bool {
-	return s.IrcOk
+// GetBroadcasterID returns the value of BroadcasterID.
+func (s *ChannelLive) GetBroadcasterID() int64 {
+	return s.BroadcasterID
 }
 
-// SetLogin sets the value of Login.
-func (s *IrcMonitorStatusChannelsItem) SetLogin(val string) {
-	s.Login = val
+// GetBroadcasterLogin returns the value of BroadcasterLogin.
+func (s *ChannelLive) GetBroadcasterLogin() string {
+	return s.BroadcasterLogin
 }
 
-// SetIrcOk sets the value of IrcOk.
-func (s *IrcMonitorStatusChannelsItem) SetIrcOk(val bool) {
-	s.IrcOk = val
+// GetDisplayName returns the value of DisplayName.
+func (s *ChannelLive) GetDisplayName() string {
+	return s.DisplayName
 }
 
-type ListAiMessagesOKApplicationJSON []AiMessage
-
-func (*ListAiMessagesOKApplicationJSON) listAiMessagesRes() {}
+// GetProfileImageURL returns the value of ProfileImageURL.
+func (s *ChannelLive) GetProfileImageURL() string {
+	return s.ProfileImageURL
+}
 
-type ListChannelChattersOKApplicationJSON []ChannelChatterEntry
+// GetIsLive returns the value of IsLive.
+func (s *ChannelLive) GetIsLive() bool {
+	return s.IsLive
+}
 
-func (*ListChannelChattersOKApplicationJSON) listChannelChattersRes() {}
+// GetTitle returns the value of Title.
+func (s *ChannelLive) GetTitle() OptNilString {
+	return s.Title
+}
 
-// Ref: #/components/schemas/ListChannelChattersRequest
-type ListChannelChattersRequest struct {
-	// Twitch OAuth account id (must exist; used for consistency with other channel APIs).
-	AccountID int64 `json:"account_id"`
-	// Channel login.
-	Login string `json:"login"`
-	// When set, message_count is the number of persisted chat lines per chatter since this time (e.g.
-	// current stream started_at).
-	SessionStartedAt OptNilDateTime `json:"session_started_at"`
+// GetGameName returns the value of GameName.
+func (s *ChannelLive) GetGameName() OptNilString {
+	return s.GameName
 }
 
-// GetAccountID returns the value of AccountID.
-func (s *ListChannelChattersRequest) GetAccountID() int64 {
-	return s.AccountID
+// GetViewerCount returns the value of ViewerCount.
+func (s *ChannelLive) GetViewerCount() OptNilInt64 {
+	return s.ViewerCount
 }
 
-// GetLogin returns the value of Login.
-func (s *ListChannelChattersRequest) GetLogin() string {
-	return s.Login
+// GetChannelChatterCount returns the value of ChannelChatterCount.
+func (s *ChannelLive) GetChannelChatterCount() OptNilInt64 {
+	return s.ChannelChatterCount
 }
 
-// GetSessionStartedAt returns the value of SessionStartedAt.
-func (s *ListChannelChattersRequest) GetSessionStartedAt() OptNilDateTime {
-	return s.SessionStartedAt
+// GetStartedAt returns the value of StartedAt.
+func (s *ChannelLive) GetStartedAt() OptNilDateTime {
+	return s.StartedAt
 }
 
-// SetAccountID sets the value of AccountID.
-func (s *ListChannelChattersRequest) SetAccountID(val int64) {
-	s.AccountID = val
+// SetBroadcasterID sets the value of BroadcasterID.
+func (s *ChannelLive) SetBroadcasterID(val int64) {
+	s.BroadcasterID = val
 }
 
-// SetLogin sets the value of Login.
-func (s *ListChannelChattersRequest) SetLogin(val string) {
-	s.Login = val
+// SetBroadcasterLogin sets the value of BroadcasterLogin.
+func (s *ChannelLive) SetBroadcasterLogin(val string) {
+	s.BroadcasterLogin = val
 }
 
-// SetSessionStartedAt sets the value of SessionStartedAt.
-func (s *ListChannelChattersRequest) SetSessionStartedAt(val OptNilDateTime) {
-	s.SessionStartedAt = val
+// SetDisplayName sets the value of DisplayName.
+func (s *ChannelLive) SetDisplayName(val string) {
+	s.DisplayName = val
 }
 
-type ListChatHistoryOKApplicationJSON []ChatHistoryEntry
+// SetProfileImageURL sets the value of ProfileImageURL.
+func (s *ChannelLive) SetProfileImageURL(val string) {
+	s.ProfileImageURL = val
+}
 
-func (*ListChatHistoryOKApplicationJSON) listChatHistoryRes() {}
+// SetIsLive sets the value of IsLive.
+func (s *ChannelLive) SetIsLive(val bool) {
+	s.IsLive = val
+}
 
-type ListRecordedStreamActivityOKApplicationJSON []UserActivityEvent
+// SetTitle sets the value of Title.
+func (s *ChannelLive) SetTitle(val OptNilString) {
+	s.Title = val
+}
 
-func (*ListRecordedStreamActivityOKApplicationJSON) listRecordedStreamActivityRes() {}
+// SetGameName sets the value of GameName.
+func (s *ChannelLive) SetGameName(val OptNilString) {
+	s.GameName = val
+}
 
-type ListRecordedStreamMessagesOKApplicationJSON []ChatHistoryEntry
+// SetViewerCount sets the value of ViewerCount.
+func (s *ChannelLive) SetViewerCount(val OptNilInt64) {
+	s.ViewerCount = val
+}
 
-func (*ListRecordedStreamMessagesOKApplicationJSON) listRecordedStreamMessagesRes() {}
+// SetChannelChatterCount sets the value of ChannelChatterCount.
+func (s *ChannelLive) SetChannelChatterCount(val OptNilInt64) {
+	s.ChannelChatterCount = val
+}
 
-type ListTwitchUserActivityOKApplicationJSON []UserActivityEvent
+// SetStartedAt sets the value of StartedAt.
+func (s *ChannelLive) SetStartedAt(val OptNilDateTime) {
+	s.StartedAt = val
+}
 
-func (*ListTwitchUserActivityOKApplicationJSON) listTwitchUserActivityRes() {}
+func (*ChannelLive) getChannelLiveRes() {}
 
-// Ref: #/components/schemas/ListTwitchUserActivityRequest
-type ListTwitchUserActivityRequest struct {
-	ID              int64       `json:"id"`
-	Limit           OptInt      `json:"limit"`
-	CursorCreatedAt OptDateTime `json:"cursor_created_at"`
-	CursorID        OptInt64    `json:"cursor_id"`
+// Ref: #/components/schemas/ChatClassifierSettings
+type ChatClassifierSettings struct {
+	// When true and AI settings are configured, sampled chat is classified in the background (default
+	// false).
+	Enabled bool `json:"enabled"`
+	// Monitored channel logins whose chat is classified.
+	Channels []string `json:"channels"`
+	// Share of messages sent to the model (default 0.2).
+	SampleRate float64 `json:"sample_rate"`
+	// Messages per completion request (default 20).
+	BatchSize int `json:"batch_size"`
+	// Tokens the classifier may spend per UTC day before pausing until the next day (default 200000).
+	DailyTokenBudget int64 `json:"daily_token_budget"`
+	// Tokens spent on the current UTC day (ignored on update).
+	TokensUsedToday OptInt64 `json:"tokens_used_today"`
 }
 
-// GetID returns the value of ID.
-func (s *ListTwitchUserActivityRequest) GetID() int64 {
-	return s.ID
+// GetEnabled returns the value of Enabled.
+func (s *ChatClassifierSettings) GetEnabled() bool {
+	return s.Enabled
 }
 
-// GetLimit returns the value of Limit.
-func (s *ListTwitchUserActivityRequest) GetLimit() OptInt {
-	return s.Limit
+// GetChannels returns the value of Channels.
+func (s *ChatClassifierSettings) GetChannels() []string {
+	return s.Channels
 }
 
-// GetCursorCreatedAt returns the value of CursorCreatedAt.
-func (s *ListTwitchUserActivityRequest) GetCursorCreatedAt() OptDateTime {
-	return s.CursorCreatedAt
+// GetSampleRate returns the value of SampleRate.
+func (s *ChatClassifierSettings) GetSampleRate() float64 {
+	return s.SampleRate
 }
 
-// GetCursorID returns the value of CursorID.
-func (s *ListTwitchUserActivityRequest) GetCursorID() OptInt64 {
-	return s.CursorID
+// GetBatchSize returns the value of BatchSize.
+func (s *ChatClassifierSettings) GetBatchSize() int {
+	return s.BatchSize
 }
 
-// SetID sets the value of ID.
-func (s *ListTwitchUserActivityRequest) SetID(val int64) {
-	s.ID = val
+// GetDailyTokenBudget returns the value of DailyTokenBudget.
+func (s *ChatClassifierSettings) GetDailyTokenBudget() int64 {
+	return s.DailyTokenBudget
 }
 
-// SetLimit sets the value of Limit.
-func (s *ListTwitchUserActivityRequest) SetLimit(val OptInt) {
-	s.Limit = val
+// GetTokensUsedToday returns the value of TokensUsedToday.
+func (s *ChatClassifierSettings) GetTokensUsedToday() OptInt64 {
+	return s.TokensUsedToday
 }
 
-// SetCursorCreatedAt sets the value of CursorCreatedAt.
-func (s *ListTwitchUserActivityRequest) SetCursorCreatedAt(val OptDateTime) {
-	s.CursorCreatedAt = val
+// SetEnabled sets the value of Enabled.
+func (s *ChatClassifierSettings) SetEnabled(val bool) {
+	s.Enabled = val
 }
 
-// SetCursorID sets the value of CursorID.
-func (s *ListTwitchUserActivityRequest) SetCursorID(val OptInt64) {
-	s.CursorID = val
+// SetChannels sets the value of Channels.
+func (s *ChatClassifierSettings) SetChannels(val []string) {
+	s.Channels = val
 }
 
-// Ref: #/components/schemas/LoginRequest
-type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// SetSampleRate sets the value of SampleRate.
+func (s *ChatClassifierSettings) SetSampleRate(val float64) {
+	s.SampleRate = val
 }
 
-// GetEmail returns the value of Email.
-func (s *LoginRequest) GetEmail() string {
-	return s.Email
+// SetBatchSize sets the value of BatchSize.
+func (s *ChatClassifierSettings) SetBatchSize(val int) {
+	s.BatchSize = val
 }
 
-// GetPassword returns the value of Password.
-func (s *LoginRequest) GetPassword() string {
-	return s.Password
+// SetDailyTokenBudget sets the value of DailyTokenBudget.
+func (s *ChatClassifierSettings) SetDailyTokenBudget(val int64) {
+	s.DailyTokenBudget = val
 }
 
-// SetEmail sets the value of Email.
-func (s *LoginRequest) SetEmail(val string) {
-	s.Email = val
+// SetTokensUsedToday sets the value of TokensUsedToday.
+func (s *ChatClassifierSettings) SetTokensUsedToday(val OptInt64) {
+	s.TokensUsedToday = val
 }
 
-// SetPassword sets the value of Password.
-func (s *LoginRequest) SetPassword(val string) {
-	s.Password = val
-}
+func (*ChatClassifierSettings) updateChatClassifierSettingsRes() {}
 
-// Ref: #/components/schemas/LoginResponse
-type LoginResponse struct {
-	Token string `json:"token"`
+// Ref: #/components/schemas/ChatHistoryEntry
+type ChatHistoryEntry struct {
+	ID int64 `json:"id"`
+	// Channel login where the message appeared.
+	Channel string `json:"channel"`
+	User    string `json:"user"`
+	// Twitch user id of the chatter when known.
+	ChatterUserID OptNilInt64 `json:"chatter_user_id"`
+	// Whether the chatter is marked in dredge.
+	ChatterMarked bool `json:"chatter_marked"`
+	// Whether the chatter is flagged as suspicious.
+	ChatterIsSus bool `json:"chatter_is_sus"`
+	// Whether this was the chatter's first message in this channel (Twitch IRC).
+	FirstMessage bool   `json:"first_message"`
+	Message      string `json:"message"`
+	KeywordMatch bool   `json:"keyword_match"`
+	// Irc: observed in chat; sent: posted via dredge.
+	Source    ChatHistoryEntrySource `json:"source"`
+	CreatedAt time.Time              `json:"created_at"`
+	// Twitch chat roles / badges for display (e.g. mod, VIP, verified bot, other badges).
+	BadgeTags []ChatHistoryEntryBadgeTagsItem `json:"badge_tags"`
 }
 
-// GetToken returns the value of Token.
-func (s *LoginResponse) GetToken() string {
-	return s.Token
+// GetID returns the value of ID.
+func (s *ChatHistoryEntry) GetID() int64 {
+	return s.ID
 }
 
-// SetToken sets the value of Token.
-func (s *LoginResponse) SetToken(val string) {
-	s.Token = val
+// GetChannel returns the value of Channel.
+func (s *ChatHistoryEntry) GetChannel() string {
+	return s.Channel
 }
 
-func (*LoginResponse) loginRes() {}
+// GetUser returns the value of User.
+func (s *ChatHistoryEntry) GetUser() string {
+	return s.User
+}
 
-// LoginUnauthorized is response for Login operation.
-type LoginUnauthorized struct{}
+// GetChatterUserID returns the value of ChatterUserID.
+func (s *ChatHistoryEntry) GetChatterUserID() OptNilInt64 {
+	return s.ChatterUserID
+}
 
-func (*LoginUnauthorized) loginRes() {}
+// GetChatterMarked returns the value of ChatterMarked.
+func (s *ChatHistoryEntry) GetChatterMarked() bool {
+	return s.ChatterMarked
+}
 
-// MeUnauthorized is response for Me operation.
-type MeUnauthorized struct{}
+// GetChatterIsSus returns the value of ChatterIsSus.
+func (s *ChatHistoryEntry) GetChatterIsSus() bool {
+	return s.ChatterIsSus
+}
 
-func (*MeUnauthorized) meRes() {}
+// GetFirstMessage returns the value of FirstMessage.
+func (s *ChatHistoryEntry) GetFirstMessage() bool {
+	return s.FirstMessage
+}
 
-// NewNilFloat64 returns new NilFloat64 with value set to v.
-func NewNilFloat64(v float64) NilFloat64 {
-	return NilFloat64{
-		Value: v,
-	}
-}
-
-// NilFloat64 is nullable float64.
-type NilFloat64 struct {
-	Value float64
-	Null  bool
+// GetMessage returns the value of Message.
+func (s *ChatHistoryEntry) GetMessage() string {
+	return s.Message
 }
 
-// SetTo sets value to v.
-func (o *NilFloat64) SetTo(v float64) {
-	o.Null = false
-	o.Value = v
+// GetKeywordMatch returns the value of KeywordMatch.
+func (s *ChatHistoryEntry) GetKeywordMatch() bool {
+	return s.KeywordMatch
 }
 
-// IsNull returns true if value is Null.
-func (o NilFloat64) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *NilFloat64) SetToNull() {
-	o.Null = true
-	var v float64
-	o.Value = v
+// GetSource returns the value of Source.
+func (s *ChatHistoryEntry) GetSource() ChatHistoryEntrySource {
+	return s.Source
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilFloat64) Get() (v float64, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ChatHistoryEntry) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilFloat64) Or(d float64) float64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetBadgeTags returns the value of BadgeTags.
+func (s *ChatHistoryEntry) GetBadgeTags() []ChatHistoryEntryBadgeTagsItem {
+	return s.BadgeTags
 }
 
-// NewNilInt64 returns new NilInt64 with value set to v.
-func NewNilInt64(v int64) NilInt64 {
-	return NilInt64{
-		Value: v,
-	}
+// SetID sets the value of ID.
+func (s *ChatHistoryEntry) SetID(val int64) {
+	s.ID = val
 }
 
-// NilInt64 is nullable int64.
-type NilInt64 struct {
-	Value int64
-	Null  bool
+// SetChannel sets the value of Channel.
+func (s *ChatHistoryEntry) SetChannel(val string) {
+	s.Channel = val
 }
 
-// SetTo sets value to v.
-func (o *NilInt64) SetTo(v int64) {
-	o.Null = false
-	o.Value = v
+// SetUser sets the value of User.
+func (s *ChatHistoryEntry) SetUser(val string) {
+	s.User = val
 }
 
-// IsNull returns true if value is Null.
-func (o NilInt64) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *NilInt64) SetToNull() {
-	o.Null = true
-	var v int64
-	o.Value = v
+// SetChatterUserID sets the value of ChatterUserID.
+func (s *ChatHistoryEntry) SetChatterUserID(val OptNilInt64) {
+	s.ChatterUserID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilInt64) Get() (v int64, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetChatterMarked sets the value of ChatterMarked.
+func (s *ChatHistoryEntry) SetChatterMarked(val bool) {
+	s.ChatterMarked = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetChatterIsSus sets the value of ChatterIsSus.
+func (s *ChatHistoryEntry) SetChatterIsSus(val bool) {
+	s.ChatterIsSus = val
 }
 
-// Ref: #/components/schemas/NotificationEntry
-type NotificationEntry struct {
-	ID        int64                     `json:"id"`
-	Provider  NotificationEntryProvider `json:"provider"`
-	Settings  NotificationEntrySettings `json:"settings"`
-	Enabled   bool                      `json:"enabled"`
-	CreatedAt time.Time                 `json:"created_at"`
+// SetFirstMessage sets the value of FirstMessage.
+func (s *ChatHistoryEntry) SetFirstMessage(val bool) {
+	s.FirstMessage = val
 }
 
-// GetID returns the value of ID.
-func (s *NotificationEntry) GetID() int64 {
-	return s.ID
+// SetMessage sets the value of Message.
+func (s *ChatHistoryEntry) SetMessage(val string) {
+	s.Message = val
 }
 
-// GetProvider returns the value of Provider.
-func (s *NotificationEntry) GetProvider() NotificationEntryProvider {
-	return s.Provider
+// SetKeywordMatch sets the value of KeywordMatch.
+func (s *ChatHistoryEntry) SetKeywordMatch(val bool) {
+	s.KeywordMatch = val
 }
 
-// GetSettings returns the value of Settings.
-func (s *NotificationEntry) GetSettings() NotificationEntrySettings {
-	return s.Settings
+// SetSource sets the value of Source.
+func (s *ChatHistoryEntry) SetSource(val ChatHistoryEntrySource) {
+	s.Source = val
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *NotificationEntry) GetEnabled() bool {
-	return s.Enabled
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ChatHistoryEntry) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *NotificationEntry) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetBadgeTags sets the value of BadgeTags.
+func (s *ChatHistoryEntry) SetBadgeTags(val []ChatHistoryEntryBadgeTagsItem) {
+	s.BadgeTags = val
 }
 
-// SetID sets the value of ID.
-func (s *NotificationEntry) SetID(val int64) {
-	s.ID = val
-}
+type ChatHistoryEntryBadgeTagsItem string
 
-// SetProvider sets the value of Provider.
-func (s *NotificationEntry) SetProvider(val NotificationEntryProvider) {
-	s.Provider = val
-}
+const (
+	ChatHistoryEntryBadgeTagsItemModerator ChatHistoryEntryBadgeTagsItem = "moderator"
+	ChatHistoryEntryBadgeTagsItemVip       ChatHistoryEntryBadgeTagsItem = "vip"
+	ChatHistoryEntryBadgeTagsItemBot       ChatHistoryEntryBadgeTagsItem = "bot"
+	ChatHistoryEntryBadgeTagsItemOther     ChatHistoryEntryBadgeTagsItem = "other"
+)
 
-// SetSettings sets the value of Settings.
-func (s *NotificationEntry) SetSettings(val NotificationEntrySettings) {
-	s.Settings = val
+// AllValues returns all ChatHistoryEntryBadgeTagsItem values.
+func (ChatHistoryEntryBadgeTagsItem) AllValues() []ChatHistoryEntryBadgeTagsItem {
+	return []ChatHistoryEntryBadgeTagsItem{
+		ChatHistoryEntryBadgeTagsItemModerator,
+		ChatHistoryEntryBadgeTagsItemVip,
+		ChatHistoryEntryBadgeTagsItemBot,
+		ChatHistoryEntryBadgeTagsItemOther,
+	}
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *NotificationEntry) SetEnabled(val bool) {
-	s.Enabled = val
+// MarshalText implements encoding.TextMarshaler.
+func (s ChatHistoryEntryBadgeTagsItem) MarshalText() ([]byte, error) {
+	switch s {
+	case ChatHistoryEntryBadgeTagsItemModerator:
+		return []byte(s), nil
+	case ChatHistoryEntryBadgeTagsItemVip:
+		return []byte(s), nil
+	case ChatHistoryEntryBadgeTagsItemBot:
+		return []byte(s), nil
+	case ChatHistoryEntryBadgeTagsItemOther:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *NotificationEntry) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ChatHistoryEntryBadgeTagsItem) UnmarshalText(data []byte) error {
+	switch ChatHistoryEntryBadgeTagsItem(data) {
+	case ChatHistoryEntryBadgeTagsItemModerator:
+		*s = ChatHistoryEntryBadgeTagsItemModerator
+		return nil
+	case ChatHistoryEntryBadgeTagsItemVip:
+		*s = ChatHistoryEntryBadgeTagsItemVip
+		return nil
+	case ChatHistoryEntryBadgeTagsItemBot:
+		*s = ChatHistoryEntryBadgeTagsItemBot
+		return nil
+	case ChatHistoryEntryBadgeTagsItemOther:
+		*s = ChatHistoryEntryBadgeTagsItemOther
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-func (*NotificationEntry) updateNotificationRes() {}
-
-type NotificationEntryProvider string
+// Irc: observed in chat; sent: posted via dredge.
+type ChatHistoryEntrySource string
 
 const (
-	NotificationEntryProviderTelegram NotificationEntryProvider = "telegram"
-	NotificationEntryProviderWebhook  NotificationEntryProvider = "webhook"
+	ChatHistoryEntrySourceIrc  ChatHistoryEntrySource = "irc"
+	ChatHistoryEntrySourceSent ChatHistoryEntrySource = "sent"
 )
 
-// AllValues returns all NotificationEntryProvider values.
-func (NotificationEntryProvider) AllValues() []NotificationEntryProvider {
-	return []NotificationEntryProvider{
-		NotificationEntryProviderTelegram,
-		NotificationEntryProviderWebhook,
+// AllValues returns all ChatHistoryEntrySource values.
+func (ChatHistoryEntrySource) AllValues() []ChatHistoryEntrySource {
+	return []ChatHistoryEntrySource{
+		ChatHistoryEntrySourceIrc,
+		ChatHistoryEntrySourceSent,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s NotificationEntryProvider) MarshalText() ([]byte, error) {
+func (s ChatHistoryEntrySource) MarshalText() ([]byte, error) {
 	switch s {
-	case NotificationEntryProviderTelegram:
+	case ChatHistoryEntrySourceIrc:
 		return []byte(s), nil
-	case NotificationEntryProviderWebhook:
+	case ChatHistoryEntrySourceSent:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -2180,1665 +2356,6787 @@ func (s NotificationEntryProvider) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *NotificationEntryProvider) UnmarshalText(data []byte) error {
-	switch NotificationEntryProvider(data) {
-	case NotificationEntryProviderTelegram:
-		*s = NotificationEntryProviderTelegram
+func (s *ChatHistoryEntrySource) UnmarshalText(data []byte) error {
+	switch ChatHistoryEntrySource(data) {
+	case ChatHistoryEntrySourceIrc:
+		*s = ChatHistoryEntrySourceIrc
 		return nil
-	case NotificationEntryProviderWebhook:
-		*s = NotificationEntryProviderWebhook
+	case ChatHistoryEntrySourceSent:
+		*s = ChatHistoryEntrySourceSent
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type NotificationEntrySettings map[string]jx.Raw
+// A burst of chat detected live during a stream.
+// Ref: #/components/schemas/ChatMoment
+type ChatMoment struct {
+	ID int64 `json:"id"`
+	// Emote flood or phrase cluster when one emote or phrase dominates the moment, otherwise a velocity
+	// spike.
+	Kind ChatMomentKind `json:"kind"`
+	// Emote code or phrase of floods and clusters, empty for velocity spikes.
+	Label     string    `json:"label"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	// Start of the moment relative to the stream start (VOD timestamp).
+	OffsetSeconds int64 `json:"offset_seconds"`
+	Messages      int64 `json:"messages"`
+	Chatters      int64 `json:"chatters"`
+	// Peak 30-second message rate over the channel's rolling 5-minute baseline (0 while it was warming
+	// up).
+	Intensity float64 `json:"intensity"`
+	// Representative messages, one per distinct phrase, most repeated first.
+	SampleMessages []ChatMomentMessage `json:"sample_messages"`
+}
 
-func (s *NotificationEntrySettings) init() NotificationEntrySettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetID returns the value of ID.
+func (s *ChatMoment) GetID() int64 {
+	return s.ID
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// GetKind returns the value of Kind.
+func (s *ChatMoment) GetKind() ChatMomentKind {
+	return s.Kind
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetLabel returns the value of Label.
+func (s *ChatMoment) GetLabel() string {
+	return s.Label
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// GetStartedAt returns the value of StartedAt.
+func (s *ChatMoment) GetStartedAt() time.Time {
+	return s.StartedAt
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetEndedAt returns the value of EndedAt.
+func (s *ChatMoment) GetEndedAt() time.Time {
+	return s.EndedAt
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetOffsetSeconds returns the value of OffsetSeconds.
+func (s *ChatMoment) GetOffsetSeconds() int64 {
+	return s.OffsetSeconds
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMessages returns the value of Messages.
+func (s *ChatMoment) GetMessages() int64 {
+	return s.Messages
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetChatters returns the value of Chatters.
+func (s *ChatMoment) GetChatters() int64 {
+	return s.Chatters
 }
 
-// NewOptClientNoticeDetails returns new OptClientNoticeDetails with value set to v.
-func NewOptClientNoticeDetails(v ClientNoticeDetails) OptClientNoticeDetails {
-	return OptClientNoticeDetails{
-		Value: v,
-		Set:   true,
-	}
+// GetIntensity returns the value of Intensity.
+func (s *ChatMoment) GetIntensity() float64 {
+	return s.Intensity
 }
 
-// OptClientNoticeDetails is optional ClientNoticeDetails.
-type OptClientNoticeDetails struct {
-	Value ClientNoticeDetails
-	Set   bool
+// GetSampleMessages returns the value of SampleMessages.
+func (s *ChatMoment) GetSampleMessages() []ChatMomentMessage {
+	return s.SampleMessages
 }
 
-// IsSet returns true if OptClientNoticeDetails was set.
-func (o OptClientNoticeDetails) IsSet() bool { return o.Set }
+// SetID sets the value of ID.
+func (s *ChatMoment) SetID(val int64) {
+	s.ID = val
+}
 
-// Reset unsets value.
-func (o *OptClientNoticeDetails) Reset() {
-	var v ClientNoticeDetails
-	o.Value = v
-	o.Set = false
+// SetKind sets the value of Kind.
+func (s *ChatMoment) SetKind(val ChatMomentKind) {
+	s.Kind = val
 }
 
-// SetTo sets value to v.
-func (o *OptClientNoticeDetails) SetTo(v ClientNoticeDetails) {
-	o.Set = true
-	o.Value = v
+// SetLabel sets the value of Label.
+func (s *ChatMoment) SetLabel(val string) {
+	s.Label = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptClientNoticeDetails) Get() (v ClientNoticeDetails, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetStartedAt sets the value of StartedAt.
+func (s *ChatMoment) SetStartedAt(val time.Time) {
+	s.StartedAt = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptClientNoticeDetails) Or(d ClientNoticeDetails) ClientNoticeDetails {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetEndedAt sets the value of EndedAt.
+func (s *ChatMoment) SetEndedAt(val time.Time) {
+	s.EndedAt = val
 }
 
-// NewOptCreateAiConversationRequest returns new OptCreateAiConversationRequest with value set to v.
-func NewOptCreateAiConversationRequest(v CreateAiConversationRequest) OptCreateAiConversationRequest {
-	return OptCreateAiConversationRequest{
-		Value: v,
-		Set:   true,
-	}
+// SetOffsetSeconds sets the value of OffsetSeconds.
+func (s *ChatMoment) SetOffsetSeconds(val int64) {
+	s.OffsetSeconds = val
 }
 
-// OptCreateAiConversationRequest is optional CreateAiConversationRequest.
-type OptCreateAiConversationRequest struct {
-	Value CreateAiConversationRequest
-	Set   bool
+// SetMessages sets the value of Messages.
+func (s *ChatMoment) SetMessages(val int64) {
+	s.Messages = val
 }
 
-// IsSet returns true if OptCreateAiConversationRequest was set.
-func (o OptCreateAiConversationRequest) IsSet() bool { return o.Set }
+// SetChatters sets the value of Chatters.
+func (s *ChatMoment) SetChatters(val int64) {
+	s.Chatters = val
+}
 
-// Reset unsets value.
-func (o *OptCreateAiConversationRequest) Reset() {
-	var v CreateAiConversationRequest
-	o.Value = v
-	o.Set = false
+// SetIntensity sets the value of Intensity.
+func (s *ChatMoment) SetIntensity(val float64) {
+	s.Intensity = val
 }
 
-// SetTo sets value to v.
-func (o *OptCreateAiConversationRequest) SetTo(v CreateAiConversationRequest) {
-	o.Set = true
-	o.Value = v
+// SetSampleMessages sets the value of SampleMessages.
+func (s *ChatMoment) SetSampleMessages(val []ChatMomentMessage) {
+	s.SampleMessages = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCreateAiConversationRequest) Get() (v CreateAiConversationRequest, ok bool) {
-	if !o.Set {
-		return v, false
+// Emote flood or phrase cluster when one emote or phrase dominates the moment, otherwise a velocity
+// spike.
+type ChatMomentKind string
+
+const (
+	ChatMomentKindVelocity   ChatMomentKind = "velocity"
+	ChatMomentKindEmoteFlood ChatMomentKind = "emote_flood"
+	ChatMomentKindPhrase     ChatMomentKind = "phrase"
+)
+
+// AllValues returns all ChatMomentKind values.
+func (ChatMomentKind) AllValues() []ChatMomentKind {
+	return []ChatMomentKind{
+		ChatMomentKindVelocity,
+		ChatMomentKindEmoteFlood,
+		ChatMomentKindPhrase,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCreateAiConversationRequest) Or(d CreateAiConversationRequest) CreateAiConversationRequest {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s ChatMomentKind) MarshalText() ([]byte, error) {
+	switch s {
+	case ChatMomentKindVelocity:
+		return []byte(s), nil
+	case ChatMomentKindEmoteFlood:
+		return []byte(s), nil
+	case ChatMomentKindPhrase:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptCreateTwitchAccountRequestAccountType returns new OptCreateTwitchAccountRequestAccountType with value set to v.
-func NewOptCreateTwitchAccountRequestAccountType(v CreateTwitchAccountRequestAccountType) OptCreateTwitchAccountRequestAccountType {
-	return OptCreateTwitchAccountRequestAccountType{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ChatMomentKind) UnmarshalText(data []byte) error {
+	switch ChatMomentKind(data) {
+	case ChatMomentKindVelocity:
+		*s = ChatMomentKindVelocity
+		return nil
+	case ChatMomentKindEmoteFlood:
+		*s = ChatMomentKindEmoteFlood
+		return nil
+	case ChatMomentKindPhrase:
+		*s = ChatMomentKindPhrase
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptCreateTwitchAccountRequestAccountType is optional CreateTwitchAccountRequestAccountType.
-type OptCreateTwitchAccountRequestAccountType struct {
-	Value CreateTwitchAccountRequestAccountType
-	Set   bool
+// Ref: #/components/schemas/ChatMomentMessage
+type ChatMomentMessage struct {
+	Login     string    `json:"login"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
-// IsSet returns true if OptCreateTwitchAccountRequestAccountType was set.
-func (o OptCreateTwitchAccountRequestAccountType) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptCreateTwitchAccountRequestAccountType) Reset() {
-	var v CreateTwitchAccountRequestAccountType
-	o.Value = v
-	o.Set = false
+// GetLogin returns the value of Login.
+func (s *ChatMomentMessage) GetLogin() string {
+	return s.Login
 }
 
-// SetTo sets value to v.
-func (o *OptCreateTwitchAccountRequestAccountType) SetTo(v CreateTwitchAccountRequestAccountType) {
-	o.Set = true
-	o.Value = v
+// GetBody returns the value of Body.
+func (s *ChatMomentMessage) GetBody() string {
+	return s.Body
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCreateTwitchAccountRequestAccountType) Get() (v CreateTwitchAccountRequestAccountType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ChatMomentMessage) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCreateTwitchAccountRequestAccountType) Or(d CreateTwitchAccountRequestAccountType) CreateTwitchAccountRequestAccountType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetLogin sets the value of Login.
+func (s *ChatMomentMessage) SetLogin(val string) {
+	s.Login = val
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// SetBody sets the value of Body.
+func (s *ChatMomentMessage) SetBody(val string) {
+	s.Body = val
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ChatMomentMessage) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/ChatToxicity
+type ChatToxicity struct {
+	// Messages labeled by the AI chat classifier.
+	ClassifiedMessages int64 `json:"classified_messages"`
+	// Classified messages with toxicity of at least 0.5.
+	ToxicMessages int64 `json:"toxic_messages"`
+	// 0 to 1.
+	AvgToxicity float64 `json:"avg_toxicity"`
+	// 0 to 1.
+	AvgHarassment float64 `json:"avg_harassment"`
+	// -1 (negative) to 1 (positive).
+	AvgSentiment float64 `json:"avg_sentiment"`
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// GetClassifiedMessages returns the value of ClassifiedMessages.
+func (s *ChatToxicity) GetClassifiedMessages() int64 {
+	return s.ClassifiedMessages
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// GetToxicMessages returns the value of ToxicMessages.
+func (s *ChatToxicity) GetToxicMessages() int64 {
+	return s.ToxicMessages
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetAvgToxicity returns the value of AvgToxicity.
+func (s *ChatToxicity) GetAvgToxicity() float64 {
+	return s.AvgToxicity
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetAvgHarassment returns the value of AvgHarassment.
+func (s *ChatToxicity) GetAvgHarassment() float64 {
+	return s.AvgHarassment
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// GetAvgSentiment returns the value of AvgSentiment.
+func (s *ChatToxicity) GetAvgSentiment() float64 {
+	return s.AvgSentiment
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// SetClassifiedMessages sets the value of ClassifiedMessages.
+func (s *ChatToxicity) SetClassifiedMessages(val int64) {
+	s.ClassifiedMessages = val
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// SetToxicMessages sets the value of ToxicMessages.
+func (s *ChatToxicity) SetToxicMessages(val int64) {
+	s.ToxicMessages = val
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// SetAvgToxicity sets the value of AvgToxicity.
+func (s *ChatToxicity) SetAvgToxicity(val float64) {
+	s.AvgToxicity = val
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// SetAvgHarassment sets the value of AvgHarassment.
+func (s *ChatToxicity) SetAvgHarassment(val float64) {
+	s.AvgHarassment = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetAvgSentiment sets the value of AvgSentiment.
+func (s *ChatToxicity) SetAvgSentiment(val float64) {
+	s.AvgSentiment = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/ClientNotice
+type ClientNotice struct {
+	Severity ClientNoticeSeverity   `json:"severity"`
+	Code     string                 `json:"code"`
+	Message  string                 `json:"message"`
+	Details  OptClientNoticeDetails `json:"details"`
 }
 
-// NewOptInt64 returns new OptInt64 with value set to v.
-func NewOptInt64(v int64) OptInt64 {
-	return OptInt64{
-		Value: v,
-		Set:   true,
-	}
+// GetSeverity returns the value of Severity.
+func (s *ClientNotice) GetSeverity() ClientNoticeSeverity {
+	return s.Severity
 }
 
-// OptInt64 is optional int64.
-type OptInt64 struct {
-	Value int64
-	Set   bool
+// GetCode returns the value of Code.
+func (s *ClientNotice) GetCode() string {
+	return s.Code
 }
 
-// IsSet returns true if OptInt64 was set.
-func (o OptInt64) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptInt64) Reset() {
-	var v int64
-	o.Value = v
-	o.Set = false
+// GetMessage returns the value of Message.
+func (s *ClientNotice) GetMessage() string {
+	return s.Message
 }
 
-// SetTo sets value to v.
-func (o *OptInt64) SetTo(v int64) {
-	o.Set = true
-	o.Value = v
+// GetDetails returns the value of Details.
+func (s *ClientNotice) GetDetails() OptClientNoticeDetails {
+	return s.Details
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt64) Get() (v int64, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetSeverity sets the value of Severity.
+func (s *ClientNotice) SetSeverity(val ClientNoticeSeverity) {
+	s.Severity = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCode sets the value of Code.
+func (s *ClientNotice) SetCode(val string) {
+	s.Code = val
 }
 
-// NewOptNilChannelLive returns new OptNilChannelLive with value set to v.
-func NewOptNilChannelLive(v ChannelLive) OptNilChannelLive {
-	return OptNilChannelLive{
-		Value: v,
-		Set:   true,
-	}
+// SetMessage sets the value of Message.
+func (s *ClientNotice) SetMessage(val string) {
+	s.Message = val
 }
 
-// OptNilChannelLive is optional nullable ChannelLive.
-type OptNilChannelLive struct {
-	Value ChannelLive
-	Set   bool
-	Null  bool
+// SetDetails sets the value of Details.
+func (s *ClientNotice) SetDetails(val OptClientNoticeDetails) {
+	s.Details = val
 }
 
-// IsSet returns true if OptNilChannelLive was set.
-func (o OptNilChannelLive) IsSet() bool { return o.Set }
+type ClientNoticeDetails map[string]jx.Raw
 
-// Reset unsets value.
-func (o *OptNilChannelLive) Reset() {
-	var v ChannelLive
-	o.Value = v
-	o.Set = false
-	o.Null = false
+func (s *ClientNoticeDetails) init() ClientNoticeDetails {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetTo sets value to v.
-func (o *OptNilChannelLive) SetTo(v ChannelLive) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
+type ClientNoticeSeverity string
 
-// IsNull returns true if value is Null.
-func (o OptNilChannelLive) IsNull() bool { return o.Null }
+const (
+	ClientNoticeSeverityError   ClientNoticeSeverity = "error"
+	ClientNoticeSeverityWarning ClientNoticeSeverity = "warning"
+)
 
-// SetToNull sets value to null.
-func (o *OptNilChannelLive) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v ChannelLive
-	o.Value = v
+// AllValues returns all ClientNoticeSeverity values.
+func (ClientNoticeSeverity) AllValues() []ClientNoticeSeverity {
+	return []ClientNoticeSeverity{
+		ClientNoticeSeverityError,
+		ClientNoticeSeverityWarning,
+	}
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilChannelLive) Get() (v ChannelLive, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
+// MarshalText implements encoding.TextMarshaler.
+func (s ClientNoticeSeverity) MarshalText() ([]byte, error) {
+	switch s {
+	case ClientNoticeSeverityError:
+		return []byte(s), nil
+	case ClientNoticeSeverityWarning:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilChannelLive) Or(d ChannelLive) ChannelLive {
-	if v, ok := o.Get(); ok {
-		return v
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ClientNoticeSeverity) UnmarshalText(data []byte) error {
+	switch ClientNoticeSeverity(data) {
+	case ClientNoticeSeverityError:
+		*s = ClientNoticeSeverityError
+		return nil
+	case ClientNoticeSeverityWarning:
+		*s = ClientNoticeSeverityWarning
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
-	return d
 }
 
-// NewOptNilDateTime returns new OptNilDateTime with value set to v.
-func NewOptNilDateTime(v time.Time) OptNilDateTime {
-	return OptNilDateTime{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/ConfirmAiToolRequest
+type ConfirmAiToolRequest struct {
+	ToolCallID string `json:"tool_call_id"`
+	Approve    bool   `json:"approve"`
 }
 
-// OptNilDateTime is optional nullable time.Time.
-type OptNilDateTime struct {
-	Value time.Time
-	Set   bool
-	Null  bool
+// GetToolCallID returns the value of ToolCallID.
+func (s *ConfirmAiToolRequest) GetToolCallID() string {
+	return s.ToolCallID
 }
 
-// IsSet returns true if OptNilDateTime was set.
-func (o OptNilDateTime) IsSet() bool { return o.Set }
+// GetApprove returns the value of Approve.
+func (s *ConfirmAiToolRequest) GetApprove() bool {
+	return s.Approve
+}
 
-// Reset unsets value.
-func (o *OptNilDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetToolCallID sets the value of ToolCallID.
+func (s *ConfirmAiToolRequest) SetToolCallID(val string) {
+	s.ToolCallID = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetApprove sets the value of Approve.
+func (s *ConfirmAiToolRequest) SetApprove(val bool) {
+	s.Approve = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilDateTime) IsNull() bool { return o.Null }
+// Ref: #/components/schemas/CountResponse
+type CountResponse struct {
+	Total int64 `json:"total"`
+}
 
-// SetToNull sets value to null.
-func (o *OptNilDateTime) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v time.Time
-	o.Value = v
+// GetTotal returns the value of Total.
+func (s *CountResponse) GetTotal() int64 {
+	return s.Total
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilDateTime) Get() (v time.Time, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetTotal sets the value of Total.
+func (s *CountResponse) SetTotal(val int64) {
+	s.Total = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/CreateAiConversationRequest
+type CreateAiConversationRequest struct {
+	Title OptString `json:"title"`
 }
 
-// NewOptNilInt64 returns new OptNilInt64 with value set to v.
-func NewOptNilInt64(v int64) OptNilInt64 {
-	return OptNilInt64{
-		Value: v,
-		Set:   true,
-	}
+// GetTitle returns the value of Title.
+func (s *CreateAiConversationRequest) GetTitle() OptString {
+	return s.Title
 }
 
-// OptNilInt64 is optional nullable int64.
-type OptNilInt64 struct {
-	Value int64
-	Set   bool
-	Null  bool
+// SetTitle sets the value of Title.
+func (s *CreateAiConversationRequest) SetTitle(val OptString) {
+	s.Title = val
 }
 
-// IsSet returns true if OptNilInt64 was set.
-func (o OptNilInt64) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/CreateAiMessageRequest
+type CreateAiMessageRequest struct {
+	Content string `json:"content"`
+}
 
-// Reset unsets value.
-func (o *OptNilInt64) Reset() {
-	var v int64
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetContent returns the value of Content.
+func (s *CreateAiMessageRequest) GetContent() string {
+	return s.Content
 }
 
-// SetTo sets value to v.
-func (o *OptNilInt64) SetTo(v int64) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetContent sets the value of Content.
+func (s *CreateAiMessageRequest) SetContent(val string) {
+	s.Content = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilInt64) IsNull() bool { return o.Null }
+// Ref: #/components/schemas/CreateBlocklistRequest
+type CreateBlocklistRequest struct {
+	Name   string          `json:"name"`
+	Format BlocklistFormat `json:"format"`
+	// Http(s) URL to pull the list from; omit for upload-only lists.
+	SourceURL OptString `json:"source_url"`
+	// Pull interval (default 1440).
+	SyncIntervalMinutes OptInt  `json:"sync_interval_minutes"`
+	Enabled             OptBool `json:"enabled"`
+}
 
-// SetToNull sets value to null.
-func (o *OptNilInt64) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v int64
-	o.Value = v
+// GetName returns the value of Name.
+func (s *CreateBlocklistRequest) GetName() string {
+	return s.Name
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilInt64) Get() (v int64, ok bool) {
-	if o.Null {
-		return v, false
+// GetFormat returns the value of Format.
+func (s *CreateBlocklistRequest) GetFormat() BlocklistFormat {
+	return s.Format
+}
+
+// GetSourceURL returns the value of SourceURL.
+func (s *CreateBlocklistRequest) GetSourceURL() OptString {
+	return s.SourceURL
+}
+
+// GetSyncIntervalMinutes returns the value of SyncIntervalMinutes.
+func (s *CreateBlocklistRequest) GetSyncIntervalMinutes() OptInt {
+	return s.SyncIntervalMinutes
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *CreateBlocklistRequest) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// SetName sets the value of Name.
+func (s *CreateBlocklistRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetFormat sets the value of Format.
+func (s *CreateBlocklistRequest) SetFormat(val BlocklistFormat) {
+	s.Format = val
+}
+
+// SetSourceURL sets the value of SourceURL.
+func (s *CreateBlocklistRequest) SetSourceURL(val OptString) {
+	s.SourceURL = val
+}
+
+// SetSyncIntervalMinutes sets the value of SyncIntervalMinutes.
+func (s *CreateBlocklistRequest) SetSyncIntervalMinutes(val OptInt) {
+	s.SyncIntervalMinutes = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *CreateBlocklistRequest) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// Ref: #/components/schemas/CreateLoginPatternRequest
+type CreateLoginPatternRequest struct {
+	Kind        LoginPatternKind `json:"kind"`
+	Pattern     string           `json:"pattern"`
+	Description OptString        `json:"description"`
+}
+
+// GetKind returns the value of Kind.
+func (s *CreateLoginPatternRequest) GetKind() LoginPatternKind {
+	return s.Kind
+}
+
+// GetPattern returns the value of Pattern.
+func (s *CreateLoginPatternRequest) GetPattern() string {
+	return s.Pattern
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateLoginPatternRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// SetKind sets the value of Kind.
+func (s *CreateLoginPatternRequest) SetKind(val LoginPatternKind) {
+	s.Kind = val
+}
+
+// SetPattern sets the value of Pattern.
+func (s *CreateLoginPatternRequest) SetPattern(val string) {
+	s.Pattern = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateLoginPatternRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// Ref: #/components/schemas/CreateNotificationRequest
+type CreateNotificationRequest struct {
+	Provider CreateNotificationRequestProvider `json:"provider"`
+	Settings CreateNotificationRequestSettings `json:"settings"`
+	Enabled  OptBool                           `json:"enabled"`
+	// Labels that rules can target via action_settings.notification_tags.
+	Tags []string `json:"tags"`
+	// Default event filter (chat_message, stream_start, stream_end, interval); empty accepts every event.
+	// Only applies when the notify action names no notification_ids or notification_tags.
+	EventTypes []string `json:"event_types"`
+	// Default channel login filter; empty accepts every channel. Same precedence as event_types.
+	Channels []string              `json:"channels"`
+	Policy   OptNotificationPolicy `json:"policy"`
+}
+
+// GetProvider returns the value of Provider.
+func (s *CreateNotificationRequest) GetProvider() CreateNotificationRequestProvider {
+	return s.Provider
+}
+
+// GetSettings returns the value of Settings.
+func (s *CreateNotificationRequest) GetSettings() CreateNotificationRequestSettings {
+	return s.Settings
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *CreateNotificationRequest) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetTags returns the value of Tags.
+func (s *CreateNotificationRequest) GetTags() []string {
+	return s.Tags
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *CreateNotificationRequest) GetEventTypes() []string {
+	return s.EventTypes
+}
+
+// GetChannels returns the value of Channels.
+func (s *CreateNotificationRequest) GetChannels() []string {
+	return s.Channels
+}
+
+// GetPolicy returns the value of Policy.
+func (s *CreateNotificationRequest) GetPolicy() OptNotificationPolicy {
+	return s.Policy
+}
+
+// SetProvider sets the value of Provider.
+func (s *CreateNotificationRequest) SetProvider(val CreateNotificationRequestProvider) {
+	s.Provider = val
+}
+
+// SetSettings sets the value of Settings.
+func (s *CreateNotificationRequest) SetSettings(val CreateNotificationRequestSettings) {
+	s.Settings = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *CreateNotificationRequest) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetTags sets the value of Tags.
+func (s *CreateNotificationRequest) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *CreateNotificationRequest) SetEventTypes(val []string) {
+	s.EventTypes = val
+}
+
+// SetChannels sets the value of Channels.
+func (s *CreateNotificationRequest) SetChannels(val []string) {
+	s.Channels = val
+}
+
+// SetPolicy sets the value of Policy.
+func (s *CreateNotificationRequest) SetPolicy(val OptNotificationPolicy) {
+	s.Policy = val
+}
+
+type CreateNotificationRequestProvider string
+
+const (
+	CreateNotificationRequestProviderTelegram CreateNotificationRequestProvider = "telegram"
+	CreateNotificationRequestProviderWebhook  CreateNotificationRequestProvider = "webhook"
+	CreateNotificationRequestProviderDiscord  CreateNotificationRequestProvider = "discord"
+	CreateNotificationRequestProviderMatrix   CreateNotificationRequestProvider = "matrix"
+	CreateNotificationRequestProviderNtfy     CreateNotificationRequestProvider = "ntfy"
+	CreateNotificationRequestProviderEmail    CreateNotificationRequestProvider = "email"
+)
+
+// AllValues returns all CreateNotificationRequestProvider values.
+func (CreateNotificationRequestProvider) AllValues() []CreateNotificationRequestProvider {
+	return []CreateNotificationRequestProvider{
+		CreateNotificationRequestProviderTelegram,
+		CreateNotificationRequestProviderWebhook,
+		CreateNotificationRequestProviderDiscord,
+		CreateNotificationRequestProviderMatrix,
+		CreateNotificationRequestProviderNtfy,
+		CreateNotificationRequestProviderEmail,
 	}
-	if !o.Set {
-		return v, false
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateNotificationRequestProvider) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateNotificationRequestProviderTelegram:
+		return []byte(s), nil
+	case CreateNotificationRequestProviderWebhook:
+		return []byte(s), nil
+	case CreateNotificationRequestProviderDiscord:
+		return []byte(s), nil
+	case CreateNotificationRequestProviderMatrix:
+		return []byte(s), nil
+	case CreateNotificationRequestProviderNtfy:
+		return []byte(s), nil
+	case CreateNotificationRequestProviderEmail:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateNotificationRequestProvider) UnmarshalText(data []byte) error {
+	switch CreateNotificationRequestProvider(data) {
+	case CreateNotificationRequestProviderTelegram:
+		*s = CreateNotificationRequestProviderTelegram
+		return nil
+	case CreateNotificationRequestProviderWebhook:
+		*s = CreateNotificationRequestProviderWebhook
+		return nil
+	case CreateNotificationRequestProviderDiscord:
+		*s = CreateNotificationRequestProviderDiscord
+		return nil
+	case CreateNotificationRequestProviderMatrix:
+		*s = CreateNotificationRequestProviderMatrix
+		return nil
+	case CreateNotificationRequestProviderNtfy:
+		*s = CreateNotificationRequestProviderNtfy
+		return nil
+	case CreateNotificationRequestProviderEmail:
+		*s = CreateNotificationRequestProviderEmail
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
-	return d
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
+type CreateNotificationRequestSettings map[string]jx.Raw
+
+func (s *CreateNotificationRequestSettings) init() CreateNotificationRequestSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
+	return m
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+// Mute one entry (notification_id) and/or one channel until until, or for minutes; at most 30 days.
+// Ref: #/components/schemas/CreateNotificationSnoozeRequest
+type CreateNotificationSnoozeRequest struct {
+	NotificationID OptInt64    `json:"notification_id"`
+	Channel        OptString   `json:"channel"`
+	Until          OptDateTime `json:"until"`
+	Minutes        OptInt      `json:"minutes"`
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// GetNotificationID returns the value of NotificationID.
+func (s *CreateNotificationSnoozeRequest) GetNotificationID() OptInt64 {
+	return s.NotificationID
+}
 
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetChannel returns the value of Channel.
+func (s *CreateNotificationSnoozeRequest) GetChannel() OptString {
+	return s.Channel
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetUntil returns the value of Until.
+func (s *CreateNotificationSnoozeRequest) GetUntil() OptDateTime {
+	return s.Until
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// GetMinutes returns the value of Minutes.
+func (s *CreateNotificationSnoozeRequest) GetMinutes() OptInt {
+	return s.Minutes
+}
 
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+// SetNotificationID sets the value of NotificationID.
+func (s *CreateNotificationSnoozeRequest) SetNotificationID(val OptInt64) {
+	s.NotificationID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetChannel sets the value of Channel.
+func (s *CreateNotificationSnoozeRequest) SetChannel(val OptString) {
+	s.Channel = val
+}
+
+// SetUntil sets the value of Until.
+func (s *CreateNotificationSnoozeRequest) SetUntil(val OptDateTime) {
+	s.Until = val
+}
+
+// SetMinutes sets the value of Minutes.
+func (s *CreateNotificationSnoozeRequest) SetMinutes(val OptInt) {
+	s.Minutes = val
+}
+
+// Ref: #/components/schemas/CreateRuleRequest
+type CreateRuleRequest struct {
+	// Display name for this rule (required; non-empty).
+	Name           string                          `json:"name"`
+	Enabled        OptBool                         `json:"enabled"`
+	EventType      RuleEventType                   `json:"event_type"`
+	EventSettings  CreateRuleRequestEventSettings  `json:"event_settings"`
+	Middlewares    []RuleMiddleware                `json:"middlewares"`
+	ActionType     RuleActionType                  `json:"action_type"`
+	ActionSettings CreateRuleRequestActionSettings `json:"action_settings"`
+	UseSharedPool  OptBool                         `json:"use_shared_pool"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateRuleRequest) GetName() string {
+	return s.Name
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *CreateRuleRequest) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetEventType returns the value of EventType.
+func (s *CreateRuleRequest) GetEventType() RuleEventType {
+	return s.EventType
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+// GetEventSettings returns the value of EventSettings.
+func (s *CreateRuleRequest) GetEventSettings() CreateRuleRequestEventSettings {
+	return s.EventSettings
+}
+
+// GetMiddlewares returns the value of Middlewares.
+func (s *CreateRuleRequest) GetMiddlewares() []RuleMiddleware {
+	return s.Middlewares
+}
+
+// GetActionType returns the value of ActionType.
+func (s *CreateRuleRequest) GetActionType() RuleActionType {
+	return s.ActionType
+}
+
+// GetActionSettings returns the value of ActionSettings.
+func (s *CreateRuleRequest) GetActionSettings() CreateRuleRequestActionSettings {
+	return s.ActionSettings
+}
+
+// GetUseSharedPool returns the value of UseSharedPool.
+func (s *CreateRuleRequest) GetUseSharedPool() OptBool {
+	return s.UseSharedPool
+}
+
+// SetName sets the value of Name.
+func (s *CreateRuleRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *CreateRuleRequest) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *CreateRuleRequest) SetEventType(val RuleEventType) {
+	s.EventType = val
+}
+
+// SetEventSettings sets the value of EventSettings.
+func (s *CreateRuleRequest) SetEventSettings(val CreateRuleRequestEventSettings) {
+	s.EventSettings = val
+}
+
+// SetMiddlewares sets the value of Middlewares.
+func (s *CreateRuleRequest) SetMiddlewares(val []RuleMiddleware) {
+	s.Middlewares = val
+}
+
+// SetActionType sets the value of ActionType.
+func (s *CreateRuleRequest) SetActionType(val RuleActionType) {
+	s.ActionType = val
+}
+
+// SetActionSettings sets the value of ActionSettings.
+func (s *CreateRuleRequest) SetActionSettings(val CreateRuleRequestActionSettings) {
+	s.ActionSettings = val
+}
+
+// SetUseSharedPool sets the value of UseSharedPool.
+func (s *CreateRuleRequest) SetUseSharedPool(val OptBool) {
+	s.UseSharedPool = val
+}
+
+type CreateRuleRequestActionSettings map[string]jx.Raw
+
+func (s *CreateRuleRequestActionSettings) init() CreateRuleRequestActionSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type CreateRuleRequestEventSettings map[string]jx.Raw
+
+func (s *CreateRuleRequestEventSettings) init() CreateRuleRequestEventSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/CreateTwitchAccountRequest
+type CreateTwitchAccountRequest struct {
+	// Twitch user id for this OAuth identity (same as Helix user id).
+	ID           int64                                    `json:"id"`
+	Username     string                                   `json:"username"`
+	RefreshToken string                                   `json:"refresh_token"`
+	AccountType  OptCreateTwitchAccountRequestAccountType `json:"account_type"`
+}
+
+// GetID returns the value of ID.
+func (s *CreateTwitchAccountRequest) GetID() int64 {
+	return s.ID
+}
+
+// GetUsername returns the value of Username.
+func (s *CreateTwitchAccountRequest) GetUsername() string {
+	return s.Username
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *CreateTwitchAccountRequest) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// GetAccountType returns the value of AccountType.
+func (s *CreateTwitchAccountRequest) GetAccountType() OptCreateTwitchAccountRequestAccountType {
+	return s.AccountType
+}
+
+// SetID sets the value of ID.
+func (s *CreateTwitchAccountRequest) SetID(val int64) {
+	s.ID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *CreateTwitchAccountRequest) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *CreateTwitchAccountRequest) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+// SetAccountType sets the value of AccountType.
+func (s *CreateTwitchAccountRequest) SetAccountType(val OptCreateTwitchAccountRequestAccountType) {
+	s.AccountType = val
+}
+
+type CreateTwitchAccountRequestAccountType string
+
+const (
+	CreateTwitchAccountRequestAccountTypeMain CreateTwitchAccountRequestAccountType = "main"
+	CreateTwitchAccountRequestAccountTypeBot  CreateTwitchAccountRequestAccountType = "bot"
+)
+
+// AllValues returns all CreateTwitchAccountRequestAccountType values.
+func (CreateTwitchAccountRequestAccountType) AllValues() []CreateTwitchAccountRequestAccountType {
+	return []CreateTwitchAccountRequestAccountType{
+		CreateTwitchAccountRequestAccountTypeMain,
+		CreateTwitchAccountRequestAccountTypeBot,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateTwitchAccountRequestAccountType) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateTwitchAccountRequestAccountTypeMain:
+		return []byte(s), nil
+	case CreateTwitchAccountRequestAccountTypeBot:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateTwitchAccountRequestAccountType) UnmarshalText(data []byte) error {
+	switch CreateTwitchAccountRequestAccountType(data) {
+	case CreateTwitchAccountRequestAccountTypeMain:
+		*s = CreateTwitchAccountRequestAccountTypeMain
+		return nil
+	case CreateTwitchAccountRequestAccountTypeBot:
+		*s = CreateTwitchAccountRequestAccountTypeBot
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CreateTwitchUserRequest
+type CreateTwitchUserRequest struct {
+	// Channel login or display name; resolved via Twitch Helix.
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateTwitchUserRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *CreateTwitchUserRequest) SetName(val string) {
+	s.Name = val
+}
+
+// DeleteAiConversationNoContent is response for DeleteAiConversation operation.
+type DeleteAiConversationNoContent struct{}
+
+func (*DeleteAiConversationNoContent) deleteAiConversationRes() {}
+
+// DeleteBlocklistNoContent is response for DeleteBlocklist operation.
+type DeleteBlocklistNoContent struct{}
+
+func (*DeleteBlocklistNoContent) deleteBlocklistRes() {}
+
+// Ref: #/components/schemas/DeleteByIDRequest
+type DeleteByIDRequest struct {
+	ID int64 `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *DeleteByIDRequest) GetID() int64 {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *DeleteByIDRequest) SetID(val int64) {
+	s.ID = val
+}
+
+// DeleteEmoteSetNoContent is response for DeleteEmoteSet operation.
+type DeleteEmoteSetNoContent struct{}
+
+func (*DeleteEmoteSetNoContent) deleteEmoteSetRes() {}
+
+// DeleteLoginPatternNoContent is response for DeleteLoginPattern operation.
+type DeleteLoginPatternNoContent struct{}
+
+func (*DeleteLoginPatternNoContent) deleteLoginPatternRes() {}
+
+// DeleteNotificationNoContent is response for DeleteNotification operation.
+type DeleteNotificationNoContent struct{}
+
+func (*DeleteNotificationNoContent) deleteNotificationRes() {}
+
+// DeleteNotificationSnoozeNoContent is response for DeleteNotificationSnooze operation.
+type DeleteNotificationSnoozeNoContent struct{}
+
+func (*DeleteNotificationSnoozeNoContent) deleteNotificationSnoozeRes() {}
+
+// DeleteRuleNoContent is response for DeleteRule operation.
+type DeleteRuleNoContent struct{}
+
+func (*DeleteRuleNoContent) deleteRuleRes() {}
+
+// DeleteTwitchAccountNoContent is response for DeleteTwitchAccount operation.
+type DeleteTwitchAccountNoContent struct{}
+
+func (*DeleteTwitchAccountNoContent) deleteTwitchAccountRes() {}
+
+// DeleteTwitchUserLinkNoContent is response for DeleteTwitchUserLink operation.
+type DeleteTwitchUserLinkNoContent struct{}
+
+func (*DeleteTwitchUserLinkNoContent) deleteTwitchUserLinkRes() {}
+
+// Ref: #/components/schemas/DeleteTwitchUserLinkRequest
+type DeleteTwitchUserLinkRequest struct {
+	TwitchUserID int64 `json:"twitch_user_id"`
+	LinkedUserID int64 `json:"linked_user_id"`
+}
+
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *DeleteTwitchUserLinkRequest) GetTwitchUserID() int64 {
+	return s.TwitchUserID
+}
+
+// GetLinkedUserID returns the value of LinkedUserID.
+func (s *DeleteTwitchUserLinkRequest) GetLinkedUserID() int64 {
+	return s.LinkedUserID
+}
+
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *DeleteTwitchUserLinkRequest) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
+}
+
+// SetLinkedUserID sets the value of LinkedUserID.
+func (s *DeleteTwitchUserLinkRequest) SetLinkedUserID(val int64) {
+	s.LinkedUserID = val
+}
+
+// DenyChannelDiscoveryCandidateNoContent is response for DenyChannelDiscoveryCandidate operation.
+type DenyChannelDiscoveryCandidateNoContent struct{}
+
+func (*DenyChannelDiscoveryCandidateNoContent) denyChannelDiscoveryCandidateRes() {}
+
+// Ref: #/components/schemas/DiscoveryCandidate
+type DiscoveryCandidate struct {
+	User         TwitchUser   `json:"user"`
+	DiscoveredAt time.Time    `json:"discovered_at"`
+	LastSeenAt   time.Time    `json:"last_seen_at"`
+	ViewerCount  OptNilInt64  `json:"viewer_count"`
+	Title        OptNilString `json:"title"`
+	GameName     OptNilString `json:"game_name"`
+	// Stream tag snapshot from the last matching Helix /streams row.
+	StreamTags []string `json:"stream_tags"`
+}
+
+// GetUser returns the value of User.
+func (s *DiscoveryCandidate) GetUser() TwitchUser {
+	return s.User
+}
+
+// GetDiscoveredAt returns the value of DiscoveredAt.
+func (s *DiscoveryCandidate) GetDiscoveredAt() time.Time {
+	return s.DiscoveredAt
+}
+
+// GetLastSeenAt returns the value of LastSeenAt.
+func (s *DiscoveryCandidate) GetLastSeenAt() time.Time {
+	return s.LastSeenAt
+}
+
+// GetViewerCount returns the value of ViewerCount.
+func (s *DiscoveryCandidate) GetViewerCount() OptNilInt64 {
+	return s.ViewerCount
+}
+
+// GetTitle returns the value of Title.
+func (s *DiscoveryCandidate) GetTitle() OptNilString {
+	return s.Title
+}
+
+// GetGameName returns the value of GameName.
+func (s *DiscoveryCandidate) GetGameName() OptNilString {
+	return s.GameName
+}
+
+// GetStreamTags returns the value of StreamTags.
+func (s *DiscoveryCandidate) GetStreamTags() []string {
+	return s.StreamTags
+}
+
+// SetUser sets the value of User.
+func (s *DiscoveryCandidate) SetUser(val TwitchUser) {
+	s.User = val
+}
+
+// SetDiscoveredAt sets the value of DiscoveredAt.
+func (s *DiscoveryCandidate) SetDiscoveredAt(val time.Time) {
+	s.DiscoveredAt = val
+}
+
+// SetLastSeenAt sets the value of LastSeenAt.
+func (s *DiscoveryCandidate) SetLastSeenAt(val time.Time) {
+	s.LastSeenAt = val
+}
+
+// SetViewerCount sets the value of ViewerCount.
+func (s *DiscoveryCandidate) SetViewerCount(val OptNilInt64) {
+	s.ViewerCount = val
+}
+
+// SetTitle sets the value of Title.
+func (s *DiscoveryCandidate) SetTitle(val OptNilString) {
+	s.Title = val
+}
+
+// SetGameName sets the value of GameName.
+func (s *DiscoveryCandidate) SetGameName(val OptNilString) {
+	s.GameName = val
+}
+
+// SetStreamTags sets the value of StreamTags.
+func (s *DiscoveryCandidate) SetStreamTags(val []string) {
+	s.StreamTags = val
+}
+
+// Ref: #/components/schemas/EmoteSet
+type EmoteSet struct {
+	ID       int64            `json:"id"`
+	Name     string           `json:"name"`
+	Provider EmoteSetProvider `json:"provider"`
+	// Channel login the set applies to; empty for all monitored channels.
+	Channel    string    `json:"channel"`
+	EmoteCount int       `json:"emote_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *EmoteSet) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *EmoteSet) GetName() string {
+	return s.Name
+}
+
+// GetProvider returns the value of Provider.
+func (s *EmoteSet) GetProvider() EmoteSetProvider {
+	return s.Provider
+}
+
+// GetChannel returns the value of Channel.
+func (s *EmoteSet) GetChannel() string {
+	return s.Channel
+}
+
+// GetEmoteCount returns the value of EmoteCount.
+func (s *EmoteSet) GetEmoteCount() int {
+	return s.EmoteCount
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *EmoteSet) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *EmoteSet) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *EmoteSet) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *EmoteSet) SetName(val string) {
+	s.Name = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *EmoteSet) SetProvider(val EmoteSetProvider) {
+	s.Provider = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *EmoteSet) SetChannel(val string) {
+	s.Channel = val
+}
+
+// SetEmoteCount sets the value of EmoteCount.
+func (s *EmoteSet) SetEmoteCount(val int) {
+	s.EmoteCount = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *EmoteSet) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *EmoteSet) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*EmoteSet) saveEmoteSetRes() {}
+
+// Ref: #/components/schemas/EmoteSetEmote
+type EmoteSetEmote struct {
+	// Word matched in chat (case-sensitive, no spaces).
+	Code string `json:"code"`
+	// Provider emote id (default the code).
+	ID OptString `json:"id"`
+}
+
+// GetCode returns the value of Code.
+func (s *EmoteSetEmote) GetCode() string {
+	return s.Code
+}
+
+// GetID returns the value of ID.
+func (s *EmoteSetEmote) GetID() OptString {
+	return s.ID
+}
+
+// SetCode sets the value of Code.
+func (s *EmoteSetEmote) SetCode(val string) {
+	s.Code = val
+}
+
+// SetID sets the value of ID.
+func (s *EmoteSetEmote) SetID(val OptString) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/EmoteSetProvider
+type EmoteSetProvider string
+
+const (
+	EmoteSetProviderBttv   EmoteSetProvider = "bttv"
+	EmoteSetProviderFfz    EmoteSetProvider = "ffz"
+	EmoteSetProvider7tv    EmoteSetProvider = "7tv"
+	EmoteSetProviderCustom EmoteSetProvider = "custom"
+)
+
+// AllValues returns all EmoteSetProvider values.
+func (EmoteSetProvider) AllValues() []EmoteSetProvider {
+	return []EmoteSetProvider{
+		EmoteSetProviderBttv,
+		EmoteSetProviderFfz,
+		EmoteSetProvider7tv,
+		EmoteSetProviderCustom,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EmoteSetProvider) MarshalText() ([]byte, error) {
+	switch s {
+	case EmoteSetProviderBttv:
+		return []byte(s), nil
+	case EmoteSetProviderFfz:
+		return []byte(s), nil
+	case EmoteSetProvider7tv:
+		return []byte(s), nil
+	case EmoteSetProviderCustom:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EmoteSetProvider) UnmarshalText(data []byte) error {
+	switch EmoteSetProvider(data) {
+	case EmoteSetProviderBttv:
+		*s = EmoteSetProviderBttv
+		return nil
+	case EmoteSetProviderFfz:
+		*s = EmoteSetProviderFfz
+		return nil
+	case EmoteSetProvider7tv:
+		*s = EmoteSetProvider7tv
+		return nil
+	case EmoteSetProviderCustom:
+		*s = EmoteSetProviderCustom
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/EmoteTimelineBucket
+type EmoteTimelineBucket struct {
+	Start time.Time `json:"start"`
+	// Uses per emote, in the order of StreamEmotes.top.
+	Uses []int64 `json:"uses"`
+}
+
+// GetStart returns the value of Start.
+func (s *EmoteTimelineBucket) GetStart() time.Time {
+	return s.Start
+}
+
+// GetUses returns the value of Uses.
+func (s *EmoteTimelineBucket) GetUses() []int64 {
+	return s.Uses
+}
+
+// SetStart sets the value of Start.
+func (s *EmoteTimelineBucket) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetUses sets the value of Uses.
+func (s *EmoteTimelineBucket) SetUses(val []int64) {
+	s.Uses = val
+}
+
+// Ref: #/components/schemas/EmoteUsage
+type EmoteUsage struct {
+	// Twitch, or the provider of the emote set the code was detected from.
+	Provider string `json:"provider"`
+	EmoteID  string `json:"emote_id"`
+	Code     string `json:"code"`
+	// Occurrences, counting repeats within a message.
+	Uses     int64 `json:"uses"`
+	Messages int64 `json:"messages"`
+	Chatters int64 `json:"chatters"`
+}
+
+// GetProvider returns the value of Provider.
+func (s *EmoteUsage) GetProvider() string {
+	return s.Provider
+}
+
+// GetEmoteID returns the value of EmoteID.
+func (s *EmoteUsage) GetEmoteID() string {
+	return s.EmoteID
+}
+
+// GetCode returns the value of Code.
+func (s *EmoteUsage) GetCode() string {
+	return s.Code
+}
+
+// GetUses returns the value of Uses.
+func (s *EmoteUsage) GetUses() int64 {
+	return s.Uses
+}
+
+// GetMessages returns the value of Messages.
+func (s *EmoteUsage) GetMessages() int64 {
+	return s.Messages
+}
+
+// GetChatters returns the value of Chatters.
+func (s *EmoteUsage) GetChatters() int64 {
+	return s.Chatters
+}
+
+// SetProvider sets the value of Provider.
+func (s *EmoteUsage) SetProvider(val string) {
+	s.Provider = val
+}
+
+// SetEmoteID sets the value of EmoteID.
+func (s *EmoteUsage) SetEmoteID(val string) {
+	s.EmoteID = val
+}
+
+// SetCode sets the value of Code.
+func (s *EmoteUsage) SetCode(val string) {
+	s.Code = val
+}
+
+// SetUses sets the value of Uses.
+func (s *EmoteUsage) SetUses(val int64) {
+	s.Uses = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *EmoteUsage) SetMessages(val int64) {
+	s.Messages = val
+}
+
+// SetChatters sets the value of Chatters.
+func (s *EmoteUsage) SetChatters(val int64) {
+	s.Chatters = val
+}
+
+// Ref: #/components/schemas/ErrorMessage
+type ErrorMessage struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorMessage) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorMessage) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ErrorMessage) confirmAiToolRes()                  {}
+func (*ErrorMessage) createAiMessageRes()                {}
+func (*ErrorMessage) createBlocklistRes()                {}
+func (*ErrorMessage) createLoginPatternRes()             {}
+func (*ErrorMessage) createNotificationSnoozeRes()       {}
+func (*ErrorMessage) createTwitchUserRes()               {}
+func (*ErrorMessage) deleteAiConversationRes()           {}
+func (*ErrorMessage) deleteBlocklistRes()                {}
+func (*ErrorMessage) deleteEmoteSetRes()                 {}
+func (*ErrorMessage) deleteLoginPatternRes()             {}
+func (*ErrorMessage) deleteNotificationRes()             {}
+func (*ErrorMessage) deleteNotificationSnoozeRes()       {}
+func (*ErrorMessage) deleteRuleRes()                     {}
+func (*ErrorMessage) deleteTwitchAccountRes()            {}
+func (*ErrorMessage) deleteTwitchUserLinkRes()           {}
+func (*ErrorMessage) denyChannelDiscoveryCandidateRes()  {}
+func (*ErrorMessage) getChannelLiveRes()                 {}
+func (*ErrorMessage) getMonitoredLeaderboardRes()        {}
+func (*ErrorMessage) getRecordedStreamEmotesRes()        {}
+func (*ErrorMessage) getRecordedStreamLeaderboardRes()   {}
+func (*ErrorMessage) getRecordedStreamRes()              {}
+func (*ErrorMessage) getTwitchUserActivityTimelineRes()  {}
+func (*ErrorMessage) getTwitchUserProfileRes()           {}
+func (*ErrorMessage) listAiMessagesRes()                 {}
+func (*ErrorMessage) listChannelChattersRes()            {}
+func (*ErrorMessage) listChatHistoryRes()                {}
+func (*ErrorMessage) listRecordedStreamActivityRes()     {}
+func (*ErrorMessage) listRecordedStreamMessagesRes()     {}
+func (*ErrorMessage) listTwitchUserActivityRes()         {}
+func (*ErrorMessage) previewRuleNotifyRes()              {}
+func (*ErrorMessage) resendNotificationDeliveryRes()     {}
+func (*ErrorMessage) saveEmoteSetRes()                   {}
+func (*ErrorMessage) scanTwitchUserAltsRes()             {}
+func (*ErrorMessage) setChannelBlacklistRes()            {}
+func (*ErrorMessage) stopAiAgentRes()                    {}
+func (*ErrorMessage) updateBotDetectionSettingsRes()     {}
+func (*ErrorMessage) updateChannelDiscoverySettingsRes() {}
+func (*ErrorMessage) updateChatClassifierSettingsRes()   {}
+func (*ErrorMessage) updateNotificationRes()             {}
+func (*ErrorMessage) updateRuleRes()                     {}
+func (*ErrorMessage) updateSuspicionSettingsRes()        {}
+func (*ErrorMessage) updateTwitchAccountRes()            {}
+
+// Ref: #/components/schemas/FollowedChannelEntry
+type FollowedChannelEntry struct {
+	ChannelID    int64          `json:"channel_id"`
+	ChannelLogin string         `json:"channel_login"`
+	FollowedAt   OptNilDateTime `json:"followed_at"`
+	// True when this followed channel login is on the global blacklist.
+	OnBlacklist bool `json:"on_blacklist"`
+}
+
+// GetChannelID returns the value of ChannelID.
+func (s *FollowedChannelEntry) GetChannelID() int64 {
+	return s.ChannelID
+}
+
+// GetChannelLogin returns the value of ChannelLogin.
+func (s *FollowedChannelEntry) GetChannelLogin() string {
+	return s.ChannelLogin
+}
+
+// GetFollowedAt returns the value of FollowedAt.
+func (s *FollowedChannelEntry) GetFollowedAt() OptNilDateTime {
+	return s.FollowedAt
+}
+
+// GetOnBlacklist returns the value of OnBlacklist.
+func (s *FollowedChannelEntry) GetOnBlacklist() bool {
+	return s.OnBlacklist
+}
+
+// SetChannelID sets the value of ChannelID.
+func (s *FollowedChannelEntry) SetChannelID(val int64) {
+	s.ChannelID = val
+}
+
+// SetChannelLogin sets the value of ChannelLogin.
+func (s *FollowedChannelEntry) SetChannelLogin(val string) {
+	s.ChannelLogin = val
+}
+
+// SetFollowedAt sets the value of FollowedAt.
+func (s *FollowedChannelEntry) SetFollowedAt(val OptNilDateTime) {
+	s.FollowedAt = val
+}
+
+// SetOnBlacklist sets the value of OnBlacklist.
+func (s *FollowedChannelEntry) SetOnBlacklist(val bool) {
+	s.OnBlacklist = val
+}
+
+// Ref: #/components/schemas/FollowedMonitoredChannel
+type FollowedMonitoredChannel struct {
+	ChannelID    int64          `json:"channel_id"`
+	ChannelLogin string         `json:"channel_login"`
+	FollowedAt   OptNilDateTime `json:"followed_at"`
+}
+
+// GetChannelID returns the value of ChannelID.
+func (s *FollowedMonitoredChannel) GetChannelID() int64 {
+	return s.ChannelID
+}
+
+// GetChannelLogin returns the value of ChannelLogin.
+func (s *FollowedMonitoredChannel) GetChannelLogin() string {
+	return s.ChannelLogin
+}
+
+// GetFollowedAt returns the value of FollowedAt.
+func (s *FollowedMonitoredChannel) GetFollowedAt() OptNilDateTime {
+	return s.FollowedAt
+}
+
+// SetChannelID sets the value of ChannelID.
+func (s *FollowedMonitoredChannel) SetChannelID(val int64) {
+	s.ChannelID = val
+}
+
+// SetChannelLogin sets the value of ChannelLogin.
+func (s *FollowedMonitoredChannel) SetChannelLogin(val string) {
+	s.ChannelLogin = val
+}
+
+// SetFollowedAt sets the value of FollowedAt.
+func (s *FollowedMonitoredChannel) SetFollowedAt(val OptNilDateTime) {
+	s.FollowedAt = val
+}
+
+type GetChannelActivityHeatmapBadRequest ErrorMessage
+
+func (*GetChannelActivityHeatmapBadRequest) getChannelActivityHeatmapRes() {}
+
+type GetChannelActivityHeatmapNotFound ErrorMessage
+
+func (*GetChannelActivityHeatmapNotFound) getChannelActivityHeatmapRes() {}
+
+type GetChannelAnalyticsBadRequest ErrorMessage
+
+func (*GetChannelAnalyticsBadRequest) getChannelAnalyticsRes() {}
+
+type GetChannelAnalyticsNotFound ErrorMessage
+
+func (*GetChannelAnalyticsNotFound) getChannelAnalyticsRes() {}
+
+type GetChannelEmoteUsageBadRequest ErrorMessage
+
+func (*GetChannelEmoteUsageBadRequest) getChannelEmoteUsageRes() {}
+
+type GetChannelEmoteUsageNotFound ErrorMessage
+
+func (*GetChannelEmoteUsageNotFound) getChannelEmoteUsageRes() {}
+
+type GetChannelEmoteUsageOKApplicationJSON []EmoteUsage
+
+func (*GetChannelEmoteUsageOKApplicationJSON) getChannelEmoteUsageRes() {}
+
+type GetChannelLeaderboardBadRequest ErrorMessage
+
+func (*GetChannelLeaderboardBadRequest) getChannelLeaderboardRes() {}
+
+type GetChannelLeaderboardNotFound ErrorMessage
+
+func (*GetChannelLeaderboardNotFound) getChannelLeaderboardRes() {}
+
+// Ref: #/components/schemas/GetChannelLiveRequest
+type GetChannelLiveRequest struct {
+	Login string `json:"login"`
+}
+
+// GetLogin returns the value of Login.
+func (s *GetChannelLiveRequest) GetLogin() string {
+	return s.Login
+}
+
+// SetLogin sets the value of Login.
+func (s *GetChannelLiveRequest) SetLogin(val string) {
+	s.Login = val
+}
+
+type GetRecordedStreamLeaderboardOKApplicationJSON []StreamLeaderboardEntry
+
+func (*GetRecordedStreamLeaderboardOKApplicationJSON) getRecordedStreamLeaderboardRes() {}
+
+type GetRecordedStreamTermSeriesBadRequest ErrorMessage
+
+func (*GetRecordedStreamTermSeriesBadRequest) getRecordedStreamTermSeriesRes() {}
+
+type GetRecordedStreamTermSeriesNotFound ErrorMessage
+
+func (*GetRecordedStreamTermSeriesNotFound) getRecordedStreamTermSeriesRes() {}
+
+type GetRecordedStreamTermsBadRequest ErrorMessage
+
+func (*GetRecordedStreamTermsBadRequest) getRecordedStreamTermsRes() {}
+
+type GetRecordedStreamTermsNotFound ErrorMessage
+
+func (*GetRecordedStreamTermsNotFound) getRecordedStreamTermsRes() {}
+
+// GetSystemStatsUnauthorized is response for GetSystemStats operation.
+type GetSystemStatsUnauthorized struct{}
+
+func (*GetSystemStatsUnauthorized) getSystemStatsRes() {}
+
+type GetTwitchUserActivityHeatmapBadRequest ErrorMessage
+
+func (*GetTwitchUserActivityHeatmapBadRequest) getTwitchUserActivityHeatmapRes() {}
+
+type GetTwitchUserActivityHeatmapNotFound ErrorMessage
+
+func (*GetTwitchUserActivityHeatmapNotFound) getTwitchUserActivityHeatmapRes() {}
+
+type GetTwitchUserActivityTimelineOKApplicationJSON []ActivityTimelineSegment
+
+func (*GetTwitchUserActivityTimelineOKApplicationJSON) getTwitchUserActivityTimelineRes() {}
+
+// Ref: #/components/schemas/GetTwitchUserActivityTimelineRequest
+type GetTwitchUserActivityTimelineRequest struct {
+	ID int64 `json:"id"`
+	// Range start (RFC3339). Defaults to 7 days before `to`.
+	From OptDateTime `json:"from"`
+	// Range end (RFC3339). Defaults to now.
+	To OptDateTime `json:"to"`
+}
+
+// GetID returns the value of ID.
+func (s *GetTwitchUserActivityTimelineRequest) GetID() int64 {
+	return s.ID
+}
+
+// GetFrom returns the value of From.
+func (s *GetTwitchUserActivityTimelineRequest) GetFrom() OptDateTime {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *GetTwitchUserActivityTimelineRequest) GetTo() OptDateTime {
+	return s.To
+}
+
+// SetID sets the value of ID.
+func (s *GetTwitchUserActivityTimelineRequest) SetID(val int64) {
+	s.ID = val
+}
+
+// SetFrom sets the value of From.
+func (s *GetTwitchUserActivityTimelineRequest) SetFrom(val OptDateTime) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *GetTwitchUserActivityTimelineRequest) SetTo(val OptDateTime) {
+	s.To = val
+}
+
+type GetTwitchUserEmoteUsageBadRequest ErrorMessage
+
+func (*GetTwitchUserEmoteUsageBadRequest) getTwitchUserEmoteUsageRes() {}
+
+type GetTwitchUserEmoteUsageNotFound ErrorMessage
+
+func (*GetTwitchUserEmoteUsageNotFound) getTwitchUserEmoteUsageRes() {}
+
+type GetTwitchUserEmoteUsageOKApplicationJSON []EmoteUsage
+
+func (*GetTwitchUserEmoteUsageOKApplicationJSON) getTwitchUserEmoteUsageRes() {}
+
+// Ref: #/components/schemas/GetTwitchUserProfileRequest
+type GetTwitchUserProfileRequest struct {
+	ID int64 `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *GetTwitchUserProfileRequest) GetID() int64 {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *GetTwitchUserProfileRequest) SetID(val int64) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/IrcJoinedSample
+type IrcJoinedSample struct {
+	CapturedAt time.Time `json:"captured_at"`
+	// Number of monitored channels joined on IRC at sample time.
+	JoinedCount int `json:"joined_count"`
+}
+
+// GetCapturedAt returns the value of CapturedAt.
+func (s *IrcJoinedSample) GetCapturedAt() time.Time {
+	return s.CapturedAt
+}
+
+// GetJoinedCount returns the value of JoinedCount.
+func (s *IrcJoinedSample) GetJoinedCount() int {
+	return s.JoinedCount
+}
+
+// SetCapturedAt sets the value of CapturedAt.
+func (s *IrcJoinedSample) SetCapturedAt(val time.Time) {
+	s.CapturedAt = val
+}
+
+// SetJoinedCount sets the value of JoinedCount.
+func (s *IrcJoinedSample) SetJoinedCount(val int) {
+	s.JoinedCount = val
+}
+
+// Ref: #/components/schemas/IrcMonitorSettings
+type IrcMonitorSettings struct {
+	// Linked Twitch account id (Helix user id) for authenticated IRC; null uses anonymous read-only IRC
+	// (default).
+	OAuthTwitchAccountID NilInt64 `json:"oauth_twitch_account_id"`
+	// Per-user enrichment cooldown used by worker queue checks; default 24h.
+	EnrichmentCooldownHours int `json:"enrichment_cooldown_hours"`
+}
+
+// GetOAuthTwitchAccountID returns the value of OAuthTwitchAccountID.
+func (s *IrcMonitorSettings) GetOAuthTwitchAccountID() NilInt64 {
+	return s.OAuthTwitchAccountID
+}
+
+// GetEnrichmentCooldownHours returns the value of EnrichmentCooldownHours.
+func (s *IrcMonitorSettings) GetEnrichmentCooldownHours() int {
+	return s.EnrichmentCooldownHours
+}
+
+// SetOAuthTwitchAccountID sets the value of OAuthTwitchAccountID.
+func (s *IrcMonitorSettings) SetOAuthTwitchAccountID(val NilInt64) {
+	s.OAuthTwitchAccountID = val
+}
+
+// SetEnrichmentCooldownHours sets the value of EnrichmentCooldownHours.
+func (s *IrcMonitorSettings) SetEnrichmentCooldownHours(val int) {
+	s.EnrichmentCooldownHours = val
+}
+
+// Ref: #/components/schemas/IrcMonitorStatus
+type IrcMonitorStatus struct {
+	// IRC monitor TCP session is connected.
+	Connected bool                           `json:"connected"`
+	Channels  []IrcMonitorStatusChannelsItem `json:"channels"`
+}
+
+// GetConnected returns the value of Connected.
+func (s *IrcMonitorStatus) GetConnected() bool {
+	return s.Connected
+}
+
+// GetChannels returns the value of Channels.
+func (s *IrcMonitorStatus) GetChannels() []IrcMonitorStatusChannelsItem {
+	return s.Channels
+}
+
+// SetConnected sets the value of Connected.
+func (s *IrcMonitorStatus) SetConnected(val bool) {
+	s.Connected = val
+}
+
+// SetChannels sets the value of Channels.
+func (s *IrcMonitorStatus) SetChannels(val []IrcMonitorStatusChannelsItem) {
+	s.Channels = val
+}
+
+type IrcMonitorStatusChannelsItem struct {
+	Login string `json:"login"`
+	// True when the IRC client's NAMES-backed userlist for this channel is non-empty (session state).
+	IrcOk bool `json:"irc_ok"`
+}
+
+// GetLogin returns the value of Login.
+func (s *IrcMonitorStatusChannelsItem) GetLogin() string {
+	return s.Login
+}
+
+// GetIrcOk returns the value of IrcOk.
+func (s *IrcMonitorStatusChannelsItem) GetIrcOk() bool {
+	return s.IrcOk
+}
+
+// SetLogin sets the value of Login.
+func (s *IrcMonitorStatusChannelsItem) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetIrcOk sets the value of IrcOk.
+func (s *IrcMonitorStatusChannelsItem) SetIrcOk(val bool) {
+	s.IrcOk = val
+}
+
+// Ref: #/components/schemas/LikelyBot
+type LikelyBot struct {
+	TwitchUserID int64  `json:"twitch_user_id"`
+	Username     string `json:"username"`
+	// Channels the user was present in at once when last detected.
+	ConcurrentChannels int `json:"concurrent_channels"`
+	// Presence summed over those channels.
+	PresentSeconds int64 `json:"present_seconds"`
+	// Messages sent since the earliest of those presences.
+	MessageCount    int64     `json:"message_count"`
+	FirstDetectedAt time.Time `json:"first_detected_at"`
+	LastDetectedAt  time.Time `json:"last_detected_at"`
+}
+
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *LikelyBot) GetTwitchUserID() int64 {
+	return s.TwitchUserID
+}
+
+// GetUsername returns the value of Username.
+func (s *LikelyBot) GetUsername() string {
+	return s.Username
+}
+
+// GetConcurrentChannels returns the value of ConcurrentChannels.
+func (s *LikelyBot) GetConcurrentChannels() int {
+	return s.ConcurrentChannels
+}
+
+// GetPresentSeconds returns the value of PresentSeconds.
+func (s *LikelyBot) GetPresentSeconds() int64 {
+	return s.PresentSeconds
+}
+
+// GetMessageCount returns the value of MessageCount.
+func (s *LikelyBot) GetMessageCount() int64 {
+	return s.MessageCount
+}
+
+// GetFirstDetectedAt returns the value of FirstDetectedAt.
+func (s *LikelyBot) GetFirstDetectedAt() time.Time {
+	return s.FirstDetectedAt
+}
+
+// GetLastDetectedAt returns the value of LastDetectedAt.
+func (s *LikelyBot) GetLastDetectedAt() time.Time {
+	return s.LastDetectedAt
+}
+
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *LikelyBot) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *LikelyBot) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetConcurrentChannels sets the value of ConcurrentChannels.
+func (s *LikelyBot) SetConcurrentChannels(val int) {
+	s.ConcurrentChannels = val
+}
+
+// SetPresentSeconds sets the value of PresentSeconds.
+func (s *LikelyBot) SetPresentSeconds(val int64) {
+	s.PresentSeconds = val
+}
+
+// SetMessageCount sets the value of MessageCount.
+func (s *LikelyBot) SetMessageCount(val int64) {
+	s.MessageCount = val
+}
+
+// SetFirstDetectedAt sets the value of FirstDetectedAt.
+func (s *LikelyBot) SetFirstDetectedAt(val time.Time) {
+	s.FirstDetectedAt = val
+}
+
+// SetLastDetectedAt sets the value of LastDetectedAt.
+func (s *LikelyBot) SetLastDetectedAt(val time.Time) {
+	s.LastDetectedAt = val
+}
+
+type ListAiMessagesOKApplicationJSON []AiMessage
+
+func (*ListAiMessagesOKApplicationJSON) listAiMessagesRes() {}
+
+type ListChannelChattersOKApplicationJSON []ChannelChatterEntry
+
+func (*ListChannelChattersOKApplicationJSON) listChannelChattersRes() {}
+
+// Ref: #/components/schemas/ListChannelChattersRequest
+type ListChannelChattersRequest struct {
+	// Twitch OAuth account id (must exist; used for consistency with other channel APIs).
+	AccountID int64 `json:"account_id"`
+	// Channel login.
+	Login string `json:"login"`
+	// When set, message_count is the number of persisted chat lines per chatter since this time (e.g.
+	// current stream started_at).
+	SessionStartedAt OptNilDateTime `json:"session_started_at"`
+}
+
+// GetAccountID returns the value of AccountID.
+func (s *ListChannelChattersRequest) GetAccountID() int64 {
+	return s.AccountID
+}
+
+// GetLogin returns the value of Login.
+func (s *ListChannelChattersRequest) GetLogin() string {
+	return s.Login
+}
+
+// GetSessionStartedAt returns the value of SessionStartedAt.
+func (s *ListChannelChattersRequest) GetSessionStartedAt() OptNilDateTime {
+	return s.SessionStartedAt
+}
+
+// SetAccountID sets the value of AccountID.
+func (s *ListChannelChattersRequest) SetAccountID(val int64) {
+	s.AccountID = val
+}
+
+// SetLogin sets the value of Login.
+func (s *ListChannelChattersRequest) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetSessionStartedAt sets the value of SessionStartedAt.
+func (s *ListChannelChattersRequest) SetSessionStartedAt(val OptNilDateTime) {
+	s.SessionStartedAt = val
+}
+
+type ListChatHistoryOKApplicationJSON []ChatHistoryEntry
+
+func (*ListChatHistoryOKApplicationJSON) listChatHistoryRes() {}
+
+type ListRecordedStreamActivityOKApplicationJSON []UserActivityEvent
+
+func (*ListRecordedStreamActivityOKApplicationJSON) listRecordedStreamActivityRes() {}
+
+type ListRecordedStreamMessagesOKApplicationJSON []ChatHistoryEntry
+
+func (*ListRecordedStreamMessagesOKApplicationJSON) listRecordedStreamMessagesRes() {}
+
+type ListTwitchUserActivityOKApplicationJSON []UserActivityEvent
+
+func (*ListTwitchUserActivityOKApplicationJSON) listTwitchUserActivityRes() {}
+
+// Ref: #/components/schemas/ListTwitchUserActivityRequest
+type ListTwitchUserActivityRequest struct {
+	ID              int64       `json:"id"`
+	Limit           OptInt      `json:"limit"`
+	CursorCreatedAt OptDateTime `json:"cursor_created_at"`
+	CursorID        OptInt64    `json:"cursor_id"`
+}
+
+// GetID returns the value of ID.
+func (s *ListTwitchUserActivityRequest) GetID() int64 {
+	return s.ID
+}
+
+// GetLimit returns the value of Limit.
+func (s *ListTwitchUserActivityRequest) GetLimit() OptInt {
+	return s.Limit
+}
+
+// GetCursorCreatedAt returns the value of CursorCreatedAt.
+func (s *ListTwitchUserActivityRequest) GetCursorCreatedAt() OptDateTime {
+	return s.CursorCreatedAt
+}
+
+// GetCursorID returns the value of CursorID.
+func (s *ListTwitchUserActivityRequest) GetCursorID() OptInt64 {
+	return s.CursorID
+}
+
+// SetID sets the value of ID.
+func (s *ListTwitchUserActivityRequest) SetID(val int64) {
+	s.ID = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *ListTwitchUserActivityRequest) SetLimit(val OptInt) {
+	s.Limit = val
+}
+
+// SetCursorCreatedAt sets the value of CursorCreatedAt.
+func (s *ListTwitchUserActivityRequest) SetCursorCreatedAt(val OptDateTime) {
+	s.CursorCreatedAt = val
+}
+
+// SetCursorID sets the value of CursorID.
+func (s *ListTwitchUserActivityRequest) SetCursorID(val OptInt64) {
+	s.CursorID = val
+}
+
+// Ref: #/components/schemas/LoginPattern
+type LoginPattern struct {
+	ID          int64            `json:"id"`
+	Kind        LoginPatternKind `json:"kind"`
+	Pattern     string           `json:"pattern"`
+	Description string           `json:"description"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *LoginPattern) GetID() int64 {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *LoginPattern) GetKind() LoginPatternKind {
+	return s.Kind
+}
+
+// GetPattern returns the value of Pattern.
+func (s *LoginPattern) GetPattern() string {
+	return s.Pattern
+}
+
+// GetDescription returns the value of Description.
+func (s *LoginPattern) GetDescription() string {
+	return s.Description
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *LoginPattern) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *LoginPattern) SetID(val int64) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *LoginPattern) SetKind(val LoginPatternKind) {
+	s.Kind = val
+}
+
+// SetPattern sets the value of Pattern.
+func (s *LoginPattern) SetPattern(val string) {
+	s.Pattern = val
+}
+
+// SetDescription sets the value of Description.
+func (s *LoginPattern) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *LoginPattern) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*LoginPattern) createLoginPatternRes() {}
+
+// `glob` (`*` and `?`) must match the whole normalized login; `regex` is case-insensitive and
+// matches the raw
+// login or its normalized skeleton. Normalization lowercases, folds look-alike characters (digits,
+// symbols,
+// Cyrillic/Greek homoglyphs, `rn`→`m`) onto letters and drops underscores. Digits written in a
+// glob are kept
+// literal (`user1*` matches `user123`, not `useri5`) and the login is compared both with and without
+// its
+// digits folded.
+// Ref: #/components/schemas/LoginPatternKind
+type LoginPatternKind string
+
+const (
+	LoginPatternKindRegex LoginPatternKind = "regex"
+	LoginPatternKindGlob  LoginPatternKind = "glob"
+)
+
+// AllValues returns all LoginPatternKind values.
+func (LoginPatternKind) AllValues() []LoginPatternKind {
+	return []LoginPatternKind{
+		LoginPatternKindRegex,
+		LoginPatternKindGlob,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LoginPatternKind) MarshalText() ([]byte, error) {
+	switch s {
+	case LoginPatternKindRegex:
+		return []byte(s), nil
+	case LoginPatternKindGlob:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LoginPatternKind) UnmarshalText(data []byte) error {
+	switch LoginPatternKind(data) {
+	case LoginPatternKindRegex:
+		*s = LoginPatternKindRegex
+		return nil
+	case LoginPatternKindGlob:
+		*s = LoginPatternKindGlob
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/LoginPatternTestResult
+type LoginPatternTestResult struct {
+	Login string `json:"login"`
+	// Login with every look-alike folded, digits included (`sh0ut_1` → `shouti`).
+	Skeleton string `json:"skeleton"`
+	// Login folded with digits kept (`sh0ut_1` → `sh0ut1`); globs also match against this form.
+	GlobSkeleton string `json:"glob_skeleton"`
+	Matches      bool   `json:"matches"`
+}
+
+// GetLogin returns the value of Login.
+func (s *LoginPatternTestResult) GetLogin() string {
+	return s.Login
+}
+
+// GetSkeleton returns the value of Skeleton.
+func (s *LoginPatternTestResult) GetSkeleton() string {
+	return s.Skeleton
+}
+
+// GetGlobSkeleton returns the value of GlobSkeleton.
+func (s *LoginPatternTestResult) GetGlobSkeleton() string {
+	return s.GlobSkeleton
+}
+
+// GetMatches returns the value of Matches.
+func (s *LoginPatternTestResult) GetMatches() bool {
+	return s.Matches
+}
+
+// SetLogin sets the value of Login.
+func (s *LoginPatternTestResult) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetSkeleton sets the value of Skeleton.
+func (s *LoginPatternTestResult) SetSkeleton(val string) {
+	s.Skeleton = val
+}
+
+// SetGlobSkeleton sets the value of GlobSkeleton.
+func (s *LoginPatternTestResult) SetGlobSkeleton(val string) {
+	s.GlobSkeleton = val
+}
+
+// SetMatches sets the value of Matches.
+func (s *LoginPatternTestResult) SetMatches(val bool) {
+	s.Matches = val
+}
+
+// Ref: #/components/schemas/LoginRequest
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// GetEmail returns the value of Email.
+func (s *LoginRequest) GetEmail() string {
+	return s.Email
+}
+
+// GetPassword returns the value of Password.
+func (s *LoginRequest) GetPassword() string {
+	return s.Password
+}
+
+// SetEmail sets the value of Email.
+func (s *LoginRequest) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetPassword sets the value of Password.
+func (s *LoginRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// Ref: #/components/schemas/LoginResponse
+type LoginResponse struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns the value of Token.
+func (s *LoginResponse) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *LoginResponse) SetToken(val string) {
+	s.Token = val
+}
+
+func (*LoginResponse) loginRes() {}
+
+// LoginUnauthorized is response for Login operation.
+type LoginUnauthorized struct{}
+
+func (*LoginUnauthorized) loginRes() {}
+
+// MeUnauthorized is response for Me operation.
+type MeUnauthorized struct{}
+
+func (*MeUnauthorized) meRes() {}
+
+// NewNilDateTime returns new NilDateTime with value set to v.
+func NewNilDateTime(v time.Time) NilDateTime {
+	return NilDateTime{
+		Value: v,
+	}
+}
+
+// NilDateTime is nullable time.Time.
+type NilDateTime struct {
+	Value time.Time
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDateTime) SetTo(v time.Time) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilDateTime) SetToNull() {
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilFloat64 returns new NilFloat64 with value set to v.
+func NewNilFloat64(v float64) NilFloat64 {
+	return NilFloat64{
+		Value: v,
+	}
+}
+
+// NilFloat64 is nullable float64.
+type NilFloat64 struct {
+	Value float64
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilFloat64) SetTo(v float64) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilFloat64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilFloat64) SetToNull() {
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilInt64 returns new NilInt64 with value set to v.
+func NewNilInt64(v int64) NilInt64 {
+	return NilInt64{
+		Value: v,
+	}
+}
+
+// NilInt64 is nullable int64.
+type NilInt64 struct {
+	Value int64
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt64) SetTo(v int64) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt64) SetToNull() {
+	o.Null = true
+	var v int64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt64) Get() (v int64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/NotificationDelivery
+type NotificationDelivery struct {
+	ID int64 `json:"id"`
+	// Stable id sent as X-Dredge-Event-Id on every webhook attempt of this delivery.
+	EventID        string `json:"event_id"`
+	NotificationID int64  `json:"notification_id"`
+	// Payload type (keyword_match, rule_text, stream_start, stream_end, flood_summary).
+	EventType string `json:"event_type"`
+	Channel   string `json:"channel"`
+	// Rendered rule template; empty when the provider default line is used.
+	Text           string                     `json:"text"`
+	Status         NotificationDeliveryStatus `json:"status"`
+	Attempts       int                        `json:"attempts"`
+	NextAttemptAt  time.Time                  `json:"next_attempt_at"`
+	LastStatusCode OptNilInt                  `json:"last_status_code"`
+	LastError      OptNilString               `json:"last_error"`
+	DeliveredAt    OptNilDateTime             `json:"delivered_at"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	UpdatedAt      time.Time                  `json:"updated_at"`
+	// Provider calls, newest first.
+	AttemptLog []NotificationDeliveryAttempt `json:"attempt_log"`
+}
+
+// GetID returns the value of ID.
+func (s *NotificationDelivery) GetID() int64 {
+	return s.ID
+}
+
+// GetEventID returns the value of EventID.
+func (s *NotificationDelivery) GetEventID() string {
+	return s.EventID
+}
+
+// GetNotificationID returns the value of NotificationID.
+func (s *NotificationDelivery) GetNotificationID() int64 {
+	return s.NotificationID
+}
+
+// GetEventType returns the value of EventType.
+func (s *NotificationDelivery) GetEventType() string {
+	return s.EventType
+}
+
+// GetChannel returns the value of Channel.
+func (s *NotificationDelivery) GetChannel() string {
+	return s.Channel
+}
+
+// GetText returns the value of Text.
+func (s *NotificationDelivery) GetText() string {
+	return s.Text
+}
+
+// GetStatus returns the value of Status.
+func (s *NotificationDelivery) GetStatus() NotificationDeliveryStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *NotificationDelivery) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetNextAttemptAt returns the value of NextAttemptAt.
+func (s *NotificationDelivery) GetNextAttemptAt() time.Time {
+	return s.NextAttemptAt
+}
+
+// GetLastStatusCode returns the value of LastStatusCode.
+func (s *NotificationDelivery) GetLastStatusCode() OptNilInt {
+	return s.LastStatusCode
+}
+
+// GetLastError returns the value of LastError.
+func (s *NotificationDelivery) GetLastError() OptNilString {
+	return s.LastError
+}
+
+// GetDeliveredAt returns the value of DeliveredAt.
+func (s *NotificationDelivery) GetDeliveredAt() OptNilDateTime {
+	return s.DeliveredAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *NotificationDelivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *NotificationDelivery) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// GetAttemptLog returns the value of AttemptLog.
+func (s *NotificationDelivery) GetAttemptLog() []NotificationDeliveryAttempt {
+	return s.AttemptLog
+}
+
+// SetID sets the value of ID.
+func (s *NotificationDelivery) SetID(val int64) {
+	s.ID = val
+}
+
+// SetEventID sets the value of EventID.
+func (s *NotificationDelivery) SetEventID(val string) {
+	s.EventID = val
+}
+
+// SetNotificationID sets the value of NotificationID.
+func (s *NotificationDelivery) SetNotificationID(val int64) {
+	s.NotificationID = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *NotificationDelivery) SetEventType(val string) {
+	s.EventType = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *NotificationDelivery) SetChannel(val string) {
+	s.Channel = val
+}
+
+// SetText sets the value of Text.
+func (s *NotificationDelivery) SetText(val string) {
+	s.Text = val
+}
+
+// SetStatus sets the value of Status.
+func (s *NotificationDelivery) SetStatus(val NotificationDeliveryStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *NotificationDelivery) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetNextAttemptAt sets the value of NextAttemptAt.
+func (s *NotificationDelivery) SetNextAttemptAt(val time.Time) {
+	s.NextAttemptAt = val
+}
+
+// SetLastStatusCode sets the value of LastStatusCode.
+func (s *NotificationDelivery) SetLastStatusCode(val OptNilInt) {
+	s.LastStatusCode = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *NotificationDelivery) SetLastError(val OptNilString) {
+	s.LastError = val
+}
+
+// SetDeliveredAt sets the value of DeliveredAt.
+func (s *NotificationDelivery) SetDeliveredAt(val OptNilDateTime) {
+	s.DeliveredAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *NotificationDelivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *NotificationDelivery) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// SetAttemptLog sets the value of AttemptLog.
+func (s *NotificationDelivery) SetAttemptLog(val []NotificationDeliveryAttempt) {
+	s.AttemptLog = val
+}
+
+func (*NotificationDelivery) resendNotificationDeliveryRes() {}
+
+// Ref: #/components/schemas/NotificationDeliveryAttempt
+type NotificationDeliveryAttempt struct {
+	ID          int64     `json:"id"`
+	AttemptedAt time.Time `json:"attempted_at"`
+	StatusCode  OptNilInt `json:"status_code"`
+	// First 512 bytes of the provider response body.
+	ResponseSnippet string `json:"response_snippet"`
+	Error           string `json:"error"`
+	DurationMs      int64  `json:"duration_ms"`
+}
+
+// GetID returns the value of ID.
+func (s *NotificationDeliveryAttempt) GetID() int64 {
+	return s.ID
+}
+
+// GetAttemptedAt returns the value of AttemptedAt.
+func (s *NotificationDeliveryAttempt) GetAttemptedAt() time.Time {
+	return s.AttemptedAt
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *NotificationDeliveryAttempt) GetStatusCode() OptNilInt {
+	return s.StatusCode
+}
+
+// GetResponseSnippet returns the value of ResponseSnippet.
+func (s *NotificationDeliveryAttempt) GetResponseSnippet() string {
+	return s.ResponseSnippet
+}
+
+// GetError returns the value of Error.
+func (s *NotificationDeliveryAttempt) GetError() string {
+	return s.Error
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *NotificationDeliveryAttempt) GetDurationMs() int64 {
+	return s.DurationMs
+}
+
+// SetID sets the value of ID.
+func (s *NotificationDeliveryAttempt) SetID(val int64) {
+	s.ID = val
+}
+
+// SetAttemptedAt sets the value of AttemptedAt.
+func (s *NotificationDeliveryAttempt) SetAttemptedAt(val time.Time) {
+	s.AttemptedAt = val
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *NotificationDeliveryAttempt) SetStatusCode(val OptNilInt) {
+	s.StatusCode = val
+}
+
+// SetResponseSnippet sets the value of ResponseSnippet.
+func (s *NotificationDeliveryAttempt) SetResponseSnippet(val string) {
+	s.ResponseSnippet = val
+}
+
+// SetError sets the value of Error.
+func (s *NotificationDeliveryAttempt) SetError(val string) {
+	s.Error = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *NotificationDeliveryAttempt) SetDurationMs(val int64) {
+	s.DurationMs = val
+}
+
+// Pending (queued or waiting for retry), sending (claimed by a worker), delivered, dead (gave up),
+// suppressed (not sent because of quiet hours, a snooze or flood control; last_error holds the
+// reason).
+// Ref: #/components/schemas/NotificationDeliveryStatus
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryStatusPending    NotificationDeliveryStatus = "pending"
+	NotificationDeliveryStatusSending    NotificationDeliveryStatus = "sending"
+	NotificationDeliveryStatusDelivered  NotificationDeliveryStatus = "delivered"
+	NotificationDeliveryStatusDead       NotificationDeliveryStatus = "dead"
+	NotificationDeliveryStatusSuppressed NotificationDeliveryStatus = "suppressed"
+)
+
+// AllValues returns all NotificationDeliveryStatus values.
+func (NotificationDeliveryStatus) AllValues() []NotificationDeliveryStatus {
+	return []NotificationDeliveryStatus{
+		NotificationDeliveryStatusPending,
+		NotificationDeliveryStatusSending,
+		NotificationDeliveryStatusDelivered,
+		NotificationDeliveryStatusDead,
+		NotificationDeliveryStatusSuppressed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NotificationDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case NotificationDeliveryStatusPending:
+		return []byte(s), nil
+	case NotificationDeliveryStatusSending:
+		return []byte(s), nil
+	case NotificationDeliveryStatusDelivered:
+		return []byte(s), nil
+	case NotificationDeliveryStatusDead:
+		return []byte(s), nil
+	case NotificationDeliveryStatusSuppressed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NotificationDeliveryStatus) UnmarshalText(data []byte) error {
+	switch NotificationDeliveryStatus(data) {
+	case NotificationDeliveryStatusPending:
+		*s = NotificationDeliveryStatusPending
+		return nil
+	case NotificationDeliveryStatusSending:
+		*s = NotificationDeliveryStatusSending
+		return nil
+	case NotificationDeliveryStatusDelivered:
+		*s = NotificationDeliveryStatusDelivered
+		return nil
+	case NotificationDeliveryStatusDead:
+		*s = NotificationDeliveryStatusDead
+		return nil
+	case NotificationDeliveryStatusSuppressed:
+		*s = NotificationDeliveryStatusSuppressed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/NotificationEntry
+type NotificationEntry struct {
+	ID       int64                     `json:"id"`
+	Provider NotificationEntryProvider `json:"provider"`
+	// Provider settings. telegram: bot_token, chat_id; optional interactive (bool) with allowed_user_ids
+	// (list of Telegram user ids, required when interactive) runs a long-polling bot for the token that
+	// answers /live, /where <user>, /mute <channel|all> <duration> and /user <login>, and adds "mark
+	// sus",
+	// "mark", "blacklist channel" and "open in UI" buttons to alerts; only allowlisted users may use
+	// them.
+	// webhook: url; optional headers (object), method (POST, PUT or PATCH; default POST),
+	// secret (adds X-Dredge-Signature: sha256=<hex HMAC-SHA256 of "<X-Dredge-Timestamp>.<body>">),
+	// body_template with $EVENT_ID, $EVENT_TYPE, $CHANNEL, $USERNAME, $MESSAGE, $TITLE, $TEXT and
+	// $TIMESTAMP
+	// placeholders (values are escaped for JSON and form content types), and content_type (default
+	// application/json).
+	// Every webhook request carries X-Dredge-Event-Id, stable across retries of the same delivery.
+	// discord: webhook_url; optional username, avatar_url and color (embed color as an integer).
+	// matrix: access_token, room_id; homeserver_url unless notifications.matrix_homeserver_url is
+	// configured.
+	// ntfy: topic; optional server_url (default notifications.ntfy_base_url or https://ntfy.sh), token,
+	// priority (1-5) and tags (list of strings).
+	// email: host, from, to (list of addresses); optional port (default 587, or 465 with tls), tls
+	// (starttls
+	// (default), tls or none), username, password, subject_prefix (default "[dredge]") and digest_minutes
+	// (1-1440; batches the entry's events into one email per window).
+	Settings  NotificationEntrySettings `json:"settings"`
+	Enabled   bool                      `json:"enabled"`
+	CreatedAt time.Time                 `json:"created_at"`
+	// Labels that rules can target via action_settings.notification_tags.
+	Tags []string `json:"tags"`
+	// Default event filter (chat_message, stream_start, stream_end, interval); empty accepts every event.
+	// Only applies when the notify action names no notification_ids or notification_tags.
+	EventTypes []string `json:"event_types"`
+	// Default channel login filter; empty accepts every channel. Same precedence as event_types.
+	Channels []string           `json:"channels"`
+	Policy   NotificationPolicy `json:"policy"`
+}
+
+// GetID returns the value of ID.
+func (s *NotificationEntry) GetID() int64 {
+	return s.ID
+}
+
+// GetProvider returns the value of Provider.
+func (s *NotificationEntry) GetProvider() NotificationEntryProvider {
+	return s.Provider
+}
+
+// GetSettings returns the value of Settings.
+func (s *NotificationEntry) GetSettings() NotificationEntrySettings {
+	return s.Settings
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *NotificationEntry) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *NotificationEntry) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetTags returns the value of Tags.
+func (s *NotificationEntry) GetTags() []string {
+	return s.Tags
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *NotificationEntry) GetEventTypes() []string {
+	return s.EventTypes
+}
+
+// GetChannels returns the value of Channels.
+func (s *NotificationEntry) GetChannels() []string {
+	return s.Channels
+}
+
+// GetPolicy returns the value of Policy.
+func (s *NotificationEntry) GetPolicy() NotificationPolicy {
+	return s.Policy
+}
+
+// SetID sets the value of ID.
+func (s *NotificationEntry) SetID(val int64) {
+	s.ID = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *NotificationEntry) SetProvider(val NotificationEntryProvider) {
+	s.Provider = val
+}
+
+// SetSettings sets the value of Settings.
+func (s *NotificationEntry) SetSettings(val NotificationEntrySettings) {
+	s.Settings = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *NotificationEntry) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *NotificationEntry) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetTags sets the value of Tags.
+func (s *NotificationEntry) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *NotificationEntry) SetEventTypes(val []string) {
+	s.EventTypes = val
+}
+
+// SetChannels sets the value of Channels.
+func (s *NotificationEntry) SetChannels(val []string) {
+	s.Channels = val
+}
+
+// SetPolicy sets the value of Policy.
+func (s *NotificationEntry) SetPolicy(val NotificationPolicy) {
+	s.Policy = val
+}
+
+func (*NotificationEntry) updateNotificationRes() {}
+
+type NotificationEntryProvider string
+
+const (
+	NotificationEntryProviderTelegram NotificationEntryProvider = "telegram"
+	NotificationEntryProviderWebhook  NotificationEntryProvider = "webhook"
+	NotificationEntryProviderDiscord  NotificationEntryProvider = "discord"
+	NotificationEntryProviderMatrix   NotificationEntryProvider = "matrix"
+	NotificationEntryProviderNtfy     NotificationEntryProvider = "ntfy"
+	NotificationEntryProviderEmail    NotificationEntryProvider = "email"
+)
+
+// AllValues returns all NotificationEntryProvider values.
+func (NotificationEntryProvider) AllValues() []NotificationEntryProvider {
+	return []NotificationEntryProvider{
+		NotificationEntryProviderTelegram,
+		NotificationEntryProviderWebhook,
+		NotificationEntryProviderDiscord,
+		NotificationEntryProviderMatrix,
+		NotificationEntryProviderNtfy,
+		NotificationEntryProviderEmail,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NotificationEntryProvider) MarshalText() ([]byte, error) {
+	switch s {
+	case NotificationEntryProviderTelegram:
+		return []byte(s), nil
+	case NotificationEntryProviderWebhook:
+		return []byte(s), nil
+	case NotificationEntryProviderDiscord:
+		return []byte(s), nil
+	case NotificationEntryProviderMatrix:
+		return []byte(s), nil
+	case NotificationEntryProviderNtfy:
+		return []byte(s), nil
+	case NotificationEntryProviderEmail:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NotificationEntryProvider) UnmarshalText(data []byte) error {
+	switch NotificationEntryProvider(data) {
+	case NotificationEntryProviderTelegram:
+		*s = NotificationEntryProviderTelegram
+		return nil
+	case NotificationEntryProviderWebhook:
+		*s = NotificationEntryProviderWebhook
+		return nil
+	case NotificationEntryProviderDiscord:
+		*s = NotificationEntryProviderDiscord
+		return nil
+	case NotificationEntryProviderMatrix:
+		*s = NotificationEntryProviderMatrix
+		return nil
+	case NotificationEntryProviderNtfy:
+		*s = NotificationEntryProviderNtfy
+		return nil
+	case NotificationEntryProviderEmail:
+		*s = NotificationEntryProviderEmail
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Provider settings. telegram: bot_token, chat_id; optional interactive (bool) with allowed_user_ids
+// (list of Telegram user ids, required when interactive) runs a long-polling bot for the token that
+// answers /live, /where <user>, /mute <channel|all> <duration> and /user <login>, and adds "mark
+// sus",
+// "mark", "blacklist channel" and "open in UI" buttons to alerts; only allowlisted users may use
+// them.
+// webhook: url; optional headers (object), method (POST, PUT or PATCH; default POST),
+// secret (adds X-Dredge-Signature: sha256=<hex HMAC-SHA256 of "<X-Dredge-Timestamp>.<body>">),
+// body_template with $EVENT_ID, $EVENT_TYPE, $CHANNEL, $USERNAME, $MESSAGE, $TITLE, $TEXT and
+// $TIMESTAMP
+// placeholders (values are escaped for JSON and form content types), and content_type (default
+// application/json).
+// Every webhook request carries X-Dredge-Event-Id, stable across retries of the same delivery.
+// discord: webhook_url; optional username, avatar_url and color (embed color as an integer).
+// matrix: access_token, room_id; homeserver_url unless notifications.matrix_homeserver_url is
+// configured.
+// ntfy: topic; optional server_url (default notifications.ntfy_base_url or https://ntfy.sh), token,
+// priority (1-5) and tags (list of strings).
+// email: host, from, to (list of addresses); optional port (default 587, or 465 with tls), tls
+// (starttls
+// (default), tls or none), username, password, subject_prefix (default "[dredge]") and digest_minutes
+// (1-1440; batches the entry's events into one email per window).
+type NotificationEntrySettings map[string]jx.Raw
+
+func (s *NotificationEntrySettings) init() NotificationEntrySettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Quiet hours and flood control. Events held back by either (or by a snooze) are recorded in the
+// delivery
+// log with status suppressed. On update, a present policy replaces the stored one.
+// Ref: #/components/schemas/NotificationPolicy
+type NotificationPolicy struct {
+	QuietHours OptNilNotificationQuietHours `json:"quiet_hours"`
+	// Events per channel sent within flood_window_seconds; later ones are suppressed and reported in one
+	// "N more matches in #channel" message when the window ends. 0 disables flood control.
+	FloodThreshold OptInt `json:"flood_threshold"`
+	// Flood control window; defaults to 60 when flood_threshold is set.
+	FloodWindowSeconds OptInt `json:"flood_window_seconds"`
+}
+
+// GetQuietHours returns the value of QuietHours.
+func (s *NotificationPolicy) GetQuietHours() OptNilNotificationQuietHours {
+	return s.QuietHours
+}
+
+// GetFloodThreshold returns the value of FloodThreshold.
+func (s *NotificationPolicy) GetFloodThreshold() OptInt {
+	return s.FloodThreshold
+}
+
+// GetFloodWindowSeconds returns the value of FloodWindowSeconds.
+func (s *NotificationPolicy) GetFloodWindowSeconds() OptInt {
+	return s.FloodWindowSeconds
+}
+
+// SetQuietHours sets the value of QuietHours.
+func (s *NotificationPolicy) SetQuietHours(val OptNilNotificationQuietHours) {
+	s.QuietHours = val
+}
+
+// SetFloodThreshold sets the value of FloodThreshold.
+func (s *NotificationPolicy) SetFloodThreshold(val OptInt) {
+	s.FloodThreshold = val
+}
+
+// SetFloodWindowSeconds sets the value of FloodWindowSeconds.
+func (s *NotificationPolicy) SetFloodWindowSeconds(val OptInt) {
+	s.FloodWindowSeconds = val
+}
+
+// Daily window without deliveries; when end is not after start the window wraps past midnight.
+// Ref: #/components/schemas/NotificationQuietHours
+type NotificationQuietHours struct {
+	// Local time HH:MM.
+	Start string `json:"start"`
+	// Local time HH:MM.
+	End string `json:"end"`
+	// IANA time zone name (default UTC).
+	Timezone OptString `json:"timezone"`
+}
+
+// GetStart returns the value of Start.
+func (s *NotificationQuietHours) GetStart() string {
+	return s.Start
+}
+
+// GetEnd returns the value of End.
+func (s *NotificationQuietHours) GetEnd() string {
+	return s.End
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *NotificationQuietHours) GetTimezone() OptString {
+	return s.Timezone
+}
+
+// SetStart sets the value of Start.
+func (s *NotificationQuietHours) SetStart(val string) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *NotificationQuietHours) SetEnd(val string) {
+	s.End = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *NotificationQuietHours) SetTimezone(val OptString) {
+	s.Timezone = val
+}
+
+// Ref: #/components/schemas/NotificationSnooze
+type NotificationSnooze struct {
+	ID int64 `json:"id"`
+	// Muted entry; null mutes every entry.
+	NotificationID OptNilInt64 `json:"notification_id"`
+	// Muted channel login; empty mutes every channel.
+	Channel   string    `json:"channel"`
+	Until     time.Time `json:"until"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *NotificationSnooze) GetID() int64 {
+	return s.ID
+}
+
+// GetNotificationID returns the value of NotificationID.
+func (s *NotificationSnooze) GetNotificationID() OptNilInt64 {
+	return s.NotificationID
+}
+
+// GetChannel returns the value of Channel.
+func (s *NotificationSnooze) GetChannel() string {
+	return s.Channel
+}
+
+// GetUntil returns the value of Until.
+func (s *NotificationSnooze) GetUntil() time.Time {
+	return s.Until
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *NotificationSnooze) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *NotificationSnooze) SetID(val int64) {
+	s.ID = val
+}
+
+// SetNotificationID sets the value of NotificationID.
+func (s *NotificationSnooze) SetNotificationID(val OptNilInt64) {
+	s.NotificationID = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *NotificationSnooze) SetChannel(val string) {
+	s.Channel = val
+}
+
+// SetUntil sets the value of Until.
+func (s *NotificationSnooze) SetUntil(val time.Time) {
+	s.Until = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *NotificationSnooze) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*NotificationSnooze) createNotificationSnoozeRes() {}
+
+// Ref: #/components/schemas/NotificationTestResult
+type NotificationTestResult struct {
+	EventType string `json:"event_type"`
+	// Rendered alert line that was sent.
+	Text      string `json:"text"`
+	Delivered bool   `json:"delivered"`
+	// Provider HTTP status; null when the request failed before a response (or for SMTP).
+	StatusCode OptNilInt `json:"status_code"`
+	// Start of the provider response body, secrets redacted.
+	ResponseSnippet string `json:"response_snippet"`
+	// Empty when delivered; secrets redacted.
+	Error      string `json:"error"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// GetEventType returns the value of EventType.
+func (s *NotificationTestResult) GetEventType() string {
+	return s.EventType
+}
+
+// GetText returns the value of Text.
+func (s *NotificationTestResult) GetText() string {
+	return s.Text
+}
+
+// GetDelivered returns the value of Delivered.
+func (s *NotificationTestResult) GetDelivered() bool {
+	return s.Delivered
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *NotificationTestResult) GetStatusCode() OptNilInt {
+	return s.StatusCode
+}
+
+// GetResponseSnippet returns the value of ResponseSnippet.
+func (s *NotificationTestResult) GetResponseSnippet() string {
+	return s.ResponseSnippet
+}
+
+// GetError returns the value of Error.
+func (s *NotificationTestResult) GetError() string {
+	return s.Error
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *NotificationTestResult) GetDurationMs() int64 {
+	return s.DurationMs
+}
+
+// SetEventType sets the value of EventType.
+func (s *NotificationTestResult) SetEventType(val string) {
+	s.EventType = val
+}
+
+// SetText sets the value of Text.
+func (s *NotificationTestResult) SetText(val string) {
+	s.Text = val
+}
+
+// SetDelivered sets the value of Delivered.
+func (s *NotificationTestResult) SetDelivered(val bool) {
+	s.Delivered = val
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *NotificationTestResult) SetStatusCode(val OptNilInt) {
+	s.StatusCode = val
+}
+
+// SetResponseSnippet sets the value of ResponseSnippet.
+func (s *NotificationTestResult) SetResponseSnippet(val string) {
+	s.ResponseSnippet = val
+}
+
+// SetError sets the value of Error.
+func (s *NotificationTestResult) SetError(val string) {
+	s.Error = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *NotificationTestResult) SetDurationMs(val int64) {
+	s.DurationMs = val
+}
+
+// NewOptAudienceOverlapPeriod returns new OptAudienceOverlapPeriod with value set to v.
+func NewOptAudienceOverlapPeriod(v AudienceOverlapPeriod) OptAudienceOverlapPeriod {
+	return OptAudienceOverlapPeriod{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAudienceOverlapPeriod is optional AudienceOverlapPeriod.
+type OptAudienceOverlapPeriod struct {
+	Value AudienceOverlapPeriod
+	Set   bool
+}
+
+// IsSet returns true if OptAudienceOverlapPeriod was set.
+func (o OptAudienceOverlapPeriod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAudienceOverlapPeriod) Reset() {
+	var v AudienceOverlapPeriod
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAudienceOverlapPeriod) SetTo(v AudienceOverlapPeriod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAudienceOverlapPeriod) Get() (v AudienceOverlapPeriod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAudienceOverlapPeriod) Or(d AudienceOverlapPeriod) AudienceOverlapPeriod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptChannelAnalyticsBucket returns new OptChannelAnalyticsBucket with value set to v.
+func NewOptChannelAnalyticsBucket(v ChannelAnalyticsBucket) OptChannelAnalyticsBucket {
+	return OptChannelAnalyticsBucket{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptChannelAnalyticsBucket is optional ChannelAnalyticsBucket.
+type OptChannelAnalyticsBucket struct {
+	Value ChannelAnalyticsBucket
+	Set   bool
+}
+
+// IsSet returns true if OptChannelAnalyticsBucket was set.
+func (o OptChannelAnalyticsBucket) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptChannelAnalyticsBucket) Reset() {
+	var v ChannelAnalyticsBucket
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptChannelAnalyticsBucket) SetTo(v ChannelAnalyticsBucket) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptChannelAnalyticsBucket) Get() (v ChannelAnalyticsBucket, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptChannelAnalyticsBucket) Or(d ChannelAnalyticsBucket) ChannelAnalyticsBucket {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptChatToxicity returns new OptChatToxicity with value set to v.
+func NewOptChatToxicity(v ChatToxicity) OptChatToxicity {
+	return OptChatToxicity{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptChatToxicity is optional ChatToxicity.
+type OptChatToxicity struct {
+	Value ChatToxicity
+	Set   bool
+}
+
+// IsSet returns true if OptChatToxicity was set.
+func (o OptChatToxicity) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptChatToxicity) Reset() {
+	var v ChatToxicity
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptChatToxicity) SetTo(v ChatToxicity) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptChatToxicity) Get() (v ChatToxicity, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptChatToxicity) Or(d ChatToxicity) ChatToxicity {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptClientNoticeDetails returns new OptClientNoticeDetails with value set to v.
+func NewOptClientNoticeDetails(v ClientNoticeDetails) OptClientNoticeDetails {
+	return OptClientNoticeDetails{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptClientNoticeDetails is optional ClientNoticeDetails.
+type OptClientNoticeDetails struct {
+	Value ClientNoticeDetails
+	Set   bool
+}
+
+// IsSet returns true if OptClientNoticeDetails was set.
+func (o OptClientNoticeDetails) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptClientNoticeDetails) Reset() {
+	var v ClientNoticeDetails
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptClientNoticeDetails) SetTo(v ClientNoticeDetails) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptClientNoticeDetails) Get() (v ClientNoticeDetails, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptClientNoticeDetails) Or(d ClientNoticeDetails) ClientNoticeDetails {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateAiConversationRequest returns new OptCreateAiConversationRequest with value set to v.
+func NewOptCreateAiConversationRequest(v CreateAiConversationRequest) OptCreateAiConversationRequest {
+	return OptCreateAiConversationRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateAiConversationRequest is optional CreateAiConversationRequest.
+type OptCreateAiConversationRequest struct {
+	Value CreateAiConversationRequest
+	Set   bool
+}
+
+// IsSet returns true if OptCreateAiConversationRequest was set.
+func (o OptCreateAiConversationRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateAiConversationRequest) Reset() {
+	var v CreateAiConversationRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateAiConversationRequest) SetTo(v CreateAiConversationRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateAiConversationRequest) Get() (v CreateAiConversationRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateAiConversationRequest) Or(d CreateAiConversationRequest) CreateAiConversationRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateTwitchAccountRequestAccountType returns new OptCreateTwitchAccountRequestAccountType with value set to v.
+func NewOptCreateTwitchAccountRequestAccountType(v CreateTwitchAccountRequestAccountType) OptCreateTwitchAccountRequestAccountType {
+	return OptCreateTwitchAccountRequestAccountType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateTwitchAccountRequestAccountType is optional CreateTwitchAccountRequestAccountType.
+type OptCreateTwitchAccountRequestAccountType struct {
+	Value CreateTwitchAccountRequestAccountType
+	Set   bool
+}
+
+// IsSet returns true if OptCreateTwitchAccountRequestAccountType was set.
+func (o OptCreateTwitchAccountRequestAccountType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateTwitchAccountRequestAccountType) Reset() {
+	var v CreateTwitchAccountRequestAccountType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateTwitchAccountRequestAccountType) SetTo(v CreateTwitchAccountRequestAccountType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateTwitchAccountRequestAccountType) Get() (v CreateTwitchAccountRequestAccountType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateTwitchAccountRequestAccountType) Or(d CreateTwitchAccountRequestAccountType) CreateTwitchAccountRequestAccountType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilChannelLive returns new OptNilChannelLive with value set to v.
+func NewOptNilChannelLive(v ChannelLive) OptNilChannelLive {
+	return OptNilChannelLive{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilChannelLive is optional nullable ChannelLive.
+type OptNilChannelLive struct {
+	Value ChannelLive
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilChannelLive was set.
+func (o OptNilChannelLive) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilChannelLive) Reset() {
+	var v ChannelLive
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilChannelLive) SetTo(v ChannelLive) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilChannelLive) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilChannelLive) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v ChannelLive
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilChannelLive) Get() (v ChannelLive, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilChannelLive) Or(d ChannelLive) ChannelLive {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt64 returns new OptNilInt64 with value set to v.
+func NewOptNilInt64(v int64) OptNilInt64 {
+	return OptNilInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt64 is optional nullable int64.
+type OptNilInt64 struct {
+	Value int64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt64 was set.
+func (o OptNilInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt64) SetTo(v int64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt64) Get() (v int64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNotificationQuietHours returns new OptNilNotificationQuietHours with value set to v.
+func NewOptNilNotificationQuietHours(v NotificationQuietHours) OptNilNotificationQuietHours {
+	return OptNilNotificationQuietHours{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNotificationQuietHours is optional nullable NotificationQuietHours.
+type OptNilNotificationQuietHours struct {
+	Value NotificationQuietHours
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNotificationQuietHours was set.
+func (o OptNilNotificationQuietHours) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNotificationQuietHours) Reset() {
+	var v NotificationQuietHours
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNotificationQuietHours) SetTo(v NotificationQuietHours) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilNotificationQuietHours) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilNotificationQuietHours) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v NotificationQuietHours
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNotificationQuietHours) Get() (v NotificationQuietHours, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNotificationQuietHours) Or(d NotificationQuietHours) NotificationQuietHours {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilSuspicionScore returns new OptNilSuspicionScore with value set to v.
+func NewOptNilSuspicionScore(v SuspicionScore) OptNilSuspicionScore {
+	return OptNilSuspicionScore{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilSuspicionScore is optional nullable SuspicionScore.
+type OptNilSuspicionScore struct {
+	Value SuspicionScore
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilSuspicionScore was set.
+func (o OptNilSuspicionScore) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilSuspicionScore) Reset() {
+	var v SuspicionScore
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilSuspicionScore) SetTo(v SuspicionScore) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilSuspicionScore) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilSuspicionScore) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v SuspicionScore
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilSuspicionScore) Get() (v SuspicionScore, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilSuspicionScore) Or(d SuspicionScore) SuspicionScore {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUserActivityEventDetails returns new OptNilUserActivityEventDetails with value set to v.
+func NewOptNilUserActivityEventDetails(v UserActivityEventDetails) OptNilUserActivityEventDetails {
+	return OptNilUserActivityEventDetails{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUserActivityEventDetails is optional nullable UserActivityEventDetails.
+type OptNilUserActivityEventDetails struct {
+	Value UserActivityEventDetails
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUserActivityEventDetails was set.
+func (o OptNilUserActivityEventDetails) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUserActivityEventDetails) Reset() {
+	var v UserActivityEventDetails
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUserActivityEventDetails) SetTo(v UserActivityEventDetails) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUserActivityEventDetails) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUserActivityEventDetails) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v UserActivityEventDetails
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUserActivityEventDetails) Get() (v UserActivityEventDetails, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUserActivityEventDetails) Or(d UserActivityEventDetails) UserActivityEventDetails {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNotificationDeliveryStatus returns new OptNotificationDeliveryStatus with value set to v.
+func NewOptNotificationDeliveryStatus(v NotificationDeliveryStatus) OptNotificationDeliveryStatus {
+	return OptNotificationDeliveryStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNotificationDeliveryStatus is optional NotificationDeliveryStatus.
+type OptNotificationDeliveryStatus struct {
+	Value NotificationDeliveryStatus
+	Set   bool
+}
+
+// IsSet returns true if OptNotificationDeliveryStatus was set.
+func (o OptNotificationDeliveryStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNotificationDeliveryStatus) Reset() {
+	var v NotificationDeliveryStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNotificationDeliveryStatus) SetTo(v NotificationDeliveryStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNotificationDeliveryStatus) Get() (v NotificationDeliveryStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNotificationDeliveryStatus) Or(d NotificationDeliveryStatus) NotificationDeliveryStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNotificationPolicy returns new OptNotificationPolicy with value set to v.
+func NewOptNotificationPolicy(v NotificationPolicy) OptNotificationPolicy {
+	return OptNotificationPolicy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNotificationPolicy is optional NotificationPolicy.
+type OptNotificationPolicy struct {
+	Value NotificationPolicy
+	Set   bool
+}
+
+// IsSet returns true if OptNotificationPolicy was set.
+func (o OptNotificationPolicy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNotificationPolicy) Reset() {
+	var v NotificationPolicy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNotificationPolicy) SetTo(v NotificationPolicy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNotificationPolicy) Get() (v NotificationPolicy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNotificationPolicy) Or(d NotificationPolicy) NotificationPolicy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStartSuspicionReevaluationRequest returns new OptStartSuspicionReevaluationRequest with value set to v.
+func NewOptStartSuspicionReevaluationRequest(v StartSuspicionReevaluationRequest) OptStartSuspicionReevaluationRequest {
+	return OptStartSuspicionReevaluationRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStartSuspicionReevaluationRequest is optional StartSuspicionReevaluationRequest.
+type OptStartSuspicionReevaluationRequest struct {
+	Value StartSuspicionReevaluationRequest
+	Set   bool
+}
+
+// IsSet returns true if OptStartSuspicionReevaluationRequest was set.
+func (o OptStartSuspicionReevaluationRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStartSuspicionReevaluationRequest) Reset() {
+	var v StartSuspicionReevaluationRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStartSuspicionReevaluationRequest) SetTo(v StartSuspicionReevaluationRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStartSuspicionReevaluationRequest) Get() (v StartSuspicionReevaluationRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStartSuspicionReevaluationRequest) Or(d StartSuspicionReevaluationRequest) StartSuspicionReevaluationRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStartTwitchOAuthRequest returns new OptStartTwitchOAuthRequest with value set to v.
+func NewOptStartTwitchOAuthRequest(v StartTwitchOAuthRequest) OptStartTwitchOAuthRequest {
+	return OptStartTwitchOAuthRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStartTwitchOAuthRequest is optional StartTwitchOAuthRequest.
+type OptStartTwitchOAuthRequest struct {
+	Value StartTwitchOAuthRequest
+	Set   bool
+}
+
+// IsSet returns true if OptStartTwitchOAuthRequest was set.
+func (o OptStartTwitchOAuthRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStartTwitchOAuthRequest) Reset() {
+	var v StartTwitchOAuthRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStartTwitchOAuthRequest) SetTo(v StartTwitchOAuthRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStartTwitchOAuthRequest) Get() (v StartTwitchOAuthRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStartTwitchOAuthRequest) Or(d StartTwitchOAuthRequest) StartTwitchOAuthRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStreamLeaderboardSort returns new OptStreamLeaderboardSort with value set to v.
+func NewOptStreamLeaderboardSort(v StreamLeaderboardSort) OptStreamLeaderboardSort {
+	return OptStreamLeaderboardSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStreamLeaderboardSort is optional StreamLeaderboardSort.
+type OptStreamLeaderboardSort struct {
+	Value StreamLeaderboardSort
+	Set   bool
+}
+
+// IsSet returns true if OptStreamLeaderboardSort was set.
+func (o OptStreamLeaderboardSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStreamLeaderboardSort) Reset() {
+	var v StreamLeaderboardSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStreamLeaderboardSort) SetTo(v StreamLeaderboardSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStreamLeaderboardSort) Get() (v StreamLeaderboardSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStreamLeaderboardSort) Or(d StreamLeaderboardSort) StreamLeaderboardSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStreamRecap returns new OptStreamRecap with value set to v.
+func NewOptStreamRecap(v StreamRecap) OptStreamRecap {
+	return OptStreamRecap{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStreamRecap is optional StreamRecap.
+type OptStreamRecap struct {
+	Value StreamRecap
+	Set   bool
+}
+
+// IsSet returns true if OptStreamRecap was set.
+func (o OptStreamRecap) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStreamRecap) Reset() {
+	var v StreamRecap
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStreamRecap) SetTo(v StreamRecap) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStreamRecap) Get() (v StreamRecap, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStreamRecap) Or(d StreamRecap) StreamRecap {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSuspicionEventEvidence returns new OptSuspicionEventEvidence with value set to v.
+func NewOptSuspicionEventEvidence(v SuspicionEventEvidence) OptSuspicionEventEvidence {
+	return OptSuspicionEventEvidence{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSuspicionEventEvidence is optional SuspicionEventEvidence.
+type OptSuspicionEventEvidence struct {
+	Value SuspicionEventEvidence
+	Set   bool
+}
+
+// IsSet returns true if OptSuspicionEventEvidence was set.
+func (o OptSuspicionEventEvidence) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSuspicionEventEvidence) Reset() {
+	var v SuspicionEventEvidence
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSuspicionEventEvidence) SetTo(v SuspicionEventEvidence) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSuspicionEventEvidence) Get() (v SuspicionEventEvidence, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSuspicionEventEvidence) Or(d SuspicionEventEvidence) SuspicionEventEvidence {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSuspicionEventSource returns new OptSuspicionEventSource with value set to v.
+func NewOptSuspicionEventSource(v SuspicionEventSource) OptSuspicionEventSource {
+	return OptSuspicionEventSource{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSuspicionEventSource is optional SuspicionEventSource.
+type OptSuspicionEventSource struct {
+	Value SuspicionEventSource
+	Set   bool
+}
+
+// IsSet returns true if OptSuspicionEventSource was set.
+func (o OptSuspicionEventSource) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSuspicionEventSource) Reset() {
+	var v SuspicionEventSource
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSuspicionEventSource) SetTo(v SuspicionEventSource) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSuspicionEventSource) Get() (v SuspicionEventSource, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSuspicionEventSource) Or(d SuspicionEventSource) SuspicionEventSource {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSuspicionWeights returns new OptSuspicionWeights with value set to v.
+func NewOptSuspicionWeights(v SuspicionWeights) OptSuspicionWeights {
+	return OptSuspicionWeights{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSuspicionWeights is optional SuspicionWeights.
+type OptSuspicionWeights struct {
+	Value SuspicionWeights
+	Set   bool
+}
+
+// IsSet returns true if OptSuspicionWeights was set.
+func (o OptSuspicionWeights) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSuspicionWeights) Reset() {
+	var v SuspicionWeights
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSuspicionWeights) SetTo(v SuspicionWeights) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSuspicionWeights) Get() (v SuspicionWeights, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSuspicionWeights) Or(d SuspicionWeights) SuspicionWeights {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTestNotificationRequest returns new OptTestNotificationRequest with value set to v.
+func NewOptTestNotificationRequest(v TestNotificationRequest) OptTestNotificationRequest {
+	return OptTestNotificationRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTestNotificationRequest is optional TestNotificationRequest.
+type OptTestNotificationRequest struct {
+	Value TestNotificationRequest
+	Set   bool
+}
+
+// IsSet returns true if OptTestNotificationRequest was set.
+func (o OptTestNotificationRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTestNotificationRequest) Reset() {
+	var v TestNotificationRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTestNotificationRequest) SetTo(v TestNotificationRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTestNotificationRequest) Get() (v TestNotificationRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTestNotificationRequest) Or(d TestNotificationRequest) TestNotificationRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateNotificationPostRequestProvider returns new OptUpdateNotificationPostRequestProvider with value set to v.
+func NewOptUpdateNotificationPostRequestProvider(v UpdateNotificationPostRequestProvider) OptUpdateNotificationPostRequestProvider {
+	return OptUpdateNotificationPostRequestProvider{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateNotificationPostRequestProvider is optional UpdateNotificationPostRequestProvider.
+type OptUpdateNotificationPostRequestProvider struct {
+	Value UpdateNotificationPostRequestProvider
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateNotificationPostRequestProvider was set.
+func (o OptUpdateNotificationPostRequestProvider) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateNotificationPostRequestProvider) Reset() {
+	var v UpdateNotificationPostRequestProvider
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateNotificationPostRequestProvider) SetTo(v UpdateNotificationPostRequestProvider) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateNotificationPostRequestProvider) Get() (v UpdateNotificationPostRequestProvider, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateNotificationPostRequestProvider) Or(d UpdateNotificationPostRequestProvider) UpdateNotificationPostRequestProvider {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateNotificationPostRequestSettings returns new OptUpdateNotificationPostRequestSettings with value set to v.
+func NewOptUpdateNotificationPostRequestSettings(v UpdateNotificationPostRequestSettings) OptUpdateNotificationPostRequestSettings {
+	return OptUpdateNotificationPostRequestSettings{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateNotificationPostRequestSettings is optional UpdateNotificationPostRequestSettings.
+type OptUpdateNotificationPostRequestSettings struct {
+	Value UpdateNotificationPostRequestSettings
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateNotificationPostRequestSettings was set.
+func (o OptUpdateNotificationPostRequestSettings) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateNotificationPostRequestSettings) Reset() {
+	var v UpdateNotificationPostRequestSettings
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateNotificationPostRequestSettings) SetTo(v UpdateNotificationPostRequestSettings) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateNotificationPostRequestSettings) Get() (v UpdateNotificationPostRequestSettings, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateNotificationPostRequestSettings) Or(d UpdateNotificationPostRequestSettings) UpdateNotificationPostRequestSettings {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/PatchAiSettingsRequest
+type PatchAiSettingsRequest struct {
+	BaseURL OptString `json:"base_url"`
+	Model   OptString `json:"model"`
+	// When set, replaces the stored token; omit to leave unchanged.
+	APIToken OptString `json:"api_token"`
+}
+
+// GetBaseURL returns the value of BaseURL.
+func (s *PatchAiSettingsRequest) GetBaseURL() OptString {
+	return s.BaseURL
+}
+
+// GetModel returns the value of Model.
+func (s *PatchAiSettingsRequest) GetModel() OptString {
+	return s.Model
+}
+
+// GetAPIToken returns the value of APIToken.
+func (s *PatchAiSettingsRequest) GetAPIToken() OptString {
+	return s.APIToken
+}
+
+// SetBaseURL sets the value of BaseURL.
+func (s *PatchAiSettingsRequest) SetBaseURL(val OptString) {
+	s.BaseURL = val
+}
+
+// SetModel sets the value of Model.
+func (s *PatchAiSettingsRequest) SetModel(val OptString) {
+	s.Model = val
+}
+
+// SetAPIToken sets the value of APIToken.
+func (s *PatchAiSettingsRequest) SetAPIToken(val OptString) {
+	s.APIToken = val
+}
+
+// Ref: #/components/schemas/PreviewRuleNotifyRequest
+type PreviewRuleNotifyRequest struct {
+	EventType RuleEventType `json:"event_type"`
+	// Notify action settings as edited; only `text` affects the preview.
+	ActionSettings PreviewRuleNotifyRequestActionSettings `json:"action_settings"`
+	// Value for $RULE_ID (default 0).
+	RuleID   OptInt64  `json:"rule_id"`
+	Channel  OptString `json:"channel"`
+	Username OptString `json:"username"`
+	// Sample chat message for $TEXT.
+	Text OptString `json:"text"`
+	// Sample stream title for $TITLE.
+	Title OptString `json:"title"`
+}
+
+// GetEventType returns the value of EventType.
+func (s *PreviewRuleNotifyRequest) GetEventType() RuleEventType {
+	return s.EventType
+}
+
+// GetActionSettings returns the value of ActionSettings.
+func (s *PreviewRuleNotifyRequest) GetActionSettings() PreviewRuleNotifyRequestActionSettings {
+	return s.ActionSettings
+}
+
+// GetRuleID returns the value of RuleID.
+func (s *PreviewRuleNotifyRequest) GetRuleID() OptInt64 {
+	return s.RuleID
+}
+
+// GetChannel returns the value of Channel.
+func (s *PreviewRuleNotifyRequest) GetChannel() OptString {
+	return s.Channel
+}
+
+// GetUsername returns the value of Username.
+func (s *PreviewRuleNotifyRequest) GetUsername() OptString {
+	return s.Username
+}
+
+// GetText returns the value of Text.
+func (s *PreviewRuleNotifyRequest) GetText() OptString {
+	return s.Text
+}
+
+// GetTitle returns the value of Title.
+func (s *PreviewRuleNotifyRequest) GetTitle() OptString {
+	return s.Title
+}
+
+// SetEventType sets the value of EventType.
+func (s *PreviewRuleNotifyRequest) SetEventType(val RuleEventType) {
+	s.EventType = val
+}
+
+// SetActionSettings sets the value of ActionSettings.
+func (s *PreviewRuleNotifyRequest) SetActionSettings(val PreviewRuleNotifyRequestActionSettings) {
+	s.ActionSettings = val
+}
+
+// SetRuleID sets the value of RuleID.
+func (s *PreviewRuleNotifyRequest) SetRuleID(val OptInt64) {
+	s.RuleID = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *PreviewRuleNotifyRequest) SetChannel(val OptString) {
+	s.Channel = val
+}
+
+// SetUsername sets the value of Username.
+func (s *PreviewRuleNotifyRequest) SetUsername(val OptString) {
+	s.Username = val
+}
+
+// SetText sets the value of Text.
+func (s *PreviewRuleNotifyRequest) SetText(val OptString) {
+	s.Text = val
+}
+
+// SetTitle sets the value of Title.
+func (s *PreviewRuleNotifyRequest) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// Notify action settings as edited; only `text` affects the preview.
+type PreviewRuleNotifyRequestActionSettings map[string]jx.Raw
+
+func (s *PreviewRuleNotifyRequestActionSettings) init() PreviewRuleNotifyRequestActionSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/PreviewRuleNotifyResponse
+type PreviewRuleNotifyResponse struct {
+	Text string `json:"text"`
+}
+
+// GetText returns the value of Text.
+func (s *PreviewRuleNotifyResponse) GetText() string {
+	return s.Text
+}
+
+// SetText sets the value of Text.
+func (s *PreviewRuleNotifyResponse) SetText(val string) {
+	s.Text = val
+}
+
+func (*PreviewRuleNotifyResponse) previewRuleNotifyRes() {}
+
+// Ref: #/components/schemas/RecordedStream
+type RecordedStream struct {
+	ID int64 `json:"id"`
+	// Twitch_users.id of the broadcaster.
+	ChannelID     int64     `json:"channel_id"`
+	ChannelLogin  string    `json:"channel_login"`
+	HelixStreamID string    `json:"helix_stream_id"`
+	StartedAt     time.Time `json:"started_at"`
+	// Null while the broadcast is still live.
+	EndedAt   OptNilDateTime `json:"ended_at"`
+	Title     OptNilString   `json:"title"`
+	GameName  OptNilString   `json:"game_name"`
+	CreatedAt time.Time      `json:"created_at"`
+	// Set by getRecordedStream once the stream has ended and its recap was computed.
+	Recap OptStreamRecap `json:"recap"`
+	// Chat highlight moments detected live during the stream, in order (set by getRecordedStream).
+	Moments []ChatMoment `json:"moments"`
+	// Chatters whose first message in the channel was sent during this stream (set by getRecordedStream).
+	NewChatters OptInt64 `json:"new_chatters"`
+	// Chatters of this stream who had chatted in the channel before it started (set by getRecordedStream).
+	ReturningChatters OptInt64 `json:"returning_chatters"`
+	// Aggregated AI classifier labels of the stream's messages; set by getRecordedStream once any were
+	// classified.
+	ChatToxicity OptChatToxicity `json:"chat_toxicity"`
+}
+
+// GetID returns the value of ID.
+func (s *RecordedStream) GetID() int64 {
+	return s.ID
+}
+
+// GetChannelID returns the value of ChannelID.
+func (s *RecordedStream) GetChannelID() int64 {
+	return s.ChannelID
+}
+
+// GetChannelLogin returns the value of ChannelLogin.
+func (s *RecordedStream) GetChannelLogin() string {
+	return s.ChannelLogin
+}
+
+// GetHelixStreamID returns the value of HelixStreamID.
+func (s *RecordedStream) GetHelixStreamID() string {
+	return s.HelixStreamID
+}
+
+// GetStartedAt returns the value of StartedAt.
+func (s *RecordedStream) GetStartedAt() time.Time {
+	return s.StartedAt
+}
+
+// GetEndedAt returns the value of EndedAt.
+func (s *RecordedStream) GetEndedAt() OptNilDateTime {
+	return s.EndedAt
+}
+
+// GetTitle returns the value of Title.
+func (s *RecordedStream) GetTitle() OptNilString {
+	return s.Title
+}
+
+// GetGameName returns the value of GameName.
+func (s *RecordedStream) GetGameName() OptNilString {
+	return s.GameName
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *RecordedStream) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetRecap returns the value of Recap.
+func (s *RecordedStream) GetRecap() OptStreamRecap {
+	return s.Recap
+}
+
+// GetMoments returns the value of Moments.
+func (s *RecordedStream) GetMoments() []ChatMoment {
+	return s.Moments
+}
+
+// GetNewChatters returns the value of NewChatters.
+func (s *RecordedStream) GetNewChatters() OptInt64 {
+	return s.NewChatters
+}
+
+// GetReturningChatters returns the value of ReturningChatters.
+func (s *RecordedStream) GetReturningChatters() OptInt64 {
+	return s.ReturningChatters
+}
+
+// GetChatToxicity returns the value of ChatToxicity.
+func (s *RecordedStream) GetChatToxicity() OptChatToxicity {
+	return s.ChatToxicity
+}
+
+// SetID sets the value of ID.
+func (s *RecordedStream) SetID(val int64) {
+	s.ID = val
+}
+
+// SetChannelID sets the value of ChannelID.
+func (s *RecordedStream) SetChannelID(val int64) {
+	s.ChannelID = val
+}
+
+// SetChannelLogin sets the value of ChannelLogin.
+func (s *RecordedStream) SetChannelLogin(val string) {
+	s.ChannelLogin = val
+}
+
+// SetHelixStreamID sets the value of HelixStreamID.
+func (s *RecordedStream) SetHelixStreamID(val string) {
+	s.HelixStreamID = val
+}
+
+// SetStartedAt sets the value of StartedAt.
+func (s *RecordedStream) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
+
+// SetEndedAt sets the value of EndedAt.
+func (s *RecordedStream) SetEndedAt(val OptNilDateTime) {
+	s.EndedAt = val
+}
+
+// SetTitle sets the value of Title.
+func (s *RecordedStream) SetTitle(val OptNilString) {
+	s.Title = val
+}
+
+// SetGameName sets the value of GameName.
+func (s *RecordedStream) SetGameName(val OptNilString) {
+	s.GameName = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *RecordedStream) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetRecap sets the value of Recap.
+func (s *RecordedStream) SetRecap(val OptStreamRecap) {
+	s.Recap = val
+}
+
+// SetMoments sets the value of Moments.
+func (s *RecordedStream) SetMoments(val []ChatMoment) {
+	s.Moments = val
+}
+
+// SetNewChatters sets the value of NewChatters.
+func (s *RecordedStream) SetNewChatters(val OptInt64) {
+	s.NewChatters = val
+}
+
+// SetReturningChatters sets the value of ReturningChatters.
+func (s *RecordedStream) SetReturningChatters(val OptInt64) {
+	s.ReturningChatters = val
+}
+
+// SetChatToxicity sets the value of ChatToxicity.
+func (s *RecordedStream) SetChatToxicity(val OptChatToxicity) {
+	s.ChatToxicity = val
+}
+
+func (*RecordedStream) getRecordedStreamRes() {}
+
+// Ref: #/components/schemas/ResendNotificationDeliveryRequest
+type ResendNotificationDeliveryRequest struct {
+	ID int64 `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *ResendNotificationDeliveryRequest) GetID() int64 {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *ResendNotificationDeliveryRequest) SetID(val int64) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/Rule
+type Rule struct {
+	ID int64 `json:"id"`
+	// Display name for this rule.
+	Name           string             `json:"name"`
+	Enabled        bool               `json:"enabled"`
+	EventType      RuleEventType      `json:"event_type"`
+	EventSettings  RuleEventSettings  `json:"event_settings"`
+	Middlewares    []RuleMiddleware   `json:"middlewares"`
+	ActionType     RuleActionType     `json:"action_type"`
+	ActionSettings RuleActionSettings `json:"action_settings"`
+	UseSharedPool  bool               `json:"use_shared_pool"`
+	CreatedAt      time.Time          `json:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Rule) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Rule) GetName() string {
+	return s.Name
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *Rule) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetEventType returns the value of EventType.
+func (s *Rule) GetEventType() RuleEventType {
+	return s.EventType
+}
+
+// GetEventSettings returns the value of EventSettings.
+func (s *Rule) GetEventSettings() RuleEventSettings {
+	return s.EventSettings
+}
+
+// GetMiddlewares returns the value of Middlewares.
+func (s *Rule) GetMiddlewares() []RuleMiddleware {
+	return s.Middlewares
+}
+
+// GetActionType returns the value of ActionType.
+func (s *Rule) GetActionType() RuleActionType {
+	return s.ActionType
+}
+
+// GetActionSettings returns the value of ActionSettings.
+func (s *Rule) GetActionSettings() RuleActionSettings {
+	return s.ActionSettings
+}
+
+// GetUseSharedPool returns the value of UseSharedPool.
+func (s *Rule) GetUseSharedPool() bool {
+	return s.UseSharedPool
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Rule) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Rule) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Rule) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Rule) SetName(val string) {
+	s.Name = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *Rule) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *Rule) SetEventType(val RuleEventType) {
+	s.EventType = val
+}
+
+// SetEventSettings sets the value of EventSettings.
+func (s *Rule) SetEventSettings(val RuleEventSettings) {
+	s.EventSettings = val
+}
+
+// SetMiddlewares sets the value of Middlewares.
+func (s *Rule) SetMiddlewares(val []RuleMiddleware) {
+	s.Middlewares = val
+}
+
+// SetActionType sets the value of ActionType.
+func (s *Rule) SetActionType(val RuleActionType) {
+	s.ActionType = val
+}
+
+// SetActionSettings sets the value of ActionSettings.
+func (s *Rule) SetActionSettings(val RuleActionSettings) {
+	s.ActionSettings = val
+}
+
+// SetUseSharedPool sets the value of UseSharedPool.
+func (s *Rule) SetUseSharedPool(val bool) {
+	s.UseSharedPool = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Rule) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Rule) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Rule) updateRuleRes() {}
+
+type RuleActionSettings map[string]jx.Raw
+
+func (s *RuleActionSettings) init() RuleActionSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Notify — deliver `action_settings.text` via notification providers.
+// Optional `notification_ids` (integer array) and `notification_tags` (string array) restrict
+// delivery to matching entries;
+// if both are omitted, every enabled entry whose default event_types/channels filters accept the
+// event is used.
+// send_chat — post to the event channel via Helix; `action_settings` requires `message` (template).
+// Optional `account_id` (integer, app-linked Twitch OAuth row id) selects which linked account sends
+// the message;
+// if omitted or zero, the server uses the linked bot account when present, otherwise the first
+// linked account.
+// mark_sus — chat_message only; marks the chatter suspicious (sus_type `manual`, audit source
+// `rule`).
+// Optional `description` (template) sets sus_description. Users already suspicious or with automatic
+// suspicion
+// suppressed are left unchanged.
+// Ref: #/components/schemas/RuleActionType
+type RuleActionType string
+
+const (
+	RuleActionTypeNotify   RuleActionType = "notify"
+	RuleActionTypeSendChat RuleActionType = "send_chat"
+	RuleActionTypeMarkSus  RuleActionType = "mark_sus"
+)
+
+// AllValues returns all RuleActionType values.
+func (RuleActionType) AllValues() []RuleActionType {
+	return []RuleActionType{
+		RuleActionTypeNotify,
+		RuleActionTypeSendChat,
+		RuleActionTypeMarkSus,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RuleActionType) MarshalText() ([]byte, error) {
+	switch s {
+	case RuleActionTypeNotify:
+		return []byte(s), nil
+	case RuleActionTypeSendChat:
+		return []byte(s), nil
+	case RuleActionTypeMarkSus:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RuleActionType) UnmarshalText(data []byte) error {
+	switch RuleActionType(data) {
+	case RuleActionTypeNotify:
+		*s = RuleActionTypeNotify
+		return nil
+	case RuleActionTypeSendChat:
+		*s = RuleActionTypeSendChat
+		return nil
+	case RuleActionTypeMarkSus:
+		*s = RuleActionTypeMarkSus
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type RuleEventSettings map[string]jx.Raw
+
+func (s *RuleEventSettings) init() RuleEventSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Chat_message — IRC chat line; stream_start / stream_end — Helix live edge; interval —
+// periodic tick (see event_settings).
+// Ref: #/components/schemas/RuleEventType
+type RuleEventType string
+
+const (
+	RuleEventTypeChatMessage RuleEventType = "chat_message"
+	RuleEventTypeStreamStart RuleEventType = "stream_start"
+	RuleEventTypeStreamEnd   RuleEventType = "stream_end"
+	RuleEventTypeInterval    RuleEventType = "interval"
+)
+
+// AllValues returns all RuleEventType values.
+func (RuleEventType) AllValues() []RuleEventType {
+	return []RuleEventType{
+		RuleEventTypeChatMessage,
+		RuleEventTypeStreamStart,
+		RuleEventTypeStreamEnd,
+		RuleEventTypeInterval,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RuleEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case RuleEventTypeChatMessage:
+		return []byte(s), nil
+	case RuleEventTypeStreamStart:
+		return []byte(s), nil
+	case RuleEventTypeStreamEnd:
+		return []byte(s), nil
+	case RuleEventTypeInterval:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RuleEventType) UnmarshalText(data []byte) error {
+	switch RuleEventType(data) {
+	case RuleEventTypeChatMessage:
+		*s = RuleEventTypeChatMessage
+		return nil
+	case RuleEventTypeStreamStart:
+		*s = RuleEventTypeStreamStart
+		return nil
+	case RuleEventTypeStreamEnd:
+		*s = RuleEventTypeStreamEnd
+		return nil
+	case RuleEventTypeInterval:
+		*s = RuleEventTypeInterval
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/RuleMiddleware
+type RuleMiddleware struct {
+	// Filter_channel, filter_user, match_regex, contains_word, cooldown,
+	// first_seen_in_channel (optional boolean returning: pass only chatters seen in the channel before)
+	// classify (label toxicity | harassment | sentiment with optional min / max: checks the chatter's
+	// latest
+	// AI-classified message in the channel from the last hour; unclassified chatters fail unless
+	// pass_unclassified).
+	Type     string                 `json:"type"`
+	Settings RuleMiddlewareSettings `json:"settings"`
+}
+
+// GetType returns the value of Type.
+func (s *RuleMiddleware) GetType() string {
+	return s.Type
+}
+
+// GetSettings returns the value of Settings.
+func (s *RuleMiddleware) GetSettings() RuleMiddlewareSettings {
+	return s.Settings
+}
+
+// SetType sets the value of Type.
+func (s *RuleMiddleware) SetType(val string) {
+	s.Type = val
+}
+
+// SetSettings sets the value of Settings.
+func (s *RuleMiddleware) SetSettings(val RuleMiddlewareSettings) {
+	s.Settings = val
+}
+
+type RuleMiddlewareSettings map[string]jx.Raw
+
+func (s *RuleMiddlewareSettings) init() RuleMiddlewareSettings {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/RuleTemplateVariable
+type RuleTemplateVariable struct {
+	// Placeholder key without leading $ (e.g. CHANNEL); use as $CHANNEL in templates.
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// GetName returns the value of Name.
+func (s *RuleTemplateVariable) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *RuleTemplateVariable) GetDescription() string {
+	return s.Description
+}
+
+// SetName sets the value of Name.
+func (s *RuleTemplateVariable) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RuleTemplateVariable) SetDescription(val string) {
+	s.Description = val
+}
+
+// Ref: #/components/schemas/RuleTemplateVariablesResponse
+type RuleTemplateVariablesResponse struct {
+	Variables []RuleTemplateVariable `json:"variables"`
+}
+
+// GetVariables returns the value of Variables.
+func (s *RuleTemplateVariablesResponse) GetVariables() []RuleTemplateVariable {
+	return s.Variables
+}
+
+// SetVariables sets the value of Variables.
+func (s *RuleTemplateVariablesResponse) SetVariables(val []RuleTemplateVariable) {
+	s.Variables = val
+}
+
+// Ref: #/components/schemas/RuleTrigger
+type RuleTrigger struct {
+	ID           int64       `json:"id"`
+	CreatedAt    time.Time   `json:"created_at"`
+	RuleID       OptNilInt64 `json:"rule_id"`
+	RuleName     string      `json:"rule_name"`
+	TriggerEvent string      `json:"trigger_event"`
+	ActionType   string      `json:"action_type"`
+	DisplayText  string      `json:"display_text"`
+}
+
+// GetID returns the value of ID.
+func (s *RuleTrigger) GetID() int64 {
+	return s.ID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *RuleTrigger) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetRuleID returns the value of RuleID.
+func (s *RuleTrigger) GetRuleID() OptNilInt64 {
+	return s.RuleID
+}
+
+// GetRuleName returns the value of RuleName.
+func (s *RuleTrigger) GetRuleName() string {
+	return s.RuleName
+}
+
+// GetTriggerEvent returns the value of TriggerEvent.
+func (s *RuleTrigger) GetTriggerEvent() string {
+	return s.TriggerEvent
+}
+
+// GetActionType returns the value of ActionType.
+func (s *RuleTrigger) GetActionType() string {
+	return s.ActionType
+}
+
+// GetDisplayText returns the value of DisplayText.
+func (s *RuleTrigger) GetDisplayText() string {
+	return s.DisplayText
+}
+
+// SetID sets the value of ID.
+func (s *RuleTrigger) SetID(val int64) {
+	s.ID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *RuleTrigger) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetRuleID sets the value of RuleID.
+func (s *RuleTrigger) SetRuleID(val OptNilInt64) {
+	s.RuleID = val
+}
+
+// SetRuleName sets the value of RuleName.
+func (s *RuleTrigger) SetRuleName(val string) {
+	s.RuleName = val
+}
+
+// SetTriggerEvent sets the value of TriggerEvent.
+func (s *RuleTrigger) SetTriggerEvent(val string) {
+	s.TriggerEvent = val
+}
+
+// SetActionType sets the value of ActionType.
+func (s *RuleTrigger) SetActionType(val string) {
+	s.ActionType = val
+}
+
+// SetDisplayText sets the value of DisplayText.
+func (s *RuleTrigger) SetDisplayText(val string) {
+	s.DisplayText = val
+}
+
+// Ref: #/components/schemas/SaveEmoteSetRequest
+type SaveEmoteSetRequest struct {
+	Name     string           `json:"name"`
+	Provider EmoteSetProvider `json:"provider"`
+	// Limit the set to one channel's chat (default all monitored channels).
+	Channel OptString       `json:"channel"`
+	Emotes  []EmoteSetEmote `json:"emotes"`
+}
+
+// GetName returns the value of Name.
+func (s *SaveEmoteSetRequest) GetName() string {
+	return s.Name
+}
+
+// GetProvider returns the value of Provider.
+func (s *SaveEmoteSetRequest) GetProvider() EmoteSetProvider {
+	return s.Provider
+}
+
+// GetChannel returns the value of Channel.
+func (s *SaveEmoteSetRequest) GetChannel() OptString {
+	return s.Channel
+}
+
+// GetEmotes returns the value of Emotes.
+func (s *SaveEmoteSetRequest) GetEmotes() []EmoteSetEmote {
+	return s.Emotes
+}
+
+// SetName sets the value of Name.
+func (s *SaveEmoteSetRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetProvider sets the value of Provider.
+func (s *SaveEmoteSetRequest) SetProvider(val EmoteSetProvider) {
+	s.Provider = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *SaveEmoteSetRequest) SetChannel(val OptString) {
+	s.Channel = val
+}
+
+// SetEmotes sets the value of Emotes.
+func (s *SaveEmoteSetRequest) SetEmotes(val []EmoteSetEmote) {
+	s.Emotes = val
+}
+
+// ScanLikelyBotsNoContent is response for ScanLikelyBots operation.
+type ScanLikelyBotsNoContent struct{}
+
+type ScanTwitchUserAltsOKApplicationJSON []AltCandidate
+
+func (*ScanTwitchUserAltsOKApplicationJSON) scanTwitchUserAltsRes() {}
+
+// SendMessageAccepted is response for SendMessage operation.
+type SendMessageAccepted struct{}
+
+func (*SendMessageAccepted) sendMessageRes() {}
+
+type SendMessageBadGateway ClientNotice
+
+func (*SendMessageBadGateway) sendMessageRes() {}
+
+type SendMessageBadRequest ClientNotice
+
+func (*SendMessageBadRequest) sendMessageRes() {}
+
+// Ref: #/components/schemas/SendMessageRequest
+type SendMessageRequest struct {
+	AccountID int64  `json:"account_id"`
+	Channel   string `json:"channel"`
+	Message   string `json:"message"`
+}
+
+// GetAccountID returns the value of AccountID.
+func (s *SendMessageRequest) GetAccountID() int64 {
+	return s.AccountID
+}
+
+// GetChannel returns the value of Channel.
+func (s *SendMessageRequest) GetChannel() string {
+	return s.Channel
+}
+
+// GetMessage returns the value of Message.
+func (s *SendMessageRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetAccountID sets the value of AccountID.
+func (s *SendMessageRequest) SetAccountID(val int64) {
+	s.AccountID = val
+}
+
+// SetChannel sets the value of Channel.
+func (s *SendMessageRequest) SetChannel(val string) {
+	s.Channel = val
+}
+
+// SetMessage sets the value of Message.
+func (s *SendMessageRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+type SendMessageUnauthorized ClientNotice
+
+func (*SendMessageUnauthorized) sendMessageRes() {}
+
+type SendMessageUnprocessableEntity ClientNotice
+
+func (*SendMessageUnprocessableEntity) sendMessageRes() {}
+
+// SetChannelBlacklistNoContent is response for SetChannelBlacklist operation.
+type SetChannelBlacklistNoContent struct{}
+
+func (*SetChannelBlacklistNoContent) setChannelBlacklistRes() {}
+
+type SetTwitchUserLinkBadRequest ErrorMessage
+
+func (*SetTwitchUserLinkBadRequest) setTwitchUserLinkRes() {}
+
+type SetTwitchUserLinkNotFound ErrorMessage
+
+func (*SetTwitchUserLinkNotFound) setTwitchUserLinkRes() {}
 
-// NewOptNilUserActivityEventDetails returns new OptNilUserActivityEventDetails with value set to v.
-func NewOptNilUserActivityEventDetails(v UserActivityEventDetails) OptNilUserActivityEventDetails {
-	return OptNilUserActivityEventDetails{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/SetTwitchUserLinkRequest
+type SetTwitchUserLinkRequest struct {
+	TwitchUserID int64          `json:"twitch_user_id"`
+	LinkedUserID int64          `json:"linked_user_id"`
+	Status       UserLinkStatus `json:"status"`
+	Note         OptString      `json:"note"`
 }
 
-// OptNilUserActivityEventDetails is optional nullable UserActivityEventDetails.
-type OptNilUserActivityEventDetails struct {
-	Value UserActivityEventDetails
-	Set   bool
-	Null  bool
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *SetTwitchUserLinkRequest) GetTwitchUserID() int64 {
+	return s.TwitchUserID
 }
 
-// IsSet returns true if OptNilUserActivityEventDetails was set.
-func (o OptNilUserActivityEventDetails) IsSet() bool { return o.Set }
+// GetLinkedUserID returns the value of LinkedUserID.
+func (s *SetTwitchUserLinkRequest) GetLinkedUserID() int64 {
+	return s.LinkedUserID
+}
 
-// Reset unsets value.
-func (o *OptNilUserActivityEventDetails) Reset() {
-	var v UserActivityEventDetails
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetStatus returns the value of Status.
+func (s *SetTwitchUserLinkRequest) GetStatus() UserLinkStatus {
+	return s.Status
 }
 
-// SetTo sets value to v.
-func (o *OptNilUserActivityEventDetails) SetTo(v UserActivityEventDetails) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetNote returns the value of Note.
+func (s *SetTwitchUserLinkRequest) GetNote() OptString {
+	return s.Note
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilUserActivityEventDetails) IsNull() bool { return o.Null }
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *SetTwitchUserLinkRequest) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilUserActivityEventDetails) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v UserActivityEventDetails
-	o.Value = v
+// SetLinkedUserID sets the value of LinkedUserID.
+func (s *SetTwitchUserLinkRequest) SetLinkedUserID(val int64) {
+	s.LinkedUserID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilUserActivityEventDetails) Get() (v UserActivityEventDetails, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetStatus sets the value of Status.
+func (s *SetTwitchUserLinkRequest) SetStatus(val UserLinkStatus) {
+	s.Status = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilUserActivityEventDetails) Or(d UserActivityEventDetails) UserActivityEventDetails {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetNote sets the value of Note.
+func (s *SetTwitchUserLinkRequest) SetNote(val OptString) {
+	s.Note = val
 }
 
-// NewOptStartTwitchOAuthRequest returns new OptStartTwitchOAuthRequest with value set to v.
-func NewOptStartTwitchOAuthRequest(v StartTwitchOAuthRequest) OptStartTwitchOAuthRequest {
-	return OptStartTwitchOAuthRequest{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/StartSuspicionReevaluationRequest
+type StartSuspicionReevaluationRequest struct {
+	// How many users with follows older than the enrichment cooldown may be re-synced from Twitch first.
+	// Default 0 uses cached follows only.
+	RefetchBudget OptInt `json:"refetch_budget"`
 }
 
-// OptStartTwitchOAuthRequest is optional StartTwitchOAuthRequest.
-type OptStartTwitchOAuthRequest struct {
-	Value StartTwitchOAuthRequest
-	Set   bool
+// GetRefetchBudget returns the value of RefetchBudget.
+func (s *StartSuspicionReevaluationRequest) GetRefetchBudget() OptInt {
+	return s.RefetchBudget
 }
 
-// IsSet returns true if OptStartTwitchOAuthRequest was set.
-func (o OptStartTwitchOAuthRequest) IsSet() bool { return o.Set }
+// SetRefetchBudget sets the value of RefetchBudget.
+func (s *StartSuspicionReevaluationRequest) SetRefetchBudget(val OptInt) {
+	s.RefetchBudget = val
+}
 
-// Reset unsets value.
-func (o *OptStartTwitchOAuthRequest) Reset() {
-	var v StartTwitchOAuthRequest
-	o.Value = v
-	o.Set = false
+// Ref: #/components/schemas/StartTwitchOAuthRequest
+type StartTwitchOAuthRequest struct {
+	// Optional SPA URL to open after OAuth (same scheme/host/port as configured twitch.oauth_return_url).
+	//  For hash routers, include the route in the fragment (e.g. http://localhost/#/settings?t=1).
+	ReturnURL OptString `json:"return_url"`
 }
 
-// SetTo sets value to v.
-func (o *OptStartTwitchOAuthRequest) SetTo(v StartTwitchOAuthRequest) {
-	o.Set = true
-	o.Value = v
+// GetReturnURL returns the value of ReturnURL.
+func (s *StartTwitchOAuthRequest) GetReturnURL() OptString {
+	return s.ReturnURL
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptStartTwitchOAuthRequest) Get() (v StartTwitchOAuthRequest, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetReturnURL sets the value of ReturnURL.
+func (s *StartTwitchOAuthRequest) SetReturnURL(val OptString) {
+	s.ReturnURL = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptStartTwitchOAuthRequest) Or(d StartTwitchOAuthRequest) StartTwitchOAuthRequest {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/StartTwitchOAuthResponse
+type StartTwitchOAuthResponse struct {
+	// Twitch authorize URL; redirect the user here (e.g. window.location.assign).
+	AuthorizeURL string `json:"authorize_url"`
 }
 
-// NewOptStreamLeaderboardSort returns new OptStreamLeaderboardSort with value set to v.
-func NewOptStreamLeaderboardSort(v StreamLeaderboardSort) OptStreamLeaderboardSort {
-	return OptStreamLeaderboardSort{
-		Value: v,
-		Set:   true,
-	}
+// GetAuthorizeURL returns the value of AuthorizeURL.
+func (s *StartTwitchOAuthResponse) GetAuthorizeURL() string {
+	return s.AuthorizeURL
 }
 
-// OptStreamLeaderboardSort is optional StreamLeaderboardSort.
-type OptStreamLeaderboardSort struct {
-	Value StreamLeaderboardSort
-	Set   bool
+// SetAuthorizeURL sets the value of AuthorizeURL.
+func (s *StartTwitchOAuthResponse) SetAuthorizeURL(val string) {
+	s.AuthorizeURL = val
 }
 
-// IsSet returns true if OptStreamLeaderboardSort was set.
-func (o OptStreamLeaderboardSort) IsSet() bool { return o.Set }
+// StopAiAgentNoContent is response for StopAiAgent operation.
+type StopAiAgentNoContent struct{}
 
-// Reset unsets value.
-func (o *OptStreamLeaderboardSort) Reset() {
-	var v StreamLeaderboardSort
-	o.Value = v
-	o.Set = false
-}
+func (*StopAiAgentNoContent) stopAiAgentRes() {}
 
-// SetTo sets value to v.
-func (o *OptStreamLeaderboardSort) SetTo(v StreamLeaderboardSort) {
-	o.Set = true
-	o.Value = v
+// Ref: #/components/schemas/StreamEmotes
+type StreamEmotes struct {
+	StreamID      int64                 `json:"stream_id"`
+	Top           []EmoteUsage          `json:"top"`
+	BucketSeconds int                   `json:"bucket_seconds"`
+	Timeline      []EmoteTimelineBucket `json:"timeline"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptStreamLeaderboardSort) Get() (v StreamLeaderboardSort, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetStreamID returns the value of StreamID.
+func (s *StreamEmotes) GetStreamID() int64 {
+	return s.StreamID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptStreamLeaderboardSort) Or(d StreamLeaderboardSort) StreamLeaderboardSort {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetTop returns the value of Top.
+func (s *StreamEmotes) GetTop() []EmoteUsage {
+	return s.Top
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetBucketSeconds returns the value of BucketSeconds.
+func (s *StreamEmotes) GetBucketSeconds() int {
+	return s.BucketSeconds
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetTimeline returns the value of Timeline.
+func (s *StreamEmotes) GetTimeline() []EmoteTimelineBucket {
+	return s.Timeline
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetStreamID sets the value of StreamID.
+func (s *StreamEmotes) SetStreamID(val int64) {
+	s.StreamID = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetTop sets the value of Top.
+func (s *StreamEmotes) SetTop(val []EmoteUsage) {
+	s.Top = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetBucketSeconds sets the value of BucketSeconds.
+func (s *StreamEmotes) SetBucketSeconds(val int) {
+	s.BucketSeconds = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetTimeline sets the value of Timeline.
+func (s *StreamEmotes) SetTimeline(val []EmoteTimelineBucket) {
+	s.Timeline = val
 }
 
-// NewOptUpdateNotificationPostRequestProvider returns new OptUpdateNotificationPostRequestProvider with value set to v.
-func NewOptUpdateNotificationPostRequestProvider(v UpdateNotificationPostRequestProvider) OptUpdateNotificationPostRequestProvider {
-	return OptUpdateNotificationPostRequestProvider{
-		Value: v,
-		Set:   true,
-	}
+func (*StreamEmotes) getRecordedStreamEmotesRes() {}
+
+// Ref: #/components/schemas/StreamLeaderboardEntry
+type StreamLeaderboardEntry struct {
+	Login            string         `json:"login"`
+	UserTwitchID     int64          `json:"user_twitch_id"`
+	PresenceSeconds  int64          `json:"presence_seconds"`
+	MessageCount     int64          `json:"message_count"`
+	AccountCreatedAt OptNilDateTime `json:"account_created_at"`
 }
 
-// OptUpdateNotificationPostRequestProvider is optional UpdateNotificationPostRequestProvider.
-type OptUpdateNotificationPostRequestProvider struct {
-	Value UpdateNotificationPostRequestProvider
-	Set   bool
+// GetLogin returns the value of Login.
+func (s *StreamLeaderboardEntry) GetLogin() string {
+	return s.Login
 }
 
-// IsSet returns true if OptUpdateNotificationPostRequestProvider was set.
-func (o OptUpdateNotificationPostRequestProvider) IsSet() bool { return o.Set }
+// GetUserTwitchID returns the value of UserTwitchID.
+func (s *StreamLeaderboardEntry) GetUserTwitchID() int64 {
+	return s.UserTwitchID
+}
 
-// Reset unsets value.
-func (o *OptUpdateNotificationPostRequestProvider) Reset() {
-	var v UpdateNotificationPostRequestProvider
-	o.Value = v
-	o.Set = false
+// GetPresenceSeconds returns the value of PresenceSeconds.
+func (s *StreamLeaderboardEntry) GetPresenceSeconds() int64 {
+	return s.PresenceSeconds
 }
 
-// SetTo sets value to v.
-func (o *OptUpdateNotificationPostRequestProvider) SetTo(v UpdateNotificationPostRequestProvider) {
-	o.Set = true
-	o.Value = v
+// GetMessageCount returns the value of MessageCount.
+func (s *StreamLeaderboardEntry) GetMessageCount() int64 {
+	return s.MessageCount
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUpdateNotificationPostRequestProvider) Get() (v UpdateNotificationPostRequestProvider, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetAccountCreatedAt returns the value of AccountCreatedAt.
+func (s *StreamLeaderboardEntry) GetAccountCreatedAt() OptNilDateTime {
+	return s.AccountCreatedAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUpdateNotificationPostRequestProvider) Or(d UpdateNotificationPostRequestProvider) UpdateNotificationPostRequestProvider {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetLogin sets the value of Login.
+func (s *StreamLeaderboardEntry) SetLogin(val string) {
+	s.Login = val
 }
 
-// NewOptUpdateNotificationPostRequestSettings returns new OptUpdateNotificationPostRequestSettings with value set to v.
-func NewOptUpdateNotificationPostRequestSettings(v UpdateNotificationPostRequestSettings) OptUpdateNotificationPostRequestSettings {
-	return OptUpdateNotificationPostRequestSettings{
-		Value: v,
-		Set:   true,
-	}
+// SetUserTwitchID sets the value of UserTwitchID.
+func (s *StreamLeaderboardEntry) SetUserTwitchID(val int64) {
+	s.UserTwitchID = val
 }
 
-// OptUpdateNotificationPostRequestSettings is optional UpdateNotificationPostRequestSettings.
-type OptUpdateNotificationPostRequestSettings struct {
-	Value UpdateNotificationPostRequestSettings
-	Set   bool
+// SetPresenceSeconds sets the value of PresenceSeconds.
+func (s *StreamLeaderboardEntry) SetPresenceSeconds(val int64) {
+	s.PresenceSeconds = val
 }
 
-// IsSet returns true if OptUpdateNotificationPostRequestSettings was set.
-func (o OptUpdateNotificationPostRequestSettings) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptUpdateNotificationPostRequestSettings) Reset() {
-	var v UpdateNotificationPostRequestSettings
-	o.Value = v
-	o.Set = false
+// SetMessageCount sets the value of MessageCount.
+func (s *StreamLeaderboardEntry) SetMessageCount(val int64) {
+	s.MessageCount = val
 }
 
-// SetTo sets value to v.
-func (o *OptUpdateNotificationPostRequestSettings) SetTo(v UpdateNotificationPostRequestSettings) {
-	o.Set = true
-	o.Value = v
+// SetAccountCreatedAt sets the value of AccountCreatedAt.
+func (s *StreamLeaderboardEntry) SetAccountCreatedAt(val OptNilDateTime) {
+	s.AccountCreatedAt = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUpdateNotificationPostRequestSettings) Get() (v UpdateNotificationPostRequestSettings, ok bool) {
-	if !o.Set {
-		return v, false
+// Ref: #/components/schemas/StreamLeaderboardSort
+type StreamLeaderboardSort string
+
+const (
+	StreamLeaderboardSortPresenceDesc StreamLeaderboardSort = "presence_desc"
+	StreamLeaderboardSortPresenceAsc  StreamLeaderboardSort = "presence_asc"
+	StreamLeaderboardSortMessagesDesc StreamLeaderboardSort = "messages_desc"
+	StreamLeaderboardSortMessagesAsc  StreamLeaderboardSort = "messages_asc"
+	StreamLeaderboardSortLoginAz      StreamLeaderboardSort = "login_az"
+	StreamLeaderboardSortLoginZa      StreamLeaderboardSort = "login_za"
+	StreamLeaderboardSortAccountNew   StreamLeaderboardSort = "account_new"
+	StreamLeaderboardSortAccountOld   StreamLeaderboardSort = "account_old"
+)
+
+// AllValues returns all StreamLeaderboardSort values.
+func (StreamLeaderboardSort) AllValues() []StreamLeaderboardSort {
+	return []StreamLeaderboardSort{
+		StreamLeaderboardSortPresenceDesc,
+		StreamLeaderboardSortPresenceAsc,
+		StreamLeaderboardSortMessagesDesc,
+		StreamLeaderboardSortMessagesAsc,
+		StreamLeaderboardSortLoginAz,
+		StreamLeaderboardSortLoginZa,
+		StreamLeaderboardSortAccountNew,
+		StreamLeaderboardSortAccountOld,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUpdateNotificationPostRequestSettings) Or(d UpdateNotificationPostRequestSettings) UpdateNotificationPostRequestSettings {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s StreamLeaderboardSort) MarshalText() ([]byte, error) {
+	switch s {
+	case StreamLeaderboardSortPresenceDesc:
+		return []byte(s), nil
+	case StreamLeaderboardSortPresenceAsc:
+		return []byte(s), nil
+	case StreamLeaderboardSortMessagesDesc:
+		return []byte(s), nil
+	case StreamLeaderboardSortMessagesAsc:
+		return []byte(s), nil
+	case StreamLeaderboardSortLoginAz:
+		return []byte(s), nil
+	case StreamLeaderboardSortLoginZa:
+		return []byte(s), nil
+	case StreamLeaderboardSortAccountNew:
+		return []byte(s), nil
+	case StreamLeaderboardSortAccountOld:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// Ref: #/components/schemas/PatchAiSettingsRequest
-type PatchAiSettingsRequest struct {
-	BaseURL OptString `json:"base_url"`
-	Model   OptString `json:"model"`
-	// When set, replaces the stored token; omit to leave unchanged.
-	APIToken OptString `json:"api_token"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StreamLeaderboardSort) UnmarshalText(data []byte) error {
+	switch StreamLeaderboardSort(data) {
+	case StreamLeaderboardSortPresenceDesc:
+		*s = StreamLeaderboardSortPresenceDesc
+		return nil
+	case StreamLeaderboardSortPresenceAsc:
+		*s = StreamLeaderboardSortPresenceAsc
+		return nil
+	case StreamLeaderboardSortMessagesDesc:
+		*s = StreamLeaderboardSortMessagesDesc
+		return nil
+	case StreamLeaderboardSortMessagesAsc:
+		*s = StreamLeaderboardSortMessagesAsc
+		return nil
+	case StreamLeaderboardSortLoginAz:
+		*s = StreamLeaderboardSortLoginAz
+		return nil
+	case StreamLeaderboardSortLoginZa:
+		*s = StreamLeaderboardSortLoginZa
+		return nil
+	case StreamLeaderboardSortAccountNew:
+		*s = StreamLeaderboardSortAccountNew
+		return nil
+	case StreamLeaderboardSortAccountOld:
+		*s = StreamLeaderboardSortAccountOld
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetBaseURL returns the value of BaseURL.
-func (s *PatchAiSettingsRequest) GetBaseURL() OptString {
-	return s.BaseURL
+// What happened during a finished stream, computed when the stream is closed.
+// Ref: #/components/schemas/StreamRecap
+type StreamRecap struct {
+	DurationSeconds int64 `json:"duration_seconds"`
+	// Absent when Helix never reported a viewer count.
+	PeakViewers    OptInt64 `json:"peak_viewers"`
+	AverageViewers OptInt64 `json:"average_viewers"`
+	Messages       int64    `json:"messages"`
+	UniqueChatters int64    `json:"unique_chatters"`
+	// Chatters who never chatted in the channel before this stream.
+	NewChatters     int64                `json:"new_chatters"`
+	TopChatters     []StreamRecapChatter `json:"top_chatters"`
+	SuspiciousUsers []StreamRecapUser    `json:"suspicious_users"`
+	RuleTriggers    []StreamRecapRule    `json:"rule_triggers"`
+	// Busiest 5-minute chat windows, busiest first.
+	BusiestMoments []StreamRecapMoment `json:"busiest_moments"`
+	// LLM-written summary, when enabled and AI settings are configured.
+	Summary   OptString `json:"summary"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
-// GetModel returns the value of Model.
-func (s *PatchAiSettingsRequest) GetModel() OptString {
-	return s.Model
+// GetDurationSeconds returns the value of DurationSeconds.
+func (s *StreamRecap) GetDurationSeconds() int64 {
+	return s.DurationSeconds
 }
 
-// GetAPIToken returns the value of APIToken.
-func (s *PatchAiSettingsRequest) GetAPIToken() OptString {
-	return s.APIToken
+// GetPeakViewers returns the value of PeakViewers.
+func (s *StreamRecap) GetPeakViewers() OptInt64 {
+	return s.PeakViewers
 }
 
-// SetBaseURL sets the value of BaseURL.
-func (s *PatchAiSettingsRequest) SetBaseURL(val OptString) {
-	s.BaseURL = val
+// GetAverageViewers returns the value of AverageViewers.
+func (s *StreamRecap) GetAverageViewers() OptInt64 {
+	return s.AverageViewers
 }
 
-// SetModel sets the value of Model.
-func (s *PatchAiSettingsRequest) SetModel(val OptString) {
-	s.Model = val
+// GetMessages returns the value of Messages.
+func (s *StreamRecap) GetMessages() int64 {
+	return s.Messages
 }
 
-// SetAPIToken sets the value of APIToken.
-func (s *PatchAiSettingsRequest) SetAPIToken(val OptString) {
-	s.APIToken = val
+// GetUniqueChatters returns the value of UniqueChatters.
+func (s *StreamRecap) GetUniqueChatters() int64 {
+	return s.UniqueChatters
 }
 
-// Ref: #/components/schemas/RecordedStream
-type RecordedStream struct {
-	ID int64 `json:"id"`
-	// Twitch_users.id of the broadcaster.
-	ChannelID     int64     `json:"channel_id"`
-	ChannelLogin  string    `json:"channel_login"`
-	HelixStreamID string    `json:"helix_stream_id"`
-	StartedAt     time.Time `json:"started_at"`
-	// Null while the broadcast is still live.
-	EndedAt   OptNilDateTime `json:"ended_at"`
-	Title     OptNilString   `json:"title"`
-	GameName  OptNilString   `json:"game_name"`
-	CreatedAt time.Time      `json:"created_at"`
+// GetNewChatters returns the value of NewChatters.
+func (s *StreamRecap) GetNewChatters() int64 {
+	return s.NewChatters
 }
 
-// GetID returns the value of ID.
-func (s *RecordedStream) GetID() int64 {
-	return s.ID
+// GetTopChatters returns the value of TopChatters.
+func (s *StreamRecap) GetTopChatters() []StreamRecapChatter {
+	return s.TopChatters
 }
 
-// GetChannelID returns the value of ChannelID.
-func (s *RecordedStream) GetChannelID() int64 {
-	return s.ChannelID
+// GetSuspiciousUsers returns the value of SuspiciousUsers.
+func (s *StreamRecap) GetSuspiciousUsers() []StreamRecapUser {
+	return s.SuspiciousUsers
 }
 
-// GetChannelLogin returns the value of ChannelLogin.
-func (s *RecordedStream) GetChannelLogin() string {
-	return s.ChannelLogin
+// GetRuleTriggers returns the value of RuleTriggers.
+func (s *StreamRecap) GetRuleTriggers() []StreamRecapRule {
+	return s.RuleTriggers
 }
 
-// GetHelixStreamID returns the value of HelixStreamID.
-func (s *RecordedStream) GetHelixStreamID() string {
-	return s.HelixStreamID
+// GetBusiestMoments returns the value of BusiestMoments.
+func (s *StreamRecap) GetBusiestMoments() []StreamRecapMoment {
+	return s.BusiestMoments
 }
 
-// GetStartedAt returns the value of StartedAt.
-func (s *RecordedStream) GetStartedAt() time.Time {
-	return s.StartedAt
+// GetSummary returns the value of Summary.
+func (s *StreamRecap) GetSummary() OptString {
+	return s.Summary
 }
 
-// GetEndedAt returns the value of EndedAt.
-func (s *RecordedStream) GetEndedAt() OptNilDateTime {
-	return s.EndedAt
+// GetCreatedAt returns the value of CreatedAt.
+func (s *StreamRecap) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// GetTitle returns the value of Title.
-func (s *RecordedStream) GetTitle() OptNilString {
-	return s.Title
+// SetDurationSeconds sets the value of DurationSeconds.
+func (s *StreamRecap) SetDurationSeconds(val int64) {
+	s.DurationSeconds = val
 }
 
-// GetGameName returns the value of GameName.
-func (s *RecordedStream) GetGameName() OptNilString {
-	return s.GameName
+// SetPeakViewers sets the value of PeakViewers.
+func (s *StreamRecap) SetPeakViewers(val OptInt64) {
+	s.PeakViewers = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *RecordedStream) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetAverageViewers sets the value of AverageViewers.
+func (s *StreamRecap) SetAverageViewers(val OptInt64) {
+	s.AverageViewers = val
 }
 
-// SetID sets the value of ID.
-func (s *RecordedStream) SetID(val int64) {
-	s.ID = val
+// SetMessages sets the value of Messages.
+func (s *StreamRecap) SetMessages(val int64) {
+	s.Messages = val
 }
 
-// SetChannelID sets the value of ChannelID.
-func (s *RecordedStream) SetChannelID(val int64) {
-	s.ChannelID = val
+// SetUniqueChatters sets the value of UniqueChatters.
+func (s *StreamRecap) SetUniqueChatters(val int64) {
+	s.UniqueChatters = val
 }
 
-// SetChannelLogin sets the value of ChannelLogin.
-func (s *RecordedStream) SetChannelLogin(val string) {
-	s.ChannelLogin = val
+// SetNewChatters sets the value of NewChatters.
+func (s *StreamRecap) SetNewChatters(val int64) {
+	s.NewChatters = val
 }
 
-// SetHelixStreamID sets the value of HelixStreamID.
-func (s *RecordedStream) SetHelixStreamID(val string) {
-	s.HelixStreamID = val
+// SetTopChatters sets the value of TopChatters.
+func (s *StreamRecap) SetTopChatters(val []StreamRecapChatter) {
+	s.TopChatters = val
 }
 
-// SetStartedAt sets the value of StartedAt.
-func (s *RecordedStream) SetStartedAt(val time.Time) {
-	s.StartedAt = val
+// SetSuspiciousUsers sets the value of SuspiciousUsers.
+func (s *StreamRecap) SetSuspiciousUsers(val []StreamRecapUser) {
+	s.SuspiciousUsers = val
 }
 
-// SetEndedAt sets the value of EndedAt.
-func (s *RecordedStream) SetEndedAt(val OptNilDateTime) {
-	s.EndedAt = val
+// SetRuleTriggers sets the value of RuleTriggers.
+func (s *StreamRecap) SetRuleTriggers(val []StreamRecapRule) {
+	s.RuleTriggers = val
 }
 
-// SetTitle sets the value of Title.
-func (s *RecordedStream) SetTitle(val OptNilString) {
-	s.Title = val
+// SetBusiestMoments sets the value of BusiestMoments.
+func (s *StreamRecap) SetBusiestMoments(val []StreamRecapMoment) {
+	s.BusiestMoments = val
 }
 
-// SetGameName sets the value of GameName.
-func (s *RecordedStream) SetGameName(val OptNilString) {
-	s.GameName = val
+// SetSummary sets the value of Summary.
+func (s *StreamRecap) SetSummary(val OptString) {
+	s.Summary = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *RecordedStream) SetCreatedAt(val time.Time) {
+func (s *StreamRecap) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-func (*RecordedStream) getRecordedStreamRes() {}
+// Ref: #/components/schemas/StreamRecapChatter
+type StreamRecapChatter struct {
+	UserTwitchID    int64  `json:"user_twitch_id"`
+	Login           string `json:"login"`
+	MessageCount    int64  `json:"message_count"`
+	PresenceSeconds int64  `json:"presence_seconds"`
+}
 
-// Ref: #/components/schemas/Rule
-type Rule struct {
-	ID int64 `json:"id"`
-	// Display name for this rule.
-	Name           string             `json:"name"`
-	Enabled        bool               `json:"enabled"`
-	EventType      RuleEventType      `json:"event_type"`
-	EventSettings  RuleEventSettings  `json:"event_settings"`
-	Middlewares    []RuleMiddleware   `json:"middlewares"`
-	ActionType     RuleActionType     `json:"action_type"`
-	ActionSettings RuleActionSettings `json:"action_settings"`
-	UseSharedPool  bool               `json:"use_shared_pool"`
-	CreatedAt      time.Time          `json:"created_at"`
-	UpdatedAt      time.Time          `json:"updated_at"`
+// GetUserTwitchID returns the value of UserTwitchID.
+func (s *StreamRecapChatter) GetUserTwitchID() int64 {
+	return s.UserTwitchID
 }
 
-// GetID returns the value of ID.
-func (s *Rule) GetID() int64 {
-	return s.ID
+// GetLogin returns the value of Login.
+func (s *StreamRecapChatter) GetLogin() string {
+	return s.Login
 }
 
-// GetName returns the value of Name.
-func (s *Rule) GetName() string {
-	return s.Name
+// GetMessageCount returns the value of MessageCount.
+func (s *StreamRecapChatter) GetMessageCount() int64 {
+	return s.MessageCount
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *Rule) GetEnabled() bool {
-	return s.Enabled
+// GetPresenceSeconds returns the value of PresenceSeconds.
+func (s *StreamRecapChatter) GetPresenceSeconds() int64 {
+	return s.PresenceSeconds
 }
 
-// GetEventType returns the value of EventType.
-func (s *Rule) GetEventType() RuleEventType {
-	return s.EventType
+// SetUserTwitchID sets the value of UserTwitchID.
+func (s *StreamRecapChatter) SetUserTwitchID(val int64) {
+	s.UserTwitchID = val
 }
 
-// GetEventSettings returns the value of EventSettings.
-func (s *Rule) GetEventSettings() RuleEventSettings {
-	return s.EventSettings
+// SetLogin sets the value of Login.
+func (s *StreamRecapChatter) SetLogin(val string) {
+	s.Login = val
 }
 
-// GetMiddlewares returns the value of Middlewares.
-func (s *Rule) GetMiddlewares() []RuleMiddleware {
-	return s.Middlewares
+// SetMessageCount sets the value of MessageCount.
+func (s *StreamRecapChatter) SetMessageCount(val int64) {
+	s.MessageCount = val
 }
 
-// GetActionType returns the value of ActionType.
-func (s *Rule) GetActionType() RuleActionType {
-	return s.ActionType
+// SetPresenceSeconds sets the value of PresenceSeconds.
+func (s *StreamRecapChatter) SetPresenceSeconds(val int64) {
+	s.PresenceSeconds = val
 }
 
-// GetActionSettings returns the value of ActionSettings.
-func (s *Rule) GetActionSettings() RuleActionSettings {
-	return s.ActionSettings
+// Ref: #/components/schemas/StreamRecapMoment
+type StreamRecapMoment struct {
+	// Start of a 5-minute chat window.
+	Start    time.Time `json:"start"`
+	Messages int64     `json:"messages"`
+	Chatters int64     `json:"chatters"`
+}
+
+// GetStart returns the value of Start.
+func (s *StreamRecapMoment) GetStart() time.Time {
+	return s.Start
 }
 
-// GetUseSharedPool returns the value of UseSharedPool.
-func (s *Rule) GetUseSharedPool() bool {
-	return s.UseSharedPool
+// GetMessages returns the value of Messages.
+func (s *StreamRecapMoment) GetMessages() int64 {
+	return s.Messages
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Rule) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetChatters returns the value of Chatters.
+func (s *StreamRecapMoment) GetChatters() int64 {
+	return s.Chatters
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Rule) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// SetStart sets the value of Start.
+func (s *StreamRecapMoment) SetStart(val time.Time) {
+	s.Start = val
 }
 
-// SetID sets the value of ID.
-func (s *Rule) SetID(val int64) {
-	s.ID = val
+// SetMessages sets the value of Messages.
+func (s *StreamRecapMoment) SetMessages(val int64) {
+	s.Messages = val
 }
 
-// SetName sets the value of Name.
-func (s *Rule) SetName(val string) {
-	s.Name = val
+// SetChatters sets the value of Chatters.
+func (s *StreamRecapMoment) SetChatters(val int64) {
+	s.Chatters = val
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *Rule) SetEnabled(val bool) {
-	s.Enabled = val
+// Ref: #/components/schemas/StreamRecapRule
+type StreamRecapRule struct {
+	// Absent once the rule is deleted.
+	RuleID   OptInt64 `json:"rule_id"`
+	RuleName string   `json:"rule_name"`
+	Triggers int64    `json:"triggers"`
 }
 
-// SetEventType sets the value of EventType.
-func (s *Rule) SetEventType(val RuleEventType) {
-	s.EventType = val
+// GetRuleID returns the value of RuleID.
+func (s *StreamRecapRule) GetRuleID() OptInt64 {
+	return s.RuleID
 }
 
-// SetEventSettings sets the value of EventSettings.
-func (s *Rule) SetEventSettings(val RuleEventSettings) {
-	s.EventSettings = val
+// GetRuleName returns the value of RuleName.
+func (s *StreamRecapRule) GetRuleName() string {
+	return s.RuleName
 }
 
-// SetMiddlewares sets the value of Middlewares.
-func (s *Rule) SetMiddlewares(val []RuleMiddleware) {
-	s.Middlewares = val
+// GetTriggers returns the value of Triggers.
+func (s *StreamRecapRule) GetTriggers() int64 {
+	return s.Triggers
 }
 
-// SetActionType sets the value of ActionType.
-func (s *Rule) SetActionType(val RuleActionType) {
-	s.ActionType = val
+// SetRuleID sets the value of RuleID.
+func (s *StreamRecapRule) SetRuleID(val OptInt64) {
+	s.RuleID = val
 }
 
-// SetActionSettings sets the value of ActionSettings.
-func (s *Rule) SetActionSettings(val RuleActionSettings) {
-	s.ActionSettings = val
+// SetRuleName sets the value of RuleName.
+func (s *StreamRecapRule) SetRuleName(val string) {
+	s.RuleName = val
 }
 
-// SetUseSharedPool sets the value of UseSharedPool.
-func (s *Rule) SetUseSharedPool(val bool) {
-	s.UseSharedPool = val
+// SetTriggers sets the value of Triggers.
+func (s *StreamRecapRule) SetTriggers(val int64) {
+	s.Triggers = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Rule) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// Ref: #/components/schemas/StreamRecapSettings
+type StreamRecapSettings struct {
+	// Add a short LLM-written summary to recaps when AI settings are configured (default true).
+	AiSummary bool `json:"ai_summary"`
+	// Send each recap to notification entries accepting stream_end events (default false).
+	Notify bool `json:"notify"`
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Rule) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
+// GetAiSummary returns the value of AiSummary.
+func (s *StreamRecapSettings) GetAiSummary() bool {
+	return s.AiSummary
 }
 
-func (*Rule) updateRuleRes() {}
+// GetNotify returns the value of Notify.
+func (s *StreamRecapSettings) GetNotify() bool {
+	return s.Notify
+}
 
-type RuleActionSettings map[string]jx.Raw
+// SetAiSummary sets the value of AiSummary.
+func (s *StreamRecapSettings) SetAiSummary(val bool) {
+	s.AiSummary = val
+}
 
-func (s *RuleActionSettings) init() RuleActionSettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetNotify sets the value of Notify.
+func (s *StreamRecapSettings) SetNotify(val bool) {
+	s.Notify = val
 }
 
-// Notify — deliver `action_settings.text` via notification providers.
-// send_chat — post to the event channel via Helix; `action_settings` requires `message` (template).
-// Optional `account_id` (integer, app-linked Twitch OAuth row id) selects which linked account sends
-// the message;
-// if omitted or zero, the server uses the linked bot account when present, otherwise the first
-// linked account.
-// Ref: #/components/schemas/RuleActionType
-type RuleActionType string
+// Ref: #/components/schemas/StreamRecapUser
+type StreamRecapUser struct {
+	UserTwitchID   int64     `json:"user_twitch_id"`
+	Login          string    `json:"login"`
+	SusType        OptString `json:"sus_type"`
+	SusDescription OptString `json:"sus_description"`
+}
 
-const (
-	RuleActionTypeNotify   RuleActionType = "notify"
-	RuleActionTypeSendChat RuleActionType = "send_chat"
-)
+// GetUserTwitchID returns the value of UserTwitchID.
+func (s *StreamRecapUser) GetUserTwitchID() int64 {
+	return s.UserTwitchID
+}
 
-// AllValues returns all RuleActionType values.
-func (RuleActionType) AllValues() []RuleActionType {
-	return []RuleActionType{
-		RuleActionTypeNotify,
-		RuleActionTypeSendChat,
-	}
+// GetLogin returns the value of Login.
+func (s *StreamRecapUser) GetLogin() string {
+	return s.Login
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s RuleActionType) MarshalText() ([]byte, error) {
-	switch s {
-	case RuleActionTypeNotify:
-		return []byte(s), nil
-	case RuleActionTypeSendChat:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetSusType returns the value of SusType.
+func (s *StreamRecapUser) GetSusType() OptString {
+	return s.SusType
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *RuleActionType) UnmarshalText(data []byte) error {
-	switch RuleActionType(data) {
-	case RuleActionTypeNotify:
-		*s = RuleActionTypeNotify
-		return nil
-	case RuleActionTypeSendChat:
-		*s = RuleActionTypeSendChat
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetSusDescription returns the value of SusDescription.
+func (s *StreamRecapUser) GetSusDescription() OptString {
+	return s.SusDescription
 }
 
-type RuleEventSettings map[string]jx.Raw
+// SetUserTwitchID sets the value of UserTwitchID.
+func (s *StreamRecapUser) SetUserTwitchID(val int64) {
+	s.UserTwitchID = val
+}
 
-func (s *RuleEventSettings) init() RuleEventSettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetLogin sets the value of Login.
+func (s *StreamRecapUser) SetLogin(val string) {
+	s.Login = val
 }
 
-// Chat_message — IRC chat line; stream_start / stream_end — Helix live edge; interval —
-// periodic tick (see event_settings).
-// Ref: #/components/schemas/RuleEventType
-type RuleEventType string
+// SetSusType sets the value of SusType.
+func (s *StreamRecapUser) SetSusType(val OptString) {
+	s.SusType = val
+}
 
-const (
-	RuleEventTypeChatMessage RuleEventType = "chat_message"
-	RuleEventTypeStreamStart RuleEventType = "stream_start"
-	RuleEventTypeStreamEnd   RuleEventType = "stream_end"
-	RuleEventTypeInterval    RuleEventType = "interval"
-)
+// SetSusDescription sets the value of SusDescription.
+func (s *StreamRecapUser) SetSusDescription(val OptString) {
+	s.SusDescription = val
+}
 
-// AllValues returns all RuleEventType values.
-func (RuleEventType) AllValues() []RuleEventType {
-	return []RuleEventType{
-		RuleEventTypeChatMessage,
-		RuleEventTypeStreamStart,
-		RuleEventTypeStreamEnd,
-		RuleEventTypeInterval,
-	}
+// Ref: #/components/schemas/StreamRetentionEntry
+type StreamRetentionEntry struct {
+	StreamID  int64     `json:"stream_id"`
+	StartedAt time.Time `json:"started_at"`
+	Title     OptString `json:"title"`
+	// Distinct message senders during the stream.
+	Chatters int `json:"chatters"`
+	// Of those, how many chatted again in the next stream.
+	ReturningChatters int      `json:"returning_chatters"`
+	NextStreamID      NilInt64 `json:"next_stream_id"`
+	// Share of chatters who returned (returning_chatters / chatters); null until a next stream exists or
+	// without chatters.
+	Retention NilFloat64 `json:"retention"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s RuleEventType) MarshalText() ([]byte, error) {
-	switch s {
-	case RuleEventTypeChatMessage:
-		return []byte(s), nil
-	case RuleEventTypeStreamStart:
-		return []byte(s), nil
-	case RuleEventTypeStreamEnd:
-		return []byte(s), nil
-	case RuleEventTypeInterval:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetStreamID returns the value of StreamID.
+func (s *StreamRetentionEntry) GetStreamID() int64 {
+	return s.StreamID
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *RuleEventType) UnmarshalText(data []byte) error {
-	switch RuleEventType(data) {
-	case RuleEventTypeChatMessage:
-		*s = RuleEventTypeChatMessage
-		return nil
-	case RuleEventTypeStreamStart:
-		*s = RuleEventTypeStreamStart
-		return nil
-	case RuleEventTypeStreamEnd:
-		*s = RuleEventTypeStreamEnd
-		return nil
-	case RuleEventTypeInterval:
-		*s = RuleEventTypeInterval
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetStartedAt returns the value of StartedAt.
+func (s *StreamRetentionEntry) GetStartedAt() time.Time {
+	return s.StartedAt
 }
 
-// Ref: #/components/schemas/RuleMiddleware
-type RuleMiddleware struct {
-	// Filter_channel, filter_user, match_regex, contains_word, cooldown.
-	Type     string                 `json:"type"`
-	Settings RuleMiddlewareSettings `json:"settings"`
+// GetTitle returns the value of Title.
+func (s *StreamRetentionEntry) GetTitle() OptString {
+	return s.Title
 }
 
-// GetType returns the value of Type.
-func (s *RuleMiddleware) GetType() string {
-	return s.Type
+// GetChatters returns the value of Chatters.
+func (s *StreamRetentionEntry) GetChatters() int {
+	return s.Chatters
 }
 
-// GetSettings returns the value of Settings.
-func (s *RuleMiddleware) GetSettings() RuleMiddlewareSettings {
-	return s.Settings
+// GetReturningChatters returns the value of ReturningChatters.
+func (s *StreamRetentionEntry) GetReturningChatters() int {
+	return s.ReturningChatters
 }
 
-// SetType sets the value of Type.
-func (s *RuleMiddleware) SetType(val string) {
-	s.Type = val
+// GetNextStreamID returns the value of NextStreamID.
+func (s *StreamRetentionEntry) GetNextStreamID() NilInt64 {
+	return s.NextStreamID
 }
 
-// SetSettings sets the value of Settings.
-func (s *RuleMiddleware) SetSettings(val RuleMiddlewareSettings) {
-	s.Settings = val
+// GetRetention returns the value of Retention.
+func (s *StreamRetentionEntry) GetRetention() NilFloat64 {
+	return s.Retention
 }
 
-type RuleMiddlewareSettings map[string]jx.Raw
+// SetStreamID sets the value of StreamID.
+func (s *StreamRetentionEntry) SetStreamID(val int64) {
+	s.StreamID = val
+}
 
-func (s *RuleMiddlewareSettings) init() RuleMiddlewareSettings {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetStartedAt sets the value of StartedAt.
+func (s *StreamRetentionEntry) SetStartedAt(val time.Time) {
+	s.StartedAt = val
 }
 
-// Ref: #/components/schemas/RuleTemplateVariable
-type RuleTemplateVariable struct {
-	// Placeholder key without leading $ (e.g. CHANNEL); use as $CHANNEL in templates.
-	Name        string `json:"name"`
-	Description string `json:"description"`
+// SetTitle sets the value of Title.
+func (s *StreamRetentionEntry) SetTitle(val OptString) {
+	s.Title = val
 }
 
-// GetName returns the value of Name.
-func (s *RuleTemplateVariable) GetName() string {
-	return s.Name
+// SetChatters sets the value of Chatters.
+func (s *StreamRetentionEntry) SetChatters(val int) {
+	s.Chatters = val
 }
 
-// GetDescription returns the value of Description.
-func (s *RuleTemplateVariable) GetDescription() string {
-	return s.Description
+// SetReturningChatters sets the value of ReturningChatters.
+func (s *StreamRetentionEntry) SetReturningChatters(val int) {
+	s.ReturningChatters = val
 }
 
-// SetName sets the value of Name.
-func (s *RuleTemplateVariable) SetName(val string) {
-	s.Name = val
+// SetNextStreamID sets the value of NextStreamID.
+func (s *StreamRetentionEntry) SetNextStreamID(val NilInt64) {
+	s.NextStreamID = val
 }
 
-// SetDescription sets the value of Description.
-func (s *RuleTemplateVariable) SetDescription(val string) {
-	s.Description = val
+// SetRetention sets the value of Retention.
+func (s *StreamRetentionEntry) SetRetention(val NilFloat64) {
+	s.Retention = val
 }
 
-// Ref: #/components/schemas/RuleTemplateVariablesResponse
-type RuleTemplateVariablesResponse struct {
-	Variables []RuleTemplateVariable `json:"variables"`
+// Ref: #/components/schemas/SuspicionEvent
+type SuspicionEvent struct {
+	ID                int64                `json:"id"`
+	TwitchUserID      int64                `json:"twitch_user_id"`
+	Username          string               `json:"username"`
+	Source            SuspicionEventSource `json:"source"`
+	OldIsSus          bool                 `json:"old_is_sus"`
+	NewIsSus          bool                 `json:"new_is_sus"`
+	OldSusType        OptNilString         `json:"old_sus_type"`
+	NewSusType        OptNilString         `json:"new_sus_type"`
+	OldSusDescription OptNilString         `json:"old_sus_description"`
+	NewSusDescription OptNilString         `json:"new_sus_description"`
+	// Snapshot of what drove the change (score breakdown for auto, actor for manual).
+	Evidence  OptSuspicionEventEvidence `json:"evidence"`
+	CreatedAt time.Time                 `json:"created_at"`
 }
 
-// GetVariables returns the value of Variables.
-func (s *RuleTemplateVariablesResponse) GetVariables() []RuleTemplateVariable {
-	return s.Variables
+// GetID returns the value of ID.
+func (s *SuspicionEvent) GetID() int64 {
+	return s.ID
 }
 
-// SetVariables sets the value of Variables.
-func (s *RuleTemplateVariablesResponse) SetVariables(val []RuleTemplateVariable) {
-	s.Variables = val
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *SuspicionEvent) GetTwitchUserID() int64 {
+	return s.TwitchUserID
 }
 
-// Ref: #/components/schemas/RuleTrigger
-type RuleTrigger struct {
-	ID           int64       `json:"id"`
-	CreatedAt    time.Time   `json:"created_at"`
-	RuleID       OptNilInt64 `json:"rule_id"`
-	RuleName     string      `json:"rule_name"`
-	TriggerEvent string      `json:"trigger_event"`
-	ActionType   string      `json:"action_type"`
-	DisplayText  string      `json:"display_text"`
+// GetUsername returns the value of Username.
+func (s *SuspicionEvent) GetUsername() string {
+	return s.Username
 }
 
-// GetID returns the value of ID.
-func (s *RuleTrigger) GetID() int64 {
-	return s.ID
+// GetSource returns the value of Source.
+func (s *SuspicionEvent) GetSource() SuspicionEventSource {
+	return s.Source
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *RuleTrigger) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetOldIsSus returns the value of OldIsSus.
+func (s *SuspicionEvent) GetOldIsSus() bool {
+	return s.OldIsSus
 }
 
-// GetRuleID returns the value of RuleID.
-func (s *RuleTrigger) GetRuleID() OptNilInt64 {
-	return s.RuleID
+// GetNewIsSus returns the value of NewIsSus.
+func (s *SuspicionEvent) GetNewIsSus() bool {
+	return s.NewIsSus
 }
 
-// GetRuleName returns the value of RuleName.
-func (s *RuleTrigger) GetRuleName() string {
-	return s.RuleName
+// GetOldSusType returns the value of OldSusType.
+func (s *SuspicionEvent) GetOldSusType() OptNilString {
+	return s.OldSusType
 }
 
-// GetTriggerEvent returns the value of TriggerEvent.
-func (s *RuleTrigger) GetTriggerEvent() string {
-	return s.TriggerEvent
+// GetNewSusType returns the value of NewSusType.
+func (s *SuspicionEvent) GetNewSusType() OptNilString {
+	return s.NewSusType
 }
 
-// GetActionType returns the value of ActionType.
-func (s *RuleTrigger) GetActionType() string {
-	return s.ActionType
+// GetOldSusDescription returns the value of OldSusDescription.
+func (s *SuspicionEvent) GetOldSusDescription() OptNilString {
+	return s.OldSusDescription
 }
 
-// GetDisplayText returns the value of DisplayText.
-func (s *RuleTrigger) GetDisplayText() string {
-	return s.DisplayText
+// GetNewSusDescription returns the value of NewSusDescription.
+func (s *SuspicionEvent) GetNewSusDescription() OptNilString {
+	return s.NewSusDescription
+}
+
+// GetEvidence returns the value of Evidence.
+func (s *SuspicionEvent) GetEvidence() OptSuspicionEventEvidence {
+	return s.Evidence
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SuspicionEvent) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
 // SetID sets the value of ID.
-func (s *RuleTrigger) SetID(val int64) {
+func (s *SuspicionEvent) SetID(val int64) {
 	s.ID = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *RuleTrigger) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *SuspicionEvent) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
 }
 
-// SetRuleID sets the value of RuleID.
-func (s *RuleTrigger) SetRuleID(val OptNilInt64) {
-	s.RuleID = val
+// SetUsername sets the value of Username.
+func (s *SuspicionEvent) SetUsername(val string) {
+	s.Username = val
 }
 
-// SetRuleName sets the value of RuleName.
-func (s *RuleTrigger) SetRuleName(val string) {
-	s.RuleName = val
+// SetSource sets the value of Source.
+func (s *SuspicionEvent) SetSource(val SuspicionEventSource) {
+	s.Source = val
 }
 
-// SetTriggerEvent sets the value of TriggerEvent.
-func (s *RuleTrigger) SetTriggerEvent(val string) {
-	s.TriggerEvent = val
+// SetOldIsSus sets the value of OldIsSus.
+func (s *SuspicionEvent) SetOldIsSus(val bool) {
+	s.OldIsSus = val
 }
 
-// SetActionType sets the value of ActionType.
-func (s *RuleTrigger) SetActionType(val string) {
-	s.ActionType = val
+// SetNewIsSus sets the value of NewIsSus.
+func (s *SuspicionEvent) SetNewIsSus(val bool) {
+	s.NewIsSus = val
 }
 
-// SetDisplayText sets the value of DisplayText.
-func (s *RuleTrigger) SetDisplayText(val string) {
-	s.DisplayText = val
+// SetOldSusType sets the value of OldSusType.
+func (s *SuspicionEvent) SetOldSusType(val OptNilString) {
+	s.OldSusType = val
 }
 
-// SendMessageAccepted is response for SendMessage operation.
-type SendMessageAccepted struct{}
+// SetNewSusType sets the value of NewSusType.
+func (s *SuspicionEvent) SetNewSusType(val OptNilString) {
+	s.NewSusType = val
+}
 
-func (*SendMessageAccepted) sendMessageRes() {}
+// SetOldSusDescription sets the value of OldSusDescription.
+func (s *SuspicionEvent) SetOldSusDescription(val OptNilString) {
+	s.OldSusDescription = val
+}
 
-type SendMessageBadGateway ClientNotice
+// SetNewSusDescription sets the value of NewSusDescription.
+func (s *SuspicionEvent) SetNewSusDescription(val OptNilString) {
+	s.NewSusDescription = val
+}
 
-func (*SendMessageBadGateway) sendMessageRes() {}
+// SetEvidence sets the value of Evidence.
+func (s *SuspicionEvent) SetEvidence(val OptSuspicionEventEvidence) {
+	s.Evidence = val
+}
 
-type SendMessageBadRequest ClientNotice
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SuspicionEvent) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
 
-func (*SendMessageBadRequest) sendMessageRes() {}
+// Snapshot of what drove the change (score breakdown for auto, actor for manual).
+type SuspicionEventEvidence map[string]jx.Raw
 
-// Ref: #/components/schemas/SendMessageRequest
-type SendMessageRequest struct {
-	AccountID int64  `json:"account_id"`
-	Channel   string `json:"channel"`
-	Message   string `json:"message"`
+func (s *SuspicionEventEvidence) init() SuspicionEventEvidence {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// GetAccountID returns the value of AccountID.
-func (s *SendMessageRequest) GetAccountID() int64 {
-	return s.AccountID
-}
+// Ref: #/components/schemas/SuspicionEventSource
+type SuspicionEventSource string
 
-// GetChannel returns the value of Channel.
-func (s *SendMessageRequest) GetChannel() string {
-	return s.Channel
-}
+const (
+	SuspicionEventSourceAuto     SuspicionEventSource = "auto"
+	SuspicionEventSourceManual   SuspicionEventSource = "manual"
+	SuspicionEventSourceTelegram SuspicionEventSource = "telegram"
+	SuspicionEventSourceAi       SuspicionEventSource = "ai"
+	SuspicionEventSourceRule     SuspicionEventSource = "rule"
+)
 
-// GetMessage returns the value of Message.
-func (s *SendMessageRequest) GetMessage() string {
-	return s.Message
+// AllValues returns all SuspicionEventSource values.
+func (SuspicionEventSource) AllValues() []SuspicionEventSource {
+	return []SuspicionEventSource{
+		SuspicionEventSourceAuto,
+		SuspicionEventSourceManual,
+		SuspicionEventSourceTelegram,
+		SuspicionEventSourceAi,
+		SuspicionEventSourceRule,
+	}
 }
 
-// SetAccountID sets the value of AccountID.
-func (s *SendMessageRequest) SetAccountID(val int64) {
-	s.AccountID = val
+// MarshalText implements encoding.TextMarshaler.
+func (s SuspicionEventSource) MarshalText() ([]byte, error) {
+	switch s {
+	case SuspicionEventSourceAuto:
+		return []byte(s), nil
+	case SuspicionEventSourceManual:
+		return []byte(s), nil
+	case SuspicionEventSourceTelegram:
+		return []byte(s), nil
+	case SuspicionEventSourceAi:
+		return []byte(s), nil
+	case SuspicionEventSourceRule:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetChannel sets the value of Channel.
-func (s *SendMessageRequest) SetChannel(val string) {
-	s.Channel = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SuspicionEventSource) UnmarshalText(data []byte) error {
+	switch SuspicionEventSource(data) {
+	case SuspicionEventSourceAuto:
+		*s = SuspicionEventSourceAuto
+		return nil
+	case SuspicionEventSourceManual:
+		*s = SuspicionEventSourceManual
+		return nil
+	case SuspicionEventSourceTelegram:
+		*s = SuspicionEventSourceTelegram
+		return nil
+	case SuspicionEventSourceAi:
+		*s = SuspicionEventSourceAi
+		return nil
+	case SuspicionEventSourceRule:
+		*s = SuspicionEventSourceRule
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetMessage sets the value of Message.
-func (s *SendMessageRequest) SetMessage(val string) {
-	s.Message = val
+// Ref: #/components/schemas/SuspicionReevaluation
+type SuspicionReevaluation struct {
+	Running bool `json:"running"`
+	// Another run was requested and starts when this one finishes.
+	Queued bool `json:"queued"`
+	// What started the run; empty before the first run.
+	Reason        SuspicionReevaluationReason `json:"reason"`
+	RefetchBudget int                         `json:"refetch_budget"`
+	// Known users when the run started.
+	Total     int64 `json:"total"`
+	Processed int   `json:"processed"`
+	Evaluated int   `json:"evaluated"`
+	// Users whose follows were re-synced before scoring.
+	Refetched int `json:"refetched"`
+	// Users whose follows were never synced.
+	Skipped    int         `json:"skipped"`
+	Failed     int         `json:"failed"`
+	StartedAt  OptDateTime `json:"started_at"`
+	FinishedAt OptDateTime `json:"finished_at"`
 }
 
-type SendMessageUnauthorized ClientNotice
-
-func (*SendMessageUnauthorized) sendMessageRes() {}
+// GetRunning returns the value of Running.
+func (s *SuspicionReevaluation) GetRunning() bool {
+	return s.Running
+}
 
-type SendMessageUnprocessableEntity ClientNotice
+// GetQueued returns the value of Queued.
+func (s *SuspicionReevaluation) GetQueued() bool {
+	return s.Queued
+}
 
-func (*SendMessageUnprocessableEntity) sendMessageRes() {}
+// GetReason returns the value of Reason.
+func (s *SuspicionReevaluation) GetReason() SuspicionReevaluationReason {
+	return s.Reason
+}
 
-// SetChannelBlacklistNoContent is response for SetChannelBlacklist operation.
-type SetChannelBlacklistNoContent struct{}
+// GetRefetchBudget returns the value of RefetchBudget.
+func (s *SuspicionReevaluation) GetRefetchBudget() int {
+	return s.RefetchBudget
+}
 
-func (*SetChannelBlacklistNoContent) setChannelBlacklistRes() {}
+// GetTotal returns the value of Total.
+func (s *SuspicionReevaluation) GetTotal() int64 {
+	return s.Total
+}
 
-// Ref: #/components/schemas/StartTwitchOAuthRequest
-type StartTwitchOAuthRequest struct {
-	// Optional SPA URL to open after OAuth (same scheme/host/port as configured twitch.oauth_return_url).
-	//  For hash routers, include the route in the fragment (e.g. http://localhost/#/settings?t=1).
-	ReturnURL OptString `json:"return_url"`
+// GetProcessed returns the value of Processed.
+func (s *SuspicionReevaluation) GetProcessed() int {
+	return s.Processed
 }
 
-// GetReturnURL returns the value of ReturnURL.
-func (s *StartTwitchOAuthRequest) GetReturnURL() OptString {
-	return s.ReturnURL
+// GetEvaluated returns the value of Evaluated.
+func (s *SuspicionReevaluation) GetEvaluated() int {
+	return s.Evaluated
 }
 
-// SetReturnURL sets the value of ReturnURL.
-func (s *StartTwitchOAuthRequest) SetReturnURL(val OptString) {
-	s.ReturnURL = val
+// GetRefetched returns the value of Refetched.
+func (s *SuspicionReevaluation) GetRefetched() int {
+	return s.Refetched
 }
 
-// Ref: #/components/schemas/StartTwitchOAuthResponse
-type StartTwitchOAuthResponse struct {
-	// Twitch authorize URL; redirect the user here (e.g. window.location.assign).
-	AuthorizeURL string `json:"authorize_url"`
+// GetSkipped returns the value of Skipped.
+func (s *SuspicionReevaluation) GetSkipped() int {
+	return s.Skipped
 }
 
-// GetAuthorizeURL returns the value of AuthorizeURL.
-func (s *StartTwitchOAuthResponse) GetAuthorizeURL() string {
-	return s.AuthorizeURL
+// GetFailed returns the value of Failed.
+func (s *SuspicionReevaluation) GetFailed() int {
+	return s.Failed
 }
 
-// SetAuthorizeURL sets the value of AuthorizeURL.
-func (s *StartTwitchOAuthResponse) SetAuthorizeURL(val string) {
-	s.AuthorizeURL = val
+// GetStartedAt returns the value of StartedAt.
+func (s *SuspicionReevaluation) GetStartedAt() OptDateTime {
+	return s.StartedAt
 }
 
-// StopAiAgentNoContent is response for StopAiAgent operation.
-type StopAiAgentNoContent struct{}
+// GetFinishedAt returns the value of FinishedAt.
+func (s *SuspicionReevaluation) GetFinishedAt() OptDateTime {
+	return s.FinishedAt
+}
 
-func (*StopAiAgentNoContent) stopAiAgentRes() {}
+// SetRunning sets the value of Running.
+func (s *SuspicionReevaluation) SetRunning(val bool) {
+	s.Running = val
+}
 
-// Ref: #/components/schemas/StreamLeaderboardEntry
-type StreamLeaderboardEntry struct {
-	Login            string         `json:"login"`
-	UserTwitchID     int64          `json:"user_twitch_id"`
-	PresenceSeconds  int64          `json:"presence_seconds"`
-	MessageCount     int64          `json:"message_count"`
-	AccountCreatedAt OptNilDateTime `json:"account_created_at"`
+// SetQueued sets the value of Queued.
+func (s *SuspicionReevaluation) SetQueued(val bool) {
+	s.Queued = val
 }
 
-// GetLogin returns the value of Login.
-func (s *StreamLeaderboardEntry) GetLogin() string {
-	return s.Login
+// SetReason sets the value of Reason.
+func (s *SuspicionReevaluation) SetReason(val SuspicionReevaluationReason) {
+	s.Reason = val
 }
 
-// GetUserTwitchID returns the value of UserTwitchID.
-func (s *StreamLeaderboardEntry) GetUserTwitchID() int64 {
-	return s.UserTwitchID
+// SetRefetchBudget sets the value of RefetchBudget.
+func (s *SuspicionReevaluation) SetRefetchBudget(val int) {
+	s.RefetchBudget = val
 }
 
-// GetPresenceSeconds returns the value of PresenceSeconds.
-func (s *StreamLeaderboardEntry) GetPresenceSeconds() int64 {
-	return s.PresenceSeconds
+// SetTotal sets the value of Total.
+func (s *SuspicionReevaluation) SetTotal(val int64) {
+	s.Total = val
 }
 
-// GetMessageCount returns the value of MessageCount.
-func (s *StreamLeaderboardEntry) GetMessageCount() int64 {
-	return s.MessageCount
+// SetProcessed sets the value of Processed.
+func (s *SuspicionReevaluation) SetProcessed(val int) {
+	s.Processed = val
 }
 
-// GetAccountCreatedAt returns the value of AccountCreatedAt.
-func (s *StreamLeaderboardEntry) GetAccountCreatedAt() OptNilDateTime {
-	return s.AccountCreatedAt
+// SetEvaluated sets the value of Evaluated.
+func (s *SuspicionReevaluation) SetEvaluated(val int) {
+	s.Evaluated = val
 }
 
-// SetLogin sets the value of Login.
-func (s *StreamLeaderboardEntry) SetLogin(val string) {
-	s.Login = val
+// SetRefetched sets the value of Refetched.
+func (s *SuspicionReevaluation) SetRefetched(val int) {
+	s.Refetched = val
 }
 
-// SetUserTwitchID sets the value of UserTwitchID.
-func (s *StreamLeaderboardEntry) SetUserTwitchID(val int64) {
-	s.UserTwitchID = val
+// SetSkipped sets the value of Skipped.
+func (s *SuspicionReevaluation) SetSkipped(val int) {
+	s.Skipped = val
 }
 
-// SetPresenceSeconds sets the value of PresenceSeconds.
-func (s *StreamLeaderboardEntry) SetPresenceSeconds(val int64) {
-	s.PresenceSeconds = val
+// SetFailed sets the value of Failed.
+func (s *SuspicionReevaluation) SetFailed(val int) {
+	s.Failed = val
 }
 
-// SetMessageCount sets the value of MessageCount.
-func (s *StreamLeaderboardEntry) SetMessageCount(val int64) {
-	s.MessageCount = val
+// SetStartedAt sets the value of StartedAt.
+func (s *SuspicionReevaluation) SetStartedAt(val OptDateTime) {
+	s.StartedAt = val
 }
 
-// SetAccountCreatedAt sets the value of AccountCreatedAt.
-func (s *StreamLeaderboardEntry) SetAccountCreatedAt(val OptNilDateTime) {
-	s.AccountCreatedAt = val
+// SetFinishedAt sets the value of FinishedAt.
+func (s *SuspicionReevaluation) SetFinishedAt(val OptDateTime) {
+	s.FinishedAt = val
 }
 
-// Ref: #/components/schemas/StreamLeaderboardSort
-type StreamLeaderboardSort string
+// What started the run; empty before the first run.
+type SuspicionReevaluationReason string
 
 const (
-	StreamLeaderboardSortPresenceDesc StreamLeaderboardSort = "presence_desc"
-	StreamLeaderboardSortPresenceAsc  StreamLeaderboardSort = "presence_asc"
-	StreamLeaderboardSortMessagesDesc StreamLeaderboardSort = "messages_desc"
-	StreamLeaderboardSortMessagesAsc  StreamLeaderboardSort = "messages_asc"
-	StreamLeaderboardSortLoginAz      StreamLeaderboardSort = "login_az"
-	StreamLeaderboardSortLoginZa      StreamLeaderboardSort = "login_za"
-	StreamLeaderboardSortAccountNew   StreamLeaderboardSort = "account_new"
-	StreamLeaderboardSortAccountOld   StreamLeaderboardSort = "account_old"
+	SuspicionReevaluationReasonEmpty             SuspicionReevaluationReason = ""
+	SuspicionReevaluationReasonSuspicionSettings SuspicionReevaluationReason = "suspicion_settings"
+	SuspicionReevaluationReasonBlacklist         SuspicionReevaluationReason = "blacklist"
+	SuspicionReevaluationReasonLoginPatterns     SuspicionReevaluationReason = "login_patterns"
+	SuspicionReevaluationReasonBlocklists        SuspicionReevaluationReason = "blocklists"
+	SuspicionReevaluationReasonManual            SuspicionReevaluationReason = "manual"
 )
 
-// AllValues returns all StreamLeaderboardSort values.
-func (StreamLeaderboardSort) AllValues() []StreamLeaderboardSort {
-	return []StreamLeaderboardSort{
-		StreamLeaderboardSortPresenceDesc,
-		StreamLeaderboardSortPresenceAsc,
-		StreamLeaderboardSortMessagesDesc,
-		StreamLeaderboardSortMessagesAsc,
-		StreamLeaderboardSortLoginAz,
-		StreamLeaderboardSortLoginZa,
-		StreamLeaderboardSortAccountNew,
-		StreamLeaderboardSortAccountOld,
+// AllValues returns all SuspicionReevaluationReason values.
+func (SuspicionReevaluationReason) AllValues() []SuspicionReevaluationReason {
+	return []SuspicionReevaluationReason{
+		SuspicionReevaluationReasonEmpty,
+		SuspicionReevaluationReasonSuspicionSettings,
+		SuspicionReevaluationReasonBlacklist,
+		SuspicionReevaluationReasonLoginPatterns,
+		SuspicionReevaluationReasonBlocklists,
+		SuspicionReevaluationReasonManual,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s StreamLeaderboardSort) MarshalText() ([]byte, error) {
+func (s SuspicionReevaluationReason) MarshalText() ([]byte, error) {
 	switch s {
-	case StreamLeaderboardSortPresenceDesc:
-		return []byte(s), nil
-	case StreamLeaderboardSortPresenceAsc:
+	case SuspicionReevaluationReasonEmpty:
 		return []byte(s), nil
-	case StreamLeaderboardSortMessagesDesc:
-		return []byte(s), nil
-	case StreamLeaderboardSortMessagesAsc:
+	case SuspicionReevaluationReasonSuspicionSettings:
 		return []byte(s), nil
-	case StreamLeaderboardSortLoginAz:
+	case SuspicionReevaluationReasonBlacklist:
 		return []byte(s), nil
-	case StreamLeaderboardSortLoginZa:
+	case SuspicionReevaluationReasonLoginPatterns:
 		return []byte(s), nil
-	case StreamLeaderboardSortAccountNew:
+	case SuspicionReevaluationReasonBlocklists:
 		return []byte(s), nil
-	case StreamLeaderboardSortAccountOld:
+	case SuspicionReevaluationReasonManual:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -3846,37 +9144,81 @@ func (s StreamLeaderboardSort) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *StreamLeaderboardSort) UnmarshalText(data []byte) error {
-	switch StreamLeaderboardSort(data) {
-	case StreamLeaderboardSortPresenceDesc:
-		*s = StreamLeaderboardSortPresenceDesc
-		return nil
-	case StreamLeaderboardSortPresenceAsc:
-		*s = StreamLeaderboardSortPresenceAsc
-		return nil
-	case StreamLeaderboardSortMessagesDesc:
-		*s = StreamLeaderboardSortMessagesDesc
+func (s *SuspicionReevaluationReason) UnmarshalText(data []byte) error {
+	switch SuspicionReevaluationReason(data) {
+	case SuspicionReevaluationReasonEmpty:
+		*s = SuspicionReevaluationReasonEmpty
 		return nil
-	case StreamLeaderboardSortMessagesAsc:
-		*s = StreamLeaderboardSortMessagesAsc
+	case SuspicionReevaluationReasonSuspicionSettings:
+		*s = SuspicionReevaluationReasonSuspicionSettings
 		return nil
-	case StreamLeaderboardSortLoginAz:
-		*s = StreamLeaderboardSortLoginAz
+	case SuspicionReevaluationReasonBlacklist:
+		*s = SuspicionReevaluationReasonBlacklist
 		return nil
-	case StreamLeaderboardSortLoginZa:
-		*s = StreamLeaderboardSortLoginZa
+	case SuspicionReevaluationReasonLoginPatterns:
+		*s = SuspicionReevaluationReasonLoginPatterns
 		return nil
-	case StreamLeaderboardSortAccountNew:
-		*s = StreamLeaderboardSortAccountNew
+	case SuspicionReevaluationReasonBlocklists:
+		*s = SuspicionReevaluationReasonBlocklists
 		return nil
-	case StreamLeaderboardSortAccountOld:
-		*s = StreamLeaderboardSortAccountOld
+	case SuspicionReevaluationReasonManual:
+		*s = SuspicionReevaluationReasonManual
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// Ref: #/components/schemas/SuspicionScore
+type SuspicionScore struct {
+	Score int `json:"score"`
+	// Score at or above which the user is marked suspicious.
+	Threshold  int       `json:"threshold"`
+	ComputedAt time.Time `json:"computed_at"`
+	// Signals that contributed to the score.
+	Signals []SuspicionSignal `json:"signals"`
+}
+
+// GetScore returns the value of Score.
+func (s *SuspicionScore) GetScore() int {
+	return s.Score
+}
+
+// GetThreshold returns the value of Threshold.
+func (s *SuspicionScore) GetThreshold() int {
+	return s.Threshold
+}
+
+// GetComputedAt returns the value of ComputedAt.
+func (s *SuspicionScore) GetComputedAt() time.Time {
+	return s.ComputedAt
+}
+
+// GetSignals returns the value of Signals.
+func (s *SuspicionScore) GetSignals() []SuspicionSignal {
+	return s.Signals
+}
+
+// SetScore sets the value of Score.
+func (s *SuspicionScore) SetScore(val int) {
+	s.Score = val
+}
+
+// SetThreshold sets the value of Threshold.
+func (s *SuspicionScore) SetThreshold(val int) {
+	s.Threshold = val
+}
+
+// SetComputedAt sets the value of ComputedAt.
+func (s *SuspicionScore) SetComputedAt(val time.Time) {
+	s.ComputedAt = val
+}
+
+// SetSignals sets the value of Signals.
+func (s *SuspicionScore) SetSignals(val []SuspicionSignal) {
+	s.Signals = val
+}
+
 // Ref: #/components/schemas/SuspicionSettings
 type SuspicionSettings struct {
 	AutoCheckAccountAge bool `json:"auto_check_account_age"`
@@ -3888,6 +9230,13 @@ type SuspicionSettings struct {
 	LowFollowsThreshold int `json:"low_follows_threshold"`
 	// Safety cap when paginating GQL follows per user (default 1 page).
 	MaxGqlFollowPages int `json:"max_gql_follow_pages"`
+	// Weighted score at or above which a user is marked suspicious (omit to keep current).
+	ScoreThreshold OptInt              `json:"score_threshold"`
+	Weights        OptSuspicionWeights `json:"weights"`
+	// Case-insensitive regexes matched against the login (omit to keep current).
+	NamePatterns []string `json:"name_patterns"`
+	// Simultaneous monitored channels needed for the multi-channel signal (omit to keep current).
+	MultiChannelThreshold OptInt `json:"multi_channel_threshold"`
 }
 
 // GetAutoCheckAccountAge returns the value of AutoCheckAccountAge.
@@ -3920,6 +9269,26 @@ func (s *SuspicionSettings) GetMaxGqlFollowPages() int {
 	return s.MaxGqlFollowPages
 }
 
+// GetScoreThreshold returns the value of ScoreThreshold.
+func (s *SuspicionSettings) GetScoreThreshold() OptInt {
+	return s.ScoreThreshold
+}
+
+// GetWeights returns the value of Weights.
+func (s *SuspicionSettings) GetWeights() OptSuspicionWeights {
+	return s.Weights
+}
+
+// GetNamePatterns returns the value of NamePatterns.
+func (s *SuspicionSettings) GetNamePatterns() []string {
+	return s.NamePatterns
+}
+
+// GetMultiChannelThreshold returns the value of MultiChannelThreshold.
+func (s *SuspicionSettings) GetMultiChannelThreshold() OptInt {
+	return s.MultiChannelThreshold
+}
+
 // SetAutoCheckAccountAge sets the value of AutoCheckAccountAge.
 func (s *SuspicionSettings) SetAutoCheckAccountAge(val bool) {
 	s.AutoCheckAccountAge = val
@@ -3950,6 +9319,260 @@ func (s *SuspicionSettings) SetMaxGqlFollowPages(val int) {
 	s.MaxGqlFollowPages = val
 }
 
+// SetScoreThreshold sets the value of ScoreThreshold.
+func (s *SuspicionSettings) SetScoreThreshold(val OptInt) {
+	s.ScoreThreshold = val
+}
+
+// SetWeights sets the value of Weights.
+func (s *SuspicionSettings) SetWeights(val OptSuspicionWeights) {
+	s.Weights = val
+}
+
+// SetNamePatterns sets the value of NamePatterns.
+func (s *SuspicionSettings) SetNamePatterns(val []string) {
+	s.NamePatterns = val
+}
+
+// SetMultiChannelThreshold sets the value of MultiChannelThreshold.
+func (s *SuspicionSettings) SetMultiChannelThreshold(val OptInt) {
+	s.MultiChannelThreshold = val
+}
+
+func (*SuspicionSettings) updateSuspicionSettingsRes() {}
+
+// Ref: #/components/schemas/SuspicionSignal
+type SuspicionSignal struct {
+	Key SuspicionSignalKey `json:"key"`
+	// Points this signal contributed.
+	Score int `json:"score"`
+	// Maximum points this signal can contribute.
+	Weight int `json:"weight"`
+	// Human-readable explanation.
+	Detail string `json:"detail"`
+}
+
+// GetKey returns the value of Key.
+func (s *SuspicionSignal) GetKey() SuspicionSignalKey {
+	return s.Key
+}
+
+// GetScore returns the value of Score.
+func (s *SuspicionSignal) GetScore() int {
+	return s.Score
+}
+
+// GetWeight returns the value of Weight.
+func (s *SuspicionSignal) GetWeight() int {
+	return s.Weight
+}
+
+// GetDetail returns the value of Detail.
+func (s *SuspicionSignal) GetDetail() string {
+	return s.Detail
+}
+
+// SetKey sets the value of Key.
+func (s *SuspicionSignal) SetKey(val SuspicionSignalKey) {
+	s.Key = val
+}
+
+// SetScore sets the value of Score.
+func (s *SuspicionSignal) SetScore(val int) {
+	s.Score = val
+}
+
+// SetWeight sets the value of Weight.
+func (s *SuspicionSignal) SetWeight(val int) {
+	s.Weight = val
+}
+
+// SetDetail sets the value of Detail.
+func (s *SuspicionSignal) SetDetail(val string) {
+	s.Detail = val
+}
+
+type SuspicionSignalKey string
+
+const (
+	SuspicionSignalKeyAccountAge       SuspicionSignalKey = "account_age"
+	SuspicionSignalKeyBlacklistFollows SuspicionSignalKey = "blacklist_follows"
+	SuspicionSignalKeyLowFollows       SuspicionSignalKey = "low_follows"
+	SuspicionSignalKeyNamePattern      SuspicionSignalKey = "name_pattern"
+	SuspicionSignalKeyFirstMessage     SuspicionSignalKey = "first_message"
+	SuspicionSignalKeyMultiChannel     SuspicionSignalKey = "multi_channel"
+	SuspicionSignalKeyModeration       SuspicionSignalKey = "moderation"
+)
+
+// AllValues returns all SuspicionSignalKey values.
+func (SuspicionSignalKey) AllValues() []SuspicionSignalKey {
+	return []SuspicionSignalKey{
+		SuspicionSignalKeyAccountAge,
+		SuspicionSignalKeyBlacklistFollows,
+		SuspicionSignalKeyLowFollows,
+		SuspicionSignalKeyNamePattern,
+		SuspicionSignalKeyFirstMessage,
+		SuspicionSignalKeyMultiChannel,
+		SuspicionSignalKeyModeration,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SuspicionSignalKey) MarshalText() ([]byte, error) {
+	switch s {
+	case SuspicionSignalKeyAccountAge:
+		return []byte(s), nil
+	case SuspicionSignalKeyBlacklistFollows:
+		return []byte(s), nil
+	case SuspicionSignalKeyLowFollows:
+		return []byte(s), nil
+	case SuspicionSignalKeyNamePattern:
+		return []byte(s), nil
+	case SuspicionSignalKeyFirstMessage:
+		return []byte(s), nil
+	case SuspicionSignalKeyMultiChannel:
+		return []byte(s), nil
+	case SuspicionSignalKeyModeration:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SuspicionSignalKey) UnmarshalText(data []byte) error {
+	switch SuspicionSignalKey(data) {
+	case SuspicionSignalKeyAccountAge:
+		*s = SuspicionSignalKeyAccountAge
+		return nil
+	case SuspicionSignalKeyBlacklistFollows:
+		*s = SuspicionSignalKeyBlacklistFollows
+		return nil
+	case SuspicionSignalKeyLowFollows:
+		*s = SuspicionSignalKeyLowFollows
+		return nil
+	case SuspicionSignalKeyNamePattern:
+		*s = SuspicionSignalKeyNamePattern
+		return nil
+	case SuspicionSignalKeyFirstMessage:
+		*s = SuspicionSignalKeyFirstMessage
+		return nil
+	case SuspicionSignalKeyMultiChannel:
+		*s = SuspicionSignalKeyMultiChannel
+		return nil
+	case SuspicionSignalKeyModeration:
+		*s = SuspicionSignalKeyModeration
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Maximum points each signal contributes; 0 disables the signal.
+// Ref: #/components/schemas/SuspicionWeights
+type SuspicionWeights struct {
+	AccountAge       int `json:"account_age"`
+	BlacklistFollows int `json:"blacklist_follows"`
+	LowFollows       int `json:"low_follows"`
+	NamePattern      int `json:"name_pattern"`
+	FirstMessage     int `json:"first_message"`
+	MultiChannel     int `json:"multi_channel"`
+	Moderation       int `json:"moderation"`
+}
+
+// GetAccountAge returns the value of AccountAge.
+func (s *SuspicionWeights) GetAccountAge() int {
+	return s.AccountAge
+}
+
+// GetBlacklistFollows returns the value of BlacklistFollows.
+func (s *SuspicionWeights) GetBlacklistFollows() int {
+	return s.BlacklistFollows
+}
+
+// GetLowFollows returns the value of LowFollows.
+func (s *SuspicionWeights) GetLowFollows() int {
+	return s.LowFollows
+}
+
+// GetNamePattern returns the value of NamePattern.
+func (s *SuspicionWeights) GetNamePattern() int {
+	return s.NamePattern
+}
+
+// GetFirstMessage returns the value of FirstMessage.
+func (s *SuspicionWeights) GetFirstMessage() int {
+	return s.FirstMessage
+}
+
+// GetMultiChannel returns the value of MultiChannel.
+func (s *SuspicionWeights) GetMultiChannel() int {
+	return s.MultiChannel
+}
+
+// GetModeration returns the value of Moderation.
+func (s *SuspicionWeights) GetModeration() int {
+	return s.Moderation
+}
+
+// SetAccountAge sets the value of AccountAge.
+func (s *SuspicionWeights) SetAccountAge(val int) {
+	s.AccountAge = val
+}
+
+// SetBlacklistFollows sets the value of BlacklistFollows.
+func (s *SuspicionWeights) SetBlacklistFollows(val int) {
+	s.BlacklistFollows = val
+}
+
+// SetLowFollows sets the value of LowFollows.
+func (s *SuspicionWeights) SetLowFollows(val int) {
+	s.LowFollows = val
+}
+
+// SetNamePattern sets the value of NamePattern.
+func (s *SuspicionWeights) SetNamePattern(val int) {
+	s.NamePattern = val
+}
+
+// SetFirstMessage sets the value of FirstMessage.
+func (s *SuspicionWeights) SetFirstMessage(val int) {
+	s.FirstMessage = val
+}
+
+// SetMultiChannel sets the value of MultiChannel.
+func (s *SuspicionWeights) SetMultiChannel(val int) {
+	s.MultiChannel = val
+}
+
+// SetModeration sets the value of Moderation.
+func (s *SuspicionWeights) SetModeration(val int) {
+	s.Moderation = val
+}
+
+type SyncBlocklistBadRequest ErrorMessage
+
+func (*SyncBlocklistBadRequest) syncBlocklistRes() {}
+
+type SyncBlocklistNotFound ErrorMessage
+
+func (*SyncBlocklistNotFound) syncBlocklistRes() {}
+
+// Ref: #/components/schemas/SyncBlocklistRequest
+type SyncBlocklistRequest struct {
+	ID int64 `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *SyncBlocklistRequest) GetID() int64 {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *SyncBlocklistRequest) SetID(val int64) {
+	s.ID = val
+}
+
 // Ref: #/components/schemas/SystemStatsCaches
 type SystemStatsCaches struct {
 	HelixUserOAuthCacheEntries int32 `json:"helix_user_oauth_cache_entries"`
@@ -4486,35 +10109,303 @@ func (s *SystemStatsTables) SetChannelBlacklist(val int64) {
 	s.ChannelBlacklist = val
 }
 
-// SetRuleTriggerEvents sets the value of RuleTriggerEvents.
-func (s *SystemStatsTables) SetRuleTriggerEvents(val int64) {
-	s.RuleTriggerEvents = val
+// SetRuleTriggerEvents sets the value of RuleTriggerEvents.
+func (s *SystemStatsTables) SetRuleTriggerEvents(val int64) {
+	s.RuleTriggerEvents = val
+}
+
+// SetIrcJoinedSamples sets the value of IrcJoinedSamples.
+func (s *SystemStatsTables) SetIrcJoinedSamples(val int64) {
+	s.IrcJoinedSamples = val
+}
+
+// SetTwitchDiscoveryCandidates sets the value of TwitchDiscoveryCandidates.
+func (s *SystemStatsTables) SetTwitchDiscoveryCandidates(val int64) {
+	s.TwitchDiscoveryCandidates = val
+}
+
+// SetTwitchDiscoveryDenied sets the value of TwitchDiscoveryDenied.
+func (s *SystemStatsTables) SetTwitchDiscoveryDenied(val int64) {
+	s.TwitchDiscoveryDenied = val
+}
+
+// SetAiConversations sets the value of AiConversations.
+func (s *SystemStatsTables) SetAiConversations(val int64) {
+	s.AiConversations = val
+}
+
+// SetAiMessages sets the value of AiMessages.
+func (s *SystemStatsTables) SetAiMessages(val int64) {
+	s.AiMessages = val
+}
+
+// Ref: #/components/schemas/TermSeries
+type TermSeries struct {
+	StreamID      int64             `json:"stream_id"`
+	Term          string            `json:"term"`
+	BucketSeconds int               `json:"bucket_seconds"`
+	Messages      int               `json:"messages"`
+	Points        []TermSeriesPoint `json:"points"`
+}
+
+// GetStreamID returns the value of StreamID.
+func (s *TermSeries) GetStreamID() int64 {
+	return s.StreamID
+}
+
+// GetTerm returns the value of Term.
+func (s *TermSeries) GetTerm() string {
+	return s.Term
+}
+
+// GetBucketSeconds returns the value of BucketSeconds.
+func (s *TermSeries) GetBucketSeconds() int {
+	return s.BucketSeconds
+}
+
+// GetMessages returns the value of Messages.
+func (s *TermSeries) GetMessages() int {
+	return s.Messages
+}
+
+// GetPoints returns the value of Points.
+func (s *TermSeries) GetPoints() []TermSeriesPoint {
+	return s.Points
+}
+
+// SetStreamID sets the value of StreamID.
+func (s *TermSeries) SetStreamID(val int64) {
+	s.StreamID = val
+}
+
+// SetTerm sets the value of Term.
+func (s *TermSeries) SetTerm(val string) {
+	s.Term = val
+}
+
+// SetBucketSeconds sets the value of BucketSeconds.
+func (s *TermSeries) SetBucketSeconds(val int) {
+	s.BucketSeconds = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *TermSeries) SetMessages(val int) {
+	s.Messages = val
+}
+
+// SetPoints sets the value of Points.
+func (s *TermSeries) SetPoints(val []TermSeriesPoint) {
+	s.Points = val
+}
+
+func (*TermSeries) getRecordedStreamTermSeriesRes() {}
+
+// Ref: #/components/schemas/TermSeriesPoint
+type TermSeriesPoint struct {
+	Start    time.Time `json:"start"`
+	Messages int       `json:"messages"`
+}
+
+// GetStart returns the value of Start.
+func (s *TermSeriesPoint) GetStart() time.Time {
+	return s.Start
+}
+
+// GetMessages returns the value of Messages.
+func (s *TermSeriesPoint) GetMessages() int {
+	return s.Messages
+}
+
+// SetStart sets the value of Start.
+func (s *TermSeriesPoint) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *TermSeriesPoint) SetMessages(val int) {
+	s.Messages = val
+}
+
+// Ref: #/components/schemas/TestLoginPatternRequest
+type TestLoginPatternRequest struct {
+	Kind    LoginPatternKind `json:"kind"`
+	Pattern string           `json:"pattern"`
+	Logins  []string         `json:"logins"`
+}
+
+// GetKind returns the value of Kind.
+func (s *TestLoginPatternRequest) GetKind() LoginPatternKind {
+	return s.Kind
+}
+
+// GetPattern returns the value of Pattern.
+func (s *TestLoginPatternRequest) GetPattern() string {
+	return s.Pattern
+}
+
+// GetLogins returns the value of Logins.
+func (s *TestLoginPatternRequest) GetLogins() []string {
+	return s.Logins
+}
+
+// SetKind sets the value of Kind.
+func (s *TestLoginPatternRequest) SetKind(val LoginPatternKind) {
+	s.Kind = val
+}
+
+// SetPattern sets the value of Pattern.
+func (s *TestLoginPatternRequest) SetPattern(val string) {
+	s.Pattern = val
+}
+
+// SetLogins sets the value of Logins.
+func (s *TestLoginPatternRequest) SetLogins(val []string) {
+	s.Logins = val
+}
+
+// Ref: #/components/schemas/TestLoginPatternResponse
+type TestLoginPatternResponse struct {
+	// Set when the pattern is invalid.
+	CompileError OptNilString `json:"compile_error"`
+	// Glob after normalization (lowercased, look-alikes folded, digits kept, underscores dropped), as it
+	// is
+	// compared against logins; null for regexes, which are used as written.
+	NormalizedPattern OptNilString             `json:"normalized_pattern"`
+	Results           []LoginPatternTestResult `json:"results"`
+}
+
+// GetCompileError returns the value of CompileError.
+func (s *TestLoginPatternResponse) GetCompileError() OptNilString {
+	return s.CompileError
+}
+
+// GetNormalizedPattern returns the value of NormalizedPattern.
+func (s *TestLoginPatternResponse) GetNormalizedPattern() OptNilString {
+	return s.NormalizedPattern
+}
+
+// GetResults returns the value of Results.
+func (s *TestLoginPatternResponse) GetResults() []LoginPatternTestResult {
+	return s.Results
+}
+
+// SetCompileError sets the value of CompileError.
+func (s *TestLoginPatternResponse) SetCompileError(val OptNilString) {
+	s.CompileError = val
+}
+
+// SetNormalizedPattern sets the value of NormalizedPattern.
+func (s *TestLoginPatternResponse) SetNormalizedPattern(val OptNilString) {
+	s.NormalizedPattern = val
+}
+
+// SetResults sets the value of Results.
+func (s *TestLoginPatternResponse) SetResults(val []LoginPatternTestResult) {
+	s.Results = val
+}
+
+type TestNotificationBadRequest ErrorMessage
+
+func (*TestNotificationBadRequest) testNotificationRes() {}
+
+type TestNotificationNotFound ErrorMessage
+
+func (*TestNotificationNotFound) testNotificationRes() {}
+
+// Ref: #/components/schemas/TestNotificationRequest
+type TestNotificationRequest struct {
+	// Event types to send; all when omitted or empty.
+	EventTypes []TestNotificationRequestEventTypesItem `json:"event_types"`
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *TestNotificationRequest) GetEventTypes() []TestNotificationRequestEventTypesItem {
+	return s.EventTypes
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *TestNotificationRequest) SetEventTypes(val []TestNotificationRequestEventTypesItem) {
+	s.EventTypes = val
+}
+
+type TestNotificationRequestEventTypesItem string
+
+const (
+	TestNotificationRequestEventTypesItemKeywordMatch TestNotificationRequestEventTypesItem = "keyword_match"
+	TestNotificationRequestEventTypesItemStreamStart  TestNotificationRequestEventTypesItem = "stream_start"
+	TestNotificationRequestEventTypesItemStreamEnd    TestNotificationRequestEventTypesItem = "stream_end"
+	TestNotificationRequestEventTypesItemRuleText     TestNotificationRequestEventTypesItem = "rule_text"
+	TestNotificationRequestEventTypesItemFloodSummary TestNotificationRequestEventTypesItem = "flood_summary"
+)
+
+// AllValues returns all TestNotificationRequestEventTypesItem values.
+func (TestNotificationRequestEventTypesItem) AllValues() []TestNotificationRequestEventTypesItem {
+	return []TestNotificationRequestEventTypesItem{
+		TestNotificationRequestEventTypesItemKeywordMatch,
+		TestNotificationRequestEventTypesItemStreamStart,
+		TestNotificationRequestEventTypesItemStreamEnd,
+		TestNotificationRequestEventTypesItemRuleText,
+		TestNotificationRequestEventTypesItemFloodSummary,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TestNotificationRequestEventTypesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case TestNotificationRequestEventTypesItemKeywordMatch:
+		return []byte(s), nil
+	case TestNotificationRequestEventTypesItemStreamStart:
+		return []byte(s), nil
+	case TestNotificationRequestEventTypesItemStreamEnd:
+		return []byte(s), nil
+	case TestNotificationRequestEventTypesItemRuleText:
+		return []byte(s), nil
+	case TestNotificationRequestEventTypesItemFloodSummary:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetIrcJoinedSamples sets the value of IrcJoinedSamples.
-func (s *SystemStatsTables) SetIrcJoinedSamples(val int64) {
-	s.IrcJoinedSamples = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TestNotificationRequestEventTypesItem) UnmarshalText(data []byte) error {
+	switch TestNotificationRequestEventTypesItem(data) {
+	case TestNotificationRequestEventTypesItemKeywordMatch:
+		*s = TestNotificationRequestEventTypesItemKeywordMatch
+		return nil
+	case TestNotificationRequestEventTypesItemStreamStart:
+		*s = TestNotificationRequestEventTypesItemStreamStart
+		return nil
+	case TestNotificationRequestEventTypesItemStreamEnd:
+		*s = TestNotificationRequestEventTypesItemStreamEnd
+		return nil
+	case TestNotificationRequestEventTypesItemRuleText:
+		*s = TestNotificationRequestEventTypesItemRuleText
+		return nil
+	case TestNotificationRequestEventTypesItemFloodSummary:
+		*s = TestNotificationRequestEventTypesItemFloodSummary
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetTwitchDiscoveryCandidates sets the value of TwitchDiscoveryCandidates.
-func (s *SystemStatsTables) SetTwitchDiscoveryCandidates(val int64) {
-	s.TwitchDiscoveryCandidates = val
+// Ref: #/components/schemas/TestNotificationResponse
+type TestNotificationResponse struct {
+	Results []NotificationTestResult `json:"results"`
 }
 
-// SetTwitchDiscoveryDenied sets the value of TwitchDiscoveryDenied.
-func (s *SystemStatsTables) SetTwitchDiscoveryDenied(val int64) {
-	s.TwitchDiscoveryDenied = val
+// GetResults returns the value of Results.
+func (s *TestNotificationResponse) GetResults() []NotificationTestResult {
+	return s.Results
 }
 
-// SetAiConversations sets the value of AiConversations.
-func (s *SystemStatsTables) SetAiConversations(val int64) {
-	s.AiConversations = val
+// SetResults sets the value of Results.
+func (s *TestNotificationResponse) SetResults(val []NotificationTestResult) {
+	s.Results = val
 }
 
-// SetAiMessages sets the value of AiMessages.
-func (s *SystemStatsTables) SetAiMessages(val int64) {
-	s.AiMessages = val
-}
+func (*TestNotificationResponse) testNotificationRes() {}
 
 // Ref: #/components/schemas/TestRuleRegexRequest
 type TestRuleRegexRequest struct {
@@ -4581,6 +10472,162 @@ func (s *TestRuleRegexResponse) SetCompileError(val OptNilString) {
 	s.CompileError = val
 }
 
+// Ref: #/components/schemas/TrendingTerm
+type TrendingTerm struct {
+	Term  string `json:"term"`
+	Emote bool   `json:"emote"`
+	Words int    `json:"words"`
+	// Messages in the window using the term.
+	Messages int `json:"messages"`
+	Chatters int `json:"chatters"`
+	// Baseline streams that also used the term.
+	BaselineStreams int     `json:"baseline_streams"`
+	Score           float64 `json:"score"`
+}
+
+// GetTerm returns the value of Term.
+func (s *TrendingTerm) GetTerm() string {
+	return s.Term
+}
+
+// GetEmote returns the value of Emote.
+func (s *TrendingTerm) GetEmote() bool {
+	return s.Emote
+}
+
+// GetWords returns the value of Words.
+func (s *TrendingTerm) GetWords() int {
+	return s.Words
+}
+
+// GetMessages returns the value of Messages.
+func (s *TrendingTerm) GetMessages() int {
+	return s.Messages
+}
+
+// GetChatters returns the value of Chatters.
+func (s *TrendingTerm) GetChatters() int {
+	return s.Chatters
+}
+
+// GetBaselineStreams returns the value of BaselineStreams.
+func (s *TrendingTerm) GetBaselineStreams() int {
+	return s.BaselineStreams
+}
+
+// GetScore returns the value of Score.
+func (s *TrendingTerm) GetScore() float64 {
+	return s.Score
+}
+
+// SetTerm sets the value of Term.
+func (s *TrendingTerm) SetTerm(val string) {
+	s.Term = val
+}
+
+// SetEmote sets the value of Emote.
+func (s *TrendingTerm) SetEmote(val bool) {
+	s.Emote = val
+}
+
+// SetWords sets the value of Words.
+func (s *TrendingTerm) SetWords(val int) {
+	s.Words = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *TrendingTerm) SetMessages(val int) {
+	s.Messages = val
+}
+
+// SetChatters sets the value of Chatters.
+func (s *TrendingTerm) SetChatters(val int) {
+	s.Chatters = val
+}
+
+// SetBaselineStreams sets the value of BaselineStreams.
+func (s *TrendingTerm) SetBaselineStreams(val int) {
+	s.BaselineStreams = val
+}
+
+// SetScore sets the value of Score.
+func (s *TrendingTerm) SetScore(val float64) {
+	s.Score = val
+}
+
+// Ref: #/components/schemas/TrendingTerms
+type TrendingTerms struct {
+	StreamID int64     `json:"stream_id"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Messages int       `json:"messages"`
+	// Recent streams of the channel the terms were compared against.
+	BaselineStreams int            `json:"baseline_streams"`
+	Terms           []TrendingTerm `json:"terms"`
+}
+
+// GetStreamID returns the value of StreamID.
+func (s *TrendingTerms) GetStreamID() int64 {
+	return s.StreamID
+}
+
+// GetFrom returns the value of From.
+func (s *TrendingTerms) GetFrom() time.Time {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *TrendingTerms) GetTo() time.Time {
+	return s.To
+}
+
+// GetMessages returns the value of Messages.
+func (s *TrendingTerms) GetMessages() int {
+	return s.Messages
+}
+
+// GetBaselineStreams returns the value of BaselineStreams.
+func (s *TrendingTerms) GetBaselineStreams() int {
+	return s.BaselineStreams
+}
+
+// GetTerms returns the value of Terms.
+func (s *TrendingTerms) GetTerms() []TrendingTerm {
+	return s.Terms
+}
+
+// SetStreamID sets the value of StreamID.
+func (s *TrendingTerms) SetStreamID(val int64) {
+	s.StreamID = val
+}
+
+// SetFrom sets the value of From.
+func (s *TrendingTerms) SetFrom(val time.Time) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *TrendingTerms) SetTo(val time.Time) {
+	s.To = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *TrendingTerms) SetMessages(val int) {
+	s.Messages = val
+}
+
+// SetBaselineStreams sets the value of BaselineStreams.
+func (s *TrendingTerms) SetBaselineStreams(val int) {
+	s.BaselineStreams = val
+}
+
+// SetTerms sets the value of Terms.
+func (s *TrendingTerms) SetTerms(val []TrendingTerm) {
+	s.Terms = val
+}
+
+func (*TrendingTerms) getRecordedStreamTermsRes() {}
+
 // Ref: #/components/schemas/TwitchAccount
 type TwitchAccount struct {
 	ID          int64                    `json:"id"`
@@ -4860,6 +10907,17 @@ type TwitchUserProfile struct {
 	NotifyStreamStart       bool     `json:"notify_stream_start"`
 	// Profile image URL from Helix enrichment when available.
 	ProfileImageURL OptNilString `json:"profile_image_url"`
+	// Latest weighted suspicion breakdown; null until the user has been enriched.
+	SuspicionScore OptNilSuspicionScore `json:"suspicion_score"`
+	// Most recent suspicion changes for this user (newest first).
+	SuspicionHistory []SuspicionEvent `json:"suspicion_history"`
+	// Users that may be the same person (highest score first); reviewed pairs are in linked_users.
+	PossibleAlts []AltCandidate `json:"possible_alts"`
+	// Confirmed and rejected alt links (newest decision first).
+	LinkedUsers []UserLink `json:"linked_users"`
+	// Aggregated AI classifier labels of the user's messages across channels; set once any were
+	// classified.
+	ChatToxicity OptChatToxicity `json:"chat_toxicity"`
 }
 
 // GetID returns the value of ID.
@@ -4952,6 +11010,31 @@ func (s *TwitchUserProfile) GetProfileImageURL() OptNilString {
 	return s.ProfileImageURL
 }
 
+// GetSuspicionScore returns the value of SuspicionScore.
+func (s *TwitchUserProfile) GetSuspicionScore() OptNilSuspicionScore {
+	return s.SuspicionScore
+}
+
+// GetSuspicionHistory returns the value of SuspicionHistory.
+func (s *TwitchUserProfile) GetSuspicionHistory() []SuspicionEvent {
+	return s.SuspicionHistory
+}
+
+// GetPossibleAlts returns the value of PossibleAlts.
+func (s *TwitchUserProfile) GetPossibleAlts() []AltCandidate {
+	return s.PossibleAlts
+}
+
+// GetLinkedUsers returns the value of LinkedUsers.
+func (s *TwitchUserProfile) GetLinkedUsers() []UserLink {
+	return s.LinkedUsers
+}
+
+// GetChatToxicity returns the value of ChatToxicity.
+func (s *TwitchUserProfile) GetChatToxicity() OptChatToxicity {
+	return s.ChatToxicity
+}
+
 // SetID sets the value of ID.
 func (s *TwitchUserProfile) SetID(val int64) {
 	s.ID = val
@@ -5042,6 +11125,31 @@ func (s *TwitchUserProfile) SetProfileImageURL(val OptNilString) {
 	s.ProfileImageURL = val
 }
 
+// SetSuspicionScore sets the value of SuspicionScore.
+func (s *TwitchUserProfile) SetSuspicionScore(val OptNilSuspicionScore) {
+	s.SuspicionScore = val
+}
+
+// SetSuspicionHistory sets the value of SuspicionHistory.
+func (s *TwitchUserProfile) SetSuspicionHistory(val []SuspicionEvent) {
+	s.SuspicionHistory = val
+}
+
+// SetPossibleAlts sets the value of PossibleAlts.
+func (s *TwitchUserProfile) SetPossibleAlts(val []AltCandidate) {
+	s.PossibleAlts = val
+}
+
+// SetLinkedUsers sets the value of LinkedUsers.
+func (s *TwitchUserProfile) SetLinkedUsers(val []UserLink) {
+	s.LinkedUsers = val
+}
+
+// SetChatToxicity sets the value of ChatToxicity.
+func (s *TwitchUserProfile) SetChatToxicity(val OptChatToxicity) {
+	s.ChatToxicity = val
+}
+
 func (*TwitchUserProfile) getTwitchUserProfileRes() {}
 
 // Ref: #/components/schemas/UpdateNotificationPostRequest
@@ -5050,6 +11158,15 @@ type UpdateNotificationPostRequest struct {
 	Provider OptUpdateNotificationPostRequestProvider `json:"provider"`
 	Settings OptUpdateNotificationPostRequestSettings `json:"settings"`
 	Enabled  OptBool                                  `json:"enabled"`
+	// When any of tags, event_types or channels is present, all three are replaced (omitted ones become
+	// empty).
+	Tags []string `json:"tags"`
+	// Default event filter (chat_message, stream_start, stream_end, interval); empty accepts every event.
+	// Only applies when the notify action names no notification_ids or notification_tags.
+	EventTypes []string `json:"event_types"`
+	// Default channel login filter; empty accepts every channel. Same precedence as event_types.
+	Channels []string              `json:"channels"`
+	Policy   OptNotificationPolicy `json:"policy"`
 }
 
 // GetID returns the value of ID.
@@ -5072,6 +11189,26 @@ func (s *UpdateNotificationPostRequest) GetEnabled() OptBool {
 	return s.Enabled
 }
 
+// GetTags returns the value of Tags.
+func (s *UpdateNotificationPostRequest) GetTags() []string {
+	return s.Tags
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *UpdateNotificationPostRequest) GetEventTypes() []string {
+	return s.EventTypes
+}
+
+// GetChannels returns the value of Channels.
+func (s *UpdateNotificationPostRequest) GetChannels() []string {
+	return s.Channels
+}
+
+// GetPolicy returns the value of Policy.
+func (s *UpdateNotificationPostRequest) GetPolicy() OptNotificationPolicy {
+	return s.Policy
+}
+
 // SetID sets the value of ID.
 func (s *UpdateNotificationPostRequest) SetID(val int64) {
 	s.ID = val
@@ -5092,11 +11229,35 @@ func (s *UpdateNotificationPostRequest) SetEnabled(val OptBool) {
 	s.Enabled = val
 }
 
+// SetTags sets the value of Tags.
+func (s *UpdateNotificationPostRequest) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *UpdateNotificationPostRequest) SetEventTypes(val []string) {
+	s.EventTypes = val
+}
+
+// SetChannels sets the value of Channels.
+func (s *UpdateNotificationPostRequest) SetChannels(val []string) {
+	s.Channels = val
+}
+
+// SetPolicy sets the value of Policy.
+func (s *UpdateNotificationPostRequest) SetPolicy(val OptNotificationPolicy) {
+	s.Policy = val
+}
+
 type UpdateNotificationPostRequestProvider string
 
 const (
 	UpdateNotificationPostRequestProviderTelegram UpdateNotificationPostRequestProvider = "telegram"
 	UpdateNotificationPostRequestProviderWebhook  UpdateNotificationPostRequestProvider = "webhook"
+	UpdateNotificationPostRequestProviderDiscord  UpdateNotificationPostRequestProvider = "discord"
+	UpdateNotificationPostRequestProviderMatrix   UpdateNotificationPostRequestProvider = "matrix"
+	UpdateNotificationPostRequestProviderNtfy     UpdateNotificationPostRequestProvider = "ntfy"
+	UpdateNotificationPostRequestProviderEmail    UpdateNotificationPostRequestProvider = "email"
 )
 
 // AllValues returns all UpdateNotificationPostRequestProvider values.
@@ -5104,6 +11265,10 @@ func (UpdateNotificationPostRequestProvider) AllValues() []UpdateNotificationPos
 	return []UpdateNotificationPostRequestProvider{
 		UpdateNotificationPostRequestProviderTelegram,
 		UpdateNotificationPostRequestProviderWebhook,
+		UpdateNotificationPostRequestProviderDiscord,
+		UpdateNotificationPostRequestProviderMatrix,
+		UpdateNotificationPostRequestProviderNtfy,
+		UpdateNotificationPostRequestProviderEmail,
 	}
 }
 
@@ -5114,6 +11279,14 @@ func (s UpdateNotificationPostRequestProvider) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case UpdateNotificationPostRequestProviderWebhook:
 		return []byte(s), nil
+	case UpdateNotificationPostRequestProviderDiscord:
+		return []byte(s), nil
+	case UpdateNotificationPostRequestProviderMatrix:
+		return []byte(s), nil
+	case UpdateNotificationPostRequestProviderNtfy:
+		return []byte(s), nil
+	case UpdateNotificationPostRequestProviderEmail:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -5128,6 +11301,18 @@ func (s *UpdateNotificationPostRequestProvider) UnmarshalText(data []byte) error
 	case UpdateNotificationPostRequestProviderWebhook:
 		*s = UpdateNotificationPostRequestProviderWebhook
 		return nil
+	case UpdateNotificationPostRequestProviderDiscord:
+		*s = UpdateNotificationPostRequestProviderDiscord
+		return nil
+	case UpdateNotificationPostRequestProviderMatrix:
+		*s = UpdateNotificationPostRequestProviderMatrix
+		return nil
+	case UpdateNotificationPostRequestProviderNtfy:
+		*s = UpdateNotificationPostRequestProviderNtfy
+		return nil
+	case UpdateNotificationPostRequestProviderEmail:
+		*s = UpdateNotificationPostRequestProviderEmail
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -5461,6 +11646,53 @@ func (s *UpdateTwitchUserPostRequest) SetNotifyStreamStart(val OptBool) {
 	s.NotifyStreamStart = val
 }
 
+type UploadBlocklistBadRequest ErrorMessage
+
+func (*UploadBlocklistBadRequest) uploadBlocklistRes() {}
+
+type UploadBlocklistNotFound ErrorMessage
+
+func (*UploadBlocklistNotFound) uploadBlocklistRes() {}
+
+// Ref: #/components/schemas/UploadBlocklistRequest
+type UploadBlocklistRequest struct {
+	ID int64 `json:"id"`
+	// Original file name, kept as provenance.
+	Filename OptString `json:"filename"`
+	// File content in the list's format.
+	Content string `json:"content"`
+}
+
+// GetID returns the value of ID.
+func (s *UploadBlocklistRequest) GetID() int64 {
+	return s.ID
+}
+
+// GetFilename returns the value of Filename.
+func (s *UploadBlocklistRequest) GetFilename() OptString {
+	return s.Filename
+}
+
+// GetContent returns the value of Content.
+func (s *UploadBlocklistRequest) GetContent() string {
+	return s.Content
+}
+
+// SetID sets the value of ID.
+func (s *UploadBlocklistRequest) SetID(val int64) {
+	s.ID = val
+}
+
+// SetFilename sets the value of Filename.
+func (s *UploadBlocklistRequest) SetFilename(val OptString) {
+	s.Filename = val
+}
+
+// SetContent sets the value of Content.
+func (s *UploadBlocklistRequest) SetContent(val string) {
+	s.Content = val
+}
+
 // Ref: #/components/schemas/UserActivityEvent
 type UserActivityEvent struct {
 	ID int64 `json:"id"`
@@ -5547,9 +11779,12 @@ func (s *UserActivityEventDetails) init() UserActivityEventDetails {
 type UserActivityEventEventType string
 
 const (
-	UserActivityEventEventTypeChatOnline  UserActivityEventEventType = "chat_online"
-	UserActivityEventEventTypeChatOffline UserActivityEventEventType = "chat_offline"
-	UserActivityEventEventTypeMessage     UserActivityEventEventType = "message"
+	UserActivityEventEventTypeChatOnline         UserActivityEventEventType = "chat_online"
+	UserActivityEventEventTypeChatOffline        UserActivityEventEventType = "chat_offline"
+	UserActivityEventEventTypeMessage            UserActivityEventEventType = "message"
+	UserActivityEventEventTypeBan                UserActivityEventEventType = "ban"
+	UserActivityEventEventTypeTimeout            UserActivityEventEventType = "timeout"
+	UserActivityEventEventTypeFirstSeenInChannel UserActivityEventEventType = "first_seen_in_channel"
 )
 
 // AllValues returns all UserActivityEventEventType values.
@@ -5558,6 +11793,9 @@ func (UserActivityEventEventType) AllValues() []UserActivityEventEventType {
 		UserActivityEventEventTypeChatOnline,
 		UserActivityEventEventTypeChatOffline,
 		UserActivityEventEventTypeMessage,
+		UserActivityEventEventTypeBan,
+		UserActivityEventEventTypeTimeout,
+		UserActivityEventEventTypeFirstSeenInChannel,
 	}
 }
 
@@ -5570,6 +11808,12 @@ func (s UserActivityEventEventType) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case UserActivityEventEventTypeMessage:
 		return []byte(s), nil
+	case UserActivityEventEventTypeBan:
+		return []byte(s), nil
+	case UserActivityEventEventTypeTimeout:
+		return []byte(s), nil
+	case UserActivityEventEventTypeFirstSeenInChannel:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -5587,6 +11831,153 @@ func (s *UserActivityEventEventType) UnmarshalText(data []byte) error {
 	case UserActivityEventEventTypeMessage:
 		*s = UserActivityEventEventTypeMessage
 		return nil
+	case UserActivityEventEventTypeBan:
+		*s = UserActivityEventEventTypeBan
+		return nil
+	case UserActivityEventEventTypeTimeout:
+		*s = UserActivityEventEventTypeTimeout
+		return nil
+	case UserActivityEventEventTypeFirstSeenInChannel:
+		*s = UserActivityEventEventTypeFirstSeenInChannel
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/UserLink
+type UserLink struct {
+	// The linked user.
+	TwitchUserID int64          `json:"twitch_user_id"`
+	Username     string         `json:"username"`
+	Status       UserLinkStatus `json:"status"`
+	// Alt score of the pair when it was reviewed, if it was a possible alt.
+	Score           OptNilInt   `json:"score"`
+	Note            string      `json:"note"`
+	DecidedByUserID OptNilInt64 `json:"decided_by_user_id"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+// GetTwitchUserID returns the value of TwitchUserID.
+func (s *UserLink) GetTwitchUserID() int64 {
+	return s.TwitchUserID
+}
+
+// GetUsername returns the value of Username.
+func (s *UserLink) GetUsername() string {
+	return s.Username
+}
+
+// GetStatus returns the value of Status.
+func (s *UserLink) GetStatus() UserLinkStatus {
+	return s.Status
+}
+
+// GetScore returns the value of Score.
+func (s *UserLink) GetScore() OptNilInt {
+	return s.Score
+}
+
+// GetNote returns the value of Note.
+func (s *UserLink) GetNote() string {
+	return s.Note
+}
+
+// GetDecidedByUserID returns the value of DecidedByUserID.
+func (s *UserLink) GetDecidedByUserID() OptNilInt64 {
+	return s.DecidedByUserID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *UserLink) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *UserLink) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetTwitchUserID sets the value of TwitchUserID.
+func (s *UserLink) SetTwitchUserID(val int64) {
+	s.TwitchUserID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *UserLink) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetStatus sets the value of Status.
+func (s *UserLink) SetStatus(val UserLinkStatus) {
+	s.Status = val
+}
+
+// SetScore sets the value of Score.
+func (s *UserLink) SetScore(val OptNilInt) {
+	s.Score = val
+}
+
+// SetNote sets the value of Note.
+func (s *UserLink) SetNote(val string) {
+	s.Note = val
+}
+
+// SetDecidedByUserID sets the value of DecidedByUserID.
+func (s *UserLink) SetDecidedByUserID(val OptNilInt64) {
+	s.DecidedByUserID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *UserLink) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *UserLink) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*UserLink) setTwitchUserLinkRes() {}
+
+// Ref: #/components/schemas/UserLinkStatus
+type UserLinkStatus string
+
+const (
+	UserLinkStatusConfirmed UserLinkStatus = "confirmed"
+	UserLinkStatusRejected  UserLinkStatus = "rejected"
+)
+
+// AllValues returns all UserLinkStatus values.
+func (UserLinkStatus) AllValues() []UserLinkStatus {
+	return []UserLinkStatus{
+		UserLinkStatusConfirmed,
+		UserLinkStatusRejected,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UserLinkStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case UserLinkStatusConfirmed:
+		return []byte(s), nil
+	case UserLinkStatusRejected:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserLinkStatus) UnmarshalText(data []byte) error {
+	switch UserLinkStatus(data) {
+	case UserLinkStatusConfirmed:
+		*s = UserLinkStatusConfirmed
+		return nil
+	case UserLinkStatusRejected:
+		*s = UserLinkStatusRejected
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -42,34 +42,64 @@ var operationRolesBearerAuth = map[string][]string{
 	CountTwitchMessagesOperation:              []string{},
 	CreateAiConversationOperation:             []string{},
 	CreateAiMessageOperation:                  []string{},
+	CreateBlocklistOperation:                  []string{},
+	CreateLoginPatternOperation:               []string{},
 	CreateNotificationOperation:               []string{},
+	CreateNotificationSnoozeOperation:         []string{},
 	CreateRuleOperation:                       []string{},
 	CreateTwitchAccountOperation:              []string{},
 	CreateTwitchUserOperation:                 []string{},
 	DeleteAiConversationOperation:             []string{},
+	DeleteBlocklistOperation:                  []string{},
+	DeleteEmoteSetOperation:                   []string{},
+	DeleteLoginPatternOperation:               []string{},
 	DeleteNotificationOperation:               []string{},
+	DeleteNotificationSnoozeOperation:         []string{},
 	DeleteRuleOperation:                       []string{},
 	DeleteTwitchAccountOperation:              []string{},
+	DeleteTwitchUserLinkOperation:             []string{},
 	DenyChannelDiscoveryCandidateOperation:    []string{},
 	GetAiSettingsOperation:                    []string{},
+	GetAudienceOverlapOperation:               []string{},
+	GetBotDetectionSettingsOperation:          []string{},
+	GetChannelActivityHeatmapOperation:        []string{},
+	GetChannelAnalyticsOperation:              []string{},
 	GetChannelDiscoverySettingsOperation:      []string{},
+	GetChannelEmoteUsageOperation:             []string{},
+	GetChannelLeaderboardOperation:            []string{},
 	GetChannelLiveOperation:                   []string{},
+	GetChatClassifierSettingsOperation:        []string{},
 	GetIrcMonitorSettingsOperation:            []string{},
 	GetIrcMonitorStatusOperation:              []string{},
+	GetMonitoredLeaderboardOperation:          []string{},
 	GetRecordedStreamOperation:                []string{},
+	GetRecordedStreamEmotesOperation:          []string{},
 	GetRecordedStreamLeaderboardOperation:     []string{},
+	GetRecordedStreamTermSeriesOperation:      []string{},
+	GetRecordedStreamTermsOperation:           []string{},
+	GetStreamRecapSettingsOperation:           []string{},
+	GetSuspicionReevaluationOperation:         []string{},
 	GetSuspicionSettingsOperation:             []string{},
 	GetSystemStatsOperation:                   []string{},
+	GetTwitchUserActivityHeatmapOperation:     []string{},
 	GetTwitchUserActivityTimelineOperation:    []string{},
+	GetTwitchUserEmoteUsageOperation:          []string{},
 	GetTwitchUserProfileOperation:             []string{},
 	GetWatchUiHintsOperation:                  []string{},
 	ListAiConversationsOperation:              []string{},
 	ListAiMessagesOperation:                   []string{},
+	ListBlocklistsOperation:                   []string{},
 	ListChannelBlacklistOperation:             []string{},
+	ListChannelBotEstimatesOperation:          []string{},
 	ListChannelChattersOperation:              []string{},
 	ListChannelDiscoveryCandidatesOperation:   []string{},
 	ListChatHistoryOperation:                  []string{},
+	ListEmoteSetsOperation:                    []string{},
 	ListIrcMonitorJoinedHistoryOperation:      []string{},
+	ListLikelyBotsOperation:                   []string{},
+	ListLoginPatternsOperation:                []string{},
+	ListNotificationDeliveriesOperation:       []string{},
+	ListNotificationSnoozesOperation:          []string{},
 	ListNotificationsOperation:                []string{},
 	ListRecordedStreamActivityOperation:       []string{},
 	ListRecordedStreamMessagesOperation:       []string{},
@@ -77,6 +107,7 @@ var operationRolesBearerAuth = map[string][]string{
 	ListRuleTemplateVariablesOperation:        []string{},
 	ListRuleTriggersOperation:                 []string{},
 	ListRulesOperation:                        []string{},
+	ListSuspicionEventsOperation:              []string{},
 	ListTwitchAccountsOperation:               []string{},
 	ListTwitchDirectoryUsersOperation:         []string{},
 	ListTwitchMessagesOperation:               []string{},
@@ -84,18 +115,32 @@ var operationRolesBearerAuth = map[string][]string{
 	ListTwitchUsersOperation:                  []string{},
 	MeOperation:                               []string{},
 	PatchAiSettingsOperation:                  []string{},
+	PreviewRuleNotifyOperation:                []string{},
+	ResendNotificationDeliveryOperation:       []string{},
+	SaveEmoteSetOperation:                     []string{},
+	ScanLikelyBotsOperation:                   []string{},
+	ScanTwitchUserAltsOperation:               []string{},
 	SendMessageOperation:                      []string{},
 	SetChannelBlacklistOperation:              []string{},
+	SetTwitchUserLinkOperation:                []string{},
+	StartSuspicionReevaluationOperation:       []string{},
 	StartTwitchOAuthOperation:                 []string{},
 	StopAiAgentOperation:                      []string{},
+	SyncBlocklistOperation:                    []string{},
+	TestLoginPatternOperation:                 []string{},
+	TestNotificationOperation:                 []string{},
 	TestRuleRegexOperation:                    []string{},
+	UpdateBotDetectionSettingsOperation:       []string{},
 	UpdateChannelDiscoverySettingsOperation:   []string{},
+	UpdateChatClassifierSettingsOperation:     []string{},
 	UpdateIrcMonitorSettingsOperation:         []string{},
 	UpdateNotificationOperation:               []string{},
 	UpdateRuleOperation:                       []string{},
+	UpdateStreamRecapSettingsOperation:        []string{},
 	UpdateSuspicionSettingsOperation:          []string{},
 	UpdateTwitchAccountOperation:              []string{},
 	UpdateTwitchUserOperation:                 []string{},
+	UploadBlocklistOperation:                  []string{},
 }
 
 // GetRolesForBearerAuth returns the required roles for the given operation.
@@ -42,10 +42,36 @@ type Handler interface {
 	//
 	// POST /api/v1/ai/conversations/{conversationId}/messages
 	CreateAiMessage(ctx context.Context, req *CreateAiMessageRequest, params CreateAiMessageParams) (CreateAiMessageRes, error)
+	// CreateBlocklist implements createBlocklist operation.
+	//
+	// Creates an empty named blocklist. Lists with `source_url` are pulled every `sync_interval_minutes`
+	// (first pull within 5 minutes, or use `/settings/blocklists/sync`); others are filled by uploads.
+	// Chatters whose id or login is listed are marked suspicious with sus_type `auto_blocklist` during
+	// enrichment.
+	//
+	// POST /api/v1/settings/blocklists
+	CreateBlocklist(ctx context.Context, req *CreateBlocklistRequest) (CreateBlocklistRes, error)
+	// CreateLoginPattern implements createLoginPattern operation.
+	//
+	// Adds a regex or glob to the login pattern blacklist (re-adding the same kind and pattern updates
+	// its
+	// description). Matching chatters are marked suspicious with sus_type `auto_login_pattern`; all
+	// known users
+	// are re-evaluated in the background.
+	//
+	// POST /api/v1/settings/login-patterns
+	CreateLoginPattern(ctx context.Context, req *CreateLoginPatternRequest) (CreateLoginPatternRes, error)
 	// CreateNotification implements createNotification operation.
 	//
 	// POST /api/v1/settings/notifications
 	CreateNotification(ctx context.Context, req *CreateNotificationRequest) (*NotificationEntry, error)
+	// CreateNotificationSnooze implements createNotificationSnooze operation.
+	//
+	// Temporarily mute notifications for one entry, one channel, or both. Muted events are recorded as
+	// suppressed.
+	//
+	// POST /api/v1/settings/notifications/snoozes
+	CreateNotificationSnooze(ctx context.Context, req *CreateNotificationSnoozeRequest) (CreateNotificationSnoozeRes, error)
 	// CreateRule implements createRule operation.
 	//
 	// POST /api/v1/settings/rules
@@ -62,10 +88,28 @@ type Handler interface {
 	//
 	// DELETE /api/v1/ai/conversations/{conversationId}
 	DeleteAiConversation(ctx context.Context, params DeleteAiConversationParams) (DeleteAiConversationRes, error)
+	// DeleteBlocklist implements deleteBlocklist operation.
+	//
+	// POST /api/v1/settings/blocklists/delete
+	DeleteBlocklist(ctx context.Context, req *DeleteByIDRequest) (DeleteBlocklistRes, error)
+	// DeleteEmoteSet implements deleteEmoteSet operation.
+	//
+	// POST /api/v1/settings/emote-sets/delete
+	DeleteEmoteSet(ctx context.Context, req *DeleteByIDRequest) (DeleteEmoteSetRes, error)
+	// DeleteLoginPattern implements deleteLoginPattern operation.
+	//
+	// POST /api/v1/settings/login-patterns/delete
+	DeleteLoginPattern(ctx context.Context, req *DeleteByIDRequest) (DeleteLoginPatternRes, error)
 	// DeleteNotification implements deleteNotification operation.
 	//
 	// POST /api/v1/settings/notifications/delete
 	DeleteNotification(ctx context.Context, req *DeleteByIDRequest) (DeleteNotificationRes, error)
+	// DeleteNotificationSnooze implements deleteNotificationSnooze operation.
+	//
+	// End a snooze early.
+	//
+	// POST /api/v1/settings/notifications/snoozes/delete
+	DeleteNotificationSnooze(ctx context.Context, req *DeleteByIDRequest) (DeleteNotificationSnoozeRes, error)
 	// DeleteRule implements deleteRule operation.
 	//
 	// POST /api/v1/settings/rules/delete
@@ -74,6 +118,10 @@ type Handler interface {
 	//
 	// POST /api/v1/settings/twitch-accounts/delete
 	DeleteTwitchAccount(ctx context.Context, req *DeleteByIDRequest) (DeleteTwitchAccountRes, error)
+	// DeleteTwitchUserLink implements deleteTwitchUserLink operation.
+	//
+	// POST /api/v1/twitch/users/links/delete
+	DeleteTwitchUserLink(ctx context.Context, req *DeleteTwitchUserLinkRequest) (DeleteTwitchUserLinkRes, error)
 	// DenyChannelDiscoveryCandidate implements denyChannelDiscoveryCandidate operation.
 	//
 	// POST /api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny
@@ -82,14 +130,63 @@ type Handler interface {
 	//
 	// GET /api/v1/ai/settings
 	GetAiSettings(ctx context.Context) (*AiSettings, error)
+	// GetAudienceOverlap implements getAudienceOverlap operation.
+	//
+	// Audience overlap between monitored channels over a trailing period. A channel's audience is
+	// everyone who chatted or was present in IRC during the period (likely bots excluded when bot
+	// detection hides them from stats). Returns Jaccard (shared / union) and overlap (shared / smaller
+	// audience) matrices in channels order, the channel pairs with their top shared users, and per
+	// channel the channels its community also frequents (shared monitored audience and GQL follows).
+	// Results are cached per period for an hour.
+	//
+	// GET /api/v1/twitch/audience/overlap
+	GetAudienceOverlap(ctx context.Context, params GetAudienceOverlapParams) (*AudienceOverlap, error)
+	// GetBotDetectionSettings implements getBotDetectionSettings operation.
+	//
+	// GET /api/v1/settings/bot-detection
+	GetBotDetectionSettings(ctx context.Context) (*BotDetectionSettings, error)
+	// GetChannelActivityHeatmap implements getChannelActivityHeatmap operation.
+	//
+	// When a monitored channel's chat is busiest: chatters' summed IRC presence seconds and chat
+	// messages per local weekday and hour.
+	//
+	// GET /api/v1/twitch/channels/{login}/heatmap
+	GetChannelActivityHeatmap(ctx context.Context, params GetChannelActivityHeatmapParams) (GetChannelActivityHeatmapRes, error)
+	// GetChannelAnalytics implements getChannelAnalytics operation.
+	//
+	// Time-bucketed chat activity (messages, unique/new chatters, average IRC presence) for a monitored
+	// channel and stream-over-stream chatter retention of the streams started in the range. Buckets are
+	// UTC-aligned; hour and day series come from rollups refreshed every few minutes (see
+	// rolled_up_until), minute series are computed live. Ranges are limited to 24h (minute), 31d (hour)
+	// and 366d (day).
+	//
+	// GET /api/v1/twitch/channels/{login}/analytics
+	GetChannelAnalytics(ctx context.Context, params GetChannelAnalyticsParams) (GetChannelAnalyticsRes, error)
 	// GetChannelDiscoverySettings implements getChannelDiscoverySettings operation.
 	//
 	// GET /api/v1/settings/channel-discovery
 	GetChannelDiscoverySettings(ctx context.Context) (*ChannelDiscoverySettings, error)
+	// GetChannelEmoteUsage implements getChannelEmoteUsage operation.
+	//
+	// Emotes used most in a monitored channel's chat.
+	//
+	// GET /api/v1/twitch/channels/{login}/emotes
+	GetChannelEmoteUsage(ctx context.Context, params GetChannelEmoteUsageParams) (GetChannelEmoteUsageRes, error)
+	// GetChannelLeaderboard implements getChannelLeaderboard operation.
+	//
+	// Chatters of a monitored channel ranked by IRC presence, messages or account age over whole UTC
+	// days. Totals come from daily rollups refreshed every few minutes (see rolled_up_until).
+	//
+	// GET /api/v1/twitch/channels/{login}/leaderboard
+	GetChannelLeaderboard(ctx context.Context, params GetChannelLeaderboardParams) (GetChannelLeaderboardRes, error)
 	// GetChannelLive implements getChannelLive operation.
 	//
 	// POST /api/v1/twitch/channels/live
 	GetChannelLive(ctx context.Context, req *GetChannelLiveRequest) (GetChannelLiveRes, error)
+	// GetChatClassifierSettings implements getChatClassifierSettings operation.
+	//
+	// GET /api/v1/settings/chat-classifier
+	GetChatClassifierSettings(ctx context.Context) (*ChatClassifierSettings, error)
 	// GetIrcMonitorSettings implements getIrcMonitorSettings operation.
 	//
 	// GET /api/v1/settings/irc-monitor-settings
@@ -98,14 +195,56 @@ type Handler interface {
 	//
 	// GET /api/v1/twitch/irc-monitor/status
 	GetIrcMonitorStatus(ctx context.Context) (*IrcMonitorStatus, error)
+	// GetMonitoredLeaderboard implements getMonitoredLeaderboard operation.
+	//
+	// Chatters ranked across all monitored channels over whole UTC days; presence and messages are
+	// summed over channels. Same filters and rollup lag as the per-channel leaderboard.
+	//
+	// GET /api/v1/twitch/leaderboard
+	GetMonitoredLeaderboard(ctx context.Context, params GetMonitoredLeaderboardParams) (GetMonitoredLeaderboardRes, error)
 	// GetRecordedStream implements getRecordedStream operation.
 	//
 	// GET /api/v1/twitch/streams/{streamId}
 	GetRecordedStream(ctx context.Context, params GetRecordedStreamParams) (GetRecordedStreamRes, error)
+	// GetRecordedStreamEmotes implements getRecordedStreamEmotes operation.
+	//
+	// The stream's most used emotes and a timeline of their uses from stream start, in buckets of whole
+	// minutes
+	// (about 60 buckets per stream). Timeline uses are aligned with `top`.
+	//
+	// GET /api/v1/twitch/streams/{streamId}/emotes
+	GetRecordedStreamEmotes(ctx context.Context, params GetRecordedStreamEmotesParams) (GetRecordedStreamEmotesRes, error)
 	// GetRecordedStreamLeaderboard implements getRecordedStreamLeaderboard operation.
 	//
 	// GET /api/v1/twitch/streams/{streamId}/leaderboard
 	GetRecordedStreamLeaderboard(ctx context.Context, params GetRecordedStreamLeaderboardParams) (GetRecordedStreamLeaderboardRes, error)
+	// GetRecordedStreamTermSeries implements getRecordedStreamTermSeries operation.
+	//
+	// How many messages used a term over the stream, in buckets of whole minutes from stream start
+	// (about 60
+	// buckets per stream). The term is normalized like trending terms; emote codes match
+	// case-insensitively.
+	//
+	// GET /api/v1/twitch/streams/{streamId}/terms/series
+	GetRecordedStreamTermSeries(ctx context.Context, params GetRecordedStreamTermSeriesParams) (GetRecordedStreamTermSeriesRes, error)
+	// GetRecordedStreamTerms implements getRecordedStreamTerms operation.
+	//
+	// Trending chat terms of a stream (or a window of it): words, 2-3 word phrases and emotes ranked by
+	// TF-IDF,
+	// i.e. the share of the window's messages using the term weighted by how rare the term is in the
+	// channel's
+	// recent streams. Stopwords, mentions and links are ignored.
+	//
+	// GET /api/v1/twitch/streams/{streamId}/terms
+	GetRecordedStreamTerms(ctx context.Context, params GetRecordedStreamTermsParams) (GetRecordedStreamTermsRes, error)
+	// GetStreamRecapSettings implements getStreamRecapSettings operation.
+	//
+	// GET /api/v1/settings/stream-recap
+	GetStreamRecapSettings(ctx context.Context) (*StreamRecapSettings, error)
+	// GetSuspicionReevaluation implements getSuspicionReevaluation operation.
+	//
+	// GET /api/v1/settings/suspicion-settings/reevaluate
+	GetSuspicionReevaluation(ctx context.Context) (*SuspicionReevaluation, error)
 	// GetSuspicionSettings implements getSuspicionSettings operation.
 	//
 	// GET /api/v1/settings/suspicion-settings
@@ -117,10 +256,23 @@ type Handler interface {
 	//
 	// GET /api/v1/stats
 	GetSystemStats(ctx context.Context) (GetSystemStatsRes, error)
+	// GetTwitchUserActivityHeatmap implements getTwitchUserActivityHeatmap operation.
+	//
+	// When the user is usually online and chatting: IRC presence seconds (summed over monitored
+	// channels) and chat messages per local weekday and hour.
+	//
+	// GET /api/v1/twitch/users/{twitch_user_id}/heatmap
+	GetTwitchUserActivityHeatmap(ctx context.Context, params GetTwitchUserActivityHeatmapParams) (GetTwitchUserActivityHeatmapRes, error)
 	// GetTwitchUserActivityTimeline implements getTwitchUserActivityTimeline operation.
 	//
 	// POST /api/v1/twitch/users/activity/timeline
 	GetTwitchUserActivityTimeline(ctx context.Context, req *GetTwitchUserActivityTimelineRequest) (GetTwitchUserActivityTimelineRes, error)
+	// GetTwitchUserEmoteUsage implements getTwitchUserEmoteUsage operation.
+	//
+	// Emotes the user used most in monitored channels' chat.
+	//
+	// GET /api/v1/twitch/users/{twitch_user_id}/emotes
+	GetTwitchUserEmoteUsage(ctx context.Context, params GetTwitchUserEmoteUsageParams) (GetTwitchUserEmoteUsageRes, error)
 	// GetTwitchUserProfile implements getTwitchUserProfile operation.
 	//
 	// POST /api/v1/twitch/users/profile
@@ -137,10 +289,20 @@ type Handler interface {
 	//
 	// GET /api/v1/ai/conversations/{conversationId}/messages
 	ListAiMessages(ctx context.Context, params ListAiMessagesParams) (ListAiMessagesRes, error)
+	// ListBlocklists implements listBlocklists operation.
+	//
+	// GET /api/v1/settings/blocklists
+	ListBlocklists(ctx context.Context) ([]Blocklist, error)
 	// ListChannelBlacklist implements listChannelBlacklist operation.
 	//
 	// GET /api/v1/settings/channel-blacklist
 	ListChannelBlacklist(ctx context.Context) ([]string, error)
+	// ListChannelBotEstimates implements listChannelBotEstimates operation.
+	//
+	// Estimated bot share of each live monitored channel from the latest bot detection run.
+	//
+	// GET /api/v1/twitch/bots/channels
+	ListChannelBotEstimates(ctx context.Context) ([]ChannelBotEstimate, error)
 	// ListChannelChatters implements listChannelChatters operation.
 	//
 	// POST /api/v1/twitch/channels/chatters
@@ -153,12 +315,41 @@ type Handler interface {
 	//
 	// GET /api/v1/twitch/chat/history
 	ListChatHistory(ctx context.Context, params ListChatHistoryParams) (ListChatHistoryRes, error)
+	// ListEmoteSets implements listEmoteSets operation.
+	//
+	// GET /api/v1/settings/emote-sets
+	ListEmoteSets(ctx context.Context) ([]EmoteSet, error)
 	// ListIrcMonitorJoinedHistory implements listIrcMonitorJoinedHistory operation.
 	//
 	// Historical IRC joined channel counts (periodic samples).
 	//
 	// GET /api/v1/twitch/irc-monitor/joined-history
 	ListIrcMonitorJoinedHistory(ctx context.Context, params ListIrcMonitorJoinedHistoryParams) ([]IrcJoinedSample, error)
+	// ListLikelyBots implements listLikelyBots operation.
+	//
+	// Accounts present in many monitored channels at once that (almost) never chat, as flagged by the
+	// periodic bot detection. Users stay listed for 7 days after they were last detected.
+	//
+	// GET /api/v1/twitch/bots
+	ListLikelyBots(ctx context.Context, params ListLikelyBotsParams) ([]LikelyBot, error)
+	// ListLoginPatterns implements listLoginPatterns operation.
+	//
+	// GET /api/v1/settings/login-patterns
+	ListLoginPatterns(ctx context.Context) ([]LoginPattern, error)
+	// ListNotificationDeliveries implements listNotificationDeliveries operation.
+	//
+	// Notification outbox delivery log (newest first) with per-attempt status codes, response snippets
+	// and errors.
+	// Cursor-based incremental loading like listNotifications.
+	//
+	// GET /api/v1/settings/notifications/deliveries
+	ListNotificationDeliveries(ctx context.Context, params ListNotificationDeliveriesParams) ([]NotificationDelivery, error)
+	// ListNotificationSnoozes implements listNotificationSnoozes operation.
+	//
+	// Active snoozes, soonest expiry first.
+	//
+	// GET /api/v1/settings/notifications/snoozes
+	ListNotificationSnoozes(ctx context.Context) ([]NotificationSnooze, error)
 	// ListNotifications implements listNotifications operation.
 	//
 	// List notification entries (newest first) with cursor-based incremental loading.
@@ -199,6 +390,12 @@ type Handler interface {
 	//
 	// GET /api/v1/settings/rules
 	ListRules(ctx context.Context) ([]Rule, error)
+	// ListSuspicionEvents implements listSuspicionEvents operation.
+	//
+	// Suspicion audit log (newest first) with filters and cursor-based incremental loading.
+	//
+	// GET /api/v1/twitch/suspicion-events
+	ListSuspicionEvents(ctx context.Context, params ListSuspicionEventsParams) ([]SuspicionEvent, error)
 	// ListTwitchAccounts implements listTwitchAccounts operation.
 	//
 	// GET /api/v1/settings/twitch-accounts
@@ -235,6 +432,42 @@ type Handler interface {
 	//
 	// PATCH /api/v1/ai/settings
 	PatchAiSettings(ctx context.Context, req *PatchAiSettingsRequest) (*AiSettings, error)
+	// PreviewRuleNotify implements previewRuleNotify operation.
+	//
+	// Expands a notify action's `text` template (or the engine default when empty) for a sample event.
+	// Sample fields that are omitted use placeholder values.
+	//
+	// POST /api/v1/settings/rules/notify-preview
+	PreviewRuleNotify(ctx context.Context, req *PreviewRuleNotifyRequest) (PreviewRuleNotifyRes, error)
+	// ResendNotificationDelivery implements resendNotificationDelivery operation.
+	//
+	// Requeue a delivery (typically dead) as pending with a fresh retry budget; earlier attempts stay in
+	// the log.
+	//
+	// POST /api/v1/settings/notifications/deliveries/resend
+	ResendNotificationDelivery(ctx context.Context, req *ResendNotificationDeliveryRequest) (ResendNotificationDeliveryRes, error)
+	// SaveEmoteSet implements saveEmoteSet operation.
+	//
+	// Creates a third-party emote set or replaces the codes of the set with the same name. Words of
+	// incoming chat
+	// messages equal to a code (case-sensitive) are stored as emote occurrences; sets scoped to a
+	// channel win over
+	// global ones. The IRC monitor reloads sets every minute and stored messages are not re-scanned.
+	//
+	// POST /api/v1/settings/emote-sets
+	SaveEmoteSet(ctx context.Context, req *SaveEmoteSetRequest) (SaveEmoteSetRes, error)
+	// ScanLikelyBots implements scanLikelyBots operation.
+	//
+	// Runs bot detection now instead of waiting for the next periodic run.
+	//
+	// POST /api/v1/twitch/bots/scan
+	ScanLikelyBots(ctx context.Context) error
+	// ScanTwitchUserAlts implements scanTwitchUserAlts operation.
+	//
+	// Re-scores the user's possible alts now instead of waiting for the hourly alt-detection run.
+	//
+	// POST /api/v1/twitch/users/{twitch_user_id}/alts/scan
+	ScanTwitchUserAlts(ctx context.Context, params ScanTwitchUserAltsParams) (ScanTwitchUserAltsRes, error)
 	// SendMessage implements sendMessage operation.
 	//
 	// POST /api/v1/twitch/send
@@ -243,6 +476,19 @@ type Handler interface {
 	//
 	// POST /api/v1/settings/channel-blacklist
 	SetChannelBlacklist(ctx context.Context, req *ChannelBlacklistChange) (SetChannelBlacklistRes, error)
+	// SetTwitchUserLink implements setTwitchUserLink operation.
+	//
+	// Confirms or rejects that two users are the same person.
+	//
+	// POST /api/v1/twitch/users/links
+	SetTwitchUserLink(ctx context.Context, req *SetTwitchUserLinkRequest) (SetTwitchUserLinkRes, error)
+	// StartSuspicionReevaluation implements startSuspicionReevaluation operation.
+	//
+	// Re-scores every known user from cached enrichment data in the background. Progress is also pushed
+	// over /ws as suspicion_reevaluation messages. A request made while a run is in progress is queued.
+	//
+	// POST /api/v1/settings/suspicion-settings/reevaluate
+	StartSuspicionReevaluation(ctx context.Context, req OptStartSuspicionReevaluationRequest) (*SuspicionReevaluation, error)
 	// StartTwitchOAuth implements startTwitchOAuth operation.
 	//
 	// Start Twitch authorization (browser) to link an account without pasting a refresh token.
@@ -253,14 +499,46 @@ type Handler interface {
 	//
 	// POST /api/v1/ai/conversations/{conversationId}/stop
 	StopAiAgent(ctx context.Context, params StopAiAgentParams) (StopAiAgentRes, error)
+	// SyncBlocklist implements syncBlocklist operation.
+	//
+	// Pulls a list's `source_url` now. Download and parse failures are reported in `last_sync_error` of
+	// the
+	// returned list; unchanged content keeps the current entries.
+	//
+	// POST /api/v1/settings/blocklists/sync
+	SyncBlocklist(ctx context.Context, req *SyncBlocklistRequest) (SyncBlocklistRes, error)
+	// TestLoginPattern implements testLoginPattern operation.
+	//
+	// Checks a pattern against sample logins without saving it; shows how the pattern and each login are
+	// normalized before matching.
+	//
+	// POST /api/v1/settings/login-patterns/test
+	TestLoginPattern(ctx context.Context, req *TestLoginPatternRequest) (*TestLoginPatternResponse, error)
+	// TestNotification implements testNotification operation.
+	//
+	// Renders a sample event of each type (or only `event_types`) and sends it through the entry's
+	// provider
+	// synchronously, bypassing the outbox, snoozes and the entry's policy. Works for disabled entries.
+	// Tokens and URL credentials from the entry settings are redacted from responses and errors.
+	//
+	// POST /api/v1/settings/notifications/{id}/test
+	TestNotification(ctx context.Context, req OptTestNotificationRequest, params TestNotificationParams) (TestNotificationRes, error)
 	// TestRuleRegex implements testRuleRegex operation.
 	//
 	// POST /api/v1/settings/rules/test-regex
 	TestRuleRegex(ctx context.Context, req *TestRuleRegexRequest) (*TestRuleRegexResponse, error)
+	// UpdateBotDetectionSettings implements updateBotDetectionSettings operation.
+	//
+	// PATCH /api/v1/settings/bot-detection
+	UpdateBotDetectionSettings(ctx context.Context, req *BotDetectionSettings) (UpdateBotDetectionSettingsRes, error)
 	// UpdateChannelDiscoverySettings implements updateChannelDiscoverySettings operation.
 	//
 	// PATCH /api/v1/settings/channel-discovery
 	UpdateChannelDiscoverySettings(ctx context.Context, req *ChannelDiscoverySettings) (UpdateChannelDiscoverySettingsRes, error)
+	// UpdateChatClassifierSettings implements updateChatClassifierSettings operation.
+	//
+	// PATCH /api/v1/settings/chat-classifier
+	UpdateChatClassifierSettings(ctx context.Context, req *ChatClassifierSettings) (UpdateChatClassifierSettingsRes, error)
 	// UpdateIrcMonitorSettings implements updateIrcMonitorSettings operation.
 	//
 	// PATCH /api/v1/settings/irc-monitor-settings
@@ -273,10 +551,14 @@ type Handler interface {
 	//
 	// POST /api/v1/settings/rules/update
 	UpdateRule(ctx context.Context, req *UpdateRulePostRequest) (UpdateRuleRes, error)
+	// UpdateStreamRecapSettings implements updateStreamRecapSettings operation.
+	//
+	// PATCH /api/v1/settings/stream-recap
+	UpdateStreamRecapSettings(ctx context.Context, req *StreamRecapSettings) (*StreamRecapSettings, error)
 	// UpdateSuspicionSettings implements updateSuspicionSettings operation.
 	//
 	// PATCH /api/v1/settings/suspicion-settings
-	UpdateSuspicionSettings(ctx context.Context, req *SuspicionSettings) (*SuspicionSettings, error)
+	UpdateSuspicionSettings(ctx context.Context, req *SuspicionSettings) (UpdateSuspicionSettingsRes, error)
 	// UpdateTwitchAccount implements updateTwitchAccount operation.
 	//
 	// POST /api/v1/settings/twitch-accounts/update
@@ -285,6 +567,12 @@ type Handler interface {
 	//
 	// POST /api/v1/settings/twitch-users/update
 	UpdateTwitchUser(ctx context.Context, req *UpdateTwitchUserPostRequest) (UpdateTwitchUserRes, error)
+	// UploadBlocklist implements uploadBlocklist operation.
+	//
+	// Replaces a blocklist's entries with file content parsed in the list's format.
+	//
+	// POST /api/v1/settings/blocklists/upload
+	UploadBlocklist(ctx context.Context, req *UploadBlocklistRequest) (UploadBlocklistRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
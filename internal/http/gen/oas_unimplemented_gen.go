@@ -71,6 +71,31 @@ func (UnimplementedHandler) CreateAiMessage(ctx context.Context, req *CreateAiMe
 	return r, ht.ErrNotImplemented
 }
 
+// CreateBlocklist implements createBlocklist operation.
+//
+// Creates an empty named blocklist. Lists with `source_url` are pulled every `sync_interval_minutes`
+// (first pull within 5 minutes, or use `/settings/blocklists/sync`); others are filled by uploads.
+// Chatters whose id or login is listed are marked suspicious with sus_type `auto_blocklist` during
+// enrichment.
+//
+// POST /api/v1/settings/blocklists
+func (UnimplementedHandler) CreateBlocklist(ctx context.Context, req *CreateBlocklistRequest) (r CreateBlocklistRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateLoginPattern implements createLoginPattern operation.
+//
+// Adds a regex or glob to the login pattern blacklist (re-adding the same kind and pattern updates
+// its
+// description). Matching chatters are marked suspicious with sus_type `auto_login_pattern`; all
+// known users
+// are re-evaluated in the background.
+//
+// POST /api/v1/settings/login-patterns
+func (UnimplementedHandler) CreateLoginPattern(ctx context.Context, req *CreateLoginPatternRequest) (r CreateLoginPatternRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateNotification implements createNotification operation.
 //
 // POST /api/v1/settings/notifications
@@ -78,6 +103,16 @@ func (UnimplementedHandler) CreateNotification(ctx context.Context, req *CreateN
 	return r, ht.ErrNotImplemented
 }
 
+// CreateNotificationSnooze implements createNotificationSnooze operation.
+//
+// Temporarily mute notifications for one entry, one channel, or both. Muted events are recorded as
+// suppressed.
+//
+// POST /api/v1/settings/notifications/snoozes
+func (UnimplementedHandler) CreateNotificationSnooze(ctx context.Context, req *CreateNotificationSnoozeRequest) (r CreateNotificationSnoozeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateRule implements createRule operation.
 //
 // POST /api/v1/settings/rules
@@ -106,6 +141,27 @@ func (UnimplementedHandler) DeleteAiConversation(ctx context.Context, params Del
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteBlocklist implements deleteBlocklist operation.
+//
+// POST /api/v1/settings/blocklists/delete
+func (UnimplementedHandler) DeleteBlocklist(ctx context.Context, req *DeleteByIDRequest) (r DeleteBlocklistRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteEmoteSet implements deleteEmoteSet operation.
+//
+// POST /api/v1/settings/emote-sets/delete
+func (UnimplementedHandler) DeleteEmoteSet(ctx context.Context, req *DeleteByIDRequest) (r DeleteEmoteSetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteLoginPattern implements deleteLoginPattern operation.
+//
+// POST /api/v1/settings/login-patterns/delete
+func (UnimplementedHandler) DeleteLoginPattern(ctx context.Context, req *DeleteByIDRequest) (r DeleteLoginPatternRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteNotification implements deleteNotification operation.
 //
 // POST /api/v1/settings/notifications/delete
@@ -113,6 +169,15 @@ func (UnimplementedHandler) DeleteNotification(ctx context.Context, req *DeleteB
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteNotificationSnooze implements deleteNotificationSnooze operation.
+//
+// End a snooze early.
+//
+// POST /api/v1/settings/notifications/snoozes/delete
+func (UnimplementedHandler) DeleteNotificationSnooze(ctx context.Context, req *DeleteByIDRequest) (r DeleteNotificationSnoozeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteRule implements deleteRule operation.
 //
 // POST /api/v1/settings/rules/delete
@@ -127,6 +192,13 @@ func (UnimplementedHandler) DeleteTwitchAccount(ctx context.Context, req *Delete
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteTwitchUserLink implements deleteTwitchUserLink operation.
+//
+// POST /api/v1/twitch/users/links/delete
+func (UnimplementedHandler) DeleteTwitchUserLink(ctx context.Context, req *DeleteTwitchUserLinkRequest) (r DeleteTwitchUserLinkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DenyChannelDiscoveryCandidate implements denyChannelDiscoveryCandidate operation.
 //
 // POST /api/v1/settings/channel-discovery/candidates/{twitch_user_id}/deny
@@ -141,6 +213,50 @@ func (UnimplementedHandler) GetAiSettings(ctx context.Context) (r *AiSettings, _
 	return r, ht.ErrNotImplemented
 }
 
+// GetAudienceOverlap implements getAudienceOverlap operation.
+//
+// Audience overlap between monitored channels over a trailing period. A channel's audience is
+// everyone who chatted or was present in IRC during the period (likely bots excluded when bot
+// detection hides them from stats). Returns Jaccard (shared / union) and overlap (shared / smaller
+// audience) matrices in channels order, the channel pairs with their top shared users, and per
+// channel the channels its community also frequents (shared monitored audience and GQL follows).
+// Results are cached per period for an hour.
+//
+// GET /api/v1/twitch/audience/overlap
+func (UnimplementedHandler) GetAudienceOverlap(ctx context.Context, params GetAudienceOverlapParams) (r *AudienceOverlap, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBotDetectionSettings implements getBotDetectionSettings operation.
+//
+// GET /api/v1/settings/bot-detection
+func (UnimplementedHandler) GetBotDetectionSettings(ctx context.Context) (r *BotDetectionSettings, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetChannelActivityHeatmap implements getChannelActivityHeatmap operation.
+//
+// When a monitored channel's chat is busiest: chatters' summed IRC presence seconds and chat
+// messages per local weekday and hour.
+//
+// GET /api/v1/twitch/channels/{login}/heatmap
+func (UnimplementedHandler) GetChannelActivityHeatmap(ctx context.Context, params GetChannelActivityHeatmapParams) (r GetChannelActivityHeatmapRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetChannelAnalytics implements getChannelAnalytics operation.
+//
+// Time-bucketed chat activity (messages, unique/new chatters, average IRC presence) for a monitored
+// channel and stream-over-stream chatter retention of the streams started in the range. Buckets are
+// UTC-aligned; hour and day series come from rollups refreshed every few minutes (see
+// rolled_up_until), minute series are computed live. Ranges are limited to 24h (minute), 31d (hour)
+// and 366d (day).
+//
+// GET /api/v1/twitch/channels/{login}/analytics
+func (UnimplementedHandler) GetChannelAnalytics(ctx context.Context, params GetChannelAnalyticsParams) (r GetChannelAnalyticsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetChannelDiscoverySettings implements getChannelDiscoverySettings operation.
 //
 // GET /api/v1/settings/channel-discovery
@@ -148,6 +264,25 @@ func (UnimplementedHandler) GetChannelDiscoverySettings(ctx context.Context) (r
 	return r, ht.ErrNotImplemented
 }
 
+// GetChannelEmoteUsage implements getChannelEmoteUsage operation.
+//
+// Emotes used most in a monitored channel's chat.
+//
+// GET /api/v1/twitch/channels/{login}/emotes
+func (UnimplementedHandler) GetChannelEmoteUsage(ctx context.Context, params GetChannelEmoteUsageParams) (r GetChannelEmoteUsageRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetChannelLeaderboard implements getChannelLeaderboard operation.
+//
+// Chatters of a monitored channel ranked by IRC presence, messages or account age over whole UTC
+// days. Totals come from daily rollups refreshed every few minutes (see rolled_up_until).
+//
+// GET /api/v1/twitch/channels/{login}/leaderboard
+func (UnimplementedHandler) GetChannelLeaderboard(ctx context.Context, params GetChannelLeaderboardParams) (r GetChannelLeaderboardRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetChannelLive implements getChannelLive operation.
 //
 // POST /api/v1/twitch/channels/live
@@ -155,6 +290,13 @@ func (UnimplementedHandler) GetChannelLive(ctx context.Context, req *GetChannelL
 	return r, ht.ErrNotImplemented
 }
 
+// GetChatClassifierSettings implements getChatClassifierSettings operation.
+//
+// GET /api/v1/settings/chat-classifier
+func (UnimplementedHandler) GetChatClassifierSettings(ctx context.Context) (r *ChatClassifierSettings, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetIrcMonitorSettings implements getIrcMonitorSettings operation.
 //
 // GET /api/v1/settings/irc-monitor-settings
@@ -169,6 +311,16 @@ func (UnimplementedHandler) GetIrcMonitorStatus(ctx context.Context) (r *IrcMoni
 	return r, ht.ErrNotImplemented
 }
 
+// GetMonitoredLeaderboard implements getMonitoredLeaderboard operation.
+//
+// Chatters ranked across all monitored channels over whole UTC days; presence and messages are
+// summed over channels. Same filters and rollup lag as the per-channel leaderboard.
+//
+// GET /api/v1/twitch/leaderboard
+func (UnimplementedHandler) GetMonitoredLeaderboard(ctx context.Context, params GetMonitoredLeaderboardParams) (r GetMonitoredLeaderboardRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetRecordedStream implements getRecordedStream operation.
 //
 // GET /api/v1/twitch/streams/{streamId}
@@ -176,6 +328,17 @@ func (UnimplementedHandler) GetRecordedStream(ctx context.Context, params GetRec
 	return r, ht.ErrNotImplemented
 }
 
+// GetRecordedStreamEmotes implements getRecordedStreamEmotes operation.
+//
+// The stream's most used emotes and a timeline of their uses from stream start, in buckets of whole
+// minutes
+// (about 60 buckets per stream). Timeline uses are aligned with `top`.
+//
+// GET /api/v1/twitch/streams/{streamId}/emotes
+func (UnimplementedHandler) GetRecordedStreamEmotes(ctx context.Context, params GetRecordedStreamEmotesParams) (r GetRecordedStreamEmotesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetRecordedStreamLeaderboard implements getRecordedStreamLeaderboard operation.
 //
 // GET /api/v1/twitch/streams/{streamId}/leaderboard
@@ -183,6 +346,45 @@ func (UnimplementedHandler) GetRecordedStreamLeaderboard(ctx context.Context, pa
 	return r, ht.ErrNotImplemented
 }
 
+// GetRecordedStreamTermSeries implements getRecordedStreamTermSeries operation.
+//
+// How many messages used a term over the stream, in buckets of whole minutes from stream start
+// (about 60
+// buckets per stream). The term is normalized like trending terms; emote codes match
+// case-insensitively.
+//
+// GET /api/v1/twitch/streams/{streamId}/terms/series
+func (UnimplementedHandler) GetRecordedStreamTermSeries(ctx context.Context, params GetRecordedStreamTermSeriesParams) (r GetRecordedStreamTermSeriesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRecordedStreamTerms implements getRecordedStreamTerms operation.
+//
+// Trending chat terms of a stream (or a window of it): words, 2-3 word phrases and emotes ranked by
+// TF-IDF,
+// i.e. the share of the window's messages using the term weighted by how rare the term is in the
+// channel's
+// recent streams. Stopwords, mentions and links are ignored.
+//
+// GET /api/v1/twitch/streams/{streamId}/terms
+func (UnimplementedHandler) GetRecordedStreamTerms(ctx context.Context, params GetRecordedStreamTermsParams) (r GetRecordedStreamTermsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetStreamRecapSettings implements getStreamRecapSettings operation.
+//
+// GET /api/v1/settings/stream-recap
+func (UnimplementedHandler) GetStreamRecapSettings(ctx context.Context) (r *StreamRecapSettings, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetSuspicionReevaluation implements getSuspicionReevaluation operation.
+//
+// GET /api/v1/settings/suspicion-settings/reevaluate
+func (UnimplementedHandler) GetSuspicionReevaluation(ctx context.Context) (r *SuspicionReevaluation, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetSuspicionSettings implements getSuspicionSettings operation.
 //
 // GET /api/v1/settings/suspicion-settings
@@ -200,6 +402,16 @@ func (UnimplementedHandler) GetSystemStats(ctx context.Context) (r GetSystemStat
 	return r, ht.ErrNotImplemented
 }
 
+// GetTwitchUserActivityHeatmap implements getTwitchUserActivityHeatmap operation.
+//
+// When the user is usually online and chatting: IRC presence seconds (summed over monitored
+// channels) and chat messages per local weekday and hour.
+//
+// GET /api/v1/twitch/users/{twitch_user_id}/heatmap
+func (UnimplementedHandler) GetTwitchUserActivityHeatmap(ctx context.Context, params GetTwitchUserActivityHeatmapParams) (r GetTwitchUserActivityHeatmapRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTwitchUserActivityTimeline implements getTwitchUserActivityTimeline operation.
 //
 // POST /api/v1/twitch/users/activity/timeline
@@ -207,6 +419,15 @@ func (UnimplementedHandler) GetTwitchUserActivityTimeline(ctx context.Context, r
 	return r, ht.ErrNotImplemented
 }
 
+// GetTwitchUserEmoteUsage implements getTwitchUserEmoteUsage operation.
+//
+// Emotes the user used most in monitored channels' chat.
+//
+// GET /api/v1/twitch/users/{twitch_user_id}/emotes
+func (UnimplementedHandler) GetTwitchUserEmoteUsage(ctx context.Context, params GetTwitchUserEmoteUsageParams) (r GetTwitchUserEmoteUsageRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTwitchUserProfile implements getTwitchUserProfile operation.
 //
 // POST /api/v1/twitch/users/profile
@@ -235,6 +456,13 @@ func (UnimplementedHandler) ListAiMessages(ctx context.Context, params ListAiMes
 	return r, ht.ErrNotImplemented
 }
 
+// ListBlocklists implements listBlocklists operation.
+//
+// GET /api/v1/settings/blocklists
+func (UnimplementedHandler) ListBlocklists(ctx context.Context) (r []Blocklist, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListChannelBlacklist implements listChannelBlacklist operation.
 //
 // GET /api/v1/settings/channel-blacklist
@@ -242,6 +470,15 @@ func (UnimplementedHandler) ListChannelBlacklist(ctx context.Context) (r []strin
 	return r, ht.ErrNotImplemented
 }
 
+// ListChannelBotEstimates implements listChannelBotEstimates operation.
+//
+// Estimated bot share of each live monitored channel from the latest bot detection run.
+//
+// GET /api/v1/twitch/bots/channels
+func (UnimplementedHandler) ListChannelBotEstimates(ctx context.Context) (r []ChannelBotEstimate, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListChannelChatters implements listChannelChatters operation.
 //
 // POST /api/v1/twitch/channels/chatters
@@ -263,6 +500,13 @@ func (UnimplementedHandler) ListChatHistory(ctx context.Context, params ListChat
 	return r, ht.ErrNotImplemented
 }
 
+// ListEmoteSets implements listEmoteSets operation.
+//
+// GET /api/v1/settings/emote-sets
+func (UnimplementedHandler) ListEmoteSets(ctx context.Context) (r []EmoteSet, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListIrcMonitorJoinedHistory implements listIrcMonitorJoinedHistory operation.
 //
 // Historical IRC joined channel counts (periodic samples).
@@ -272,6 +516,43 @@ func (UnimplementedHandler) ListIrcMonitorJoinedHistory(ctx context.Context, par
 	return r, ht.ErrNotImplemented
 }
 
+// ListLikelyBots implements listLikelyBots operation.
+//
+// Accounts present in many monitored channels at once that (almost) never chat, as flagged by the
+// periodic bot detection. Users stay listed for 7 days after they were last detected.
+//
+// GET /api/v1/twitch/bots
+func (UnimplementedHandler) ListLikelyBots(ctx context.Context, params ListLikelyBotsParams) (r []LikelyBot, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLoginPatterns implements listLoginPatterns operation.
+//
+// GET /api/v1/settings/login-patterns
+func (UnimplementedHandler) ListLoginPatterns(ctx context.Context) (r []LoginPattern, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListNotificationDeliveries implements listNotificationDeliveries operation.
+//
+// Notification outbox delivery log (newest first) with per-attempt status codes, response snippets
+// and errors.
+// Cursor-based incremental loading like listNotifications.
+//
+// GET /api/v1/settings/notifications/deliveries
+func (UnimplementedHandler) ListNotificationDeliveries(ctx context.Context, params ListNotificationDeliveriesParams) (r []NotificationDelivery, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListNotificationSnoozes implements listNotificationSnoozes operation.
+//
+// Active snoozes, soonest expiry first.
+//
+// GET /api/v1/settings/notifications/snoozes
+func (UnimplementedHandler) ListNotificationSnoozes(ctx context.Context) (r []NotificationSnooze, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListNotifications implements listNotifications operation.
 //
 // List notification entries (newest first) with cursor-based incremental loading.
@@ -333,6 +614,15 @@ func (UnimplementedHandler) ListRules(ctx context.Context) (r []Rule, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// ListSuspicionEvents implements listSuspicionEvents operation.
+//
+// Suspicion audit log (newest first) with filters and cursor-based incremental loading.
+//
+// GET /api/v1/twitch/suspicion-events
+func (UnimplementedHandler) ListSuspicionEvents(ctx context.Context, params ListSuspicionEventsParams) (r []SuspicionEvent, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListTwitchAccounts implements listTwitchAccounts operation.
 //
 // GET /api/v1/settings/twitch-accounts
@@ -393,6 +683,57 @@ func (UnimplementedHandler) PatchAiSettings(ctx context.Context, req *PatchAiSet
 	return r, ht.ErrNotImplemented
 }
 
+// PreviewRuleNotify implements previewRuleNotify operation.
+//
+// Expands a notify action's `text` template (or the engine default when empty) for a sample event.
+// Sample fields that are omitted use placeholder values.
+//
+// POST /api/v1/settings/rules/notify-preview
+func (UnimplementedHandler) PreviewRuleNotify(ctx context.Context, req *PreviewRuleNotifyRequest) (r PreviewRuleNotifyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ResendNotificationDelivery implements resendNotificationDelivery operation.
+//
+// Requeue a delivery (typically dead) as pending with a fresh retry budget; earlier attempts stay in
+// the log.
+//
+// POST /api/v1/settings/notifications/deliveries/resend
+func (UnimplementedHandler) ResendNotificationDelivery(ctx context.Context, req *ResendNotificationDeliveryRequest) (r ResendNotificationDeliveryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SaveEmoteSet implements saveEmoteSet operation.
+//
+// Creates a third-party emote set or replaces the codes of the set with the same name. Words of
+// incoming chat
+// messages equal to a code (case-sensitive) are stored as emote occurrences; sets scoped to a
+// channel win over
+// global ones. The IRC monitor reloads sets every minute and stored messages are not re-scanned.
+//
+// POST /api/v1/settings/emote-sets
+func (UnimplementedHandler) SaveEmoteSet(ctx context.Context, req *SaveEmoteSetRequest) (r SaveEmoteSetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ScanLikelyBots implements scanLikelyBots operation.
+//
+// Runs bot detection now instead of waiting for the next periodic run.
+//
+// POST /api/v1/twitch/bots/scan
+func (UnimplementedHandler) ScanLikelyBots(ctx context.Context) error {
+	return ht.ErrNotImplemented
+}
+
+// ScanTwitchUserAlts implements scanTwitchUserAlts operation.
+//
+// Re-scores the user's possible alts now instead of waiting for the hourly alt-detection run.
+//
+// POST /api/v1/twitch/users/{twitch_user_id}/alts/scan
+func (UnimplementedHandler) ScanTwitchUserAlts(ctx context.Context, params ScanTwitchUserAltsParams) (r ScanTwitchUserAltsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SendMessage implements sendMessage operation.
 //
 // POST /api/v1/twitch/send
@@ -407,6 +748,25 @@ func (UnimplementedHandler) SetChannelBlacklist(ctx context.Context, req *Channe
 	return r, ht.ErrNotImplemented
 }
 
+// SetTwitchUserLink implements setTwitchUserLink operation.
+//
+// Confirms or rejects that two users are the same person.
+//
+// POST /api/v1/twitch/users/links
+func (UnimplementedHandler) SetTwitchUserLink(ctx context.Context, req *SetTwitchUserLinkRequest) (r SetTwitchUserLinkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StartSuspicionReevaluation implements startSuspicionReevaluation operation.
+//
+// Re-scores every known user from cached enrichment data in the background. Progress is also pushed
+// over /ws as suspicion_reevaluation messages. A request made while a run is in progress is queued.
+//
+// POST /api/v1/settings/suspicion-settings/reevaluate
+func (UnimplementedHandler) StartSuspicionReevaluation(ctx context.Context, req OptStartSuspicionReevaluationRequest) (r *SuspicionReevaluation, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // StartTwitchOAuth implements startTwitchOAuth operation.
 //
 // Start Twitch authorization (browser) to link an account without pasting a refresh token.
@@ -423,6 +783,39 @@ func (UnimplementedHandler) StopAiAgent(ctx context.Context, params StopAiAgentP
 	return r, ht.ErrNotImplemented
 }
 
+// SyncBlocklist implements syncBlocklist operation.
+//
+// Pulls a list's `source_url` now. Download and parse failures are reported in `last_sync_error` of
+// the
+// returned list; unchanged content keeps the current entries.
+//
+// POST /api/v1/settings/blocklists/sync
+func (UnimplementedHandler) SyncBlocklist(ctx context.Context, req *SyncBlocklistRequest) (r SyncBlocklistRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// TestLoginPattern implements testLoginPattern operation.
+//
+// Checks a pattern against sample logins without saving it; shows how the pattern and each login are
+// normalized before matching.
+//
+// POST /api/v1/settings/login-patterns/test
+func (UnimplementedHandler) TestLoginPattern(ctx context.Context, req *TestLoginPatternRequest) (r *TestLoginPatternResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// TestNotification implements testNotification operation.
+//
+// Renders a sample event of each type (or only `event_types`) and sends it through the entry's
+// provider
+// synchronously, bypassing the outbox, snoozes and the entry's policy. Works for disabled entries.
+// Tokens and URL credentials from the entry settings are redacted from responses and errors.
+//
+// POST /api/v1/settings/notifications/{id}/test
+func (UnimplementedHandler) TestNotification(ctx context.Context, req OptTestNotificationRequest, params TestNotificationParams) (r TestNotificationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // TestRuleRegex implements testRuleRegex operation.
 //
 // POST /api/v1/settings/rules/test-regex
@@ -430,6 +823,13 @@ func (UnimplementedHandler) TestRuleRegex(ctx context.Context, req *TestRuleRege
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateBotDetectionSettings implements updateBotDetectionSettings operation.
+//
+// PATCH /api/v1/settings/bot-detection
+func (UnimplementedHandler) UpdateBotDetectionSettings(ctx context.Context, req *BotDetectionSettings) (r UpdateBotDetectionSettingsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateChannelDiscoverySettings implements updateChannelDiscoverySettings operation.
 //
 // PATCH /api/v1/settings/channel-discovery
@@ -437,6 +837,13 @@ func (UnimplementedHandler) UpdateChannelDiscoverySettings(ctx context.Context,
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateChatClassifierSettings implements updateChatClassifierSettings operation.
+//
+// PATCH /api/v1/settings/chat-classifier
+func (UnimplementedHandler) UpdateChatClassifierSettings(ctx context.Context, req *ChatClassifierSettings) (r UpdateChatClassifierSettingsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateIrcMonitorSettings implements updateIrcMonitorSettings operation.
 //
 // PATCH /api/v1/settings/irc-monitor-settings
@@ -458,10 +865,17 @@ func (UnimplementedHandler) UpdateRule(ctx context.Context, req *UpdateRulePostR
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateStreamRecapSettings implements updateStreamRecapSettings operation.
+//
+// PATCH /api/v1/settings/stream-recap
+func (UnimplementedHandler) UpdateStreamRecapSettings(ctx context.Context, req *StreamRecapSettings) (r *StreamRecapSettings, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateSuspicionSettings implements updateSuspicionSettings operation.
 //
 // PATCH /api/v1/settings/suspicion-settings
-func (UnimplementedHandler) UpdateSuspicionSettings(ctx context.Context, req *SuspicionSettings) (r *SuspicionSettings, _ error) {
+func (UnimplementedHandler) UpdateSuspicionSettings(ctx context.Context, req *SuspicionSettings) (r UpdateSuspicionSettingsRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -478,3 +892,12 @@ func (UnimplementedHandler) UpdateTwitchAccount(ctx context.Context, req *Update
 func (UnimplementedHandler) UpdateTwitchUser(ctx context.Context, req *UpdateTwitchUserPostRequest) (r UpdateTwitchUserRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// UploadBlocklist implements uploadBlocklist operation.
+//
+// Replaces a blocklist's entries with file content parsed in the list's format.
+//
+// POST /api/v1/settings/blocklists/upload
+func (UnimplementedHandler) UploadBlocklist(ctx context.Context, req *UploadBlocklistRequest) (r UploadBlocklistRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+	twitchuc "github.com/rofleksey/dredge/internal/usecase/twitch"
+)
+
+func (h *Handler) GetTwitchUserActivityHeatmap(ctx context.Context, params gen.GetTwitchUserActivityHeatmapParams) (gen.GetTwitchUserActivityHeatmapRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_twitch_user_activity_heatmap")
+	defer span.End()
+
+	hm, err := h.twitch.UserActivityHeatmap(ctx, params.TwitchUserID, entity.ActivityHeatmapQuery{
+		From:     params.From.Or(time.Time{}),
+		To:       params.To.Or(time.Time{}),
+		Timezone: params.Timezone.Or(""),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidActivityHeatmapQuery) {
+			return &gen.GetTwitchUserActivityHeatmapBadRequest{Message: err.Error()}, nil
+		}
+
+		if errors.Is(err, entity.ErrTwitchUserNotFound) {
+			return &gen.GetTwitchUserActivityHeatmapNotFound{Message: "user not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "user activity heatmap failed", err, zap.Int64("user_id", params.TwitchUserID))
+		return nil, err
+	}
+
+	return activityHeatmapToGen(hm), nil
+}
+
+func (h *Handler) GetChannelActivityHeatmap(ctx context.Context, params gen.GetChannelActivityHeatmapParams) (gen.GetChannelActivityHeatmapRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_channel_activity_heatmap")
+	defer span.End()
+
+	hm, err := h.twitch.ChannelActivityHeatmap(ctx, params.Login, entity.ActivityHeatmapQuery{
+		From:     params.From.Or(time.Time{}),
+		To:       params.To.Or(time.Time{}),
+		Timezone: params.Timezone.Or(""),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidActivityHeatmapQuery) {
+			return &gen.GetChannelActivityHeatmapBadRequest{Message: err.Error()}, nil
+		}
+
+		if errors.Is(err, twitchuc.ErrChannelNotMonitored) {
+			return &gen.GetChannelActivityHeatmapNotFound{Message: "channel is not monitored"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "channel activity heatmap failed", err, zap.String("channel", params.Login))
+		return nil, err
+	}
+
+	return activityHeatmapToGen(hm), nil
+}
+
+func activityHeatmapToGen(hm entity.ActivityHeatmap) *gen.ActivityHeatmap {
+	return &gen.ActivityHeatmap{
+		Timezone:        hm.Timezone,
+		From:            hm.From,
+		To:              hm.To,
+		PresenceSeconds: hourOfWeekRows(hm.PresenceSeconds),
+		Messages:        hourOfWeekRows(hm.Messages),
+	}
+}
+
+func hourOfWeekRows(m entity.HourOfWeekMatrix) [][]int64 {
+	out := make([][]int64, len(m))
+	for i := range m {
+		out[i] = append([]int64(nil), m[i][:]...)
+	}
+
+	return out
+}
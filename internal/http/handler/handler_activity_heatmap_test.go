@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_GetTwitchUserActivityHeatmap(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	var msgs entity.HourOfWeekMatrix
+	msgs[0][19] = 7
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(5)).Return(entity.TwitchUser{ID: 5}, nil)
+	repo.EXPECT().ListUserActivityEventsForTimeline(gomock.Any(), int64(5), gomock.Any(), to).Return(nil, nil)
+	repo.EXPECT().CountChatMessagesByHourOfWeek(gomock.Any(), gomock.Any(), nil, from, to, "Asia/Tokyo").Return(msgs, nil)
+
+	res, err := h.GetTwitchUserActivityHeatmap(context.Background(), gen.GetTwitchUserActivityHeatmapParams{
+		TwitchUserID: 5, From: gen.NewOptDateTime(from), To: gen.NewOptDateTime(to), Timezone: gen.NewOptString("Asia/Tokyo"),
+	})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.ActivityHeatmap)
+	require.True(t, ok)
+	assert.Equal(t, "Asia/Tokyo", out.Timezone)
+	require.Len(t, out.Messages, 7)
+	require.Len(t, out.Messages[0], 24)
+	assert.Equal(t, int64(7), out.Messages[0][19])
+	require.Len(t, out.PresenceSeconds, 7)
+
+	res, err = h.GetTwitchUserActivityHeatmap(context.Background(), gen.GetTwitchUserActivityHeatmapParams{
+		TwitchUserID: 5, Timezone: gen.NewOptString("Not/AZone"),
+	})
+	require.NoError(t, err)
+
+	_, ok = res.(*gen.GetTwitchUserActivityHeatmapBadRequest)
+	require.True(t, ok)
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(6)).Return(entity.TwitchUser{}, entity.ErrTwitchUserNotFound)
+
+	res, err = h.GetTwitchUserActivityHeatmap(context.Background(), gen.GetTwitchUserActivityHeatmapParams{TwitchUserID: 6})
+	require.NoError(t, err)
+
+	_, ok = res.(*gen.GetTwitchUserActivityHeatmapNotFound)
+	require.True(t, ok)
+}
+
+func TestHandler_GetChannelActivityHeatmap(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "chan").Return(int64(9), true, nil)
+	repo.EXPECT().ListUserActivityEventsForChannelPresence(gomock.Any(), int64(9), gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().CountChatMessagesByHourOfWeek(gomock.Any(), nil, gomock.Any(), gomock.Any(), gomock.Any(), "UTC").
+		Return(entity.HourOfWeekMatrix{}, nil)
+
+	res, err := h.GetChannelActivityHeatmap(context.Background(), gen.GetChannelActivityHeatmapParams{Login: "chan"})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.ActivityHeatmap)
+	require.True(t, ok)
+	assert.Equal(t, "UTC", out.Timezone)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "x").Return(int64(0), false, nil)
+
+	res, err = h.GetChannelActivityHeatmap(context.Background(), gen.GetChannelActivityHeatmapParams{Login: "x"})
+	require.NoError(t, err)
+
+	_, ok = res.(*gen.GetChannelActivityHeatmapNotFound)
+	require.True(t, ok)
+}
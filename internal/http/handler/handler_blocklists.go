@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) ListBlocklists(ctx context.Context) ([]gen.Blocklist, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.list_blocklists")
+	defer span.End()
+
+	list, err := h.sett.ListBlocklists(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "list blocklists failed", err)
+		return nil, err
+	}
+
+	out := make([]gen.Blocklist, 0, len(list))
+	for _, b := range list {
+		out = append(out, blocklistEntityToGen(b))
+	}
+
+	return out, nil
+}
+
+func (h *Handler) CreateBlocklist(ctx context.Context, req *gen.CreateBlocklistRequest) (gen.CreateBlocklistRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.create_blocklist")
+	defer span.End()
+
+	b := entity.Blocklist{
+		Name:                req.Name,
+		Format:              string(req.Format),
+		SyncIntervalMinutes: req.SyncIntervalMinutes.Or(0),
+		Enabled:             req.Enabled.Or(true),
+	}
+
+	if u, ok := req.SourceURL.Get(); ok {
+		b.SourceURL = &u
+	}
+
+	out, err := h.sett.CreateBlocklist(ctx, b)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidBlocklist) {
+			return &gen.ErrorMessage{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "create blocklist failed", err)
+		return nil, err
+	}
+
+	res := blocklistEntityToGen(out)
+
+	return &res, nil
+}
+
+func (h *Handler) DeleteBlocklist(ctx context.Context, req *gen.DeleteByIDRequest) (gen.DeleteBlocklistRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.delete_blocklist")
+	defer span.End()
+
+	if err := h.sett.DeleteBlocklist(ctx, req.ID); err != nil {
+		if errors.Is(err, entity.ErrBlocklistNotFound) {
+			return &gen.ErrorMessage{Message: "blocklist not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "delete blocklist failed", err, zap.Int64("id", req.ID))
+		return nil, err
+	}
+
+	return &gen.DeleteBlocklistNoContent{}, nil
+}
+
+func (h *Handler) UploadBlocklist(ctx context.Context, req *gen.UploadBlocklistRequest) (gen.UploadBlocklistRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.upload_blocklist")
+	defer span.End()
+
+	out, err := h.sett.UploadBlocklist(ctx, req.ID, req.Filename.Or(""), []byte(req.Content))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrBlocklistNotFound):
+			return &gen.UploadBlocklistNotFound{Message: "blocklist not found"}, nil
+		case errors.Is(err, entity.ErrInvalidBlocklist):
+			return &gen.UploadBlocklistBadRequest{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "upload blocklist failed", err, zap.Int64("id", req.ID))
+		return nil, err
+	}
+
+	res := blocklistEntityToGen(out)
+
+	return &res, nil
+}
+
+func (h *Handler) SyncBlocklist(ctx context.Context, req *gen.SyncBlocklistRequest) (gen.SyncBlocklistRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.sync_blocklist")
+	defer span.End()
+
+	out, err := h.twitch.SyncBlocklist(ctx, req.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrBlocklistNotFound):
+			return &gen.SyncBlocklistNotFound{Message: "blocklist not found"}, nil
+		case errors.Is(err, entity.ErrInvalidBlocklist):
+			return &gen.SyncBlocklistBadRequest{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "sync blocklist failed", err, zap.Int64("id", req.ID))
+		return nil, err
+	}
+
+	res := blocklistEntityToGen(out)
+
+	return &res, nil
+}
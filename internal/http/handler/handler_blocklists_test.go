@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_CreateBlocklist(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	src := "https://lists.example.org/raids.json"
+
+	repo.EXPECT().ListBlocklists(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().CreateBlocklist(gomock.Any(), entity.Blocklist{
+		Name: "raids", Format: entity.BlocklistFormatJSON, SourceURL: &src, SyncIntervalMinutes: 60, Enabled: true,
+	}).Return(entity.Blocklist{ID: 1, Name: "raids", Format: entity.BlocklistFormatJSON, SourceURL: &src}, nil)
+
+	res, err := h.CreateBlocklist(context.Background(), &gen.CreateBlocklistRequest{
+		Name:                "raids",
+		Format:              gen.BlocklistFormatJSON,
+		SourceURL:           gen.NewOptString(src),
+		SyncIntervalMinutes: gen.NewOptInt(60),
+	})
+	require.NoError(t, err)
+
+	b, ok := res.(*gen.Blocklist)
+	require.True(t, ok)
+	assert.Equal(t, src, b.SourceURL.Value)
+	assert.True(t, b.SourceFilename.Null)
+	assert.True(t, b.LastSyncedAt.Null)
+}
+
+func TestHandler_CreateBlocklist_invalid(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, _ := testHandler(t)
+	defer ctrl.Finish()
+
+	res, err := h.CreateBlocklist(context.Background(), &gen.CreateBlocklistRequest{
+		Name: "raids", Format: gen.BlocklistFormatText, SourceURL: gen.NewOptString("file:///etc/passwd"),
+	})
+	require.NoError(t, err)
+
+	em, ok := res.(*gen.ErrorMessage)
+	require.True(t, ok)
+	assert.Contains(t, em.Message, "source_url")
+}
+
+func TestHandler_UploadBlocklist(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	list := entity.Blocklist{ID: 2, Name: "raids", Format: entity.BlocklistFormatText}
+
+	repo.EXPECT().GetBlocklist(gomock.Any(), int64(9)).Return(entity.Blocklist{}, entity.ErrBlocklistNotFound)
+	repo.EXPECT().GetBlocklist(gomock.Any(), int64(2)).Return(list, nil)
+
+	res, err := h.UploadBlocklist(context.Background(), &gen.UploadBlocklistRequest{ID: 9, Content: "a"})
+	require.NoError(t, err)
+	_, ok := res.(*gen.UploadBlocklistNotFound)
+	assert.True(t, ok)
+
+	res, err = h.UploadBlocklist(context.Background(), &gen.UploadBlocklistRequest{ID: 2, Content: "# nothing\n"})
+	require.NoError(t, err)
+	_, ok = res.(*gen.UploadBlocklistBadRequest)
+	assert.True(t, ok)
+}
+
+func TestHandler_SyncBlocklist(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	body := "raid_a\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	src := srv.URL
+	digest := entity.BlocklistDigest([]byte(body))
+	synced := time.Now().UTC()
+	list := entity.Blocklist{ID: 2, Name: "raids", Format: entity.BlocklistFormatText, SourceURL: &src, ContentSHA256: &digest}
+
+	repo.EXPECT().GetBlocklist(gomock.Any(), int64(2)).Return(list, nil)
+	repo.EXPECT().RecordBlocklistSync(gomock.Any(), int64(2), gomock.Any(), nil).Return(nil)
+
+	list.LastSyncedAt = &synced
+	repo.EXPECT().GetBlocklist(gomock.Any(), int64(2)).Return(list, nil)
+
+	res, err := h.SyncBlocklist(context.Background(), &gen.SyncBlocklistRequest{ID: 2})
+	require.NoError(t, err)
+
+	b, ok := res.(*gen.Blocklist)
+	require.True(t, ok)
+	assert.False(t, b.LastSyncedAt.Null)
+	assert.True(t, b.LastSyncError.Null)
+}
+
+func TestHandler_DeleteBlocklist_notFound(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().DeleteBlocklist(gomock.Any(), int64(5)).Return(entity.ErrBlocklistNotFound)
+
+	res, err := h.DeleteBlocklist(context.Background(), &gen.DeleteByIDRequest{ID: 5})
+	require.NoError(t, err)
+
+	_, ok := res.(*gen.ErrorMessage)
+	assert.True(t, ok)
+}
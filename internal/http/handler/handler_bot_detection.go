@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) GetBotDetectionSettings(ctx context.Context) (*gen.BotDetectionSettings, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_bot_detection_settings")
+	defer span.End()
+
+	s, err := h.sett.GetBotDetectionSettings(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get bot detection settings failed", err)
+		return nil, err
+	}
+
+	return botDetectionEntityToGen(s), nil
+}
+
+func (h *Handler) UpdateBotDetectionSettings(ctx context.Context, req *gen.BotDetectionSettings) (gen.UpdateBotDetectionSettingsRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.update_bot_detection_settings")
+	defer span.End()
+
+	out, err := h.sett.UpdateBotDetectionSettings(ctx, entity.BotDetectionSettings{
+		Enabled:               req.Enabled,
+		MinConcurrentChannels: req.MinConcurrentChannels,
+		MaxMessagesPerHour:    req.MaxMessagesPerHour,
+		ViewersPerChatter:     req.ViewersPerChatter,
+		ExcludeFromStats:      req.ExcludeFromStats,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidBotDetectionSettings) {
+			return &gen.ErrorMessage{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "update bot detection settings failed", err)
+		return nil, err
+	}
+
+	return botDetectionEntityToGen(out), nil
+}
+
+func (h *Handler) ListLikelyBots(ctx context.Context, params gen.ListLikelyBotsParams) ([]gen.LikelyBot, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.list_likely_bots")
+	defer span.End()
+
+	list, err := h.twitch.ListLikelyBots(ctx, min(max(params.Limit.Or(100), 1), 500))
+	if err != nil {
+		h.obs.LogError(ctx, span, "list likely bots failed", err)
+		return nil, err
+	}
+
+	out := make([]gen.LikelyBot, 0, len(list))
+	for _, b := range list {
+		out = append(out, gen.LikelyBot{
+			TwitchUserID:       b.TwitchUserID,
+			Username:           b.Username,
+			ConcurrentChannels: b.ConcurrentChannels,
+			PresentSeconds:     b.PresentSeconds,
+			MessageCount:       b.MessageCount,
+			FirstDetectedAt:    b.FirstDetectedAt,
+			LastDetectedAt:     b.LastDetectedAt,
+		})
+	}
+
+	return out, nil
+}
+
+func (h *Handler) ListChannelBotEstimates(ctx context.Context) ([]gen.ChannelBotEstimate, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.list_channel_bot_estimates")
+	defer span.End()
+
+	list, err := h.twitch.ListChannelBotEstimates(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "list channel bot estimates failed", err)
+		return nil, err
+	}
+
+	out := make([]gen.ChannelBotEstimate, 0, len(list))
+	for _, e := range list {
+		out = append(out, gen.ChannelBotEstimate{
+			ChannelTwitchUserID: e.ChannelTwitchUserID,
+			ChannelLogin:        e.ChannelLogin,
+			ViewerCount:         e.ViewerCount,
+			ChatterCount:        e.ChatterCount,
+			BotChatterCount:     e.BotChatterCount,
+			BotShare:            e.BotShare,
+			ViewerInflated:      e.ViewerInflated,
+			ComputedAt:          e.ComputedAt,
+		})
+	}
+
+	return out, nil
+}
+
+func (h *Handler) ScanLikelyBots(ctx context.Context) error {
+	ctx, span := h.obs.StartSpan(ctx, "handler.scan_likely_bots")
+	defer span.End()
+
+	if err := h.twitch.RunBotDetection(ctx); err != nil {
+		h.obs.LogError(ctx, span, "scan likely bots failed", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_UpdateBotDetectionSettings_invalid(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, _ := testHandler(t)
+	defer ctrl.Finish()
+
+	res, err := h.UpdateBotDetectionSettings(context.Background(), &gen.BotDetectionSettings{
+		Enabled:               true,
+		MinConcurrentChannels: 1,
+		MaxMessagesPerHour:    0.05,
+		ViewersPerChatter:     4,
+	})
+	require.NoError(t, err)
+
+	em, ok := res.(*gen.ErrorMessage)
+	require.True(t, ok)
+	assert.Contains(t, em.Message, "min_concurrent_channels")
+}
+
+func TestHandler_ListLikelyBots_clampsLimit(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+
+	repo.EXPECT().ListLikelyBots(gomock.Any(), 500).Return([]entity.LikelyBot{{
+		TwitchUserID: 7, Username: "lurker", ConcurrentChannels: 30, PresentSeconds: 3600,
+		FirstDetectedAt: now, LastDetectedAt: now,
+	}}, nil)
+
+	out, err := h.ListLikelyBots(context.Background(), gen.ListLikelyBotsParams{Limit: gen.NewOptInt(10000)})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, "lurker", out[0].Username)
+	assert.Equal(t, 30, out[0].ConcurrentChannels)
+}
+
+func TestHandler_ListChannelBotEstimates(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().ListChannelBotEstimates(gomock.Any()).Return([]entity.ChannelBotEstimate{{
+		ChannelTwitchUserID: 3, ChannelLogin: "streamer", ViewerCount: 100, ChatterCount: 20,
+		BotChatterCount: 5, BotShare: 0.7, ViewerInflated: true, ComputedAt: time.Now().UTC(),
+	}}, nil)
+
+	out, err := h.ListChannelBotEstimates(context.Background())
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, "streamer", out[0].ChannelLogin)
+	assert.InDelta(t, 0.7, out[0].BotShare, 1e-9)
+	assert.True(t, out[0].ViewerInflated)
+}
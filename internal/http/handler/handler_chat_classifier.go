@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) GetChatClassifierSettings(ctx context.Context) (*gen.ChatClassifierSettings, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_chat_classifier_settings")
+	defer span.End()
+
+	s, err := h.sett.GetChatClassifierSettings(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get chat classifier settings failed", err)
+		return nil, err
+	}
+
+	used, err := h.sett.ChatClassifierTokensUsedToday(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get chat classifier tokens used failed", err)
+		return nil, err
+	}
+
+	return chatClassifierEntityToGen(s, used), nil
+}
+
+func (h *Handler) UpdateChatClassifierSettings(ctx context.Context, req *gen.ChatClassifierSettings) (gen.UpdateChatClassifierSettingsRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.update_chat_classifier_settings")
+	defer span.End()
+
+	out, err := h.sett.UpdateChatClassifierSettings(ctx, entity.ChatClassifierSettings{
+		Enabled:          req.Enabled,
+		Channels:         req.Channels,
+		SampleRate:       req.SampleRate,
+		BatchSize:        req.BatchSize,
+		DailyTokenBudget: req.DailyTokenBudget,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidChatClassifierSettings) {
+			return &gen.ErrorMessage{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "update chat classifier settings failed", err)
+		return nil, err
+	}
+
+	used, err := h.sett.ChatClassifierTokensUsedToday(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get chat classifier tokens used failed", err)
+		return nil, err
+	}
+
+	return chatClassifierEntityToGen(out, used), nil
+}
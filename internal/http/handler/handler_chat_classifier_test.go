@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_GetChatClassifierSettings(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().GetChatClassifierSettings(gomock.Any()).Return(entity.ChatClassifierSettings{
+		Enabled: true, Channels: []string{"streamer"}, SampleRate: 0.2, BatchSize: 20, DailyTokenBudget: 1000,
+	}, nil)
+	repo.EXPECT().ChatClassifierTokensUsed(gomock.Any(), gomock.Any()).Return(int64(300), nil)
+
+	out, err := h.GetChatClassifierSettings(context.Background())
+	require.NoError(t, err)
+	assert.True(t, out.Enabled)
+	assert.Equal(t, []string{"streamer"}, out.Channels)
+	assert.Equal(t, int64(1000), out.DailyTokenBudget)
+	assert.Equal(t, gen.NewOptInt64(300), out.TokensUsedToday)
+}
+
+func TestHandler_UpdateChatClassifierSettings_invalid(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, _ := testHandler(t)
+	defer ctrl.Finish()
+
+	res, err := h.UpdateChatClassifierSettings(context.Background(), &gen.ChatClassifierSettings{
+		Enabled:    true,
+		Channels:   []string{},
+		SampleRate: 2,
+		BatchSize:  20,
+	})
+	require.NoError(t, err)
+
+	em, ok := res.(*gen.ErrorMessage)
+	require.True(t, ok)
+	assert.Contains(t, em.Message, "sample_rate")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-faster/jx"
 
+	"github.com/rofleksey/dredge/internal/entity"
 	"github.com/rofleksey/dredge/internal/http/gen"
 )
 
@@ -14,7 +15,14 @@ func (h *Handler) CreateNotification(ctx context.Context, req *gen.CreateNotific
 		enabled = req.Enabled.Value
 	}
 
-	e, err := h.sett.CreateNotification(ctx, string(req.Provider), rawSettingsToMap(map[string]jx.Raw(req.Settings)), enabled)
+	routing := entity.NotificationRouting{
+		Tags:       req.Tags,
+		EventTypes: req.EventTypes,
+		Channels:   req.Channels,
+	}
+
+	e, err := h.sett.CreateNotification(ctx, string(req.Provider), rawSettingsToMap(map[string]jx.Raw(req.Settings)), enabled, routing,
+		notificationPolicyFromGen(req.Policy.Or(gen.NotificationPolicy{})))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) ListEmoteSets(ctx context.Context) ([]gen.EmoteSet, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.list_emote_sets")
+	defer span.End()
+
+	list, err := h.sett.ListEmoteSets(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "list emote sets failed", err)
+		return nil, err
+	}
+
+	out := make([]gen.EmoteSet, 0, len(list))
+	for _, s := range list {
+		out = append(out, emoteSetEntityToGen(s))
+	}
+
+	return out, nil
+}
+
+func (h *Handler) SaveEmoteSet(ctx context.Context, req *gen.SaveEmoteSetRequest) (gen.SaveEmoteSetRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.save_emote_set")
+	defer span.End()
+
+	emotes := make([]entity.EmoteSetEmote, 0, len(req.Emotes))
+	for _, e := range req.Emotes {
+		emotes = append(emotes, entity.EmoteSetEmote{Code: e.Code, EmoteID: e.ID.Or("")})
+	}
+
+	out, err := h.sett.SaveEmoteSet(ctx, entity.EmoteSet{
+		Name:     req.Name,
+		Provider: string(req.Provider),
+		Channel:  req.Channel.Or(""),
+	}, emotes)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidEmoteSet) {
+			return &gen.ErrorMessage{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "save emote set failed", err, zap.String("name", req.Name))
+		return nil, err
+	}
+
+	s := emoteSetEntityToGen(out)
+
+	return &s, nil
+}
+
+func (h *Handler) DeleteEmoteSet(ctx context.Context, req *gen.DeleteByIDRequest) (gen.DeleteEmoteSetRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.delete_emote_set")
+	defer span.End()
+
+	if err := h.sett.DeleteEmoteSet(ctx, req.ID); err != nil {
+		if errors.Is(err, entity.ErrEmoteSetNotFound) {
+			return &gen.ErrorMessage{Message: "emote set not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "delete emote set failed", err, zap.Int64("id", req.ID))
+		return nil, err
+	}
+
+	return &gen.DeleteEmoteSetNoContent{}, nil
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_ListEmoteSets(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().ListEmoteSets(gomock.Any()).Return([]entity.EmoteSet{
+		{ID: 1, Name: "global", Provider: entity.EmoteProvider7TV, EmoteCount: 12},
+	}, nil)
+
+	out, err := h.ListEmoteSets(context.Background())
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, gen.EmoteSetProvider7tv, out[0].Provider)
+	assert.Equal(t, 12, out[0].EmoteCount)
+}
+
+func TestHandler_SaveEmoteSet(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().SaveEmoteSet(gomock.Any(),
+		entity.EmoteSet{Name: "chan ffz", Provider: entity.EmoteProviderFFZ, Channel: "chan"},
+		[]entity.EmoteSetEmote{{Code: "OMEGALUL", EmoteID: "128054"}, {Code: "monkaS", EmoteID: "monkaS"}},
+	).Return(entity.EmoteSet{ID: 3, Name: "chan ffz", Provider: entity.EmoteProviderFFZ, Channel: "chan", EmoteCount: 2}, nil)
+
+	res, err := h.SaveEmoteSet(context.Background(), &gen.SaveEmoteSetRequest{
+		Name:     "chan ffz",
+		Provider: gen.EmoteSetProviderFfz,
+		Channel:  gen.NewOptString("chan"),
+		Emotes:   []gen.EmoteSetEmote{{Code: "OMEGALUL", ID: gen.NewOptString("128054")}, {Code: "monkaS"}},
+	})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.EmoteSet)
+	require.True(t, ok)
+	assert.Equal(t, int64(3), out.ID)
+
+	res, err = h.SaveEmoteSet(context.Background(), &gen.SaveEmoteSetRequest{
+		Name: "bad", Provider: gen.EmoteSetProviderCustom, Emotes: []gen.EmoteSetEmote{{Code: "two words"}},
+	})
+	require.NoError(t, err)
+	require.IsType(t, &gen.ErrorMessage{}, res)
+}
+
+func TestHandler_DeleteEmoteSet(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().DeleteEmoteSet(gomock.Any(), int64(3)).Return(nil)
+	repo.EXPECT().DeleteEmoteSet(gomock.Any(), int64(4)).Return(entity.ErrEmoteSetNotFound)
+
+	res, err := h.DeleteEmoteSet(context.Background(), &gen.DeleteByIDRequest{ID: 3})
+	require.NoError(t, err)
+	require.IsType(t, &gen.DeleteEmoteSetNoContent{}, res)
+
+	res, err = h.DeleteEmoteSet(context.Background(), &gen.DeleteByIDRequest{ID: 4})
+	require.NoError(t, err)
+	require.IsType(t, &gen.ErrorMessage{}, res)
+}
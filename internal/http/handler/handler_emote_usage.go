@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+	twitchuc "github.com/rofleksey/dredge/internal/usecase/twitch"
+)
+
+func (h *Handler) GetRecordedStreamEmotes(ctx context.Context, params gen.GetRecordedStreamEmotesParams) (gen.GetRecordedStreamEmotesRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_recorded_stream_emotes")
+	defer span.End()
+
+	st, err := h.twitch.GetMonitoredStream(ctx, params.StreamId)
+	if err != nil {
+		if errors.Is(err, entity.ErrStreamNotFound) {
+			return &gen.ErrorMessage{Message: "stream not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "get stream for emotes failed", err)
+		return nil, err
+	}
+
+	e, err := h.twitch.StreamEmotes(ctx, st, params.Limit.Or(10))
+	if err != nil {
+		h.obs.LogError(ctx, span, "stream emotes failed", err, zap.Int64("stream_id", st.ID))
+		return nil, err
+	}
+
+	timeline := make([]gen.EmoteTimelineBucket, 0, len(e.Timeline))
+	for _, b := range e.Timeline {
+		timeline = append(timeline, gen.EmoteTimelineBucket{Start: b.Start, Uses: b.Uses})
+	}
+
+	return &gen.StreamEmotes{
+		StreamID:      e.StreamID,
+		Top:           emoteUsageToGen(e.Top),
+		BucketSeconds: e.BucketSeconds,
+		Timeline:      timeline,
+	}, nil
+}
+
+func (h *Handler) GetChannelEmoteUsage(ctx context.Context, params gen.GetChannelEmoteUsageParams) (gen.GetChannelEmoteUsageRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_channel_emote_usage")
+	defer span.End()
+
+	list, err := h.twitch.ChannelEmoteUsage(ctx, params.Login, optTimePtr(params.From), optTimePtr(params.To), params.Limit.Or(0))
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidEmoteUsageQuery) {
+			return &gen.GetChannelEmoteUsageBadRequest{Message: err.Error()}, nil
+		}
+
+		if errors.Is(err, twitchuc.ErrChannelNotMonitored) {
+			return &gen.GetChannelEmoteUsageNotFound{Message: "channel is not monitored"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "channel emote usage failed", err, zap.String("channel", params.Login))
+		return nil, err
+	}
+
+	out := gen.GetChannelEmoteUsageOKApplicationJSON(emoteUsageToGen(list))
+
+	return &out, nil
+}
+
+func (h *Handler) GetTwitchUserEmoteUsage(ctx context.Context, params gen.GetTwitchUserEmoteUsageParams) (gen.GetTwitchUserEmoteUsageRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_twitch_user_emote_usage")
+	defer span.End()
+
+	list, err := h.twitch.UserEmoteUsage(ctx, params.TwitchUserID, optTimePtr(params.From), optTimePtr(params.To), params.Limit.Or(0))
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidEmoteUsageQuery) {
+			return &gen.GetTwitchUserEmoteUsageBadRequest{Message: err.Error()}, nil
+		}
+
+		if errors.Is(err, entity.ErrTwitchUserNotFound) {
+			return &gen.GetTwitchUserEmoteUsageNotFound{Message: "user not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "user emote usage failed", err, zap.Int64("user_id", params.TwitchUserID))
+		return nil, err
+	}
+
+	out := gen.GetTwitchUserEmoteUsageOKApplicationJSON(emoteUsageToGen(list))
+
+	return &out, nil
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_GetRecordedStreamEmotes_ok(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Minute)
+	top := []entity.EmoteUsage{{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Uses: 3, Messages: 2, Chatters: 1}}
+
+	repo.EXPECT().GetMonitoredStreamByID(gomock.Any(), int64(7)).Return(entity.Stream{ID: 7, StartedAt: start, EndedAt: &end}, nil)
+	repo.EXPECT().ListEmoteUsage(gomock.Any(), gomock.Any()).Return(top, nil)
+	repo.EXPECT().ListStreamEmoteCounts(gomock.Any(), int64(7), start, time.Minute, top).
+		Return([]entity.EmoteBucketCount{{Bucket: 1, Provider: entity.EmoteProviderTwitch, EmoteID: "25", Uses: 3}}, nil)
+
+	res, err := h.GetRecordedStreamEmotes(context.Background(), gen.GetRecordedStreamEmotesParams{StreamId: 7})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.StreamEmotes)
+	require.True(t, ok)
+	assert.Equal(t, 60, out.BucketSeconds)
+	require.Len(t, out.Top, 1)
+	assert.Equal(t, "Kappa", out.Top[0].Code)
+	require.Len(t, out.Timeline, 2)
+	assert.Equal(t, []int64{0}, out.Timeline[0].Uses)
+	assert.Equal(t, []int64{3}, out.Timeline[1].Uses)
+}
+
+func TestHandler_GetRecordedStreamEmotes_notFound(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().GetMonitoredStreamByID(gomock.Any(), int64(7)).Return(entity.Stream{}, pgx.ErrNoRows)
+
+	res, err := h.GetRecordedStreamEmotes(context.Background(), gen.GetRecordedStreamEmotesParams{StreamId: 7})
+	require.NoError(t, err)
+	require.IsType(t, &gen.ErrorMessage{}, res)
+}
+
+func TestHandler_GetChannelEmoteUsage(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "chan").Return(int64(9), true, nil)
+	repo.EXPECT().ListEmoteUsage(gomock.Any(), gomock.Any()).
+		Return([]entity.EmoteUsage{{Provider: entity.EmoteProviderBTTV, EmoteID: "b1", Code: "catJAM", Uses: 4}}, nil)
+
+	res, err := h.GetChannelEmoteUsage(context.Background(), gen.GetChannelEmoteUsageParams{Login: "chan"})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.GetChannelEmoteUsageOKApplicationJSON)
+	require.True(t, ok)
+	require.Len(t, *out, 1)
+	assert.Equal(t, "bttv", (*out)[0].Provider)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "other").Return(int64(0), false, nil)
+
+	res, err = h.GetChannelEmoteUsage(context.Background(), gen.GetChannelEmoteUsageParams{Login: "other"})
+	require.NoError(t, err)
+	require.IsType(t, &gen.GetChannelEmoteUsageNotFound{}, res)
+
+	now := time.Now()
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "chan").Return(int64(9), true, nil)
+
+	res, err = h.GetChannelEmoteUsage(context.Background(), gen.GetChannelEmoteUsageParams{
+		Login: "chan", From: gen.NewOptDateTime(now), To: gen.NewOptDateTime(now.Add(-time.Hour)),
+	})
+	require.NoError(t, err)
+	require.IsType(t, &gen.GetChannelEmoteUsageBadRequest{}, res)
+}
+
+func TestHandler_GetTwitchUserEmoteUsage(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(5)).Return(entity.TwitchUser{ID: 5}, nil)
+	repo.EXPECT().ListEmoteUsage(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	res, err := h.GetTwitchUserEmoteUsage(context.Background(), gen.GetTwitchUserEmoteUsageParams{TwitchUserID: 5})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.GetTwitchUserEmoteUsageOKApplicationJSON)
+	require.True(t, ok)
+	assert.Empty(t, *out)
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(6)).Return(entity.TwitchUser{}, entity.ErrTwitchUserNotFound)
+
+	res, err = h.GetTwitchUserEmoteUsage(context.Background(), gen.GetTwitchUserEmoteUsageParams{TwitchUserID: 6})
+	require.NoError(t, err)
+	require.IsType(t, &gen.GetTwitchUserEmoteUsageNotFound{}, res)
+}
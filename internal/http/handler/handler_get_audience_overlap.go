@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) GetAudienceOverlap(ctx context.Context, params gen.GetAudienceOverlapParams) (*gen.AudienceOverlap, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_audience_overlap")
+	defer span.End()
+
+	period := entity.AudienceOverlapPeriod(params.Period.Or(gen.AudienceOverlapPeriod7d))
+
+	o, err := h.twitch.GetAudienceOverlap(ctx, period, params.Refresh.Or(false))
+	if err != nil {
+		h.obs.LogError(ctx, span, "get audience overlap failed", err)
+		return nil, err
+	}
+
+	return audienceOverlapToGen(o), nil
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_GetAudienceOverlap(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().GetAudienceOverlapCache(gomock.Any(), entity.AudienceOverlapPeriodWeek).Return(&entity.AudienceOverlap{
+		Period:     entity.AudienceOverlapPeriodWeek,
+		ComputedAt: time.Now().UTC(),
+		Channels: []entity.AudienceChannel{
+			{ChannelTwitchUserID: 1, Login: "a", Audience: 4, Community: []entity.AudienceCommunityChannel{{ChannelTwitchUserID: 2, Login: "b", Monitored: true, ActiveUsers: 1}}},
+			{ChannelTwitchUserID: 2, Login: "b", Audience: 2},
+		},
+		Pairs: []entity.AudienceOverlapPair{{ChannelA: 1, ChannelB: 2, SharedUsers: 1, Jaccard: 0.2, Overlap: 0.5,
+			TopShared: []entity.AudienceSharedUser{{TwitchUserID: 9, Login: "u", MessagesA: 3}}}},
+	}, nil)
+
+	res, err := h.GetAudienceOverlap(context.Background(), gen.GetAudienceOverlapParams{})
+	require.NoError(t, err)
+
+	assert.Equal(t, gen.AudienceOverlapPeriod7d, res.Period)
+	require.Len(t, res.Channels, 2)
+	require.Len(t, res.Channels[0].Community, 1)
+	require.Len(t, res.Pairs, 1)
+	assert.Equal(t, "u", res.Pairs[0].TopShared[0].Login)
+	assert.Equal(t, [][]float64{{1, 0.2}, {0.2, 1}}, res.JaccardMatrix)
+	assert.Equal(t, [][]float64{{1, 0.5}, {0.5, 1}}, res.OverlapMatrix)
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+	twitchuc "github.com/rofleksey/dredge/internal/usecase/twitch"
+)
+
+func (h *Handler) GetChannelAnalytics(ctx context.Context, params gen.GetChannelAnalyticsParams) (gen.GetChannelAnalyticsRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_channel_analytics")
+	defer span.End()
+
+	q := entity.ChannelAnalyticsQuery{
+		Channel: params.Login,
+		Bucket:  entity.ChannelAnalyticsBucket(params.Bucket.Or(gen.ChannelAnalyticsBucketHour)),
+		From:    params.From.Or(time.Time{}),
+		To:      params.To.Or(time.Time{}),
+	}
+
+	a, err := h.twitch.GetChannelAnalytics(ctx, q)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidChannelAnalyticsQuery) {
+			return &gen.GetChannelAnalyticsBadRequest{Message: err.Error()}, nil
+		}
+
+		if errors.Is(err, twitchuc.ErrChannelNotMonitored) {
+			return &gen.GetChannelAnalyticsNotFound{Message: "channel is not monitored"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "get channel analytics failed", err, zap.String("channel", params.Login))
+		return nil, err
+	}
+
+	return channelAnalyticsToGen(a), nil
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_GetChannelAnalytics_ok(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+	next := int64(8)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "chan").Return(int64(9), true, nil)
+	repo.EXPECT().ListChannelActivityRollups(gomock.Any(), int64(9), entity.ChannelAnalyticsBucketHour, from, to).
+		Return([]entity.ChannelActivityBucket{{Start: from, MessageCount: 5, PresentChatters: 2, PresenceSeconds: 300}}, nil)
+	repo.EXPECT().ListStreamRetention(gomock.Any(), int64(9), from, to).Return([]entity.StreamRetention{
+		{StreamID: 7, StartedAt: from, NextStreamID: &next, Chatters: 4, ReturningChatters: 3},
+		{StreamID: 8, StartedAt: from.Add(time.Hour), Chatters: 2},
+	}, nil)
+	repo.EXPECT().GetChannelAnalyticsWatermark(gomock.Any()).Return(&to, nil)
+
+	res, err := h.GetChannelAnalytics(context.Background(), gen.GetChannelAnalyticsParams{
+		Login: "chan", From: gen.NewOptDateTime(from), To: gen.NewOptDateTime(to),
+	})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.ChannelAnalytics)
+	require.True(t, ok)
+	assert.Equal(t, gen.ChannelAnalyticsBucketHour, out.Bucket)
+	require.Len(t, out.Series, 2)
+	assert.Equal(t, int64(5), out.Series[0].Messages)
+	assert.InDelta(t, 150.0, out.Series[0].AveragePresenceSeconds, 1e-9)
+	require.Len(t, out.Retention, 2)
+	assert.InDelta(t, 0.75, out.Retention[0].Retention.Value, 1e-9)
+	assert.True(t, out.Retention[1].Retention.Null)
+	assert.True(t, out.Retention[1].NextStreamID.Null)
+}
+
+func TestHandler_GetChannelAnalytics_errors(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+
+	res, err := h.GetChannelAnalytics(context.Background(), gen.GetChannelAnalyticsParams{
+		Login: "chan", Bucket: gen.NewOptChannelAnalyticsBucket(gen.ChannelAnalyticsBucketMinute),
+		From: gen.NewOptDateTime(now.Add(-72 * time.Hour)),
+	})
+	require.NoError(t, err)
+
+	_, ok := res.(*gen.GetChannelAnalyticsBadRequest)
+	require.True(t, ok)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "x").Return(int64(0), false, nil)
+
+	res, err = h.GetChannelAnalytics(context.Background(), gen.GetChannelAnalyticsParams{Login: "x"})
+	require.NoError(t, err)
+
+	_, ok = res.(*gen.GetChannelAnalyticsNotFound)
+	require.True(t, ok)
+}
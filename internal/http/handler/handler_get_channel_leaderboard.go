@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+	twitchuc "github.com/rofleksey/dredge/internal/usecase/twitch"
+)
+
+func (h *Handler) GetChannelLeaderboard(ctx context.Context, params gen.GetChannelLeaderboardParams) (gen.GetChannelLeaderboardRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_channel_leaderboard")
+	defer span.End()
+
+	q := entity.ChannelLeaderboardQuery{
+		Channel:       params.Login,
+		From:          params.From.Or(time.Time{}),
+		To:            params.To.Or(time.Time{}),
+		Sort:          entity.StreamLeaderboardSort(params.Sort.Or("")),
+		Query:         params.Q.Or(""),
+		ExcludeMarked: params.ExcludeMarked.Or(false),
+		ExcludeLinked: params.ExcludeLinked.Or(false),
+		Limit:         params.Limit.Or(0),
+		Offset:        params.Offset.Or(0),
+	}
+
+	if v, ok := params.ExcludeBots.Get(); ok {
+		q.ExcludeBots = &v
+	}
+
+	lb, err := h.twitch.ChannelLeaderboard(ctx, q)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidChannelLeaderboardQuery) {
+			return &gen.GetChannelLeaderboardBadRequest{Message: err.Error()}, nil
+		}
+
+		if errors.Is(err, twitchuc.ErrChannelNotMonitored) {
+			return &gen.GetChannelLeaderboardNotFound{Message: "channel is not monitored"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "get channel leaderboard failed", err, zap.String("channel", params.Login))
+		return nil, err
+	}
+
+	return channelLeaderboardToGen(lb), nil
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_GetChannelLeaderboard_ok(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	to := from.Add(48 * time.Hour)
+	created := from.AddDate(-3, 0, 0)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "chan").Return(int64(9), true, nil)
+	repo.EXPECT().ListChannelLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, channelID *int64, q entity.ChannelLeaderboardQuery) ([]entity.ChannelLeaderboardRow, int64, error) {
+			assert.Equal(t, int64(9), *channelID)
+			assert.Equal(t, entity.StreamLeaderboardSortAccountOld, q.Sort)
+			assert.True(t, *q.ExcludeBots)
+			assert.True(t, q.ExcludeMarked)
+			assert.Equal(t, 10, q.Limit)
+			assert.Equal(t, 20, q.Offset)
+
+			return []entity.ChannelLeaderboardRow{
+				{Login: "alice", UserTwitchID: 5, PresenceSeconds: 600, MessageCount: 3, Channels: 1, AccountCreatedAt: &created},
+				{Login: "bob", UserTwitchID: 6, MessageCount: 1, Channels: 1},
+			}, 22, nil
+		})
+	repo.EXPECT().GetChannelAnalyticsWatermark(gomock.Any()).Return(nil, nil)
+
+	res, err := h.GetChannelLeaderboard(context.Background(), gen.GetChannelLeaderboardParams{
+		Login: "chan", From: gen.NewOptDateTime(from), To: gen.NewOptDateTime(to),
+		Sort:          gen.NewOptStreamLeaderboardSort(gen.StreamLeaderboardSortAccountOld),
+		ExcludeBots:   gen.NewOptBool(true),
+		ExcludeMarked: gen.NewOptBool(true),
+		Limit:         gen.NewOptInt(10),
+		Offset:        gen.NewOptInt(20),
+	})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.ChannelLeaderboard)
+	require.True(t, ok)
+	assert.Equal(t, "chan", out.ChannelLogin.Value)
+	assert.Equal(t, from, out.From)
+	assert.Equal(t, to, out.To)
+	assert.Equal(t, int64(22), out.Total)
+	assert.True(t, out.RolledUpUntil.Null)
+	require.Len(t, out.Entries, 2)
+	assert.Equal(t, created, out.Entries[0].AccountCreatedAt.Value)
+	assert.True(t, out.Entries[1].AccountCreatedAt.Null)
+}
+
+func TestHandler_GetChannelLeaderboard_errors(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	res, err := h.GetChannelLeaderboard(context.Background(), gen.GetChannelLeaderboardParams{
+		Login: "chan", Limit: gen.NewOptInt(entity.MaxChannelLeaderboardLimit + 1),
+	})
+	require.NoError(t, err)
+
+	_, ok := res.(*gen.GetChannelLeaderboardBadRequest)
+	require.True(t, ok)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "x").Return(int64(0), false, nil)
+
+	res, err = h.GetChannelLeaderboard(context.Background(), gen.GetChannelLeaderboardParams{Login: "x"})
+	require.NoError(t, err)
+
+	_, ok = res.(*gen.GetChannelLeaderboardNotFound)
+	require.True(t, ok)
+}
+
+func TestHandler_GetMonitoredLeaderboard(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(entity.BotDetectionSettings{}, nil)
+	repo.EXPECT().ListChannelLeaderboard(gomock.Any(), nil, gomock.Any()).
+		Return([]entity.ChannelLeaderboardRow{{Login: "alice", UserTwitchID: 5, MessageCount: 9, Channels: 3}}, int64(1), nil)
+	repo.EXPECT().GetChannelAnalyticsWatermark(gomock.Any()).Return(nil, nil)
+
+	res, err := h.GetMonitoredLeaderboard(context.Background(), gen.GetMonitoredLeaderboardParams{Q: gen.NewOptString("ali")})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.ChannelLeaderboard)
+	require.True(t, ok)
+	assert.False(t, out.ChannelLogin.Set)
+	require.Len(t, out.Entries, 1)
+	assert.Equal(t, 3, out.Entries[0].Channels)
+
+	res, err = h.GetMonitoredLeaderboard(context.Background(), gen.GetMonitoredLeaderboardParams{Offset: gen.NewOptInt(-1)})
+	require.NoError(t, err)
+
+	_, ok = res.(*gen.ErrorMessage)
+	require.True(t, ok)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) GetMonitoredLeaderboard(ctx context.Context, params gen.GetMonitoredLeaderboardParams) (gen.GetMonitoredLeaderboardRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_monitored_leaderboard")
+	defer span.End()
+
+	q := entity.ChannelLeaderboardQuery{
+		From:          params.From.Or(time.Time{}),
+		To:            params.To.Or(time.Time{}),
+		Sort:          entity.StreamLeaderboardSort(params.Sort.Or("")),
+		Query:         params.Q.Or(""),
+		ExcludeMarked: params.ExcludeMarked.Or(false),
+		ExcludeLinked: params.ExcludeLinked.Or(false),
+		Limit:         params.Limit.Or(0),
+		Offset:        params.Offset.Or(0),
+	}
+
+	if v, ok := params.ExcludeBots.Get(); ok {
+		q.ExcludeBots = &v
+	}
+
+	lb, err := h.twitch.ChannelLeaderboard(ctx, q)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidChannelLeaderboardQuery) {
+			return &gen.ErrorMessage{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "get monitored leaderboard failed", err)
+		return nil, err
+	}
+
+	return channelLeaderboardToGen(lb), nil
+}
@@ -24,5 +24,45 @@ func (h *Handler) GetRecordedStream(ctx context.Context, params gen.GetRecordedS
 
 	g := streamEntityToGen(st)
 
+	recap, err := h.twitch.StreamRecap(ctx, st.ID)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get stream recap failed", err)
+		return nil, err
+	}
+
+	if recap != nil {
+		g.Recap.SetTo(streamRecapToGen(*recap))
+	}
+
+	counts, err := h.twitch.StreamChatterCounts(ctx, st.ID)
+	if err != nil {
+		h.obs.LogError(ctx, span, "count stream chatters failed", err)
+		return nil, err
+	}
+
+	g.NewChatters.SetTo(counts.NewChatters)
+	g.ReturningChatters.SetTo(counts.ReturningChatters)
+
+	toxicity, err := h.twitch.StreamChatToxicity(ctx, st.ID)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get stream chat toxicity failed", err)
+		return nil, err
+	}
+
+	if toxicity.ClassifiedMessages > 0 {
+		g.ChatToxicity.SetTo(chatToxicityToGen(toxicity))
+	}
+
+	moments, err := h.twitch.StreamChatMoments(ctx, st.ID)
+	if err != nil {
+		h.obs.LogError(ctx, span, "list stream chat moments failed", err)
+		return nil, err
+	}
+
+	g.Moments = make([]gen.ChatMoment, 0, len(moments))
+	for _, m := range moments {
+		g.Moments = append(g.Moments, chatMomentToGen(m))
+	}
+
 	return &g, nil
 }
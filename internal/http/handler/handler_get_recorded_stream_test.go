@@ -1,6 +1,17 @@
 package handler
 
-import "testing"
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
 
 func TestHandler_GetRecordedStream_smoke(t *testing.T) {
 	t.Parallel()
@@ -8,3 +19,84 @@ func TestHandler_GetRecordedStream_smoke(t *testing.T) {
 	defer ctrl.Finish()
 	_ = h
 }
+
+func TestHandler_GetRecordedStream_withRecap(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	ruleID := int64(3)
+
+	repo.EXPECT().GetMonitoredStreamByID(gomock.Any(), int64(7)).Return(entity.Stream{ID: 7, StartedAt: start, EndedAt: &end}, nil)
+	repo.EXPECT().GetStreamRecap(gomock.Any(), int64(7)).Return(&entity.StreamRecap{
+		StreamID:        7,
+		DurationSeconds: 3600,
+		PeakViewers:     entity.ToPointer(int64(50)),
+		Messages:        10,
+		TopChatters:     []entity.StreamRecapChatter{{UserTwitchID: 5, Login: "alice", MessageCount: 6}},
+		RuleTriggers:    []entity.StreamRecapRule{{RuleID: &ruleID, RuleName: "links", Triggers: 2}},
+		Summary:         "quiet stream",
+	}, nil)
+	repo.EXPECT().CountStreamChatters(gomock.Any(), int64(7)).Return(entity.StreamChatterCounts{NewChatters: 2, ReturningChatters: 5}, nil)
+	repo.EXPECT().GetStreamChatToxicity(gomock.Any(), int64(7)).Return(entity.ChatToxicity{
+		ClassifiedMessages: 4, ToxicMessages: 1, AvgToxicity: 0.3, AvgSentiment: 0.2,
+	}, nil)
+	repo.EXPECT().ListStreamChatMoments(gomock.Any(), int64(7)).Return(nil, nil)
+
+	res, err := h.GetRecordedStream(context.Background(), gen.GetRecordedStreamParams{StreamId: 7})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.RecordedStream)
+	require.True(t, ok)
+
+	recap, ok := out.Recap.Get()
+	require.True(t, ok)
+	assert.Equal(t, int64(3600), recap.DurationSeconds)
+	assert.Equal(t, gen.NewOptInt64(50), recap.PeakViewers)
+	assert.False(t, recap.AverageViewers.IsSet())
+	assert.Equal(t, "alice", recap.TopChatters[0].Login)
+	assert.Equal(t, gen.NewOptInt64(3), recap.RuleTriggers[0].RuleID)
+	assert.Equal(t, gen.NewOptString("quiet stream"), recap.Summary)
+	assert.NotNil(t, recap.SuspiciousUsers)
+	assert.NotNil(t, out.Moments)
+	assert.Empty(t, out.Moments)
+	assert.Equal(t, gen.NewOptInt64(2), out.NewChatters)
+	assert.Equal(t, gen.NewOptInt64(5), out.ReturningChatters)
+
+	toxicity, ok := out.ChatToxicity.Get()
+	require.True(t, ok)
+	assert.Equal(t, int64(1), toxicity.ToxicMessages)
+	assert.InDelta(t, 0.3, toxicity.AvgToxicity, 1e-9)
+}
+
+func TestHandler_GetRecordedStream_live(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	start := time.Now().Add(-time.Hour)
+
+	repo.EXPECT().GetMonitoredStreamByID(gomock.Any(), int64(7)).Return(entity.Stream{ID: 7, StartedAt: start}, nil)
+	repo.EXPECT().GetStreamRecap(gomock.Any(), int64(7)).Return(nil, nil)
+	repo.EXPECT().CountStreamChatters(gomock.Any(), int64(7)).Return(entity.StreamChatterCounts{}, nil)
+	repo.EXPECT().GetStreamChatToxicity(gomock.Any(), int64(7)).Return(entity.ChatToxicity{}, nil)
+	repo.EXPECT().ListStreamChatMoments(gomock.Any(), int64(7)).Return([]entity.ChatMoment{{
+		ID: 2, StreamID: 7, Kind: entity.ChatMomentKindEmoteFlood, Label: "KEKW",
+		StartedAt: start.Add(10 * time.Minute), EndedAt: start.Add(11 * time.Minute), OffsetSeconds: 600,
+		Messages: 80, Chatters: 30, Intensity: 4.2,
+		SampleMessages: []entity.ChatMomentMessage{{Login: "bob", Body: "KEKW KEKW", CreatedAt: start.Add(10 * time.Minute)}},
+	}}, nil)
+
+	res, err := h.GetRecordedStream(context.Background(), gen.GetRecordedStreamParams{StreamId: 7})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.RecordedStream)
+	require.True(t, ok)
+	assert.False(t, out.Recap.IsSet())
+	assert.False(t, out.ChatToxicity.IsSet())
+	require.Len(t, out.Moments, 1)
+	assert.Equal(t, gen.ChatMomentKindEmoteFlood, out.Moments[0].Kind)
+	assert.Equal(t, "KEKW", out.Moments[0].Label)
+	assert.Equal(t, int64(600), out.Moments[0].OffsetSeconds)
+	assert.Equal(t, []gen.ChatMomentMessage{{Login: "bob", Body: "KEKW KEKW", CreatedAt: start.Add(10 * time.Minute)}}, out.Moments[0].SampleMessages)
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/rofleksey/dredge/internal/http/gen"
 )
 
+// profileSuspicionHistoryLimit caps the suspicion changes embedded in a profile; older ones are in the feed.
+const profileSuspicionHistoryLimit = 20
+
 func (h *Handler) GetTwitchUserProfile(ctx context.Context, req *gen.GetTwitchUserProfileRequest) (gen.GetTwitchUserProfileRes, error) {
 	ctx, span := h.obs.StartSpan(ctx, "handler.get_twitch_user_profile")
 	defer span.End()
@@ -109,5 +112,68 @@ func (h *Handler) GetTwitchUserProfile(ctx context.Context, req *gen.GetTwitchUs
 
 	prof.SetFollowedChannels(fcFull)
 
+	score, err := h.twitch.GetSuspicionScore(ctx, u.ID)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get suspicion score failed", err, zap.Int64("id", u.ID))
+		return nil, err
+	}
+
+	if score != nil {
+		prof.SetSuspicionScore(gen.NewOptNilSuspicionScore(suspicionScoreToGen(*score)))
+	} else {
+		var z gen.OptNilSuspicionScore
+		z.SetToNull()
+		prof.SetSuspicionScore(z)
+	}
+
+	history, err := h.twitch.ListSuspicionEvents(ctx, entity.SuspicionEventListFilter{TwitchUserID: &u.ID, Limit: profileSuspicionHistoryLimit})
+	if err != nil {
+		h.obs.LogError(ctx, span, "list suspicion events failed", err, zap.Int64("id", u.ID))
+		return nil, err
+	}
+
+	historyGen := make([]gen.SuspicionEvent, 0, len(history))
+	for _, e := range history {
+		historyGen = append(historyGen, suspicionEventToGen(e))
+	}
+
+	prof.SetSuspicionHistory(historyGen)
+
+	alts, err := h.twitch.ListAltCandidates(ctx, u.ID)
+	if err != nil {
+		h.obs.LogError(ctx, span, "list alt candidates failed", err, zap.Int64("id", u.ID))
+		return nil, err
+	}
+
+	altsGen := make([]gen.AltCandidate, 0, len(alts))
+	for _, c := range alts {
+		altsGen = append(altsGen, altCandidateToGen(c))
+	}
+
+	prof.SetPossibleAlts(altsGen)
+
+	links, err := h.twitch.ListUserLinks(ctx, u.ID)
+	if err != nil {
+		h.obs.LogError(ctx, span, "list user links failed", err, zap.Int64("id", u.ID))
+		return nil, err
+	}
+
+	linksGen := make([]gen.UserLink, 0, len(links))
+	for _, l := range links {
+		linksGen = append(linksGen, userLinkToGen(l))
+	}
+
+	prof.SetLinkedUsers(linksGen)
+
+	toxicity, err := h.twitch.ChatterChatToxicity(ctx, u.ID)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get chatter chat toxicity failed", err, zap.Int64("id", u.ID))
+		return nil, err
+	}
+
+	if toxicity.ClassifiedMessages > 0 {
+		prof.SetChatToxicity(gen.NewOptChatToxicity(chatToxicityToGen(toxicity)))
+	}
+
 	return &prof, nil
 }
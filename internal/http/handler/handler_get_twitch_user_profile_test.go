@@ -25,10 +25,68 @@ func TestHandler_GetTwitchUserProfile(t *testing.T) {
 	repo.EXPECT().ListFollowedMonitoredChannels(gomock.Any(), int64(9)).Return(nil, nil)
 	repo.EXPECT().ListUserFollowedChannels(gomock.Any(), int64(9)).Return(nil, nil)
 	repo.EXPECT().ListChannelBlacklist(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().GetSuspicionScore(gomock.Any(), int64(9)).Return(&entity.SuspicionScore{
+		TwitchUserID: 9,
+		Score:        65,
+		Threshold:    50,
+		ComputedAt:   now,
+		Signals: []entity.SuspicionSignal{
+			{Key: entity.SuspicionSignalAccountAge, Score: 50, Weight: 50, Detail: "Account created 0 days ago (window 14 days)"},
+			{Key: entity.SuspicionSignalNamePattern, Score: 15, Weight: 15, Detail: "Login matches pattern"},
+		},
+	}, nil)
+
+	repo.EXPECT().ListSuspicionEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f entity.SuspicionEventListFilter) ([]entity.SuspicionEvent, error) {
+			require.Equal(t, int64(9), *f.TwitchUserID)
+
+			return []entity.SuspicionEvent{{ID: 3, TwitchUserID: 9, Username: "u", Source: entity.SuspicionSourceManual, NewIsSus: true, CreatedAt: now}}, nil
+		})
+
+	repo.EXPECT().ListAltCandidates(gomock.Any(), int64(9), 10).Return([]entity.AltCandidate{{
+		TwitchUserID:      9,
+		CandidateUserID:   12,
+		CandidateUsername: "u2",
+		Score:             55,
+		Signals:           []entity.AltSignal{{Key: entity.AltSignalLoginSimilarity, Score: 20, Weight: 20, Detail: "Logins share the stem \"u\""}},
+		ComputedAt:        now,
+	}}, nil)
+
+	linkScore := 61
+	repo.EXPECT().ListUserLinks(gomock.Any(), int64(9)).Return([]entity.UserLink{{
+		TwitchUserID:   9,
+		LinkedUserID:   14,
+		LinkedUsername: "u_alt",
+		Status:         entity.UserLinkConfirmed,
+		Score:          &linkScore,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}}, nil)
+	repo.EXPECT().GetChatterChatToxicity(gomock.Any(), int64(9)).Return(entity.ChatToxicity{ClassifiedMessages: 12, ToxicMessages: 3, AvgToxicity: 0.4}, nil)
 
 	res, err := h.GetTwitchUserProfile(context.Background(), &gen.GetTwitchUserProfileRequest{ID: 9})
 	require.NoError(t, err)
 
-	_, ok := res.(*gen.TwitchUserProfile)
+	prof, ok := res.(*gen.TwitchUserProfile)
+	require.True(t, ok)
+
+	score, ok := prof.SuspicionScore.Get()
+	require.True(t, ok)
+	require.Equal(t, 65, score.Score)
+	require.Len(t, score.Signals, 2)
+	require.Equal(t, gen.SuspicionSignalKeyNamePattern, score.Signals[1].Key)
+	require.Len(t, prof.SuspicionHistory, 1)
+	require.Equal(t, gen.SuspicionEventSourceManual, prof.SuspicionHistory[0].Source)
+	require.Len(t, prof.PossibleAlts, 1)
+	require.Equal(t, int64(12), prof.PossibleAlts[0].TwitchUserID)
+	require.Equal(t, gen.AltSignalKeyLoginSimilarity, prof.PossibleAlts[0].Signals[0].Key)
+	require.Len(t, prof.LinkedUsers, 1)
+	require.Equal(t, gen.UserLinkStatusConfirmed, prof.LinkedUsers[0].Status)
+	require.Equal(t, 61, prof.LinkedUsers[0].Score.Or(0))
+	require.False(t, prof.LinkedUsers[0].DecidedByUserID.IsSet())
+
+	toxicity, ok := prof.ChatToxicity.Get()
 	require.True(t, ok)
+	require.Equal(t, int64(12), toxicity.ClassifiedMessages)
+	require.Equal(t, int64(3), toxicity.ToxicMessages)
 }
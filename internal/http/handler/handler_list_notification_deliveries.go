@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) ListNotificationDeliveries(ctx context.Context, params gen.ListNotificationDeliveriesParams) ([]gen.NotificationDelivery, error) {
+	f := entity.NotificationDeliveryListFilter{}
+	if v, ok := params.NotificationID.Get(); ok {
+		f.NotificationEntryID = &v
+	}
+
+	if v, ok := params.Status.Get(); ok {
+		f.Status = string(v)
+	}
+
+	if v, ok := params.Limit.Get(); ok {
+		f.Limit = v
+	}
+
+	if v, ok := params.CursorCreatedAt.Get(); ok {
+		f.CursorCreatedAt = &v
+	}
+
+	if v, ok := params.CursorID.Get(); ok {
+		f.CursorID = &v
+	}
+
+	list, err := h.sett.ListNotificationDeliveries(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]gen.NotificationDelivery, 0, len(list))
+
+	for _, d := range list {
+		out = append(out, notificationDeliveryToGen(d))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_ListNotificationDeliveries(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	nid := int64(4)
+	code := 429
+	repo.EXPECT().ListNotificationDeliveries(gomock.Any(), entity.NotificationDeliveryListFilter{
+		NotificationEntryID: &nid,
+		Status:              entity.NotificationDeliveryPending,
+		Limit:               10,
+	}).Return([]entity.NotificationDelivery{
+		{
+			ID:                  1,
+			NotificationEntryID: nid,
+			Event:               entity.NotificationEvent{Type: "keyword_match", Channel: "chan"},
+			Status:              entity.NotificationDeliveryPending,
+			Attempts:            1,
+			NextAttemptAt:       time.Now(),
+			LastStatusCode:      &code,
+			AttemptLog: []entity.NotificationDeliveryAttempt{
+				{ID: 9, DeliveryID: 1, StatusCode: &code, ResponseSnippet: `{"ok":false}`},
+			},
+		},
+	}, nil)
+
+	out, err := h.ListNotificationDeliveries(adminCtx(), gen.ListNotificationDeliveriesParams{
+		NotificationID: gen.NewOptInt64(nid),
+		Status:         gen.NewOptNotificationDeliveryStatus(gen.NotificationDeliveryStatusPending),
+		Limit:          gen.NewOptInt(10),
+	})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	require.Equal(t, 429, out[0].LastStatusCode.Value)
+	require.Len(t, out[0].AttemptLog, 1)
+	require.Equal(t, `{"ok":false}`, out[0].AttemptLog[0].ResponseSnippet)
+}
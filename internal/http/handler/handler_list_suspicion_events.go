@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) ListSuspicionEvents(ctx context.Context, params gen.ListSuspicionEventsParams) ([]gen.SuspicionEvent, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.list_suspicion_events")
+	defer span.End()
+
+	f := entity.SuspicionEventListFilter{}
+
+	if v, ok := params.TwitchUserID.Get(); ok {
+		f.TwitchUserID = &v
+	}
+
+	if v, ok := params.Source.Get(); ok {
+		f.Source = string(v)
+	}
+
+	if v, ok := params.NewIsSus.Get(); ok {
+		f.NewIsSus = &v
+	}
+
+	if v, ok := params.Limit.Get(); ok {
+		f.Limit = v
+	}
+
+	if v, ok := params.CursorCreatedAt.Get(); ok {
+		f.CursorCreatedAt = &v
+	}
+
+	if v, ok := params.CursorID.Get(); ok {
+		f.CursorID = &v
+	}
+
+	list, err := h.twitch.ListSuspicionEvents(ctx, f)
+	if err != nil {
+		h.obs.LogError(ctx, span, "list suspicion events failed", err)
+		return nil, err
+	}
+
+	out := make([]gen.SuspicionEvent, 0, len(list))
+
+	for _, e := range list {
+		out = append(out, suspicionEventToGen(e))
+	}
+
+	return out, nil
+}
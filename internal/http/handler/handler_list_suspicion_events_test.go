@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_ListSuspicionEvents(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	uid := int64(9)
+	sus := true
+	newType := entity.SusTypeAutoScore
+
+	repo.EXPECT().ListSuspicionEvents(gomock.Any(), entity.SuspicionEventListFilter{
+		TwitchUserID: &uid,
+		Source:       entity.SuspicionSourceAuto,
+		NewIsSus:     &sus,
+		Limit:        10,
+	}).Return([]entity.SuspicionEvent{
+		{
+			ID:           1,
+			TwitchUserID: 9,
+			Username:     "viewer",
+			Source:       entity.SuspicionSourceAuto,
+			NewIsSus:     true,
+			NewSusType:   &newType,
+			Evidence:     map[string]any{"score": 70, "threshold": 50},
+			CreatedAt:    now,
+		},
+	}, nil)
+
+	out, err := h.ListSuspicionEvents(context.Background(), gen.ListSuspicionEventsParams{
+		TwitchUserID: gen.NewOptInt64(9),
+		Source:       gen.NewOptSuspicionEventSource(gen.SuspicionEventSourceAuto),
+		NewIsSus:     gen.NewOptBool(true),
+		Limit:        gen.NewOptInt(10),
+	})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+
+	e := out[0]
+	assert.Equal(t, "viewer", e.Username)
+	assert.Equal(t, gen.SuspicionEventSourceAuto, e.Source)
+	assert.False(t, e.OldIsSus)
+	assert.True(t, e.NewIsSus)
+	assert.Equal(t, entity.SusTypeAutoScore, e.NewSusType.Or(""))
+	assert.True(t, e.OldSusType.IsNull())
+
+	ev, ok := e.Evidence.Get()
+	require.True(t, ok)
+	assert.JSONEq(t, "70", string(ev["score"]))
+}
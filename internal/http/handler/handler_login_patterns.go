@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) ListLoginPatterns(ctx context.Context) ([]gen.LoginPattern, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.list_login_patterns")
+	defer span.End()
+
+	list, err := h.sett.ListLoginPatterns(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "list login patterns failed", err)
+		return nil, err
+	}
+
+	out := make([]gen.LoginPattern, 0, len(list))
+	for _, p := range list {
+		out = append(out, loginPatternEntityToGen(p))
+	}
+
+	return out, nil
+}
+
+func (h *Handler) CreateLoginPattern(ctx context.Context, req *gen.CreateLoginPatternRequest) (gen.CreateLoginPatternRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.create_login_pattern")
+	defer span.End()
+
+	out, err := h.sett.CreateLoginPattern(ctx, entity.LoginPattern{
+		Kind:        string(req.Kind),
+		Pattern:     req.Pattern,
+		Description: req.Description.Or(""),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidLoginPattern) {
+			return &gen.ErrorMessage{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "create login pattern failed", err)
+		return nil, err
+	}
+
+	p := loginPatternEntityToGen(out)
+
+	return &p, nil
+}
+
+func (h *Handler) DeleteLoginPattern(ctx context.Context, req *gen.DeleteByIDRequest) (gen.DeleteLoginPatternRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.delete_login_pattern")
+	defer span.End()
+
+	if err := h.sett.DeleteLoginPattern(ctx, req.ID); err != nil {
+		if errors.Is(err, entity.ErrLoginPatternNotFound) {
+			return &gen.ErrorMessage{Message: "login pattern not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "delete login pattern failed", err, zap.Int64("id", req.ID))
+		return nil, err
+	}
+
+	return &gen.DeleteLoginPatternNoContent{}, nil
+}
+
+func (h *Handler) TestLoginPattern(_ context.Context, req *gen.TestLoginPatternRequest) (*gen.TestLoginPatternResponse, error) {
+	var ce gen.OptNilString
+	ce.SetToNull()
+
+	var np gen.OptNilString
+	np.SetToNull()
+
+	m, err := entity.CompileLoginPattern(string(req.Kind), req.Pattern)
+	if err != nil {
+		ce.SetTo(err.Error())
+	} else if req.Kind == gen.LoginPatternKindGlob {
+		np.SetTo(entity.GlobSkeleton(req.Pattern))
+	}
+
+	results := make([]gen.LoginPatternTestResult, 0, len(req.Logins))
+	for _, login := range req.Logins {
+		results = append(results, gen.LoginPatternTestResult{
+			Login:        login,
+			Skeleton:     entity.LoginSkeleton(login),
+			GlobSkeleton: entity.GlobSkeleton(login),
+			Matches:      err == nil && m.Match(login),
+		})
+	}
+
+	return &gen.TestLoginPatternResponse{CompileError: ce, NormalizedPattern: np, Results: results}, nil
+}
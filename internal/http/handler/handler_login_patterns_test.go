@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_CreateLoginPattern(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().CreateLoginPattern(gomock.Any(), entity.LoginPattern{Kind: "glob", Pattern: "*hate*"}).
+		Return(entity.LoginPattern{ID: 2, Kind: "glob", Pattern: "*hate*"}, nil)
+
+	res, err := h.CreateLoginPattern(context.Background(), &gen.CreateLoginPatternRequest{
+		Kind: gen.LoginPatternKindGlob, Pattern: "*hate*",
+	})
+	require.NoError(t, err)
+
+	p, ok := res.(*gen.LoginPattern)
+	require.True(t, ok)
+	assert.Equal(t, int64(2), p.ID)
+	assert.Equal(t, gen.LoginPatternKindGlob, p.Kind)
+}
+
+func TestHandler_CreateLoginPattern_invalid(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, _ := testHandler(t)
+	defer ctrl.Finish()
+
+	res, err := h.CreateLoginPattern(context.Background(), &gen.CreateLoginPatternRequest{
+		Kind: gen.LoginPatternKindRegex, Pattern: "(",
+	})
+	require.NoError(t, err)
+
+	em, ok := res.(*gen.ErrorMessage)
+	require.True(t, ok)
+	assert.Contains(t, em.Message, "invalid login pattern")
+}
+
+func TestHandler_DeleteLoginPattern_notFound(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().DeleteLoginPattern(gomock.Any(), int64(5)).Return(entity.ErrLoginPatternNotFound)
+
+	res, err := h.DeleteLoginPattern(context.Background(), &gen.DeleteByIDRequest{ID: 5})
+	require.NoError(t, err)
+
+	_, ok := res.(*gen.ErrorMessage)
+	assert.True(t, ok)
+}
+
+func TestHandler_TestLoginPattern(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, _ := testHandler(t)
+	defer ctrl.Finish()
+
+	res, err := h.TestLoginPattern(context.Background(), &gen.TestLoginPatternRequest{
+		Kind:    gen.LoginPatternKindGlob,
+		Pattern: "*streamer*",
+		Logins:  []string{"the_5tr3amer", "viewer"},
+	})
+	require.NoError(t, err)
+	assert.True(t, res.CompileError.IsNull())
+	assert.Equal(t, "*streamer*", res.NormalizedPattern.Or(""))
+	require.Len(t, res.Results, 2)
+	assert.Equal(t, gen.LoginPatternTestResult{Login: "the_5tr3amer", Skeleton: "thestreamer", GlobSkeleton: "the5tr3amer", Matches: true}, res.Results[0])
+	assert.False(t, res.Results[1].Matches)
+}
+
+func TestHandler_TestLoginPattern_compileError(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, _ := testHandler(t)
+	defer ctrl.Finish()
+
+	res, err := h.TestLoginPattern(context.Background(), &gen.TestLoginPatternRequest{
+		Kind: gen.LoginPatternKindRegex, Pattern: "(", Logins: []string{"x"},
+	})
+	require.NoError(t, err)
+
+	msg, ok := res.CompileError.Get()
+	require.True(t, ok)
+	assert.NotEmpty(t, msg)
+	assert.True(t, res.NormalizedPattern.IsNull())
+	assert.False(t, res.Results[0].Matches)
+}
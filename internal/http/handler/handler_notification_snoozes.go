@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) ListNotificationSnoozes(ctx context.Context) ([]gen.NotificationSnooze, error) {
+	snoozes, err := h.sett.ListNotificationSnoozes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]gen.NotificationSnooze, 0, len(snoozes))
+	for _, s := range snoozes {
+		out = append(out, notificationSnoozeToGen(s))
+	}
+
+	return out, nil
+}
+
+func (h *Handler) CreateNotificationSnooze(ctx context.Context, req *gen.CreateNotificationSnoozeRequest) (gen.CreateNotificationSnoozeRes, error) {
+	var until time.Time
+
+	switch {
+	case req.Until.IsSet() && req.Minutes.IsSet():
+		return nil, fmt.Errorf("set either until or minutes: %w", entity.ErrInvalidNotification)
+	case req.Until.IsSet():
+		until = req.Until.Value
+	case req.Minutes.IsSet():
+		until = time.Now().Add(time.Duration(req.Minutes.Value) * time.Minute)
+	default:
+		return nil, fmt.Errorf("until or minutes is required: %w", entity.ErrInvalidNotification)
+	}
+
+	var entryID *int64
+
+	if req.NotificationID.IsSet() {
+		id := req.NotificationID.Value
+		entryID = &id
+	}
+
+	s, err := h.sett.SnoozeNotifications(ctx, entryID, req.Channel.Or(""), until)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotificationNotFound) {
+			return &gen.ErrorMessage{Message: "notification not found"}, nil
+		}
+
+		return nil, err
+	}
+
+	out := notificationSnoozeToGen(s)
+
+	return &out, nil
+}
+
+func (h *Handler) DeleteNotificationSnooze(ctx context.Context, req *gen.DeleteByIDRequest) (gen.DeleteNotificationSnoozeRes, error) {
+	if err := h.sett.DeleteNotificationSnooze(ctx, req.ID); err != nil {
+		if errors.Is(err, entity.ErrNotificationSnoozeNotFound) {
+			return &gen.ErrorMessage{Message: "notification snooze not found"}, nil
+		}
+
+		return nil, err
+	}
+
+	return &gen.DeleteNotificationSnoozeNoContent{}, nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/http/gen"
+	"github.com/rofleksey/dredge/internal/usecase/rules"
+)
+
+func (h *Handler) PreviewRuleNotify(ctx context.Context, req *gen.PreviewRuleNotifyRequest) (gen.PreviewRuleNotifyRes, error) {
+	_ = ctx
+
+	p := rules.SamplePayload(string(req.EventType), rules.EvalPayload{
+		Channel:  req.Channel.Or(""),
+		Username: req.Username.Or(""),
+		Text:     req.Text.Or(""),
+		Title:    req.Title.Or(""),
+	})
+
+	text, err := rules.PreviewNotifyText(req.RuleID.Or(0), rawSettingsToMap(req.ActionSettings), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gen.PreviewRuleNotifyResponse{Text: text}, nil
+}
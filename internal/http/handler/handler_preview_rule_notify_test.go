@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_PreviewRuleNotify(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, _ := testHandler(t)
+	defer ctrl.Finish()
+
+	req := &gen.PreviewRuleNotifyRequest{
+		EventType:      gen.RuleEventTypeChatMessage,
+		ActionSettings: gen.PreviewRuleNotifyRequestActionSettings{"text": jx.Raw(`"$USERNAME said $TEXT in #$CHANNEL"`)},
+		Channel:        gen.NewOptString("SomeChannel"),
+		Text:           gen.NewOptString("hi"),
+	}
+
+	res, err := h.PreviewRuleNotify(context.Background(), req)
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.PreviewRuleNotifyResponse)
+	require.True(t, ok)
+	assert.Equal(t, "example_user said hi in #somechannel", out.Text)
+}
+
+func TestHandler_PreviewRuleNotify_badText(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, _ := testHandler(t)
+	defer ctrl.Finish()
+
+	_, err := h.PreviewRuleNotify(context.Background(), &gen.PreviewRuleNotifyRequest{
+		EventType:      gen.RuleEventTypeInterval,
+		ActionSettings: gen.PreviewRuleNotifyRequestActionSettings{"text": jx.Raw(`42`)},
+	})
+	require.ErrorIs(t, err, entity.ErrInvalidRule)
+}
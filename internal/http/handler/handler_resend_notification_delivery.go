@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) ResendNotificationDelivery(ctx context.Context, req *gen.ResendNotificationDeliveryRequest) (gen.ResendNotificationDeliveryRes, error) {
+	d, err := h.sett.ResendNotificationDelivery(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotificationDeliveryNotFound) {
+			return &gen.ErrorMessage{Message: "notification delivery not found"}, nil
+		}
+
+		return nil, err
+	}
+
+	out := notificationDeliveryToGen(d)
+
+	return &out, nil
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_ResendNotificationDelivery_notFound(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().RequeueNotificationDelivery(gomock.Any(), int64(5)).
+		Return(entity.NotificationDelivery{}, entity.ErrNotificationDeliveryNotFound)
+
+	res, err := h.ResendNotificationDelivery(adminCtx(), &gen.ResendNotificationDeliveryRequest{ID: 5})
+	require.NoError(t, err)
+
+	_, ok := res.(*gen.ErrorMessage)
+	require.True(t, ok)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) GetStreamRecapSettings(ctx context.Context) (*gen.StreamRecapSettings, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_stream_recap_settings")
+	defer span.End()
+
+	s, err := h.sett.GetStreamRecapSettings(ctx)
+	if err != nil {
+		h.obs.LogError(ctx, span, "get stream recap settings failed", err)
+		return nil, err
+	}
+
+	return &gen.StreamRecapSettings{AiSummary: s.AISummary, Notify: s.Notify}, nil
+}
+
+func (h *Handler) UpdateStreamRecapSettings(ctx context.Context, req *gen.StreamRecapSettings) (*gen.StreamRecapSettings, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.update_stream_recap_settings")
+	defer span.End()
+
+	out, err := h.sett.UpdateStreamRecapSettings(ctx, entity.StreamRecapSettings{AISummary: req.AiSummary, Notify: req.Notify})
+	if err != nil {
+		h.obs.LogError(ctx, span, "update stream recap settings failed", err)
+		return nil, err
+	}
+
+	return &gen.StreamRecapSettings{AiSummary: out.AISummary, Notify: out.Notify}, nil
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_StreamRecapSettings(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().GetStreamRecapSettings(gomock.Any()).Return(entity.StreamRecapSettings{AISummary: true}, nil)
+
+	got, err := h.GetStreamRecapSettings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &gen.StreamRecapSettings{AiSummary: true}, got)
+
+	in := entity.StreamRecapSettings{Notify: true}
+
+	repo.EXPECT().UpdateStreamRecapSettings(gomock.Any(), in).Return(nil)
+	repo.EXPECT().GetStreamRecapSettings(gomock.Any()).Return(in, nil)
+
+	got, err = h.UpdateStreamRecapSettings(context.Background(), &gen.StreamRecapSettings{Notify: true})
+	require.NoError(t, err)
+	assert.Equal(t, &gen.StreamRecapSettings{Notify: true}, got)
+}
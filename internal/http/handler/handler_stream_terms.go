@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) GetRecordedStreamTerms(ctx context.Context, params gen.GetRecordedStreamTermsParams) (gen.GetRecordedStreamTermsRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_recorded_stream_terms")
+	defer span.End()
+
+	st, err := h.twitch.GetMonitoredStream(ctx, params.StreamId)
+	if err != nil {
+		if errors.Is(err, entity.ErrStreamNotFound) {
+			return &gen.GetRecordedStreamTermsNotFound{Message: "stream not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "get stream for terms failed", err)
+		return nil, err
+	}
+
+	t, err := h.twitch.StreamTrendingTerms(ctx, st, optTimePtr(params.From), optTimePtr(params.To), params.Limit.Or(0))
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidTermQuery) {
+			return &gen.GetRecordedStreamTermsBadRequest{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "stream trending terms failed", err, zap.Int64("stream_id", st.ID))
+		return nil, err
+	}
+
+	terms := make([]gen.TrendingTerm, 0, len(t.Terms))
+	for _, x := range t.Terms {
+		terms = append(terms, gen.TrendingTerm{
+			Term:            x.Term,
+			Emote:           x.Emote,
+			Words:           x.Words,
+			Messages:        x.Messages,
+			Chatters:        x.Chatters,
+			BaselineStreams: x.BaselineStreams,
+			Score:           x.Score,
+		})
+	}
+
+	return &gen.TrendingTerms{
+		StreamID:        t.StreamID,
+		From:            t.From,
+		To:              t.To,
+		Messages:        t.Messages,
+		BaselineStreams: t.BaselineStreams,
+		Terms:           terms,
+	}, nil
+}
+
+func (h *Handler) GetRecordedStreamTermSeries(ctx context.Context, params gen.GetRecordedStreamTermSeriesParams) (gen.GetRecordedStreamTermSeriesRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.get_recorded_stream_term_series")
+	defer span.End()
+
+	st, err := h.twitch.GetMonitoredStream(ctx, params.StreamId)
+	if err != nil {
+		if errors.Is(err, entity.ErrStreamNotFound) {
+			return &gen.GetRecordedStreamTermSeriesNotFound{Message: "stream not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "get stream for term series failed", err)
+		return nil, err
+	}
+
+	series, err := h.twitch.StreamTermSeries(ctx, st, params.Term)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidTermQuery) {
+			return &gen.GetRecordedStreamTermSeriesBadRequest{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "stream term series failed", err, zap.Int64("stream_id", st.ID))
+		return nil, err
+	}
+
+	points := make([]gen.TermSeriesPoint, 0, len(series.Points))
+	for _, p := range series.Points {
+		points = append(points, gen.TermSeriesPoint{Start: p.Start, Messages: p.Messages})
+	}
+
+	return &gen.TermSeries{
+		StreamID:      series.StreamID,
+		Term:          series.Term,
+		BucketSeconds: series.BucketSeconds,
+		Messages:      series.Messages,
+		Points:        points,
+	}, nil
+}
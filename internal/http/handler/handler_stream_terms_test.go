@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_GetRecordedStreamTerms_ok(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	repo.EXPECT().GetMonitoredStreamByID(gomock.Any(), int64(7)).
+		Return(entity.Stream{ID: 7, ChannelTwitchUserID: 9, StartedAt: start, EndedAt: &end}, nil)
+	repo.EXPECT().ListStreamTermMessages(gomock.Any(), int64(7), nil, nil, "").Return([]entity.TermMessage{
+		{Body: "boss fight"},
+		{Body: "boss fight again"},
+	}, nil)
+	repo.EXPECT().CountBaselineTerms(gomock.Any(), int64(9), int64(7), gomock.Any(), gomock.Any()).
+		Return(0, map[entity.ChatTerm]int{}, nil)
+
+	res, err := h.GetRecordedStreamTerms(context.Background(), gen.GetRecordedStreamTermsParams{StreamId: 7})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.TrendingTerms)
+	require.True(t, ok)
+	assert.Equal(t, 2, out.Messages)
+	require.Len(t, out.Terms, 3)
+	assert.Equal(t, "boss", out.Terms[0].Term)
+	assert.Equal(t, "boss fight", out.Terms[1].Term)
+	assert.Equal(t, 2, out.Terms[1].Words)
+}
+
+func TestHandler_GetRecordedStreamTerms_badWindow(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	repo.EXPECT().GetMonitoredStreamByID(gomock.Any(), int64(7)).Return(entity.Stream{ID: 7, StartedAt: start, EndedAt: &end}, nil)
+
+	res, err := h.GetRecordedStreamTerms(context.Background(), gen.GetRecordedStreamTermsParams{
+		StreamId: 7,
+		From:     gen.NewOptDateTime(end.Add(time.Hour)),
+	})
+	require.NoError(t, err)
+	require.IsType(t, &gen.GetRecordedStreamTermsBadRequest{}, res)
+}
+
+func TestHandler_GetRecordedStreamTermSeries(t *testing.T) {
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Minute)
+
+	repo.EXPECT().GetMonitoredStreamByID(gomock.Any(), int64(7)).Return(entity.Stream{ID: 7, StartedAt: start, EndedAt: &end}, nil)
+	repo.EXPECT().ListStreamTermMessages(gomock.Any(), int64(7), nil, nil, "gg").Return([]entity.TermMessage{
+		{CreatedAt: start.Add(90 * time.Second), Body: "GG"},
+	}, nil)
+
+	res, err := h.GetRecordedStreamTermSeries(context.Background(), gen.GetRecordedStreamTermSeriesParams{StreamId: 7, Term: "GG"})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.TermSeries)
+	require.True(t, ok)
+	assert.Equal(t, "gg", out.Term)
+	require.Len(t, out.Points, 2)
+	assert.Equal(t, 1, out.Points[1].Messages)
+
+	repo.EXPECT().GetMonitoredStreamByID(gomock.Any(), int64(8)).Return(entity.Stream{}, pgx.ErrNoRows)
+
+	res, err = h.GetRecordedStreamTermSeries(context.Background(), gen.GetRecordedStreamTermSeriesParams{StreamId: 8, Term: "gg"})
+	require.NoError(t, err)
+	require.IsType(t, &gen.GetRecordedStreamTermSeriesNotFound{}, res)
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) GetSuspicionReevaluation(_ context.Context) (*gen.SuspicionReevaluation, error) {
+	return suspicionReevaluationToGen(h.twitch.SuspicionReevaluationStatus()), nil
+}
+
+func (h *Handler) StartSuspicionReevaluation(_ context.Context, req gen.OptStartSuspicionReevaluationRequest) (*gen.SuspicionReevaluation, error) {
+	budget := 0
+	if r, ok := req.Get(); ok {
+		budget = r.RefetchBudget.Or(0)
+	}
+
+	return suspicionReevaluationToGen(h.twitch.StartSuspicionReevaluation(entity.SuspicionReevalReasonManual, budget)), nil
+}
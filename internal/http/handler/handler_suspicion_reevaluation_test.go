@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_StartSuspicionReevaluation(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().CountTwitchUsersBrowse(gomock.Any(), entity.TwitchUserBrowseFilter{}).Return(int64(0), nil)
+	repo.EXPECT().ListLinkedTwitchAccountUserIDs(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(entity.SuspicionSettings{}, nil)
+	repo.EXPECT().ListChannelBlacklist(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().GetIrcMonitorSettings(gomock.Any()).Return(entity.IrcMonitorSettings{}, nil)
+	repo.EXPECT().ListFollowsSyncStates(gomock.Any(), int64(0), gomock.Any()).Return(nil, nil)
+
+	before, err := h.GetSuspicionReevaluation(context.Background())
+	require.NoError(t, err)
+	assert.False(t, before.Running)
+	assert.Equal(t, gen.SuspicionReevaluationReasonEmpty, before.Reason)
+
+	out, err := h.StartSuspicionReevaluation(context.Background(),
+		gen.NewOptStartSuspicionReevaluationRequest(gen.StartSuspicionReevaluationRequest{RefetchBudget: gen.NewOptInt(5)}))
+	require.NoError(t, err)
+	assert.True(t, out.Running)
+	assert.Equal(t, gen.SuspicionReevaluationReasonManual, out.Reason)
+	assert.Equal(t, 5, out.RefetchBudget)
+	assert.True(t, out.StartedAt.IsSet())
+
+	require.Eventually(t, func() bool {
+		st, err := h.GetSuspicionReevaluation(context.Background())
+		return err == nil && !st.Running && st.FinishedAt.IsSet()
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) TestNotification(ctx context.Context, req gen.OptTestNotificationRequest, params gen.TestNotificationParams) (gen.TestNotificationRes, error) {
+	var eventTypes []string
+
+	if body, ok := req.Get(); ok {
+		for _, t := range body.EventTypes {
+			eventTypes = append(eventTypes, string(t))
+		}
+	}
+
+	results, err := h.sett.TestNotification(ctx, params.ID, eventTypes)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotificationNotFound) {
+			return &gen.TestNotificationNotFound{Message: "notification not found"}, nil
+		}
+
+		if errors.Is(err, entity.ErrInvalidNotification) {
+			return &gen.TestNotificationBadRequest{Message: err.Error()}, nil
+		}
+
+		return nil, err
+	}
+
+	out := &gen.TestNotificationResponse{Results: make([]gen.NotificationTestResult, 0, len(results))}
+
+	for _, r := range results {
+		out.Results = append(out.Results, notificationTestResultToGen(r))
+	}
+
+	return out, nil
+}
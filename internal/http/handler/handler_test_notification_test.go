@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+	"github.com/rofleksey/dredge/internal/service/notify"
+)
+
+func TestHandler_TestNotification(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	h.sett.SetNotificationTester(notify.NewDispatcher(notify.Config{Repo: repo, Obs: h.obs, HTTPClient: srv.Client()}))
+
+	repo.EXPECT().GetNotificationEntry(gomock.Any(), int64(3)).
+		Return(entity.NotificationEntry{ID: 3, Provider: "webhook", Settings: map[string]any{"url": srv.URL}}, nil)
+
+	res, err := h.TestNotification(context.Background(), gen.NewOptTestNotificationRequest(gen.TestNotificationRequest{
+		EventTypes: []gen.TestNotificationRequestEventTypesItem{gen.TestNotificationRequestEventTypesItemStreamEnd},
+	}), gen.TestNotificationParams{ID: 3})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.TestNotificationResponse)
+	require.True(t, ok)
+	require.Len(t, out.Results, 1)
+	assert.Equal(t, "stream_end", out.Results[0].EventType)
+	assert.True(t, out.Results[0].Delivered)
+	assert.Equal(t, "ok", out.Results[0].ResponseSnippet)
+	assert.Equal(t, 200, out.Results[0].StatusCode.Or(0))
+}
+
+func TestHandler_TestNotification_notFound(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	h.sett.SetNotificationTester(notify.NewDispatcher(notify.Config{Repo: repo, Obs: h.obs}))
+
+	repo.EXPECT().GetNotificationEntry(gomock.Any(), int64(9)).Return(entity.NotificationEntry{}, entity.ErrNotificationNotFound)
+
+	res, err := h.TestNotification(context.Background(), gen.OptTestNotificationRequest{}, gen.TestNotificationParams{ID: 9})
+	require.NoError(t, err)
+	_, ok := res.(*gen.TestNotificationNotFound)
+	require.True(t, ok)
+}
@@ -31,7 +31,24 @@ func (h *Handler) UpdateNotification(ctx context.Context, req *gen.UpdateNotific
 		enabled = &v
 	}
 
-	e, err := h.sett.UpdateNotification(ctx, req.ID, prov, settings, enabled)
+	var routing *entity.NotificationRouting
+
+	if req.Tags != nil || req.EventTypes != nil || req.Channels != nil {
+		routing = &entity.NotificationRouting{
+			Tags:       req.Tags,
+			EventTypes: req.EventTypes,
+			Channels:   req.Channels,
+		}
+	}
+
+	var policy *entity.NotificationPolicy
+
+	if req.Policy.IsSet() {
+		p := notificationPolicyFromGen(req.Policy.Value)
+		policy = &p
+	}
+
+	e, err := h.sett.UpdateNotification(ctx, req.ID, prov, settings, enabled, routing, policy)
 	if err != nil {
 		if errors.Is(err, entity.ErrNotificationNotFound) {
 			return &gen.ErrorMessage{Message: "notification not found"}, nil
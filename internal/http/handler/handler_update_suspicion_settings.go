@@ -2,15 +2,29 @@ package handler
 
 import (
 	"context"
+	"errors"
 
+	"github.com/rofleksey/dredge/internal/entity"
 	"github.com/rofleksey/dredge/internal/http/gen"
 )
 
-func (h *Handler) UpdateSuspicionSettings(ctx context.Context, req *gen.SuspicionSettings) (*gen.SuspicionSettings, error) {
-	s := suspicionGenToEntity(req)
+func (h *Handler) UpdateSuspicionSettings(ctx context.Context, req *gen.SuspicionSettings) (gen.UpdateSuspicionSettingsRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.update_suspicion_settings")
+	defer span.End()
 
-	out, err := h.sett.UpdateSuspicionSettings(ctx, s)
+	cur, err := h.sett.GetSuspicionSettings(ctx)
 	if err != nil {
+		h.obs.LogError(ctx, span, "get suspicion settings failed", err)
+		return nil, err
+	}
+
+	out, err := h.sett.UpdateSuspicionSettings(ctx, suspicionGenToEntity(req, cur))
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidSuspicionSettings) {
+			return &gen.ErrorMessage{Message: err.Error()}, nil
+		}
+
+		h.obs.LogError(ctx, span, "update suspicion settings failed", err)
 		return nil, err
 	}
 
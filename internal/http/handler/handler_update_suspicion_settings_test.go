@@ -1,10 +1,59 @@
 package handler
 
-import "testing"
+import (
+	"context"
+	"testing"
 
-func TestHandler_UpdateSuspicionSettings_smoke(t *testing.T) {
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_UpdateSuspicionSettings_keepsOmittedScoringFields(t *testing.T) {
 	t.Parallel()
-	h, ctrl, _ := testHandler(t)
+	h, ctrl, repo := testHandler(t)
 	defer ctrl.Finish()
-	_ = h
+
+	cur := entity.SuspicionSettings{
+		ScoreThreshold:        50,
+		Weights:               entity.SuspicionWeights{AccountAge: 50, Moderation: 20},
+		NamePatterns:          []string{"^bot"},
+		MultiChannelThreshold: 5,
+	}
+	want := cur
+	want.AutoCheckBlacklist = true
+	want.ScoreThreshold = 80
+
+	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(cur, nil)
+	repo.EXPECT().UpdateSuspicionSettings(gomock.Any(), want).Return(nil)
+	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(want, nil)
+
+	res, err := h.UpdateSuspicionSettings(context.Background(), &gen.SuspicionSettings{
+		AutoCheckBlacklist: true,
+		ScoreThreshold:     gen.NewOptInt(80),
+	})
+	require.NoError(t, err)
+
+	out, ok := res.(*gen.SuspicionSettings)
+	require.True(t, ok)
+	assert.Equal(t, 80, out.ScoreThreshold.Or(0))
+	assert.Equal(t, []string{"^bot"}, out.NamePatterns)
+}
+
+func TestHandler_UpdateSuspicionSettings_invalidPattern(t *testing.T) {
+	t.Parallel()
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(entity.SuspicionSettings{ScoreThreshold: 50, MultiChannelThreshold: 5}, nil)
+
+	res, err := h.UpdateSuspicionSettings(context.Background(), &gen.SuspicionSettings{NamePatterns: []string{"("}})
+	require.NoError(t, err)
+
+	msg, ok := res.(*gen.ErrorMessage)
+	require.True(t, ok)
+	assert.Contains(t, msg.Message, "name pattern")
 }
@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/authctx"
 	"github.com/rofleksey/dredge/internal/http/gen"
 	twitchuc "github.com/rofleksey/dredge/internal/usecase/twitch"
 )
@@ -85,6 +86,11 @@ func (h *Handler) UpdateTwitchUser(ctx context.Context, req *gen.UpdateTwitchUse
 		patch.NotifyStreamStart = &v
 	}
 
+	patch.SusSource = entity.SuspicionSourceManual
+	if userID, ok := authctx.UserID(ctx); ok {
+		patch.SusEvidence = map[string]any{"actor_user_id": userID}
+	}
+
 	u, err := h.sett.PatchTwitchUser(ctx, req.ID, patch)
 	if err != nil {
 		if errors.Is(err, entity.ErrTwitchUserNotFound) {
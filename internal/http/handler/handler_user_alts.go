@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/authctx"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func (h *Handler) ScanTwitchUserAlts(ctx context.Context, params gen.ScanTwitchUserAltsParams) (gen.ScanTwitchUserAltsRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.scan_twitch_user_alts")
+	defer span.End()
+
+	list, err := h.twitch.DetectAltsForUser(ctx, params.TwitchUserID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTwitchUserNotFound) {
+			return &gen.ErrorMessage{Message: "twitch user not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "scan twitch user alts failed", err, zap.Int64("id", params.TwitchUserID))
+		return nil, err
+	}
+
+	out := make(gen.ScanTwitchUserAltsOKApplicationJSON, 0, len(list))
+	for _, c := range list {
+		out = append(out, altCandidateToGen(c))
+	}
+
+	return &out, nil
+}
+
+func (h *Handler) SetTwitchUserLink(ctx context.Context, req *gen.SetTwitchUserLinkRequest) (gen.SetTwitchUserLinkRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.set_twitch_user_link")
+	defer span.End()
+
+	var actor *int64
+	if userID, ok := authctx.UserID(ctx); ok {
+		actor = &userID
+	}
+
+	l, err := h.twitch.SetUserLink(ctx, req.TwitchUserID, req.LinkedUserID, string(req.Status), req.Note.Or(""), actor)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidUserLink) {
+			return &gen.SetTwitchUserLinkBadRequest{Message: err.Error()}, nil
+		}
+
+		if errors.Is(err, entity.ErrTwitchUserNotFound) {
+			return &gen.SetTwitchUserLinkNotFound{Message: "twitch user not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "set twitch user link failed", err)
+		return nil, err
+	}
+
+	out := userLinkToGen(l)
+
+	return &out, nil
+}
+
+func (h *Handler) DeleteTwitchUserLink(ctx context.Context, req *gen.DeleteTwitchUserLinkRequest) (gen.DeleteTwitchUserLinkRes, error) {
+	ctx, span := h.obs.StartSpan(ctx, "handler.delete_twitch_user_link")
+	defer span.End()
+
+	if err := h.twitch.DeleteUserLink(ctx, req.TwitchUserID, req.LinkedUserID); err != nil {
+		if errors.Is(err, entity.ErrUserLinkNotFound) {
+			return &gen.ErrorMessage{Message: "user link not found"}, nil
+		}
+
+		h.obs.LogError(ctx, span, "delete twitch user link failed", err)
+		return nil, err
+	}
+
+	return &gen.DeleteTwitchUserLinkNoContent{}, nil
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/http/gen"
+)
+
+func TestHandler_ScanTwitchUserAlts_notFound(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(5)).Return(entity.TwitchUser{}, entity.ErrTwitchUserNotFound)
+
+	res, err := h.ScanTwitchUserAlts(context.Background(), gen.ScanTwitchUserAltsParams{TwitchUserID: 5})
+	require.NoError(t, err)
+	assert.IsType(t, &gen.ErrorMessage{}, res)
+}
+
+func TestHandler_SetTwitchUserLink(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	actor := int64(1)
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(4)).Return(entity.TwitchUser{ID: 4}, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(9)).Return(entity.TwitchUser{ID: 9}, nil)
+	repo.EXPECT().UpsertUserLink(gomock.Any(), entity.UserLink{
+		TwitchUserID:    4,
+		LinkedUserID:    9,
+		Status:          entity.UserLinkConfirmed,
+		Note:            "ban evasion",
+		DecidedByUserID: &actor,
+	}).Return(entity.UserLink{
+		TwitchUserID: 4, LinkedUserID: 9, LinkedUsername: "alt", Status: entity.UserLinkConfirmed,
+		Note: "ban evasion", DecidedByUserID: &actor, CreatedAt: now, UpdatedAt: now,
+	}, nil)
+
+	res, err := h.SetTwitchUserLink(adminCtx(), &gen.SetTwitchUserLinkRequest{
+		TwitchUserID: 4,
+		LinkedUserID: 9,
+		Status:       gen.UserLinkStatusConfirmed,
+		Note:         gen.NewOptString("ban evasion"),
+	})
+	require.NoError(t, err)
+
+	link, ok := res.(*gen.UserLink)
+	require.True(t, ok)
+	assert.Equal(t, int64(9), link.TwitchUserID)
+	assert.Equal(t, "alt", link.Username)
+	assert.Equal(t, int64(1), link.DecidedByUserID.Or(0))
+
+	res, err = h.SetTwitchUserLink(adminCtx(), &gen.SetTwitchUserLinkRequest{TwitchUserID: 4, LinkedUserID: 4, Status: gen.UserLinkStatusRejected})
+	require.NoError(t, err)
+	assert.IsType(t, &gen.SetTwitchUserLinkBadRequest{}, res)
+}
+
+func TestHandler_DeleteTwitchUserLink(t *testing.T) {
+	t.Parallel()
+
+	h, ctrl, repo := testHandler(t)
+	defer ctrl.Finish()
+
+	repo.EXPECT().DeleteUserLink(gomock.Any(), int64(4), int64(9)).Return(nil)
+	repo.EXPECT().DeleteUserLink(gomock.Any(), int64(4), int64(8)).Return(entity.ErrUserLinkNotFound)
+
+	res, err := h.DeleteTwitchUserLink(context.Background(), &gen.DeleteTwitchUserLinkRequest{TwitchUserID: 4, LinkedUserID: 9})
+	require.NoError(t, err)
+	assert.IsType(t, &gen.DeleteTwitchUserLinkNoContent{}, res)
+
+	res, err = h.DeleteTwitchUserLink(context.Background(), &gen.DeleteTwitchUserLinkRequest{TwitchUserID: 4, LinkedUserID: 8})
+	require.NoError(t, err)
+	assert.IsType(t, &gen.ErrorMessage{}, res)
+}
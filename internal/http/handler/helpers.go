@@ -180,18 +180,140 @@ func notificationEntityToGen(e entity.NotificationEntry) gen.NotificationEntry {
 		settings[k] = jx.Raw(raw)
 	}
 
-	prov := gen.NotificationEntryProviderTelegram
-	if e.Provider == "webhook" {
-		prov = gen.NotificationEntryProviderWebhook
+	return gen.NotificationEntry{
+		ID:         e.ID,
+		Provider:   gen.NotificationEntryProvider(e.Provider),
+		Settings:   settings,
+		Enabled:    e.Enabled,
+		CreatedAt:  e.CreatedAt,
+		Tags:       nonNilStrings(e.Tags),
+		EventTypes: nonNilStrings(e.EventTypes),
+		Channels:   nonNilStrings(e.Channels),
+		Policy:     notificationPolicyToGen(e.NotificationPolicy),
 	}
+}
 
-	return gen.NotificationEntry{
-		ID:        e.ID,
-		Provider:  prov,
-		Settings:  settings,
-		Enabled:   e.Enabled,
-		CreatedAt: e.CreatedAt,
+func notificationPolicyToGen(p entity.NotificationPolicy) gen.NotificationPolicy {
+	out := gen.NotificationPolicy{
+		FloodThreshold:     gen.NewOptInt(p.FloodThreshold),
+		FloodWindowSeconds: gen.NewOptInt(int(p.FloodWindow / time.Second)),
+	}
+
+	if q := p.QuietHours; q != nil {
+		out.QuietHours = gen.NewOptNilNotificationQuietHours(gen.NotificationQuietHours{
+			Start:    q.Start,
+			End:      q.End,
+			Timezone: gen.NewOptString(q.Timezone),
+		})
+	} else {
+		out.QuietHours.SetToNull()
+	}
+
+	return out
+}
+
+// notificationPolicyFromGen maps a request policy; a null or omitted quiet_hours disables quiet hours.
+func notificationPolicyFromGen(p gen.NotificationPolicy) entity.NotificationPolicy {
+	out := entity.NotificationPolicy{
+		FloodThreshold: p.FloodThreshold.Or(0),
+		FloodWindow:    time.Duration(p.FloodWindowSeconds.Or(0)) * time.Second,
+	}
+
+	if q, ok := p.QuietHours.Get(); ok {
+		out.QuietHours = &entity.NotificationQuietHours{Start: q.Start, End: q.End, Timezone: q.Timezone.Or("")}
+	}
+
+	return out
+}
+
+func notificationSnoozeToGen(s entity.NotificationSnooze) gen.NotificationSnooze {
+	out := gen.NotificationSnooze{
+		ID:        s.ID,
+		Channel:   s.Channel,
+		Until:     s.Until,
+		CreatedAt: s.CreatedAt,
+	}
+
+	if s.NotificationEntryID != nil {
+		out.NotificationID = gen.NewOptNilInt64(*s.NotificationEntryID)
+	} else {
+		out.NotificationID.SetToNull()
+	}
+
+	return out
+}
+
+func notificationDeliveryToGen(d entity.NotificationDelivery) gen.NotificationDelivery {
+	out := gen.NotificationDelivery{
+		ID:             d.ID,
+		EventID:        d.EventID,
+		NotificationID: d.NotificationEntryID,
+		EventType:      d.Event.Type,
+		Channel:        d.Event.Channel,
+		Text:           d.Event.Text,
+		Status:         gen.NotificationDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+		AttemptLog:     make([]gen.NotificationDeliveryAttempt, 0, len(d.AttemptLog)),
+	}
+
+	if d.LastStatusCode != nil {
+		out.LastStatusCode = gen.NewOptNilInt(*d.LastStatusCode)
+	}
+
+	if d.LastError != nil {
+		out.LastError = gen.NewOptNilString(*d.LastError)
+	}
+
+	if d.DeliveredAt != nil {
+		out.DeliveredAt = gen.NewOptNilDateTime(*d.DeliveredAt)
+	}
+
+	for _, a := range d.AttemptLog {
+		ga := gen.NotificationDeliveryAttempt{
+			ID:              a.ID,
+			AttemptedAt:     a.AttemptedAt,
+			ResponseSnippet: a.ResponseSnippet,
+			Error:           a.Error,
+			DurationMs:      a.DurationMs,
+		}
+
+		if a.StatusCode != nil {
+			ga.StatusCode = gen.NewOptNilInt(*a.StatusCode)
+		}
+
+		out.AttemptLog = append(out.AttemptLog, ga)
+	}
+
+	return out
+}
+
+func notificationTestResultToGen(r entity.NotificationTestResult) gen.NotificationTestResult {
+	out := gen.NotificationTestResult{
+		EventType:       r.EventType,
+		Text:            r.Text,
+		Delivered:       r.Delivered,
+		ResponseSnippet: r.ResponseSnippet,
+		Error:           r.Error,
+		DurationMs:      r.DurationMs,
+	}
+
+	if r.StatusCode != nil {
+		out.StatusCode = gen.NewOptNilInt(*r.StatusCode)
+	}
+
+	return out
+}
+
+// nonNilStrings keeps required JSON arrays encoded as [] instead of null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
 	}
+
+	return s
 }
 
 func rawSettingsToMap(s map[string]jx.Raw) map[string]any {
@@ -364,6 +486,12 @@ func entityActivityToGen(e entity.UserActivityEvent, profileUsername string) gen
 		et = gen.UserActivityEventEventTypeChatOffline
 	case entity.UserActivityMessage:
 		et = gen.UserActivityEventEventTypeMessage
+	case entity.UserActivityBan:
+		et = gen.UserActivityEventEventTypeBan
+	case entity.UserActivityTimeout:
+		et = gen.UserActivityEventEventTypeTimeout
+	case entity.UserActivityFirstSeenInChannel:
+		et = gen.UserActivityEventEventTypeFirstSeenInChannel
 	default:
 		et = gen.UserActivityEventEventTypeMessage
 	}
@@ -456,29 +584,409 @@ func ircMonitorGenToEntity(req *gen.IrcMonitorSettings) entity.IrcMonitorSetting
 }
 
 func suspicionEntityToGen(s entity.SuspicionSettings) *gen.SuspicionSettings {
+	patterns := s.NamePatterns
+	if patterns == nil {
+		patterns = []string{}
+	}
+
 	return &gen.SuspicionSettings{
-		AutoCheckAccountAge: s.AutoCheckAccountAge,
-		AccountAgeSusDays:   s.AccountAgeSusDays,
-		AutoCheckBlacklist:  s.AutoCheckBlacklist,
-		AutoCheckLowFollows: s.AutoCheckLowFollows,
-		LowFollowsThreshold: s.LowFollowsThreshold,
-		MaxGqlFollowPages:   s.MaxGQLFollowPages,
+		AutoCheckAccountAge:   s.AutoCheckAccountAge,
+		AccountAgeSusDays:     s.AccountAgeSusDays,
+		AutoCheckBlacklist:    s.AutoCheckBlacklist,
+		AutoCheckLowFollows:   s.AutoCheckLowFollows,
+		LowFollowsThreshold:   s.LowFollowsThreshold,
+		MaxGqlFollowPages:     s.MaxGQLFollowPages,
+		ScoreThreshold:        gen.NewOptInt(s.ScoreThreshold),
+		Weights:               gen.NewOptSuspicionWeights(suspicionWeightsToGen(s.Weights)),
+		NamePatterns:          patterns,
+		MultiChannelThreshold: gen.NewOptInt(s.MultiChannelThreshold),
+	}
+}
+
+func suspicionWeightsToGen(w entity.SuspicionWeights) gen.SuspicionWeights {
+	return gen.SuspicionWeights{
+		AccountAge:       w.AccountAge,
+		BlacklistFollows: w.BlacklistFollows,
+		LowFollows:       w.LowFollows,
+		NamePattern:      w.NamePattern,
+		FirstMessage:     w.FirstMessage,
+		MultiChannel:     w.MultiChannel,
+		Moderation:       w.Moderation,
+	}
+}
+
+func suspicionScoreToGen(s entity.SuspicionScore) gen.SuspicionScore {
+	signals := make([]gen.SuspicionSignal, 0, len(s.Signals))
+	for _, sig := range s.Signals {
+		signals = append(signals, gen.SuspicionSignal{
+			Key:    gen.SuspicionSignalKey(sig.Key),
+			Score:  sig.Score,
+			Weight: sig.Weight,
+			Detail: sig.Detail,
+		})
+	}
+
+	return gen.SuspicionScore{
+		Score:      s.Score,
+		Threshold:  s.Threshold,
+		ComputedAt: s.ComputedAt,
+		Signals:    signals,
+	}
+}
+
+func suspicionEventToGen(e entity.SuspicionEvent) gen.SuspicionEvent {
+	out := gen.SuspicionEvent{
+		ID:                e.ID,
+		TwitchUserID:      e.TwitchUserID,
+		Username:          e.Username,
+		Source:            gen.SuspicionEventSource(e.Source),
+		OldIsSus:          e.OldIsSus,
+		NewIsSus:          e.NewIsSus,
+		OldSusType:        optNilStringFromPtr(e.OldSusType),
+		NewSusType:        optNilStringFromPtr(e.NewSusType),
+		OldSusDescription: optNilStringFromPtr(e.OldSusDescription),
+		NewSusDescription: optNilStringFromPtr(e.NewSusDescription),
+		CreatedAt:         e.CreatedAt,
+	}
+
+	if raw, err := json.Marshal(e.Evidence); err == nil && len(e.Evidence) > 0 {
+		var rm map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &rm); err == nil {
+			ev := make(gen.SuspicionEventEvidence, len(rm))
+			for k, v := range rm {
+				ev[k] = jx.Raw(v)
+			}
+
+			out.SetEvidence(gen.NewOptSuspicionEventEvidence(ev))
+		}
+	}
+
+	return out
+}
+
+func altCandidateToGen(c entity.AltCandidate) gen.AltCandidate {
+	signals := make([]gen.AltSignal, 0, len(c.Signals))
+	for _, sig := range c.Signals {
+		signals = append(signals, gen.AltSignal{
+			Key:    gen.AltSignalKey(sig.Key),
+			Score:  sig.Score,
+			Weight: sig.Weight,
+			Detail: sig.Detail,
+		})
+	}
+
+	return gen.AltCandidate{
+		TwitchUserID: c.CandidateUserID,
+		Username:     c.CandidateUsername,
+		Score:        c.Score,
+		Signals:      signals,
+		ComputedAt:   c.ComputedAt,
+	}
+}
+
+func userLinkToGen(l entity.UserLink) gen.UserLink {
+	out := gen.UserLink{
+		TwitchUserID: l.LinkedUserID,
+		Username:     l.LinkedUsername,
+		Status:       gen.UserLinkStatus(l.Status),
+		Note:         l.Note,
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+	}
+
+	if l.Score != nil {
+		out.SetScore(gen.NewOptNilInt(*l.Score))
+	}
+
+	if l.DecidedByUserID != nil {
+		out.SetDecidedByUserID(gen.NewOptNilInt64(*l.DecidedByUserID))
+	}
+
+	return out
+}
+
+func botDetectionEntityToGen(s entity.BotDetectionSettings) *gen.BotDetectionSettings {
+	return &gen.BotDetectionSettings{
+		Enabled:               s.Enabled,
+		MinConcurrentChannels: s.MinConcurrentChannels,
+		MaxMessagesPerHour:    s.MaxMessagesPerHour,
+		ViewersPerChatter:     s.ViewersPerChatter,
+		ExcludeFromStats:      s.ExcludeFromStats,
+	}
+}
+
+func chatClassifierEntityToGen(s entity.ChatClassifierSettings, tokensUsedToday int64) *gen.ChatClassifierSettings {
+	return &gen.ChatClassifierSettings{
+		Enabled:          s.Enabled,
+		Channels:         append([]string{}, s.Channels...),
+		SampleRate:       s.SampleRate,
+		BatchSize:        s.BatchSize,
+		DailyTokenBudget: s.DailyTokenBudget,
+		TokensUsedToday:  gen.NewOptInt64(tokensUsedToday),
+	}
+}
+
+func chatToxicityToGen(t entity.ChatToxicity) gen.ChatToxicity {
+	return gen.ChatToxicity{
+		ClassifiedMessages: t.ClassifiedMessages,
+		ToxicMessages:      t.ToxicMessages,
+		AvgToxicity:        t.AvgToxicity,
+		AvgHarassment:      t.AvgHarassment,
+		AvgSentiment:       t.AvgSentiment,
+	}
+}
+
+func loginPatternEntityToGen(p entity.LoginPattern) gen.LoginPattern {
+	return gen.LoginPattern{
+		ID:          p.ID,
+		Kind:        gen.LoginPatternKind(p.Kind),
+		Pattern:     p.Pattern,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+func blocklistEntityToGen(b entity.Blocklist) gen.Blocklist {
+	return gen.Blocklist{
+		ID:                  b.ID,
+		Name:                b.Name,
+		Format:              gen.BlocklistFormat(b.Format),
+		SourceURL:           nilStringFromPtr(b.SourceURL),
+		SyncIntervalMinutes: b.SyncIntervalMinutes,
+		Enabled:             b.Enabled,
+		SourceFilename:      nilStringFromPtr(b.SourceFilename),
+		ContentSHA256:       nilStringFromPtr(b.ContentSHA256),
+		EntryCount:          b.EntryCount,
+		LastImportedAt:      nilDateTimeFromPtr(b.LastImportedAt),
+		LastSyncedAt:        nilDateTimeFromPtr(b.LastSyncedAt),
+		LastSyncError:       nilStringFromPtr(b.LastSyncError),
+		CreatedAt:           b.CreatedAt,
+	}
+}
+
+func channelAnalyticsToGen(a entity.ChannelAnalytics) *gen.ChannelAnalytics {
+	series := make([]gen.ChannelAnalyticsPoint, 0, len(a.Series))
+	for _, b := range a.Series {
+		series = append(series, gen.ChannelAnalyticsPoint{
+			BucketStart:            b.Start,
+			Messages:               b.MessageCount,
+			UniqueChatters:         b.UniqueChatters,
+			NewChatters:            b.NewChatters,
+			PresentChatters:        b.PresentChatters,
+			AveragePresenceSeconds: b.AveragePresenceSeconds(),
+		})
+	}
+
+	retention := make([]gen.StreamRetentionEntry, 0, len(a.Retention))
+	for _, r := range a.Retention {
+		e := gen.StreamRetentionEntry{
+			StreamID:          r.StreamID,
+			StartedAt:         r.StartedAt,
+			Chatters:          r.Chatters,
+			ReturningChatters: r.ReturningChatters,
+			NextStreamID:      gen.NilInt64{Null: true},
+			Retention:         gen.NilFloat64{Null: true},
+		}
+
+		if r.Title != nil {
+			e.Title = gen.NewOptString(*r.Title)
+		}
+
+		if r.NextStreamID != nil {
+			e.NextStreamID = gen.NewNilInt64(*r.NextStreamID)
+		}
+
+		if rate := r.Rate(); rate != nil {
+			e.Retention = gen.NewNilFloat64(*rate)
+		}
+
+		retention = append(retention, e)
+	}
+
+	return &gen.ChannelAnalytics{
+		ChannelLogin:  a.ChannelLogin,
+		Bucket:        gen.ChannelAnalyticsBucket(a.Bucket),
+		From:          a.From,
+		To:            a.To,
+		Series:        series,
+		Retention:     retention,
+		RolledUpUntil: nilDateTimeFromPtr(a.RolledUpUntil),
+	}
+}
+
+func channelLeaderboardToGen(lb entity.ChannelLeaderboard) *gen.ChannelLeaderboard {
+	entries := make([]gen.ChannelLeaderboardEntry, 0, len(lb.Rows))
+	for _, r := range lb.Rows {
+		e := gen.ChannelLeaderboardEntry{
+			Login:           r.Login,
+			UserTwitchID:    r.UserTwitchID,
+			PresenceSeconds: r.PresenceSeconds,
+			MessageCount:    r.MessageCount,
+			Channels:        r.Channels,
+		}
+
+		if r.AccountCreatedAt != nil {
+			e.AccountCreatedAt = gen.NewOptNilDateTime(*r.AccountCreatedAt)
+		} else {
+			e.AccountCreatedAt.SetToNull()
+		}
+
+		entries = append(entries, e)
+	}
+
+	out := &gen.ChannelLeaderboard{
+		From:          lb.From,
+		To:            lb.To,
+		Total:         lb.Total,
+		Entries:       entries,
+		RolledUpUntil: nilDateTimeFromPtr(lb.RolledUpUntil),
+	}
+
+	if lb.Channel != "" {
+		out.ChannelLogin = gen.NewOptString(lb.Channel)
+	}
+
+	return out
+}
+
+func audienceOverlapToGen(o entity.AudienceOverlap) *gen.AudienceOverlap {
+	channels := make([]gen.AudienceChannel, 0, len(o.Channels))
+	for _, c := range o.Channels {
+		community := make([]gen.AudienceCommunityChannel, 0, len(c.Community))
+		for _, cc := range c.Community {
+			community = append(community, gen.AudienceCommunityChannel{
+				TwitchUserID: cc.ChannelTwitchUserID,
+				Login:        cc.Login,
+				Monitored:    cc.Monitored,
+				ActiveUsers:  cc.ActiveUsers,
+				Followers:    cc.Followers,
+			})
+		}
+
+		channels = append(channels, gen.AudienceChannel{
+			TwitchUserID: c.ChannelTwitchUserID,
+			Login:        c.Login,
+			Chatters:     c.Chatters,
+			PresentUsers: c.PresentUsers,
+			Audience:     c.Audience,
+			Community:    community,
+		})
+	}
+
+	pairs := make([]gen.AudienceOverlapPair, 0, len(o.Pairs))
+	for _, p := range o.Pairs {
+		top := make([]gen.AudienceSharedUser, 0, len(p.TopShared))
+		for _, u := range p.TopShared {
+			top = append(top, gen.AudienceSharedUser{
+				TwitchUserID: u.TwitchUserID,
+				Login:        u.Login,
+				MessagesA:    u.MessagesA,
+				MessagesB:    u.MessagesB,
+			})
+		}
+
+		pairs = append(pairs, gen.AudienceOverlapPair{
+			ChannelA:    p.ChannelA,
+			ChannelB:    p.ChannelB,
+			SharedUsers: p.SharedUsers,
+			Jaccard:     p.Jaccard,
+			Overlap:     p.Overlap,
+			TopShared:   top,
+		})
+	}
+
+	return &gen.AudienceOverlap{
+		Period:        gen.AudienceOverlapPeriod(o.Period),
+		From:          o.From,
+		To:            o.To,
+		ComputedAt:    o.ComputedAt,
+		Channels:      channels,
+		Pairs:         pairs,
+		JaccardMatrix: o.Matrix(func(p entity.AudienceOverlapPair) float64 { return p.Jaccard }),
+		OverlapMatrix: o.Matrix(func(p entity.AudienceOverlapPair) float64 { return p.Overlap }),
+	}
+}
+
+func nilStringFromPtr(s *string) gen.NilString {
+	if s == nil {
+		return gen.NilString{Null: true}
+	}
+
+	return gen.NewNilString(*s)
+}
+
+func nilDateTimeFromPtr(t *time.Time) gen.NilDateTime {
+	if t == nil {
+		return gen.NilDateTime{Null: true}
+	}
+
+	return gen.NewNilDateTime(*t)
+}
+
+func suspicionReevaluationToGen(st entity.SuspicionReevaluation) *gen.SuspicionReevaluation {
+	out := &gen.SuspicionReevaluation{
+		Running:       st.Running,
+		Queued:        st.Queued,
+		Reason:        gen.SuspicionReevaluationReason(st.Reason),
+		RefetchBudget: st.RefetchBudget,
+		Total:         st.Total,
+		Processed:     st.Processed,
+		Evaluated:     st.Evaluated,
+		Refetched:     st.Refetched,
+		Skipped:       st.Skipped,
+		Failed:        st.Failed,
+	}
+
+	if st.StartedAt != nil {
+		out.SetStartedAt(gen.NewOptDateTime(*st.StartedAt))
+	}
+
+	if st.FinishedAt != nil {
+		out.SetFinishedAt(gen.NewOptDateTime(*st.FinishedAt))
 	}
+
+	return out
 }
 
-func suspicionGenToEntity(s *gen.SuspicionSettings) entity.SuspicionSettings {
+// suspicionGenToEntity applies a request onto cur; omitted scoring fields keep their current values.
+func suspicionGenToEntity(s *gen.SuspicionSettings, cur entity.SuspicionSettings) entity.SuspicionSettings {
 	if s == nil {
-		return entity.SuspicionSettings{}
+		return cur
+	}
+
+	out := cur
+	out.AutoCheckAccountAge = s.AutoCheckAccountAge
+	out.AccountAgeSusDays = s.AccountAgeSusDays
+	out.AutoCheckBlacklist = s.AutoCheckBlacklist
+	out.AutoCheckLowFollows = s.AutoCheckLowFollows
+	out.LowFollowsThreshold = s.LowFollowsThreshold
+	out.MaxGQLFollowPages = s.MaxGqlFollowPages
+
+	if v, ok := s.ScoreThreshold.Get(); ok {
+		out.ScoreThreshold = v
+	}
+
+	if w, ok := s.Weights.Get(); ok {
+		out.Weights = entity.SuspicionWeights{
+			AccountAge:       w.AccountAge,
+			BlacklistFollows: w.BlacklistFollows,
+			LowFollows:       w.LowFollows,
+			NamePattern:      w.NamePattern,
+			FirstMessage:     w.FirstMessage,
+			MultiChannel:     w.MultiChannel,
+			Moderation:       w.Moderation,
+		}
 	}
 
-	return entity.SuspicionSettings{
-		AutoCheckAccountAge: s.AutoCheckAccountAge,
-		AccountAgeSusDays:   s.AccountAgeSusDays,
-		AutoCheckBlacklist:  s.AutoCheckBlacklist,
-		AutoCheckLowFollows: s.AutoCheckLowFollows,
-		LowFollowsThreshold: s.LowFollowsThreshold,
-		MaxGQLFollowPages:   s.MaxGqlFollowPages,
+	if s.NamePatterns != nil {
+		out.NamePatterns = s.NamePatterns
 	}
+
+	if v, ok := s.MultiChannelThreshold.Get(); ok {
+		out.MultiChannelThreshold = v
+	}
+
+	return out
 }
 
 func channelDiscoveryEntityToGen(s entity.ChannelDiscoverySettings) *gen.ChannelDiscoverySettings {
@@ -557,3 +1065,126 @@ func discoveryCandidateEntityToGen(c entity.TwitchDiscoveryCandidate) gen.Discov
 
 	return out
 }
+
+func emoteSetEntityToGen(s entity.EmoteSet) gen.EmoteSet {
+	return gen.EmoteSet{
+		ID:         s.ID,
+		Name:       s.Name,
+		Provider:   gen.EmoteSetProvider(s.Provider),
+		Channel:    s.Channel,
+		EmoteCount: s.EmoteCount,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+func emoteUsageToGen(list []entity.EmoteUsage) []gen.EmoteUsage {
+	out := make([]gen.EmoteUsage, 0, len(list))
+	for _, u := range list {
+		out = append(out, gen.EmoteUsage{
+			Provider: u.Provider,
+			EmoteID:  u.EmoteID,
+			Code:     u.Code,
+			Uses:     u.Uses,
+			Messages: u.Messages,
+			Chatters: u.Chatters,
+		})
+	}
+
+	return out
+}
+
+// optTimePtr returns a pointer to an optional query time (nil when absent).
+func optTimePtr(v gen.OptDateTime) *time.Time {
+	if t, ok := v.Get(); ok {
+		return &t
+	}
+
+	return nil
+}
+
+func streamRecapToGen(r entity.StreamRecap) gen.StreamRecap {
+	out := gen.StreamRecap{
+		DurationSeconds: r.DurationSeconds,
+		Messages:        r.Messages,
+		UniqueChatters:  r.UniqueChatters,
+		NewChatters:     r.NewChatters,
+		TopChatters:     make([]gen.StreamRecapChatter, 0, len(r.TopChatters)),
+		SuspiciousUsers: make([]gen.StreamRecapUser, 0, len(r.SuspiciousUsers)),
+		RuleTriggers:    make([]gen.StreamRecapRule, 0, len(r.RuleTriggers)),
+		BusiestMoments:  make([]gen.StreamRecapMoment, 0, len(r.BusiestMoments)),
+		CreatedAt:       r.CreatedAt,
+	}
+
+	if r.PeakViewers != nil {
+		out.PeakViewers.SetTo(*r.PeakViewers)
+	}
+
+	if r.AverageViewers != nil {
+		out.AverageViewers.SetTo(*r.AverageViewers)
+	}
+
+	if r.Summary != "" {
+		out.Summary.SetTo(r.Summary)
+	}
+
+	for _, c := range r.TopChatters {
+		out.TopChatters = append(out.TopChatters, gen.StreamRecapChatter{
+			UserTwitchID:    c.UserTwitchID,
+			Login:           c.Login,
+			MessageCount:    c.MessageCount,
+			PresenceSeconds: c.PresenceSeconds,
+		})
+	}
+
+	for _, u := range r.SuspiciousUsers {
+		g := gen.StreamRecapUser{UserTwitchID: u.UserTwitchID, Login: u.Login}
+
+		if u.SusType != nil {
+			g.SusType.SetTo(*u.SusType)
+		}
+
+		if u.SusDescription != nil {
+			g.SusDescription.SetTo(*u.SusDescription)
+		}
+
+		out.SuspiciousUsers = append(out.SuspiciousUsers, g)
+	}
+
+	for _, t := range r.RuleTriggers {
+		g := gen.StreamRecapRule{RuleName: t.RuleName, Triggers: t.Triggers}
+
+		if t.RuleID != nil {
+			g.RuleID.SetTo(*t.RuleID)
+		}
+
+		out.RuleTriggers = append(out.RuleTriggers, g)
+	}
+
+	for _, m := range r.BusiestMoments {
+		out.BusiestMoments = append(out.BusiestMoments, gen.StreamRecapMoment{Start: m.Start, Messages: m.Messages, Chatters: m.Chatters})
+	}
+
+	return out
+}
+
+func chatMomentToGen(m entity.ChatMoment) gen.ChatMoment {
+	out := gen.ChatMoment{
+		ID:             m.ID,
+		Kind:           gen.ChatMomentKind(m.Kind),
+		Label:          m.Label,
+		StartedAt:      m.StartedAt,
+		EndedAt:        m.EndedAt,
+		OffsetSeconds:  m.OffsetSeconds,
+		Messages:       m.Messages,
+		Chatters:       m.Chatters,
+		Intensity:      m.Intensity,
+		SampleMessages: make([]gen.ChatMomentMessage, 0, len(m.SampleMessages)),
+	}
+
+	for _, s := range m.SampleMessages {
+		out.SampleMessages = append(out.SampleMessages, gen.ChatMomentMessage{Login: s.Login, Body: s.Body, CreatedAt: s.CreatedAt})
+	}
+
+	return out
+}
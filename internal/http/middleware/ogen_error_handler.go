@@ -23,7 +23,7 @@ func OgenErrorHandler() gen.ErrorHandler {
 			return
 		}
 
-		if errors.Is(err, entity.ErrInvalidRule) {
+		if errors.Is(err, entity.ErrInvalidRule) || errors.Is(err, entity.ErrInvalidNotification) {
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusBadRequest)
 
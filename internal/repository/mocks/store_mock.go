@@ -100,12 +100,58 @@ func (mr *MockStoreMockRecorder) ApproveDiscoveryCandidate(ctx, twitchUserID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveDiscoveryCandidate", reflect.TypeOf((*MockStore)(nil).ApproveDiscoveryCandidate), ctx, twitchUserID)
 }
 
+// ChatClassifierTokensUsed mocks base method.
+func (m *MockStore) ChatClassifierTokensUsed(ctx context.Context, day time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChatClassifierTokensUsed", ctx, day)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChatClassifierTokensUsed indicates an expected call of ChatClassifierTokensUsed.
+func (mr *MockStoreMockRecorder) ChatClassifierTokensUsed(ctx, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChatClassifierTokensUsed", reflect.TypeOf((*MockStore)(nil).ChatClassifierTokensUsed), ctx, day)
+}
+
+// ClaimEntryNotificationDeliveries mocks base method.
+func (m *MockStore) ClaimEntryNotificationDeliveries(ctx context.Context, entryID int64, limit int, lease time.Duration) ([]entity.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimEntryNotificationDeliveries", ctx, entryID, limit, lease)
+	ret0, _ := ret[0].([]entity.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimEntryNotificationDeliveries indicates an expected call of ClaimEntryNotificationDeliveries.
+func (mr *MockStoreMockRecorder) ClaimEntryNotificationDeliveries(ctx, entryID, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimEntryNotificationDeliveries", reflect.TypeOf((*MockStore)(nil).ClaimEntryNotificationDeliveries), ctx, entryID, limit, lease)
+}
+
+// ClaimNotificationDeliveries mocks base method.
+func (m *MockStore) ClaimNotificationDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entity.NotificationDeliveryJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNotificationDeliveries", ctx, limit, lease)
+	ret0, _ := ret[0].([]entity.NotificationDeliveryJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNotificationDeliveries indicates an expected call of ClaimNotificationDeliveries.
+func (mr *MockStoreMockRecorder) ClaimNotificationDeliveries(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNotificationDeliveries", reflect.TypeOf((*MockStore)(nil).ClaimNotificationDeliveries), ctx, limit, lease)
+}
+
 // CloseOpenStreamsForChannel mocks base method.
-func (m *MockStore) CloseOpenStreamsForChannel(ctx context.Context, channelTwitchUserID int64) error {
+func (m *MockStore) CloseOpenStreamsForChannel(ctx context.Context, channelTwitchUserID int64) ([]int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CloseOpenStreamsForChannel", ctx, channelTwitchUserID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CloseOpenStreamsForChannel indicates an expected call of CloseOpenStreamsForChannel.
@@ -114,6 +160,36 @@ func (mr *MockStoreMockRecorder) CloseOpenStreamsForChannel(ctx, channelTwitchUs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseOpenStreamsForChannel", reflect.TypeOf((*MockStore)(nil).CloseOpenStreamsForChannel), ctx, channelTwitchUserID)
 }
 
+// CompleteNotificationDeliveryAttempt mocks base method.
+func (m *MockStore) CompleteNotificationDeliveryAttempt(ctx context.Context, deliveryID int64, attempt entity.NotificationDeliveryAttempt, status string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteNotificationDeliveryAttempt", ctx, deliveryID, attempt, status, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteNotificationDeliveryAttempt indicates an expected call of CompleteNotificationDeliveryAttempt.
+func (mr *MockStoreMockRecorder) CompleteNotificationDeliveryAttempt(ctx, deliveryID, attempt, status, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteNotificationDeliveryAttempt", reflect.TypeOf((*MockStore)(nil).CompleteNotificationDeliveryAttempt), ctx, deliveryID, attempt, status, nextAttemptAt)
+}
+
+// CountBaselineTerms mocks base method.
+func (m *MockStore) CountBaselineTerms(ctx context.Context, channelID, excludeStreamID int64, streams int, terms []entity.ChatTerm) (int, map[entity.ChatTerm]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBaselineTerms", ctx, channelID, excludeStreamID, streams, terms)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(map[entity.ChatTerm]int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountBaselineTerms indicates an expected call of CountBaselineTerms.
+func (mr *MockStoreMockRecorder) CountBaselineTerms(ctx, channelID, excludeStreamID, streams, terms any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBaselineTerms", reflect.TypeOf((*MockStore)(nil).CountBaselineTerms), ctx, channelID, excludeStreamID, streams, terms)
+}
+
 // CountChannelChatters mocks base method.
 func (m *MockStore) CountChannelChatters(ctx context.Context, channelTwitchUserID int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +205,21 @@ func (mr *MockStoreMockRecorder) CountChannelChatters(ctx, channelTwitchUserID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChannelChatters", reflect.TypeOf((*MockStore)(nil).CountChannelChatters), ctx, channelTwitchUserID)
 }
 
+// CountChannelRuleTriggers mocks base method.
+func (m *MockStore) CountChannelRuleTriggers(ctx context.Context, channel string, from, to time.Time) ([]entity.StreamRecapRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountChannelRuleTriggers", ctx, channel, from, to)
+	ret0, _ := ret[0].([]entity.StreamRecapRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountChannelRuleTriggers indicates an expected call of CountChannelRuleTriggers.
+func (mr *MockStoreMockRecorder) CountChannelRuleTriggers(ctx, channel, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChannelRuleTriggers", reflect.TypeOf((*MockStore)(nil).CountChannelRuleTriggers), ctx, channel, from, to)
+}
+
 // CountChatMessages mocks base method.
 func (m *MockStore) CountChatMessages(ctx context.Context, f entity.ChatMessageListFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +250,21 @@ func (mr *MockStoreMockRecorder) CountChatMessagesByChatter(ctx, chatterID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChatMessagesByChatter", reflect.TypeOf((*MockStore)(nil).CountChatMessagesByChatter), ctx, chatterID)
 }
 
+// CountChatMessagesByHourOfWeek mocks base method.
+func (m *MockStore) CountChatMessagesByHourOfWeek(ctx context.Context, chatterID, channelID *int64, from, to time.Time, timezone string) (entity.HourOfWeekMatrix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountChatMessagesByHourOfWeek", ctx, chatterID, channelID, from, to, timezone)
+	ret0, _ := ret[0].(entity.HourOfWeekMatrix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountChatMessagesByHourOfWeek indicates an expected call of CountChatMessagesByHourOfWeek.
+func (mr *MockStoreMockRecorder) CountChatMessagesByHourOfWeek(ctx, chatterID, channelID, from, to, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChatMessagesByHourOfWeek", reflect.TypeOf((*MockStore)(nil).CountChatMessagesByHourOfWeek), ctx, chatterID, channelID, from, to, timezone)
+}
+
 // CountChatMessagesPerChatterForChannelSince mocks base method.
 func (m *MockStore) CountChatMessagesPerChatterForChannelSince(ctx context.Context, channelTwitchUserID int64, since time.Time) (map[int64]int64, error) {
 	m.ctrl.T.Helper()
@@ -189,6 +295,21 @@ func (mr *MockStoreMockRecorder) CountChatMessagesPerChatterForStream(ctx, strea
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChatMessagesPerChatterForStream", reflect.TypeOf((*MockStore)(nil).CountChatMessagesPerChatterForStream), ctx, streamID)
 }
 
+// CountFollowedChannelsAmong mocks base method.
+func (m *MockStore) CountFollowedChannelsAmong(ctx context.Context, followerIDs []int64, limit int) ([]entity.AudienceCommunityChannel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFollowedChannelsAmong", ctx, followerIDs, limit)
+	ret0, _ := ret[0].([]entity.AudienceCommunityChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFollowedChannelsAmong indicates an expected call of CountFollowedChannelsAmong.
+func (mr *MockStoreMockRecorder) CountFollowedChannelsAmong(ctx, followerIDs, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFollowedChannelsAmong", reflect.TypeOf((*MockStore)(nil).CountFollowedChannelsAmong), ctx, followerIDs, limit)
+}
+
 // CountRules mocks base method.
 func (m *MockStore) CountRules(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -204,6 +325,21 @@ func (mr *MockStoreMockRecorder) CountRules(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRules", reflect.TypeOf((*MockStore)(nil).CountRules), ctx)
 }
 
+// CountStreamChatters mocks base method.
+func (m *MockStore) CountStreamChatters(ctx context.Context, streamID int64) (entity.StreamChatterCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountStreamChatters", ctx, streamID)
+	ret0, _ := ret[0].(entity.StreamChatterCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountStreamChatters indicates an expected call of CountStreamChatters.
+func (mr *MockStoreMockRecorder) CountStreamChatters(ctx, streamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountStreamChatters", reflect.TypeOf((*MockStore)(nil).CountStreamChatters), ctx, streamID)
+}
+
 // CountTwitchAccounts mocks base method.
 func (m *MockStore) CountTwitchAccounts(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +370,21 @@ func (mr *MockStoreMockRecorder) CountTwitchUsersBrowse(ctx, f any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTwitchUsersBrowse", reflect.TypeOf((*MockStore)(nil).CountTwitchUsersBrowse), ctx, f)
 }
 
+// CountUserActivityEventsByType mocks base method.
+func (m *MockStore) CountUserActivityEventsByType(ctx context.Context, chatterID int64, eventTypes []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserActivityEventsByType", ctx, chatterID, eventTypes)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserActivityEventsByType indicates an expected call of CountUserActivityEventsByType.
+func (mr *MockStoreMockRecorder) CountUserActivityEventsByType(ctx, chatterID, eventTypes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserActivityEventsByType", reflect.TypeOf((*MockStore)(nil).CountUserActivityEventsByType), ctx, chatterID, eventTypes)
+}
+
 // CreateAIConversation mocks base method.
 func (m *MockStore) CreateAIConversation(ctx context.Context, title *string) (entity.AIConversation, error) {
 	m.ctrl.T.Helper()
@@ -249,19 +400,64 @@ func (mr *MockStoreMockRecorder) CreateAIConversation(ctx, title any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAIConversation", reflect.TypeOf((*MockStore)(nil).CreateAIConversation), ctx, title)
 }
 
+// CreateBlocklist mocks base method.
+func (m *MockStore) CreateBlocklist(ctx context.Context, b entity.Blocklist) (entity.Blocklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlocklist", ctx, b)
+	ret0, _ := ret[0].(entity.Blocklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBlocklist indicates an expected call of CreateBlocklist.
+func (mr *MockStoreMockRecorder) CreateBlocklist(ctx, b any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlocklist", reflect.TypeOf((*MockStore)(nil).CreateBlocklist), ctx, b)
+}
+
+// CreateLoginPattern mocks base method.
+func (m *MockStore) CreateLoginPattern(ctx context.Context, p entity.LoginPattern) (entity.LoginPattern, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoginPattern", ctx, p)
+	ret0, _ := ret[0].(entity.LoginPattern)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoginPattern indicates an expected call of CreateLoginPattern.
+func (mr *MockStoreMockRecorder) CreateLoginPattern(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoginPattern", reflect.TypeOf((*MockStore)(nil).CreateLoginPattern), ctx, p)
+}
+
 // CreateNotificationEntry mocks base method.
-func (m *MockStore) CreateNotificationEntry(ctx context.Context, provider string, settings map[string]any, enabled bool) (entity.NotificationEntry, error) {
+func (m *MockStore) CreateNotificationEntry(ctx context.Context, provider string, settings map[string]any, enabled bool, routing entity.NotificationRouting, policy entity.NotificationPolicy) (entity.NotificationEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNotificationEntry", ctx, provider, settings, enabled)
+	ret := m.ctrl.Call(m, "CreateNotificationEntry", ctx, provider, settings, enabled, routing, policy)
 	ret0, _ := ret[0].(entity.NotificationEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNotificationEntry indicates an expected call of CreateNotificationEntry.
-func (mr *MockStoreMockRecorder) CreateNotificationEntry(ctx, provider, settings, enabled any) *gomock.Call {
+func (mr *MockStoreMockRecorder) CreateNotificationEntry(ctx, provider, settings, enabled, routing, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationEntry", reflect.TypeOf((*MockStore)(nil).CreateNotificationEntry), ctx, provider, settings, enabled, routing, policy)
+}
+
+// CreateNotificationSnooze mocks base method.
+func (m *MockStore) CreateNotificationSnooze(ctx context.Context, s entity.NotificationSnooze) (entity.NotificationSnooze, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationSnooze", ctx, s)
+	ret0, _ := ret[0].(entity.NotificationSnooze)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotificationSnooze indicates an expected call of CreateNotificationSnooze.
+func (mr *MockStoreMockRecorder) CreateNotificationSnooze(ctx, s any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationEntry", reflect.TypeOf((*MockStore)(nil).CreateNotificationEntry), ctx, provider, settings, enabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationSnooze", reflect.TypeOf((*MockStore)(nil).CreateNotificationSnooze), ctx, s)
 }
 
 // CreateRule mocks base method.
@@ -323,6 +519,20 @@ func (mr *MockStoreMockRecorder) DeleteAIConversation(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAIConversation", reflect.TypeOf((*MockStore)(nil).DeleteAIConversation), ctx, id)
 }
 
+// DeleteBlocklist mocks base method.
+func (m *MockStore) DeleteBlocklist(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlocklist", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlocklist indicates an expected call of DeleteBlocklist.
+func (mr *MockStoreMockRecorder) DeleteBlocklist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlocklist", reflect.TypeOf((*MockStore)(nil).DeleteBlocklist), ctx, id)
+}
+
 // DeleteChannelChatterPresence mocks base method.
 func (m *MockStore) DeleteChannelChatterPresence(ctx context.Context, channelTwitchUserID, chatterTwitchUserID int64) (time.Time, bool, error) {
 	m.ctrl.T.Helper()
@@ -339,6 +549,34 @@ func (mr *MockStoreMockRecorder) DeleteChannelChatterPresence(ctx, channelTwitch
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelChatterPresence", reflect.TypeOf((*MockStore)(nil).DeleteChannelChatterPresence), ctx, channelTwitchUserID, chatterTwitchUserID)
 }
 
+// DeleteEmoteSet mocks base method.
+func (m *MockStore) DeleteEmoteSet(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmoteSet", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmoteSet indicates an expected call of DeleteEmoteSet.
+func (mr *MockStoreMockRecorder) DeleteEmoteSet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmoteSet", reflect.TypeOf((*MockStore)(nil).DeleteEmoteSet), ctx, id)
+}
+
+// DeleteLoginPattern mocks base method.
+func (m *MockStore) DeleteLoginPattern(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoginPattern", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoginPattern indicates an expected call of DeleteLoginPattern.
+func (mr *MockStoreMockRecorder) DeleteLoginPattern(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginPattern", reflect.TypeOf((*MockStore)(nil).DeleteLoginPattern), ctx, id)
+}
+
 // DeleteNotificationEntry mocks base method.
 func (m *MockStore) DeleteNotificationEntry(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -353,6 +591,20 @@ func (mr *MockStoreMockRecorder) DeleteNotificationEntry(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationEntry", reflect.TypeOf((*MockStore)(nil).DeleteNotificationEntry), ctx, id)
 }
 
+// DeleteNotificationSnooze mocks base method.
+func (m *MockStore) DeleteNotificationSnooze(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationSnooze", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationSnooze indicates an expected call of DeleteNotificationSnooze.
+func (mr *MockStoreMockRecorder) DeleteNotificationSnooze(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationSnooze", reflect.TypeOf((*MockStore)(nil).DeleteNotificationSnooze), ctx, id)
+}
+
 // DeleteRule mocks base method.
 func (m *MockStore) DeleteRule(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -395,6 +647,20 @@ func (mr *MockStoreMockRecorder) DeleteTwitchDiscoveryCandidate(ctx, twitchUserI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTwitchDiscoveryCandidate", reflect.TypeOf((*MockStore)(nil).DeleteTwitchDiscoveryCandidate), ctx, twitchUserID)
 }
 
+// DeleteUserLink mocks base method.
+func (m *MockStore) DeleteUserLink(ctx context.Context, userID, linkedUserID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserLink", ctx, userID, linkedUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserLink indicates an expected call of DeleteUserLink.
+func (mr *MockStoreMockRecorder) DeleteUserLink(ctx, userID, linkedUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserLink", reflect.TypeOf((*MockStore)(nil).DeleteUserLink), ctx, userID, linkedUserID)
+}
+
 // DenyDiscoveryCandidate mocks base method.
 func (m *MockStore) DenyDiscoveryCandidate(ctx context.Context, twitchUserID int64) error {
 	m.ctrl.T.Helper()
@@ -409,6 +675,35 @@ func (mr *MockStoreMockRecorder) DenyDiscoveryCandidate(ctx, twitchUserID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyDiscoveryCandidate", reflect.TypeOf((*MockStore)(nil).DenyDiscoveryCandidate), ctx, twitchUserID)
 }
 
+// EarliestChatMessageAt mocks base method.
+func (m *MockStore) EarliestChatMessageAt(ctx context.Context) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EarliestChatMessageAt", ctx)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EarliestChatMessageAt indicates an expected call of EarliestChatMessageAt.
+func (mr *MockStoreMockRecorder) EarliestChatMessageAt(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EarliestChatMessageAt", reflect.TypeOf((*MockStore)(nil).EarliestChatMessageAt), ctx)
+}
+
+// EnqueueNotificationDeliveries mocks base method.
+func (m *MockStore) EnqueueNotificationDeliveries(ctx context.Context, entryIDs []int64, ev entity.NotificationEvent, notBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueNotificationDeliveries", ctx, entryIDs, ev, notBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueNotificationDeliveries indicates an expected call of EnqueueNotificationDeliveries.
+func (mr *MockStoreMockRecorder) EnqueueNotificationDeliveries(ctx, entryIDs, ev, notBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueNotificationDeliveries", reflect.TypeOf((*MockStore)(nil).EnqueueNotificationDeliveries), ctx, entryIDs, ev, notBefore)
+}
+
 // GetAIConversation mocks base method.
 func (m *MockStore) GetAIConversation(ctx context.Context, id int64) (entity.AIConversation, error) {
 	m.ctrl.T.Helper()
@@ -439,6 +734,66 @@ func (mr *MockStoreMockRecorder) GetAISettings(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAISettings", reflect.TypeOf((*MockStore)(nil).GetAISettings), ctx)
 }
 
+// GetAudienceOverlapCache mocks base method.
+func (m *MockStore) GetAudienceOverlapCache(ctx context.Context, period entity.AudienceOverlapPeriod) (*entity.AudienceOverlap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAudienceOverlapCache", ctx, period)
+	ret0, _ := ret[0].(*entity.AudienceOverlap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAudienceOverlapCache indicates an expected call of GetAudienceOverlapCache.
+func (mr *MockStoreMockRecorder) GetAudienceOverlapCache(ctx, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAudienceOverlapCache", reflect.TypeOf((*MockStore)(nil).GetAudienceOverlapCache), ctx, period)
+}
+
+// GetBlocklist mocks base method.
+func (m *MockStore) GetBlocklist(ctx context.Context, id int64) (entity.Blocklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocklist", ctx, id)
+	ret0, _ := ret[0].(entity.Blocklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocklist indicates an expected call of GetBlocklist.
+func (mr *MockStoreMockRecorder) GetBlocklist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocklist", reflect.TypeOf((*MockStore)(nil).GetBlocklist), ctx, id)
+}
+
+// GetBotDetectionSettings mocks base method.
+func (m *MockStore) GetBotDetectionSettings(ctx context.Context) (entity.BotDetectionSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBotDetectionSettings", ctx)
+	ret0, _ := ret[0].(entity.BotDetectionSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBotDetectionSettings indicates an expected call of GetBotDetectionSettings.
+func (mr *MockStoreMockRecorder) GetBotDetectionSettings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBotDetectionSettings", reflect.TypeOf((*MockStore)(nil).GetBotDetectionSettings), ctx)
+}
+
+// GetChannelAnalyticsWatermark mocks base method.
+func (m *MockStore) GetChannelAnalyticsWatermark(ctx context.Context) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelAnalyticsWatermark", ctx)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelAnalyticsWatermark indicates an expected call of GetChannelAnalyticsWatermark.
+func (mr *MockStoreMockRecorder) GetChannelAnalyticsWatermark(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelAnalyticsWatermark", reflect.TypeOf((*MockStore)(nil).GetChannelAnalyticsWatermark), ctx)
+}
+
 // GetChannelDiscoverySettings mocks base method.
 func (m *MockStore) GetChannelDiscoverySettings(ctx context.Context) (entity.ChannelDiscoverySettings, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +809,36 @@ func (mr *MockStoreMockRecorder) GetChannelDiscoverySettings(ctx any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelDiscoverySettings", reflect.TypeOf((*MockStore)(nil).GetChannelDiscoverySettings), ctx)
 }
 
+// GetChatClassifierSettings mocks base method.
+func (m *MockStore) GetChatClassifierSettings(ctx context.Context) (entity.ChatClassifierSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatClassifierSettings", ctx)
+	ret0, _ := ret[0].(entity.ChatClassifierSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatClassifierSettings indicates an expected call of GetChatClassifierSettings.
+func (mr *MockStoreMockRecorder) GetChatClassifierSettings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatClassifierSettings", reflect.TypeOf((*MockStore)(nil).GetChatClassifierSettings), ctx)
+}
+
+// GetChatterChatToxicity mocks base method.
+func (m *MockStore) GetChatterChatToxicity(ctx context.Context, chatterTwitchUserID int64) (entity.ChatToxicity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatterChatToxicity", ctx, chatterTwitchUserID)
+	ret0, _ := ret[0].(entity.ChatToxicity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatterChatToxicity indicates an expected call of GetChatterChatToxicity.
+func (mr *MockStoreMockRecorder) GetChatterChatToxicity(ctx, chatterTwitchUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatterChatToxicity", reflect.TypeOf((*MockStore)(nil).GetChatterChatToxicity), ctx, chatterTwitchUserID)
+}
+
 // GetHelixMeta mocks base method.
 func (m *MockStore) GetHelixMeta(ctx context.Context, twitchUserID int64) (*time.Time, *time.Time, *string, error) {
 	m.ctrl.T.Helper()
@@ -486,6 +871,21 @@ func (mr *MockStoreMockRecorder) GetIrcMonitorSettings(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIrcMonitorSettings", reflect.TypeOf((*MockStore)(nil).GetIrcMonitorSettings), ctx)
 }
 
+// GetLatestChatterLabels mocks base method.
+func (m *MockStore) GetLatestChatterLabels(ctx context.Context, channel, username string, since time.Time) (*entity.ChatMessageLabels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestChatterLabels", ctx, channel, username, since)
+	ret0, _ := ret[0].(*entity.ChatMessageLabels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestChatterLabels indicates an expected call of GetLatestChatterLabels.
+func (mr *MockStoreMockRecorder) GetLatestChatterLabels(ctx, channel, username, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestChatterLabels", reflect.TypeOf((*MockStore)(nil).GetLatestChatterLabels), ctx, channel, username, since)
+}
+
 // GetMonitoredStreamByID mocks base method.
 func (m *MockStore) GetMonitoredStreamByID(ctx context.Context, id int64) (entity.Stream, error) {
 	m.ctrl.T.Helper()
@@ -501,6 +901,21 @@ func (mr *MockStoreMockRecorder) GetMonitoredStreamByID(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitoredStreamByID", reflect.TypeOf((*MockStore)(nil).GetMonitoredStreamByID), ctx, id)
 }
 
+// GetNotificationEntry mocks base method.
+func (m *MockStore) GetNotificationEntry(ctx context.Context, id int64) (entity.NotificationEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationEntry", ctx, id)
+	ret0, _ := ret[0].(entity.NotificationEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationEntry indicates an expected call of GetNotificationEntry.
+func (mr *MockStoreMockRecorder) GetNotificationEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationEntry", reflect.TypeOf((*MockStore)(nil).GetNotificationEntry), ctx, id)
+}
+
 // GetStreamByID mocks base method.
 func (m *MockStore) GetStreamByID(ctx context.Context, id int64) (entity.Stream, error) {
 	m.ctrl.T.Helper()
@@ -516,52 +931,127 @@ func (mr *MockStoreMockRecorder) GetStreamByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamByID", reflect.TypeOf((*MockStore)(nil).GetStreamByID), ctx, id)
 }
 
-// GetSuspicionSettings mocks base method.
-func (m *MockStore) GetSuspicionSettings(ctx context.Context) (entity.SuspicionSettings, error) {
+// GetStreamChatToxicity mocks base method.
+func (m *MockStore) GetStreamChatToxicity(ctx context.Context, streamID int64) (entity.ChatToxicity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSuspicionSettings", ctx)
-	ret0, _ := ret[0].(entity.SuspicionSettings)
+	ret := m.ctrl.Call(m, "GetStreamChatToxicity", ctx, streamID)
+	ret0, _ := ret[0].(entity.ChatToxicity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSuspicionSettings indicates an expected call of GetSuspicionSettings.
-func (mr *MockStoreMockRecorder) GetSuspicionSettings(ctx any) *gomock.Call {
+// GetStreamChatToxicity indicates an expected call of GetStreamChatToxicity.
+func (mr *MockStoreMockRecorder) GetStreamChatToxicity(ctx, streamID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspicionSettings", reflect.TypeOf((*MockStore)(nil).GetSuspicionSettings), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamChatToxicity", reflect.TypeOf((*MockStore)(nil).GetStreamChatToxicity), ctx, streamID)
 }
 
-// GetTwitchAccountByID mocks base method.
-func (m *MockStore) GetTwitchAccountByID(ctx context.Context, id int64) (entity.TwitchAccount, error) {
+// GetStreamRecap mocks base method.
+func (m *MockStore) GetStreamRecap(ctx context.Context, streamID int64) (*entity.StreamRecap, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTwitchAccountByID", ctx, id)
-	ret0, _ := ret[0].(entity.TwitchAccount)
+	ret := m.ctrl.Call(m, "GetStreamRecap", ctx, streamID)
+	ret0, _ := ret[0].(*entity.StreamRecap)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTwitchAccountByID indicates an expected call of GetTwitchAccountByID.
-func (mr *MockStoreMockRecorder) GetTwitchAccountByID(ctx, id any) *gomock.Call {
+// GetStreamRecap indicates an expected call of GetStreamRecap.
+func (mr *MockStoreMockRecorder) GetStreamRecap(ctx, streamID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwitchAccountByID", reflect.TypeOf((*MockStore)(nil).GetTwitchAccountByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamRecap", reflect.TypeOf((*MockStore)(nil).GetStreamRecap), ctx, streamID)
 }
 
-// GetTwitchAccountByTwitchUserID mocks base method.
-func (m *MockStore) GetTwitchAccountByTwitchUserID(ctx context.Context, twitchUserID int64) (entity.TwitchAccount, error) {
+// GetStreamRecapSettings mocks base method.
+func (m *MockStore) GetStreamRecapSettings(ctx context.Context) (entity.StreamRecapSettings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTwitchAccountByTwitchUserID", ctx, twitchUserID)
-	ret0, _ := ret[0].(entity.TwitchAccount)
+	ret := m.ctrl.Call(m, "GetStreamRecapSettings", ctx)
+	ret0, _ := ret[0].(entity.StreamRecapSettings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTwitchAccountByTwitchUserID indicates an expected call of GetTwitchAccountByTwitchUserID.
-func (mr *MockStoreMockRecorder) GetTwitchAccountByTwitchUserID(ctx, twitchUserID any) *gomock.Call {
+// GetStreamRecapSettings indicates an expected call of GetStreamRecapSettings.
+func (mr *MockStoreMockRecorder) GetStreamRecapSettings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwitchAccountByTwitchUserID", reflect.TypeOf((*MockStore)(nil).GetTwitchAccountByTwitchUserID), ctx, twitchUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamRecapSettings", reflect.TypeOf((*MockStore)(nil).GetStreamRecapSettings), ctx)
 }
 
-// GetTwitchUserByID mocks base method.
+// GetStreamRecapStats mocks base method.
+func (m *MockStore) GetStreamRecapStats(ctx context.Context, streamID int64) (entity.StreamRecap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamRecapStats", ctx, streamID)
+	ret0, _ := ret[0].(entity.StreamRecap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamRecapStats indicates an expected call of GetStreamRecapStats.
+func (mr *MockStoreMockRecorder) GetStreamRecapStats(ctx, streamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamRecapStats", reflect.TypeOf((*MockStore)(nil).GetStreamRecapStats), ctx, streamID)
+}
+
+// GetSuspicionScore mocks base method.
+func (m *MockStore) GetSuspicionScore(ctx context.Context, twitchUserID int64) (*entity.SuspicionScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuspicionScore", ctx, twitchUserID)
+	ret0, _ := ret[0].(*entity.SuspicionScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuspicionScore indicates an expected call of GetSuspicionScore.
+func (mr *MockStoreMockRecorder) GetSuspicionScore(ctx, twitchUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspicionScore", reflect.TypeOf((*MockStore)(nil).GetSuspicionScore), ctx, twitchUserID)
+}
+
+// GetSuspicionSettings mocks base method.
+func (m *MockStore) GetSuspicionSettings(ctx context.Context) (entity.SuspicionSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuspicionSettings", ctx)
+	ret0, _ := ret[0].(entity.SuspicionSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuspicionSettings indicates an expected call of GetSuspicionSettings.
+func (mr *MockStoreMockRecorder) GetSuspicionSettings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuspicionSettings", reflect.TypeOf((*MockStore)(nil).GetSuspicionSettings), ctx)
+}
+
+// GetTwitchAccountByID mocks base method.
+func (m *MockStore) GetTwitchAccountByID(ctx context.Context, id int64) (entity.TwitchAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwitchAccountByID", ctx, id)
+	ret0, _ := ret[0].(entity.TwitchAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwitchAccountByID indicates an expected call of GetTwitchAccountByID.
+func (mr *MockStoreMockRecorder) GetTwitchAccountByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwitchAccountByID", reflect.TypeOf((*MockStore)(nil).GetTwitchAccountByID), ctx, id)
+}
+
+// GetTwitchAccountByTwitchUserID mocks base method.
+func (m *MockStore) GetTwitchAccountByTwitchUserID(ctx context.Context, twitchUserID int64) (entity.TwitchAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwitchAccountByTwitchUserID", ctx, twitchUserID)
+	ret0, _ := ret[0].(entity.TwitchAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwitchAccountByTwitchUserID indicates an expected call of GetTwitchAccountByTwitchUserID.
+func (mr *MockStoreMockRecorder) GetTwitchAccountByTwitchUserID(ctx, twitchUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwitchAccountByTwitchUserID", reflect.TypeOf((*MockStore)(nil).GetTwitchAccountByTwitchUserID), ctx, twitchUserID)
+}
+
+// GetTwitchUserByID mocks base method.
 func (m *MockStore) GetTwitchUserByID(ctx context.Context, id int64) (entity.TwitchUser, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTwitchUserByID", ctx, id)
@@ -606,6 +1096,20 @@ func (mr *MockStoreMockRecorder) InsertChatMessage(ctx, channelTwitchUserID, cha
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertChatMessage", reflect.TypeOf((*MockStore)(nil).InsertChatMessage), ctx, channelTwitchUserID, chatterTwitchUserID, chatterUsername, body, keywordMatch, msgType, badgeTags, firstMessage)
 }
 
+// InsertChatMessageEmotes mocks base method.
+func (m *MockStore) InsertChatMessageEmotes(ctx context.Context, messageID int64, emotes []entity.ChatEmote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertChatMessageEmotes", ctx, messageID, emotes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertChatMessageEmotes indicates an expected call of InsertChatMessageEmotes.
+func (mr *MockStoreMockRecorder) InsertChatMessageEmotes(ctx, messageID, emotes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertChatMessageEmotes", reflect.TypeOf((*MockStore)(nil).InsertChatMessageEmotes), ctx, messageID, emotes)
+}
+
 // InsertChatMessageForChannelLogin mocks base method.
 func (m *MockStore) InsertChatMessageForChannelLogin(ctx context.Context, channelLogin string, chatterTwitchUserID *int64, chatterUsername, body string, keywordMatch bool, msgType string, badgeTags []string, firstMessage bool) (int64, error) {
 	m.ctrl.T.Helper()
@@ -621,6 +1125,21 @@ func (mr *MockStoreMockRecorder) InsertChatMessageForChannelLogin(ctx, channelLo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertChatMessageForChannelLogin", reflect.TypeOf((*MockStore)(nil).InsertChatMessageForChannelLogin), ctx, channelLogin, chatterTwitchUserID, chatterUsername, body, keywordMatch, msgType, badgeTags, firstMessage)
 }
 
+// InsertChatMoment mocks base method.
+func (m_2 *MockStore) InsertChatMoment(ctx context.Context, m entity.ChatMoment) (entity.ChatMoment, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "InsertChatMoment", ctx, m)
+	ret0, _ := ret[0].(entity.ChatMoment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertChatMoment indicates an expected call of InsertChatMoment.
+func (mr *MockStoreMockRecorder) InsertChatMoment(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertChatMoment", reflect.TypeOf((*MockStore)(nil).InsertChatMoment), ctx, m)
+}
+
 // InsertIrcJoinedSample mocks base method.
 func (m *MockStore) InsertIrcJoinedSample(ctx context.Context, joinedCount int) error {
 	m.ctrl.T.Helper()
@@ -636,17 +1155,17 @@ func (mr *MockStoreMockRecorder) InsertIrcJoinedSample(ctx, joinedCount any) *go
 }
 
 // InsertRuleTriggerEvent mocks base method.
-func (m *MockStore) InsertRuleTriggerEvent(ctx context.Context, ruleID int64, ruleName, triggerEvent, actionType, displayText string) error {
+func (m *MockStore) InsertRuleTriggerEvent(ctx context.Context, ruleID int64, ruleName, triggerEvent, actionType, channel, displayText string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertRuleTriggerEvent", ctx, ruleID, ruleName, triggerEvent, actionType, displayText)
+	ret := m.ctrl.Call(m, "InsertRuleTriggerEvent", ctx, ruleID, ruleName, triggerEvent, actionType, channel, displayText)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InsertRuleTriggerEvent indicates an expected call of InsertRuleTriggerEvent.
-func (mr *MockStoreMockRecorder) InsertRuleTriggerEvent(ctx, ruleID, ruleName, triggerEvent, actionType, displayText any) *gomock.Call {
+func (mr *MockStoreMockRecorder) InsertRuleTriggerEvent(ctx, ruleID, ruleName, triggerEvent, actionType, channel, displayText any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRuleTriggerEvent", reflect.TypeOf((*MockStore)(nil).InsertRuleTriggerEvent), ctx, ruleID, ruleName, triggerEvent, actionType, displayText)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRuleTriggerEvent", reflect.TypeOf((*MockStore)(nil).InsertRuleTriggerEvent), ctx, ruleID, ruleName, triggerEvent, actionType, channel, displayText)
 }
 
 // InsertUserActivityEvent mocks base method.
@@ -708,6 +1227,21 @@ func (mr *MockStoreMockRecorder) IsTwitchUserSuspicious(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTwitchUserSuspicious", reflect.TypeOf((*MockStore)(nil).IsTwitchUserSuspicious), ctx, id)
 }
 
+// LikelyBotIDs mocks base method.
+func (m *MockStore) LikelyBotIDs(ctx context.Context, ids []int64) (map[int64]struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikelyBotIDs", ctx, ids)
+	ret0, _ := ret[0].(map[int64]struct{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LikelyBotIDs indicates an expected call of LikelyBotIDs.
+func (mr *MockStoreMockRecorder) LikelyBotIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikelyBotIDs", reflect.TypeOf((*MockStore)(nil).LikelyBotIDs), ctx, ids)
+}
+
 // ListAIConversations mocks base method.
 func (m *MockStore) ListAIConversations(ctx context.Context) ([]entity.AIConversation, error) {
 	m.ctrl.T.Helper()
@@ -738,6 +1272,126 @@ func (mr *MockStoreMockRecorder) ListAIMessages(ctx, conversationID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAIMessages", reflect.TypeOf((*MockStore)(nil).ListAIMessages), ctx, conversationID)
 }
 
+// ListActiveNotificationSnoozes mocks base method.
+func (m *MockStore) ListActiveNotificationSnoozes(ctx context.Context, at time.Time) ([]entity.NotificationSnooze, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveNotificationSnoozes", ctx, at)
+	ret0, _ := ret[0].([]entity.NotificationSnooze)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveNotificationSnoozes indicates an expected call of ListActiveNotificationSnoozes.
+func (mr *MockStoreMockRecorder) ListActiveNotificationSnoozes(ctx, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveNotificationSnoozes", reflect.TypeOf((*MockStore)(nil).ListActiveNotificationSnoozes), ctx, at)
+}
+
+// ListAltCandidatePool mocks base method.
+func (m *MockStore) ListAltCandidatePool(ctx context.Context, userID int64, loginStem string, perSource int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAltCandidatePool", ctx, userID, loginStem, perSource)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAltCandidatePool indicates an expected call of ListAltCandidatePool.
+func (mr *MockStoreMockRecorder) ListAltCandidatePool(ctx, userID, loginStem, perSource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAltCandidatePool", reflect.TypeOf((*MockStore)(nil).ListAltCandidatePool), ctx, userID, loginStem, perSource)
+}
+
+// ListAltCandidates mocks base method.
+func (m *MockStore) ListAltCandidates(ctx context.Context, userID int64, limit int) ([]entity.AltCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAltCandidates", ctx, userID, limit)
+	ret0, _ := ret[0].([]entity.AltCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAltCandidates indicates an expected call of ListAltCandidates.
+func (mr *MockStoreMockRecorder) ListAltCandidates(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAltCandidates", reflect.TypeOf((*MockStore)(nil).ListAltCandidates), ctx, userID, limit)
+}
+
+// ListBlocklists mocks base method.
+func (m *MockStore) ListBlocklists(ctx context.Context) ([]entity.Blocklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlocklists", ctx)
+	ret0, _ := ret[0].([]entity.Blocklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlocklists indicates an expected call of ListBlocklists.
+func (mr *MockStoreMockRecorder) ListBlocklists(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlocklists", reflect.TypeOf((*MockStore)(nil).ListBlocklists), ctx)
+}
+
+// ListBotPresence mocks base method.
+func (m *MockStore) ListBotPresence(ctx context.Context, minChannels int) ([]entity.BotPresence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBotPresence", ctx, minChannels)
+	ret0, _ := ret[0].([]entity.BotPresence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBotPresence indicates an expected call of ListBotPresence.
+func (mr *MockStoreMockRecorder) ListBotPresence(ctx, minChannels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBotPresence", reflect.TypeOf((*MockStore)(nil).ListBotPresence), ctx, minChannels)
+}
+
+// ListChannelActivityMinutes mocks base method.
+func (m *MockStore) ListChannelActivityMinutes(ctx context.Context, channelTwitchUserID int64, from, to time.Time) ([]entity.ChannelActivityBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChannelActivityMinutes", ctx, channelTwitchUserID, from, to)
+	ret0, _ := ret[0].([]entity.ChannelActivityBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChannelActivityMinutes indicates an expected call of ListChannelActivityMinutes.
+func (mr *MockStoreMockRecorder) ListChannelActivityMinutes(ctx, channelTwitchUserID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelActivityMinutes", reflect.TypeOf((*MockStore)(nil).ListChannelActivityMinutes), ctx, channelTwitchUserID, from, to)
+}
+
+// ListChannelActivityRollups mocks base method.
+func (m *MockStore) ListChannelActivityRollups(ctx context.Context, channelTwitchUserID int64, bucket entity.ChannelAnalyticsBucket, from, to time.Time) ([]entity.ChannelActivityBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChannelActivityRollups", ctx, channelTwitchUserID, bucket, from, to)
+	ret0, _ := ret[0].([]entity.ChannelActivityBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChannelActivityRollups indicates an expected call of ListChannelActivityRollups.
+func (mr *MockStoreMockRecorder) ListChannelActivityRollups(ctx, channelTwitchUserID, bucket, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelActivityRollups", reflect.TypeOf((*MockStore)(nil).ListChannelActivityRollups), ctx, channelTwitchUserID, bucket, from, to)
+}
+
+// ListChannelAudience mocks base method.
+func (m *MockStore) ListChannelAudience(ctx context.Context, from, to time.Time) ([]entity.AudienceMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChannelAudience", ctx, from, to)
+	ret0, _ := ret[0].([]entity.AudienceMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChannelAudience indicates an expected call of ListChannelAudience.
+func (mr *MockStoreMockRecorder) ListChannelAudience(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelAudience", reflect.TypeOf((*MockStore)(nil).ListChannelAudience), ctx, from, to)
+}
+
 // ListChannelBlacklist mocks base method.
 func (m *MockStore) ListChannelBlacklist(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -753,6 +1407,36 @@ func (mr *MockStoreMockRecorder) ListChannelBlacklist(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelBlacklist", reflect.TypeOf((*MockStore)(nil).ListChannelBlacklist), ctx)
 }
 
+// ListChannelBotCounts mocks base method.
+func (m *MockStore) ListChannelBotCounts(ctx context.Context) ([]entity.ChannelBotCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChannelBotCounts", ctx)
+	ret0, _ := ret[0].([]entity.ChannelBotCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChannelBotCounts indicates an expected call of ListChannelBotCounts.
+func (mr *MockStoreMockRecorder) ListChannelBotCounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelBotCounts", reflect.TypeOf((*MockStore)(nil).ListChannelBotCounts), ctx)
+}
+
+// ListChannelBotEstimates mocks base method.
+func (m *MockStore) ListChannelBotEstimates(ctx context.Context) ([]entity.ChannelBotEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChannelBotEstimates", ctx)
+	ret0, _ := ret[0].([]entity.ChannelBotEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChannelBotEstimates indicates an expected call of ListChannelBotEstimates.
+func (mr *MockStoreMockRecorder) ListChannelBotEstimates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelBotEstimates", reflect.TypeOf((*MockStore)(nil).ListChannelBotEstimates), ctx)
+}
+
 // ListChannelChatterEntries mocks base method.
 func (m *MockStore) ListChannelChatterEntries(ctx context.Context, channelTwitchUserID int64) ([]entity.ChannelChatterEntry, error) {
 	m.ctrl.T.Helper()
@@ -783,6 +1467,22 @@ func (mr *MockStoreMockRecorder) ListChannelChatterIDs(ctx, channelTwitchUserID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelChatterIDs", reflect.TypeOf((*MockStore)(nil).ListChannelChatterIDs), ctx, channelTwitchUserID)
 }
 
+// ListChannelLeaderboard mocks base method.
+func (m *MockStore) ListChannelLeaderboard(ctx context.Context, channelID *int64, q entity.ChannelLeaderboardQuery) ([]entity.ChannelLeaderboardRow, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChannelLeaderboard", ctx, channelID, q)
+	ret0, _ := ret[0].([]entity.ChannelLeaderboardRow)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListChannelLeaderboard indicates an expected call of ListChannelLeaderboard.
+func (mr *MockStoreMockRecorder) ListChannelLeaderboard(ctx, channelID, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelLeaderboard", reflect.TypeOf((*MockStore)(nil).ListChannelLeaderboard), ctx, channelID, q)
+}
+
 // ListChatHistory mocks base method.
 func (m *MockStore) ListChatHistory(ctx context.Context, channel string, limit int) ([]entity.ChatHistoryMessage, error) {
 	m.ctrl.T.Helper()
@@ -813,6 +1513,22 @@ func (mr *MockStoreMockRecorder) ListChatMessages(ctx, f any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatMessages", reflect.TypeOf((*MockStore)(nil).ListChatMessages), ctx, f)
 }
 
+// ListChatMessagesForClassification mocks base method.
+func (m *MockStore) ListChatMessagesForClassification(ctx context.Context, since time.Time, limit int) ([]entity.ClassifiableChatMessage, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatMessagesForClassification", ctx, since, limit)
+	ret0, _ := ret[0].([]entity.ClassifiableChatMessage)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListChatMessagesForClassification indicates an expected call of ListChatMessagesForClassification.
+func (mr *MockStoreMockRecorder) ListChatMessagesForClassification(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatMessagesForClassification", reflect.TypeOf((*MockStore)(nil).ListChatMessagesForClassification), ctx, since, limit)
+}
+
 // ListChatterChannelPairsForFollowEnrichment mocks base method.
 func (m *MockStore) ListChatterChannelPairsForFollowEnrichment(ctx context.Context, limit int) ([]entity.ChatterChannelPair, error) {
 	m.ctrl.T.Helper()
@@ -828,6 +1544,36 @@ func (mr *MockStoreMockRecorder) ListChatterChannelPairsForFollowEnrichment(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatterChannelPairsForFollowEnrichment", reflect.TypeOf((*MockStore)(nil).ListChatterChannelPairsForFollowEnrichment), ctx, limit)
 }
 
+// ListChatterChannelPresence mocks base method.
+func (m *MockStore) ListChatterChannelPresence(ctx context.Context, chatterTwitchUserID int64) ([]entity.ChatterChannelPresence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatterChannelPresence", ctx, chatterTwitchUserID)
+	ret0, _ := ret[0].([]entity.ChatterChannelPresence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatterChannelPresence indicates an expected call of ListChatterChannelPresence.
+func (mr *MockStoreMockRecorder) ListChatterChannelPresence(ctx, chatterTwitchUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatterChannelPresence", reflect.TypeOf((*MockStore)(nil).ListChatterChannelPresence), ctx, chatterTwitchUserID)
+}
+
+// ListChattersActiveSince mocks base method.
+func (m *MockStore) ListChattersActiveSince(ctx context.Context, since time.Time, limit int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChattersActiveSince", ctx, since, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChattersActiveSince indicates an expected call of ListChattersActiveSince.
+func (mr *MockStoreMockRecorder) ListChattersActiveSince(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChattersActiveSince", reflect.TypeOf((*MockStore)(nil).ListChattersActiveSince), ctx, since, limit)
+}
+
 // ListDistinctChattersWithMessages mocks base method.
 func (m *MockStore) ListDistinctChattersWithMessages(ctx context.Context, limit int) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -843,6 +1589,36 @@ func (mr *MockStoreMockRecorder) ListDistinctChattersWithMessages(ctx, limit any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDistinctChattersWithMessages", reflect.TypeOf((*MockStore)(nil).ListDistinctChattersWithMessages), ctx, limit)
 }
 
+// ListEmoteSets mocks base method.
+func (m *MockStore) ListEmoteSets(ctx context.Context) ([]entity.EmoteSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmoteSets", ctx)
+	ret0, _ := ret[0].([]entity.EmoteSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmoteSets indicates an expected call of ListEmoteSets.
+func (mr *MockStoreMockRecorder) ListEmoteSets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmoteSets", reflect.TypeOf((*MockStore)(nil).ListEmoteSets), ctx)
+}
+
+// ListEmoteUsage mocks base method.
+func (m *MockStore) ListEmoteUsage(ctx context.Context, f entity.EmoteUsageFilter) ([]entity.EmoteUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmoteUsage", ctx, f)
+	ret0, _ := ret[0].([]entity.EmoteUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmoteUsage indicates an expected call of ListEmoteUsage.
+func (mr *MockStoreMockRecorder) ListEmoteUsage(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmoteUsage", reflect.TypeOf((*MockStore)(nil).ListEmoteUsage), ctx, f)
+}
+
 // ListEnabledNotificationEntries mocks base method.
 func (m *MockStore) ListEnabledNotificationEntries(ctx context.Context) ([]entity.NotificationEntry, error) {
 	m.ctrl.T.Helper()
@@ -873,6 +1649,21 @@ func (mr *MockStoreMockRecorder) ListFollowedMonitoredChannels(ctx, chatterID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowedMonitoredChannels", reflect.TypeOf((*MockStore)(nil).ListFollowedMonitoredChannels), ctx, chatterID)
 }
 
+// ListFollowsSyncStates mocks base method.
+func (m *MockStore) ListFollowsSyncStates(ctx context.Context, afterID int64, limit int) ([]entity.FollowsSyncState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFollowsSyncStates", ctx, afterID, limit)
+	ret0, _ := ret[0].([]entity.FollowsSyncState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowsSyncStates indicates an expected call of ListFollowsSyncStates.
+func (mr *MockStoreMockRecorder) ListFollowsSyncStates(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowsSyncStates", reflect.TypeOf((*MockStore)(nil).ListFollowsSyncStates), ctx, afterID, limit)
+}
+
 // ListIrcJoinedSamples mocks base method.
 func (m *MockStore) ListIrcJoinedSamples(ctx context.Context, from, to time.Time) ([]entity.IrcJoinedSample, error) {
 	m.ctrl.T.Helper()
@@ -882,115 +1673,295 @@ func (m *MockStore) ListIrcJoinedSamples(ctx context.Context, from, to time.Time
 	return ret0, ret1
 }
 
-// ListIrcJoinedSamples indicates an expected call of ListIrcJoinedSamples.
-func (mr *MockStoreMockRecorder) ListIrcJoinedSamples(ctx, from, to any) *gomock.Call {
+// ListIrcJoinedSamples indicates an expected call of ListIrcJoinedSamples.
+func (mr *MockStoreMockRecorder) ListIrcJoinedSamples(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIrcJoinedSamples", reflect.TypeOf((*MockStore)(nil).ListIrcJoinedSamples), ctx, from, to)
+}
+
+// ListLikelyBots mocks base method.
+func (m *MockStore) ListLikelyBots(ctx context.Context, limit int) ([]entity.LikelyBot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLikelyBots", ctx, limit)
+	ret0, _ := ret[0].([]entity.LikelyBot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLikelyBots indicates an expected call of ListLikelyBots.
+func (mr *MockStoreMockRecorder) ListLikelyBots(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLikelyBots", reflect.TypeOf((*MockStore)(nil).ListLikelyBots), ctx, limit)
+}
+
+// ListLinkedTwitchAccountUserIDs mocks base method.
+func (m *MockStore) ListLinkedTwitchAccountUserIDs(ctx context.Context) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLinkedTwitchAccountUserIDs", ctx)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLinkedTwitchAccountUserIDs indicates an expected call of ListLinkedTwitchAccountUserIDs.
+func (mr *MockStoreMockRecorder) ListLinkedTwitchAccountUserIDs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinkedTwitchAccountUserIDs", reflect.TypeOf((*MockStore)(nil).ListLinkedTwitchAccountUserIDs), ctx)
+}
+
+// ListLoginPatterns mocks base method.
+func (m *MockStore) ListLoginPatterns(ctx context.Context) ([]entity.LoginPattern, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoginPatterns", ctx)
+	ret0, _ := ret[0].([]entity.LoginPattern)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoginPatterns indicates an expected call of ListLoginPatterns.
+func (mr *MockStoreMockRecorder) ListLoginPatterns(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoginPatterns", reflect.TypeOf((*MockStore)(nil).ListLoginPatterns), ctx)
+}
+
+// ListMonitoredOrMarkedTwitchUserIDs mocks base method.
+func (m *MockStore) ListMonitoredOrMarkedTwitchUserIDs(ctx context.Context) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMonitoredOrMarkedTwitchUserIDs", ctx)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMonitoredOrMarkedTwitchUserIDs indicates an expected call of ListMonitoredOrMarkedTwitchUserIDs.
+func (mr *MockStoreMockRecorder) ListMonitoredOrMarkedTwitchUserIDs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitoredOrMarkedTwitchUserIDs", reflect.TypeOf((*MockStore)(nil).ListMonitoredOrMarkedTwitchUserIDs), ctx)
+}
+
+// ListMonitoredStreams mocks base method.
+func (m *MockStore) ListMonitoredStreams(ctx context.Context, f entity.StreamListFilter) ([]entity.Stream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMonitoredStreams", ctx, f)
+	ret0, _ := ret[0].([]entity.Stream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMonitoredStreams indicates an expected call of ListMonitoredStreams.
+func (mr *MockStoreMockRecorder) ListMonitoredStreams(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitoredStreams", reflect.TypeOf((*MockStore)(nil).ListMonitoredStreams), ctx, f)
+}
+
+// ListMonitoredTwitchUsers mocks base method.
+func (m *MockStore) ListMonitoredTwitchUsers(ctx context.Context) ([]entity.TwitchUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMonitoredTwitchUsers", ctx)
+	ret0, _ := ret[0].([]entity.TwitchUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMonitoredTwitchUsers indicates an expected call of ListMonitoredTwitchUsers.
+func (mr *MockStoreMockRecorder) ListMonitoredTwitchUsers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitoredTwitchUsers", reflect.TypeOf((*MockStore)(nil).ListMonitoredTwitchUsers), ctx)
+}
+
+// ListNotificationDeliveries mocks base method.
+func (m *MockStore) ListNotificationDeliveries(ctx context.Context, f entity.NotificationDeliveryListFilter) ([]entity.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationDeliveries", ctx, f)
+	ret0, _ := ret[0].([]entity.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationDeliveries indicates an expected call of ListNotificationDeliveries.
+func (mr *MockStoreMockRecorder) ListNotificationDeliveries(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationDeliveries", reflect.TypeOf((*MockStore)(nil).ListNotificationDeliveries), ctx, f)
+}
+
+// ListNotificationEntries mocks base method.
+func (m *MockStore) ListNotificationEntries(ctx context.Context, f entity.NotificationListFilter) ([]entity.NotificationEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationEntries", ctx, f)
+	ret0, _ := ret[0].([]entity.NotificationEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationEntries indicates an expected call of ListNotificationEntries.
+func (mr *MockStoreMockRecorder) ListNotificationEntries(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationEntries", reflect.TypeOf((*MockStore)(nil).ListNotificationEntries), ctx, f)
+}
+
+// ListRuleTriggerEvents mocks base method.
+func (m *MockStore) ListRuleTriggerEvents(ctx context.Context, f entity.RuleTriggerListFilter) ([]entity.RuleTriggerEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleTriggerEvents", ctx, f)
+	ret0, _ := ret[0].([]entity.RuleTriggerEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleTriggerEvents indicates an expected call of ListRuleTriggerEvents.
+func (mr *MockStoreMockRecorder) ListRuleTriggerEvents(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTriggerEvents", reflect.TypeOf((*MockStore)(nil).ListRuleTriggerEvents), ctx, f)
+}
+
+// ListRules mocks base method.
+func (m *MockStore) ListRules(ctx context.Context) ([]entity.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx)
+	ret0, _ := ret[0].([]entity.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockStoreMockRecorder) ListRules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockStore)(nil).ListRules), ctx)
+}
+
+// ListScopedEmotes mocks base method.
+func (m *MockStore) ListScopedEmotes(ctx context.Context) ([]entity.ScopedEmote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScopedEmotes", ctx)
+	ret0, _ := ret[0].([]entity.ScopedEmote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScopedEmotes indicates an expected call of ListScopedEmotes.
+func (mr *MockStoreMockRecorder) ListScopedEmotes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScopedEmotes", reflect.TypeOf((*MockStore)(nil).ListScopedEmotes), ctx)
+}
+
+// ListStreamBusiestMoments mocks base method.
+func (m *MockStore) ListStreamBusiestMoments(ctx context.Context, streamID int64, origin time.Time, bucket time.Duration, limit int) ([]entity.StreamRecapMoment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStreamBusiestMoments", ctx, streamID, origin, bucket, limit)
+	ret0, _ := ret[0].([]entity.StreamRecapMoment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStreamBusiestMoments indicates an expected call of ListStreamBusiestMoments.
+func (mr *MockStoreMockRecorder) ListStreamBusiestMoments(ctx, streamID, origin, bucket, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIrcJoinedSamples", reflect.TypeOf((*MockStore)(nil).ListIrcJoinedSamples), ctx, from, to)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamBusiestMoments", reflect.TypeOf((*MockStore)(nil).ListStreamBusiestMoments), ctx, streamID, origin, bucket, limit)
 }
 
-// ListLinkedTwitchAccountUserIDs mocks base method.
-func (m *MockStore) ListLinkedTwitchAccountUserIDs(ctx context.Context) ([]int64, error) {
+// ListStreamChatMoments mocks base method.
+func (m *MockStore) ListStreamChatMoments(ctx context.Context, streamID int64) ([]entity.ChatMoment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLinkedTwitchAccountUserIDs", ctx)
-	ret0, _ := ret[0].([]int64)
+	ret := m.ctrl.Call(m, "ListStreamChatMoments", ctx, streamID)
+	ret0, _ := ret[0].([]entity.ChatMoment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListLinkedTwitchAccountUserIDs indicates an expected call of ListLinkedTwitchAccountUserIDs.
-func (mr *MockStoreMockRecorder) ListLinkedTwitchAccountUserIDs(ctx any) *gomock.Call {
+// ListStreamChatMoments indicates an expected call of ListStreamChatMoments.
+func (mr *MockStoreMockRecorder) ListStreamChatMoments(ctx, streamID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinkedTwitchAccountUserIDs", reflect.TypeOf((*MockStore)(nil).ListLinkedTwitchAccountUserIDs), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamChatMoments", reflect.TypeOf((*MockStore)(nil).ListStreamChatMoments), ctx, streamID)
 }
 
-// ListMonitoredOrMarkedTwitchUserIDs mocks base method.
-func (m *MockStore) ListMonitoredOrMarkedTwitchUserIDs(ctx context.Context) ([]int64, error) {
+// ListStreamEmoteCounts mocks base method.
+func (m *MockStore) ListStreamEmoteCounts(ctx context.Context, streamID int64, origin time.Time, bucket time.Duration, emotes []entity.EmoteUsage) ([]entity.EmoteBucketCount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMonitoredOrMarkedTwitchUserIDs", ctx)
-	ret0, _ := ret[0].([]int64)
+	ret := m.ctrl.Call(m, "ListStreamEmoteCounts", ctx, streamID, origin, bucket, emotes)
+	ret0, _ := ret[0].([]entity.EmoteBucketCount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMonitoredOrMarkedTwitchUserIDs indicates an expected call of ListMonitoredOrMarkedTwitchUserIDs.
-func (mr *MockStoreMockRecorder) ListMonitoredOrMarkedTwitchUserIDs(ctx any) *gomock.Call {
+// ListStreamEmoteCounts indicates an expected call of ListStreamEmoteCounts.
+func (mr *MockStoreMockRecorder) ListStreamEmoteCounts(ctx, streamID, origin, bucket, emotes any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitoredOrMarkedTwitchUserIDs", reflect.TypeOf((*MockStore)(nil).ListMonitoredOrMarkedTwitchUserIDs), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamEmoteCounts", reflect.TypeOf((*MockStore)(nil).ListStreamEmoteCounts), ctx, streamID, origin, bucket, emotes)
 }
 
-// ListMonitoredStreams mocks base method.
-func (m *MockStore) ListMonitoredStreams(ctx context.Context, f entity.StreamListFilter) ([]entity.Stream, error) {
+// ListStreamRetention mocks base method.
+func (m *MockStore) ListStreamRetention(ctx context.Context, channelTwitchUserID int64, from, to time.Time) ([]entity.StreamRetention, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMonitoredStreams", ctx, f)
-	ret0, _ := ret[0].([]entity.Stream)
+	ret := m.ctrl.Call(m, "ListStreamRetention", ctx, channelTwitchUserID, from, to)
+	ret0, _ := ret[0].([]entity.StreamRetention)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMonitoredStreams indicates an expected call of ListMonitoredStreams.
-func (mr *MockStoreMockRecorder) ListMonitoredStreams(ctx, f any) *gomock.Call {
+// ListStreamRetention indicates an expected call of ListStreamRetention.
+func (mr *MockStoreMockRecorder) ListStreamRetention(ctx, channelTwitchUserID, from, to any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitoredStreams", reflect.TypeOf((*MockStore)(nil).ListMonitoredStreams), ctx, f)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamRetention", reflect.TypeOf((*MockStore)(nil).ListStreamRetention), ctx, channelTwitchUserID, from, to)
 }
 
-// ListMonitoredTwitchUsers mocks base method.
-func (m *MockStore) ListMonitoredTwitchUsers(ctx context.Context) ([]entity.TwitchUser, error) {
+// ListStreamTermMessages mocks base method.
+func (m *MockStore) ListStreamTermMessages(ctx context.Context, streamID int64, from, to *time.Time, contains string) ([]entity.TermMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListMonitoredTwitchUsers", ctx)
-	ret0, _ := ret[0].([]entity.TwitchUser)
+	ret := m.ctrl.Call(m, "ListStreamTermMessages", ctx, streamID, from, to, contains)
+	ret0, _ := ret[0].([]entity.TermMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListMonitoredTwitchUsers indicates an expected call of ListMonitoredTwitchUsers.
-func (mr *MockStoreMockRecorder) ListMonitoredTwitchUsers(ctx any) *gomock.Call {
+// ListStreamTermMessages indicates an expected call of ListStreamTermMessages.
+func (mr *MockStoreMockRecorder) ListStreamTermMessages(ctx, streamID, from, to, contains any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonitoredTwitchUsers", reflect.TypeOf((*MockStore)(nil).ListMonitoredTwitchUsers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamTermMessages", reflect.TypeOf((*MockStore)(nil).ListStreamTermMessages), ctx, streamID, from, to, contains)
 }
 
-// ListNotificationEntries mocks base method.
-func (m *MockStore) ListNotificationEntries(ctx context.Context, f entity.NotificationListFilter) ([]entity.NotificationEntry, error) {
+// ListStreamsPendingTerms mocks base method.
+func (m *MockStore) ListStreamsPendingTerms(ctx context.Context, limit int) ([]entity.Stream, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNotificationEntries", ctx, f)
-	ret0, _ := ret[0].([]entity.NotificationEntry)
+	ret := m.ctrl.Call(m, "ListStreamsPendingTerms", ctx, limit)
+	ret0, _ := ret[0].([]entity.Stream)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNotificationEntries indicates an expected call of ListNotificationEntries.
-func (mr *MockStoreMockRecorder) ListNotificationEntries(ctx, f any) *gomock.Call {
+// ListStreamsPendingTerms indicates an expected call of ListStreamsPendingTerms.
+func (mr *MockStoreMockRecorder) ListStreamsPendingTerms(ctx, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationEntries", reflect.TypeOf((*MockStore)(nil).ListNotificationEntries), ctx, f)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamsPendingTerms", reflect.TypeOf((*MockStore)(nil).ListStreamsPendingTerms), ctx, limit)
 }
 
-// ListRuleTriggerEvents mocks base method.
-func (m *MockStore) ListRuleTriggerEvents(ctx context.Context, f entity.RuleTriggerListFilter) ([]entity.RuleTriggerEvent, error) {
+// ListSuspicionEvents mocks base method.
+func (m *MockStore) ListSuspicionEvents(ctx context.Context, f entity.SuspicionEventListFilter) ([]entity.SuspicionEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRuleTriggerEvents", ctx, f)
-	ret0, _ := ret[0].([]entity.RuleTriggerEvent)
+	ret := m.ctrl.Call(m, "ListSuspicionEvents", ctx, f)
+	ret0, _ := ret[0].([]entity.SuspicionEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRuleTriggerEvents indicates an expected call of ListRuleTriggerEvents.
-func (mr *MockStoreMockRecorder) ListRuleTriggerEvents(ctx, f any) *gomock.Call {
+// ListSuspicionEvents indicates an expected call of ListSuspicionEvents.
+func (mr *MockStoreMockRecorder) ListSuspicionEvents(ctx, f any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTriggerEvents", reflect.TypeOf((*MockStore)(nil).ListRuleTriggerEvents), ctx, f)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSuspicionEvents", reflect.TypeOf((*MockStore)(nil).ListSuspicionEvents), ctx, f)
 }
 
-// ListRules mocks base method.
-func (m *MockStore) ListRules(ctx context.Context) ([]entity.Rule, error) {
+// ListSuspiciousTwitchUsersByIDs mocks base method.
+func (m *MockStore) ListSuspiciousTwitchUsersByIDs(ctx context.Context, ids []int64) ([]entity.StreamRecapUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRules", ctx)
-	ret0, _ := ret[0].([]entity.Rule)
+	ret := m.ctrl.Call(m, "ListSuspiciousTwitchUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entity.StreamRecapUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRules indicates an expected call of ListRules.
-func (mr *MockStoreMockRecorder) ListRules(ctx any) *gomock.Call {
+// ListSuspiciousTwitchUsersByIDs indicates an expected call of ListSuspiciousTwitchUsersByIDs.
+func (mr *MockStoreMockRecorder) ListSuspiciousTwitchUsersByIDs(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockStore)(nil).ListRules), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSuspiciousTwitchUsersByIDs", reflect.TypeOf((*MockStore)(nil).ListSuspiciousTwitchUsersByIDs), ctx, ids)
 }
 
 // ListTwitchAccounts mocks base method.
@@ -1143,6 +2114,36 @@ func (mr *MockStoreMockRecorder) ListUserFollowedChannels(ctx, followerID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserFollowedChannels", reflect.TypeOf((*MockStore)(nil).ListUserFollowedChannels), ctx, followerID)
 }
 
+// ListUserLinks mocks base method.
+func (m *MockStore) ListUserLinks(ctx context.Context, userID int64) ([]entity.UserLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserLinks", ctx, userID)
+	ret0, _ := ret[0].([]entity.UserLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserLinks indicates an expected call of ListUserLinks.
+func (mr *MockStoreMockRecorder) ListUserLinks(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserLinks", reflect.TypeOf((*MockStore)(nil).ListUserLinks), ctx, userID)
+}
+
+// MatchBlocklists mocks base method.
+func (m *MockStore) MatchBlocklists(ctx context.Context, userID int64, login string) ([]entity.BlocklistHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchBlocklists", ctx, userID, login)
+	ret0, _ := ret[0].([]entity.BlocklistHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchBlocklists indicates an expected call of MatchBlocklists.
+func (mr *MockStoreMockRecorder) MatchBlocklists(ctx, userID, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchBlocklists", reflect.TypeOf((*MockStore)(nil).MatchBlocklists), ctx, userID, login)
+}
+
 // MonitoredChannelTwitchUserID mocks base method.
 func (m *MockStore) MonitoredChannelTwitchUserID(ctx context.Context, channel string) (int64, bool, error) {
 	m.ctrl.T.Helper()
@@ -1189,6 +2190,93 @@ func (mr *MockStoreMockRecorder) PatchTwitchUser(ctx, id, patch any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchTwitchUser", reflect.TypeOf((*MockStore)(nil).PatchTwitchUser), ctx, id, patch)
 }
 
+// PruneNotificationDeliveries mocks base method.
+func (m *MockStore) PruneNotificationDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneNotificationDeliveries", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneNotificationDeliveries indicates an expected call of PruneNotificationDeliveries.
+func (mr *MockStoreMockRecorder) PruneNotificationDeliveries(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneNotificationDeliveries", reflect.TypeOf((*MockStore)(nil).PruneNotificationDeliveries), ctx, before)
+}
+
+// PruneNotificationSnoozes mocks base method.
+func (m *MockStore) PruneNotificationSnoozes(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneNotificationSnoozes", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneNotificationSnoozes indicates an expected call of PruneNotificationSnoozes.
+func (mr *MockStoreMockRecorder) PruneNotificationSnoozes(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneNotificationSnoozes", reflect.TypeOf((*MockStore)(nil).PruneNotificationSnoozes), ctx, before)
+}
+
+// RecordBlocklistSync mocks base method.
+func (m *MockStore) RecordBlocklistSync(ctx context.Context, id int64, syncedAt time.Time, syncErr *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordBlocklistSync", ctx, id, syncedAt, syncErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBlocklistSync indicates an expected call of RecordBlocklistSync.
+func (mr *MockStoreMockRecorder) RecordBlocklistSync(ctx, id, syncedAt, syncErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBlocklistSync", reflect.TypeOf((*MockStore)(nil).RecordBlocklistSync), ctx, id, syncedAt, syncErr)
+}
+
+// RecordChatterFirstSeen mocks base method.
+func (m *MockStore) RecordChatterFirstSeen(ctx context.Context, channelTwitchUserID, chatterTwitchUserID int64, at time.Time) (entity.ChatterFirstSeen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordChatterFirstSeen", ctx, channelTwitchUserID, chatterTwitchUserID, at)
+	ret0, _ := ret[0].(entity.ChatterFirstSeen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordChatterFirstSeen indicates an expected call of RecordChatterFirstSeen.
+func (mr *MockStoreMockRecorder) RecordChatterFirstSeen(ctx, channelTwitchUserID, chatterTwitchUserID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChatterFirstSeen", reflect.TypeOf((*MockStore)(nil).RecordChatterFirstSeen), ctx, channelTwitchUserID, chatterTwitchUserID, at)
+}
+
+// RecordSuppressedNotificationDeliveries mocks base method.
+func (m *MockStore) RecordSuppressedNotificationDeliveries(ctx context.Context, entryIDs []int64, ev entity.NotificationEvent, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSuppressedNotificationDeliveries", ctx, entryIDs, ev, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSuppressedNotificationDeliveries indicates an expected call of RecordSuppressedNotificationDeliveries.
+func (mr *MockStoreMockRecorder) RecordSuppressedNotificationDeliveries(ctx, entryIDs, ev, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSuppressedNotificationDeliveries", reflect.TypeOf((*MockStore)(nil).RecordSuppressedNotificationDeliveries), ctx, entryIDs, ev, reason)
+}
+
+// RefreshStreamRetention mocks base method.
+func (m *MockStore) RefreshStreamRetention(ctx context.Context, since time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshStreamRetention", ctx, since)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshStreamRetention indicates an expected call of RefreshStreamRetention.
+func (mr *MockStoreMockRecorder) RefreshStreamRetention(ctx, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshStreamRetention", reflect.TypeOf((*MockStore)(nil).RefreshStreamRetention), ctx, since)
+}
+
 // RemoveChannelBlacklist mocks base method.
 func (m *MockStore) RemoveChannelBlacklist(ctx context.Context, login string) error {
 	m.ctrl.T.Helper()
@@ -1203,6 +2291,62 @@ func (mr *MockStoreMockRecorder) RemoveChannelBlacklist(ctx, login any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChannelBlacklist", reflect.TypeOf((*MockStore)(nil).RemoveChannelBlacklist), ctx, login)
 }
 
+// ReplaceAltCandidates mocks base method.
+func (m *MockStore) ReplaceAltCandidates(ctx context.Context, userID int64, cands []entity.AltCandidate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAltCandidates", ctx, userID, cands)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceAltCandidates indicates an expected call of ReplaceAltCandidates.
+func (mr *MockStoreMockRecorder) ReplaceAltCandidates(ctx, userID, cands any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAltCandidates", reflect.TypeOf((*MockStore)(nil).ReplaceAltCandidates), ctx, userID, cands)
+}
+
+// ReplaceBlocklistEntries mocks base method.
+func (m *MockStore) ReplaceBlocklistEntries(ctx context.Context, id int64, entries []entity.BlocklistEntry, sourceFilename *string, contentSHA256 string, importedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceBlocklistEntries", ctx, id, entries, sourceFilename, contentSHA256, importedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceBlocklistEntries indicates an expected call of ReplaceBlocklistEntries.
+func (mr *MockStoreMockRecorder) ReplaceBlocklistEntries(ctx, id, entries, sourceFilename, contentSHA256, importedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBlocklistEntries", reflect.TypeOf((*MockStore)(nil).ReplaceBlocklistEntries), ctx, id, entries, sourceFilename, contentSHA256, importedAt)
+}
+
+// ReplaceChannelActivityRollups mocks base method.
+func (m *MockStore) ReplaceChannelActivityRollups(ctx context.Context, from, to time.Time, presence []entity.ChannelActivityBucket, chatterPresence []entity.ChatterDayPresence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceChannelActivityRollups", ctx, from, to, presence, chatterPresence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceChannelActivityRollups indicates an expected call of ReplaceChannelActivityRollups.
+func (mr *MockStoreMockRecorder) ReplaceChannelActivityRollups(ctx, from, to, presence, chatterPresence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceChannelActivityRollups", reflect.TypeOf((*MockStore)(nil).ReplaceChannelActivityRollups), ctx, from, to, presence, chatterPresence)
+}
+
+// ReplaceChannelBotEstimates mocks base method.
+func (m *MockStore) ReplaceChannelBotEstimates(ctx context.Context, estimates []entity.ChannelBotEstimate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceChannelBotEstimates", ctx, estimates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceChannelBotEstimates indicates an expected call of ReplaceChannelBotEstimates.
+func (mr *MockStoreMockRecorder) ReplaceChannelBotEstimates(ctx, estimates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceChannelBotEstimates", reflect.TypeOf((*MockStore)(nil).ReplaceChannelBotEstimates), ctx, estimates)
+}
+
 // ReplaceChannelChattersSnapshot mocks base method.
 func (m *MockStore) ReplaceChannelChattersSnapshot(ctx context.Context, channelTwitchUserID int64, chatterIDs []int64) error {
 	m.ctrl.T.Helper()
@@ -1217,6 +2361,20 @@ func (mr *MockStoreMockRecorder) ReplaceChannelChattersSnapshot(ctx, channelTwit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceChannelChattersSnapshot", reflect.TypeOf((*MockStore)(nil).ReplaceChannelChattersSnapshot), ctx, channelTwitchUserID, chatterIDs)
 }
 
+// ReplaceStreamTerms mocks base method.
+func (m *MockStore) ReplaceStreamTerms(ctx context.Context, streamID int64, messages int, terms []entity.TermCount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceStreamTerms", ctx, streamID, messages, terms)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceStreamTerms indicates an expected call of ReplaceStreamTerms.
+func (mr *MockStoreMockRecorder) ReplaceStreamTerms(ctx, streamID, messages, terms any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceStreamTerms", reflect.TypeOf((*MockStore)(nil).ReplaceStreamTerms), ctx, streamID, messages, terms)
+}
+
 // ReplaceUserFollowedChannels mocks base method.
 func (m *MockStore) ReplaceUserFollowedChannels(ctx context.Context, followerID int64, rows []entity.FollowedChannelRow) error {
 	m.ctrl.T.Helper()
@@ -1231,6 +2389,78 @@ func (mr *MockStoreMockRecorder) ReplaceUserFollowedChannels(ctx, followerID, ro
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUserFollowedChannels", reflect.TypeOf((*MockStore)(nil).ReplaceUserFollowedChannels), ctx, followerID, rows)
 }
 
+// RequeueNotificationDelivery mocks base method.
+func (m *MockStore) RequeueNotificationDelivery(ctx context.Context, id int64) (entity.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueNotificationDelivery", ctx, id)
+	ret0, _ := ret[0].(entity.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueNotificationDelivery indicates an expected call of RequeueNotificationDelivery.
+func (mr *MockStoreMockRecorder) RequeueNotificationDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueNotificationDelivery", reflect.TypeOf((*MockStore)(nil).RequeueNotificationDelivery), ctx, id)
+}
+
+// SaveAudienceOverlapCache mocks base method.
+func (m *MockStore) SaveAudienceOverlapCache(ctx context.Context, o entity.AudienceOverlap) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAudienceOverlapCache", ctx, o)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAudienceOverlapCache indicates an expected call of SaveAudienceOverlapCache.
+func (mr *MockStoreMockRecorder) SaveAudienceOverlapCache(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAudienceOverlapCache", reflect.TypeOf((*MockStore)(nil).SaveAudienceOverlapCache), ctx, o)
+}
+
+// SaveChatClassification mocks base method.
+func (m *MockStore) SaveChatClassification(ctx context.Context, labels []entity.ChatMessageLabels, cursor int64, day time.Time, tokens int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveChatClassification", ctx, labels, cursor, day, tokens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveChatClassification indicates an expected call of SaveChatClassification.
+func (mr *MockStoreMockRecorder) SaveChatClassification(ctx, labels, cursor, day, tokens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChatClassification", reflect.TypeOf((*MockStore)(nil).SaveChatClassification), ctx, labels, cursor, day, tokens)
+}
+
+// SaveEmoteSet mocks base method.
+func (m *MockStore) SaveEmoteSet(ctx context.Context, s entity.EmoteSet, emotes []entity.EmoteSetEmote) (entity.EmoteSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEmoteSet", ctx, s, emotes)
+	ret0, _ := ret[0].(entity.EmoteSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveEmoteSet indicates an expected call of SaveEmoteSet.
+func (mr *MockStoreMockRecorder) SaveEmoteSet(ctx, s, emotes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEmoteSet", reflect.TypeOf((*MockStore)(nil).SaveEmoteSet), ctx, s, emotes)
+}
+
+// SaveStreamRecap mocks base method.
+func (m *MockStore) SaveStreamRecap(ctx context.Context, recap entity.StreamRecap) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStreamRecap", ctx, recap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStreamRecap indicates an expected call of SaveStreamRecap.
+func (mr *MockStoreMockRecorder) SaveStreamRecap(ctx, recap any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStreamRecap", reflect.TypeOf((*MockStore)(nil).SaveStreamRecap), ctx, recap)
+}
+
 // SetAIMessageMetadata mocks base method.
 func (m *MockStore) SetAIMessageMetadata(ctx context.Context, messageID int64, metadata map[string]any) error {
 	m.ctrl.T.Helper()
@@ -1303,6 +2533,20 @@ func (mr *MockStoreMockRecorder) TwitchUserIDByUsername(ctx, username any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TwitchUserIDByUsername", reflect.TypeOf((*MockStore)(nil).TwitchUserIDByUsername), ctx, username)
 }
 
+// UpdateBotDetectionSettings mocks base method.
+func (m *MockStore) UpdateBotDetectionSettings(ctx context.Context, s entity.BotDetectionSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBotDetectionSettings", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBotDetectionSettings indicates an expected call of UpdateBotDetectionSettings.
+func (mr *MockStoreMockRecorder) UpdateBotDetectionSettings(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBotDetectionSettings", reflect.TypeOf((*MockStore)(nil).UpdateBotDetectionSettings), ctx, s)
+}
+
 // UpdateChannelDiscoverySettings mocks base method.
 func (m *MockStore) UpdateChannelDiscoverySettings(ctx context.Context, s entity.ChannelDiscoverySettings) error {
 	m.ctrl.T.Helper()
@@ -1317,6 +2561,20 @@ func (mr *MockStoreMockRecorder) UpdateChannelDiscoverySettings(ctx, s any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannelDiscoverySettings", reflect.TypeOf((*MockStore)(nil).UpdateChannelDiscoverySettings), ctx, s)
 }
 
+// UpdateChatClassifierSettings mocks base method.
+func (m *MockStore) UpdateChatClassifierSettings(ctx context.Context, s entity.ChatClassifierSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChatClassifierSettings", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChatClassifierSettings indicates an expected call of UpdateChatClassifierSettings.
+func (mr *MockStoreMockRecorder) UpdateChatClassifierSettings(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatClassifierSettings", reflect.TypeOf((*MockStore)(nil).UpdateChatClassifierSettings), ctx, s)
+}
+
 // UpdateIrcMonitorSettings mocks base method.
 func (m *MockStore) UpdateIrcMonitorSettings(ctx context.Context, s entity.IrcMonitorSettings) error {
 	m.ctrl.T.Helper()
@@ -1332,18 +2590,18 @@ func (mr *MockStoreMockRecorder) UpdateIrcMonitorSettings(ctx, s any) *gomock.Ca
 }
 
 // UpdateNotificationEntry mocks base method.
-func (m *MockStore) UpdateNotificationEntry(ctx context.Context, id int64, provider *string, settings map[string]any, enabled *bool) (entity.NotificationEntry, error) {
+func (m *MockStore) UpdateNotificationEntry(ctx context.Context, id int64, provider *string, settings map[string]any, enabled *bool, routing *entity.NotificationRouting, policy *entity.NotificationPolicy) (entity.NotificationEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNotificationEntry", ctx, id, provider, settings, enabled)
+	ret := m.ctrl.Call(m, "UpdateNotificationEntry", ctx, id, provider, settings, enabled, routing, policy)
 	ret0, _ := ret[0].(entity.NotificationEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateNotificationEntry indicates an expected call of UpdateNotificationEntry.
-func (mr *MockStoreMockRecorder) UpdateNotificationEntry(ctx, id, provider, settings, enabled any) *gomock.Call {
+func (mr *MockStoreMockRecorder) UpdateNotificationEntry(ctx, id, provider, settings, enabled, routing, policy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationEntry", reflect.TypeOf((*MockStore)(nil).UpdateNotificationEntry), ctx, id, provider, settings, enabled)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationEntry", reflect.TypeOf((*MockStore)(nil).UpdateNotificationEntry), ctx, id, provider, settings, enabled, routing, policy)
 }
 
 // UpdateRule mocks base method.
@@ -1361,6 +2619,20 @@ func (mr *MockStoreMockRecorder) UpdateRule(ctx, id, r any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockStore)(nil).UpdateRule), ctx, id, r)
 }
 
+// UpdateStreamRecapSettings mocks base method.
+func (m *MockStore) UpdateStreamRecapSettings(ctx context.Context, s entity.StreamRecapSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStreamRecapSettings", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStreamRecapSettings indicates an expected call of UpdateStreamRecapSettings.
+func (mr *MockStoreMockRecorder) UpdateStreamRecapSettings(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStreamRecapSettings", reflect.TypeOf((*MockStore)(nil).UpdateStreamRecapSettings), ctx, s)
+}
+
 // UpdateSuspicionSettings mocks base method.
 func (m *MockStore) UpdateSuspicionSettings(ctx context.Context, s entity.SuspicionSettings) error {
 	m.ctrl.T.Helper()
@@ -1432,6 +2704,20 @@ func (mr *MockStoreMockRecorder) UpsertChannelFollow(ctx, chatterID, channelID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertChannelFollow", reflect.TypeOf((*MockStore)(nil).UpsertChannelFollow), ctx, chatterID, channelID, followedAt, checkedAt)
 }
 
+// UpsertFollowsSyncMeta mocks base method.
+func (m *MockStore) UpsertFollowsSyncMeta(ctx context.Context, twitchUserID int64, total int, syncedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFollowsSyncMeta", ctx, twitchUserID, total, syncedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFollowsSyncMeta indicates an expected call of UpsertFollowsSyncMeta.
+func (mr *MockStoreMockRecorder) UpsertFollowsSyncMeta(ctx, twitchUserID, total, syncedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFollowsSyncMeta", reflect.TypeOf((*MockStore)(nil).UpsertFollowsSyncMeta), ctx, twitchUserID, total, syncedAt)
+}
+
 // UpsertHelixMeta mocks base method.
 func (m *MockStore) UpsertHelixMeta(ctx context.Context, twitchUserID int64, accountCreatedAt *time.Time, profileImageURL *string, fetchedAt time.Time) error {
 	m.ctrl.T.Helper()
@@ -1446,6 +2732,20 @@ func (mr *MockStoreMockRecorder) UpsertHelixMeta(ctx, twitchUserID, accountCreat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertHelixMeta", reflect.TypeOf((*MockStore)(nil).UpsertHelixMeta), ctx, twitchUserID, accountCreatedAt, profileImageURL, fetchedAt)
 }
 
+// UpsertLikelyBots mocks base method.
+func (m *MockStore) UpsertLikelyBots(ctx context.Context, bots []entity.BotPresence, detectedAt, staleBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertLikelyBots", ctx, bots, detectedAt, staleBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertLikelyBots indicates an expected call of UpsertLikelyBots.
+func (mr *MockStoreMockRecorder) UpsertLikelyBots(ctx, bots, detectedAt, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLikelyBots", reflect.TypeOf((*MockStore)(nil).UpsertLikelyBots), ctx, bots, detectedAt, staleBefore)
+}
+
 // UpsertStreamFromHelix mocks base method.
 func (m *MockStore) UpsertStreamFromHelix(ctx context.Context, channelTwitchUserID int64, helixStreamID string, startedAt time.Time, title, gameName string, viewerCount *int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1461,6 +2761,20 @@ func (mr *MockStoreMockRecorder) UpsertStreamFromHelix(ctx, channelTwitchUserID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertStreamFromHelix", reflect.TypeOf((*MockStore)(nil).UpsertStreamFromHelix), ctx, channelTwitchUserID, helixStreamID, startedAt, title, gameName, viewerCount)
 }
 
+// UpsertSuspicionScore mocks base method.
+func (m *MockStore) UpsertSuspicionScore(ctx context.Context, s entity.SuspicionScore) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSuspicionScore", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSuspicionScore indicates an expected call of UpsertSuspicionScore.
+func (mr *MockStoreMockRecorder) UpsertSuspicionScore(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSuspicionScore", reflect.TypeOf((*MockStore)(nil).UpsertSuspicionScore), ctx, s)
+}
+
 // UpsertTwitchDiscoveryCandidate mocks base method.
 func (m *MockStore) UpsertTwitchDiscoveryCandidate(ctx context.Context, twitchUserID int64, viewerCount *int64, title, gameName *string, streamTags []string) error {
 	m.ctrl.T.Helper()
@@ -1489,3 +2803,18 @@ func (mr *MockStoreMockRecorder) UpsertTwitchUserFromChat(ctx, id, username any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTwitchUserFromChat", reflect.TypeOf((*MockStore)(nil).UpsertTwitchUserFromChat), ctx, id, username)
 }
+
+// UpsertUserLink mocks base method.
+func (m *MockStore) UpsertUserLink(ctx context.Context, l entity.UserLink) (entity.UserLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUserLink", ctx, l)
+	ret0, _ := ret[0].(entity.UserLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertUserLink indicates an expected call of UpsertUserLink.
+func (mr *MockStoreMockRecorder) UpsertUserLink(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUserLink", reflect.TypeOf((*MockStore)(nil).UpsertUserLink), ctx, l)
+}
@@ -233,6 +233,39 @@ func (r *Repository) ListChannelChatterEntries(ctx context.Context, channelTwitc
 	return out, rows.Err()
 }
 
+// ListChatterChannelPresence returns the channels a chatter is currently present in, longest first.
+func (r *Repository) ListChatterChannelPresence(ctx context.Context, chatterTwitchUserID int64) ([]entity.ChatterChannelPresence, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_chatter_channel_presence")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.username, c.present_since
+		FROM channel_chatters c
+		INNER JOIN twitch_users u ON u.id = c.channel_twitch_user_id
+		WHERE c.chatter_twitch_user_id = $1
+		ORDER BY c.present_since ASC, u.username ASC
+	`, chatterTwitchUserID)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list chatter channel presence failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.ChatterChannelPresence
+
+	for rows.Next() {
+		var p entity.ChatterChannelPresence
+
+		if err := rows.Scan(&p.ChannelLogin, &p.PresentSince); err != nil {
+			return nil, err
+		}
+
+		out = append(out, p)
+	}
+
+	return out, rows.Err()
+}
+
 // InsertUserActivityEvent appends one activity row.
 func (r *Repository) InsertUserActivityEvent(ctx context.Context, chatterID int64, eventType string, channelTwitchUserID *int64, details map[string]any) error {
 	ctx, span := r.obs.StartSpan(ctx, "repo.insert_user_activity_event")
@@ -264,6 +297,23 @@ func (r *Repository) InsertUserActivityEvent(ctx context.Context, chatterID int6
 	return err
 }
 
+// CountUserActivityEventsByType counts a chatter's activity rows with any of eventTypes.
+func (r *Repository) CountUserActivityEventsByType(ctx context.Context, chatterID int64, eventTypes []string) (int64, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.count_user_activity_events_by_type")
+	defer span.End()
+
+	var n int64
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT count(*) FROM user_activity_events WHERE chatter_twitch_user_id = $1 AND event_type = ANY($2)
+	`, chatterID, eventTypes).Scan(&n)
+	if err != nil {
+		r.obs.LogError(ctx, span, "count user activity events failed", err)
+	}
+
+	return n, err
+}
+
 // ListUserActivityEvents lists activity for a chatter (newest first).
 func (r *Repository) ListUserActivityEvents(ctx context.Context, f entity.UserActivityListFilter) ([]entity.UserActivityEvent, error) {
 	ctx, span := r.obs.StartSpan(ctx, "repo.list_user_activity_events")
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// CountChatMessagesByHourOfWeek counts chat messages in [from, to) per local weekday (Monday first) and hour in
+// timezone, for one chatter and/or one channel (nil matches any).
+func (r *Repository) CountChatMessagesByHourOfWeek(
+	ctx context.Context,
+	chatterID, channelID *int64,
+	from, to time.Time,
+	timezone string,
+) (entity.HourOfWeekMatrix, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.count_chat_messages_by_hour_of_week")
+	defer span.End()
+
+	var out entity.HourOfWeekMatrix
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT extract(isodow FROM created_at AT TIME ZONE $5)::int - 1 AS day,
+			extract(hour FROM created_at AT TIME ZONE $5)::int AS hour,
+			count(*)
+		FROM chat_messages
+		WHERE created_at >= $3 AND created_at < $4
+		  AND ($1::bigint IS NULL OR chatter_twitch_user_id = $1)
+		  AND ($2::bigint IS NULL OR twitch_user_id = $2)
+		GROUP BY day, hour
+	`, chatterID, channelID, from, to, timezone)
+	if err != nil {
+		r.obs.LogError(ctx, span, "count chat messages by hour of week failed", err, zap.String("timezone", timezone))
+		return out, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			day, hour int
+			n         int64
+		)
+
+		if err := rows.Scan(&day, &hour, &n); err != nil {
+			return out, err
+		}
+
+		out[day][hour] = n
+	}
+
+	return out, rows.Err()
+}
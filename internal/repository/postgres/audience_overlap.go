@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListChannelAudience returns every monitored channel's audience in [from, to): chatters with their message count,
+// and users with IRC presence (a chat_online event in the window, or a channel_chatters row seen in it).
+func (r *Repository) ListChannelAudience(ctx context.Context, from, to time.Time) ([]entity.AudienceMember, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_channel_audience")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		WITH monitored AS (
+			SELECT id FROM twitch_users WHERE monitored = true
+		), msgs AS (
+			SELECT twitch_user_id AS channel_id, chatter_twitch_user_id AS user_id, count(*) AS n
+			FROM chat_messages
+			WHERE created_at >= $1 AND created_at < $2
+			  AND chatter_twitch_user_id IS NOT NULL
+			  AND twitch_user_id IN (SELECT id FROM monitored)
+			GROUP BY 1, 2
+		), presence AS (
+			SELECT channel_twitch_user_id AS channel_id, chatter_twitch_user_id AS user_id
+			FROM user_activity_events
+			WHERE event_type = $3 AND created_at >= $1 AND created_at < $2
+			  AND channel_twitch_user_id IN (SELECT id FROM monitored)
+			UNION
+			SELECT channel_twitch_user_id, chatter_twitch_user_id
+			FROM channel_chatters
+			WHERE present_since < $2 AND updated_at >= $1
+			  AND channel_twitch_user_id IN (SELECT id FROM monitored)
+		)
+		SELECT COALESCE(m.channel_id, p.channel_id), u.id, u.username, COALESCE(m.n, 0), p.user_id IS NOT NULL
+		FROM msgs m
+		FULL JOIN presence p ON p.channel_id = m.channel_id AND p.user_id = m.user_id
+		JOIN twitch_users u ON u.id = COALESCE(m.user_id, p.user_id)
+	`, from, to, entity.UserActivityChatOnline)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list channel audience failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.AudienceMember
+
+	for rows.Next() {
+		var m entity.AudienceMember
+		if err := rows.Scan(&m.ChannelTwitchUserID, &m.TwitchUserID, &m.Login, &m.Messages, &m.Present); err != nil {
+			return nil, err
+		}
+
+		out = append(out, m)
+	}
+
+	return out, rows.Err()
+}
+
+// CountFollowedChannelsAmong returns the channels most followed by the given users (GQL-synced follows), with
+// how many of them follow each one.
+func (r *Repository) CountFollowedChannelsAmong(ctx context.Context, followerIDs []int64, limit int) ([]entity.AudienceCommunityChannel, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.count_followed_channels_among")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT f.followed_channel_id, f.followed_channel_login, COALESCE(bool_or(u.monitored), false), count(*)
+		FROM user_followed_channels f
+		LEFT JOIN twitch_users u ON u.id = f.followed_channel_id
+		WHERE f.follower_twitch_user_id = ANY($1)
+		GROUP BY f.followed_channel_id, f.followed_channel_login
+		ORDER BY count(*) DESC, f.followed_channel_login ASC
+		LIMIT $2
+	`, followerIDs, limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "count followed channels failed", err, zap.Int("followers", len(followerIDs)))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.AudienceCommunityChannel
+
+	for rows.Next() {
+		var c entity.AudienceCommunityChannel
+		if err := rows.Scan(&c.ChannelTwitchUserID, &c.Login, &c.Monitored, &c.Followers); err != nil {
+			return nil, err
+		}
+
+		out = append(out, c)
+	}
+
+	return out, rows.Err()
+}
+
+// GetAudienceOverlapCache returns the cached overlap for a period (nil when never computed).
+func (r *Repository) GetAudienceOverlapCache(ctx context.Context, period entity.AudienceOverlapPeriod) (*entity.AudienceOverlap, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_audience_overlap_cache")
+	defer span.End()
+
+	var raw []byte
+
+	err := r.pool.QueryRow(ctx, `SELECT result FROM audience_overlap_cache WHERE period = $1`, string(period)).Scan(&raw)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		r.obs.LogError(ctx, span, "get audience overlap cache failed", err, zap.String("period", string(period)))
+		return nil, err
+	}
+
+	var o entity.AudienceOverlap
+	if err := json.Unmarshal(raw, &o); err != nil {
+		r.obs.LogError(ctx, span, "decode audience overlap cache failed", err, zap.String("period", string(period)))
+		return nil, err
+	}
+
+	return &o, nil
+}
+
+// SaveAudienceOverlapCache replaces the cached overlap for o.Period.
+func (r *Repository) SaveAudienceOverlapCache(ctx context.Context, o entity.AudienceOverlap) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.save_audience_overlap_cache")
+	defer span.End()
+
+	raw, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, `
+		INSERT INTO audience_overlap_cache (period, computed_at, result)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (period) DO UPDATE SET computed_at = EXCLUDED.computed_at, result = EXCLUDED.result
+	`, string(o.Period), o.ComputedAt, raw); err != nil {
+		r.obs.LogError(ctx, span, "save audience overlap cache failed", err, zap.String("period", string(o.Period)))
+		return err
+	}
+
+	return nil
+}
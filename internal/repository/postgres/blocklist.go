@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const blocklistColumns = `id, name, format, source_url, sync_interval_minutes, enabled, source_filename, content_sha256,
+	entry_count, last_imported_at, last_synced_at, last_sync_error, created_at`
+
+func scanBlocklist(row pgx.Row) (entity.Blocklist, error) {
+	var b entity.Blocklist
+
+	err := row.Scan(&b.ID, &b.Name, &b.Format, &b.SourceURL, &b.SyncIntervalMinutes, &b.Enabled, &b.SourceFilename,
+		&b.ContentSHA256, &b.EntryCount, &b.LastImportedAt, &b.LastSyncedAt, &b.LastSyncError, &b.CreatedAt)
+
+	return b, err
+}
+
+// ListBlocklists returns all external blocklists by name.
+func (r *Repository) ListBlocklists(ctx context.Context) ([]entity.Blocklist, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_blocklists")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `SELECT `+blocklistColumns+` FROM blocklists ORDER BY name ASC`)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list blocklists failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.Blocklist
+
+	for rows.Next() {
+		b, err := scanBlocklist(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, b)
+	}
+
+	return out, rows.Err()
+}
+
+// GetBlocklist returns one blocklist or entity.ErrBlocklistNotFound.
+func (r *Repository) GetBlocklist(ctx context.Context, id int64) (entity.Blocklist, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_blocklist")
+	defer span.End()
+
+	b, err := scanBlocklist(r.pool.QueryRow(ctx, `SELECT `+blocklistColumns+` FROM blocklists WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Blocklist{}, entity.ErrBlocklistNotFound
+		}
+
+		r.obs.LogError(ctx, span, "get blocklist failed", err, zap.Int64("id", id))
+		return entity.Blocklist{}, err
+	}
+
+	return b, nil
+}
+
+// CreateBlocklist inserts an empty blocklist definition.
+func (r *Repository) CreateBlocklist(ctx context.Context, b entity.Blocklist) (entity.Blocklist, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.create_blocklist")
+	defer span.End()
+
+	out, err := scanBlocklist(r.pool.QueryRow(ctx, `
+		INSERT INTO blocklists (name, format, source_url, sync_interval_minutes, enabled)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+blocklistColumns,
+		b.Name, b.Format, b.SourceURL, b.SyncIntervalMinutes, b.Enabled))
+	if err != nil {
+		r.obs.LogError(ctx, span, "create blocklist failed", err, zap.String("name", b.Name))
+		return entity.Blocklist{}, err
+	}
+
+	return out, nil
+}
+
+// DeleteBlocklist removes a blocklist and its entries.
+func (r *Repository) DeleteBlocklist(ctx context.Context, id int64) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.delete_blocklist")
+	defer span.End()
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM blocklists WHERE id = $1`, id)
+	if err != nil {
+		r.obs.LogError(ctx, span, "delete blocklist failed", err, zap.Int64("id", id))
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrBlocklistNotFound
+	}
+
+	return nil
+}
+
+// ReplaceBlocklistEntries swaps a blocklist's entries for a new import and records its provenance
+// (sourceFilename is nil for URL pulls). Transactional.
+func (r *Repository) ReplaceBlocklistEntries(
+	ctx context.Context,
+	id int64,
+	entries []entity.BlocklistEntry,
+	sourceFilename *string,
+	contentSHA256 string,
+	importedAt time.Time,
+) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.replace_blocklist_entries")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin replace blocklist entries failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE blocklists
+		SET source_filename = $2, content_sha256 = $3, entry_count = $4, last_imported_at = $5
+		WHERE id = $1
+	`, id, sourceFilename, contentSHA256, len(entries), importedAt)
+	if err != nil {
+		r.obs.LogError(ctx, span, "update blocklist import failed", err, zap.Int64("id", id))
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrBlocklistNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM blocklist_entries WHERE blocklist_id = $1`, id); err != nil {
+		r.obs.LogError(ctx, span, "delete blocklist entries failed", err, zap.Int64("id", id))
+		return err
+	}
+
+	logins := make([]string, len(entries))
+	ids := make([]int64, len(entries))
+
+	for i, e := range entries {
+		logins[i] = e.Login
+		ids[i] = e.TwitchUserID
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO blocklist_entries (blocklist_id, twitch_login, twitch_user_id)
+		SELECT $1, NULLIF(l, ''), NULLIF(u, 0) FROM unnest($2::text[], $3::bigint[]) AS t(l, u)
+	`, id, logins, ids); err != nil {
+		r.obs.LogError(ctx, span, "insert blocklist entries failed", err, zap.Int64("id", id))
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit replace blocklist entries failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// RecordBlocklistSync stores the outcome of a URL pull (syncErr nil on success).
+func (r *Repository) RecordBlocklistSync(ctx context.Context, id int64, syncedAt time.Time, syncErr *string) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.record_blocklist_sync")
+	defer span.End()
+
+	if _, err := r.pool.Exec(ctx, `
+		UPDATE blocklists SET last_synced_at = $2, last_sync_error = $3 WHERE id = $1
+	`, id, syncedAt, syncErr); err != nil {
+		r.obs.LogError(ctx, span, "record blocklist sync failed", err, zap.Int64("id", id))
+		return err
+	}
+
+	return nil
+}
+
+// MatchBlocklists returns the enabled blocklists listing userID or login (by name; an id match wins over login).
+func (r *Repository) MatchBlocklists(ctx context.Context, userID int64, login string) ([]entity.BlocklistHit, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.match_blocklists")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT b.id, b.name, CASE WHEN bool_or(e.twitch_user_id = $1) THEN 'id' ELSE 'login' END
+		FROM blocklist_entries e
+		JOIN blocklists b ON b.id = e.blocklist_id
+		WHERE b.enabled AND (e.twitch_user_id = $1 OR e.twitch_login = lower($2))
+		GROUP BY b.id, b.name
+		ORDER BY b.name ASC
+	`, userID, login)
+	if err != nil {
+		r.obs.LogError(ctx, span, "match blocklists failed", err, zap.Int64("user_id", userID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.BlocklistHit
+
+	for rows.Next() {
+		var h entity.BlocklistHit
+		if err := rows.Scan(&h.BlocklistID, &h.Name, &h.MatchedBy); err != nil {
+			return nil, err
+		}
+
+		out = append(out, h)
+	}
+
+	return out, rows.Err()
+}
@@ -0,0 +1,331 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// GetBotDetectionSettings returns the singleton row (id=1).
+func (r *Repository) GetBotDetectionSettings(ctx context.Context) (entity.BotDetectionSettings, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_bot_detection_settings")
+	defer span.End()
+
+	var s entity.BotDetectionSettings
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT enabled, min_concurrent_channels, max_messages_per_hour, viewers_per_chatter, exclude_from_stats
+		FROM bot_detection_settings WHERE id = 1
+	`).Scan(&s.Enabled, &s.MinConcurrentChannels, &s.MaxMessagesPerHour, &s.ViewersPerChatter, &s.ExcludeFromStats)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return defaultBotDetectionSettings(), nil
+		}
+
+		r.obs.LogError(ctx, span, "get bot detection settings failed", err)
+	}
+
+	return s, err
+}
+
+func defaultBotDetectionSettings() entity.BotDetectionSettings {
+	return entity.BotDetectionSettings{
+		Enabled:               true,
+		MinConcurrentChannels: 8,
+		MaxMessagesPerHour:    0.05,
+		ViewersPerChatter:     4,
+	}
+}
+
+// UpdateBotDetectionSettings upserts the singleton row.
+func (r *Repository) UpdateBotDetectionSettings(ctx context.Context, s entity.BotDetectionSettings) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.update_bot_detection_settings")
+	defer span.End()
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO bot_detection_settings (
+			id, enabled, min_concurrent_channels, max_messages_per_hour, viewers_per_chatter, exclude_from_stats
+		) VALUES (1, $1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			min_concurrent_channels = EXCLUDED.min_concurrent_channels,
+			max_messages_per_hour = EXCLUDED.max_messages_per_hour,
+			viewers_per_chatter = EXCLUDED.viewers_per_chatter,
+			exclude_from_stats = EXCLUDED.exclude_from_stats
+	`, s.Enabled, s.MinConcurrentChannels, s.MaxMessagesPerHour, s.ViewersPerChatter, s.ExcludeFromStats)
+	if err != nil {
+		r.obs.LogError(ctx, span, "update bot detection settings failed", err)
+	}
+
+	return err
+}
+
+// ListBotPresence returns users currently present in at least minChannels channels (IRC snapshot) with their summed
+// presence and the messages they sent since their earliest current presence, most channels first.
+func (r *Repository) ListBotPresence(ctx context.Context, minChannels int) ([]entity.BotPresence, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_bot_presence")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT p.chatter_twitch_user_id, p.channels, p.present_seconds,
+			(SELECT count(*) FROM chat_messages m
+			 WHERE m.chatter_twitch_user_id = p.chatter_twitch_user_id AND m.created_at >= p.since)
+		FROM (
+			SELECT chatter_twitch_user_id, count(*) AS channels,
+				sum(extract(epoch FROM now() - present_since))::bigint AS present_seconds,
+				min(present_since) AS since
+			FROM channel_chatters
+			GROUP BY chatter_twitch_user_id
+			HAVING count(*) >= $1
+		) p
+		ORDER BY p.channels DESC, p.chatter_twitch_user_id ASC
+	`, minChannels)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list bot presence failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.BotPresence
+
+	for rows.Next() {
+		var p entity.BotPresence
+		if err := rows.Scan(&p.TwitchUserID, &p.ConcurrentChannels, &p.PresentSeconds, &p.MessageCount); err != nil {
+			return nil, err
+		}
+
+		out = append(out, p)
+	}
+
+	return out, rows.Err()
+}
+
+// UpsertLikelyBots records users detected at detectedAt (keeping their first detection time) and forgets bots not
+// detected since staleBefore (transactional).
+func (r *Repository) UpsertLikelyBots(ctx context.Context, bots []entity.BotPresence, detectedAt, staleBefore time.Time) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.upsert_likely_bots")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin upsert likely bots failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for _, b := range bots {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO twitch_likely_bots (
+				twitch_user_id, concurrent_channels, present_seconds, message_count, first_detected_at, last_detected_at
+			) VALUES ($1, $2, $3, $4, $5, $5)
+			ON CONFLICT (twitch_user_id) DO UPDATE SET
+				concurrent_channels = EXCLUDED.concurrent_channels,
+				present_seconds = EXCLUDED.present_seconds,
+				message_count = EXCLUDED.message_count,
+				last_detected_at = EXCLUDED.last_detected_at
+		`, b.TwitchUserID, b.ConcurrentChannels, b.PresentSeconds, b.MessageCount, detectedAt); err != nil {
+			r.obs.LogError(ctx, span, "upsert likely bot failed", err, zap.Int64("twitch_user_id", b.TwitchUserID))
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM twitch_likely_bots WHERE last_detected_at < $1`, staleBefore); err != nil {
+		r.obs.LogError(ctx, span, "delete stale likely bots failed", err)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit upsert likely bots failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListLikelyBots returns up to limit flagged users, those present in the most channels first.
+func (r *Repository) ListLikelyBots(ctx context.Context, limit int) ([]entity.LikelyBot, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_likely_bots")
+	defer span.End()
+
+	if limit < 1 {
+		limit = 100
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT b.twitch_user_id, u.username, b.concurrent_channels, b.present_seconds, b.message_count,
+			b.first_detected_at, b.last_detected_at
+		FROM twitch_likely_bots b
+		JOIN twitch_users u ON u.id = b.twitch_user_id
+		ORDER BY b.concurrent_channels DESC, b.last_detected_at DESC, b.twitch_user_id ASC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list likely bots failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.LikelyBot
+
+	for rows.Next() {
+		var b entity.LikelyBot
+		if err := rows.Scan(&b.TwitchUserID, &b.Username, &b.ConcurrentChannels, &b.PresentSeconds, &b.MessageCount,
+			&b.FirstDetectedAt, &b.LastDetectedAt); err != nil {
+			return nil, err
+		}
+
+		out = append(out, b)
+	}
+
+	return out, rows.Err()
+}
+
+// LikelyBotIDs returns the subset of ids currently flagged as likely bots.
+func (r *Repository) LikelyBotIDs(ctx context.Context, ids []int64) (map[int64]struct{}, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.likely_bot_ids")
+	defer span.End()
+
+	out := make(map[int64]struct{})
+
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `SELECT twitch_user_id FROM twitch_likely_bots WHERE twitch_user_id = ANY($1)`, ids)
+	if err != nil {
+		r.obs.LogError(ctx, span, "likely bot ids failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		out[id] = struct{}{}
+	}
+
+	return out, rows.Err()
+}
+
+// ListChannelBotCounts returns live monitored channels with a Helix viewer count, their current chatters and how
+// many of those are flagged as likely bots.
+func (r *Repository) ListChannelBotCounts(ctx context.Context) ([]entity.ChannelBotCounts, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_channel_bot_counts")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.channel_twitch_user_id, s.viewer_count,
+			(SELECT count(*) FROM channel_chatters c WHERE c.channel_twitch_user_id = s.channel_twitch_user_id),
+			(SELECT count(*) FROM channel_chatters c
+			 JOIN twitch_likely_bots b ON b.twitch_user_id = c.chatter_twitch_user_id
+			 WHERE c.channel_twitch_user_id = s.channel_twitch_user_id)
+		FROM (
+			SELECT DISTINCT ON (channel_twitch_user_id) channel_twitch_user_id, viewer_count
+			FROM streams
+			WHERE ended_at IS NULL AND viewer_count IS NOT NULL
+			ORDER BY channel_twitch_user_id, started_at DESC
+		) s
+		JOIN twitch_users u ON u.id = s.channel_twitch_user_id
+		WHERE u.monitored
+		ORDER BY s.channel_twitch_user_id
+	`)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list channel bot counts failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.ChannelBotCounts
+
+	for rows.Next() {
+		var c entity.ChannelBotCounts
+		if err := rows.Scan(&c.ChannelTwitchUserID, &c.ViewerCount, &c.ChatterCount, &c.BotChatterCount); err != nil {
+			return nil, err
+		}
+
+		out = append(out, c)
+	}
+
+	return out, rows.Err()
+}
+
+// ReplaceChannelBotEstimates replaces all stored per-channel estimates with the latest run (transactional).
+func (r *Repository) ReplaceChannelBotEstimates(ctx context.Context, estimates []entity.ChannelBotEstimate) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.replace_channel_bot_estimates")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin replace channel bot estimates failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM channel_bot_estimates`); err != nil {
+		r.obs.LogError(ctx, span, "delete channel bot estimates failed", err)
+		return err
+	}
+
+	for _, e := range estimates {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO channel_bot_estimates (
+				channel_twitch_user_id, viewer_count, chatter_count, bot_chatter_count, bot_share, viewer_inflated, computed_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, e.ChannelTwitchUserID, e.ViewerCount, e.ChatterCount, e.BotChatterCount, e.BotShare, e.ViewerInflated,
+			e.ComputedAt); err != nil {
+			r.obs.LogError(ctx, span, "insert channel bot estimate failed", err,
+				zap.Int64("channel_twitch_user_id", e.ChannelTwitchUserID))
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit replace channel bot estimates failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListChannelBotEstimates returns the latest per-channel estimates, highest bot share first.
+func (r *Repository) ListChannelBotEstimates(ctx context.Context) ([]entity.ChannelBotEstimate, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_channel_bot_estimates")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT e.channel_twitch_user_id, u.username, e.viewer_count, e.chatter_count, e.bot_chatter_count,
+			e.bot_share, e.viewer_inflated, e.computed_at
+		FROM channel_bot_estimates e
+		JOIN twitch_users u ON u.id = e.channel_twitch_user_id
+		ORDER BY e.bot_share DESC, u.username ASC
+	`)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list channel bot estimates failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.ChannelBotEstimate
+
+	for rows.Next() {
+		var e entity.ChannelBotEstimate
+		if err := rows.Scan(&e.ChannelTwitchUserID, &e.ChannelLogin, &e.ViewerCount, &e.ChatterCount,
+			&e.BotChatterCount, &e.BotShare, &e.ViewerInflated, &e.ComputedAt); err != nil {
+			return nil, err
+		}
+
+		out = append(out, e)
+	}
+
+	return out, rows.Err()
+}
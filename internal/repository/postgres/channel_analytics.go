@@ -0,0 +1,368 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// GetChannelAnalyticsWatermark returns how far chat history has been rolled up (nil before the first rollup).
+func (r *Repository) GetChannelAnalyticsWatermark(ctx context.Context) (*time.Time, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_channel_analytics_watermark")
+	defer span.End()
+
+	var until *time.Time
+
+	if err := r.pool.QueryRow(ctx, `
+		SELECT (SELECT rolled_up_until FROM channel_analytics_state WHERE id = 1)
+	`).Scan(&until); err != nil {
+		r.obs.LogError(ctx, span, "get channel analytics watermark failed", err)
+		return nil, err
+	}
+
+	return until, nil
+}
+
+// EarliestChatMessageAt returns the oldest stored chat message time (nil without messages).
+func (r *Repository) EarliestChatMessageAt(ctx context.Context) (*time.Time, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.earliest_chat_message_at")
+	defer span.End()
+
+	var at *time.Time
+
+	if err := r.pool.QueryRow(ctx, `SELECT min(created_at) FROM chat_messages`).Scan(&at); err != nil {
+		r.obs.LogError(ctx, span, "earliest chat message query failed", err)
+		return nil, err
+	}
+
+	return at, nil
+}
+
+// ReplaceChannelActivityRollups recomputes the hour and day rollups starting in [from, to) from chat_messages,
+// merges the window's first messages per chatter (per channel and globally), stores the given presence totals
+// (computed by the caller from IRC presence segments) and advances the watermark to to. Per-chatter daily rollups
+// are rebuilt the same way from messages and chatterPresence. from should be day-aligned so day buckets are
+// recomputed whole. Transactional.
+func (r *Repository) ReplaceChannelActivityRollups(
+	ctx context.Context,
+	from, to time.Time,
+	presence []entity.ChannelActivityBucket,
+	chatterPresence []entity.ChatterDayPresence,
+) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.replace_channel_activity_rollups")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin replace channel activity rollups failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO channel_chatter_first_messages (channel_twitch_user_id, chatter_twitch_user_id, first_message_at)
+		SELECT twitch_user_id, chatter_twitch_user_id, min(created_at)
+		FROM chat_messages
+		WHERE created_at >= $1 AND created_at < $2 AND chatter_twitch_user_id IS NOT NULL
+		GROUP BY twitch_user_id, chatter_twitch_user_id
+		ON CONFLICT (channel_twitch_user_id, chatter_twitch_user_id) DO UPDATE
+		SET first_message_at = LEAST(channel_chatter_first_messages.first_message_at, EXCLUDED.first_message_at)
+	`, from, to); err != nil {
+		r.obs.LogError(ctx, span, "upsert chatter first messages failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO chatter_first_seen (chatter_twitch_user_id, channel_twitch_user_id, first_seen_at)
+		SELECT DISTINCT ON (chatter_twitch_user_id) chatter_twitch_user_id, channel_twitch_user_id, first_message_at
+		FROM channel_chatter_first_messages
+		WHERE first_message_at >= $1 AND first_message_at < $2
+		ORDER BY chatter_twitch_user_id, first_message_at, channel_twitch_user_id
+		ON CONFLICT (chatter_twitch_user_id) DO UPDATE
+		SET channel_twitch_user_id = EXCLUDED.channel_twitch_user_id, first_seen_at = EXCLUDED.first_seen_at
+		WHERE EXCLUDED.first_seen_at < chatter_first_seen.first_seen_at
+	`, from, to); err != nil {
+		r.obs.LogError(ctx, span, "upsert chatter global first seen failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM channel_activity_rollups WHERE bucket_start >= $1 AND bucket_start < $2
+	`, from, to); err != nil {
+		r.obs.LogError(ctx, span, "delete channel activity rollups failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO channel_activity_rollups (channel_twitch_user_id, granularity, bucket_start, message_count, unique_chatters)
+		SELECT m.twitch_user_id, g.granularity, date_trunc(g.granularity, m.created_at, 'UTC'),
+		       count(*), count(DISTINCT m.chatter_twitch_user_id)
+		FROM chat_messages m
+		CROSS JOIN (VALUES ('hour'), ('day')) AS g (granularity)
+		WHERE m.created_at >= $1 AND m.created_at < $2
+		GROUP BY 1, 2, 3
+	`, from, to); err != nil {
+		r.obs.LogError(ctx, span, "insert channel message rollups failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO channel_activity_rollups (channel_twitch_user_id, granularity, bucket_start, new_chatters)
+		SELECT f.channel_twitch_user_id, g.granularity, date_trunc(g.granularity, f.first_message_at, 'UTC'), count(*)
+		FROM channel_chatter_first_messages f
+		CROSS JOIN (VALUES ('hour'), ('day')) AS g (granularity)
+		WHERE f.first_message_at >= $1 AND f.first_message_at < $2
+		GROUP BY 1, 2, 3
+		ON CONFLICT (channel_twitch_user_id, granularity, bucket_start) DO UPDATE
+		SET new_chatters = EXCLUDED.new_chatters
+	`, from, to); err != nil {
+		r.obs.LogError(ctx, span, "upsert channel new chatter rollups failed", err)
+		return err
+	}
+
+	channels := make([]int64, len(presence))
+	granularities := make([]string, len(presence))
+	starts := make([]time.Time, len(presence))
+	present := make([]int32, len(presence))
+	seconds := make([]int64, len(presence))
+
+	for i, p := range presence {
+		channels[i] = p.ChannelTwitchUserID
+		granularities[i] = string(p.Bucket)
+		starts[i] = p.Start
+		present[i] = int32(p.PresentChatters)
+		seconds[i] = p.PresenceSeconds
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO channel_activity_rollups (channel_twitch_user_id, granularity, bucket_start, present_chatters, presence_seconds)
+		SELECT c, g, b, n, s
+		FROM unnest($1::bigint[], $2::text[], $3::timestamptz[], $4::int[], $5::bigint[]) AS t(c, g, b, n, s)
+		ON CONFLICT (channel_twitch_user_id, granularity, bucket_start) DO UPDATE
+		SET present_chatters = EXCLUDED.present_chatters, presence_seconds = EXCLUDED.presence_seconds
+	`, channels, granularities, starts, present, seconds); err != nil {
+		r.obs.LogError(ctx, span, "upsert channel presence rollups failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM channel_chatter_daily_rollups
+		WHERE day >= ($1::timestamptz AT TIME ZONE 'UTC')::date AND (day::timestamp AT TIME ZONE 'UTC') < $2
+	`, from, to); err != nil {
+		r.obs.LogError(ctx, span, "delete chatter daily rollups failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO channel_chatter_daily_rollups (channel_twitch_user_id, chatter_twitch_user_id, day, message_count)
+		SELECT twitch_user_id, chatter_twitch_user_id, (created_at AT TIME ZONE 'UTC')::date, count(*)
+		FROM chat_messages
+		WHERE created_at >= $1 AND created_at < $2 AND chatter_twitch_user_id IS NOT NULL
+		GROUP BY 1, 2, 3
+	`, from, to); err != nil {
+		r.obs.LogError(ctx, span, "insert chatter daily message rollups failed", err)
+		return err
+	}
+
+	chatterChannels := make([]int64, len(chatterPresence))
+	chatters := make([]int64, len(chatterPresence))
+	days := make([]time.Time, len(chatterPresence))
+	chatterSeconds := make([]int64, len(chatterPresence))
+
+	for i, p := range chatterPresence {
+		chatterChannels[i] = p.ChannelTwitchUserID
+		chatters[i] = p.ChatterTwitchUserID
+		days[i] = p.Day
+		chatterSeconds[i] = p.PresenceSeconds
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO channel_chatter_daily_rollups (channel_twitch_user_id, chatter_twitch_user_id, day, presence_seconds)
+		SELECT c, u, (d AT TIME ZONE 'UTC')::date, s
+		FROM unnest($1::bigint[], $2::bigint[], $3::timestamptz[], $4::bigint[]) AS t(c, u, d, s)
+		ON CONFLICT (channel_twitch_user_id, day, chatter_twitch_user_id) DO UPDATE
+		SET presence_seconds = EXCLUDED.presence_seconds
+	`, chatterChannels, chatters, days, chatterSeconds); err != nil {
+		r.obs.LogError(ctx, span, "upsert chatter daily presence rollups failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE channel_analytics_state SET rolled_up_until = $1 WHERE id = 1`, to); err != nil {
+		r.obs.LogError(ctx, span, "update channel analytics watermark failed", err)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit replace channel activity rollups failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// RefreshStreamRetention recomputes chatter retention for every stream that ended (or whose next stream ended)
+// at or after since, plus streams still live or without a successor.
+func (r *Repository) RefreshStreamRetention(ctx context.Context, since time.Time) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.refresh_stream_retention")
+	defer span.End()
+
+	if _, err := r.pool.Exec(ctx, `
+		WITH ordered AS (
+			SELECT id, channel_twitch_user_id, started_at, COALESCE(ended_at, 'infinity') AS ended_at,
+			       lead(id) OVER w AS next_id,
+			       lead(COALESCE(ended_at, 'infinity')) OVER w AS next_ended_at
+			FROM streams
+			WINDOW w AS (PARTITION BY channel_twitch_user_id ORDER BY started_at, id)
+		), touched AS (
+			SELECT * FROM ordered
+			WHERE ended_at >= $1 OR COALESCE(next_ended_at, 'infinity') >= $1
+		), chatters AS (
+			SELECT DISTINCT stream_id, chatter_twitch_user_id
+			FROM chat_messages
+			WHERE chatter_twitch_user_id IS NOT NULL
+			  AND stream_id IN (SELECT id FROM touched UNION SELECT next_id FROM touched WHERE next_id IS NOT NULL)
+		)
+		INSERT INTO stream_chatter_retention (
+			stream_id, channel_twitch_user_id, started_at, next_stream_id, chatters, returning_chatters, computed_at
+		)
+		SELECT t.id, t.channel_twitch_user_id, t.started_at, t.next_id,
+		       (SELECT count(*) FROM chatters c WHERE c.stream_id = t.id),
+		       (SELECT count(*) FROM chatters c
+		        JOIN chatters n ON n.chatter_twitch_user_id = c.chatter_twitch_user_id AND n.stream_id = t.next_id
+		        WHERE c.stream_id = t.id),
+		       now()
+		FROM touched t
+		ON CONFLICT (stream_id) DO UPDATE SET
+			next_stream_id = EXCLUDED.next_stream_id,
+			chatters = EXCLUDED.chatters,
+			returning_chatters = EXCLUDED.returning_chatters,
+			computed_at = EXCLUDED.computed_at
+	`, since); err != nil {
+		r.obs.LogError(ctx, span, "refresh stream retention failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListChannelActivityRollups returns a channel's stored hour or day buckets starting in [from, to) (oldest first).
+func (r *Repository) ListChannelActivityRollups(
+	ctx context.Context,
+	channelTwitchUserID int64,
+	bucket entity.ChannelAnalyticsBucket,
+	from, to time.Time,
+) ([]entity.ChannelActivityBucket, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_channel_activity_rollups")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT bucket_start, message_count, unique_chatters, new_chatters, present_chatters, presence_seconds
+		FROM channel_activity_rollups
+		WHERE channel_twitch_user_id = $1 AND granularity = $2 AND bucket_start >= $3 AND bucket_start < $4
+		ORDER BY bucket_start ASC
+	`, channelTwitchUserID, string(bucket), from, to)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list channel activity rollups failed", err, zap.Int64("channel_id", channelTwitchUserID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.ChannelActivityBucket
+
+	for rows.Next() {
+		b := entity.ChannelActivityBucket{ChannelTwitchUserID: channelTwitchUserID, Bucket: bucket}
+		if err := rows.Scan(&b.Start, &b.MessageCount, &b.UniqueChatters, &b.NewChatters, &b.PresentChatters, &b.PresenceSeconds); err != nil {
+			return nil, err
+		}
+
+		out = append(out, b)
+	}
+
+	return out, rows.Err()
+}
+
+// ListChannelActivityMinutes computes per-minute message, unique chatter and new chatter counts for a channel
+// straight from chat_messages (presence is left to the caller). A chatter is new at their first message in the
+// window unless an earlier first message is already rolled up.
+func (r *Repository) ListChannelActivityMinutes(ctx context.Context, channelTwitchUserID int64, from, to time.Time) ([]entity.ChannelActivityBucket, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_channel_activity_minutes")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		WITH msgs AS (
+			SELECT date_trunc('minute', created_at, 'UTC') AS b, count(*) AS n, count(DISTINCT chatter_twitch_user_id) AS u
+			FROM chat_messages
+			WHERE twitch_user_id = $1 AND created_at >= $2 AND created_at < $3
+			GROUP BY 1
+		), firsts AS (
+			SELECT date_trunc('minute', min(m.created_at), 'UTC') AS b
+			FROM chat_messages m
+			LEFT JOIN channel_chatter_first_messages f
+			  ON f.channel_twitch_user_id = m.twitch_user_id AND f.chatter_twitch_user_id = m.chatter_twitch_user_id
+			WHERE m.twitch_user_id = $1 AND m.created_at >= $2 AND m.created_at < $3
+			  AND m.chatter_twitch_user_id IS NOT NULL
+			  AND (f.first_message_at IS NULL OR f.first_message_at >= $2)
+			GROUP BY m.chatter_twitch_user_id
+		), fresh AS (
+			SELECT b, count(*) AS n FROM firsts GROUP BY b
+		)
+		SELECT COALESCE(m.b, f.b), COALESCE(m.n, 0), COALESCE(m.u, 0), COALESCE(f.n, 0)
+		FROM msgs m
+		FULL JOIN fresh f ON f.b = m.b
+		ORDER BY 1 ASC
+	`, channelTwitchUserID, from, to)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list channel activity minutes failed", err, zap.Int64("channel_id", channelTwitchUserID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.ChannelActivityBucket
+
+	for rows.Next() {
+		b := entity.ChannelActivityBucket{ChannelTwitchUserID: channelTwitchUserID, Bucket: entity.ChannelAnalyticsBucketMinute}
+		if err := rows.Scan(&b.Start, &b.MessageCount, &b.UniqueChatters, &b.NewChatters); err != nil {
+			return nil, err
+		}
+
+		out = append(out, b)
+	}
+
+	return out, rows.Err()
+}
+
+// ListStreamRetention returns the retention rows of a channel's streams started in [from, to) (oldest first).
+func (r *Repository) ListStreamRetention(ctx context.Context, channelTwitchUserID int64, from, to time.Time) ([]entity.StreamRetention, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_stream_retention")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT r.stream_id, r.started_at, s.title, r.next_stream_id, r.chatters, r.returning_chatters
+		FROM stream_chatter_retention r
+		JOIN streams s ON s.id = r.stream_id
+		WHERE r.channel_twitch_user_id = $1 AND r.started_at >= $2 AND r.started_at < $3
+		ORDER BY r.started_at ASC, r.stream_id ASC
+	`, channelTwitchUserID, from, to)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list stream retention failed", err, zap.Int64("channel_id", channelTwitchUserID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.StreamRetention
+
+	for rows.Next() {
+		var s entity.StreamRetention
+		if err := rows.Scan(&s.StreamID, &s.StartedAt, &s.Title, &s.NextStreamID, &s.Chatters, &s.ReturningChatters); err != nil {
+			return nil, err
+		}
+
+		out = append(out, s)
+	}
+
+	return out, rows.Err()
+}
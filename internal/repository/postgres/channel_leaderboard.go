@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// channelLeaderboardOrder mirrors the stream leaderboard sorts: the sort key, then presence for account sorts,
+// then messages and login.
+var channelLeaderboardOrder = map[entity.StreamLeaderboardSort]string{
+	entity.StreamLeaderboardSortPresenceDesc: "presence DESC, messages DESC, login ASC",
+	entity.StreamLeaderboardSortPresenceAsc:  "presence ASC, messages DESC, login ASC",
+	entity.StreamLeaderboardSortMessagesDesc: "messages DESC, login ASC",
+	entity.StreamLeaderboardSortMessagesAsc:  "messages ASC, login ASC",
+	entity.StreamLeaderboardSortLoginAZ:      "login ASC",
+	entity.StreamLeaderboardSortLoginZA:      "login DESC",
+	entity.StreamLeaderboardSortAccountNew:   "account_created_at DESC NULLS LAST, presence DESC, messages DESC, login ASC",
+	entity.StreamLeaderboardSortAccountOld:   "account_created_at ASC NULLS LAST, presence DESC, messages DESC, login ASC",
+}
+
+// ListChannelLeaderboard ranks chatters by their daily rollups in [q.From, q.To) of one channel, or of every
+// monitored channel when channelID is nil, and returns one page plus the number of matching chatters. q must be
+// normalized.
+func (r *Repository) ListChannelLeaderboard(ctx context.Context, channelID *int64, q entity.ChannelLeaderboardQuery) ([]entity.ChannelLeaderboardRow, int64, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_channel_leaderboard")
+	defer span.End()
+
+	order, ok := channelLeaderboardOrder[q.Sort]
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: unknown sort %q", entity.ErrInvalidChannelLeaderboardQuery, q.Sort)
+	}
+
+	excludeBots := q.ExcludeBots != nil && *q.ExcludeBots
+
+	rows, err := r.pool.Query(ctx, `
+		WITH totals AS (
+			SELECT d.chatter_twitch_user_id AS id, sum(d.message_count)::bigint AS messages,
+				sum(d.presence_seconds)::bigint AS presence,
+				count(DISTINCT d.channel_twitch_user_id)::int AS channels
+			FROM channel_chatter_daily_rollups d
+			JOIN twitch_users c ON c.id = d.channel_twitch_user_id AND c.monitored = true
+			WHERE d.day >= ($1::timestamptz AT TIME ZONE 'UTC')::date AND d.day < ($2::timestamptz AT TIME ZONE 'UTC')::date
+			  AND ($3::bigint IS NULL OR d.channel_twitch_user_id = $3)
+			GROUP BY d.chatter_twitch_user_id
+		), ranked AS (
+			SELECT t.id, u.username AS login, t.messages, t.presence, t.channels, h.account_created_at
+			FROM totals t
+			JOIN twitch_users u ON u.id = t.id
+			LEFT JOIN twitch_user_helix_meta h ON h.twitch_user_id = t.id
+			WHERE ($4 = '' OR strpos(lower(u.username), lower($4)) > 0)
+			  AND (NOT $5 OR NOT EXISTS (SELECT 1 FROM twitch_likely_bots b WHERE b.twitch_user_id = t.id))
+			  AND (NOT $6 OR NOT u.marked)
+			  AND (NOT $7 OR NOT EXISTS (SELECT 1 FROM twitch_accounts a WHERE a.id = t.id AND a.deleted_at IS NULL))
+		)
+		SELECT c.total, p.id, p.login, p.messages, p.presence, p.channels, p.account_created_at
+		FROM (SELECT count(*) AS total FROM ranked) c
+		LEFT JOIN LATERAL (
+			SELECT * FROM ranked
+			ORDER BY `+order+`
+			LIMIT $8 OFFSET $9
+		) p ON true
+	`, q.From, q.To, channelID, q.Query, excludeBots, q.ExcludeMarked, q.ExcludeLinked, q.Limit, q.Offset)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list channel leaderboard failed", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		out   []entity.ChannelLeaderboardRow
+		total int64
+	)
+
+	// The count row is always returned; an empty page comes back as one row without a chatter.
+	for rows.Next() {
+		var (
+			id       *int64
+			login    *string
+			messages *int64
+			presence *int64
+			channels *int
+			row      entity.ChannelLeaderboardRow
+		)
+
+		if err := rows.Scan(&total, &id, &login, &messages, &presence, &channels, &row.AccountCreatedAt); err != nil {
+			return nil, 0, err
+		}
+
+		if id == nil {
+			continue
+		}
+
+		row.UserTwitchID, row.Login, row.MessageCount, row.PresenceSeconds, row.Channels = *id, *login, *messages, *presence, *channels
+		out = append(out, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return out, total, nil
+}
@@ -0,0 +1,297 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// GetChatClassifierSettings returns the singleton row (id=1) with the enabled channel logins, or the defaults when
+// it was never saved.
+func (r *Repository) GetChatClassifierSettings(ctx context.Context) (entity.ChatClassifierSettings, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_chat_classifier_settings")
+	defer span.End()
+
+	var s entity.ChatClassifierSettings
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT enabled, sample_rate, batch_size, daily_token_budget,
+			ARRAY(
+				SELECT u.username
+				FROM chat_classifier_channels cc
+				JOIN twitch_users u ON u.id = cc.channel_twitch_user_id
+				ORDER BY u.username
+			)
+		FROM chat_classifier_settings WHERE id = 1
+	`).Scan(&s.Enabled, &s.SampleRate, &s.BatchSize, &s.DailyTokenBudget, &s.Channels)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ChatClassifierSettings{Channels: []string{}, SampleRate: 0.2, BatchSize: 20, DailyTokenBudget: 200_000}, nil
+		}
+
+		r.obs.LogError(ctx, span, "get chat classifier settings failed", err)
+	}
+
+	return s, err
+}
+
+// UpdateChatClassifierSettings upserts the singleton row and replaces the enabled channels with the monitored
+// channels among s.Channels. The message cursor is kept.
+func (r *Repository) UpdateChatClassifierSettings(ctx context.Context, s entity.ChatClassifierSettings) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.update_chat_classifier_settings")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin update chat classifier settings failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO chat_classifier_settings (id, enabled, sample_rate, batch_size, daily_token_budget)
+		VALUES (1, $1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			sample_rate = EXCLUDED.sample_rate,
+			batch_size = EXCLUDED.batch_size,
+			daily_token_budget = EXCLUDED.daily_token_budget
+	`, s.Enabled, s.SampleRate, s.BatchSize, s.DailyTokenBudget); err != nil {
+		r.obs.LogError(ctx, span, "update chat classifier settings failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM chat_classifier_channels`); err != nil {
+		r.obs.LogError(ctx, span, "delete chat classifier channels failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO chat_classifier_channels (channel_twitch_user_id)
+		SELECT id FROM twitch_users WHERE username = ANY($1) AND monitored = true
+	`, s.Channels); err != nil {
+		r.obs.LogError(ctx, span, "insert chat classifier channels failed", err)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit update chat classifier settings failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListChatMessagesForClassification returns up to limit messages of classifier-enabled channels after the stored
+// cursor, oldest first, skipping messages created before since. scannedTo is the id up to which every message was
+// considered: the last returned one when the page is full, otherwise the newest message id at query time.
+func (r *Repository) ListChatMessagesForClassification(ctx context.Context, since time.Time, limit int) ([]entity.ClassifiableChatMessage, int64, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_chat_messages_for_classification")
+	defer span.End()
+
+	var cursor, head int64
+
+	if err := r.pool.QueryRow(ctx, `
+		SELECT s.cursor_message_id, COALESCE((SELECT max(id) FROM chat_messages), 0)
+		FROM chat_classifier_settings s WHERE s.id = 1
+	`).Scan(&cursor, &head); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, nil
+		}
+
+		r.obs.LogError(ctx, span, "get chat classifier cursor failed", err)
+		return nil, 0, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT m.id, c.username, m.username, m.body
+		FROM chat_messages m
+		JOIN chat_classifier_channels cc ON cc.channel_twitch_user_id = m.twitch_user_id
+		JOIN twitch_users c ON c.id = m.twitch_user_id
+		WHERE m.id > GREATEST($1, COALESCE((
+				SELECT id FROM chat_messages WHERE created_at < $2 ORDER BY created_at DESC, id DESC LIMIT 1
+			), 0))
+		  AND m.id <= $3
+		ORDER BY m.id
+		LIMIT $4
+	`, cursor, since, head, limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list chat messages for classification failed", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var out []entity.ClassifiableChatMessage
+
+	for rows.Next() {
+		var m entity.ClassifiableChatMessage
+		if err := rows.Scan(&m.ID, &m.Channel, &m.Username, &m.Text); err != nil {
+			return nil, 0, err
+		}
+
+		out = append(out, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if len(out) == limit {
+		return out, out[len(out)-1].ID, nil
+	}
+
+	return out, head, nil
+}
+
+// SaveChatClassification stores labels, adds tokens to the day's usage and moves the classifier cursor forward to
+// cursor, in one transaction.
+func (r *Repository) SaveChatClassification(ctx context.Context, labels []entity.ChatMessageLabels, cursor int64, day time.Time, tokens int64) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.save_chat_classification")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin save chat classification failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	ids := make([]int64, len(labels))
+	toxicity := make([]float64, len(labels))
+	harassment := make([]float64, len(labels))
+	sentiment := make([]float64, len(labels))
+
+	for i, l := range labels {
+		ids[i], toxicity[i], harassment[i], sentiment[i] = l.MessageID, l.Toxicity, l.Harassment, l.Sentiment
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO chat_message_labels (chat_message_id, toxicity, harassment, sentiment)
+		SELECT t.id, t.toxicity, t.harassment, t.sentiment
+		FROM unnest($1::bigint[], $2::float8[], $3::float8[], $4::float8[]) AS t (id, toxicity, harassment, sentiment)
+		JOIN chat_messages m ON m.id = t.id
+		ON CONFLICT (chat_message_id) DO UPDATE SET
+			toxicity = EXCLUDED.toxicity,
+			harassment = EXCLUDED.harassment,
+			sentiment = EXCLUDED.sentiment,
+			classified_at = now()
+	`, ids, toxicity, harassment, sentiment); err != nil {
+		r.obs.LogError(ctx, span, "insert chat message labels failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO chat_classifier_usage (day, tokens) VALUES ($1::date, $2)
+		ON CONFLICT (day) DO UPDATE SET tokens = chat_classifier_usage.tokens + EXCLUDED.tokens
+	`, day.UTC().Format(time.DateOnly), tokens); err != nil {
+		r.obs.LogError(ctx, span, "add chat classifier usage failed", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE chat_classifier_settings SET cursor_message_id = GREATEST(cursor_message_id, $1) WHERE id = 1
+	`, cursor); err != nil {
+		r.obs.LogError(ctx, span, "advance chat classifier cursor failed", err)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit save chat classification failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// ChatClassifierTokensUsed returns the tokens the classifier spent on a UTC day.
+func (r *Repository) ChatClassifierTokensUsed(ctx context.Context, day time.Time) (int64, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.chat_classifier_tokens_used")
+	defer span.End()
+
+	var tokens int64
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE((SELECT tokens FROM chat_classifier_usage WHERE day = $1::date), 0)
+	`, day.UTC().Format(time.DateOnly)).Scan(&tokens)
+	if err != nil {
+		r.obs.LogError(ctx, span, "get chat classifier tokens used failed", err)
+	}
+
+	return tokens, err
+}
+
+// GetLatestChatterLabels returns the labels of the chatter's most recent classified message in the channel created
+// at or after since, or nil when there is none.
+func (r *Repository) GetLatestChatterLabels(ctx context.Context, channel, username string, since time.Time) (*entity.ChatMessageLabels, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_latest_chatter_labels")
+	defer span.End()
+
+	var l entity.ChatMessageLabels
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT l.chat_message_id, l.toxicity, l.harassment, l.sentiment
+		FROM chat_messages m
+		JOIN twitch_users c ON c.id = m.twitch_user_id
+		JOIN chat_message_labels l ON l.chat_message_id = m.id
+		WHERE c.username = $1 AND m.username = $2 AND m.created_at >= $3
+		ORDER BY m.created_at DESC, m.id DESC
+		LIMIT 1
+	`, channel, username, since).Scan(&l.MessageID, &l.Toxicity, &l.Harassment, &l.Sentiment)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		r.obs.LogError(ctx, span, "get latest chatter labels failed", err, zap.String("channel", channel))
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+// GetStreamChatToxicity aggregates the labels of a stream's classified messages.
+func (r *Repository) GetStreamChatToxicity(ctx context.Context, streamID int64) (entity.ChatToxicity, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_stream_chat_toxicity")
+	defer span.End()
+
+	out, err := r.chatToxicity(ctx, `m.stream_id = $1`, streamID)
+	if err != nil {
+		r.obs.LogError(ctx, span, "get stream chat toxicity failed", err, zap.Int64("stream_id", streamID))
+	}
+
+	return out, err
+}
+
+// GetChatterChatToxicity aggregates the labels of a chatter's classified messages across channels.
+func (r *Repository) GetChatterChatToxicity(ctx context.Context, chatterTwitchUserID int64) (entity.ChatToxicity, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_chatter_chat_toxicity")
+	defer span.End()
+
+	out, err := r.chatToxicity(ctx, `m.chatter_twitch_user_id = $1`, chatterTwitchUserID)
+	if err != nil {
+		r.obs.LogError(ctx, span, "get chatter chat toxicity failed", err, zap.Int64("chatter_id", chatterTwitchUserID))
+	}
+
+	return out, err
+}
+
+func (r *Repository) chatToxicity(ctx context.Context, where string, id int64) (entity.ChatToxicity, error) {
+	var out entity.ChatToxicity
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT count(*), count(*) FILTER (WHERE l.toxicity >= $2),
+			COALESCE(avg(l.toxicity), 0), COALESCE(avg(l.harassment), 0), COALESCE(avg(l.sentiment), 0)
+		FROM chat_message_labels l
+		JOIN chat_messages m ON m.id = l.chat_message_id
+		WHERE `+where, id, entity.ChatToxicThreshold).
+		Scan(&out.ClassifiedMessages, &out.ToxicMessages, &out.AvgToxicity, &out.AvgHarassment, &out.AvgSentiment)
+
+	return out, err
+}
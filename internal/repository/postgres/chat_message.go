@@ -313,6 +313,10 @@ func (r *Repository) ListChatMessages(ctx context.Context, f entity.ChatMessageL
 		argN++
 	}
 
+	if f.FirstMessageOnly {
+		b.WriteString(` AND m.first_message`)
+	}
+
 	if f.CursorCreatedAt != nil && f.CursorID != nil {
 		b.WriteString(` AND (m.created_at, m.id) < ($`)
 		b.WriteString(strconv.Itoa(argN))
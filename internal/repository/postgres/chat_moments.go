@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// InsertChatMoment stores a detected chat moment of m.StreamID and returns it with its id and its offset from the
+// stream start.
+func (r *Repository) InsertChatMoment(ctx context.Context, m entity.ChatMoment) (entity.ChatMoment, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.insert_chat_moment")
+	defer span.End()
+
+	if m.SampleMessages == nil {
+		m.SampleMessages = []entity.ChatMomentMessage{}
+	}
+
+	samples, err := json.Marshal(m.SampleMessages)
+	if err != nil {
+		return entity.ChatMoment{}, err
+	}
+
+	err = r.pool.QueryRow(ctx, `
+		INSERT INTO chat_moments (stream_id, kind, label, started_at, ended_at, messages, chatters, intensity, sample_messages)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::jsonb)
+		RETURNING id, (SELECT GREATEST(0, floor(extract(epoch FROM $4::timestamptz - s.started_at)))::bigint
+			FROM streams s WHERE s.id = $1)
+	`, m.StreamID, m.Kind, m.Label, m.StartedAt, m.EndedAt, m.Messages, m.Chatters, m.Intensity, samples).Scan(&m.ID, &m.OffsetSeconds)
+	if err != nil {
+		r.obs.LogError(ctx, span, "insert chat moment failed", err, zap.Int64("stream_id", m.StreamID))
+		return entity.ChatMoment{}, err
+	}
+
+	return m, nil
+}
+
+// ListStreamChatMoments returns a stream's chat moments in order.
+func (r *Repository) ListStreamChatMoments(ctx context.Context, streamID int64) ([]entity.ChatMoment, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_stream_chat_moments")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT m.id, m.stream_id, m.kind, m.label, m.started_at, m.ended_at,
+			GREATEST(0, floor(extract(epoch FROM m.started_at - s.started_at)))::bigint,
+			m.messages, m.chatters, m.intensity, m.sample_messages
+		FROM chat_moments m
+		JOIN streams s ON s.id = m.stream_id
+		WHERE m.stream_id = $1
+		ORDER BY m.started_at ASC, m.id ASC
+	`, streamID)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list stream chat moments failed", err, zap.Int64("stream_id", streamID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.ChatMoment
+
+	for rows.Next() {
+		var (
+			m       entity.ChatMoment
+			samples []byte
+		)
+
+		if err := rows.Scan(&m.ID, &m.StreamID, &m.Kind, &m.Label, &m.StartedAt, &m.EndedAt, &m.OffsetSeconds,
+			&m.Messages, &m.Chatters, &m.Intensity, &samples); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(samples, &m.SampleMessages); err != nil {
+			return nil, err
+		}
+
+		out = append(out, m)
+	}
+
+	return out, rows.Err()
+}
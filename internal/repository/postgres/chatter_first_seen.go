@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// RecordChatterFirstSeen stores at as the chatter's first message time in the channel and globally unless one is
+// already recorded, and reports which of the two were new.
+func (r *Repository) RecordChatterFirstSeen(ctx context.Context, channelTwitchUserID, chatterTwitchUserID int64, at time.Time) (entity.ChatterFirstSeen, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.record_chatter_first_seen")
+	defer span.End()
+
+	var out entity.ChatterFirstSeen
+
+	err := r.pool.QueryRow(ctx, `
+		WITH c AS (
+			INSERT INTO channel_chatter_first_messages (channel_twitch_user_id, chatter_twitch_user_id, first_message_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (channel_twitch_user_id, chatter_twitch_user_id) DO NOTHING
+			RETURNING 1
+		), g AS (
+			INSERT INTO chatter_first_seen (chatter_twitch_user_id, channel_twitch_user_id, first_seen_at)
+			VALUES ($2, $1, $3)
+			ON CONFLICT (chatter_twitch_user_id) DO NOTHING
+			RETURNING 1
+		)
+		SELECT EXISTS (SELECT 1 FROM c), EXISTS (SELECT 1 FROM g)
+	`, channelTwitchUserID, chatterTwitchUserID, at).Scan(&out.InChannel, &out.Global)
+	if err != nil {
+		r.obs.LogError(ctx, span, "record chatter first seen failed", err,
+			zap.Int64("channel_id", channelTwitchUserID), zap.Int64("chatter_id", chatterTwitchUserID))
+	}
+
+	return out, err
+}
+
+// CountStreamChatters splits a stream's distinct chatters into new (first message in the channel at or after the
+// stream start) and returning ones. Chatters without a first-seen record count as new.
+func (r *Repository) CountStreamChatters(ctx context.Context, streamID int64) (entity.StreamChatterCounts, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.count_stream_chatters")
+	defer span.End()
+
+	var out entity.StreamChatterCounts
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT count(*) FILTER (WHERE f.first_message_at IS NULL OR f.first_message_at >= s.started_at),
+			count(*) FILTER (WHERE f.first_message_at < s.started_at)
+		FROM streams s
+		JOIN (SELECT DISTINCT chatter_twitch_user_id FROM chat_messages WHERE stream_id = $1 AND chatter_twitch_user_id IS NOT NULL) m
+			ON true
+		LEFT JOIN channel_chatter_first_messages f
+			ON f.channel_twitch_user_id = s.channel_twitch_user_id AND f.chatter_twitch_user_id = m.chatter_twitch_user_id
+		WHERE s.id = $1
+	`, streamID).Scan(&out.NewChatters, &out.ReturningChatters)
+	if err != nil {
+		r.obs.LogError(ctx, span, "count stream chatters failed", err, zap.Int64("stream_id", streamID))
+	}
+
+	return out, err
+}
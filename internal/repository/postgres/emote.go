@@ -0,0 +1,270 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const emoteSetColumns = `id, name, provider, channel, emote_count, created_at, updated_at`
+
+func scanEmoteSet(row pgx.Row) (entity.EmoteSet, error) {
+	var s entity.EmoteSet
+
+	err := row.Scan(&s.ID, &s.Name, &s.Provider, &s.Channel, &s.EmoteCount, &s.CreatedAt, &s.UpdatedAt)
+
+	return s, err
+}
+
+// ListEmoteSets returns the configured third-party emote sets by name.
+func (r *Repository) ListEmoteSets(ctx context.Context) ([]entity.EmoteSet, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_emote_sets")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `SELECT `+emoteSetColumns+` FROM emote_sets ORDER BY name ASC`)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list emote sets failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.EmoteSet
+
+	for rows.Next() {
+		s, err := scanEmoteSet(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, s)
+	}
+
+	return out, rows.Err()
+}
+
+// SaveEmoteSet creates the set named s.Name or replaces its provider, channel and emotes. Transactional.
+func (r *Repository) SaveEmoteSet(ctx context.Context, s entity.EmoteSet, emotes []entity.EmoteSetEmote) (entity.EmoteSet, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.save_emote_set")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin save emote set failed", err)
+		return entity.EmoteSet{}, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	out, err := scanEmoteSet(tx.QueryRow(ctx, `
+		INSERT INTO emote_sets (name, provider, channel, emote_count)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (name) DO UPDATE SET
+			provider = EXCLUDED.provider, channel = EXCLUDED.channel, emote_count = EXCLUDED.emote_count, updated_at = now()
+		RETURNING `+emoteSetColumns,
+		s.Name, s.Provider, s.Channel, len(emotes)))
+	if err != nil {
+		r.obs.LogError(ctx, span, "upsert emote set failed", err, zap.String("name", s.Name))
+		return entity.EmoteSet{}, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM emote_set_emotes WHERE set_id = $1`, out.ID); err != nil {
+		r.obs.LogError(ctx, span, "delete emote set emotes failed", err, zap.Int64("id", out.ID))
+		return entity.EmoteSet{}, err
+	}
+
+	codes := make([]string, len(emotes))
+	ids := make([]string, len(emotes))
+
+	for i, e := range emotes {
+		codes[i] = e.Code
+		ids[i] = e.EmoteID
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO emote_set_emotes (set_id, code, emote_id)
+		SELECT $1, code, emote_id FROM unnest($2::text[], $3::text[]) AS t (code, emote_id)
+	`, out.ID, codes, ids); err != nil {
+		r.obs.LogError(ctx, span, "insert emote set emotes failed", err, zap.Int64("id", out.ID))
+		return entity.EmoteSet{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit save emote set failed", err)
+		return entity.EmoteSet{}, err
+	}
+
+	return out, nil
+}
+
+// DeleteEmoteSet removes a set and its emotes; stored occurrences are kept.
+func (r *Repository) DeleteEmoteSet(ctx context.Context, id int64) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.delete_emote_set")
+	defer span.End()
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM emote_sets WHERE id = $1`, id)
+	if err != nil {
+		r.obs.LogError(ctx, span, "delete emote set failed", err, zap.Int64("id", id))
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrEmoteSetNotFound
+	}
+
+	return nil
+}
+
+// ListScopedEmotes returns every code of every emote set with its provider and channel scope.
+func (r *Repository) ListScopedEmotes(ctx context.Context) ([]entity.ScopedEmote, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_scoped_emotes")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.provider, s.channel, e.code, e.emote_id
+		FROM emote_set_emotes e
+		JOIN emote_sets s ON s.id = e.set_id
+		ORDER BY s.id ASC
+	`)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list scoped emotes failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.ScopedEmote
+
+	for rows.Next() {
+		var e entity.ScopedEmote
+		if err := rows.Scan(&e.Provider, &e.Channel, &e.Code, &e.EmoteID); err != nil {
+			return nil, err
+		}
+
+		out = append(out, e)
+	}
+
+	return out, rows.Err()
+}
+
+// InsertChatMessageEmotes stores the emote occurrences of a chat message.
+func (r *Repository) InsertChatMessageEmotes(ctx context.Context, messageID int64, emotes []entity.ChatEmote) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.insert_chat_message_emotes")
+	defer span.End()
+
+	if messageID == 0 {
+		return errors.New("invalid chat message emotes insert")
+	}
+
+	starts := make([]int32, len(emotes))
+	ends := make([]int32, len(emotes))
+	providers := make([]string, len(emotes))
+	ids := make([]string, len(emotes))
+	codes := make([]string, len(emotes))
+
+	for i, e := range emotes {
+		starts[i], ends[i] = int32(e.Start), int32(e.End)
+		providers[i], ids[i], codes[i] = e.Provider, e.EmoteID, e.Code
+	}
+
+	if _, err := r.pool.Exec(ctx, `
+		INSERT INTO chat_message_emotes (message_id, start_pos, end_pos, provider, emote_id, code)
+		SELECT $1, s, e, p, i, c FROM unnest($2::int[], $3::int[], $4::text[], $5::text[], $6::text[]) AS t (s, e, p, i, c)
+		ON CONFLICT (message_id, start_pos) DO NOTHING
+	`, messageID, starts, ends, providers, ids, codes); err != nil {
+		r.obs.LogError(ctx, span, "insert chat message emotes failed", err, zap.Int64("message_id", messageID))
+		return err
+	}
+
+	return nil
+}
+
+// ListEmoteUsage returns the most used emotes among the messages matching f (most uses first).
+func (r *Repository) ListEmoteUsage(ctx context.Context, f entity.EmoteUsageFilter) ([]entity.EmoteUsage, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_emote_usage")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT e.provider, e.emote_id, min(e.code), count(*), count(DISTINCT e.message_id),
+			count(DISTINCT m.chatter_twitch_user_id)
+		FROM chat_message_emotes e
+		JOIN chat_messages m ON m.id = e.message_id
+		WHERE ($1::bigint IS NULL OR m.stream_id = $1)
+		  AND ($2::bigint IS NULL OR m.twitch_user_id = $2)
+		  AND ($3::bigint IS NULL OR m.chatter_twitch_user_id = $3)
+		  AND ($4::timestamptz IS NULL OR m.created_at >= $4)
+		  AND ($5::timestamptz IS NULL OR m.created_at < $5)
+		GROUP BY e.provider, e.emote_id
+		ORDER BY count(*) DESC, min(e.code) ASC
+		LIMIT $6
+	`, f.StreamID, f.ChannelTwitchUserID, f.ChatterTwitchUserID, f.From, f.To, f.Limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list emote usage failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.EmoteUsage
+
+	for rows.Next() {
+		var u entity.EmoteUsage
+		if err := rows.Scan(&u.Provider, &u.EmoteID, &u.Code, &u.Uses, &u.Messages, &u.Chatters); err != nil {
+			return nil, err
+		}
+
+		out = append(out, u)
+	}
+
+	return out, rows.Err()
+}
+
+// ListStreamEmoteCounts counts the uses of the given emotes in a stream's messages per bucket of width bucket
+// counted from origin.
+func (r *Repository) ListStreamEmoteCounts(
+	ctx context.Context,
+	streamID int64,
+	origin time.Time,
+	bucket time.Duration,
+	emotes []entity.EmoteUsage,
+) ([]entity.EmoteBucketCount, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_stream_emote_counts")
+	defer span.End()
+
+	providers := make([]string, len(emotes))
+	ids := make([]string, len(emotes))
+
+	for i, e := range emotes {
+		providers[i], ids[i] = e.Provider, e.EmoteID
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT floor(extract(epoch FROM m.created_at - $2::timestamptz) / $3)::int AS bucket, e.provider, e.emote_id, count(*)
+		FROM chat_message_emotes e
+		JOIN chat_messages m ON m.id = e.message_id
+		JOIN unnest($4::text[], $5::text[]) AS t (provider, emote_id) ON t.provider = e.provider AND t.emote_id = e.emote_id
+		WHERE m.stream_id = $1 AND m.created_at >= $2
+		GROUP BY 1, 2, 3
+		ORDER BY 1 ASC
+	`, streamID, origin, bucket.Seconds(), providers, ids)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list stream emote counts failed", err, zap.Int64("stream_id", streamID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.EmoteBucketCount
+
+	for rows.Next() {
+		var c entity.EmoteBucketCount
+		if err := rows.Scan(&c.Bucket, &c.Provider, &c.EmoteID, &c.Uses); err != nil {
+			return nil, err
+		}
+
+		out = append(out, c)
+	}
+
+	return out, rows.Err()
+}
@@ -81,3 +81,64 @@ func (r *Repository) ListUserFollowedChannels(ctx context.Context, followerID in
 
 	return out, rows.Err()
 }
+
+// UpsertFollowsSyncMeta records the GQL follow total and when follows were last synced for a user.
+func (r *Repository) UpsertFollowsSyncMeta(ctx context.Context, twitchUserID int64, total int, syncedAt time.Time) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.upsert_follows_sync_meta")
+	defer span.End()
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO twitch_user_helix_meta (twitch_user_id, follows_total, follows_synced_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (twitch_user_id) DO UPDATE SET
+			follows_total = EXCLUDED.follows_total,
+			follows_synced_at = EXCLUDED.follows_synced_at
+	`, twitchUserID, total, syncedAt)
+	if err != nil {
+		r.obs.LogError(ctx, span, "upsert follows sync meta failed", err, zap.Int64("twitch_user_id", twitchUserID))
+	}
+
+	return err
+}
+
+// ListFollowsSyncStates returns up to limit twitch users with id > afterID (ascending) and their follows sync state.
+func (r *Repository) ListFollowsSyncStates(ctx context.Context, afterID int64, limit int) ([]entity.FollowsSyncState, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_follows_sync_states")
+	defer span.End()
+
+	if limit < 1 {
+		limit = 200
+	}
+
+	if limit > 5000 {
+		limit = 5000
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.id, m.follows_total, m.follows_synced_at
+		FROM twitch_users u
+		LEFT JOIN twitch_user_helix_meta m ON m.twitch_user_id = u.id
+		WHERE u.id > $1
+		ORDER BY u.id ASC
+		LIMIT $2
+	`, afterID, limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list follows sync states failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.FollowsSyncState
+
+	for rows.Next() {
+		var st entity.FollowsSyncState
+
+		if err := rows.Scan(&st.TwitchUserID, &st.FollowsTotal, &st.FollowsSyncedAt); err != nil {
+			return nil, err
+		}
+
+		out = append(out, st)
+	}
+
+	return out, rows.Err()
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListLoginPatterns returns the login pattern blacklist (oldest first).
+func (r *Repository) ListLoginPatterns(ctx context.Context) ([]entity.LoginPattern, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_login_patterns")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, kind, pattern, description, created_at FROM login_patterns ORDER BY id ASC
+	`)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list login patterns failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.LoginPattern
+
+	for rows.Next() {
+		var p entity.LoginPattern
+		if err := rows.Scan(&p.ID, &p.Kind, &p.Pattern, &p.Description, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		out = append(out, p)
+	}
+
+	return out, rows.Err()
+}
+
+// CreateLoginPattern inserts a pattern; re-adding an existing kind and pattern only updates its description.
+func (r *Repository) CreateLoginPattern(ctx context.Context, p entity.LoginPattern) (entity.LoginPattern, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.create_login_pattern")
+	defer span.End()
+
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO login_patterns (kind, pattern, description) VALUES ($1, $2, $3)
+		ON CONFLICT (kind, pattern) DO UPDATE SET description = EXCLUDED.description
+		RETURNING id, kind, pattern, description, created_at
+	`, p.Kind, p.Pattern, p.Description).Scan(&p.ID, &p.Kind, &p.Pattern, &p.Description, &p.CreatedAt)
+	if err != nil {
+		r.obs.LogError(ctx, span, "create login pattern failed", err, zap.String("pattern", p.Pattern))
+		return entity.LoginPattern{}, err
+	}
+
+	return p, nil
+}
+
+// DeleteLoginPattern removes a pattern by id.
+func (r *Repository) DeleteLoginPattern(ctx context.Context, id int64) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.delete_login_pattern")
+	defer span.End()
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM login_patterns WHERE id = $1`, id)
+	if err != nil {
+		r.obs.LogError(ctx, span, "delete login pattern failed", err, zap.Int64("id", id))
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrLoginPatternNotFound
+	}
+
+	return nil
+}
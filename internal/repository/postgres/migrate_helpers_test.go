@@ -21,7 +21,7 @@ func TestListMigrationFiles(t *testing.T) {
 
 	names, err := listMigrationFiles()
 	require.NoError(t, err)
-	require.Len(t, names, 11)
+	require.Len(t, names, 33)
 	assert.Equal(t, "0001_init.sql", names[0])
 	assert.Equal(t, "0002_streams_viewer_count.sql", names[1])
 	assert.Equal(t, "0003_enrichment_cooldown.sql", names[2])
@@ -33,6 +33,28 @@ func TestListMigrationFiles(t *testing.T) {
 	assert.Equal(t, "0009_irc_joined_samples.sql", names[8])
 	assert.Equal(t, "0010_rule_trigger_events.sql", names[9])
 	assert.Equal(t, "0011_channel_discovery.sql", names[10])
+	assert.Equal(t, "0012_notification_routing.sql", names[11])
+	assert.Equal(t, "0013_notification_outbox.sql", names[12])
+	assert.Equal(t, "0014_notification_delivery_event_id.sql", names[13])
+	assert.Equal(t, "0015_notification_provider_open.sql", names[14])
+	assert.Equal(t, "0016_notification_snoozes.sql", names[15])
+	assert.Equal(t, "0017_notification_policy.sql", names[16])
+	assert.Equal(t, "0018_suspicion_scoring.sql", names[17])
+	assert.Equal(t, "0019_suspicion_events.sql", names[18])
+	assert.Equal(t, "0020_follows_sync_meta.sql", names[19])
+	assert.Equal(t, "0021_user_alts.sql", names[20])
+	assert.Equal(t, "0022_bot_detection.sql", names[21])
+	assert.Equal(t, "0023_login_patterns.sql", names[22])
+	assert.Equal(t, "0024_blocklists.sql", names[23])
+	assert.Equal(t, "0025_channel_analytics.sql", names[24])
+	assert.Equal(t, "0026_audience_overlap.sql", names[25])
+	assert.Equal(t, "0027_emotes.sql", names[26])
+	assert.Equal(t, "0028_stream_terms.sql", names[27])
+	assert.Equal(t, "0029_stream_recaps.sql", names[28])
+	assert.Equal(t, "0030_chat_moments.sql", names[29])
+	assert.Equal(t, "0031_chatter_daily_rollups.sql", names[30])
+	assert.Equal(t, "0032_chatter_first_seen.sql", names[31])
+	assert.Equal(t, "0033_chat_classification.sql", names[32])
 
 	for _, n := range names {
 		assert.True(t, strings.HasSuffix(n, ".sql"), n)
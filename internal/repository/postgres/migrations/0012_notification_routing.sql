@@ -0,0 +1,5 @@
+-- Routing labels and default filters for notification entries; empty arrays accept everything.
+ALTER TABLE notification_entries
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS event_types TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS channels TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,40 @@
+-- Durable outbox: one row per (event, notification entry), retried by the notify worker pool.
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    notification_entry_id BIGINT NOT NULL REFERENCES notification_entries (id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL,
+    channel TEXT NOT NULL DEFAULT '',
+    username TEXT NOT NULL DEFAULT '',
+    message TEXT NOT NULL DEFAULT '',
+    title TEXT NOT NULL DEFAULT '',
+    text TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sending', 'delivered', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_status_code INT,
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Claim scan: due pending rows and expired 'sending' leases.
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_due
+    ON notification_deliveries (next_attempt_at) WHERE status IN ('pending', 'sending');
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_entry_created
+    ON notification_deliveries (notification_entry_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_created
+    ON notification_deliveries (created_at DESC, id DESC);
+
+CREATE TABLE IF NOT EXISTS notification_delivery_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    delivery_id BIGINT NOT NULL REFERENCES notification_deliveries (id) ON DELETE CASCADE,
+    attempted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    status_code INT,
+    response_snippet TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    duration_ms BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_delivery_attempts_delivery
+    ON notification_delivery_attempts (delivery_id, attempted_at DESC);
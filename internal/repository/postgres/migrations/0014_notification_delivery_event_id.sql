@@ -0,0 +1,3 @@
+-- Stable per-delivery id sent to webhook receivers (X-Dredge-Event-Id) so retries can be deduplicated.
+ALTER TABLE notification_deliveries
+    ADD COLUMN IF NOT EXISTS event_id UUID NOT NULL DEFAULT gen_random_uuid();
@@ -0,0 +1,2 @@
+-- Provider types are validated by the notify provider registry, so new providers need no migration.
+ALTER TABLE notification_entries DROP CONSTRAINT IF EXISTS notification_entries_provider_check;
@@ -0,0 +1,10 @@
+-- Temporary mutes for notification entries and/or channels (Telegram /mute, snooze API).
+CREATE TABLE IF NOT EXISTS notification_snoozes (
+    id BIGSERIAL PRIMARY KEY,
+    notification_entry_id BIGINT REFERENCES notification_entries (id) ON DELETE CASCADE,
+    channel TEXT NOT NULL DEFAULT '',
+    until TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_snoozes_until ON notification_snoozes (until);
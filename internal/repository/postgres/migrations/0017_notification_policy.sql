@@ -0,0 +1,11 @@
+-- Per-entry quiet hours and flood control; suppressed deliveries stay in the outbox log.
+ALTER TABLE notification_entries
+    ADD COLUMN IF NOT EXISTS quiet_hours_start TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS quiet_hours_end TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS quiet_hours_timezone TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS flood_threshold INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS flood_window_seconds INT NOT NULL DEFAULT 0;
+
+ALTER TABLE notification_deliveries DROP CONSTRAINT IF EXISTS notification_deliveries_status_check;
+ALTER TABLE notification_deliveries ADD CONSTRAINT notification_deliveries_status_check
+    CHECK (status IN ('pending', 'sending', 'delivered', 'dead', 'suppressed'));
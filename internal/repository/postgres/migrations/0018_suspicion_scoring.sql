@@ -0,0 +1,20 @@
+-- Weighted suspicion scoring: per-signal weights, a trigger threshold and the latest score breakdown per user.
+ALTER TABLE suspicion_settings
+    ADD COLUMN IF NOT EXISTS score_threshold INT NOT NULL DEFAULT 50,
+    ADD COLUMN IF NOT EXISTS weight_account_age INT NOT NULL DEFAULT 50,
+    ADD COLUMN IF NOT EXISTS weight_blacklist_follows INT NOT NULL DEFAULT 50,
+    ADD COLUMN IF NOT EXISTS weight_low_follows INT NOT NULL DEFAULT 40,
+    ADD COLUMN IF NOT EXISTS weight_name_pattern INT NOT NULL DEFAULT 15,
+    ADD COLUMN IF NOT EXISTS weight_first_message INT NOT NULL DEFAULT 25,
+    ADD COLUMN IF NOT EXISTS weight_multi_channel INT NOT NULL DEFAULT 20,
+    ADD COLUMN IF NOT EXISTS weight_moderation INT NOT NULL DEFAULT 20,
+    ADD COLUMN IF NOT EXISTS name_patterns TEXT[] NOT NULL DEFAULT ARRAY['^[a-z]+_?[0-9]{4,}$'],
+    ADD COLUMN IF NOT EXISTS multi_channel_threshold INT NOT NULL DEFAULT 5;
+
+CREATE TABLE IF NOT EXISTS twitch_user_suspicion_scores (
+    twitch_user_id BIGINT PRIMARY KEY REFERENCES twitch_users (id) ON DELETE CASCADE,
+    score INT NOT NULL,
+    threshold INT NOT NULL,
+    signals JSONB NOT NULL DEFAULT '[]'::jsonb,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,17 @@
+-- Audit log of suspicion state changes (who or what flipped is_sus / sus_type / sus_description, and why).
+CREATE TABLE IF NOT EXISTS suspicion_events (
+    id BIGSERIAL PRIMARY KEY,
+    twitch_user_id BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    source TEXT NOT NULL,
+    old_is_sus BOOLEAN NOT NULL,
+    new_is_sus BOOLEAN NOT NULL,
+    old_sus_type TEXT,
+    new_sus_type TEXT,
+    old_sus_description TEXT,
+    new_sus_description TEXT,
+    evidence JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_suspicion_events_created ON suspicion_events (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_suspicion_events_user_created ON suspicion_events (twitch_user_id, created_at DESC, id DESC);
@@ -0,0 +1,13 @@
+-- Remember the GQL follow total per user so suspicion can be re-evaluated without refetching follows.
+ALTER TABLE twitch_user_helix_meta
+    ADD COLUMN IF NOT EXISTS follows_total INT,
+    ADD COLUMN IF NOT EXISTS follows_synced_at TIMESTAMPTZ;
+
+-- Users synced before this migration: the stored rows are the best known total.
+INSERT INTO twitch_user_helix_meta (twitch_user_id, follows_total, follows_synced_at)
+SELECT follower_twitch_user_id, count(*), max(synced_at)
+FROM user_followed_channels
+GROUP BY follower_twitch_user_id
+ON CONFLICT (twitch_user_id) DO UPDATE SET
+    follows_total = COALESCE(twitch_user_helix_meta.follows_total, EXCLUDED.follows_total),
+    follows_synced_at = COALESCE(twitch_user_helix_meta.follows_synced_at, EXCLUDED.follows_synced_at);
@@ -0,0 +1,33 @@
+-- Alt-account detection: latest scored "possible alt" pairs and reviewed user-to-user links.
+-- Pairs are stored once with user_a < user_b.
+CREATE TABLE IF NOT EXISTS twitch_user_alt_candidates (
+    user_a BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    user_b BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    score INT NOT NULL,
+    signals JSONB NOT NULL DEFAULT '[]'::jsonb,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_a, user_b),
+    CHECK (user_a < user_b)
+);
+
+CREATE INDEX IF NOT EXISTS idx_twitch_user_alt_candidates_user_b ON twitch_user_alt_candidates (user_b);
+
+CREATE TABLE IF NOT EXISTS twitch_user_links (
+    user_a BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    user_b BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('confirmed', 'rejected')),
+    score INT,
+    note TEXT NOT NULL DEFAULT '',
+    decided_by_user_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_a, user_b),
+    CHECK (user_a < user_b)
+);
+
+CREATE INDEX IF NOT EXISTS idx_twitch_user_links_user_b ON twitch_user_links (user_b);
+
+-- Candidate pools: users sharing followed channels and accounts created close together.
+CREATE INDEX IF NOT EXISTS idx_user_followed_channels_channel ON user_followed_channels (followed_channel_id);
+CREATE INDEX IF NOT EXISTS idx_twitch_user_helix_meta_created ON twitch_user_helix_meta (account_created_at)
+    WHERE account_created_at IS NOT NULL;
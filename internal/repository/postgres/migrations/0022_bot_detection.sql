@@ -0,0 +1,36 @@
+-- Lurker / view-bot detection from IRC presence: settings, accounts flagged as likely bots and the latest
+-- per-channel bot-share estimates.
+CREATE TABLE IF NOT EXISTS bot_detection_settings (
+    id SMALLINT PRIMARY KEY CHECK (id = 1),
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    min_concurrent_channels INT NOT NULL DEFAULT 8 CHECK (min_concurrent_channels >= 2),
+    max_messages_per_hour DOUBLE PRECISION NOT NULL DEFAULT 0.05 CHECK (max_messages_per_hour >= 0),
+    viewers_per_chatter DOUBLE PRECISION NOT NULL DEFAULT 4 CHECK (viewers_per_chatter >= 1),
+    exclude_from_stats BOOLEAN NOT NULL DEFAULT false
+);
+
+INSERT INTO bot_detection_settings (id) VALUES (1)
+    ON CONFLICT (id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS twitch_likely_bots (
+    twitch_user_id BIGINT PRIMARY KEY REFERENCES twitch_users (id) ON DELETE CASCADE,
+    concurrent_channels INT NOT NULL,
+    present_seconds BIGINT NOT NULL,
+    message_count BIGINT NOT NULL,
+    first_detected_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_detected_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_twitch_likely_bots_last_detected ON twitch_likely_bots (last_detected_at);
+
+CREATE TABLE IF NOT EXISTS channel_bot_estimates (
+    channel_twitch_user_id BIGINT PRIMARY KEY REFERENCES twitch_users (id) ON DELETE CASCADE,
+    viewer_count BIGINT NOT NULL,
+    chatter_count BIGINT NOT NULL,
+    bot_chatter_count BIGINT NOT NULL,
+    bot_share DOUBLE PRECISION NOT NULL,
+    viewer_inflated BOOLEAN NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_channel_chatters_chatter ON channel_chatters (chatter_twitch_user_id);
@@ -0,0 +1,10 @@
+-- Login pattern blacklist: regexes or globs matched against chatter logins (and their confusable-folded
+-- skeletons); a match marks the user suspicious with sus_type auto_login_pattern.
+CREATE TABLE IF NOT EXISTS login_patterns (
+    id BIGSERIAL PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('regex', 'glob')),
+    pattern TEXT NOT NULL CHECK (pattern <> ''),
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (kind, pattern)
+);
@@ -0,0 +1,27 @@
+-- External blocklists of known hate-raid / bot accounts, uploaded or pulled from a URL on a schedule.
+CREATE TABLE IF NOT EXISTS blocklists (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE CHECK (name <> ''),
+    format TEXT NOT NULL CHECK (format IN ('text', 'csv', 'json')),
+    source_url TEXT,
+    sync_interval_minutes INT NOT NULL DEFAULT 1440,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    source_filename TEXT,
+    content_sha256 TEXT,
+    entry_count INT NOT NULL DEFAULT 0,
+    last_imported_at TIMESTAMPTZ,
+    last_synced_at TIMESTAMPTZ,
+    last_sync_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS blocklist_entries (
+    blocklist_id BIGINT NOT NULL REFERENCES blocklists (id) ON DELETE CASCADE,
+    twitch_login TEXT,
+    twitch_user_id BIGINT,
+    CHECK (twitch_login IS NOT NULL OR twitch_user_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_blocklist_entries_list ON blocklist_entries (blocklist_id);
+CREATE INDEX IF NOT EXISTS idx_blocklist_entries_login ON blocklist_entries (twitch_login) WHERE twitch_login IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_blocklist_entries_user ON blocklist_entries (twitch_user_id) WHERE twitch_user_id IS NOT NULL;
@@ -0,0 +1,47 @@
+-- Channel analytics rollups kept current by the analytics rollup loop: hourly and daily activity per channel,
+-- each chatter's first message per channel (new-chatter counts), per-stream chatter retention and the watermark
+-- up to which chat history has been rolled up.
+CREATE TABLE IF NOT EXISTS channel_activity_rollups (
+    channel_twitch_user_id BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    granularity TEXT NOT NULL CHECK (granularity IN ('hour', 'day')),
+    bucket_start TIMESTAMPTZ NOT NULL,
+    message_count BIGINT NOT NULL DEFAULT 0,
+    unique_chatters INT NOT NULL DEFAULT 0,
+    new_chatters INT NOT NULL DEFAULT 0,
+    present_chatters INT NOT NULL DEFAULT 0,
+    presence_seconds BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (channel_twitch_user_id, granularity, bucket_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_channel_activity_rollups_bucket ON channel_activity_rollups (granularity, bucket_start);
+
+CREATE TABLE IF NOT EXISTS channel_chatter_first_messages (
+    channel_twitch_user_id BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    chatter_twitch_user_id BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    first_message_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (channel_twitch_user_id, chatter_twitch_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_channel_chatter_first_messages_at
+    ON channel_chatter_first_messages (channel_twitch_user_id, first_message_at);
+CREATE INDEX IF NOT EXISTS idx_channel_chatter_first_messages_first ON channel_chatter_first_messages (first_message_at);
+
+CREATE TABLE IF NOT EXISTS stream_chatter_retention (
+    stream_id BIGINT PRIMARY KEY REFERENCES streams (id) ON DELETE CASCADE,
+    channel_twitch_user_id BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    started_at TIMESTAMPTZ NOT NULL,
+    next_stream_id BIGINT REFERENCES streams (id) ON DELETE SET NULL,
+    chatters INT NOT NULL,
+    returning_chatters INT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stream_chatter_retention_channel ON stream_chatter_retention (channel_twitch_user_id, started_at);
+
+CREATE TABLE IF NOT EXISTS channel_analytics_state (
+    id SMALLINT PRIMARY KEY CHECK (id = 1),
+    rolled_up_until TIMESTAMPTZ
+);
+
+INSERT INTO channel_analytics_state (id) VALUES (1)
+    ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,6 @@
+-- Cached audience overlap between monitored channels, one row per trailing period.
+CREATE TABLE IF NOT EXISTS audience_overlap_cache (
+    period TEXT PRIMARY KEY CHECK (period IN ('24h', '7d', '30d', '90d')),
+    computed_at TIMESTAMPTZ NOT NULL,
+    result JSONB NOT NULL
+);
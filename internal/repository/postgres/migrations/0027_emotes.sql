@@ -0,0 +1,30 @@
+-- Emote occurrences per chat message (Twitch emotes from IRC tags and third-party codes detected from locally
+-- configured emote sets), with inclusive rune offsets into the message body.
+CREATE TABLE IF NOT EXISTS chat_message_emotes (
+    message_id BIGINT NOT NULL REFERENCES chat_messages (id) ON DELETE CASCADE,
+    start_pos INT NOT NULL,
+    end_pos INT NOT NULL,
+    provider TEXT NOT NULL,
+    emote_id TEXT NOT NULL,
+    code TEXT NOT NULL,
+    PRIMARY KEY (message_id, start_pos)
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_message_emotes_emote ON chat_message_emotes (provider, emote_id);
+
+CREATE TABLE IF NOT EXISTS emote_sets (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE CHECK (name <> ''),
+    provider TEXT NOT NULL CHECK (provider IN ('bttv', 'ffz', '7tv', 'custom')),
+    channel TEXT NOT NULL DEFAULT '',
+    emote_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS emote_set_emotes (
+    set_id BIGINT NOT NULL REFERENCES emote_sets (id) ON DELETE CASCADE,
+    code TEXT NOT NULL CHECK (code <> ''),
+    emote_id TEXT NOT NULL,
+    PRIMARY KEY (set_id, code)
+);
@@ -0,0 +1,16 @@
+-- Per-stream chat term counts (word n-grams and emote codes used in at least two messages), computed once a
+-- stream has ended; they form each channel's baseline for trending-term TF-IDF.
+CREATE TABLE IF NOT EXISTS stream_terms (
+    stream_id BIGINT NOT NULL REFERENCES streams (id) ON DELETE CASCADE,
+    term TEXT NOT NULL,
+    emote BOOLEAN NOT NULL DEFAULT false,
+    messages INT NOT NULL,
+    chatters INT NOT NULL,
+    PRIMARY KEY (stream_id, term, emote)
+);
+
+CREATE TABLE IF NOT EXISTS stream_terms_state (
+    stream_id BIGINT PRIMARY KEY REFERENCES streams (id) ON DELETE CASCADE,
+    message_count INT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
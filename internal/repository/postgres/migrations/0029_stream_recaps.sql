@@ -0,0 +1,24 @@
+-- Stream recaps computed when a stream is closed: per-stream viewer aggregates from Helix polls, the channel of
+-- each rule trigger, the stored recaps and the singleton recap settings.
+ALTER TABLE streams
+    ADD COLUMN IF NOT EXISTS peak_viewer_count BIGINT,
+    ADD COLUMN IF NOT EXISTS viewer_count_sum BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS viewer_count_samples INT NOT NULL DEFAULT 0;
+
+ALTER TABLE rule_trigger_events
+    ADD COLUMN IF NOT EXISTS channel TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS stream_recaps (
+    stream_id BIGINT PRIMARY KEY REFERENCES streams (id) ON DELETE CASCADE,
+    recap JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS stream_recap_settings (
+    id SMALLINT PRIMARY KEY CHECK (id = 1),
+    ai_summary BOOLEAN NOT NULL DEFAULT true,
+    notify BOOLEAN NOT NULL DEFAULT false
+);
+
+INSERT INTO stream_recap_settings (id) VALUES (1)
+    ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,16 @@
+-- Chat highlight moments detected live during a stream (velocity spikes, emote floods, phrase clusters).
+CREATE TABLE IF NOT EXISTS chat_moments (
+    id BIGSERIAL PRIMARY KEY,
+    stream_id BIGINT NOT NULL REFERENCES streams (id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    label TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ NOT NULL,
+    messages BIGINT NOT NULL,
+    chatters BIGINT NOT NULL,
+    intensity DOUBLE PRECISION NOT NULL,
+    sample_messages JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_moments_stream_started ON chat_moments (stream_id, started_at);
@@ -0,0 +1,14 @@
+-- Per-chatter daily activity in each channel, kept current by the analytics rollup loop and backing channel
+-- leaderboards over long ranges. The watermark is reset so existing chat history is rolled up again.
+CREATE TABLE IF NOT EXISTS channel_chatter_daily_rollups (
+    channel_twitch_user_id BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    chatter_twitch_user_id BIGINT NOT NULL REFERENCES twitch_users (id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    message_count BIGINT NOT NULL DEFAULT 0,
+    presence_seconds BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (channel_twitch_user_id, day, chatter_twitch_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_channel_chatter_daily_rollups_day ON channel_chatter_daily_rollups (day);
+
+UPDATE channel_analytics_state SET rolled_up_until = NULL WHERE id = 1;
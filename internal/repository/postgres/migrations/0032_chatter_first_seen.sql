@@ -0,0 +1,11 @@
+-- Dredge's own first-seen records. channel_chatter_first_messages (per channel, until now filled only by the
+-- analytics rollup) is also written at ingestion, and chatter_first_seen keeps each chatter's first message in any
+-- monitored channel. Both are backfilled from stored chat history in bounded chunks by the analytics rollup loop,
+-- whose watermark is reset here, rather than in this transaction.
+CREATE TABLE IF NOT EXISTS chatter_first_seen (
+    chatter_twitch_user_id BIGINT PRIMARY KEY REFERENCES twitch_users (id) ON DELETE CASCADE,
+    channel_twitch_user_id BIGINT REFERENCES twitch_users (id) ON DELETE SET NULL,
+    first_seen_at TIMESTAMPTZ NOT NULL
+);
+
+UPDATE channel_analytics_state SET rolled_up_until = NULL WHERE id = 1;
@@ -0,0 +1,30 @@
+-- AI chat classification: toxicity, harassment and sentiment labels of sampled chat messages, the singleton
+-- classifier settings with its message cursor, the channels it runs on and the tokens it spent per UTC day.
+CREATE TABLE IF NOT EXISTS chat_message_labels (
+    chat_message_id BIGINT PRIMARY KEY REFERENCES chat_messages (id) ON DELETE CASCADE,
+    toxicity REAL NOT NULL,
+    harassment REAL NOT NULL,
+    sentiment REAL NOT NULL,
+    classified_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS chat_classifier_settings (
+    id SMALLINT PRIMARY KEY CHECK (id = 1),
+    enabled BOOLEAN NOT NULL DEFAULT false,
+    sample_rate DOUBLE PRECISION NOT NULL DEFAULT 0.2,
+    batch_size INT NOT NULL DEFAULT 20,
+    daily_token_budget BIGINT NOT NULL DEFAULT 200000,
+    cursor_message_id BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO chat_classifier_settings (id) VALUES (1)
+    ON CONFLICT (id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS chat_classifier_channels (
+    channel_twitch_user_id BIGINT PRIMARY KEY REFERENCES twitch_users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS chat_classifier_usage (
+    day DATE PRIMARY KEY,
+    tokens BIGINT NOT NULL DEFAULT 0
+);
@@ -4,12 +4,102 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/rofleksey/dredge/internal/entity"
 	"go.uber.org/zap"
 )
 
+const notificationEntryColumns = `id, provider, settings, enabled, created_at, tags, event_types, channels,
+	quiet_hours_start, quiet_hours_end, quiet_hours_timezone, flood_threshold, flood_window_seconds`
+
+// notificationEntryRow holds the columns of a notification entry that need decoding after Scan.
+type notificationEntryRow struct {
+	raw                             []byte
+	quietStart, quietEnd, quietZone string
+	floodWindowSeconds              int
+}
+
+// dest returns Scan destinations in notificationEntryColumns order.
+func (row *notificationEntryRow) dest(e *entity.NotificationEntry) []any {
+	return []any{
+		&e.ID, &e.Provider, &row.raw, &e.Enabled, &e.CreatedAt, &e.Tags, &e.EventTypes, &e.Channels,
+		&row.quietStart, &row.quietEnd, &row.quietZone, &e.FloodThreshold, &row.floodWindowSeconds,
+	}
+}
+
+func (row *notificationEntryRow) finish(e *entity.NotificationEntry) {
+	e.Settings = settingsFromJSON(row.raw)
+	e.FloodWindow = time.Duration(row.floodWindowSeconds) * time.Second
+
+	if row.quietStart != "" {
+		e.QuietHours = &entity.NotificationQuietHours{Start: row.quietStart, End: row.quietEnd, Timezone: row.quietZone}
+	}
+}
+
+func scanNotificationEntry(scanner interface {
+	Scan(dest ...any) error
+}) (entity.NotificationEntry, error) {
+	var (
+		e   entity.NotificationEntry
+		row notificationEntryRow
+	)
+
+	if err := scanner.Scan(row.dest(&e)...); err != nil {
+		return e, err
+	}
+
+	row.finish(&e)
+
+	return e, nil
+}
+
+// settingsFromJSON decodes a provider settings jsonb column, never returning nil.
+func settingsFromJSON(raw []byte) map[string]any {
+	var settings map[string]any
+
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &settings)
+	}
+
+	if settings == nil {
+		settings = map[string]any{}
+	}
+
+	return settings
+}
+
+// notificationRoutingArgs returns non-nil slices so NOT NULL array columns never receive SQL NULL.
+func notificationRoutingArgs(r entity.NotificationRouting) ([]string, []string, []string) {
+	tags := r.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	events := r.EventTypes
+	if events == nil {
+		events = []string{}
+	}
+
+	channels := r.Channels
+	if channels == nil {
+		channels = []string{}
+	}
+
+	return tags, events, channels
+}
+
+// notificationPolicyArgs flattens a policy into the quiet_hours_* and flood_* column values.
+func notificationPolicyArgs(p entity.NotificationPolicy) (string, string, string, int, int) {
+	var q entity.NotificationQuietHours
+	if p.QuietHours != nil {
+		q = *p.QuietHours
+	}
+
+	return q.Start, q.End, q.Timezone, p.FloodThreshold, int(p.FloodWindow / time.Second)
+}
+
 func (r *Repository) ListNotificationEntries(ctx context.Context, f entity.NotificationListFilter) ([]entity.NotificationEntry, error) {
 	ctx, span := r.obs.StartSpan(ctx, "repo.list_notification_entries")
 	defer span.End()
@@ -23,7 +113,7 @@ func (r *Repository) ListNotificationEntries(ctx context.Context, f entity.Notif
 	}
 
 	rows, err := r.pool.Query(ctx, `
-		SELECT id, provider, settings, enabled, created_at
+		SELECT `+notificationEntryColumns+`
 		FROM notification_entries
 		WHERE ($1::timestamptz IS NULL OR $2::bigint IS NULL OR (created_at, id) < ($1, $2))
 		ORDER BY created_at DESC, id DESC
@@ -38,24 +128,12 @@ func (r *Repository) ListNotificationEntries(ctx context.Context, f entity.Notif
 	out := make([]entity.NotificationEntry, 0)
 
 	for rows.Next() {
-		var (
-			e   entity.NotificationEntry
-			raw []byte
-		)
-
-		if err := rows.Scan(&e.ID, &e.Provider, &raw, &e.Enabled, &e.CreatedAt); err != nil {
+		e, err := scanNotificationEntry(rows)
+		if err != nil {
 			r.obs.LogError(ctx, span, "scan notification entry failed", err)
 			return nil, err
 		}
 
-		if len(raw) > 0 {
-			_ = json.Unmarshal(raw, &e.Settings)
-		}
-
-		if e.Settings == nil {
-			e.Settings = map[string]any{}
-		}
-
 		out = append(out, e)
 	}
 
@@ -72,7 +150,7 @@ func (r *Repository) ListEnabledNotificationEntries(ctx context.Context) ([]enti
 	defer span.End()
 
 	rows, err := r.pool.Query(ctx, `
-		SELECT id, provider, settings, enabled, created_at
+		SELECT `+notificationEntryColumns+`
 		FROM notification_entries WHERE enabled = true ORDER BY id
 	`)
 	if err != nil {
@@ -84,24 +162,12 @@ func (r *Repository) ListEnabledNotificationEntries(ctx context.Context) ([]enti
 	out := make([]entity.NotificationEntry, 0)
 
 	for rows.Next() {
-		var (
-			e   entity.NotificationEntry
-			raw []byte
-		)
-
-		if err := rows.Scan(&e.ID, &e.Provider, &raw, &e.Enabled, &e.CreatedAt); err != nil {
+		e, err := scanNotificationEntry(rows)
+		if err != nil {
 			r.obs.LogError(ctx, span, "scan notification entry failed", err)
 			return nil, err
 		}
 
-		if len(raw) > 0 {
-			_ = json.Unmarshal(raw, &e.Settings)
-		}
-
-		if e.Settings == nil {
-			e.Settings = map[string]any{}
-		}
-
 		out = append(out, e)
 	}
 
@@ -113,7 +179,7 @@ func (r *Repository) ListEnabledNotificationEntries(ctx context.Context) ([]enti
 	return out, nil
 }
 
-func (r *Repository) CreateNotificationEntry(ctx context.Context, provider string, settings map[string]any, enabled bool) (entity.NotificationEntry, error) {
+func (r *Repository) CreateNotificationEntry(ctx context.Context, provider string, settings map[string]any, enabled bool, routing entity.NotificationRouting, policy entity.NotificationPolicy) (entity.NotificationEntry, error) {
 	ctx, span := r.obs.StartSpan(ctx, "repo.create_notification_entry")
 	defer span.End()
 
@@ -126,39 +192,51 @@ func (r *Repository) CreateNotificationEntry(ctx context.Context, provider strin
 		return entity.NotificationEntry{}, err
 	}
 
-	var e entity.NotificationEntry
+	tags, events, channels := notificationRoutingArgs(routing)
+	quietStart, quietEnd, quietZone, floodThreshold, floodWindow := notificationPolicyArgs(policy)
 
-	err = r.pool.QueryRow(ctx, `
-		INSERT INTO notification_entries (provider, settings, enabled)
-		VALUES ($1, $2::jsonb, $3)
-		RETURNING id, provider, settings, enabled, created_at
-	`, provider, raw, enabled).Scan(&e.ID, &e.Provider, &raw, &e.Enabled, &e.CreatedAt)
+	e, err := scanNotificationEntry(r.pool.QueryRow(ctx, `
+		INSERT INTO notification_entries (provider, settings, enabled, tags, event_types, channels,
+			quiet_hours_start, quiet_hours_end, quiet_hours_timezone, flood_threshold, flood_window_seconds)
+		VALUES ($1, $2::jsonb, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING `+notificationEntryColumns+`
+	`, provider, raw, enabled, tags, events, channels, quietStart, quietEnd, quietZone, floodThreshold, floodWindow))
 	if err != nil {
 		r.obs.LogError(ctx, span, "create notification entry failed", err)
 		return entity.NotificationEntry{}, err
 	}
 
-	_ = json.Unmarshal(raw, &e.Settings)
-	if e.Settings == nil {
-		e.Settings = map[string]any{}
+	return e, nil
+}
+
+func (r *Repository) GetNotificationEntry(ctx context.Context, id int64) (entity.NotificationEntry, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_notification_entry")
+	defer span.End()
+
+	e, err := scanNotificationEntry(r.pool.QueryRow(ctx, `
+		SELECT `+notificationEntryColumns+` FROM notification_entries WHERE id = $1
+	`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NotificationEntry{}, entity.ErrNotificationNotFound
+		}
+
+		r.obs.LogError(ctx, span, "get notification entry failed", err, zap.Int64("id", id))
+
+		return entity.NotificationEntry{}, err
 	}
 
 	return e, nil
 }
 
-func (r *Repository) UpdateNotificationEntry(ctx context.Context, id int64, provider *string, settings map[string]any, enabled *bool) (entity.NotificationEntry, error) {
+func (r *Repository) UpdateNotificationEntry(ctx context.Context, id int64, provider *string, settings map[string]any, enabled *bool, routing *entity.NotificationRouting, policy *entity.NotificationPolicy) (entity.NotificationEntry, error) {
 	ctx, span := r.obs.StartSpan(ctx, "repo.update_notification_entry")
 	defer span.End()
 
 	// Load current
-	var (
-		cur entity.NotificationEntry
-		raw []byte
-	)
-
-	err := r.pool.QueryRow(ctx, `
-		SELECT id, provider, settings, enabled, created_at FROM notification_entries WHERE id = $1
-	`, id).Scan(&cur.ID, &cur.Provider, &raw, &cur.Enabled, &cur.CreatedAt)
+	cur, err := scanNotificationEntry(r.pool.QueryRow(ctx, `
+		SELECT `+notificationEntryColumns+` FROM notification_entries WHERE id = $1
+	`, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return entity.NotificationEntry{}, entity.ErrNotificationNotFound
@@ -166,11 +244,6 @@ func (r *Repository) UpdateNotificationEntry(ctx context.Context, id int64, prov
 		return entity.NotificationEntry{}, err
 	}
 
-	_ = json.Unmarshal(raw, &cur.Settings)
-	if cur.Settings == nil {
-		cur.Settings = map[string]any{}
-	}
-
 	if provider != nil {
 		cur.Provider = *provider
 	}
@@ -183,24 +256,36 @@ func (r *Repository) UpdateNotificationEntry(ctx context.Context, id int64, prov
 		cur.Enabled = *enabled
 	}
 
-	raw, err = json.Marshal(cur.Settings)
+	if routing != nil {
+		cur.NotificationRouting = *routing
+	}
+
+	if policy != nil {
+		cur.NotificationPolicy = *policy
+	}
+
+	raw, err := json.Marshal(cur.Settings)
 	if err != nil {
 		return entity.NotificationEntry{}, err
 	}
 
-	err = r.pool.QueryRow(ctx, `
-		UPDATE notification_entries SET provider = $2, settings = $3::jsonb, enabled = $4
+	tags, events, channels := notificationRoutingArgs(cur.NotificationRouting)
+	quietStart, quietEnd, quietZone, floodThreshold, floodWindow := notificationPolicyArgs(cur.NotificationPolicy)
+
+	out, err := scanNotificationEntry(r.pool.QueryRow(ctx, `
+		UPDATE notification_entries
+		SET provider = $2, settings = $3::jsonb, enabled = $4, tags = $5, event_types = $6, channels = $7,
+			quiet_hours_start = $8, quiet_hours_end = $9, quiet_hours_timezone = $10,
+			flood_threshold = $11, flood_window_seconds = $12
 		WHERE id = $1
-		RETURNING id, provider, settings, enabled, created_at
-	`, id, cur.Provider, raw, cur.Enabled).Scan(&cur.ID, &cur.Provider, &raw, &cur.Enabled, &cur.CreatedAt)
+		RETURNING `+notificationEntryColumns+`
+	`, id, cur.Provider, raw, cur.Enabled, tags, events, channels, quietStart, quietEnd, quietZone, floodThreshold, floodWindow))
 	if err != nil {
 		r.obs.LogError(ctx, span, "update notification entry failed", err, zap.Int64("id", id))
 		return entity.NotificationEntry{}, err
 	}
 
-	_ = json.Unmarshal(raw, &cur.Settings)
-
-	return cur, nil
+	return out, nil
 }
 
 func (r *Repository) DeleteNotificationEntry(ctx context.Context, id int64) error {
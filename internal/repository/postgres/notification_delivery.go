@@ -0,0 +1,413 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rofleksey/dredge/internal/entity"
+	"go.uber.org/zap"
+)
+
+const notificationDeliveryColumns = `d.id, d.event_id::text, d.notification_entry_id, d.event_type, d.channel, d.username, d.message, d.title, d.text,
+	d.status, d.attempts, d.next_attempt_at, d.last_status_code, d.last_error, d.delivered_at, d.created_at, d.updated_at`
+
+func scanNotificationDelivery(scanner interface {
+	Scan(dest ...any) error
+}, extra ...any) (entity.NotificationDelivery, error) {
+	var (
+		d          entity.NotificationDelivery
+		statusCode pgtype.Int4
+		lastErr    pgtype.Text
+		delivered  pgtype.Timestamptz
+	)
+
+	dest := []any{
+		&d.ID, &d.EventID, &d.NotificationEntryID, &d.Event.Type, &d.Event.Channel, &d.Event.User, &d.Event.Message, &d.Event.Title, &d.Event.Text,
+		&d.Status, &d.Attempts, &d.NextAttemptAt, &statusCode, &lastErr, &delivered, &d.CreatedAt, &d.UpdatedAt,
+	}
+
+	if err := scanner.Scan(append(dest, extra...)...); err != nil {
+		return d, err
+	}
+
+	if statusCode.Valid {
+		v := int(statusCode.Int32)
+		d.LastStatusCode = &v
+	}
+
+	if lastErr.Valid {
+		v := lastErr.String
+		d.LastError = &v
+	}
+
+	if delivered.Valid {
+		v := delivered.Time
+		d.DeliveredAt = &v
+	}
+
+	return d, nil
+}
+
+// EnqueueNotificationDeliveries inserts one pending outbox row per entry id for the same event.
+// A zero notBefore makes the rows due immediately; digest entries pass the end of their batching window.
+func (r *Repository) EnqueueNotificationDeliveries(ctx context.Context, entryIDs []int64, ev entity.NotificationEvent, notBefore time.Time) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.enqueue_notification_deliveries")
+	defer span.End()
+
+	if len(entryIDs) == 0 {
+		return nil
+	}
+
+	var due pgtype.Timestamptz
+	if !notBefore.IsZero() {
+		due = pgtype.Timestamptz{Time: notBefore, Valid: true}
+	}
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO notification_deliveries (notification_entry_id, event_type, channel, username, message, title, text, next_attempt_at)
+		SELECT entry_id, $2, $3, $4, $5, $6, $7, COALESCE($8, NOW()) FROM unnest($1::bigint[]) AS entry_id
+	`, entryIDs, ev.Type, ev.Channel, ev.User, ev.Message, ev.Title, ev.Text, due)
+	if err != nil {
+		r.obs.LogError(ctx, span, "enqueue notification deliveries failed", err, zap.String("event_type", ev.Type))
+		return err
+	}
+
+	return nil
+}
+
+// RecordSuppressedNotificationDeliveries stores one suppressed outbox row per entry id so events held back
+// by quiet hours, snoozes or flood control still show up in the delivery log; reason goes to last_error.
+func (r *Repository) RecordSuppressedNotificationDeliveries(ctx context.Context, entryIDs []int64, ev entity.NotificationEvent, reason string) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.record_suppressed_notification_deliveries")
+	defer span.End()
+
+	if len(entryIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO notification_deliveries (notification_entry_id, event_type, channel, username, message, title, text, status, last_error)
+		SELECT entry_id, $2, $3, $4, $5, $6, $7, 'suppressed', $8 FROM unnest($1::bigint[]) AS entry_id
+	`, entryIDs, ev.Type, ev.Channel, ev.User, ev.Message, ev.Title, ev.Text, reason)
+	if err != nil {
+		r.obs.LogError(ctx, span, "record suppressed notification deliveries failed", err, zap.String("event_type", ev.Type))
+		return err
+	}
+
+	return nil
+}
+
+// ClaimNotificationDeliveries leases up to limit due rows (pending, or sending with an expired lease)
+// by marking them sending until now()+lease. Concurrent workers skip each other's locked rows.
+func (r *Repository) ClaimNotificationDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entity.NotificationDeliveryJob, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.claim_notification_deliveries")
+	defer span.End()
+
+	if limit <= 0 {
+		limit = 1
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		WITH due AS (
+			SELECT id FROM notification_deliveries
+			WHERE status IN ('pending', 'sending') AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), claimed AS (
+			UPDATE notification_deliveries d
+			SET status = 'sending', next_attempt_at = NOW() + make_interval(secs => $2), updated_at = NOW()
+			FROM due WHERE d.id = due.id
+			RETURNING d.*
+		)
+		SELECT `+notificationDeliveryColumns+`,
+			n.id, n.provider, n.settings, n.enabled, n.created_at, n.tags, n.event_types, n.channels,
+			n.quiet_hours_start, n.quiet_hours_end, n.quiet_hours_timezone, n.flood_threshold, n.flood_window_seconds
+		FROM claimed d
+		JOIN notification_entries n ON n.id = d.notification_entry_id
+		ORDER BY d.id
+	`, limit, lease.Seconds())
+	if err != nil {
+		r.obs.LogError(ctx, span, "claim notification deliveries failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]entity.NotificationDeliveryJob, 0)
+
+	for rows.Next() {
+		var (
+			job   entity.NotificationDeliveryJob
+			entry notificationEntryRow
+		)
+
+		job.Delivery, err = scanNotificationDelivery(rows, entry.dest(&job.Entry)...)
+		if err != nil {
+			r.obs.LogError(ctx, span, "scan claimed notification delivery failed", err)
+			return nil, err
+		}
+
+		entry.finish(&job.Entry)
+
+		out = append(out, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.obs.LogError(ctx, span, "claimed notification rows iteration failed", err)
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ClaimEntryNotificationDeliveries leases up to limit due rows of one entry, oldest first. Digest
+// delivery uses it to fold every due event of an entry into one message.
+func (r *Repository) ClaimEntryNotificationDeliveries(ctx context.Context, entryID int64, limit int, lease time.Duration) ([]entity.NotificationDelivery, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.claim_entry_notification_deliveries")
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		WITH due AS (
+			SELECT id FROM notification_deliveries
+			WHERE notification_entry_id = $1 AND status IN ('pending', 'sending') AND next_attempt_at <= NOW()
+			ORDER BY id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		), claimed AS (
+			UPDATE notification_deliveries d
+			SET status = 'sending', next_attempt_at = NOW() + make_interval(secs => $3), updated_at = NOW()
+			FROM due WHERE d.id = due.id
+			RETURNING d.*
+		)
+		SELECT `+notificationDeliveryColumns+`
+		FROM claimed d
+		ORDER BY d.id
+	`, entryID, limit, lease.Seconds())
+	if err != nil {
+		r.obs.LogError(ctx, span, "claim entry notification deliveries failed", err, zap.Int64("notification_id", entryID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]entity.NotificationDelivery, 0)
+
+	for rows.Next() {
+		d, err := scanNotificationDelivery(rows)
+		if err != nil {
+			r.obs.LogError(ctx, span, "scan claimed notification delivery failed", err)
+			return nil, err
+		}
+
+		out = append(out, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.obs.LogError(ctx, span, "claimed notification rows iteration failed", err)
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// CompleteNotificationDeliveryAttempt records one attempt and moves the delivery to status
+// (pending with nextAttemptAt for retries, delivered, or dead) in one transaction.
+func (r *Repository) CompleteNotificationDeliveryAttempt(ctx context.Context, deliveryID int64, attempt entity.NotificationDeliveryAttempt, status string, nextAttemptAt time.Time) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.complete_notification_delivery_attempt")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "complete notification delivery begin tx failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO notification_delivery_attempts (delivery_id, attempted_at, status_code, response_snippet, error, duration_ms)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, deliveryID, attempt.AttemptedAt, attempt.StatusCode, attempt.ResponseSnippet, attempt.Error, attempt.DurationMs); err != nil {
+		r.obs.LogError(ctx, span, "insert notification delivery attempt failed", err, zap.Int64("delivery_id", deliveryID))
+		return err
+	}
+
+	var lastErr *string
+	if attempt.Error != "" {
+		lastErr = &attempt.Error
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE notification_deliveries
+		SET status = $2,
+			attempts = attempts + 1,
+			next_attempt_at = $3,
+			last_status_code = $4,
+			last_error = $5,
+			delivered_at = CASE WHEN $2 = 'delivered' THEN NOW() ELSE delivered_at END,
+			updated_at = NOW()
+		WHERE id = $1
+	`, deliveryID, status, nextAttemptAt, attempt.StatusCode, lastErr); err != nil {
+		r.obs.LogError(ctx, span, "update notification delivery failed", err, zap.Int64("delivery_id", deliveryID))
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "complete notification delivery commit failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListNotificationDeliveries returns the delivery log (newest first) with each row's attempts attached.
+func (r *Repository) ListNotificationDeliveries(ctx context.Context, f entity.NotificationDeliveryListFilter) ([]entity.NotificationDelivery, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_notification_deliveries")
+	defer span.End()
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	if limit > 200 {
+		limit = 200
+	}
+
+	var status *string
+	if f.Status != "" {
+		status = &f.Status
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+notificationDeliveryColumns+`
+		FROM notification_deliveries d
+		WHERE ($1::bigint IS NULL OR d.notification_entry_id = $1)
+			AND ($2::text IS NULL OR d.status = $2)
+			AND ($3::timestamptz IS NULL OR $4::bigint IS NULL OR (d.created_at, d.id) < ($3, $4))
+		ORDER BY d.created_at DESC, d.id DESC
+		LIMIT $5
+	`, f.NotificationEntryID, status, f.CursorCreatedAt, f.CursorID, limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list notification deliveries failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]entity.NotificationDelivery, 0)
+	ids := make([]int64, 0)
+
+	for rows.Next() {
+		d, err := scanNotificationDelivery(rows)
+		if err != nil {
+			r.obs.LogError(ctx, span, "scan notification delivery failed", err)
+			return nil, err
+		}
+
+		out = append(out, d)
+		ids = append(ids, d.ID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.obs.LogError(ctx, span, "notification delivery rows iteration failed", err)
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	attempts, err := r.listNotificationDeliveryAttempts(ctx, ids)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list notification delivery attempts failed", err)
+		return nil, err
+	}
+
+	for i := range out {
+		out[i].AttemptLog = attempts[out[i].ID]
+	}
+
+	return out, nil
+}
+
+func (r *Repository) listNotificationDeliveryAttempts(ctx context.Context, deliveryIDs []int64) (map[int64][]entity.NotificationDeliveryAttempt, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, delivery_id, attempted_at, status_code, response_snippet, error, duration_ms
+		FROM notification_delivery_attempts
+		WHERE delivery_id = ANY($1::bigint[])
+		ORDER BY attempted_at DESC, id DESC
+	`, deliveryIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[int64][]entity.NotificationDeliveryAttempt, len(deliveryIDs))
+
+	for rows.Next() {
+		var (
+			a          entity.NotificationDeliveryAttempt
+			statusCode pgtype.Int4
+		)
+
+		if err := rows.Scan(&a.ID, &a.DeliveryID, &a.AttemptedAt, &statusCode, &a.ResponseSnippet, &a.Error, &a.DurationMs); err != nil {
+			return nil, err
+		}
+
+		if statusCode.Valid {
+			v := int(statusCode.Int32)
+			a.StatusCode = &v
+		}
+
+		out[a.DeliveryID] = append(out[a.DeliveryID], a)
+	}
+
+	return out, rows.Err()
+}
+
+// RequeueNotificationDelivery resets a delivery to pending with a fresh retry budget (manual re-send).
+// Previous attempts stay in the log.
+func (r *Repository) RequeueNotificationDelivery(ctx context.Context, id int64) (entity.NotificationDelivery, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.requeue_notification_delivery")
+	defer span.End()
+
+	d, err := scanNotificationDelivery(r.pool.QueryRow(ctx, `
+		UPDATE notification_deliveries d
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW(), updated_at = NOW()
+		WHERE d.id = $1
+		RETURNING `+notificationDeliveryColumns+`
+	`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NotificationDelivery{}, entity.ErrNotificationDeliveryNotFound
+		}
+
+		r.obs.LogError(ctx, span, "requeue notification delivery failed", err, zap.Int64("id", id))
+		return entity.NotificationDelivery{}, err
+	}
+
+	return d, nil
+}
+
+// PruneNotificationDeliveries deletes delivered, dead and suppressed rows last updated before the cutoff.
+func (r *Repository) PruneNotificationDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.prune_notification_deliveries")
+	defer span.End()
+
+	tag, err := r.pool.Exec(ctx, `
+		DELETE FROM notification_deliveries
+		WHERE status IN ('delivered', 'dead', 'suppressed') AND updated_at < $1
+	`, before)
+	if err != nil {
+		r.obs.LogError(ctx, span, "prune notification deliveries failed", err)
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rofleksey/dredge/internal/entity"
+	"go.uber.org/zap"
+)
+
+const notificationSnoozeColumns = `id, notification_entry_id, channel, until, created_at`
+
+func scanNotificationSnooze(scanner interface {
+	Scan(dest ...any) error
+}) (entity.NotificationSnooze, error) {
+	var (
+		s       entity.NotificationSnooze
+		entryID pgtype.Int8
+	)
+
+	if err := scanner.Scan(&s.ID, &entryID, &s.Channel, &s.Until, &s.CreatedAt); err != nil {
+		return s, err
+	}
+
+	if entryID.Valid {
+		v := entryID.Int64
+		s.NotificationEntryID = &v
+	}
+
+	return s, nil
+}
+
+// CreateNotificationSnooze stores a mute; the caller has normalized the channel.
+func (r *Repository) CreateNotificationSnooze(ctx context.Context, s entity.NotificationSnooze) (entity.NotificationSnooze, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.create_notification_snooze")
+	defer span.End()
+
+	out, err := scanNotificationSnooze(r.pool.QueryRow(ctx, `
+		INSERT INTO notification_snoozes (notification_entry_id, channel, until)
+		VALUES ($1, $2, $3)
+		RETURNING `+notificationSnoozeColumns+`
+	`, s.NotificationEntryID, s.Channel, s.Until))
+	if err != nil {
+		r.obs.LogError(ctx, span, "create notification snooze failed", err, zap.String("channel", s.Channel))
+		return entity.NotificationSnooze{}, err
+	}
+
+	return out, nil
+}
+
+// ListActiveNotificationSnoozes returns snoozes that have not expired at at, soonest expiry first.
+func (r *Repository) ListActiveNotificationSnoozes(ctx context.Context, at time.Time) ([]entity.NotificationSnooze, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_active_notification_snoozes")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+notificationSnoozeColumns+` FROM notification_snoozes
+		WHERE until > $1
+		ORDER BY until, id
+	`, at)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list notification snoozes failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]entity.NotificationSnooze, 0)
+
+	for rows.Next() {
+		s, err := scanNotificationSnooze(rows)
+		if err != nil {
+			r.obs.LogError(ctx, span, "scan notification snooze failed", err)
+			return nil, err
+		}
+
+		out = append(out, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.obs.LogError(ctx, span, "notification snooze rows iteration failed", err)
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// DeleteNotificationSnooze removes a snooze before it expires.
+func (r *Repository) DeleteNotificationSnooze(ctx context.Context, id int64) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.delete_notification_snooze")
+	defer span.End()
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM notification_snoozes WHERE id = $1`, id)
+	if err != nil {
+		r.obs.LogError(ctx, span, "delete notification snooze failed", err, zap.Int64("id", id))
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrNotificationSnoozeNotFound
+	}
+
+	return nil
+}
+
+// PruneNotificationSnoozes deletes snoozes that expired before before.
+func (r *Repository) PruneNotificationSnoozes(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.prune_notification_snoozes")
+	defer span.End()
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM notification_snoozes WHERE until < $1`, before)
+	if err != nil {
+		r.obs.LogError(ctx, span, "prune notification snoozes failed", err)
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -141,13 +141,17 @@ func TestRepository_integration(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	notif, err := repo.CreateNotificationEntry(ctx, "telegram", map[string]any{"k": "v"}, true)
+	notif, err := repo.CreateNotificationEntry(ctx, "telegram", map[string]any{"k": "v"}, true, entity.NotificationRouting{Tags: []string{"mods"}}, entity.NotificationPolicy{})
 	require.NoError(t, err)
 	time.Sleep(5 * time.Millisecond)
-	notif2, err := repo.CreateNotificationEntry(ctx, "webhook", map[string]any{"url": "https://example.org/a"}, true)
+	notif2, err := repo.CreateNotificationEntry(ctx, "webhook", map[string]any{"url": "https://example.org/a"}, true, entity.NotificationRouting{}, entity.NotificationPolicy{
+		QuietHours:     &entity.NotificationQuietHours{Start: "23:00", End: "07:00", Timezone: "Europe/Berlin"},
+		FloodThreshold: 5,
+		FloodWindow:    time.Minute,
+	})
 	require.NoError(t, err)
 	time.Sleep(5 * time.Millisecond)
-	notif3, err := repo.CreateNotificationEntry(ctx, "telegram", map[string]any{"chat_id": "1"}, false)
+	notif3, err := repo.CreateNotificationEntry(ctx, "telegram", map[string]any{"chat_id": "1"}, false, entity.NotificationRouting{}, entity.NotificationPolicy{})
 	require.NoError(t, err)
 
 	entries, err := repo.ListNotificationEntries(ctx, entity.NotificationListFilter{Limit: 2})
@@ -171,8 +175,75 @@ func TestRepository_integration(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, enabled)
 
-	_, err = repo.UpdateNotificationEntry(ctx, notif.ID, entity.ToPointer("webhook"), map[string]any{"u": "x"}, entity.ToPointer(false))
+	updatedNotif, err := repo.UpdateNotificationEntry(ctx, notif.ID, entity.ToPointer("webhook"), map[string]any{"u": "x"}, entity.ToPointer(false), &entity.NotificationRouting{
+		EventTypes: []string{"stream_start"},
+		Channels:   []string{"chan_a"},
+	}, &entity.NotificationPolicy{FloodThreshold: 2, FloodWindow: 30 * time.Second})
+	require.NoError(t, err)
+	assert.Empty(t, updatedNotif.Tags)
+	assert.Nil(t, updatedNotif.QuietHours)
+	assert.Equal(t, 2, updatedNotif.FloodThreshold)
+	assert.Equal(t, 30*time.Second, updatedNotif.FloodWindow)
+	assert.Equal(t, []string{"stream_start"}, updatedNotif.EventTypes)
+	assert.Equal(t, []string{"chan_a"}, updatedNotif.Channels)
+
+	gotNotif, err := repo.GetNotificationEntry(ctx, notif.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "webhook", gotNotif.Provider)
+	assert.Equal(t, map[string]any{"u": "x"}, gotNotif.Settings)
+
+	discordNotif, err := repo.CreateNotificationEntry(ctx, "discord", map[string]any{"webhook_url": "https://discord.example/x"}, false, entity.NotificationRouting{}, entity.NotificationPolicy{})
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteNotificationEntry(ctx, discordNotif.ID))
+
+	require.NoError(t, repo.EnqueueNotificationDeliveries(ctx, []int64{notif2.ID}, entity.NotificationEvent{
+		Type: "rule_text", Channel: "chan_a", Text: "hi",
+	}, time.Time{}))
+
+	jobs, err := repo.ClaimNotificationDeliveries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, notif2.ID, jobs[0].Entry.ID)
+	assert.Equal(t, "https://example.org/a", jobs[0].Entry.Settings["url"])
+	assert.Equal(t, &entity.NotificationQuietHours{Start: "23:00", End: "07:00", Timezone: "Europe/Berlin"}, jobs[0].Entry.QuietHours)
+	assert.Equal(t, 5, jobs[0].Entry.FloodThreshold)
+	assert.Equal(t, entity.NotificationDeliverySending, jobs[0].Delivery.Status)
+
+	again, err := repo.ClaimNotificationDeliveries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+
+	require.NoError(t, repo.CompleteNotificationDeliveryAttempt(ctx, jobs[0].Delivery.ID, entity.NotificationDeliveryAttempt{
+		AttemptedAt: time.Now(), StatusCode: entity.ToPointer(500), ResponseSnippet: "boom", Error: "unexpected status 500",
+	}, entity.NotificationDeliveryDead, time.Now()))
+
+	deliveries, err := repo.ListNotificationDeliveries(ctx, entity.NotificationDeliveryListFilter{NotificationEntryID: &notif2.ID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, entity.NotificationDeliveryDead, deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	require.Len(t, deliveries[0].AttemptLog, 1)
+	assert.Equal(t, "boom", deliveries[0].AttemptLog[0].ResponseSnippet)
+
+	requeued, err := repo.RequeueNotificationDelivery(ctx, deliveries[0].ID)
 	require.NoError(t, err)
+	assert.Equal(t, entity.NotificationDeliveryPending, requeued.Status)
+	assert.Equal(t, 0, requeued.Attempts)
+
+	_, err = repo.RequeueNotificationDelivery(ctx, 777_777)
+	assert.ErrorIs(t, err, entity.ErrNotificationDeliveryNotFound)
+
+	for _, at := range []time.Time{{}, time.Now().Add(-time.Minute), time.Now().Add(time.Hour)} {
+		require.NoError(t, repo.EnqueueNotificationDeliveries(ctx, []int64{notif3.ID}, entity.NotificationEvent{
+			Type: "rule_text", Channel: "chan_a", Text: "digest",
+		}, at))
+	}
+
+	digest, err := repo.ClaimEntryNotificationDeliveries(ctx, notif3.ID, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, digest, 2)
+	assert.Equal(t, notif3.ID, digest[0].NotificationEntryID)
+	assert.Equal(t, entity.NotificationDeliverySending, digest[1].Status)
 
 	_, err = repo.InsertChatMessage(ctx, 0, nil, "x", "b", false, "irc", nil, false)
 	require.Error(t, err)
@@ -321,6 +392,11 @@ func TestRepository_integration(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, chatters, 2)
 
+	presence, err := repo.ListChatterChannelPresence(ctx, chatterID)
+	require.NoError(t, err)
+	require.Len(t, presence, 1)
+	assert.Equal(t, "channel1", presence[0].ChannelLogin)
+
 	ch100 := channelID
 	require.NoError(t, repo.InsertUserActivityEvent(ctx, chatterID, entity.UserActivityChatOnline, &ch100, map[string]any{"x": 1}))
 
@@ -372,7 +448,7 @@ func TestRepository_integration(t *testing.T) {
 	})
 	assert.ErrorIs(t, err, entity.ErrRuleNotFound)
 
-	require.NoError(t, repo.InsertRuleTriggerEvent(ctx, rule.ID, rule.Name, "chat_message", "notify", "[c] u: hello"))
+	require.NoError(t, repo.InsertRuleTriggerEvent(ctx, rule.ID, rule.Name, "chat_message", "notify", "c", "[c] u: hello"))
 	rtEvents, err := repo.ListRuleTriggerEvents(ctx, entity.RuleTriggerListFilter{Limit: 10})
 	require.NoError(t, err)
 	require.NotEmpty(t, rtEvents)
@@ -383,9 +459,47 @@ func TestRepository_integration(t *testing.T) {
 
 	require.NoError(t, repo.DeleteRule(ctx, rule.ID))
 
-	_, err = repo.UpdateNotificationEntry(ctx, 888_888, nil, map[string]any{}, entity.ToPointer(true))
+	_, err = repo.UpdateNotificationEntry(ctx, 888_888, nil, map[string]any{}, entity.ToPointer(true), nil, nil)
+	assert.ErrorIs(t, err, entity.ErrNotificationNotFound)
+
+	_, err = repo.GetNotificationEntry(ctx, 888_888)
 	assert.ErrorIs(t, err, entity.ErrNotificationNotFound)
 
+	snooze, err := repo.CreateNotificationSnooze(ctx, entity.NotificationSnooze{NotificationEntryID: &notif.ID, Channel: "chan_a", Until: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	_, err = repo.CreateNotificationSnooze(ctx, entity.NotificationSnooze{Until: time.Now().Add(-time.Hour)})
+	require.NoError(t, err)
+
+	snoozes, err := repo.ListActiveNotificationSnoozes(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, snoozes, 1)
+	assert.Equal(t, snooze.ID, snoozes[0].ID)
+	require.NotNil(t, snoozes[0].NotificationEntryID)
+	assert.Equal(t, notif.ID, *snoozes[0].NotificationEntryID)
+
+	pruned, err := repo.PruneNotificationSnoozes(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+
+	require.NoError(t, repo.DeleteNotificationSnooze(ctx, snooze.ID))
+	assert.ErrorIs(t, repo.DeleteNotificationSnooze(ctx, snooze.ID), entity.ErrNotificationSnoozeNotFound)
+
+	require.NoError(t, repo.RecordSuppressedNotificationDeliveries(ctx, []int64{notif.ID}, entity.NotificationEvent{
+		Type: "keyword_match", Channel: "chan_a", User: "u", Message: "m",
+	}, entity.NotificationSuppressedFlood))
+
+	suppressed, err := repo.ListNotificationDeliveries(ctx, entity.NotificationDeliveryListFilter{
+		NotificationEntryID: &notif.ID, Status: entity.NotificationDeliverySuppressed,
+	})
+	require.NoError(t, err)
+	require.Len(t, suppressed, 1)
+	require.NotNil(t, suppressed[0].LastError)
+	assert.Equal(t, entity.NotificationSuppressedFlood, *suppressed[0].LastError)
+
+	claimedSuppressed, err := repo.ClaimEntryNotificationDeliveries(ctx, notif.ID, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, claimedSuppressed, "suppressed rows are never sent")
+
 	require.NoError(t, repo.DeleteNotificationEntry(ctx, notif.ID))
 
 	err = repo.DeleteNotificationEntry(ctx, 999_999)
@@ -427,17 +541,80 @@ func TestRepository_integration(t *testing.T) {
 	ss, err := repo.GetSuspicionSettings(ctx)
 	require.NoError(t, err)
 	assert.True(t, ss.AutoCheckAccountAge)
+	assert.Equal(t, 50, ss.ScoreThreshold)
+	assert.Equal(t, 50, ss.Weights.AccountAge)
+	assert.Equal(t, []string{"^[a-z]+_?[0-9]{4,}$"}, ss.NamePatterns)
 	require.NoError(t, repo.UpdateSuspicionSettings(ctx, entity.SuspicionSettings{
-		AutoCheckAccountAge: false,
-		AccountAgeSusDays:   ss.AccountAgeSusDays,
-		AutoCheckBlacklist:  ss.AutoCheckBlacklist,
-		AutoCheckLowFollows: ss.AutoCheckLowFollows,
-		LowFollowsThreshold: ss.LowFollowsThreshold,
-		MaxGQLFollowPages:   ss.MaxGQLFollowPages,
+		AutoCheckAccountAge:   false,
+		AccountAgeSusDays:     ss.AccountAgeSusDays,
+		AutoCheckBlacklist:    ss.AutoCheckBlacklist,
+		AutoCheckLowFollows:   ss.AutoCheckLowFollows,
+		LowFollowsThreshold:   ss.LowFollowsThreshold,
+		MaxGQLFollowPages:     ss.MaxGQLFollowPages,
+		ScoreThreshold:        70,
+		Weights:               entity.SuspicionWeights{Moderation: 40},
+		MultiChannelThreshold: 3,
 	}))
 	ss2, err := repo.GetSuspicionSettings(ctx)
 	require.NoError(t, err)
 	assert.False(t, ss2.AutoCheckAccountAge)
+	assert.Equal(t, 70, ss2.ScoreThreshold)
+	assert.Equal(t, entity.SuspicionWeights{Moderation: 40}, ss2.Weights)
+	assert.Empty(t, ss2.NamePatterns)
+	assert.Equal(t, 3, ss2.MultiChannelThreshold)
+
+	noScore, err := repo.GetSuspicionScore(ctx, chatterID)
+	require.NoError(t, err)
+	assert.Nil(t, noScore)
+	require.NoError(t, repo.UpsertSuspicionScore(ctx, entity.SuspicionScore{
+		TwitchUserID: chatterID,
+		Score:        55,
+		Threshold:    50,
+		Signals:      []entity.SuspicionSignal{{Key: entity.SuspicionSignalModeration, Score: 55, Weight: 60, Detail: "Banned"}},
+		ComputedAt:   time.Now().UTC(),
+	}))
+	score, err := repo.GetSuspicionScore(ctx, chatterID)
+	require.NoError(t, err)
+	require.NotNil(t, score)
+	assert.Equal(t, 55, score.Score)
+	require.Len(t, score.Signals, 1)
+	assert.Equal(t, entity.SuspicionSignalModeration, score.Signals[0].Key)
+
+	require.NoError(t, repo.InsertUserActivityEvent(ctx, chatterID, entity.UserActivityTimeout, nil, map[string]any{"duration_seconds": 60}))
+	modCount, err := repo.CountUserActivityEventsByType(ctx, chatterID, []string{entity.UserActivityBan, entity.UserActivityTimeout})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), modCount)
+
+	autoType := entity.SusTypeAutoScore
+	autoDesc := "Score 55/50"
+	_, err = repo.PatchTwitchUser(ctx, chatterID, entity.TwitchUserPatch{
+		IsSus: entity.ToPointer(true), SusType: &autoType, SusDescription: &autoDesc,
+		SusSource: entity.SuspicionSourceAuto, SusEvidence: map[string]any{"score": 55},
+	})
+	require.NoError(t, err)
+	_, err = repo.PatchTwitchUser(ctx, chatterID, entity.TwitchUserPatch{IsSus: entity.ToPointer(true), SusType: &autoType, SusDescription: &autoDesc})
+	require.NoError(t, err, "unchanged suspicion state is not logged")
+	_, err = repo.PatchTwitchUser(ctx, chatterID, entity.TwitchUserPatch{
+		IsSus: entity.ToPointer(true), SusType: &autoType, SusDescription: entity.ToPointer("Score 52/50"),
+		SusSource: entity.SuspicionSourceAuto,
+	})
+	require.NoError(t, err, "an automatic re-score with the same verdict is not logged")
+	_, err = repo.PatchTwitchUser(ctx, chatterID, entity.TwitchUserPatch{
+		IsSus: entity.ToPointer(false), SusType: entity.ToPointer(""), SusDescription: entity.ToPointer(""),
+	})
+	require.NoError(t, err)
+	susEvents, err := repo.ListSuspicionEvents(ctx, entity.SuspicionEventListFilter{TwitchUserID: entity.ToPointer(chatterID)})
+	require.NoError(t, err)
+	require.Len(t, susEvents, 2)
+	assert.Equal(t, entity.SuspicionSourceManual, susEvents[0].Source)
+	assert.False(t, susEvents[0].NewIsSus)
+	assert.Equal(t, autoType, *susEvents[0].OldSusType)
+	assert.Nil(t, susEvents[0].NewSusType)
+	assert.Equal(t, entity.SuspicionSourceAuto, susEvents[1].Source)
+	assert.InDelta(t, 55, susEvents[1].Evidence["score"], 0)
+	autoOnly, err := repo.ListSuspicionEvents(ctx, entity.SuspicionEventListFilter{Source: entity.SuspicionSourceAuto, NewIsSus: entity.ToPointer(true)})
+	require.NoError(t, err)
+	require.Len(t, autoOnly, 1)
 
 	require.NoError(t, repo.ReplaceUserFollowedChannels(ctx, chatterID, []entity.FollowedChannelRow{
 		{FollowedChannelID: 9001, FollowedChannelLogin: "foo", FollowedAt: nil},
@@ -447,6 +624,438 @@ func TestRepository_integration(t *testing.T) {
 	require.Len(t, gqlFollows, 1)
 	assert.Equal(t, "foo", gqlFollows[0].FollowedChannelLogin)
 
+	followsSyncedAt := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.UpsertFollowsSyncMeta(ctx, chatterID, 42, followsSyncedAt))
+	syncStates, err := repo.ListFollowsSyncStates(ctx, chatterID-1, 1)
+	require.NoError(t, err)
+	require.Len(t, syncStates, 1)
+	assert.Equal(t, chatterID, syncStates[0].TwitchUserID)
+	require.NotNil(t, syncStates[0].FollowsTotal)
+	assert.Equal(t, 42, *syncStates[0].FollowsTotal)
+	require.NotNil(t, syncStates[0].FollowsSyncedAt)
+	assert.True(t, followsSyncedAt.Equal(*syncStates[0].FollowsSyncedAt))
+
+	require.NoError(t, repo.ReplaceUserFollowedChannels(ctx, otherID, []entity.FollowedChannelRow{
+		{FollowedChannelID: 9001, FollowedChannelLogin: "foo", FollowedAt: nil},
+	}))
+	altPool, err := repo.ListAltCandidatePool(ctx, chatterID, "chatter", 10)
+	require.NoError(t, err)
+	assert.Contains(t, altPool, otherID)
+	assert.NotContains(t, altPool, chatterID)
+
+	require.NoError(t, repo.ReplaceAltCandidates(ctx, otherID, []entity.AltCandidate{{
+		CandidateUserID: chatterID,
+		Score:           70,
+		Signals:         []entity.AltSignal{{Key: entity.AltSignalFollowOverlap, Score: 30, Weight: 30, Detail: "d"}},
+		ComputedAt:      time.Now().UTC(),
+	}}))
+	alts, err := repo.ListAltCandidates(ctx, chatterID, 10)
+	require.NoError(t, err)
+	require.Len(t, alts, 1)
+	assert.Equal(t, otherID, alts[0].CandidateUserID)
+	assert.Equal(t, "otheruser", alts[0].CandidateUsername)
+	require.Len(t, alts[0].Signals, 1)
+
+	link, err := repo.UpsertUserLink(ctx, entity.UserLink{TwitchUserID: chatterID, LinkedUserID: otherID, Status: entity.UserLinkRejected})
+	require.NoError(t, err)
+	assert.Equal(t, "otheruser", link.LinkedUsername)
+	require.NotNil(t, link.Score)
+	assert.Equal(t, 70, *link.Score)
+
+	alts, err = repo.ListAltCandidates(ctx, chatterID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, alts, "reviewed pairs are not possible alts")
+
+	links, err := repo.ListUserLinks(ctx, otherID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, chatterID, links[0].LinkedUserID)
+	assert.Equal(t, entity.UserLinkRejected, links[0].Status)
+
+	require.NoError(t, repo.DeleteUserLink(ctx, otherID, chatterID))
+	require.ErrorIs(t, repo.DeleteUserLink(ctx, otherID, chatterID), entity.ErrUserLinkNotFound)
+
+	botSettings, err := repo.GetBotDetectionSettings(ctx)
+	require.NoError(t, err)
+	assert.True(t, botSettings.Enabled)
+	assert.Equal(t, 8, botSettings.MinConcurrentChannels)
+
+	botSettings.ExcludeFromStats = true
+	require.NoError(t, repo.UpdateBotDetectionSettings(ctx, botSettings))
+
+	botSettings, err = repo.GetBotDetectionSettings(ctx)
+	require.NoError(t, err)
+	assert.True(t, botSettings.ExcludeFromStats)
+
+	require.NoError(t, repo.ReplaceChannelChattersSnapshot(ctx, channelID, []int64{chatterID, otherID}))
+
+	botPresence, err := repo.ListBotPresence(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, botPresence, 2)
+	assert.Equal(t, 1, botPresence[0].ConcurrentChannels)
+
+	detectedAt := time.Now().UTC()
+	require.NoError(t, repo.UpsertLikelyBots(ctx, []entity.BotPresence{{TwitchUserID: otherID, ConcurrentChannels: 12}},
+		detectedAt, detectedAt.Add(-time.Hour)))
+
+	bots, err := repo.ListLikelyBots(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, bots, 1)
+	assert.Equal(t, "otheruser", bots[0].Username)
+	assert.Equal(t, 12, bots[0].ConcurrentChannels)
+
+	botIDs, err := repo.LikelyBotIDs(ctx, []int64{chatterID, otherID})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]struct{}{otherID: {}}, botIDs)
+
+	_, err = repo.ListChannelBotCounts(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.ReplaceChannelBotEstimates(ctx, []entity.ChannelBotEstimate{{
+		ChannelTwitchUserID: channelID, ViewerCount: 10, ChatterCount: 2, BotChatterCount: 1,
+		BotShare: 0.5, ViewerInflated: true, ComputedAt: detectedAt,
+	}}))
+
+	estimates, err := repo.ListChannelBotEstimates(ctx)
+	require.NoError(t, err)
+	require.Len(t, estimates, 1)
+	assert.Equal(t, "channel1", estimates[0].ChannelLogin)
+	assert.True(t, estimates[0].ViewerInflated)
+
+	require.NoError(t, repo.UpsertLikelyBots(ctx, nil, detectedAt.Add(2*time.Hour), detectedAt.Add(time.Hour)))
+
+	bots, err = repo.ListLikelyBots(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, bots, "bots not detected since staleBefore are forgotten")
+
+	lp, err := repo.CreateLoginPattern(ctx, entity.LoginPattern{Kind: entity.LoginPatternGlob, Pattern: "*hate*"})
+	require.NoError(t, err)
+	assert.NotZero(t, lp.ID)
+
+	lpAgain, err := repo.CreateLoginPattern(ctx, entity.LoginPattern{Kind: entity.LoginPatternGlob, Pattern: "*hate*", Description: "slurs"})
+	require.NoError(t, err)
+	assert.Equal(t, lp.ID, lpAgain.ID, "re-adding a pattern updates its description")
+
+	loginPatterns, err := repo.ListLoginPatterns(ctx)
+	require.NoError(t, err)
+	require.Len(t, loginPatterns, 1)
+	assert.Equal(t, "slurs", loginPatterns[0].Description)
+
+	require.NoError(t, repo.DeleteLoginPattern(ctx, lp.ID))
+	require.ErrorIs(t, repo.DeleteLoginPattern(ctx, lp.ID), entity.ErrLoginPatternNotFound)
+
+	blSrc := "https://lists.example.org/raids.txt"
+	blist, err := repo.CreateBlocklist(ctx, entity.Blocklist{
+		Name: "raids", Format: entity.BlocklistFormatText, SourceURL: &blSrc, SyncIntervalMinutes: 60, Enabled: true,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, blist.LastImportedAt)
+
+	importedAt := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.ReplaceBlocklistEntries(ctx, blist.ID, []entity.BlocklistEntry{
+		{Login: "raid_a"}, {TwitchUserID: 424242}, {Login: "raid_b", TwitchUserID: 434343},
+	}, nil, "abc", importedAt))
+
+	hits, err := repo.MatchBlocklists(ctx, 424242, "someone")
+	require.NoError(t, err)
+	assert.Equal(t, []entity.BlocklistHit{{BlocklistID: blist.ID, Name: "raids", MatchedBy: "id"}}, hits)
+
+	hits, err = repo.MatchBlocklists(ctx, 1, "RAID_A")
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "login", hits[0].MatchedBy)
+
+	hits, err = repo.MatchBlocklists(ctx, 1, "innocent")
+	require.NoError(t, err)
+	assert.Empty(t, hits)
+
+	syncErr := "unexpected status 500"
+	require.NoError(t, repo.RecordBlocklistSync(ctx, blist.ID, importedAt, &syncErr))
+
+	blist, err = repo.GetBlocklist(ctx, blist.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, blist.EntryCount)
+	assert.Equal(t, "abc", *blist.ContentSHA256)
+	assert.Nil(t, blist.SourceFilename)
+	assert.Equal(t, syncErr, *blist.LastSyncError)
+
+	blocklists, err := repo.ListBlocklists(ctx)
+	require.NoError(t, err)
+	require.Len(t, blocklists, 1)
+
+	require.NoError(t, repo.DeleteBlocklist(ctx, blist.ID))
+	require.ErrorIs(t, repo.DeleteBlocklist(ctx, blist.ID), entity.ErrBlocklistNotFound)
+	_, err = repo.GetBlocklist(ctx, blist.ID)
+	require.ErrorIs(t, err, entity.ErrBlocklistNotFound)
+
+	watermark, err := repo.GetChannelAnalyticsWatermark(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, watermark)
+
+	earliestMsg, err := repo.EarliestChatMessageAt(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, earliestMsg)
+
+	rollupFrom := entity.ChannelAnalyticsBucketDay.Truncate(*earliestMsg)
+	rollupTo := time.Now().UTC().Add(time.Minute)
+	presenceHour := entity.ChannelAnalyticsBucketHour.Truncate(*earliestMsg)
+
+	require.NoError(t, repo.ReplaceChannelActivityRollups(ctx, rollupFrom, rollupTo, []entity.ChannelActivityBucket{
+		{ChannelTwitchUserID: channelID, Bucket: entity.ChannelAnalyticsBucketHour, Start: presenceHour, PresentChatters: 1, PresenceSeconds: 60},
+	}, []entity.ChatterDayPresence{
+		{ChannelTwitchUserID: channelID, ChatterTwitchUserID: chatterID, Day: rollupFrom, PresenceSeconds: 60},
+	}))
+	require.NoError(t, repo.ReplaceChannelActivityRollups(ctx, rollupFrom, rollupTo, nil, []entity.ChatterDayPresence{
+		{ChannelTwitchUserID: channelID, ChatterTwitchUserID: chatterID, Day: rollupFrom, PresenceSeconds: 120},
+	}), "rollups are idempotent")
+	require.NoError(t, repo.RefreshStreamRetention(ctx, rollupFrom))
+
+	watermark, err = repo.GetChannelAnalyticsWatermark(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, watermark)
+	assert.WithinDuration(t, rollupTo, *watermark, time.Millisecond)
+
+	dayRollups, err := repo.ListChannelActivityRollups(ctx, channelID, entity.ChannelAnalyticsBucketDay, rollupFrom, rollupTo)
+	require.NoError(t, err)
+	require.NotEmpty(t, dayRollups)
+
+	var (
+		rolledMsgs     int64
+		rolledNew      int
+		rolledPresence int
+	)
+
+	for _, b := range dayRollups {
+		rolledMsgs += b.MessageCount
+		rolledNew += b.NewChatters
+		rolledPresence += b.PresentChatters
+	}
+
+	assert.GreaterOrEqual(t, rolledMsgs, int64(2))
+	assert.GreaterOrEqual(t, rolledNew, 1)
+	assert.Zero(t, rolledPresence, "presence is replaced by the second pass")
+
+	lbQuery, err := entity.ChannelLeaderboardQuery{From: rollupFrom, To: rollupTo}.Normalize(rollupTo)
+	require.NoError(t, err)
+
+	lbRows, lbTotal, err := repo.ListChannelLeaderboard(ctx, entity.ToPointer(channelID), lbQuery)
+	require.NoError(t, err)
+	require.NotEmpty(t, lbRows)
+	assert.Equal(t, int64(len(lbRows)), lbTotal)
+
+	for _, row := range lbRows {
+		if row.UserTwitchID == chatterID {
+			assert.Equal(t, int64(120), row.PresenceSeconds)
+			assert.GreaterOrEqual(t, row.MessageCount, int64(1))
+		}
+	}
+
+	_, allTotal, err := repo.ListChannelLeaderboard(ctx, nil, lbQuery)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, allTotal, lbTotal)
+
+	lbQuery.Offset = int(lbTotal)
+	lbRows, lbTotalPast, err := repo.ListChannelLeaderboard(ctx, entity.ToPointer(channelID), lbQuery)
+	require.NoError(t, err)
+	assert.Empty(t, lbRows)
+	assert.Equal(t, lbTotal, lbTotalPast)
+
+	hourOfWeek, err := repo.CountChatMessagesByHourOfWeek(ctx, entity.ToPointer(chatterID), nil, rollupFrom, rollupTo, "Europe/Berlin")
+	require.NoError(t, err)
+
+	var hourOfWeekMsgs int64
+
+	for _, row := range hourOfWeek {
+		for _, n := range row {
+			hourOfWeekMsgs += n
+		}
+	}
+
+	assert.GreaterOrEqual(t, hourOfWeekMsgs, int64(1))
+
+	channelHourOfWeek, err := repo.CountChatMessagesByHourOfWeek(ctx, nil, entity.ToPointer(channelID), rollupTo, rollupTo.Add(time.Hour), "UTC")
+	require.NoError(t, err)
+	assert.Equal(t, entity.HourOfWeekMatrix{}, channelHourOfWeek)
+
+	_, err = repo.UpsertTwitchUserFromChat(ctx, 777_010, "firstseentest")
+	require.NoError(t, err)
+	firstSeen, err := repo.RecordChatterFirstSeen(ctx, channelID, 777_010, rollupTo)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ChatterFirstSeen{InChannel: true, Global: true}, firstSeen)
+	firstSeen, err = repo.RecordChatterFirstSeen(ctx, channelID, 777_010, rollupTo.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, entity.ChatterFirstSeen{}, firstSeen)
+
+	chatterCounts, err := repo.CountStreamChatters(ctx, 999_999)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StreamChatterCounts{}, chatterCounts)
+
+	classifier, err := repo.GetChatClassifierSettings(ctx)
+	require.NoError(t, err)
+	assert.False(t, classifier.Enabled)
+	assert.Empty(t, classifier.Channels)
+
+	classifier.Enabled = true
+	classifier.Channels = []string{"channel1", "nosuchchannel"}
+	require.NoError(t, repo.UpdateChatClassifierSettings(ctx, classifier))
+	classifier, err = repo.GetChatClassifierSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"channel1"}, classifier.Channels)
+
+	candidates, scannedTo, err := repo.ListChatMessagesForClassification(ctx, time.Time{}, 10_000)
+	require.NoError(t, err)
+	require.NotEmpty(t, candidates)
+	assert.GreaterOrEqual(t, scannedTo, msgID)
+
+	classifiedAt := time.Now().UTC()
+	require.NoError(t, repo.SaveChatClassification(ctx, []entity.ChatMessageLabels{{MessageID: msgID, Toxicity: 0.75, Sentiment: -0.5}}, scannedTo, classifiedAt, 50))
+	tokensUsed, err := repo.ChatClassifierTokensUsed(ctx, classifiedAt)
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), tokensUsed)
+
+	candidates, _, err = repo.ListChatMessagesForClassification(ctx, time.Time{}, 10_000)
+	require.NoError(t, err)
+	assert.Empty(t, candidates)
+
+	latestLabels, err := repo.GetLatestChatterLabels(ctx, "channel1", "chatter1", time.Time{})
+	require.NoError(t, err)
+	require.NotNil(t, latestLabels)
+	assert.Equal(t, msgID, latestLabels.MessageID)
+	assert.InDelta(t, 0.75, latestLabels.Toxicity, 1e-6)
+
+	chatterToxicity, err := repo.GetChatterChatToxicity(ctx, chatterID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), chatterToxicity.ClassifiedMessages)
+	assert.Equal(t, int64(1), chatterToxicity.ToxicMessages)
+
+	streamToxicity, err := repo.GetStreamChatToxicity(ctx, 999_999)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ChatToxicity{}, streamToxicity)
+
+	minutes, err := repo.ListChannelActivityMinutes(ctx, channelID, rollupFrom, rollupTo)
+	require.NoError(t, err)
+	require.NotEmpty(t, minutes)
+
+	_, err = repo.ListChannelActivityMinutes(ctx, channelID, rollupTo, rollupTo.Add(time.Minute))
+	require.NoError(t, err)
+
+	_, err = repo.ListStreamRetention(ctx, channelID, rollupFrom, rollupTo)
+	require.NoError(t, err)
+
+	audience, err := repo.ListChannelAudience(ctx, rollupFrom, rollupTo)
+	require.NoError(t, err)
+
+	var chatterAudience *entity.AudienceMember
+
+	for i, m := range audience {
+		if m.ChannelTwitchUserID == channelID && m.TwitchUserID == chatterID {
+			chatterAudience = &audience[i]
+		}
+	}
+
+	require.NotNil(t, chatterAudience)
+	assert.GreaterOrEqual(t, chatterAudience.Messages, int64(2))
+
+	_, err = repo.CountFollowedChannelsAmong(ctx, []int64{chatterID}, 10)
+	require.NoError(t, err)
+
+	cachedOverlap, err := repo.GetAudienceOverlapCache(ctx, entity.AudienceOverlapPeriodWeek)
+	require.NoError(t, err)
+	assert.Nil(t, cachedOverlap)
+
+	overlapAt := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.SaveAudienceOverlapCache(ctx, entity.AudienceOverlap{
+		Period:     entity.AudienceOverlapPeriodWeek,
+		ComputedAt: overlapAt,
+		Channels:   []entity.AudienceChannel{{ChannelTwitchUserID: channelID, Login: "channel1", Audience: 1}},
+	}))
+
+	cachedOverlap, err = repo.GetAudienceOverlapCache(ctx, entity.AudienceOverlapPeriodWeek)
+	require.NoError(t, err)
+	require.NotNil(t, cachedOverlap)
+	assert.True(t, overlapAt.Equal(cachedOverlap.ComputedAt))
+	require.Len(t, cachedOverlap.Channels, 1)
+	assert.Equal(t, "channel1", cachedOverlap.Channels[0].Login)
+
+	emoteSet, err := repo.SaveEmoteSet(ctx, entity.EmoteSet{Name: "global bttv", Provider: entity.EmoteProviderBTTV},
+		[]entity.EmoteSetEmote{{Code: "catJAM", EmoteID: "b1"}, {Code: "OMEGALUL", EmoteID: "b2"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, emoteSet.EmoteCount)
+
+	emoteSet, err = repo.SaveEmoteSet(ctx, entity.EmoteSet{Name: "global bttv", Provider: entity.EmoteProviderBTTV, Channel: "channel1"},
+		[]entity.EmoteSetEmote{{Code: "catJAM", EmoteID: "b1"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, emoteSet.EmoteCount)
+
+	scopedEmotes, err := repo.ListScopedEmotes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.ScopedEmote{{Provider: entity.EmoteProviderBTTV, Channel: "channel1", Code: "catJAM", EmoteID: "b1"}}, scopedEmotes)
+
+	emoteMsgID, err := repo.InsertChatMessage(ctx, channelID, &chatterPtr, "chatter1", "Kappa catJAM Kappa", false, "irc", nil, false)
+	require.NoError(t, err)
+	require.NoError(t, repo.InsertChatMessageEmotes(ctx, emoteMsgID, []entity.ChatEmote{
+		{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Start: 0, End: 4},
+		{Provider: entity.EmoteProviderBTTV, EmoteID: "b1", Code: "catJAM", Start: 6, End: 11},
+		{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Start: 13, End: 17},
+	}))
+
+	emoteChannelID := channelID
+	emoteUsage, err := repo.ListEmoteUsage(ctx, entity.EmoteUsageFilter{ChannelTwitchUserID: &emoteChannelID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, emoteUsage, 2)
+	assert.Equal(t, entity.EmoteUsage{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Uses: 2, Messages: 1, Chatters: 1}, emoteUsage[0])
+
+	userEmoteUsage, err := repo.ListEmoteUsage(ctx, entity.EmoteUsageFilter{ChatterTwitchUserID: &chatterPtr, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, userEmoteUsage, 1)
+
+	_, err = repo.ListStreamEmoteCounts(ctx, 1, time.Now().Add(-time.Hour), time.Minute, emoteUsage)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.DeleteEmoteSet(ctx, emoteSet.ID))
+	require.ErrorIs(t, repo.DeleteEmoteSet(ctx, emoteSet.ID), entity.ErrEmoteSetNotFound)
+
+	termMsgs, err := repo.ListStreamTermMessages(ctx, 999999, nil, nil, "")
+	require.NoError(t, err)
+	require.Empty(t, termMsgs)
+
+	pendingTerms, err := repo.ListStreamsPendingTerms(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, pendingTerms)
+
+	baselineStreams, baselineDF, err := repo.CountBaselineTerms(ctx, channelID, 0, 30, []entity.ChatTerm{{Text: "hello"}})
+	require.NoError(t, err)
+	require.Zero(t, baselineStreams)
+	require.Empty(t, baselineDF)
+
+	recap, err := repo.GetStreamRecap(ctx, 999_999)
+	require.NoError(t, err)
+	assert.Nil(t, recap)
+
+	_, err = repo.GetStreamRecapStats(ctx, 999_999)
+	require.ErrorIs(t, err, entity.ErrStreamNotFound)
+
+	recapSettings, err := repo.GetStreamRecapSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StreamRecapSettings{AISummary: true}, recapSettings)
+	require.NoError(t, repo.UpdateStreamRecapSettings(ctx, entity.StreamRecapSettings{Notify: true}))
+	recapSettings, err = repo.GetStreamRecapSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StreamRecapSettings{Notify: true}, recapSettings)
+
+	ruleTriggers, err := repo.CountChannelRuleTriggers(ctx, "c", time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, ruleTriggers, 1)
+	assert.Equal(t, int64(1), ruleTriggers[0].Triggers)
+
+	susUsers, err := repo.ListSuspiciousTwitchUsersByIDs(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, susUsers)
+
+	chatMoments, err := repo.ListStreamChatMoments(ctx, 999_999)
+	require.NoError(t, err)
+	assert.Empty(t, chatMoments)
+
 	require.NoError(t, repo.InsertIrcJoinedSample(ctx, 5))
 	require.NoError(t, repo.InsertIrcJoinedSample(ctx, 105))
 
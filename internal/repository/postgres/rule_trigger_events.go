@@ -8,14 +8,14 @@ import (
 	"go.uber.org/zap"
 )
 
-func (r *Repository) InsertRuleTriggerEvent(ctx context.Context, ruleID int64, ruleName, triggerEvent, actionType, displayText string) error {
+func (r *Repository) InsertRuleTriggerEvent(ctx context.Context, ruleID int64, ruleName, triggerEvent, actionType, channel, displayText string) error {
 	ctx, span := r.obs.StartSpan(ctx, "repo.insert_rule_trigger_event")
 	defer span.End()
 
 	_, err := r.pool.Exec(ctx, `
-		INSERT INTO rule_trigger_events (rule_id, rule_name, trigger_event, action_type, display_text)
-		VALUES ($1, $2, $3, $4, $5)
-	`, ruleID, ruleName, triggerEvent, actionType, displayText)
+		INSERT INTO rule_trigger_events (rule_id, rule_name, trigger_event, action_type, channel, display_text)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, ruleID, ruleName, triggerEvent, actionType, channel, displayText)
 	if err != nil {
 		r.obs.LogError(ctx, span, "insert rule trigger event failed", err,
 			zap.Int64("rule_id", ruleID),
@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// GetStreamRecapStats fills the aggregate figures of a stream's recap: viewer peak and average from Helix polls,
+// messages, unique chatters and chatters who never chatted in the channel before the stream started.
+func (r *Repository) GetStreamRecapStats(ctx context.Context, streamID int64) (entity.StreamRecap, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_stream_recap_stats")
+	defer span.End()
+
+	out := entity.StreamRecap{StreamID: streamID}
+
+	var samples int64
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT s.peak_viewer_count, s.viewer_count_sum / NULLIF(s.viewer_count_samples, 0), s.viewer_count_samples,
+			(SELECT count(*) FROM chat_messages m WHERE m.stream_id = s.id),
+			(SELECT count(DISTINCT m.chatter_twitch_user_id) FROM chat_messages m WHERE m.stream_id = s.id),
+			(SELECT count(DISTINCT m.chatter_twitch_user_id)
+			 FROM chat_messages m
+			 WHERE m.stream_id = s.id
+			   AND NOT EXISTS (
+				SELECT 1 FROM chat_messages p
+				WHERE p.chatter_twitch_user_id = m.chatter_twitch_user_id
+				  AND p.twitch_user_id = s.channel_twitch_user_id
+				  AND p.created_at < s.started_at
+			   ))
+		FROM streams s
+		WHERE s.id = $1
+	`, streamID).Scan(&out.PeakViewers, &out.AverageViewers, &samples, &out.Messages, &out.UniqueChatters, &out.NewChatters)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.StreamRecap{}, entity.ErrStreamNotFound
+		}
+
+		r.obs.LogError(ctx, span, "get stream recap stats failed", err, zap.Int64("stream_id", streamID))
+		return entity.StreamRecap{}, err
+	}
+
+	if samples == 0 {
+		out.PeakViewers, out.AverageViewers = nil, nil
+	}
+
+	return out, nil
+}
+
+// ListStreamBusiestMoments returns a stream's chat windows of width bucket (aligned to origin) with the most
+// messages, busiest first.
+func (r *Repository) ListStreamBusiestMoments(ctx context.Context, streamID int64, origin time.Time, bucket time.Duration, limit int) ([]entity.StreamRecapMoment, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_stream_busiest_moments")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT floor(extract(epoch FROM m.created_at - $2::timestamptz) / $3)::int AS bucket,
+			count(*), count(DISTINCT m.chatter_twitch_user_id)
+		FROM chat_messages m
+		WHERE m.stream_id = $1
+		GROUP BY bucket
+		ORDER BY count(*) DESC, bucket ASC
+		LIMIT $4
+	`, streamID, origin, bucket.Seconds(), limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list stream busiest moments failed", err, zap.Int64("stream_id", streamID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.StreamRecapMoment
+
+	for rows.Next() {
+		var (
+			m entity.StreamRecapMoment
+			i int
+		)
+
+		if err := rows.Scan(&i, &m.Messages, &m.Chatters); err != nil {
+			return nil, err
+		}
+
+		m.Start = origin.Add(time.Duration(i) * bucket)
+		out = append(out, m)
+	}
+
+	return out, rows.Err()
+}
+
+// CountChannelRuleTriggers counts rule triggers recorded for a channel in [from, to), most triggered first.
+func (r *Repository) CountChannelRuleTriggers(ctx context.Context, channel string, from, to time.Time) ([]entity.StreamRecapRule, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.count_channel_rule_triggers")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT rule_id, rule_name, count(*)
+		FROM rule_trigger_events
+		WHERE channel = $1 AND created_at >= $2 AND created_at < $3
+		GROUP BY rule_id, rule_name
+		ORDER BY count(*) DESC, rule_name ASC
+	`, channel, from, to)
+	if err != nil {
+		r.obs.LogError(ctx, span, "count channel rule triggers failed", err, zap.String("channel", channel))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.StreamRecapRule
+
+	for rows.Next() {
+		var t entity.StreamRecapRule
+		if err := rows.Scan(&t.RuleID, &t.RuleName, &t.Triggers); err != nil {
+			return nil, err
+		}
+
+		out = append(out, t)
+	}
+
+	return out, rows.Err()
+}
+
+// ListSuspiciousTwitchUsersByIDs returns the users among ids flagged suspicious, by login.
+func (r *Repository) ListSuspiciousTwitchUsersByIDs(ctx context.Context, ids []int64) ([]entity.StreamRecapUser, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_suspicious_twitch_users_by_ids")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, username, sus_type, sus_description
+		FROM twitch_users
+		WHERE id = ANY($1) AND is_sus = true
+		ORDER BY username ASC
+	`, ids)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list suspicious twitch users by ids failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.StreamRecapUser
+
+	for rows.Next() {
+		var u entity.StreamRecapUser
+		if err := rows.Scan(&u.UserTwitchID, &u.Login, &u.SusType, &u.SusDescription); err != nil {
+			return nil, err
+		}
+
+		out = append(out, u)
+	}
+
+	return out, rows.Err()
+}
+
+// SaveStreamRecap stores (or replaces) a stream's recap.
+func (r *Repository) SaveStreamRecap(ctx context.Context, recap entity.StreamRecap) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.save_stream_recap")
+	defer span.End()
+
+	raw, err := json.Marshal(recap)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, `
+		INSERT INTO stream_recaps (stream_id, recap, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (stream_id) DO UPDATE SET recap = EXCLUDED.recap, created_at = EXCLUDED.created_at
+	`, recap.StreamID, raw, recap.CreatedAt); err != nil {
+		r.obs.LogError(ctx, span, "save stream recap failed", err, zap.Int64("stream_id", recap.StreamID))
+		return err
+	}
+
+	return nil
+}
+
+// GetStreamRecap returns a stream's recap (nil when none was computed).
+func (r *Repository) GetStreamRecap(ctx context.Context, streamID int64) (*entity.StreamRecap, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_stream_recap")
+	defer span.End()
+
+	var raw []byte
+
+	err := r.pool.QueryRow(ctx, `SELECT recap FROM stream_recaps WHERE stream_id = $1`, streamID).Scan(&raw)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		r.obs.LogError(ctx, span, "get stream recap failed", err, zap.Int64("stream_id", streamID))
+		return nil, err
+	}
+
+	var recap entity.StreamRecap
+	if err := json.Unmarshal(raw, &recap); err != nil {
+		r.obs.LogError(ctx, span, "decode stream recap failed", err, zap.Int64("stream_id", streamID))
+		return nil, err
+	}
+
+	return &recap, nil
+}
+
+// GetStreamRecapSettings returns the singleton row (id=1), or the defaults when it was never saved.
+func (r *Repository) GetStreamRecapSettings(ctx context.Context) (entity.StreamRecapSettings, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_stream_recap_settings")
+	defer span.End()
+
+	var s entity.StreamRecapSettings
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT ai_summary, notify FROM stream_recap_settings WHERE id = 1
+	`).Scan(&s.AISummary, &s.Notify)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.StreamRecapSettings{AISummary: true}, nil
+		}
+
+		r.obs.LogError(ctx, span, "get stream recap settings failed", err)
+	}
+
+	return s, err
+}
+
+// UpdateStreamRecapSettings upserts the singleton row.
+func (r *Repository) UpdateStreamRecapSettings(ctx context.Context, s entity.StreamRecapSettings) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.update_stream_recap_settings")
+	defer span.End()
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO stream_recap_settings (id, ai_summary, notify) VALUES (1, $1, $2)
+		ON CONFLICT (id) DO UPDATE SET ai_summary = EXCLUDED.ai_summary, notify = EXCLUDED.notify
+	`, s.AISummary, s.Notify)
+	if err != nil {
+		r.obs.LogError(ctx, span, "update stream recap settings failed", err)
+	}
+
+	return err
+}
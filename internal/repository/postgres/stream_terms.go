@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListStreamTermMessages returns a stream's chat messages in [from, to) (nil bounds are open) with their emote
+// occurrences, oldest first. A non-empty contains keeps only messages whose lowercased body contains it.
+func (r *Repository) ListStreamTermMessages(ctx context.Context, streamID int64, from, to *time.Time, contains string) ([]entity.TermMessage, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_stream_term_messages")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT m.id, m.chatter_twitch_user_id, m.created_at, m.body,
+			COALESCE(array_agg(e.start_pos ORDER BY e.start_pos) FILTER (WHERE e.message_id IS NOT NULL), '{}'),
+			COALESCE(array_agg(e.end_pos ORDER BY e.start_pos) FILTER (WHERE e.message_id IS NOT NULL), '{}'),
+			COALESCE(array_agg(e.code ORDER BY e.start_pos) FILTER (WHERE e.message_id IS NOT NULL), '{}')
+		FROM chat_messages m
+		LEFT JOIN chat_message_emotes e ON e.message_id = m.id
+		WHERE m.stream_id = $1
+		  AND ($2::timestamptz IS NULL OR m.created_at >= $2)
+		  AND ($3::timestamptz IS NULL OR m.created_at < $3)
+		  AND ($4::text = '' OR strpos(lower(m.body), $4) > 0)
+		GROUP BY m.id
+		ORDER BY m.created_at ASC, m.id ASC
+	`, streamID, from, to, contains)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list stream term messages failed", err, zap.Int64("stream_id", streamID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.TermMessage
+
+	for rows.Next() {
+		var (
+			m            entity.TermMessage
+			starts, ends []int32
+			codes        []string
+		)
+
+		if err := rows.Scan(&m.ID, &m.ChatterTwitchUserID, &m.CreatedAt, &m.Body, &starts, &ends, &codes); err != nil {
+			return nil, err
+		}
+
+		for i := range starts {
+			m.Emotes = append(m.Emotes, entity.ChatEmote{Code: codes[i], Start: int(starts[i]), End: int(ends[i])})
+		}
+
+		out = append(out, m)
+	}
+
+	return out, rows.Err()
+}
+
+// ListStreamsPendingTerms returns ended streams of monitored channels whose terms were never computed, most
+// recently ended first.
+func (r *Repository) ListStreamsPendingTerms(ctx context.Context, limit int) ([]entity.Stream, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_streams_pending_terms")
+	defer span.End()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.id, s.channel_twitch_user_id, u.username, s.helix_stream_id, s.started_at, s.ended_at, COALESCE(s.title, ''), COALESCE(s.game_name, ''), s.created_at
+		FROM streams s
+		INNER JOIN twitch_users u ON u.id = s.channel_twitch_user_id AND u.monitored = true
+		LEFT JOIN stream_terms_state t ON t.stream_id = s.id
+		WHERE s.ended_at IS NOT NULL AND t.stream_id IS NULL
+		ORDER BY s.ended_at DESC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list streams pending terms failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.Stream
+
+	for rows.Next() {
+		s, err := scanStreamRow(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, s)
+	}
+
+	return out, rows.Err()
+}
+
+// ReplaceStreamTerms stores a stream's term counts and marks its terms computed. Transactional.
+func (r *Repository) ReplaceStreamTerms(ctx context.Context, streamID int64, messages int, terms []entity.TermCount) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.replace_stream_terms")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin replace stream terms failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM stream_terms WHERE stream_id = $1`, streamID); err != nil {
+		r.obs.LogError(ctx, span, "delete stream terms failed", err, zap.Int64("stream_id", streamID))
+		return err
+	}
+
+	texts := make([]string, len(terms))
+	emotes := make([]bool, len(terms))
+	msgs := make([]int32, len(terms))
+	chatters := make([]int32, len(terms))
+
+	for i, t := range terms {
+		texts[i], emotes[i] = t.Term, t.Emote
+		msgs[i], chatters[i] = int32(t.Messages), int32(t.Chatters)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO stream_terms (stream_id, term, emote, messages, chatters)
+		SELECT $1, term, emote, messages, chatters
+		FROM unnest($2::text[], $3::bool[], $4::int[], $5::int[]) AS t (term, emote, messages, chatters)
+	`, streamID, texts, emotes, msgs, chatters); err != nil {
+		r.obs.LogError(ctx, span, "insert stream terms failed", err, zap.Int64("stream_id", streamID))
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO stream_terms_state (stream_id, message_count, computed_at)
+		VALUES ($1, $2, now())
+		ON CONFLICT (stream_id) DO UPDATE SET message_count = EXCLUDED.message_count, computed_at = EXCLUDED.computed_at
+	`, streamID, messages); err != nil {
+		r.obs.LogError(ctx, span, "save stream terms state failed", err, zap.Int64("stream_id", streamID))
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit replace stream terms failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// CountBaselineTerms picks a channel's baseline — its latest computed streams (at most streams), without
+// excludeStreamID — and returns its size with how many of those streams used each of the given terms.
+func (r *Repository) CountBaselineTerms(
+	ctx context.Context,
+	channelID, excludeStreamID int64,
+	streams int,
+	terms []entity.ChatTerm,
+) (int, map[entity.ChatTerm]int, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.count_baseline_terms")
+	defer span.End()
+
+	var baseline []int64
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(array_agg(id), '{}') FROM (
+			SELECT s.id
+			FROM streams s
+			JOIN stream_terms_state t ON t.stream_id = s.id
+			WHERE s.channel_twitch_user_id = $1 AND s.id <> $2
+			ORDER BY s.started_at DESC
+			LIMIT $3
+		) b
+	`, channelID, excludeStreamID, streams).Scan(&baseline)
+	if err != nil {
+		r.obs.LogError(ctx, span, "select baseline streams failed", err, zap.Int64("channel_id", channelID))
+		return 0, nil, err
+	}
+
+	df := make(map[entity.ChatTerm]int)
+
+	if len(baseline) == 0 || len(terms) == 0 {
+		return len(baseline), df, nil
+	}
+
+	texts := make([]string, len(terms))
+	emotes := make([]bool, len(terms))
+
+	for i, t := range terms {
+		texts[i], emotes[i] = t.Text, t.Emote
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT st.term, st.emote, count(*)
+		FROM stream_terms st
+		JOIN unnest($2::text[], $3::bool[]) AS q (term, emote) ON q.term = st.term AND q.emote = st.emote
+		WHERE st.stream_id = ANY($1)
+		GROUP BY st.term, st.emote
+	`, baseline, texts, emotes)
+	if err != nil {
+		r.obs.LogError(ctx, span, "count baseline terms failed", err, zap.Int64("channel_id", channelID))
+		return 0, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			t     entity.ChatTerm
+			count int
+		)
+
+		if err := rows.Scan(&t.Text, &t.Emote, &count); err != nil {
+			return 0, nil, err
+		}
+
+		df[t] = count
+	}
+
+	return len(baseline), df, rows.Err()
+}
@@ -69,7 +69,10 @@ func (r *Repository) UpsertStreamFromHelix(ctx context.Context, channelTwitchUse
 
 	if err == nil {
 		_, err = tx.Exec(ctx, `
-			UPDATE streams SET title = $2, game_name = $3, viewer_count = $4, helix_synced_at = NOW()
+			UPDATE streams SET title = $2, game_name = $3, viewer_count = $4, helix_synced_at = NOW(),
+				peak_viewer_count = GREATEST(peak_viewer_count, $4),
+				viewer_count_sum = viewer_count_sum + COALESCE($4, 0),
+				viewer_count_samples = viewer_count_samples + CASE WHEN $4::bigint IS NULL THEN 0 ELSE 1 END
 			WHERE id = $1
 		`, existingID, nullIfEmpty(title), nullIfEmpty(gameName), viewerCount)
 		if err != nil {
@@ -96,8 +99,11 @@ func (r *Repository) UpsertStreamFromHelix(ctx context.Context, channelTwitchUse
 	var newID int64
 
 	err = tx.QueryRow(ctx, `
-		INSERT INTO streams (channel_twitch_user_id, helix_stream_id, started_at, ended_at, title, game_name, viewer_count, helix_synced_at)
-		VALUES ($1, $2, $3, NULL, $4, $5, $6, NOW())
+		INSERT INTO streams (
+			channel_twitch_user_id, helix_stream_id, started_at, ended_at, title, game_name, viewer_count, helix_synced_at,
+			peak_viewer_count, viewer_count_sum, viewer_count_samples
+		)
+		VALUES ($1, $2, $3, NULL, $4, $5, $6, NOW(), $6, COALESCE($6, 0), CASE WHEN $6::bigint IS NULL THEN 0 ELSE 1 END)
 		RETURNING id
 	`, channelTwitchUserID, helixStreamID, startedAt, nullIfEmpty(title), nullIfEmpty(gameName), viewerCount).Scan(&newID)
 	if err != nil {
@@ -120,20 +126,34 @@ func nullIfEmpty(s string) any {
 	return s
 }
 
-// CloseOpenStreamsForChannel sets ended_at on any open stream for the channel.
-func (r *Repository) CloseOpenStreamsForChannel(ctx context.Context, channelTwitchUserID int64) error {
+// CloseOpenStreamsForChannel sets ended_at on any open stream for the channel and returns the closed stream ids.
+func (r *Repository) CloseOpenStreamsForChannel(ctx context.Context, channelTwitchUserID int64) ([]int64, error) {
 	ctx, span := r.obs.StartSpan(ctx, "repo.close_open_streams_for_channel")
 	defer span.End()
 
-	_, err := r.pool.Exec(ctx, `
+	rows, err := r.pool.Query(ctx, `
 		UPDATE streams SET ended_at = NOW()
 		WHERE channel_twitch_user_id = $1 AND ended_at IS NULL
+		RETURNING id
 	`, channelTwitchUserID)
 	if err != nil {
 		r.obs.LogError(ctx, span, "close open streams for channel failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []int64
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		out = append(out, id)
 	}
 
-	return err
+	return out, rows.Err()
 }
 
 func scanStreamRow(rows interface {
@@ -244,6 +264,10 @@ func (r *Repository) ListMonitoredStreams(ctx context.Context, f entity.StreamLi
 		n++
 	}
 
+	if f.LiveOnly {
+		q += ` AND s.ended_at IS NULL`
+	}
+
 	if f.CursorStartedAt != nil && f.CursorID != nil {
 		q += ` AND (s.started_at, s.id) < ($` + strconv.Itoa(n) + `, $` + strconv.Itoa(n+1) + `)`
 
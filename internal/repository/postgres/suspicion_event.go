@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// suspicionChanged reports whether a patch altered the audited suspicion fields. Automatic descriptions embed
+// values that drift daily (account age, per-signal scores), so for source auto only is_sus and sus_type count.
+func suspicionChanged(old, cur entity.TwitchUser, source string) bool {
+	if old.IsSus != cur.IsSus || ptrStr(old.SusType) != ptrStr(cur.SusType) {
+		return true
+	}
+
+	return source != entity.SuspicionSourceAuto && ptrStr(old.SusDescription) != ptrStr(cur.SusDescription)
+}
+
+func ptrStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func insertSuspicionEvent(ctx context.Context, tx pgx.Tx, old, cur entity.TwitchUser, source string, evidence map[string]any) error {
+	if source == "" {
+		source = entity.SuspicionSourceManual
+	}
+
+	if evidence == nil {
+		evidence = map[string]any{}
+	}
+
+	ej, err := json.Marshal(evidence)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO suspicion_events (
+			twitch_user_id, source, old_is_sus, new_is_sus, old_sus_type, new_sus_type,
+			old_sus_description, new_sus_description, evidence
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::jsonb)
+	`, cur.ID, source, old.IsSus, cur.IsSus, old.SusType, cur.SusType, old.SusDescription, cur.SusDescription, ej)
+
+	return err
+}
+
+// ListSuspicionEvents returns suspicion changes (newest first) with keyset pagination.
+func (r *Repository) ListSuspicionEvents(ctx context.Context, f entity.SuspicionEventListFilter) ([]entity.SuspicionEvent, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_suspicion_events")
+	defer span.End()
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	if limit > 200 {
+		limit = 200
+	}
+
+	var b strings.Builder
+
+	b.WriteString(`
+		SELECT e.id, e.twitch_user_id, u.username, e.source, e.old_is_sus, e.new_is_sus, e.old_sus_type, e.new_sus_type,
+			e.old_sus_description, e.new_sus_description, e.evidence, e.created_at
+		FROM suspicion_events e
+		JOIN twitch_users u ON u.id = e.twitch_user_id
+		WHERE TRUE`)
+
+	args := make([]any, 0, 6)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if f.TwitchUserID != nil {
+		b.WriteString(` AND e.twitch_user_id = ` + arg(*f.TwitchUserID))
+	}
+
+	if f.Source != "" {
+		b.WriteString(` AND e.source = ` + arg(f.Source))
+	}
+
+	if f.NewIsSus != nil {
+		b.WriteString(` AND e.new_is_sus = ` + arg(*f.NewIsSus))
+	}
+
+	if f.CursorCreatedAt != nil && f.CursorID != nil {
+		b.WriteString(` AND (e.created_at, e.id) < (` + arg(*f.CursorCreatedAt) + `, ` + arg(*f.CursorID) + `)`)
+	}
+
+	b.WriteString(` ORDER BY e.created_at DESC, e.id DESC LIMIT ` + arg(limit))
+
+	rows, err := r.pool.Query(ctx, b.String(), args...)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list suspicion events failed", err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	out := make([]entity.SuspicionEvent, 0)
+
+	for rows.Next() {
+		var (
+			e                entity.SuspicionEvent
+			oldType, newType pgtype.Text
+			oldDesc, newDesc pgtype.Text
+			evidence         []byte
+		)
+
+		if err := rows.Scan(&e.ID, &e.TwitchUserID, &e.Username, &e.Source, &e.OldIsSus, &e.NewIsSus,
+			&oldType, &newType, &oldDesc, &newDesc, &evidence, &e.CreatedAt); err != nil {
+			r.obs.LogError(ctx, span, "scan suspicion event failed", err)
+			return nil, err
+		}
+
+		e.OldSusType = textPtr(oldType)
+		e.NewSusType = textPtr(newType)
+		e.OldSusDescription = textPtr(oldDesc)
+		e.NewSusDescription = textPtr(newDesc)
+
+		if len(evidence) > 0 {
+			if err := json.Unmarshal(evidence, &e.Evidence); err != nil {
+				r.obs.LogError(ctx, span, "unmarshal suspicion evidence failed", err)
+				return nil, err
+			}
+		}
+
+		out = append(out, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.obs.LogError(ctx, span, "suspicion event rows iteration failed", err)
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	s := t.String
+
+	return &s
+}
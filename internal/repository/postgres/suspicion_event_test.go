@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestSuspicionChanged(t *testing.T) {
+	t.Parallel()
+
+	autoScore, manual := entity.SusTypeAutoScore, entity.SusTypeManual
+	day1, day2 := "Score 88/50: Account created 1 days ago", "Score 85/50: Account created 2 days ago"
+
+	old := entity.TwitchUser{IsSus: true, SusType: &autoScore, SusDescription: &day1}
+	rescored := entity.TwitchUser{IsSus: true, SusType: &autoScore, SusDescription: &day2}
+
+	assert.False(t, suspicionChanged(old, rescored, entity.SuspicionSourceAuto), "automatic re-score with the same verdict")
+	assert.True(t, suspicionChanged(old, rescored, entity.SuspicionSourceManual), "manual description edit")
+
+	rescored.SusType = &manual
+	assert.True(t, suspicionChanged(old, rescored, entity.SuspicionSourceAuto))
+
+	assert.True(t, suspicionChanged(old, entity.TwitchUser{}, entity.SuspicionSourceAuto))
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// UpsertSuspicionScore stores the latest score breakdown for a user, replacing the previous one.
+func (r *Repository) UpsertSuspicionScore(ctx context.Context, s entity.SuspicionScore) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.upsert_suspicion_score")
+	defer span.End()
+
+	signals := s.Signals
+	if signals == nil {
+		signals = []entity.SuspicionSignal{}
+	}
+
+	sj, err := json.Marshal(signals)
+	if err != nil {
+		r.obs.LogError(ctx, span, "marshal suspicion signals failed", err)
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO twitch_user_suspicion_scores (twitch_user_id, score, threshold, signals, computed_at)
+		VALUES ($1, $2, $3, $4::jsonb, $5)
+		ON CONFLICT (twitch_user_id) DO UPDATE SET
+			score = EXCLUDED.score,
+			threshold = EXCLUDED.threshold,
+			signals = EXCLUDED.signals,
+			computed_at = EXCLUDED.computed_at
+	`, s.TwitchUserID, s.Score, s.Threshold, sj, s.ComputedAt)
+	if err != nil {
+		r.obs.LogError(ctx, span, "upsert suspicion score failed", err, zap.Int64("twitch_user_id", s.TwitchUserID))
+	}
+
+	return err
+}
+
+// GetSuspicionScore returns the latest score breakdown, or nil when the user was never scored.
+func (r *Repository) GetSuspicionScore(ctx context.Context, twitchUserID int64) (*entity.SuspicionScore, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.get_suspicion_score")
+	defer span.End()
+
+	s := entity.SuspicionScore{TwitchUserID: twitchUserID}
+
+	var sj []byte
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT score, threshold, signals, computed_at FROM twitch_user_suspicion_scores WHERE twitch_user_id = $1
+	`, twitchUserID).Scan(&s.Score, &s.Threshold, &sj, &s.ComputedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		r.obs.LogError(ctx, span, "get suspicion score failed", err, zap.Int64("twitch_user_id", twitchUserID))
+		return nil, err
+	}
+
+	if err := json.Unmarshal(sj, &s.Signals); err != nil {
+		r.obs.LogError(ctx, span, "unmarshal suspicion signals failed", err)
+		return nil, err
+	}
+
+	return &s, nil
+}
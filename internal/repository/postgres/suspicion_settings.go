@@ -17,11 +17,15 @@ func (r *Repository) GetSuspicionSettings(ctx context.Context) (entity.Suspicion
 
 	err := r.pool.QueryRow(ctx, `
 		SELECT auto_check_account_age, account_age_sus_days, auto_check_blacklist, auto_check_low_follows,
-			low_follows_threshold, max_gql_follow_pages
+			low_follows_threshold, max_gql_follow_pages, score_threshold,
+			weight_account_age, weight_blacklist_follows, weight_low_follows, weight_name_pattern,
+			weight_first_message, weight_multi_channel, weight_moderation, name_patterns, multi_channel_threshold
 		FROM suspicion_settings WHERE id = 1
 	`).Scan(
 		&s.AutoCheckAccountAge, &s.AccountAgeSusDays, &s.AutoCheckBlacklist, &s.AutoCheckLowFollows,
-		&s.LowFollowsThreshold, &s.MaxGQLFollowPages,
+		&s.LowFollowsThreshold, &s.MaxGQLFollowPages, &s.ScoreThreshold,
+		&s.Weights.AccountAge, &s.Weights.BlacklistFollows, &s.Weights.LowFollows, &s.Weights.NamePattern,
+		&s.Weights.FirstMessage, &s.Weights.MultiChannel, &s.Weights.Moderation, &s.NamePatterns, &s.MultiChannelThreshold,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -42,6 +46,18 @@ func defaultSuspicionSettings() entity.SuspicionSettings {
 		AutoCheckLowFollows: true,
 		LowFollowsThreshold: 10,
 		MaxGQLFollowPages:   1,
+		ScoreThreshold:      50,
+		Weights: entity.SuspicionWeights{
+			AccountAge:       50,
+			BlacklistFollows: 50,
+			LowFollows:       40,
+			NamePattern:      15,
+			FirstMessage:     25,
+			MultiChannel:     20,
+			Moderation:       20,
+		},
+		NamePatterns:          []string{`^[a-z]+_?[0-9]{4,}$`},
+		MultiChannelThreshold: 5,
 	}
 }
 
@@ -50,21 +66,40 @@ func (r *Repository) UpdateSuspicionSettings(ctx context.Context, s entity.Suspi
 	ctx, span := r.obs.StartSpan(ctx, "repo.update_suspicion_settings")
 	defer span.End()
 
+	patterns := s.NamePatterns
+	if patterns == nil {
+		patterns = []string{}
+	}
+
 	_, err := r.pool.Exec(ctx, `
 		INSERT INTO suspicion_settings (
 			id, auto_check_account_age, account_age_sus_days, auto_check_blacklist, auto_check_low_follows,
-			low_follows_threshold, max_gql_follow_pages
-		) VALUES (1, $1, $2, $3, $4, $5, $6)
+			low_follows_threshold, max_gql_follow_pages, score_threshold,
+			weight_account_age, weight_blacklist_follows, weight_low_follows, weight_name_pattern,
+			weight_first_message, weight_multi_channel, weight_moderation, name_patterns, multi_channel_threshold
+		) VALUES (1, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		ON CONFLICT (id) DO UPDATE SET
 			auto_check_account_age = EXCLUDED.auto_check_account_age,
 			account_age_sus_days = EXCLUDED.account_age_sus_days,
 			auto_check_blacklist = EXCLUDED.auto_check_blacklist,
 			auto_check_low_follows = EXCLUDED.auto_check_low_follows,
 			low_follows_threshold = EXCLUDED.low_follows_threshold,
-			max_gql_follow_pages = EXCLUDED.max_gql_follow_pages
+			max_gql_follow_pages = EXCLUDED.max_gql_follow_pages,
+			score_threshold = EXCLUDED.score_threshold,
+			weight_account_age = EXCLUDED.weight_account_age,
+			weight_blacklist_follows = EXCLUDED.weight_blacklist_follows,
+			weight_low_follows = EXCLUDED.weight_low_follows,
+			weight_name_pattern = EXCLUDED.weight_name_pattern,
+			weight_first_message = EXCLUDED.weight_first_message,
+			weight_multi_channel = EXCLUDED.weight_multi_channel,
+			weight_moderation = EXCLUDED.weight_moderation,
+			name_patterns = EXCLUDED.name_patterns,
+			multi_channel_threshold = EXCLUDED.multi_channel_threshold
 	`,
 		s.AutoCheckAccountAge, s.AccountAgeSusDays, s.AutoCheckBlacklist, s.AutoCheckLowFollows,
-		s.LowFollowsThreshold, s.MaxGQLFollowPages,
+		s.LowFollowsThreshold, s.MaxGQLFollowPages, s.ScoreThreshold,
+		s.Weights.AccountAge, s.Weights.BlacklistFollows, s.Weights.LowFollows, s.Weights.NamePattern,
+		s.Weights.FirstMessage, s.Weights.MultiChannel, s.Weights.Moderation, patterns, s.MultiChannelThreshold,
 	)
 	if err != nil {
 		r.obs.LogError(ctx, span, "update suspicion settings failed", err)
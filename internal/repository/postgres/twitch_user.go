@@ -272,7 +272,35 @@ func (r *Repository) PatchTwitchUser(ctx context.Context, id int64, patch entity
 		strings.Join(setParts, ", "), argN,
 	)
 
-	u, err := scanTwitchUser(r.pool.QueryRow(ctx, q, args...))
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin tx failed", err)
+		return entity.TwitchUser{}, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	susTouched := patch.IsSus != nil || patch.SusType != nil || patch.SusDescription != nil
+
+	var old entity.TwitchUser
+
+	if susTouched {
+		old, err = scanTwitchUser(tx.QueryRow(ctx, `
+			SELECT id, username, monitored, marked, is_sus, sus_type, sus_description, sus_auto_suppressed,
+				irc_only_when_live, notify_off_stream_messages, notify_stream_start
+			FROM twitch_users WHERE id = $1 FOR UPDATE
+		`, id))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return entity.TwitchUser{}, entity.ErrTwitchUserNotFound
+			}
+
+			r.obs.LogError(ctx, span, "lock twitch user failed", err, zap.Int64("id", id))
+			return entity.TwitchUser{}, err
+		}
+	}
+
+	u, err := scanTwitchUser(tx.QueryRow(ctx, q, args...))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return entity.TwitchUser{}, entity.ErrTwitchUserNotFound
@@ -282,6 +310,18 @@ func (r *Repository) PatchTwitchUser(ctx context.Context, id int64, patch entity
 		return entity.TwitchUser{}, err
 	}
 
+	if susTouched && suspicionChanged(old, u, patch.SusSource) {
+		if err := insertSuspicionEvent(ctx, tx, old, u, patch.SusSource, patch.SusEvidence); err != nil {
+			r.obs.LogError(ctx, span, "insert suspicion event failed", err, zap.Int64("id", id))
+			return entity.TwitchUser{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit patch twitch user failed", err, zap.Int64("id", id))
+		return entity.TwitchUser{}, err
+	}
+
 	return u, nil
 }
 
@@ -0,0 +1,312 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// orderedPair returns the ids as stored in pair tables (user_a < user_b).
+func orderedPair(a, b int64) (int64, int64) {
+	if a > b {
+		return b, a
+	}
+
+	return a, b
+}
+
+// ListAltCandidatePool returns users worth scoring as alts of userID: those sharing the most followed channels,
+// accounts created closest to it (within 3 days) and logins starting with loginStem. Each source returns at
+// most perSource ids; the result is de-duplicated and excludes userID.
+func (r *Repository) ListAltCandidatePool(ctx context.Context, userID int64, loginStem string, perSource int) ([]int64, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_alt_candidate_pool")
+	defer span.End()
+
+	if perSource < 1 {
+		perSource = 25
+	}
+
+	stem := normalizeStoredUsername(loginStem)
+
+	rows, err := r.pool.Query(ctx, `
+		(
+			SELECT o.follower_twitch_user_id
+			FROM user_followed_channels me
+			JOIN user_followed_channels o ON o.followed_channel_id = me.followed_channel_id
+			WHERE me.follower_twitch_user_id = $1 AND o.follower_twitch_user_id <> $1
+			GROUP BY o.follower_twitch_user_id
+			ORDER BY count(*) DESC, o.follower_twitch_user_id
+			LIMIT $3
+		)
+		UNION
+		(
+			SELECT o.twitch_user_id
+			FROM twitch_user_helix_meta me
+			JOIN twitch_user_helix_meta o ON o.account_created_at
+				BETWEEN me.account_created_at - interval '3 days' AND me.account_created_at + interval '3 days'
+			WHERE me.twitch_user_id = $1 AND o.twitch_user_id <> $1
+			ORDER BY abs(extract(epoch FROM o.account_created_at - me.account_created_at)), o.twitch_user_id
+			LIMIT $3
+		)
+		UNION
+		(
+			SELECT u.id
+			FROM twitch_users u
+			WHERE length($2) >= 3 AND starts_with(u.username, $2) AND u.id <> $1
+			ORDER BY u.id
+			LIMIT $3
+		)
+	`, userID, stem, perSource)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list alt candidate pool failed", err, zap.Int64("twitch_user_id", userID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []int64
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		out = append(out, id)
+	}
+
+	return out, rows.Err()
+}
+
+// ReplaceAltCandidates replaces every stored candidate pair involving userID (transactional).
+func (r *Repository) ReplaceAltCandidates(ctx context.Context, userID int64, cands []entity.AltCandidate) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.replace_alt_candidates")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		r.obs.LogError(ctx, span, "begin replace alt candidates failed", err)
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM twitch_user_alt_candidates WHERE user_a = $1 OR user_b = $1`, userID); err != nil {
+		r.obs.LogError(ctx, span, "delete alt candidates failed", err, zap.Int64("twitch_user_id", userID))
+		return err
+	}
+
+	for _, c := range cands {
+		signals := c.Signals
+		if signals == nil {
+			signals = []entity.AltSignal{}
+		}
+
+		sj, err := json.Marshal(signals)
+		if err != nil {
+			return err
+		}
+
+		a, b := orderedPair(userID, c.CandidateUserID)
+
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO twitch_user_alt_candidates (user_a, user_b, score, signals, computed_at)
+			VALUES ($1, $2, $3, $4::jsonb, $5)
+		`, a, b, c.Score, sj, c.ComputedAt); err != nil {
+			r.obs.LogError(ctx, span, "insert alt candidate failed", err, zap.Int64("twitch_user_id", userID))
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.obs.LogError(ctx, span, "commit replace alt candidates failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListAltCandidates returns stored possible alts of userID (highest score first), skipping pairs that were
+// already confirmed or rejected.
+func (r *Repository) ListAltCandidates(ctx context.Context, userID int64, limit int) ([]entity.AltCandidate, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_alt_candidates")
+	defer span.End()
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.id, u.username, c.score, c.signals, c.computed_at
+		FROM twitch_user_alt_candidates c
+		JOIN twitch_users u ON u.id = CASE WHEN c.user_a = $1 THEN c.user_b ELSE c.user_a END
+		WHERE (c.user_a = $1 OR c.user_b = $1)
+			AND NOT EXISTS (SELECT 1 FROM twitch_user_links l WHERE l.user_a = c.user_a AND l.user_b = c.user_b)
+		ORDER BY c.score DESC, u.username ASC
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list alt candidates failed", err, zap.Int64("twitch_user_id", userID))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.AltCandidate
+
+	for rows.Next() {
+		c := entity.AltCandidate{TwitchUserID: userID}
+
+		var sj []byte
+
+		if err := rows.Scan(&c.CandidateUserID, &c.CandidateUsername, &c.Score, &sj, &c.ComputedAt); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(sj, &c.Signals); err != nil {
+			r.obs.LogError(ctx, span, "unmarshal alt signals failed", err)
+			return nil, err
+		}
+
+		out = append(out, c)
+	}
+
+	return out, rows.Err()
+}
+
+// UpsertUserLink stores a confirmed or rejected link; the score is copied from the candidate pair when one exists.
+func (r *Repository) UpsertUserLink(ctx context.Context, l entity.UserLink) (entity.UserLink, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.upsert_user_link")
+	defer span.End()
+
+	a, b := orderedPair(l.TwitchUserID, l.LinkedUserID)
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO twitch_user_links (user_a, user_b, status, score, note, decided_by_user_id)
+		VALUES ($1, $2, $3, (SELECT score FROM twitch_user_alt_candidates WHERE user_a = $1 AND user_b = $2), $4, $5)
+		ON CONFLICT (user_a, user_b) DO UPDATE SET
+			status = EXCLUDED.status,
+			score = COALESCE(EXCLUDED.score, twitch_user_links.score),
+			note = EXCLUDED.note,
+			decided_by_user_id = EXCLUDED.decided_by_user_id,
+			updated_at = now()
+	`, a, b, l.Status, l.Note, l.DecidedByUserID)
+	if err != nil {
+		r.obs.LogError(ctx, span, "upsert user link failed", err, zap.Int64("twitch_user_id", l.TwitchUserID),
+			zap.Int64("linked_user_id", l.LinkedUserID))
+		return entity.UserLink{}, err
+	}
+
+	links, err := r.listUserLinks(ctx, l.TwitchUserID, &l.LinkedUserID)
+	if err != nil {
+		r.obs.LogError(ctx, span, "reload user link failed", err)
+		return entity.UserLink{}, err
+	}
+
+	if len(links) == 0 {
+		return entity.UserLink{}, entity.ErrUserLinkNotFound
+	}
+
+	return links[0], nil
+}
+
+// DeleteUserLink removes a link in either direction.
+func (r *Repository) DeleteUserLink(ctx context.Context, userID, linkedUserID int64) error {
+	ctx, span := r.obs.StartSpan(ctx, "repo.delete_user_link")
+	defer span.End()
+
+	a, b := orderedPair(userID, linkedUserID)
+
+	tag, err := r.pool.Exec(ctx, `DELETE FROM twitch_user_links WHERE user_a = $1 AND user_b = $2`, a, b)
+	if err != nil {
+		r.obs.LogError(ctx, span, "delete user link failed", err)
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrUserLinkNotFound
+	}
+
+	return nil
+}
+
+// ListUserLinks returns confirmed and rejected links of userID (newest decision first).
+func (r *Repository) ListUserLinks(ctx context.Context, userID int64) ([]entity.UserLink, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_user_links")
+	defer span.End()
+
+	out, err := r.listUserLinks(ctx, userID, nil)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list user links failed", err, zap.Int64("twitch_user_id", userID))
+	}
+
+	return out, err
+}
+
+func (r *Repository) listUserLinks(ctx context.Context, userID int64, linkedUserID *int64) ([]entity.UserLink, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT u.id, u.username, l.status, l.score, l.note, l.decided_by_user_id, l.created_at, l.updated_at
+		FROM twitch_user_links l
+		JOIN twitch_users u ON u.id = CASE WHEN l.user_a = $1 THEN l.user_b ELSE l.user_a END
+		WHERE (l.user_a = $1 OR l.user_b = $1) AND ($2::bigint IS NULL OR u.id = $2)
+		ORDER BY l.updated_at DESC, u.id ASC
+	`, userID, linkedUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []entity.UserLink
+
+	for rows.Next() {
+		l := entity.UserLink{TwitchUserID: userID}
+
+		if err := rows.Scan(&l.LinkedUserID, &l.LinkedUsername, &l.Status, &l.Score, &l.Note, &l.DecidedByUserID,
+			&l.CreatedAt, &l.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		out = append(out, l)
+	}
+
+	return out, rows.Err()
+}
+
+// ListChattersActiveSince returns chatters with messages at or after since, most recently active first.
+func (r *Repository) ListChattersActiveSince(ctx context.Context, since time.Time, limit int) ([]int64, error) {
+	ctx, span := r.obs.StartSpan(ctx, "repo.list_chatters_active_since")
+	defer span.End()
+
+	if limit < 1 {
+		limit = 500
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT chatter_twitch_user_id
+		FROM chat_messages
+		WHERE created_at >= $1 AND chatter_twitch_user_id IS NOT NULL
+		GROUP BY chatter_twitch_user_id
+		ORDER BY max(created_at) DESC
+		LIMIT $2
+	`, since, limit)
+	if err != nil {
+		r.obs.LogError(ctx, span, "list chatters active since failed", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []int64
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		out = append(out, id)
+	}
+
+	return out, rows.Err()
+}
@@ -24,9 +24,22 @@ type Store interface {
 
 	ListNotificationEntries(ctx context.Context, f entity.NotificationListFilter) ([]entity.NotificationEntry, error)
 	ListEnabledNotificationEntries(ctx context.Context) ([]entity.NotificationEntry, error)
-	CreateNotificationEntry(ctx context.Context, provider string, settings map[string]any, enabled bool) (entity.NotificationEntry, error)
-	UpdateNotificationEntry(ctx context.Context, id int64, provider *string, settings map[string]any, enabled *bool) (entity.NotificationEntry, error)
+	GetNotificationEntry(ctx context.Context, id int64) (entity.NotificationEntry, error)
+	CreateNotificationEntry(ctx context.Context, provider string, settings map[string]any, enabled bool, routing entity.NotificationRouting, policy entity.NotificationPolicy) (entity.NotificationEntry, error)
+	UpdateNotificationEntry(ctx context.Context, id int64, provider *string, settings map[string]any, enabled *bool, routing *entity.NotificationRouting, policy *entity.NotificationPolicy) (entity.NotificationEntry, error)
 	DeleteNotificationEntry(ctx context.Context, id int64) error
+	EnqueueNotificationDeliveries(ctx context.Context, entryIDs []int64, ev entity.NotificationEvent, notBefore time.Time) error
+	RecordSuppressedNotificationDeliveries(ctx context.Context, entryIDs []int64, ev entity.NotificationEvent, reason string) error
+	ClaimNotificationDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entity.NotificationDeliveryJob, error)
+	ClaimEntryNotificationDeliveries(ctx context.Context, entryID int64, limit int, lease time.Duration) ([]entity.NotificationDelivery, error)
+	CompleteNotificationDeliveryAttempt(ctx context.Context, deliveryID int64, attempt entity.NotificationDeliveryAttempt, status string, nextAttemptAt time.Time) error
+	ListNotificationDeliveries(ctx context.Context, f entity.NotificationDeliveryListFilter) ([]entity.NotificationDelivery, error)
+	RequeueNotificationDelivery(ctx context.Context, id int64) (entity.NotificationDelivery, error)
+	PruneNotificationDeliveries(ctx context.Context, before time.Time) (int64, error)
+	CreateNotificationSnooze(ctx context.Context, s entity.NotificationSnooze) (entity.NotificationSnooze, error)
+	ListActiveNotificationSnoozes(ctx context.Context, at time.Time) ([]entity.NotificationSnooze, error)
+	DeleteNotificationSnooze(ctx context.Context, id int64) error
+	PruneNotificationSnoozes(ctx context.Context, before time.Time) (int64, error)
 
 	ListTwitchAccounts(ctx context.Context) ([]entity.TwitchAccount, error)
 	CountTwitchAccounts(ctx context.Context) (int64, error)
@@ -39,6 +52,7 @@ type Store interface {
 
 	InsertChatMessage(ctx context.Context, channelTwitchUserID int64, chatterTwitchUserID *int64, chatterUsername, body string, keywordMatch bool, msgType string, badgeTags []string, firstMessage bool) (int64, error)
 	InsertChatMessageForChannelLogin(ctx context.Context, channelLogin string, chatterTwitchUserID *int64, chatterUsername, body string, keywordMatch bool, msgType string, badgeTags []string, firstMessage bool) (int64, error)
+	InsertChatMessageEmotes(ctx context.Context, messageID int64, emotes []entity.ChatEmote) error
 	UpsertTwitchUserFromChat(ctx context.Context, id int64, username string) (inserted bool, err error)
 	IsMonitoredChannel(ctx context.Context, channel string) (bool, error)
 	// MonitoredChannelTwitchUserID returns the twitch_users.id for a monitored channel by login (ok=false if not monitored).
@@ -59,6 +73,7 @@ type Store interface {
 	ListChannelChatterIDs(ctx context.Context, channelTwitchUserID int64) ([]int64, error)
 	CountChannelChatters(ctx context.Context, channelTwitchUserID int64) (int64, error)
 	ListChannelChatterEntries(ctx context.Context, channelTwitchUserID int64) ([]entity.ChannelChatterEntry, error)
+	ListChatterChannelPresence(ctx context.Context, chatterTwitchUserID int64) ([]entity.ChatterChannelPresence, error)
 	InsertUserActivityEvent(ctx context.Context, chatterID int64, eventType string, channelTwitchUserID *int64, details map[string]any) error
 	ListUserActivityEvents(ctx context.Context, f entity.UserActivityListFilter) ([]entity.UserActivityEvent, error)
 	ListUserActivityEventsForTimeline(ctx context.Context, chatterID int64, from, to time.Time) ([]entity.UserActivityEvent, error)
@@ -73,7 +88,7 @@ type Store interface {
 
 	ActiveStreamIDForChannel(ctx context.Context, channelTwitchUserID int64) (*int64, error)
 	UpsertStreamFromHelix(ctx context.Context, channelTwitchUserID int64, helixStreamID string, startedAt time.Time, title, gameName string, viewerCount *int64) (int64, error)
-	CloseOpenStreamsForChannel(ctx context.Context, channelTwitchUserID int64) error
+	CloseOpenStreamsForChannel(ctx context.Context, channelTwitchUserID int64) ([]int64, error)
 	GetStreamByID(ctx context.Context, id int64) (entity.Stream, error)
 	GetMonitoredStreamByID(ctx context.Context, id int64) (entity.Stream, error)
 	ListMonitoredStreams(ctx context.Context, f entity.StreamListFilter) ([]entity.Stream, error)
@@ -81,14 +96,92 @@ type Store interface {
 	CountChatMessagesPerChatterForChannelSince(ctx context.Context, channelTwitchUserID int64, since time.Time) (map[int64]int64, error)
 	ListUserActivityEventsForChannelPresence(ctx context.Context, channelTwitchUserID int64, from, to time.Time) ([]entity.UserActivityEvent, error)
 	ListUserActivityForStream(ctx context.Context, f entity.UserActivityListFilterForStream) ([]entity.UserActivityEvent, error)
+	CountUserActivityEventsByType(ctx context.Context, chatterID int64, eventTypes []string) (int64, error)
 
 	ReplaceUserFollowedChannels(ctx context.Context, followerID int64, rows []entity.FollowedChannelRow) error
 	ListUserFollowedChannels(ctx context.Context, followerID int64) ([]entity.FollowedChannelRow, error)
+	UpsertFollowsSyncMeta(ctx context.Context, twitchUserID int64, total int, syncedAt time.Time) error
+	ListFollowsSyncStates(ctx context.Context, afterID int64, limit int) ([]entity.FollowsSyncState, error)
+
+	ListAltCandidatePool(ctx context.Context, userID int64, loginStem string, perSource int) ([]int64, error)
+	ReplaceAltCandidates(ctx context.Context, userID int64, cands []entity.AltCandidate) error
+	ListAltCandidates(ctx context.Context, userID int64, limit int) ([]entity.AltCandidate, error)
+	UpsertUserLink(ctx context.Context, l entity.UserLink) (entity.UserLink, error)
+	DeleteUserLink(ctx context.Context, userID, linkedUserID int64) error
+	ListUserLinks(ctx context.Context, userID int64) ([]entity.UserLink, error)
+	ListChattersActiveSince(ctx context.Context, since time.Time, limit int) ([]int64, error)
+
+	GetBotDetectionSettings(ctx context.Context) (entity.BotDetectionSettings, error)
+	UpdateBotDetectionSettings(ctx context.Context, s entity.BotDetectionSettings) error
+	ListBotPresence(ctx context.Context, minChannels int) ([]entity.BotPresence, error)
+	UpsertLikelyBots(ctx context.Context, bots []entity.BotPresence, detectedAt, staleBefore time.Time) error
+	ListLikelyBots(ctx context.Context, limit int) ([]entity.LikelyBot, error)
+	LikelyBotIDs(ctx context.Context, ids []int64) (map[int64]struct{}, error)
+	ListChannelBotCounts(ctx context.Context) ([]entity.ChannelBotCounts, error)
+	ReplaceChannelBotEstimates(ctx context.Context, estimates []entity.ChannelBotEstimate) error
+	ListChannelBotEstimates(ctx context.Context) ([]entity.ChannelBotEstimate, error)
+
 	ListChannelBlacklist(ctx context.Context) ([]string, error)
 	AddChannelBlacklist(ctx context.Context, login string) error
 	RemoveChannelBlacklist(ctx context.Context, login string) error
+	ListLoginPatterns(ctx context.Context) ([]entity.LoginPattern, error)
+	CreateLoginPattern(ctx context.Context, p entity.LoginPattern) (entity.LoginPattern, error)
+	DeleteLoginPattern(ctx context.Context, id int64) error
+	ListEmoteSets(ctx context.Context) ([]entity.EmoteSet, error)
+	SaveEmoteSet(ctx context.Context, s entity.EmoteSet, emotes []entity.EmoteSetEmote) (entity.EmoteSet, error)
+	DeleteEmoteSet(ctx context.Context, id int64) error
+	ListScopedEmotes(ctx context.Context) ([]entity.ScopedEmote, error)
+	ListBlocklists(ctx context.Context) ([]entity.Blocklist, error)
+	GetBlocklist(ctx context.Context, id int64) (entity.Blocklist, error)
+	CreateBlocklist(ctx context.Context, b entity.Blocklist) (entity.Blocklist, error)
+	DeleteBlocklist(ctx context.Context, id int64) error
+	ReplaceBlocklistEntries(ctx context.Context, id int64, entries []entity.BlocklistEntry, sourceFilename *string, contentSHA256 string, importedAt time.Time) error
+	RecordBlocklistSync(ctx context.Context, id int64, syncedAt time.Time, syncErr *string) error
+	MatchBlocklists(ctx context.Context, userID int64, login string) ([]entity.BlocklistHit, error)
+	GetChannelAnalyticsWatermark(ctx context.Context) (*time.Time, error)
+	EarliestChatMessageAt(ctx context.Context) (*time.Time, error)
+	ReplaceChannelActivityRollups(ctx context.Context, from, to time.Time, presence []entity.ChannelActivityBucket, chatterPresence []entity.ChatterDayPresence) error
+	ListChannelLeaderboard(ctx context.Context, channelID *int64, q entity.ChannelLeaderboardQuery) ([]entity.ChannelLeaderboardRow, int64, error)
+	CountChatMessagesByHourOfWeek(ctx context.Context, chatterID, channelID *int64, from, to time.Time, timezone string) (entity.HourOfWeekMatrix, error)
+	RecordChatterFirstSeen(ctx context.Context, channelTwitchUserID, chatterTwitchUserID int64, at time.Time) (entity.ChatterFirstSeen, error)
+	CountStreamChatters(ctx context.Context, streamID int64) (entity.StreamChatterCounts, error)
+	RefreshStreamRetention(ctx context.Context, since time.Time) error
+	ListChannelActivityRollups(ctx context.Context, channelTwitchUserID int64, bucket entity.ChannelAnalyticsBucket, from, to time.Time) ([]entity.ChannelActivityBucket, error)
+	ListChannelActivityMinutes(ctx context.Context, channelTwitchUserID int64, from, to time.Time) ([]entity.ChannelActivityBucket, error)
+	ListStreamRetention(ctx context.Context, channelTwitchUserID int64, from, to time.Time) ([]entity.StreamRetention, error)
+	ListChannelAudience(ctx context.Context, from, to time.Time) ([]entity.AudienceMember, error)
+	CountFollowedChannelsAmong(ctx context.Context, followerIDs []int64, limit int) ([]entity.AudienceCommunityChannel, error)
+	GetAudienceOverlapCache(ctx context.Context, period entity.AudienceOverlapPeriod) (*entity.AudienceOverlap, error)
+	SaveAudienceOverlapCache(ctx context.Context, o entity.AudienceOverlap) error
+	ListEmoteUsage(ctx context.Context, f entity.EmoteUsageFilter) ([]entity.EmoteUsage, error)
+	ListStreamEmoteCounts(ctx context.Context, streamID int64, origin time.Time, bucket time.Duration, emotes []entity.EmoteUsage) ([]entity.EmoteBucketCount, error)
+	ListStreamTermMessages(ctx context.Context, streamID int64, from, to *time.Time, contains string) ([]entity.TermMessage, error)
+	ListStreamsPendingTerms(ctx context.Context, limit int) ([]entity.Stream, error)
+	ReplaceStreamTerms(ctx context.Context, streamID int64, messages int, terms []entity.TermCount) error
+	CountBaselineTerms(ctx context.Context, channelID, excludeStreamID int64, streams int, terms []entity.ChatTerm) (int, map[entity.ChatTerm]int, error)
+	GetStreamRecapStats(ctx context.Context, streamID int64) (entity.StreamRecap, error)
+	ListStreamBusiestMoments(ctx context.Context, streamID int64, origin time.Time, bucket time.Duration, limit int) ([]entity.StreamRecapMoment, error)
+	CountChannelRuleTriggers(ctx context.Context, channel string, from, to time.Time) ([]entity.StreamRecapRule, error)
+	ListSuspiciousTwitchUsersByIDs(ctx context.Context, ids []int64) ([]entity.StreamRecapUser, error)
+	SaveStreamRecap(ctx context.Context, recap entity.StreamRecap) error
+	GetStreamRecap(ctx context.Context, streamID int64) (*entity.StreamRecap, error)
+	GetStreamRecapSettings(ctx context.Context) (entity.StreamRecapSettings, error)
+	UpdateStreamRecapSettings(ctx context.Context, s entity.StreamRecapSettings) error
+	GetChatClassifierSettings(ctx context.Context) (entity.ChatClassifierSettings, error)
+	UpdateChatClassifierSettings(ctx context.Context, s entity.ChatClassifierSettings) error
+	ListChatMessagesForClassification(ctx context.Context, since time.Time, limit int) ([]entity.ClassifiableChatMessage, int64, error)
+	SaveChatClassification(ctx context.Context, labels []entity.ChatMessageLabels, cursor int64, day time.Time, tokens int64) error
+	ChatClassifierTokensUsed(ctx context.Context, day time.Time) (int64, error)
+	GetLatestChatterLabels(ctx context.Context, channel, username string, since time.Time) (*entity.ChatMessageLabels, error)
+	GetStreamChatToxicity(ctx context.Context, streamID int64) (entity.ChatToxicity, error)
+	GetChatterChatToxicity(ctx context.Context, chatterTwitchUserID int64) (entity.ChatToxicity, error)
+	InsertChatMoment(ctx context.Context, m entity.ChatMoment) (entity.ChatMoment, error)
+	ListStreamChatMoments(ctx context.Context, streamID int64) ([]entity.ChatMoment, error)
 	GetSuspicionSettings(ctx context.Context) (entity.SuspicionSettings, error)
 	UpdateSuspicionSettings(ctx context.Context, s entity.SuspicionSettings) error
+	UpsertSuspicionScore(ctx context.Context, s entity.SuspicionScore) error
+	GetSuspicionScore(ctx context.Context, twitchUserID int64) (*entity.SuspicionScore, error)
+	ListSuspicionEvents(ctx context.Context, f entity.SuspicionEventListFilter) ([]entity.SuspicionEvent, error)
 	GetIrcMonitorSettings(ctx context.Context) (entity.IrcMonitorSettings, error)
 	UpdateIrcMonitorSettings(ctx context.Context, s entity.IrcMonitorSettings) error
 	GetChannelDiscoverySettings(ctx context.Context) (entity.ChannelDiscoverySettings, error)
@@ -101,7 +194,7 @@ type Store interface {
 	ApproveDiscoveryCandidate(ctx context.Context, twitchUserID int64) (entity.TwitchUser, error)
 	DenyDiscoveryCandidate(ctx context.Context, twitchUserID int64) error
 	InsertIrcJoinedSample(ctx context.Context, joinedCount int) error
-	InsertRuleTriggerEvent(ctx context.Context, ruleID int64, ruleName, triggerEvent, actionType, displayText string) error
+	InsertRuleTriggerEvent(ctx context.Context, ruleID int64, ruleName, triggerEvent, actionType, channel, displayText string) error
 	ListIrcJoinedSamples(ctx context.Context, from, to time.Time) ([]entity.IrcJoinedSample, error)
 	ListLinkedTwitchAccountUserIDs(ctx context.Context) ([]int64, error)
 
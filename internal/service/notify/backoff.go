@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	retryBaseDelay = 5 * time.Second
+	retryMaxDelay  = 30 * time.Minute
+)
+
+// retryDelay returns the wait before the next attempt after `attempts` failures: exponential from
+// retryBaseDelay capped at retryMaxDelay, with equal jitter, and never shorter than the provider's retryAfter.
+func retryDelay(attempts int, retryAfter time.Duration) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	d := retryMaxDelay
+	if attempts <= 20 {
+		d = min(retryBaseDelay<<(attempts-1), retryMaxDelay)
+	}
+
+	half := d / 2
+	d = half + rand.N(half+1)
+
+	return max(d, retryAfter)
+}
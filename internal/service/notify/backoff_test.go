@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	for range 50 {
+		d := retryDelay(1, 0)
+		assert.GreaterOrEqual(t, d, retryBaseDelay/2)
+		assert.LessOrEqual(t, d, retryBaseDelay)
+
+		d = retryDelay(3, 0)
+		assert.GreaterOrEqual(t, d, 10*time.Second)
+		assert.LessOrEqual(t, d, 20*time.Second)
+
+		d = retryDelay(100, 0)
+		assert.GreaterOrEqual(t, d, retryMaxDelay/2)
+		assert.LessOrEqual(t, d, retryMaxDelay)
+	}
+
+	assert.Equal(t, time.Hour, retryDelay(1, time.Hour))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 7*time.Second, parseRetryAfter("7", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+}
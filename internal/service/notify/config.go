@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/observability"
+	"github.com/rofleksey/dredge/internal/repository"
+)
+
+// Config wires the notification outbox dispatcher.
+type Config struct {
+	Repo           repository.Store
+	Obs            *observability.Stack
+	HTTPClient     *http.Client
+	PersistContext func() context.Context
+	// Providers resolves entry provider types; nil uses NewRegistry with public base URLs.
+	Providers *Registry
+	// Workers is the number of deliveries sent concurrently (default 8).
+	Workers int
+	// PollInterval is how often due retries are picked up without a wake-up (default 2s).
+	PollInterval time.Duration
+	// DeliveryTimeout bounds one provider call (default 15s).
+	DeliveryTimeout time.Duration
+	// MaxAttempts is how many failed attempts move a delivery to dead (default 8).
+	MaxAttempts int
+	// Retention is how long delivered and dead rows are kept (default 30 days).
+	Retention time.Duration
+}
@@ -0,0 +1,114 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// discordProvider posts an embed to a Discord channel webhook (settings: webhook_url; optional username,
+// avatar_url, color).
+type discordProvider struct{}
+
+func (discordProvider) Type() string { return "discord" }
+
+func (p discordProvider) ValidateSettings(settings map[string]any) error {
+	if err := validateHTTPURL(p.Type(), settings, "webhook_url", true); err != nil {
+		return err
+	}
+
+	if err := validateHTTPURL(p.Type(), settings, "avatar_url", false); err != nil {
+		return err
+	}
+
+	if err := optionalStringSettings(p.Type(), settings, "username"); err != nil {
+		return err
+	}
+
+	if c, ok, err := intSetting(settings, "color"); err != nil || (ok && (c < 0 || c > 0xFFFFFF)) {
+		return fmt.Errorf("discord: settings.color must be an RGB integer: %w", entity.ErrInvalidNotification)
+	}
+
+	return nil
+}
+
+// discordEventColor picks an embed accent per event type when settings.color is unset.
+func discordEventColor(eventType string) int {
+	switch eventType {
+	case eventStreamStart:
+		return 0x2ECC71
+	case eventStreamEnd:
+		return 0x95A5A6
+	default:
+		return 0x9146FF
+	}
+}
+
+func (p discordProvider) Render(settings map[string]any, d entity.NotificationDelivery) (Request, error) {
+	color, ok, _ := intSetting(settings, "color")
+	if !ok {
+		color = discordEventColor(d.Event.Type)
+	}
+
+	embed := map[string]any{
+		"title":       truncateString(eventTitle(d.Event), 250),
+		"description": truncateString(eventText(d.Event), 4000),
+		"color":       color,
+		"timestamp":   d.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	payload := map[string]any{
+		"embeds": []any{embed},
+		// Never ping @everyone or roles from chat content.
+		"allowed_mentions": map[string]any{"parse": []string{}},
+	}
+
+	if v := stringSetting(settings, "username"); v != "" {
+		payload["username"] = v
+	}
+
+	if v := stringSetting(settings, "avatar_url"); v != "" {
+		payload["avatar_url"] = v
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return Request{}, err
+	}
+
+	// wait=true makes Discord return the created message instead of 204, which is more useful in the delivery log.
+	u, err := url.Parse(stringSetting(settings, "webhook_url"))
+	if err != nil {
+		return Request{}, err
+	}
+
+	q := u.Query()
+	q.Set("wait", "true")
+	u.RawQuery = q.Encode()
+
+	return Request{
+		Method: http.MethodPost,
+		URL:    u.String(),
+		Header: http.Header{"Content-Type": {"application/json"}},
+		Body:   body,
+	}, nil
+}
+
+func (p discordProvider) Send(ctx context.Context, client *http.Client, req Request) Result {
+	return sendHTTP(ctx, client, p.Type(), req, func(body []byte) time.Duration {
+		var rb struct {
+			RetryAfter float64 `json:"retry_after"`
+		}
+
+		if json.Unmarshal(body, &rb) != nil || rb.RetryAfter <= 0 {
+			return 0
+		}
+
+		return time.Duration(rb.RetryAfter * float64(time.Second))
+	})
+}
@@ -0,0 +1,80 @@
+package notify
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestDiscordProvider_embed(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query   string
+		payload map[string]any
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, `{"id":"1"}`)
+	}))
+	defer srv.Close()
+
+	res := renderAndSend(t, discordProvider{}, srv.Client(), map[string]any{
+		"webhook_url": srv.URL + "/api/webhooks/1/tok",
+		"username":    "dredge",
+	}, entity.NotificationDelivery{
+		CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Event:     entity.NotificationEvent{Type: eventStreamStart, Channel: "chan", Title: "speedrun"},
+	})
+	require.True(t, res.Delivered(), res.Err)
+
+	assert.Equal(t, "wait=true", query)
+	assert.Equal(t, "dredge", payload["username"])
+
+	embeds, _ := payload["embeds"].([]any)
+	require.Len(t, embeds, 1)
+
+	embed, _ := embeds[0].(map[string]any)
+	assert.Equal(t, "#chan is live", embed["title"])
+	assert.Equal(t, "[live] #chan started streaming: speedrun", embed["description"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", embed["timestamp"])
+	assert.InDelta(t, 0x2ECC71, embed["color"], 0)
+}
+
+func TestDiscordProvider_rateLimited(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = io.WriteString(w, `{"message":"You are being rate limited.","retry_after":1.5,"global":false}`)
+	}))
+	defer srv.Close()
+
+	res := renderAndSend(t, discordProvider{}, srv.Client(), map[string]any{"webhook_url": srv.URL}, entity.NotificationDelivery{
+		Event: entity.NotificationEvent{Type: eventRuleText, Channel: "chan", Text: "hi"},
+	})
+	require.False(t, res.Delivered())
+	assert.False(t, res.Permanent)
+	assert.Equal(t, 1500*time.Millisecond, res.RetryAfter)
+}
+
+func TestDiscordProvider_ValidateSettings(t *testing.T) {
+	t.Parallel()
+
+	p := discordProvider{}
+
+	require.NoError(t, p.ValidateSettings(map[string]any{"webhook_url": "https://discord.com/api/webhooks/1/x", "color": float64(0xFF0000)}))
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"webhook_url": "https://d.example", "color": "red"}), entity.ErrInvalidNotification)
+}
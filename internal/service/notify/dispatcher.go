@@ -0,0 +1,142 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/observability"
+	"github.com/rofleksey/dredge/internal/repository"
+)
+
+// Dispatcher enqueues notification events into the Postgres outbox and delivers them from a worker pool
+// with retries; rows that keep failing end up dead and stay visible in the delivery log.
+type Dispatcher struct {
+	repo            repository.Store
+	obs             *observability.Stack
+	httpClient      *http.Client
+	persistParent   func() context.Context
+	providers       *Registry
+	workers         int
+	pollInterval    time.Duration
+	deliveryTimeout time.Duration
+	maxAttempts     int
+	retention       time.Duration
+
+	wake chan struct{}
+
+	floodMu   sync.Mutex
+	floods    map[floodKey]*floodWindow
+	floodDone []*floodWindow
+
+	loopMu     sync.Mutex
+	loopCancel context.CancelFunc
+	loopWG     sync.WaitGroup
+}
+
+// NewDispatcher constructs an outbox dispatcher; call Start to run the delivery loop.
+func NewDispatcher(cfg Config) *Dispatcher {
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	providers := cfg.Providers
+	if providers == nil {
+		providers = NewRegistry(ProvidersConfig{})
+	}
+
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = 8
+	}
+
+	poll := cfg.PollInterval
+	if poll <= 0 {
+		poll = 2 * time.Second
+	}
+
+	timeout := cfg.DeliveryTimeout
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+
+	maxAttempts := cfg.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 8
+	}
+
+	retention := cfg.Retention
+	if retention <= 0 {
+		retention = 30 * 24 * time.Hour
+	}
+
+	return &Dispatcher{
+		repo:            cfg.Repo,
+		obs:             cfg.Obs,
+		httpClient:      client,
+		persistParent:   cfg.PersistContext,
+		providers:       providers,
+		workers:         workers,
+		pollInterval:    poll,
+		deliveryTimeout: timeout,
+		maxAttempts:     maxAttempts,
+		retention:       retention,
+		wake:            make(chan struct{}, 1),
+		floods:          make(map[floodKey]*floodWindow),
+	}
+}
+
+func (d *Dispatcher) persistContext() context.Context {
+	if d.persistParent != nil {
+		return d.persistParent()
+	}
+
+	return context.Background()
+}
+
+// Start runs the delivery loop until Stop. Calling Start twice is a no-op.
+func (d *Dispatcher) Start(ctx context.Context) {
+	d.loopMu.Lock()
+	defer d.loopMu.Unlock()
+
+	if d.loopCancel != nil {
+		return
+	}
+
+	loopCtx, cancel := context.WithCancel(ctx)
+	d.loopCancel = cancel
+
+	d.loopWG.Add(1)
+
+	go func() {
+		defer d.loopWG.Done()
+
+		d.run(loopCtx)
+	}()
+}
+
+// Stop cancels the delivery loop and waits for in-flight deliveries to finish recording their attempt.
+// Undelivered rows stay in the outbox and are picked up on the next start.
+func (d *Dispatcher) Stop() {
+	d.loopMu.Lock()
+	cancel := d.loopCancel
+	d.loopCancel = nil
+	d.loopMu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	d.loopWG.Wait()
+}
+
+// signal wakes the delivery loop after an enqueue without blocking.
+func (d *Dispatcher) signal() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,42 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	"github.com/rofleksey/dredge/internal/repository"
+)
+
+func testDispatcher(t *testing.T, repo repository.Store, client *http.Client, telegramURL string) *Dispatcher {
+	t.Helper()
+
+	return NewDispatcher(Config{
+		Repo:            repo,
+		Obs:             &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")},
+		HTTPClient:      client,
+		PersistContext:  context.Background,
+		Providers:       NewRegistry(ProvidersConfig{TelegramAPIBaseURL: telegramURL}),
+		PollInterval:    time.Hour,
+		DeliveryTimeout: 2 * time.Second,
+	})
+}
+
+// renderAndSend validates, renders and sends one delivery the way the worker does.
+func renderAndSend(t *testing.T, p Provider, client *http.Client, settings map[string]any, d entity.NotificationDelivery) Result {
+	t.Helper()
+
+	require.NoError(t, p.ValidateSettings(settings))
+
+	req, err := p.Render(settings, d)
+	require.NoError(t, err)
+
+	return p.Send(t.Context(), client, req)
+}
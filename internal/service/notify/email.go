@@ -0,0 +1,315 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// Email TLS modes (settings.tls).
+const (
+	emailTLSStartTLS = "starttls"
+	emailTLSImplicit = "tls"
+	emailTLSNone     = "none"
+)
+
+// maxDigestMinutes caps settings.digest_minutes at one day.
+const maxDigestMinutes = 24 * 60
+
+// emailProvider sends an HTML + plaintext email per event over SMTP (settings: host, from, to; optional
+// port, tls, username, password, subject_prefix, digest_minutes).
+type emailProvider struct{}
+
+func (emailProvider) Type() string { return "email" }
+
+func (p emailProvider) ValidateSettings(settings map[string]any) error {
+	if err := requireStringSettings(p.Type(), settings, "host", "from"); err != nil {
+		return err
+	}
+
+	if err := optionalStringSettings(p.Type(), settings, "tls", "username", "password", "subject_prefix"); err != nil {
+		return err
+	}
+
+	if _, err := mail.ParseAddress(stringSetting(settings, "from")); err != nil {
+		return fmt.Errorf("email: settings.from: %w", entity.ErrInvalidNotification)
+	}
+
+	to, err := stringsSetting(settings, "to")
+	if err != nil {
+		return fmt.Errorf("email: %w: %w", err, entity.ErrInvalidNotification)
+	}
+
+	if len(to) == 0 {
+		return fmt.Errorf("email: settings.to is required: %w", entity.ErrInvalidNotification)
+	}
+
+	for _, addr := range to {
+		if _, err := mail.ParseAddress(addr); err != nil {
+			return fmt.Errorf("email: settings.to %q: %w", addr, entity.ErrInvalidNotification)
+		}
+	}
+
+	switch emailTLSMode(settings) {
+	case emailTLSStartTLS, emailTLSImplicit, emailTLSNone:
+	default:
+		return fmt.Errorf("email: settings.tls must be starttls, tls or none: %w", entity.ErrInvalidNotification)
+	}
+
+	if port, ok, err := intSetting(settings, "port"); err != nil || (ok && (port < 1 || port > 65535)) {
+		return fmt.Errorf("email: settings.port must be 1-65535: %w", entity.ErrInvalidNotification)
+	}
+
+	if stringSetting(settings, "password") != "" && stringSetting(settings, "username") == "" {
+		return fmt.Errorf("email: settings.password requires username: %w", entity.ErrInvalidNotification)
+	}
+
+	if m, ok, err := intSetting(settings, "digest_minutes"); err != nil || (ok && (m < 0 || m > maxDigestMinutes)) {
+		return fmt.Errorf("email: settings.digest_minutes must be 0-%d: %w", maxDigestMinutes, entity.ErrInvalidNotification)
+	}
+
+	return nil
+}
+
+// DigestInterval batches an entry's events into one email every settings.digest_minutes.
+func (emailProvider) DigestInterval(settings map[string]any) time.Duration {
+	m, _, _ := intSetting(settings, "digest_minutes")
+	if m <= 0 {
+		return 0
+	}
+
+	return time.Duration(m) * time.Minute
+}
+
+func emailTLSMode(settings map[string]any) string {
+	mode := strings.ToLower(stringSetting(settings, "tls"))
+	if mode == "" {
+		return emailTLSStartTLS
+	}
+
+	return mode
+}
+
+// emailServerURL encodes the SMTP endpoint as smtp:// (STARTTLS), smtps:// (implicit TLS) or
+// smtp://…?tls=none, with credentials in the userinfo.
+func emailServerURL(settings map[string]any) string {
+	mode := emailTLSMode(settings)
+
+	port, ok, _ := intSetting(settings, "port")
+	if !ok {
+		port = 587
+		if mode == emailTLSImplicit {
+			port = 465
+		}
+	}
+
+	u := url.URL{Scheme: "smtp", Host: fmt.Sprintf("%s:%d", stringSetting(settings, "host"), port)}
+	if mode == emailTLSImplicit {
+		u.Scheme = "smtps"
+	}
+
+	if mode == emailTLSNone {
+		u.RawQuery = "tls=none"
+	}
+
+	if user := stringSetting(settings, "username"); user != "" {
+		u.User = url.UserPassword(user, stringSetting(settings, "password"))
+	}
+
+	return u.String()
+}
+
+func (p emailProvider) Render(settings map[string]any, d entity.NotificationDelivery) (Request, error) {
+	ev := d.Event
+	text := eventText(ev)
+
+	html, err := renderEmailHTML(emailView{
+		Heading: eventTitle(ev),
+		Items:   []emailItem{{Title: eventTitle(ev), Text: text, Time: emailTime(d.CreatedAt)}},
+	})
+	if err != nil {
+		return Request{}, err
+	}
+
+	return p.message(settings, eventTitle(ev), "<"+emailMessageID(d)+">", text+"\n", html)
+}
+
+// RenderDigest folds several deliveries of one entry into a single email, oldest first.
+func (p emailProvider) RenderDigest(settings map[string]any, ds []entity.NotificationDelivery) (Request, error) {
+	if len(ds) == 1 {
+		return p.Render(settings, ds[0])
+	}
+
+	view := emailView{Heading: fmt.Sprintf("%d notifications", len(ds))}
+
+	var text strings.Builder
+
+	for _, d := range ds {
+		item := emailItem{Title: eventTitle(d.Event), Text: eventText(d.Event), Time: emailTime(d.CreatedAt)}
+		view.Items = append(view.Items, item)
+
+		fmt.Fprintf(&text, "%s  %s\n", item.Time, item.Text)
+	}
+
+	html, err := renderEmailHTML(view)
+	if err != nil {
+		return Request{}, err
+	}
+
+	return p.message(settings, view.Heading, "<digest."+emailMessageID(ds[0])+">", text.String(), html)
+}
+
+// message builds a multipart/alternative RFC 5322 message and its SMTP envelope.
+func (p emailProvider) message(settings map[string]any, subject, messageID, text, html string) (Request, error) {
+	from := stringSetting(settings, "from")
+	to, _ := stringsSetting(settings, "to")
+
+	prefix := "[dredge]"
+	if v, ok := settings["subject_prefix"].(string); ok {
+		prefix = strings.TrimSpace(v)
+	}
+
+	if prefix != "" {
+		subject = prefix + " " + subject
+	}
+
+	var (
+		body bytes.Buffer
+		msg  bytes.Buffer
+	)
+
+	mw := multipart.NewWriter(&body)
+
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", text},
+		{"text/html; charset=utf-8", html},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return Request{}, err
+		}
+
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return Request{}, err
+		}
+
+		if err := qp.Close(); err != nil {
+			return Request{}, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return Request{}, err
+	}
+
+	header := []struct{ k, v string }{
+		{"From", from},
+		{"To", strings.Join(to, ", ")},
+		{"Subject", mime.QEncoding.Encode("utf-8", truncateString(subject, 200))},
+		{"Date", time.Now().UTC().Format(time.RFC1123Z)},
+		{"Message-ID", messageID},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + strconv.Quote(mw.Boundary())},
+	}
+
+	for _, h := range header {
+		fmt.Fprintf(&msg, "%s: %s\r\n", h.k, h.v)
+	}
+
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+
+	fromAddr, err := mail.ParseAddress(from)
+	if err != nil {
+		return Request{}, fmt.Errorf("parse from: %w", err)
+	}
+
+	rcpt := make([]string, 0, len(to))
+
+	for _, addr := range to {
+		a, err := mail.ParseAddress(addr)
+		if err != nil {
+			return Request{}, fmt.Errorf("parse to: %w", err)
+		}
+
+		rcpt = append(rcpt, a.Address)
+	}
+
+	return Request{
+		Method: "SMTP",
+		URL:    emailServerURL(settings),
+		Body:   msg.Bytes(),
+		From:   fromAddr.Address,
+		To:     rcpt,
+	}, nil
+}
+
+func (p emailProvider) Send(ctx context.Context, _ *http.Client, req Request) Result {
+	return sendSMTP(ctx, p.Type(), req)
+}
+
+// emailMessageID is stable per delivery so a retried send does not look like a new mail to threading clients.
+func emailMessageID(d entity.NotificationDelivery) string {
+	id := d.EventID
+	if id == "" {
+		id = strconv.FormatInt(d.ID, 10)
+	}
+
+	return id + "@dredge"
+}
+
+func emailTime(t time.Time) string {
+	if t.IsZero() {
+		t = time.Now()
+	}
+
+	return t.UTC().Format("2006-01-02 15:04:05 UTC")
+}
+
+type emailItem struct {
+	Title string
+	Text  string
+	Time  string
+}
+
+type emailView struct {
+	Heading string
+	Items   []emailItem
+}
+
+var emailHTMLTemplate = template.Must(template.New("email").Parse(`<!DOCTYPE html>
+<html><body style="font-family:sans-serif">
+<h2>{{.Heading}}</h2>
+{{range .Items}}<div style="margin-bottom:12px">
+<div><strong>{{.Title}}</strong> <span style="color:#888">{{.Time}}</span></div>
+<div style="white-space:pre-wrap">{{.Text}}</div>
+</div>
+{{end}}</body></html>
+`))
+
+func renderEmailHTML(v emailView) (string, error) {
+	var buf bytes.Buffer
+
+	if err := emailHTMLTemplate.Execute(&buf, v); err != nil {
+		return "", fmt.Errorf("render email html: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,365 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+// smtpSink is a minimal local SMTP server that records accepted messages.
+type smtpSink struct {
+	ln net.Listener
+	// rcptReply, when set, replaces the 250 reply to RCPT TO.
+	rcptReply string
+
+	mu       sync.Mutex
+	auth     string
+	from     string
+	to       []string
+	messages []string
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &smtpSink{ln: ln}
+
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *smtpSink) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = fmt.Fprintf(conn, "%s\r\n", line) }
+
+	reply("220 sink ready")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		s.mu.Lock()
+		rcptReply := s.rcptReply
+		s.mu.Unlock()
+
+		switch cmd {
+		case "EHLO":
+			reply("250-sink")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			raw, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "AUTH PLAIN "))
+
+			s.mu.Lock()
+			s.auth = strings.ReplaceAll(string(raw), "\x00", "|")
+			s.mu.Unlock()
+
+			reply("235 ok")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = line
+			s.mu.Unlock()
+
+			reply("250 ok")
+		case "RCPT":
+			if rcptReply != "" {
+				reply(rcptReply)
+				continue
+			}
+
+			s.mu.Lock()
+			s.to = append(s.to, line)
+			s.mu.Unlock()
+
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+
+			var msg strings.Builder
+
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if l == ".\r\n" {
+					break
+				}
+
+				msg.WriteString(strings.TrimPrefix(l, "."))
+			}
+
+			s.mu.Lock()
+			s.messages = append(s.messages, msg.String())
+			s.mu.Unlock()
+
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func (s *smtpSink) received() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.messages...)
+}
+
+func sinkSettings(s *smtpSink) map[string]any {
+	return map[string]any{
+		"host": "127.0.0.1",
+		"port": float64(s.port()),
+		"tls":  "none",
+		"from": "Dredge <dredge@example.org>",
+		"to":   []any{"mod1@example.org", "Mod Two <mod2@example.org>"},
+	}
+}
+
+// parseEmail returns the decoded subject and the text/plain and text/html parts.
+func parseEmail(t *testing.T, raw string) (string, map[string]string) {
+	t.Helper()
+
+	msg, err := mail.ReadMessage(strings.NewReader(raw))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	parts := make(map[string]string)
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+
+		ct, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		b, err := io.ReadAll(p)
+		require.NoError(t, err)
+
+		parts[ct] = string(b)
+	}
+
+	return subject, parts
+}
+
+func TestEmailProvider_sendToSink(t *testing.T) {
+	t.Parallel()
+
+	sink := newSMTPSink(t)
+
+	settings := sinkSettings(sink)
+	settings["username"] = "bot"
+	settings["password"] = "pw"
+
+	res := renderAndSend(t, emailProvider{}, nil, settings, entity.NotificationDelivery{
+		EventID: "evt-1",
+		Event:   entity.NotificationEvent{Type: eventKeywordMatch, Channel: "chan", User: "u", Message: "<b>hi</b>"},
+	})
+	require.True(t, res.Delivered(), res.Err)
+
+	msgs := sink.received()
+	require.Len(t, msgs, 1)
+
+	sink.mu.Lock()
+	assert.Equal(t, "MAIL FROM:<dredge@example.org>", sink.from)
+	assert.Equal(t, []string{"RCPT TO:<mod1@example.org>", "RCPT TO:<mod2@example.org>"}, sink.to)
+	assert.Equal(t, "|bot|pw", sink.auth)
+	sink.mu.Unlock()
+
+	subject, parts := parseEmail(t, msgs[0])
+	assert.Equal(t, "[dredge] Chat in #chan", subject)
+	assert.Equal(t, "[chan] u: <b>hi</b>\r\n", parts["text/plain"])
+	assert.Contains(t, parts["text/html"], "[chan] u: &lt;b&gt;hi&lt;/b&gt;")
+	assert.Contains(t, msgs[0], "Message-ID: <evt-1@dredge>")
+}
+
+func TestEmailProvider_rejectedRecipientIsPermanent(t *testing.T) {
+	t.Parallel()
+
+	sink := newSMTPSink(t)
+	sink.rcptReply = "550 no such user"
+
+	res := renderAndSend(t, emailProvider{}, nil, sinkSettings(sink), entity.NotificationDelivery{
+		Event: entity.NotificationEvent{Type: eventStreamEnd, Channel: "chan"},
+	})
+	require.False(t, res.Delivered())
+	assert.True(t, res.Permanent)
+	assert.Contains(t, res.Body, "no such user")
+}
+
+func TestEmailProvider_temporaryRejectRetries(t *testing.T) {
+	t.Parallel()
+
+	sink := newSMTPSink(t)
+	sink.rcptReply = "451 try later"
+
+	res := renderAndSend(t, emailProvider{}, nil, sinkSettings(sink), entity.NotificationDelivery{
+		Event: entity.NotificationEvent{Type: eventStreamEnd, Channel: "chan"},
+	})
+	require.False(t, res.Delivered())
+	assert.False(t, res.Permanent)
+}
+
+func TestEmailProvider_starttlsRequired(t *testing.T) {
+	t.Parallel()
+
+	sink := newSMTPSink(t)
+
+	settings := sinkSettings(sink)
+	delete(settings, "tls")
+
+	res := renderAndSend(t, emailProvider{}, nil, settings, entity.NotificationDelivery{
+		Event: entity.NotificationEvent{Type: eventStreamEnd, Channel: "chan"},
+	})
+	require.False(t, res.Delivered())
+	assert.True(t, res.Permanent)
+	assert.Contains(t, res.Err.Error(), "STARTTLS")
+	assert.Empty(t, sink.received())
+}
+
+func TestEmailProvider_RenderDigest(t *testing.T) {
+	t.Parallel()
+
+	req, err := emailProvider{}.RenderDigest(map[string]any{
+		"host":           "smtp.example.org",
+		"from":           "dredge@example.org",
+		"to":             []any{"mod@example.org"},
+		"subject_prefix": "",
+	}, []entity.NotificationDelivery{
+		{ID: 1, CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Event: entity.NotificationEvent{Type: eventStreamStart, Channel: "chan"}},
+		{ID: 2, CreatedAt: time.Date(2026, 1, 2, 3, 9, 0, 0, time.UTC), Event: entity.NotificationEvent{Type: eventStreamEnd, Channel: "chan"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "smtp://smtp.example.org:587", req.URL)
+
+	subject, parts := parseEmail(t, string(req.Body))
+	assert.Equal(t, "2 notifications", subject)
+	assert.Equal(t, "2026-01-02 03:04:05 UTC  [live] #chan started streaming\r\n2026-01-02 03:09:00 UTC  [offline] #chan stopped streaming\r\n", parts["text/plain"])
+	assert.Contains(t, parts["text/html"], "#chan went offline")
+}
+
+func TestEmailProvider_ValidateSettings(t *testing.T) {
+	t.Parallel()
+
+	p := emailProvider{}
+	ok := map[string]any{"host": "smtp.example.org", "from": "a@example.org", "to": []any{"b@example.org"}, "digest_minutes": float64(30)}
+
+	require.NoError(t, p.ValidateSettings(ok))
+	assert.Equal(t, 30*time.Minute, p.DigestInterval(ok))
+
+	for name, patch := range map[string]map[string]any{
+		"no to":         {"to": []any{}},
+		"bad to":        {"to": []any{"not an address"}},
+		"bad tls":       {"tls": "ssl"},
+		"bad port":      {"port": float64(70000)},
+		"password only": {"password": "x"},
+		"long digest":   {"digest_minutes": float64(maxDigestMinutes + 1)},
+	} {
+		settings := make(map[string]any, len(ok))
+		for k, v := range ok {
+			settings[k] = v
+		}
+
+		for k, v := range patch {
+			settings[k] = v
+		}
+
+		require.ErrorIs(t, p.ValidateSettings(settings), entity.ErrInvalidNotification, name)
+	}
+}
+
+func TestDrain_digestSendsOneEmailPerEntry(t *testing.T) {
+	t.Parallel()
+
+	sink := newSMTPSink(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	settings := sinkSettings(sink)
+	settings["digest_minutes"] = float64(10)
+	entry := entity.NotificationEntry{ID: 5, Provider: "email", Enabled: true, Settings: settings}
+
+	gomock.InOrder(
+		repo.EXPECT().ClaimNotificationDeliveries(gomock.Any(), d.workers, d.lease()).Return([]entity.NotificationDeliveryJob{
+			{Entry: entry, Delivery: entity.NotificationDelivery{ID: 1, Event: entity.NotificationEvent{Type: eventStreamStart, Channel: "a"}}},
+			{Entry: entry, Delivery: entity.NotificationDelivery{ID: 2, Event: entity.NotificationEvent{Type: eventStreamStart, Channel: "b"}}},
+		}, nil),
+		repo.EXPECT().ClaimEntryNotificationDeliveries(gomock.Any(), int64(5), maxDigestDeliveries-2, d.lease()).Return([]entity.NotificationDelivery{
+			{ID: 3, Event: entity.NotificationEvent{Type: eventStreamStart, Channel: "c"}},
+		}, nil),
+		repo.EXPECT().ClaimNotificationDeliveries(gomock.Any(), d.workers, d.lease()).Return(nil, nil),
+	)
+
+	for _, id := range []int64{1, 2, 3} {
+		repo.EXPECT().CompleteNotificationDeliveryAttempt(gomock.Any(), id, gomock.Any(), entity.NotificationDeliveryDelivered, gomock.Any()).Return(nil)
+	}
+
+	d.drain(context.Background())
+
+	msgs := sink.received()
+	require.Len(t, msgs, 1)
+
+	subject, _ := parseEmail(t, msgs[0])
+	assert.Equal(t, "[dredge] 3 notifications", subject)
+}
@@ -0,0 +1,237 @@
+package notify
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// Outbox payload types (also the webhook "type" field).
+const (
+	eventKeywordMatch = "keyword_match"
+	eventRuleText     = "rule_text"
+	eventStreamStart  = "stream_start"
+	eventStreamEnd    = "stream_end"
+)
+
+// NotifyChatKeyword queues keyword-style notifications for the entries selected by route (rules engine).
+// When textTemplate is empty, providers use the default line matching [$CHANNEL] $USERNAME: $TEXT.
+func (d *Dispatcher) NotifyChatKeyword(ctx context.Context, route entity.NotificationRoute, channel, user, message, textTemplate string) {
+	_ = ctx
+
+	d.enqueue(route, entity.NotifyEventChatMessage, entity.NotificationEvent{
+		Type:    eventKeywordMatch,
+		Channel: channel,
+		User:    user,
+		Message: message,
+		Text:    strings.TrimSpace(textTemplate),
+	})
+}
+
+// NotifyRuleText queues a rules-engine notification with only channel and rendered text (e.g. interval rules).
+func (d *Dispatcher) NotifyRuleText(ctx context.Context, route entity.NotificationRoute, channel, text string) {
+	_ = ctx
+
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+
+	d.enqueue(route, entity.NotifyEventInterval, entity.NotificationEvent{
+		Type:    eventRuleText,
+		Channel: channel,
+		Text:    text,
+	})
+}
+
+// NotifyStreamStart queues stream go-live notifications (rules engine).
+func (d *Dispatcher) NotifyStreamStart(ctx context.Context, route entity.NotificationRoute, channelLogin, title, textTemplate string) {
+	_ = ctx
+
+	d.enqueue(route, entity.NotifyEventStreamStart, entity.NotificationEvent{
+		Type:    eventStreamStart,
+		Channel: channelLogin,
+		Title:   title,
+		Text:    strings.TrimSpace(textTemplate),
+	})
+}
+
+// NotifyStreamEnd queues stream offline notifications (rules engine).
+func (d *Dispatcher) NotifyStreamEnd(ctx context.Context, route entity.NotificationRoute, channelLogin, textTemplate string) {
+	_ = ctx
+
+	d.enqueue(route, entity.NotifyEventStreamEnd, entity.NotificationEvent{
+		Type:    eventStreamEnd,
+		Channel: channelLogin,
+		Text:    strings.TrimSpace(textTemplate),
+	})
+}
+
+// NotifyStreamRecap queues a finished stream's recap as a stream_end event for the entries whose default filters
+// accept stream_end in that channel (digest entries batch it like any other event).
+func (d *Dispatcher) NotifyStreamRecap(ctx context.Context, channelLogin, text string) {
+	_ = ctx
+
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+
+	d.enqueue(entity.NotificationRoute{}, entity.NotifyEventStreamEnd, entity.NotificationEvent{
+		Type:    eventStreamEnd,
+		Channel: channelLogin,
+		Text:    text,
+	})
+}
+
+// enqueue stores one outbox row per selected entry. It uses the persist context so a cancelled
+// request or IRC callback context never drops an alert that already fired.
+func (d *Dispatcher) enqueue(route entity.NotificationRoute, routeEvent string, ev entity.NotificationEvent) {
+	ctx := d.persistContext()
+
+	entries, err := d.repo.ListEnabledNotificationEntries(ctx)
+	if err != nil {
+		d.obs.Logger.Warn("list notification entries failed", zap.Error(err))
+		return
+	}
+
+	selected := make([]entity.NotificationEntry, 0, len(entries))
+
+	for _, e := range entries {
+		if notificationEntrySelected(e, route, routeEvent, ev.Channel) {
+			selected = append(selected, e)
+		}
+	}
+
+	if len(selected) == 0 {
+		return
+	}
+
+	now := time.Now()
+
+	snoozes, err := d.repo.ListActiveNotificationSnoozes(ctx, now)
+	if err != nil {
+		// Deliver rather than drop: a muted alert arriving is better than a missed one.
+		d.obs.Logger.Warn("list notification snoozes failed", zap.Error(err))
+	}
+
+	var (
+		immediate  []int64
+		digests    = make(map[time.Time][]int64)
+		suppressed = make(map[string][]int64)
+	)
+
+	for _, e := range selected {
+		if reason := d.suppression(e, snoozes, ev, now); reason != "" {
+			d.obs.Logger.Debug("notification suppressed", zap.Int64("notification_id", e.ID), zap.String("channel", ev.Channel), zap.String("reason", reason))
+			suppressed[reason] = append(suppressed[reason], e.ID)
+
+			continue
+		}
+
+		if at := d.digestDue(e, now); !at.IsZero() {
+			digests[at] = append(digests[at], e.ID)
+		} else {
+			immediate = append(immediate, e.ID)
+		}
+	}
+
+	if len(immediate) > 0 {
+		if err := d.repo.EnqueueNotificationDeliveries(ctx, immediate, ev, time.Time{}); err != nil {
+			d.obs.Logger.Warn("enqueue notification deliveries failed", zap.Error(err), zap.String("type", ev.Type))
+		} else {
+			d.signal()
+		}
+	}
+
+	for at, ids := range digests {
+		if err := d.repo.EnqueueNotificationDeliveries(ctx, ids, ev, at); err != nil {
+			d.obs.Logger.Warn("enqueue digest notification deliveries failed", zap.Error(err), zap.String("type", ev.Type))
+		}
+	}
+
+	for reason, ids := range suppressed {
+		if err := d.repo.RecordSuppressedNotificationDeliveries(ctx, ids, ev, reason); err != nil {
+			d.obs.Logger.Warn("record suppressed notification deliveries failed", zap.Error(err), zap.String("reason", reason))
+		}
+	}
+}
+
+// suppression returns why an entry must not receive ev now (snooze, quiet hours, flood control), or "".
+// Flood control is checked last so only events that would otherwise be sent count against the window.
+func (d *Dispatcher) suppression(e entity.NotificationEntry, snoozes []entity.NotificationSnooze, ev entity.NotificationEvent, now time.Time) string {
+	switch {
+	case snoozed(snoozes, e.ID, ev.Channel):
+		return entity.NotificationSuppressedSnoozed
+	case e.QuietHours != nil && e.QuietHours.Active(now):
+		return entity.NotificationSuppressedQuietHours
+	case !d.floodAllow(e, ev, now):
+		return entity.NotificationSuppressedFlood
+	default:
+		return ""
+	}
+}
+
+func snoozed(snoozes []entity.NotificationSnooze, entryID int64, channel string) bool {
+	channel = normalizeChannel(channel)
+
+	for _, s := range snoozes {
+		if s.Matches(entryID, channel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// digestDue returns when a digest entry's current batching window closes (windows are aligned to the
+// interval, so every event in the window shares the time); zero for entries that send immediately.
+func (d *Dispatcher) digestDue(e entity.NotificationEntry, now time.Time) time.Time {
+	p, ok := d.providers.Get(e.Provider)
+	if !ok {
+		return time.Time{}
+	}
+
+	dp, ok := p.(DigestProvider)
+	if !ok {
+		return time.Time{}
+	}
+
+	interval := dp.DigestInterval(e.Settings)
+	if interval <= 0 {
+		return time.Time{}
+	}
+
+	return now.Truncate(interval).Add(interval)
+}
+
+// notificationEntrySelected applies explicit route targets (ids or tags) when present;
+// otherwise the entry's default event/channel filters decide.
+func notificationEntrySelected(e entity.NotificationEntry, route entity.NotificationRoute, event, channel string) bool {
+	if !route.IsZero() {
+		if slices.Contains(route.EntryIDs, e.ID) {
+			return true
+		}
+
+		for _, t := range route.Tags {
+			if slices.Contains(e.Tags, strings.ToLower(strings.TrimSpace(t))) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(e.EventTypes) > 0 && !slices.Contains(e.EventTypes, event) {
+		return false
+	}
+
+	if len(e.Channels) > 0 && !slices.Contains(e.Channels, normalizeChannel(channel)) {
+		return false
+	}
+
+	return true
+}
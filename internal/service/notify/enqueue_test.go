@@ -0,0 +1,200 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestNotifyChatKeyword_noEntries(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).Return(nil, nil)
+
+	d.NotifyChatKeyword(context.Background(), entity.NotificationRoute{}, "ch", "u", "msg", "")
+}
+
+func TestNotifyChatKeyword_enqueuesSelectedEntries(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).Return([]entity.NotificationEntry{
+		{ID: 1, Provider: "webhook"},
+		{ID: 2, Provider: "telegram", NotificationRouting: entity.NotificationRouting{EventTypes: []string{entity.NotifyEventStreamStart}}},
+	}, nil)
+	repo.EXPECT().ListActiveNotificationSnoozes(gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().EnqueueNotificationDeliveries(gomock.Any(), []int64{1}, entity.NotificationEvent{
+		Type:    eventKeywordMatch,
+		Channel: "ch",
+		User:    "u",
+		Message: "msg",
+		Text:    "hello",
+	}, time.Time{}).Return(nil)
+
+	d.NotifyChatKeyword(context.Background(), entity.NotificationRoute{}, "ch", "u", "msg", " hello ")
+
+	select {
+	case <-d.wake:
+	default:
+		t.Fatal("expected enqueue to wake the delivery loop")
+	}
+}
+
+func TestNotifyStreamEnd_digestEntryScheduledAtWindowEnd(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).Return([]entity.NotificationEntry{
+		{ID: 4, Provider: "email", Settings: map[string]any{"digest_minutes": float64(15)}},
+	}, nil)
+	repo.EXPECT().ListActiveNotificationSnoozes(gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().EnqueueNotificationDeliveries(gomock.Any(), []int64{4}, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []int64, _ entity.NotificationEvent, at time.Time) error {
+			assert.True(t, at.After(time.Now()))
+			assert.LessOrEqual(t, time.Until(at), 15*time.Minute)
+			assert.Equal(t, at, at.Truncate(15*time.Minute))
+
+			return nil
+		})
+
+	d.NotifyStreamEnd(context.Background(), entity.NotificationRoute{}, "ch", "")
+
+	select {
+	case <-d.wake:
+		t.Fatal("digest rows must wait for their window")
+	default:
+	}
+}
+
+func TestNotifyStreamStart_snoozedEntryRecordedAsSuppressed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	muted := int64(1)
+
+	repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).Return([]entity.NotificationEntry{
+		{ID: 1, Provider: "webhook"},
+		{ID: 2, Provider: "webhook"},
+	}, nil).Times(2)
+	repo.EXPECT().ListActiveNotificationSnoozes(gomock.Any(), gomock.Any()).Return([]entity.NotificationSnooze{
+		{NotificationEntryID: &muted, Channel: "chana"},
+		{Channel: "chanb"},
+	}, nil).Times(2)
+	repo.EXPECT().EnqueueNotificationDeliveries(gomock.Any(), []int64{2}, gomock.Any(), time.Time{}).Return(nil)
+	repo.EXPECT().RecordSuppressedNotificationDeliveries(gomock.Any(), []int64{1}, gomock.Any(), entity.NotificationSuppressedSnoozed).Return(nil)
+	repo.EXPECT().RecordSuppressedNotificationDeliveries(gomock.Any(), []int64{1, 2}, gomock.Any(), entity.NotificationSuppressedSnoozed).Return(nil)
+
+	d.NotifyStreamStart(context.Background(), entity.NotificationRoute{}, "#ChanA", "", "")
+	d.NotifyStreamStart(context.Background(), entity.NotificationRoute{}, "chanb", "", "")
+}
+
+func TestNotifyRuleText_quietHoursSuppressed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	// A window covering the whole day except one minute that is not now.
+	now := time.Now().UTC()
+	start := now.Add(2 * time.Minute).Format("15:04")
+	end := now.Add(time.Minute).Format("15:04")
+
+	repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).Return([]entity.NotificationEntry{
+		{ID: 1, Provider: "webhook", NotificationPolicy: entity.NotificationPolicy{
+			QuietHours: &entity.NotificationQuietHours{Start: start, End: end, Timezone: "UTC"},
+		}},
+	}, nil)
+	repo.EXPECT().ListActiveNotificationSnoozes(gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().RecordSuppressedNotificationDeliveries(gomock.Any(), []int64{1}, entity.NotificationEvent{
+		Type: eventRuleText, Channel: "ch", Text: "hi",
+	}, entity.NotificationSuppressedQuietHours).Return(nil)
+
+	d.NotifyRuleText(context.Background(), entity.NotificationRoute{}, "ch", "hi")
+}
+
+func TestNotifyRuleText_emptySkipped(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d := testDispatcher(t, repomocks.NewMockStore(ctrl), nil, "")
+
+	d.NotifyRuleText(context.Background(), entity.NotificationRoute{}, "ch", "  ")
+}
+
+func TestNotifyStreamRecap_followsStreamEndFilters(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).Return([]entity.NotificationEntry{
+		{ID: 1, Provider: "webhook", NotificationRouting: entity.NotificationRouting{EventTypes: []string{entity.NotifyEventStreamEnd}}},
+		{ID: 2, Provider: "webhook", NotificationRouting: entity.NotificationRouting{EventTypes: []string{entity.NotifyEventChatMessage}}},
+		{ID: 3, Provider: "webhook", NotificationRouting: entity.NotificationRouting{Channels: []string{"other"}}},
+	}, nil)
+	repo.EXPECT().ListActiveNotificationSnoozes(gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().EnqueueNotificationDeliveries(gomock.Any(), []int64{1}, entity.NotificationEvent{
+		Type:    eventStreamEnd,
+		Channel: "ch",
+		Text:    "[recap] #ch streamed 1h00m",
+	}, time.Time{}).Return(nil)
+
+	d.NotifyStreamRecap(context.Background(), "ch", "[recap] #ch streamed 1h00m")
+}
+
+func TestNotificationEntrySelected(t *testing.T) {
+	t.Parallel()
+
+	mods := entity.NotificationEntry{ID: 1, NotificationRouting: entity.NotificationRouting{Tags: []string{"mods"}, Channels: []string{"chana"}}}
+	live := entity.NotificationEntry{ID: 2, NotificationRouting: entity.NotificationRouting{EventTypes: []string{entity.NotifyEventStreamStart}}}
+	all := entity.NotificationEntry{ID: 3}
+
+	assert.True(t, notificationEntrySelected(mods, entity.NotificationRoute{}, entity.NotifyEventChatMessage, "#ChanA"))
+	assert.False(t, notificationEntrySelected(mods, entity.NotificationRoute{}, entity.NotifyEventChatMessage, "chanb"))
+	assert.True(t, notificationEntrySelected(live, entity.NotificationRoute{}, entity.NotifyEventStreamStart, "chanb"))
+	assert.False(t, notificationEntrySelected(live, entity.NotificationRoute{}, entity.NotifyEventChatMessage, "chanb"))
+	assert.True(t, notificationEntrySelected(all, entity.NotificationRoute{}, entity.NotifyEventStreamEnd, "chanb"))
+
+	byTag := entity.NotificationRoute{Tags: []string{"Mods"}}
+	assert.True(t, notificationEntrySelected(mods, byTag, entity.NotifyEventChatMessage, "chanb"))
+	assert.False(t, notificationEntrySelected(all, byTag, entity.NotifyEventChatMessage, "chana"))
+
+	byID := entity.NotificationRoute{EntryIDs: []int64{2}}
+	assert.True(t, notificationEntrySelected(live, byID, entity.NotifyEventChatMessage, "chana"))
+	assert.False(t, notificationEntrySelected(mods, byID, entity.NotifyEventChatMessage, "chana"))
+}
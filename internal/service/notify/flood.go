@@ -0,0 +1,116 @@
+package notify
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// eventFloodSummary is the outbox payload type of "N more matches in #channel" messages.
+const eventFloodSummary = "flood_summary"
+
+type floodKey struct {
+	entryID int64
+	channel string
+}
+
+// floodWindow counts one entry's events for one channel. The first FloodThreshold events are sent,
+// the rest are suppressed and reported in one summary once the window ends.
+type floodWindow struct {
+	entry      entity.NotificationEntry
+	channel    string
+	ends       time.Time
+	sent       int
+	suppressed int
+	// matchesOnly is true while every suppressed event was a keyword match.
+	matchesOnly bool
+}
+
+// floodAllow counts an event against its entry's flood window and reports whether it may be sent.
+func (d *Dispatcher) floodAllow(e entity.NotificationEntry, ev entity.NotificationEvent, now time.Time) bool {
+	if e.FloodThreshold <= 0 || e.FloodWindow <= 0 {
+		return true
+	}
+
+	key := floodKey{entryID: e.ID, channel: normalizeChannel(ev.Channel)}
+
+	d.floodMu.Lock()
+	defer d.floodMu.Unlock()
+
+	w := d.floods[key]
+	if w == nil || !now.Before(w.ends) {
+		if w != nil && w.suppressed > 0 {
+			// The loop has not flushed the finished window yet; keep its summary.
+			d.floodDone = append(d.floodDone, w)
+		}
+
+		w = &floodWindow{entry: e, channel: key.channel, ends: now.Add(e.FloodWindow), matchesOnly: true}
+		d.floods[key] = w
+	}
+
+	if w.sent < e.FloodThreshold {
+		w.sent++
+		return true
+	}
+
+	w.suppressed++
+	w.matchesOnly = w.matchesOnly && ev.Type == eventKeywordMatch
+
+	return false
+}
+
+// flushFloods queues one summary per finished window that suppressed events; all windows when force
+// is set (shutdown).
+func (d *Dispatcher) flushFloods(now time.Time, force bool) {
+	d.floodMu.Lock()
+
+	done := d.floodDone
+	d.floodDone = nil
+
+	for key, w := range d.floods {
+		if !force && now.Before(w.ends) {
+			continue
+		}
+
+		delete(d.floods, key)
+
+		if w.suppressed > 0 {
+			done = append(done, w)
+		}
+	}
+
+	d.floodMu.Unlock()
+
+	if len(done) == 0 {
+		return
+	}
+
+	ctx := d.persistContext()
+
+	for _, w := range done {
+		ev := floodSummaryEvent(w)
+
+		if err := d.repo.EnqueueNotificationDeliveries(ctx, []int64{w.entry.ID}, ev, d.digestDue(w.entry, now)); err != nil {
+			d.obs.Logger.Warn("enqueue flood summary failed", zap.Error(err), zap.Int64("notification_id", w.entry.ID))
+		}
+	}
+
+	d.signal()
+}
+
+func floodSummaryEvent(w *floodWindow) entity.NotificationEvent {
+	noun := "notifications"
+	if w.matchesOnly {
+		noun = "matches"
+	}
+
+	text := fmt.Sprintf("%d more %s", w.suppressed, noun)
+	if w.channel != "" {
+		text += " in #" + w.channel
+	}
+
+	return entity.NotificationEvent{Type: eventFloodSummary, Channel: w.channel, Text: text}
+}
@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestNotifyChatKeyword_floodCollapsedIntoSummary(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	entry := entity.NotificationEntry{ID: 7, Provider: "webhook", NotificationPolicy: entity.NotificationPolicy{
+		FloodThreshold: 2,
+		FloodWindow:    time.Minute,
+	}}
+
+	repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).Return([]entity.NotificationEntry{entry}, nil).Times(5)
+	repo.EXPECT().ListActiveNotificationSnoozes(gomock.Any(), gomock.Any()).Return(nil, nil).Times(5)
+	repo.EXPECT().EnqueueNotificationDeliveries(gomock.Any(), []int64{7}, gomock.Any(), time.Time{}).Return(nil).Times(2)
+	repo.EXPECT().RecordSuppressedNotificationDeliveries(gomock.Any(), []int64{7}, gomock.Any(), entity.NotificationSuppressedFlood).Return(nil).Times(3)
+
+	for range 5 {
+		d.NotifyChatKeyword(context.Background(), entity.NotificationRoute{}, "#Raided", "u", "msg", "")
+	}
+
+	// The window is still open: no summary yet.
+	d.flushFloods(time.Now(), false)
+
+	repo.EXPECT().EnqueueNotificationDeliveries(gomock.Any(), []int64{7}, entity.NotificationEvent{
+		Type:    eventFloodSummary,
+		Channel: "raided",
+		Text:    "3 more matches in #raided",
+	}, time.Time{}).Return(nil)
+
+	d.flushFloods(time.Now().Add(time.Minute), false)
+
+	// Flushed windows are gone; a second flush sends nothing.
+	d.flushFloods(time.Now().Add(time.Hour), true)
+}
+
+func TestFloodAllow_perChannelWindows(t *testing.T) {
+	t.Parallel()
+
+	d := testDispatcher(t, nil, nil, "")
+	e := entity.NotificationEntry{ID: 1, NotificationPolicy: entity.NotificationPolicy{FloodThreshold: 1, FloodWindow: time.Minute}}
+	now := time.Now()
+
+	assert.True(t, d.floodAllow(e, entity.NotificationEvent{Channel: "a"}, now))
+	assert.False(t, d.floodAllow(e, entity.NotificationEvent{Channel: "a"}, now))
+	assert.True(t, d.floodAllow(e, entity.NotificationEvent{Channel: "b"}, now))
+	assert.True(t, d.floodAllow(e, entity.NotificationEvent{Channel: "a"}, now.Add(time.Minute)), "a new window starts")
+	assert.True(t, d.floodAllow(entity.NotificationEntry{ID: 2}, entity.NotificationEvent{Channel: "a"}, now), "flood control off")
+
+	// The summary of the replaced window is kept for the next flush.
+	assert.Len(t, d.floodDone, 1)
+	assert.Equal(t, "1 more notifications in #a", floodSummaryEvent(d.floodDone[0]).Text)
+}
@@ -0,0 +1,34 @@
+package notify
+
+import (
+	"strings"
+)
+
+const responseSnippetMaxBytes = 512
+
+// normalizeChannel returns a canonical lowercase channel name without #.
+func normalizeChannel(ch string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(ch)), "#")
+}
+
+func truncateString(s string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+
+	return string(r[:max]) + "..."
+}
+
+// snippet trims a provider response body for the delivery log.
+func snippet(b []byte) string {
+	if len(b) > responseSnippetMaxBytes {
+		b = b[:responseSnippetMaxBytes]
+	}
+
+	return strings.ToValidUTF8(strings.TrimSpace(string(b)), "")
+}
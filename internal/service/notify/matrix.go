@@ -0,0 +1,81 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// matrixProvider sends m.text messages through the client-server API (settings: access_token, room_id;
+// optional homeserver_url overriding the configured default).
+type matrixProvider struct {
+	homeserverURL string
+}
+
+func newMatrixProvider(homeserverURL string) matrixProvider {
+	return matrixProvider{homeserverURL: strings.TrimRight(strings.TrimSpace(homeserverURL), "/")}
+}
+
+func (matrixProvider) Type() string { return "matrix" }
+
+func (p matrixProvider) ValidateSettings(settings map[string]any) error {
+	if err := requireStringSettings(p.Type(), settings, "access_token", "room_id"); err != nil {
+		return err
+	}
+
+	return validateHTTPURL(p.Type(), settings, "homeserver_url", p.homeserverURL == "")
+}
+
+func (p matrixProvider) Render(settings map[string]any, d entity.NotificationDelivery) (Request, error) {
+	hs := p.homeserverURL
+	if v := stringSetting(settings, "homeserver_url"); v != "" {
+		hs = strings.TrimRight(v, "/")
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"msgtype": "m.text",
+		"body":    eventText(d.Event),
+	})
+	if err != nil {
+		return Request{}, err
+	}
+
+	// The transaction id is the delivery's event id, so the homeserver deduplicates our retries.
+	txn := d.EventID
+	if txn == "" {
+		txn = fmt.Sprintf("delivery-%d", d.ID)
+	}
+
+	u := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		hs, url.PathEscape(stringSetting(settings, "room_id")), url.PathEscape(txn))
+
+	return Request{
+		Method: http.MethodPut,
+		URL:    u,
+		Header: http.Header{
+			"Content-Type":  {"application/json"},
+			"Authorization": {"Bearer " + stringSetting(settings, "access_token")},
+		},
+		Body: body,
+	}, nil
+}
+
+func (p matrixProvider) Send(ctx context.Context, client *http.Client, req Request) Result {
+	return sendHTTP(ctx, client, p.Type(), req, func(body []byte) time.Duration {
+		var rb struct {
+			RetryAfterMs int64 `json:"retry_after_ms"`
+		}
+
+		if json.Unmarshal(body, &rb) != nil || rb.RetryAfterMs <= 0 {
+			return 0
+		}
+
+		return time.Duration(rb.RetryAfterMs) * time.Millisecond
+	})
+}
@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestMatrixProvider_send(t *testing.T) {
+	t.Parallel()
+
+	var (
+		method, path, auth string
+		payload            map[string]string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.EscapedPath()
+		auth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		_, _ = io.WriteString(w, `{"event_id":"$abc"}`)
+	}))
+	defer srv.Close()
+
+	p := newMatrixProvider(srv.URL)
+
+	res := renderAndSend(t, p, srv.Client(), map[string]any{
+		"access_token": "tok",
+		"room_id":      "!room:example.org",
+	}, entity.NotificationDelivery{
+		EventID: "evt-9",
+		Event:   entity.NotificationEvent{Type: eventStreamEnd, Channel: "chan"},
+	})
+	require.True(t, res.Delivered(), res.Err)
+
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/_matrix/client/v3/rooms/%21room:example.org/send/m.room.message/evt-9", path)
+	assert.Equal(t, "Bearer tok", auth)
+	assert.Equal(t, map[string]string{"msgtype": "m.text", "body": "[offline] #chan stopped streaming"}, payload)
+}
+
+func TestMatrixProvider_rateLimited(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = io.WriteString(w, `{"errcode":"M_LIMIT_EXCEEDED","retry_after_ms":2500}`)
+	}))
+	defer srv.Close()
+
+	res := renderAndSend(t, newMatrixProvider(""), srv.Client(), map[string]any{
+		"homeserver_url": srv.URL,
+		"access_token":   "tok",
+		"room_id":        "!r:x",
+	}, entity.NotificationDelivery{ID: 3, Event: entity.NotificationEvent{Type: eventRuleText, Channel: "c", Text: "t"}})
+
+	assert.Equal(t, 2500*time.Millisecond, res.RetryAfter)
+	assert.False(t, res.Permanent)
+}
+
+func TestMatrixProvider_ValidateSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := map[string]any{"access_token": "tok", "room_id": "!r:x"}
+
+	require.ErrorIs(t, newMatrixProvider("").ValidateSettings(settings), entity.ErrInvalidNotification)
+	require.NoError(t, newMatrixProvider("https://matrix.example").ValidateSettings(settings))
+	require.ErrorIs(t, newMatrixProvider("https://matrix.example").ValidateSettings(map[string]any{"room_id": "!r:x"}), entity.ErrInvalidNotification)
+}
@@ -0,0 +1,92 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const defaultNtfyBaseURL = "https://ntfy.sh"
+
+// ntfyProvider publishes JSON messages to an ntfy topic (settings: topic; optional server_url, token,
+// priority 1-5, tags).
+type ntfyProvider struct {
+	baseURL string
+}
+
+func newNtfyProvider(baseURL string) ntfyProvider {
+	baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	if baseURL == "" {
+		baseURL = defaultNtfyBaseURL
+	}
+
+	return ntfyProvider{baseURL: baseURL}
+}
+
+func (ntfyProvider) Type() string { return "ntfy" }
+
+func (p ntfyProvider) ValidateSettings(settings map[string]any) error {
+	if err := requireStringSettings(p.Type(), settings, "topic"); err != nil {
+		return err
+	}
+
+	if err := validateHTTPURL(p.Type(), settings, "server_url", false); err != nil {
+		return err
+	}
+
+	if err := optionalStringSettings(p.Type(), settings, "token"); err != nil {
+		return err
+	}
+
+	if pr, ok, err := intSetting(settings, "priority"); err != nil || (ok && (pr < 1 || pr > 5)) {
+		return fmt.Errorf("ntfy: settings.priority must be 1-5: %w", entity.ErrInvalidNotification)
+	}
+
+	if _, err := stringsSetting(settings, "tags"); err != nil {
+		return fmt.Errorf("ntfy: settings.tags must be a list of strings: %w", entity.ErrInvalidNotification)
+	}
+
+	return nil
+}
+
+func (p ntfyProvider) Render(settings map[string]any, d entity.NotificationDelivery) (Request, error) {
+	server := p.baseURL
+	if v := stringSetting(settings, "server_url"); v != "" {
+		server = strings.TrimRight(v, "/")
+	}
+
+	msg := map[string]any{
+		"topic":   stringSetting(settings, "topic"),
+		"title":   eventTitle(d.Event),
+		"message": eventText(d.Event),
+	}
+
+	if pr, ok, _ := intSetting(settings, "priority"); ok {
+		msg["priority"] = pr
+	}
+
+	if tags, _ := stringsSetting(settings, "tags"); len(tags) > 0 {
+		msg["tags"] = tags
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	header := http.Header{"Content-Type": {"application/json"}}
+	if tok := stringSetting(settings, "token"); tok != "" {
+		header.Set("Authorization", "Bearer "+tok)
+	}
+
+	// JSON publishing goes to the server root; the topic is in the body.
+	return Request{Method: http.MethodPost, URL: server, Header: header, Body: body}, nil
+}
+
+func (p ntfyProvider) Send(ctx context.Context, client *http.Client, req Request) Result {
+	return sendHTTP(ctx, client, p.Type(), req, nil)
+}
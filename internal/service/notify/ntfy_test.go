@@ -0,0 +1,57 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestNtfyProvider_publish(t *testing.T) {
+	t.Parallel()
+
+	var (
+		auth    string
+		payload map[string]any
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	res := renderAndSend(t, newNtfyProvider(srv.URL), srv.Client(), map[string]any{
+		"topic":    "alerts",
+		"token":    "tk_1",
+		"priority": float64(4),
+		"tags":     []any{"warning"},
+	}, entity.NotificationDelivery{
+		Event: entity.NotificationEvent{Type: eventKeywordMatch, Channel: "chan", User: "u", Message: "hello"},
+	})
+	require.True(t, res.Delivered(), res.Err)
+
+	assert.Equal(t, "Bearer tk_1", auth)
+	assert.Equal(t, "alerts", payload["topic"])
+	assert.Equal(t, "Chat in #chan", payload["title"])
+	assert.Equal(t, "[chan] u: hello", payload["message"])
+	assert.InDelta(t, 4, payload["priority"], 0)
+	assert.Equal(t, []any{"warning"}, payload["tags"])
+}
+
+func TestNtfyProvider_ValidateSettings(t *testing.T) {
+	t.Parallel()
+
+	p := newNtfyProvider("")
+
+	require.NoError(t, p.ValidateSettings(map[string]any{"topic": "a"}))
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"topic": "a", "priority": float64(9)}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"topic": "a", "tags": []any{1}}), entity.ErrInvalidNotification)
+}
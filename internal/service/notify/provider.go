@@ -0,0 +1,303 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// Provider delivers outbox rows for one notification_entries.provider type.
+type Provider interface {
+	// Type is the provider name stored on notification entries (e.g. telegram).
+	Type() string
+	// ValidateSettings rejects entry settings the provider cannot send with; errors wrap entity.ErrInvalidNotification.
+	ValidateSettings(settings map[string]any) error
+	// Render builds the outbound HTTP request for one delivery. Settings have already passed ValidateSettings.
+	Render(settings map[string]any, d entity.NotificationDelivery) (Request, error)
+	// Send performs a rendered request and classifies the response.
+	Send(ctx context.Context, client *http.Client, req Request) Result
+}
+
+// DigestProvider is implemented by providers that can fold several deliveries of one entry into a
+// single message (email digest mode).
+type DigestProvider interface {
+	Provider
+	// DigestInterval is the batching window configured in settings; zero sends every event on its own.
+	DigestInterval(settings map[string]any) time.Duration
+	// RenderDigest builds one request for deliveries of the same entry, oldest first.
+	RenderDigest(settings map[string]any, ds []entity.NotificationDelivery) (Request, error)
+}
+
+// Request is a rendered provider call.
+type Request struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+	// From and To are the SMTP envelope of email requests; HTTP providers leave them empty.
+	From string
+	To   []string
+}
+
+// ProvidersConfig sets provider API base URLs; empty values use the public services.
+type ProvidersConfig struct {
+	TelegramAPIBaseURL string
+	// NtfyBaseURL is the ntfy server for entries without settings.server_url.
+	NtfyBaseURL string
+	// MatrixHomeserverURL is the homeserver for entries without settings.homeserver_url.
+	MatrixHomeserverURL string
+}
+
+// Registry maps provider type names to implementations.
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry registers the built-in providers (telegram, webhook, discord, matrix, ntfy, email).
+func NewRegistry(cfg ProvidersConfig) *Registry {
+	r := &Registry{providers: make(map[string]Provider)}
+
+	r.Register(newTelegramProvider(cfg.TelegramAPIBaseURL))
+	r.Register(webhookProvider{})
+	r.Register(discordProvider{})
+	r.Register(newMatrixProvider(cfg.MatrixHomeserverURL))
+	r.Register(newNtfyProvider(cfg.NtfyBaseURL))
+	r.Register(emailProvider{})
+
+	return r
+}
+
+// Register adds or replaces the provider for p.Type().
+func (r *Registry) Register(p Provider) {
+	r.providers[p.Type()] = p
+}
+
+// Get returns the provider registered for typ.
+func (r *Registry) Get(typ string) (Provider, bool) {
+	p, ok := r.providers[typ]
+	return p, ok
+}
+
+// Types returns registered provider names in sorted order.
+func (r *Registry) Types() []string {
+	out := make([]string, 0, len(r.providers))
+
+	for t := range r.providers {
+		out = append(out, t)
+	}
+
+	slices.Sort(out)
+
+	return out
+}
+
+// ValidateSettings checks settings against the provider registered for typ.
+func (r *Registry) ValidateSettings(typ string, settings map[string]any) error {
+	p, ok := r.Get(typ)
+	if !ok {
+		return fmt.Errorf("unknown notification provider %q: %w", typ, entity.ErrInvalidNotification)
+	}
+
+	return p.ValidateSettings(settings)
+}
+
+// sendHTTP performs req and classifies the response. retryAfterFromBody, when set, reads a provider-specific
+// rate-limit hint from a 429 body before falling back to the Retry-After header.
+func sendHTTP(ctx context.Context, client *http.Client, name string, req Request, retryAfterFromBody func(body []byte) time.Duration) Result {
+	hreq, err := http.NewRequestWithContext(ctx, req.Method, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return Result{Err: fmt.Errorf("%s: build request: %w", name, unwrapURLError(err)), Permanent: true}
+	}
+
+	for k, vs := range req.Header {
+		for _, v := range vs {
+			hreq.Header.Add(k, v)
+		}
+	}
+
+	resp, err := client.Do(hreq)
+	if err != nil {
+		return Result{Err: fmt.Errorf("%s: request failed: %w", name, unwrapURLError(err))}
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+	res := classifyHTTP(Result{StatusCode: resp.StatusCode, Body: snippet(b)})
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfterFromBody != nil {
+			res.RetryAfter = retryAfterFromBody(b)
+		}
+
+		if res.RetryAfter <= 0 {
+			res.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		}
+	}
+
+	return res
+}
+
+// unwrapURLError drops the request URL from transport errors so secrets in it never reach the delivery log.
+func unwrapURLError(err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		return ue.Err
+	}
+
+	return err
+}
+
+func stringSetting(settings map[string]any, key string) string {
+	v, _ := settings[key].(string)
+	return strings.TrimSpace(v)
+}
+
+// requireStringSettings checks that every key is a non-empty string.
+func requireStringSettings(provider string, settings map[string]any, keys ...string) error {
+	for _, k := range keys {
+		if stringSetting(settings, k) == "" {
+			return fmt.Errorf("%s: settings.%s is required: %w", provider, k, entity.ErrInvalidNotification)
+		}
+	}
+
+	return nil
+}
+
+// optionalStringSettings checks that present keys hold strings.
+func optionalStringSettings(provider string, settings map[string]any, keys ...string) error {
+	for _, k := range keys {
+		v, ok := settings[k]
+		if !ok || v == nil {
+			continue
+		}
+
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("%s: settings.%s must be a string: %w", provider, k, entity.ErrInvalidNotification)
+		}
+	}
+
+	return nil
+}
+
+// validateHTTPURL requires an absolute http(s) URL in settings[key] when present (or always when required).
+func validateHTTPURL(provider string, settings map[string]any, key string, required bool) error {
+	raw := stringSetting(settings, key)
+	if raw == "" {
+		if required {
+			return fmt.Errorf("%s: settings.%s is required: %w", provider, key, entity.ErrInvalidNotification)
+		}
+
+		return nil
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s: settings.%s must be an http(s) URL: %w", provider, key, entity.ErrInvalidNotification)
+	}
+
+	return nil
+}
+
+// intSetting reads an integer setting (JSON numbers decode as float64). ok is false when the key is absent.
+func intSetting(settings map[string]any, key string) (int, bool, error) {
+	v, present := settings[key]
+	if !present || v == nil {
+		return 0, false, nil
+	}
+
+	switch n := v.(type) {
+	case float64:
+		if n != float64(int(n)) {
+			return 0, false, fmt.Errorf("settings.%s must be an integer", key)
+		}
+
+		return int(n), true, nil
+	case int:
+		return n, true, nil
+	case int64:
+		return int(n), true, nil
+	default:
+		return 0, false, fmt.Errorf("settings.%s must be an integer", key)
+	}
+}
+
+// stringsSetting reads a list-of-strings setting ([]any from JSON or []string).
+func stringsSetting(settings map[string]any, key string) ([]string, error) {
+	v, present := settings[key]
+	if !present || v == nil {
+		return nil, nil
+	}
+
+	switch list := v.(type) {
+	case []string:
+		return list, nil
+	case []any:
+		out := make([]string, 0, len(list))
+
+		for _, item := range list {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("settings.%s must be a list of strings", key)
+			}
+
+			out = append(out, s)
+		}
+
+		return out, nil
+	default:
+		return nil, fmt.Errorf("settings.%s must be a list of strings", key)
+	}
+}
+
+// int64sSetting reads a list of integer ids (JSON numbers or numeric strings).
+func int64sSetting(settings map[string]any, key string) ([]int64, error) {
+	v, present := settings[key]
+	if !present || v == nil {
+		return nil, nil
+	}
+
+	list, ok := v.([]any)
+	if !ok {
+		if ids, ok := v.([]int64); ok {
+			return ids, nil
+		}
+
+		return nil, fmt.Errorf("settings.%s must be a list of integers", key)
+	}
+
+	out := make([]int64, 0, len(list))
+
+	for _, item := range list {
+		switch n := item.(type) {
+		case float64:
+			if n != float64(int64(n)) {
+				return nil, fmt.Errorf("settings.%s must be a list of integers", key)
+			}
+
+			out = append(out, int64(n))
+		case string:
+			id, err := strconv.ParseInt(strings.TrimSpace(n), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("settings.%s must be a list of integers", key)
+			}
+
+			out = append(out, id)
+		default:
+			return nil, fmt.Errorf("settings.%s must be a list of integers", key)
+		}
+	}
+
+	return out, nil
+}
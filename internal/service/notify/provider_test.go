@@ -0,0 +1,22 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	r := NewRegistry(ProvidersConfig{})
+
+	assert.Equal(t, []string{"discord", "email", "matrix", "ntfy", "telegram", "webhook"}, r.Types())
+
+	require.NoError(t, r.ValidateSettings("telegram", map[string]any{"bot_token": "t", "chat_id": "1"}))
+	require.ErrorIs(t, r.ValidateSettings("telegram", map[string]any{"bot_token": "t"}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, r.ValidateSettings("pager", map[string]any{}), entity.ErrInvalidNotification)
+}
@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// eventText renders the human-readable line for an event; Text wins, otherwise the default for the event type.
+func eventText(ev entity.NotificationEvent) string {
+	if strings.TrimSpace(ev.Text) != "" {
+		return ev.Text
+	}
+
+	switch ev.Type {
+	case eventStreamStart:
+		text := fmt.Sprintf("[live] #%s started streaming", ev.Channel)
+		if strings.TrimSpace(ev.Title) != "" {
+			text += ": " + truncateString(ev.Title, 500)
+		}
+
+		return text
+	case eventStreamEnd:
+		return fmt.Sprintf("[offline] #%s stopped streaming", ev.Channel)
+	default:
+		return fmt.Sprintf("[%s] %s: %s", ev.Channel, ev.User, truncateString(ev.Message, 3500))
+	}
+}
+
+// eventTitle is the short heading used by providers with a separate title field (Discord, ntfy).
+func eventTitle(ev entity.NotificationEvent) string {
+	switch ev.Type {
+	case eventStreamStart:
+		return fmt.Sprintf("#%s is live", ev.Channel)
+	case eventStreamEnd:
+		return fmt.Sprintf("#%s went offline", ev.Channel)
+	case eventKeywordMatch:
+		return fmt.Sprintf("Chat in #%s", ev.Channel)
+	default:
+		return "#" + ev.Channel
+	}
+}
+
+// bodyTemplateVars are the $NAME placeholders available in webhook body_template.
+func bodyTemplateVars(d entity.NotificationDelivery, sentAt time.Time) map[string]string {
+	return map[string]string{
+		"EVENT_ID":   d.EventID,
+		"EVENT_TYPE": d.Event.Type,
+		"CHANNEL":    d.Event.Channel,
+		"USERNAME":   d.Event.User,
+		"MESSAGE":    d.Event.Message,
+		"TITLE":      d.Event.Title,
+		"TEXT":       eventText(d.Event),
+		"TIMESTAMP":  strconv.FormatInt(sentAt.Unix(), 10),
+	}
+}
+
+// expandBodyTemplate substitutes $NAME placeholders, escaping values for contentType so a template like
+// {"content":"$TEXT"} stays valid JSON whatever the chat message contains.
+func expandBodyTemplate(tpl, contentType string, vars map[string]string) string {
+	escape := func(v string) string { return v }
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		escape = jsonStringContent
+	case mediaType == "application/x-www-form-urlencoded":
+		escape = url.QueryEscape
+	}
+
+	pairs := make([]string, 0, len(vars)*2)
+
+	for _, k := range bodyTemplateVarOrder {
+		if v, ok := vars[k]; ok {
+			pairs = append(pairs, "$"+k, escape(v))
+		}
+	}
+
+	return strings.NewReplacer(pairs...).Replace(tpl)
+}
+
+// bodyTemplateVarOrder keeps replacer construction deterministic (map iteration is not).
+var bodyTemplateVarOrder = []string{"EVENT_TYPE", "EVENT_ID", "TIMESTAMP", "USERNAME", "CHANNEL", "MESSAGE", "TITLE", "TEXT"}
+
+// jsonStringContent returns v encoded as a JSON string without the surrounding quotes.
+func jsonStringContent(v string) string {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+
+	b := bytes.TrimSpace(buf.Bytes())
+
+	return string(b[1 : len(b)-1])
+}
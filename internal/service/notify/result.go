@@ -0,0 +1,65 @@
+package notify
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Result is the outcome of one provider call.
+type Result struct {
+	StatusCode int
+	// Body is the provider response snippet kept in the delivery log.
+	Body string
+	// RetryAfter is the provider-requested wait (Telegram retry_after, HTTP Retry-After); zero when absent.
+	RetryAfter time.Duration
+	Err        error
+	// Permanent marks failures that retrying cannot fix (bad settings, 4xx other than 408/429).
+	Permanent bool
+}
+
+// Delivered reports a successful exchange: no error and, for HTTP providers, a 2xx status.
+func (r Result) Delivered() bool {
+	return r.Err == nil && (r.StatusCode == 0 || r.StatusCode >= 200 && r.StatusCode < 300)
+}
+
+// classifyHTTP fills Err/Permanent for a completed HTTP exchange.
+func classifyHTTP(res Result) Result {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res
+	}
+
+	res.Err = fmt.Errorf("unexpected status %d", res.StatusCode)
+
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests, res.StatusCode == http.StatusRequestTimeout:
+	case res.StatusCode >= 400 && res.StatusCode < 500:
+		res.Permanent = true
+	}
+
+	return res
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+
+		return time.Duration(secs) * time.Second
+	}
+
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+
+	return 0
+}
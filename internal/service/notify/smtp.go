@@ -0,0 +1,137 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"net/url"
+	"time"
+)
+
+// sendSMTP delivers a rendered email. req.URL is smtp:// (STARTTLS required), smtps:// (implicit TLS) or
+// smtp://…?tls=none, optionally with credentials; 5xx replies and auth failures are permanent.
+func sendSMTP(ctx context.Context, name string, req Request) Result {
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return Result{Err: fmt.Errorf("%s: parse server url: %w", name, err), Permanent: true}
+	}
+
+	if err := smtpDeliver(ctx, u, req); err != nil {
+		res := Result{Err: fmt.Errorf("%s: %w", name, err)}
+
+		var pe *smtpPermanentError
+		var te *textproto.Error
+
+		switch {
+		case errors.As(err, &pe):
+			res.Permanent = true
+		case errors.As(err, &te):
+			res.Body = snippet([]byte(te.Error()))
+			res.Permanent = te.Code >= 500
+		}
+
+		return res
+	}
+
+	return Result{Body: fmt.Sprintf("accepted for %d recipient(s)", len(req.To))}
+}
+
+// smtpPermanentError marks configuration problems retrying cannot fix (no STARTTLS, rejected auth).
+type smtpPermanentError struct {
+	err error
+}
+
+func (e *smtpPermanentError) Error() string { return e.err.Error() }
+
+func (e *smtpPermanentError) Unwrap() error { return e.err }
+
+func smtpDeliver(ctx context.Context, u *url.URL, req Request) error {
+	host := u.Hostname()
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", u.Host)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+
+	// net/smtp has no context support; the connection deadline bounds the whole exchange.
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(time.Minute))
+	}
+
+	if u.Scheme == "smtps" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			return fmt.Errorf("tls handshake: %w", err)
+		}
+
+		conn = tlsConn
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("greeting: %w", err)
+	}
+	defer func() { _ = c.Close() }()
+
+	if u.Scheme == "smtp" && u.Query().Get("tls") != emailTLSNone {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return &smtpPermanentError{err: errors.New("server does not offer STARTTLS")}
+		}
+
+		if err := c.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	}
+
+	if u.User != nil {
+		pass, _ := u.User.Password()
+
+		// PlainAuth refuses to send credentials over an unencrypted connection to a non-local host.
+		if err := c.Auth(smtp.PlainAuth("", u.User.Username(), pass, host)); err != nil {
+			var te *textproto.Error
+			if errors.As(err, &te) && te.Code < 500 {
+				return fmt.Errorf("auth: %w", err)
+			}
+
+			return &smtpPermanentError{err: fmt.Errorf("auth: %w", err)}
+		}
+	}
+
+	if err := c.Mail(req.From); err != nil {
+		return fmt.Errorf("mail from: %w", err)
+	}
+
+	for _, rcpt := range req.To {
+		if err := c.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("rcpt to %s: %w", rcpt, err)
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("data: %w", err)
+	}
+
+	if _, err := w.Write(req.Body); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("end data: %w", err)
+	}
+
+	// The message is accepted once DATA completes; a failed QUIT must not trigger a duplicate send.
+	_ = c.Quit()
+
+	return nil
+}
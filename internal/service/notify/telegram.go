@@ -0,0 +1,167 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const defaultTelegramAPIBaseURL = "https://api.telegram.org"
+
+// telegramProvider sends plain-text messages through the Bot API (settings: bot_token, chat_id; optional
+// interactive with allowed_user_ids, which attaches inline action buttons handled by the Telegram bot).
+type telegramProvider struct {
+	baseURL string
+}
+
+func newTelegramProvider(baseURL string) telegramProvider {
+	baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	if baseURL == "" {
+		baseURL = defaultTelegramAPIBaseURL
+	}
+
+	return telegramProvider{baseURL: baseURL}
+}
+
+func (telegramProvider) Type() string { return "telegram" }
+
+func (p telegramProvider) ValidateSettings(settings map[string]any) error {
+	if err := requireStringSettings(p.Type(), settings, "bot_token", "chat_id"); err != nil {
+		return err
+	}
+
+	if v, ok := settings["interactive"]; ok && v != nil {
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("telegram: settings.interactive must be a boolean: %w", entity.ErrInvalidNotification)
+		}
+	}
+
+	ids, err := int64sSetting(settings, "allowed_user_ids")
+	if err != nil {
+		return fmt.Errorf("telegram: %w: %w", err, entity.ErrInvalidNotification)
+	}
+
+	if interactive, _ := settings["interactive"].(bool); interactive && len(ids) == 0 {
+		return fmt.Errorf("telegram: settings.allowed_user_ids is required with interactive: %w", entity.ErrInvalidNotification)
+	}
+
+	return nil
+}
+
+func (p telegramProvider) Render(settings map[string]any, d entity.NotificationDelivery) (Request, error) {
+	form := url.Values{}
+	form.Set("chat_id", stringSetting(settings, "chat_id"))
+	form.Set("text", eventText(d.Event))
+
+	if _, ok := TelegramInteractive(settings); ok {
+		markup, err := json.Marshal(map[string]any{"inline_keyboard": telegramAlertKeyboard(d.Event)})
+		if err != nil {
+			return Request{}, err
+		}
+
+		form.Set("reply_markup", string(markup))
+	}
+
+	return Request{
+		Method: http.MethodPost,
+		URL:    fmt.Sprintf("%s/bot%s/sendMessage", p.baseURL, stringSetting(settings, "bot_token")),
+		Header: http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+		Body:   []byte(form.Encode()),
+	}, nil
+}
+
+// Inline button actions on interactive Telegram alerts; callback_data is "<action>:<login>".
+const (
+	TelegramActionMarkSus   = "sus"
+	TelegramActionMark      = "mark"
+	TelegramActionBlacklist = "bl"
+	TelegramActionOpen      = "open"
+)
+
+// TelegramInteractive reports whether a telegram entry runs in interactive bot mode and returns the
+// Telegram user ids allowed to use commands and buttons.
+func TelegramInteractive(settings map[string]any) ([]int64, bool) {
+	if on, _ := settings["interactive"].(bool); !on {
+		return nil, false
+	}
+
+	ids, err := int64sSetting(settings, "allowed_user_ids")
+	if err != nil || len(ids) == 0 {
+		return nil, false
+	}
+
+	return ids, true
+}
+
+// ParseTelegramCallback splits callback_data produced by the alert keyboard.
+func ParseTelegramCallback(data string) (action, login string, ok bool) {
+	action, login, ok = strings.Cut(data, ":")
+	if !ok || login == "" {
+		return "", "", false
+	}
+
+	switch action {
+	case TelegramActionMarkSus, TelegramActionMark, TelegramActionBlacklist, TelegramActionOpen:
+		return action, login, true
+	default:
+		return "", "", false
+	}
+}
+
+type telegramButton struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data"`
+}
+
+// telegramAlertKeyboard offers user actions for chat events and channel actions for every event.
+func telegramAlertKeyboard(ev entity.NotificationEvent) [][]telegramButton {
+	channel := normalizeChannel(ev.Channel)
+	user := normalizeChannel(ev.User)
+
+	var rows [][]telegramButton
+
+	if ev.Type == eventKeywordMatch && user != "" {
+		rows = append(rows, []telegramButton{
+			{Text: "Mark sus", CallbackData: TelegramActionMarkSus + ":" + user},
+			{Text: "Mark", CallbackData: TelegramActionMark + ":" + user},
+		})
+	}
+
+	if channel == "" {
+		return rows
+	}
+
+	open := channel
+	if user != "" && ev.Type == eventKeywordMatch {
+		open = user
+	}
+
+	return append(rows, []telegramButton{
+		{Text: "Blacklist #" + channel, CallbackData: TelegramActionBlacklist + ":" + channel},
+		{Text: "Open in UI", CallbackData: TelegramActionOpen + ":" + open},
+	})
+}
+
+// telegramErrorBody is the Bot API error envelope; parameters.retry_after is set on flood control (429).
+type telegramErrorBody struct {
+	Parameters struct {
+		RetryAfter int `json:"retry_after"`
+	} `json:"parameters"`
+}
+
+func (p telegramProvider) Send(ctx context.Context, client *http.Client, req Request) Result {
+	return sendHTTP(ctx, client, p.Type(), req, func(body []byte) time.Duration {
+		var tb telegramErrorBody
+		if json.Unmarshal(body, &tb) != nil || tb.Parameters.RetryAfter <= 0 {
+			return 0
+		}
+
+		return time.Duration(tb.Parameters.RetryAfter) * time.Second
+	})
+}
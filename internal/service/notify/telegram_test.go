@@ -0,0 +1,82 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestTelegramProvider_interactiveKeyboard(t *testing.T) {
+	t.Parallel()
+
+	p := newTelegramProvider("https://tg.example")
+	settings := map[string]any{"bot_token": "tok", "chat_id": "42", "interactive": true, "allowed_user_ids": []any{float64(7), "8"}}
+
+	require.NoError(t, p.ValidateSettings(settings))
+
+	req, err := p.Render(settings, entity.NotificationDelivery{
+		Event: entity.NotificationEvent{Type: eventKeywordMatch, Channel: "#ChanA", User: "Bob", Message: "hi"},
+	})
+	require.NoError(t, err)
+
+	form, err := url.ParseQuery(string(req.Body))
+	require.NoError(t, err)
+
+	var markup struct {
+		InlineKeyboard [][]telegramButton `json:"inline_keyboard"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(form.Get("reply_markup")), &markup))
+	assert.Equal(t, [][]telegramButton{
+		{{Text: "Mark sus", CallbackData: "sus:bob"}, {Text: "Mark", CallbackData: "mark:bob"}},
+		{{Text: "Blacklist #chana", CallbackData: "bl:chana"}, {Text: "Open in UI", CallbackData: "open:bob"}},
+	}, markup.InlineKeyboard)
+
+	ids, ok := TelegramInteractive(settings)
+	require.True(t, ok)
+	assert.Equal(t, []int64{7, 8}, ids)
+}
+
+func TestTelegramProvider_plainHasNoKeyboard(t *testing.T) {
+	t.Parallel()
+
+	req, err := newTelegramProvider("").Render(map[string]any{"bot_token": "tok", "chat_id": "42"}, entity.NotificationDelivery{
+		Event: entity.NotificationEvent{Type: eventStreamStart, Channel: "chana"},
+	})
+	require.NoError(t, err)
+
+	form, err := url.ParseQuery(string(req.Body))
+	require.NoError(t, err)
+	assert.Empty(t, form.Get("reply_markup"))
+	assert.Equal(t, "https://api.telegram.org/bottok/sendMessage", req.URL)
+}
+
+func TestTelegramProvider_ValidateSettings(t *testing.T) {
+	t.Parallel()
+
+	p := newTelegramProvider("")
+
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"bot_token": "t", "chat_id": "1", "interactive": true}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"bot_token": "t", "chat_id": "1", "interactive": "yes"}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"bot_token": "t", "chat_id": "1", "allowed_user_ids": []any{"x"}}), entity.ErrInvalidNotification)
+}
+
+func TestParseTelegramCallback(t *testing.T) {
+	t.Parallel()
+
+	action, login, ok := ParseTelegramCallback("bl:chana")
+	require.True(t, ok)
+	assert.Equal(t, TelegramActionBlacklist, action)
+	assert.Equal(t, "chana", login)
+
+	_, _, ok = ParseTelegramCallback("drop:chana")
+	assert.False(t, ok)
+
+	_, _, ok = ParseTelegramCallback("sus:")
+	assert.False(t, ok)
+}
@@ -0,0 +1,120 @@
+package notify
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// TestEventTypes lists the event types SendTest can render, in display order.
+func TestEventTypes() []string {
+	return []string{eventKeywordMatch, eventStreamStart, eventStreamEnd, eventRuleText, eventFloodSummary}
+}
+
+// sampleEvent returns a representative event of typ with placeholder data.
+func sampleEvent(typ string) entity.NotificationEvent {
+	ev := entity.NotificationEvent{Type: typ, Channel: "example_channel"}
+
+	switch typ {
+	case eventKeywordMatch:
+		ev.User = "example_user"
+		ev.Message = "test notification from dredge"
+	case eventStreamStart:
+		ev.Title = "Test stream title"
+	case eventRuleText:
+		ev.Text = "[test] rule notification from dredge"
+	case eventFloodSummary:
+		ev.Text = "3 more matches in #example_channel"
+	}
+
+	return ev
+}
+
+// SendTest renders a sample event of each requested type (all of TestEventTypes when empty) and sends it
+// with e's provider right away. It bypasses the outbox, snoozes and the entry's policy, and works for
+// disabled entries so settings can be checked before enabling them.
+func (d *Dispatcher) SendTest(ctx context.Context, e entity.NotificationEntry, eventTypes []string) ([]entity.NotificationTestResult, error) {
+	if len(eventTypes) == 0 {
+		eventTypes = TestEventTypes()
+	}
+
+	for _, typ := range eventTypes {
+		if !slices.Contains(TestEventTypes(), typ) {
+			return nil, fmt.Errorf("unknown event type %q: %w", typ, entity.ErrInvalidNotification)
+		}
+	}
+
+	out := make([]entity.NotificationTestResult, 0, len(eventTypes))
+
+	for _, typ := range eventTypes {
+		ev := sampleEvent(typ)
+		delivery := entity.NotificationDelivery{
+			EventID:             "test-" + strings.ToLower(rand.Text()),
+			NotificationEntryID: e.ID,
+			Event:               ev,
+			CreatedAt:           time.Now(),
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, d.deliveryTimeout)
+		started := time.Now()
+		res := d.send(sendCtx, e, []entity.NotificationDelivery{delivery})
+		elapsed := time.Since(started).Milliseconds()
+
+		cancel()
+
+		r := entity.NotificationTestResult{
+			EventType:       typ,
+			Text:            eventText(ev),
+			ResponseSnippet: redactSecrets(res.Body, e.Settings),
+			Delivered:       res.Delivered(),
+			DurationMs:      elapsed,
+		}
+
+		if res.StatusCode > 0 {
+			code := res.StatusCode
+			r.StatusCode = &code
+		}
+
+		if res.Err != nil {
+			r.Error = redactSecrets(res.Err.Error(), e.Settings)
+		}
+
+		out = append(out, r)
+	}
+
+	return out, nil
+}
+
+// secretSettingKeys hold credentials, or URLs that embed them (Discord webhooks).
+var secretSettingKeys = []string{"bot_token", "secret", "password", "access_token", "token", "webhook_url", "url"}
+
+var urlUserinfoRe = regexp.MustCompile(`(?i)\b([a-z][a-z0-9+.-]*://)[^/\s@]+@`)
+
+// redactSecrets blanks entry credentials and URL userinfo out of provider responses and errors shown to users.
+func redactSecrets(s string, settings map[string]any) string {
+	if s == "" {
+		return s
+	}
+
+	for _, k := range secretSettingKeys {
+		if v := stringSetting(settings, k); len(v) >= 4 {
+			s = strings.ReplaceAll(s, v, "[redacted]")
+		}
+	}
+
+	if headers, ok := settings["headers"].(map[string]any); ok {
+		for _, hv := range headers {
+			if v, _ := hv.(string); len(strings.TrimSpace(v)) >= 4 {
+				s = strings.ReplaceAll(s, strings.TrimSpace(v), "[redacted]")
+			}
+		}
+	}
+
+	return urlUserinfoRe.ReplaceAllString(s, "${1}[redacted]@")
+}
@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestSendTest_allEventTypes(t *testing.T) {
+	t.Parallel()
+
+	var types []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		typ, _ := body["type"].(string)
+		types = append(types, typ)
+
+		if typ == eventStreamEnd {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("rejected"))
+
+			return
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	d := testDispatcher(t, nil, srv.Client(), "")
+
+	out, err := d.SendTest(t.Context(), entity.NotificationEntry{ID: 1, Provider: "webhook", Settings: map[string]any{"url": srv.URL}}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, TestEventTypes(), types, "each event type is sent once, in order")
+	require.Len(t, out, len(TestEventTypes()))
+
+	assert.True(t, out[0].Delivered)
+	assert.Equal(t, "[example_channel] example_user: test notification from dredge", out[0].Text)
+	require.NotNil(t, out[0].StatusCode)
+	assert.Equal(t, http.StatusOK, *out[0].StatusCode)
+
+	assert.False(t, out[2].Delivered)
+	assert.Equal(t, "rejected", out[2].ResponseSnippet)
+	assert.Contains(t, out[2].Error, "unexpected status 400")
+}
+
+func TestSendTest_unknownEventType(t *testing.T) {
+	t.Parallel()
+
+	d := testDispatcher(t, nil, nil, "")
+
+	_, err := d.SendTest(t.Context(), entity.NotificationEntry{Provider: "webhook"}, []string{"nope"})
+	require.ErrorIs(t, err, entity.ErrInvalidNotification)
+}
+
+func TestSendTest_redactsBotToken(t *testing.T) {
+	t.Parallel()
+
+	const token = "123456:SECRET-token"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"ok":false,"description":"bad token ` + token + ` at ` + r.URL.Path + `"}`))
+	}))
+	defer srv.Close()
+
+	d := testDispatcher(t, nil, srv.Client(), srv.URL)
+
+	out, err := d.SendTest(t.Context(), entity.NotificationEntry{
+		Provider: "telegram",
+		Settings: map[string]any{"bot_token": token, "chat_id": "1"},
+	}, []string{eventStreamStart})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+
+	assert.False(t, out[0].Delivered)
+	assert.NotContains(t, out[0].ResponseSnippet, token)
+	assert.Contains(t, out[0].ResponseSnippet, "[redacted]")
+}
+
+func TestRedactSecrets(t *testing.T) {
+	t.Parallel()
+
+	settings := map[string]any{
+		"url":     "https://hooks.example.org/abc",
+		"secret":  "s3cr3t",
+		"headers": map[string]any{"Authorization": "Bearer xyz-123"},
+	}
+
+	got := redactSecrets("posting to https://hooks.example.org/abc with s3cr3t, Bearer xyz-123 via https://user:pw@proxy.example.org/x", settings)
+
+	assert.Equal(t, "posting to [redacted] with [redacted], [redacted] via https://[redacted]@proxy.example.org/x", got)
+	assert.False(t, strings.Contains(redactSecrets("abc", map[string]any{"token": "ab"}), "[redacted]"), "short values are left alone")
+}
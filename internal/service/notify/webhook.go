@@ -0,0 +1,150 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// Headers sent with every webhook delivery. The signature is only added when the entry has a secret.
+const (
+	webhookEventIDHeader   = "X-Dredge-Event-Id"
+	webhookTimestampHeader = "X-Dredge-Timestamp"
+	webhookSignatureHeader = "X-Dredge-Signature"
+)
+
+// webhookProvider posts JSON (or a body_template) to an arbitrary URL, optionally HMAC-signed.
+type webhookProvider struct{}
+
+func (webhookProvider) Type() string { return "webhook" }
+
+func (p webhookProvider) ValidateSettings(settings map[string]any) error {
+	if err := validateHTTPURL(p.Type(), settings, "url", true); err != nil {
+		return err
+	}
+
+	if err := optionalStringSettings(p.Type(), settings, "method", "secret", "body_template", "content_type"); err != nil {
+		return err
+	}
+
+	if h, ok := settings["headers"]; ok && h != nil {
+		if _, ok := h.(map[string]any); !ok {
+			return fmt.Errorf("webhook: settings.headers must be an object: %w", entity.ErrInvalidNotification)
+		}
+	}
+
+	if m := stringSetting(settings, "method"); m != "" {
+		switch strings.ToUpper(m) {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			return fmt.Errorf("webhook: settings.method %q must be POST, PUT or PATCH: %w", m, entity.ErrInvalidNotification)
+		}
+	}
+
+	if ct := stringSetting(settings, "content_type"); ct != "" {
+		if _, _, err := mime.ParseMediaType(ct); err != nil {
+			return fmt.Errorf("webhook: settings.content_type %q: %w", ct, entity.ErrInvalidNotification)
+		}
+	}
+
+	return nil
+}
+
+// webhookPayload renders the default JSON body posted to webhook entries (shape kept stable for receivers).
+func webhookPayload(d entity.NotificationDelivery) map[string]any {
+	ev := d.Event
+
+	payload := map[string]any{
+		"event_id": d.EventID,
+		"type":     ev.Type,
+		"channel":  ev.Channel,
+	}
+
+	switch ev.Type {
+	case eventKeywordMatch:
+		payload["user"] = ev.User
+		payload["message"] = truncateString(ev.Message, 400)
+	case eventStreamStart:
+		payload["title"] = ev.Title
+	}
+
+	if ev.Text != "" || ev.Type == eventRuleText {
+		payload["text"] = ev.Text
+	}
+
+	return payload
+}
+
+// webhookSignature is hex HMAC-SHA256 over "<timestamp>.<body>"; receivers recompute it with the shared secret
+// and reject stale timestamps to stop replays.
+func webhookSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (p webhookProvider) Render(settings map[string]any, d entity.NotificationDelivery) (Request, error) {
+	method := http.MethodPost
+	if m := stringSetting(settings, "method"); m != "" {
+		method = strings.ToUpper(m)
+	}
+
+	contentType := "application/json"
+	if ct := stringSetting(settings, "content_type"); ct != "" {
+		contentType = ct
+	}
+
+	now := time.Now()
+
+	var body []byte
+
+	if tpl, _ := settings["body_template"].(string); strings.TrimSpace(tpl) != "" {
+		body = []byte(expandBodyTemplate(tpl, contentType, bodyTemplateVars(d, now)))
+	} else {
+		b, err := json.Marshal(webhookPayload(d))
+		if err != nil {
+			return Request{}, err
+		}
+
+		body = b
+	}
+
+	header := http.Header{}
+
+	if h, ok := settings["headers"].(map[string]any); ok {
+		for k, v := range h {
+			if sv, ok := v.(string); ok {
+				header.Set(k, sv)
+			}
+		}
+	}
+
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	header.Set("Content-Type", contentType)
+	header.Set(webhookEventIDHeader, d.EventID)
+	header.Set(webhookTimestampHeader, ts)
+
+	if secret, _ := settings["secret"].(string); secret != "" {
+		header.Set(webhookSignatureHeader, webhookSignature(secret, ts, body))
+	}
+
+	return Request{Method: method, URL: stringSetting(settings, "url"), Header: header, Body: body}, nil
+}
+
+func (p webhookProvider) Send(ctx context.Context, client *http.Client, req Request) Result {
+	return sendHTTP(ctx, client, p.Type(), req, nil)
+}
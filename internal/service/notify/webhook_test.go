@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestPostWebhook_signedTemplate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		method string
+		header http.Header
+		body   []byte
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		header = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	res := renderAndSend(t, webhookProvider{}, srv.Client(), map[string]any{
+		"url":           srv.URL,
+		"method":        "put",
+		"secret":        "s3cret",
+		"body_template": `{"content":"$TEXT","id":"$EVENT_ID"}`,
+		"headers":       map[string]any{"X-Dredge-Event-Id": "spoofed"},
+	}, entity.NotificationDelivery{
+		EventID: "evt-1",
+		Event:   entity.NotificationEvent{Type: eventKeywordMatch, Channel: "ch", User: "u", Message: `say "hi"`},
+	})
+	require.True(t, res.Delivered(), res.Err)
+
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "evt-1", header.Get(webhookEventIDHeader))
+	assert.JSONEq(t, `{"content":"[ch] u: say \"hi\"","id":"evt-1"}`, string(body))
+
+	ts := header.Get(webhookTimestampHeader)
+	require.NotEmpty(t, ts)
+	assert.Equal(t, webhookSignature("s3cret", ts, body), header.Get(webhookSignatureHeader))
+}
+
+func TestPostWebhook_unsignedWithoutSecret(t *testing.T) {
+	t.Parallel()
+
+	var header http.Header
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	res := renderAndSend(t, webhookProvider{}, srv.Client(), map[string]any{"url": srv.URL}, entity.NotificationDelivery{
+		EventID: "evt-2",
+		Event:   entity.NotificationEvent{Type: eventStreamEnd, Channel: "ch"},
+	})
+	require.True(t, res.Delivered(), res.Err)
+
+	assert.Empty(t, header.Get(webhookSignatureHeader))
+	assert.Equal(t, "evt-2", header.Get(webhookEventIDHeader))
+}
+
+func TestExpandBodyTemplate_escaping(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]string{"TEXT": "a&b \"c\"", "CHANNEL": "ch"}
+
+	assert.Equal(t, `{"t":"a&b \"c\"","c":"ch"}`, expandBodyTemplate(`{"t":"$TEXT","c":"$CHANNEL"}`, "application/json; charset=utf-8", vars))
+	assert.Equal(t, "text=a%26b+%22c%22", expandBodyTemplate("text=$TEXT", "application/x-www-form-urlencoded", vars))
+	assert.Equal(t, `a&b "c" in ch`, expandBodyTemplate("$TEXT in $CHANNEL", "text/plain", vars))
+}
+
+func TestWebhookProvider_ValidateSettings(t *testing.T) {
+	t.Parallel()
+
+	p := webhookProvider{}
+
+	require.NoError(t, p.ValidateSettings(map[string]any{"url": "https://x.example", "method": "put", "secret": "s", "content_type": "text/plain; charset=utf-8"}))
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"url": "ftp://x"}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"url": "https://x.example", "method": "DELETE"}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"url": "https://x.example", "secret": 5}), entity.ErrInvalidNotification)
+	require.ErrorIs(t, p.ValidateSettings(map[string]any{"url": "https://x.example", "content_type": "not a type"}), entity.ErrInvalidNotification)
+}
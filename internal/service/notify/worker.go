@@ -0,0 +1,288 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// run claims due deliveries in batches of d.workers and sends them concurrently until ctx is cancelled.
+func (d *Dispatcher) run(ctx context.Context) {
+	poll := time.NewTicker(d.pollInterval)
+	defer poll.Stop()
+
+	prune := time.NewTicker(time.Hour)
+	defer prune.Stop()
+
+	d.prune()
+
+	// Summaries of windows still open at shutdown are queued for the next start.
+	defer d.flushFloods(time.Now(), true)
+
+	for {
+		d.flushFloods(time.Now(), false)
+		d.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wake:
+		case <-poll.C:
+		case <-prune.C:
+			d.prune()
+		}
+	}
+}
+
+// drain processes batches until nothing is due or ctx is cancelled.
+func (d *Dispatcher) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		jobs, err := d.repo.ClaimNotificationDeliveries(ctx, d.workers, d.lease())
+		if err != nil {
+			if ctx.Err() == nil {
+				d.obs.Logger.Warn("claim notification deliveries failed", zap.Error(err))
+			}
+
+			return
+		}
+
+		if len(jobs) == 0 {
+			return
+		}
+
+		var wg sync.WaitGroup
+
+		for _, b := range d.batches(ctx, jobs) {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				d.deliverBatch(b.entry, b.deliveries)
+			}()
+		}
+
+		wg.Wait()
+	}
+}
+
+// maxDigestDeliveries caps how many events one digest message folds together.
+const maxDigestDeliveries = 100
+
+type deliveryBatch struct {
+	entry      entity.NotificationEntry
+	deliveries []entity.NotificationDelivery
+}
+
+// batches keeps one batch per claimed row, except for digest entries: their rows are merged per entry
+// and topped up with the entry's other due rows so one window becomes one message.
+func (d *Dispatcher) batches(ctx context.Context, jobs []entity.NotificationDeliveryJob) []deliveryBatch {
+	out := make([]deliveryBatch, 0, len(jobs))
+	digestIdx := make(map[int64]int)
+
+	for _, job := range jobs {
+		if d.digestInterval(job.Entry) <= 0 {
+			out = append(out, deliveryBatch{entry: job.Entry, deliveries: []entity.NotificationDelivery{job.Delivery}})
+			continue
+		}
+
+		if i, ok := digestIdx[job.Entry.ID]; ok {
+			out[i].deliveries = append(out[i].deliveries, job.Delivery)
+			continue
+		}
+
+		digestIdx[job.Entry.ID] = len(out)
+		out = append(out, deliveryBatch{entry: job.Entry, deliveries: []entity.NotificationDelivery{job.Delivery}})
+	}
+
+	for entryID, i := range digestIdx {
+		more, err := d.repo.ClaimEntryNotificationDeliveries(ctx, entryID, maxDigestDeliveries-len(out[i].deliveries), d.lease())
+		if err != nil {
+			if ctx.Err() == nil {
+				d.obs.Logger.Warn("claim digest notification deliveries failed", zap.Error(err), zap.Int64("notification_id", entryID))
+			}
+
+			continue
+		}
+
+		out[i].deliveries = append(out[i].deliveries, more...)
+	}
+
+	return out
+}
+
+// digestInterval is the entry's batching window, zero when its provider sends every event on its own.
+func (d *Dispatcher) digestInterval(e entity.NotificationEntry) time.Duration {
+	p, ok := d.providers.Get(e.Provider)
+	if !ok {
+		return 0
+	}
+
+	dp, ok := p.(DigestProvider)
+	if !ok {
+		return 0
+	}
+
+	return dp.DigestInterval(e.Settings)
+}
+
+// lease is how long a claimed row stays reserved; an expired lease (process crash) makes it due again.
+func (d *Dispatcher) lease() time.Duration {
+	return d.deliveryTimeout + 30*time.Second
+}
+
+// deliver sends one claimed row and records the attempt.
+func (d *Dispatcher) deliver(job entity.NotificationDeliveryJob) {
+	d.deliverBatch(job.Entry, []entity.NotificationDelivery{job.Delivery})
+}
+
+// deliverBatch sends claimed rows of one entry as a single message and records the attempt on each row.
+// It deliberately does not inherit the loop context so Stop lets in-flight sends finish instead of
+// recording them as failures.
+func (d *Dispatcher) deliverBatch(e entity.NotificationEntry, deliveries []entity.NotificationDelivery) {
+	// Rows queued before the entry was disabled (including retries) are settled without sending.
+	if !e.Enabled {
+		for _, delivery := range deliveries {
+			d.recordAttempt(delivery.ID, entity.NotificationDeliveryAttempt{
+				DeliveryID:  delivery.ID,
+				AttemptedAt: time.Now(),
+				Error:       entity.NotificationSuppressedDisabled,
+			}, entity.NotificationDeliverySuppressed, time.Now())
+		}
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.deliveryTimeout)
+	defer cancel()
+
+	started := time.Now()
+	res := d.send(ctx, e, deliveries)
+	elapsed := time.Since(started).Milliseconds()
+
+	// Rows of a failed digest retry together so the next attempt is still one message.
+	var retryAt time.Time
+
+	for _, delivery := range deliveries {
+		attempt := entity.NotificationDeliveryAttempt{
+			DeliveryID:      delivery.ID,
+			AttemptedAt:     started,
+			ResponseSnippet: res.Body,
+			DurationMs:      elapsed,
+		}
+
+		if res.StatusCode > 0 {
+			code := res.StatusCode
+			attempt.StatusCode = &code
+		}
+
+		if res.Err != nil {
+			attempt.Error = res.Err.Error()
+		}
+
+		status, next := d.nextState(delivery.Attempts+1, res)
+
+		if status == entity.NotificationDeliveryPending {
+			if retryAt.IsZero() {
+				retryAt = next
+			}
+
+			next = retryAt
+		}
+
+		logFields := []zap.Field{
+			zap.Int64("delivery_id", delivery.ID),
+			zap.Int64("notification_id", e.ID),
+			zap.String("provider", e.Provider),
+			zap.Int("status_code", res.StatusCode),
+			zap.Error(res.Err),
+		}
+
+		switch status {
+		case entity.NotificationDeliveryDead:
+			d.obs.Logger.Warn("notification delivery dead-lettered", logFields...)
+		case entity.NotificationDeliveryPending:
+			d.obs.Logger.Info("notification delivery failed, will retry", append(logFields, zap.Time("next_attempt_at", next))...)
+		}
+
+		d.recordAttempt(delivery.ID, attempt, status, next)
+	}
+}
+
+func (d *Dispatcher) recordAttempt(deliveryID int64, attempt entity.NotificationDeliveryAttempt, status string, next time.Time) {
+	saveCtx, saveCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer saveCancel()
+
+	if err := d.repo.CompleteNotificationDeliveryAttempt(saveCtx, deliveryID, attempt, status, next); err != nil {
+		// The lease expires and the row is retried; at-least-once delivery.
+		d.obs.Logger.Error("record notification delivery attempt failed", zap.Error(err), zap.Int64("delivery_id", deliveryID))
+	}
+}
+
+// nextState maps a send result to the row's next status; attempts includes the one just made.
+func (d *Dispatcher) nextState(attempts int, res Result) (string, time.Time) {
+	now := time.Now()
+
+	switch {
+	case res.Delivered():
+		return entity.NotificationDeliveryDelivered, now
+	case res.Permanent, attempts >= d.maxAttempts:
+		return entity.NotificationDeliveryDead, now
+	default:
+		return entity.NotificationDeliveryPending, now.Add(retryDelay(attempts, res.RetryAfter))
+	}
+}
+
+// send validates, renders and performs one delivery (or one digest of several) with the entry's provider.
+func (d *Dispatcher) send(ctx context.Context, e entity.NotificationEntry, deliveries []entity.NotificationDelivery) Result {
+	p, ok := d.providers.Get(e.Provider)
+	if !ok {
+		return Result{Err: fmt.Errorf("unknown notification provider %q", e.Provider), Permanent: true}
+	}
+
+	if err := p.ValidateSettings(e.Settings); err != nil {
+		return Result{Err: err, Permanent: true}
+	}
+
+	var (
+		req Request
+		err error
+	)
+
+	if dp, ok := p.(DigestProvider); ok && len(deliveries) > 1 {
+		req, err = dp.RenderDigest(e.Settings, deliveries)
+	} else {
+		req, err = p.Render(e.Settings, deliveries[0])
+	}
+
+	if err != nil {
+		return Result{Err: fmt.Errorf("%s: render: %w", e.Provider, err), Permanent: true}
+	}
+
+	return p.Send(ctx, d.httpClient, req)
+}
+
+// prune drops delivered and dead rows older than the retention window and expired snoozes.
+func (d *Dispatcher) prune() {
+	ctx, cancel := context.WithTimeout(d.persistContext(), 30*time.Second)
+	defer cancel()
+
+	n, err := d.repo.PruneNotificationDeliveries(ctx, time.Now().Add(-d.retention))
+	if err != nil {
+		d.obs.Logger.Warn("prune notification deliveries failed", zap.Error(err))
+		return
+	}
+
+	if n > 0 {
+		d.obs.Logger.Debug("pruned notification deliveries", zap.Int64("rows", n))
+	}
+
+	if _, err := d.repo.PruneNotificationSnoozes(ctx, time.Now()); err != nil {
+		d.obs.Logger.Warn("prune notification snoozes failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,209 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestDeliver_webhookDelivered(t *testing.T) {
+	t.Parallel()
+
+	var got map[string]any
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, srv.Client(), "")
+
+	repo.EXPECT().CompleteNotificationDeliveryAttempt(gomock.Any(), int64(10), gomock.Any(), entity.NotificationDeliveryDelivered, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int64, a entity.NotificationDeliveryAttempt, _ string, _ time.Time) error {
+			require.NotNil(t, a.StatusCode)
+			assert.Equal(t, http.StatusNoContent, *a.StatusCode)
+			assert.Empty(t, a.Error)
+
+			return nil
+		})
+
+	d.deliver(entity.NotificationDeliveryJob{
+		Delivery: entity.NotificationDelivery{ID: 10, EventID: "evt-10", Event: entity.NotificationEvent{Type: eventRuleText, Channel: "ch", Text: "hi"}},
+		Entry:    entity.NotificationEntry{ID: 1, Enabled: true, Provider: "webhook", Settings: map[string]any{"url": srv.URL}},
+	})
+
+	assert.Equal(t, map[string]any{"event_id": "evt-10", "type": "rule_text", "channel": "ch", "text": "hi"}, got)
+}
+
+func TestDeliver_webhookServerErrorRetries(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = io.WriteString(w, "upstream down")
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, srv.Client(), "")
+
+	repo.EXPECT().CompleteNotificationDeliveryAttempt(gomock.Any(), int64(11), gomock.Any(), entity.NotificationDeliveryPending, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int64, a entity.NotificationDeliveryAttempt, _ string, next time.Time) error {
+			assert.Equal(t, "upstream down", a.ResponseSnippet)
+			assert.NotEmpty(t, a.Error)
+			assert.True(t, next.After(time.Now()))
+
+			return nil
+		})
+
+	d.deliver(entity.NotificationDeliveryJob{
+		Delivery: entity.NotificationDelivery{ID: 11, Event: entity.NotificationEvent{Type: eventStreamEnd, Channel: "ch"}},
+		Entry:    entity.NotificationEntry{ID: 1, Enabled: true, Provider: "webhook", Settings: map[string]any{"url": srv.URL}},
+	})
+}
+
+func TestDeliver_disabledEntrySkipped(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("disabled entry must not be sent")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, srv.Client(), "")
+
+	repo.EXPECT().CompleteNotificationDeliveryAttempt(gomock.Any(), int64(13), gomock.Any(), entity.NotificationDeliverySuppressed, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int64, a entity.NotificationDeliveryAttempt, _ string, _ time.Time) error {
+			assert.Equal(t, entity.NotificationSuppressedDisabled, a.Error)
+			assert.Nil(t, a.StatusCode)
+
+			return nil
+		})
+
+	d.deliver(entity.NotificationDeliveryJob{
+		Delivery: entity.NotificationDelivery{ID: 13, Attempts: 2, Event: entity.NotificationEvent{Type: eventStreamEnd, Channel: "ch"}},
+		Entry:    entity.NotificationEntry{ID: 1, Enabled: false, Provider: "webhook", Settings: map[string]any{"url": srv.URL}},
+	})
+}
+
+func TestDeliver_lastAttemptGoesDead(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, srv.Client(), "")
+
+	repo.EXPECT().CompleteNotificationDeliveryAttempt(gomock.Any(), int64(12), gomock.Any(), entity.NotificationDeliveryDead, gomock.Any()).Return(nil)
+
+	d.deliver(entity.NotificationDeliveryJob{
+		Delivery: entity.NotificationDelivery{ID: 12, Attempts: d.maxAttempts - 1, Event: entity.NotificationEvent{Type: eventStreamEnd, Channel: "ch"}},
+		Entry:    entity.NotificationEntry{ID: 1, Enabled: true, Provider: "webhook", Settings: map[string]any{"url": srv.URL}},
+	})
+}
+
+func TestDeliver_missingSettingsDead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, nil, "")
+
+	repo.EXPECT().CompleteNotificationDeliveryAttempt(gomock.Any(), int64(13), gomock.Any(), entity.NotificationDeliveryDead, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int64, a entity.NotificationDeliveryAttempt, _ string, _ time.Time) error {
+			assert.Nil(t, a.StatusCode)
+			assert.Contains(t, a.Error, "bot_token")
+
+			return nil
+		})
+
+	d.deliver(entity.NotificationDeliveryJob{
+		Delivery: entity.NotificationDelivery{ID: 13, Event: entity.NotificationEvent{Type: eventStreamEnd, Channel: "ch"}},
+		Entry:    entity.NotificationEntry{ID: 1, Enabled: true, Provider: "telegram", Settings: map[string]any{}},
+	})
+}
+
+func TestDeliver_telegramHonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var form url.Values
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/botTOKEN/sendMessage", r.URL.Path)
+
+		_ = r.ParseForm()
+		form = r.PostForm
+
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = io.WriteString(w, `{"ok":false,"error_code":429,"description":"Too Many Requests","parameters":{"retry_after":600}}`)
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	d := testDispatcher(t, repo, srv.Client(), srv.URL)
+
+	repo.EXPECT().CompleteNotificationDeliveryAttempt(gomock.Any(), int64(14), gomock.Any(), entity.NotificationDeliveryPending, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int64, a entity.NotificationDeliveryAttempt, _ string, next time.Time) error {
+			require.NotNil(t, a.StatusCode)
+			assert.Equal(t, http.StatusTooManyRequests, *a.StatusCode)
+			assert.True(t, next.After(time.Now().Add(599*time.Second)))
+
+			return nil
+		})
+
+	d.deliver(entity.NotificationDeliveryJob{
+		Delivery: entity.NotificationDelivery{ID: 14, Event: entity.NotificationEvent{Type: eventKeywordMatch, Channel: "ch", User: "u", Message: "hey"}},
+		Entry:    entity.NotificationEntry{ID: 1, Enabled: true, Provider: "telegram", Settings: map[string]any{"bot_token": "TOKEN", "chat_id": "42"}},
+	})
+
+	assert.Equal(t, "42", form.Get("chat_id"))
+	assert.Equal(t, "[ch] u: hey", form.Get("text"))
+}
+
+func TestNextState_clientErrorIsPermanent(t *testing.T) {
+	t.Parallel()
+
+	d := testDispatcher(t, nil, nil, "")
+
+	status, _ := d.nextState(1, classifyHTTP(Result{StatusCode: http.StatusBadRequest}))
+	assert.Equal(t, entity.NotificationDeliveryDead, status)
+
+	status, _ = d.nextState(1, classifyHTTP(Result{StatusCode: http.StatusRequestTimeout}))
+	assert.Equal(t, entity.NotificationDeliveryPending, status)
+}
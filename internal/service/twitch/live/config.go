@@ -9,7 +9,7 @@ import (
 	"github.com/rofleksey/dredge/internal/service/twitch/helix"
 )
 
-// Config wires the IRC monitor, presence snapshots, notifications, and outbound send.
+// Config wires the IRC monitor, presence snapshots, and outbound send.
 type Config struct {
 	Helix                     *helix.Client
 	Repo                      repository.Store
@@ -22,4 +22,6 @@ type Config struct {
 	JoinReconcileInterval time.Duration
 	// OAuthTokenSyncInterval is how often the IRC OAuth token is refreshed in-process (default 2m).
 	OAuthTokenSyncInterval time.Duration
+	// OnNewUser runs after a chatter is first inserted into twitch_users (may be nil).
+	OnNewUser func(userID int64)
 }
@@ -0,0 +1,94 @@
+package live
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// emoteSetMatcher finds third-party emote codes in chat messages. Channel-scoped sets win over global ones when
+// both define a code.
+type emoteSetMatcher struct {
+	global  map[string]entity.ScopedEmote
+	channel map[string]map[string]entity.ScopedEmote
+}
+
+// newEmoteSetMatcher indexes the emotes of all configured sets by code.
+func newEmoteSetMatcher(emotes []entity.ScopedEmote) *emoteSetMatcher {
+	m := &emoteSetMatcher{global: make(map[string]entity.ScopedEmote), channel: make(map[string]map[string]entity.ScopedEmote)}
+
+	for _, e := range emotes {
+		if e.Channel == "" {
+			m.global[e.Code] = e
+			continue
+		}
+
+		if m.channel[e.Channel] == nil {
+			m.channel[e.Channel] = make(map[string]entity.ScopedEmote)
+		}
+
+		m.channel[e.Channel][e.Code] = e
+	}
+
+	return m
+}
+
+// Empty reports whether no set defines any code.
+func (m *emoteSetMatcher) Empty() bool {
+	return m == nil || (len(m.global) == 0 && len(m.channel) == 0)
+}
+
+// Match returns the third-party emotes among the space-separated words of body (exact, case-sensitive codes, as
+// chat clients render them). Words overlapping a taken occurrence (e.g. a Twitch emote) are skipped.
+func (m *emoteSetMatcher) Match(channel, body string, taken []entity.ChatEmote) []entity.ChatEmote {
+	if m.Empty() {
+		return nil
+	}
+
+	scoped := m.channel[channel]
+
+	var out []entity.ChatEmote
+
+	pos := 0
+
+	for _, word := range strings.Split(body, " ") {
+		n := utf8.RuneCountInString(word)
+		start, end := pos, pos+n-1
+		pos += n + 1
+
+		if n == 0 {
+			continue
+		}
+
+		e, ok := scoped[word]
+		if !ok {
+			e, ok = m.global[word]
+		}
+
+		if !ok || overlapsEmote(taken, start, end) {
+			continue
+		}
+
+		out = append(out, entity.ChatEmote{Provider: e.Provider, EmoteID: e.EmoteID, Code: e.Code, Start: start, End: end})
+	}
+
+	return out
+}
+
+func overlapsEmote(emotes []entity.ChatEmote, start, end int) bool {
+	for _, e := range emotes {
+		if e.Start <= end && start <= e.End {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortChatEmotes orders occurrences by position.
+func sortChatEmotes(emotes []entity.ChatEmote) {
+	slices.SortFunc(emotes, func(a, b entity.ChatEmote) int { return cmp.Compare(a.Start, b.Start) })
+}
@@ -0,0 +1,34 @@
+package live
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestEmoteSetMatcher_Match(t *testing.T) {
+	m := newEmoteSetMatcher([]entity.ScopedEmote{
+		{Provider: entity.EmoteProviderBTTV, Code: "catJAM", EmoteID: "b1"},
+		{Provider: entity.EmoteProvider7TV, Code: "Clap", EmoteID: "g1"},
+		{Provider: entity.EmoteProvider7TV, Channel: "streamer", Code: "Clap", EmoteID: "s1"},
+	})
+
+	taken := []entity.ChatEmote{{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Start: 0, End: 4}}
+
+	got := m.Match("streamer", "Kappa ñ catJAM  Clap catjam", taken)
+	assert.Equal(t, []entity.ChatEmote{
+		{Provider: entity.EmoteProviderBTTV, EmoteID: "b1", Code: "catJAM", Start: 8, End: 13},
+		{Provider: entity.EmoteProvider7TV, EmoteID: "s1", Code: "Clap", Start: 16, End: 19},
+	}, got)
+
+	got = m.Match("other", "Clap", nil)
+	assert.Equal(t, []entity.ChatEmote{{Provider: entity.EmoteProvider7TV, EmoteID: "g1", Code: "Clap", Start: 0, End: 3}}, got)
+
+	got = m.Match("streamer", "Kappa", []entity.ChatEmote{{Start: 0, End: 4}})
+	assert.Empty(t, got)
+
+	assert.True(t, newEmoteSetMatcher(nil).Empty())
+	assert.Nil(t, (*emoteSetMatcher)(nil).Match("streamer", "catJAM", nil))
+}
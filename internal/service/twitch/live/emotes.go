@@ -0,0 +1,67 @@
+package live
+
+import (
+	"context"
+	"time"
+
+	twitchirc "github.com/gempir/go-twitch-irc/v4"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// emoteSetsReloadInterval is how long the third-party emote sets are cached between reloads.
+const emoteSetsReloadInterval = time.Minute
+
+// twitchChatEmotes flattens the IRC emotes tag into one occurrence per position.
+func twitchChatEmotes(emotes []*twitchirc.Emote) []entity.ChatEmote {
+	var out []entity.ChatEmote
+
+	for _, e := range emotes {
+		if e == nil {
+			continue
+		}
+
+		for _, p := range e.Positions {
+			out = append(out, entity.ChatEmote{
+				Provider: entity.EmoteProviderTwitch, EmoteID: e.ID, Code: e.Name, Start: p.Start, End: p.End,
+			})
+		}
+	}
+
+	return out
+}
+
+// messageEmotes returns the Twitch emotes of a message plus third-party codes from the configured emote sets,
+// ordered by position.
+func (r *Runtime) messageEmotes(ctx context.Context, channel string, msg twitchirc.PrivateMessage) []entity.ChatEmote {
+	out := twitchChatEmotes(msg.Emotes)
+	out = append(out, r.emoteMatcher(ctx).Match(channel, msg.Message, out)...)
+
+	sortChatEmotes(out)
+
+	return out
+}
+
+// emoteMatcher returns the cached matcher for the configured emote sets, reloading it every
+// emoteSetsReloadInterval. A failed reload keeps the previous matcher until the next interval.
+func (r *Runtime) emoteMatcher(ctx context.Context) *emoteSetMatcher {
+	r.emoteMu.Lock()
+	defer r.emoteMu.Unlock()
+
+	if time.Since(r.emoteSetsLoadedAt) < emoteSetsReloadInterval {
+		return r.emoteSets
+	}
+
+	r.emoteSetsLoadedAt = time.Now()
+
+	emotes, err := r.repo.ListScopedEmotes(ctx)
+	if err != nil {
+		r.obs.Logger.Warn("load emote sets failed", zap.Error(err))
+		return r.emoteSets
+	}
+
+	r.emoteSets = newEmoteSetMatcher(emotes)
+
+	return r.emoteSets
+}
@@ -0,0 +1,70 @@
+package live
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	twitchirc "github.com/gempir/go-twitch-irc/v4"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestTwitchChatEmotes(t *testing.T) {
+	t.Parallel()
+
+	got := twitchChatEmotes([]*twitchirc.Emote{
+		{Name: "Kappa", ID: "25", Positions: []twitchirc.EmotePosition{{Start: 0, End: 4}, {Start: 12, End: 16}}},
+		nil,
+		{Name: "LUL", ID: "425618", Positions: []twitchirc.EmotePosition{{Start: 6, End: 8}}},
+	})
+
+	assert.Equal(t, []entity.ChatEmote{
+		{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Start: 0, End: 4},
+		{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Start: 12, End: 16},
+		{Provider: entity.EmoteProviderTwitch, EmoteID: "425618", Code: "LUL", Start: 6, End: 8},
+	}, got)
+}
+
+func TestRuntime_messageEmotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+
+	r := NewRuntime(Config{Repo: repo, Obs: &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}})
+
+	// Loaded once and cached for emoteSetsReloadInterval.
+	repo.EXPECT().ListScopedEmotes(gomock.Any()).Return([]entity.ScopedEmote{
+		{Provider: entity.EmoteProviderBTTV, Code: "catJAM", EmoteID: "b1"},
+	}, nil)
+
+	msg := twitchirc.PrivateMessage{
+		Message: "catJAM Kappa",
+		Emotes:  []*twitchirc.Emote{{Name: "Kappa", ID: "25", Positions: []twitchirc.EmotePosition{{Start: 7, End: 11}}}},
+	}
+
+	want := []entity.ChatEmote{
+		{Provider: entity.EmoteProviderBTTV, EmoteID: "b1", Code: "catJAM", Start: 0, End: 5},
+		{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Start: 7, End: 11},
+	}
+
+	assert.Equal(t, want, r.messageEmotes(context.Background(), "streamer", msg))
+	assert.Equal(t, want, r.messageEmotes(context.Background(), "streamer", msg))
+}
+
+func TestRuntime_messageEmotes_loadFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+
+	r := NewRuntime(Config{Repo: repo, Obs: &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}})
+
+	repo.EXPECT().ListScopedEmotes(gomock.Any()).Return(nil, errors.New("db down"))
+
+	got := r.messageEmotes(context.Background(), "streamer", twitchirc.PrivateMessage{Message: "catJAM"})
+	assert.Empty(t, got)
+}
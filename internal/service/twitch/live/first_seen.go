@@ -0,0 +1,76 @@
+package live
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// maxSeenChatters bounds the in-memory set of chatters already recorded per channel; it is cleared when full.
+const maxSeenChatters = 100_000
+
+// seenChatter is a (channel, chatter) pair whose first-seen record is already stored.
+type seenChatter struct {
+	channelID int64
+	chatterID int64
+}
+
+// recordChatterFirstSeen stores the first-seen records of a chat message and, when it is the chatter's first in the
+// channel, adds a first_seen_in_channel activity event. roomID is the channel id from the IRC room-id tag; the
+// channel login is looked up only when it is missing. Chatters already recorded since startup skip the database.
+// Failures are logged and read as "seen before".
+func (r *Runtime) recordChatterFirstSeen(ctx context.Context, channel, roomID string, chatterID int64, at time.Time) entity.ChatterFirstSeen {
+	chID, err := strconv.ParseInt(roomID, 10, 64)
+	if err != nil || chID <= 0 {
+		if chID, err = r.repo.TwitchUserIDByUsername(ctx, channel); err != nil {
+			r.obs.Logger.Debug("first seen channel lookup failed", zap.Error(err), zap.String("channel", channel))
+			return entity.ChatterFirstSeen{}
+		}
+	}
+
+	key := seenChatter{channelID: chID, chatterID: chatterID}
+	if r.chatterSeen(key) {
+		return entity.ChatterFirstSeen{}
+	}
+
+	fs, err := r.repo.RecordChatterFirstSeen(ctx, chID, chatterID, at)
+	if err != nil {
+		r.obs.Logger.Warn("record chatter first seen failed", zap.Error(err), zap.String("channel", channel))
+		return entity.ChatterFirstSeen{}
+	}
+
+	r.markChatterSeen(key)
+
+	if fs.InChannel {
+		details := map[string]any{"first_seen_global": fs.Global}
+		if err := r.repo.InsertUserActivityEvent(ctx, chatterID, entity.UserActivityFirstSeenInChannel, &chID, details); err != nil {
+			r.obs.Logger.Warn("first seen activity insert failed", zap.Error(err), zap.String("channel", channel))
+		}
+	}
+
+	return fs
+}
+
+func (r *Runtime) chatterSeen(key seenChatter) bool {
+	r.seenChattersMu.Lock()
+	defer r.seenChattersMu.Unlock()
+
+	_, ok := r.seenChatters[key]
+
+	return ok
+}
+
+func (r *Runtime) markChatterSeen(key seenChatter) {
+	r.seenChattersMu.Lock()
+	defer r.seenChattersMu.Unlock()
+
+	if len(r.seenChatters) >= maxSeenChatters {
+		clear(r.seenChatters)
+	}
+
+	r.seenChatters[key] = struct{}{}
+}
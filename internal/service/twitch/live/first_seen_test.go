@@ -0,0 +1,53 @@
+package live
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestRuntime_recordChatterFirstSeen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+
+	r := NewRuntime(Config{Repo: repo, Broadcaster: &captureBroadcaster{}, Obs: &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}})
+
+	at := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+	chID := int64(42)
+
+	// The room-id tag supplies the channel, and a repeat chatter is answered from memory.
+	repo.EXPECT().RecordChatterFirstSeen(gomock.Any(), chID, int64(7), at).Return(entity.ChatterFirstSeen{InChannel: true, Global: true}, nil)
+	repo.EXPECT().InsertUserActivityEvent(gomock.Any(), int64(7), entity.UserActivityFirstSeenInChannel, &chID, map[string]any{"first_seen_global": true}).Return(nil)
+
+	assert.Equal(t, entity.ChatterFirstSeen{InChannel: true, Global: true}, r.recordChatterFirstSeen(context.Background(), "streamer", "42", 7, at))
+	assert.Equal(t, entity.ChatterFirstSeen{}, r.recordChatterFirstSeen(context.Background(), "streamer", "42", 7, at))
+}
+
+func TestRuntime_recordChatterFirstSeen_withoutRoomID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+
+	r := NewRuntime(Config{Repo: repo, Broadcaster: &captureBroadcaster{}, Obs: &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}})
+
+	at := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "streamer").Return(int64(42), nil).Times(2)
+	gomock.InOrder(
+		repo.EXPECT().RecordChatterFirstSeen(gomock.Any(), int64(42), int64(7), at).Return(entity.ChatterFirstSeen{}, errors.New("db down")),
+		repo.EXPECT().RecordChatterFirstSeen(gomock.Any(), int64(42), int64(7), at).Return(entity.ChatterFirstSeen{}, nil),
+	)
+
+	// A failed record is not remembered, so the next message retries it.
+	assert.Equal(t, entity.ChatterFirstSeen{}, r.recordChatterFirstSeen(context.Background(), "streamer", "", 7, at))
+	assert.Equal(t, entity.ChatterFirstSeen{}, r.recordChatterFirstSeen(context.Background(), "streamer", "", 7, at))
+}
@@ -8,12 +8,10 @@ import (
 func (r *Runtime) attachIRCMonitorDebug(client *twitchirc.Client) {
 	// Do not call client.OnConnect here: the twitch-irc client keeps only one OnConnect callback,
 	// and attachIRCMonitorAppHandlers must own it to set ircMonitorTCP for GetIrcMonitorStatus.
+	// The same applies to OnClearChatMessage, which records bans and timeouts.
 	client.OnWhisperMessage(func(m twitchirc.WhisperMessage) {
 		r.obs.Logger.Debug("irc monitor: whisper", zap.String("user", m.User.Name))
 	})
-	client.OnClearChatMessage(func(m twitchirc.ClearChatMessage) {
-		r.obs.Logger.Debug("irc monitor: clear_chat", zap.String("channel", m.Channel))
-	})
 	client.OnClearMessage(func(m twitchirc.ClearMessage) {
 		r.obs.Logger.Debug("irc monitor: clear_msg", zap.String("channel", m.Channel))
 	})
@@ -2,6 +2,7 @@ package live
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"time"
 
@@ -49,6 +50,62 @@ func (r *Runtime) attachIRCMonitorAppHandlers(client *twitchirc.Client) {
 
 		go r.handleIRCChatterPresence(context.Background(), ch, u, false)
 	})
+
+	client.OnClearChatMessage(func(m twitchirc.ClearChatMessage) {
+		r.obs.Logger.Debug("irc monitor: clear_chat", zap.String("channel", m.Channel))
+
+		if m.TargetUserID == "" {
+			// Whole-chat clear, not aimed at a chatter.
+			return
+		}
+
+		go r.handleIRCModeration(context.Background(), m)
+	})
+}
+
+// handleIRCModeration records a ban (no duration) or timeout against the target chatter and
+// re-enqueues them so the moderation suspicion signal picks it up.
+func (r *Runtime) handleIRCModeration(ctx context.Context, m twitchirc.ClearChatMessage) {
+	persistCtx, cancel := context.WithTimeout(ctx, 12*time.Second)
+	defer cancel()
+
+	uid, err := strconv.ParseInt(m.TargetUserID, 10, 64)
+	if err != nil {
+		return
+	}
+
+	login := strings.ToLower(strings.TrimSpace(m.TargetUsername))
+	if login == "" {
+		return
+	}
+
+	chID, err := r.repo.TwitchUserIDByUsername(persistCtx, NormalizeTwitchChannel(m.Channel))
+	if err != nil {
+		return
+	}
+
+	if err := r.upsertChatter(persistCtx, uid, login); err != nil {
+		r.obs.Logger.Debug("irc clear_chat upsert chatter failed", zap.Error(err), zap.String("user", login))
+		return
+	}
+
+	ev := entity.UserActivityBan
+
+	var details map[string]any
+
+	if m.BanDuration > 0 {
+		ev = entity.UserActivityTimeout
+		details = map[string]any{"duration_seconds": m.BanDuration}
+	}
+
+	if err := r.repo.InsertUserActivityEvent(persistCtx, uid, ev, &chID, details); err != nil {
+		r.obs.Logger.Debug("irc clear_chat activity insert failed", zap.Error(err), zap.String("user", login))
+		return
+	}
+
+	if r.onEnqueue != nil {
+		r.onEnqueue(uid)
+	}
 }
 
 func (r *Runtime) handleIRCChatterPresence(ctx context.Context, channelLogin, userLogin string, join bool) {
@@ -76,7 +133,7 @@ func (r *Runtime) handleIRCChatterPresence(ctx context.Context, channelLogin, us
 		return
 	}
 
-	if _, err := r.repo.UpsertTwitchUserFromChat(persistCtx, uid, userLogin); err != nil {
+	if err := r.upsertChatter(persistCtx, uid, userLogin); err != nil {
 		r.obs.Logger.Debug("irc join/part upsert chatter failed", zap.Error(err), zap.String("user", userLogin))
 		return
 	}
@@ -0,0 +1,409 @@
+package live
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// chatMomentBucket is the granularity of chat velocity.
+	chatMomentBucket = 10 * time.Second
+	// chatMomentWindowBuckets is the sliding window (30s) checked for spikes, floods and clusters.
+	chatMomentWindowBuckets = 3
+	// chatMomentBaselineBuckets is the rolling baseline (5m) before the window.
+	chatMomentBaselineBuckets = 30
+	// chatMomentWarmupBuckets is how much baseline (1m) is needed before velocity spikes are detected.
+	chatMomentWarmupBuckets = 6
+	// chatMomentMinBaseline floors the baseline (messages per bucket) so near-silent chats do not spike on a handful
+	// of messages.
+	chatMomentMinBaseline = 0.5
+	// chatMomentVelocityRatio is how many times the baseline the window's rate must reach.
+	chatMomentVelocityRatio = 3.0
+	// chatMomentMinMessages is the fewest messages in the window for any moment.
+	chatMomentMinMessages = 10
+	// chatMomentMinChatters is the fewest distinct chatters behind a flood or cluster.
+	chatMomentMinChatters = 5
+	// chatMomentDominantShare is the share of messages one emote or phrase needs to be a flood or cluster.
+	chatMomentDominantShare = 0.4
+	// chatMomentQuietBuckets is how many buckets without a trigger close a moment.
+	chatMomentQuietBuckets = 2
+	// chatMomentSampleMessages is how many representative messages a moment keeps.
+	chatMomentSampleMessages = 5
+	// chatMomentMaxKept caps the messages a moment holds in memory for picking samples.
+	chatMomentMaxKept = 2000
+)
+
+type chatMomentMsg struct {
+	login  string
+	body   string
+	phrase string
+	emotes []string
+	at     time.Time
+}
+
+type chatMomentBucketMsgs struct {
+	start time.Time
+	msgs  []chatMomentMsg
+}
+
+type chatMomentState struct {
+	start, end     time.Time
+	baseline, peak float64
+	quiet          int
+	pending        []chatMomentMsg
+
+	messages       int64
+	chatters       map[string]struct{}
+	emoteMsgs      map[string]int64
+	phraseMsgs     map[string]int64
+	phraseChatters map[string]map[string]struct{}
+	kept           []chatMomentMsg
+}
+
+// chatMomentDetector finds chat moments in one channel's chat. Messages are counted in 10-second buckets; a moment
+// opens when the last 30 seconds hold at least 10 messages and either run at 3× the rolling 5-minute baseline, or
+// have one emote (flood) or one phrase (cluster) in 40% of messages from at least 5 chatters. It ends after 20
+// seconds without a trigger or without a bucket busier than the baseline. Not safe for concurrent use.
+type chatMomentDetector struct {
+	cur     chatMomentBucketMsgs
+	window  []chatMomentBucketMsgs
+	counts  []int
+	moment  *chatMomentState
+	lastEnd time.Time
+}
+
+// newChatMomentDetector returns an empty detector.
+func newChatMomentDetector() *chatMomentDetector {
+	return &chatMomentDetector{}
+}
+
+// Add records a chat message sent at `at` and returns the moments that ended before it.
+func (d *chatMomentDetector) Add(login, body string, emotes []entity.ChatEmote, at time.Time) []entity.ChatMoment {
+	out := d.Advance(at)
+
+	m := chatMomentMsg{login: login, body: body, phrase: chatMomentPhrase(body), at: at}
+
+	for _, e := range emotes {
+		if !slices.Contains(m.emotes, e.Code) {
+			m.emotes = append(m.emotes, e.Code)
+		}
+	}
+
+	d.cur.msgs = append(d.cur.msgs, m)
+
+	return out
+}
+
+// Advance closes the buckets that ended by now and returns the moments that ended with them.
+func (d *chatMomentDetector) Advance(now time.Time) []entity.ChatMoment {
+	if d.cur.start.IsZero() {
+		d.cur.start = now.Truncate(chatMomentBucket)
+		return nil
+	}
+
+	var out []entity.ChatMoment
+
+	for !now.Before(d.cur.start.Add(chatMomentBucket)) {
+		if d.moment == nil && now.Sub(d.cur.start) > chatMomentBucket*(chatMomentWindowBuckets+chatMomentBaselineBuckets) {
+			// Quiet for longer than the baseline spans: nothing to carry over.
+			*d = chatMomentDetector{cur: chatMomentBucketMsgs{start: now.Truncate(chatMomentBucket)}, lastEnd: d.lastEnd}
+			break
+		}
+
+		if m, ok := d.closeBucket(); ok {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
+
+// Idle reports whether the detector holds no state worth keeping.
+func (d *chatMomentDetector) Idle() bool {
+	return d.moment == nil && len(d.counts) == 0 && len(d.cur.msgs) == 0
+}
+
+func (d *chatMomentDetector) closeBucket() (entity.ChatMoment, bool) {
+	b := d.cur
+	d.cur = chatMomentBucketMsgs{start: b.start.Add(chatMomentBucket)}
+
+	d.window = append(d.window, b)
+	if len(d.window) > chatMomentWindowBuckets {
+		d.window = d.window[1:]
+	}
+
+	d.counts = append(d.counts, len(b.msgs))
+	if len(d.counts) > chatMomentWindowBuckets+chatMomentBaselineBuckets {
+		d.counts = d.counts[1:]
+	}
+
+	baseline := d.baseline()
+
+	var (
+		windowMsgs []chatMomentMsg
+		ratio      float64
+	)
+
+	for _, w := range d.window {
+		windowMsgs = append(windowMsgs, w.msgs...)
+	}
+
+	if baseline > 0 {
+		ratio = float64(len(windowMsgs)) / float64(len(d.window)) / baseline
+	}
+
+	// The window smooths over 30s, so a bucket only extends a moment when it is busier than the baseline itself.
+	active := chatMomentTriggered(windowMsgs, baseline, ratio) && float64(len(b.msgs)) > baseline
+
+	if d.moment == nil {
+		if !active {
+			return entity.ChatMoment{}, false
+		}
+
+		start := d.window[0].start
+		for _, w := range d.window {
+			if float64(len(w.msgs)) > baseline {
+				start = w.start
+				break
+			}
+		}
+
+		if start.Before(d.lastEnd) {
+			start = d.lastEnd
+		}
+
+		d.moment = &chatMomentState{
+			start:          start,
+			baseline:       baseline,
+			chatters:       make(map[string]struct{}),
+			emoteMsgs:      make(map[string]int64),
+			phraseMsgs:     make(map[string]int64),
+			phraseChatters: make(map[string]map[string]struct{}),
+		}
+
+		for _, w := range d.window {
+			if !w.start.Before(start) {
+				d.moment.add(w.msgs)
+			}
+		}
+
+		d.moment.end = d.cur.start
+		d.moment.peak = ratio
+
+		return entity.ChatMoment{}, false
+	}
+
+	if active {
+		d.moment.add(d.moment.pending)
+		d.moment.add(b.msgs)
+		d.moment.pending = nil
+		d.moment.quiet = 0
+		d.moment.end = d.cur.start
+		d.moment.peak = max(d.moment.peak, ratio)
+
+		return entity.ChatMoment{}, false
+	}
+
+	d.moment.quiet++
+	d.moment.pending = append(d.moment.pending, b.msgs...)
+
+	if d.moment.quiet < chatMomentQuietBuckets {
+		return entity.ChatMoment{}, false
+	}
+
+	out := d.moment.result()
+	d.lastEnd = d.moment.end
+	d.moment = nil
+
+	return out, true
+}
+
+// baseline is the mean messages per bucket before the window (frozen while a moment is open), or 0 until enough
+// history was seen.
+func (d *chatMomentDetector) baseline() float64 {
+	if d.moment != nil {
+		return d.moment.baseline
+	}
+
+	prior := d.counts[:len(d.counts)-len(d.window)]
+	if len(prior) < chatMomentWarmupBuckets {
+		return 0
+	}
+
+	var sum int
+	for _, c := range prior {
+		sum += c
+	}
+
+	return max(float64(sum)/float64(len(prior)), chatMomentMinBaseline)
+}
+
+func chatMomentTriggered(msgs []chatMomentMsg, baseline, ratio float64) bool {
+	if len(msgs) < chatMomentMinMessages {
+		return false
+	}
+
+	if baseline > 0 && ratio >= chatMomentVelocityRatio {
+		return true
+	}
+
+	// Floods and clusters of a chat that is quieter than usual are not moments.
+	if baseline > 0 && ratio < 1 {
+		return false
+	}
+
+	emoteMsgs := make(map[string]int)
+	emoteChatters := make(map[string]map[string]struct{})
+	phraseMsgs := make(map[string]int)
+	phraseChatters := make(map[string]map[string]struct{})
+
+	for _, m := range msgs {
+		for _, code := range m.emotes {
+			emoteMsgs[code]++
+			addChatMomentChatter(emoteChatters, code, m.login)
+		}
+
+		if m.phrase != "" {
+			phraseMsgs[m.phrase]++
+			addChatMomentChatter(phraseChatters, m.phrase, m.login)
+		}
+	}
+
+	dominant := func(n, chatters int) bool {
+		return float64(n) >= chatMomentDominantShare*float64(len(msgs)) && chatters >= chatMomentMinChatters
+	}
+
+	for code, n := range emoteMsgs {
+		if dominant(n, len(emoteChatters[code])) {
+			return true
+		}
+	}
+
+	for phrase, n := range phraseMsgs {
+		if dominant(n, len(phraseChatters[phrase])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func addChatMomentChatter(m map[string]map[string]struct{}, key, login string) {
+	if m[key] == nil {
+		m[key] = make(map[string]struct{})
+	}
+
+	m[key][login] = struct{}{}
+}
+
+func (s *chatMomentState) add(msgs []chatMomentMsg) {
+	for _, m := range msgs {
+		s.messages++
+		s.chatters[m.login] = struct{}{}
+
+		for _, code := range m.emotes {
+			s.emoteMsgs[code]++
+		}
+
+		if m.phrase != "" {
+			s.phraseMsgs[m.phrase]++
+			addChatMomentChatter(s.phraseChatters, m.phrase, m.login)
+		}
+
+		if len(s.kept) < chatMomentMaxKept {
+			s.kept = append(s.kept, m)
+		}
+	}
+}
+
+func (s *chatMomentState) result() entity.ChatMoment {
+	out := entity.ChatMoment{
+		Kind:      entity.ChatMomentKindVelocity,
+		StartedAt: s.start,
+		EndedAt:   s.end,
+		Messages:  s.messages,
+		Chatters:  int64(len(s.chatters)),
+		Intensity: math.Round(s.peak*100) / 100,
+	}
+
+	share := chatMomentDominantShare * float64(s.messages)
+
+	if code, n := topChatMomentKey(s.emoteMsgs); n > 0 && float64(n) >= share {
+		out.Kind, out.Label = entity.ChatMomentKindEmoteFlood, code
+	} else if phrase, n := topChatMomentKey(s.phraseMsgs); n > 0 && float64(n) >= share &&
+		len(s.phraseChatters[phrase]) >= chatMomentMinChatters {
+		out.Kind, out.Label = entity.ChatMomentKindPhrase, phrase
+	}
+
+	// One sample per distinct phrase, most repeated first.
+	type group struct {
+		first chatMomentMsg
+		n     int
+	}
+
+	var groups []*group
+
+	byPhrase := make(map[string]*group)
+
+	for _, m := range s.kept {
+		if g, ok := byPhrase[m.phrase]; ok {
+			g.n++
+			continue
+		}
+
+		g := &group{first: m, n: 1}
+		byPhrase[m.phrase] = g
+		groups = append(groups, g)
+	}
+
+	slices.SortStableFunc(groups, func(a, b *group) int { return cmp.Compare(b.n, a.n) })
+
+	for _, g := range groups[:min(len(groups), chatMomentSampleMessages)] {
+		out.SampleMessages = append(out.SampleMessages, entity.ChatMomentMessage{
+			Login:     g.first.login,
+			Body:      g.first.body,
+			CreatedAt: g.first.at,
+		})
+	}
+
+	return out
+}
+
+// topChatMomentKey returns the most counted key (the smallest on ties).
+func topChatMomentKey(counts map[string]int64) (string, int64) {
+	var (
+		top string
+		n   int64
+	)
+
+	for k, c := range counts {
+		if c > n || (c == n && k < top) {
+			top, n = k, c
+		}
+	}
+
+	return top, n
+}
+
+// chatMomentPhrase normalizes a message for phrase clustering: lowercased words stripped of surrounding punctuation,
+// with repeats in a row collapsed ("W W W!" and "w" are the same phrase).
+func chatMomentPhrase(body string) string {
+	var words []string
+
+	for _, raw := range strings.Fields(body) {
+		w := strings.ToLower(strings.TrimFunc(raw, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) }))
+		if w == "" || (len(words) > 0 && words[len(words)-1] == w) {
+			continue
+		}
+
+		words = append(words, w)
+	}
+
+	return strings.Join(words, " ")
+}
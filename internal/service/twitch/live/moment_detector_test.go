@@ -0,0 +1,130 @@
+package live
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+var chatMomentT0 = time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+
+// feedChatMoment sends n messages spread over bucket i (10s buckets from chatMomentT0).
+func feedChatMoment(d *chatMomentDetector, i, n int, msg func(j int) (string, string, []entity.ChatEmote)) []entity.ChatMoment {
+	var out []entity.ChatMoment
+
+	for j := range n {
+		login, body, emotes := msg(j)
+		at := chatMomentT0.Add(time.Duration(i)*chatMomentBucket + time.Duration(j)*chatMomentBucket/time.Duration(n))
+		out = append(out, d.Add(login, body, emotes, at)...)
+	}
+
+	return out
+}
+
+func chatMomentChatter(bucket int) func(j int) (string, string, []entity.ChatEmote) {
+	return func(j int) (string, string, []entity.ChatEmote) {
+		return fmt.Sprintf("user%d", j), fmt.Sprintf("message %d-%d", bucket, j), nil
+	}
+}
+
+func TestChatMomentDetector_velocity(t *testing.T) {
+	d := newChatMomentDetector()
+
+	var got []entity.ChatMoment
+
+	for i := range 12 {
+		got = append(got, feedChatMoment(d, i, 2, chatMomentChatter(i))...)
+	}
+
+	for i := 12; i < 15; i++ {
+		got = append(got, feedChatMoment(d, i, 20, chatMomentChatter(i))...)
+	}
+
+	for i := 15; i < 20; i++ {
+		got = append(got, feedChatMoment(d, i, 2, chatMomentChatter(i))...)
+	}
+
+	require.Len(t, got, 1)
+	assert.Equal(t, entity.ChatMomentKindVelocity, got[0].Kind)
+	assert.Empty(t, got[0].Label)
+	assert.Equal(t, chatMomentT0.Add(12*chatMomentBucket), got[0].StartedAt)
+	assert.Equal(t, chatMomentT0.Add(15*chatMomentBucket), got[0].EndedAt)
+	assert.Equal(t, int64(60), got[0].Messages)
+	assert.Equal(t, int64(20), got[0].Chatters)
+	assert.InDelta(t, 10.0, got[0].Intensity, 0.001)
+	assert.Len(t, got[0].SampleMessages, chatMomentSampleMessages)
+}
+
+func TestChatMomentDetector_emoteFlood(t *testing.T) {
+	d := newChatMomentDetector()
+
+	var got []entity.ChatMoment
+
+	for i := range 12 {
+		got = append(got, feedChatMoment(d, i, 2, chatMomentChatter(i))...)
+	}
+
+	for i := 12; i < 15; i++ {
+		got = append(got, feedChatMoment(d, i, 5, func(j int) (string, string, []entity.ChatEmote) {
+			return fmt.Sprintf("user%d", j), "Kappa Kappa", []entity.ChatEmote{{Code: "Kappa", Start: 0, End: 4}, {Code: "Kappa", Start: 6, End: 10}}
+		})...)
+	}
+
+	// Quiet buckets still close the moment without new messages.
+	got = append(got, d.Advance(chatMomentT0.Add(20*chatMomentBucket))...)
+
+	require.Len(t, got, 1)
+	assert.Equal(t, entity.ChatMomentKindEmoteFlood, got[0].Kind)
+	assert.Equal(t, "Kappa", got[0].Label)
+	assert.Equal(t, chatMomentT0.Add(12*chatMomentBucket), got[0].StartedAt)
+	assert.Equal(t, int64(15), got[0].Messages)
+	assert.InDelta(t, 2.5, got[0].Intensity, 0.001)
+	require.Len(t, got[0].SampleMessages, 1)
+	assert.Equal(t, "Kappa Kappa", got[0].SampleMessages[0].Body)
+}
+
+func TestChatMomentDetector_phraseBeforeWarmup(t *testing.T) {
+	d := newChatMomentDetector()
+
+	got := feedChatMoment(d, 0, 12, func(j int) (string, string, []entity.ChatEmote) {
+		if j%4 == 3 {
+			return fmt.Sprintf("user%d", j), "what happened", nil
+		}
+
+		return fmt.Sprintf("user%d", j), "W W!", nil
+	})
+	got = append(got, d.Advance(chatMomentT0.Add(5*chatMomentBucket))...)
+
+	require.Len(t, got, 1)
+	assert.Equal(t, entity.ChatMomentKindPhrase, got[0].Kind)
+	assert.Equal(t, "w", got[0].Label)
+	assert.Equal(t, chatMomentT0, got[0].StartedAt)
+	assert.Equal(t, chatMomentT0.Add(chatMomentBucket), got[0].EndedAt)
+	assert.Zero(t, got[0].Intensity)
+	require.Len(t, got[0].SampleMessages, 2)
+	assert.Equal(t, "W W!", got[0].SampleMessages[0].Body)
+	assert.Equal(t, "what happened", got[0].SampleMessages[1].Body)
+}
+
+func TestChatMomentDetector_steadyChatIdles(t *testing.T) {
+	d := newChatMomentDetector()
+
+	for i := range 40 {
+		assert.Empty(t, feedChatMoment(d, i, 3, chatMomentChatter(i)))
+	}
+
+	assert.False(t, d.Idle())
+	assert.Empty(t, d.Advance(chatMomentT0.Add(2*time.Hour)))
+	assert.True(t, d.Idle())
+}
+
+func TestChatMomentPhrase(t *testing.T) {
+	assert.Equal(t, "w", chatMomentPhrase("W w W!!"))
+	assert.Equal(t, "let's go", chatMomentPhrase("  LET'S   go  "))
+	assert.Empty(t, chatMomentPhrase("!!! ?"))
+}
@@ -0,0 +1,121 @@
+package live
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// chatMomentTickInterval closes quiet chat buckets so moments end even when chat stops.
+const chatMomentTickInterval = 10 * time.Second
+
+// observeChatMoment feeds a chat message to its channel's moment detector and records the moments it ends.
+func (r *Runtime) observeChatMoment(channel, login, body string, emotes []entity.ChatEmote, at time.Time) {
+	r.momentsMu.Lock()
+
+	d := r.moments[channel]
+	if d == nil {
+		d = newChatMomentDetector()
+		r.moments[channel] = d
+	}
+
+	ended := d.Add(login, body, emotes, at)
+	r.momentsMu.Unlock()
+
+	for _, m := range ended {
+		r.recordChatMoment(channel, m)
+	}
+}
+
+func (r *Runtime) runChatMomentLoop(ctx context.Context) {
+	t := time.NewTicker(chatMomentTickInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-t.C:
+			r.advanceChatMoments(now.UTC())
+		}
+	}
+}
+
+// advanceChatMoments closes elapsed buckets of every channel, drops idle detectors and records ended moments.
+func (r *Runtime) advanceChatMoments(now time.Time) {
+	ended := make(map[string][]entity.ChatMoment)
+
+	r.momentsMu.Lock()
+
+	for ch, d := range r.moments {
+		if ms := d.Advance(now); len(ms) > 0 {
+			ended[ch] = ms
+		}
+
+		if d.Idle() {
+			delete(r.moments, ch)
+		}
+	}
+
+	r.momentsMu.Unlock()
+
+	for ch, ms := range ended {
+		for _, m := range ms {
+			r.recordChatMoment(ch, m)
+		}
+	}
+}
+
+// recordChatMoment stores a moment against the channel's live stream and pushes it to /ws clients. Moments while
+// no stream is recorded are dropped.
+func (r *Runtime) recordChatMoment(channel string, m entity.ChatMoment) {
+	ctx, cancel := context.WithTimeout(r.persistContext(), 10*time.Second)
+	defer cancel()
+
+	channelID, err := r.repo.TwitchUserIDByUsername(ctx, channel)
+	if err != nil {
+		r.obs.Logger.Debug("chat moment: resolve channel failed", zap.Error(err), zap.String("channel", channel))
+		return
+	}
+
+	streamID, err := r.repo.ActiveStreamIDForChannel(ctx, channelID)
+	if err != nil || streamID == nil {
+		return
+	}
+
+	m.StreamID = *streamID
+
+	m, err = r.repo.InsertChatMoment(ctx, m)
+	if err != nil {
+		r.obs.Logger.Warn("persist chat moment failed", zap.Error(err), zap.String("channel", channel))
+		return
+	}
+
+	if r.broadcaster == nil {
+		return
+	}
+
+	samples := m.SampleMessages
+	if samples == nil {
+		samples = []entity.ChatMomentMessage{}
+	}
+
+	r.broadcaster.BroadcastJSON(map[string]any{
+		"type":            "chat_moment",
+		"channel":         channel,
+		"id":              m.ID,
+		"stream_id":       m.StreamID,
+		"kind":            m.Kind,
+		"label":           m.Label,
+		"started_at":      m.StartedAt.Format(time.RFC3339Nano),
+		"ended_at":        m.EndedAt.Format(time.RFC3339Nano),
+		"offset_seconds":  m.OffsetSeconds,
+		"messages":        m.Messages,
+		"chatters":        m.Chatters,
+		"intensity":       m.Intensity,
+		"sample_messages": samples,
+	})
+}
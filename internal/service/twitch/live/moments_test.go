@@ -0,0 +1,91 @@
+package live
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+type captureBroadcaster struct {
+	mu   sync.Mutex
+	msgs []map[string]any
+}
+
+func (b *captureBroadcaster) BroadcastJSON(v any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.msgs = append(b.msgs, v.(map[string]any))
+}
+
+// floodChatMoment sends a 12-chatter "W" cluster to channel at t0 so the next advance ends a phrase moment.
+func floodChatMoment(r *Runtime, channel string, t0 time.Time) {
+	for i := range 12 {
+		r.observeChatMoment(channel, fmt.Sprintf("user%d", i), "W", nil, t0.Add(time.Duration(i)*100*time.Millisecond))
+	}
+}
+
+func TestRuntime_chatMoments_recordsAndBroadcasts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	bc := &captureBroadcaster{}
+
+	r := NewRuntime(Config{Repo: repo, Broadcaster: bc, Obs: &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}})
+
+	t0 := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+	streamID := int64(7)
+
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "streamer").Return(int64(42), nil)
+	repo.EXPECT().ActiveStreamIDForChannel(gomock.Any(), int64(42)).Return(&streamID, nil)
+	repo.EXPECT().InsertChatMoment(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, m entity.ChatMoment) (entity.ChatMoment, error) {
+		assert.Equal(t, streamID, m.StreamID)
+		assert.Equal(t, entity.ChatMomentKindPhrase, m.Kind)
+
+		m.ID = 3
+		m.OffsetSeconds = 600
+
+		return m, nil
+	})
+
+	floodChatMoment(r, "streamer", t0)
+	r.advanceChatMoments(t0.Add(time.Minute))
+
+	require.Len(t, bc.msgs, 1)
+	assert.Equal(t, "chat_moment", bc.msgs[0]["type"])
+	assert.Equal(t, "streamer", bc.msgs[0]["channel"])
+	assert.Equal(t, int64(3), bc.msgs[0]["id"])
+	assert.Equal(t, "w", bc.msgs[0]["label"])
+	assert.Equal(t, int64(600), bc.msgs[0]["offset_seconds"])
+}
+
+func TestRuntime_chatMoments_droppedWhenOffline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	bc := &captureBroadcaster{}
+
+	r := NewRuntime(Config{Repo: repo, Broadcaster: bc, Obs: &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}})
+
+	t0 := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "streamer").Return(int64(42), nil)
+	repo.EXPECT().ActiveStreamIDForChannel(gomock.Any(), int64(42)).Return(nil, nil)
+
+	floodChatMoment(r, "streamer", t0)
+	r.advanceChatMoments(t0.Add(time.Minute))
+	assert.Empty(t, bc.msgs)
+
+	// Long quiet chat drops the detector.
+	r.advanceChatMoments(t0.Add(time.Hour))
+	assert.Empty(t, r.moments)
+}
@@ -104,8 +104,7 @@ func (r *Runtime) wirePrivateMessageHandlers(client *twitchirc.Client) {
 		var chatterID *int64
 
 		if tid, err := strconv.ParseInt(msg.User.ID, 10, 64); err == nil && tid > 0 {
-			_, err := r.repo.UpsertTwitchUserFromChat(persistCtx, tid, chatterLogin)
-			if err != nil {
+			if err := r.upsertChatter(persistCtx, tid, chatterLogin); err != nil {
 				r.obs.Logger.Warn("upsert chatter from irc failed", zap.Error(err), zap.String("channel", ch))
 			} else {
 				chatterID = &tid
@@ -115,16 +114,28 @@ func (r *Runtime) wirePrivateMessageHandlers(client *twitchirc.Client) {
 			}
 		}
 
+		var firstSeen entity.ChatterFirstSeen
+
+		if chatterID != nil {
+			firstSeen = r.recordChatterFirstSeen(persistCtx, ch, msg.RoomID, *chatterID, ts)
+		}
+
 		keyword := false
 
 		if re := r.ruleEng(); re != nil {
-			keyword = re.KeywordMatchChat(persistCtx, ch, chatterLogin, msg.Message)
-			re.HandleChatMessage(ch, chatterLogin, msg.Message)
+			keyword = re.KeywordMatchChat(persistCtx, ch, chatterLogin, msg.Message, firstSeen.InChannel)
+			re.HandleChatMessage(ch, chatterLogin, msg.Message, firstSeen.InChannel)
 		}
 
-		_, err := r.repo.InsertChatMessageForChannelLogin(persistCtx, ch, chatterID, chatterLogin, msg.Message, keyword, "irc", badgeTags, msg.FirstMessage)
+		emotes := r.messageEmotes(persistCtx, ch, msg)
+
+		msgID, err := r.repo.InsertChatMessageForChannelLogin(persistCtx, ch, chatterID, chatterLogin, msg.Message, keyword, "irc", badgeTags, msg.FirstMessage)
 		if err != nil {
 			r.obs.Logger.Warn("persist chat message failed", zap.Error(err), zap.String("channel", ch))
+		} else if len(emotes) > 0 {
+			if err := r.repo.InsertChatMessageEmotes(persistCtx, msgID, emotes); err != nil {
+				r.obs.Logger.Warn("persist chat message emotes failed", zap.Error(err), zap.String("channel", ch))
+			}
 		}
 
 		var chatterMarked bool
@@ -141,22 +152,26 @@ func (r *Runtime) wirePrivateMessageHandlers(client *twitchirc.Client) {
 		}
 
 		wsPayload := map[string]any{
-			"type":           "chat_message",
-			"channel":        ch,
-			"user":           chatterLogin,
-			"message":        msg.Message,
-			"keyword_match":  keyword,
-			"chatter_marked": chatterMarked,
-			"chatter_is_sus": chatterIsSus,
-			"first_message":  msg.FirstMessage,
-			"badge_tags":     badgeTags,
-			"created_at":     ts.Format(time.RFC3339Nano),
+			"type":                  "chat_message",
+			"channel":               ch,
+			"user":                  chatterLogin,
+			"message":               msg.Message,
+			"keyword_match":         keyword,
+			"chatter_marked":        chatterMarked,
+			"chatter_is_sus":        chatterIsSus,
+			"first_message":         msg.FirstMessage,
+			"first_seen_in_channel": firstSeen.InChannel,
+			"badge_tags":            badgeTags,
+			"emotes":                emotes,
+			"created_at":            ts.Format(time.RFC3339Nano),
 		}
 		if chatterID != nil {
 			wsPayload["user_twitch_id"] = *chatterID
 		}
 
 		r.broadcaster.BroadcastJSON(wsPayload)
+
+		r.observeChatMoment(ch, chatterLogin, msg.Message, emotes, ts)
 	})
 }
 
@@ -217,6 +232,14 @@ func (r *Runtime) StartMonitor(ctx context.Context) error {
 		r.runJoinReconcileLoop(loopCtx)
 	}()
 
+	r.monitorLoopsWG.Add(1)
+
+	go func() {
+		defer r.monitorLoopsWG.Done()
+
+		r.runChatMomentLoop(loopCtx)
+	}()
+
 	if useOAuthSync {
 		r.monitorLoopsWG.Add(1)
 
@@ -131,7 +131,7 @@ func (r *Runtime) snapshotChannelPresence(ctx context.Context, client *twitchirc
 		}
 
 		// channel_chatters and activity rows FK to twitch_users; Helix IDs are not inserted elsewhere for NAMES-only users.
-		if _, err := r.repo.UpsertTwitchUserFromChat(ctx, id, ln); err != nil {
+		if err := r.upsertChatter(ctx, id, ln); err != nil {
 			return err
 		}
 
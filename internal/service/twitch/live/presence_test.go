@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	twitchirc "github.com/gempir/go-twitch-irc/v4"
 	"github.com/rofleksey/dredge/internal/entity"
 	"github.com/rofleksey/dredge/internal/observability"
 	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
@@ -97,3 +98,37 @@ func TestEmitPresenceDiffEvents_emitsJoinAndLeaveEdges(t *testing.T) {
 
 	r.emitPresenceDiffEvents(context.Background(), 77, prevSet, currSet)
 }
+
+func TestHandleIRCModeration_recordsBanAndTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+
+	var enqueued []int64
+
+	r := NewRuntime(Config{
+		Repo:          repo,
+		Obs:           obs,
+		OnEnqueueUser: func(id int64) { enqueued = append(enqueued, id) },
+	})
+
+	chID := int64(77)
+
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "alpha").Return(chID, nil).Times(2)
+	repo.EXPECT().UpsertTwitchUserFromChat(gomock.Any(), int64(101), "spammer").Return(false, nil).Times(2)
+	repo.EXPECT().InsertUserActivityEvent(gomock.Any(), int64(101), entity.UserActivityBan, &chID, nil).Return(nil)
+	repo.EXPECT().InsertUserActivityEvent(gomock.Any(), int64(101), entity.UserActivityTimeout, &chID,
+		map[string]any{"duration_seconds": 600}).Return(nil)
+
+	r.handleIRCModeration(context.Background(), twitchirc.ClearChatMessage{Channel: "Alpha", TargetUserID: "101", TargetUsername: "Spammer"})
+	r.handleIRCModeration(context.Background(), twitchirc.ClearChatMessage{Channel: "alpha", TargetUserID: "101", TargetUsername: "spammer", BanDuration: 600})
+	r.handleIRCModeration(context.Background(), twitchirc.ClearChatMessage{Channel: "alpha", TargetUserID: "nope", TargetUsername: "x"})
+
+	if len(enqueued) != 2 {
+		t.Fatalf("enqueued = %v, want two enrichment requests", enqueued)
+	}
+}
@@ -18,13 +18,14 @@ type streamLiveEdge struct {
 	wasLive     bool
 }
 
-// Runtime owns the IRC monitor connection, presence polling, and notification dispatch.
+// Runtime owns the IRC monitor connection and presence polling.
 type Runtime struct {
 	helix                     *helix.Client
 	repo                      repository.Store
 	obs                       *observability.Stack
 	broadcaster               interface{ BroadcastJSON(v any) }
 	onEnqueue                 func(int64)
+	onNewUser                 func(int64)
 	persistParent             func() context.Context
 	channelChattersSyncPeriod time.Duration
 	joinReconcileInterval     time.Duration
@@ -46,10 +47,18 @@ type Runtime struct {
 	monitorLoopsCancel context.CancelFunc
 	monitorLoopsWG     sync.WaitGroup
 
-	notifySem chan struct{}
-
 	ruleEngineMu sync.RWMutex
 	ruleEngine   RuleEngine
+
+	emoteMu           sync.Mutex
+	emoteSets         *emoteSetMatcher
+	emoteSetsLoadedAt time.Time
+
+	momentsMu sync.Mutex
+	moments   map[string]*chatMomentDetector
+
+	seenChattersMu sync.Mutex
+	seenChatters   map[seenChatter]struct{}
 }
 
 // NewRuntime constructs runtime state for IRC-backed features.
@@ -75,14 +84,30 @@ func NewRuntime(cfg Config) *Runtime {
 		obs:                       cfg.Obs,
 		broadcaster:               cfg.Broadcaster,
 		onEnqueue:                 cfg.OnEnqueueUser,
+		onNewUser:                 cfg.OnNewUser,
 		persistParent:             cfg.PersistContext,
 		channelChattersSyncPeriod: period,
 		joinReconcileInterval:     joinInt,
 		oauthTokenSyncInterval:    oauthInt,
 		reconcilerJoined:          make(map[string]bool),
 		streamEdge:                make(map[int64]streamLiveEdge),
-		notifySem:                 make(chan struct{}, 8),
+		moments:                   make(map[string]*chatMomentDetector),
+		seenChatters:              make(map[seenChatter]struct{}),
+	}
+}
+
+// upsertChatter ensures a twitch_users row for a chatter and reports first inserts to OnNewUser.
+func (r *Runtime) upsertChatter(ctx context.Context, id int64, login string) error {
+	inserted, err := r.repo.UpsertTwitchUserFromChat(ctx, id, login)
+	if err != nil {
+		return err
 	}
+
+	if inserted && r.onNewUser != nil {
+		r.onNewUser(id)
+	}
+
+	return nil
 }
 
 func (r *Runtime) persistContext() context.Context {
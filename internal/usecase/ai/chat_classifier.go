@@ -0,0 +1,122 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// chatClassifierTokensPerMessage bounds the completion size of a batch (one short JSON object per message).
+	chatClassifierTokensPerMessage = 40
+	chatClassifierPrompt           = `You label Twitch chat messages for moderators. The input is a JSON array of ` +
+		`messages with id, channel, user and text. Reply with only a JSON object {"labels": [...]} holding one ` +
+		`{"id", "toxicity", "harassment", "sentiment"} object per message: toxicity and harassment are 0 to 1 ` +
+		`(harassment means targeting a person), sentiment is -1 (negative) to 1 (positive). Emotes, slang and ` +
+		`banter between friends are not toxic by themselves.`
+)
+
+type chatClassifierInput struct {
+	ID      int64  `json:"id"`
+	Channel string `json:"channel"`
+	User    string `json:"user"`
+	Text    string `json:"text"`
+}
+
+type chatClassifierOutput struct {
+	Labels []struct {
+		ID         int64   `json:"id"`
+		Toxicity   float64 `json:"toxicity"`
+		Harassment float64 `json:"harassment"`
+		Sentiment  float64 `json:"sentiment"`
+	} `json:"labels"`
+}
+
+// ClassifyChatMessages asks the configured model to label a batch of chat messages and returns the labels of the
+// messages it answered for, with the tokens the call used. It returns entity.ErrAINotConfigured without calling
+// anything when the AI base URL or token is not configured.
+func (u *Usecase) ClassifyChatMessages(ctx context.Context, msgs []entity.ClassifiableChatMessage) ([]entity.ChatMessageLabels, int64, error) {
+	client, model, err := u.completionClient(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	in := make([]chatClassifierInput, len(msgs))
+	for i, m := range msgs {
+		in[i] = chatClassifierInput{ID: m.ID, Channel: m.Channel, User: m.Username, Text: m.Text}
+	}
+
+	input, err := json.Marshal(in)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: chatClassifierPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: string(input)},
+		},
+		MaxTokens:      chatClassifierTokensPerMessage*len(msgs) + 50,
+		ResponseFormat: &openai.ChatCompletionResponseFormat{Type: openai.ChatCompletionResponseFormatTypeJSONObject},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tokens := int64(resp.Usage.TotalTokens)
+
+	if len(resp.Choices) == 0 {
+		return nil, tokens, errors.New("chat classifier: empty completion")
+	}
+
+	labels, err := parseChatLabels(resp.Choices[0].Message.Content, msgs)
+	if err != nil {
+		return nil, tokens, err
+	}
+
+	return labels, tokens, nil
+}
+
+// parseChatLabels decodes a classifier reply (tolerating a markdown code fence), keeps one label per message of the
+// batch and clamps scores to their ranges.
+func parseChatLabels(content string, msgs []entity.ClassifiableChatMessage) ([]entity.ChatMessageLabels, error) {
+	content = strings.TrimSpace(content)
+	content = strings.TrimPrefix(content, "```json")
+	content = strings.TrimPrefix(content, "```")
+	content = strings.TrimSuffix(content, "```")
+
+	var out chatClassifierOutput
+	if err := json.Unmarshal([]byte(content), &out); err != nil {
+		return nil, fmt.Errorf("chat classifier: decode reply: %w", err)
+	}
+
+	pending := make(map[int64]bool, len(msgs))
+	for _, m := range msgs {
+		pending[m.ID] = true
+	}
+
+	labels := make([]entity.ChatMessageLabels, 0, len(out.Labels))
+
+	for _, l := range out.Labels {
+		if !pending[l.ID] {
+			continue
+		}
+
+		pending[l.ID] = false
+		labels = append(labels, entity.ChatMessageLabels{
+			MessageID:  l.ID,
+			Toxicity:   min(max(l.Toxicity, 0), 1),
+			Harassment: min(max(l.Harassment, 0), 1),
+			Sentiment:  min(max(l.Sentiment, -1), 1),
+		})
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,96 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+// fakeCompletionServer answers every chat completion with reply and counts 120 tokens.
+func fakeCompletionServer(t *testing.T, reply string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer tok", r.Header.Get("Authorization"))
+
+		var req openai.ChatCompletionRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "judge", req.Model)
+		assert.Contains(t, req.Messages[1].Content, `"text":"you are awful"`)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{{Message: openai.ChatCompletionMessage{Role: openai.ChatMessageRoleAssistant, Content: reply}}},
+			Usage:   openai.Usage{TotalTokens: 120},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestUsecase_ClassifyChatMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	u := New(repo, nil, nil, nil, nil, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	srv := fakeCompletionServer(t, "```json\n"+`{"labels": [
+		{"id": 1, "toxicity": 0.9, "harassment": 1.4, "sentiment": -0.8},
+		{"id": 2, "toxicity": 0, "harassment": 0, "sentiment": 0.7},
+		{"id": 99, "toxicity": 1, "harassment": 1, "sentiment": -1},
+		{"id": 1, "toxicity": 0, "harassment": 0, "sentiment": 1}
+	]}`+"\n```")
+
+	repo.EXPECT().GetAISettings(gomock.Any()).Return(entity.AISettings{BaseURL: srv.URL + "/v1/", Model: "judge", APIToken: "tok"}, nil)
+
+	labels, tokens, err := u.ClassifyChatMessages(context.Background(), []entity.ClassifiableChatMessage{
+		{ID: 1, Channel: "streamer", Username: "troll", Text: "you are awful"},
+		{ID: 2, Channel: "streamer", Username: "fan", Text: "great play"},
+		{ID: 3, Channel: "streamer", Username: "lurker", Text: "hi"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(120), tokens)
+	assert.Equal(t, []entity.ChatMessageLabels{
+		{MessageID: 1, Toxicity: 0.9, Harassment: 1, Sentiment: -0.8},
+		{MessageID: 2, Sentiment: 0.7},
+	}, labels)
+}
+
+func TestUsecase_ClassifyChatMessages_notConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	u := New(repo, nil, nil, nil, nil, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	repo.EXPECT().GetAISettings(gomock.Any()).Return(entity.AISettings{Model: "judge"}, nil)
+
+	_, _, err := u.ClassifyChatMessages(context.Background(), []entity.ClassifiableChatMessage{{ID: 1, Text: "hi"}})
+	require.ErrorIs(t, err, entity.ErrAINotConfigured)
+}
+
+func TestUsecase_ClassifyChatMessages_badReply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	u := New(repo, nil, nil, nil, nil, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	srv := fakeCompletionServer(t, "I cannot help with that.")
+
+	repo.EXPECT().GetAISettings(gomock.Any()).Return(entity.AISettings{BaseURL: srv.URL + "/v1", Model: "judge", APIToken: "tok"}, nil)
+
+	_, tokens, err := u.ClassifyChatMessages(context.Background(), []entity.ClassifiableChatMessage{{ID: 1, Text: "you are awful"}})
+	require.Error(t, err)
+	assert.Equal(t, int64(120), tokens)
+}
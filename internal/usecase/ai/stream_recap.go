@@ -0,0 +1,60 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	streamRecapMaxTokens = 300
+	streamRecapPrompt    = `You write recaps of finished Twitch streams for the channel's moderators. Given the stream's ` +
+		`statistics as JSON, write 2-4 plain sentences (no markdown, no lists) on how the stream went: activity, ` +
+		`notable chatters, suspicious users and rule triggers worth a look. Do not repeat every number.`
+)
+
+// SummarizeStreamRecap asks the configured model for a short summary of a finished stream's recap. It returns ""
+// without calling anything when the AI base URL or token is not configured.
+func (u *Usecase) SummarizeStreamRecap(ctx context.Context, st entity.Stream, recap entity.StreamRecap) (string, error) {
+	client, model, err := u.completionClient(ctx)
+	if err != nil {
+		if errors.Is(err, entity.ErrAINotConfigured) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	input, err := json.Marshal(map[string]any{
+		"channel": st.ChannelLogin,
+		"title":   st.Title,
+		"game":    st.GameName,
+		"recap":   recap,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: streamRecapPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: string(input)},
+		},
+		MaxTokens: streamRecapMaxTokens,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", errors.New("stream recap summary: empty completion")
+	}
+
+	return strings.TrimSpace(resp.Choices[0].Message.Content), nil
+}
@@ -37,6 +37,8 @@ const (
 	ToolListStreamMessages           = "list_stream_messages"
 	ToolListStreamActivity           = "list_stream_activity"
 	ToolGetStreamLeaderboard         = "get_stream_leaderboard"
+	ToolGetTwitchUserActivityHeatmap = "get_twitch_user_activity_heatmap"
+	ToolGetChannelActivityHeatmap    = "get_channel_activity_heatmap"
 	ToolGetTwitchUser                = "get_twitch_user"
 	ToolCountRules                   = "count_rules"
 	ToolCreateNotification           = "create_notification"
@@ -79,6 +81,8 @@ var readOnlyTools = map[string]struct{}{
 	ToolListStreamMessages:            {},
 	ToolListStreamActivity:            {},
 	ToolGetStreamLeaderboard:          {},
+	ToolGetTwitchUserActivityHeatmap:  {},
+	ToolGetChannelActivityHeatmap:     {},
 	ToolGetTwitchUser:                 {},
 	ToolCountRules:                    {},
 }
@@ -96,6 +100,20 @@ func LLMTools() []openai.Tool {
 	boolSchema := jsonschema.Boolean
 	obj := jsonschema.Object
 
+	notificationPolicySchema := jsonschema.Definition{
+		Type:        obj,
+		Description: "Quiet hours and flood control; suppressed events are still recorded in the delivery log",
+		Properties: map[string]jsonschema.Definition{
+			"quiet_hours": {Type: obj, Description: "daily window with no deliveries", Properties: map[string]jsonschema.Definition{
+				"start":    {Type: str, Description: "HH:MM"},
+				"end":      {Type: str, Description: "HH:MM; before start wraps past midnight"},
+				"timezone": {Type: str, Description: "IANA zone, default UTC"},
+			}},
+			"flood_threshold":      {Type: integer, Description: "events per channel per window before the rest collapse into one summary; 0 = off"},
+			"flood_window_seconds": {Type: integer, Description: "default 60"},
+		},
+	}
+
 	return []openai.Tool{
 		toolFn(ToolListTwitchMessages, "Search persisted chat messages (newest first).", jsonschema.Definition{
 			Type: obj,
@@ -247,13 +265,33 @@ func LLMTools() []openai.Tool {
 			},
 			Required: []string{"stream_id"},
 		}),
+		toolFn(ToolGetTwitchUserActivityHeatmap, "When a user is usually online and chatting: presence seconds (summed over monitored channels) and messages per local weekday (Monday first) and hour. Ranges up to 92 days.", jsonschema.Definition{
+			Type: obj,
+			Properties: map[string]jsonschema.Definition{
+				"id":       {Type: integer},
+				"from":     {Type: str, Description: "RFC3339, optional, default to minus 28 days"},
+				"to":       {Type: str, Description: "RFC3339, optional, default now"},
+				"timezone": {Type: str, Description: "IANA zone, default UTC"},
+			},
+			Required: []string{"id"},
+		}),
+		toolFn(ToolGetChannelActivityHeatmap, "When a monitored channel's chat is busiest: chatters' summed presence seconds and messages per local weekday (Monday first) and hour. Ranges up to 92 days.", jsonschema.Definition{
+			Type: obj,
+			Properties: map[string]jsonschema.Definition{
+				"login":    {Type: str, Description: "Channel login"},
+				"from":     {Type: str, Description: "RFC3339, optional, default to minus 28 days"},
+				"to":       {Type: str, Description: "RFC3339, optional, default now"},
+				"timezone": {Type: str, Description: "IANA zone, default UTC"},
+			},
+			Required: []string{"login"},
+		}),
 		toolFn(ToolGetTwitchUser, "Load twitch_users row by Twitch user id.", jsonschema.Definition{
 			Type:       obj,
 			Properties: map[string]jsonschema.Definition{"id": {Type: integer}},
 			Required:   []string{"id"},
 		}),
 		toolFn(ToolCountRules, "Count automation rules.", jsonschema.Definition{Type: obj, Properties: map[string]jsonschema.Definition{}}),
-		toolFn(ToolCreateRule, "Create a new rule (requires user approval). event_type: chat_message | stream_start | stream_end | interval. action_type: notify | send_chat. middleware type: filter_channel | filter_user | match_regex | contains_word | cooldown. Use list_rules and rule_template_variables before editing.", jsonschema.Definition{
+		toolFn(ToolCreateRule, "Create a new rule (requires user approval). event_type: chat_message | stream_start | stream_end | interval. action_type: notify | send_chat | mark_sus (chat_message only). middleware type: filter_channel | filter_user | match_regex | contains_word | cooldown | first_seen_in_channel (settings.returning=true keeps only chatters seen in the channel before) | classify (settings.label toxicity|harassment|sentiment with optional min/max, checked against the chatter's latest AI-classified message in the channel; pass_unclassified). Use list_rules and rule_template_variables before editing.", jsonschema.Definition{
 			Type: obj,
 			Properties: map[string]jsonschema.Definition{
 				"name":            {Type: str},
@@ -261,7 +299,7 @@ func LLMTools() []openai.Tool {
 				"event_type":      {Type: str, Description: "chat_message | stream_start | stream_end | interval"},
 				"event_settings":  {Type: obj, Description: "For interval: interval_seconds (int), channel (login)."},
 				"middlewares":     {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: obj, Description: "{type, settings}"}},
-				"action_type":     {Type: str, Description: "notify | send_chat"},
+				"action_type":     {Type: str, Description: "notify | send_chat | mark_sus"},
 				"action_settings": {Type: obj},
 				"use_shared_pool": {Type: boolSchema},
 			},
@@ -276,7 +314,7 @@ func LLMTools() []openai.Tool {
 				"event_type":      {Type: str, Description: "chat_message | stream_start | stream_end | interval"},
 				"event_settings":  {Type: obj},
 				"middlewares":     {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: obj}},
-				"action_type":     {Type: str, Description: "notify | send_chat"},
+				"action_type":     {Type: str, Description: "notify | send_chat | mark_sus"},
 				"action_settings": {Type: obj},
 				"use_shared_pool": {Type: boolSchema},
 			},
@@ -287,22 +325,30 @@ func LLMTools() []openai.Tool {
 			Properties: map[string]jsonschema.Definition{"id": {Type: integer}},
 			Required:   []string{"id"},
 		}),
-		toolFn(ToolCreateNotification, "Create a notification entry (requires user approval). provider e.g. telegram, webhook.", jsonschema.Definition{
+		toolFn(ToolCreateNotification, "Create a notification entry (requires user approval). provider: telegram, webhook, discord, matrix, ntfy or email. tags label the entry for notify rules (action_settings.notification_tags); event_types and channels are default filters used when a rule names no targets.", jsonschema.Definition{
 			Type: obj,
 			Properties: map[string]jsonschema.Definition{
-				"provider": {Type: str},
-				"settings": {Type: obj},
-				"enabled":  {Type: boolSchema},
+				"provider":    {Type: str},
+				"settings":    {Type: obj, Description: "telegram: bot_token, chat_id, optional interactive (bool) with allowed_user_ids (Telegram user ids allowed to use bot commands and alert buttons). webhook: url, optional headers, method (POST|PUT|PATCH), secret (HMAC signing), body_template ($TEXT, $CHANNEL, $USERNAME, $MESSAGE, $TITLE, $EVENT_ID, $EVENT_TYPE, $TIMESTAMP), content_type. discord: webhook_url, optional username, avatar_url, color. matrix: access_token, room_id, homeserver_url. ntfy: topic, optional server_url, token, priority (1-5), tags. email: host, from, to (list), optional port, tls (starttls|tls|none), username, password, subject_prefix, digest_minutes"},
+				"enabled":     {Type: boolSchema},
+				"tags":        {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: str}},
+				"event_types": {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: str}, Description: "chat_message | stream_start | stream_end | interval; empty = all"},
+				"channels":    {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: str}, Description: "channel logins; empty = all"},
+				"policy":      notificationPolicySchema,
 			},
 			Required: []string{"provider", "settings"},
 		}),
-		toolFn(ToolUpdateNotification, "Update notification by id (requires user approval).", jsonschema.Definition{
+		toolFn(ToolUpdateNotification, "Update notification by id (requires user approval). Passing any of tags, event_types, channels replaces all three.", jsonschema.Definition{
 			Type: obj,
 			Properties: map[string]jsonschema.Definition{
-				"id":       {Type: integer},
-				"provider": {Type: str},
-				"settings": {Type: obj},
-				"enabled":  {Type: boolSchema},
+				"id":          {Type: integer},
+				"provider":    {Type: str},
+				"settings":    {Type: obj},
+				"enabled":     {Type: boolSchema},
+				"tags":        {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: str}},
+				"event_types": {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: str}},
+				"channels":    {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: str}},
+				"policy":      notificationPolicySchema,
 			},
 			Required: []string{"id"},
 		}),
@@ -328,6 +374,18 @@ func LLMTools() []openai.Tool {
 				"auto_check_low_follows": {Type: boolSchema},
 				"low_follows_threshold":  {Type: integer},
 				"max_gql_follow_pages":   {Type: integer},
+				"score_threshold":        {Type: integer, Description: "Weighted score that marks a user suspicious; omit to keep current"},
+				"weights": {Type: obj, Description: "Max points per signal (0 disables); omit to keep current", Properties: map[string]jsonschema.Definition{
+					"account_age":       {Type: integer},
+					"blacklist_follows": {Type: integer},
+					"low_follows":       {Type: integer},
+					"name_pattern":      {Type: integer},
+					"first_message":     {Type: integer},
+					"multi_channel":     {Type: integer},
+					"moderation":        {Type: integer},
+				}},
+				"name_patterns":           {Type: jsonschema.Array, Items: &jsonschema.Definition{Type: str}, Description: "Case-insensitive login regexes; omit to keep current"},
+				"multi_channel_threshold": {Type: integer, Description: "Simultaneous channels for the multi-channel signal; omit to keep current"},
 			},
 			Required: []string{"auto_check_account_age", "account_age_sus_days", "auto_check_blacklist", "auto_check_low_follows", "low_follows_threshold", "max_gql_follow_pages"},
 		}),
@@ -77,6 +77,10 @@ func (u *Usecase) ExecTool(ctx context.Context, name, argumentsJSON string) (str
 		return u.toolListStreamActivity(ctx, argumentsJSON)
 	case ToolGetStreamLeaderboard:
 		return u.toolGetStreamLeaderboard(ctx, argumentsJSON)
+	case ToolGetTwitchUserActivityHeatmap:
+		return u.toolGetTwitchUserActivityHeatmap(ctx, argumentsJSON)
+	case ToolGetChannelActivityHeatmap:
+		return u.toolGetChannelActivityHeatmap(ctx, argumentsJSON)
 	case ToolGetTwitchUser:
 		return u.toolGetTwitchUser(ctx, argumentsJSON)
 	case ToolCountRules:
@@ -212,6 +216,10 @@ func (u *Usecase) toolGetTwitchUserProfile(ctx context.Context, args string) (st
 	if err != nil {
 		return mustJSON(map[string]string{"error": err.Error()}), err
 	}
+	score, err := u.tw.GetSuspicionScore(ctx, p.ID)
+	if err != nil {
+		return mustJSON(map[string]string{"error": err.Error()}), err
+	}
 	out := map[string]any{
 		"user":                      uu,
 		"message_count":             msgCount,
@@ -221,6 +229,7 @@ func (u *Usecase) toolGetTwitchUserProfile(ctx context.Context, args string) (st
 		"followed_monitored_channels": monF,
 		"followed_channels_gql":     gqlF,
 		"channel_blacklist":         bl,
+		"suspicion_score":           score,
 	}
 	return mustJSON(out), nil
 }
@@ -646,6 +655,54 @@ func mapField(m map[string]any, k string) map[string]any {
 	return mm
 }
 
+func stringsField(m map[string]any, k string) []string {
+	arr, ok := m[k].([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(arr))
+	for _, x := range arr {
+		if s, ok := x.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func notificationRoutingFromRaw(m map[string]any) entity.NotificationRouting {
+	return entity.NotificationRouting{
+		Tags:       stringsField(m, "tags"),
+		EventTypes: stringsField(m, "event_types"),
+		Channels:   stringsField(m, "channels"),
+	}
+}
+
+// notificationPolicyFromRaw reads the optional "policy" object: quiet_hours {start, end, timezone},
+// flood_threshold and flood_window_seconds.
+func notificationPolicyFromRaw(m map[string]any) entity.NotificationPolicy {
+	p := mapField(m, "policy")
+
+	var out entity.NotificationPolicy
+
+	if q, ok := p["quiet_hours"].(map[string]any); ok {
+		out.QuietHours = &entity.NotificationQuietHours{
+			Start:    stringField(q, "start"),
+			End:      stringField(q, "end"),
+			Timezone: stringField(q, "timezone"),
+		}
+	}
+
+	if n, err := int64Field(p, "flood_threshold"); err == nil {
+		out.FloodThreshold = int(n)
+	}
+
+	if n, err := int64Field(p, "flood_window_seconds"); err == nil {
+		out.FloodWindow = time.Duration(n) * time.Second
+	}
+
+	return out
+}
+
 func middlewaresFromRaw(v any) []entity.RuleMiddleware {
 	arr, ok := v.([]any)
 	if !ok || len(arr) == 0 {
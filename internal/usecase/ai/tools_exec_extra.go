@@ -344,6 +344,87 @@ func (u *Usecase) toolGetStreamLeaderboard(ctx context.Context, args string) (st
 	return mustJSON(rows), nil
 }
 
+type activityHeatmapArgs struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Timezone string `json:"timezone"`
+}
+
+func (a activityHeatmapArgs) query() (entity.ActivityHeatmapQuery, error) {
+	q := entity.ActivityHeatmapQuery{Timezone: a.Timezone}
+	if strings.TrimSpace(a.From) != "" {
+		t, err := time.Parse(time.RFC3339, a.From)
+		if err != nil {
+			return q, fmt.Errorf("bad from: %w", err)
+		}
+		q.From = t
+	}
+	if strings.TrimSpace(a.To) != "" {
+		t, err := time.Parse(time.RFC3339, a.To)
+		if err != nil {
+			return q, fmt.Errorf("bad to: %w", err)
+		}
+		q.To = t
+	}
+	return q, nil
+}
+
+func activityHeatmapJSON(hm entity.ActivityHeatmap) string {
+	return mustJSON(map[string]any{
+		"timezone":         hm.Timezone,
+		"from":             hm.From,
+		"to":               hm.To,
+		"rows":             "monday..sunday",
+		"columns":          "hour 0..23",
+		"presence_seconds": hm.PresenceSeconds,
+		"messages":         hm.Messages,
+	})
+}
+
+func (u *Usecase) toolGetTwitchUserActivityHeatmap(ctx context.Context, args string) (string, error) {
+	var p struct {
+		ID int64 `json:"id"`
+		activityHeatmapArgs
+	}
+	if err := json.Unmarshal([]byte(args), &p); err != nil {
+		return mustJSON(map[string]string{"error": err.Error()}), err
+	}
+	q, err := p.query()
+	if err != nil {
+		return mustJSON(map[string]string{"error": err.Error()}), err
+	}
+	hm, err := u.tw.UserActivityHeatmap(ctx, p.ID, q)
+	if err != nil {
+		if errors.Is(err, entity.ErrTwitchUserNotFound) {
+			return mustJSON(map[string]string{"error": "twitch user not found"}), err
+		}
+		return mustJSON(map[string]string{"error": err.Error()}), err
+	}
+	return activityHeatmapJSON(hm), nil
+}
+
+func (u *Usecase) toolGetChannelActivityHeatmap(ctx context.Context, args string) (string, error) {
+	var p struct {
+		Login string `json:"login"`
+		activityHeatmapArgs
+	}
+	if err := json.Unmarshal([]byte(args), &p); err != nil {
+		return mustJSON(map[string]string{"error": err.Error()}), err
+	}
+	q, err := p.query()
+	if err != nil {
+		return mustJSON(map[string]string{"error": err.Error()}), err
+	}
+	hm, err := u.tw.ChannelActivityHeatmap(ctx, p.Login, q)
+	if err != nil {
+		if errors.Is(err, twitchuc.ErrChannelNotMonitored) {
+			return mustJSON(map[string]string{"error": "channel is not monitored"}), err
+		}
+		return mustJSON(map[string]string{"error": err.Error()}), err
+	}
+	return activityHeatmapJSON(hm), nil
+}
+
 func (u *Usecase) toolGetTwitchUser(ctx context.Context, args string) (string, error) {
 	var p struct {
 		ID int64 `json:"id"`
@@ -383,7 +464,7 @@ func (u *Usecase) toolCreateNotification(ctx context.Context, args string) (stri
 	if v, ok := raw["enabled"].(bool); ok {
 		enabled = v
 	}
-	e, err := u.sett.CreateNotification(ctx, provider, settings, enabled)
+	e, err := u.sett.CreateNotification(ctx, provider, settings, enabled, notificationRoutingFromRaw(raw), notificationPolicyFromRaw(raw))
 	if err != nil {
 		return mustJSON(map[string]string{"error": err.Error()}), err
 	}
@@ -412,7 +493,20 @@ func (u *Usecase) toolUpdateNotification(ctx context.Context, args string) (stri
 	if v, ok := raw["enabled"].(bool); ok {
 		enabled = &v
 	}
-	e, err := u.sett.UpdateNotification(ctx, id, prov, settings, enabled)
+	var routing *entity.NotificationRouting
+	_, hasTags := raw["tags"]
+	_, hasEvents := raw["event_types"]
+	_, hasChannels := raw["channels"]
+	if hasTags || hasEvents || hasChannels {
+		r := notificationRoutingFromRaw(raw)
+		routing = &r
+	}
+	var policy *entity.NotificationPolicy
+	if _, ok := raw["policy"]; ok {
+		p := notificationPolicyFromRaw(raw)
+		policy = &p
+	}
+	e, err := u.sett.UpdateNotification(ctx, id, prov, settings, enabled, routing, policy)
 	if err != nil {
 		if errors.Is(err, entity.ErrNotificationNotFound) {
 			return mustJSON(map[string]string{"error": "notification not found"}), err
@@ -453,25 +547,61 @@ func (u *Usecase) toolSetChannelBlacklist(ctx context.Context, args string) (str
 	return mustJSON(map[string]any{"ok": true}), nil
 }
 
+type suspicionWeightsWire struct {
+	AccountAge       int `json:"account_age"`
+	BlacklistFollows int `json:"blacklist_follows"`
+	LowFollows       int `json:"low_follows"`
+	NamePattern      int `json:"name_pattern"`
+	FirstMessage     int `json:"first_message"`
+	MultiChannel     int `json:"multi_channel"`
+	Moderation       int `json:"moderation"`
+}
+
 func (u *Usecase) toolUpdateSuspicionSettings(ctx context.Context, args string) (string, error) {
 	var wire struct {
-		AutoCheckAccountAge bool `json:"auto_check_account_age"`
-		AccountAgeSusDays   int  `json:"account_age_sus_days"`
-		AutoCheckBlacklist  bool `json:"auto_check_blacklist"`
-		AutoCheckLowFollows bool `json:"auto_check_low_follows"`
-		LowFollowsThreshold int  `json:"low_follows_threshold"`
-		MaxGQLFollowPages   int  `json:"max_gql_follow_pages"`
+		AutoCheckAccountAge   bool                  `json:"auto_check_account_age"`
+		AccountAgeSusDays     int                   `json:"account_age_sus_days"`
+		AutoCheckBlacklist    bool                  `json:"auto_check_blacklist"`
+		AutoCheckLowFollows   bool                  `json:"auto_check_low_follows"`
+		LowFollowsThreshold   int                   `json:"low_follows_threshold"`
+		MaxGQLFollowPages     int                   `json:"max_gql_follow_pages"`
+		ScoreThreshold        *int                  `json:"score_threshold"`
+		Weights               *suspicionWeightsWire `json:"weights"`
+		NamePatterns          []string              `json:"name_patterns"`
+		MultiChannelThreshold *int                  `json:"multi_channel_threshold"`
 	}
 	if err := json.Unmarshal([]byte(args), &wire); err != nil {
 		return mustJSON(map[string]string{"error": err.Error()}), err
 	}
-	in := entity.SuspicionSettings{
-		AutoCheckAccountAge: wire.AutoCheckAccountAge,
-		AccountAgeSusDays:   wire.AccountAgeSusDays,
-		AutoCheckBlacklist:  wire.AutoCheckBlacklist,
-		AutoCheckLowFollows: wire.AutoCheckLowFollows,
-		LowFollowsThreshold: wire.LowFollowsThreshold,
-		MaxGQLFollowPages:   wire.MaxGQLFollowPages,
+	in, err := u.sett.GetSuspicionSettings(ctx)
+	if err != nil {
+		return mustJSON(map[string]string{"error": err.Error()}), err
+	}
+	in.AutoCheckAccountAge = wire.AutoCheckAccountAge
+	in.AccountAgeSusDays = wire.AccountAgeSusDays
+	in.AutoCheckBlacklist = wire.AutoCheckBlacklist
+	in.AutoCheckLowFollows = wire.AutoCheckLowFollows
+	in.LowFollowsThreshold = wire.LowFollowsThreshold
+	in.MaxGQLFollowPages = wire.MaxGQLFollowPages
+	if wire.ScoreThreshold != nil {
+		in.ScoreThreshold = *wire.ScoreThreshold
+	}
+	if w := wire.Weights; w != nil {
+		in.Weights = entity.SuspicionWeights{
+			AccountAge:       w.AccountAge,
+			BlacklistFollows: w.BlacklistFollows,
+			LowFollows:       w.LowFollows,
+			NamePattern:      w.NamePattern,
+			FirstMessage:     w.FirstMessage,
+			MultiChannel:     w.MultiChannel,
+			Moderation:       w.Moderation,
+		}
+	}
+	if wire.NamePatterns != nil {
+		in.NamePatterns = wire.NamePatterns
+	}
+	if wire.MultiChannelThreshold != nil {
+		in.MultiChannelThreshold = *wire.MultiChannelThreshold
 	}
 	out, err := u.sett.UpdateSuspicionSettings(ctx, in)
 	if err != nil {
@@ -617,6 +747,8 @@ func (u *Usecase) toolPatchTwitchUser(ctx context.Context, args string) (string,
 	if v := optionalBoolPtr(raw, "notify_stream_start"); v != nil {
 		patch.NotifyStreamStart = v
 	}
+	patch.SusSource = entity.SuspicionSourceAI
+	patch.SusEvidence = map[string]any{"tool": ToolPatchTwitchUser}
 	var before entity.TwitchUser
 	if patch.Monitored != nil {
 		var err error
@@ -5,6 +5,8 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/sashabaranov/go-openai"
+
 	"github.com/rofleksey/dredge/internal/entity"
 	"github.com/rofleksey/dredge/internal/observability"
 	"github.com/rofleksey/dredge/internal/repository"
@@ -46,6 +48,29 @@ func New(repo repository.Store, tw *twitchuc.Usecase, rulesSvc *rules.Usecase, s
 	}
 }
 
+// completionClient builds a client and model name from the stored AI settings for background completions. It returns
+// entity.ErrAINotConfigured while the base URL or token is empty.
+func (u *Usecase) completionClient(ctx context.Context) (*openai.Client, string, error) {
+	settings, err := u.repo.GetAISettings(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if strings.TrimSpace(settings.BaseURL) == "" || strings.TrimSpace(settings.APIToken) == "" {
+		return nil, "", entity.ErrAINotConfigured
+	}
+
+	cfg := openai.DefaultConfig(settings.APIToken)
+	cfg.BaseURL = strings.TrimRight(strings.TrimSpace(settings.BaseURL), "/")
+
+	model := strings.TrimSpace(settings.Model)
+	if model == "" {
+		model = "gpt-4o-mini"
+	}
+
+	return openai.NewClientWithConfig(cfg), model, nil
+}
+
 func (u *Usecase) publicSettings(s entity.AISettings) (entity.AISettingsPublic, error) {
 	tok := s.APIToken
 	var last4 string
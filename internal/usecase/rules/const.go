@@ -1,5 +1,7 @@
 package rules
 
+import "time"
+
 // Event types (stored in rules.event_type).
 const (
 	EventChatMessage = "chat_message"
@@ -15,16 +17,29 @@ const (
 	MWMatchRegex    = "match_regex"
 	MWContainsWord  = "contains_word"
 	MWCooldown      = "cooldown"
+	// MWFirstSeenInChannel passes chat from chatters new to the channel (or, with returning, only from known ones).
+	MWFirstSeenInChannel = "first_seen_in_channel"
+	// MWClassify checks a label of the chatter's latest AI-classified message in the channel (classification runs in
+	// the background, so the message being evaluated is not labeled yet).
+	MWClassify = "classify"
 )
 
 // Action types.
 const (
 	ActionNotify   = "notify"
 	ActionSendChat = "send_chat"
+	// ActionMarkSus marks the chatter of a chat_message suspicious (audit source "rule").
+	ActionMarkSus = "mark_sus"
 )
 
 // defaultNotifyTextTemplate is used when a notify rule has no action_settings.text (chat-style events).
 const defaultNotifyTextTemplate = "[$CHANNEL] $USERNAME: $TEXT"
 
+// defaultMarkSusDescriptionTemplate is used when a mark_sus rule has no action_settings.description.
+const defaultMarkSusDescriptionTemplate = "marked by rule $RULE_ID in $CHANNEL"
+
+// classifyLabelMaxAge is how recent a classified message must be for the classify middleware to use its labels.
+const classifyLabelMaxAge = time.Hour
+
 // maxRegexRunes limits regex input size (ReDoS mitigation), same idea as live.rule_match.
 const maxRegexRunes = 4000
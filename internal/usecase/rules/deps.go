@@ -2,19 +2,27 @@ package rules
 
 import (
 	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
 )
 
-// NotifyDispatcher sends outbound notifications (Telegram, webhook).
+// NotifyDispatcher queues outbound notifications (Telegram, webhook) for delivery.
+// route narrows delivery to specific notification entries; a zero route uses each entry's default filters.
 type NotifyDispatcher interface {
 	// NotifyChatKeyword is for chat-backed rules (keyword / message context).
-	NotifyChatKeyword(ctx context.Context, channel, user, message, textTemplate string)
+	NotifyChatKeyword(ctx context.Context, route entity.NotificationRoute, channel, user, message, textTemplate string)
 	// NotifyRuleText is for rules with no chat line (e.g. interval): channel + rendered template only.
-	NotifyRuleText(ctx context.Context, channel, text string)
-	NotifyStreamStart(ctx context.Context, channel, title, textTemplate string)
-	NotifyStreamEnd(ctx context.Context, channel, textTemplate string)
+	NotifyRuleText(ctx context.Context, route entity.NotificationRoute, channel, text string)
+	NotifyStreamStart(ctx context.Context, route entity.NotificationRoute, channel, title, textTemplate string)
+	NotifyStreamEnd(ctx context.Context, route entity.NotificationRoute, channel, textTemplate string)
 }
 
 // SendMessenger sends a Twitch chat message via Helix.
 type SendMessenger interface {
 	SendMessage(ctx context.Context, accountID int64, channel, message string) error
 }
+
+// SuspicionMarker marks a chatter suspicious for the mark_sus action; marked is false when the user was left as is.
+type SuspicionMarker interface {
+	MarkSusFromRule(ctx context.Context, login, description string, evidence map[string]any) (marked bool, err error)
+}
@@ -24,18 +24,20 @@ type Config struct {
 	Helix          *helix.Client
 	Notify         NotifyDispatcher
 	Send           SendMessenger
+	Suspicion      SuspicionMarker
 	PersistContext func() context.Context
 	Obs            *observability.Stack
 }
 
 // Engine evaluates rules with a worker pool and interval scheduler.
 type Engine struct {
-	deps     evalDeps
-	persist  func() context.Context
-	obs      *observability.Stack
-	notify   NotifyDispatcher
-	send     SendMessenger
-	cooldown *cooldownTracker
+	deps      evalDeps
+	persist   func() context.Context
+	obs       *observability.Stack
+	notify    NotifyDispatcher
+	send      SendMessenger
+	suspicion SuspicionMarker
+	cooldown  *cooldownTracker
 
 	rules atomic.Value // []entity.Rule
 
@@ -70,6 +72,7 @@ func NewEngine(cfg Config) *Engine {
 		obs:          cfg.Obs,
 		notify:       cfg.Notify,
 		send:         cfg.Send,
+		suspicion:    cfg.Suspicion,
 		cooldown:     newCooldownTracker(),
 		work:         make(chan workItem, workQueueSize),
 		intervalNext: make(map[int64]time.Time),
@@ -113,13 +116,15 @@ func (e *Engine) Reload(ctx context.Context, list []entity.Rule) {
 	e.intervalMu.Unlock()
 }
 
-// HandleChatMessage dispatches chat_message rules.
-func (e *Engine) HandleChatMessage(channel, user, text string) {
+// HandleChatMessage dispatches chat_message rules. firstSeenInChannel marks the chatter's first stored message in
+// the channel.
+func (e *Engine) HandleChatMessage(channel, user, text string, firstSeenInChannel bool) {
 	p := EvalPayload{
-		Event:    EventChatMessage,
-		Channel:  trimLower(channel),
-		Username: trimLower(user),
-		Text:     text,
+		Event:              EventChatMessage,
+		Channel:            trimLower(channel),
+		Username:           trimLower(user),
+		Text:               text,
+		FirstSeenInChannel: firstSeenInChannel,
 	}
 
 	e.dispatchEvent(EventChatMessage, p)
@@ -147,12 +152,13 @@ func (e *Engine) HandleStreamEnd(channel string) {
 }
 
 // KeywordMatchChat returns true if any enabled chat_message rule passes all middlewares except cooldown is skipped.
-func (e *Engine) KeywordMatchChat(ctx context.Context, channel, user, text string) bool {
+func (e *Engine) KeywordMatchChat(ctx context.Context, channel, user, text string, firstSeenInChannel bool) bool {
 	p := EvalPayload{
-		Event:    EventChatMessage,
-		Channel:  trimLower(channel),
-		Username: trimLower(user),
-		Text:     text,
+		Event:              EventChatMessage,
+		Channel:            trimLower(channel),
+		Username:           trimLower(user),
+		Text:               text,
+		FirstSeenInChannel: firstSeenInChannel,
 	}
 
 	for _, r := range e.snapshot() {
@@ -187,39 +187,38 @@ func (e *Engine) execAction(ctx context.Context, rule entity.Rule, p EvalPayload
 	switch rule.ActionType {
 	case ActionNotify:
 		tpl, _ := rule.ActionSettings["text"].(string)
-		if tpl == "" {
-			switch p.Event {
-			case EventInterval:
-				tpl = "[interval] #$CHANNEL"
-			case EventStreamStart, EventStreamEnd:
-				// Empty: live notify uses provider-specific defaults.
-			default:
-				tpl = defaultNotifyTextTemplate
-			}
-		}
 
-		vars := TemplateVars(rule.ID, p.Channel, p.Username, p.Text, p.Title)
-		out := ExpandTemplate(tpl, vars)
+		vars := TemplateVars(rule.ID, p.Channel, p.Username, p.Text, p.Title, p.FirstSeenInChannel)
+		out := ExpandTemplate(notifyTemplate(p.Event, tpl), vars)
 		display := notifyDisplayTextForLog(p, out)
 
+		route, routeErr := ParseNotifyRoute(rule.ActionSettings)
+		if routeErr != nil {
+			if e.obs != nil {
+				e.obs.Logger.Debug("rules notify skipped: bad route", zap.Error(routeErr), zap.Int64("rule_id", rule.ID))
+			}
+
+			return
+		}
+
 		switch p.Event {
 		case EventChatMessage:
-			e.notify.NotifyChatKeyword(ctx, p.Channel, p.Username, p.Text, out)
+			e.notify.NotifyChatKeyword(ctx, route, p.Channel, p.Username, p.Text, out)
 		case EventStreamStart:
-			e.notify.NotifyStreamStart(ctx, p.Channel, p.Title, out)
+			e.notify.NotifyStreamStart(ctx, route, p.Channel, p.Title, out)
 		case EventStreamEnd:
-			e.notify.NotifyStreamEnd(ctx, p.Channel, out)
+			e.notify.NotifyStreamEnd(ctx, route, p.Channel, out)
 		case EventInterval:
-			e.notify.NotifyRuleText(ctx, p.Channel, out)
+			e.notify.NotifyRuleText(ctx, route, p.Channel, out)
 		default:
-			e.notify.NotifyChatKeyword(ctx, p.Channel, p.Username, p.Text, out)
+			e.notify.NotifyChatKeyword(ctx, route, p.Channel, p.Username, p.Text, out)
 		}
 
 		e.recordRuleTrigger(ctx, rule, p, ActionNotify, display)
 	case ActionSendChat:
 		msgTpl, _ := rule.ActionSettings["message"].(string)
 
-		vars := TemplateVars(rule.ID, p.Channel, p.Username, p.Text, p.Title)
+		vars := TemplateVars(rule.ID, p.Channel, p.Username, p.Text, p.Title, p.FirstSeenInChannel)
 		ch := trimLower(p.Channel)
 		msg := ExpandTemplate(msgTpl, vars)
 
@@ -251,6 +250,43 @@ func (e *Engine) execAction(ctx context.Context, rule entity.Rule, p EvalPayload
 
 		display := fmt.Sprintf("#%s › %s", ch, msg)
 		e.recordRuleTrigger(ctx, rule, p, ActionSendChat, display)
+	case ActionMarkSus:
+		login := trimLower(p.Username)
+		if login == "" || e.suspicion == nil {
+			return
+		}
+
+		descTpl, _ := rule.ActionSettings["description"].(string)
+		if descTpl == "" {
+			descTpl = defaultMarkSusDescriptionTemplate
+		}
+
+		vars := TemplateVars(rule.ID, p.Channel, p.Username, p.Text, p.Title, p.FirstSeenInChannel)
+		desc := ExpandTemplate(descTpl, vars)
+		ch := trimLower(p.Channel)
+
+		evidence := map[string]any{
+			"rule_id":   rule.ID,
+			"rule_name": rule.Name,
+			"channel":   ch,
+			"text":      p.Text,
+		}
+
+		marked, err := e.suspicion.MarkSusFromRule(ctx, login, desc, evidence)
+		if err != nil {
+			if e.obs != nil {
+				e.obs.Logger.Debug("rules mark_sus failed", zap.Error(err), zap.Int64("rule_id", rule.ID))
+			}
+
+			return
+		}
+
+		if !marked {
+			return
+		}
+
+		display := fmt.Sprintf("#%s › %s marked sus", ch, login)
+		e.recordRuleTrigger(ctx, rule, p, ActionMarkSus, display)
 	default:
 		return
 	}
@@ -269,7 +305,7 @@ func (e *Engine) recordRuleTrigger(ctx context.Context, rule entity.Rule, p Eval
 		ctx = context.Background()
 	}
 
-	err := e.deps.Repo.InsertRuleTriggerEvent(ctx, rule.ID, rule.Name, p.Event, actionType, displayText)
+	err := e.deps.Repo.InsertRuleTriggerEvent(ctx, rule.ID, rule.Name, p.Event, actionType, trimLower(p.Channel), displayText)
 	if err != nil && e.obs != nil {
 		e.obs.Logger.Warn("insert rule trigger event failed", zap.Error(err), zap.Int64("rule_id", rule.ID))
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
@@ -53,6 +54,33 @@ func TestEngine_KeywordMatchChat_noRules(t *testing.T) {
 
 	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
 	e := NewEngine(Config{Obs: obs})
-	ok := e.KeywordMatchChat(context.Background(), "ch", "u", "x")
+	ok := e.KeywordMatchChat(context.Background(), "ch", "u", "x", false)
 	require.False(t, ok)
 }
+
+type fakeSuspicionMarker struct {
+	login       string
+	description string
+	evidence    map[string]any
+}
+
+func (f *fakeSuspicionMarker) MarkSusFromRule(_ context.Context, login, description string, evidence map[string]any) (bool, error) {
+	f.login, f.description, f.evidence = login, description, evidence
+
+	return true, nil
+}
+
+func TestEngine_execAction_markSus(t *testing.T) {
+	t.Parallel()
+
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	marker := &fakeSuspicionMarker{}
+	e := NewEngine(Config{Obs: obs, Suspicion: marker})
+
+	rule := entity.Rule{ID: 7, Name: "spam", ActionType: ActionMarkSus, ActionSettings: map[string]any{}}
+	e.execAction(context.Background(), rule, EvalPayload{Event: EventChatMessage, Channel: "Chan", Username: "Spammer", Text: "buy followers"})
+
+	assert.Equal(t, "spammer", marker.login)
+	assert.Equal(t, "marked by rule 7 in Chan", marker.description)
+	assert.Equal(t, map[string]any{"rule_id": int64(7), "rule_name": "spam", "channel": "chan", "text": "buy followers"}, marker.evidence)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -30,13 +31,14 @@ func ExpandTemplate(tpl string, vars map[string]string) string {
 }
 
 // TemplateVars builds standard variables for actions.
-func TemplateVars(ruleID int64, channel, username, text, title string) map[string]string {
+func TemplateVars(ruleID int64, channel, username, text, title string, firstSeenInChannel bool) map[string]string {
 	return map[string]string{
-		"RULE_ID":  fmt.Sprint(ruleID),
-		"CHANNEL":  channel,
-		"USERNAME": username,
-		"TEXT":     text,
-		"TITLE":    title,
+		"RULE_ID":               fmt.Sprint(ruleID),
+		"CHANNEL":               channel,
+		"USERNAME":              username,
+		"TEXT":                  text,
+		"TITLE":                 title,
+		"FIRST_SEEN_IN_CHANNEL": strconv.FormatBool(firstSeenInChannel),
 	}
 }
 
@@ -54,6 +56,7 @@ func RuleTemplateVariables() []RuleTemplateVariable {
 		{Name: "USERNAME", Description: "Chatter login for chat events; empty when not applicable."},
 		{Name: "TEXT", Description: "Chat message body for chat_message; empty when not applicable."},
 		{Name: "TITLE", Description: "Stream title for stream_start; empty when not applicable."},
+		{Name: "FIRST_SEEN_IN_CHANNEL", Description: "true when a chat_message is the chatter's first message Dredge stored in the channel; false otherwise."},
 	}
 }
 
@@ -108,6 +111,11 @@ func MiddlewareOK(ctx context.Context, d *evalDeps, mw entity.RuleMiddleware, p
 	case MWCooldown:
 		// evaluated in engine with mutex
 		return true
+	case MWFirstSeenInChannel:
+		returning, _ := mw.Settings["returning"].(bool)
+		return p.FirstSeenInChannel != returning
+	case MWClassify:
+		return mwClassify(ctx, d, mw.Settings, p)
 	default:
 		return false
 	}
@@ -156,6 +164,35 @@ func mwFilterChannel(ctx context.Context, d *evalDeps, s map[string]any, p EvalP
 	return live
 }
 
+func mwClassify(ctx context.Context, d *evalDeps, s map[string]any, p EvalPayload) bool {
+	labels, err := d.Repo.GetLatestChatterLabels(ctx, trimLower(p.Channel), trimLower(p.Username), time.Now().Add(-classifyLabelMaxAge))
+	if err != nil {
+		return false
+	}
+
+	if labels == nil {
+		passUnclassified, _ := s["pass_unclassified"].(bool)
+		return passUnclassified
+	}
+
+	label, _ := s["label"].(string)
+
+	v, ok := labels.Label(label)
+	if !ok {
+		return false
+	}
+
+	if lo, ok := numFromMap(s, "min"); ok && v < lo {
+		return false
+	}
+
+	if hi, ok := numFromMap(s, "max"); ok && v > hi {
+		return false
+	}
+
+	return true
+}
+
 func mwFilterUser(s map[string]any, p EvalPayload) bool {
 	u := trimLower(p.Username)
 
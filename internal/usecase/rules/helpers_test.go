@@ -1,9 +1,14 @@
 package rules
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/repository/mocks"
 )
 
 func TestExpandTemplate(t *testing.T) {
@@ -16,7 +21,8 @@ func TestExpandTemplate(t *testing.T) {
 func TestRuleTemplateVariables_matchesTemplateVarsKeys(t *testing.T) {
 	t.Parallel()
 
-	tv := TemplateVars(42, "ch", "u", "txt", "ttl")
+	tv := TemplateVars(42, "ch", "u", "txt", "ttl", true)
+	require.Equal(t, "true", tv["FIRST_SEEN_IN_CHANNEL"])
 	list := RuleTemplateVariables()
 	require.Len(t, list, len(tv))
 
@@ -51,3 +57,44 @@ func TestMwContainsWordCaseInsensitive(t *testing.T) {
 	require.True(t, mwContainsWord(sSensitive, "x Foo y"))
 	require.False(t, mwContainsWord(sSensitive, "x foo y"))
 }
+
+func TestMiddlewareOK_firstSeenInChannel(t *testing.T) {
+	t.Parallel()
+
+	mw := entity.RuleMiddleware{Type: MWFirstSeenInChannel, Settings: map[string]any{}}
+	require.True(t, MiddlewareOK(context.Background(), nil, mw, EvalPayload{FirstSeenInChannel: true}, false))
+	require.False(t, MiddlewareOK(context.Background(), nil, mw, EvalPayload{}, false))
+
+	mw.Settings["returning"] = true
+	require.False(t, MiddlewareOK(context.Background(), nil, mw, EvalPayload{FirstSeenInChannel: true}, false))
+	require.True(t, MiddlewareOK(context.Background(), nil, mw, EvalPayload{}, false))
+}
+
+func TestMiddlewareOK_classify(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockStore(ctrl)
+	d := &evalDeps{Repo: repo}
+	p := EvalPayload{Channel: "Streamer", Username: "Troll", Text: "hi"}
+
+	repo.EXPECT().GetLatestChatterLabels(gomock.Any(), "streamer", "troll", gomock.Any()).
+		Return(&entity.ChatMessageLabels{MessageID: 1, Toxicity: 0.8, Sentiment: -0.6}, nil).Times(3)
+
+	mw := entity.RuleMiddleware{Type: MWClassify, Settings: map[string]any{"label": "toxicity", "min": 0.7}}
+	require.True(t, MiddlewareOK(context.Background(), d, mw, p, false))
+
+	mw.Settings = map[string]any{"label": "sentiment", "min": -0.5}
+	require.False(t, MiddlewareOK(context.Background(), d, mw, p, false))
+
+	mw.Settings = map[string]any{"label": "sentiment", "max": -0.5}
+	require.True(t, MiddlewareOK(context.Background(), d, mw, p, false))
+
+	repo.EXPECT().GetLatestChatterLabels(gomock.Any(), "streamer", "troll", gomock.Any()).Return(nil, nil).Times(2)
+
+	mw.Settings = map[string]any{"label": "toxicity", "min": 0.7}
+	require.False(t, MiddlewareOK(context.Background(), d, mw, p, false))
+
+	mw.Settings["pass_unclassified"] = true
+	require.True(t, MiddlewareOK(context.Background(), d, mw, p, false))
+}
@@ -2,7 +2,10 @@ package rules
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+
+	"github.com/rofleksey/dredge/internal/entity"
 )
 
 const (
@@ -23,9 +26,74 @@ func truncateRunes(s string, max int) string {
 	return string(r[:max])
 }
 
+// notifyTemplate returns the notify text template for event, falling back to the engine defaults when tpl is empty.
+func notifyTemplate(event, tpl string) string {
+	if tpl != "" {
+		return tpl
+	}
+
+	switch event {
+	case EventInterval:
+		return "[interval] #$CHANNEL"
+	case EventStreamStart, EventStreamEnd:
+		// Empty: live notify uses provider-specific defaults.
+		return ""
+	default:
+		return defaultNotifyTextTemplate
+	}
+}
+
+// SamplePayload returns placeholder event data for previews; non-empty fields of override win.
+func SamplePayload(event string, override EvalPayload) EvalPayload {
+	p := EvalPayload{Event: event, Channel: "example_channel"}
+
+	switch event {
+	case EventChatMessage:
+		p.Username = "example_user"
+		p.Text = "hello from chat"
+	case EventStreamStart:
+		p.Title = "Example stream title"
+	}
+
+	if s := trimLower(strings.TrimPrefix(strings.TrimSpace(override.Channel), "#")); s != "" {
+		p.Channel = s
+	}
+
+	if s := trimLower(strings.TrimPrefix(strings.TrimSpace(override.Username), "@")); s != "" {
+		p.Username = s
+	}
+
+	if override.Text != "" {
+		p.Text = override.Text
+	}
+
+	if override.Title != "" {
+		p.Title = override.Title
+	}
+
+	return p
+}
+
+// PreviewNotifyText renders the line a notify action with actionSettings would send for p, without
+// sending anything. It applies the same defaults and $VAR expansion as the engine.
+func PreviewNotifyText(ruleID int64, actionSettings map[string]any, p EvalPayload) (string, error) {
+	if !slices.Contains([]string{EventChatMessage, EventStreamStart, EventStreamEnd, EventInterval}, p.Event) {
+		return "", fmt.Errorf("unknown event_type %q: %w", p.Event, entity.ErrInvalidRule)
+	}
+
+	tpl, ok := actionSettings["text"].(string)
+	if !ok && actionSettings["text"] != nil {
+		return "", fmt.Errorf("action_settings.text must be a string: %w", entity.ErrInvalidRule)
+	}
+
+	vars := TemplateVars(ruleID, p.Channel, p.Username, p.Text, p.Title, p.FirstSeenInChannel)
+
+	return notifyDisplayTextForLog(p, ExpandTemplate(notifyTemplate(p.Event, tpl), vars)), nil
+}
+
 // notifyDisplayTextForLog returns the outbound line stored for the rule triggers feed.
 // When expandedTemplate is non-empty it matches what Telegram receives from the rules engine.
-// When empty, defaults mirror internal/service/twitch/live/notify.go sendTelegram* helpers.
+// When empty, defaults mirror internal/service/notify eventText.
 func notifyDisplayTextForLog(p EvalPayload, expandedTemplate string) string {
 	if strings.TrimSpace(expandedTemplate) != "" {
 		return expandedTemplate
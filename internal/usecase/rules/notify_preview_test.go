@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
 )
 
 func Test_notifyDisplayTextForLog_expandedPreferred(t *testing.T) {
@@ -44,3 +47,36 @@ func Test_notifyDisplayTextForLog_chatDefault(t *testing.T) {
 	prefix := "[c] u: "
 	assert.Len(t, []rune(got), len([]rune(prefix))+telegramChatMsgTruncateRunes)
 }
+
+func TestPreviewNotifyText(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		settings map[string]any
+		p        EvalPayload
+		want     string
+	}{
+		{"chat default", nil, SamplePayload(EventChatMessage, EvalPayload{}), "[example_channel] example_user: hello from chat"},
+		{"chat template", map[string]any{"text": "rule $RULE_ID: $USERNAME in #$CHANNEL"}, SamplePayload(EventChatMessage, EvalPayload{Channel: "#Foo"}), "rule 5: example_user in #foo"},
+		{"stream start default", map[string]any{"text": ""}, SamplePayload(EventStreamStart, EvalPayload{Title: "Speedrun"}), "[live] #example_channel started streaming: Speedrun"},
+		{"stream end default", nil, SamplePayload(EventStreamEnd, EvalPayload{}), "[offline] #example_channel stopped streaming"},
+		{"interval default", nil, SamplePayload(EventInterval, EvalPayload{}), "[interval] #example_channel"},
+	}
+
+	for _, tc := range cases {
+		got, err := PreviewNotifyText(5, tc.settings, tc.p)
+		require.NoError(t, err, tc.name)
+		assert.Equal(t, tc.want, got, tc.name)
+	}
+}
+
+func TestPreviewNotifyText_invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := PreviewNotifyText(0, nil, EvalPayload{Event: "nope"})
+	require.ErrorIs(t, err, entity.ErrInvalidRule)
+
+	_, err = PreviewNotifyText(0, map[string]any{"text": 3}, SamplePayload(EventChatMessage, EvalPayload{}))
+	require.ErrorIs(t, err, entity.ErrInvalidRule)
+}
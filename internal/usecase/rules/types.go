@@ -8,4 +8,7 @@ type EvalPayload struct {
 	Text        string
 	Title       string
 	IntervalSec float64
+	// FirstSeenInChannel is set on chat_message events that are the chatter's first message Dredge stored in
+	// the channel.
+	FirstSeenInChannel bool
 }
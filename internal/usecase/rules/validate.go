@@ -42,6 +42,9 @@ func ValidateRule(r entity.Rule) error {
 
 	switch r.ActionType {
 	case ActionNotify:
+		if _, err := ParseNotifyRoute(r.ActionSettings); err != nil {
+			return fmt.Errorf("notify action_settings: %w: %w", err, entity.ErrInvalidRule)
+		}
 	case ActionSendChat:
 		msg, _ := r.ActionSettings["message"].(string)
 		if msg == "" {
@@ -51,6 +54,16 @@ func ValidateRule(r entity.Rule) error {
 		if _, err := ParseSendChatAccountID(r.ActionSettings); err != nil {
 			return fmt.Errorf("send_chat action_settings: %w: %w", err, entity.ErrInvalidRule)
 		}
+	case ActionMarkSus:
+		if r.EventType != EventChatMessage {
+			return fmt.Errorf("mark_sus requires chat_message event: %w", entity.ErrInvalidRule)
+		}
+
+		if v, ok := r.ActionSettings["description"]; ok && v != nil {
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("mark_sus description must be a string: %w", entity.ErrInvalidRule)
+			}
+		}
 	default:
 		return fmt.Errorf("unknown action_type %q: %w", r.ActionType, entity.ErrInvalidRule)
 	}
@@ -85,35 +98,40 @@ func ParseSendChatAccountID(m map[string]any) (int64, error) {
 		return 0, nil
 	}
 
+	return parseNonNegativeID(v, "account_id")
+}
+
+// parseNonNegativeID accepts a JSON number or numeric string; name is used in error messages.
+func parseNonNegativeID(v any, name string) (int64, error) {
 	switch n := v.(type) {
 	case float64:
 		if math.IsNaN(n) || math.IsInf(n, 0) {
-			return 0, fmt.Errorf("invalid account_id")
+			return 0, fmt.Errorf("invalid %s", name)
 		}
 
 		if n < 0 {
-			return 0, fmt.Errorf("account_id must be non-negative")
+			return 0, fmt.Errorf("%s must be non-negative", name)
 		}
 
 		if n > float64(1<<53) {
-			return 0, fmt.Errorf("account_id is too large; use a string value")
+			return 0, fmt.Errorf("%s is too large; use a string value", name)
 		}
 
 		ri := int64(n)
 		if float64(ri) != n {
-			return 0, fmt.Errorf("account_id must be a whole number")
+			return 0, fmt.Errorf("%s must be a whole number", name)
 		}
 
 		return ri, nil
 	case int:
 		if n < 0 {
-			return 0, fmt.Errorf("account_id must be non-negative")
+			return 0, fmt.Errorf("%s must be non-negative", name)
 		}
 
 		return int64(n), nil
 	case int64:
 		if n < 0 {
-			return 0, fmt.Errorf("account_id must be non-negative")
+			return 0, fmt.Errorf("%s must be non-negative", name)
 		}
 
 		return n, nil
@@ -125,19 +143,67 @@ func ParseSendChatAccountID(m map[string]any) (int64, error) {
 
 		parsed, err := strconv.ParseInt(s, 10, 64)
 		if err != nil || parsed < 0 {
-			return 0, fmt.Errorf("invalid account_id")
+			return 0, fmt.Errorf("invalid %s", name)
 		}
 
 		return parsed, nil
 	default:
-		return 0, fmt.Errorf("account_id must be a number or numeric string")
+		return 0, fmt.Errorf("%s must be a number or numeric string", name)
+	}
+}
+
+// ParseNotifyRoute reads optional action_settings.notification_ids and notification_tags for notify.
+// When both are empty the action goes to every enabled entry whose default filters accept the event.
+func ParseNotifyRoute(m map[string]any) (entity.NotificationRoute, error) {
+	var route entity.NotificationRoute
+
+	if m == nil {
+		return route, nil
+	}
+
+	if v, ok := m["notification_ids"]; ok && v != nil {
+		arr, ok := v.([]any)
+		if !ok {
+			return route, fmt.Errorf("notification_ids must be an array")
+		}
+
+		for _, x := range arr {
+			id, err := parseNonNegativeID(x, "notification_ids item")
+			if err != nil {
+				return route, err
+			}
+
+			if id == 0 {
+				return route, fmt.Errorf("notification_ids must contain positive integers")
+			}
+
+			route.EntryIDs = append(route.EntryIDs, id)
+		}
+	}
+
+	if v, ok := m["notification_tags"]; ok && v != nil {
+		if _, ok := v.([]any); !ok {
+			return route, fmt.Errorf("notification_tags must be an array of strings")
+		}
+
+		route.Tags = strSliceFromAny(v)
 	}
+
+	return route, nil
 }
 
 func validateMiddleware(typ string, s map[string]any) error {
 	switch typ {
 	case MWFilterChannel, MWFilterUser:
 		return nil
+	case MWFirstSeenInChannel:
+		if v, ok := s["returning"]; ok {
+			if _, ok := v.(bool); !ok {
+				return fmt.Errorf("first_seen_in_channel returning must be a boolean: %w", entity.ErrInvalidRule)
+			}
+		}
+	case MWClassify:
+		return validateClassify(s)
 	case MWMatchRegex:
 		pat, _ := s["pattern"].(string)
 		if pat == "" {
@@ -163,6 +229,36 @@ func validateMiddleware(typ string, s map[string]any) error {
 	return nil
 }
 
+func validateClassify(s map[string]any) error {
+	label, _ := s["label"].(string)
+	if _, ok := (entity.ChatMessageLabels{}).Label(label); !ok {
+		return fmt.Errorf("classify label must be toxicity, harassment or sentiment: %w", entity.ErrInvalidRule)
+	}
+
+	lo, hasLo := numFromMap(s, "min")
+	hi, hasHi := numFromMap(s, "max")
+
+	if _, ok := s["min"]; ok && !hasLo {
+		return fmt.Errorf("classify min must be a number: %w", entity.ErrInvalidRule)
+	}
+
+	if _, ok := s["max"]; ok && !hasHi {
+		return fmt.Errorf("classify max must be a number: %w", entity.ErrInvalidRule)
+	}
+
+	if hasLo && hasHi && lo > hi {
+		return fmt.Errorf("classify min must not exceed max: %w", entity.ErrInvalidRule)
+	}
+
+	if v, ok := s["pass_unclassified"]; ok {
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("classify pass_unclassified must be a boolean: %w", entity.ErrInvalidRule)
+		}
+	}
+
+	return nil
+}
+
 func containsWordsNonEmpty(v any) bool {
 	if s, ok := v.([]string); ok && len(s) > 0 {
 		return true
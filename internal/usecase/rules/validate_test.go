@@ -111,6 +111,43 @@ func TestParseSendChatAccountID(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestParseNotifyRoute(t *testing.T) {
+	t.Parallel()
+
+	route, err := ParseNotifyRoute(nil)
+	require.NoError(t, err)
+	require.True(t, route.IsZero())
+
+	route, err = ParseNotifyRoute(map[string]any{
+		"notification_ids":  []any{float64(3), "5"},
+		"notification_tags": []any{" Mods ", ""},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 5}, route.EntryIDs)
+	require.Equal(t, []string{"mods"}, route.Tags)
+
+	_, err = ParseNotifyRoute(map[string]any{"notification_ids": []any{float64(0)}})
+	require.Error(t, err)
+
+	_, err = ParseNotifyRoute(map[string]any{"notification_tags": "mods"})
+	require.Error(t, err)
+}
+
+func TestValidateRule_notify_bad_route(t *testing.T) {
+	t.Parallel()
+
+	r := entity.Rule{
+		Name:           "n",
+		EventType:      EventStreamStart,
+		EventSettings:  map[string]any{},
+		ActionType:     ActionNotify,
+		ActionSettings: map[string]any{"notification_ids": "1"},
+	}
+
+	err := ValidateRule(r)
+	require.ErrorIs(t, err, entity.ErrInvalidRule)
+}
+
 func TestValidateRule_send_chat_missing_message(t *testing.T) {
 	t.Parallel()
 
@@ -127,6 +164,27 @@ func TestValidateRule_send_chat_missing_message(t *testing.T) {
 	require.ErrorIs(t, err, entity.ErrInvalidRule)
 }
 
+func TestValidateRule_mark_sus(t *testing.T) {
+	t.Parallel()
+
+	r := entity.Rule{
+		Name:           "ms",
+		EventType:      EventChatMessage,
+		EventSettings:  map[string]any{},
+		ActionType:     ActionMarkSus,
+		ActionSettings: map[string]any{"description": "spam in $CHANNEL"},
+	}
+
+	require.NoError(t, ValidateRule(r))
+
+	r.ActionSettings = map[string]any{"description": 5}
+	require.ErrorIs(t, ValidateRule(r), entity.ErrInvalidRule)
+
+	r.ActionSettings = map[string]any{}
+	r.EventType = EventStreamStart
+	require.ErrorIs(t, ValidateRule(r), entity.ErrInvalidRule)
+}
+
 func TestValidateRule_name_empty(t *testing.T) {
 	t.Parallel()
 
@@ -141,3 +199,42 @@ func TestValidateRule_name_empty(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, entity.ErrInvalidRule)
 }
+
+func TestValidateRule_first_seen_in_channel_returning(t *testing.T) {
+	t.Parallel()
+
+	r := entity.Rule{
+		Name:           "newcomers",
+		EventType:      EventChatMessage,
+		Middlewares:    []entity.RuleMiddleware{{Type: MWFirstSeenInChannel, Settings: map[string]any{"returning": true}}},
+		ActionType:     ActionNotify,
+		ActionSettings: map[string]any{},
+	}
+	require.NoError(t, ValidateRule(r))
+
+	r.Middlewares[0].Settings["returning"] = "yes"
+	require.ErrorIs(t, ValidateRule(r), entity.ErrInvalidRule)
+}
+
+func TestValidateRule_classify(t *testing.T) {
+	t.Parallel()
+
+	r := entity.Rule{
+		Name:           "toxic",
+		EventType:      EventChatMessage,
+		Middlewares:    []entity.RuleMiddleware{{Type: MWClassify, Settings: map[string]any{"label": "toxicity", "min": 0.7}}},
+		ActionType:     ActionNotify,
+		ActionSettings: map[string]any{},
+	}
+	require.NoError(t, ValidateRule(r))
+
+	for _, bad := range []map[string]any{
+		{"label": "spam"},
+		{"label": "toxicity", "min": "high"},
+		{"label": "sentiment", "min": 0.5, "max": -0.5},
+		{"label": "toxicity", "pass_unclassified": "yes"},
+	} {
+		r.Middlewares[0].Settings = bad
+		require.ErrorIs(t, ValidateRule(r), entity.ErrInvalidRule, "%v", bad)
+	}
+}
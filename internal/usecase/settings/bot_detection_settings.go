@@ -0,0 +1,58 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// maxBotDetectionThreshold bounds the channel count and viewers-per-chatter ratio to values a typo cannot exceed.
+const maxBotDetectionThreshold = 1000
+
+func (s *Usecase) GetBotDetectionSettings(ctx context.Context) (entity.BotDetectionSettings, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.get_bot_detection_settings")
+	defer span.End()
+
+	out, err := s.repo.GetBotDetectionSettings(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get bot detection settings failed", err)
+	}
+
+	return out, err
+}
+
+func (s *Usecase) UpdateBotDetectionSettings(ctx context.Context, in entity.BotDetectionSettings) (entity.BotDetectionSettings, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.update_bot_detection_settings")
+	defer span.End()
+
+	if err := validateBotDetectionSettings(in); err != nil {
+		return entity.BotDetectionSettings{}, err
+	}
+
+	if err := s.repo.UpdateBotDetectionSettings(ctx, in); err != nil {
+		s.obs.LogError(ctx, span, "update bot detection settings failed", err)
+		return entity.BotDetectionSettings{}, err
+	}
+
+	return s.repo.GetBotDetectionSettings(ctx)
+}
+
+func validateBotDetectionSettings(in entity.BotDetectionSettings) error {
+	if in.MinConcurrentChannels < 2 || in.MinConcurrentChannels > maxBotDetectionThreshold {
+		return fmt.Errorf("%w: min_concurrent_channels must be between 2 and %d", entity.ErrInvalidBotDetectionSettings,
+			maxBotDetectionThreshold)
+	}
+
+	if math.IsNaN(in.MaxMessagesPerHour) || in.MaxMessagesPerHour < 0 {
+		return fmt.Errorf("%w: max_messages_per_hour must not be negative", entity.ErrInvalidBotDetectionSettings)
+	}
+
+	if math.IsNaN(in.ViewersPerChatter) || in.ViewersPerChatter < 1 || in.ViewersPerChatter > maxBotDetectionThreshold {
+		return fmt.Errorf("%w: viewers_per_chatter must be between 1 and %d", entity.ErrInvalidBotDetectionSettings,
+			maxBotDetectionThreshold)
+	}
+
+	return nil
+}
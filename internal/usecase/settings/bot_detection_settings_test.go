@@ -0,0 +1,60 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_UpdateBotDetectionSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	in := entity.BotDetectionSettings{
+		Enabled:               true,
+		MinConcurrentChannels: 8,
+		MaxMessagesPerHour:    0.05,
+		ViewersPerChatter:     4,
+		ExcludeFromStats:      true,
+	}
+
+	repo.EXPECT().UpdateBotDetectionSettings(gomock.Any(), in).Return(nil)
+	repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(in, nil)
+
+	out, err := svc.UpdateBotDetectionSettings(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, in, out)
+}
+
+func TestService_UpdateBotDetectionSettings_invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	valid := entity.BotDetectionSettings{MinConcurrentChannels: 8, MaxMessagesPerHour: 0.05, ViewersPerChatter: 4}
+
+	for name, mutate := range map[string]func(*entity.BotDetectionSettings){
+		"one channel":       func(s *entity.BotDetectionSettings) { s.MinConcurrentChannels = 1 },
+		"negative messages": func(s *entity.BotDetectionSettings) { s.MaxMessagesPerHour = -1 },
+		"ratio below one":   func(s *entity.BotDetectionSettings) { s.ViewersPerChatter = 0.5 },
+	} {
+		in := valid
+		mutate(&in)
+
+		_, err := svc.UpdateBotDetectionSettings(context.Background(), in)
+		require.ErrorIs(t, err, entity.ErrInvalidBotDetectionSettings, name)
+	}
+}
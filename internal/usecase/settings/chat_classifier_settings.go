@@ -0,0 +1,96 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// maxChatClassifierBatchSize keeps one classifier prompt well inside small model context windows.
+const maxChatClassifierBatchSize = 100
+
+func (s *Usecase) GetChatClassifierSettings(ctx context.Context) (entity.ChatClassifierSettings, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.get_chat_classifier_settings")
+	defer span.End()
+
+	out, err := s.repo.GetChatClassifierSettings(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get chat classifier settings failed", err)
+	}
+
+	return out, err
+}
+
+// ChatClassifierTokensUsedToday returns the tokens the classifier spent on the current UTC day.
+func (s *Usecase) ChatClassifierTokensUsedToday(ctx context.Context) (int64, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.chat_classifier_tokens_used_today")
+	defer span.End()
+
+	out, err := s.repo.ChatClassifierTokensUsed(ctx, time.Now().UTC())
+	if err != nil {
+		s.obs.LogError(ctx, span, "get chat classifier tokens used failed", err)
+	}
+
+	return out, err
+}
+
+func (s *Usecase) UpdateChatClassifierSettings(ctx context.Context, in entity.ChatClassifierSettings) (entity.ChatClassifierSettings, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.update_chat_classifier_settings")
+	defer span.End()
+
+	if err := validateChatClassifierSettings(in); err != nil {
+		return entity.ChatClassifierSettings{}, err
+	}
+
+	channels := make([]string, 0, len(in.Channels))
+
+	for _, c := range in.Channels {
+		login := strings.ToLower(strings.TrimSpace(c))
+		if slices.Contains(channels, login) {
+			continue
+		}
+
+		_, ok, err := s.repo.MonitoredChannelTwitchUserID(ctx, login)
+		if err != nil {
+			s.obs.LogError(ctx, span, "resolve chat classifier channel failed", err)
+			return entity.ChatClassifierSettings{}, err
+		}
+
+		if !ok {
+			return entity.ChatClassifierSettings{}, fmt.Errorf("%w: %q is not a monitored channel", entity.ErrInvalidChatClassifierSettings, c)
+		}
+
+		channels = append(channels, login)
+	}
+
+	in.Channels = channels
+
+	if err := s.repo.UpdateChatClassifierSettings(ctx, in); err != nil {
+		s.obs.LogError(ctx, span, "update chat classifier settings failed", err)
+		return entity.ChatClassifierSettings{}, err
+	}
+
+	return s.repo.GetChatClassifierSettings(ctx)
+}
+
+func validateChatClassifierSettings(in entity.ChatClassifierSettings) error {
+	if math.IsNaN(in.SampleRate) || in.SampleRate < 0 || in.SampleRate > 1 {
+		return fmt.Errorf("%w: sample_rate must be between 0 and 1", entity.ErrInvalidChatClassifierSettings)
+	}
+
+	if in.BatchSize < 1 || in.BatchSize > maxChatClassifierBatchSize {
+		return fmt.Errorf("%w: batch_size must be between 1 and %d", entity.ErrInvalidChatClassifierSettings,
+			maxChatClassifierBatchSize)
+	}
+
+	if in.DailyTokenBudget < 0 {
+		return fmt.Errorf("%w: daily_token_budget must not be negative", entity.ErrInvalidChatClassifierSettings)
+	}
+
+	return nil
+}
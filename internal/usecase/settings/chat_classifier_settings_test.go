@@ -0,0 +1,49 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_UpdateChatClassifierSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	in := entity.ChatClassifierSettings{Enabled: true, Channels: []string{" Streamer", "streamer"}, SampleRate: 0.5, BatchSize: 10, DailyTokenBudget: 5000}
+	want := in
+	want.Channels = []string{"streamer"}
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "streamer").Return(int64(1), true, nil)
+	repo.EXPECT().UpdateChatClassifierSettings(gomock.Any(), want).Return(nil)
+	repo.EXPECT().GetChatClassifierSettings(gomock.Any()).Return(want, nil)
+
+	out, err := svc.UpdateChatClassifierSettings(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, want, out)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "nobody").Return(int64(0), false, nil)
+
+	_, err = svc.UpdateChatClassifierSettings(context.Background(), entity.ChatClassifierSettings{Channels: []string{"nobody"}, SampleRate: 0.5, BatchSize: 10})
+	require.ErrorIs(t, err, entity.ErrInvalidChatClassifierSettings)
+
+	for _, bad := range []entity.ChatClassifierSettings{
+		{SampleRate: 1.5, BatchSize: 10},
+		{SampleRate: 0.5, BatchSize: 0},
+		{SampleRate: 0.5, BatchSize: 10, DailyTokenBudget: -1},
+	} {
+		_, err = svc.UpdateChatClassifierSettings(context.Background(), bad)
+		require.ErrorIs(t, err, entity.ErrInvalidChatClassifierSettings)
+	}
+}
@@ -0,0 +1,90 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	defaultBlocklistSyncMinutes = 24 * 60
+	minBlocklistSyncMinutes     = 15
+	maxBlocklistSyncMinutes     = 7 * 24 * 60
+	maxBlocklistNameLen         = 100
+)
+
+// CreateBlocklist validates and stores an empty blocklist. Lists with a source URL are filled by the sync loop;
+// others by UploadBlocklist.
+func (s *Usecase) CreateBlocklist(ctx context.Context, b entity.Blocklist) (entity.Blocklist, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.create_blocklist")
+	defer span.End()
+
+	b.Name = strings.TrimSpace(b.Name)
+
+	if b.SourceURL != nil {
+		if u := strings.TrimSpace(*b.SourceURL); u != "" {
+			b.SourceURL = &u
+		} else {
+			b.SourceURL = nil
+		}
+	}
+
+	if b.SyncIntervalMinutes == 0 {
+		b.SyncIntervalMinutes = defaultBlocklistSyncMinutes
+	}
+
+	if err := validateBlocklist(b); err != nil {
+		return entity.Blocklist{}, err
+	}
+
+	existing, err := s.repo.ListBlocklists(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list blocklists failed", err)
+		return entity.Blocklist{}, err
+	}
+
+	for _, e := range existing {
+		if strings.EqualFold(e.Name, b.Name) {
+			return entity.Blocklist{}, fmt.Errorf("%w: name %q is already used", entity.ErrInvalidBlocklist, b.Name)
+		}
+	}
+
+	out, err := s.repo.CreateBlocklist(ctx, b)
+	if err != nil {
+		s.obs.LogError(ctx, span, "create blocklist failed", err, zap.String("name", b.Name))
+		return entity.Blocklist{}, err
+	}
+
+	return out, nil
+}
+
+func validateBlocklist(b entity.Blocklist) error {
+	if b.Name == "" || len(b.Name) > maxBlocklistNameLen {
+		return fmt.Errorf("%w: name must be 1-%d characters", entity.ErrInvalidBlocklist, maxBlocklistNameLen)
+	}
+
+	switch b.Format {
+	case entity.BlocklistFormatText, entity.BlocklistFormatCSV, entity.BlocklistFormatJSON:
+	default:
+		return fmt.Errorf("%w: unknown format %q", entity.ErrInvalidBlocklist, b.Format)
+	}
+
+	if b.SourceURL != nil {
+		u, err := url.Parse(*b.SourceURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: source_url must be an http(s) URL", entity.ErrInvalidBlocklist)
+		}
+	}
+
+	if b.SyncIntervalMinutes < minBlocklistSyncMinutes || b.SyncIntervalMinutes > maxBlocklistSyncMinutes {
+		return fmt.Errorf("%w: sync_interval_minutes must be between %d and %d",
+			entity.ErrInvalidBlocklist, minBlocklistSyncMinutes, maxBlocklistSyncMinutes)
+	}
+
+	return nil
+}
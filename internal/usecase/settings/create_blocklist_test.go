@@ -0,0 +1,62 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_CreateBlocklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	src := " https://lists.example.org/raids.txt "
+	want := "https://lists.example.org/raids.txt"
+
+	repo.EXPECT().ListBlocklists(gomock.Any()).Return([]entity.Blocklist{{Name: "other"}}, nil)
+	repo.EXPECT().CreateBlocklist(gomock.Any(), entity.Blocklist{
+		Name: "raids", Format: entity.BlocklistFormatText, SourceURL: &want, SyncIntervalMinutes: 1440, Enabled: true,
+	}).Return(entity.Blocklist{ID: 4, Name: "raids"}, nil)
+
+	out, err := svc.CreateBlocklist(context.Background(), entity.Blocklist{
+		Name: " raids ", Format: entity.BlocklistFormatText, SourceURL: &src, Enabled: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), out.ID)
+}
+
+func TestService_CreateBlocklist_invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	ftp := "ftp://lists.example.org/raids.txt"
+
+	for _, b := range []entity.Blocklist{
+		{Name: "", Format: entity.BlocklistFormatText},
+		{Name: "raids", Format: "xml"},
+		{Name: "raids", Format: entity.BlocklistFormatText, SourceURL: &ftp},
+		{Name: "raids", Format: entity.BlocklistFormatText, SyncIntervalMinutes: 5},
+	} {
+		_, err := svc.CreateBlocklist(context.Background(), b)
+		require.ErrorIs(t, err, entity.ErrInvalidBlocklist, b)
+	}
+
+	repo.EXPECT().ListBlocklists(gomock.Any()).Return([]entity.Blocklist{{Name: "Raids"}}, nil)
+
+	_, err := svc.CreateBlocklist(context.Background(), entity.Blocklist{Name: "raids", Format: entity.BlocklistFormatJSON})
+	require.ErrorIs(t, err, entity.ErrInvalidBlocklist, "duplicate name")
+}
@@ -0,0 +1,34 @@
+package settings
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// CreateLoginPattern validates and stores a blacklist pattern, then re-evaluates known users against it.
+func (s *Usecase) CreateLoginPattern(ctx context.Context, p entity.LoginPattern) (entity.LoginPattern, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.create_login_pattern")
+	defer span.End()
+
+	p.Pattern = strings.TrimSpace(p.Pattern)
+	p.Description = strings.TrimSpace(p.Description)
+
+	if _, err := entity.CompileLoginPattern(p.Kind, p.Pattern); err != nil {
+		return entity.LoginPattern{}, err
+	}
+
+	out, err := s.repo.CreateLoginPattern(ctx, p)
+	if err != nil {
+		s.obs.LogError(ctx, span, "create login pattern failed", err, zap.String("pattern", p.Pattern))
+		return entity.LoginPattern{}, err
+	}
+
+	s.invalidateLoginPatterns()
+	s.startSuspicionReevaluation(entity.SuspicionReevalReasonLoginPatterns)
+
+	return out, nil
+}
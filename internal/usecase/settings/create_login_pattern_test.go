@@ -0,0 +1,68 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_CreateLoginPattern(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	var reasons []string
+	svc.SetSuspicionReevaluator(reevaluatorFunc(func(reason string, _ int) { reasons = append(reasons, reason) }))
+
+	repo.EXPECT().CreateLoginPattern(gomock.Any(), entity.LoginPattern{
+		Kind: entity.LoginPatternGlob, Pattern: "*hate*", Description: "slurs",
+	}).Return(entity.LoginPattern{ID: 3, Kind: entity.LoginPatternGlob, Pattern: "*hate*", Description: "slurs"}, nil)
+
+	out, err := svc.CreateLoginPattern(context.Background(), entity.LoginPattern{
+		Kind: entity.LoginPatternGlob, Pattern: "  *hate* ", Description: " slurs ",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), out.ID)
+	require.Equal(t, []string{entity.SuspicionReevalReasonLoginPatterns}, reasons)
+}
+
+func TestService_CreateLoginPattern_invalidatesWithoutReevaluator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	invalidated := 0
+	svc.SetLoginPatternInvalidator(invalidatorFunc(func() { invalidated++ }))
+
+	repo.EXPECT().CreateLoginPattern(gomock.Any(), gomock.Any()).Return(entity.LoginPattern{ID: 3}, nil)
+
+	_, err := svc.CreateLoginPattern(context.Background(), entity.LoginPattern{Kind: entity.LoginPatternGlob, Pattern: "*hate*"})
+	require.NoError(t, err)
+	require.Equal(t, 1, invalidated)
+}
+
+func TestService_CreateLoginPattern_invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	_, err := svc.CreateLoginPattern(context.Background(), entity.LoginPattern{Kind: entity.LoginPatternRegex, Pattern: "("})
+	require.ErrorIs(t, err, entity.ErrInvalidLoginPattern)
+
+	_, err = svc.CreateLoginPattern(context.Background(), entity.LoginPattern{Kind: "exact", Pattern: "bob"})
+	require.ErrorIs(t, err, entity.ErrInvalidLoginPattern)
+}
@@ -6,9 +6,23 @@ import (
 	"github.com/rofleksey/dredge/internal/entity"
 )
 
-func (s *Usecase) CreateNotification(ctx context.Context, provider string, settings map[string]any, enabled bool) (entity.NotificationEntry, error) {
+func (s *Usecase) CreateNotification(ctx context.Context, provider string, settings map[string]any, enabled bool, routing entity.NotificationRouting, policy entity.NotificationPolicy) (entity.NotificationEntry, error) {
 	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.create_notification")
 	defer span.End()
 
-	return s.repo.CreateNotificationEntry(ctx, provider, settings, enabled)
+	if err := s.validateNotificationSettings(provider, settings); err != nil {
+		return entity.NotificationEntry{}, err
+	}
+
+	routing, err := normalizeNotificationRouting(routing)
+	if err != nil {
+		return entity.NotificationEntry{}, err
+	}
+
+	policy, err = normalizeNotificationPolicy(policy)
+	if err != nil {
+		return entity.NotificationEntry{}, err
+	}
+
+	return s.repo.CreateNotificationEntry(ctx, provider, settings, enabled, routing, policy)
 }
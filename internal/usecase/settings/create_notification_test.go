@@ -2,7 +2,9 @@ package settings
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
@@ -21,9 +23,63 @@ func TestService_CreateNotification(t *testing.T) {
 	repo := repomocks.NewMockStore(ctrl)
 	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
 
-	repo.EXPECT().CreateNotificationEntry(gomock.Any(), "telegram", map[string]any{}, true).Return(entity.NotificationEntry{ID: 2}, nil)
+	repo.EXPECT().CreateNotificationEntry(gomock.Any(), "telegram", map[string]any{}, true, entity.NotificationRouting{Tags: []string{"mods"}},
+		entity.NotificationPolicy{FloodThreshold: 5, FloodWindow: time.Minute}).Return(entity.NotificationEntry{ID: 2}, nil)
 
-	created, err := svc.CreateNotification(context.Background(), "telegram", map[string]any{}, true)
+	created, err := svc.CreateNotification(context.Background(), "telegram", map[string]any{}, true, entity.NotificationRouting{Tags: []string{"Mods"}},
+		entity.NotificationPolicy{FloodThreshold: 5})
 	require.NoError(t, err)
 	require.Equal(t, int64(2), created.ID)
 }
+
+func TestService_CreateNotification_invalidEventType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	_, err := svc.CreateNotification(context.Background(), "webhook", map[string]any{}, true, entity.NotificationRouting{EventTypes: []string{"raid"}}, entity.NotificationPolicy{})
+	require.ErrorIs(t, err, entity.ErrInvalidNotification)
+}
+
+// providersFunc adapts a function to NotificationProviders.
+type providersFunc func(provider string, settings map[string]any) error
+
+func (f providersFunc) ValidateSettings(provider string, settings map[string]any) error {
+	return f(provider, settings)
+}
+
+// requireURL accepts only entries with settings.url, standing in for the notify registry.
+var requireURL = providersFunc(func(provider string, settings map[string]any) error {
+	if _, ok := settings["url"].(string); !ok {
+		return fmt.Errorf("%s: settings.url is required: %w", provider, entity.ErrInvalidNotification)
+	}
+
+	return nil
+})
+
+func TestService_CreateNotification_invalidSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+	svc.SetNotificationProviders(requireURL)
+
+	_, err := svc.CreateNotification(context.Background(), "webhook", map[string]any{}, true, entity.NotificationRouting{}, entity.NotificationPolicy{})
+	require.ErrorIs(t, err, entity.ErrInvalidNotification)
+}
+
+func TestService_CreateNotification_invalidQuietHours(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	_, err := svc.CreateNotification(context.Background(), "webhook", map[string]any{}, true, entity.NotificationRouting{}, entity.NotificationPolicy{
+		QuietHours: &entity.NotificationQuietHours{Start: "23:00", End: "07:00", Timezone: "Mars/Olympus"},
+	})
+	require.ErrorIs(t, err, entity.ErrInvalidNotification)
+}
@@ -0,0 +1,28 @@
+package settings
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// DeleteBlocklist removes a blocklist; users it had flagged are cleared by the following re-evaluation.
+func (s *Usecase) DeleteBlocklist(ctx context.Context, id int64) error {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.delete_blocklist")
+	defer span.End()
+
+	if err := s.repo.DeleteBlocklist(ctx, id); err != nil {
+		if !errors.Is(err, entity.ErrBlocklistNotFound) {
+			s.obs.LogError(ctx, span, "delete blocklist failed", err, zap.Int64("id", id))
+		}
+
+		return err
+	}
+
+	s.startSuspicionReevaluation(entity.SuspicionReevalReasonBlocklists)
+
+	return nil
+}
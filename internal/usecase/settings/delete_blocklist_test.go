@@ -0,0 +1,33 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_DeleteBlocklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	var reasons []string
+	svc.SetSuspicionReevaluator(reevaluatorFunc(func(reason string, _ int) { reasons = append(reasons, reason) }))
+
+	repo.EXPECT().DeleteBlocklist(gomock.Any(), int64(3)).Return(nil)
+	repo.EXPECT().DeleteBlocklist(gomock.Any(), int64(4)).Return(entity.ErrBlocklistNotFound)
+
+	require.NoError(t, svc.DeleteBlocklist(context.Background(), 3))
+	require.ErrorIs(t, svc.DeleteBlocklist(context.Background(), 4), entity.ErrBlocklistNotFound)
+	require.Equal(t, []string{entity.SuspicionReevalReasonBlocklists}, reasons)
+}
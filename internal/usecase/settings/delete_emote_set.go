@@ -0,0 +1,26 @@
+package settings
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// DeleteEmoteSet removes a set; emote occurrences already stored from it are kept.
+func (s *Usecase) DeleteEmoteSet(ctx context.Context, id int64) error {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.delete_emote_set")
+	defer span.End()
+
+	if err := s.repo.DeleteEmoteSet(ctx, id); err != nil {
+		if !errors.Is(err, entity.ErrEmoteSetNotFound) {
+			s.obs.LogError(ctx, span, "delete emote set failed", err, zap.Int64("id", id))
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package settings
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// DeleteLoginPattern removes a pattern; users it had flagged are cleared by the following re-evaluation.
+func (s *Usecase) DeleteLoginPattern(ctx context.Context, id int64) error {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.delete_login_pattern")
+	defer span.End()
+
+	if err := s.repo.DeleteLoginPattern(ctx, id); err != nil {
+		if !errors.Is(err, entity.ErrLoginPatternNotFound) {
+			s.obs.LogError(ctx, span, "delete login pattern failed", err, zap.Int64("id", id))
+		}
+
+		return err
+	}
+
+	s.invalidateLoginPatterns()
+	s.startSuspicionReevaluation(entity.SuspicionReevalReasonLoginPatterns)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_DeleteLoginPattern(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	var reasons []string
+	svc.SetSuspicionReevaluator(reevaluatorFunc(func(reason string, _ int) { reasons = append(reasons, reason) }))
+
+	repo.EXPECT().DeleteLoginPattern(gomock.Any(), int64(3)).Return(nil)
+	repo.EXPECT().DeleteLoginPattern(gomock.Any(), int64(4)).Return(entity.ErrLoginPatternNotFound)
+
+	require.NoError(t, svc.DeleteLoginPattern(context.Background(), 3))
+	require.ErrorIs(t, svc.DeleteLoginPattern(context.Background(), 4), entity.ErrLoginPatternNotFound)
+	require.Equal(t, []string{entity.SuspicionReevalReasonLoginPatterns}, reasons)
+}
+
+func TestService_DeleteLoginPattern_invalidatesWithoutReevaluator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	invalidated := 0
+	svc.SetLoginPatternInvalidator(invalidatorFunc(func() { invalidated++ }))
+
+	repo.EXPECT().DeleteLoginPattern(gomock.Any(), int64(3)).Return(nil)
+	repo.EXPECT().DeleteLoginPattern(gomock.Any(), int64(4)).Return(entity.ErrLoginPatternNotFound)
+
+	require.NoError(t, svc.DeleteLoginPattern(context.Background(), 3))
+	require.ErrorIs(t, svc.DeleteLoginPattern(context.Background(), 4), entity.ErrLoginPatternNotFound)
+	require.Equal(t, 1, invalidated, "only a successful delete invalidates")
+}
@@ -0,0 +1,13 @@
+package settings
+
+import (
+	"context"
+)
+
+// DeleteNotificationSnooze ends a snooze early.
+func (s *Usecase) DeleteNotificationSnooze(ctx context.Context, id int64) error {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.delete_notification_snooze")
+	defer span.End()
+
+	return s.repo.DeleteNotificationSnooze(ctx, id)
+}
@@ -0,0 +1,29 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_DeleteNotificationSnooze(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	repo.EXPECT().DeleteNotificationSnooze(gomock.Any(), int64(4)).Return(nil)
+	repo.EXPECT().DeleteNotificationSnooze(gomock.Any(), int64(5)).Return(entity.ErrNotificationSnoozeNotFound)
+
+	require.NoError(t, svc.DeleteNotificationSnooze(context.Background(), 4))
+	require.ErrorIs(t, svc.DeleteNotificationSnooze(context.Background(), 5), entity.ErrNotificationSnoozeNotFound)
+}
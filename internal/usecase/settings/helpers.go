@@ -1,7 +1,9 @@
 package settings
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/rofleksey/dredge/internal/entity"
 )
@@ -46,3 +48,119 @@ func normalizeChannelDiscoverySettings(s entity.ChannelDiscoverySettings) entity
 	return out
 }
 
+// normalizeNotificationRouting lowercases and de-duplicates tags and channels and rejects unknown event types.
+func normalizeNotificationRouting(r entity.NotificationRouting) (entity.NotificationRouting, error) {
+	out := entity.NotificationRouting{
+		Tags:     normalizeLowerList(r.Tags, ""),
+		Channels: normalizeLowerList(r.Channels, "#"),
+	}
+
+	for _, ev := range normalizeLowerList(r.EventTypes, "") {
+		switch ev {
+		case entity.NotifyEventChatMessage, entity.NotifyEventStreamStart, entity.NotifyEventStreamEnd, entity.NotifyEventInterval:
+			out.EventTypes = append(out.EventTypes, ev)
+		default:
+			return entity.NotificationRouting{}, fmt.Errorf("unknown event type %q: %w", ev, entity.ErrInvalidNotification)
+		}
+	}
+
+	return out, nil
+}
+
+// Flood control bounds for NotificationPolicy.
+const (
+	maxNotificationFloodThreshold  = 1000
+	defaultNotificationFloodWindow = time.Minute
+	maxNotificationFloodWindow     = 24 * time.Hour
+)
+
+// normalizeNotificationPolicy validates quiet hours (HH:MM bounds and an IANA time zone) and flood
+// control limits, defaulting the flood window to one minute when only a threshold is given.
+func normalizeNotificationPolicy(p entity.NotificationPolicy) (entity.NotificationPolicy, error) {
+	out := entity.NotificationPolicy{FloodThreshold: p.FloodThreshold, FloodWindow: p.FloodWindow}
+
+	if q := p.QuietHours; q != nil {
+		norm := entity.NotificationQuietHours{
+			Start:    strings.TrimSpace(q.Start),
+			End:      strings.TrimSpace(q.End),
+			Timezone: strings.TrimSpace(q.Timezone),
+		}
+
+		start, err1 := time.Parse("15:04", norm.Start)
+		end, err2 := time.Parse("15:04", norm.End)
+
+		if err1 != nil || err2 != nil {
+			return entity.NotificationPolicy{}, fmt.Errorf("quiet hours start and end must be HH:MM: %w", entity.ErrInvalidNotification)
+		}
+
+		if start.Equal(end) {
+			return entity.NotificationPolicy{}, fmt.Errorf("quiet hours start and end must differ: %w", entity.ErrInvalidNotification)
+		}
+
+		if norm.Timezone == "" {
+			norm.Timezone = "UTC"
+		}
+
+		if _, err := time.LoadLocation(norm.Timezone); err != nil {
+			return entity.NotificationPolicy{}, fmt.Errorf("unknown quiet hours timezone %q: %w", norm.Timezone, entity.ErrInvalidNotification)
+		}
+
+		norm.Start = start.Format("15:04")
+		norm.End = end.Format("15:04")
+		out.QuietHours = &norm
+	}
+
+	if out.FloodThreshold < 0 || out.FloodThreshold > maxNotificationFloodThreshold {
+		return entity.NotificationPolicy{}, fmt.Errorf("flood threshold must be 0-%d: %w", maxNotificationFloodThreshold, entity.ErrInvalidNotification)
+	}
+
+	if out.FloodThreshold == 0 {
+		out.FloodWindow = 0
+		return out, nil
+	}
+
+	if out.FloodWindow == 0 {
+		out.FloodWindow = defaultNotificationFloodWindow
+	}
+
+	if out.FloodWindow < time.Second || out.FloodWindow > maxNotificationFloodWindow {
+		return entity.NotificationPolicy{}, fmt.Errorf("flood window must be between 1s and %s: %w", maxNotificationFloodWindow, entity.ErrInvalidNotification)
+	}
+
+	return out, nil
+}
+
+// validateNotificationSettings checks settings against the provider registry when one is configured.
+func (s *Usecase) validateNotificationSettings(provider string, settings map[string]any) error {
+	if s.providers == nil {
+		return nil
+	}
+
+	return s.providers.ValidateSettings(provider, settings)
+}
+
+func normalizeLowerList(in []string, trimPrefix string) []string {
+	var out []string
+
+	seen := make(map[string]struct{}, len(in))
+
+	for _, v := range in {
+		x := strings.ToLower(strings.TrimSpace(v))
+		if trimPrefix != "" {
+			x = strings.TrimPrefix(x, trimPrefix)
+		}
+
+		if x == "" {
+			continue
+		}
+
+		if _, ok := seen[x]; ok {
+			continue
+		}
+
+		seen[x] = struct{}{}
+		out = append(out, x)
+	}
+
+	return out
+}
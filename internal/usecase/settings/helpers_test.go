@@ -2,8 +2,12 @@ package settings
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
 )
 
 func TestNormalizeTwitchAccountLinkType(t *testing.T) {
@@ -14,3 +18,47 @@ func TestNormalizeTwitchAccountLinkType(t *testing.T) {
 	assert.Equal(t, "main", normalizeTwitchAccountLinkType(""))
 	assert.Equal(t, "main", normalizeTwitchAccountLinkType("anything"))
 }
+
+func TestNormalizeNotificationRouting(t *testing.T) {
+	t.Parallel()
+
+	out, err := normalizeNotificationRouting(entity.NotificationRouting{
+		Tags:       []string{" Mods ", "mods", ""},
+		EventTypes: []string{"Stream_Start"},
+		Channels:   []string{"#ChanA", "chana"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mods"}, out.Tags)
+	assert.Equal(t, []string{"stream_start"}, out.EventTypes)
+	assert.Equal(t, []string{"chana"}, out.Channels)
+
+	_, err = normalizeNotificationRouting(entity.NotificationRouting{EventTypes: []string{"raid"}})
+	require.ErrorIs(t, err, entity.ErrInvalidNotification)
+}
+
+func TestNormalizeNotificationPolicy(t *testing.T) {
+	t.Parallel()
+
+	out, err := normalizeNotificationPolicy(entity.NotificationPolicy{
+		QuietHours:     &entity.NotificationQuietHours{Start: " 9:05", End: "07:00"},
+		FloodThreshold: 3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &entity.NotificationQuietHours{Start: "09:05", End: "07:00", Timezone: "UTC"}, out.QuietHours)
+	assert.Equal(t, time.Minute, out.FloodWindow)
+
+	out, err = normalizeNotificationPolicy(entity.NotificationPolicy{FloodWindow: time.Hour})
+	require.NoError(t, err)
+	assert.Zero(t, out.FloodWindow, "window is dropped without a threshold")
+
+	for name, p := range map[string]entity.NotificationPolicy{
+		"bad start":     {QuietHours: &entity.NotificationQuietHours{Start: "25:00", End: "07:00"}},
+		"empty window":  {QuietHours: &entity.NotificationQuietHours{Start: "07:00", End: "07:00"}},
+		"bad zone":      {QuietHours: &entity.NotificationQuietHours{Start: "22:00", End: "07:00", Timezone: "Nowhere/City"}},
+		"neg threshold": {FloodThreshold: -1},
+		"long window":   {FloodThreshold: 1, FloodWindow: 48 * time.Hour},
+	} {
+		_, err := normalizeNotificationPolicy(p)
+		require.ErrorIs(t, err, entity.ErrInvalidNotification, name)
+	}
+}
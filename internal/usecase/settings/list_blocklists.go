@@ -0,0 +1,19 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func (s *Usecase) ListBlocklists(ctx context.Context) ([]entity.Blocklist, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.list_blocklists")
+	defer span.End()
+
+	out, err := s.repo.ListBlocklists(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list blocklists failed", err)
+	}
+
+	return out, err
+}
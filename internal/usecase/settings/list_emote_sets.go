@@ -0,0 +1,19 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func (s *Usecase) ListEmoteSets(ctx context.Context) ([]entity.EmoteSet, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.list_emote_sets")
+	defer span.End()
+
+	out, err := s.repo.ListEmoteSets(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list emote sets failed", err)
+	}
+
+	return out, err
+}
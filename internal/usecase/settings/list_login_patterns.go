@@ -0,0 +1,19 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func (s *Usecase) ListLoginPatterns(ctx context.Context) ([]entity.LoginPattern, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.list_login_patterns")
+	defer span.End()
+
+	out, err := s.repo.ListLoginPatterns(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list login patterns failed", err)
+	}
+
+	return out, err
+}
@@ -0,0 +1,14 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func (s *Usecase) ListNotificationDeliveries(ctx context.Context, f entity.NotificationDeliveryListFilter) ([]entity.NotificationDelivery, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.list_notification_deliveries")
+	defer span.End()
+
+	return s.repo.ListNotificationDeliveries(ctx, f)
+}
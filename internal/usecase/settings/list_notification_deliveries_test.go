@@ -0,0 +1,31 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_ListNotificationDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	nid := int64(3)
+	f := entity.NotificationDeliveryListFilter{NotificationEntryID: &nid, Status: entity.NotificationDeliveryDead, Limit: 10}
+	repo.EXPECT().ListNotificationDeliveries(gomock.Any(), f).Return([]entity.NotificationDelivery{{ID: 1, NotificationEntryID: 3}}, nil)
+
+	list, err := svc.ListNotificationDeliveries(context.Background(), f)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
@@ -0,0 +1,16 @@
+package settings
+
+import (
+	"context"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListNotificationSnoozes returns snoozes that are still active, soonest expiry first.
+func (s *Usecase) ListNotificationSnoozes(ctx context.Context) ([]entity.NotificationSnooze, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.list_notification_snoozes")
+	defer span.End()
+
+	return s.repo.ListActiveNotificationSnoozes(ctx, time.Now())
+}
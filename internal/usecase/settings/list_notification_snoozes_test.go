@@ -0,0 +1,36 @@
+package settings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_ListNotificationSnoozes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	want := []entity.NotificationSnooze{{ID: 1, Channel: "chan", Until: time.Now().Add(time.Hour)}}
+
+	repo.EXPECT().ListActiveNotificationSnoozes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, at time.Time) ([]entity.NotificationSnooze, error) {
+			require.WithinDuration(t, time.Now(), at, time.Minute)
+			return want, nil
+		})
+
+	got, err := svc.ListNotificationSnoozes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,259 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+var (
+	blocklistLoginKeys = []string{"login", "user_login", "username", "user_name", "name"}
+	blocklistIDKeys    = []string{"id", "user_id", "twitch_id", "twitch_user_id"}
+	blocklistJSONLists = []string{"users", "accounts", "entries", "logins", "data"}
+)
+
+// ParseBlocklist extracts entries from list content.
+//
+//   - text: one account per line; blank lines and lines starting with # are ignored.
+//   - csv: a header naming a login column (login, user_login, username, ...) and/or an id column (id, user_id, ...);
+//     without a recognized header the first column of every row is used.
+//   - json: an array of strings, numbers or objects with the same keys as csv, or an object holding such an array
+//     under users, accounts, entries, logins or data.
+//
+// Bare values made only of digits are Twitch user ids, anything else is a login (a leading @ is dropped).
+// Duplicates are removed; content without any entry is rejected.
+func ParseBlocklist(format string, content []byte) ([]entity.BlocklistEntry, error) {
+	var (
+		out []entity.BlocklistEntry
+		err error
+	)
+
+	switch format {
+	case entity.BlocklistFormatText:
+		out, err = parseBlocklistText(content)
+	case entity.BlocklistFormatCSV:
+		out, err = parseBlocklistCSV(content)
+	case entity.BlocklistFormatJSON:
+		out, err = parseBlocklistJSON(content)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", entity.ErrInvalidBlocklist, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	out = dedupeBlocklistEntries(out)
+
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%w: no entries found", entity.ErrInvalidBlocklist)
+	}
+
+	if len(out) > entity.MaxBlocklistEntries {
+		return nil, fmt.Errorf("%w: %d entries exceed the limit of %d", entity.ErrInvalidBlocklist, len(out), entity.MaxBlocklistEntries)
+	}
+
+	return out, nil
+}
+
+func parseBlocklistText(content []byte) ([]entity.BlocklistEntry, error) {
+	var out []entity.BlocklistEntry
+
+	for line := range strings.Lines(string(content)) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if e, ok := blocklistValue(line); ok {
+			out = append(out, e)
+		}
+	}
+
+	return out, nil
+}
+
+func parseBlocklistCSV(content []byte) ([]entity.BlocklistEntry, error) {
+	r := csv.NewReader(bytes.NewReader(content))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	loginCol, idCol := -1, -1
+	first := true
+
+	var out []entity.BlocklistEntry
+
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", entity.ErrInvalidBlocklist, err)
+		}
+
+		if first {
+			first = false
+
+			for i, h := range rec {
+				h = strings.ToLower(strings.TrimSpace(h))
+
+				switch {
+				case loginCol < 0 && slices.Contains(blocklistLoginKeys, h):
+					loginCol = i
+				case idCol < 0 && slices.Contains(blocklistIDKeys, h):
+					idCol = i
+				}
+			}
+
+			if loginCol >= 0 || idCol >= 0 {
+				continue
+			}
+		}
+
+		if loginCol < 0 && idCol < 0 {
+			if len(rec) > 0 {
+				if e, ok := blocklistValue(rec[0]); ok {
+					out = append(out, e)
+				}
+			}
+
+			continue
+		}
+
+		var e entity.BlocklistEntry
+
+		if loginCol >= 0 && loginCol < len(rec) {
+			e.Login = normalizeBlocklistLogin(rec[loginCol])
+		}
+
+		if idCol >= 0 && idCol < len(rec) {
+			e.TwitchUserID = parseBlocklistID(rec[idCol])
+		}
+
+		if e.Login != "" || e.TwitchUserID > 0 {
+			out = append(out, e)
+		}
+	}
+
+	return out, nil
+}
+
+func parseBlocklistJSON(content []byte) ([]entity.BlocklistEntry, error) {
+	var root any
+	if err := json.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("%w: %w", entity.ErrInvalidBlocklist, err)
+	}
+
+	items, ok := root.([]any)
+	if !ok {
+		obj, isObj := root.(map[string]any)
+		if !isObj {
+			return nil, fmt.Errorf("%w: json must be an array or an object holding one", entity.ErrInvalidBlocklist)
+		}
+
+		for _, k := range blocklistJSONLists {
+			if items, ok = obj[k].([]any); ok {
+				break
+			}
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("%w: json object has no %s array", entity.ErrInvalidBlocklist, strings.Join(blocklistJSONLists, "/"))
+		}
+	}
+
+	var out []entity.BlocklistEntry
+
+	for _, it := range items {
+		switch v := it.(type) {
+		case string:
+			if e, ok := blocklistValue(v); ok {
+				out = append(out, e)
+			}
+		case float64:
+			if v > 0 && v == float64(int64(v)) {
+				out = append(out, entity.BlocklistEntry{TwitchUserID: int64(v)})
+			}
+		case map[string]any:
+			var e entity.BlocklistEntry
+
+			for _, k := range blocklistLoginKeys {
+				if s, ok := v[k].(string); ok && e.Login == "" {
+					e.Login = normalizeBlocklistLogin(s)
+				}
+			}
+
+			for _, k := range blocklistIDKeys {
+				if e.TwitchUserID > 0 {
+					break
+				}
+
+				switch id := v[k].(type) {
+				case string:
+					e.TwitchUserID = parseBlocklistID(id)
+				case float64:
+					if id > 0 && id == float64(int64(id)) {
+						e.TwitchUserID = int64(id)
+					}
+				}
+			}
+
+			if e.Login != "" || e.TwitchUserID > 0 {
+				out = append(out, e)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// blocklistValue reads a bare value: digits are a user id, anything else a login.
+func blocklistValue(v string) (entity.BlocklistEntry, bool) {
+	if id := parseBlocklistID(v); id > 0 {
+		return entity.BlocklistEntry{TwitchUserID: id}, true
+	}
+
+	login := normalizeBlocklistLogin(v)
+
+	return entity.BlocklistEntry{Login: login}, login != ""
+}
+
+func normalizeBlocklistLogin(v string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(v), "@"))
+}
+
+func parseBlocklistID(v string) int64 {
+	id, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	if err != nil || id <= 0 {
+		return 0
+	}
+
+	return id
+}
+
+func dedupeBlocklistEntries(in []entity.BlocklistEntry) []entity.BlocklistEntry {
+	seen := make(map[entity.BlocklistEntry]struct{}, len(in))
+	out := in[:0]
+
+	for _, e := range in {
+		if _, ok := seen[e]; ok {
+			continue
+		}
+
+		seen[e] = struct{}{}
+		out = append(out, e)
+	}
+
+	return out
+}
@@ -0,0 +1,50 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func TestParseBlocklist_text(t *testing.T) {
+	got, err := ParseBlocklist(entity.BlocklistFormatText, []byte("# hate raid 2026-01\n@RaidBot_1\n\nraidbot_1\n  123456  \n"))
+	require.NoError(t, err)
+	assert.Equal(t, []entity.BlocklistEntry{{Login: "raidbot_1"}, {TwitchUserID: 123456}}, got)
+}
+
+func TestParseBlocklist_csv(t *testing.T) {
+	got, err := ParseBlocklist(entity.BlocklistFormatCSV, []byte("reason,user_login,user_id\nspam,Bot_A,11\nspam,,12\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []entity.BlocklistEntry{{Login: "bot_a", TwitchUserID: 11}, {TwitchUserID: 12}}, got)
+
+	got, err = ParseBlocklist(entity.BlocklistFormatCSV, []byte("bot_b,first seen 2026\n13,x\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []entity.BlocklistEntry{{Login: "bot_b"}, {TwitchUserID: 13}}, got, "no header: first column")
+}
+
+func TestParseBlocklist_json(t *testing.T) {
+	got, err := ParseBlocklist(entity.BlocklistFormatJSON, []byte(`["bot_a", 14, {"username": "Bot_C", "user_id": "15"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []entity.BlocklistEntry{{Login: "bot_a"}, {TwitchUserID: 14}, {Login: "bot_c", TwitchUserID: 15}}, got)
+
+	got, err = ParseBlocklist(entity.BlocklistFormatJSON, []byte(`{"updated": "today", "accounts": [{"login": "bot_d", "id": 16}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []entity.BlocklistEntry{{Login: "bot_d", TwitchUserID: 16}}, got)
+
+	_, err = ParseBlocklist(entity.BlocklistFormatJSON, []byte(`{"foo": []}`))
+	require.ErrorIs(t, err, entity.ErrInvalidBlocklist)
+
+	_, err = ParseBlocklist(entity.BlocklistFormatJSON, []byte(`[`))
+	require.ErrorIs(t, err, entity.ErrInvalidBlocklist)
+}
+
+func TestParseBlocklist_invalid(t *testing.T) {
+	_, err := ParseBlocklist(entity.BlocklistFormatText, []byte("# only comments\n"))
+	require.ErrorIs(t, err, entity.ErrInvalidBlocklist)
+
+	_, err = ParseBlocklist("xml", []byte("a"))
+	require.ErrorIs(t, err, entity.ErrInvalidBlocklist)
+}
@@ -0,0 +1,15 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ResendNotificationDelivery requeues an outbox row; the notify worker picks it up on its next poll.
+func (s *Usecase) ResendNotificationDelivery(ctx context.Context, id int64) (entity.NotificationDelivery, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.resend_notification_delivery")
+	defer span.End()
+
+	return s.repo.RequeueNotificationDelivery(ctx, id)
+}
@@ -0,0 +1,44 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_ResendNotificationDelivery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	repo.EXPECT().RequeueNotificationDelivery(gomock.Any(), int64(7)).
+		Return(entity.NotificationDelivery{ID: 7, Status: entity.NotificationDeliveryPending}, nil)
+
+	d, err := svc.ResendNotificationDelivery(context.Background(), 7)
+	require.NoError(t, err)
+	require.Equal(t, entity.NotificationDeliveryPending, d.Status)
+}
+
+func TestService_ResendNotificationDelivery_notFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	repo.EXPECT().RequeueNotificationDelivery(gomock.Any(), int64(8)).
+		Return(entity.NotificationDelivery{}, entity.ErrNotificationDeliveryNotFound)
+
+	_, err := svc.ResendNotificationDelivery(context.Background(), 8)
+	require.ErrorIs(t, err, entity.ErrNotificationDeliveryNotFound)
+}
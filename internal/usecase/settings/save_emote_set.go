@@ -0,0 +1,29 @@
+package settings
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// SaveEmoteSet validates and stores a third-party emote set, replacing the codes of an existing set with the same
+// name. The IRC monitor picks up changes within a minute; messages already stored are not re-scanned.
+func (s *Usecase) SaveEmoteSet(ctx context.Context, set entity.EmoteSet, emotes []entity.EmoteSetEmote) (entity.EmoteSet, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.save_emote_set")
+	defer span.End()
+
+	set, emotes, err := entity.NormalizeEmoteSet(set, emotes)
+	if err != nil {
+		return entity.EmoteSet{}, err
+	}
+
+	out, err := s.repo.SaveEmoteSet(ctx, set, emotes)
+	if err != nil {
+		s.obs.LogError(ctx, span, "save emote set failed", err, zap.String("name", set.Name))
+		return entity.EmoteSet{}, err
+	}
+
+	return out, nil
+}
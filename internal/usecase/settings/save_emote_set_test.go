@@ -0,0 +1,47 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_SaveEmoteSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	repo.EXPECT().SaveEmoteSet(gomock.Any(),
+		entity.EmoteSet{Name: "streamer 7tv", Provider: entity.EmoteProvider7TV, Channel: "streamer"},
+		[]entity.EmoteSetEmote{{Code: "Clap", EmoteID: "60aeab"}, {Code: "EZ", EmoteID: "EZ"}},
+	).Return(entity.EmoteSet{ID: 2, Name: "streamer 7tv", Provider: entity.EmoteProvider7TV, Channel: "streamer", EmoteCount: 2}, nil)
+
+	out, err := svc.SaveEmoteSet(context.Background(),
+		entity.EmoteSet{Name: "streamer 7tv ", Provider: "7TV", Channel: "#Streamer"},
+		[]entity.EmoteSetEmote{{Code: "Clap", EmoteID: "60aeab"}, {Code: " EZ"}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), out.ID)
+	require.Equal(t, 2, out.EmoteCount)
+}
+
+func TestService_SaveEmoteSet_invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	_, err := svc.SaveEmoteSet(context.Background(), entity.EmoteSet{Name: "set", Provider: "seventv"}, nil)
+	require.ErrorIs(t, err, entity.ErrInvalidEmoteSet)
+}
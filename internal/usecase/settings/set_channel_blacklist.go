@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
 )
 
 func (s *Usecase) SetChannelBlacklist(ctx context.Context, login string, add bool) error {
@@ -20,7 +22,10 @@ func (s *Usecase) SetChannelBlacklist(ctx context.Context, login string, add boo
 
 	if err != nil {
 		s.obs.LogError(ctx, span, "set channel blacklist failed", err, zap.String("login", login), zap.Bool("add", add))
+		return err
 	}
 
-	return err
+	s.startSuspicionReevaluation(entity.SuspicionReevalReasonBlacklist)
+
+	return nil
 }
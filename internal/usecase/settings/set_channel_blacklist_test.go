@@ -2,6 +2,7 @@ package settings
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,10 +10,24 @@ import (
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 
+	"github.com/rofleksey/dredge/internal/entity"
 	"github.com/rofleksey/dredge/internal/observability"
 	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
 )
 
+// invalidatorFunc records login pattern cache invalidations.
+type invalidatorFunc func()
+
+func (f invalidatorFunc) InvalidateLoginPatterns() { f() }
+
+// reevaluatorFunc records bulk suspicion re-evaluation requests.
+type reevaluatorFunc func(reason string, refetchBudget int)
+
+func (f reevaluatorFunc) StartSuspicionReevaluation(reason string, refetchBudget int) entity.SuspicionReevaluation {
+	f(reason, refetchBudget)
+	return entity.SuspicionReevaluation{Running: true, Reason: reason}
+}
+
 func TestService_SetChannelBlacklist_add(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -36,3 +51,25 @@ func TestService_SetChannelBlacklist_remove(t *testing.T) {
 
 	require.NoError(t, svc.SetChannelBlacklist(context.Background(), "x", false))
 }
+
+func TestService_SetChannelBlacklist_reevaluatesSuspicion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	var reasons []string
+
+	svc.SetSuspicionReevaluator(reevaluatorFunc(func(reason string, refetchBudget int) {
+		require.Zero(t, refetchBudget)
+		reasons = append(reasons, reason)
+	}))
+
+	repo.EXPECT().AddChannelBlacklist(gomock.Any(), "x").Return(nil)
+	repo.EXPECT().RemoveChannelBlacklist(gomock.Any(), "y").Return(errors.New("db down"))
+
+	require.NoError(t, svc.SetChannelBlacklist(context.Background(), "x", true))
+	require.Error(t, svc.SetChannelBlacklist(context.Background(), "y", false))
+	require.Equal(t, []string{entity.SuspicionReevalReasonBlacklist}, reasons)
+}
@@ -0,0 +1,43 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// maxNotificationSnooze bounds how far ahead a snooze may run.
+const maxNotificationSnooze = 30 * 24 * time.Hour
+
+// SnoozeNotifications mutes one entry (or all when entryID is nil) for one channel (or all when empty) until until.
+func (s *Usecase) SnoozeNotifications(ctx context.Context, entryID *int64, channel string, until time.Time) (entity.NotificationSnooze, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.snooze_notifications")
+	defer span.End()
+
+	now := time.Now()
+	if !until.After(now) || until.Sub(now) > maxNotificationSnooze {
+		return entity.NotificationSnooze{}, fmt.Errorf("snooze must end within %s: %w", maxNotificationSnooze, entity.ErrInvalidNotification)
+	}
+
+	if entryID != nil {
+		if _, err := s.repo.GetNotificationEntry(ctx, *entryID); err != nil {
+			return entity.NotificationSnooze{}, err
+		}
+	}
+
+	out, err := s.repo.CreateNotificationSnooze(ctx, entity.NotificationSnooze{
+		NotificationEntryID: entryID,
+		Channel:             strings.TrimPrefix(strings.ToLower(strings.TrimSpace(channel)), "#"),
+		Until:               until,
+	})
+	if err != nil {
+		s.obs.LogError(ctx, span, "snooze notifications failed", err, zap.String("channel", channel))
+	}
+
+	return out, err
+}
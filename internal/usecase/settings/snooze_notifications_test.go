@@ -0,0 +1,48 @@
+package settings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_SnoozeNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	until := time.Now().Add(time.Hour)
+	id := int64(3)
+
+	repo.EXPECT().GetNotificationEntry(gomock.Any(), id).Return(entity.NotificationEntry{ID: id}, nil)
+	repo.EXPECT().CreateNotificationSnooze(gomock.Any(), entity.NotificationSnooze{NotificationEntryID: &id, Channel: "chana", Until: until}).
+		Return(entity.NotificationSnooze{ID: 1}, nil)
+
+	out, err := svc.SnoozeNotifications(context.Background(), &id, " #ChanA ", until)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), out.ID)
+}
+
+func TestService_SnoozeNotifications_invalidUntil(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(repomocks.NewMockStore(ctrl), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	_, err := svc.SnoozeNotifications(context.Background(), nil, "", time.Now().Add(-time.Minute))
+	require.ErrorIs(t, err, entity.ErrInvalidNotification)
+
+	_, err = svc.SnoozeNotifications(context.Background(), nil, "", time.Now().Add(maxNotificationSnooze+time.Hour))
+	require.ErrorIs(t, err, entity.ErrInvalidNotification)
+}
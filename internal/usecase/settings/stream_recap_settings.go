@@ -0,0 +1,31 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func (s *Usecase) GetStreamRecapSettings(ctx context.Context) (entity.StreamRecapSettings, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.get_stream_recap_settings")
+	defer span.End()
+
+	out, err := s.repo.GetStreamRecapSettings(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get stream recap settings failed", err)
+	}
+
+	return out, err
+}
+
+func (s *Usecase) UpdateStreamRecapSettings(ctx context.Context, in entity.StreamRecapSettings) (entity.StreamRecapSettings, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.update_stream_recap_settings")
+	defer span.End()
+
+	if err := s.repo.UpdateStreamRecapSettings(ctx, in); err != nil {
+		s.obs.LogError(ctx, span, "update stream recap settings failed", err)
+		return entity.StreamRecapSettings{}, err
+	}
+
+	return s.repo.GetStreamRecapSettings(ctx)
+}
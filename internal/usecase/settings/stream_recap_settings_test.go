@@ -0,0 +1,38 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_UpdateStreamRecapSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	in := entity.StreamRecapSettings{Notify: true}
+
+	repo.EXPECT().UpdateStreamRecapSettings(gomock.Any(), in).Return(nil)
+	repo.EXPECT().GetStreamRecapSettings(gomock.Any()).Return(in, nil)
+
+	out, err := svc.UpdateStreamRecapSettings(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, in, out)
+
+	repo.EXPECT().UpdateStreamRecapSettings(gomock.Any(), in).Return(errors.New("db down"))
+
+	_, err = svc.UpdateStreamRecapSettings(context.Background(), in)
+	require.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package settings
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// TestNotification sends sample events of the given types (all when empty) through entry id's provider
+// and returns each provider response. Nothing is written to the outbox or delivery log.
+func (s *Usecase) TestNotification(ctx context.Context, id int64, eventTypes []string) ([]entity.NotificationTestResult, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.test_notification")
+	defer span.End()
+
+	if s.tester == nil {
+		return nil, errors.New("notification test sending is not configured")
+	}
+
+	e, err := s.repo.GetNotificationEntry(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrNotificationNotFound) {
+			s.obs.LogError(ctx, span, "get notification failed", err, zap.Int64("id", id))
+		}
+
+		return nil, err
+	}
+
+	out, err := s.tester.SendTest(ctx, e, eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range out {
+		if !r.Delivered {
+			s.obs.Logger.Info("notification test send failed",
+				zap.Int64("notification_id", id), zap.String("event_type", r.EventType), zap.String("error", r.Error))
+		}
+	}
+
+	return out, nil
+}
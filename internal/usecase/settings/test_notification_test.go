@@ -0,0 +1,59 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+type testerFunc func(e entity.NotificationEntry, eventTypes []string) ([]entity.NotificationTestResult, error)
+
+func (f testerFunc) SendTest(_ context.Context, e entity.NotificationEntry, eventTypes []string) ([]entity.NotificationTestResult, error) {
+	return f(e, eventTypes)
+}
+
+func TestService_TestNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+	svc.SetNotificationTester(testerFunc(func(e entity.NotificationEntry, eventTypes []string) ([]entity.NotificationTestResult, error) {
+		require.Equal(t, int64(4), e.ID)
+		require.Equal(t, []string{"stream_start"}, eventTypes)
+
+		return []entity.NotificationTestResult{{EventType: "stream_start", Delivered: true}}, nil
+	}))
+
+	repo.EXPECT().GetNotificationEntry(gomock.Any(), int64(4)).Return(entity.NotificationEntry{ID: 4, Provider: "webhook"}, nil)
+
+	out, err := svc.TestNotification(context.Background(), 4, []string{"stream_start"})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	require.True(t, out[0].Delivered)
+}
+
+func TestService_TestNotification_notFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+	svc.SetNotificationTester(testerFunc(func(entity.NotificationEntry, []string) ([]entity.NotificationTestResult, error) {
+		t.Fatal("tester must not be called")
+		return nil, nil
+	}))
+
+	repo.EXPECT().GetNotificationEntry(gomock.Any(), int64(9)).Return(entity.NotificationEntry{}, entity.ErrNotificationNotFound)
+
+	_, err := svc.TestNotification(context.Background(), 9, nil)
+	require.ErrorIs(t, err, entity.ErrNotificationNotFound)
+}
@@ -1,6 +1,9 @@
 package settings
 
 import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
 	"github.com/rofleksey/dredge/internal/observability"
 	"github.com/rofleksey/dredge/internal/repository"
 )
@@ -10,6 +13,66 @@ func New(repo repository.Store, obs *observability.Stack) *Usecase {
 }
 
 type Usecase struct {
-	repo repository.Store
-	obs  *observability.Stack
+	repo      repository.Store
+	obs       *observability.Stack
+	providers NotificationProviders
+	tester    NotificationTester
+	reeval    SuspicionReevaluator
+	patterns  LoginPatternInvalidator
+}
+
+// NotificationProviders validates per-provider notification settings (implemented by *notify.Registry).
+type NotificationProviders interface {
+	ValidateSettings(provider string, settings map[string]any) error
+}
+
+// SetNotificationProviders enables provider-aware validation of notification entries; without it
+// create/update accept any provider and settings.
+func (s *Usecase) SetNotificationProviders(p NotificationProviders) {
+	s.providers = p
+}
+
+// NotificationTester sends sample events through an entry's provider (implemented by *notify.Dispatcher).
+type NotificationTester interface {
+	SendTest(ctx context.Context, e entity.NotificationEntry, eventTypes []string) ([]entity.NotificationTestResult, error)
+}
+
+// SetNotificationTester enables TestNotification.
+func (s *Usecase) SetNotificationTester(t NotificationTester) {
+	s.tester = t
+}
+
+// SuspicionReevaluator re-scores all known users after suspicion inputs change (implemented by *twitch.Usecase).
+type SuspicionReevaluator interface {
+	StartSuspicionReevaluation(reason string, refetchBudget int) entity.SuspicionReevaluation
+}
+
+// SetSuspicionReevaluator makes suspicion settings and blacklist changes re-evaluate every known user;
+// without it they only apply as users are re-enriched.
+func (s *Usecase) SetSuspicionReevaluator(r SuspicionReevaluator) {
+	s.reeval = r
+}
+
+// LoginPatternInvalidator drops a cached login pattern blacklist (implemented by *twitch.Usecase).
+type LoginPatternInvalidator interface {
+	InvalidateLoginPatterns()
+}
+
+// SetLoginPatternInvalidator makes login pattern changes take effect on the next identity check.
+func (s *Usecase) SetLoginPatternInvalidator(p LoginPatternInvalidator) {
+	s.patterns = p
+}
+
+// invalidateLoginPatterns drops the cached login pattern blacklist when an invalidator is configured.
+func (s *Usecase) invalidateLoginPatterns() {
+	if s.patterns != nil {
+		s.patterns.InvalidateLoginPatterns()
+	}
+}
+
+// startSuspicionReevaluation queues a cache-only re-evaluation when a reevaluator is configured.
+func (s *Usecase) startSuspicionReevaluation(reason string) {
+	if s.reeval != nil {
+		s.reeval.StartSuspicionReevaluation(reason, 0)
+	}
 }
@@ -6,9 +6,47 @@ import (
 	"github.com/rofleksey/dredge/internal/entity"
 )
 
-func (s *Usecase) UpdateNotification(ctx context.Context, id int64, provider *string, settings map[string]any, enabled *bool) (entity.NotificationEntry, error) {
+func (s *Usecase) UpdateNotification(ctx context.Context, id int64, provider *string, settings map[string]any, enabled *bool, routing *entity.NotificationRouting, policy *entity.NotificationPolicy) (entity.NotificationEntry, error) {
 	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.update_notification")
 	defer span.End()
 
-	return s.repo.UpdateNotificationEntry(ctx, id, provider, settings, enabled)
+	if s.providers != nil && (provider != nil || settings != nil) {
+		// Validate the entry as it will be stored: a provider switch must come with settings it accepts.
+		cur, err := s.repo.GetNotificationEntry(ctx, id)
+		if err != nil {
+			return entity.NotificationEntry{}, err
+		}
+
+		if provider != nil {
+			cur.Provider = *provider
+		}
+
+		if settings != nil {
+			cur.Settings = settings
+		}
+
+		if err := s.validateNotificationSettings(cur.Provider, cur.Settings); err != nil {
+			return entity.NotificationEntry{}, err
+		}
+	}
+
+	if routing != nil {
+		norm, err := normalizeNotificationRouting(*routing)
+		if err != nil {
+			return entity.NotificationEntry{}, err
+		}
+
+		routing = &norm
+	}
+
+	if policy != nil {
+		norm, err := normalizeNotificationPolicy(*policy)
+		if err != nil {
+			return entity.NotificationEntry{}, err
+		}
+
+		policy = &norm
+	}
+
+	return s.repo.UpdateNotificationEntry(ctx, id, provider, settings, enabled, routing, policy)
 }
@@ -22,8 +22,32 @@ func TestService_UpdateNotification(t *testing.T) {
 	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
 
 	en := true
-	repo.EXPECT().UpdateNotificationEntry(gomock.Any(), int64(2), nil, map[string]any{"a": 1}, &en).Return(entity.NotificationEntry{ID: 2}, nil)
+	repo.EXPECT().UpdateNotificationEntry(gomock.Any(), int64(2), nil, map[string]any{"a": 1}, &en, nil, nil).Return(entity.NotificationEntry{ID: 2}, nil)
 
-	_, err := svc.UpdateNotification(context.Background(), 2, nil, map[string]any{"a": 1}, &en)
+	_, err := svc.UpdateNotification(context.Background(), 2, nil, map[string]any{"a": 1}, &en, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestService_UpdateNotification_validatesEffectiveSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+	svc.SetNotificationProviders(requireURL)
+
+	repo.EXPECT().GetNotificationEntry(gomock.Any(), int64(2)).Return(entity.NotificationEntry{
+		ID: 2, Provider: "telegram", Settings: map[string]any{"bot_token": "t"},
+	}, nil)
+
+	_, err := svc.UpdateNotification(context.Background(), 2, entity.ToPointer("webhook"), nil, nil, nil, nil)
+	require.ErrorIs(t, err, entity.ErrInvalidNotification)
+
+	settings := map[string]any{"url": "https://example.org"}
+
+	repo.EXPECT().GetNotificationEntry(gomock.Any(), int64(2)).Return(entity.NotificationEntry{ID: 2, Provider: "webhook"}, nil)
+	repo.EXPECT().UpdateNotificationEntry(gomock.Any(), int64(2), nil, settings, nil, nil, nil).Return(entity.NotificationEntry{ID: 2}, nil)
+
+	_, err = svc.UpdateNotification(context.Background(), 2, nil, settings, nil, nil, nil)
 	require.NoError(t, err)
 }
@@ -2,18 +2,76 @@ package settings
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/rofleksey/dredge/internal/entity"
 )
 
+// maxSuspicionWeight bounds a single signal weight so a typo cannot dwarf every other signal.
+const maxSuspicionWeight = 1000
+
 func (s *Usecase) UpdateSuspicionSettings(ctx context.Context, in entity.SuspicionSettings) (entity.SuspicionSettings, error) {
 	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.update_suspicion_settings")
 	defer span.End()
 
+	in, err := normalizeSuspicionSettings(in)
+	if err != nil {
+		return entity.SuspicionSettings{}, err
+	}
+
 	if err := s.repo.UpdateSuspicionSettings(ctx, in); err != nil {
 		s.obs.LogError(ctx, span, "update suspicion settings failed", err)
 		return entity.SuspicionSettings{}, err
 	}
 
+	s.startSuspicionReevaluation(entity.SuspicionReevalReasonSettings)
+
 	return s.repo.GetSuspicionSettings(ctx)
 }
+
+// normalizeSuspicionSettings trims name patterns and rejects values the scoring engine cannot use.
+func normalizeSuspicionSettings(in entity.SuspicionSettings) (entity.SuspicionSettings, error) {
+	if in.ScoreThreshold < 1 {
+		return in, fmt.Errorf("%w: score_threshold must be at least 1", entity.ErrInvalidSuspicionSettings)
+	}
+
+	if in.MultiChannelThreshold < 2 {
+		return in, fmt.Errorf("%w: multi_channel_threshold must be at least 2", entity.ErrInvalidSuspicionSettings)
+	}
+
+	w := in.Weights
+	for name, v := range map[string]int{
+		"account_age":       w.AccountAge,
+		"blacklist_follows": w.BlacklistFollows,
+		"low_follows":       w.LowFollows,
+		"name_pattern":      w.NamePattern,
+		"first_message":     w.FirstMessage,
+		"multi_channel":     w.MultiChannel,
+		"moderation":        w.Moderation,
+	} {
+		if v < 0 || v > maxSuspicionWeight {
+			return in, fmt.Errorf("%w: weight %s must be between 0 and %d", entity.ErrInvalidSuspicionSettings, name, maxSuspicionWeight)
+		}
+	}
+
+	patterns := make([]string, 0, len(in.NamePatterns))
+
+	for _, p := range in.NamePatterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+
+		if _, err := regexp.Compile("(?i)" + p); err != nil {
+			return in, fmt.Errorf("%w: name pattern %q: %w", entity.ErrInvalidSuspicionSettings, p, err)
+		}
+
+		patterns = append(patterns, p)
+	}
+
+	in.NamePatterns = patterns
+
+	return in, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/mock/gomock"
@@ -21,11 +22,48 @@ func TestService_UpdateSuspicionSettings(t *testing.T) {
 	repo := repomocks.NewMockStore(ctrl)
 	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
 
-	in := entity.SuspicionSettings{}
-	repo.EXPECT().UpdateSuspicionSettings(gomock.Any(), in).Return(nil)
-	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(in, nil)
+	in := entity.SuspicionSettings{
+		ScoreThreshold:        50,
+		MultiChannelThreshold: 5,
+		Weights:               entity.SuspicionWeights{AccountAge: 50},
+		NamePatterns:          []string{" ^bot ", ""},
+	}
+	want := in
+	want.NamePatterns = []string{"^bot"}
+
+	var reasons []string
+
+	svc.SetSuspicionReevaluator(reevaluatorFunc(func(reason string, _ int) { reasons = append(reasons, reason) }))
+
+	repo.EXPECT().UpdateSuspicionSettings(gomock.Any(), want).Return(nil)
+	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(want, nil)
 
 	out, err := svc.UpdateSuspicionSettings(context.Background(), in)
 	require.NoError(t, err)
-	require.Equal(t, in, out)
+	require.Equal(t, want, out)
+	require.Equal(t, []string{entity.SuspicionReevalReasonSettings}, reasons)
+}
+
+func TestService_UpdateSuspicionSettings_invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	valid := entity.SuspicionSettings{ScoreThreshold: 50, MultiChannelThreshold: 5}
+
+	for name, mutate := range map[string]func(*entity.SuspicionSettings){
+		"threshold":     func(s *entity.SuspicionSettings) { s.ScoreThreshold = 0 },
+		"multi_channel": func(s *entity.SuspicionSettings) { s.MultiChannelThreshold = 1 },
+		"weight":        func(s *entity.SuspicionSettings) { s.Weights.Moderation = 1001 },
+		"pattern":       func(s *entity.SuspicionSettings) { s.NamePatterns = []string{"("} },
+	} {
+		in := valid
+		mutate(&in)
+
+		_, err := svc.UpdateSuspicionSettings(context.Background(), in)
+		require.ErrorIs(t, err, entity.ErrInvalidSuspicionSettings, name)
+		assert.Contains(t, err.Error(), "invalid suspicion settings: ", name)
+	}
 }
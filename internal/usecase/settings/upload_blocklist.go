@@ -0,0 +1,52 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// UploadBlocklist replaces a blocklist's entries with uploaded file content in the list's format.
+func (s *Usecase) UploadBlocklist(ctx context.Context, id int64, filename string, content []byte) (entity.Blocklist, error) {
+	ctx, span := s.obs.StartSpan(ctx, "usecase.settings.upload_blocklist")
+	defer span.End()
+
+	b, err := s.repo.GetBlocklist(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrBlocklistNotFound) {
+			s.obs.LogError(ctx, span, "get blocklist failed", err, zap.Int64("id", id))
+		}
+
+		return entity.Blocklist{}, err
+	}
+
+	entries, err := ParseBlocklist(b.Format, content)
+	if err != nil {
+		return entity.Blocklist{}, err
+	}
+
+	filename = strings.TrimSpace(filename)
+	if filename == "" {
+		filename = "upload"
+	}
+
+	if err := s.repo.ReplaceBlocklistEntries(ctx, id, entries, &filename, entity.BlocklistDigest(content), time.Now().UTC()); err != nil {
+		s.obs.LogError(ctx, span, "replace blocklist entries failed", err, zap.Int64("id", id))
+		return entity.Blocklist{}, err
+	}
+
+	s.startSuspicionReevaluation(entity.SuspicionReevalReasonBlocklists)
+
+	out, err := s.repo.GetBlocklist(ctx, id)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get blocklist failed", err, zap.Int64("id", id))
+		return entity.Blocklist{}, err
+	}
+
+	return out, nil
+}
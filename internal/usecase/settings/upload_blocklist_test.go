@@ -0,0 +1,56 @@
+package settings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_UploadBlocklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	var reasons []string
+	svc.SetSuspicionReevaluator(reevaluatorFunc(func(reason string, _ int) { reasons = append(reasons, reason) }))
+
+	content := []byte("login,id\nraid_a,11\n")
+	list := entity.Blocklist{ID: 2, Name: "raids", Format: entity.BlocklistFormatCSV}
+
+	repo.EXPECT().GetBlocklist(gomock.Any(), int64(2)).Return(list, nil)
+	repo.EXPECT().ReplaceBlocklistEntries(gomock.Any(), int64(2), []entity.BlocklistEntry{{Login: "raid_a", TwitchUserID: 11}},
+		gomock.Any(), entity.BlocklistDigest(content), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, _ []entity.BlocklistEntry, filename *string, _ string, _ time.Time) error {
+			require.Equal(t, "raids.csv", *filename)
+			return nil
+		})
+	repo.EXPECT().GetBlocklist(gomock.Any(), int64(2)).Return(list, nil)
+
+	_, err := svc.UploadBlocklist(context.Background(), 2, "raids.csv", content)
+	require.NoError(t, err)
+	require.Equal(t, []string{entity.SuspicionReevalReasonBlocklists}, reasons)
+}
+
+func TestService_UploadBlocklist_invalidContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	repo.EXPECT().GetBlocklist(gomock.Any(), int64(2)).Return(entity.Blocklist{ID: 2, Format: entity.BlocklistFormatJSON}, nil)
+
+	_, err := svc.UploadBlocklist(context.Background(), 2, "", []byte("not json"))
+	require.ErrorIs(t, err, entity.ErrInvalidBlocklist)
+}
@@ -0,0 +1,364 @@
+package telegrambot
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	"github.com/rofleksey/dredge/internal/repository"
+	"github.com/rofleksey/dredge/internal/service/notify"
+)
+
+const defaultAPIBaseURL = "https://api.telegram.org"
+
+// Settings applies changes requested from Telegram (implemented by *settings.Usecase).
+type Settings interface {
+	PatchTwitchUser(ctx context.Context, id int64, patch entity.TwitchUserPatch) (entity.TwitchUser, error)
+	SetChannelBlacklist(ctx context.Context, login string, add bool) error
+	SnoozeNotifications(ctx context.Context, entryID *int64, channel string, until time.Time) (entity.NotificationSnooze, error)
+}
+
+// Config wires the interactive Telegram bot.
+type Config struct {
+	Repo     repository.Store
+	Settings Settings
+	Obs      *observability.Stack
+	// HTTPClient performs Bot API calls; nil uses a client whose timeout exceeds PollTimeout.
+	HTTPClient *http.Client
+	// APIBaseURL replaces https://api.telegram.org (local Bot API server or tests).
+	APIBaseURL string
+	// WebBaseURL is the web UI origin used for "open in UI" links.
+	WebBaseURL string
+	// RefreshInterval is how often notification entries are re-read to start or stop pollers (default 1m).
+	RefreshInterval time.Duration
+	// PollTimeout is the getUpdates long-poll timeout (default 30s).
+	PollTimeout time.Duration
+}
+
+// Bot long-polls getUpdates for every enabled interactive telegram notification entry, answering
+// commands and alert-button callbacks from allowlisted Telegram users.
+type Bot struct {
+	repo            repository.Store
+	settings        Settings
+	obs             *observability.Stack
+	api             *apiClient
+	webBaseURL      string
+	refreshInterval time.Duration
+	pollTimeout     time.Duration
+
+	mu      sync.Mutex
+	pollers map[string]*poller // by bot token; one getUpdates consumer per token
+
+	loopMu     sync.Mutex
+	loopCancel context.CancelFunc
+	loopWG     sync.WaitGroup
+}
+
+// botEntry is the notification entry a poller acts for.
+type botEntry struct {
+	id      int64
+	allowed []int64
+}
+
+type poller struct {
+	mu     sync.Mutex
+	entry  botEntry
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (p *poller) current() botEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.entry
+}
+
+func (p *poller) set(e botEntry) {
+	p.mu.Lock()
+	p.entry = e
+	p.mu.Unlock()
+}
+
+// New constructs a bot; call Start to begin polling.
+func New(cfg Config) *Bot {
+	poll := cfg.PollTimeout
+	if poll <= 0 {
+		poll = 30 * time.Second
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: poll + 15*time.Second}
+	}
+
+	base := strings.TrimRight(strings.TrimSpace(cfg.APIBaseURL), "/")
+	if base == "" {
+		base = defaultAPIBaseURL
+	}
+
+	refresh := cfg.RefreshInterval
+	if refresh <= 0 {
+		refresh = time.Minute
+	}
+
+	return &Bot{
+		repo:            cfg.Repo,
+		settings:        cfg.Settings,
+		obs:             cfg.Obs,
+		api:             &apiClient{http: client, baseURL: base},
+		webBaseURL:      strings.TrimRight(strings.TrimSpace(cfg.WebBaseURL), "/"),
+		refreshInterval: refresh,
+		pollTimeout:     poll,
+		pollers:         make(map[string]*poller),
+	}
+}
+
+// Start runs the entry refresh loop until Stop. Calling Start twice is a no-op.
+func (b *Bot) Start(ctx context.Context) {
+	b.loopMu.Lock()
+	defer b.loopMu.Unlock()
+
+	if b.loopCancel != nil {
+		return
+	}
+
+	loopCtx, cancel := context.WithCancel(ctx)
+	b.loopCancel = cancel
+
+	b.loopWG.Add(1)
+
+	go func() {
+		defer b.loopWG.Done()
+
+		ticker := time.NewTicker(b.refreshInterval)
+		defer ticker.Stop()
+
+		for {
+			b.refresh(loopCtx)
+
+			select {
+			case <-loopCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop cancels the refresh loop and every poller and waits for them to exit.
+func (b *Bot) Stop() {
+	b.loopMu.Lock()
+	cancel := b.loopCancel
+	b.loopCancel = nil
+	b.loopMu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	b.loopWG.Wait()
+
+	b.mu.Lock()
+	pollers := b.pollers
+	b.pollers = make(map[string]*poller)
+	b.mu.Unlock()
+
+	for _, p := range pollers {
+		p.cancel()
+		<-p.done
+	}
+}
+
+// refresh starts pollers for new interactive entries, updates allowlists, and stops pollers whose
+// entry was disabled, deleted or switched off interactive mode, waiting for them to exit so a
+// removed token is never polled after refresh returns.
+func (b *Bot) refresh(ctx context.Context) {
+	for _, p := range b.syncPollers(ctx) {
+		<-p.done
+	}
+}
+
+// syncPollers reconciles pollers with the enabled interactive entries and returns the cancelled ones.
+func (b *Bot) syncPollers(ctx context.Context) []*poller {
+	entries, err := b.repo.ListEnabledNotificationEntries(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			b.obs.Logger.Warn("telegram bot: list notification entries failed", zap.Error(err))
+		}
+
+		return nil
+	}
+
+	want := interactiveEntries(entries)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var stopped []*poller
+
+	for token, p := range b.pollers {
+		if _, ok := want[token]; !ok {
+			p.cancel()
+			delete(b.pollers, token)
+
+			stopped = append(stopped, p)
+		}
+	}
+
+	for token, e := range want {
+		if p, ok := b.pollers[token]; ok {
+			p.set(e)
+			continue
+		}
+
+		pctx, cancel := context.WithCancel(ctx)
+		p := &poller{entry: e, cancel: cancel, done: make(chan struct{})}
+		b.pollers[token] = p
+
+		go func() {
+			defer close(p.done)
+
+			b.poll(pctx, token, p)
+		}()
+	}
+
+	return stopped
+}
+
+// interactiveEntries maps bot tokens to the lowest-id interactive entry using them.
+func interactiveEntries(entries []entity.NotificationEntry) map[string]botEntry {
+	out := make(map[string]botEntry)
+
+	for _, e := range entries {
+		if e.Provider != "telegram" {
+			continue
+		}
+
+		allowed, ok := notify.TelegramInteractive(e.Settings)
+		if !ok {
+			continue
+		}
+
+		token, _ := e.Settings["bot_token"].(string)
+		token = strings.TrimSpace(token)
+
+		if token == "" {
+			continue
+		}
+
+		if cur, ok := out[token]; ok && cur.id < e.ID {
+			continue
+		}
+
+		out[token] = botEntry{id: e.ID, allowed: allowed}
+	}
+
+	return out
+}
+
+// poll consumes getUpdates until ctx is cancelled, backing off after errors.
+func (b *Bot) poll(ctx context.Context, token string, p *poller) {
+	var offset int64
+
+	backoff := time.Second
+
+	for ctx.Err() == nil {
+		updates, err := b.api.getUpdates(ctx, token, offset, b.pollTimeout)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			b.obs.Logger.Warn("telegram bot: getUpdates failed", zap.Error(err), zap.Int64("notification_id", p.current().id))
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+
+			backoff = min(backoff*2, time.Minute)
+
+			continue
+		}
+
+		backoff = time.Second
+
+		for _, u := range updates {
+			offset = max(offset, u.UpdateID+1)
+
+			b.handleUpdate(ctx, token, p.current(), u)
+		}
+	}
+}
+
+func (b *Bot) handleUpdate(ctx context.Context, token string, e botEntry, u tgUpdate) {
+	ctx, span := b.obs.StartSpan(ctx, "telegrambot.handleUpdate")
+	defer span.End()
+
+	switch {
+	case u.CallbackQuery != nil:
+		b.handleCallback(ctx, token, e, u.CallbackQuery)
+	case u.Message != nil:
+		b.handleMessage(ctx, token, e, u.Message)
+	}
+}
+
+func (b *Bot) handleMessage(ctx context.Context, token string, e botEntry, m *tgMessage) {
+	text := strings.TrimSpace(m.Text)
+	if !strings.HasPrefix(text, "/") {
+		return
+	}
+
+	var reply string
+
+	if m.From == nil || !slices.Contains(e.allowed, m.From.ID) {
+		reply = "You are not allowed to use this bot."
+	} else {
+		reply = b.runCommand(ctx, e, text)
+	}
+
+	if err := b.api.sendMessage(ctx, token, m.Chat.ID, reply); err != nil && ctx.Err() == nil {
+		b.obs.Logger.Warn("telegram bot: reply failed", zap.Error(err), zap.Int64("notification_id", e.id))
+	}
+}
+
+func (b *Bot) handleCallback(ctx context.Context, token string, e botEntry, cq *tgCallbackQuery) {
+	var answer string
+
+	if !slices.Contains(e.allowed, cq.From.ID) {
+		answer = "You are not allowed to do this."
+
+		b.obs.Logger.Info("telegram bot: callback from user not in allowlist",
+			zap.Int64("telegram_user_id", cq.From.ID), zap.Int64("notification_id", e.id))
+	} else {
+		var link string
+
+		answer, link = b.runCallback(ctx, cq.Data, cq.From)
+
+		if link != "" && cq.Message != nil {
+			if err := b.api.sendMessage(ctx, token, cq.Message.Chat.ID, link); err != nil && ctx.Err() == nil {
+				b.obs.Logger.Warn("telegram bot: send link failed", zap.Error(err))
+			}
+		}
+	}
+
+	if err := b.api.answerCallbackQuery(ctx, token, cq.ID, answer); err != nil && ctx.Err() == nil {
+		b.obs.Logger.Warn("telegram bot: answer callback failed", zap.Error(err), zap.Int64("notification_id", e.id))
+	}
+}
+
+// userURL links to a Twitch user's page in the web UI (hash routing).
+func (b *Bot) userURL(id int64) string {
+	return b.webBaseURL + "/#/users/" + strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,313 @@
+package telegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	"github.com/rofleksey/dredge/internal/repository"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+// fakeBotAPI serves getUpdates from a queue and records every other call.
+type fakeBotAPI struct {
+	srv *httptest.Server
+
+	mu      sync.Mutex
+	updates []tgUpdate
+	calls   []apiCall
+}
+
+type apiCall struct {
+	Path   string
+	Params map[string]any
+}
+
+func newFakeBotAPI(t *testing.T) *fakeBotAPI {
+	t.Helper()
+
+	f := &fakeBotAPI{}
+	f.srv = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.srv.Close)
+
+	return f
+}
+
+func (f *fakeBotAPI) serve(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&params)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result any = true
+
+	if strings.HasSuffix(r.URL.Path, "/getUpdates") {
+		offset, _ := params["offset"].(float64)
+
+		var out []tgUpdate
+
+		for _, u := range f.updates {
+			if u.UpdateID >= int64(offset) {
+				out = append(out, u)
+			}
+		}
+
+		result = out
+	} else {
+		f.calls = append(f.calls, apiCall{Path: r.URL.Path, Params: params})
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"ok": true, "result": result})
+}
+
+func (f *fakeBotAPI) recorded() []apiCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]apiCall(nil), f.calls...)
+}
+
+// fakeSettings records settings usecase calls made by the bot.
+type fakeSettings struct {
+	mu        sync.Mutex
+	patches   map[int64]entity.TwitchUserPatch
+	blacklist []string
+	snoozes   []entity.NotificationSnooze
+}
+
+func (s *fakeSettings) PatchTwitchUser(_ context.Context, id int64, patch entity.TwitchUserPatch) (entity.TwitchUser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.patches == nil {
+		s.patches = make(map[int64]entity.TwitchUserPatch)
+	}
+
+	s.patches[id] = patch
+
+	return entity.TwitchUser{ID: id}, nil
+}
+
+func (s *fakeSettings) SetChannelBlacklist(_ context.Context, login string, add bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if add {
+		s.blacklist = append(s.blacklist, login)
+	}
+
+	return nil
+}
+
+func (s *fakeSettings) SnoozeNotifications(_ context.Context, entryID *int64, channel string, until time.Time) (entity.NotificationSnooze, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sn := entity.NotificationSnooze{ID: int64(len(s.snoozes) + 1), NotificationEntryID: entryID, Channel: channel, Until: until}
+	s.snoozes = append(s.snoozes, sn)
+
+	return sn, nil
+}
+
+func testBot(repo repository.Store, settings Settings, apiURL string) *Bot {
+	return New(Config{
+		Repo:            repo,
+		Settings:        settings,
+		Obs:             &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")},
+		APIBaseURL:      apiURL,
+		WebBaseURL:      "https://dredge.example.org/",
+		RefreshInterval: time.Hour,
+		PollTimeout:     time.Second,
+	})
+}
+
+func interactiveEntry(id int64, token string, allowed ...any) entity.NotificationEntry {
+	return entity.NotificationEntry{
+		ID:       id,
+		Provider: "telegram",
+		Enabled:  true,
+		Settings: map[string]any{
+			"bot_token":        token,
+			"chat_id":          "1",
+			"interactive":      true,
+			"allowed_user_ids": allowed,
+		},
+	}
+}
+
+func TestInteractiveEntries(t *testing.T) {
+	t.Parallel()
+
+	got := interactiveEntries([]entity.NotificationEntry{
+		interactiveEntry(3, "tok-a", float64(7)),
+		interactiveEntry(2, "tok-a", float64(8)),
+		interactiveEntry(4, "tok-b", "9"),
+		interactiveEntry(5, "tok-c"),
+		{ID: 6, Provider: "telegram", Settings: map[string]any{"bot_token": "tok-d", "chat_id": "1"}},
+		{ID: 7, Provider: "webhook", Settings: map[string]any{"interactive": true}},
+	})
+
+	assert.Equal(t, map[string]botEntry{
+		"tok-a": {id: 2, allowed: []int64{8}},
+		"tok-b": {id: 4, allowed: []int64{9}},
+	}, got)
+}
+
+func TestBot_callbackRequiresAllowlist(t *testing.T) {
+	t.Parallel()
+
+	api := newFakeBotAPI(t)
+	settings := &fakeSettings{}
+	b := testBot(nil, settings, api.srv.URL)
+
+	b.handleUpdate(context.Background(), "tok", botEntry{id: 1, allowed: []int64{42}}, tgUpdate{
+		CallbackQuery: &tgCallbackQuery{ID: "cb1", From: tgUser{ID: 99}, Data: "bl:chan"},
+	})
+
+	assert.Empty(t, settings.blacklist)
+
+	calls := api.recorded()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "/bottok/answerCallbackQuery", calls[0].Path)
+	assert.Equal(t, "cb1", calls[0].Params["callback_query_id"])
+	assert.Contains(t, calls[0].Params["text"], "not allowed")
+}
+
+func TestBot_callbackActions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "viewer").Return(int64(77), nil).Times(3)
+
+	api := newFakeBotAPI(t)
+	settings := &fakeSettings{}
+	b := testBot(repo, settings, api.srv.URL)
+	e := botEntry{id: 1, allowed: []int64{42}}
+	msg := &tgMessage{Chat: tgChat{ID: 500}}
+
+	for i, data := range []string{"bl:chan", "sus:viewer", "mark:viewer", "open:viewer"} {
+		b.handleUpdate(context.Background(), "tok", e, tgUpdate{
+			CallbackQuery: &tgCallbackQuery{ID: "cb" + string(rune('0'+i)), From: tgUser{ID: 42}, Message: msg, Data: data},
+		})
+	}
+
+	assert.Equal(t, []string{"chan"}, settings.blacklist)
+
+	patch := settings.patches[77]
+	require.NotNil(t, patch.Marked)
+	assert.True(t, *patch.Marked)
+
+	calls := api.recorded()
+	require.Len(t, calls, 5)
+	assert.Equal(t, "/bottok/sendMessage", calls[3].Path)
+	assert.Equal(t, "https://dredge.example.org/#/users/77", calls[3].Params["text"])
+	assert.Equal(t, float64(500), calls[3].Params["chat_id"])
+	assert.Equal(t, "Link sent.", calls[4].Params["text"])
+}
+
+func TestBot_callbackMarkSus(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "viewer").Return(int64(77), nil)
+
+	api := newFakeBotAPI(t)
+	settings := &fakeSettings{}
+	b := testBot(repo, settings, api.srv.URL)
+
+	answer, link := b.runCallback(context.Background(), "sus:viewer", tgUser{ID: 42})
+	assert.Equal(t, "viewer marked sus.", answer)
+	assert.Empty(t, link)
+
+	patch := settings.patches[77]
+	require.NotNil(t, patch.IsSus)
+	assert.True(t, *patch.IsSus)
+	assert.Equal(t, entity.SusTypeManual, *patch.SusType)
+	assert.Equal(t, entity.SuspicionSourceTelegram, patch.SusSource)
+	assert.Equal(t, map[string]any{"telegram_user_id": int64(42)}, patch.SusEvidence)
+}
+
+func TestBot_pollsAndStopsWithEntry(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).
+		Return([]entity.NotificationEntry{interactiveEntry(1, "tok", float64(42))}, nil).AnyTimes()
+
+	api := newFakeBotAPI(t)
+	api.updates = []tgUpdate{
+		{UpdateID: 10, Message: &tgMessage{From: &tgUser{ID: 42}, Chat: tgChat{ID: 500}, Text: "/help@dredge_bot"}},
+		{UpdateID: 11, Message: &tgMessage{From: &tgUser{ID: 13}, Chat: tgChat{ID: 500}, Text: "/live"}},
+		{UpdateID: 12, Message: &tgMessage{From: &tgUser{ID: 42}, Chat: tgChat{ID: 500}, Text: "not a command"}},
+	}
+
+	b := testBot(repo, &fakeSettings{}, api.srv.URL)
+	b.Start(context.Background())
+
+	require.Eventually(t, func() bool { return len(api.recorded()) >= 2 }, 5*time.Second, 10*time.Millisecond)
+
+	b.Stop()
+
+	calls := api.recorded()
+	require.Len(t, calls, 2, "each update is handled once and plain text is ignored")
+	assert.Equal(t, helpText, calls[0].Params["text"])
+	assert.Contains(t, calls[1].Params["text"], "not allowed")
+}
+
+func TestBot_refreshWaitsForRemovedPoller(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	gomock.InOrder(
+		repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).
+			Return([]entity.NotificationEntry{interactiveEntry(1, "tok", float64(42))}, nil),
+		repo.EXPECT().ListEnabledNotificationEntries(gomock.Any()).Return(nil, nil),
+	)
+
+	api := newFakeBotAPI(t)
+	b := testBot(repo, &fakeSettings{}, api.srv.URL)
+
+	b.refresh(context.Background())
+
+	b.mu.Lock()
+	p := b.pollers["tok"]
+	b.mu.Unlock()
+
+	require.NotNil(t, p)
+
+	b.refresh(context.Background())
+
+	select {
+	case <-p.done:
+	default:
+		t.Fatal("refresh returned while the removed poller was still running")
+	}
+
+	assert.Empty(t, b.pollers)
+}
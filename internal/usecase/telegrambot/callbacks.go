@@ -0,0 +1,76 @@
+package telegrambot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/service/notify"
+)
+
+// runCallback applies an alert button press from an allowlisted user. It returns the toast shown to the
+// user and, for "open in UI", a link to post in the chat.
+func (b *Bot) runCallback(ctx context.Context, data string, from tgUser) (answer, link string) {
+	action, login, ok := notify.ParseTelegramCallback(data)
+	if !ok {
+		return "Unknown action.", ""
+	}
+
+	b.obs.Logger.Info("telegram bot: alert action",
+		zap.String("action", action), zap.String("login", login), zap.Int64("telegram_user_id", from.ID))
+
+	if action == notify.TelegramActionBlacklist {
+		if err := b.settings.SetChannelBlacklist(ctx, login, true); err != nil {
+			return b.failed(ctx, "blacklist", err), ""
+		}
+
+		return fmt.Sprintf("#%s blacklisted.", login), ""
+	}
+
+	id, err := b.repo.TwitchUserIDByUsername(ctx, login)
+	if errors.Is(err, entity.ErrNoTwitchUserForChannel) {
+		return fmt.Sprintf("Unknown user %s.", login), ""
+	}
+
+	if err != nil {
+		return b.failed(ctx, action, err), ""
+	}
+
+	var patch entity.TwitchUserPatch
+
+	switch action {
+	case notify.TelegramActionOpen:
+		if b.webBaseURL == "" {
+			return "server.base_url is not configured.", ""
+		}
+
+		return "Link sent.", b.userURL(id)
+	case notify.TelegramActionMarkSus:
+		sus := true
+		susType := entity.SusTypeManual
+		desc := "marked from Telegram"
+		patch = entity.TwitchUserPatch{
+			IsSus:          &sus,
+			SusType:        &susType,
+			SusDescription: &desc,
+			SusSource:      entity.SuspicionSourceTelegram,
+			SusEvidence:    map[string]any{"telegram_user_id": from.ID},
+		}
+	case notify.TelegramActionMark:
+		marked := true
+		patch = entity.TwitchUserPatch{Marked: &marked}
+	}
+
+	if _, err := b.settings.PatchTwitchUser(ctx, id, patch); err != nil {
+		return b.failed(ctx, action, err), ""
+	}
+
+	if action == notify.TelegramActionMarkSus {
+		return fmt.Sprintf("%s marked sus.", login), ""
+	}
+
+	return fmt.Sprintf("%s marked.", login), ""
+}
@@ -0,0 +1,134 @@
+package telegrambot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// apiClient is the small subset of the Bot API the bot uses.
+type apiClient struct {
+	http    *http.Client
+	baseURL string
+}
+
+type tgUser struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+type tgChat struct {
+	ID int64 `json:"id"`
+}
+
+type tgMessage struct {
+	MessageID int64   `json:"message_id"`
+	From      *tgUser `json:"from"`
+	Chat      tgChat  `json:"chat"`
+	Text      string  `json:"text"`
+}
+
+type tgCallbackQuery struct {
+	ID      string     `json:"id"`
+	From    tgUser     `json:"from"`
+	Message *tgMessage `json:"message"`
+	Data    string     `json:"data"`
+}
+
+type tgUpdate struct {
+	UpdateID      int64            `json:"update_id"`
+	Message       *tgMessage       `json:"message"`
+	CallbackQuery *tgCallbackQuery `json:"callback_query"`
+}
+
+type tgResponse struct {
+	OK          bool            `json:"ok"`
+	Description string          `json:"description"`
+	Result      json.RawMessage `json:"result"`
+}
+
+// call POSTs params as JSON to method and decodes result into out (when non-nil).
+func (c *apiClient) call(ctx context.Context, token, method string, params, out any) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/bot%s/%s", c.baseURL, token, method), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: build request: %w", method, unwrapURLError(err))
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", method, unwrapURLError(err))
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return fmt.Errorf("%s: read response: %w", method, err)
+	}
+
+	var tr tgResponse
+	if err := json.Unmarshal(raw, &tr); err != nil {
+		return fmt.Errorf("%s: status %d: decode response: %w", method, resp.StatusCode, err)
+	}
+
+	if !tr.OK {
+		return fmt.Errorf("%s: status %d: %s", method, resp.StatusCode, tr.Description)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.Unmarshal(tr.Result, out)
+}
+
+// getUpdates long-polls for messages and callback queries after offset.
+func (c *apiClient) getUpdates(ctx context.Context, token string, offset int64, timeout time.Duration) ([]tgUpdate, error) {
+	var out []tgUpdate
+
+	err := c.call(ctx, token, "getUpdates", map[string]any{
+		"offset":          offset,
+		"timeout":         int(timeout.Seconds()),
+		"allowed_updates": []string{"message", "callback_query"},
+	}, &out)
+
+	return out, err
+}
+
+func (c *apiClient) sendMessage(ctx context.Context, token string, chatID int64, text string) error {
+	return c.call(ctx, token, "sendMessage", map[string]any{
+		"chat_id":                  chatID,
+		"text":                     text,
+		"disable_web_page_preview": true,
+	}, nil)
+}
+
+func (c *apiClient) answerCallbackQuery(ctx context.Context, token, id, text string) error {
+	return c.call(ctx, token, "answerCallbackQuery", map[string]any{
+		"callback_query_id": id,
+		"text":              text,
+	}, nil)
+}
+
+// unwrapURLError drops the request URL (which embeds the bot token) from transport errors.
+func unwrapURLError(err error) error {
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		return ue.Err
+	}
+
+	return err
+}
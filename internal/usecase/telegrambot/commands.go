@@ -0,0 +1,260 @@
+package telegrambot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// maxListed caps /live and /where replies so they fit in one Telegram message.
+const maxListed = 30
+
+const helpText = `Commands:
+/live - channels that are live now
+/where <user> - channels a user is currently chatting in
+/mute <channel|all> <duration> - snooze this entry's alerts (e.g. 1h, 30m, 2d)
+/user <login> - user summary`
+
+// runCommand executes a slash command for an allowlisted user and returns the reply text.
+func (b *Bot) runCommand(ctx context.Context, e botEntry, text string) string {
+	fields := strings.Fields(text)
+	// Commands in groups arrive as /cmd@botname.
+	cmd, _, _ := strings.Cut(strings.ToLower(fields[0]), "@")
+	args := fields[1:]
+
+	switch cmd {
+	case "/start", "/help":
+		return helpText
+	case "/live":
+		return b.cmdLive(ctx)
+	case "/where":
+		if len(args) != 1 {
+			return "Usage: /where <user>"
+		}
+
+		return b.cmdWhere(ctx, normalizeLogin(args[0]))
+	case "/mute":
+		if len(args) != 2 {
+			return "Usage: /mute <channel|all> <duration>"
+		}
+
+		return b.cmdMute(ctx, e, args[0], args[1])
+	case "/user":
+		if len(args) != 1 {
+			return "Usage: /user <login>"
+		}
+
+		return b.cmdUser(ctx, normalizeLogin(args[0]))
+	default:
+		return "Unknown command.\n\n" + helpText
+	}
+}
+
+func (b *Bot) cmdLive(ctx context.Context) string {
+	streams, err := b.repo.ListMonitoredStreams(ctx, entity.StreamListFilter{LiveOnly: true, Limit: maxListed})
+	if err != nil {
+		return b.failed(ctx, "/live", err)
+	}
+
+	if len(streams) == 0 {
+		return "No monitored channels are live."
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("Live now:")
+
+	for _, s := range streams {
+		fmt.Fprintf(&sb, "\n#%s (%s)", s.ChannelLogin, formatSince(s.StartedAt))
+
+		if s.GameName != "" {
+			fmt.Fprintf(&sb, " - %s", s.GameName)
+		}
+	}
+
+	return sb.String()
+}
+
+func (b *Bot) cmdWhere(ctx context.Context, login string) string {
+	id, err := b.repo.TwitchUserIDByUsername(ctx, login)
+	if errors.Is(err, entity.ErrNoTwitchUserForChannel) {
+		return fmt.Sprintf("Unknown user %s.", login)
+	}
+
+	if err != nil {
+		return b.failed(ctx, "/where", err)
+	}
+
+	presence, err := b.repo.ListChatterChannelPresence(ctx, id)
+	if err != nil {
+		return b.failed(ctx, "/where", err)
+	}
+
+	if len(presence) == 0 {
+		return fmt.Sprintf("%s is not in any monitored channel.", login)
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s is in:", login)
+
+	for i, p := range presence {
+		if i == maxListed {
+			fmt.Fprintf(&sb, "\n… and %d more", len(presence)-maxListed)
+			break
+		}
+
+		fmt.Fprintf(&sb, "\n#%s (%s)", p.ChannelLogin, formatSince(p.PresentSince))
+	}
+
+	return sb.String()
+}
+
+func (b *Bot) cmdMute(ctx context.Context, e botEntry, target, rawDur string) string {
+	dur, err := parseDuration(rawDur)
+	if err != nil || dur <= 0 {
+		return "Invalid duration; use e.g. 30m, 1h or 2d."
+	}
+
+	channel := normalizeLogin(target)
+	if channel == "all" {
+		channel = ""
+	}
+
+	entryID := e.id
+
+	sn, err := b.settings.SnoozeNotifications(ctx, &entryID, channel, time.Now().Add(dur))
+	if errors.Is(err, entity.ErrInvalidNotification) {
+		return "Cannot mute: " + err.Error()
+	}
+
+	if err != nil {
+		return b.failed(ctx, "/mute", err)
+	}
+
+	until := sn.Until.UTC().Format("2006-01-02 15:04 UTC")
+	if channel == "" {
+		return "Muted all alerts until " + until + "."
+	}
+
+	return fmt.Sprintf("Muted #%s until %s.", channel, until)
+}
+
+func (b *Bot) cmdUser(ctx context.Context, login string) string {
+	id, err := b.repo.TwitchUserIDByUsername(ctx, login)
+	if errors.Is(err, entity.ErrNoTwitchUserForChannel) {
+		return fmt.Sprintf("Unknown user %s.", login)
+	}
+
+	if err != nil {
+		return b.failed(ctx, "/user", err)
+	}
+
+	u, err := b.repo.GetTwitchUserByID(ctx, id)
+	if err != nil {
+		return b.failed(ctx, "/user", err)
+	}
+
+	msgs, err := b.repo.CountChatMessagesByChatter(ctx, id)
+	if err != nil {
+		return b.failed(ctx, "/user", err)
+	}
+
+	presence, err := b.repo.ListChatterChannelPresence(ctx, id)
+	if err != nil {
+		return b.failed(ctx, "/user", err)
+	}
+
+	var flags []string
+
+	if u.Monitored {
+		flags = append(flags, "monitored")
+	}
+
+	if u.Marked {
+		flags = append(flags, "marked")
+	}
+
+	if u.IsSus {
+		flags = append(flags, "sus")
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s (id %d)", u.Username, u.ID)
+
+	if len(flags) > 0 {
+		fmt.Fprintf(&sb, " [%s]", strings.Join(flags, ", "))
+	}
+
+	if u.IsSus && u.SusDescription != nil && *u.SusDescription != "" {
+		fmt.Fprintf(&sb, "\nSus: %s", *u.SusDescription)
+	}
+
+	fmt.Fprintf(&sb, "\nMessages: %d", msgs)
+
+	if len(presence) > 0 {
+		channels := make([]string, 0, len(presence))
+		for _, p := range presence {
+			channels = append(channels, "#"+p.ChannelLogin)
+		}
+
+		fmt.Fprintf(&sb, "\nIn chat: %s", strings.Join(channels, ", "))
+	}
+
+	if b.webBaseURL != "" {
+		fmt.Fprintf(&sb, "\n%s", b.userURL(u.ID))
+	}
+
+	return sb.String()
+}
+
+// failed logs an unexpected command error and returns a generic reply.
+func (b *Bot) failed(ctx context.Context, cmd string, err error) string {
+	b.obs.LogError(ctx, trace.SpanFromContext(ctx), "telegram bot: "+cmd+" failed", err)
+
+	return "Something went wrong, see server logs."
+}
+
+func normalizeLogin(s string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(s), "#"), "@"))
+}
+
+// parseDuration accepts time.ParseDuration syntax plus a whole-day "d" suffix.
+func parseDuration(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(s)
+}
+
+func formatSince(t time.Time) string {
+	d := time.Since(t).Round(time.Minute)
+	if d < time.Minute {
+		return "just now"
+	}
+
+	h := int(d.Hours())
+	m := int(d.Minutes()) % 60
+
+	if h == 0 {
+		return fmt.Sprintf("%dm", m)
+	}
+
+	return fmt.Sprintf("%dh%02dm", h, m)
+}
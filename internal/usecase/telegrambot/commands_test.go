@@ -0,0 +1,118 @@
+package telegrambot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestRunCommand_live(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	repo.EXPECT().ListMonitoredStreams(gomock.Any(), entity.StreamListFilter{LiveOnly: true, Limit: maxListed}).Return([]entity.Stream{
+		{ChannelLogin: "alpha", StartedAt: time.Now().Add(-90 * time.Minute), GameName: "Chess"},
+	}, nil)
+
+	b := testBot(repo, nil, "")
+
+	assert.Equal(t, "Live now:\n#alpha (1h30m) - Chess", b.runCommand(context.Background(), botEntry{}, "/live"))
+}
+
+func TestRunCommand_where(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "viewer").Return(int64(7), nil)
+	repo.EXPECT().ListChatterChannelPresence(gomock.Any(), int64(7)).Return([]entity.ChatterChannelPresence{
+		{ChannelLogin: "alpha", PresentSince: time.Now().Add(-5 * time.Minute)},
+	}, nil)
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "ghost").Return(int64(0), entity.ErrNoTwitchUserForChannel)
+
+	b := testBot(repo, nil, "")
+
+	assert.Equal(t, "viewer is in:\n#alpha (5m)", b.runCommand(context.Background(), botEntry{}, "/where @Viewer"))
+	assert.Equal(t, "Unknown user ghost.", b.runCommand(context.Background(), botEntry{}, "/where ghost"))
+	assert.Equal(t, "Usage: /where <user>", b.runCommand(context.Background(), botEntry{}, "/where"))
+}
+
+func TestRunCommand_mute(t *testing.T) {
+	t.Parallel()
+
+	settings := &fakeSettings{}
+	b := testBot(nil, settings, "")
+	e := botEntry{id: 3}
+
+	assert.Contains(t, b.runCommand(context.Background(), e, "/mute #Alpha 1h"), "Muted #alpha until ")
+	assert.Contains(t, b.runCommand(context.Background(), e, "/mute all 2d"), "Muted all alerts until ")
+	assert.Contains(t, b.runCommand(context.Background(), e, "/mute alpha soon"), "Invalid duration")
+
+	require.Len(t, settings.snoozes, 2)
+	assert.Equal(t, int64(3), *settings.snoozes[0].NotificationEntryID)
+	assert.Equal(t, "alpha", settings.snoozes[0].Channel)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), settings.snoozes[0].Until, time.Minute)
+	assert.Empty(t, settings.snoozes[1].Channel)
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), settings.snoozes[1].Until, time.Minute)
+}
+
+func TestRunCommand_user(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	desc := "spam"
+
+	repo := repomocks.NewMockStore(ctrl)
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "viewer").Return(int64(7), nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(7)).Return(entity.TwitchUser{ID: 7, Username: "viewer", IsSus: true, SusDescription: &desc}, nil)
+	repo.EXPECT().CountChatMessagesByChatter(gomock.Any(), int64(7)).Return(int64(12), nil)
+	repo.EXPECT().ListChatterChannelPresence(gomock.Any(), int64(7)).Return([]entity.ChatterChannelPresence{{ChannelLogin: "alpha"}}, nil)
+
+	b := testBot(repo, nil, "")
+
+	assert.Equal(t, "viewer (id 7) [sus]\nSus: spam\nMessages: 12\nIn chat: #alpha\nhttps://dredge.example.org/#/users/7",
+		b.runCommand(context.Background(), botEntry{}, "/user viewer"))
+}
+
+func TestRunCommand_repoErrorIsGeneric(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	repo.EXPECT().ListMonitoredStreams(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+	b := testBot(repo, nil, "")
+
+	assert.Equal(t, "Something went wrong, see server logs.", b.runCommand(context.Background(), botEntry{}, "/live"))
+	assert.Contains(t, b.runCommand(context.Background(), botEntry{}, "/nope"), "Unknown command.")
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]time.Duration{"90m": 90 * time.Minute, "1h": time.Hour, "2D": 48 * time.Hour} {
+		got, err := parseDuration(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := parseDuration("xd")
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package twitch
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// UserActivityHeatmap returns when a user is present in monitored channels' IRC and chats, by local hour of week.
+// Presence in several channels at once is summed, like the profile's weekly presence.
+func (s *Usecase) UserActivityHeatmap(ctx context.Context, userID int64, q entity.ActivityHeatmapQuery) (entity.ActivityHeatmap, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.user_activity_heatmap")
+	defer span.End()
+
+	q, loc, err := q.Normalize(time.Now().UTC())
+	if err != nil {
+		return entity.ActivityHeatmap{}, err
+	}
+
+	if _, err := s.repo.GetTwitchUserByID(ctx, userID); err != nil {
+		return entity.ActivityHeatmap{}, err
+	}
+
+	evs, err := s.repo.ListUserActivityEventsForTimeline(ctx, userID, q.From.Add(-channelAnalyticsPresenceLookback), q.To)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list user presence events failed", err, zap.Int64("user_id", userID))
+		return entity.ActivityHeatmap{}, err
+	}
+
+	msgs, err := s.repo.CountChatMessagesByHourOfWeek(ctx, &userID, nil, q.From, q.To, q.Timezone)
+	if err != nil {
+		s.obs.LogError(ctx, span, "count user messages by hour of week failed", err, zap.Int64("user_id", userID))
+		return entity.ActivityHeatmap{}, err
+	}
+
+	out := entity.ActivityHeatmap{Timezone: q.Timezone, From: q.From, To: q.To, Messages: msgs}
+	addPresenceHeatmap(&out.PresenceSeconds, BuildActivityTimelineSegments(evs, q.To), q.From, q.To, loc)
+
+	return out, nil
+}
+
+// ChannelActivityHeatmap returns when a monitored channel's chat is busiest by local hour of week: chatters' summed
+// IRC presence and messages.
+func (s *Usecase) ChannelActivityHeatmap(ctx context.Context, channel string, q entity.ActivityHeatmapQuery) (entity.ActivityHeatmap, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.channel_activity_heatmap")
+	defer span.End()
+
+	q, loc, err := q.Normalize(time.Now().UTC())
+	if err != nil {
+		return entity.ActivityHeatmap{}, err
+	}
+
+	channelID, ok, err := s.repo.MonitoredChannelTwitchUserID(ctx, channel)
+	if err != nil {
+		s.obs.LogError(ctx, span, "check monitored channel failed", err, zap.String("channel", channel))
+		return entity.ActivityHeatmap{}, err
+	}
+
+	if !ok {
+		return entity.ActivityHeatmap{}, ErrChannelNotMonitored
+	}
+
+	byChatter, err := s.channelPresenceEvents(ctx, channelID, q.From, q.To)
+	if err != nil {
+		s.obs.LogError(ctx, span, "channel presence for heatmap failed", err, zap.Int64("channel_id", channelID))
+		return entity.ActivityHeatmap{}, err
+	}
+
+	msgs, err := s.repo.CountChatMessagesByHourOfWeek(ctx, nil, &channelID, q.From, q.To, q.Timezone)
+	if err != nil {
+		s.obs.LogError(ctx, span, "count channel messages by hour of week failed", err, zap.Int64("channel_id", channelID))
+		return entity.ActivityHeatmap{}, err
+	}
+
+	out := entity.ActivityHeatmap{Timezone: q.Timezone, From: q.From, To: q.To, Messages: msgs}
+
+	for _, list := range byChatter {
+		addPresenceHeatmap(&out.PresenceSeconds, BuildActivityTimelineSegments(list, q.To), q.From, q.To, loc)
+	}
+
+	return out, nil
+}
+
+// addPresenceHeatmap adds segments clipped to [from, to) to m.
+func addPresenceHeatmap(m *entity.HourOfWeekMatrix, segs []entity.ActivityTimelineSegment, from, to time.Time, loc *time.Location) {
+	for _, seg := range segs {
+		start, end := seg.Start, seg.End
+		if start.Before(from) {
+			start = from
+		}
+
+		if end.After(to) {
+			end = to
+		}
+
+		m.AddSpan(start, end, loc)
+	}
+}
@@ -0,0 +1,86 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestUsecase_UserActivityHeatmap(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	// Monday 2026-03-09, 10:00 UTC is 11:00 in Berlin.
+	from := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+
+	var msgs entity.HourOfWeekMatrix
+	msgs[0][11] = 4
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(5)).Return(entity.TwitchUser{ID: 5}, nil)
+	repo.EXPECT().ListUserActivityEventsForTimeline(gomock.Any(), int64(5), from.Add(-channelAnalyticsPresenceLookback), to).
+		Return([]entity.UserActivityEvent{
+			presenceEvent(1, 5, 9, entity.UserActivityChatOnline, from.Add(-time.Hour)),
+			presenceEvent(2, 5, 9, entity.UserActivityChatOffline, from.Add(30*time.Minute)),
+			presenceEvent(3, 5, 10, entity.UserActivityChatOnline, from.Add(90*time.Minute)),
+		}, nil)
+	repo.EXPECT().CountChatMessagesByHourOfWeek(gomock.Any(), gomock.Any(), nil, from, to, "Europe/Berlin").Return(msgs, nil)
+
+	got, err := svc.UserActivityHeatmap(context.Background(), 5, entity.ActivityHeatmapQuery{From: from, To: to, Timezone: "Europe/Berlin"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Europe/Berlin", got.Timezone)
+	assert.Equal(t, int64(4), got.Messages[0][11])
+	assert.Equal(t, int64(1800), got.PresenceSeconds[0][11], "the session before from is clipped")
+	assert.Equal(t, int64(1800), got.PresenceSeconds[0][12], "the open session runs to the end of the range")
+}
+
+func TestUsecase_ChannelActivityHeatmap(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	from := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "streamer").Return(int64(9), true, nil)
+	repo.EXPECT().ListUserActivityEventsForChannelPresence(gomock.Any(), int64(9), from.Add(-channelAnalyticsPresenceLookback), to).
+		Return([]entity.UserActivityEvent{
+			presenceEvent(1, 5, 9, entity.UserActivityChatOnline, from),
+			presenceEvent(2, 6, 9, entity.UserActivityChatOnline, from.Add(30*time.Minute)),
+		}, nil)
+	repo.EXPECT().CountChatMessagesByHourOfWeek(gomock.Any(), nil, gomock.Any(), from, to, "UTC").Return(entity.HourOfWeekMatrix{}, nil)
+
+	got, err := svc.ChannelActivityHeatmap(context.Background(), "streamer", entity.ActivityHeatmapQuery{From: from, To: to})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3600+1800), got.PresenceSeconds[0][10])
+
+	_, err = svc.ChannelActivityHeatmap(context.Background(), "streamer", entity.ActivityHeatmapQuery{Timezone: "Nowhere/Land"})
+	require.ErrorIs(t, err, entity.ErrInvalidActivityHeatmapQuery)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "ghost").Return(int64(0), false, nil)
+
+	_, err = svc.ChannelActivityHeatmap(context.Background(), "ghost", entity.ActivityHeatmapQuery{})
+	require.ErrorIs(t, err, ErrChannelNotMonitored)
+}
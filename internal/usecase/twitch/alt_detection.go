@@ -0,0 +1,176 @@
+package twitch
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// altPoolPerSource caps candidates taken from each pool source (shared follows, creation time, login stem).
+	altPoolPerSource = 25
+	// altMinScore is the lowest pair score stored as a possible alt.
+	altMinScore = 40
+	// altCandidatesKept is how many possible alts are stored and shown per user.
+	altCandidatesKept = 10
+	// altLookback bounds the presence and messages compared.
+	altLookback = 30 * 24 * time.Hour
+	// altMessagesScanned caps the recent messages used for stylometry and timing.
+	altMessagesScanned = 200
+	// altDetectionInterval is how often chatters active since the previous run are re-scanned.
+	altDetectionInterval = time.Hour
+	// altDetectionBatch caps users scanned per run; the most recently active go first.
+	altDetectionBatch = 500
+)
+
+// DetectAltsForUser scores userID against its candidate pool, stores the best pairs as possible alts and
+// returns them (confirmed and rejected pairs excluded).
+func (s *Usecase) DetectAltsForUser(ctx context.Context, userID int64) ([]entity.AltCandidate, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.detect_alts_for_user")
+	defer span.End()
+
+	now := time.Now().UTC()
+
+	me, err := s.loadAltProfile(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := s.repo.ListAltCandidatePool(ctx, userID, loginStem(me.Login), altPoolPerSource)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list alt candidate pool failed", err, zap.Int64("id", userID))
+		return nil, err
+	}
+
+	var cands []entity.AltCandidate
+
+	for _, id := range pool {
+		// A candidate that vanished or failed to load is skipped so the rest of the pool is still scored.
+		other, err := s.loadAltProfile(ctx, id, now)
+		if err != nil {
+			s.obs.Logger.Warn("alt detection: load candidate failed", zap.Int64("id", userID), zap.Int64("candidate_id", id), zap.Error(err))
+			continue
+		}
+
+		score, signals := scoreAltPair(me, other)
+		if score < altMinScore {
+			continue
+		}
+
+		cands = append(cands, entity.AltCandidate{
+			TwitchUserID:      userID,
+			CandidateUserID:   id,
+			CandidateUsername: other.Login,
+			Score:             score,
+			Signals:           signals,
+			ComputedAt:        now,
+		})
+	}
+
+	slices.SortStableFunc(cands, func(a, b entity.AltCandidate) int { return b.Score - a.Score })
+
+	if len(cands) > altCandidatesKept {
+		cands = cands[:altCandidatesKept]
+	}
+
+	if err := s.repo.ReplaceAltCandidates(ctx, userID, cands); err != nil {
+		s.obs.LogError(ctx, span, "replace alt candidates failed", err, zap.Int64("id", userID))
+		return nil, err
+	}
+
+	return s.ListAltCandidates(ctx, userID)
+}
+
+func (s *Usecase) loadAltProfile(ctx context.Context, userID int64, now time.Time) (altProfile, error) {
+	u, err := s.repo.GetTwitchUserByID(ctx, userID)
+	if err != nil {
+		return altProfile{}, err
+	}
+
+	follows, err := s.repo.ListUserFollowedChannels(ctx, userID)
+	if err != nil {
+		return altProfile{}, err
+	}
+
+	created, _, _, err := s.repo.GetHelixMeta(ctx, userID)
+	if err != nil {
+		return altProfile{}, err
+	}
+
+	events, err := s.repo.ListUserActivityEventsForTimeline(ctx, userID, now.Add(-altLookback), now)
+	if err != nil {
+		return altProfile{}, err
+	}
+
+	since := now.Add(-altLookback)
+
+	msgs, err := s.repo.ListChatMessages(ctx, entity.ChatMessageListFilter{
+		ChatterUserID: &userID,
+		CreatedFrom:   &since,
+		Limit:         altMessagesScanned,
+	})
+	if err != nil {
+		return altProfile{}, err
+	}
+
+	p := altProfile{
+		ID:       userID,
+		Login:    u.Username,
+		Follows:  make(map[int64]struct{}, len(follows)),
+		Created:  created,
+		Presence: BuildActivityTimelineSegments(events, now),
+		Messages: msgs,
+	}
+
+	for _, f := range follows {
+		p.Follows[f.FollowedChannelID] = struct{}{}
+	}
+
+	return p, nil
+}
+
+// StartAltDetectionLoop re-scans possible alts of recently active chatters every altDetectionInterval
+// until ctx is cancelled.
+func (s *Usecase) StartAltDetectionLoop(ctx context.Context) {
+	ticker := time.NewTicker(altDetectionInterval)
+	defer ticker.Stop()
+
+	since := time.Now().UTC().Add(-24 * time.Hour)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			started := time.Now().UTC()
+
+			s.runAltDetection(ctx, since)
+
+			since = started
+		}
+	}
+}
+
+func (s *Usecase) runAltDetection(ctx context.Context, since time.Time) {
+	ids, err := s.repo.ListChattersActiveSince(ctx, since, altDetectionBatch)
+	if err != nil {
+		s.obs.Logger.Warn("alt detection: list active chatters failed", zap.Error(err))
+		return
+	}
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if _, err := s.DetectAltsForUser(ctx, id); err != nil {
+			s.obs.Logger.Debug("alt detection: scan failed", zap.Int64("id", id), zap.Error(err))
+		}
+	}
+
+	s.obs.Logger.Debug("alt detection run finished", zap.Int("users", len(ids)))
+}
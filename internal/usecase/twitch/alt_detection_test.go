@@ -0,0 +1,122 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func expectAltProfile(repo *repomocks.MockStore, id int64, login string, created *time.Time, followIDs ...int64) {
+	rows := make([]entity.FollowedChannelRow, 0, len(followIDs))
+	for _, f := range followIDs {
+		rows = append(rows, entity.FollowedChannelRow{FollowedChannelID: f})
+	}
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), id).Return(entity.TwitchUser{ID: id, Username: login}, nil)
+	repo.EXPECT().ListUserFollowedChannels(gomock.Any(), id).Return(rows, nil)
+	repo.EXPECT().GetHelixMeta(gomock.Any(), id).Return(created, nil, nil, nil)
+	repo.EXPECT().ListUserActivityEventsForTimeline(gomock.Any(), id, gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().ListChatMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f entity.ChatMessageListFilter) ([]entity.ChatHistoryMessage, error) {
+			if *f.ChatterUserID != id || f.CreatedFrom == nil || f.Limit != altMessagesScanned {
+				return nil, assert.AnError
+			}
+
+			return nil, nil
+		})
+}
+
+func TestDetectAltsForUser(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	created := time.Now().UTC().Add(-100 * 24 * time.Hour)
+
+	expectAltProfile(repo, 1, "spammer_1", &created, 1, 2, 3, 4, 5)
+	repo.EXPECT().ListAltCandidatePool(gomock.Any(), int64(1), "spammer", altPoolPerSource).Return([]int64{2, 3}, nil)
+	expectAltProfile(repo, 2, "spammer_2", &created, 1, 2, 3, 4, 5)
+	expectAltProfile(repo, 3, "someone", nil, 1, 2, 3, 4, 5)
+
+	repo.EXPECT().ReplaceAltCandidates(gomock.Any(), int64(1), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, cands []entity.AltCandidate) error {
+			require.Len(t, cands, 1)
+			assert.Equal(t, int64(2), cands[0].CandidateUserID)
+			assert.Equal(t, 65, cands[0].Score)
+			assert.Equal(t, []string{entity.AltSignalFollowOverlap, entity.AltSignalLoginSimilarity, entity.AltSignalAccountCreated},
+				altSignalKeys(cands[0].Signals))
+
+			return nil
+		})
+
+	stored := []entity.AltCandidate{{TwitchUserID: 1, CandidateUserID: 2, CandidateUsername: "spammer_2", Score: 65}}
+	repo.EXPECT().ListAltCandidates(gomock.Any(), int64(1), altCandidatesKept).Return(stored, nil)
+
+	out, err := svc.DetectAltsForUser(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, stored, out)
+}
+
+func TestDetectAltsForUser_skipsFailingCandidate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	created := time.Now().UTC().Add(-100 * 24 * time.Hour)
+
+	expectAltProfile(repo, 1, "spammer_1", &created, 1, 2, 3, 4, 5)
+	repo.EXPECT().ListAltCandidatePool(gomock.Any(), int64(1), "spammer", altPoolPerSource).Return([]int64{2, 3}, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(2)).Return(entity.TwitchUser{}, entity.ErrTwitchUserNotFound)
+	expectAltProfile(repo, 3, "spammer_3", &created, 1, 2, 3, 4, 5)
+
+	repo.EXPECT().ReplaceAltCandidates(gomock.Any(), int64(1), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, cands []entity.AltCandidate) error {
+			require.Len(t, cands, 1)
+			assert.Equal(t, int64(3), cands[0].CandidateUserID)
+
+			return nil
+		})
+
+	stored := []entity.AltCandidate{{TwitchUserID: 1, CandidateUserID: 3, CandidateUsername: "spammer_3", Score: 65}}
+	repo.EXPECT().ListAltCandidates(gomock.Any(), int64(1), altCandidatesKept).Return(stored, nil)
+
+	out, err := svc.DetectAltsForUser(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, stored, out)
+}
+
+func TestDetectAltsForUser_unknownUser(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(5)).Return(entity.TwitchUser{}, entity.ErrTwitchUserNotFound)
+
+	_, err := svc.DetectAltsForUser(context.Background(), 5)
+	require.ErrorIs(t, err, entity.ErrTwitchUserNotFound)
+}
@@ -0,0 +1,340 @@
+package twitch
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// Alt signal weights; they sum to 100 so a pair score reads as a percentage.
+const (
+	altWeightFollowOverlap  = 30
+	altWeightLogin          = 20
+	altWeightAccountCreated = 15
+	altWeightSharedPresence = 15
+	altWeightStylometry     = 10
+	altWeightTiming         = 10
+)
+
+// Minimum data per user before a signal is considered; below these the comparison is noise.
+const (
+	altMinFollows  = 5
+	altMinMessages = 10
+	altMinPresence = 10 * time.Minute
+)
+
+// altProfile is the cached data one side of a pair is compared on.
+type altProfile struct {
+	ID       int64
+	Login    string
+	Follows  map[int64]struct{}
+	Created  *time.Time
+	Presence []entity.ActivityTimelineSegment
+	Messages []entity.ChatHistoryMessage
+}
+
+// scoreAltPair sums the weighted similarity signals that fire for two users.
+func scoreAltPair(a, b altProfile) (int, []entity.AltSignal) {
+	var (
+		total   int
+		signals []entity.AltSignal
+	)
+
+	add := func(key string, weight, score int, detail string) {
+		if score <= 0 {
+			return
+		}
+
+		score = min(score, weight)
+		total += score
+		signals = append(signals, entity.AltSignal{Key: key, Score: score, Weight: weight, Detail: detail})
+	}
+
+	if len(a.Follows) >= altMinFollows && len(b.Follows) >= altMinFollows {
+		shared := 0
+
+		for id := range a.Follows {
+			if _, ok := b.Follows[id]; ok {
+				shared++
+			}
+		}
+
+		j := float64(shared) / float64(len(a.Follows)+len(b.Follows)-shared)
+		if j >= 0.2 {
+			add(entity.AltSignalFollowOverlap, altWeightFollowOverlap, scaled(altWeightFollowOverlap, j, 0, 0.6),
+				fmt.Sprintf("Share %d followed channels (%.0f%% overlap)", shared, j*100))
+		}
+	}
+
+	if stem := loginStem(a.Login); len(stem) >= 3 && stem == loginStem(b.Login) {
+		add(entity.AltSignalLoginSimilarity, altWeightLogin, altWeightLogin,
+			fmt.Sprintf("Logins share the stem %q", stem))
+	} else if sim := loginSimilarity(a.Login, b.Login); sim >= 0.6 {
+		add(entity.AltSignalLoginSimilarity, altWeightLogin, scaled(altWeightLogin, sim, 0.5, 1),
+			fmt.Sprintf("Logins are %.0f%% similar", sim*100))
+	}
+
+	if a.Created != nil && b.Created != nil {
+		d := a.Created.Sub(*b.Created).Abs()
+
+		var score int
+
+		switch {
+		case d <= time.Hour:
+			score = altWeightAccountCreated
+		case d <= 24*time.Hour:
+			score = altWeightAccountCreated * 2 / 3
+		case d <= 72*time.Hour:
+			score = altWeightAccountCreated / 3
+		}
+
+		add(entity.AltSignalAccountCreated, altWeightAccountCreated, score,
+			fmt.Sprintf("Accounts created %s apart", d.Round(time.Minute)))
+	}
+
+	if overlap, least := presenceOverlap(a.Presence, b.Presence); least >= altMinPresence {
+		ratio := overlap.Seconds() / least.Seconds()
+		if ratio >= 0.3 {
+			add(entity.AltSignalSharedPresence, altWeightSharedPresence, scaled(altWeightSharedPresence, ratio, 0, 0.8),
+				fmt.Sprintf("In the same channels at the same time for %s", overlap.Round(time.Minute)))
+		}
+	}
+
+	if len(a.Messages) >= altMinMessages && len(b.Messages) >= altMinMessages {
+		if sim := styleSimilarity(a.Messages, b.Messages); sim >= 0.6 {
+			add(entity.AltSignalStylometry, altWeightStylometry, scaled(altWeightStylometry, sim, 0.5, 0.9),
+				fmt.Sprintf("Writing style is %.0f%% similar", sim*100))
+		}
+
+		ha, hb := hourHistogram(a.Messages), hourHistogram(b.Messages)
+		if sim := cosine(ha[:], hb[:]); sim >= 0.85 {
+			add(entity.AltSignalTiming, altWeightTiming, scaled(altWeightTiming, sim, 0.7, 1),
+				fmt.Sprintf("Chat at the same hours of day (%.0f%% similar)", sim*100))
+		}
+	}
+
+	return total, signals
+}
+
+// scaled maps v from [lo, hi] onto [0, weight], clamping outside the range.
+func scaled(weight int, v, lo, hi float64) int {
+	f := (v - lo) / (hi - lo)
+	f = math.Max(0, math.Min(1, f))
+
+	return int(math.Round(float64(weight) * f))
+}
+
+// loginStem drops trailing digits and separators, e.g. "spammer_123" -> "spammer".
+func loginStem(login string) string {
+	return strings.TrimRight(strings.ToLower(login), "0123456789_")
+}
+
+// loginSimilarity is 1 minus the edit distance normalized by the longer login.
+func loginSimilarity(a, b string) float64 {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+
+	n := max(len(a), len(b))
+	if n == 0 || a == b {
+		return 0
+	}
+
+	return 1 - float64(levenshtein(a, b))/float64(n)
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+// presenceOverlap returns how long both users were present in the same channel and the smaller of their
+// total presence times.
+func presenceOverlap(a, b []entity.ActivityTimelineSegment) (overlap, least time.Duration) {
+	var ta, tb time.Duration
+
+	for _, s := range a {
+		ta += s.End.Sub(s.Start)
+	}
+
+	for _, s := range b {
+		tb += s.End.Sub(s.Start)
+	}
+
+	for _, sa := range a {
+		for _, sb := range b {
+			if sa.ChannelTwitchUserID != sb.ChannelTwitchUserID {
+				continue
+			}
+
+			start, end := sa.Start, sa.End
+			if sb.Start.After(start) {
+				start = sb.Start
+			}
+
+			if sb.End.Before(end) {
+				end = sb.End
+			}
+
+			if end.After(start) {
+				overlap += end.Sub(start)
+			}
+		}
+	}
+
+	return overlap, min(ta, tb)
+}
+
+// styleSimilarity averages the similarity of simple character features and of word usage.
+func styleSimilarity(a, b []entity.ChatHistoryMessage) float64 {
+	fa, fb := styleFeatures(a), styleFeatures(b)
+
+	var diff float64
+	for i := range fa {
+		diff += math.Abs(fa[i] - fb[i])
+	}
+
+	features := 1 - diff/float64(len(fa))
+
+	wa, wb := wordFrequencies(a), wordFrequencies(b)
+
+	var dot, na, nb float64
+
+	for w, x := range wa {
+		dot += x * wb[w]
+		na += x * x
+	}
+
+	for _, y := range wb {
+		nb += y * y
+	}
+
+	var words float64
+	if na > 0 && nb > 0 {
+		words = dot / math.Sqrt(na*nb)
+	}
+
+	return (features + words) / 2
+}
+
+// styleFeatures are per-user averages in [0, 1]: message length, uppercase letters, punctuation, non-ASCII
+// (emoji), and the share of messages asking questions, exclaiming or mentioning someone.
+func styleFeatures(msgs []entity.ChatHistoryMessage) [7]float64 {
+	var f [7]float64
+
+	for _, m := range msgs {
+		var letters, upper, punct, nonASCII, runes int
+
+		for _, r := range m.Message {
+			runes++
+
+			switch {
+			case r > unicode.MaxASCII:
+				nonASCII++
+			case unicode.IsLetter(r):
+				letters++
+
+				if unicode.IsUpper(r) {
+					upper++
+				}
+			case unicode.IsPunct(r):
+				punct++
+			}
+		}
+
+		f[0] += math.Min(float64(runes)/100, 1)
+		f[1] += ratio(upper, letters)
+		f[2] += ratio(punct, runes)
+		f[3] += ratio(nonASCII, runes)
+
+		if strings.Contains(m.Message, "?") {
+			f[4]++
+		}
+
+		if strings.Contains(m.Message, "!") {
+			f[5]++
+		}
+
+		if strings.HasPrefix(m.Message, "@") {
+			f[6]++
+		}
+	}
+
+	for i := range f {
+		f[i] /= float64(len(msgs))
+	}
+
+	return f
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+
+	return float64(n) / float64(d)
+}
+
+func wordFrequencies(msgs []entity.ChatHistoryMessage) map[string]float64 {
+	out := make(map[string]float64)
+
+	for _, m := range msgs {
+		for _, w := range strings.Fields(strings.ToLower(m.Message)) {
+			w = strings.TrimFunc(w, func(r rune) bool { return unicode.IsPunct(r) })
+			if len(w) >= 2 && !strings.HasPrefix(w, "@") {
+				out[w]++
+			}
+		}
+	}
+
+	return out
+}
+
+func hourHistogram(msgs []entity.ChatHistoryMessage) [24]float64 {
+	var h [24]float64
+
+	for _, m := range msgs {
+		h[m.CreatedAt.UTC().Hour()]++
+	}
+
+	return h
+}
+
+func cosine(a, b []float64) float64 {
+	var dot, na, nb float64
+
+	for i := range a {
+		dot += a[i] * b[i]
+		na += a[i] * a[i]
+		nb += b[i] * b[i]
+	}
+
+	if na == 0 || nb == 0 {
+		return 0
+	}
+
+	return dot / math.Sqrt(na*nb)
+}
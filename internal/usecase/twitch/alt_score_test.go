@@ -0,0 +1,137 @@
+package twitch
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func altSignalKeys(signals []entity.AltSignal) []string {
+	keys := make([]string, 0, len(signals))
+	for _, sig := range signals {
+		keys = append(keys, sig.Key)
+	}
+
+	return keys
+}
+
+func follows(ids ...int64) map[int64]struct{} {
+	out := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		out[id] = struct{}{}
+	}
+
+	return out
+}
+
+func chatAt(t time.Time, texts ...string) []entity.ChatHistoryMessage {
+	out := make([]entity.ChatHistoryMessage, 0, len(texts))
+	for i, text := range texts {
+		out = append(out, entity.ChatHistoryMessage{Message: text, CreatedAt: t.Add(time.Duration(i) * time.Minute)})
+	}
+
+	return out
+}
+
+func TestScoreAltPair(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2024, 6, 1, 20, 0, 0, 0, time.UTC)
+
+	t.Run("unrelated", func(t *testing.T) {
+		t.Parallel()
+
+		score, signals := scoreAltPair(
+			altProfile{Login: "alice", Follows: follows(1, 2, 3, 4, 5)},
+			altProfile{Login: "bob", Follows: follows(6, 7, 8, 9, 10)},
+		)
+		assert.Zero(t, score)
+		assert.Empty(t, signals)
+	})
+
+	t.Run("same_person", func(t *testing.T) {
+		t.Parallel()
+
+		created := base.Add(-90 * 24 * time.Hour)
+		msgs := make([]string, 12)
+
+		for i := range msgs {
+			msgs[i] = fmt.Sprintf("KEKW this streamer is so bad lol %d", i)
+		}
+
+		a := altProfile{
+			Login:    "spammer_123",
+			Follows:  follows(1, 2, 3, 4, 5, 6),
+			Created:  &created,
+			Presence: []entity.ActivityTimelineSegment{{ChannelTwitchUserID: 7, Start: base, End: base.Add(time.Hour)}},
+			Messages: chatAt(base, msgs...),
+		}
+
+		createdB := created.Add(30 * time.Minute)
+		b := altProfile{
+			Login:    "spammer456",
+			Follows:  follows(1, 2, 3, 4, 5, 9),
+			Created:  &createdB,
+			Presence: []entity.ActivityTimelineSegment{{ChannelTwitchUserID: 7, Start: base.Add(10 * time.Minute), End: base.Add(2 * time.Hour)}},
+			Messages: chatAt(base.Add(20*time.Minute), msgs...),
+		}
+
+		score, signals := scoreAltPair(a, b)
+		assert.Equal(t, []string{
+			entity.AltSignalFollowOverlap,
+			entity.AltSignalLoginSimilarity,
+			entity.AltSignalAccountCreated,
+			entity.AltSignalSharedPresence,
+			entity.AltSignalStylometry,
+			entity.AltSignalTiming,
+		}, altSignalKeys(signals))
+		assert.Equal(t, entity.AltSignal{
+			Key: entity.AltSignalLoginSimilarity, Score: 20, Weight: 20, Detail: `Logins share the stem "spammer"`,
+		}, signals[1])
+		assert.Equal(t, 15, signals[2].Score)
+		assert.Equal(t, "Share 5 followed channels (71% overlap)", signals[0].Detail)
+		assert.GreaterOrEqual(t, score, altMinScore)
+		assert.LessOrEqual(t, score, 100)
+	})
+
+	t.Run("too_little_data", func(t *testing.T) {
+		t.Parallel()
+
+		_, signals := scoreAltPair(
+			altProfile{Login: "x1", Follows: follows(1, 2), Messages: chatAt(base, "hi")},
+			altProfile{Login: "x2", Follows: follows(1, 2), Messages: chatAt(base, "hi")},
+		)
+		assert.Empty(t, signals, "short stems, few follows and few messages are ignored")
+	})
+}
+
+func TestLoginSimilarity(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "viewer", loginStem("Viewer_2024"))
+	assert.Equal(t, 3, levenshtein("kitten", "sitting"))
+	assert.InDelta(t, 1-1.0/6, loginSimilarity("shadow", "shad0w"), 1e-9)
+	assert.Zero(t, loginSimilarity("same", "SAME"))
+}
+
+func TestPresenceOverlap(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	a := []entity.ActivityTimelineSegment{
+		{ChannelTwitchUserID: 1, Start: base, End: base.Add(time.Hour)},
+		{ChannelTwitchUserID: 2, Start: base, End: base.Add(time.Hour)},
+	}
+	b := []entity.ActivityTimelineSegment{
+		{ChannelTwitchUserID: 1, Start: base.Add(30 * time.Minute), End: base.Add(90 * time.Minute)},
+	}
+
+	overlap, least := presenceOverlap(a, b)
+	require.Equal(t, 30*time.Minute, overlap)
+	require.Equal(t, time.Hour, least)
+}
@@ -0,0 +1,249 @@
+package twitch
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// audienceOverlapCacheTTL is how long a computed period is served from the cache.
+	audienceOverlapCacheTTL = time.Hour
+	// audienceOverlapTopShared caps the shared users listed per channel pair.
+	audienceOverlapTopShared = 10
+	// audienceCommunitySize caps the "also frequents" channels listed per monitored channel.
+	audienceCommunitySize = 10
+)
+
+// GetAudienceOverlap compares the audiences (chatters and present users) of all monitored channels over a trailing
+// period. Results are cached per period for audienceOverlapCacheTTL; refresh recomputes regardless. Likely bots
+// are left out when bot detection excludes them from stats.
+func (s *Usecase) GetAudienceOverlap(ctx context.Context, period entity.AudienceOverlapPeriod, refresh bool) (entity.AudienceOverlap, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.get_audience_overlap")
+	defer span.End()
+
+	d, err := period.Duration()
+	if err != nil {
+		return entity.AudienceOverlap{}, err
+	}
+
+	now := time.Now().UTC()
+
+	if !refresh {
+		cached, err := s.repo.GetAudienceOverlapCache(ctx, period)
+		if err != nil {
+			s.obs.LogError(ctx, span, "get audience overlap cache failed", err)
+			return entity.AudienceOverlap{}, err
+		}
+
+		if cached != nil && now.Sub(cached.ComputedAt) < audienceOverlapCacheTTL {
+			return *cached, nil
+		}
+	}
+
+	channels, err := s.repo.ListMonitoredTwitchUsers(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list monitored channels failed", err)
+		return entity.AudienceOverlap{}, err
+	}
+
+	members, err := s.repo.ListChannelAudience(ctx, now.Add(-d), now)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list channel audience failed", err)
+		return entity.AudienceOverlap{}, err
+	}
+
+	members = s.withoutLikelyBotMembers(ctx, members)
+
+	o, audiences := buildAudienceOverlap(channels, members)
+	o.Period, o.From, o.To, o.ComputedAt = period, now.Add(-d), now, now
+
+	for i, c := range o.Channels {
+		if c.Audience == 0 {
+			continue
+		}
+
+		follows, err := s.repo.CountFollowedChannelsAmong(ctx, slices.Collect(maps.Keys(audiences[c.ChannelTwitchUserID])), 2*audienceCommunitySize)
+		if err != nil {
+			s.obs.LogError(ctx, span, "count audience follows failed", err, zap.Int64("channel_id", c.ChannelTwitchUserID))
+			return entity.AudienceOverlap{}, err
+		}
+
+		o.Channels[i].Community = mergeAudienceCommunity(c.ChannelTwitchUserID, c.Community, follows)
+	}
+
+	if err := s.repo.SaveAudienceOverlapCache(ctx, o); err != nil {
+		s.obs.LogError(ctx, span, "save audience overlap cache failed", err)
+		return entity.AudienceOverlap{}, err
+	}
+
+	return o, nil
+}
+
+func (s *Usecase) withoutLikelyBotMembers(ctx context.Context, members []entity.AudienceMember) []entity.AudienceMember {
+	ids := make(map[int64]struct{}, len(members))
+	for _, m := range members {
+		ids[m.TwitchUserID] = struct{}{}
+	}
+
+	bots := s.likelyBotsExcluded(ctx, slices.Collect(maps.Keys(ids)))
+	if len(bots) == 0 {
+		return members
+	}
+
+	return slices.DeleteFunc(members, func(m entity.AudienceMember) bool {
+		_, bot := bots[m.TwitchUserID]
+		return bot
+	})
+}
+
+// buildAudienceOverlap sizes every channel's audience and compares each pair of channels (ordered by login). Each
+// channel's Community holds the monitored channels it shares users with; the audiences are returned by channel
+// and user id.
+func buildAudienceOverlap(
+	channels []entity.TwitchUser,
+	members []entity.AudienceMember,
+) (entity.AudienceOverlap, map[int64]map[int64]entity.AudienceMember) {
+	audiences := make(map[int64]map[int64]entity.AudienceMember, len(channels))
+
+	for _, m := range members {
+		if audiences[m.ChannelTwitchUserID] == nil {
+			audiences[m.ChannelTwitchUserID] = make(map[int64]entity.AudienceMember)
+		}
+
+		audiences[m.ChannelTwitchUserID][m.TwitchUserID] = m
+	}
+
+	channels = slices.Clone(channels)
+	slices.SortFunc(channels, func(a, b entity.TwitchUser) int { return cmp.Compare(a.Username, b.Username) })
+
+	var o entity.AudienceOverlap
+
+	for _, ch := range channels {
+		c := entity.AudienceChannel{ChannelTwitchUserID: ch.ID, Login: ch.Username, Audience: len(audiences[ch.ID])}
+
+		for _, m := range audiences[ch.ID] {
+			if m.Messages > 0 {
+				c.Chatters++
+			}
+
+			if m.Present {
+				c.PresentUsers++
+			}
+		}
+
+		o.Channels = append(o.Channels, c)
+	}
+
+	for i := range o.Channels {
+		for j := i + 1; j < len(o.Channels); j++ {
+			a, b := &o.Channels[i], &o.Channels[j]
+
+			p, ok := compareAudiences(a.ChannelTwitchUserID, b.ChannelTwitchUserID, audiences)
+			if !ok {
+				continue
+			}
+
+			o.Pairs = append(o.Pairs, p)
+			a.Community = append(a.Community, entity.AudienceCommunityChannel{
+				ChannelTwitchUserID: b.ChannelTwitchUserID, Login: b.Login, Monitored: true, ActiveUsers: p.SharedUsers,
+			})
+			b.Community = append(b.Community, entity.AudienceCommunityChannel{
+				ChannelTwitchUserID: a.ChannelTwitchUserID, Login: a.Login, Monitored: true, ActiveUsers: p.SharedUsers,
+			})
+		}
+	}
+
+	return o, audiences
+}
+
+// compareAudiences builds the pair for two channels; ok is false when they share no users.
+func compareAudiences(x, y int64, audiences map[int64]map[int64]entity.AudienceMember) (entity.AudienceOverlapPair, bool) {
+	if x > y {
+		x, y = y, x
+	}
+
+	a, b := audiences[x], audiences[y]
+
+	small, large := a, b
+	if len(b) < len(a) {
+		small, large = b, a
+	}
+
+	var shared []entity.AudienceSharedUser
+
+	for id := range small {
+		if _, ok := large[id]; ok {
+			shared = append(shared, entity.AudienceSharedUser{
+				TwitchUserID: id, Login: a[id].Login, MessagesA: a[id].Messages, MessagesB: b[id].Messages,
+			})
+		}
+	}
+
+	if len(shared) == 0 {
+		return entity.AudienceOverlapPair{}, false
+	}
+
+	slices.SortFunc(shared, func(p, q entity.AudienceSharedUser) int {
+		if c := cmp.Compare(q.MessagesA+q.MessagesB, p.MessagesA+p.MessagesB); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(p.Login, q.Login)
+	})
+
+	n := len(shared)
+
+	return entity.AudienceOverlapPair{
+		ChannelA:    x,
+		ChannelB:    y,
+		SharedUsers: n,
+		Jaccard:     float64(n) / float64(len(a)+len(b)-n),
+		Overlap:     float64(n) / float64(min(len(a), len(b))),
+		TopShared:   shared[:min(n, audienceOverlapTopShared)],
+	}, true
+}
+
+// mergeAudienceCommunity joins the monitored channels a channel shares users with and the channels its audience
+// follows, ranked by the larger of the two counts, without the channel itself.
+func mergeAudienceCommunity(
+	channelID int64,
+	active []entity.AudienceCommunityChannel,
+	follows []entity.AudienceCommunityChannel,
+) []entity.AudienceCommunityChannel {
+	byID := make(map[int64]entity.AudienceCommunityChannel, len(active)+len(follows))
+
+	for _, c := range active {
+		byID[c.ChannelTwitchUserID] = c
+	}
+
+	for _, f := range follows {
+		if f.ChannelTwitchUserID == channelID {
+			continue
+		}
+
+		c, ok := byID[f.ChannelTwitchUserID]
+		if !ok {
+			c = f
+		}
+
+		c.Followers = f.Followers
+		byID[f.ChannelTwitchUserID] = c
+	}
+
+	out := slices.SortedFunc(maps.Values(byID), func(a, b entity.AudienceCommunityChannel) int {
+		if c := cmp.Compare(max(b.ActiveUsers, b.Followers), max(a.ActiveUsers, a.Followers)); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Login, b.Login)
+	})
+
+	return out[:min(len(out), audienceCommunitySize)]
+}
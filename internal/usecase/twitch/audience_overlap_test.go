@@ -0,0 +1,135 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func testAudience() ([]entity.TwitchUser, []entity.AudienceMember) {
+	channels := []entity.TwitchUser{{ID: 20, Username: "bravo"}, {ID: 10, Username: "alpha"}, {ID: 30, Username: "charlie"}}
+	members := []entity.AudienceMember{
+		{ChannelTwitchUserID: 10, TwitchUserID: 1, Login: "u1", Messages: 5},
+		{ChannelTwitchUserID: 10, TwitchUserID: 2, Login: "u2", Present: true},
+		{ChannelTwitchUserID: 10, TwitchUserID: 3, Login: "u3", Messages: 1, Present: true},
+		{ChannelTwitchUserID: 20, TwitchUserID: 2, Login: "u2", Messages: 9},
+		{ChannelTwitchUserID: 20, TwitchUserID: 3, Login: "u3", Present: true},
+		{ChannelTwitchUserID: 30, TwitchUserID: 4, Login: "u4", Messages: 1},
+	}
+
+	return channels, members
+}
+
+func TestBuildAudienceOverlap(t *testing.T) {
+	t.Parallel()
+
+	o, audiences := buildAudienceOverlap(testAudience())
+
+	require.Len(t, o.Channels, 3)
+	assert.Equal(t, "alpha", o.Channels[0].Login)
+	assert.Equal(t, 3, o.Channels[0].Audience)
+	assert.Equal(t, 2, o.Channels[0].Chatters)
+	assert.Equal(t, 2, o.Channels[0].PresentUsers)
+	assert.Len(t, audiences[20], 2)
+
+	require.Len(t, o.Pairs, 1, "charlie shares nobody")
+
+	p := o.Pairs[0]
+	assert.Equal(t, int64(10), p.ChannelA)
+	assert.Equal(t, int64(20), p.ChannelB)
+	assert.Equal(t, 2, p.SharedUsers)
+	assert.InDelta(t, 2.0/3.0, p.Jaccard, 1e-9)
+	assert.InDelta(t, 1.0, p.Overlap, 1e-9)
+	assert.Equal(t, []entity.AudienceSharedUser{
+		{TwitchUserID: 2, Login: "u2", MessagesB: 9},
+		{TwitchUserID: 3, Login: "u3", MessagesA: 1},
+	}, p.TopShared)
+
+	assert.Equal(t, []entity.AudienceCommunityChannel{
+		{ChannelTwitchUserID: 20, Login: "bravo", Monitored: true, ActiveUsers: 2},
+	}, o.Channels[0].Community)
+	assert.Empty(t, o.Channels[2].Community)
+}
+
+func TestMergeAudienceCommunity(t *testing.T) {
+	t.Parallel()
+
+	got := mergeAudienceCommunity(10,
+		[]entity.AudienceCommunityChannel{{ChannelTwitchUserID: 20, Login: "bravo", Monitored: true, ActiveUsers: 2}},
+		[]entity.AudienceCommunityChannel{
+			{ChannelTwitchUserID: 10, Login: "alpha", Monitored: true, Followers: 3},
+			{ChannelTwitchUserID: 99, Login: "outside", Followers: 5},
+			{ChannelTwitchUserID: 20, Login: "bravo", Monitored: true, Followers: 1},
+		})
+
+	assert.Equal(t, []entity.AudienceCommunityChannel{
+		{ChannelTwitchUserID: 99, Login: "outside", Followers: 5},
+		{ChannelTwitchUserID: 20, Login: "bravo", Monitored: true, ActiveUsers: 2, Followers: 1},
+	}, got)
+}
+
+func TestUsecase_GetAudienceOverlap(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	channels, members := testAudience()
+	stale := &entity.AudienceOverlap{Period: entity.AudienceOverlapPeriodWeek, ComputedAt: time.Now().Add(-2 * audienceOverlapCacheTTL)}
+
+	repo.EXPECT().GetAudienceOverlapCache(gomock.Any(), entity.AudienceOverlapPeriodWeek).Return(stale, nil)
+	repo.EXPECT().ListMonitoredTwitchUsers(gomock.Any()).Return(channels, nil)
+	repo.EXPECT().ListChannelAudience(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, from, to time.Time) ([]entity.AudienceMember, error) {
+			assert.Equal(t, 7*24*time.Hour, to.Sub(from))
+			return members, nil
+		})
+	repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(entity.BotDetectionSettings{ExcludeFromStats: true}, nil)
+	repo.EXPECT().LikelyBotIDs(gomock.Any(), gomock.Any()).Return(map[int64]struct{}{4: {}}, nil)
+	repo.EXPECT().CountFollowedChannelsAmong(gomock.Any(), gomock.Any(), 2*audienceCommunitySize).Return(nil, nil).Times(2)
+	repo.EXPECT().SaveAudienceOverlapCache(gomock.Any(), gomock.Any()).Return(nil)
+
+	got, err := svc.GetAudienceOverlap(context.Background(), entity.AudienceOverlapPeriodWeek, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.AudienceOverlapPeriodWeek, got.Period)
+	require.Len(t, got.Channels, 3)
+	assert.Zero(t, got.Channels[2].Audience, "likely bot removed from charlie")
+	require.Len(t, got.Pairs, 1)
+}
+
+func TestUsecase_GetAudienceOverlap_cached(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	fresh := &entity.AudienceOverlap{Period: entity.AudienceOverlapPeriodDay, ComputedAt: time.Now().UTC()}
+
+	repo.EXPECT().GetAudienceOverlapCache(gomock.Any(), entity.AudienceOverlapPeriodDay).Return(fresh, nil)
+
+	got, err := svc.GetAudienceOverlap(context.Background(), entity.AudienceOverlapPeriodDay, false)
+	require.NoError(t, err)
+	assert.Equal(t, *fresh, got)
+
+	_, err = svc.GetAudienceOverlap(context.Background(), "1y", false)
+	require.ErrorIs(t, err, entity.ErrInvalidAudienceOverlapPeriod)
+}
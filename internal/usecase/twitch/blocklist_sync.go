@@ -0,0 +1,172 @@
+package twitch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/usecase/settings"
+)
+
+const (
+	// blocklistSyncCheckInterval is how often URL blocklists are checked for an elapsed sync interval.
+	blocklistSyncCheckInterval = 5 * time.Minute
+	// maxBlocklistDownloadBytes caps one pulled blocklist body.
+	maxBlocklistDownloadBytes = 32 << 20
+)
+
+// SyncBlocklist pulls a blocklist's source URL now and replaces its entries when the content changed. Pull and
+// parse failures are stored on the list (LastSyncError) instead of being returned.
+func (s *Usecase) SyncBlocklist(ctx context.Context, id int64) (entity.Blocklist, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.sync_blocklist")
+	defer span.End()
+
+	b, err := s.repo.GetBlocklist(ctx, id)
+	if err != nil {
+		if !errors.Is(err, entity.ErrBlocklistNotFound) {
+			s.obs.LogError(ctx, span, "get blocklist failed", err, zap.Int64("id", id))
+		}
+
+		return entity.Blocklist{}, err
+	}
+
+	if b.SourceURL == nil {
+		return entity.Blocklist{}, fmt.Errorf("%w: blocklist has no source_url", entity.ErrInvalidBlocklist)
+	}
+
+	changed, err := s.syncBlocklist(ctx, b)
+	if err != nil {
+		s.obs.LogError(ctx, span, "record blocklist sync failed", err, zap.Int64("id", id))
+		return entity.Blocklist{}, err
+	}
+
+	if changed {
+		s.StartSuspicionReevaluation(entity.SuspicionReevalReasonBlocklists, 0)
+	}
+
+	return s.repo.GetBlocklist(ctx, id)
+}
+
+// syncBlocklist pulls one list and records the outcome; only failing to record it is returned as an error.
+func (s *Usecase) syncBlocklist(ctx context.Context, b entity.Blocklist) (bool, error) {
+	now := time.Now().UTC()
+
+	changed, pullErr := s.pullBlocklist(ctx, b, now)
+
+	var syncErr *string
+
+	if pullErr != nil {
+		msg := pullErr.Error()
+		syncErr = &msg
+
+		s.obs.Logger.Warn("blocklist pull failed", zap.Int64("id", b.ID), zap.String("name", b.Name), zap.Error(pullErr))
+	}
+
+	if err := s.repo.RecordBlocklistSync(ctx, b.ID, now, syncErr); err != nil {
+		return false, err
+	}
+
+	return changed, nil
+}
+
+// pullBlocklist downloads and imports a list's source; unchanged content (same digest as the last pull) is skipped.
+func (s *Usecase) pullBlocklist(ctx context.Context, b entity.Blocklist, now time.Time) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, *b.SourceURL, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.blocklistHTTP.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBlocklistDownloadBytes+1))
+	if err != nil {
+		return false, err
+	}
+
+	if len(body) > maxBlocklistDownloadBytes {
+		return false, fmt.Errorf("body exceeds %d bytes", maxBlocklistDownloadBytes)
+	}
+
+	digest := entity.BlocklistDigest(body)
+	if b.SourceFilename == nil && b.ContentSHA256 != nil && *b.ContentSHA256 == digest {
+		return false, nil
+	}
+
+	entries, err := settings.ParseBlocklist(b.Format, body)
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.repo.ReplaceBlocklistEntries(ctx, b.ID, entries, nil, digest, now); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// StartBlocklistSyncLoop pulls enabled URL blocklists whose sync interval has elapsed, until ctx is cancelled.
+func (s *Usecase) StartBlocklistSyncLoop(ctx context.Context) {
+	ticker := time.NewTicker(blocklistSyncCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.syncDueBlocklists(ctx)
+		}
+	}
+}
+
+// syncDueBlocklists pulls every due list and re-evaluates suspicion once if any of them changed.
+func (s *Usecase) syncDueBlocklists(ctx context.Context) {
+	lists, err := s.repo.ListBlocklists(ctx)
+	if err != nil {
+		s.obs.Logger.Warn("blocklist sync tick load failed", zap.Error(err))
+		return
+	}
+
+	now := time.Now().UTC()
+	changed := false
+
+	for _, b := range lists {
+		if !blocklistDue(b, now) {
+			continue
+		}
+
+		c, err := s.syncBlocklist(ctx, b)
+		if err != nil {
+			s.obs.Logger.Warn("record blocklist sync failed", zap.Int64("id", b.ID), zap.Error(err))
+			continue
+		}
+
+		changed = changed || c
+	}
+
+	if changed {
+		s.StartSuspicionReevaluation(entity.SuspicionReevalReasonBlocklists, 0)
+	}
+}
+
+func blocklistDue(b entity.Blocklist, now time.Time) bool {
+	if !b.Enabled || b.SourceURL == nil {
+		return false
+	}
+
+	return b.LastSyncedAt == nil || now.Sub(*b.LastSyncedAt) >= time.Duration(b.SyncIntervalMinutes)*time.Minute
+}
@@ -0,0 +1,131 @@
+package twitch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestBlocklistDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	src := "http://lists.example.org/raids.txt"
+	recent := now.Add(-30 * time.Minute)
+	old := now.Add(-2 * time.Hour)
+
+	assert.True(t, blocklistDue(entity.Blocklist{Enabled: true, SourceURL: &src, SyncIntervalMinutes: 60}, now), "never synced")
+	assert.False(t, blocklistDue(entity.Blocklist{Enabled: true, SourceURL: &src, SyncIntervalMinutes: 60, LastSyncedAt: &recent}, now))
+	assert.True(t, blocklistDue(entity.Blocklist{Enabled: true, SourceURL: &src, SyncIntervalMinutes: 60, LastSyncedAt: &old}, now))
+	assert.False(t, blocklistDue(entity.Blocklist{Enabled: false, SourceURL: &src, SyncIntervalMinutes: 60}, now), "disabled")
+	assert.False(t, blocklistDue(entity.Blocklist{Enabled: true, SyncIntervalMinutes: 60}, now), "upload-only")
+}
+
+func TestSyncBlocklist(t *testing.T) {
+	t.Parallel()
+
+	body := "# raid accounts\nraid_a\n123\n"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/raids.txt" {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	okURL := srv.URL + "/raids.txt"
+	missingURL := srv.URL + "/missing.txt"
+	digest := entity.BlocklistDigest([]byte(body))
+	upload := "raids.txt"
+
+	t.Run("imports changed content", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := repomocks.NewMockStore(ctrl)
+		svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+		repo.EXPECT().ReplaceBlocklistEntries(gomock.Any(), int64(1),
+			[]entity.BlocklistEntry{{Login: "raid_a"}, {TwitchUserID: 123}}, nil, digest, gomock.Any()).Return(nil)
+		repo.EXPECT().RecordBlocklistSync(gomock.Any(), int64(1), gomock.Any(), nil).Return(nil)
+
+		changed, err := svc.syncBlocklist(context.Background(), entity.Blocklist{
+			ID: 1, Format: entity.BlocklistFormatText, SourceURL: &okURL, SourceFilename: &upload, ContentSHA256: &digest,
+		})
+		require.NoError(t, err)
+		assert.True(t, changed, "same digest but entries came from an upload")
+	})
+
+	t.Run("skips unchanged content", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := repomocks.NewMockStore(ctrl)
+		svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+		repo.EXPECT().RecordBlocklistSync(gomock.Any(), int64(1), gomock.Any(), nil).Return(nil)
+
+		changed, err := svc.syncBlocklist(context.Background(), entity.Blocklist{
+			ID: 1, Format: entity.BlocklistFormatText, SourceURL: &okURL, ContentSHA256: &digest,
+		})
+		require.NoError(t, err)
+		assert.False(t, changed)
+	})
+
+	t.Run("records pull failure", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := repomocks.NewMockStore(ctrl)
+		svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+		repo.EXPECT().RecordBlocklistSync(gomock.Any(), int64(2), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ int64, _ time.Time, syncErr *string) error {
+				require.NotNil(t, syncErr)
+				assert.Equal(t, "unexpected status 404", *syncErr)
+
+				return nil
+			})
+
+		changed, err := svc.syncBlocklist(context.Background(), entity.Blocklist{
+			ID: 2, Format: entity.BlocklistFormatText, SourceURL: &missingURL,
+		})
+		require.NoError(t, err)
+		assert.False(t, changed)
+	})
+
+	t.Run("no source url", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := repomocks.NewMockStore(ctrl)
+		svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+		repo.EXPECT().GetBlocklist(gomock.Any(), int64(3)).Return(entity.Blocklist{ID: 3, Format: entity.BlocklistFormatText}, nil)
+
+		_, err := svc.SyncBlocklist(context.Background(), 3)
+		require.ErrorIs(t, err, entity.ErrInvalidBlocklist)
+	})
+}
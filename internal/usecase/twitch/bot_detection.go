@@ -0,0 +1,188 @@
+package twitch
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// botDetectionInterval is how often presence is analyzed for likely bots.
+	botDetectionInterval = 10 * time.Minute
+	// likelyBotRetention keeps a flagged user listed (and excluded from stats) after it was last detected.
+	likelyBotRetention = 7 * 24 * time.Hour
+)
+
+// RunBotDetection flags users present in many channels at once who (almost) never chat as likely bots, then
+// estimates each live monitored channel's bot share from its Helix viewer count and chatter presence.
+func (s *Usecase) RunBotDetection(ctx context.Context) error {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.run_bot_detection")
+	defer span.End()
+
+	st, err := s.repo.GetBotDetectionSettings(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get bot detection settings failed", err)
+		return err
+	}
+
+	presence, err := s.repo.ListBotPresence(ctx, st.MinConcurrentChannels)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list bot presence failed", err)
+		return err
+	}
+
+	own, err := s.repo.ListLinkedTwitchAccountUserIDs(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list linked twitch accounts failed", err)
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	bots := likelyBots(presence, st, own)
+
+	if err := s.repo.UpsertLikelyBots(ctx, bots, now, now.Add(-likelyBotRetention)); err != nil {
+		s.obs.LogError(ctx, span, "upsert likely bots failed", err)
+		return err
+	}
+
+	counts, err := s.repo.ListChannelBotCounts(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list channel bot counts failed", err)
+		return err
+	}
+
+	estimates := make([]entity.ChannelBotEstimate, 0, len(counts))
+
+	for _, c := range counts {
+		estimates = append(estimates, estimateChannelBotShare(c, st.ViewersPerChatter, now))
+	}
+
+	if err := s.repo.ReplaceChannelBotEstimates(ctx, estimates); err != nil {
+		s.obs.LogError(ctx, span, "replace channel bot estimates failed", err)
+		return err
+	}
+
+	s.obs.Logger.Debug("bot detection run finished",
+		zap.Int("candidates", len(presence)), zap.Int("bots", len(bots)), zap.Int("channels", len(estimates)))
+
+	return nil
+}
+
+// likelyBots keeps users in enough channels whose messages per channel-hour of presence stay at or under the
+// configured maximum. Presence shorter than an hour counts as one hour so fresh joins are not judged on minutes.
+// The monitor's own linked accounts are never flagged.
+func likelyBots(presence []entity.BotPresence, st entity.BotDetectionSettings, ownIDs []int64) []entity.BotPresence {
+	own := make(map[int64]struct{}, len(ownIDs))
+	for _, id := range ownIDs {
+		own[id] = struct{}{}
+	}
+
+	var out []entity.BotPresence
+
+	for _, p := range presence {
+		if _, ok := own[p.TwitchUserID]; ok || p.ConcurrentChannels < st.MinConcurrentChannels {
+			continue
+		}
+
+		hours := math.Max(float64(p.PresentSeconds)/3600, 1)
+		if float64(p.MessageCount)/hours > st.MaxMessagesPerHour {
+			continue
+		}
+
+		out = append(out, p)
+	}
+
+	return out
+}
+
+// estimateChannelBotShare treats flagged chatters as bots and Helix viewers beyond viewersPerChatter per human
+// chatter as unexplained (likely view-bots). The share is relative to the viewer count, or to the chatters when
+// the channel reports fewer viewers than chatters.
+func estimateChannelBotShare(c entity.ChannelBotCounts, viewersPerChatter float64, now time.Time) entity.ChannelBotEstimate {
+	humans := max(c.ChatterCount-c.BotChatterCount, 0)
+	expected := int64(math.Ceil(float64(humans) * viewersPerChatter))
+	unexplained := max(c.ViewerCount-expected-c.BotChatterCount, 0)
+
+	audience := max(c.ViewerCount, c.ChatterCount)
+
+	var share float64
+	if audience > 0 {
+		share = math.Min(float64(c.BotChatterCount+unexplained)/float64(audience), 1)
+	}
+
+	return entity.ChannelBotEstimate{
+		ChannelTwitchUserID: c.ChannelTwitchUserID,
+		ViewerCount:         c.ViewerCount,
+		ChatterCount:        c.ChatterCount,
+		BotChatterCount:     c.BotChatterCount,
+		BotShare:            math.Round(share*1000) / 1000,
+		ViewerInflated:      unexplained > 0,
+		ComputedAt:          now,
+	}
+}
+
+// StartBotDetectionLoop runs RunBotDetection once at start and then every botDetectionInterval while enabled,
+// until ctx is cancelled.
+func (s *Usecase) StartBotDetectionLoop(ctx context.Context) {
+	s.runBotDetectionIfEnabled(ctx)
+
+	ticker := time.NewTicker(botDetectionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runBotDetectionIfEnabled(ctx)
+		}
+	}
+}
+
+// runBotDetectionIfEnabled is one loop tick: RunBotDetection when settings enable it.
+func (s *Usecase) runBotDetectionIfEnabled(ctx context.Context) {
+	st, err := s.repo.GetBotDetectionSettings(ctx)
+	if err != nil {
+		s.obs.Logger.Warn("bot detection settings tick load failed", zap.Error(err))
+		return
+	}
+
+	if !st.Enabled {
+		return
+	}
+
+	if err := s.RunBotDetection(ctx); err != nil {
+		s.obs.Logger.Warn("bot detection run failed", zap.Error(err))
+	}
+}
+
+// likelyBotsExcluded returns which of ids are likely bots when settings hide them from stats; otherwise nil.
+// Errors are logged and treated as "exclude nothing" so stats never fail on bot lookups.
+func (s *Usecase) likelyBotsExcluded(ctx context.Context, ids []int64) map[int64]struct{} {
+	if !s.excludeBotsFromStats(ctx) {
+		return nil
+	}
+
+	bots, err := s.repo.LikelyBotIDs(ctx, ids)
+	if err != nil {
+		s.obs.Logger.Warn("likely bot lookup failed", zap.Error(err))
+		return nil
+	}
+
+	return bots
+}
+
+func (s *Usecase) excludeBotsFromStats(ctx context.Context) bool {
+	st, err := s.repo.GetBotDetectionSettings(ctx)
+	if err != nil {
+		s.obs.Logger.Warn("bot detection settings load failed", zap.Error(err))
+		return false
+	}
+
+	return st.ExcludeFromStats
+}
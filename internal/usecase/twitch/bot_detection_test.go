@@ -0,0 +1,189 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func testBotSettings() entity.BotDetectionSettings {
+	return entity.BotDetectionSettings{
+		Enabled:               true,
+		MinConcurrentChannels: 5,
+		MaxMessagesPerHour:    0.1,
+		ViewersPerChatter:     2,
+	}
+}
+
+func TestLikelyBots(t *testing.T) {
+	t.Parallel()
+
+	got := likelyBots([]entity.BotPresence{
+		{TwitchUserID: 1, ConcurrentChannels: 20, PresentSeconds: 20 * 3600, MessageCount: 0},
+		{TwitchUserID: 2, ConcurrentChannels: 20, PresentSeconds: 20 * 3600, MessageCount: 2},
+		{TwitchUserID: 3, ConcurrentChannels: 20, PresentSeconds: 20 * 3600, MessageCount: 3},
+		{TwitchUserID: 4, ConcurrentChannels: 4, PresentSeconds: 20 * 3600, MessageCount: 0},
+		{TwitchUserID: 5, ConcurrentChannels: 6, PresentSeconds: 60, MessageCount: 1},
+		{TwitchUserID: 6, ConcurrentChannels: 30, PresentSeconds: 30 * 3600, MessageCount: 0},
+	}, testBotSettings(), []int64{6})
+
+	ids := make([]int64, 0, len(got))
+	for _, b := range got {
+		ids = append(ids, b.TwitchUserID)
+	}
+
+	assert.Equal(t, []int64{1, 2}, ids, "3 chats too much, 4 is in too few channels, 5 is judged on a full hour, 6 is ours")
+}
+
+func TestEstimateChannelBotShare(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("inflated viewers", func(t *testing.T) {
+		t.Parallel()
+
+		e := estimateChannelBotShare(entity.ChannelBotCounts{
+			ChannelTwitchUserID: 9, ViewerCount: 100, ChatterCount: 20, BotChatterCount: 5,
+		}, 2, now)
+
+		// 15 humans explain 30 viewers; 5 known bots plus 65 unexplained viewers out of 100.
+		assert.InDelta(t, 0.7, e.BotShare, 1e-9)
+		assert.True(t, e.ViewerInflated)
+		assert.Equal(t, now, e.ComputedAt)
+	})
+
+	t.Run("healthy channel", func(t *testing.T) {
+		t.Parallel()
+
+		e := estimateChannelBotShare(entity.ChannelBotCounts{ViewerCount: 30, ChatterCount: 20, BotChatterCount: 2}, 2, now)
+
+		assert.InDelta(t, 0.067, e.BotShare, 1e-9)
+		assert.False(t, e.ViewerInflated)
+	})
+
+	t.Run("fewer viewers than chatters", func(t *testing.T) {
+		t.Parallel()
+
+		e := estimateChannelBotShare(entity.ChannelBotCounts{ViewerCount: 0, ChatterCount: 10, BotChatterCount: 10}, 2, now)
+
+		assert.InDelta(t, 1, e.BotShare, 1e-9)
+		assert.False(t, e.ViewerInflated)
+	})
+}
+
+func TestRunBotDetection(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	bot := entity.BotPresence{TwitchUserID: 1, ConcurrentChannels: 10, PresentSeconds: 36000}
+
+	repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(testBotSettings(), nil)
+	repo.EXPECT().ListBotPresence(gomock.Any(), 5).Return([]entity.BotPresence{
+		bot,
+		{TwitchUserID: 2, ConcurrentChannels: 10, PresentSeconds: 36000, MessageCount: 50},
+	}, nil)
+	repo.EXPECT().ListLinkedTwitchAccountUserIDs(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().UpsertLikelyBots(gomock.Any(), []entity.BotPresence{bot}, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ []entity.BotPresence, detectedAt, staleBefore time.Time) error {
+			assert.Equal(t, likelyBotRetention, detectedAt.Sub(staleBefore))
+			return nil
+		})
+	repo.EXPECT().ListChannelBotCounts(gomock.Any()).Return([]entity.ChannelBotCounts{
+		{ChannelTwitchUserID: 9, ViewerCount: 10, ChatterCount: 5, BotChatterCount: 1},
+	}, nil)
+	repo.EXPECT().ReplaceChannelBotEstimates(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, list []entity.ChannelBotEstimate) error {
+			require.Len(t, list, 1)
+			assert.Equal(t, int64(9), list[0].ChannelTwitchUserID)
+			assert.InDelta(t, 0.2, list[0].BotShare, 1e-9)
+
+			return nil
+		})
+
+	require.NoError(t, svc.RunBotDetection(context.Background()))
+}
+
+func TestStartBotDetectionLoop_runsAtStart(t *testing.T) {
+	t.Parallel()
+
+	for _, enabled := range []bool{true, false} {
+		ctrl := gomock.NewController(t)
+
+		repo := repomocks.NewMockStore(ctrl)
+		obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+		svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+		st := testBotSettings()
+		st.Enabled = enabled
+
+		if enabled {
+			// The gate and RunBotDetection both load settings; the run stops at the presence query.
+			repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(st, nil).Times(2)
+			repo.EXPECT().ListBotPresence(gomock.Any(), st.MinConcurrentChannels).Return(nil, assert.AnError)
+		} else {
+			repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(st, nil)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		svc.StartBotDetectionLoop(ctx)
+		ctrl.Finish()
+	}
+}
+
+func TestWithoutExcludedBots(t *testing.T) {
+	t.Parallel()
+
+	list := []entity.ChannelChatterEntry{{UserTwitchID: 1}, {UserTwitchID: 2}, {UserTwitchID: 3}}
+
+	t.Run("excluded", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := repomocks.NewMockStore(ctrl)
+		svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+		st := testBotSettings()
+		st.ExcludeFromStats = true
+
+		repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(st, nil)
+		repo.EXPECT().LikelyBotIDs(gomock.Any(), []int64{1, 2, 3}).Return(map[int64]struct{}{2: {}}, nil)
+
+		got := svc.withoutExcludedBots(context.Background(), append([]entity.ChannelChatterEntry(nil), list...))
+		assert.Equal(t, []entity.ChannelChatterEntry{{UserTwitchID: 1}, {UserTwitchID: 3}}, got)
+	})
+
+	t.Run("kept when not excluded", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := repomocks.NewMockStore(ctrl)
+		svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+		repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(testBotSettings(), nil)
+
+		assert.Equal(t, list, svc.withoutExcludedBots(context.Background(), append([]entity.ChannelChatterEntry(nil), list...)))
+	})
+}
@@ -0,0 +1,263 @@
+package twitch
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// channelAnalyticsPresenceLookback is how far before a window presence events are read, so sessions that began
+// earlier still count.
+const channelAnalyticsPresenceLookback = 12 * time.Hour
+
+// GetChannelAnalytics returns a monitored channel's activity series and stream retention. Minute buckets are
+// computed from raw chat and presence events; hour and day buckets are read from the rollup tables.
+func (s *Usecase) GetChannelAnalytics(ctx context.Context, q entity.ChannelAnalyticsQuery) (entity.ChannelAnalytics, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.get_channel_analytics")
+	defer span.End()
+
+	q, err := q.Normalize(time.Now().UTC())
+	if err != nil {
+		return entity.ChannelAnalytics{}, err
+	}
+
+	channelID, ok, err := s.repo.MonitoredChannelTwitchUserID(ctx, q.Channel)
+	if err != nil {
+		s.obs.LogError(ctx, span, "check monitored channel failed", err)
+		return entity.ChannelAnalytics{}, err
+	}
+
+	if !ok {
+		return entity.ChannelAnalytics{}, ErrChannelNotMonitored
+	}
+
+	var rows [][]entity.ChannelActivityBucket
+
+	if q.Bucket == entity.ChannelAnalyticsBucketMinute {
+		msgs, err := s.repo.ListChannelActivityMinutes(ctx, channelID, q.From, q.To)
+		if err != nil {
+			s.obs.LogError(ctx, span, "list channel activity minutes failed", err, zap.Int64("channel_id", channelID))
+			return entity.ChannelAnalytics{}, err
+		}
+
+		presence, err := s.channelPresenceBuckets(ctx, channelID, q.From, q.To, q.Bucket)
+		if err != nil {
+			s.obs.LogError(ctx, span, "channel presence for analytics failed", err, zap.Int64("channel_id", channelID))
+			return entity.ChannelAnalytics{}, err
+		}
+
+		rows = append(rows, msgs, presence)
+	} else {
+		stored, err := s.repo.ListChannelActivityRollups(ctx, channelID, q.Bucket, q.From, q.To)
+		if err != nil {
+			s.obs.LogError(ctx, span, "list channel activity rollups failed", err, zap.Int64("channel_id", channelID))
+			return entity.ChannelAnalytics{}, err
+		}
+
+		rows = append(rows, stored)
+	}
+
+	retention, err := s.repo.ListStreamRetention(ctx, channelID, q.From, q.To)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list stream retention failed", err, zap.Int64("channel_id", channelID))
+		return entity.ChannelAnalytics{}, err
+	}
+
+	until, err := s.repo.GetChannelAnalyticsWatermark(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get channel analytics watermark failed", err)
+		return entity.ChannelAnalytics{}, err
+	}
+
+	return entity.ChannelAnalytics{
+		ChannelTwitchUserID: channelID,
+		ChannelLogin:        q.Channel,
+		Bucket:              q.Bucket,
+		From:                q.From,
+		To:                  q.To,
+		Series:              denseChannelSeries(channelID, q.Bucket, q.From, q.To, rows...),
+		Retention:           retention,
+		RolledUpUntil:       until,
+	}, nil
+}
+
+// channelPresenceBuckets totals a channel's IRC presence per bucket in [from, to) for each given bucket width.
+func (s *Usecase) channelPresenceBuckets(
+	ctx context.Context,
+	channelID int64,
+	from, to time.Time,
+	buckets ...entity.ChannelAnalyticsBucket,
+) ([]entity.ChannelActivityBucket, error) {
+	byChatter, err := s.channelPresenceEvents(ctx, channelID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []entity.ChannelActivityBucket
+
+	for _, bucket := range buckets {
+		out = append(out, presenceBuckets(channelID, bucket, byChatter, from, to)...)
+	}
+
+	return out, nil
+}
+
+// channelPresenceEvents loads the presence events relevant to [from, to) of a channel, grouped by chatter.
+func (s *Usecase) channelPresenceEvents(ctx context.Context, channelID int64, from, to time.Time) (map[int64][]entity.UserActivityEvent, error) {
+	evs, err := s.repo.ListUserActivityEventsForChannelPresence(ctx, channelID, from.Add(-channelAnalyticsPresenceLookback), to)
+	if err != nil {
+		return nil, err
+	}
+
+	byChatter := make(map[int64][]entity.UserActivityEvent)
+
+	for _, e := range evs {
+		byChatter[e.ChatterTwitchUserID] = append(byChatter[e.ChatterTwitchUserID], e)
+	}
+
+	return byChatter, nil
+}
+
+// chatterPresenceDays clips every chatter's presence segments to UTC days in [from, to), one row per chatter-day.
+func chatterPresenceDays(channelID int64, byChatter map[int64][]entity.UserActivityEvent, from, to time.Time) []entity.ChatterDayPresence {
+	var out []entity.ChatterDayPresence
+
+	for chatterID, list := range byChatter {
+		days := make(map[int64]int)
+
+		for _, seg := range BuildActivityTimelineSegments(list, to) {
+			start, end := seg.Start, seg.End
+			if start.Before(from) {
+				start = from
+			}
+
+			if end.After(to) {
+				end = to
+			}
+
+			for d := entity.ChannelAnalyticsBucketDay.Truncate(start); d.Before(end); d = entity.ChannelAnalyticsBucketDay.Next(d) {
+				secs := presenceSecondsClipped([]entity.ActivityTimelineSegment{{Start: start, End: end}}, d, entity.ChannelAnalyticsBucketDay.Next(d))
+				if secs <= 0 {
+					continue
+				}
+
+				i, ok := days[d.Unix()]
+				if !ok {
+					i = len(out)
+					days[d.Unix()] = i
+					out = append(out, entity.ChatterDayPresence{ChannelTwitchUserID: channelID, ChatterTwitchUserID: chatterID, Day: d})
+				}
+
+				out[i].PresenceSeconds += secs
+			}
+		}
+	}
+
+	slices.SortFunc(out, func(a, b entity.ChatterDayPresence) int {
+		if c := a.Day.Compare(b.Day); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.ChatterTwitchUserID, b.ChatterTwitchUserID)
+	})
+
+	return out
+}
+
+// presenceBuckets clips every chatter's presence segments to bucket windows; a chatter counts once per bucket.
+func presenceBuckets(
+	channelID int64,
+	bucket entity.ChannelAnalyticsBucket,
+	byChatter map[int64][]entity.UserActivityEvent,
+	from, to time.Time,
+) []entity.ChannelActivityBucket {
+	totals := make(map[int64]*entity.ChannelActivityBucket)
+
+	var order []int64
+
+	for _, list := range byChatter {
+		var counted time.Time
+
+		for _, seg := range BuildActivityTimelineSegments(list, to) {
+			start, end := seg.Start, seg.End
+			if start.Before(from) {
+				start = from
+			}
+
+			if end.After(to) {
+				end = to
+			}
+
+			for b := bucket.Truncate(start); b.Before(end); b = bucket.Next(b) {
+				secs := presenceSecondsClipped([]entity.ActivityTimelineSegment{{Start: start, End: end}}, b, bucket.Next(b))
+				if secs <= 0 {
+					continue
+				}
+
+				t, ok := totals[b.Unix()]
+				if !ok {
+					t = &entity.ChannelActivityBucket{ChannelTwitchUserID: channelID, Bucket: bucket, Start: b}
+					totals[b.Unix()] = t
+					order = append(order, b.Unix())
+				}
+
+				t.PresenceSeconds += secs
+
+				if !counted.Equal(b) {
+					t.PresentChatters++
+					counted = b
+				}
+			}
+		}
+	}
+
+	slices.Sort(order)
+
+	out := make([]entity.ChannelActivityBucket, 0, len(order))
+	for _, k := range order {
+		out = append(out, *totals[k])
+	}
+
+	return out
+}
+
+// denseChannelSeries lays out one point per bucket in [from, to), summing the metrics of all given rows per bucket
+// (sources fill disjoint metrics) and leaving buckets without rows at zero.
+func denseChannelSeries(
+	channelID int64,
+	bucket entity.ChannelAnalyticsBucket,
+	from, to time.Time,
+	rows ...[]entity.ChannelActivityBucket,
+) []entity.ChannelActivityBucket {
+	var out []entity.ChannelActivityBucket
+
+	index := make(map[int64]int)
+
+	for b := bucket.Truncate(from); b.Before(to); b = bucket.Next(b) {
+		index[b.Unix()] = len(out)
+		out = append(out, entity.ChannelActivityBucket{ChannelTwitchUserID: channelID, Bucket: bucket, Start: b})
+	}
+
+	for _, list := range rows {
+		for _, r := range list {
+			i, ok := index[r.Start.Unix()]
+			if !ok {
+				continue
+			}
+
+			p := &out[i]
+			p.MessageCount += r.MessageCount
+			p.UniqueChatters += r.UniqueChatters
+			p.NewChatters += r.NewChatters
+			p.PresentChatters += r.PresentChatters
+			p.PresenceSeconds += r.PresenceSeconds
+		}
+	}
+
+	return out
+}
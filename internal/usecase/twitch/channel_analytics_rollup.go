@@ -0,0 +1,124 @@
+package twitch
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// channelAnalyticsRollupInterval is how often new chat history is rolled into the analytics tables.
+	channelAnalyticsRollupInterval = 5 * time.Minute
+	// channelAnalyticsRollupChunk bounds one rollup pass while catching up on older history.
+	channelAnalyticsRollupChunk = 7 * 24 * time.Hour
+)
+
+// RunChannelAnalyticsRollup rolls chat history into the hourly/daily channel rollups and the per-chatter daily
+// rollups behind channel leaderboards and refreshes stream retention, one chunk per pass until it reaches the
+// present. Each pass restarts at the UTC day holding the watermark, so the current day and hour are recomputed as
+// they fill. The first run starts at the oldest stored message.
+func (s *Usecase) RunChannelAnalyticsRollup(ctx context.Context) error {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.run_channel_analytics_rollup")
+	defer span.End()
+
+	for {
+		done, err := s.rollupChannelAnalytics(ctx)
+		if err != nil {
+			s.obs.LogError(ctx, span, "channel analytics rollup pass failed", err)
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// rollupChannelAnalytics runs one pass and reports whether it reached the present.
+func (s *Usecase) rollupChannelAnalytics(ctx context.Context) (bool, error) {
+	now := time.Now().UTC()
+
+	start, err := s.repo.GetChannelAnalyticsWatermark(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if start == nil {
+		if start, err = s.repo.EarliestChatMessageAt(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	if start == nil {
+		start = &now
+	}
+
+	from := entity.ChannelAnalyticsBucketDay.Truncate(*start)
+	to := from.Add(channelAnalyticsRollupChunk)
+	done := false
+
+	if !to.Before(now) {
+		to, done = now, true
+	}
+
+	channels, err := s.repo.ListMonitoredTwitchUsers(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		presence        []entity.ChannelActivityBucket
+		chatterPresence []entity.ChatterDayPresence
+	)
+
+	for _, ch := range channels {
+		byChatter, err := s.channelPresenceEvents(ctx, ch.ID, from, to)
+		if err != nil {
+			return false, err
+		}
+
+		for _, bucket := range []entity.ChannelAnalyticsBucket{entity.ChannelAnalyticsBucketHour, entity.ChannelAnalyticsBucketDay} {
+			presence = append(presence, presenceBuckets(ch.ID, bucket, byChatter, from, to)...)
+		}
+
+		chatterPresence = append(chatterPresence, chatterPresenceDays(ch.ID, byChatter, from, to)...)
+	}
+
+	if err := s.repo.ReplaceChannelActivityRollups(ctx, from, to, presence, chatterPresence); err != nil {
+		return false, err
+	}
+
+	if err := s.repo.RefreshStreamRetention(ctx, from); err != nil {
+		return false, err
+	}
+
+	s.obs.Logger.Debug("channel analytics rollup pass finished",
+		zap.Time("from", from), zap.Time("to", to), zap.Int("presence_buckets", len(presence)),
+		zap.Int("chatter_days", len(chatterPresence)))
+
+	return done, nil
+}
+
+// StartChannelAnalyticsRollupLoop keeps the channel analytics rollups current until ctx is cancelled.
+func (s *Usecase) StartChannelAnalyticsRollupLoop(ctx context.Context) {
+	ticker := time.NewTicker(channelAnalyticsRollupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RunChannelAnalyticsRollup(ctx); err != nil {
+				s.obs.Logger.Warn("channel analytics rollup failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestUsecase_RunChannelAnalyticsRollup_catchesUpInChunks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	earliest := time.Now().UTC().Add(-20 * 24 * time.Hour)
+
+	var (
+		watermark *time.Time
+		passes    []time.Time
+	)
+
+	repo.EXPECT().GetChannelAnalyticsWatermark(gomock.Any()).DoAndReturn(func(context.Context) (*time.Time, error) {
+		return watermark, nil
+	}).Times(3)
+	repo.EXPECT().EarliestChatMessageAt(gomock.Any()).Return(&earliest, nil)
+	repo.EXPECT().ListMonitoredTwitchUsers(gomock.Any()).Return([]entity.TwitchUser{{ID: 9}}, nil).Times(3)
+	repo.EXPECT().ListUserActivityEventsForChannelPresence(gomock.Any(), int64(9), gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+	repo.EXPECT().ReplaceChannelActivityRollups(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, from, to time.Time, _ []entity.ChannelActivityBucket, _ []entity.ChatterDayPresence) error {
+			assert.Equal(t, entity.ChannelAnalyticsBucketDay.Truncate(from), from, "passes start on a UTC day")
+			passes = append(passes, from)
+			watermark = &to
+
+			return nil
+		}).Times(3)
+	repo.EXPECT().RefreshStreamRetention(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	require.NoError(t, svc.RunChannelAnalyticsRollup(context.Background()))
+
+	require.Len(t, passes, 3)
+	assert.Equal(t, entity.ChannelAnalyticsBucketDay.Truncate(earliest), passes[0])
+	assert.Equal(t, passes[0].Add(channelAnalyticsRollupChunk), passes[1])
+	assert.WithinDuration(t, time.Now(), *watermark, time.Minute, "last pass reaches the present")
+}
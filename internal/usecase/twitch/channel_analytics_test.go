@@ -0,0 +1,138 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func presenceEvent(id, chatter, channel int64, typ string, at time.Time) entity.UserActivityEvent {
+	return entity.UserActivityEvent{ID: id, ChatterTwitchUserID: chatter, EventType: typ, ChannelTwitchUserID: &channel, CreatedAt: at}
+}
+
+func TestPresenceBuckets(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	byChatter := map[int64][]entity.UserActivityEvent{
+		1: {
+			presenceEvent(1, 1, 9, entity.UserActivityChatOnline, base.Add(30*time.Minute)),
+			presenceEvent(3, 1, 9, entity.UserActivityChatOffline, base.Add(75*time.Minute)),
+		},
+		2: {presenceEvent(2, 2, 9, entity.UserActivityChatOnline, base.Add(50*time.Minute))},
+	}
+
+	got := presenceBuckets(9, entity.ChannelAnalyticsBucketHour, byChatter, base, base.Add(90*time.Minute))
+	require.Len(t, got, 2)
+
+	assert.Equal(t, base, got[0].Start)
+	assert.Equal(t, 2, got[0].PresentChatters)
+	assert.Equal(t, int64(1800+600), got[0].PresenceSeconds)
+
+	assert.Equal(t, base.Add(time.Hour), got[1].Start)
+	assert.Equal(t, 2, got[1].PresentChatters)
+	assert.Equal(t, int64(900+1800), got[1].PresenceSeconds)
+}
+
+func TestUsecase_GetChannelAnalytics_minute(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	from := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	to := from.Add(3 * time.Minute)
+	next := int64(8)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "streamer").Return(int64(9), true, nil)
+	repo.EXPECT().ListChannelActivityMinutes(gomock.Any(), int64(9), from, to).Return([]entity.ChannelActivityBucket{
+		{Start: from.Add(time.Minute), MessageCount: 3, UniqueChatters: 2, NewChatters: 1},
+	}, nil)
+	repo.EXPECT().ListUserActivityEventsForChannelPresence(gomock.Any(), int64(9), from.Add(-channelAnalyticsPresenceLookback), to).
+		Return([]entity.UserActivityEvent{presenceEvent(1, 5, 9, entity.UserActivityChatOnline, from.Add(90*time.Second))}, nil)
+	repo.EXPECT().ListStreamRetention(gomock.Any(), int64(9), from, to).Return([]entity.StreamRetention{
+		{StreamID: 7, NextStreamID: &next, Chatters: 4, ReturningChatters: 1},
+	}, nil)
+	repo.EXPECT().GetChannelAnalyticsWatermark(gomock.Any()).Return(nil, nil)
+
+	got, err := svc.GetChannelAnalytics(context.Background(), entity.ChannelAnalyticsQuery{
+		Channel: "Streamer", Bucket: entity.ChannelAnalyticsBucketMinute, From: from, To: to,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "streamer", got.ChannelLogin)
+	require.Len(t, got.Series, 3)
+	assert.Equal(t, entity.ChannelActivityBucket{ChannelTwitchUserID: 9, Bucket: entity.ChannelAnalyticsBucketMinute, Start: from}, got.Series[0])
+	assert.Equal(t, int64(3), got.Series[1].MessageCount)
+	assert.Equal(t, 1, got.Series[1].NewChatters)
+	assert.Equal(t, int64(30), got.Series[1].PresenceSeconds)
+	assert.Equal(t, int64(60), got.Series[2].PresenceSeconds)
+	assert.Equal(t, 1, got.Series[2].PresentChatters)
+	require.Len(t, got.Retention, 1)
+	assert.Nil(t, got.RolledUpUntil)
+}
+
+func TestUsecase_GetChannelAnalytics_dayFromRollups(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	until := to
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "streamer").Return(int64(9), true, nil)
+	repo.EXPECT().ListChannelActivityRollups(gomock.Any(), int64(9), entity.ChannelAnalyticsBucketDay, from, to).
+		Return([]entity.ChannelActivityBucket{{Start: from.AddDate(0, 0, 1), MessageCount: 120, PresentChatters: 4, PresenceSeconds: 400}}, nil)
+	repo.EXPECT().ListStreamRetention(gomock.Any(), int64(9), from, to).Return(nil, nil)
+	repo.EXPECT().GetChannelAnalyticsWatermark(gomock.Any()).Return(&until, nil)
+
+	got, err := svc.GetChannelAnalytics(context.Background(), entity.ChannelAnalyticsQuery{
+		Channel: "streamer", Bucket: entity.ChannelAnalyticsBucketDay, From: from.Add(5 * time.Hour), To: to,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, got.Series, 3)
+	assert.Equal(t, from, got.From, "from is aligned to the day")
+	assert.Equal(t, int64(120), got.Series[1].MessageCount)
+	assert.InDelta(t, 100.0, got.Series[1].AveragePresenceSeconds(), 1e-9)
+	assert.Equal(t, &until, got.RolledUpUntil)
+}
+
+func TestUsecase_GetChannelAnalytics_errors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	_, err := svc.GetChannelAnalytics(context.Background(), entity.ChannelAnalyticsQuery{Channel: "streamer", Bucket: "week"})
+	require.ErrorIs(t, err, entity.ErrInvalidChannelAnalyticsQuery)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "other").Return(int64(0), false, nil)
+
+	_, err = svc.GetChannelAnalytics(context.Background(), entity.ChannelAnalyticsQuery{Channel: "other"})
+	require.ErrorIs(t, err, ErrChannelNotMonitored)
+}
@@ -2,6 +2,7 @@ package twitch
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"time"
 
@@ -61,6 +62,8 @@ func (s *Usecase) ListChannelChatters(ctx context.Context, accountID int64, chan
 		return nil, err
 	}
 
+	list = s.withoutExcludedBots(ctx, list)
+
 	var counts map[int64]int64
 
 	if sessionStartedAt != nil {
@@ -87,3 +90,21 @@ func (s *Usecase) ListChannelChatters(ctx context.Context, accountID int64, chan
 
 	return list, nil
 }
+
+// withoutExcludedBots drops likely bots from a chatter list when settings hide them from stats.
+func (s *Usecase) withoutExcludedBots(ctx context.Context, list []entity.ChannelChatterEntry) []entity.ChannelChatterEntry {
+	ids := make([]int64, 0, len(list))
+	for _, e := range list {
+		ids = append(ids, e.UserTwitchID)
+	}
+
+	bots := s.likelyBotsExcluded(ctx, ids)
+	if len(bots) == 0 {
+		return list
+	}
+
+	return slices.DeleteFunc(list, func(e entity.ChannelChatterEntry) bool {
+		_, ok := bots[e.UserTwitchID]
+		return ok
+	})
+}
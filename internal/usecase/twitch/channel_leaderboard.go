@@ -0,0 +1,63 @@
+package twitch
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ChannelLeaderboard ranks chatters of one monitored channel, or of all monitored channels when q.Channel is empty,
+// over whole UTC days. Totals come from the daily chatter rollups, so days after RolledUpUntil are not counted yet.
+func (s *Usecase) ChannelLeaderboard(ctx context.Context, q entity.ChannelLeaderboardQuery) (entity.ChannelLeaderboard, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.channel_leaderboard")
+	defer span.End()
+
+	q, err := q.Normalize(time.Now().UTC())
+	if err != nil {
+		return entity.ChannelLeaderboard{}, err
+	}
+
+	var channelID *int64
+
+	if q.Channel != "" {
+		id, ok, err := s.repo.MonitoredChannelTwitchUserID(ctx, q.Channel)
+		if err != nil {
+			s.obs.LogError(ctx, span, "check monitored channel failed", err)
+			return entity.ChannelLeaderboard{}, err
+		}
+
+		if !ok {
+			return entity.ChannelLeaderboard{}, ErrChannelNotMonitored
+		}
+
+		channelID = &id
+	}
+
+	if q.ExcludeBots == nil {
+		q.ExcludeBots = entity.ToPointer(s.excludeBotsFromStats(ctx))
+	}
+
+	rows, total, err := s.repo.ListChannelLeaderboard(ctx, channelID, q)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list channel leaderboard failed", err, zap.String("channel", q.Channel))
+		return entity.ChannelLeaderboard{}, err
+	}
+
+	until, err := s.repo.GetChannelAnalyticsWatermark(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get channel analytics watermark failed", err)
+		return entity.ChannelLeaderboard{}, err
+	}
+
+	return entity.ChannelLeaderboard{
+		Channel:       q.Channel,
+		From:          q.From,
+		To:            q.To,
+		Total:         total,
+		Rows:          rows,
+		RolledUpUntil: until,
+	}, nil
+}
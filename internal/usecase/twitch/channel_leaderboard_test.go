@@ -0,0 +1,126 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestChatterPresenceDays(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	byChatter := map[int64][]entity.UserActivityEvent{
+		1: {
+			presenceEvent(1, 1, 9, entity.UserActivityChatOnline, day.Add(23*time.Hour)),
+			presenceEvent(2, 1, 9, entity.UserActivityChatOffline, day.Add(25*time.Hour)),
+		},
+		2: {presenceEvent(3, 2, 9, entity.UserActivityChatOnline, day.Add(47*time.Hour))},
+	}
+
+	got := chatterPresenceDays(9, byChatter, day, day.Add(48*time.Hour))
+	assert.Equal(t, []entity.ChatterDayPresence{
+		{ChannelTwitchUserID: 9, ChatterTwitchUserID: 1, Day: day, PresenceSeconds: 3600},
+		{ChannelTwitchUserID: 9, ChatterTwitchUserID: 1, Day: day.Add(24 * time.Hour), PresenceSeconds: 3600},
+		{ChannelTwitchUserID: 9, ChatterTwitchUserID: 2, Day: day.Add(24 * time.Hour), PresenceSeconds: 3600},
+	}, got)
+}
+
+func TestUsecase_ChannelLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	from := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	until := from.Add(time.Hour)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "streamer").Return(int64(9), true, nil)
+	repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(entity.BotDetectionSettings{ExcludeFromStats: true}, nil)
+	repo.EXPECT().ListChannelLeaderboard(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, channelID *int64, q entity.ChannelLeaderboardQuery) ([]entity.ChannelLeaderboardRow, int64, error) {
+			require.NotNil(t, channelID)
+			assert.Equal(t, int64(9), *channelID)
+			assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), q.From)
+			assert.Equal(t, time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), q.To)
+			assert.Equal(t, entity.StreamLeaderboardSortMessagesDesc, q.Sort)
+			require.NotNil(t, q.ExcludeBots)
+			assert.True(t, *q.ExcludeBots)
+			assert.Equal(t, entity.DefaultChannelLeaderboardLimit, q.Limit)
+
+			return []entity.ChannelLeaderboardRow{{Login: "alice", UserTwitchID: 5, MessageCount: 12, Channels: 1}}, 3, nil
+		})
+	repo.EXPECT().GetChannelAnalyticsWatermark(gomock.Any()).Return(&until, nil)
+
+	got, err := svc.ChannelLeaderboard(context.Background(), entity.ChannelLeaderboardQuery{
+		Channel: "Streamer", From: from, To: from.Add(26 * time.Hour), Sort: entity.StreamLeaderboardSortMessagesDesc,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "streamer", got.Channel)
+	assert.Equal(t, int64(3), got.Total)
+	require.Len(t, got.Rows, 1)
+	assert.Equal(t, "alice", got.Rows[0].Login)
+	assert.Equal(t, &until, got.RolledUpUntil)
+}
+
+func TestUsecase_ChannelLeaderboard_allChannels(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	repo.EXPECT().ListChannelLeaderboard(gomock.Any(), nil, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *int64, q entity.ChannelLeaderboardQuery) ([]entity.ChannelLeaderboardRow, int64, error) {
+			require.NotNil(t, q.ExcludeBots)
+			assert.False(t, *q.ExcludeBots, "explicit filter skips the settings lookup")
+			assert.True(t, q.ExcludeLinked)
+
+			return nil, 0, nil
+		})
+	repo.EXPECT().GetChannelAnalyticsWatermark(gomock.Any()).Return(nil, nil)
+
+	got, err := svc.ChannelLeaderboard(context.Background(), entity.ChannelLeaderboardQuery{
+		ExcludeBots: entity.ToPointer(false), ExcludeLinked: true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, got.Channel)
+	assert.Zero(t, got.Total)
+}
+
+func TestUsecase_ChannelLeaderboard_errors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	_, err := svc.ChannelLeaderboard(context.Background(), entity.ChannelLeaderboardQuery{Sort: "nope"})
+	require.ErrorIs(t, err, entity.ErrInvalidChannelLeaderboardQuery)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "ghost").Return(int64(0), false, nil)
+
+	_, err = svc.ChannelLeaderboard(context.Background(), entity.ChannelLeaderboardQuery{Channel: "ghost"})
+	require.ErrorIs(t, err, ErrChannelNotMonitored)
+}
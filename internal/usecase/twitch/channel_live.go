@@ -9,6 +9,7 @@ import (
 )
 
 // GetChannelLive returns Helix stream metadata plus the IRC-maintained chatter count when the DB query succeeds.
+// Likely bots are left out of the count when bot detection settings exclude them from stats.
 func (s *Usecase) GetChannelLive(ctx context.Context, login string) (info helix.ChannelLiveInfo, channelChatterCount *int64, err error) {
 	ctx, span := s.obs.StartSpan(ctx, "service.twitch.get_channel_live")
 	defer span.End()
@@ -26,5 +27,26 @@ func (s *Usecase) GetChannelLive(ctx context.Context, login string) (info helix.
 		return info, nil, nil
 	}
 
+	if s.excludeBotsFromStats(ctx) {
+		n -= s.countLikelyBotChatters(ctx, info.BroadcasterID)
+	}
+
 	return info, &n, nil
 }
+
+// countLikelyBotChatters counts likely bots currently present in a channel; lookup errors count as none.
+func (s *Usecase) countLikelyBotChatters(ctx context.Context, channelID int64) int64 {
+	ids, err := s.repo.ListChannelChatterIDs(ctx, channelID)
+	if err != nil {
+		s.obs.Logger.Warn("list channel chatters for bot exclusion failed", zap.Error(err))
+		return 0
+	}
+
+	bots, err := s.repo.LikelyBotIDs(ctx, ids)
+	if err != nil {
+		s.obs.Logger.Warn("likely bot lookup failed", zap.Error(err))
+		return 0
+	}
+
+	return int64(len(bots))
+}
@@ -0,0 +1,217 @@
+package twitch
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// chatClassifierInterval is how often the classifier looks for new messages.
+	chatClassifierInterval = 30 * time.Second
+	// chatClassifierScanLimit bounds how many candidate messages one batch is sampled from.
+	chatClassifierScanLimit = 500
+	// chatClassifierMaxAge skips messages older than this, e.g. after the classifier was disabled for a while.
+	chatClassifierMaxAge = time.Hour
+	// chatClassifierTimeout bounds one completion call.
+	chatClassifierTimeout = 2 * time.Minute
+	// chatClassifierCallTokens estimates the prompt and reply framing every completion call pays.
+	chatClassifierCallTokens = 200
+	// chatClassifierMessageTokens estimates one message's JSON framing and its label in the reply, on top of its text.
+	chatClassifierMessageTokens = 60
+)
+
+// ChatClassifier scores chat messages for toxicity, harassment and sentiment and reports the tokens it spent. It
+// returns entity.ErrAINotConfigured while AI settings are empty (implemented by *ai.Usecase).
+type ChatClassifier interface {
+	ClassifyChatMessages(ctx context.Context, msgs []entity.ClassifiableChatMessage) ([]entity.ChatMessageLabels, int64, error)
+}
+
+// SetChatClassifier enables background chat classification (still subject to the chat classifier settings).
+func (s *Usecase) SetChatClassifier(c ChatClassifier) {
+	s.chatClassifier = c
+}
+
+// RunChatClassification classifies sampled new messages of the enabled channels in batches until it catches up,
+// the day's token budget is spent or AI turns out not to be configured.
+func (s *Usecase) RunChatClassification(ctx context.Context) error {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.run_chat_classification")
+	defer span.End()
+
+	if s.chatClassifier == nil {
+		return nil
+	}
+
+	settings, err := s.repo.GetChatClassifierSettings(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get chat classifier settings failed", err)
+		return err
+	}
+
+	if !settings.Enabled || len(settings.Channels) == 0 || settings.SampleRate <= 0 || settings.BatchSize <= 0 {
+		return nil
+	}
+
+	for {
+		more, err := s.classifyChatBatch(ctx, settings)
+		if err != nil {
+			if errors.Is(err, entity.ErrAINotConfigured) {
+				return nil
+			}
+
+			s.obs.LogError(ctx, span, "classify chat batch failed", err)
+			return err
+		}
+
+		if !more {
+			return nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// classifyChatBatch classifies one batch; more reports whether unscanned messages may remain.
+func (s *Usecase) classifyChatBatch(ctx context.Context, settings entity.ChatClassifierSettings) (bool, error) {
+	now := time.Now().UTC()
+
+	used, err := s.repo.ChatClassifierTokensUsed(ctx, now)
+	if err != nil {
+		return false, err
+	}
+
+	remaining := settings.DailyTokenBudget - used
+	if remaining <= 0 {
+		return false, nil
+	}
+
+	candidates, scannedTo, err := s.repo.ListChatMessagesForClassification(ctx, now.Add(-chatClassifierMaxAge), chatClassifierScanLimit)
+	if err != nil {
+		return false, err
+	}
+
+	cursor := scannedTo
+	more := len(candidates) == chatClassifierScanLimit
+
+	var batch []entity.ClassifiableChatMessage
+
+	estimate := int64(chatClassifierCallTokens)
+
+	for _, m := range candidates {
+		if !entity.ChatClassifierSampled(m.ID, settings.SampleRate) {
+			continue
+		}
+
+		cost := chatClassifierMessageCost(m)
+		if estimate+cost > remaining {
+			// The rest of the day's budget cannot pay for this message: send what fits, or nothing, and leave the
+			// rest for tomorrow's budget.
+			if len(batch) == 0 {
+				s.obs.Logger.Debug("chat classifier budget too small for a batch", zap.Int64("remaining", remaining))
+				return false, nil
+			}
+
+			cursor, more = batch[len(batch)-1].ID, false
+
+			break
+		}
+
+		estimate += cost
+
+		batch = append(batch, m)
+		if len(batch) == settings.BatchSize {
+			cursor, more = m.ID, true
+			break
+		}
+	}
+
+	var (
+		labels []entity.ChatMessageLabels
+		tokens int64
+	)
+
+	if len(batch) > 0 {
+		callCtx, cancel := context.WithTimeout(ctx, chatClassifierTimeout)
+		labels, tokens, err = s.chatClassifier.ClassifyChatMessages(callCtx, batch)
+
+		cancel()
+
+		if err != nil {
+			// A reply that cost tokens but could not be used is skipped and counted against the budget; failed
+			// calls are retried by the next pass.
+			if tokens > 0 {
+				if saveErr := s.repo.SaveChatClassification(ctx, nil, cursor, now, tokens); saveErr != nil {
+					return false, errors.Join(err, saveErr)
+				}
+			}
+
+			return false, err
+		}
+	}
+
+	if err := s.repo.SaveChatClassification(ctx, labels, cursor, now, tokens); err != nil {
+		return false, err
+	}
+
+	s.obs.Logger.Debug("chat batch classified", zap.Int("messages", len(batch)), zap.Int("labels", len(labels)),
+		zap.Int64("tokens", tokens))
+
+	return more, nil
+}
+
+// chatClassifierMessageCost estimates the tokens a message adds to a classification call (about four bytes of text
+// per token).
+func chatClassifierMessageCost(m entity.ClassifiableChatMessage) int64 {
+	return chatClassifierMessageTokens + int64(len(m.Channel)+len(m.Username)+len(m.Text)+3)/4
+}
+
+// StartChatClassificationLoop runs RunChatClassification every chatClassifierInterval until ctx is done.
+func (s *Usecase) StartChatClassificationLoop(ctx context.Context) {
+	ticker := time.NewTicker(chatClassifierInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RunChatClassification(ctx); err != nil {
+				s.obs.Logger.Warn("chat classification failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// StreamChatToxicity aggregates the classifier labels of a stream's messages.
+func (s *Usecase) StreamChatToxicity(ctx context.Context, streamID int64) (entity.ChatToxicity, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.stream_chat_toxicity")
+	defer span.End()
+
+	out, err := s.repo.GetStreamChatToxicity(ctx, streamID)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get stream chat toxicity failed", err, zap.Int64("stream_id", streamID))
+		return entity.ChatToxicity{}, err
+	}
+
+	return out, nil
+}
+
+// ChatterChatToxicity aggregates the classifier labels of a user's messages across channels.
+func (s *Usecase) ChatterChatToxicity(ctx context.Context, twitchUserID int64) (entity.ChatToxicity, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.chatter_chat_toxicity")
+	defer span.End()
+
+	out, err := s.repo.GetChatterChatToxicity(ctx, twitchUserID)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get chatter chat toxicity failed", err, zap.Int64("twitch_user_id", twitchUserID))
+		return entity.ChatToxicity{}, err
+	}
+
+	return out, nil
+}
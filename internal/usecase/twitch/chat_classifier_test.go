@@ -0,0 +1,153 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+type stubChatClassifier struct {
+	batches [][]int64
+	err     error
+}
+
+func (c *stubChatClassifier) ClassifyChatMessages(_ context.Context, msgs []entity.ClassifiableChatMessage) ([]entity.ChatMessageLabels, int64, error) {
+	if c.err != nil {
+		return nil, 0, c.err
+	}
+
+	ids := make([]int64, len(msgs))
+	labels := make([]entity.ChatMessageLabels, len(msgs))
+
+	for i, m := range msgs {
+		ids[i] = m.ID
+		labels[i] = entity.ChatMessageLabels{MessageID: m.ID, Toxicity: 0.5}
+	}
+
+	c.batches = append(c.batches, ids)
+
+	return labels, 10 * int64(len(msgs)), nil
+}
+
+var testChatClassifierSettings = entity.ChatClassifierSettings{
+	Enabled: true, Channels: []string{"streamer"}, SampleRate: 1, BatchSize: 2, DailyTokenBudget: 1000,
+}
+
+func TestService_RunChatClassification(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("c", "s"), obs)
+
+	classifier := &stubChatClassifier{}
+	svc.SetChatClassifier(classifier)
+
+	msgs := []entity.ClassifiableChatMessage{{ID: 1, Text: "a"}, {ID: 2, Text: "b"}, {ID: 3, Text: "c"}}
+
+	repo.EXPECT().GetChatClassifierSettings(gomock.Any()).Return(testChatClassifierSettings, nil)
+	gomock.InOrder(
+		repo.EXPECT().ChatClassifierTokensUsed(gomock.Any(), gomock.Any()).Return(int64(0), nil),
+		repo.EXPECT().ListChatMessagesForClassification(gomock.Any(), gomock.Any(), chatClassifierScanLimit).Return(msgs, int64(10), nil),
+		repo.EXPECT().SaveChatClassification(gomock.Any(), gomock.Len(2), int64(2), gomock.Any(), int64(20)).Return(nil),
+		repo.EXPECT().ChatClassifierTokensUsed(gomock.Any(), gomock.Any()).Return(int64(20), nil),
+		repo.EXPECT().ListChatMessagesForClassification(gomock.Any(), gomock.Any(), chatClassifierScanLimit).Return(msgs[2:], int64(10), nil),
+		repo.EXPECT().SaveChatClassification(gomock.Any(), gomock.Len(1), int64(10), gomock.Any(), int64(10)).Return(nil),
+	)
+
+	require.NoError(t, svc.RunChatClassification(context.Background()))
+	require.Equal(t, [][]int64{{1, 2}, {3}}, classifier.batches)
+}
+
+func TestService_RunChatClassification_budgetSpent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("c", "s"), obs)
+	svc.SetChatClassifier(&stubChatClassifier{})
+
+	repo.EXPECT().GetChatClassifierSettings(gomock.Any()).Return(testChatClassifierSettings, nil)
+	repo.EXPECT().ChatClassifierTokensUsed(gomock.Any(), gomock.Any()).Return(int64(1000), nil)
+
+	require.NoError(t, svc.RunChatClassification(context.Background()))
+}
+
+func TestService_RunChatClassification_budgetSmallerThanBatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("c", "s"), obs)
+
+	classifier := &stubChatClassifier{}
+	svc.SetChatClassifier(classifier)
+
+	msgs := []entity.ClassifiableChatMessage{{ID: 1, Text: "a"}, {ID: 2, Text: "b"}}
+	oneMessage := chatClassifierCallTokens + chatClassifierMessageCost(msgs[0])
+
+	// The remaining budget pays for one message of the two-message batch: it is sent alone and the cursor stops
+	// after it. Once even one message does not fit, nothing is sent and the cursor stays put.
+	repo.EXPECT().GetChatClassifierSettings(gomock.Any()).Return(testChatClassifierSettings, nil).Times(2)
+	gomock.InOrder(
+		repo.EXPECT().ChatClassifierTokensUsed(gomock.Any(), gomock.Any()).
+			Return(testChatClassifierSettings.DailyTokenBudget-oneMessage, nil),
+		repo.EXPECT().ListChatMessagesForClassification(gomock.Any(), gomock.Any(), chatClassifierScanLimit).Return(msgs, int64(10), nil),
+		repo.EXPECT().SaveChatClassification(gomock.Any(), gomock.Len(1), int64(1), gomock.Any(), int64(10)).Return(nil),
+		repo.EXPECT().ChatClassifierTokensUsed(gomock.Any(), gomock.Any()).
+			Return(testChatClassifierSettings.DailyTokenBudget-oneMessage+1, nil),
+		repo.EXPECT().ListChatMessagesForClassification(gomock.Any(), gomock.Any(), chatClassifierScanLimit).Return(msgs[1:], int64(10), nil),
+	)
+
+	require.NoError(t, svc.RunChatClassification(context.Background()))
+	require.NoError(t, svc.RunChatClassification(context.Background()))
+	require.Equal(t, [][]int64{{1}}, classifier.batches)
+}
+
+func TestService_RunChatClassification_aiNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("c", "s"), obs)
+	svc.SetChatClassifier(&stubChatClassifier{err: entity.ErrAINotConfigured})
+
+	repo.EXPECT().GetChatClassifierSettings(gomock.Any()).Return(testChatClassifierSettings, nil)
+	repo.EXPECT().ChatClassifierTokensUsed(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+	repo.EXPECT().ListChatMessagesForClassification(gomock.Any(), gomock.Any(), chatClassifierScanLimit).
+		Return([]entity.ClassifiableChatMessage{{ID: 1, Text: "a"}}, int64(1), nil)
+
+	require.NoError(t, svc.RunChatClassification(context.Background()))
+}
+
+func TestService_RunChatClassification_disabled(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("c", "s"), obs)
+
+	// Without a classifier nothing is loaded at all.
+	require.NoError(t, svc.RunChatClassification(context.Background()))
+
+	svc.SetChatClassifier(&stubChatClassifier{})
+
+	disabled := testChatClassifierSettings
+	disabled.Enabled = false
+	repo.EXPECT().GetChatClassifierSettings(gomock.Any()).Return(disabled, nil)
+
+	require.NoError(t, svc.RunChatClassification(context.Background()))
+}
@@ -0,0 +1,23 @@
+package twitch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// StreamChatMoments returns the chat moments detected live during a stream, in order (see the live chat moment detector).
+func (s *Usecase) StreamChatMoments(ctx context.Context, streamID int64) ([]entity.ChatMoment, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.stream_chat_moments")
+	defer span.End()
+
+	out, err := s.repo.ListStreamChatMoments(ctx, streamID)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list stream chat moments failed", err, zap.Int64("stream_id", streamID))
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,36 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_StreamChatMoments(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("c", "s"), obs)
+
+	want := []entity.ChatMoment{{
+		ID: 1, StreamID: 9, Kind: entity.ChatMomentKindVelocity,
+		StartedAt: time.Date(2024, 5, 1, 20, 10, 0, 0, time.UTC), OffsetSeconds: 600, Messages: 60, Chatters: 20, Intensity: 4.5,
+	}}
+
+	repo.EXPECT().ListStreamChatMoments(gomock.Any(), int64(9)).Return(want, nil)
+
+	got, err := svc.StreamChatMoments(context.Background(), 9)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,187 @@
+package twitch
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// maxTermWords is the longest n-gram extracted from chat messages.
+const maxTermWords = 3
+
+// termStopwords are dropped from chat terms and break n-grams. English function words plus chat filler.
+var termStopwords = func() map[string]struct{} {
+	words := strings.Fields(`
+		a about above after again against all am an and any are arent as at be because been before being below
+		between both but by can cant could couldnt did didnt do does doesnt doing dont down during each few for
+		from further had hadnt has hasnt have havent having he hed hell hes her here heres hers herself him himself
+		his how hows i id ill im ive if in into is isnt it its itself lets me more most mustnt my myself no nor not
+		of off on once only or other ought our ours ourselves out over own same shant she shed shell shes should
+		shouldnt so some such than that thats the their theirs them themselves then there theres these they theyd
+		theyll theyre theyve this those through to too under until up very was wasnt we wed well were weve werent
+		what whats when whens where wheres which while who whos whom why whys with wont would wouldnt you youd youll
+		youre youve your yours yourself yourselves
+		u ur ya yeah yes oh ok okay just like get got go gonna also really even still will one now
+	`)
+
+	out := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		out[w] = struct{}{}
+	}
+
+	return out
+}()
+
+// messageTerms tokenizes a chat message into its distinct terms. Words are split on spaces, lowercased, stripped
+// of surrounding punctuation and apostrophes; URLs, @mentions, numbers, one-letter words and stopwords are dropped.
+// Words at an emote occurrence (Start offsets from emotes) become emote terms, and repeats of the same token in a
+// row count once, so spam does not produce n-grams. N-grams of up to maxTermWords words never span emotes or
+// dropped words.
+func messageTerms(body string, emotes []entity.ChatEmote) []entity.ChatTerm {
+	emoteAt := make(map[int]string, len(emotes))
+	for _, e := range emotes {
+		emoteAt[e.Start] = e.Code
+	}
+
+	seen := make(map[entity.ChatTerm]struct{})
+
+	var (
+		out  []entity.ChatTerm
+		run  []string
+		prev string
+	)
+
+	add := func(t entity.ChatTerm) {
+		if _, ok := seen[t]; !ok {
+			seen[t] = struct{}{}
+			out = append(out, t)
+		}
+	}
+
+	pos := 0
+
+	for _, raw := range strings.Split(body, " ") {
+		start := pos
+		pos += utf8.RuneCountInString(raw) + 1
+
+		if raw == "" {
+			continue
+		}
+
+		if code, ok := emoteAt[start]; ok && code == raw {
+			add(entity.ChatTerm{Text: code, Emote: true})
+			run, prev = nil, "\x00"+code
+
+			continue
+		}
+
+		w := termWord(raw)
+		if w == "" {
+			run, prev = nil, ""
+			continue
+		}
+
+		if w == prev {
+			continue
+		}
+
+		prev = w
+		run = append(run, w)
+
+		if len(run) > maxTermWords {
+			run = run[1:]
+		}
+
+		for n := 1; n <= len(run); n++ {
+			add(entity.ChatTerm{Text: strings.Join(run[len(run)-n:], " ")})
+		}
+	}
+
+	return out
+}
+
+// termWord normalizes one space-separated token, or returns "" when it is not a term.
+func termWord(raw string) string {
+	if strings.HasPrefix(raw, "@") || strings.Contains(raw, "://") || strings.HasPrefix(strings.ToLower(raw), "www.") {
+		return ""
+	}
+
+	w := strings.ToLower(strings.TrimFunc(raw, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) }))
+	w = strings.NewReplacer("'", "", "’", "").Replace(w)
+
+	if utf8.RuneCountInString(w) < 2 || strings.IndexFunc(w, unicode.IsLetter) < 0 {
+		return ""
+	}
+
+	if _, stop := termStopwords[w]; stop {
+		return ""
+	}
+
+	return w
+}
+
+// normalizeTermQuery turns a searched term into the form messageTerms produces (lowercase words joined by single
+// spaces). Emote codes are matched case-insensitively by callers.
+func normalizeTermQuery(q string) (string, error) {
+	words := strings.Fields(q)
+	if len(words) == 0 || len(words) > maxTermWords {
+		return "", fmt.Errorf("%w: term must be 1-%d words", entity.ErrInvalidTermQuery, maxTermWords)
+	}
+
+	for i, w := range words {
+		words[i] = strings.ToLower(strings.TrimFunc(strings.NewReplacer("'", "", "’", "").Replace(w),
+			func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) }))
+		if words[i] == "" {
+			return "", fmt.Errorf("%w: %q has no letters or digits", entity.ErrInvalidTermQuery, w)
+		}
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// countTerms counts in how many messages and by how many chatters each term appears.
+func countTerms(messages []entity.TermMessage) []entity.TermCount {
+	type agg struct {
+		count    entity.TermCount
+		chatters map[int64]struct{}
+	}
+
+	byTerm := make(map[entity.ChatTerm]*agg)
+
+	var order []entity.ChatTerm
+
+	for _, m := range messages {
+		for _, t := range messageTerms(m.Body, m.Emotes) {
+			a, ok := byTerm[t]
+			if !ok {
+				a = &agg{count: entity.TermCount{Term: t.Text, Emote: t.Emote}, chatters: make(map[int64]struct{})}
+				byTerm[t] = a
+				order = append(order, t)
+			}
+
+			a.count.Messages++
+
+			if m.ChatterTwitchUserID != nil {
+				a.chatters[*m.ChatterTwitchUserID] = struct{}{}
+			}
+		}
+	}
+
+	out := make([]entity.TermCount, 0, len(order))
+	for _, t := range order {
+		a := byTerm[t]
+		a.count.Chatters = len(a.chatters)
+		out = append(out, a.count)
+	}
+
+	return out
+}
+
+// termIDF is the smoothed inverse document frequency of a term used in df of baseline streams out of n.
+func termIDF(n, df int) float64 {
+	return math.Log(float64(1+n)/float64(1+df)) + 1
+}
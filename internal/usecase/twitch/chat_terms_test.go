@@ -0,0 +1,78 @@
+package twitch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+func termTexts(terms []entity.ChatTerm) []string {
+	out := make([]string, 0, len(terms))
+	for _, t := range terms {
+		out = append(out, t.Text)
+	}
+
+	return out
+}
+
+func TestMessageTerms(t *testing.T) {
+	got := messageTerms("The boss fight, boss FIGHT!!", nil)
+	assert.Equal(t, []string{"boss", "fight", "boss fight", "fight boss", "boss fight boss", "fight boss fight"}, termTexts(got))
+
+	got = messageTerms("@streamer check https://x.io it's 2024 GG GG GG gg", nil)
+	assert.Equal(t, []string{"check", "gg"}, termTexts(got))
+}
+
+func TestMessageTerms_emotes(t *testing.T) {
+	emotes := []entity.ChatEmote{
+		{Code: "KEKW", Start: 0, End: 3},
+		{Code: "KEKW", Start: 5, End: 8},
+		{Code: "KEKW", Start: 20, End: 23},
+	}
+
+	got := messageTerms("KEKW KEKW nice shot KEKW", emotes)
+	assert.Equal(t, []entity.ChatTerm{
+		{Text: "KEKW", Emote: true},
+		{Text: "nice"},
+		{Text: "shot"},
+		{Text: "nice shot"},
+	}, got)
+}
+
+func TestCountTerms(t *testing.T) {
+	a, b := int64(1), int64(2)
+
+	got := countTerms([]entity.TermMessage{
+		{ChatterTwitchUserID: &a, Body: "boss fight"},
+		{ChatterTwitchUserID: &a, Body: "boss"},
+		{ChatterTwitchUserID: &b, Body: "the boss"},
+		{Body: "boss boss"},
+	})
+
+	require.NotEmpty(t, got)
+	assert.Equal(t, entity.TermCount{Term: "boss", Messages: 4, Chatters: 2}, got[0])
+}
+
+func TestNormalizeTermQuery(t *testing.T) {
+	q, err := normalizeTermQuery("  Boss   Fight! ")
+	require.NoError(t, err)
+	assert.Equal(t, "boss fight", q)
+
+	_, err = normalizeTermQuery(" ")
+	require.ErrorIs(t, err, entity.ErrInvalidTermQuery)
+
+	_, err = normalizeTermQuery("one two three four")
+	require.ErrorIs(t, err, entity.ErrInvalidTermQuery)
+
+	_, err = normalizeTermQuery("!!")
+	require.ErrorIs(t, err, entity.ErrInvalidTermQuery)
+}
+
+func TestTermIDF(t *testing.T) {
+	assert.InDelta(t, 1.0, termIDF(0, 0), 1e-9)
+	assert.Greater(t, termIDF(10, 0), termIDF(10, 9))
+	assert.InDelta(t, 1.0, termIDF(10, 10), 1e-9)
+}
@@ -0,0 +1,24 @@
+package twitch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// StreamChatterCounts splits a stream's chatters into those new to the channel (first seen during the stream) and
+// returning ones.
+func (s *Usecase) StreamChatterCounts(ctx context.Context, streamID int64) (entity.StreamChatterCounts, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.stream_chatter_counts")
+	defer span.End()
+
+	out, err := s.repo.CountStreamChatters(ctx, streamID)
+	if err != nil {
+		s.obs.LogError(ctx, span, "count stream chatters failed", err, zap.Int64("stream_id", streamID))
+		return entity.StreamChatterCounts{}, err
+	}
+
+	return out, nil
+}
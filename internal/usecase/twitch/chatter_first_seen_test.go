@@ -0,0 +1,31 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_StreamChatterCounts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("c", "s"), obs)
+
+	want := entity.StreamChatterCounts{NewChatters: 4, ReturningChatters: 11}
+	repo.EXPECT().CountStreamChatters(gomock.Any(), int64(9)).Return(want, nil)
+
+	got, err := svc.StreamChatterCounts(context.Background(), 9)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,23 @@
+package twitch
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// DeleteUserLink forgets a confirmed or rejected link so the pair can show up as a possible alt again.
+func (s *Usecase) DeleteUserLink(ctx context.Context, userID, linkedUserID int64) error {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.delete_user_link")
+	defer span.End()
+
+	err := s.repo.DeleteUserLink(ctx, userID, linkedUserID)
+	if err != nil && !errors.Is(err, entity.ErrUserLinkNotFound) {
+		s.obs.LogError(ctx, span, "delete user link failed", err, zap.Int64("id", userID), zap.Int64("linked_id", linkedUserID))
+	}
+
+	return err
+}
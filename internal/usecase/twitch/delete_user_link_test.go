@@ -0,0 +1,32 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestDeleteUserLink(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	repo.EXPECT().DeleteUserLink(gomock.Any(), int64(4), int64(9)).Return(nil)
+	repo.EXPECT().DeleteUserLink(gomock.Any(), int64(4), int64(10)).Return(entity.ErrUserLinkNotFound)
+
+	require.NoError(t, svc.DeleteUserLink(context.Background(), 4, 9))
+	require.ErrorIs(t, svc.DeleteUserLink(context.Background(), 4, 10), entity.ErrUserLinkNotFound)
+}
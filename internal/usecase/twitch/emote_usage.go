@@ -0,0 +1,177 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// defaultEmoteUsageLimit is how many emotes usage queries return when no limit is given.
+	defaultEmoteUsageLimit = 20
+	// defaultEmoteUsageRange is the trailing range of channel and user usage queries without a start time.
+	defaultEmoteUsageRange = 7 * 24 * time.Hour
+	// streamEmoteTimelinePoints is roughly how many buckets a stream's emote timeline is split into.
+	streamEmoteTimelinePoints = 60
+)
+
+// StreamEmotes returns a stream's most used emotes and a dense timeline of their uses, with buckets of whole
+// minutes sized to split the stream into about streamEmoteTimelinePoints.
+func (s *Usecase) StreamEmotes(ctx context.Context, st entity.Stream, limit int) (entity.StreamEmotes, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.stream_emotes")
+	defer span.End()
+
+	if limit <= 0 {
+		limit = defaultEmoteUsageLimit
+	}
+
+	top, err := s.repo.ListEmoteUsage(ctx, entity.EmoteUsageFilter{StreamID: &st.ID, Limit: limit})
+	if err != nil {
+		s.obs.LogError(ctx, span, "list stream emote usage failed", err, zap.Int64("stream_id", st.ID))
+		return entity.StreamEmotes{}, err
+	}
+
+	end := time.Now().UTC()
+	if st.EndedAt != nil {
+		end = *st.EndedAt
+	}
+
+	bucket := streamTimelineBucket(end.Sub(st.StartedAt))
+	out := entity.StreamEmotes{StreamID: st.ID, Top: top, BucketSeconds: int(bucket.Seconds())}
+
+	if len(top) == 0 {
+		return out, nil
+	}
+
+	counts, err := s.repo.ListStreamEmoteCounts(ctx, st.ID, st.StartedAt, bucket, top)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list stream emote counts failed", err, zap.Int64("stream_id", st.ID))
+		return entity.StreamEmotes{}, err
+	}
+
+	out.Timeline = denseEmoteTimeline(st.StartedAt, end, bucket, top, counts)
+
+	return out, nil
+}
+
+// streamTimelineBucket is the whole-minute bucket width that splits d into at most about streamEmoteTimelinePoints;
+// stream emote and term timelines share it.
+func streamTimelineBucket(d time.Duration) time.Duration {
+	minutes := (d + streamEmoteTimelinePoints*time.Minute - 1) / (streamEmoteTimelinePoints * time.Minute)
+
+	return max(minutes, 1) * time.Minute
+}
+
+// denseEmoteTimeline lays counts out over every bucket from start to end (and any later bucket holding uses),
+// with Uses aligned to top.
+func denseEmoteTimeline(start, end time.Time, bucket time.Duration, top []entity.EmoteUsage, counts []entity.EmoteBucketCount) []entity.EmoteTimelineBucket {
+	index := make(map[[2]string]int, len(top))
+	for i, e := range top {
+		index[[2]string{e.Provider, e.EmoteID}] = i
+	}
+
+	n := int((end.Sub(start) + bucket - 1) / bucket)
+	for _, c := range counts {
+		n = max(n, c.Bucket+1)
+	}
+
+	out := make([]entity.EmoteTimelineBucket, max(n, 1))
+	for i := range out {
+		out[i] = entity.EmoteTimelineBucket{Start: start.Add(time.Duration(i) * bucket), Uses: make([]int64, len(top))}
+	}
+
+	for _, c := range counts {
+		i, ok := index[[2]string{c.Provider, c.EmoteID}]
+		if !ok || c.Bucket < 0 {
+			continue
+		}
+
+		out[c.Bucket].Uses[i] += c.Uses
+	}
+
+	return out
+}
+
+// ChannelEmoteUsage returns the most used emotes in a monitored channel's chat over [from, to) (default the
+// trailing defaultEmoteUsageRange).
+func (s *Usecase) ChannelEmoteUsage(ctx context.Context, channel string, from, to *time.Time, limit int) ([]entity.EmoteUsage, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.channel_emote_usage")
+	defer span.End()
+
+	id, ok, err := s.repo.MonitoredChannelTwitchUserID(ctx, channel)
+	if err != nil {
+		s.obs.LogError(ctx, span, "monitored channel lookup failed", err, zap.String("channel", channel))
+		return nil, err
+	}
+
+	if !ok {
+		return nil, ErrChannelNotMonitored
+	}
+
+	f, err := emoteUsageFilter(from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	f.ChannelTwitchUserID = &id
+
+	out, err := s.repo.ListEmoteUsage(ctx, f)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list channel emote usage failed", err, zap.String("channel", channel))
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// UserEmoteUsage returns the emotes a user used most across all monitored channels over [from, to) (default the
+// trailing defaultEmoteUsageRange).
+func (s *Usecase) UserEmoteUsage(ctx context.Context, userID int64, from, to *time.Time, limit int) ([]entity.EmoteUsage, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.user_emote_usage")
+	defer span.End()
+
+	if _, err := s.repo.GetTwitchUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	f, err := emoteUsageFilter(from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	f.ChatterTwitchUserID = &userID
+
+	out, err := s.repo.ListEmoteUsage(ctx, f)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list user emote usage failed", err, zap.Int64("user_id", userID))
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func emoteUsageFilter(from, to *time.Time, limit int) (entity.EmoteUsageFilter, error) {
+	end := time.Now().UTC()
+	if to != nil {
+		end = to.UTC()
+	}
+
+	start := end.Add(-defaultEmoteUsageRange)
+	if from != nil {
+		start = from.UTC()
+	}
+
+	if !start.Before(end) {
+		return entity.EmoteUsageFilter{}, fmt.Errorf("%w: from must be before to", entity.ErrInvalidEmoteUsageQuery)
+	}
+
+	if limit <= 0 {
+		limit = defaultEmoteUsageLimit
+	}
+
+	return entity.EmoteUsageFilter{From: &start, To: &end, Limit: limit}, nil
+}
@@ -0,0 +1,134 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestStreamEmoteBucket(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Minute, streamTimelineBucket(0))
+	assert.Equal(t, time.Minute, streamTimelineBucket(59*time.Minute))
+	assert.Equal(t, 2*time.Minute, streamTimelineBucket(61*time.Minute))
+	assert.Equal(t, 6*time.Minute, streamTimelineBucket(6*time.Hour))
+}
+
+func TestService_StreamEmotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Minute)
+	st := entity.Stream{ID: 7, StartedAt: start, EndedAt: &end}
+
+	top := []entity.EmoteUsage{
+		{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Uses: 5},
+		{Provider: entity.EmoteProviderBTTV, EmoteID: "b1", Code: "catJAM", Uses: 2},
+	}
+
+	repo.EXPECT().ListEmoteUsage(gomock.Any(), entity.EmoteUsageFilter{StreamID: &st.ID, Limit: 10}).Return(top, nil)
+	repo.EXPECT().ListStreamEmoteCounts(gomock.Any(), int64(7), start, time.Minute, top).Return([]entity.EmoteBucketCount{
+		{Bucket: 0, Provider: entity.EmoteProviderTwitch, EmoteID: "25", Uses: 4},
+		{Bucket: 2, Provider: entity.EmoteProviderTwitch, EmoteID: "25", Uses: 1},
+		{Bucket: 2, Provider: entity.EmoteProviderBTTV, EmoteID: "b1", Uses: 2},
+	}, nil)
+
+	out, err := svc.StreamEmotes(context.Background(), st, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 60, out.BucketSeconds)
+	assert.Equal(t, top, out.Top)
+	assert.Equal(t, []entity.EmoteTimelineBucket{
+		{Start: start, Uses: []int64{4, 0}},
+		{Start: start.Add(time.Minute), Uses: []int64{0, 0}},
+		{Start: start.Add(2 * time.Minute), Uses: []int64{1, 2}},
+	}, out.Timeline)
+}
+
+func TestService_StreamEmotes_noEmotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	st := entity.Stream{ID: 7, StartedAt: time.Now().Add(-time.Hour)}
+	repo.EXPECT().ListEmoteUsage(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	out, err := svc.StreamEmotes(context.Background(), st, 0)
+	require.NoError(t, err)
+	assert.Empty(t, out.Top)
+	assert.Empty(t, out.Timeline)
+}
+
+func TestService_ChannelEmoteUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	channelID := int64(9)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "streamer").Return(channelID, true, nil)
+	repo.EXPECT().ListEmoteUsage(gomock.Any(), entity.EmoteUsageFilter{
+		ChannelTwitchUserID: &channelID, From: &from, To: &to, Limit: defaultEmoteUsageLimit,
+	}).Return([]entity.EmoteUsage{{Provider: entity.EmoteProviderTwitch, EmoteID: "25", Code: "Kappa", Uses: 3}}, nil)
+
+	out, err := svc.ChannelEmoteUsage(context.Background(), "streamer", &from, &to, 0)
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "other").Return(int64(0), false, nil)
+
+	_, err = svc.ChannelEmoteUsage(context.Background(), "other", nil, nil, 0)
+	require.ErrorIs(t, err, ErrChannelNotMonitored)
+
+	repo.EXPECT().MonitoredChannelTwitchUserID(gomock.Any(), "streamer").Return(channelID, true, nil)
+
+	_, err = svc.ChannelEmoteUsage(context.Background(), "streamer", &to, &from, 0)
+	require.ErrorIs(t, err, entity.ErrInvalidEmoteUsageQuery)
+}
+
+func TestService_UserEmoteUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(5)).Return(entity.TwitchUser{ID: 5}, nil)
+	repo.EXPECT().ListEmoteUsage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f entity.EmoteUsageFilter) ([]entity.EmoteUsage, error) {
+			require.NotNil(t, f.ChatterTwitchUserID)
+			assert.Equal(t, int64(5), *f.ChatterTwitchUserID)
+			assert.Equal(t, defaultEmoteUsageRange, f.To.Sub(*f.From))
+			assert.Equal(t, 50, f.Limit)
+
+			return nil, nil
+		})
+
+	_, err := svc.UserEmoteUsage(context.Background(), 5, nil, nil, 50)
+	require.NoError(t, err)
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(6)).Return(entity.TwitchUser{}, entity.ErrTwitchUserNotFound)
+
+	_, err = svc.UserEmoteUsage(context.Background(), 6, nil, nil, 0)
+	require.ErrorIs(t, err, entity.ErrTwitchUserNotFound)
+}
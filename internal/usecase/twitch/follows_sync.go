@@ -2,6 +2,7 @@ package twitch
 
 import (
 	"context"
+	"time"
 
 	"github.com/rofleksey/dredge/internal/entity"
 	"github.com/rofleksey/dredge/internal/service/twitch/gql"
@@ -15,6 +16,11 @@ func (s *Usecase) syncUserFollowsFromGQL(ctx context.Context, userID int64) (tot
 		return 0, err
 	}
 
+	return s.syncUserFollowsWith(ctx, settings, userID)
+}
+
+// syncUserFollowsWith is syncUserFollowsFromGQL with the suspicion settings already loaded.
+func (s *Usecase) syncUserFollowsWith(ctx context.Context, settings entity.SuspicionSettings, userID int64) (int, error) {
 	maxPages := settings.MaxGQLFollowPages
 	if maxPages < 1 {
 		maxPages = 1
@@ -44,6 +50,11 @@ func (s *Usecase) syncUserFollowsFromGQL(ctx context.Context, userID int64) (tot
 		total = len(rows)
 	}
 
+	// Kept for bulk re-evaluation, which scores users from cached data without refetching follows.
+	if err := s.repo.UpsertFollowsSyncMeta(ctx, userID, total, time.Now().UTC()); err != nil {
+		return 0, err
+	}
+
 	return total, nil
 }
 
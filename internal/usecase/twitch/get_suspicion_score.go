@@ -0,0 +1,23 @@
+package twitch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// GetSuspicionScore returns the latest weighted suspicion breakdown for a user, or nil if never scored.
+func (s *Usecase) GetSuspicionScore(ctx context.Context, id int64) (*entity.SuspicionScore, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.get_suspicion_score")
+	defer span.End()
+
+	score, err := s.repo.GetSuspicionScore(ctx, id)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get suspicion score failed", err, zap.Int64("id", id))
+		return nil, err
+	}
+
+	return score, nil
+}
@@ -0,0 +1,38 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_GetSuspicionScore(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, nil, testTwitchCfg("id", "secret"), obs)
+
+	repo.EXPECT().GetSuspicionScore(gomock.Any(), int64(5)).Return(&entity.SuspicionScore{TwitchUserID: 5, Score: 70, Threshold: 50}, nil)
+	repo.EXPECT().GetSuspicionScore(gomock.Any(), int64(6)).Return(nil, nil)
+
+	got, err := svc.GetSuspicionScore(context.Background(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, 70, got.Score)
+
+	got, err = svc.GetSuspicionScore(context.Background(), 6)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,148 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// newUserLoginCheckTimeout bounds the blocklist and login pattern check started for a newly inserted chatter.
+const newUserLoginCheckTimeout = 10 * time.Second
+
+// checkNewUserLogin runs checkIdentityLists in the background for a chatter the live runtime just inserted.
+func (s *Usecase) checkNewUserLogin(userID int64) {
+	go func() {
+		ctx, cancel := context.WithTimeout(s.persistContext(), newUserLoginCheckTimeout)
+		defer cancel()
+
+		if err := s.checkIdentityLists(ctx, userID); err != nil {
+			s.obs.Logger.Debug("new user identity check failed", zap.Int64("id", userID), zap.Error(err))
+		}
+	}()
+}
+
+// identityMatch is an automatic mark derived from who a user is (a listed id or login) rather than what they did.
+type identityMatch struct {
+	susType     string
+	description string
+	evidence    map[string]any
+}
+
+// matchIdentity checks a user against the imported blocklists (by id or login), then the login pattern blacklist.
+// A match marks the user regardless of score.
+func (s *Usecase) matchIdentity(ctx context.Context, u entity.TwitchUser) (identityMatch, bool, error) {
+	hits, err := s.repo.MatchBlocklists(ctx, u.ID, u.Username)
+	if err != nil {
+		return identityMatch{}, false, err
+	}
+
+	if len(hits) > 0 {
+		names := make([]string, 0, len(hits))
+		for _, h := range hits {
+			names = append(names, h.Name)
+		}
+
+		return identityMatch{
+			susType:     entity.SusTypeAutoBlocklist,
+			description: describeBlocklistHits(names),
+			evidence:    map[string]any{"blocklists": names, "matched_by": hits[0].MatchedBy},
+		}, true, nil
+	}
+
+	patterns, err := s.compiledLoginPatterns(ctx)
+	if err != nil {
+		return identityMatch{}, false, err
+	}
+
+	if p, ok := entity.MatchLoginPatterns(patterns, u.Username); ok {
+		return identityMatch{
+			susType:     entity.SusTypeAutoLoginPattern,
+			description: describeLoginPatternMatch(u.Username, p),
+			evidence:    map[string]any{"login_pattern": p.Pattern, "skeleton": entity.LoginSkeleton(u.Username)},
+		}, true, nil
+	}
+
+	return identityMatch{}, false, nil
+}
+
+// checkIdentityLists applies matchIdentity without the rest of enrichment, so new chatters and users never
+// enriched are flagged as soon as they are seen. It only clears marks of its own types; other automatic marks
+// wait for a full evaluation.
+func (s *Usecase) checkIdentityLists(ctx context.Context, userID int64) error {
+	ownIDs, err := s.repo.ListLinkedTwitchAccountUserIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.checkIdentityListsWith(ctx, ownIDs, userID)
+}
+
+// checkIdentityListsWith is checkIdentityLists with the linked account ids already loaded.
+func (s *Usecase) checkIdentityListsWith(ctx context.Context, ownIDs []int64, userID int64) error {
+	if slices.Contains(ownIDs, userID) {
+		return nil
+	}
+
+	u, err := s.repo.GetTwitchUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if u.IsSus && u.SusType != nil && *u.SusType == entity.SusTypeManual {
+		return nil
+	}
+
+	m, matched, err := s.matchIdentity(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	markedByList := u.IsSus && u.SusType != nil &&
+		(*u.SusType == entity.SusTypeAutoBlocklist || *u.SusType == entity.SusTypeAutoLoginPattern)
+
+	if !matched {
+		if markedByList {
+			return s.applySuspicionPatch(ctx, userID, false, nil, nil, map[string]any{"reason": "no longer listed"})
+		}
+
+		return nil
+	}
+
+	if u.SusAutoSuppressed {
+		return nil
+	}
+
+	if markedByList && *u.SusType == m.susType && u.SusDescription != nil && *u.SusDescription == m.description {
+		return nil
+	}
+
+	return s.applySuspicionPatch(ctx, userID, true, &m.susType, &m.description, m.evidence)
+}
+
+func describeBlocklistHits(names []string) string {
+	if len(names) == 1 {
+		return fmt.Sprintf("Listed on blocklist %q", names[0])
+	}
+
+	quoted := make([]string, 0, len(names))
+	for _, n := range names {
+		quoted = append(quoted, strconv.Quote(n))
+	}
+
+	return "Listed on blocklists " + strings.Join(quoted, ", ")
+}
+
+func describeLoginPatternMatch(login string, p entity.LoginPattern) string {
+	if p.Description != "" {
+		return fmt.Sprintf("Login %q matches blocked %s %q (%s)", login, p.Kind, p.Pattern, p.Description)
+	}
+
+	return fmt.Sprintf("Login %q matches blocked %s %q", login, p.Kind, p.Pattern)
+}
@@ -0,0 +1,143 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestCheckIdentityLists(t *testing.T) {
+	t.Parallel()
+
+	patterns := []entity.LoginPattern{{ID: 1, Kind: entity.LoginPatternGlob, Pattern: "*streamer*", Description: "impersonation"}}
+	hits := []entity.BlocklistHit{{BlocklistID: 3, Name: "raids", MatchedBy: "id"}, {BlocklistID: 4, Name: "spam", MatchedBy: "login"}}
+	autoPattern := entity.SusTypeAutoLoginPattern
+	autoBlocklist := entity.SusTypeAutoBlocklist
+	autoScore := entity.SusTypeAutoScore
+	manual := entity.SusTypeManual
+	patternDesc := `Login "streamer2" matches blocked glob "*streamer*" (impersonation)`
+
+	tests := []struct {
+		name     string
+		user     entity.TwitchUser
+		hits     []entity.BlocklistHit
+		patterns []entity.LoginPattern
+		wantType *string // nil: no patch; "": cleared
+	}{
+		{name: "marks confusable match", user: entity.TwitchUser{Username: "the_5tr3amer"}, patterns: patterns, wantType: &autoPattern},
+		{name: "blocklist wins", user: entity.TwitchUser{Username: "streamer2"}, hits: hits, patterns: patterns, wantType: &autoBlocklist},
+		{name: "no match", user: entity.TwitchUser{Username: "viewer"}, patterns: patterns},
+		{name: "already marked", user: entity.TwitchUser{Username: "streamer2", IsSus: true, SusType: &autoPattern, SusDescription: &patternDesc}, patterns: patterns},
+		{name: "pattern mark upgraded", user: entity.TwitchUser{Username: "streamer2", IsSus: true, SusType: &autoPattern, SusDescription: &patternDesc}, hits: hits, wantType: &autoBlocklist},
+		{name: "suppressed", user: entity.TwitchUser{Username: "streamer2", SusAutoSuppressed: true}, patterns: patterns},
+		{name: "manual lock", user: entity.TwitchUser{Username: "viewer", IsSus: true, SusType: &manual}, hits: hits},
+		{name: "clears own mark", user: entity.TwitchUser{Username: "streamer2", IsSus: true, SusType: &autoBlocklist}, wantType: new(string)},
+		{name: "keeps score mark", user: entity.TwitchUser{Username: "viewer", IsSus: true, SusType: &autoScore}, patterns: patterns},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repomocks.NewMockStore(ctrl)
+			obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+			svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+			u := tt.user
+			u.ID = 7
+
+			repo.EXPECT().ListLinkedTwitchAccountUserIDs(gomock.Any()).Return([]int64{1}, nil)
+			repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(7)).Return(u, nil)
+			repo.EXPECT().MatchBlocklists(gomock.Any(), int64(7), u.Username).Return(tt.hits, nil).MaxTimes(1)
+			repo.EXPECT().ListLoginPatterns(gomock.Any()).Return(tt.patterns, nil).MaxTimes(1)
+
+			if tt.wantType != nil {
+				repo.EXPECT().PatchTwitchUser(gomock.Any(), int64(7), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int64, p entity.TwitchUserPatch) (entity.TwitchUser, error) {
+						assert.Equal(t, *tt.wantType != "", *p.IsSus)
+						assert.Equal(t, *tt.wantType, *p.SusType)
+						assert.Equal(t, entity.SuspicionSourceAuto, p.SusSource)
+
+						switch *tt.wantType {
+						case entity.SusTypeAutoLoginPattern:
+							assert.Contains(t, *p.SusDescription, "impersonation")
+							assert.Equal(t, "thestreamer", p.SusEvidence["skeleton"])
+						case entity.SusTypeAutoBlocklist:
+							assert.Equal(t, `Listed on blocklists "raids", "spam"`, *p.SusDescription)
+							assert.Equal(t, []string{"raids", "spam"}, p.SusEvidence["blocklists"])
+						}
+
+						return entity.TwitchUser{ID: 7}, nil
+					})
+			}
+
+			require.NoError(t, svc.checkIdentityLists(context.Background(), 7))
+		})
+	}
+}
+
+func TestCheckIdentityLists_ownAccount(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	repo.EXPECT().ListLinkedTwitchAccountUserIDs(gomock.Any()).Return([]int64{7}, nil)
+
+	require.NoError(t, svc.checkIdentityLists(context.Background(), 7))
+}
+
+func TestEvaluateSuspicionForUser_loginPatternOverridesScore(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	created := time.Now().UTC().AddDate(-3, 0, 0)
+
+	repo.EXPECT().ListLinkedTwitchAccountUserIDs(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(9)).Return(entity.TwitchUser{ID: 9, Username: "h4ter_xx"}, nil)
+	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(testSuspicionSettings(), nil)
+	repo.EXPECT().ListChannelBlacklist(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().MatchBlocklists(gomock.Any(), int64(9), "h4ter_xx").Return(nil, nil)
+	repo.EXPECT().ListLoginPatterns(gomock.Any()).Return([]entity.LoginPattern{
+		{Kind: entity.LoginPatternRegex, Pattern: "^hater"},
+	}, nil)
+	repo.EXPECT().ListUserFollowedChannels(gomock.Any(), int64(9)).Return(nil, nil)
+	repo.EXPECT().GetHelixMeta(gomock.Any(), int64(9)).Return(&created, nil, nil, nil)
+	repo.EXPECT().ListChatMessages(gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().ListChatterChannelPresence(gomock.Any(), int64(9)).Return(nil, nil)
+	repo.EXPECT().CountUserActivityEventsByType(gomock.Any(), int64(9), gomock.Any()).Return(int64(0), nil)
+	repo.EXPECT().UpsertSuspicionScore(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().PatchTwitchUser(gomock.Any(), int64(9), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, p entity.TwitchUserPatch) (entity.TwitchUser, error) {
+			assert.True(t, *p.IsSus)
+			assert.Equal(t, entity.SusTypeAutoLoginPattern, *p.SusType)
+			assert.Equal(t, `Login "h4ter_xx" matches blocked regex "^hater"`, *p.SusDescription)
+			assert.Equal(t, "^hater", p.SusEvidence["login_pattern"])
+			assert.Equal(t, 0, p.SusEvidence["score"])
+
+			return entity.TwitchUser{ID: 9, IsSus: true}, nil
+		})
+
+	require.NoError(t, svc.evaluateSuspicionForUser(context.Background(), 9, 100))
+}
@@ -0,0 +1,23 @@
+package twitch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListAltCandidates returns stored possible alts of a user (highest score first), excluding reviewed pairs.
+func (s *Usecase) ListAltCandidates(ctx context.Context, id int64) ([]entity.AltCandidate, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.list_alt_candidates")
+	defer span.End()
+
+	list, err := s.repo.ListAltCandidates(ctx, id, altCandidatesKept)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list alt candidates failed", err, zap.Int64("id", id))
+		return nil, err
+	}
+
+	return list, nil
+}
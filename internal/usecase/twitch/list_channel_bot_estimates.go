@@ -0,0 +1,21 @@
+package twitch
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListChannelBotEstimates returns the latest per-channel bot-share estimates, highest share first.
+func (s *Usecase) ListChannelBotEstimates(ctx context.Context) ([]entity.ChannelBotEstimate, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.list_channel_bot_estimates")
+	defer span.End()
+
+	list, err := s.repo.ListChannelBotEstimates(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list channel bot estimates failed", err)
+		return nil, err
+	}
+
+	return list, nil
+}
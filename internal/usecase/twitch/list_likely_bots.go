@@ -0,0 +1,21 @@
+package twitch
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListLikelyBots returns up to limit users flagged by bot detection, those present in the most channels first.
+func (s *Usecase) ListLikelyBots(ctx context.Context, limit int) ([]entity.LikelyBot, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.list_likely_bots")
+	defer span.End()
+
+	list, err := s.repo.ListLikelyBots(ctx, limit)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list likely bots failed", err)
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,21 @@
+package twitch
+
+import (
+	"context"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListSuspicionEvents returns the suspicion audit log (newest first), optionally for one user.
+func (s *Usecase) ListSuspicionEvents(ctx context.Context, f entity.SuspicionEventListFilter) ([]entity.SuspicionEvent, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.list_suspicion_events")
+	defer span.End()
+
+	list, err := s.repo.ListSuspicionEvents(ctx, f)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list suspicion events failed", err)
+		return nil, err
+	}
+
+	return list, nil
+}
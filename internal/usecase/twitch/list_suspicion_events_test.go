@@ -0,0 +1,39 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_ListSuspicionEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, nil, testTwitchCfg("id", "secret"), obs)
+
+	uid := int64(5)
+	f := entity.SuspicionEventListFilter{TwitchUserID: &uid, Source: entity.SuspicionSourceAuto, Limit: 10}
+
+	repo.EXPECT().ListSuspicionEvents(gomock.Any(), f).Return([]entity.SuspicionEvent{
+		{ID: 1, TwitchUserID: 5, Source: entity.SuspicionSourceAuto, NewIsSus: true},
+	}, nil)
+
+	list, err := svc.ListSuspicionEvents(context.Background(), f)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.True(t, list[0].NewIsSus)
+}
@@ -0,0 +1,23 @@
+package twitch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// ListUserLinks returns confirmed and rejected alt links of a user.
+func (s *Usecase) ListUserLinks(ctx context.Context, id int64) ([]entity.UserLink, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.list_user_links")
+	defer span.End()
+
+	list, err := s.repo.ListUserLinks(ctx, id)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list user links failed", err, zap.Int64("id", id))
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,40 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestListUserLinksAndAltCandidates(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	links := []entity.UserLink{{TwitchUserID: 4, LinkedUserID: 9, Status: entity.UserLinkRejected}}
+	cands := []entity.AltCandidate{{TwitchUserID: 4, CandidateUserID: 7, Score: 50}}
+
+	repo.EXPECT().ListUserLinks(gomock.Any(), int64(4)).Return(links, nil)
+	repo.EXPECT().ListAltCandidates(gomock.Any(), int64(4), altCandidatesKept).Return(cands, nil)
+
+	gotLinks, err := svc.ListUserLinks(context.Background(), 4)
+	require.NoError(t, err)
+	require.Equal(t, links, gotLinks)
+
+	gotCands, err := svc.ListAltCandidates(context.Background(), 4)
+	require.NoError(t, err)
+	require.Equal(t, cands, gotCands)
+}
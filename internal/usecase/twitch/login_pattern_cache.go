@@ -0,0 +1,52 @@
+package twitch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// compiledLoginPatterns returns the login pattern blacklist compiled once per change. Stored patterns that no
+// longer compile are logged when the list is loaded and left out until it changes. The list is loaded without
+// holding the lock; a result that raced an invalidation is returned but not cached.
+func (s *Usecase) compiledLoginPatterns(ctx context.Context) ([]entity.CompiledLoginPattern, error) {
+	s.loginPatternsMu.Lock()
+	cached, loaded, gen := s.loginPatterns, s.loginPatternsLoaded, s.loginPatternsGen
+	s.loginPatternsMu.Unlock()
+
+	if loaded {
+		return cached, nil
+	}
+
+	list, err := s.repo.ListLoginPatterns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := entity.CompileLoginPatterns(list)
+	if err != nil {
+		s.obs.Logger.Warn("invalid stored login patterns skipped", zap.Error(err))
+	}
+
+	s.loginPatternsMu.Lock()
+	defer s.loginPatternsMu.Unlock()
+
+	if s.loginPatternsGen == gen {
+		s.loginPatterns = compiled
+		s.loginPatternsLoaded = true
+	}
+
+	return compiled, nil
+}
+
+// InvalidateLoginPatterns makes the next check reload the login pattern blacklist.
+func (s *Usecase) InvalidateLoginPatterns() {
+	s.loginPatternsMu.Lock()
+	defer s.loginPatternsMu.Unlock()
+
+	s.loginPatterns = nil
+	s.loginPatternsLoaded = false
+	s.loginPatternsGen++
+}
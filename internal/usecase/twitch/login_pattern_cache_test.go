@@ -0,0 +1,79 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestCompiledLoginPatterns_cachedUntilInvalidated(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	core, logs := observer.New(zap.WarnLevel)
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.New(core), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	repo.EXPECT().ListLoginPatterns(gomock.Any()).Return([]entity.LoginPattern{
+		{ID: 1, Kind: entity.LoginPatternRegex, Pattern: "("},
+		{ID: 2, Kind: entity.LoginPatternGlob, Pattern: "bot*"},
+	}, nil)
+
+	for range 3 {
+		list, err := svc.compiledLoginPatterns(context.Background())
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, int64(2), list[0].ID)
+	}
+
+	assert.Equal(t, 1, logs.FilterMessage("invalid stored login patterns skipped").Len(), "reported once per load")
+
+	svc.InvalidateLoginPatterns()
+
+	repo.EXPECT().ListLoginPatterns(gomock.Any()).Return(nil, nil)
+
+	list, err := svc.compiledLoginPatterns(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+
+func TestCompiledLoginPatterns_invalidatedDuringLoadNotCached(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	// The pattern is deleted while the first load is in flight, so its stale result must not be cached.
+	repo.EXPECT().ListLoginPatterns(gomock.Any()).DoAndReturn(func(context.Context) ([]entity.LoginPattern, error) {
+		svc.InvalidateLoginPatterns()
+		return []entity.LoginPattern{{ID: 1, Kind: entity.LoginPatternGlob, Pattern: "bot*"}}, nil
+	})
+
+	list, err := svc.compiledLoginPatterns(context.Background())
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	repo.EXPECT().ListLoginPatterns(gomock.Any()).Return(nil, nil)
+
+	list, err = svc.compiledLoginPatterns(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,50 @@
+package twitch
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// MarkSusFromRule marks login suspicious for a rule's mark_sus action. Users already suspicious or with automatic
+// suspicion suppressed are left as is (marked=false), so a rule never overrides an operator's decision.
+func (s *Usecase) MarkSusFromRule(ctx context.Context, login, description string, evidence map[string]any) (bool, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.mark_sus_from_rule")
+	defer span.End()
+
+	id, err := s.repo.TwitchUserIDByUsername(ctx, login)
+	if err != nil {
+		return false, err
+	}
+
+	u, err := s.repo.GetTwitchUserByID(ctx, id)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get twitch user failed", err, zap.Int64("id", id))
+		return false, err
+	}
+
+	if u.IsSus || u.SusAutoSuppressed {
+		return false, nil
+	}
+
+	sus := true
+	susType := entity.SusTypeManual
+
+	out, err := s.repo.PatchTwitchUser(ctx, id, entity.TwitchUserPatch{
+		IsSus:          &sus,
+		SusType:        &susType,
+		SusDescription: &description,
+		SusSource:      entity.SuspicionSourceRule,
+		SusEvidence:    evidence,
+	})
+	if err != nil {
+		s.obs.LogError(ctx, span, "mark sus from rule failed", err, zap.Int64("id", id))
+		return false, err
+	}
+
+	s.BroadcastTwitchUserSuspicion(out)
+
+	return true, nil
+}
@@ -0,0 +1,65 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestMarkSusFromRule(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	evidence := map[string]any{"rule_id": int64(3)}
+
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "spammer").Return(int64(9), nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(9)).Return(entity.TwitchUser{ID: 9, Username: "spammer"}, nil)
+	repo.EXPECT().PatchTwitchUser(gomock.Any(), int64(9), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, p entity.TwitchUserPatch) (entity.TwitchUser, error) {
+			require.NotNil(t, p.IsSus)
+			assert.True(t, *p.IsSus)
+			assert.Equal(t, entity.SusTypeManual, *p.SusType)
+			assert.Equal(t, "marked by rule", *p.SusDescription)
+			assert.Equal(t, entity.SuspicionSourceRule, p.SusSource)
+			assert.Equal(t, evidence, p.SusEvidence)
+
+			return entity.TwitchUser{ID: 9, Username: "spammer", IsSus: true}, nil
+		})
+
+	marked, err := svc.MarkSusFromRule(context.Background(), "spammer", "marked by rule", evidence)
+	require.NoError(t, err)
+	assert.True(t, marked)
+}
+
+func TestMarkSusFromRule_respectsSuppressed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	repo.EXPECT().TwitchUserIDByUsername(gomock.Any(), "friend").Return(int64(4), nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(4)).Return(entity.TwitchUser{ID: 4, Username: "friend", SusAutoSuppressed: true}, nil)
+
+	marked, err := svc.MarkSusFromRule(context.Background(), "friend", "x", nil)
+	require.NoError(t, err)
+	assert.False(t, marked)
+}
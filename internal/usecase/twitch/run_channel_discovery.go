@@ -85,12 +85,19 @@ func (s *Usecase) RunChannelDiscovery(ctx context.Context) error {
 				continue
 			}
 
-			if _, err := s.repo.UpsertTwitchUserFromChat(ctx, row.UserID, row.UserLogin); err != nil {
+			inserted, err := s.repo.UpsertTwitchUserFromChat(ctx, row.UserID, row.UserLogin)
+			if err != nil {
 				s.obs.Logger.Warn("discovery upsert twitch user failed",
 					zap.Error(err), zap.Int64("twitch_user_id", row.UserID), zap.String("login", row.UserLogin))
 				continue
 			}
 
+			if inserted {
+				if err := s.checkIdentityLists(ctx, row.UserID); err != nil {
+					s.obs.Logger.Debug("discovery identity check failed", zap.Error(err), zap.Int64("twitch_user_id", row.UserID))
+				}
+			}
+
 			var titlePtr, gamePtr *string
 
 			if t := strings.TrimSpace(row.Title); t != "" {
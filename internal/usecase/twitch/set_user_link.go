@@ -0,0 +1,45 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// SetUserLink confirms or rejects that two users are the same person. actorUserID is the reviewing app user.
+func (s *Usecase) SetUserLink(ctx context.Context, userID, linkedUserID int64, status, note string, actorUserID *int64) (entity.UserLink, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.set_user_link")
+	defer span.End()
+
+	if userID == linkedUserID {
+		return entity.UserLink{}, fmt.Errorf("%w: a user cannot be linked to itself", entity.ErrInvalidUserLink)
+	}
+
+	if status != entity.UserLinkConfirmed && status != entity.UserLinkRejected {
+		return entity.UserLink{}, fmt.Errorf("%w: unknown status %q", entity.ErrInvalidUserLink, status)
+	}
+
+	for _, id := range []int64{userID, linkedUserID} {
+		if _, err := s.repo.GetTwitchUserByID(ctx, id); err != nil {
+			return entity.UserLink{}, err
+		}
+	}
+
+	link, err := s.repo.UpsertUserLink(ctx, entity.UserLink{
+		TwitchUserID:    userID,
+		LinkedUserID:    linkedUserID,
+		Status:          status,
+		Note:            strings.TrimSpace(note),
+		DecidedByUserID: actorUserID,
+	})
+	if err != nil {
+		s.obs.LogError(ctx, span, "upsert user link failed", err, zap.Int64("id", userID), zap.Int64("linked_id", linkedUserID))
+		return entity.UserLink{}, err
+	}
+
+	return link, nil
+}
@@ -0,0 +1,65 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestSetUserLink(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	actor := int64(1)
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(4)).Return(entity.TwitchUser{ID: 4}, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(9)).Return(entity.TwitchUser{ID: 9}, nil)
+	repo.EXPECT().UpsertUserLink(gomock.Any(), entity.UserLink{
+		TwitchUserID:    4,
+		LinkedUserID:    9,
+		Status:          entity.UserLinkConfirmed,
+		Note:            "same typos",
+		DecidedByUserID: &actor,
+	}).Return(entity.UserLink{TwitchUserID: 4, LinkedUserID: 9, LinkedUsername: "alt", Status: entity.UserLinkConfirmed}, nil)
+
+	out, err := svc.SetUserLink(context.Background(), 4, 9, entity.UserLinkConfirmed, "  same typos ", &actor)
+	require.NoError(t, err)
+	assert.Equal(t, "alt", out.LinkedUsername)
+}
+
+func TestSetUserLink_invalid(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	_, err := svc.SetUserLink(context.Background(), 4, 4, entity.UserLinkConfirmed, "", nil)
+	require.ErrorIs(t, err, entity.ErrInvalidUserLink)
+
+	_, err = svc.SetUserLink(context.Background(), 4, 9, "maybe", "", nil)
+	require.ErrorIs(t, err, entity.ErrInvalidUserLink)
+
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(4)).Return(entity.TwitchUser{}, entity.ErrTwitchUserNotFound)
+
+	_, err = svc.SetUserLink(context.Background(), 4, 9, entity.UserLinkRejected, "", nil)
+	require.ErrorIs(t, err, entity.ErrTwitchUserNotFound)
+}
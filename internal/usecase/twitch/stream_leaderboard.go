@@ -2,6 +2,8 @@ package twitch
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/rofleksey/dredge/internal/entity"
@@ -52,6 +54,10 @@ func (s *Usecase) StreamLeaderboard(ctx context.Context, stream entity.Stream, s
 		ids[id] = struct{}{}
 	}
 
+	for id := range s.likelyBotsExcluded(ctx, slices.Collect(maps.Keys(ids))) {
+		delete(ids, id)
+	}
+
 	out := make([]entity.StreamLeaderboardRow, 0, len(ids))
 
 	for id := range ids {
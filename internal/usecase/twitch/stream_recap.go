@@ -0,0 +1,162 @@
+package twitch
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// streamRecapTopChatters is how many leaderboard chatters a recap lists.
+	streamRecapTopChatters = 5
+	// streamRecapMoments is how many busiest chat windows a recap lists.
+	streamRecapMoments = 3
+	// streamRecapMomentWindow is the width of a recap's busiest chat windows.
+	streamRecapMomentWindow = 5 * time.Minute
+	// streamRecapSummaryTimeout bounds the optional LLM call so a slow provider cannot hold a recap back.
+	streamRecapSummaryTimeout = 2 * time.Minute
+)
+
+// StreamRecapSummarizer writes a short summary of a finished stream's recap, or "" when AI is not configured
+// (implemented by *ai.Usecase).
+type StreamRecapSummarizer interface {
+	SummarizeStreamRecap(ctx context.Context, st entity.Stream, recap entity.StreamRecap) (string, error)
+}
+
+// StreamRecapNotifier delivers a recap to notification entries as a stream_end event (implemented by
+// *notify.Dispatcher).
+type StreamRecapNotifier interface {
+	NotifyStreamRecap(ctx context.Context, channel, text string)
+}
+
+// SetStreamRecapSummarizer enables AI summaries in stream recaps.
+func (s *Usecase) SetStreamRecapSummarizer(sm StreamRecapSummarizer) {
+	s.recapSummarizer = sm
+}
+
+// SetStreamRecapNotifier enables stream recap notifications.
+func (s *Usecase) SetStreamRecapNotifier(n StreamRecapNotifier) {
+	s.recapNotifier = n
+}
+
+// StreamRecap returns the stored recap of a stream (nil while the stream is live or when none was computed).
+func (s *Usecase) StreamRecap(ctx context.Context, streamID int64) (*entity.StreamRecap, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.stream_recap")
+	defer span.End()
+
+	out, err := s.repo.GetStreamRecap(ctx, streamID)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get stream recap failed", err, zap.Int64("stream_id", streamID))
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// BuildStreamRecap computes the recap of a stream up to its end (or now while live). Top chatters and suspicious
+// users come from the stream leaderboard, so they follow its likely-bot exclusion.
+func (s *Usecase) BuildStreamRecap(ctx context.Context, st entity.Stream) (entity.StreamRecap, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.build_stream_recap")
+	defer span.End()
+
+	end := time.Now().UTC()
+	if st.EndedAt != nil {
+		end = *st.EndedAt
+	}
+
+	recap, err := s.repo.GetStreamRecapStats(ctx, st.ID)
+	if err != nil {
+		s.obs.LogError(ctx, span, "stream recap stats failed", err, zap.Int64("stream_id", st.ID))
+		return entity.StreamRecap{}, err
+	}
+
+	recap.DurationSeconds = int64(end.Sub(st.StartedAt).Seconds())
+	recap.CreatedAt = time.Now().UTC()
+
+	board, err := s.StreamLeaderboard(ctx, st, entity.StreamLeaderboardSortMessagesDesc, "")
+	if err != nil {
+		return entity.StreamRecap{}, err
+	}
+
+	ids := make([]int64, 0, len(board))
+
+	for _, row := range board {
+		ids = append(ids, row.UserTwitchID)
+
+		if row.MessageCount > 0 && len(recap.TopChatters) < streamRecapTopChatters {
+			recap.TopChatters = append(recap.TopChatters, entity.StreamRecapChatter{
+				UserTwitchID:    row.UserTwitchID,
+				Login:           row.Login,
+				MessageCount:    row.MessageCount,
+				PresenceSeconds: row.PresenceSeconds,
+			})
+		}
+	}
+
+	if recap.SuspiciousUsers, err = s.repo.ListSuspiciousTwitchUsersByIDs(ctx, ids); err != nil {
+		s.obs.LogError(ctx, span, "suspicious stream users failed", err, zap.Int64("stream_id", st.ID))
+		return entity.StreamRecap{}, err
+	}
+
+	if recap.RuleTriggers, err = s.repo.CountChannelRuleTriggers(ctx, st.ChannelLogin, st.StartedAt, end); err != nil {
+		s.obs.LogError(ctx, span, "stream rule triggers failed", err, zap.Int64("stream_id", st.ID))
+		return entity.StreamRecap{}, err
+	}
+
+	recap.BusiestMoments, err = s.repo.ListStreamBusiestMoments(ctx, st.ID, st.StartedAt, streamRecapMomentWindow, streamRecapMoments)
+	if err != nil {
+		s.obs.LogError(ctx, span, "stream busiest moments failed", err, zap.Int64("stream_id", st.ID))
+		return entity.StreamRecap{}, err
+	}
+
+	return recap, nil
+}
+
+// recordStreamRecap builds and stores the recap of a stream that was just closed, then adds the AI summary and
+// sends the notification the recap settings ask for. A failed summary still stores the recap without one.
+func (s *Usecase) recordStreamRecap(ctx context.Context, streamID int64) error {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.record_stream_recap")
+	defer span.End()
+
+	st, err := s.repo.GetStreamByID(ctx, streamID)
+	if err != nil {
+		s.obs.LogError(ctx, span, "get stream for recap failed", err, zap.Int64("stream_id", streamID))
+		return err
+	}
+
+	recap, err := s.BuildStreamRecap(ctx, st)
+	if err != nil {
+		return err
+	}
+
+	settings, err := s.repo.GetStreamRecapSettings(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "stream recap settings failed", err)
+		return err
+	}
+
+	if settings.AISummary && s.recapSummarizer != nil {
+		sumCtx, cancel := context.WithTimeout(ctx, streamRecapSummaryTimeout)
+		summary, err := s.recapSummarizer.SummarizeStreamRecap(sumCtx, st, recap)
+		cancel()
+
+		if err != nil {
+			s.obs.Logger.Warn("stream recap summary failed", zap.Error(err), zap.Int64("stream_id", streamID))
+		} else {
+			recap.Summary = summary
+		}
+	}
+
+	if err := s.repo.SaveStreamRecap(ctx, recap); err != nil {
+		return err
+	}
+
+	if settings.Notify && s.recapNotifier != nil {
+		s.recapNotifier.NotifyStreamRecap(ctx, st.ChannelLogin, recap.DigestText(st.ChannelLogin))
+	}
+
+	return nil
+}
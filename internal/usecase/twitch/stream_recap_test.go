@@ -0,0 +1,126 @@
+package twitch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+type fakeRecapSummarizer struct {
+	summary string
+	err     error
+}
+
+func (f fakeRecapSummarizer) SummarizeStreamRecap(context.Context, entity.Stream, entity.StreamRecap) (string, error) {
+	return f.summary, f.err
+}
+
+type fakeRecapNotifier struct {
+	channel, text string
+}
+
+func (f *fakeRecapNotifier) NotifyStreamRecap(_ context.Context, channel, text string) {
+	f.channel, f.text = channel, text
+}
+
+// expectStreamRecapBuild mocks the repository reads of BuildStreamRecap for a stream with two chatters.
+func expectStreamRecapBuild(repo *repomocks.MockStore, st entity.Stream) {
+	susType := entity.SusTypeManual
+
+	repo.EXPECT().GetStreamRecapStats(gomock.Any(), st.ID).Return(entity.StreamRecap{
+		StreamID: st.ID, PeakViewers: entity.ToPointer(int64(80)), Messages: 9, UniqueChatters: 2, NewChatters: 1,
+	}, nil)
+	repo.EXPECT().ListUserActivityEventsForChannelPresence(gomock.Any(), st.ChannelTwitchUserID, gomock.Any(), gomock.Any()).Return(nil, nil)
+	repo.EXPECT().CountChatMessagesPerChatterForStream(gomock.Any(), st.ID).Return(map[int64]int64{5: 7, 6: 2}, nil)
+	repo.EXPECT().GetBotDetectionSettings(gomock.Any()).Return(entity.BotDetectionSettings{}, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(5)).Return(entity.TwitchUser{ID: 5, Username: "alice"}, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(6)).Return(entity.TwitchUser{ID: 6, Username: "spam_1234"}, nil)
+	repo.EXPECT().GetHelixMeta(gomock.Any(), gomock.Any()).Return(nil, nil, nil, nil).Times(2)
+	repo.EXPECT().ListSuspiciousTwitchUsersByIDs(gomock.Any(), gomock.InAnyOrder([]int64{5, 6})).
+		Return([]entity.StreamRecapUser{{UserTwitchID: 6, Login: "spam_1234", SusType: &susType}}, nil)
+	repo.EXPECT().CountChannelRuleTriggers(gomock.Any(), st.ChannelLogin, st.StartedAt, *st.EndedAt).
+		Return([]entity.StreamRecapRule{{RuleName: "links", Triggers: 2}}, nil)
+	repo.EXPECT().ListStreamBusiestMoments(gomock.Any(), st.ID, st.StartedAt, streamRecapMomentWindow, streamRecapMoments).
+		Return([]entity.StreamRecapMoment{{Start: st.StartedAt, Messages: 9, Chatters: 2}}, nil)
+}
+
+func TestUsecase_recordStreamRecap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	notifier := &fakeRecapNotifier{}
+	svc.SetStreamRecapSummarizer(fakeRecapSummarizer{summary: "A quiet stream."})
+	svc.SetStreamRecapNotifier(notifier)
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(90 * time.Minute)
+	st := entity.Stream{ID: 7, ChannelTwitchUserID: 9, ChannelLogin: "streamer", StartedAt: start, EndedAt: &end}
+
+	repo.EXPECT().GetStreamByID(gomock.Any(), int64(7)).Return(st, nil)
+	expectStreamRecapBuild(repo, st)
+	repo.EXPECT().GetStreamRecapSettings(gomock.Any()).Return(entity.StreamRecapSettings{AISummary: true, Notify: true}, nil)
+
+	var saved entity.StreamRecap
+
+	repo.EXPECT().SaveStreamRecap(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r entity.StreamRecap) error {
+		saved = r
+		return nil
+	})
+
+	require.NoError(t, svc.recordStreamRecap(context.Background(), 7))
+
+	assert.Equal(t, int64(7), saved.StreamID)
+	assert.Equal(t, int64(90*60), saved.DurationSeconds)
+	assert.Equal(t, int64(80), *saved.PeakViewers)
+	assert.Equal(t, []entity.StreamRecapChatter{
+		{UserTwitchID: 5, Login: "alice", MessageCount: 7},
+		{UserTwitchID: 6, Login: "spam_1234", MessageCount: 2},
+	}, saved.TopChatters)
+	require.Len(t, saved.SuspiciousUsers, 1)
+	assert.Equal(t, "links", saved.RuleTriggers[0].RuleName)
+	assert.Equal(t, "A quiet stream.", saved.Summary)
+
+	assert.Equal(t, "streamer", notifier.channel)
+	assert.Equal(t, saved.DigestText("streamer"), notifier.text)
+}
+
+func TestUsecase_recordStreamRecap_summaryFailureStillSaves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	notifier := &fakeRecapNotifier{}
+	svc.SetStreamRecapSummarizer(fakeRecapSummarizer{err: errors.New("llm down")})
+	svc.SetStreamRecapNotifier(notifier)
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	st := entity.Stream{ID: 7, ChannelTwitchUserID: 9, ChannelLogin: "streamer", StartedAt: start, EndedAt: &end}
+
+	repo.EXPECT().GetStreamByID(gomock.Any(), int64(7)).Return(st, nil)
+	expectStreamRecapBuild(repo, st)
+	repo.EXPECT().GetStreamRecapSettings(gomock.Any()).Return(entity.StreamRecapSettings{AISummary: true}, nil)
+	repo.EXPECT().SaveStreamRecap(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r entity.StreamRecap) error {
+		assert.Empty(t, r.Summary)
+		return nil
+	})
+
+	require.NoError(t, svc.recordStreamRecap(context.Background(), 7))
+	assert.Empty(t, notifier.text, "notifications are off")
+}
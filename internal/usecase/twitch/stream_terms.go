@@ -0,0 +1,181 @@
+package twitch
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// defaultTrendingTermsLimit is how many terms trending queries return when no limit is given.
+	defaultTrendingTermsLimit = 25
+	// trendingTermsBaselineStreams is how many of the channel's latest computed streams form the IDF baseline.
+	trendingTermsBaselineStreams = 30
+)
+
+// StreamTrendingTerms ranks the terms of a stream's chat in [from, to) (default the whole stream) by TF-IDF: the
+// share of the window's messages using the term, weighted by how rare it is across the channel's baseline streams.
+func (s *Usecase) StreamTrendingTerms(ctx context.Context, st entity.Stream, from, to *time.Time, limit int) (entity.TrendingTerms, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.stream_trending_terms")
+	defer span.End()
+
+	start, end := st.StartedAt, time.Now().UTC()
+	if st.EndedAt != nil {
+		end = *st.EndedAt
+	}
+
+	if from != nil && from.After(start) {
+		start = from.UTC()
+	}
+
+	if to != nil && to.Before(end) {
+		end = to.UTC()
+	}
+
+	if !start.Before(end) {
+		return entity.TrendingTerms{}, fmt.Errorf("%w: window is outside the stream or from is not before to", entity.ErrInvalidTermQuery)
+	}
+
+	if limit <= 0 {
+		limit = defaultTrendingTermsLimit
+	}
+
+	// Unset bounds stay open so messages logged just outside the recorded session still count.
+	var lo, hi *time.Time
+	if from != nil {
+		lo = &start
+	}
+
+	if to != nil {
+		hi = &end
+	}
+
+	messages, err := s.repo.ListStreamTermMessages(ctx, st.ID, lo, hi, "")
+	if err != nil {
+		s.obs.LogError(ctx, span, "list stream term messages failed", err, zap.Int64("stream_id", st.ID))
+		return entity.TrendingTerms{}, err
+	}
+
+	counts := frequentTerms(countTerms(messages))
+
+	terms := make([]entity.ChatTerm, len(counts))
+	for i, c := range counts {
+		terms[i] = entity.ChatTerm{Text: c.Term, Emote: c.Emote}
+	}
+
+	baseline, df, err := s.repo.CountBaselineTerms(ctx, st.ChannelTwitchUserID, st.ID, trendingTermsBaselineStreams, terms)
+	if err != nil {
+		s.obs.LogError(ctx, span, "count baseline terms failed", err, zap.Int64("stream_id", st.ID))
+		return entity.TrendingTerms{}, err
+	}
+
+	out := entity.TrendingTerms{
+		StreamID:        st.ID,
+		From:            start,
+		To:              end,
+		Messages:        len(messages),
+		BaselineStreams: baseline,
+		Terms:           make([]entity.TrendingTerm, 0, len(counts)),
+	}
+
+	for i, c := range counts {
+		words := 1
+		if !c.Emote {
+			words = strings.Count(c.Term, " ") + 1
+		}
+
+		out.Terms = append(out.Terms, entity.TrendingTerm{
+			Term:            c.Term,
+			Emote:           c.Emote,
+			Words:           words,
+			Messages:        c.Messages,
+			Chatters:        c.Chatters,
+			BaselineStreams: df[terms[i]],
+			Score:           float64(c.Messages) / float64(len(messages)) * termIDF(baseline, df[terms[i]]),
+		})
+	}
+
+	slices.SortFunc(out.Terms, func(a, b entity.TrendingTerm) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(b.Messages, a.Messages); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Term, b.Term)
+	})
+
+	if len(out.Terms) > limit {
+		out.Terms = out.Terms[:limit]
+	}
+
+	return out, nil
+}
+
+// StreamTermSeries counts the messages using term in each bucket of a stream, with buckets of whole minutes sized
+// like the stream emote timeline. Emote codes match case-insensitively.
+func (s *Usecase) StreamTermSeries(ctx context.Context, st entity.Stream, term string) (entity.TermSeries, error) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.stream_term_series")
+	defer span.End()
+
+	q, err := normalizeTermQuery(term)
+	if err != nil {
+		return entity.TermSeries{}, err
+	}
+
+	end := time.Now().UTC()
+	if st.EndedAt != nil {
+		end = *st.EndedAt
+	}
+
+	// Every match contains the query's first word, so the database can drop most messages up front.
+	first, _, _ := strings.Cut(q, " ")
+
+	messages, err := s.repo.ListStreamTermMessages(ctx, st.ID, nil, nil, first)
+	if err != nil {
+		s.obs.LogError(ctx, span, "list stream term messages failed", err, zap.Int64("stream_id", st.ID))
+		return entity.TermSeries{}, err
+	}
+
+	bucket := streamTimelineBucket(end.Sub(st.StartedAt))
+	out := entity.TermSeries{StreamID: st.ID, Term: q, BucketSeconds: int(bucket.Seconds())}
+
+	var hits []int
+
+	for _, m := range messages {
+		if !slices.ContainsFunc(messageTerms(m.Body, m.Emotes), func(t entity.ChatTerm) bool {
+			return strings.EqualFold(t.Text, q)
+		}) {
+			continue
+		}
+
+		out.Messages++
+
+		hits = append(hits, max(int(m.CreatedAt.Sub(st.StartedAt)/bucket), 0))
+	}
+
+	n := int((end.Sub(st.StartedAt) + bucket - 1) / bucket)
+	for _, i := range hits {
+		n = max(n, i+1)
+	}
+
+	out.Points = make([]entity.TermSeriesPoint, max(n, 1))
+	for i := range out.Points {
+		out.Points[i].Start = st.StartedAt.Add(time.Duration(i) * bucket)
+	}
+
+	for _, i := range hits {
+		out.Points[i].Messages++
+	}
+
+	return out, nil
+}
@@ -0,0 +1,88 @@
+package twitch
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+const (
+	// streamTermsRollupInterval is how often term counts are computed for newly ended streams.
+	streamTermsRollupInterval = 10 * time.Minute
+	// streamTermsRollupBatch bounds how many streams one pass loads.
+	streamTermsRollupBatch = 20
+	// streamTermsMinMessages is how many messages must use a term before it is stored or ranked.
+	streamTermsMinMessages = 2
+)
+
+// RunStreamTermsRollup computes and stores the term counts of every ended stream that has none yet, which become
+// the channel baseline of trending-term scoring. Most recently ended streams go first.
+func (s *Usecase) RunStreamTermsRollup(ctx context.Context) error {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.run_stream_terms_rollup")
+	defer span.End()
+
+	for {
+		streams, err := s.repo.ListStreamsPendingTerms(ctx, streamTermsRollupBatch)
+		if err != nil {
+			s.obs.LogError(ctx, span, "list streams pending terms failed", err)
+			return err
+		}
+
+		for _, st := range streams {
+			if err := s.rollupStreamTerms(ctx, st.ID); err != nil {
+				s.obs.LogError(ctx, span, "stream terms rollup failed", err, zap.Int64("stream_id", st.ID))
+				return err
+			}
+		}
+
+		if len(streams) < streamTermsRollupBatch {
+			return nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Usecase) rollupStreamTerms(ctx context.Context, streamID int64) error {
+	messages, err := s.repo.ListStreamTermMessages(ctx, streamID, nil, nil, "")
+	if err != nil {
+		return err
+	}
+
+	return s.repo.ReplaceStreamTerms(ctx, streamID, len(messages), frequentTerms(countTerms(messages)))
+}
+
+// frequentTerms keeps the terms used in at least streamTermsMinMessages messages.
+func frequentTerms(counts []entity.TermCount) []entity.TermCount {
+	out := counts[:0]
+
+	for _, c := range counts {
+		if c.Messages >= streamTermsMinMessages {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
+
+// StartStreamTermsLoop runs RunStreamTermsRollup every streamTermsRollupInterval until ctx is done.
+func (s *Usecase) StartStreamTermsLoop(ctx context.Context) {
+	ticker := time.NewTicker(streamTermsRollupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RunStreamTermsRollup(ctx); err != nil {
+				s.obs.Logger.Warn("stream terms rollup failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestUsecase_RunStreamTermsRollup(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	chatter := int64(5)
+
+	repo.EXPECT().ListStreamsPendingTerms(gomock.Any(), streamTermsRollupBatch).Return([]entity.Stream{{ID: 7}, {ID: 8}}, nil)
+	repo.EXPECT().ListStreamTermMessages(gomock.Any(), int64(7), nil, nil, "").Return([]entity.TermMessage{
+		{ChatterTwitchUserID: &chatter, Body: "speedrun pb"},
+		{ChatterTwitchUserID: &chatter, Body: "pb pb pb"},
+	}, nil)
+	repo.EXPECT().ReplaceStreamTerms(gomock.Any(), int64(7), 2, []entity.TermCount{
+		{Term: "pb", Messages: 2, Chatters: 1},
+	}).Return(nil)
+	repo.EXPECT().ListStreamTermMessages(gomock.Any(), int64(8), nil, nil, "").Return(nil, nil)
+	repo.EXPECT().ReplaceStreamTerms(gomock.Any(), int64(8), 0, []entity.TermCount{}).Return(nil)
+
+	require.NoError(t, svc.RunStreamTermsRollup(context.Background()))
+}
@@ -0,0 +1,107 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+)
+
+func TestService_StreamTrendingTerms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	st := entity.Stream{ID: 7, ChannelTwitchUserID: 9, StartedAt: start, EndedAt: &end}
+	from := start.Add(30 * time.Minute)
+
+	repo.EXPECT().ListStreamTermMessages(gomock.Any(), int64(7), &from, nil, "").Return([]entity.TermMessage{
+		{Body: "hello chat"},
+		{Body: "hello new record"},
+		{Body: "new record Kappa", Emotes: []entity.ChatEmote{{Code: "Kappa", Start: 11, End: 15}}},
+		{Body: "Kappa", Emotes: []entity.ChatEmote{{Code: "Kappa", Start: 0, End: 4}}},
+	}, nil)
+	repo.EXPECT().CountBaselineTerms(gomock.Any(), int64(9), int64(7), trendingTermsBaselineStreams, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _ int64, _ int, terms []entity.ChatTerm) (int, map[entity.ChatTerm]int, error) {
+			assert.ElementsMatch(t, []entity.ChatTerm{
+				{Text: "hello"}, {Text: "new"}, {Text: "record"}, {Text: "new record"}, {Text: "Kappa", Emote: true},
+			}, terms)
+
+			// "hello" and Kappa are everyday chat in this channel, "record" is not.
+			return 10, map[entity.ChatTerm]int{{Text: "hello"}: 10, {Text: "Kappa", Emote: true}: 9, {Text: "new"}: 4}, nil
+		})
+
+	out, err := svc.StreamTrendingTerms(context.Background(), st, &from, nil, 3)
+	require.NoError(t, err)
+	assert.Equal(t, from, out.From)
+	assert.Equal(t, end, out.To)
+	assert.Equal(t, 4, out.Messages)
+	assert.Equal(t, 10, out.BaselineStreams)
+	require.Len(t, out.Terms, 3)
+	assert.Equal(t, "new record", out.Terms[0].Term)
+	assert.Equal(t, 2, out.Terms[0].Words)
+	assert.Equal(t, "record", out.Terms[1].Term)
+	assert.Equal(t, "new", out.Terms[2].Term)
+	assert.Equal(t, 4, out.Terms[2].BaselineStreams)
+	assert.InDelta(t, 0.5*termIDF(10, 0), out.Terms[0].Score, 1e-9)
+}
+
+func TestService_StreamTrendingTerms_invalidWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	before := start.Add(-time.Minute)
+
+	_, err := svc.StreamTrendingTerms(context.Background(), entity.Stream{ID: 7, StartedAt: start, EndedAt: &end}, nil, &before, 0)
+	require.ErrorIs(t, err, entity.ErrInvalidTermQuery)
+}
+
+func TestService_StreamTermSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")})
+
+	start := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Minute)
+	st := entity.Stream{ID: 7, StartedAt: start, EndedAt: &end}
+
+	repo.EXPECT().ListStreamTermMessages(gomock.Any(), int64(7), nil, nil, "new").Return([]entity.TermMessage{
+		{CreatedAt: start.Add(10 * time.Second), Body: "NEW RECORD!!"},
+		{CreatedAt: start.Add(20 * time.Second), Body: "new game record"},
+		{CreatedAt: start.Add(150 * time.Second), Body: "new record new record"},
+	}, nil)
+
+	out, err := svc.StreamTermSeries(context.Background(), st, "  New   Record ")
+	require.NoError(t, err)
+	assert.Equal(t, "new record", out.Term)
+	assert.Equal(t, 60, out.BucketSeconds)
+	assert.Equal(t, 2, out.Messages)
+	assert.Equal(t, []entity.TermSeriesPoint{
+		{Start: start, Messages: 1},
+		{Start: start.Add(time.Minute)},
+		{Start: start.Add(2 * time.Minute), Messages: 1},
+	}, out.Points)
+
+	_, err = svc.StreamTermSeries(context.Background(), st, "one two three four")
+	require.ErrorIs(t, err, entity.ErrInvalidTermQuery)
+}
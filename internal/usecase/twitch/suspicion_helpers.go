@@ -2,37 +2,78 @@ package twitch
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/rofleksey/dredge/internal/entity"
 )
 
+// firstMessagesScanned caps how many first-in-channel messages are checked for links.
+const firstMessagesScanned = 20
+
 func isAutoSusType(t *string) bool {
 	if t == nil {
 		return false
 	}
 
 	switch *t {
-	case entity.SusTypeAutoAge, entity.SusTypeAutoBlacklist, entity.SusTypeAutoLowFollow:
+	case entity.SusTypeAutoAge, entity.SusTypeAutoBlacklist, entity.SusTypeAutoLowFollow, entity.SusTypeAutoScore,
+		entity.SusTypeAutoLoginPattern, entity.SusTypeAutoBlocklist:
 		return true
 	default:
 		return false
 	}
 }
 
+// suspicionGlobals are the inputs shared by every user's evaluation; a bulk re-evaluation loads them once so the
+// whole run scores against the same snapshot.
+type suspicionGlobals struct {
+	ownIDs    []int64
+	settings  entity.SuspicionSettings
+	blacklist map[string]struct{}
+}
+
+func (s *Usecase) loadSuspicionGlobals(ctx context.Context) (suspicionGlobals, error) {
+	ownIDs, err := s.repo.ListLinkedTwitchAccountUserIDs(ctx)
+	if err != nil {
+		return suspicionGlobals{}, err
+	}
+
+	settings, err := s.repo.GetSuspicionSettings(ctx)
+	if err != nil {
+		return suspicionGlobals{}, err
+	}
+
+	blacklist, err := s.repo.ListChannelBlacklist(ctx)
+	if err != nil {
+		return suspicionGlobals{}, err
+	}
+
+	blSet := make(map[string]struct{}, len(blacklist))
+	for _, login := range blacklist {
+		blSet[strings.ToLower(login)] = struct{}{}
+	}
+
+	return suspicionGlobals{ownIDs: ownIDs, settings: settings, blacklist: blSet}, nil
+}
+
 // evaluateSuspicionForUser applies automatic suspicion rules after enrichment data is fresh.
 // gqlTotalCount is total follows from Twitch (may exceed stored rows if pagination capped).
 func (s *Usecase) evaluateSuspicionForUser(ctx context.Context, userID int64, gqlTotalCount int) error {
-	ownIDs, err := s.repo.ListLinkedTwitchAccountUserIDs(ctx)
+	g, err := s.loadSuspicionGlobals(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, id := range ownIDs {
-		if id == userID {
-			return s.clearOwnAccountSuspicion(ctx, userID)
-		}
+	return s.evaluateSuspicionWith(ctx, g, userID, gqlTotalCount)
+}
+
+// evaluateSuspicionWith is evaluateSuspicionForUser with the global inputs already loaded.
+func (s *Usecase) evaluateSuspicionWith(ctx context.Context, g suspicionGlobals, userID int64, gqlTotalCount int) error {
+	if slices.Contains(g.ownIDs, userID) {
+		return s.clearOwnAccountSuspicion(ctx, userID)
 	}
 
 	u, err := s.repo.GetTwitchUserByID(ctx, userID)
@@ -40,32 +81,72 @@ func (s *Usecase) evaluateSuspicionForUser(ctx context.Context, userID int64, gq
 		return err
 	}
 
-	settings, err := s.repo.GetSuspicionSettings(ctx)
+	settings := g.settings
+
+	identity, identified, err := s.matchIdentity(ctx, u)
 	if err != nil {
 		return err
 	}
 
-	blacklist, err := s.repo.ListChannelBlacklist(ctx)
+	follows, err := s.repo.ListUserFollowedChannels(ctx, userID)
 	if err != nil {
 		return err
 	}
 
-	blSet := make(map[string]struct{}, len(blacklist))
-	for _, login := range blacklist {
-		blSet[strings.ToLower(login)] = struct{}{}
+	accountCreated, _, _, err := s.repo.GetHelixMeta(ctx, userID)
+	if err != nil {
+		return err
 	}
 
-	follows, err := s.repo.ListUserFollowedChannels(ctx, userID)
+	firstMessages, err := s.repo.ListChatMessages(ctx, entity.ChatMessageListFilter{
+		ChatterUserID:    &userID,
+		FirstMessageOnly: true,
+		Limit:            firstMessagesScanned,
+	})
 	if err != nil {
 		return err
 	}
 
-	accountCreated, _, _, err := s.repo.GetHelixMeta(ctx, userID)
+	presence, err := s.repo.ListChatterChannelPresence(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	moderation, err := s.repo.CountUserActivityEventsByType(ctx, userID,
+		[]string{entity.UserActivityBan, entity.UserActivityTimeout})
 	if err != nil {
 		return err
 	}
 
-	shouldSus, typ, desc := computeAutoSuspicion(settings, blSet, follows, gqlTotalCount, accountCreated, time.Now().UTC())
+	score := computeSuspicionScore(settings, suspicionInputs{
+		Login:           u.Username,
+		Blacklist:       g.blacklist,
+		Follows:         follows,
+		FollowTotal:     gqlTotalCount,
+		AccountCreated:  accountCreated,
+		FirstMessages:   firstMessages,
+		ChannelCount:    len(presence),
+		ModerationCount: moderation,
+	}, time.Now().UTC())
+	score.TwitchUserID = userID
+
+	if err := s.repo.UpsertSuspicionScore(ctx, score); err != nil {
+		return err
+	}
+
+	shouldSus := suspicious(score)
+	evidence := suspicionEvidence(score)
+
+	st := entity.SusTypeAutoScore
+	sd := describeSuspicionScore(score)
+
+	// A blocklist or login pattern match marks the user regardless of score.
+	if identified {
+		shouldSus = true
+		st = identity.susType
+		sd = identity.description
+		maps.Copy(evidence, identity.evidence)
+	}
 
 	manualLocked := u.SusType != nil && *u.SusType == entity.SusTypeManual && u.IsSus
 
@@ -76,7 +157,7 @@ func (s *Usecase) evaluateSuspicionForUser(ctx context.Context, userID int64, gq
 		}
 		// Predicates no longer match: clear auto-tagged suspicion only.
 		if u.IsSus && isAutoSusType(u.SusType) {
-			return s.applySuspicionPatch(ctx, userID, false, nil, nil)
+			return s.applySuspicionPatch(ctx, userID, false, nil, nil, evidence)
 		}
 		return nil
 	}
@@ -87,14 +168,18 @@ func (s *Usecase) evaluateSuspicionForUser(ctx context.Context, userID int64, gq
 	}
 
 	if shouldSus {
-		st := typ
-		sd := desc
-		return s.applySuspicionPatch(ctx, userID, true, &st, &sd)
+		// Same verdict as before: the stored score already holds today's breakdown, and rewriting the description
+		// (account age, faded scores) on every re-score would only churn the user row.
+		if u.IsSus && u.SusType != nil && *u.SusType == st {
+			return nil
+		}
+
+		return s.applySuspicionPatch(ctx, userID, true, &st, &sd, evidence)
 	}
 
 	// Not suspicious: clear only auto-derived marks.
 	if u.IsSus && isAutoSusType(u.SusType) {
-		return s.applySuspicionPatch(ctx, userID, false, nil, nil)
+		return s.applySuspicionPatch(ctx, userID, false, nil, nil, evidence)
 	}
 
 	return nil
@@ -109,13 +194,14 @@ func (s *Usecase) clearOwnAccountSuspicion(ctx context.Context, userID int64) er
 	if !u.IsSus && u.SusType == nil {
 		return nil
 	}
-	return s.applySuspicionPatch(ctx, userID, false, nil, nil)
+	return s.applySuspicionPatch(ctx, userID, false, nil, nil, map[string]any{"reason": "linked twitch account"})
 }
 
-func (s *Usecase) applySuspicionPatch(ctx context.Context, userID int64, isSus bool, susType *string, susDesc *string) error {
+// applySuspicionPatch sets or clears automatic suspicion; evidence is recorded in the suspicion audit log.
+func (s *Usecase) applySuspicionPatch(ctx context.Context, userID int64, isSus bool, susType *string, susDesc *string, evidence map[string]any) error {
 	empty := ""
 
-	p := entity.TwitchUserPatch{IsSus: &isSus}
+	p := entity.TwitchUserPatch{IsSus: &isSus, SusSource: entity.SuspicionSourceAuto, SusEvidence: evidence}
 	if isSus {
 		p.SusType = susType
 		p.SusDescription = susDesc
@@ -133,41 +219,3 @@ func (s *Usecase) applySuspicionPatch(ctx context.Context, userID int64, isSus b
 
 	return nil
 }
-
-// computeAutoSuspicion returns whether the user matches automatic suspicion (priority: blacklist > account age > low follow count).
-func computeAutoSuspicion(
-	settings entity.SuspicionSettings,
-	blacklist map[string]struct{},
-	follows []entity.FollowedChannelRow,
-	gqlTotalCount int,
-	accountCreated *time.Time,
-	now time.Time,
-) (should bool, susType string, description string) {
-	if settings.AutoCheckBlacklist {
-		for _, f := range follows {
-			if _, ok := blacklist[strings.ToLower(f.FollowedChannelLogin)]; ok {
-				return true, entity.SusTypeAutoBlacklist, "Follows a channel on the blacklist"
-			}
-		}
-	}
-
-	if settings.AutoCheckAccountAge && accountCreated != nil {
-		threshold := time.Duration(settings.AccountAgeSusDays) * 24 * time.Hour
-		if settings.AccountAgeSusDays > 0 && now.Sub(*accountCreated) < threshold {
-			return true, entity.SusTypeAutoAge, "Account was created within the configured recent window"
-		}
-	}
-
-	if settings.AutoCheckLowFollows {
-		th := settings.LowFollowsThreshold
-		if th < 0 {
-			th = 0
-		}
-
-		if gqlTotalCount < th {
-			return true, entity.SusTypeAutoLowFollow, "Follow count is below the configured minimum"
-		}
-	}
-
-	return false, "", ""
-}
@@ -1,74 +1,255 @@
 package twitch
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
 
 	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
 )
 
-func TestComputeAutoSuspicion(t *testing.T) {
-	t.Parallel()
-
-	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
-	accountOld := now.Add(-400 * 24 * time.Hour)
-
-	settings := entity.SuspicionSettings{
+func testSuspicionSettings() entity.SuspicionSettings {
+	return entity.SuspicionSettings{
 		AutoCheckAccountAge: true,
 		AccountAgeSusDays:   14,
 		AutoCheckBlacklist:  true,
 		AutoCheckLowFollows: true,
 		LowFollowsThreshold: 10,
+		ScoreThreshold:      50,
+		Weights: entity.SuspicionWeights{
+			AccountAge:       50,
+			BlacklistFollows: 60,
+			LowFollows:       40,
+			NamePattern:      15,
+			FirstMessage:     25,
+			MultiChannel:     20,
+			Moderation:       30,
+		},
+		NamePatterns:          []string{"^[a-z]+_?[0-9]{4,}$"},
+		MultiChannelThreshold: 5,
 	}
+}
+
+func signalKeys(s entity.SuspicionScore) []string {
+	keys := make([]string, 0, len(s.Signals))
+	for _, sig := range s.Signals {
+		keys = append(keys, sig.Key)
+	}
+
+	return keys
+}
+
+func TestComputeSuspicionScore(t *testing.T) {
+	t.Parallel()
 
-	bl := map[string]struct{}{"badchannel": {}}
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	accountOld := now.Add(-400 * 24 * time.Hour)
+	settings := testSuspicionSettings()
 
-	t.Run("blacklist_wins", func(t *testing.T) {
+	t.Run("clean", func(t *testing.T) {
 		t.Parallel()
 
-		follows := []entity.FollowedChannelRow{
-			{FollowedChannelLogin: "badchannel"},
-		}
-		ok, typ, desc := computeAutoSuspicion(settings, bl, follows, 100, &accountOld, now)
-		assert.True(t, ok)
-		assert.Equal(t, entity.SusTypeAutoBlacklist, typ)
-		assert.Contains(t, desc, "blacklist")
+		got := computeSuspicionScore(settings, suspicionInputs{Login: "viewer", FollowTotal: 50, AccountCreated: &accountOld}, now)
+		assert.Zero(t, got.Score)
+		assert.Empty(t, got.Signals)
+		assert.False(t, suspicious(got))
 	})
 
-	t.Run("age_new_account", func(t *testing.T) {
+	t.Run("blacklist_scales_with_hits", func(t *testing.T) {
 		t.Parallel()
 
-		created := now.Add(-5 * 24 * time.Hour)
-		ok, typ, _ := computeAutoSuspicion(settings, nil, nil, 100, &created, now)
-		assert.True(t, ok)
-		assert.Equal(t, entity.SusTypeAutoAge, typ)
+		in := suspicionInputs{
+			Login:          "viewer",
+			Blacklist:      map[string]struct{}{"bad1": {}, "bad2": {}},
+			Follows:        []entity.FollowedChannelRow{{FollowedChannelLogin: "Bad1"}, {FollowedChannelLogin: "good"}},
+			FollowTotal:    50,
+			AccountCreated: &accountOld,
+		}
+
+		got := computeSuspicionScore(settings, in, now)
+		assert.Equal(t, 20, got.Score)
+		require.Len(t, got.Signals, 1)
+		assert.Equal(t, entity.SuspicionSignal{
+			Key: entity.SuspicionSignalBlacklistFollows, Score: 20, Weight: 60, Detail: "Follows 1 blacklisted channel(s): Bad1",
+		}, got.Signals[0])
+
+		in.Follows = append(in.Follows, entity.FollowedChannelRow{FollowedChannelLogin: "bad2"})
+		assert.Equal(t, 40, computeSuspicionScore(settings, in, now).Score)
 	})
 
-	t.Run("low_follows", func(t *testing.T) {
+	t.Run("age_and_low_follows_fade", func(t *testing.T) {
 		t.Parallel()
 
-		ok, typ, _ := computeAutoSuspicion(settings, nil, nil, 3, &accountOld, now)
-		assert.True(t, ok)
-		assert.Equal(t, entity.SusTypeAutoLowFollow, typ)
+		created := now.Add(-7 * 24 * time.Hour)
+		got := computeSuspicionScore(settings, suspicionInputs{Login: "viewer", FollowTotal: 5, AccountCreated: &created}, now)
+
+		assert.Equal(t, []string{entity.SuspicionSignalAccountAge, entity.SuspicionSignalLowFollows}, signalKeys(got))
+		assert.Equal(t, 38, got.Signals[0].Score)
+		assert.Equal(t, 30, got.Signals[1].Score)
+		assert.Equal(t, 68, got.Score)
+		assert.True(t, suspicious(got))
+		assert.Equal(t, "Score 68/50: Account created 7 days ago (window 14 days) (+38); Follows 5 channels (minimum 10) (+30)",
+			describeSuspicionScore(got))
 	})
 
-	t.Run("clean", func(t *testing.T) {
+	t.Run("behaviour_signals", func(t *testing.T) {
 		t.Parallel()
 
-		ok, _, _ := computeAutoSuspicion(settings, nil, nil, 50, &accountOld, now)
-		assert.False(t, ok)
+		got := computeSuspicionScore(settings, suspicionInputs{
+			Login:          "Bot_12345",
+			FollowTotal:    50,
+			AccountCreated: &accountOld,
+			FirstMessages: []entity.ChatHistoryMessage{
+				{Channel: "alpha", Message: "hello"},
+				{Channel: "beta", Message: "cheap viewers at spam.shop/now"},
+			},
+			ChannelCount:    6,
+			ModerationCount: 7,
+		}, now)
+
+		assert.Equal(t, []string{
+			entity.SuspicionSignalNamePattern,
+			entity.SuspicionSignalFirstMessage,
+			entity.SuspicionSignalMultiChannel,
+			entity.SuspicionSignalModeration,
+		}, signalKeys(got))
+		assert.Equal(t, "First message in #beta contains a link", got.Signals[1].Detail)
+		assert.Equal(t, 30, got.Signals[3].Score, "moderation hits are capped")
+		assert.Equal(t, 90, got.Score)
 	})
 
-	t.Run("disabled_checks", func(t *testing.T) {
+	t.Run("disabled_checks_and_zero_weights", func(t *testing.T) {
 		t.Parallel()
 
 		s := settings
 		s.AutoCheckAccountAge = false
 		s.AutoCheckBlacklist = false
 		s.AutoCheckLowFollows = false
-		ok, _, _ := computeAutoSuspicion(s, bl, []entity.FollowedChannelRow{{FollowedChannelLogin: "badchannel"}}, 2, nil, now)
-		assert.False(t, ok)
+		s.Weights.NamePattern = 0
+		s.NamePatterns = []string{"(", "^bot"}
+
+		created := now.Add(-time.Hour)
+		got := computeSuspicionScore(s, suspicionInputs{
+			Login:          "bot_1234",
+			Blacklist:      map[string]struct{}{"bad": {}},
+			Follows:        []entity.FollowedChannelRow{{FollowedChannelLogin: "bad"}},
+			FollowTotal:    1,
+			AccountCreated: &created,
+		}, now)
+		assert.Zero(t, got.Score)
 	})
 }
+
+func TestEvaluateSuspicionForUser_storesScoreAndMarksSus(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	created := time.Now().UTC().Add(-time.Hour)
+	settings := testSuspicionSettings()
+
+	repo.EXPECT().ListLinkedTwitchAccountUserIDs(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(9)).Return(entity.TwitchUser{ID: 9, Username: "viewer"}, nil)
+	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(settings, nil)
+	repo.EXPECT().ListChannelBlacklist(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().MatchBlocklists(gomock.Any(), int64(9), "viewer").Return(nil, nil)
+	repo.EXPECT().ListLoginPatterns(gomock.Any()).Return(nil, nil)
+	repo.EXPECT().ListUserFollowedChannels(gomock.Any(), int64(9)).Return(nil, nil)
+	repo.EXPECT().GetHelixMeta(gomock.Any(), int64(9)).Return(&created, nil, nil, nil)
+	repo.EXPECT().ListChatMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f entity.ChatMessageListFilter) ([]entity.ChatHistoryMessage, error) {
+			assert.True(t, f.FirstMessageOnly)
+			assert.Equal(t, int64(9), *f.ChatterUserID)
+
+			return nil, nil
+		})
+	repo.EXPECT().ListChatterChannelPresence(gomock.Any(), int64(9)).Return(nil, nil)
+	repo.EXPECT().CountUserActivityEventsByType(gomock.Any(), int64(9),
+		[]string{entity.UserActivityBan, entity.UserActivityTimeout}).Return(int64(0), nil)
+
+	var stored entity.SuspicionScore
+
+	repo.EXPECT().UpsertSuspicionScore(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, s entity.SuspicionScore) error {
+			stored = s
+			return nil
+		})
+	repo.EXPECT().PatchTwitchUser(gomock.Any(), int64(9), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, p entity.TwitchUserPatch) (entity.TwitchUser, error) {
+			assert.True(t, *p.IsSus)
+			assert.Equal(t, entity.SusTypeAutoScore, *p.SusType)
+			assert.Contains(t, *p.SusDescription, "Score 90/50")
+			assert.Equal(t, entity.SuspicionSourceAuto, p.SusSource)
+			assert.Equal(t, 90, p.SusEvidence["score"])
+
+			return entity.TwitchUser{ID: 9, IsSus: true}, nil
+		})
+
+	require.NoError(t, svc.evaluateSuspicionForUser(context.Background(), 9, 0))
+
+	assert.Equal(t, int64(9), stored.TwitchUserID)
+	assert.Equal(t, 90, stored.Score)
+	assert.Equal(t, []string{entity.SuspicionSignalAccountAge, entity.SuspicionSignalLowFollows}, signalKeys(stored))
+}
+
+func TestEvaluateSuspicionForUser_sameVerdictNextDayNoPatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(repo, stopNoopBC{}, testTwitchCfg("cid", "csec"), obs)
+
+	autoScore := entity.SusTypeAutoScore
+	user := entity.TwitchUser{ID: 9, Username: "viewer"}
+
+	// scoreOn re-scores the user as if evaluated `days` after the account was created.
+	scoreOn := func(days int) {
+		created := time.Now().UTC().Add(-time.Duration(days)*24*time.Hour - time.Hour)
+
+		repo.EXPECT().ListLinkedTwitchAccountUserIDs(gomock.Any()).Return(nil, nil)
+		repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(9)).Return(user, nil)
+		repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(testSuspicionSettings(), nil)
+		repo.EXPECT().ListChannelBlacklist(gomock.Any()).Return(nil, nil)
+		repo.EXPECT().MatchBlocklists(gomock.Any(), int64(9), "viewer").Return(nil, nil)
+		repo.EXPECT().ListLoginPatterns(gomock.Any()).Return(nil, nil).MaxTimes(1)
+		repo.EXPECT().ListUserFollowedChannels(gomock.Any(), int64(9)).Return(nil, nil)
+		repo.EXPECT().GetHelixMeta(gomock.Any(), int64(9)).Return(&created, nil, nil, nil)
+		repo.EXPECT().ListChatMessages(gomock.Any(), gomock.Any()).Return(nil, nil)
+		repo.EXPECT().ListChatterChannelPresence(gomock.Any(), int64(9)).Return(nil, nil)
+		repo.EXPECT().CountUserActivityEventsByType(gomock.Any(), int64(9), gomock.Any()).Return(int64(0), nil)
+		repo.EXPECT().UpsertSuspicionScore(gomock.Any(), gomock.Any()).Return(nil)
+
+		require.NoError(t, svc.evaluateSuspicionForUser(context.Background(), 9, 0))
+	}
+
+	// Day 1 marks the user; the patch (and its audit event) happens once.
+	repo.EXPECT().PatchTwitchUser(gomock.Any(), int64(9), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int64, p entity.TwitchUserPatch) (entity.TwitchUser, error) {
+			assert.Contains(t, *p.SusDescription, "Account created 1 days ago")
+
+			user.IsSus, user.SusType, user.SusDescription = true, &autoScore, p.SusDescription
+
+			return user, nil
+		})
+
+	scoreOn(1)
+
+	// Day 2 has a lower age score and a different description, but the verdict is unchanged: no patch.
+	scoreOn(2)
+}
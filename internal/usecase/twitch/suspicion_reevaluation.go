@@ -0,0 +1,260 @@
+package twitch
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// reevaluationBatchSize is how many users are loaded per page; progress is broadcast after each page.
+const reevaluationBatchSize = 200
+
+type reevaluationRequest struct {
+	reason        string
+	refetchBudget int
+}
+
+// StartSuspicionReevaluation re-scores every known user in the background from cached enrichment data.
+// Up to refetchBudget users whose follows are stale (older than the enrichment cooldown) are re-synced
+// from GQL first. A request made while a run is in progress is queued and runs once it finishes;
+// repeated requests collapse into one, keeping the largest budget.
+func (s *Usecase) StartSuspicionReevaluation(reason string, refetchBudget int) entity.SuspicionReevaluation {
+	refetchBudget = max(refetchBudget, 0)
+
+	s.reevalMu.Lock()
+	defer s.reevalMu.Unlock()
+
+	if s.reeval.Running {
+		if s.reevalNext == nil {
+			s.reevalNext = &reevaluationRequest{reason: reason, refetchBudget: refetchBudget}
+		} else {
+			s.reevalNext.reason = reason
+			s.reevalNext.refetchBudget = max(s.reevalNext.refetchBudget, refetchBudget)
+		}
+
+		s.reeval.Queued = true
+
+		return s.reeval
+	}
+
+	s.beginReevaluationLocked(reevaluationRequest{reason: reason, refetchBudget: refetchBudget})
+
+	go s.runSuspicionReevaluations()
+
+	return s.reeval
+}
+
+// SuspicionReevaluationStatus returns the progress of the current or last bulk re-evaluation.
+func (s *Usecase) SuspicionReevaluationStatus() entity.SuspicionReevaluation {
+	s.reevalMu.Lock()
+	defer s.reevalMu.Unlock()
+
+	return s.reeval
+}
+
+func (s *Usecase) beginReevaluationLocked(req reevaluationRequest) {
+	now := time.Now().UTC()
+
+	s.reeval = entity.SuspicionReevaluation{
+		Running:       true,
+		Reason:        req.reason,
+		RefetchBudget: req.refetchBudget,
+		StartedAt:     &now,
+	}
+}
+
+func (s *Usecase) runSuspicionReevaluations() {
+	ctx := s.persistContext()
+
+	for {
+		s.reevalMu.Lock()
+		budget := s.reeval.RefetchBudget
+		s.reevalMu.Unlock()
+
+		s.reevaluateAllUsers(ctx, budget)
+
+		s.reevalMu.Lock()
+		now := time.Now().UTC()
+		s.reeval.Running = false
+		s.reeval.Queued = false
+		s.reeval.FinishedAt = &now
+		finished := s.reeval
+
+		next := s.reevalNext
+		s.reevalNext = nil
+
+		if next != nil {
+			s.beginReevaluationLocked(*next)
+		}
+		s.reevalMu.Unlock()
+
+		s.broadcastReevaluation(finished)
+
+		if next == nil || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// reevaluateAllUsers walks twitch users by id and re-runs suspicion rules for each one with synced follows.
+func (s *Usecase) reevaluateAllUsers(ctx context.Context, refetchBudget int) {
+	ctx, span := s.obs.StartSpan(ctx, "service.twitch.reevaluate_suspicion")
+	defer span.End()
+
+	total, err := s.repo.CountTwitchUsersBrowse(ctx, entity.TwitchUserBrowseFilter{})
+	if err != nil {
+		s.obs.LogError(ctx, span, "suspicion re-evaluation: count users failed", err)
+		return
+	}
+
+	s.updateReevaluation(func(st *entity.SuspicionReevaluation) { st.Total = total })
+
+	globals, err := s.loadSuspicionGlobals(ctx)
+	if err != nil {
+		s.obs.LogError(ctx, span, "suspicion re-evaluation: load settings failed", err)
+		return
+	}
+
+	cooldown := s.enrichmentCooldown(ctx)
+
+	var afterID int64
+
+	for ctx.Err() == nil {
+		batch, err := s.repo.ListFollowsSyncStates(ctx, afterID, reevaluationBatchSize)
+		if err != nil {
+			s.obs.LogError(ctx, span, "suspicion re-evaluation: list users failed", err)
+			return
+		}
+
+		if len(batch) == 0 {
+			return
+		}
+
+		for _, st := range batch {
+			afterID = st.TwitchUserID
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			outcome := s.reevaluateUser(ctx, globals, st, cooldown, &refetchBudget)
+
+			s.updateReevaluation(func(p *entity.SuspicionReevaluation) {
+				p.Processed++
+
+				switch outcome {
+				case reevalEvaluated:
+					p.Evaluated++
+				case reevalRefetched:
+					p.Evaluated++
+					p.Refetched++
+				case reevalSkipped:
+					p.Skipped++
+				case reevalFailed:
+					p.Failed++
+				}
+			})
+		}
+
+		s.broadcastReevaluation(s.SuspicionReevaluationStatus())
+	}
+}
+
+type reevalOutcome int
+
+const (
+	reevalEvaluated reevalOutcome = iota
+	reevalRefetched
+	reevalSkipped
+	reevalFailed
+)
+
+// reevaluateUser scores one user from the cached follow total, refetching stale follows while budget remains.
+// Users whose follows were never synced are skipped rather than scored as following nobody.
+func (s *Usecase) reevaluateUser(
+	ctx context.Context,
+	g suspicionGlobals,
+	st entity.FollowsSyncState,
+	cooldown time.Duration,
+	budget *int,
+) reevalOutcome {
+	stale := st.FollowsSyncedAt == nil || time.Since(*st.FollowsSyncedAt) >= cooldown
+	refetched := false
+
+	total := 0
+	if st.FollowsTotal != nil {
+		total = *st.FollowsTotal
+	}
+
+	if stale && *budget > 0 {
+		*budget--
+
+		n, err := s.syncUserFollowsWith(ctx, g.settings, st.TwitchUserID)
+		if err != nil {
+			s.obs.Logger.Debug("suspicion re-evaluation: gql follows failed", zap.Int64("id", st.TwitchUserID), zap.Error(err))
+		} else {
+			total = n
+			refetched = true
+		}
+	}
+
+	if !refetched && st.FollowsTotal == nil {
+		// Never enriched: only blocklists and login patterns can be applied.
+		if err := s.checkIdentityListsWith(ctx, g.ownIDs, st.TwitchUserID); err != nil {
+			s.obs.Logger.Debug("suspicion re-evaluation: identity check failed", zap.Int64("id", st.TwitchUserID), zap.Error(err))
+		}
+
+		return reevalSkipped
+	}
+
+	if err := s.evaluateSuspicionWith(ctx, g, st.TwitchUserID, total); err != nil {
+		s.obs.Logger.Debug("suspicion re-evaluation: eval failed", zap.Int64("id", st.TwitchUserID), zap.Error(err))
+		return reevalFailed
+	}
+
+	if refetched {
+		return reevalRefetched
+	}
+
+	return reevalEvaluated
+}
+
+func (s *Usecase) updateReevaluation(fn func(*entity.SuspicionReevaluation)) {
+	s.reevalMu.Lock()
+	fn(&s.reeval)
+	s.reevalMu.Unlock()
+}
+
+// broadcastReevaluation pushes bulk re-evaluation progress to all live WebSocket clients.
+func (s *Usecase) broadcastReevaluation(st entity.SuspicionReevaluation) {
+	if s.broadcaster == nil {
+		return
+	}
+
+	payload := map[string]any{
+		"type":           "suspicion_reevaluation",
+		"running":        st.Running,
+		"queued":         st.Queued,
+		"reason":         st.Reason,
+		"refetch_budget": st.RefetchBudget,
+		"total":          st.Total,
+		"processed":      st.Processed,
+		"evaluated":      st.Evaluated,
+		"refetched":      st.Refetched,
+		"skipped":        st.Skipped,
+		"failed":         st.Failed,
+	}
+
+	if st.StartedAt != nil {
+		payload["started_at"] = st.StartedAt.Format(time.RFC3339)
+	}
+
+	if st.FinishedAt != nil {
+		payload["finished_at"] = st.FinishedAt.Format(time.RFC3339)
+	}
+
+	s.broadcaster.BroadcastJSON(payload)
+}
@@ -0,0 +1,117 @@
+package twitch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/rofleksey/dredge/internal/entity"
+	"github.com/rofleksey/dredge/internal/observability"
+	repomocks "github.com/rofleksey/dredge/internal/repository/mocks"
+	"github.com/rofleksey/dredge/internal/service/twitch/gql"
+)
+
+type recordingBC struct {
+	mu   sync.Mutex
+	msgs []map[string]any
+}
+
+func (b *recordingBC) BroadcastJSON(v any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if m, ok := v.(map[string]any); ok {
+		b.msgs = append(b.msgs, m)
+	}
+}
+
+func TestReevaluateAllUsers(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockStore(ctrl)
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	bc := &recordingBC{}
+	svc := New(repo, bc, testTwitchCfg("cid", "csec"), obs)
+
+	gqlCalls := 0
+	svc.gql = gql.NewClient(&http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		gqlCalls++
+		return nil, errors.New("gql down")
+	})})
+
+	fresh := time.Now().UTC().Add(-time.Hour)
+	stale := time.Now().UTC().Add(-48 * time.Hour)
+	thirty, five := 30, 5
+
+	repo.EXPECT().CountTwitchUsersBrowse(gomock.Any(), entity.TwitchUserBrowseFilter{}).Return(int64(5), nil)
+	repo.EXPECT().GetIrcMonitorSettings(gomock.Any()).Return(entity.IrcMonitorSettings{EnrichmentCooldown: 24 * time.Hour}, nil)
+	repo.EXPECT().ListFollowsSyncStates(gomock.Any(), int64(0), reevaluationBatchSize).Return([]entity.FollowsSyncState{
+		{TwitchUserID: 1, FollowsTotal: &thirty, FollowsSyncedAt: &fresh},
+		{TwitchUserID: 2},
+		{TwitchUserID: 3},
+		{TwitchUserID: 4, FollowsTotal: &five, FollowsSyncedAt: &stale},
+		{TwitchUserID: 5, FollowsTotal: &five, FollowsSyncedAt: &fresh},
+	}, nil)
+	repo.EXPECT().ListFollowsSyncStates(gomock.Any(), int64(5), reevaluationBatchSize).Return(nil, nil)
+
+	// Linked accounts, settings and the channel blacklist are loaded once for the whole run, including the refetch.
+	repo.EXPECT().ListLinkedTwitchAccountUserIDs(gomock.Any()).Return([]int64{1, 4}, nil)
+	repo.EXPECT().GetSuspicionSettings(gomock.Any()).Return(entity.SuspicionSettings{MaxGQLFollowPages: 1}, nil).Times(1)
+	repo.EXPECT().ListChannelBlacklist(gomock.Any()).Return(nil, nil)
+
+	// User 2 spends the refetch budget on a failed GQL call; user 3 is skipped without one. Both still get the
+	// blocklist and login pattern check; the pattern list is loaded once and cached.
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(2)).Return(entity.TwitchUser{ID: 2, Username: "fresh_viewer"}, nil).Times(2)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(3)).Return(entity.TwitchUser{ID: 3, Username: "other_viewer"}, nil)
+	repo.EXPECT().MatchBlocklists(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+	repo.EXPECT().ListLoginPatterns(gomock.Any()).Return(nil, nil)
+
+	// Users 1 and 4 are linked accounts so evaluation stops early; user 5 fails.
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(1)).Return(entity.TwitchUser{ID: 1}, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(4)).Return(entity.TwitchUser{ID: 4}, nil)
+	repo.EXPECT().GetTwitchUserByID(gomock.Any(), int64(5)).Return(entity.TwitchUser{}, errors.New("db down"))
+
+	svc.reevaluateAllUsers(context.Background(), 1)
+
+	st := svc.SuspicionReevaluationStatus()
+	assert.Equal(t, int64(5), st.Total)
+	assert.Equal(t, 5, st.Processed)
+	assert.Equal(t, 2, st.Evaluated)
+	assert.Zero(t, st.Refetched)
+	assert.Equal(t, 2, st.Skipped)
+	assert.Equal(t, 1, st.Failed)
+	assert.Equal(t, 1, gqlCalls)
+
+	require.Len(t, bc.msgs, 1)
+	assert.Equal(t, "suspicion_reevaluation", bc.msgs[0]["type"])
+	assert.Equal(t, 5, bc.msgs[0]["processed"])
+}
+
+func TestStartSuspicionReevaluation_queuesWhileRunning(t *testing.T) {
+	t.Parallel()
+
+	obs := &observability.Stack{Logger: zap.NewNop(), Tracer: otel.Tracer("test")}
+	svc := New(nil, nil, testTwitchCfg("cid", "csec"), obs)
+	svc.reeval = entity.SuspicionReevaluation{Running: true, Reason: entity.SuspicionReevalReasonSettings}
+
+	st := svc.StartSuspicionReevaluation(entity.SuspicionReevalReasonManual, 10)
+	assert.True(t, st.Queued)
+	assert.Equal(t, entity.SuspicionReevalReasonSettings, st.Reason)
+
+	svc.StartSuspicionReevaluation(entity.SuspicionReevalReasonBlacklist, -3)
+
+	require.NotNil(t, svc.reevalNext)
+	assert.Equal(t, reevaluationRequest{reason: entity.SuspicionReevalReasonBlacklist, refetchBudget: 10}, *svc.reevalNext)
+}
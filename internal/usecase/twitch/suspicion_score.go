@@ -0,0 +1,149 @@
+package twitch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rofleksey/dredge/internal/entity"
+)
+
+// maxCountedHits caps how many blacklisted follows or moderation actions add to a signal.
+const maxCountedHits = 3
+
+var linkRe = regexp.MustCompile(`(?i)(https?://|www\.)\S+|\b[a-z0-9-]+\.(com|net|org|ru|io|gg|xyz|shop|store|tv|me|ly)(/\S*)?\b`)
+
+// suspicionInputs is everything the scoring engine looks at for one user.
+type suspicionInputs struct {
+	Login          string
+	Blacklist      map[string]struct{}
+	Follows        []entity.FollowedChannelRow
+	FollowTotal    int
+	AccountCreated *time.Time
+	// FirstMessages are chat rows Twitch flagged as the user's first message in a channel.
+	FirstMessages []entity.ChatHistoryMessage
+	// ChannelCount is how many monitored channels the user is present in right now.
+	ChannelCount int
+	// ModerationCount is the number of bans and timeouts seen for the user.
+	ModerationCount int64
+}
+
+// computeSuspicionScore sums the weighted signals that fire for a user. Each signal contributes at most
+// its weight; the user is suspicious once Score reaches Threshold.
+func computeSuspicionScore(settings entity.SuspicionSettings, in suspicionInputs, now time.Time) entity.SuspicionScore {
+	out := entity.SuspicionScore{Threshold: settings.ScoreThreshold, ComputedAt: now}
+	w := settings.Weights
+
+	add := func(key string, weight int, score int, detail string) {
+		if weight <= 0 || score <= 0 {
+			return
+		}
+
+		score = min(score, weight)
+		out.Score += score
+		out.Signals = append(out.Signals, entity.SuspicionSignal{Key: key, Score: score, Weight: weight, Detail: detail})
+	}
+
+	if settings.AutoCheckAccountAge && in.AccountCreated != nil && settings.AccountAgeSusDays > 0 {
+		window := time.Duration(settings.AccountAgeSusDays) * 24 * time.Hour
+
+		if age := max(now.Sub(*in.AccountCreated), 0); age < window {
+			// Brand-new accounts score the full weight, fading to half at the edge of the window.
+			score := w.AccountAge - int(float64(w.AccountAge)*age.Hours()/window.Hours()/2)
+			add(entity.SuspicionSignalAccountAge, w.AccountAge, score,
+				fmt.Sprintf("Account created %d days ago (window %d days)", int(age.Hours()/24), settings.AccountAgeSusDays))
+		}
+	}
+
+	if settings.AutoCheckBlacklist {
+		var hits []string
+
+		for _, f := range in.Follows {
+			if _, ok := in.Blacklist[strings.ToLower(f.FollowedChannelLogin)]; ok {
+				hits = append(hits, f.FollowedChannelLogin)
+			}
+		}
+
+		if len(hits) > 0 {
+			add(entity.SuspicionSignalBlacklistFollows, w.BlacklistFollows, w.BlacklistFollows*min(len(hits), maxCountedHits)/maxCountedHits,
+				fmt.Sprintf("Follows %d blacklisted channel(s): %s", len(hits), strings.Join(hits, ", ")))
+		}
+	}
+
+	if settings.AutoCheckLowFollows && settings.LowFollowsThreshold > 0 && in.FollowTotal < settings.LowFollowsThreshold {
+		score := w.LowFollows - w.LowFollows*max(in.FollowTotal, 0)/settings.LowFollowsThreshold/2
+		add(entity.SuspicionSignalLowFollows, w.LowFollows, score,
+			fmt.Sprintf("Follows %d channels (minimum %d)", in.FollowTotal, settings.LowFollowsThreshold))
+	}
+
+	if pat := matchNamePattern(settings.NamePatterns, in.Login); pat != "" {
+		add(entity.SuspicionSignalNamePattern, w.NamePattern, w.NamePattern,
+			fmt.Sprintf("Login matches pattern %q", pat))
+	}
+
+	for _, m := range in.FirstMessages {
+		if linkRe.MatchString(m.Message) {
+			add(entity.SuspicionSignalFirstMessage, w.FirstMessage, w.FirstMessage,
+				fmt.Sprintf("First message in #%s contains a link", m.Channel))
+
+			break
+		}
+	}
+
+	if settings.MultiChannelThreshold > 0 && in.ChannelCount >= settings.MultiChannelThreshold {
+		add(entity.SuspicionSignalMultiChannel, w.MultiChannel, w.MultiChannel,
+			fmt.Sprintf("Chatting in %d monitored channels at once", in.ChannelCount))
+	}
+
+	if in.ModerationCount > 0 {
+		add(entity.SuspicionSignalModeration, w.Moderation, w.Moderation*int(min(in.ModerationCount, maxCountedHits))/maxCountedHits,
+			fmt.Sprintf("Banned or timed out %d time(s)", in.ModerationCount))
+	}
+
+	return out
+}
+
+// suspicious reports whether the score crosses the configured threshold.
+func suspicious(s entity.SuspicionScore) bool {
+	return s.Threshold > 0 && s.Score >= s.Threshold
+}
+
+// describeSuspicionScore renders the breakdown stored as the user's sus description.
+func describeSuspicionScore(s entity.SuspicionScore) string {
+	parts := make([]string, 0, len(s.Signals))
+	for _, sig := range s.Signals {
+		parts = append(parts, fmt.Sprintf("%s (+%d)", sig.Detail, sig.Score))
+	}
+
+	return fmt.Sprintf("Score %d/%d: %s", s.Score, s.Threshold, strings.Join(parts, "; "))
+}
+
+// suspicionEvidence snapshots a score breakdown for the suspicion audit log.
+func suspicionEvidence(s entity.SuspicionScore) map[string]any {
+	return map[string]any{
+		"score":     s.Score,
+		"threshold": s.Threshold,
+		"signals":   s.Signals,
+	}
+}
+
+// matchNamePattern returns the first pattern matching login; invalid patterns are skipped.
+func matchNamePattern(patterns []string, login string) string {
+	if login == "" {
+		return ""
+	}
+
+	for _, p := range patterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			continue
+		}
+
+		if re.MatchString(login) {
+			return p
+		}
+	}
+
+	return ""
+}
@@ -50,8 +50,18 @@ func (s *Usecase) syncStreamSessions(ctx context.Context) error {
 			continue
 		}
 
-		if err := s.repo.CloseOpenStreamsForChannel(ctx, u.ID); err != nil {
+		closed, err := s.repo.CloseOpenStreamsForChannel(ctx, u.ID)
+		if err != nil {
 			s.obs.Logger.Warn("close open streams for offline channel failed", zap.Error(err), zap.Int64("channel_user_id", u.ID))
+			continue
+		}
+
+		for _, id := range closed {
+			go func() {
+				if err := s.recordStreamRecap(s.persistContext(), id); err != nil {
+					s.obs.Logger.Warn("record stream recap failed", zap.Error(err), zap.Int64("stream_id", id))
+				}
+			}()
 		}
 	}
 
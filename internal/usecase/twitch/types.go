@@ -2,9 +2,11 @@ package twitch
 
 import (
 	"context"
+	"net/http"
 	"sync"
 	"time"
 
+	"github.com/rofleksey/dredge/internal/entity"
 	"github.com/rofleksey/dredge/internal/observability"
 	"github.com/rofleksey/dredge/internal/repository"
 	"github.com/rofleksey/dredge/internal/service/twitch/gql"
@@ -22,6 +24,9 @@ type Usecase struct {
 	gql  *gql.Client
 	live *live.Runtime
 
+	// blocklistHTTP pulls external blocklists from their source URLs.
+	blocklistHTTP *http.Client
+
 	repo        repository.Store
 	broadcaster Broadcaster
 	obs         *observability.Stack
@@ -30,6 +35,24 @@ type Usecase struct {
 	persistMu  sync.RWMutex
 	persistCtx context.Context
 
+	reevalMu   sync.Mutex
+	reeval     entity.SuspicionReevaluation
+	reevalNext *reevaluationRequest
+
+	// loginPatterns caches the compiled login pattern blacklist until InvalidateLoginPatterns; loginPatternsGen
+	// counts invalidations so a load that raced one is not stored.
+	loginPatternsMu     sync.Mutex
+	loginPatterns       []entity.CompiledLoginPattern
+	loginPatternsLoaded bool
+	loginPatternsGen    uint64
+
+	// recapSummarizer and recapNotifier are optional stream recap extensions (see SetStreamRecapSummarizer).
+	recapSummarizer StreamRecapSummarizer
+	recapNotifier   StreamRecapNotifier
+
+	// chatClassifier is the optional AI chat classifier (see SetChatClassifier).
+	chatClassifier ChatClassifier
+
 	viewerPollInterval          time.Duration
 	channelChattersSyncInterval time.Duration
 	streamSessionPollInterval   time.Duration
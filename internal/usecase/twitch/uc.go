@@ -23,6 +23,7 @@ func New(repo repository.Store, broadcaster Broadcaster, cfg config.Config, obs
 	s := &Usecase{
 		Client:                      hx,
 		gql:                         gql.NewClient(http.DefaultClient),
+		blocklistHTTP:               &http.Client{Timeout: time.Minute},
 		repo:                        repo,
 		broadcaster:                 broadcaster,
 		obs:                         obs,
@@ -53,6 +54,7 @@ func New(repo repository.Store, broadcaster Broadcaster, cfg config.Config, obs
 		ChannelChattersSyncPeriod: s.channelChattersSyncInterval,
 		JoinReconcileInterval:     joinReconcile,
 		OAuthTokenSyncInterval:    oauthTokSync,
+		OnNewUser:                 s.checkNewUserLogin,
 	})
 
 	return s